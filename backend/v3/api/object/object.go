@@ -3,6 +3,7 @@ package object
 import (
 	"github.com/zitadel/zitadel/backend/v3/storage/database"
 	"github.com/zitadel/zitadel/internal/zerrors"
+	v2_filter "github.com/zitadel/zitadel/pkg/grpc/filter/v2"
 	v2_object "github.com/zitadel/zitadel/pkg/grpc/object/v2"
 	v2beta_object "github.com/zitadel/zitadel/pkg/grpc/object/v2beta"
 )
@@ -53,3 +54,37 @@ func TextQueryMethodToTextOperation(txtMethod v2_object.TextQueryMethod) (databa
 		return 0, zerrors.ThrowInvalidArgument(nil, "OBJ-iBRBVe", "invalid text query method")
 	}
 }
+
+func TextFilterMethodToTextOperation(txtMethod v2_filter.TextFilterMethod) (database.TextOperation, error) {
+	switch txtMethod {
+	case v2_filter.TextFilterMethod_TEXT_FILTER_METHOD_CONTAINS:
+		return database.TextOperationContains, nil
+	case v2_filter.TextFilterMethod_TEXT_FILTER_METHOD_CONTAINS_IGNORE_CASE:
+		return database.TextOperationContainsIgnoreCase, nil
+	case v2_filter.TextFilterMethod_TEXT_FILTER_METHOD_ENDS_WITH:
+		return database.TextOperationEndsWith, nil
+	case v2_filter.TextFilterMethod_TEXT_FILTER_METHOD_ENDS_WITH_IGNORE_CASE:
+		return database.TextOperationEndsWithIgnoreCase, nil
+	case v2_filter.TextFilterMethod_TEXT_FILTER_METHOD_EQUALS_IGNORE_CASE:
+		return database.TextOperationEqualIgnoreCase, nil
+	case v2_filter.TextFilterMethod_TEXT_FILTER_METHOD_STARTS_WITH:
+		return database.TextOperationStartsWith, nil
+	case v2_filter.TextFilterMethod_TEXT_FILTER_METHOD_STARTS_WITH_IGNORE_CASE:
+		return database.TextOperationStartsWithIgnoreCase, nil
+	case v2_filter.TextFilterMethod_TEXT_FILTER_METHOD_EQUALS:
+		return database.TextOperationEqual, nil
+	default:
+		return 0, zerrors.ThrowInvalidArgument(nil, "OBJ-v2Q8sT", "invalid text filter method")
+	}
+}
+
+func ByteFilterMethodToBytesOperation(byteMethod v2_filter.ByteFilterMethod) (database.BytesOperation, error) {
+	switch byteMethod {
+	case v2_filter.ByteFilterMethod_BYTE_FILTER_METHOD_EQUALS:
+		return database.BytesOperationEqual, nil
+	case v2_filter.ByteFilterMethod_BYTE_FILTER_METHOD_NOT_EQUALS:
+		return database.BytesOperationNotEqual, nil
+	default:
+		return 0, zerrors.ThrowInvalidArgument(nil, "OBJ-Xk3uN7", "invalid byte filter method")
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/zitadel/zitadel/backend/v3/storage/database"
 	"github.com/zitadel/zitadel/internal/zerrors"
+	v2_filter "github.com/zitadel/zitadel/pkg/grpc/filter/v2"
 	v2_object "github.com/zitadel/zitadel/pkg/grpc/object/v2"
 )
 
@@ -75,3 +76,105 @@ func TestTextQueryMethodToTextOperation(t *testing.T) {
 		})
 	}
 }
+
+func TestTextFilterMethodToTextOperation(t *testing.T) {
+	t.Parallel()
+	tt := []struct {
+		name            string
+		filterOperation v2_filter.TextFilterMethod
+
+		expectedOperation database.TextOperation
+		expectedError     error
+	}{
+		{
+			name:              "contains",
+			filterOperation:   v2_filter.TextFilterMethod_TEXT_FILTER_METHOD_CONTAINS,
+			expectedOperation: database.TextOperationContains,
+		},
+		{
+			name:              "contains ignore case",
+			filterOperation:   v2_filter.TextFilterMethod_TEXT_FILTER_METHOD_CONTAINS_IGNORE_CASE,
+			expectedOperation: database.TextOperationContainsIgnoreCase,
+		},
+		{
+			name:              "ends with",
+			filterOperation:   v2_filter.TextFilterMethod_TEXT_FILTER_METHOD_ENDS_WITH,
+			expectedOperation: database.TextOperationEndsWith,
+		},
+		{
+			name:              "ends with ignore case",
+			filterOperation:   v2_filter.TextFilterMethod_TEXT_FILTER_METHOD_ENDS_WITH_IGNORE_CASE,
+			expectedOperation: database.TextOperationEndsWithIgnoreCase,
+		},
+		{
+			name:              "equals",
+			filterOperation:   v2_filter.TextFilterMethod_TEXT_FILTER_METHOD_EQUALS,
+			expectedOperation: database.TextOperationEqual,
+		},
+		{
+			name:              "equals ignore case",
+			filterOperation:   v2_filter.TextFilterMethod_TEXT_FILTER_METHOD_EQUALS_IGNORE_CASE,
+			expectedOperation: database.TextOperationEqualIgnoreCase,
+		},
+		{
+			name:              "starts with",
+			filterOperation:   v2_filter.TextFilterMethod_TEXT_FILTER_METHOD_STARTS_WITH,
+			expectedOperation: database.TextOperationStartsWith,
+		},
+		{
+			name:              "starts with ignore case",
+			filterOperation:   v2_filter.TextFilterMethod_TEXT_FILTER_METHOD_STARTS_WITH_IGNORE_CASE,
+			expectedOperation: database.TextOperationStartsWithIgnoreCase,
+		},
+		{
+			name:            "unknown operation",
+			filterOperation: v2_filter.TextFilterMethod(99),
+			expectedError:   zerrors.ThrowInvalidArgument(nil, "OBJ-v2Q8sT", "invalid text filter method"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := TextFilterMethodToTextOperation(tc.filterOperation)
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedOperation, got)
+		})
+	}
+}
+
+func TestByteFilterMethodToBytesOperation(t *testing.T) {
+	t.Parallel()
+	tt := []struct {
+		name            string
+		filterOperation v2_filter.ByteFilterMethod
+
+		expectedOperation database.BytesOperation
+		expectedError     error
+	}{
+		{
+			name:              "equals",
+			filterOperation:   v2_filter.ByteFilterMethod_BYTE_FILTER_METHOD_EQUALS,
+			expectedOperation: database.BytesOperationEqual,
+		},
+		{
+			name:              "not equals",
+			filterOperation:   v2_filter.ByteFilterMethod_BYTE_FILTER_METHOD_NOT_EQUALS,
+			expectedOperation: database.BytesOperationNotEqual,
+		},
+		{
+			name:            "unknown operation",
+			filterOperation: v2_filter.ByteFilterMethod(99),
+			expectedError:   zerrors.ThrowInvalidArgument(nil, "OBJ-Xk3uN7", "invalid byte filter method"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ByteFilterMethodToBytesOperation(tc.filterOperation)
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedOperation, got)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package convert
+
+import (
+	"github.com/muhlemmer/gu"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/backend/v3/domain"
+	zitadel_internal_domain "github.com/zitadel/zitadel/internal/domain"
+	grpc_object "github.com/zitadel/zitadel/pkg/grpc/object/v2"
+	v2_user "github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+/*
+ * Domain Model to GRPC v2
+ */
+
+func DomainUserListModelToGRPCResponse(users []*domain.User, assetPrefix string) []*v2_user.User {
+	toReturn := make([]*v2_user.User, len(users))
+
+	for i, user := range users {
+		toReturn[i] = DomainUserModelToGRPCResponse(user, assetPrefix)
+	}
+
+	return toReturn
+}
+
+func DomainUserModelToGRPCResponse(user *domain.User, assetPrefix string) *v2_user.User {
+	if user == nil {
+		return nil
+	}
+
+	toReturn := &v2_user.User{
+		UserId:             user.ID,
+		Details:            DomainUserModelToDetails(user),
+		State:              domainUserStateToGRPC(user.State),
+		Username:           user.Username,
+		LoginNames:         user.LoginNames,
+		PreferredLoginName: user.PreferredLoginName,
+	}
+	switch {
+	case user.Human != nil:
+		toReturn.Type = &v2_user.User_Human{
+			Human: domainHumanModelToGRPCResponse(user.Human, assetPrefix, user.OrganizationID),
+		}
+	case user.Machine != nil:
+		toReturn.Type = &v2_user.User_Machine{
+			Machine: domainMachineModelToGRPCResponse(user.Machine),
+		}
+	}
+	return toReturn
+}
+
+func DomainUserModelToDetails(user *domain.User) *grpc_object.Details {
+	return &grpc_object.Details{
+		ChangeDate:    timestamppb.New(user.UpdatedAt),
+		CreationDate:  timestamppb.New(user.CreatedAt),
+		ResourceOwner: user.OrganizationID,
+	}
+}
+
+func domainHumanModelToGRPCResponse(human *domain.HumanUser, assetPrefix, orgID string) *v2_user.HumanUser {
+	var passwordChanged, mfaInitSkipped *timestamppb.Timestamp
+	if !human.Password.VerifiedAt.IsZero() {
+		passwordChanged = timestamppb.New(human.Password.VerifiedAt)
+	}
+	if !human.MultifactorInitializationSkippedAt.IsZero() {
+		mfaInitSkipped = timestamppb.New(human.MultifactorInitializationSkippedAt)
+	}
+
+	toReturn := &v2_user.HumanUser{
+		Profile: &v2_user.HumanProfile{
+			GivenName:         human.FirstName,
+			FamilyName:        human.LastName,
+			NickName:          gu.Ptr(human.Nickname),
+			DisplayName:       gu.Ptr(human.DisplayName),
+			PreferredLanguage: gu.Ptr(human.PreferredLanguage.String()),
+			Gender:            gu.Ptr(domainGenderToGRPC(human.Gender)),
+			AvatarUrl:         zitadel_internal_domain.AvatarURL(assetPrefix, orgID, human.AvatarKey),
+		},
+		Email: &v2_user.HumanEmail{
+			Email:      human.Email.Address,
+			IsVerified: !human.Email.VerifiedAt.IsZero(),
+		},
+		Phone:                  &v2_user.HumanPhone{},
+		PasswordChangeRequired: human.Password.IsChangeRequired,
+		PasswordChanged:        passwordChanged,
+		MfaInitSkipped:         mfaInitSkipped,
+	}
+	if human.Phone != nil {
+		toReturn.Phone.Phone = human.Phone.Number
+		toReturn.Phone.IsVerified = !human.Phone.VerifiedAt.IsZero()
+	}
+	return toReturn
+}
+
+func domainMachineModelToGRPCResponse(machine *domain.MachineUser) *v2_user.MachineUser {
+	return &v2_user.MachineUser{
+		Name:            machine.Name,
+		Description:     machine.Description,
+		HasSecret:       machine.Secret != "",
+		AccessTokenType: domainAccessTokenTypeToGRPC(machine.AccessTokenType),
+	}
+}
+
+func domainUserStateToGRPC(state domain.UserState) v2_user.UserState {
+	switch state {
+	case domain.UserStateActive:
+		return v2_user.UserState_USER_STATE_ACTIVE
+	case domain.UserStateInactive:
+		return v2_user.UserState_USER_STATE_INACTIVE
+	case domain.UserStateLocked:
+		return v2_user.UserState_USER_STATE_LOCKED
+	case domain.UserStateInitial:
+		return v2_user.UserState_USER_STATE_INITIAL
+	case domain.UserStateUnspecified:
+		fallthrough
+	default:
+		return v2_user.UserState_USER_STATE_UNSPECIFIED
+	}
+}
+
+func domainGenderToGRPC(gender domain.HumanGender) v2_user.Gender {
+	switch gender {
+	case domain.HumanGenderFemale:
+		return v2_user.Gender_GENDER_FEMALE
+	case domain.HumanGenderMale:
+		return v2_user.Gender_GENDER_MALE
+	case domain.HumanGenderDiverse:
+		return v2_user.Gender_GENDER_DIVERSE
+	case domain.HumanGenderUnspecified:
+		fallthrough
+	default:
+		return v2_user.Gender_GENDER_UNSPECIFIED
+	}
+}
+
+func domainAccessTokenTypeToGRPC(tokenType domain.PersonalAccessTokenType) v2_user.AccessTokenType {
+	switch tokenType {
+	case domain.PersonalAccessTokenTypeJWT:
+		return v2_user.AccessTokenType_ACCESS_TOKEN_TYPE_JWT
+	case domain.PersonalAccessTokenTypeBearer, domain.PersonalAccessTokenTypeUnspecified:
+		fallthrough
+	default:
+		return v2_user.AccessTokenType_ACCESS_TOKEN_TYPE_BEARER
+	}
+}
@@ -0,0 +1,145 @@
+package convert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/backend/v3/domain"
+	"github.com/zitadel/zitadel/pkg/grpc/object/v2"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func TestDomainUserListModelToGRPCResponse(t *testing.T) {
+	t.Parallel()
+	now := time.Now().UTC()
+	yesterday := now.AddDate(0, 0, -1)
+
+	tt := []struct {
+		name  string
+		users []*domain.User
+		want  []*user.User
+	}{
+		{
+			name:  "empty result",
+			users: nil,
+			want:  []*user.User{},
+		},
+		{
+			name: "human and machine",
+			users: []*domain.User{
+				{
+					OrganizationID:     "org-1",
+					ID:                 "user-1",
+					Username:           "gigi",
+					LoginNames:         []string{"gigi@zitadel.com", "gigi@zitadel.ch"},
+					PreferredLoginName: "gigi@zitadel.com",
+					State:              domain.UserStateActive,
+					CreatedAt:          yesterday,
+					UpdatedAt:          now,
+					Human: &domain.HumanUser{
+						FirstName:         "Gigi",
+						LastName:          "Giraffe",
+						DisplayName:       "Gigi Giraffe",
+						PreferredLanguage: language.German,
+						Gender:            domain.HumanGenderFemale,
+						Email: domain.HumanEmail{
+							Address:    "gigi@zitadel.com",
+							VerifiedAt: now,
+						},
+						Phone: &domain.HumanPhone{
+							Number: "+41791234567",
+						},
+						Password: domain.HumanPassword{
+							IsChangeRequired: true,
+						},
+						MultifactorInitializationSkippedAt: now,
+					},
+				},
+				{
+					OrganizationID:     "org-1",
+					ID:                 "user-2",
+					Username:           "bot",
+					LoginNames:         []string{"bot"},
+					PreferredLoginName: "bot",
+					State:              domain.UserStateLocked,
+					CreatedAt:          yesterday,
+					UpdatedAt:          now,
+					Machine: &domain.MachineUser{
+						Name:            "bot",
+						Description:     "does things",
+						Secret:          "hashed",
+						AccessTokenType: domain.PersonalAccessTokenTypeJWT,
+					},
+				},
+			},
+			want: []*user.User{
+				{
+					UserId: "user-1",
+					Details: &object.Details{
+						ChangeDate:    timestamppb.New(now),
+						CreationDate:  timestamppb.New(yesterday),
+						ResourceOwner: "org-1",
+					},
+					State:              user.UserState_USER_STATE_ACTIVE,
+					Username:           "gigi",
+					LoginNames:         []string{"gigi@zitadel.com", "gigi@zitadel.ch"},
+					PreferredLoginName: "gigi@zitadel.com",
+					Type: &user.User_Human{
+						Human: &user.HumanUser{
+							Profile: &user.HumanProfile{
+								GivenName:         "Gigi",
+								FamilyName:        "Giraffe",
+								NickName:          gu.Ptr(""),
+								DisplayName:       gu.Ptr("Gigi Giraffe"),
+								PreferredLanguage: gu.Ptr("de"),
+								Gender:            gu.Ptr(user.Gender_GENDER_FEMALE),
+							},
+							Email: &user.HumanEmail{
+								Email:      "gigi@zitadel.com",
+								IsVerified: true,
+							},
+							Phone: &user.HumanPhone{
+								Phone: "+41791234567",
+							},
+							PasswordChangeRequired: true,
+							MfaInitSkipped:         timestamppb.New(now),
+						},
+					},
+				},
+				{
+					UserId: "user-2",
+					Details: &object.Details{
+						ChangeDate:    timestamppb.New(now),
+						CreationDate:  timestamppb.New(yesterday),
+						ResourceOwner: "org-1",
+					},
+					State:              user.UserState_USER_STATE_LOCKED,
+					Username:           "bot",
+					LoginNames:         []string{"bot"},
+					PreferredLoginName: "bot",
+					Type: &user.User_Machine{
+						Machine: &user.MachineUser{
+							Name:            "bot",
+							Description:     "does things",
+							HasSecret:       true,
+							AccessTokenType: user.AccessTokenType_ACCESS_TOKEN_TYPE_JWT,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := DomainUserListModelToGRPCResponse(tc.users, "")
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package userv2
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	"github.com/zitadel/zitadel/backend/v3/api/user/v2/convert"
+	"github.com/zitadel/zitadel/backend/v3/domain"
+	"github.com/zitadel/zitadel/backend/v3/storage/database/repository"
+	"github.com/zitadel/zitadel/pkg/grpc/object/v2"
+	v2_user "github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+// GetUserByID returns the user if the authenticated user is allowed to read it.
+// The permissions are checked using checkPermission of the eventstore based API.
+func GetUserByID(ctx context.Context, request *connect.Request[v2_user.GetUserByIDRequest], assetPrefix string, checkPermission func(ctx context.Context, permission, orgID, resourceID string) error) (*connect.Response[v2_user.GetUserByIDResponse], error) {
+	userGetQuery := domain.NewGetUserQuery(request.Msg.GetUserId())
+
+	err := domain.Invoke(ctx, userGetQuery,
+		domain.WithUserRepo(repository.UserRepository()),
+		domain.WithPermissionChecker(domain.PermissionCheckFunc(checkPermission)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	user := userGetQuery.Result()
+	return &connect.Response[v2_user.GetUserByIDResponse]{
+		Msg: &v2_user.GetUserByIDResponse{
+			Details: convert.DomainUserModelToDetails(user),
+			User:    convert.DomainUserModelToGRPCResponse(user, assetPrefix),
+		},
+	}, nil
+}
+
+// ListUsers returns the users the authenticated user is allowed to read.
+// The permissions are checked using checkPermission of the eventstore based API.
+func ListUsers(ctx context.Context, request *connect.Request[v2_user.ListUsersRequest], assetPrefix string, checkPermission func(ctx context.Context, permission, orgID, resourceID string) error) (*connect.Response[v2_user.ListUsersResponse], error) {
+	userListQuery := domain.NewListUsersQuery(request.Msg)
+
+	err := domain.Invoke(ctx, userListQuery,
+		domain.WithUserRepo(repository.UserRepository()),
+		domain.WithPermissionChecker(domain.PermissionCheckFunc(checkPermission)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	users := convert.DomainUserListModelToGRPCResponse(userListQuery.Result(), assetPrefix)
+	return &connect.Response[v2_user.ListUsersResponse]{
+		Msg: &v2_user.ListUsersResponse{
+			Result: users,
+			Details: &object.ListDetails{
+				TotalResult: userListQuery.Total(),
+			},
+			SortingColumn: request.Msg.GetSortingColumn(),
+		},
+	}, nil
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/backend/v3/storage/database"
+)
+
+//go:generate enumer -type AuthorizationState -transform lower -trimprefix AuthorizationState -sql
+type AuthorizationState uint8
+
+const (
+	AuthorizationStateActive AuthorizationState = iota
+	AuthorizationStateInactive
+)
+
+// Authorization grants roles of a project to a user.
+// If the project was granted to another organization the authorization references the project grant.
+type Authorization struct {
+	InstanceID     string             `json:"instanceId,omitempty" db:"instance_id"`
+	ID             string             `json:"id,omitempty" db:"id"`
+	OrganizationID string             `json:"organizationId,omitempty" db:"organization_id"`
+	UserID         string             `json:"userId,omitempty" db:"user_id"`
+	ProjectID      string             `json:"projectId,omitempty" db:"project_id"`
+	ProjectGrantID string             `json:"projectGrantId,omitempty" db:"project_grant_id"`
+	CreatedAt      time.Time          `json:"createdAt,omitzero" db:"created_at"`
+	UpdatedAt      time.Time          `json:"updatedAt,omitzero" db:"updated_at"`
+	State          AuthorizationState `json:"state,omitempty" db:"state"`
+	RoleKeys       []string           `json:"roleKeys,omitempty" db:"role_keys"`
+}
+
+type authorizationColumns interface {
+	// PrimaryKeyColumns returns the columns for the primary key fields
+	PrimaryKeyColumns() []database.Column
+	// InstanceIDColumn returns the column for the instance id field
+	InstanceIDColumn() database.Column
+	// IDColumn returns the column for the id field
+	IDColumn() database.Column
+	// OrganizationIDColumn returns the column for the organization id field
+	OrganizationIDColumn() database.Column
+	// UserIDColumn returns the column for the user id field
+	UserIDColumn() database.Column
+	// ProjectIDColumn returns the column for the project id field
+	ProjectIDColumn() database.Column
+	// ProjectGrantIDColumn returns the column for the project grant id field
+	ProjectGrantIDColumn() database.Column
+	// CreatedAtColumn returns the column for the created at field.
+	CreatedAtColumn() database.Column
+	// UpdatedAtColumn returns the column for the updated at field.
+	UpdatedAtColumn() database.Column
+	// StateColumn returns the column for the state field.
+	StateColumn() database.Column
+}
+
+type authorizationConditions interface {
+	// PrimaryKeyCondition returns a filter on the primary key fields.
+	PrimaryKeyCondition(instanceID, id string) database.Condition
+	// InstanceIDCondition returns a filter on the instance id field.
+	InstanceIDCondition(instanceID string) database.Condition
+	// IDCondition returns a filter on the id field.
+	IDCondition(id string) database.Condition
+	// OrganizationIDCondition returns a filter on the organization id field.
+	OrganizationIDCondition(organizationID string) database.Condition
+	// UserIDCondition returns a filter on the user id field.
+	UserIDCondition(userID string) database.Condition
+	// ProjectIDCondition returns a filter on the project id field.
+	ProjectIDCondition(projectID string) database.Condition
+	// ProjectGrantIDCondition returns a filter on the project grant id field.
+	ProjectGrantIDCondition(projectGrantID string) database.Condition
+	// StateCondition returns a filter on the state field.
+	StateCondition(state AuthorizationState) database.Condition
+	// RoleKeyCondition returns a filter on the role field.
+	RoleKeyCondition(op database.TextOperation, role string) database.Condition
+	// ExistsRoleKey returns a filter on the authorizations containing a role matching the condition.
+	ExistsRoleKey(cond database.Condition) database.Condition
+}
+
+type authorizationChanges interface {
+	// SetUpdatedAt sets the updated at column.
+	// Only use this when reducing events,
+	// during regular updates the DB sets this column automatically.
+	SetUpdatedAt(updatedAt time.Time) database.Change
+	// SetState sets the state column.
+	SetState(state AuthorizationState) database.Change
+}
+
+// AuthorizationRepository manages authorizations.
+//
+//go:generate mockgen -typed -package domainmock -destination ./mock/authorization.mock.go . AuthorizationRepository
+type AuthorizationRepository interface {
+	Repository
+
+	authorizationColumns
+	authorizationConditions
+	authorizationChanges
+
+	// Get a single authorization. An error is returned if not exactly one authorization is found.
+	Get(ctx context.Context, client database.QueryExecutor, opts ...database.QueryOption) (*Authorization, error)
+	// List authorizations. An empty list is returned if no authorizations are found.
+	List(ctx context.Context, client database.QueryExecutor, opts ...database.QueryOption) ([]*Authorization, error)
+	// Create a new authorization.
+	Create(ctx context.Context, client database.QueryExecutor, authorization *Authorization) error
+	// Update an existing authorization.
+	// The condition must include the instanceID and ID of the authorization to update.
+	// If roleKeys is not nil the roles of the authorization are replaced.
+	Update(ctx context.Context, client database.QueryExecutor, condition database.Condition, roleKeys []string, changes ...database.Change) (int64, error)
+	// Delete an existing authorization.
+	// The condition must include the instanceID and ID of the authorization to delete.
+	Delete(ctx context.Context, client database.QueryExecutor, condition database.Condition) (int64, error)
+}
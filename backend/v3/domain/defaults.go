@@ -11,6 +11,7 @@ import (
 
 var (
 	pool             database.Pool
+	readPool         database.Pool
 	tracer           tracing.Tracer
 	logger           logging.Logger = *logging.NewLogger(slog.Default())
 	legacyEventstore eventstore.LegacyEventstore
@@ -20,6 +21,12 @@ func SetPool(p database.Pool) {
 	pool = p
 }
 
+// SetReadPool sets the pool used for read-only queries which tolerate bounded staleness, see [InvokeOpts.ReadDB].
+// If not set, the default pool is used.
+func SetReadPool(p database.Pool) {
+	readPool = p
+}
+
 func SetTracer(t tracing.Tracer) {
 	tracer = t
 }
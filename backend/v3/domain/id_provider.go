@@ -26,6 +26,7 @@ const (
 	IDPTypeAzure
 	IDPTypeGoogle
 	IDPTypeApple
+	IDPTypeWSFederation
 )
 
 //go:generate enumer -type IDPState -transform lower -trimprefix IDPState -sql
@@ -269,6 +270,18 @@ type IDPSAML struct {
 	SAML
 }
 
+type WSFederation struct {
+	SSOEndpoint string `json:"ssoEndpoint,omitempty"`
+	Realm       string `json:"realm,omitempty"`
+	Certificate []byte `json:"certificate,omitempty"`
+	IDClaim     string `json:"idClaim,omitempty"`
+}
+
+type IDPWSFederation struct {
+	*IdentityProvider
+	WSFederation
+}
+
 // IDPIdentifierCondition is used to help specify a single identity_provider,
 // it will either be used as the  identity_provider ID or identity_provider name,
 // as identity_provider can be identified either using (instanceID + OrgID + ID) OR (instanceID + OrgID + name)
@@ -356,4 +369,5 @@ type IDProviderRepository interface {
 	GetLDAP(ctx context.Context, client database.QueryExecutor, id IDPIdentifierCondition, instanceID string, orgID *string) (*IDPLDAP, error)
 	GetApple(ctx context.Context, client database.QueryExecutor, id IDPIdentifierCondition, instanceID string, orgID *string) (*IDPApple, error)
 	GetSAML(ctx context.Context, client database.QueryExecutor, id IDPIdentifierCondition, instanceID string, orgID *string) (*IDPSAML, error)
+	GetWSFederation(ctx context.Context, client database.QueryExecutor, id IDPIdentifierCondition, instanceID string, orgID *string) (*IDPWSFederation, error)
 }
@@ -39,6 +39,20 @@ func WithInstanceDomainRepo(repo InstanceDomainRepository) InvokeOpt {
 	}
 }
 
+func WithUserRepo(repo UserRepository) InvokeOpt {
+	return func(opts *InvokeOpts) {
+		opts.userRepo = repo
+	}
+}
+
+// WithPermissionChecker sets the checker for the permissions of the authenticated user.
+// If not set, all permissions are granted.
+func WithPermissionChecker(checker PermissionChecker) InvokeOpt {
+	return func(opts *InvokeOpts) {
+		opts.Permissions = checker
+	}
+}
+
 // WithQueryExecutor sets the database client to be used by the command.
 // If not set, the default pool will be used.
 // This is mainly used for testing.
@@ -73,6 +87,7 @@ type InvokeOpts struct {
 	projectRepo            ProjectRepository
 	instanceRepo           InstanceRepository
 	instanceDomainRepo     InstanceDomainRepository
+	userRepo               UserRepository
 }
 
 func (o *InvokeOpts) DB() database.QueryExecutor {
@@ -83,6 +98,15 @@ func (o *InvokeOpts) DB() database.QueryExecutor {
 	return o.db
 }
 
+// ReadDB returns the database client for read-only queries which tolerate bounded staleness, e.g. list queries.
+// It returns the read pool unless the client was overwritten, e.g. by a transaction, in which case it is the same as [InvokeOpts.DB].
+func (o *InvokeOpts) ReadDB() database.QueryExecutor {
+	if o.db != nil || readPool == nil {
+		return o.DB()
+	}
+	return readPool
+}
+
 func (o *InvokeOpts) LegacyEventstore() eventstore.LegacyEventstore {
 	if o.legacyEventstore != nil {
 		return o.legacyEventstore
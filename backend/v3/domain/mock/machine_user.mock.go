@@ -43,44 +43,6 @@ func (m *MockMachineUserRepository) EXPECT() *MockMachineUserRepositoryMockRecor
 	return m.recorder
 }
 
-// AddKey mocks base method.
-func (m *MockMachineUserRepository) AddKey(key *domain.MachineKey) database.Change {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddKey", key)
-	ret0, _ := ret[0].(database.Change)
-	return ret0
-}
-
-// AddKey indicates an expected call of AddKey.
-func (mr *MockMachineUserRepositoryMockRecorder) AddKey(key any) *MockMachineUserRepositoryAddKeyCall {
-	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddKey", reflect.TypeOf((*MockMachineUserRepository)(nil).AddKey), key)
-	return &MockMachineUserRepositoryAddKeyCall{Call: call}
-}
-
-// MockMachineUserRepositoryAddKeyCall wrap *gomock.Call
-type MockMachineUserRepositoryAddKeyCall struct {
-	*gomock.Call
-}
-
-// Return rewrite *gomock.Call.Return
-func (c *MockMachineUserRepositoryAddKeyCall) Return(arg0 database.Change) *MockMachineUserRepositoryAddKeyCall {
-	c.Call = c.Call.Return(arg0)
-	return c
-}
-
-// Do rewrite *gomock.Call.Do
-func (c *MockMachineUserRepositoryAddKeyCall) Do(f func(*domain.MachineKey) database.Change) *MockMachineUserRepositoryAddKeyCall {
-	c.Call = c.Call.Do(f)
-	return c
-}
-
-// DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockMachineUserRepositoryAddKeyCall) DoAndReturn(f func(*domain.MachineKey) database.Change) *MockMachineUserRepositoryAddKeyCall {
-	c.Call = c.Call.DoAndReturn(f)
-	return c
-}
-
 // AddMetadata mocks base method.
 func (m *MockMachineUserRepository) AddMetadata(metadata ...*domain.Metadata) database.Change {
 	m.ctrl.T.Helper()
@@ -123,44 +85,6 @@ func (c *MockMachineUserRepositoryAddMetadataCall) DoAndReturn(f func(...*domain
 	return c
 }
 
-// AddPersonalAccessToken mocks base method.
-func (m *MockMachineUserRepository) AddPersonalAccessToken(pat *domain.PersonalAccessToken) database.Change {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddPersonalAccessToken", pat)
-	ret0, _ := ret[0].(database.Change)
-	return ret0
-}
-
-// AddPersonalAccessToken indicates an expected call of AddPersonalAccessToken.
-func (mr *MockMachineUserRepositoryMockRecorder) AddPersonalAccessToken(pat any) *MockMachineUserRepositoryAddPersonalAccessTokenCall {
-	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPersonalAccessToken", reflect.TypeOf((*MockMachineUserRepository)(nil).AddPersonalAccessToken), pat)
-	return &MockMachineUserRepositoryAddPersonalAccessTokenCall{Call: call}
-}
-
-// MockMachineUserRepositoryAddPersonalAccessTokenCall wrap *gomock.Call
-type MockMachineUserRepositoryAddPersonalAccessTokenCall struct {
-	*gomock.Call
-}
-
-// Return rewrite *gomock.Call.Return
-func (c *MockMachineUserRepositoryAddPersonalAccessTokenCall) Return(arg0 database.Change) *MockMachineUserRepositoryAddPersonalAccessTokenCall {
-	c.Call = c.Call.Return(arg0)
-	return c
-}
-
-// Do rewrite *gomock.Call.Do
-func (c *MockMachineUserRepositoryAddPersonalAccessTokenCall) Do(f func(*domain.PersonalAccessToken) database.Change) *MockMachineUserRepositoryAddPersonalAccessTokenCall {
-	c.Call = c.Call.Do(f)
-	return c
-}
-
-// DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockMachineUserRepositoryAddPersonalAccessTokenCall) DoAndReturn(f func(*domain.PersonalAccessToken) database.Change) *MockMachineUserRepositoryAddPersonalAccessTokenCall {
-	c.Call = c.Call.DoAndReturn(f)
-	return c
-}
-
 // CreatedAtColumn mocks base method.
 func (m *MockMachineUserRepository) CreatedAtColumn() database.Column {
 	m.ctrl.T.Helper()
@@ -237,6 +161,44 @@ func (c *MockMachineUserRepositoryExistsMetadataCall) DoAndReturn(f func(databas
 	return c
 }
 
+// IDColumn mocks base method.
+func (m *MockMachineUserRepository) IDColumn() database.Column {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IDColumn")
+	ret0, _ := ret[0].(database.Column)
+	return ret0
+}
+
+// IDColumn indicates an expected call of IDColumn.
+func (mr *MockMachineUserRepositoryMockRecorder) IDColumn() *MockMachineUserRepositoryIDColumnCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IDColumn", reflect.TypeOf((*MockMachineUserRepository)(nil).IDColumn))
+	return &MockMachineUserRepositoryIDColumnCall{Call: call}
+}
+
+// MockMachineUserRepositoryIDColumnCall wrap *gomock.Call
+type MockMachineUserRepositoryIDColumnCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineUserRepositoryIDColumnCall) Return(arg0 database.Column) *MockMachineUserRepositoryIDColumnCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineUserRepositoryIDColumnCall) Do(f func() database.Column) *MockMachineUserRepositoryIDColumnCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineUserRepositoryIDColumnCall) DoAndReturn(f func() database.Column) *MockMachineUserRepositoryIDColumnCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // IDCondition mocks base method.
 func (m *MockMachineUserRepository) IDCondition(userID string) database.Condition {
 	m.ctrl.T.Helper()
@@ -275,6 +237,44 @@ func (c *MockMachineUserRepositoryIDConditionCall) DoAndReturn(f func(string) da
 	return c
 }
 
+// InstanceIDColumn mocks base method.
+func (m *MockMachineUserRepository) InstanceIDColumn() database.Column {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceIDColumn")
+	ret0, _ := ret[0].(database.Column)
+	return ret0
+}
+
+// InstanceIDColumn indicates an expected call of InstanceIDColumn.
+func (mr *MockMachineUserRepositoryMockRecorder) InstanceIDColumn() *MockMachineUserRepositoryInstanceIDColumnCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceIDColumn", reflect.TypeOf((*MockMachineUserRepository)(nil).InstanceIDColumn))
+	return &MockMachineUserRepositoryInstanceIDColumnCall{Call: call}
+}
+
+// MockMachineUserRepositoryInstanceIDColumnCall wrap *gomock.Call
+type MockMachineUserRepositoryInstanceIDColumnCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineUserRepositoryInstanceIDColumnCall) Return(arg0 database.Column) *MockMachineUserRepositoryInstanceIDColumnCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineUserRepositoryInstanceIDColumnCall) Do(f func() database.Column) *MockMachineUserRepositoryInstanceIDColumnCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineUserRepositoryInstanceIDColumnCall) DoAndReturn(f func() database.Column) *MockMachineUserRepositoryInstanceIDColumnCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // InstanceIDCondition mocks base method.
 func (m *MockMachineUserRepository) InstanceIDCondition(instanceID string) database.Condition {
 	m.ctrl.T.Helper()
@@ -352,17 +352,17 @@ func (c *MockMachineUserRepositoryLoginNameConditionCall) DoAndReturn(f func(dat
 }
 
 // MetadataKeyCondition mocks base method.
-func (m *MockMachineUserRepository) MetadataKeyCondition(key string) database.Condition {
+func (m *MockMachineUserRepository) MetadataKeyCondition(op database.TextOperation, key string) database.Condition {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MetadataKeyCondition", key)
+	ret := m.ctrl.Call(m, "MetadataKeyCondition", op, key)
 	ret0, _ := ret[0].(database.Condition)
 	return ret0
 }
 
 // MetadataKeyCondition indicates an expected call of MetadataKeyCondition.
-func (mr *MockMachineUserRepositoryMockRecorder) MetadataKeyCondition(key any) *MockMachineUserRepositoryMetadataKeyConditionCall {
+func (mr *MockMachineUserRepositoryMockRecorder) MetadataKeyCondition(op, key any) *MockMachineUserRepositoryMetadataKeyConditionCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetadataKeyCondition", reflect.TypeOf((*MockMachineUserRepository)(nil).MetadataKeyCondition), key)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetadataKeyCondition", reflect.TypeOf((*MockMachineUserRepository)(nil).MetadataKeyCondition), op, key)
 	return &MockMachineUserRepositoryMetadataKeyConditionCall{Call: call}
 }
 
@@ -378,13 +378,13 @@ func (c *MockMachineUserRepositoryMetadataKeyConditionCall) Return(arg0 database
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockMachineUserRepositoryMetadataKeyConditionCall) Do(f func(string) database.Condition) *MockMachineUserRepositoryMetadataKeyConditionCall {
+func (c *MockMachineUserRepositoryMetadataKeyConditionCall) Do(f func(database.TextOperation, string) database.Condition) *MockMachineUserRepositoryMetadataKeyConditionCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockMachineUserRepositoryMetadataKeyConditionCall) DoAndReturn(f func(string) database.Condition) *MockMachineUserRepositoryMetadataKeyConditionCall {
+func (c *MockMachineUserRepositoryMetadataKeyConditionCall) DoAndReturn(f func(database.TextOperation, string) database.Condition) *MockMachineUserRepositoryMetadataKeyConditionCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -427,230 +427,268 @@ func (c *MockMachineUserRepositoryMetadataValueConditionCall) DoAndReturn(f func
 	return c
 }
 
-// OrgIDCondition mocks base method.
-func (m *MockMachineUserRepository) OrgIDCondition(orgID string) database.Condition {
+// NameColumn mocks base method.
+func (m *MockMachineUserRepository) NameColumn() database.Column {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "OrgIDCondition", orgID)
+	ret := m.ctrl.Call(m, "NameColumn")
+	ret0, _ := ret[0].(database.Column)
+	return ret0
+}
+
+// NameColumn indicates an expected call of NameColumn.
+func (mr *MockMachineUserRepositoryMockRecorder) NameColumn() *MockMachineUserRepositoryNameColumnCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NameColumn", reflect.TypeOf((*MockMachineUserRepository)(nil).NameColumn))
+	return &MockMachineUserRepositoryNameColumnCall{Call: call}
+}
+
+// MockMachineUserRepositoryNameColumnCall wrap *gomock.Call
+type MockMachineUserRepositoryNameColumnCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineUserRepositoryNameColumnCall) Return(arg0 database.Column) *MockMachineUserRepositoryNameColumnCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineUserRepositoryNameColumnCall) Do(f func() database.Column) *MockMachineUserRepositoryNameColumnCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineUserRepositoryNameColumnCall) DoAndReturn(f func() database.Column) *MockMachineUserRepositoryNameColumnCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// NameCondition mocks base method.
+func (m *MockMachineUserRepository) NameCondition(op database.TextOperation, name string) database.Condition {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NameCondition", op, name)
 	ret0, _ := ret[0].(database.Condition)
 	return ret0
 }
 
-// OrgIDCondition indicates an expected call of OrgIDCondition.
-func (mr *MockMachineUserRepositoryMockRecorder) OrgIDCondition(orgID any) *MockMachineUserRepositoryOrgIDConditionCall {
+// NameCondition indicates an expected call of NameCondition.
+func (mr *MockMachineUserRepositoryMockRecorder) NameCondition(op, name any) *MockMachineUserRepositoryNameConditionCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgIDCondition", reflect.TypeOf((*MockMachineUserRepository)(nil).OrgIDCondition), orgID)
-	return &MockMachineUserRepositoryOrgIDConditionCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NameCondition", reflect.TypeOf((*MockMachineUserRepository)(nil).NameCondition), op, name)
+	return &MockMachineUserRepositoryNameConditionCall{Call: call}
 }
 
-// MockMachineUserRepositoryOrgIDConditionCall wrap *gomock.Call
-type MockMachineUserRepositoryOrgIDConditionCall struct {
+// MockMachineUserRepositoryNameConditionCall wrap *gomock.Call
+type MockMachineUserRepositoryNameConditionCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockMachineUserRepositoryOrgIDConditionCall) Return(arg0 database.Condition) *MockMachineUserRepositoryOrgIDConditionCall {
+func (c *MockMachineUserRepositoryNameConditionCall) Return(arg0 database.Condition) *MockMachineUserRepositoryNameConditionCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockMachineUserRepositoryOrgIDConditionCall) Do(f func(string) database.Condition) *MockMachineUserRepositoryOrgIDConditionCall {
+func (c *MockMachineUserRepositoryNameConditionCall) Do(f func(database.TextOperation, string) database.Condition) *MockMachineUserRepositoryNameConditionCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockMachineUserRepositoryOrgIDConditionCall) DoAndReturn(f func(string) database.Condition) *MockMachineUserRepositoryOrgIDConditionCall {
+func (c *MockMachineUserRepositoryNameConditionCall) DoAndReturn(f func(database.TextOperation, string) database.Condition) *MockMachineUserRepositoryNameConditionCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// PrimaryKeyColumns mocks base method.
-func (m *MockMachineUserRepository) PrimaryKeyColumns() []database.Column {
+// OrgIDColumn mocks base method.
+func (m *MockMachineUserRepository) OrgIDColumn() database.Column {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PrimaryKeyColumns")
-	ret0, _ := ret[0].([]database.Column)
+	ret := m.ctrl.Call(m, "OrgIDColumn")
+	ret0, _ := ret[0].(database.Column)
 	return ret0
 }
 
-// PrimaryKeyColumns indicates an expected call of PrimaryKeyColumns.
-func (mr *MockMachineUserRepositoryMockRecorder) PrimaryKeyColumns() *MockMachineUserRepositoryPrimaryKeyColumnsCall {
+// OrgIDColumn indicates an expected call of OrgIDColumn.
+func (mr *MockMachineUserRepositoryMockRecorder) OrgIDColumn() *MockMachineUserRepositoryOrgIDColumnCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrimaryKeyColumns", reflect.TypeOf((*MockMachineUserRepository)(nil).PrimaryKeyColumns))
-	return &MockMachineUserRepositoryPrimaryKeyColumnsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgIDColumn", reflect.TypeOf((*MockMachineUserRepository)(nil).OrgIDColumn))
+	return &MockMachineUserRepositoryOrgIDColumnCall{Call: call}
 }
 
-// MockMachineUserRepositoryPrimaryKeyColumnsCall wrap *gomock.Call
-type MockMachineUserRepositoryPrimaryKeyColumnsCall struct {
+// MockMachineUserRepositoryOrgIDColumnCall wrap *gomock.Call
+type MockMachineUserRepositoryOrgIDColumnCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockMachineUserRepositoryPrimaryKeyColumnsCall) Return(arg0 []database.Column) *MockMachineUserRepositoryPrimaryKeyColumnsCall {
+func (c *MockMachineUserRepositoryOrgIDColumnCall) Return(arg0 database.Column) *MockMachineUserRepositoryOrgIDColumnCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockMachineUserRepositoryPrimaryKeyColumnsCall) Do(f func() []database.Column) *MockMachineUserRepositoryPrimaryKeyColumnsCall {
+func (c *MockMachineUserRepositoryOrgIDColumnCall) Do(f func() database.Column) *MockMachineUserRepositoryOrgIDColumnCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockMachineUserRepositoryPrimaryKeyColumnsCall) DoAndReturn(f func() []database.Column) *MockMachineUserRepositoryPrimaryKeyColumnsCall {
+func (c *MockMachineUserRepositoryOrgIDColumnCall) DoAndReturn(f func() database.Column) *MockMachineUserRepositoryOrgIDColumnCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// PrimaryKeyCondition mocks base method.
-func (m *MockMachineUserRepository) PrimaryKeyCondition(instanceID, userID string) database.Condition {
+// OrgIDCondition mocks base method.
+func (m *MockMachineUserRepository) OrgIDCondition(orgID string) database.Condition {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PrimaryKeyCondition", instanceID, userID)
+	ret := m.ctrl.Call(m, "OrgIDCondition", orgID)
 	ret0, _ := ret[0].(database.Condition)
 	return ret0
 }
 
-// PrimaryKeyCondition indicates an expected call of PrimaryKeyCondition.
-func (mr *MockMachineUserRepositoryMockRecorder) PrimaryKeyCondition(instanceID, userID any) *MockMachineUserRepositoryPrimaryKeyConditionCall {
+// OrgIDCondition indicates an expected call of OrgIDCondition.
+func (mr *MockMachineUserRepositoryMockRecorder) OrgIDCondition(orgID any) *MockMachineUserRepositoryOrgIDConditionCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrimaryKeyCondition", reflect.TypeOf((*MockMachineUserRepository)(nil).PrimaryKeyCondition), instanceID, userID)
-	return &MockMachineUserRepositoryPrimaryKeyConditionCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgIDCondition", reflect.TypeOf((*MockMachineUserRepository)(nil).OrgIDCondition), orgID)
+	return &MockMachineUserRepositoryOrgIDConditionCall{Call: call}
 }
 
-// MockMachineUserRepositoryPrimaryKeyConditionCall wrap *gomock.Call
-type MockMachineUserRepositoryPrimaryKeyConditionCall struct {
+// MockMachineUserRepositoryOrgIDConditionCall wrap *gomock.Call
+type MockMachineUserRepositoryOrgIDConditionCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockMachineUserRepositoryPrimaryKeyConditionCall) Return(arg0 database.Condition) *MockMachineUserRepositoryPrimaryKeyConditionCall {
+func (c *MockMachineUserRepositoryOrgIDConditionCall) Return(arg0 database.Condition) *MockMachineUserRepositoryOrgIDConditionCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockMachineUserRepositoryPrimaryKeyConditionCall) Do(f func(string, string) database.Condition) *MockMachineUserRepositoryPrimaryKeyConditionCall {
+func (c *MockMachineUserRepositoryOrgIDConditionCall) Do(f func(string) database.Condition) *MockMachineUserRepositoryOrgIDConditionCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockMachineUserRepositoryPrimaryKeyConditionCall) DoAndReturn(f func(string, string) database.Condition) *MockMachineUserRepositoryPrimaryKeyConditionCall {
+func (c *MockMachineUserRepositoryOrgIDConditionCall) DoAndReturn(f func(string) database.Condition) *MockMachineUserRepositoryOrgIDConditionCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RemoveKey mocks base method.
-func (m *MockMachineUserRepository) RemoveKey(id string) database.Change {
+// PrimaryKeyColumns mocks base method.
+func (m *MockMachineUserRepository) PrimaryKeyColumns() []database.Column {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveKey", id)
-	ret0, _ := ret[0].(database.Change)
+	ret := m.ctrl.Call(m, "PrimaryKeyColumns")
+	ret0, _ := ret[0].([]database.Column)
 	return ret0
 }
 
-// RemoveKey indicates an expected call of RemoveKey.
-func (mr *MockMachineUserRepositoryMockRecorder) RemoveKey(id any) *MockMachineUserRepositoryRemoveKeyCall {
+// PrimaryKeyColumns indicates an expected call of PrimaryKeyColumns.
+func (mr *MockMachineUserRepositoryMockRecorder) PrimaryKeyColumns() *MockMachineUserRepositoryPrimaryKeyColumnsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveKey", reflect.TypeOf((*MockMachineUserRepository)(nil).RemoveKey), id)
-	return &MockMachineUserRepositoryRemoveKeyCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrimaryKeyColumns", reflect.TypeOf((*MockMachineUserRepository)(nil).PrimaryKeyColumns))
+	return &MockMachineUserRepositoryPrimaryKeyColumnsCall{Call: call}
 }
 
-// MockMachineUserRepositoryRemoveKeyCall wrap *gomock.Call
-type MockMachineUserRepositoryRemoveKeyCall struct {
+// MockMachineUserRepositoryPrimaryKeyColumnsCall wrap *gomock.Call
+type MockMachineUserRepositoryPrimaryKeyColumnsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockMachineUserRepositoryRemoveKeyCall) Return(arg0 database.Change) *MockMachineUserRepositoryRemoveKeyCall {
+func (c *MockMachineUserRepositoryPrimaryKeyColumnsCall) Return(arg0 []database.Column) *MockMachineUserRepositoryPrimaryKeyColumnsCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockMachineUserRepositoryRemoveKeyCall) Do(f func(string) database.Change) *MockMachineUserRepositoryRemoveKeyCall {
+func (c *MockMachineUserRepositoryPrimaryKeyColumnsCall) Do(f func() []database.Column) *MockMachineUserRepositoryPrimaryKeyColumnsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockMachineUserRepositoryRemoveKeyCall) DoAndReturn(f func(string) database.Change) *MockMachineUserRepositoryRemoveKeyCall {
+func (c *MockMachineUserRepositoryPrimaryKeyColumnsCall) DoAndReturn(f func() []database.Column) *MockMachineUserRepositoryPrimaryKeyColumnsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RemoveMetadata mocks base method.
-func (m *MockMachineUserRepository) RemoveMetadata(condition database.Condition) database.Change {
+// PrimaryKeyCondition mocks base method.
+func (m *MockMachineUserRepository) PrimaryKeyCondition(instanceID, userID string) database.Condition {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveMetadata", condition)
-	ret0, _ := ret[0].(database.Change)
+	ret := m.ctrl.Call(m, "PrimaryKeyCondition", instanceID, userID)
+	ret0, _ := ret[0].(database.Condition)
 	return ret0
 }
 
-// RemoveMetadata indicates an expected call of RemoveMetadata.
-func (mr *MockMachineUserRepositoryMockRecorder) RemoveMetadata(condition any) *MockMachineUserRepositoryRemoveMetadataCall {
+// PrimaryKeyCondition indicates an expected call of PrimaryKeyCondition.
+func (mr *MockMachineUserRepositoryMockRecorder) PrimaryKeyCondition(instanceID, userID any) *MockMachineUserRepositoryPrimaryKeyConditionCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMetadata", reflect.TypeOf((*MockMachineUserRepository)(nil).RemoveMetadata), condition)
-	return &MockMachineUserRepositoryRemoveMetadataCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrimaryKeyCondition", reflect.TypeOf((*MockMachineUserRepository)(nil).PrimaryKeyCondition), instanceID, userID)
+	return &MockMachineUserRepositoryPrimaryKeyConditionCall{Call: call}
 }
 
-// MockMachineUserRepositoryRemoveMetadataCall wrap *gomock.Call
-type MockMachineUserRepositoryRemoveMetadataCall struct {
+// MockMachineUserRepositoryPrimaryKeyConditionCall wrap *gomock.Call
+type MockMachineUserRepositoryPrimaryKeyConditionCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockMachineUserRepositoryRemoveMetadataCall) Return(arg0 database.Change) *MockMachineUserRepositoryRemoveMetadataCall {
+func (c *MockMachineUserRepositoryPrimaryKeyConditionCall) Return(arg0 database.Condition) *MockMachineUserRepositoryPrimaryKeyConditionCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockMachineUserRepositoryRemoveMetadataCall) Do(f func(database.Condition) database.Change) *MockMachineUserRepositoryRemoveMetadataCall {
+func (c *MockMachineUserRepositoryPrimaryKeyConditionCall) Do(f func(string, string) database.Condition) *MockMachineUserRepositoryPrimaryKeyConditionCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockMachineUserRepositoryRemoveMetadataCall) DoAndReturn(f func(database.Condition) database.Change) *MockMachineUserRepositoryRemoveMetadataCall {
+func (c *MockMachineUserRepositoryPrimaryKeyConditionCall) DoAndReturn(f func(string, string) database.Condition) *MockMachineUserRepositoryPrimaryKeyConditionCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// RemovePersonalAccessToken mocks base method.
-func (m *MockMachineUserRepository) RemovePersonalAccessToken(id string) database.Change {
+// RemoveMetadata mocks base method.
+func (m *MockMachineUserRepository) RemoveMetadata(condition database.Condition) database.Change {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemovePersonalAccessToken", id)
+	ret := m.ctrl.Call(m, "RemoveMetadata", condition)
 	ret0, _ := ret[0].(database.Change)
 	return ret0
 }
 
-// RemovePersonalAccessToken indicates an expected call of RemovePersonalAccessToken.
-func (mr *MockMachineUserRepositoryMockRecorder) RemovePersonalAccessToken(id any) *MockMachineUserRepositoryRemovePersonalAccessTokenCall {
+// RemoveMetadata indicates an expected call of RemoveMetadata.
+func (mr *MockMachineUserRepositoryMockRecorder) RemoveMetadata(condition any) *MockMachineUserRepositoryRemoveMetadataCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePersonalAccessToken", reflect.TypeOf((*MockMachineUserRepository)(nil).RemovePersonalAccessToken), id)
-	return &MockMachineUserRepositoryRemovePersonalAccessTokenCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMetadata", reflect.TypeOf((*MockMachineUserRepository)(nil).RemoveMetadata), condition)
+	return &MockMachineUserRepositoryRemoveMetadataCall{Call: call}
 }
 
-// MockMachineUserRepositoryRemovePersonalAccessTokenCall wrap *gomock.Call
-type MockMachineUserRepositoryRemovePersonalAccessTokenCall struct {
+// MockMachineUserRepositoryRemoveMetadataCall wrap *gomock.Call
+type MockMachineUserRepositoryRemoveMetadataCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockMachineUserRepositoryRemovePersonalAccessTokenCall) Return(arg0 database.Change) *MockMachineUserRepositoryRemovePersonalAccessTokenCall {
+func (c *MockMachineUserRepositoryRemoveMetadataCall) Return(arg0 database.Change) *MockMachineUserRepositoryRemoveMetadataCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockMachineUserRepositoryRemovePersonalAccessTokenCall) Do(f func(string) database.Change) *MockMachineUserRepositoryRemovePersonalAccessTokenCall {
+func (c *MockMachineUserRepositoryRemoveMetadataCall) Do(f func(database.Condition) database.Change) *MockMachineUserRepositoryRemoveMetadataCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockMachineUserRepositoryRemovePersonalAccessTokenCall) DoAndReturn(f func(string) database.Change) *MockMachineUserRepositoryRemovePersonalAccessTokenCall {
+func (c *MockMachineUserRepositoryRemoveMetadataCall) DoAndReturn(f func(database.Condition) database.Change) *MockMachineUserRepositoryRemoveMetadataCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -997,6 +1035,44 @@ func (c *MockMachineUserRepositoryStateConditionCall) DoAndReturn(f func(domain.
 	return c
 }
 
+// TypeColumn mocks base method.
+func (m *MockMachineUserRepository) TypeColumn() database.Column {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TypeColumn")
+	ret0, _ := ret[0].(database.Column)
+	return ret0
+}
+
+// TypeColumn indicates an expected call of TypeColumn.
+func (mr *MockMachineUserRepositoryMockRecorder) TypeColumn() *MockMachineUserRepositoryTypeColumnCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TypeColumn", reflect.TypeOf((*MockMachineUserRepository)(nil).TypeColumn))
+	return &MockMachineUserRepositoryTypeColumnCall{Call: call}
+}
+
+// MockMachineUserRepositoryTypeColumnCall wrap *gomock.Call
+type MockMachineUserRepositoryTypeColumnCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineUserRepositoryTypeColumnCall) Return(arg0 database.Column) *MockMachineUserRepositoryTypeColumnCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineUserRepositoryTypeColumnCall) Do(f func() database.Column) *MockMachineUserRepositoryTypeColumnCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineUserRepositoryTypeColumnCall) DoAndReturn(f func() database.Column) *MockMachineUserRepositoryTypeColumnCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // TypeCondition mocks base method.
 func (m *MockMachineUserRepository) TypeCondition(userType domain.UserType) database.Condition {
 	m.ctrl.T.Helper()
@@ -1079,6 +1155,44 @@ func (c *MockMachineUserRepositoryUpdateCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// UpdatedAtColumn mocks base method.
+func (m *MockMachineUserRepository) UpdatedAtColumn() database.Column {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatedAtColumn")
+	ret0, _ := ret[0].(database.Column)
+	return ret0
+}
+
+// UpdatedAtColumn indicates an expected call of UpdatedAtColumn.
+func (mr *MockMachineUserRepositoryMockRecorder) UpdatedAtColumn() *MockMachineUserRepositoryUpdatedAtColumnCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatedAtColumn", reflect.TypeOf((*MockMachineUserRepository)(nil).UpdatedAtColumn))
+	return &MockMachineUserRepositoryUpdatedAtColumnCall{Call: call}
+}
+
+// MockMachineUserRepositoryUpdatedAtColumnCall wrap *gomock.Call
+type MockMachineUserRepositoryUpdatedAtColumnCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineUserRepositoryUpdatedAtColumnCall) Return(arg0 database.Column) *MockMachineUserRepositoryUpdatedAtColumnCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineUserRepositoryUpdatedAtColumnCall) Do(f func() database.Column) *MockMachineUserRepositoryUpdatedAtColumnCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineUserRepositoryUpdatedAtColumnCall) DoAndReturn(f func() database.Column) *MockMachineUserRepositoryUpdatedAtColumnCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UsernameColumn mocks base method.
 func (m *MockMachineUserRepository) UsernameColumn() database.Column {
 	m.ctrl.T.Helper()
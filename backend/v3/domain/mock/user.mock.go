@@ -85,6 +85,45 @@ func (c *MockUserRepositoryAddMetadataCall) DoAndReturn(f func(...*domain.Metada
 	return c
 }
 
+// Count mocks base method.
+func (m *MockUserRepository) Count(ctx context.Context, client database.QueryExecutor, condition database.Condition) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, client, condition)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockUserRepositoryMockRecorder) Count(ctx, client, condition any) *MockUserRepositoryCountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockUserRepository)(nil).Count), ctx, client, condition)
+	return &MockUserRepositoryCountCall{Call: call}
+}
+
+// MockUserRepositoryCountCall wrap *gomock.Call
+type MockUserRepositoryCountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserRepositoryCountCall) Return(arg0 uint64, arg1 error) *MockUserRepositoryCountCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserRepositoryCountCall) Do(f func(context.Context, database.QueryExecutor, database.Condition) (uint64, error)) *MockUserRepositoryCountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserRepositoryCountCall) DoAndReturn(f func(context.Context, database.QueryExecutor, database.Condition) (uint64, error)) *MockUserRepositoryCountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Create mocks base method.
 func (m *MockUserRepository) Create(ctx context.Context, client database.QueryExecutor, user *domain.User) error {
 	m.ctrl.T.Helper()
@@ -320,198 +359,198 @@ func (c *MockUserRepositoryHumanCall) DoAndReturn(f func() domain.HumanUserRepos
 	return c
 }
 
-// IDCondition mocks base method.
-func (m *MockUserRepository) IDCondition(userID string) database.Condition {
+// IDColumn mocks base method.
+func (m *MockUserRepository) IDColumn() database.Column {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IDCondition", userID)
-	ret0, _ := ret[0].(database.Condition)
+	ret := m.ctrl.Call(m, "IDColumn")
+	ret0, _ := ret[0].(database.Column)
 	return ret0
 }
 
-// IDCondition indicates an expected call of IDCondition.
-func (mr *MockUserRepositoryMockRecorder) IDCondition(userID any) *MockUserRepositoryIDConditionCall {
+// IDColumn indicates an expected call of IDColumn.
+func (mr *MockUserRepositoryMockRecorder) IDColumn() *MockUserRepositoryIDColumnCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IDCondition", reflect.TypeOf((*MockUserRepository)(nil).IDCondition), userID)
-	return &MockUserRepositoryIDConditionCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IDColumn", reflect.TypeOf((*MockUserRepository)(nil).IDColumn))
+	return &MockUserRepositoryIDColumnCall{Call: call}
 }
 
-// MockUserRepositoryIDConditionCall wrap *gomock.Call
-type MockUserRepositoryIDConditionCall struct {
+// MockUserRepositoryIDColumnCall wrap *gomock.Call
+type MockUserRepositoryIDColumnCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockUserRepositoryIDConditionCall) Return(arg0 database.Condition) *MockUserRepositoryIDConditionCall {
+func (c *MockUserRepositoryIDColumnCall) Return(arg0 database.Column) *MockUserRepositoryIDColumnCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockUserRepositoryIDConditionCall) Do(f func(string) database.Condition) *MockUserRepositoryIDConditionCall {
+func (c *MockUserRepositoryIDColumnCall) Do(f func() database.Column) *MockUserRepositoryIDColumnCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockUserRepositoryIDConditionCall) DoAndReturn(f func(string) database.Condition) *MockUserRepositoryIDConditionCall {
+func (c *MockUserRepositoryIDColumnCall) DoAndReturn(f func() database.Column) *MockUserRepositoryIDColumnCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// InstanceIDCondition mocks base method.
-func (m *MockUserRepository) InstanceIDCondition(instanceID string) database.Condition {
+// IDCondition mocks base method.
+func (m *MockUserRepository) IDCondition(userID string) database.Condition {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InstanceIDCondition", instanceID)
+	ret := m.ctrl.Call(m, "IDCondition", userID)
 	ret0, _ := ret[0].(database.Condition)
 	return ret0
 }
 
-// InstanceIDCondition indicates an expected call of InstanceIDCondition.
-func (mr *MockUserRepositoryMockRecorder) InstanceIDCondition(instanceID any) *MockUserRepositoryInstanceIDConditionCall {
+// IDCondition indicates an expected call of IDCondition.
+func (mr *MockUserRepositoryMockRecorder) IDCondition(userID any) *MockUserRepositoryIDConditionCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceIDCondition", reflect.TypeOf((*MockUserRepository)(nil).InstanceIDCondition), instanceID)
-	return &MockUserRepositoryInstanceIDConditionCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IDCondition", reflect.TypeOf((*MockUserRepository)(nil).IDCondition), userID)
+	return &MockUserRepositoryIDConditionCall{Call: call}
 }
 
-// MockUserRepositoryInstanceIDConditionCall wrap *gomock.Call
-type MockUserRepositoryInstanceIDConditionCall struct {
+// MockUserRepositoryIDConditionCall wrap *gomock.Call
+type MockUserRepositoryIDConditionCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockUserRepositoryInstanceIDConditionCall) Return(arg0 database.Condition) *MockUserRepositoryInstanceIDConditionCall {
+func (c *MockUserRepositoryIDConditionCall) Return(arg0 database.Condition) *MockUserRepositoryIDConditionCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockUserRepositoryInstanceIDConditionCall) Do(f func(string) database.Condition) *MockUserRepositoryInstanceIDConditionCall {
+func (c *MockUserRepositoryIDConditionCall) Do(f func(string) database.Condition) *MockUserRepositoryIDConditionCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockUserRepositoryInstanceIDConditionCall) DoAndReturn(f func(string) database.Condition) *MockUserRepositoryInstanceIDConditionCall {
+func (c *MockUserRepositoryIDConditionCall) DoAndReturn(f func(string) database.Condition) *MockUserRepositoryIDConditionCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// List mocks base method.
-func (m *MockUserRepository) List(ctx context.Context, client database.QueryExecutor, opts ...database.QueryOption) ([]*domain.User, error) {
+// InstanceIDColumn mocks base method.
+func (m *MockUserRepository) InstanceIDColumn() database.Column {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, client}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "List", varargs...)
-	ret0, _ := ret[0].([]*domain.User)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "InstanceIDColumn")
+	ret0, _ := ret[0].(database.Column)
+	return ret0
 }
 
-// List indicates an expected call of List.
-func (mr *MockUserRepositoryMockRecorder) List(ctx, client any, opts ...any) *MockUserRepositoryListCall {
+// InstanceIDColumn indicates an expected call of InstanceIDColumn.
+func (mr *MockUserRepositoryMockRecorder) InstanceIDColumn() *MockUserRepositoryInstanceIDColumnCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, client}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepository)(nil).List), varargs...)
-	return &MockUserRepositoryListCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceIDColumn", reflect.TypeOf((*MockUserRepository)(nil).InstanceIDColumn))
+	return &MockUserRepositoryInstanceIDColumnCall{Call: call}
 }
 
-// MockUserRepositoryListCall wrap *gomock.Call
-type MockUserRepositoryListCall struct {
+// MockUserRepositoryInstanceIDColumnCall wrap *gomock.Call
+type MockUserRepositoryInstanceIDColumnCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockUserRepositoryListCall) Return(arg0 []*domain.User, arg1 error) *MockUserRepositoryListCall {
-	c.Call = c.Call.Return(arg0, arg1)
+func (c *MockUserRepositoryInstanceIDColumnCall) Return(arg0 database.Column) *MockUserRepositoryInstanceIDColumnCall {
+	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockUserRepositoryListCall) Do(f func(context.Context, database.QueryExecutor, ...database.QueryOption) ([]*domain.User, error)) *MockUserRepositoryListCall {
+func (c *MockUserRepositoryInstanceIDColumnCall) Do(f func() database.Column) *MockUserRepositoryInstanceIDColumnCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockUserRepositoryListCall) DoAndReturn(f func(context.Context, database.QueryExecutor, ...database.QueryOption) ([]*domain.User, error)) *MockUserRepositoryListCall {
+func (c *MockUserRepositoryInstanceIDColumnCall) DoAndReturn(f func() database.Column) *MockUserRepositoryInstanceIDColumnCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// LoadIdentityProviderLinks mocks base method.
-func (m *MockUserRepository) LoadIdentityProviderLinks() domain.UserRepository {
+// InstanceIDCondition mocks base method.
+func (m *MockUserRepository) InstanceIDCondition(instanceID string) database.Condition {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LoadIdentityProviderLinks")
-	ret0, _ := ret[0].(domain.UserRepository)
+	ret := m.ctrl.Call(m, "InstanceIDCondition", instanceID)
+	ret0, _ := ret[0].(database.Condition)
 	return ret0
 }
 
-// LoadIdentityProviderLinks indicates an expected call of LoadIdentityProviderLinks.
-func (mr *MockUserRepositoryMockRecorder) LoadIdentityProviderLinks() *MockUserRepositoryLoadIdentityProviderLinksCall {
+// InstanceIDCondition indicates an expected call of InstanceIDCondition.
+func (mr *MockUserRepositoryMockRecorder) InstanceIDCondition(instanceID any) *MockUserRepositoryInstanceIDConditionCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadIdentityProviderLinks", reflect.TypeOf((*MockUserRepository)(nil).LoadIdentityProviderLinks))
-	return &MockUserRepositoryLoadIdentityProviderLinksCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceIDCondition", reflect.TypeOf((*MockUserRepository)(nil).InstanceIDCondition), instanceID)
+	return &MockUserRepositoryInstanceIDConditionCall{Call: call}
 }
 
-// MockUserRepositoryLoadIdentityProviderLinksCall wrap *gomock.Call
-type MockUserRepositoryLoadIdentityProviderLinksCall struct {
+// MockUserRepositoryInstanceIDConditionCall wrap *gomock.Call
+type MockUserRepositoryInstanceIDConditionCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockUserRepositoryLoadIdentityProviderLinksCall) Return(arg0 domain.UserRepository) *MockUserRepositoryLoadIdentityProviderLinksCall {
+func (c *MockUserRepositoryInstanceIDConditionCall) Return(arg0 database.Condition) *MockUserRepositoryInstanceIDConditionCall {
 	c.Call = c.Call.Return(arg0)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockUserRepositoryLoadIdentityProviderLinksCall) Do(f func() domain.UserRepository) *MockUserRepositoryLoadIdentityProviderLinksCall {
+func (c *MockUserRepositoryInstanceIDConditionCall) Do(f func(string) database.Condition) *MockUserRepositoryInstanceIDConditionCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockUserRepositoryLoadIdentityProviderLinksCall) DoAndReturn(f func() domain.UserRepository) *MockUserRepositoryLoadIdentityProviderLinksCall {
+func (c *MockUserRepositoryInstanceIDConditionCall) DoAndReturn(f func(string) database.Condition) *MockUserRepositoryInstanceIDConditionCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// LoadKeys mocks base method.
-func (m *MockUserRepository) LoadKeys() domain.UserRepository {
+// List mocks base method.
+func (m *MockUserRepository) List(ctx context.Context, client database.QueryExecutor, opts ...database.QueryOption) ([]*domain.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LoadKeys")
-	ret0, _ := ret[0].(domain.UserRepository)
-	return ret0
+	varargs := []any{ctx, client}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "List", varargs...)
+	ret0, _ := ret[0].([]*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// LoadKeys indicates an expected call of LoadKeys.
-func (mr *MockUserRepositoryMockRecorder) LoadKeys() *MockUserRepositoryLoadKeysCall {
+// List indicates an expected call of List.
+func (mr *MockUserRepositoryMockRecorder) List(ctx, client any, opts ...any) *MockUserRepositoryListCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadKeys", reflect.TypeOf((*MockUserRepository)(nil).LoadKeys))
-	return &MockUserRepositoryLoadKeysCall{Call: call}
+	varargs := append([]any{ctx, client}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepository)(nil).List), varargs...)
+	return &MockUserRepositoryListCall{Call: call}
 }
 
-// MockUserRepositoryLoadKeysCall wrap *gomock.Call
-type MockUserRepositoryLoadKeysCall struct {
+// MockUserRepositoryListCall wrap *gomock.Call
+type MockUserRepositoryListCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockUserRepositoryLoadKeysCall) Return(arg0 domain.UserRepository) *MockUserRepositoryLoadKeysCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockUserRepositoryListCall) Return(arg0 []*domain.User, arg1 error) *MockUserRepositoryListCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockUserRepositoryLoadKeysCall) Do(f func() domain.UserRepository) *MockUserRepositoryLoadKeysCall {
+func (c *MockUserRepositoryListCall) Do(f func(context.Context, database.QueryExecutor, ...database.QueryOption) ([]*domain.User, error)) *MockUserRepositoryListCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockUserRepositoryLoadKeysCall) DoAndReturn(f func() domain.UserRepository) *MockUserRepositoryLoadKeysCall {
+func (c *MockUserRepositoryListCall) DoAndReturn(f func(context.Context, database.QueryExecutor, ...database.QueryOption) ([]*domain.User, error)) *MockUserRepositoryListCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -554,120 +593,6 @@ func (c *MockUserRepositoryLoadMetadataCall) DoAndReturn(f func() domain.UserRep
 	return c
 }
 
-// LoadPATs mocks base method.
-func (m *MockUserRepository) LoadPATs() domain.UserRepository {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LoadPATs")
-	ret0, _ := ret[0].(domain.UserRepository)
-	return ret0
-}
-
-// LoadPATs indicates an expected call of LoadPATs.
-func (mr *MockUserRepositoryMockRecorder) LoadPATs() *MockUserRepositoryLoadPATsCall {
-	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadPATs", reflect.TypeOf((*MockUserRepository)(nil).LoadPATs))
-	return &MockUserRepositoryLoadPATsCall{Call: call}
-}
-
-// MockUserRepositoryLoadPATsCall wrap *gomock.Call
-type MockUserRepositoryLoadPATsCall struct {
-	*gomock.Call
-}
-
-// Return rewrite *gomock.Call.Return
-func (c *MockUserRepositoryLoadPATsCall) Return(arg0 domain.UserRepository) *MockUserRepositoryLoadPATsCall {
-	c.Call = c.Call.Return(arg0)
-	return c
-}
-
-// Do rewrite *gomock.Call.Do
-func (c *MockUserRepositoryLoadPATsCall) Do(f func() domain.UserRepository) *MockUserRepositoryLoadPATsCall {
-	c.Call = c.Call.Do(f)
-	return c
-}
-
-// DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockUserRepositoryLoadPATsCall) DoAndReturn(f func() domain.UserRepository) *MockUserRepositoryLoadPATsCall {
-	c.Call = c.Call.DoAndReturn(f)
-	return c
-}
-
-// LoadPasskeys mocks base method.
-func (m *MockUserRepository) LoadPasskeys() domain.UserRepository {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LoadPasskeys")
-	ret0, _ := ret[0].(domain.UserRepository)
-	return ret0
-}
-
-// LoadPasskeys indicates an expected call of LoadPasskeys.
-func (mr *MockUserRepositoryMockRecorder) LoadPasskeys() *MockUserRepositoryLoadPasskeysCall {
-	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadPasskeys", reflect.TypeOf((*MockUserRepository)(nil).LoadPasskeys))
-	return &MockUserRepositoryLoadPasskeysCall{Call: call}
-}
-
-// MockUserRepositoryLoadPasskeysCall wrap *gomock.Call
-type MockUserRepositoryLoadPasskeysCall struct {
-	*gomock.Call
-}
-
-// Return rewrite *gomock.Call.Return
-func (c *MockUserRepositoryLoadPasskeysCall) Return(arg0 domain.UserRepository) *MockUserRepositoryLoadPasskeysCall {
-	c.Call = c.Call.Return(arg0)
-	return c
-}
-
-// Do rewrite *gomock.Call.Do
-func (c *MockUserRepositoryLoadPasskeysCall) Do(f func() domain.UserRepository) *MockUserRepositoryLoadPasskeysCall {
-	c.Call = c.Call.Do(f)
-	return c
-}
-
-// DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockUserRepositoryLoadPasskeysCall) DoAndReturn(f func() domain.UserRepository) *MockUserRepositoryLoadPasskeysCall {
-	c.Call = c.Call.DoAndReturn(f)
-	return c
-}
-
-// LoadVerifications mocks base method.
-func (m *MockUserRepository) LoadVerifications() domain.UserRepository {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LoadVerifications")
-	ret0, _ := ret[0].(domain.UserRepository)
-	return ret0
-}
-
-// LoadVerifications indicates an expected call of LoadVerifications.
-func (mr *MockUserRepositoryMockRecorder) LoadVerifications() *MockUserRepositoryLoadVerificationsCall {
-	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadVerifications", reflect.TypeOf((*MockUserRepository)(nil).LoadVerifications))
-	return &MockUserRepositoryLoadVerificationsCall{Call: call}
-}
-
-// MockUserRepositoryLoadVerificationsCall wrap *gomock.Call
-type MockUserRepositoryLoadVerificationsCall struct {
-	*gomock.Call
-}
-
-// Return rewrite *gomock.Call.Return
-func (c *MockUserRepositoryLoadVerificationsCall) Return(arg0 domain.UserRepository) *MockUserRepositoryLoadVerificationsCall {
-	c.Call = c.Call.Return(arg0)
-	return c
-}
-
-// Do rewrite *gomock.Call.Do
-func (c *MockUserRepositoryLoadVerificationsCall) Do(f func() domain.UserRepository) *MockUserRepositoryLoadVerificationsCall {
-	c.Call = c.Call.Do(f)
-	return c
-}
-
-// DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockUserRepositoryLoadVerificationsCall) DoAndReturn(f func() domain.UserRepository) *MockUserRepositoryLoadVerificationsCall {
-	c.Call = c.Call.DoAndReturn(f)
-	return c
-}
-
 // LoginNameCondition mocks base method.
 func (m *MockUserRepository) LoginNameCondition(op database.TextOperation, loginName string) database.Condition {
 	m.ctrl.T.Helper()
@@ -745,17 +670,17 @@ func (c *MockUserRepositoryMachineCall) DoAndReturn(f func() domain.MachineUserR
 }
 
 // MetadataKeyCondition mocks base method.
-func (m *MockUserRepository) MetadataKeyCondition(key string) database.Condition {
+func (m *MockUserRepository) MetadataKeyCondition(op database.TextOperation, key string) database.Condition {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MetadataKeyCondition", key)
+	ret := m.ctrl.Call(m, "MetadataKeyCondition", op, key)
 	ret0, _ := ret[0].(database.Condition)
 	return ret0
 }
 
 // MetadataKeyCondition indicates an expected call of MetadataKeyCondition.
-func (mr *MockUserRepositoryMockRecorder) MetadataKeyCondition(key any) *MockUserRepositoryMetadataKeyConditionCall {
+func (mr *MockUserRepositoryMockRecorder) MetadataKeyCondition(op, key any) *MockUserRepositoryMetadataKeyConditionCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetadataKeyCondition", reflect.TypeOf((*MockUserRepository)(nil).MetadataKeyCondition), key)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetadataKeyCondition", reflect.TypeOf((*MockUserRepository)(nil).MetadataKeyCondition), op, key)
 	return &MockUserRepositoryMetadataKeyConditionCall{Call: call}
 }
 
@@ -771,13 +696,13 @@ func (c *MockUserRepositoryMetadataKeyConditionCall) Return(arg0 database.Condit
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockUserRepositoryMetadataKeyConditionCall) Do(f func(string) database.Condition) *MockUserRepositoryMetadataKeyConditionCall {
+func (c *MockUserRepositoryMetadataKeyConditionCall) Do(f func(database.TextOperation, string) database.Condition) *MockUserRepositoryMetadataKeyConditionCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockUserRepositoryMetadataKeyConditionCall) DoAndReturn(f func(string) database.Condition) *MockUserRepositoryMetadataKeyConditionCall {
+func (c *MockUserRepositoryMetadataKeyConditionCall) DoAndReturn(f func(database.TextOperation, string) database.Condition) *MockUserRepositoryMetadataKeyConditionCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -820,6 +745,44 @@ func (c *MockUserRepositoryMetadataValueConditionCall) DoAndReturn(f func(databa
 	return c
 }
 
+// OrgIDColumn mocks base method.
+func (m *MockUserRepository) OrgIDColumn() database.Column {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrgIDColumn")
+	ret0, _ := ret[0].(database.Column)
+	return ret0
+}
+
+// OrgIDColumn indicates an expected call of OrgIDColumn.
+func (mr *MockUserRepositoryMockRecorder) OrgIDColumn() *MockUserRepositoryOrgIDColumnCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgIDColumn", reflect.TypeOf((*MockUserRepository)(nil).OrgIDColumn))
+	return &MockUserRepositoryOrgIDColumnCall{Call: call}
+}
+
+// MockUserRepositoryOrgIDColumnCall wrap *gomock.Call
+type MockUserRepositoryOrgIDColumnCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserRepositoryOrgIDColumnCall) Return(arg0 database.Column) *MockUserRepositoryOrgIDColumnCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserRepositoryOrgIDColumnCall) Do(f func() database.Column) *MockUserRepositoryOrgIDColumnCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserRepositoryOrgIDColumnCall) DoAndReturn(f func() database.Column) *MockUserRepositoryOrgIDColumnCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // OrgIDCondition mocks base method.
 func (m *MockUserRepository) OrgIDCondition(orgID string) database.Condition {
 	m.ctrl.T.Helper()
@@ -1162,6 +1125,44 @@ func (c *MockUserRepositoryStateConditionCall) DoAndReturn(f func(domain.UserSta
 	return c
 }
 
+// TypeColumn mocks base method.
+func (m *MockUserRepository) TypeColumn() database.Column {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TypeColumn")
+	ret0, _ := ret[0].(database.Column)
+	return ret0
+}
+
+// TypeColumn indicates an expected call of TypeColumn.
+func (mr *MockUserRepositoryMockRecorder) TypeColumn() *MockUserRepositoryTypeColumnCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TypeColumn", reflect.TypeOf((*MockUserRepository)(nil).TypeColumn))
+	return &MockUserRepositoryTypeColumnCall{Call: call}
+}
+
+// MockUserRepositoryTypeColumnCall wrap *gomock.Call
+type MockUserRepositoryTypeColumnCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserRepositoryTypeColumnCall) Return(arg0 database.Column) *MockUserRepositoryTypeColumnCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserRepositoryTypeColumnCall) Do(f func() database.Column) *MockUserRepositoryTypeColumnCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserRepositoryTypeColumnCall) DoAndReturn(f func() database.Column) *MockUserRepositoryTypeColumnCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // TypeCondition mocks base method.
 func (m *MockUserRepository) TypeCondition(userType domain.UserType) database.Condition {
 	m.ctrl.T.Helper()
@@ -1244,6 +1245,44 @@ func (c *MockUserRepositoryUpdateCall) DoAndReturn(f func(context.Context, datab
 	return c
 }
 
+// UpdatedAtColumn mocks base method.
+func (m *MockUserRepository) UpdatedAtColumn() database.Column {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatedAtColumn")
+	ret0, _ := ret[0].(database.Column)
+	return ret0
+}
+
+// UpdatedAtColumn indicates an expected call of UpdatedAtColumn.
+func (mr *MockUserRepositoryMockRecorder) UpdatedAtColumn() *MockUserRepositoryUpdatedAtColumnCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatedAtColumn", reflect.TypeOf((*MockUserRepository)(nil).UpdatedAtColumn))
+	return &MockUserRepositoryUpdatedAtColumnCall{Call: call}
+}
+
+// MockUserRepositoryUpdatedAtColumnCall wrap *gomock.Call
+type MockUserRepositoryUpdatedAtColumnCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUserRepositoryUpdatedAtColumnCall) Return(arg0 database.Column) *MockUserRepositoryUpdatedAtColumnCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUserRepositoryUpdatedAtColumnCall) Do(f func() database.Column) *MockUserRepositoryUpdatedAtColumnCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUserRepositoryUpdatedAtColumnCall) DoAndReturn(f func() database.Column) *MockUserRepositoryUpdatedAtColumnCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UsernameColumn mocks base method.
 func (m *MockUserRepository) UsernameColumn() database.Column {
 	m.ctrl.T.Helper()
@@ -0,0 +1,51 @@
+package domainmock
+
+import (
+	context "context"
+
+	gomock "go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/backend/v3/domain"
+	database "github.com/zitadel/zitadel/backend/v3/storage/database"
+	"github.com/zitadel/zitadel/backend/v3/storage/database/repository"
+)
+
+func NewUserRepo(ctrl *gomock.Controller) *UserRepo {
+	return &UserRepo{
+		mock:           NewMockUserRepository(ctrl),
+		UserRepository: repository.UserRepository(),
+	}
+}
+
+type UserRepo struct {
+	domain.UserRepository
+	mock *MockUserRepository
+}
+
+func (r *UserRepo) EXPECT() *MockUserRepositoryMockRecorder {
+	return r.mock.EXPECT()
+}
+
+func (r *UserRepo) Get(ctx context.Context, client database.QueryExecutor, opts ...database.QueryOption) (*domain.User, error) {
+	return r.mock.Get(ctx, client, opts...)
+}
+
+func (r *UserRepo) List(ctx context.Context, client database.QueryExecutor, opts ...database.QueryOption) ([]*domain.User, error) {
+	return r.mock.List(ctx, client, opts...)
+}
+
+func (r *UserRepo) Count(ctx context.Context, client database.QueryExecutor, condition database.Condition) (uint64, error) {
+	return r.mock.Count(ctx, client, condition)
+}
+
+func (r *UserRepo) Create(ctx context.Context, client database.QueryExecutor, user *domain.User) error {
+	return r.mock.Create(ctx, client, user)
+}
+
+func (r *UserRepo) Update(ctx context.Context, client database.QueryExecutor, condition database.Condition, changes ...database.Change) (int64, error) {
+	return r.mock.Update(ctx, client, condition, changes...)
+}
+
+func (r *UserRepo) Delete(ctx context.Context, client database.QueryExecutor, condition database.Condition) (int64, error) {
+	return r.mock.Delete(ctx, client, condition)
+}
@@ -1,12 +1,17 @@
 package domain
 
-import "context"
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+)
 
 const (
 	InstanceReadPermission      = "instance.read"
 	InstanceWritePermission     = "instance.write"
 	OrganizationReadPermission  = "organization.read"
 	OrganizationWritePermission = "organization.write"
+	UserReadPermission          = "user.read"
 )
 
 // PermissionRepository is the interface that manages and checks Zitadel permissions.
@@ -36,6 +41,35 @@ type PermissionChecker interface {
 	CheckProjectGrantPermission(ctx context.Context, permission, projectGrantID string) error
 }
 
+// PermissionCheckFunc adapts the permission check of the eventstore based API to a [PermissionChecker].
+// It checks the permission on the organization and resource id, like the memberships of the authenticated user are resolved there.
+type PermissionCheckFunc func(ctx context.Context, permission, orgID, resourceID string) error
+
+var _ PermissionChecker = (PermissionCheckFunc)(nil)
+
+// CheckInstancePermission implements [PermissionChecker].
+func (f PermissionCheckFunc) CheckInstancePermission(ctx context.Context, permission string) error {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	return f(ctx, permission, instanceID, instanceID)
+}
+
+// CheckOrganizationPermission implements [PermissionChecker].
+func (f PermissionCheckFunc) CheckOrganizationPermission(ctx context.Context, permission, orgID string) error {
+	return f(ctx, permission, orgID, orgID)
+}
+
+// CheckProjectPermission implements [PermissionChecker].
+// The project is expected in the organization of the request, like the checks of the eventstore based API do.
+func (f PermissionCheckFunc) CheckProjectPermission(ctx context.Context, permission, projectID string) error {
+	return f(ctx, permission, authz.GetCtxData(ctx).OrgID, projectID)
+}
+
+// CheckProjectGrantPermission implements [PermissionChecker].
+// The project grant is expected in the organization of the request, like the checks of the eventstore based API do.
+func (f PermissionCheckFunc) CheckProjectGrantPermission(ctx context.Context, permission, projectGrantID string) error {
+	return f(ctx, permission, authz.GetCtxData(ctx).OrgID, projectGrantID)
+}
+
 type noopPermissionChecker struct{}
 
 var _ PermissionChecker = (*noopPermissionChecker)(nil)
@@ -113,6 +113,7 @@ type sessionChanges interface {
 	// ClearFactor resets the factor of the corresponding type.
 	ClearFactor(factor SessionFactorType) database.Change
 	// SetMetadata adds or updates the metadata of the session.
+	// Metadata with an empty value is removed.
 	SetMetadata(metadata []SessionMetadata) database.Change
 	// SetUserAgent adds or updates the user agent of the session.
 	SetUserAgent(userAgent SessionUserAgent) database.Change
@@ -15,6 +15,11 @@ type User struct {
 	CreatedAt      time.Time `json:"createdAt,omitzero" db:"created_at"`
 	UpdatedAt      time.Time `json:"updatedAt,omitzero" db:"updated_at"`
 
+	// LoginNames are the names the user can login with.
+	// They depend on the domain settings and the verified domains of the organization.
+	LoginNames         []string `json:"loginNames,omitempty" db:"login_names"`
+	PreferredLoginName string   `json:"preferredLoginName,omitempty" db:"preferred_login_name"`
+
 	Machine  *MachineUser    `json:"machine,omitempty" db:"machine"`
 	Human    *HumanUser      `json:"human,omitempty" db:"human"`
 	Metadata []*UserMetadata `json:"metadata,omitempty" db:"metadata"`
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"context"
+	"strings"
+
+	"github.com/zitadel/zitadel/backend/v3/storage/database"
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type GetUserQuery struct {
+	ID   string `json:"id"`
+	user *User
+}
+
+// Result implements [Querier].
+func (g *GetUserQuery) Result() *User {
+	return g.user
+}
+
+func NewGetUserQuery(userID string) *GetUserQuery {
+	return &GetUserQuery{ID: userID}
+}
+
+// Execute implements [Executor].
+func (g *GetUserQuery) Execute(ctx context.Context, opts *InvokeOpts) (err error) {
+	userRepo := opts.userRepo
+
+	user, err := userRepo.Get(ctx, opts.DB(), database.WithCondition(
+		userRepo.PrimaryKeyCondition(authz.GetInstance(ctx).InstanceID(), g.ID),
+	))
+	if err != nil {
+		return err
+	}
+	// users can always read themselves
+	if user.ID != authz.GetCtxData(ctx).UserID {
+		if authZErr := opts.Permissions.CheckOrganizationPermission(ctx, UserReadPermission, user.OrganizationID); authZErr != nil {
+			return zerrors.ThrowPermissionDenied(authZErr, "DOM-r5UeGk", "permission denied")
+		}
+	}
+
+	g.user = user
+	return nil
+}
+
+// String implements [Executor].
+func (g *GetUserQuery) String() string {
+	return "GetUserQuery"
+}
+
+// Validate implements [Validator].
+func (g *GetUserQuery) Validate(_ context.Context, _ *InvokeOpts) (err error) {
+	g.ID = strings.TrimSpace(g.ID)
+	if g.ID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "DOM-Wq7Nfa", "invalid user ID")
+	}
+	return nil
+}
+
+var _ Querier[*User] = (*GetUserQuery)(nil)
@@ -0,0 +1,196 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/backend/v3/domain"
+	domainmock "github.com/zitadel/zitadel/backend/v3/domain/mock"
+	"github.com/zitadel/zitadel/backend/v3/storage/database"
+	"github.com/zitadel/zitadel/backend/v3/storage/database/dbmock"
+	noopdb "github.com/zitadel/zitadel/backend/v3/storage/database/dialect/noop"
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestGetUserQuery_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		inputUserID   string
+		expectedError error
+	}{
+		{
+			name:          "empty user id, error",
+			inputUserID:   "",
+			expectedError: zerrors.ThrowInvalidArgument(nil, "DOM-Wq7Nfa", "invalid user ID"),
+		},
+		{
+			name:          "whitespace user id, error",
+			inputUserID:   "   ",
+			expectedError: zerrors.ThrowInvalidArgument(nil, "DOM-Wq7Nfa", "invalid user ID"),
+		},
+		{
+			name:        "valid user id, success",
+			inputUserID: " user-1 ",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Given
+			q := domain.NewGetUserQuery(tt.inputUserID)
+
+			// Test
+			err := q.Validate(context.Background(), &domain.InvokeOpts{})
+
+			// Verify
+			assert.Equal(t, tt.expectedError, err)
+		})
+	}
+}
+
+func TestGetUserQuery_Execute(t *testing.T) {
+	t.Parallel()
+
+	ctx := authz.NewMockContext("instance-1", "org-1", "")
+	getErr := errors.New("get error")
+	permissionErr := errors.New("permission error")
+
+	tt := []struct {
+		testName string
+
+		ctx               context.Context
+		userRepo          func(ctrl *gomock.Controller) domain.UserRepository
+		permissionChecker func(ctrl *gomock.Controller) domain.PermissionChecker
+
+		expectedError error
+		expectedUser  *domain.User
+	}{
+		{
+			testName: "when retrieving user fails should return error",
+			userRepo: func(ctrl *gomock.Controller) domain.UserRepository {
+				userRepo := domainmock.NewUserRepo(ctrl)
+				userRepo.EXPECT().
+					Get(
+						gomock.Any(),
+						gomock.Any(),
+						dbmock.QueryOptions(database.WithCondition(
+							userRepo.PrimaryKeyCondition("instance-1", "user-1"),
+						)),
+					).
+					Times(1).
+					Return(nil, getErr)
+				return userRepo
+			},
+			permissionChecker: func(ctrl *gomock.Controller) domain.PermissionChecker {
+				return domainmock.NewMockPermissionChecker(ctrl)
+			},
+			expectedError: getErr,
+		},
+		{
+			testName: "when user is missing permission should return permission denied",
+			userRepo: func(ctrl *gomock.Controller) domain.UserRepository {
+				userRepo := domainmock.NewUserRepo(ctrl)
+				userRepo.EXPECT().
+					Get(
+						gomock.Any(),
+						gomock.Any(),
+						dbmock.QueryOptions(database.WithCondition(
+							userRepo.PrimaryKeyCondition("instance-1", "user-1"),
+						)),
+					).
+					Times(1).
+					Return(&domain.User{ID: "user-1", OrganizationID: "org-2", Username: "gigi"}, nil)
+				return userRepo
+			},
+			permissionChecker: func(ctrl *gomock.Controller) domain.PermissionChecker {
+				permChecker := domainmock.NewMockPermissionChecker(ctrl)
+				permChecker.EXPECT().
+					CheckOrganizationPermission(gomock.Any(), domain.UserReadPermission, "org-2").
+					Times(1).
+					Return(permissionErr)
+				return permChecker
+			},
+			expectedError: zerrors.ThrowPermissionDenied(permissionErr, "DOM-r5UeGk", "permission denied"),
+		},
+		{
+			testName: "when user reads itself should not check permission",
+			ctx:      authz.NewMockContext("instance-1", "org-1", "user-1"),
+			userRepo: func(ctrl *gomock.Controller) domain.UserRepository {
+				userRepo := domainmock.NewUserRepo(ctrl)
+				userRepo.EXPECT().
+					Get(
+						gomock.Any(),
+						gomock.Any(),
+						dbmock.QueryOptions(database.WithCondition(
+							userRepo.PrimaryKeyCondition("instance-1", "user-1"),
+						)),
+					).
+					Times(1).
+					Return(&domain.User{ID: "user-1", OrganizationID: "org-1", Username: "gigi"}, nil)
+				return userRepo
+			},
+			permissionChecker: func(ctrl *gomock.Controller) domain.PermissionChecker {
+				return domainmock.NewMockPermissionChecker(ctrl)
+			},
+			expectedUser: &domain.User{ID: "user-1", OrganizationID: "org-1", Username: "gigi"},
+		},
+		{
+			testName: "when retrieving user succeeds should set user",
+			userRepo: func(ctrl *gomock.Controller) domain.UserRepository {
+				userRepo := domainmock.NewUserRepo(ctrl)
+				userRepo.EXPECT().
+					Get(
+						gomock.Any(),
+						gomock.Any(),
+						dbmock.QueryOptions(database.WithCondition(
+							userRepo.PrimaryKeyCondition("instance-1", "user-1"),
+						)),
+					).
+					Times(1).
+					Return(&domain.User{ID: "user-1", OrganizationID: "org-1", Username: "gigi"}, nil)
+				return userRepo
+			},
+			permissionChecker: func(ctrl *gomock.Controller) domain.PermissionChecker {
+				permChecker := domainmock.NewMockPermissionChecker(ctrl)
+				permChecker.EXPECT().
+					CheckOrganizationPermission(gomock.Any(), domain.UserReadPermission, "org-1").
+					Times(1).
+					Return(nil)
+				return permChecker
+			},
+			expectedUser: &domain.User{ID: "user-1", OrganizationID: "org-1", Username: "gigi"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testName, func(t *testing.T) {
+			t.Parallel()
+			// Given
+			q := domain.NewGetUserQuery("user-1")
+			ctrl := gomock.NewController(t)
+			opts := &domain.InvokeOpts{
+				Permissions: tc.permissionChecker(ctrl),
+			}
+			domain.WithQueryExecutor(new(noopdb.Pool))(opts)
+			domain.WithUserRepo(tc.userRepo(ctrl))(opts)
+			execCtx := ctx
+			if tc.ctx != nil {
+				execCtx = tc.ctx
+			}
+
+			// Test
+			err := q.Execute(execCtx, opts)
+
+			// Verify
+			assert.Equal(t, tc.expectedError, err)
+			assert.Equal(t, tc.expectedUser, q.Result())
+		})
+	}
+}
@@ -0,0 +1,319 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/backend/v3/api/object"
+	"github.com/zitadel/zitadel/backend/v3/storage/database"
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	v2_user "github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+// maxUserQueryNesting limits the depth of nested and, or and not queries.
+const maxUserQueryNesting = 20
+
+type ListUsersQuery struct {
+	Request *v2_user.ListUsersRequest
+
+	// restrictedOrgID and restrictedUserID limit the result
+	// if the user is not allowed to read the users of the whole instance.
+	restrictedOrgID  string
+	restrictedUserID string
+
+	result []*User
+	total  uint64
+}
+
+// Result implements [Querier].
+func (l *ListUsersQuery) Result() []*User {
+	return l.result
+}
+
+// Total returns the number of users matching the queries regardless of the pagination.
+func (l *ListUsersQuery) Total() uint64 {
+	return l.total
+}
+
+func NewListUsersQuery(inputRequest *v2_user.ListUsersRequest) *ListUsersQuery {
+	return &ListUsersQuery{
+		Request: inputRequest,
+	}
+}
+
+// Execute implements [Querier].
+func (l *ListUsersQuery) Execute(ctx context.Context, opts *InvokeOpts) (err error) {
+	userRepo := opts.userRepo
+
+	sorting := l.Sorting(userRepo)
+	limit, pagination := l.Pagination()
+	condition, condErr := l.conditions(ctx, userRepo)
+	if condErr != nil {
+		err = condErr
+		return err
+	}
+
+	l.result, err = userRepo.List(ctx, opts.ReadDB(), database.WithCondition(condition), sorting, limit, pagination)
+	if err != nil {
+		return err
+	}
+	l.total, err = userRepo.Count(ctx, opts.ReadDB(), condition)
+	return err
+}
+
+func (l *ListUsersQuery) Sorting(userRepo UserRepository) database.QueryOption {
+	var sortingCol database.Column
+	switch l.Request.GetSortingColumn() {
+	case v2_user.UserFieldName_USER_FIELD_NAME_USER_NAME:
+		sortingCol = userRepo.UsernameColumn()
+	case v2_user.UserFieldName_USER_FIELD_NAME_FIRST_NAME:
+		sortingCol = userRepo.Human().FirstNameColumn()
+	case v2_user.UserFieldName_USER_FIELD_NAME_LAST_NAME:
+		sortingCol = userRepo.Human().LastNameColumn()
+	case v2_user.UserFieldName_USER_FIELD_NAME_NICK_NAME:
+		sortingCol = userRepo.Human().NicknameColumn()
+	case v2_user.UserFieldName_USER_FIELD_NAME_DISPLAY_NAME:
+		sortingCol = userRepo.Human().DisplayNameColumn()
+	case v2_user.UserFieldName_USER_FIELD_NAME_EMAIL:
+		sortingCol = userRepo.Human().EmailColumn()
+	case v2_user.UserFieldName_USER_FIELD_NAME_STATE:
+		sortingCol = userRepo.StateColumn()
+	case v2_user.UserFieldName_USER_FIELD_NAME_TYPE:
+		sortingCol = userRepo.TypeColumn()
+	case v2_user.UserFieldName_USER_FIELD_NAME_CREATION_DATE:
+		sortingCol = userRepo.CreatedAtColumn()
+	case v2_user.UserFieldName_USER_FIELD_NAME_UNSPECIFIED:
+		fallthrough
+	default:
+		return func(opts *database.QueryOpts) {}
+	}
+
+	orderDirection := database.OrderDirectionDesc
+	if l.Request.GetQuery().GetAsc() {
+		orderDirection = database.OrderDirectionAsc
+	}
+
+	return database.WithOrderBy(orderDirection, sortingCol)
+}
+
+func (l *ListUsersQuery) Pagination() (database.QueryOption, database.QueryOption) {
+	return database.WithLimit(l.Request.GetQuery().GetLimit()),
+		database.WithOffset(uint32(l.Request.GetQuery().GetOffset()))
+}
+
+func (l *ListUsersQuery) conditions(ctx context.Context, userRepo UserRepository) (database.Condition, error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+
+	conditions, err := userQueriesToConditions(userRepo, l.Request.GetQueries(), 0)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case l.restrictedOrgID != "":
+		conditions = append(conditions, userRepo.OrgIDCondition(l.restrictedOrgID))
+	case l.restrictedUserID != "":
+		conditions = append(conditions, userRepo.IDCondition(l.restrictedUserID))
+	}
+
+	return database.And(
+		append(conditions, userRepo.InstanceIDCondition(instanceID))...,
+	), nil
+}
+
+func userQueriesToConditions(userRepo UserRepository, queries []*v2_user.SearchQuery, level uint8) ([]database.Condition, error) {
+	conditions := make([]database.Condition, len(queries))
+	for i, query := range queries {
+		condition, err := userQueryToCondition(userRepo, query, level)
+		if err != nil {
+			return nil, err
+		}
+		conditions[i] = condition
+	}
+	return conditions, nil
+}
+
+func userQueryToCondition(userRepo UserRepository, query *v2_user.SearchQuery, level uint8) (database.Condition, error) {
+	if level > maxUserQueryNesting {
+		return nil, zerrors.ThrowInvalidArgument(nil, "DOM-hV4c8Q", "Errors.Query.TooManyNestingLevels")
+	}
+
+	switch assertedType := query.GetQuery().(type) {
+	case *v2_user.SearchQuery_UserNameQuery:
+		method, err := object.TextQueryMethodToTextOperation(assertedType.UserNameQuery.GetMethod())
+		if err != nil {
+			return nil, err
+		}
+		return userRepo.UsernameCondition(method, assertedType.UserNameQuery.GetUserName()), nil
+	case *v2_user.SearchQuery_FirstNameQuery:
+		method, err := object.TextQueryMethodToTextOperation(assertedType.FirstNameQuery.GetMethod())
+		if err != nil {
+			return nil, err
+		}
+		return userRepo.Human().FirstNameCondition(method, assertedType.FirstNameQuery.GetFirstName()), nil
+	case *v2_user.SearchQuery_LastNameQuery:
+		method, err := object.TextQueryMethodToTextOperation(assertedType.LastNameQuery.GetMethod())
+		if err != nil {
+			return nil, err
+		}
+		return userRepo.Human().LastNameCondition(method, assertedType.LastNameQuery.GetLastName()), nil
+	case *v2_user.SearchQuery_NickNameQuery:
+		method, err := object.TextQueryMethodToTextOperation(assertedType.NickNameQuery.GetMethod())
+		if err != nil {
+			return nil, err
+		}
+		return userRepo.Human().NicknameCondition(method, assertedType.NickNameQuery.GetNickName()), nil
+	case *v2_user.SearchQuery_DisplayNameQuery:
+		method, err := object.TextQueryMethodToTextOperation(assertedType.DisplayNameQuery.GetMethod())
+		if err != nil {
+			return nil, err
+		}
+		return userRepo.Human().DisplayNameCondition(method, assertedType.DisplayNameQuery.GetDisplayName()), nil
+	case *v2_user.SearchQuery_EmailQuery:
+		method, err := object.TextQueryMethodToTextOperation(assertedType.EmailQuery.GetMethod())
+		if err != nil {
+			return nil, err
+		}
+		return userRepo.Human().EmailCondition(method, assertedType.EmailQuery.GetEmailAddress()), nil
+	case *v2_user.SearchQuery_PhoneQuery:
+		method, err := object.TextQueryMethodToTextOperation(assertedType.PhoneQuery.GetMethod())
+		if err != nil {
+			return nil, err
+		}
+		return userRepo.Human().PhoneCondition(method, assertedType.PhoneQuery.GetNumber()), nil
+	case *v2_user.SearchQuery_LoginNameQuery:
+		method, err := object.TextQueryMethodToTextOperation(assertedType.LoginNameQuery.GetMethod())
+		if err != nil {
+			return nil, err
+		}
+		return userRepo.LoginNameCondition(method, assertedType.LoginNameQuery.GetLoginName()), nil
+	case *v2_user.SearchQuery_StateQuery:
+		return userRepo.StateCondition(userStateFromProto(assertedType.StateQuery.GetState())), nil
+	case *v2_user.SearchQuery_TypeQuery:
+		return userRepo.TypeCondition(userTypeFromProto(assertedType.TypeQuery.GetType())), nil
+	case *v2_user.SearchQuery_OrganizationIdQuery:
+		return userRepo.OrgIDCondition(assertedType.OrganizationIdQuery.GetOrganizationId()), nil
+	case *v2_user.SearchQuery_InUserIdsQuery:
+		userIDs := assertedType.InUserIdsQuery.GetUserIds()
+		conditions := make([]database.Condition, len(userIDs))
+		for i, userID := range userIDs {
+			conditions[i] = userRepo.IDCondition(userID)
+		}
+		return database.Or(conditions...), nil
+	case *v2_user.SearchQuery_InUserEmailsQuery:
+		emails := assertedType.InUserEmailsQuery.GetUserEmails()
+		conditions := make([]database.Condition, len(emails))
+		for i, email := range emails {
+			conditions[i] = userRepo.Human().EmailCondition(database.TextOperationEqualIgnoreCase, email)
+		}
+		return database.Or(conditions...), nil
+	case *v2_user.SearchQuery_MetadataKeyFilter:
+		method, err := object.TextFilterMethodToTextOperation(assertedType.MetadataKeyFilter.GetMethod())
+		if err != nil {
+			return nil, err
+		}
+		return userRepo.ExistsMetadata(userRepo.MetadataKeyCondition(method, assertedType.MetadataKeyFilter.GetKey())), nil
+	case *v2_user.SearchQuery_MetadataValueFilter:
+		method, err := object.ByteFilterMethodToBytesOperation(assertedType.MetadataValueFilter.GetMethod())
+		if err != nil {
+			return nil, err
+		}
+		return userRepo.ExistsMetadata(userRepo.MetadataValueCondition(method, assertedType.MetadataValueFilter.GetValue())), nil
+	case *v2_user.SearchQuery_OrQuery:
+		conditions, err := userQueriesToConditions(userRepo, assertedType.OrQuery.GetQueries(), level+1)
+		if err != nil {
+			return nil, err
+		}
+		return database.Or(conditions...), nil
+	case *v2_user.SearchQuery_AndQuery:
+		conditions, err := userQueriesToConditions(userRepo, assertedType.AndQuery.GetQueries(), level+1)
+		if err != nil {
+			return nil, err
+		}
+		return database.And(conditions...), nil
+	case *v2_user.SearchQuery_NotQuery:
+		condition, err := userQueryToCondition(userRepo, assertedType.NotQuery.GetQuery(), level+1)
+		if err != nil {
+			return nil, err
+		}
+		return database.Not(condition), nil
+	default:
+		return nil, zerrors.ThrowInvalidArgument(NewUnexpectedQueryTypeError(assertedType), "DOM-u8KfZn", "List.Query.Invalid")
+	}
+}
+
+// userStateFromProto maps the state of the API to the [UserState].
+// Deleted users are removed from the table, the deleted state therefore maps to [UserStateUnspecified] which never matches.
+func userStateFromProto(state v2_user.UserState) UserState {
+	switch state {
+	case v2_user.UserState_USER_STATE_ACTIVE:
+		return UserStateActive
+	case v2_user.UserState_USER_STATE_INACTIVE:
+		return UserStateInactive
+	case v2_user.UserState_USER_STATE_LOCKED:
+		return UserStateLocked
+	case v2_user.UserState_USER_STATE_INITIAL:
+		return UserStateInitial
+	case v2_user.UserState_USER_STATE_DELETED, v2_user.UserState_USER_STATE_UNSPECIFIED:
+		fallthrough
+	default:
+		return UserStateUnspecified
+	}
+}
+
+func userTypeFromProto(userType v2_user.Type) UserType {
+	switch userType {
+	case v2_user.Type_TYPE_HUMAN:
+		return UserTypeHuman
+	case v2_user.Type_TYPE_MACHINE:
+		return UserTypeMachine
+	case v2_user.Type_TYPE_UNSPECIFIED:
+		fallthrough
+	default:
+		return UserTypeUnspecified
+	}
+}
+
+// String implements [Querier].
+func (l *ListUsersQuery) String() string {
+	return "ListUsersQuery"
+}
+
+// Validate implements [Querier].
+// Listing the users of an organization requires the read permission on the organization.
+// Without an organization query the users of the instance are listed if the read permission is granted on the instance,
+// otherwise the result is restricted to the organization of the authenticated user if granted there,
+// or else to the authenticated user itself.
+func (l *ListUsersQuery) Validate(ctx context.Context, opts *InvokeOpts) (err error) {
+	if orgID := l.organizationID(); orgID != "" {
+		if authZErr := opts.Permissions.CheckOrganizationPermission(ctx, UserReadPermission, orgID); authZErr != nil {
+			return zerrors.ThrowPermissionDenied(authZErr, "DOM-k3XoBq", "permission denied")
+		}
+		return nil
+	}
+	if opts.Permissions.CheckInstancePermission(ctx, UserReadPermission) == nil {
+		return nil
+	}
+	ctxData := authz.GetCtxData(ctx)
+	if ctxData.OrgID != "" && opts.Permissions.CheckOrganizationPermission(ctx, UserReadPermission, ctxData.OrgID) == nil {
+		l.restrictedOrgID = ctxData.OrgID
+		return nil
+	}
+	if ctxData.UserID == "" {
+		return zerrors.ThrowPermissionDenied(nil, "DOM-k3XoBq", "permission denied")
+	}
+	l.restrictedUserID = ctxData.UserID
+	return nil
+}
+
+// organizationID returns the organization the users are filtered by.
+// Only top level queries are considered, as all of them must match.
+func (l *ListUsersQuery) organizationID() string {
+	for _, query := range l.Request.GetQueries() {
+		if orgQuery, ok := query.GetQuery().(*v2_user.SearchQuery_OrganizationIdQuery); ok {
+			return orgQuery.OrganizationIdQuery.GetOrganizationId()
+		}
+	}
+	return ""
+}
+
+var _ Querier[[]*User] = (*ListUsersQuery)(nil)
@@ -0,0 +1,419 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/backend/v3/domain"
+	domainmock "github.com/zitadel/zitadel/backend/v3/domain/mock"
+	"github.com/zitadel/zitadel/backend/v3/storage/database"
+	"github.com/zitadel/zitadel/backend/v3/storage/database/dbmock"
+	noopdb "github.com/zitadel/zitadel/backend/v3/storage/database/dialect/noop"
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/grpc/object/v2"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func TestListUsersQuery_sorting(t *testing.T) {
+	t.Parallel()
+	userRepo := domainmock.NewUserRepo(gomock.NewController(t))
+
+	tt := []struct {
+		name    string
+		request *user.ListUsersRequest
+
+		expectedSortingDirection database.OrderDirection
+		expectedOrderBy          database.Columns
+	}{
+		{
+			name: "sorting by user name asc",
+			request: &user.ListUsersRequest{
+				SortingColumn: user.UserFieldName_USER_FIELD_NAME_USER_NAME,
+				Query:         &object.ListQuery{Asc: true},
+			},
+			expectedSortingDirection: database.OrderDirectionAsc,
+			expectedOrderBy:          database.Columns{userRepo.UsernameColumn()},
+		},
+		{
+			name: "sorting by email desc",
+			request: &user.ListUsersRequest{
+				SortingColumn: user.UserFieldName_USER_FIELD_NAME_EMAIL,
+				Query:         &object.ListQuery{Asc: false},
+			},
+			expectedSortingDirection: database.OrderDirectionDesc,
+			expectedOrderBy:          database.Columns{userRepo.Human().EmailColumn()},
+		},
+		{
+			name: "sorting by creation date desc",
+			request: &user.ListUsersRequest{
+				SortingColumn: user.UserFieldName_USER_FIELD_NAME_CREATION_DATE,
+			},
+			expectedSortingDirection: database.OrderDirectionDesc,
+			expectedOrderBy:          database.Columns{userRepo.CreatedAtColumn()},
+		},
+		{
+			name: "unspecified sorting",
+			request: &user.ListUsersRequest{
+				SortingColumn: user.UserFieldName_USER_FIELD_NAME_UNSPECIFIED,
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Given
+			l := domain.NewListUsersQuery(tc.request)
+			opts := &database.QueryOpts{}
+
+			// Test
+			gotFunc := l.Sorting(userRepo)
+			gotFunc(opts)
+
+			// Verify
+			assert.Equal(t, tc.expectedOrderBy, opts.OrderBy)
+			assert.Equal(t, tc.expectedSortingDirection, opts.Ordering)
+		})
+	}
+}
+
+func TestListUsersQuery_Execute(t *testing.T) {
+	t.Parallel()
+	listErr := errors.New("list mock error")
+
+	tt := []struct {
+		testName string
+
+		userRepo          func(ctrl *gomock.Controller) domain.UserRepository
+		permissionChecker func(ctrl *gomock.Controller) domain.PermissionChecker
+
+		inputRequest *user.ListUsersRequest
+
+		expectedUsers []*domain.User
+		expectedTotal uint64
+		expectedError error
+	}{
+		{
+			testName: "when condition parsing fails should return error",
+			userRepo: func(ctrl *gomock.Controller) domain.UserRepository {
+				return domainmock.NewUserRepo(ctrl)
+			},
+			inputRequest: &user.ListUsersRequest{
+				Queries: []*user.SearchQuery{{Query: &user.SearchQuery_UserNameQuery{UserNameQuery: &user.UserNameQuery{
+					UserName: "gigi",
+					Method:   object.TextQueryMethod(99),
+				}}}},
+			},
+			expectedError: zerrors.ThrowInvalidArgument(nil, "OBJ-iBRBVe", "invalid text query method"),
+		},
+		{
+			testName: "when nesting is too deep should return error",
+			userRepo: func(ctrl *gomock.Controller) domain.UserRepository {
+				return domainmock.NewUserRepo(ctrl)
+			},
+			inputRequest: &user.ListUsersRequest{
+				Queries: []*user.SearchQuery{nestedNotQuery(22)},
+			},
+			expectedError: zerrors.ThrowInvalidArgument(nil, "DOM-hV4c8Q", "Errors.Query.TooManyNestingLevels"),
+		},
+		{
+			testName: "when listing users fails should return error",
+			userRepo: func(ctrl *gomock.Controller) domain.UserRepository {
+				userRepo := domainmock.NewUserRepo(ctrl)
+				userRepo.EXPECT().
+					List(
+						gomock.Any(),
+						gomock.Any(),
+						dbmock.QueryOptions(database.WithCondition(database.And(
+							userRepo.InstanceIDCondition("instance-1"),
+						))),
+						gomock.Any(),
+						dbmock.QueryOptions(database.WithLimit(0)),
+						dbmock.QueryOptions(database.WithOffset(0)),
+					).
+					Times(1).
+					Return(nil, listErr)
+				return userRepo
+			},
+			inputRequest:  &user.ListUsersRequest{},
+			expectedError: listErr,
+		},
+		{
+			testName: "when listing users succeeds should return expected users",
+			userRepo: func(ctrl *gomock.Controller) domain.UserRepository {
+				userRepo := domainmock.NewUserRepo(ctrl)
+				userRepo.EXPECT().
+					List(
+						gomock.Any(),
+						gomock.Any(),
+						dbmock.QueryOptions(database.WithCondition(database.And(
+							database.Or(
+								userRepo.IDCondition("user-1"),
+								userRepo.IDCondition("user-2"),
+							),
+							database.Not(userRepo.StateCondition(domain.UserStateLocked)),
+							userRepo.TypeCondition(domain.UserTypeHuman),
+							userRepo.Human().EmailCondition(database.TextOperationStartsWith, "gigi@"),
+							userRepo.InstanceIDCondition("instance-1"),
+						))),
+						dbmock.QueryOptions(database.WithOrderBy(database.OrderDirectionAsc, userRepo.UsernameColumn())),
+						dbmock.QueryOptions(database.WithLimit(2)),
+						dbmock.QueryOptions(database.WithOffset(1)),
+					).
+					Times(1).
+					Return([]*domain.User{{ID: "user-1"}, {ID: "user-2"}}, nil)
+				userRepo.EXPECT().
+					Count(
+						gomock.Any(),
+						gomock.Any(),
+						database.And(
+							database.Or(
+								userRepo.IDCondition("user-1"),
+								userRepo.IDCondition("user-2"),
+							),
+							database.Not(userRepo.StateCondition(domain.UserStateLocked)),
+							userRepo.TypeCondition(domain.UserTypeHuman),
+							userRepo.Human().EmailCondition(database.TextOperationStartsWith, "gigi@"),
+							userRepo.InstanceIDCondition("instance-1"),
+						),
+					).
+					Times(1).
+					Return(uint64(5), nil)
+				return userRepo
+			},
+			inputRequest: &user.ListUsersRequest{
+				Queries: []*user.SearchQuery{
+					{Query: &user.SearchQuery_InUserIdsQuery{InUserIdsQuery: &user.InUserIDQuery{UserIds: []string{"user-1", "user-2"}}}},
+					{Query: &user.SearchQuery_NotQuery{NotQuery: &user.NotQuery{Query: &user.SearchQuery{
+						Query: &user.SearchQuery_StateQuery{StateQuery: &user.StateQuery{State: user.UserState_USER_STATE_LOCKED}},
+					}}}},
+					{Query: &user.SearchQuery_TypeQuery{TypeQuery: &user.TypeQuery{Type: user.Type_TYPE_HUMAN}}},
+					{Query: &user.SearchQuery_EmailQuery{EmailQuery: &user.EmailQuery{
+						EmailAddress: "gigi@",
+						Method:       object.TextQueryMethod_TEXT_QUERY_METHOD_STARTS_WITH,
+					}}},
+				},
+				SortingColumn: user.UserFieldName_USER_FIELD_NAME_USER_NAME,
+				Query: &object.ListQuery{
+					Asc:    true,
+					Offset: 1,
+					Limit:  2,
+				},
+			},
+			expectedUsers: []*domain.User{{ID: "user-1"}, {ID: "user-2"}},
+			expectedTotal: 5,
+		},
+		{
+			testName: "when counting users fails should return error",
+			userRepo: func(ctrl *gomock.Controller) domain.UserRepository {
+				userRepo := domainmock.NewUserRepo(ctrl)
+				userRepo.EXPECT().
+					List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]*domain.User{{ID: "user-1"}}, nil)
+				userRepo.EXPECT().
+					Count(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(uint64(0), listErr)
+				return userRepo
+			},
+			inputRequest:  &user.ListUsersRequest{},
+			expectedUsers: []*domain.User{{ID: "user-1"}},
+			expectedError: listErr,
+		},
+		{
+			testName: "when instance permission is missing should restrict to organization of user",
+			userRepo: func(ctrl *gomock.Controller) domain.UserRepository {
+				userRepo := domainmock.NewUserRepo(ctrl)
+				condition := database.And(
+					userRepo.OrgIDCondition("org-1"),
+					userRepo.InstanceIDCondition("instance-1"),
+				)
+				userRepo.EXPECT().
+					List(gomock.Any(), gomock.Any(), dbmock.QueryOptions(database.WithCondition(condition)), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]*domain.User{{ID: "user-1"}}, nil)
+				userRepo.EXPECT().
+					Count(gomock.Any(), gomock.Any(), condition).
+					Times(1).
+					Return(uint64(1), nil)
+				return userRepo
+			},
+			permissionChecker: func(ctrl *gomock.Controller) domain.PermissionChecker {
+				permChecker := domainmock.NewMockPermissionChecker(ctrl)
+				permChecker.EXPECT().
+					CheckInstancePermission(gomock.Any(), domain.UserReadPermission).
+					Times(1).
+					Return(errors.New("permission error"))
+				permChecker.EXPECT().
+					CheckOrganizationPermission(gomock.Any(), domain.UserReadPermission, "org-1").
+					Times(1).
+					Return(nil)
+				return permChecker
+			},
+			inputRequest:  &user.ListUsersRequest{},
+			expectedUsers: []*domain.User{{ID: "user-1"}},
+			expectedTotal: 1,
+		},
+		{
+			testName: "when organization permission is missing should restrict to user itself",
+			userRepo: func(ctrl *gomock.Controller) domain.UserRepository {
+				userRepo := domainmock.NewUserRepo(ctrl)
+				condition := database.And(
+					userRepo.IDCondition("user-1"),
+					userRepo.InstanceIDCondition("instance-1"),
+				)
+				userRepo.EXPECT().
+					List(gomock.Any(), gomock.Any(), dbmock.QueryOptions(database.WithCondition(condition)), gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(1).
+					Return([]*domain.User{{ID: "user-1"}}, nil)
+				userRepo.EXPECT().
+					Count(gomock.Any(), gomock.Any(), condition).
+					Times(1).
+					Return(uint64(1), nil)
+				return userRepo
+			},
+			permissionChecker: func(ctrl *gomock.Controller) domain.PermissionChecker {
+				permChecker := domainmock.NewMockPermissionChecker(ctrl)
+				permChecker.EXPECT().
+					CheckInstancePermission(gomock.Any(), domain.UserReadPermission).
+					Times(1).
+					Return(errors.New("permission error"))
+				permChecker.EXPECT().
+					CheckOrganizationPermission(gomock.Any(), domain.UserReadPermission, "org-1").
+					Times(1).
+					Return(errors.New("permission error"))
+				return permChecker
+			},
+			inputRequest:  &user.ListUsersRequest{},
+			expectedUsers: []*domain.User{{ID: "user-1"}},
+			expectedTotal: 1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.testName, func(t *testing.T) {
+			t.Parallel()
+			// Given
+			ctx := authz.NewMockContext("instance-1", "org-1", "user-1")
+			ctrl := gomock.NewController(t)
+			q := domain.NewListUsersQuery(tc.inputRequest)
+			opts := &domain.InvokeOpts{}
+			domain.WithQueryExecutor(new(noopdb.Pool))(opts)
+			domain.WithUserRepo(tc.userRepo(ctrl))(opts)
+			if tc.permissionChecker != nil {
+				opts.Invoker = domain.NewValidatorInvoker(nil)
+				domain.WithPermissionChecker(tc.permissionChecker(ctrl))(opts)
+			}
+
+			// Test
+			err := opts.Invoke(ctx, q)
+
+			// Verify
+			assert.Equal(t, tc.expectedError, err)
+			assert.ElementsMatch(t, tc.expectedUsers, q.Result())
+			assert.Equal(t, tc.expectedTotal, q.Total())
+		})
+	}
+}
+
+func TestListUsersQuery_Validate(t *testing.T) {
+	t.Parallel()
+	permissionErr := errors.New("permission error")
+	tt := []struct {
+		name              string
+		inputRequest      *user.ListUsersRequest
+		permissionChecker func(ctrl *gomock.Controller) domain.PermissionChecker
+		expectedError     error
+	}{
+		{
+			name:         "when user is unauthenticated and missing instance permission should return permission denied",
+			inputRequest: &user.ListUsersRequest{},
+			permissionChecker: func(ctrl *gomock.Controller) domain.PermissionChecker {
+				permChecker := domainmock.NewMockPermissionChecker(ctrl)
+				permChecker.EXPECT().
+					CheckInstancePermission(gomock.Any(), domain.UserReadPermission).
+					Times(1).
+					Return(permissionErr)
+				return permChecker
+			},
+			expectedError: zerrors.ThrowPermissionDenied(nil, "DOM-k3XoBq", "permission denied"),
+		},
+		{
+			name:         "when valid instance permission should return no error",
+			inputRequest: &user.ListUsersRequest{},
+			permissionChecker: func(ctrl *gomock.Controller) domain.PermissionChecker {
+				permChecker := domainmock.NewMockPermissionChecker(ctrl)
+				permChecker.EXPECT().
+					CheckInstancePermission(gomock.Any(), domain.UserReadPermission).
+					Times(1).
+					Return(nil)
+				return permChecker
+			},
+		},
+		{
+			name: "when user is missing organization permission should return permission denied",
+			inputRequest: &user.ListUsersRequest{
+				Queries: []*user.SearchQuery{
+					{Query: &user.SearchQuery_OrganizationIdQuery{OrganizationIdQuery: &user.OrganizationIdQuery{OrganizationId: "org-2"}}},
+				},
+			},
+			permissionChecker: func(ctrl *gomock.Controller) domain.PermissionChecker {
+				permChecker := domainmock.NewMockPermissionChecker(ctrl)
+				permChecker.EXPECT().
+					CheckOrganizationPermission(gomock.Any(), domain.UserReadPermission, "org-2").
+					Times(1).
+					Return(permissionErr)
+				return permChecker
+			},
+			expectedError: zerrors.ThrowPermissionDenied(permissionErr, "DOM-k3XoBq", "permission denied"),
+		},
+		{
+			name: "when valid organization permission should return no error",
+			inputRequest: &user.ListUsersRequest{
+				Queries: []*user.SearchQuery{
+					{Query: &user.SearchQuery_TypeQuery{TypeQuery: &user.TypeQuery{Type: user.Type_TYPE_HUMAN}}},
+					{Query: &user.SearchQuery_OrganizationIdQuery{OrganizationIdQuery: &user.OrganizationIdQuery{OrganizationId: "org-1"}}},
+				},
+			},
+			permissionChecker: func(ctrl *gomock.Controller) domain.PermissionChecker {
+				permChecker := domainmock.NewMockPermissionChecker(ctrl)
+				permChecker.EXPECT().
+					CheckOrganizationPermission(gomock.Any(), domain.UserReadPermission, "org-1").
+					Times(1).
+					Return(nil)
+				return permChecker
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Given
+			l := domain.NewListUsersQuery(tc.inputRequest)
+			opts := &domain.InvokeOpts{
+				Permissions: tc.permissionChecker(gomock.NewController(t)),
+			}
+
+			// Test
+			err := l.Validate(context.Background(), opts)
+
+			// Verify
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+func nestedNotQuery(depth int) *user.SearchQuery {
+	query := &user.SearchQuery{Query: &user.SearchQuery_StateQuery{StateQuery: &user.StateQuery{State: user.UserState_USER_STATE_ACTIVE}}}
+	for range depth {
+		query = &user.SearchQuery{Query: &user.SearchQuery_NotQuery{NotQuery: &user.NotQuery{Query: query}}}
+	}
+	return query
+}
@@ -88,19 +88,50 @@ func (c Changes) IsOnColumn(col Column) bool {
 }
 
 // Write implements [Change].
+// [CTEChange]s without a change on the updated table are skipped.
 func (m Changes) Write(builder *StatementBuilder) error {
-	for i, change := range m {
-		if i > 0 {
+	var written int
+	for _, change := range m {
+		if !writesColumn(change) {
+			continue
+		}
+		if written > 0 {
 			builder.WriteString(", ")
 		}
 		err := change.Write(builder)
 		if err != nil {
 			return err
 		}
+		written++
 	}
 	return nil
 }
 
+// CTEChanges returns all [CTEChange]s contained in the changes, including nested [Changes].
+func (m Changes) CTEChanges() []CTEChange {
+	var ctes []CTEChange
+	for _, change := range m {
+		switch c := change.(type) {
+		case CTEChange:
+			ctes = append(ctes, c)
+		case Changes:
+			ctes = append(ctes, c.CTEChanges()...)
+		}
+	}
+	return ctes
+}
+
+// writesColumn reports whether the change writes at least one column of the updated table.
+func writesColumn(change Change) bool {
+	switch c := change.(type) {
+	case *cteChange:
+		return c.change != nil
+	case Changes:
+		return slices.ContainsFunc(c, writesColumn)
+	}
+	return true
+}
+
 // Matches implements [gomock.Matcher].
 func (c Changes) Matches(x any) bool {
 	toMatch, ok := x.(*Changes)
@@ -61,6 +61,29 @@ func TestChangeWrite(t *testing.T) {
 				stmt: "counter = counter + 1",
 			},
 		},
+		{
+			name: "multiple changes skip cte without change",
+			change: NewChanges(
+				NewCTEChange(func(builder *StatementBuilder) {
+					builder.WriteString("SELECT 1")
+				}, nil),
+				NewChange(NewColumn("table", "column1"), "value1"),
+				NewChanges(
+					NewCTEChange(func(builder *StatementBuilder) {
+						builder.WriteString("SELECT 2")
+					}, nil),
+				),
+				NewCTEChange(func(builder *StatementBuilder) {
+					builder.WriteString("SELECT 3")
+				}, func(string) Change {
+					return NewChange(NewColumn("table", "column2"), "value2")
+				}),
+			),
+			want: want{
+				stmt: "column1 = $1, column2 = $2",
+				args: []any{"value1", "value2"},
+			},
+		},
 	} {
 		t.Run(test.name, func(t *testing.T) {
 			var builder StatementBuilder
@@ -257,3 +280,23 @@ func TestCTEChange(t *testing.T) {
 		})
 	}
 }
+
+func TestChangesCTEChanges(t *testing.T) {
+	cte1 := NewCTEChange(func(builder *StatementBuilder) {
+		builder.WriteString("SELECT 1")
+	}, nil)
+	cte2 := NewCTEChange(func(builder *StatementBuilder) {
+		builder.WriteString("SELECT 2")
+	}, nil)
+
+	changes := Changes{
+		NewChange(NewColumn("table", "column"), "value"),
+		cte1,
+		NewChanges(
+			NewIncrementColumnChange(NewColumn("table", "counter")),
+			cte2,
+		),
+	}
+	assert.Equal(t, []CTEChange{cte1, cte2}, changes.CTEChanges())
+	assert.Empty(t, Changes{NewChange(NewColumn("table", "column"), "value")}.CTEChanges())
+}
@@ -199,6 +199,44 @@ func (i isNotNull) IsRestrictingColumn(col Column) bool {
 
 var _ Condition = (*isNotNull)(nil)
 
+type not struct {
+	condition Condition
+}
+
+// Matches implements [Condition].
+func (n *not) Matches(x any) bool {
+	toMatch, ok := x.(*not)
+	if !ok {
+		return false
+	}
+	return n.condition.Matches(toMatch.condition)
+}
+
+// String implements [Condition].
+func (n *not) String() string {
+	return "database.not"
+}
+
+// Write implements [Condition].
+func (n not) Write(builder *StatementBuilder) {
+	builder.WriteString("NOT (")
+	n.condition.Write(builder)
+	builder.WriteString(")")
+}
+
+// Not negates the given condition.
+func Not(condition Condition) *not {
+	return &not{condition: condition}
+}
+
+// IsRestrictingColumn implements [Condition].
+// A negated condition never restricts a column.
+func (n not) IsRestrictingColumn(col Column) bool {
+	return false
+}
+
+var _ Condition = (*not)(nil)
+
 type valueCondition struct {
 	write func(builder *StatementBuilder)
 	col   Column
@@ -91,6 +91,17 @@ func TestWrite(t *testing.T) {
 				args: nil,
 			},
 		},
+		{
+			name: "not condition",
+			cond: Not(And(
+				NewColumnCondition(NewColumn("table", "column1"), NewColumn("other_table", "column2")),
+				IsNull(NewColumn("table", "column3")),
+			)),
+			want: want{
+				stmt: "NOT ((table.column1 = other_table.column2 AND table.column3 IS NULL))",
+				args: nil,
+			},
+		},
 		{
 			name: "text condition",
 			cond: NewTextCondition(NewColumn("table", "column1"), TextOperationEqual, "some text"),
@@ -228,6 +239,12 @@ func TestIsRestrictingColumn(t *testing.T) {
 			cond: IsNotNull(NewColumn("table", "column1")),
 			want: false,
 		},
+		{
+			name: "not never restricts",
+			col:  NewColumn("table", "column1"),
+			cond: Not(NewColumnCondition(NewColumn("table", "column1"), NewColumn("other_table", "column2"))),
+			want: false,
+		},
 		{
 			name: "exists with restricting column",
 			col:  NewColumn("table", "column1"),
@@ -0,0 +1,16 @@
+package migration
+
+import (
+	_ "embed"
+)
+
+var (
+	//go:embed 010_users_table/up.sql
+	up010UsersTable string
+	//go:embed 010_users_table/down.sql
+	down010UsersTable string
+)
+
+func init() {
+	registerSQLMigration(10, up010UsersTable, down010UsersTable)
+}
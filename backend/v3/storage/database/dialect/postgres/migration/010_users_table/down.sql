@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS zitadel.user_metadata;
+DROP TABLE IF EXISTS zitadel.users;
+DROP TYPE IF EXISTS zitadel.user_state;
+DROP TYPE IF EXISTS zitadel.user_type;
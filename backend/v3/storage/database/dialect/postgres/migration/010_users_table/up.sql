@@ -0,0 +1,89 @@
+CREATE TYPE zitadel.user_type AS ENUM (
+    'human'
+    , 'machine'
+);
+
+CREATE TYPE zitadel.user_state AS ENUM (
+    'active'
+    , 'inactive'
+    , 'locked'
+    , 'initial'
+);
+
+CREATE TABLE zitadel.users(
+    instance_id TEXT NOT NULL
+    , organization_id TEXT NOT NULL
+    , id TEXT NOT NULL CHECK (id <> '')
+    , username TEXT NOT NULL CHECK (username <> '')
+    , type zitadel.user_type NOT NULL
+    , state zitadel.user_state NOT NULL
+
+    , created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+    , updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+
+    -- human users
+    , first_name TEXT
+    , last_name TEXT
+    , nickname TEXT
+    , display_name TEXT
+    , preferred_language TEXT
+    , gender SMALLINT
+    , avatar_key TEXT
+    , multifactor_initialization_skipped_at TIMESTAMPTZ
+
+    , email TEXT
+    , email_verified_at TIMESTAMPTZ
+    , phone TEXT
+    , phone_verified_at TIMESTAMPTZ
+
+    , password TEXT
+    , password_change_required BOOLEAN NOT NULL DEFAULT FALSE
+    , password_verified_at TIMESTAMPTZ
+    , password_failed_attempts SMALLINT NOT NULL DEFAULT 0
+
+    -- machine users
+    , name TEXT
+    , description TEXT
+    , secret TEXT
+    , access_token_type SMALLINT
+
+    , PRIMARY KEY (instance_id, id)
+
+    , FOREIGN KEY (instance_id, organization_id) REFERENCES zitadel.organizations(instance_id, id) ON DELETE CASCADE
+
+    , CONSTRAINT human_has_no_machine_fields CHECK (type <> 'human' OR (name IS NULL AND description IS NULL AND secret IS NULL AND access_token_type IS NULL))
+    , CONSTRAINT machine_has_no_human_fields CHECK (type <> 'machine' OR (first_name IS NULL AND last_name IS NULL AND email IS NULL AND phone IS NULL AND password IS NULL))
+);
+
+CREATE INDEX idx_users_organization ON zitadel.users(instance_id, organization_id);
+CREATE INDEX idx_users_username ON zitadel.users(instance_id, LOWER(username));
+CREATE INDEX idx_users_email ON zitadel.users(instance_id, LOWER(email)) WHERE email IS NOT NULL;
+
+CREATE TRIGGER trg_set_updated_at_users
+  BEFORE UPDATE ON zitadel.users
+  FOR EACH ROW
+  WHEN (NEW.updated_at IS NULL)
+  EXECUTE FUNCTION zitadel.set_updated_at();
+
+CREATE TABLE zitadel.user_metadata (
+    instance_id TEXT NOT NULL
+    , user_id TEXT NOT NULL
+    , key TEXT NOT NULL CHECK (key <> '')
+    , value BYTEA NOT NULL
+
+    , created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+    , updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+
+    , PRIMARY KEY (instance_id, user_id, key)
+
+    , CONSTRAINT fk_user_metadata_user FOREIGN KEY (instance_id, user_id) REFERENCES zitadel.users (instance_id, id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_user_metadata_key ON zitadel.user_metadata (key);
+CREATE INDEX idx_user_metadata_value ON zitadel.user_metadata (sha256(value));
+
+CREATE TRIGGER trg_set_updated_at_user_metadata
+  BEFORE INSERT OR UPDATE ON zitadel.user_metadata
+  FOR EACH ROW
+  WHEN (NEW.updated_at IS NULL)
+  EXECUTE FUNCTION zitadel.set_updated_at();
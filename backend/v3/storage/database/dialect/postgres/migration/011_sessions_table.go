@@ -0,0 +1,16 @@
+package migration
+
+import (
+	_ "embed"
+)
+
+var (
+	//go:embed 011_sessions_table/up.sql
+	up011SessionsTable string
+	//go:embed 011_sessions_table/down.sql
+	down011SessionsTable string
+)
+
+func init() {
+	registerSQLMigration(11, up011SessionsTable, down011SessionsTable)
+}
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS zitadel.session_metadata;
+DROP TABLE IF EXISTS zitadel.session_factors;
+DROP TABLE IF EXISTS zitadel.sessions;
+DROP FUNCTION IF EXISTS zitadel.set_session_expiration();
+DROP TABLE IF EXISTS zitadel.session_user_agents;
@@ -0,0 +1,95 @@
+CREATE TABLE zitadel.session_user_agents (
+    instance_id TEXT NOT NULL
+    , fingerprint_id TEXT NOT NULL CHECK (fingerprint_id <> '')
+    , description TEXT
+    , ip INET
+    , headers JSONB
+
+    , PRIMARY KEY (instance_id, fingerprint_id)
+);
+
+CREATE TABLE zitadel.sessions (
+    instance_id TEXT NOT NULL
+    , id TEXT NOT NULL CHECK (id <> '')
+    , token TEXT
+    , lifetime INTERVAL
+    -- expiration is computed based on the lifetime, see trg_set_session_expiration
+    , expiration TIMESTAMPTZ
+    , user_id TEXT
+    , user_agent_id TEXT
+
+    , created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+    , updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+
+    , PRIMARY KEY (instance_id, id)
+
+    , FOREIGN KEY (instance_id, user_id) REFERENCES zitadel.users(instance_id, id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_sessions_user ON zitadel.sessions(instance_id, user_id) WHERE user_id IS NOT NULL;
+CREATE INDEX idx_sessions_user_agent ON zitadel.sessions(instance_id, user_agent_id) WHERE user_agent_id IS NOT NULL;
+
+CREATE TRIGGER trg_set_updated_at_sessions
+  BEFORE UPDATE ON zitadel.sessions
+  FOR EACH ROW
+  WHEN (NEW.updated_at IS NULL)
+  EXECUTE FUNCTION zitadel.set_updated_at();
+
+-- the expiration is relative to the time the lifetime was set
+-- updated_at is NULL if it is set by trg_set_updated_at_sessions
+CREATE OR REPLACE FUNCTION zitadel.set_session_expiration()
+RETURNS TRIGGER AS $$
+BEGIN
+  IF NEW.lifetime IS NULL OR NEW.lifetime <= INTERVAL '0' THEN
+    NEW.expiration := NULL;
+  ELSE
+    NEW.expiration := COALESCE(NEW.updated_at, NOW()) + NEW.lifetime;
+  END IF;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_set_session_expiration
+  BEFORE INSERT OR UPDATE OF lifetime ON zitadel.sessions
+  FOR EACH ROW
+  EXECUTE FUNCTION zitadel.set_session_expiration();
+
+CREATE TABLE zitadel.session_factors (
+    instance_id TEXT NOT NULL
+    , session_id TEXT NOT NULL
+    , type SMALLINT NOT NULL CHECK (type > 0)
+
+    , last_challenged_at TIMESTAMPTZ
+    , last_verified_at TIMESTAMPTZ
+    , last_failed_at TIMESTAMPTZ
+    -- challenge contains the type specific data of the last challenge
+    , challenge JSONB
+    -- payload contains the type specific data of the last verification
+    , payload JSONB
+
+    , PRIMARY KEY (instance_id, session_id, type)
+
+    , FOREIGN KEY (instance_id, session_id) REFERENCES zitadel.sessions(instance_id, id) ON DELETE CASCADE
+);
+
+CREATE TABLE zitadel.session_metadata (
+    instance_id TEXT NOT NULL
+    , session_id TEXT NOT NULL
+    , key TEXT NOT NULL CHECK (key <> '')
+    , value BYTEA NOT NULL
+
+    , created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+    , updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+
+    , PRIMARY KEY (instance_id, session_id, key)
+
+    , CONSTRAINT fk_session_metadata_session FOREIGN KEY (instance_id, session_id) REFERENCES zitadel.sessions (instance_id, id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_session_metadata_key ON zitadel.session_metadata (key);
+
+CREATE TRIGGER trg_set_updated_at_session_metadata
+  BEFORE INSERT OR UPDATE ON zitadel.session_metadata
+  FOR EACH ROW
+  WHEN (NEW.updated_at IS NULL)
+  EXECUTE FUNCTION zitadel.set_updated_at();
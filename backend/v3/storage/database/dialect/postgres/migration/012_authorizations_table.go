@@ -0,0 +1,16 @@
+package migration
+
+import (
+	_ "embed"
+)
+
+var (
+	//go:embed 012_authorizations_table/up.sql
+	up012AuthorizationsTable string
+	//go:embed 012_authorizations_table/down.sql
+	down012AuthorizationsTable string
+)
+
+func init() {
+	registerSQLMigration(12, up012AuthorizationsTable, down012AuthorizationsTable)
+}
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS zitadel.authorization_roles;
+DROP TABLE IF EXISTS zitadel.authorizations;
+DROP TYPE IF EXISTS zitadel.authorization_state;
@@ -0,0 +1,48 @@
+CREATE TYPE zitadel.authorization_state AS ENUM (
+    'active',
+    'inactive'
+);
+
+CREATE TABLE zitadel.authorizations(
+    instance_id TEXT NOT NULL
+    , id TEXT NOT NULL CHECK (id <> '')
+
+    , organization_id TEXT NOT NULL
+    , user_id TEXT NOT NULL
+    , project_id TEXT NOT NULL
+    , project_grant_id TEXT
+
+    , created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+    , updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+
+    , state zitadel.authorization_state NOT NULL
+
+    , PRIMARY KEY (instance_id, id)
+
+    , FOREIGN KEY (instance_id, organization_id) REFERENCES zitadel.organizations(instance_id, id) ON DELETE CASCADE
+    , FOREIGN KEY (instance_id, user_id) REFERENCES zitadel.users(instance_id, id) ON DELETE CASCADE
+    , FOREIGN KEY (instance_id, project_id) REFERENCES zitadel.projects(instance_id, id) ON DELETE CASCADE
+    , FOREIGN KEY (instance_id, project_grant_id) REFERENCES zitadel.project_grants(instance_id, id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_authorizations_user_project ON zitadel.authorizations(instance_id, user_id, project_id, project_grant_id) NULLS NOT DISTINCT;
+CREATE INDEX idx_authorizations_project ON zitadel.authorizations(instance_id, project_id);
+
+CREATE TRIGGER trg_set_updated_at_authorizations
+  BEFORE UPDATE ON zitadel.authorizations
+  FOR EACH ROW
+  WHEN (NEW.updated_at IS NULL)
+  EXECUTE FUNCTION zitadel.set_updated_at();
+
+CREATE TABLE zitadel.authorization_roles(
+    instance_id TEXT NOT NULL
+    , authorization_id TEXT NOT NULL
+    , key TEXT NOT NULL CHECK (key <> '')
+
+    , project_id TEXT NOT NULL
+
+    , PRIMARY KEY (instance_id, authorization_id, key)
+
+    , FOREIGN KEY (instance_id, authorization_id) REFERENCES zitadel.authorizations(instance_id, id) ON DELETE CASCADE
+    , FOREIGN KEY (instance_id, project_id, key) REFERENCES zitadel.project_roles(instance_id, project_id, key) ON DELETE CASCADE
+);
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/zitadel/zitadel/backend/v3/storage/database"
+)
+
+var errReaderPoolMigration = errors.New("migrations cannot be executed on the reader pool")
+
+// readerPool sends each statement to the pool returned by the reader.
+// It is used for read-only queries which tolerate bounded staleness,
+// the reader returns one of the up-to-date replicas or the primary.
+type readerPool struct {
+	reader func() *pgxpool.Pool
+}
+
+var _ database.Pool = (*readerPool)(nil)
+
+// PGxReaderPool returns a [database.Pool] which sends each statement to the pool returned by reader.
+func PGxReaderPool(reader func() *pgxpool.Pool) *readerPool {
+	return &readerPool{
+		reader: reader,
+	}
+}
+
+func (p *readerPool) pool() *pgxPool {
+	return &pgxPool{Pool: p.reader()}
+}
+
+// Acquire implements [database.Pool].
+func (p *readerPool) Acquire(ctx context.Context) (database.Connection, error) {
+	return p.pool().Acquire(ctx)
+}
+
+// Query implements [database.Pool].
+func (p *readerPool) Query(ctx context.Context, sql string, args ...any) (database.Rows, error) {
+	return p.pool().Query(ctx, sql, args...)
+}
+
+// QueryRow implements [database.Pool].
+func (p *readerPool) QueryRow(ctx context.Context, sql string, args ...any) database.Row {
+	return p.pool().QueryRow(ctx, sql, args...)
+}
+
+// Exec implements [database.Pool].
+func (p *readerPool) Exec(ctx context.Context, sql string, args ...any) (int64, error) {
+	return p.pool().Exec(ctx, sql, args...)
+}
+
+// Begin implements [database.Pool].
+// The transaction is always read-only.
+func (p *readerPool) Begin(ctx context.Context, opts *database.TransactionOptions) (database.Transaction, error) {
+	readOnly := database.TransactionOptions{AccessMode: database.AccessModeReadOnly}
+	if opts != nil {
+		readOnly.IsolationLevel = opts.IsolationLevel
+	}
+	return p.pool().Begin(ctx, &readOnly)
+}
+
+// Close implements [database.Pool].
+// The pools are owned and closed by the primary database client.
+func (p *readerPool) Close(_ context.Context) error {
+	return nil
+}
+
+// Ping implements [database.Pool].
+func (p *readerPool) Ping(ctx context.Context) error {
+	return p.pool().Ping(ctx)
+}
+
+// Migrate implements [database.Migrator].
+func (p *readerPool) Migrate(_ context.Context) error {
+	return errReaderPoolMigration
+}
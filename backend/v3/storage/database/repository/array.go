@@ -35,3 +35,35 @@ func (a *JSONArray[T]) Scan(src any) (err error) {
 	}
 	return nil
 }
+
+// JSONObject scans a json object into Value.
+// Value stays nil if the source is NULL.
+type JSONObject[T any] struct {
+	Value *T
+}
+
+func (o *JSONObject[T]) Scan(src any) (err error) {
+	var rawJSON []byte
+	switch s := src.(type) {
+	case string:
+		if len(s) == 0 {
+			return nil
+		}
+		rawJSON = []byte(s)
+	case []byte:
+		if len(s) == 0 {
+			return nil
+		}
+		rawJSON = s
+	case nil:
+		return nil
+	default:
+		return ErrScanSource
+	}
+	o.Value = new(T)
+	err = json.Unmarshal(rawJSON, o.Value)
+	if err != nil {
+		return database.NewScanError(err)
+	}
+	return nil
+}
@@ -115,3 +115,72 @@ func TestJSONArray_Scan(t *testing.T) {
 		})
 	}
 }
+
+func TestJSONObject_Scan(t *testing.T) {
+	type want struct {
+		err error
+		res *testObject
+	}
+	tests := []struct {
+		name string
+		src  any
+		want want
+	}{
+		{
+			name: "nil",
+			src:  nil,
+			want: want{
+				err: nil,
+				res: nil,
+			},
+		},
+		{
+			name: "[]byte with data",
+			src:  []byte(`{"id":"1","number":1,"active":true}`),
+			want: want{
+				err: nil,
+				res: &testObject{ID: "1", Number: 1, Active: true},
+			},
+		},
+		{
+			name: "empty []byte",
+			src:  []byte{},
+			want: want{
+				err: nil,
+				res: nil,
+			},
+		},
+		{
+			name: "string with data",
+			src:  `{"id":"1","number":1,"active":true}`,
+			want: want{
+				err: nil,
+				res: &testObject{ID: "1", Number: 1, Active: true},
+			},
+		},
+		{
+			name: "empty string",
+			src:  "",
+			want: want{
+				err: nil,
+				res: nil,
+			},
+		},
+		{
+			name: "wrong type",
+			src:  []int{1, 2, 3},
+			want: want{
+				err: repository.ErrScanSource,
+				res: nil,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var o repository.JSONObject[testObject]
+			gotErr := o.Scan(tt.src)
+			require.ErrorIs(t, gotErr, tt.want.err)
+			require.Equal(t, tt.want.res, o.Value)
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/backend/v3/domain"
+	"github.com/zitadel/zitadel/backend/v3/storage/database"
+)
+
+// -------------------------------------------------------------
+// repository
+// -------------------------------------------------------------
+
+var _ domain.AuthorizationRepository = (*authorization)(nil)
+
+type authorization struct{}
+
+// AuthorizationRepository manages authorizations of users on projects.
+func AuthorizationRepository() domain.AuthorizationRepository {
+	return new(authorization)
+}
+
+func (a authorization) Get(ctx context.Context, client database.QueryExecutor, opts ...database.QueryOption) (*domain.Authorization, error) {
+	opts = append(opts,
+		database.WithGroupBy(a.InstanceIDColumn(), a.IDColumn()),
+	)
+
+	builder, err := a.prepareQuery(opts)
+	if err != nil {
+		return nil, err
+	}
+	return getOne[domain.Authorization](ctx, client, builder)
+}
+
+func (a authorization) List(ctx context.Context, client database.QueryExecutor, opts ...database.QueryOption) ([]*domain.Authorization, error) {
+	opts = append(opts,
+		database.WithGroupBy(a.InstanceIDColumn(), a.IDColumn()),
+	)
+
+	builder, err := a.prepareQuery(opts)
+	if err != nil {
+		return nil, err
+	}
+	return getMany[domain.Authorization](ctx, client, builder)
+}
+
+const insertAuthorizationRolesStmt = `WITH added_roles AS (
+	INSERT INTO zitadel.authorization_roles (
+		instance_id, authorization_id, project_id, key
+	)
+	VALUES ($2, $3, $4, unnest($1::text[]))
+) `
+
+func (a authorization) Create(ctx context.Context, client database.QueryExecutor, authorization *domain.Authorization) error {
+	var (
+		createdAt, updatedAt any = database.DefaultInstruction, database.DefaultInstruction
+	)
+	if !authorization.CreatedAt.IsZero() {
+		createdAt = authorization.CreatedAt
+	}
+	if !authorization.UpdatedAt.IsZero() {
+		updatedAt = authorization.UpdatedAt
+	}
+
+	// separate statement to add roles to the authorization
+	builder := database.NewStatementBuilder(insertAuthorizationRolesStmt, authorization.RoleKeys)
+
+	builder.WriteString(`INSERT INTO ` + a.qualifiedTableName() + ` (instance_id, id, project_id, organization_id, user_id, project_grant_id, state, created_at, updated_at) VALUES ( `)
+	builder.WriteArgs(
+		authorization.InstanceID,
+		authorization.ID,
+		authorization.ProjectID,
+		authorization.OrganizationID,
+		authorization.UserID,
+		nullIfZero(authorization.ProjectGrantID),
+		authorization.State,
+		createdAt,
+		updatedAt,
+	)
+	builder.WriteString(` ) RETURNING created_at, updated_at`)
+
+	return client.QueryRow(ctx, builder.String(), builder.Args()...).
+		Scan(&authorization.CreatedAt, &authorization.UpdatedAt)
+}
+
+const queryUpdateAuthorizationRoleStmt = `SELECT instance_id, id, project_id, $1::text[] as keys from zitadel.authorizations`
+
+const updateAuthorizationRoleStmt = `removed_roles AS (
+    DELETE FROM zitadel.authorization_roles as ar
+    USING auth
+    WHERE auth.instance_id = ar.instance_id AND auth.id = ar.authorization_id AND NOT(ar.key = ANY(auth.keys))
+), added_roles AS (
+	INSERT INTO zitadel.authorization_roles (
+		instance_id, authorization_id, project_id, key
+	)
+	SELECT instance_id, id, project_id, unnest(auth.keys) FROM auth
+	ON CONFLICT (instance_id, authorization_id, key) DO NOTHING
+)
+UPDATE zitadel.authorizations SET `
+
+const updateAuthorizationRoleStmtWhere = ` FROM auth
+WHERE auth.instance_id = authorizations.instance_id
+  AND auth.id = authorizations.id`
+
+func (a authorization) Update(ctx context.Context, client database.QueryExecutor, condition database.Condition, roleKeys []string, changes ...database.Change) (int64, error) {
+	// if no role keys set we only have to update the authorizations table
+	if roleKeys == nil {
+		return updateOne(ctx, client, a, condition, changes...)
+	}
+
+	// if you want to update the roles you have to have the primary key, otherwise multiple authorizations get updated
+	if err := checkPKCondition(a, condition); err != nil {
+		return 0, err
+	}
+	if !database.Changes(changes).IsOnColumn(a.UpdatedAtColumn()) {
+		changes = append(changes, database.NewChange(a.UpdatedAtColumn(), database.NullInstruction))
+	}
+
+	// the statement begins with getting the authorization we want to update
+	builder := database.NewStatementBuilder("WITH auth AS (")
+	builder.WriteString(queryUpdateAuthorizationRoleStmt)
+	builder.AppendArg(roleKeys)
+	writeCondition(builder, condition)
+	builder.WriteString(" ), ")
+
+	// now we add the logic to do the required changes based on the given authorization
+	builder.WriteString(updateAuthorizationRoleStmt)
+	database.Changes(changes).Write(builder)
+	builder.WriteString(updateAuthorizationRoleStmtWhere)
+
+	return client.Exec(ctx, builder.String(), builder.Args()...)
+}
+
+func (a authorization) Delete(ctx context.Context, client database.QueryExecutor, condition database.Condition) (int64, error) {
+	return deleteOne(ctx, client, a, condition)
+}
+
+// -------------------------------------------------------------
+// changes
+// -------------------------------------------------------------
+
+func (a authorization) SetUpdatedAt(updatedAt time.Time) database.Change {
+	return database.NewChange(a.UpdatedAtColumn(), updatedAt)
+}
+
+func (a authorization) SetState(state domain.AuthorizationState) database.Change {
+	return database.NewChange(a.StateColumn(), state)
+}
+
+// -------------------------------------------------------------
+// conditions
+// -------------------------------------------------------------
+
+func (a authorization) PrimaryKeyCondition(instanceID, authorizationID string) database.Condition {
+	return database.And(
+		a.InstanceIDCondition(instanceID),
+		a.IDCondition(authorizationID),
+	)
+}
+
+func (a authorization) InstanceIDCondition(instanceID string) database.Condition {
+	return database.NewTextCondition(a.InstanceIDColumn(), database.TextOperationEqual, instanceID)
+}
+
+func (a authorization) IDCondition(authorizationID string) database.Condition {
+	return database.NewTextCondition(a.IDColumn(), database.TextOperationEqual, authorizationID)
+}
+
+func (a authorization) OrganizationIDCondition(organizationID string) database.Condition {
+	return database.NewTextCondition(a.OrganizationIDColumn(), database.TextOperationEqual, organizationID)
+}
+
+func (a authorization) UserIDCondition(userID string) database.Condition {
+	return database.NewTextCondition(a.UserIDColumn(), database.TextOperationEqual, userID)
+}
+
+func (a authorization) ProjectIDCondition(projectID string) database.Condition {
+	return database.NewTextCondition(a.ProjectIDColumn(), database.TextOperationEqual, projectID)
+}
+
+func (a authorization) ProjectGrantIDCondition(projectGrantID string) database.Condition {
+	return database.NewTextCondition(a.ProjectGrantIDColumn(), database.TextOperationEqual, projectGrantID)
+}
+
+func (a authorization) StateCondition(state domain.AuthorizationState) database.Condition {
+	return database.NewTextCondition(a.StateColumn(), database.TextOperationEqual, state.String())
+}
+
+func (a authorization) RoleKeyCondition(op database.TextOperation, role string) database.Condition {
+	return database.NewTextCondition(database.NewColumn(a.unqualifiedRolesTableName(), "key"), op, role)
+}
+
+// ExistsRoleKey creates a correlated [database.Exists] condition on authorization_roles.
+// Use this filter to make sure the authorization returned contains a specific role.
+func (a authorization) ExistsRoleKey(cond database.Condition) database.Condition {
+	return database.Exists(
+		a.qualifiedRolesTableName(),
+		database.And(
+			database.NewColumnCondition(a.InstanceIDColumn(), database.NewColumn(a.unqualifiedRolesTableName(), "instance_id")),
+			database.NewColumnCondition(a.IDColumn(), database.NewColumn(a.unqualifiedRolesTableName(), "authorization_id")),
+			cond,
+		),
+	)
+}
+
+// -------------------------------------------------------------
+// columns
+// -------------------------------------------------------------
+
+func (a authorization) qualifiedTableName() string {
+	return "zitadel." + a.unqualifiedTableName()
+}
+
+func (authorization) unqualifiedTableName() string {
+	return "authorizations"
+}
+
+func (a authorization) qualifiedRolesTableName() string {
+	return "zitadel." + a.unqualifiedRolesTableName()
+}
+
+func (authorization) unqualifiedRolesTableName() string {
+	return "authorization_roles"
+}
+
+func (a authorization) PrimaryKeyColumns() []database.Column {
+	return []database.Column{
+		a.InstanceIDColumn(),
+		a.IDColumn(),
+	}
+}
+
+func (a authorization) InstanceIDColumn() database.Column {
+	return database.NewColumn(a.unqualifiedTableName(), "instance_id")
+}
+
+func (a authorization) IDColumn() database.Column {
+	return database.NewColumn(a.unqualifiedTableName(), "id")
+}
+
+func (a authorization) OrganizationIDColumn() database.Column {
+	return database.NewColumn(a.unqualifiedTableName(), "organization_id")
+}
+
+func (a authorization) UserIDColumn() database.Column {
+	return database.NewColumn(a.unqualifiedTableName(), "user_id")
+}
+
+func (a authorization) ProjectIDColumn() database.Column {
+	return database.NewColumn(a.unqualifiedTableName(), "project_id")
+}
+
+func (a authorization) ProjectGrantIDColumn() database.Column {
+	return database.NewColumn(a.unqualifiedTableName(), "project_grant_id")
+}
+
+func (a authorization) CreatedAtColumn() database.Column {
+	return database.NewColumn(a.unqualifiedTableName(), "created_at")
+}
+
+func (a authorization) UpdatedAtColumn() database.Column {
+	return database.NewColumn(a.unqualifiedTableName(), "updated_at")
+}
+
+func (a authorization) StateColumn() database.Column {
+	return database.NewColumn(a.unqualifiedTableName(), "state")
+}
+
+// -------------------------------------------------------------
+// helpers
+// -------------------------------------------------------------
+
+const queryAuthorizationStmt = `SELECT
+	zitadel.authorizations.instance_id,
+	zitadel.authorizations.id,
+	zitadel.authorizations.organization_id,
+	zitadel.authorizations.user_id,
+	zitadel.authorizations.project_id,
+	COALESCE(zitadel.authorizations.project_grant_id, '') AS project_grant_id,
+	zitadel.authorizations.created_at,
+	zitadel.authorizations.updated_at,
+	zitadel.authorizations.state,
+	ARRAY_AGG(authorization_roles.key) FILTER (WHERE authorization_roles.authorization_id IS NOT NULL) AS role_keys
+	FROM zitadel.authorizations
+	LEFT JOIN zitadel.authorization_roles ON zitadel.authorization_roles.instance_id = zitadel.authorizations.instance_id AND zitadel.authorization_roles.authorization_id = zitadel.authorizations.id`
+
+func (a authorization) prepareQuery(opts []database.QueryOption) (*database.StatementBuilder, error) {
+	options := new(database.QueryOpts)
+	for _, opt := range opts {
+		opt(options)
+	}
+	if err := checkRestrictingColumns(options.Condition, a.InstanceIDColumn()); err != nil {
+		return nil, err
+	}
+	builder := database.NewStatementBuilder(queryAuthorizationStmt)
+	options.Write(builder)
+
+	return builder, nil
+}
@@ -0,0 +1,202 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/backend/v3/domain"
+	"github.com/zitadel/zitadel/backend/v3/storage/database"
+	"github.com/zitadel/zitadel/backend/v3/storage/database/repository"
+	"github.com/zitadel/zitadel/internal/integration"
+)
+
+func TestGetAuthorization(t *testing.T) {
+	tx, rollback := transactionForRollback(t)
+	defer rollback()
+	instanceID := createInstance(t, tx)
+	orgID := createOrganization(t, tx, instanceID)
+	projectID := createProject(t, tx, instanceID, orgID)
+	firstUserID := createUser(t, tx, instanceID, orgID)
+	secondUserID := createUser(t, tx, instanceID, orgID)
+	firstRoleKey := createProjectRole(t, tx, instanceID, orgID, projectID, "")
+	secondRoleKey := createProjectRole(t, tx, instanceID, orgID, projectID, "")
+	authorizationRepo := repository.AuthorizationRepository()
+
+	firstAuthorization := &domain.Authorization{
+		InstanceID:     instanceID,
+		ID:             integration.ID(),
+		OrganizationID: orgID,
+		UserID:         firstUserID,
+		ProjectID:      projectID,
+		State:          domain.AuthorizationStateActive,
+		RoleKeys:       []string{firstRoleKey},
+	}
+	require.NoError(t, authorizationRepo.Create(t.Context(), tx, firstAuthorization))
+	secondAuthorization := &domain.Authorization{
+		InstanceID:     instanceID,
+		ID:             integration.ID(),
+		OrganizationID: orgID,
+		UserID:         secondUserID,
+		ProjectID:      projectID,
+		State:          domain.AuthorizationStateInactive,
+		RoleKeys:       []string{firstRoleKey, secondRoleKey},
+	}
+	require.NoError(t, authorizationRepo.Create(t.Context(), tx, secondAuthorization))
+
+	tests := []struct {
+		name      string
+		condition database.Condition
+		want      *domain.Authorization
+		wantErr   error
+	}{
+		{
+			name:      "incomplete condition",
+			condition: authorizationRepo.IDCondition(firstAuthorization.ID),
+			wantErr:   database.NewMissingConditionError(authorizationRepo.InstanceIDColumn()),
+		},
+		{
+			name:      "not found",
+			condition: authorizationRepo.PrimaryKeyCondition(instanceID, "nix"),
+			wantErr:   database.NewNoRowFoundError(nil),
+		},
+		{
+			name:      "too many",
+			condition: authorizationRepo.InstanceIDCondition(instanceID),
+			wantErr:   database.NewMultipleRowsFoundError(nil),
+		},
+		{
+			name:      "ok first",
+			condition: authorizationRepo.PrimaryKeyCondition(instanceID, firstAuthorization.ID),
+			want:      firstAuthorization,
+		},
+		{
+			name: "ok by role",
+			condition: database.And(
+				authorizationRepo.InstanceIDCondition(instanceID),
+				authorizationRepo.ExistsRoleKey(authorizationRepo.RoleKeyCondition(database.TextOperationEqual, secondRoleKey)),
+			),
+			want: secondAuthorization,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := authorizationRepo.Get(t.Context(), tx, database.WithCondition(tt.condition))
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.want == nil {
+				return
+			}
+			assert.Equal(t, tt.want.ID, got.ID)
+			assert.Equal(t, tt.want.UserID, got.UserID)
+			assert.Equal(t, tt.want.State, got.State)
+			assert.ElementsMatch(t, tt.want.RoleKeys, got.RoleKeys)
+		})
+	}
+}
+
+func TestUpdateAuthorization(t *testing.T) {
+	tx, rollback := transactionForRollback(t)
+	defer rollback()
+	instanceID := createInstance(t, tx)
+	orgID := createOrganization(t, tx, instanceID)
+	projectID := createProject(t, tx, instanceID, orgID)
+	userID := createUser(t, tx, instanceID, orgID)
+	firstRoleKey := createProjectRole(t, tx, instanceID, orgID, projectID, "")
+	secondRoleKey := createProjectRole(t, tx, instanceID, orgID, projectID, "")
+	authorizationRepo := repository.AuthorizationRepository()
+
+	authorization := &domain.Authorization{
+		InstanceID:     instanceID,
+		ID:             integration.ID(),
+		OrganizationID: orgID,
+		UserID:         userID,
+		ProjectID:      projectID,
+		State:          domain.AuthorizationStateActive,
+		RoleKeys:       []string{firstRoleKey},
+	}
+	require.NoError(t, authorizationRepo.Create(t.Context(), tx, authorization))
+
+	tests := []struct {
+		name         string
+		condition    database.Condition
+		roleKeys     []string
+		changes      []database.Change
+		wantRoleKeys []string
+		wantState    domain.AuthorizationState
+		wantErr      error
+	}{
+		{
+			name:      "roles without primary key",
+			condition: authorizationRepo.InstanceIDCondition(instanceID),
+			roleKeys:  []string{secondRoleKey},
+			wantErr:   database.NewMissingConditionError(authorizationRepo.IDColumn()),
+		},
+		{
+			name:         "state",
+			condition:    authorizationRepo.PrimaryKeyCondition(instanceID, authorization.ID),
+			changes:      []database.Change{authorizationRepo.SetState(domain.AuthorizationStateInactive)},
+			wantRoleKeys: []string{firstRoleKey},
+			wantState:    domain.AuthorizationStateInactive,
+		},
+		{
+			name:         "replace roles",
+			condition:    authorizationRepo.PrimaryKeyCondition(instanceID, authorization.ID),
+			roleKeys:     []string{secondRoleKey},
+			wantRoleKeys: []string{secondRoleKey},
+			wantState:    domain.AuthorizationStateActive,
+		},
+		{
+			name:         "remove all roles",
+			condition:    authorizationRepo.PrimaryKeyCondition(instanceID, authorization.ID),
+			roleKeys:     []string{},
+			wantRoleKeys: nil,
+			wantState:    domain.AuthorizationStateActive,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			savepoint, rollback := savepointForRollback(t, tx)
+			defer rollback()
+
+			rowsAffected, err := authorizationRepo.Update(t.Context(), savepoint, tt.condition, tt.roleKeys, tt.changes...)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.EqualValues(t, 1, rowsAffected)
+
+			got, err := authorizationRepo.Get(t.Context(), savepoint, database.WithCondition(authorizationRepo.PrimaryKeyCondition(instanceID, authorization.ID)))
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.wantRoleKeys, got.RoleKeys)
+			assert.Equal(t, tt.wantState, got.State)
+		})
+	}
+}
+
+func TestDeleteAuthorization(t *testing.T) {
+	tx, rollback := transactionForRollback(t)
+	defer rollback()
+	instanceID := createInstance(t, tx)
+	orgID := createOrganization(t, tx, instanceID)
+	projectID := createProject(t, tx, instanceID, orgID)
+	userID := createUser(t, tx, instanceID, orgID)
+	authorizationRepo := repository.AuthorizationRepository()
+
+	authorization := &domain.Authorization{
+		InstanceID:     instanceID,
+		ID:             integration.ID(),
+		OrganizationID: orgID,
+		UserID:         userID,
+		ProjectID:      projectID,
+		State:          domain.AuthorizationStateActive,
+	}
+	require.NoError(t, authorizationRepo.Create(t.Context(), tx, authorization))
+
+	rowsAffected, err := authorizationRepo.Delete(t.Context(), tx, authorizationRepo.PrimaryKeyCondition(instanceID, authorization.ID))
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, rowsAffected)
+
+	_, err = authorizationRepo.Get(t.Context(), tx, database.WithCondition(authorizationRepo.PrimaryKeyCondition(instanceID, authorization.ID)))
+	require.ErrorIs(t, err, new(database.NoRowFoundError))
+}
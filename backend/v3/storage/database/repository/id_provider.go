@@ -430,6 +430,32 @@ func (i *idProvider) GetSAML(ctx context.Context, client database.QueryExecutor,
 	return saml, nil
 }
 
+func (i *idProvider) GetWSFederation(ctx context.Context, client database.QueryExecutor, id domain.IDPIdentifierCondition, instanceID string, orgID *string) (*domain.IDPWSFederation, error) {
+	wsFederation := &domain.IDPWSFederation{}
+	var err error
+
+	wsFederation.IdentityProvider, err = i.Get(ctx, client, id, instanceID, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	var idpType domain.IDPType
+	if wsFederation.Type != nil {
+		idpType = *wsFederation.Type
+	}
+
+	if idpType != domain.IDPTypeWSFederation {
+		return nil, domain.NewIDPWrongTypeError(domain.IDPTypeWSFederation, idpType)
+	}
+
+	err = json.Unmarshal(wsFederation.Payload, wsFederation)
+	if err != nil {
+		return nil, err
+	}
+
+	return wsFederation, nil
+}
+
 // -------------------------------------------------------------
 // columns
 // -------------------------------------------------------------
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/zitadel/zitadel/backend/v3/domain"
 	"github.com/zitadel/zitadel/backend/v3/storage/database"
@@ -91,6 +92,60 @@ func updateOne[Target updatable](ctx context.Context, client database.QueryExecu
 	return client.Exec(ctx, builder.String(), builder.Args()...)
 }
 
+// existingRowsCTE is the name of the common table expression selecting the primary keys of the rows to update
+// if the changes contain [database.CTEChange]s.
+const existingRowsCTE = "existing"
+
+// update updates all rows of the target matching the condition.
+// [database.CTEChange]s are written as common table expressions before the update,
+// they can reference the rows to update using [existingRowsCTE].
+func update[Target updatable](ctx context.Context, client database.QueryExecutor, target Target, condition database.Condition, changes ...database.Change) (int64, error) {
+	if len(changes) == 0 {
+		return 0, database.ErrNoChanges
+	}
+	if !database.Changes(changes).IsOnColumn(target.UpdatedAtColumn()) {
+		changes = append(changes, database.NewChange(target.UpdatedAtColumn(), database.NullInstruction))
+	}
+
+	var builder database.StatementBuilder
+	ctes := database.Changes(changes).CTEChanges()
+	if len(ctes) > 0 {
+		builder.WriteString("WITH " + existingRowsCTE + " AS (SELECT ")
+		database.Columns(target.PrimaryKeyColumns()).WriteQualified(&builder)
+		builder.WriteString(" FROM " + target.qualifiedTableName())
+		writeCondition(&builder, condition)
+		builder.WriteRune(')')
+		for i, cte := range ctes {
+			name := "cte_" + strconv.Itoa(i)
+			cte.SetName(name)
+			builder.WriteString(", " + name + " AS (")
+			cte.WriteCTE(&builder)
+			builder.WriteRune(')')
+		}
+		builder.WriteRune(' ')
+	}
+
+	builder.WriteString("UPDATE " + target.qualifiedTableName() + " SET ")
+	if err := database.Changes(changes).Write(&builder); err != nil {
+		return 0, err
+	}
+	if len(ctes) == 0 {
+		writeCondition(&builder, condition)
+		return client.Exec(ctx, builder.String(), builder.Args()...)
+	}
+
+	builder.WriteString(" FROM " + existingRowsCTE + " WHERE ")
+	for i, col := range target.PrimaryKeyColumns() {
+		if i > 0 {
+			builder.WriteString(" AND ")
+		}
+		col.WriteQualified(&builder)
+		builder.WriteString(" = " + existingRowsCTE + ".")
+		col.WriteUnqualified(&builder)
+	}
+	return client.Exec(ctx, builder.String(), builder.Args()...)
+}
+
 type deletable interface {
 	PrimaryKeyColumns() []database.Column
 	qualifiedTableName() string
@@ -108,3 +163,15 @@ func deleteOne[Target deletable](ctx context.Context, client database.QueryExecu
 
 	return client.Exec(ctx, builder.String(), builder.Args()...)
 }
+
+// nullIfZero returns [database.NullInstruction] if the value is the zero value of its type.
+func nullIfZero[V comparable](value V) any {
+	if zeroer, ok := any(value).(interface{ IsZero() bool }); ok && zeroer.IsZero() {
+		return database.NullInstruction
+	}
+	var zero V
+	if value == zero {
+		return database.NullInstruction
+	}
+	return value
+}
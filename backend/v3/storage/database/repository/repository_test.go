@@ -143,3 +143,26 @@ func createProjectRole(t *testing.T, tx database.Transaction, instanceID, orgID,
 
 	return projectRole.Key
 }
+
+func createUser(t *testing.T, tx database.Transaction, instanceID, orgID string) (userID string) {
+	t.Helper()
+	user := domain.User{
+		InstanceID:     instanceID,
+		OrganizationID: orgID,
+		ID:             gofakeit.UUID(),
+		Username:       gofakeit.Username(),
+		State:          domain.UserStateActive,
+		Human: &domain.HumanUser{
+			FirstName: gofakeit.FirstName(),
+			LastName:  gofakeit.LastName(),
+			Email: domain.HumanEmail{
+				Address: gofakeit.Email(),
+			},
+		},
+	}
+	userRepo := repository.UserRepository()
+	err := userRepo.Create(t.Context(), tx, &user)
+	require.NoError(t, err)
+
+	return user.ID
+}
@@ -0,0 +1,894 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zitadel/zitadel/backend/v3/domain"
+	"github.com/zitadel/zitadel/backend/v3/storage/database"
+)
+
+// -------------------------------------------------------------
+// repository
+// -------------------------------------------------------------
+
+var _ domain.SessionRepository = (*session)(nil)
+
+type session struct {
+	factorRepo    sessionFactor
+	metadataRepo  sessionMetadata
+	userAgentRepo sessionUserAgent
+}
+
+// SessionRepository manages sessions including their factors, metadata and user agent.
+func SessionRepository() domain.SessionRepository {
+	return new(session)
+}
+
+func (s session) qualifiedTableName() string {
+	return "zitadel." + s.unqualifiedTableName()
+}
+
+func (session) unqualifiedTableName() string {
+	return "sessions"
+}
+
+const querySessionStmt = `SELECT sessions.instance_id, sessions.id, COALESCE(sessions.token, '') AS token, COALESCE(sessions.lifetime, INTERVAL '0') AS lifetime` +
+	` , COALESCE(sessions.expiration, '0001-01-01 00:00:00+00') AS expiration, COALESCE(sessions.user_id, '') AS user_id, sessions.created_at, sessions.updated_at` +
+	` , jsonb_agg(DISTINCT jsonb_build_object('type', session_factors.type, 'lastChallengedAt', session_factors.last_challenged_at, 'lastVerifiedAt', session_factors.last_verified_at, 'lastFailedAt', session_factors.last_failed_at, 'challenge', session_factors.challenge, 'payload', session_factors.payload)) FILTER (WHERE session_factors.session_id IS NOT NULL) AS factors` +
+	` , jsonb_agg(DISTINCT jsonb_build_object('instanceId', session_metadata.instance_id, 'sessionId', session_metadata.session_id, 'key', session_metadata.key, 'value', encode(session_metadata.value, 'base64'), 'createdAt', session_metadata.created_at, 'updatedAt', session_metadata.updated_at)) FILTER (WHERE session_metadata.session_id IS NOT NULL) AS metadata` +
+	` , CASE WHEN session_user_agents.fingerprint_id IS NOT NULL THEN jsonb_build_object('instanceId', session_user_agents.instance_id, 'fingerprintId', session_user_agents.fingerprint_id, 'description', session_user_agents.description, 'ip', host(session_user_agents.ip), 'header', session_user_agents.headers) END AS user_agent` +
+	` FROM zitadel.sessions` +
+	` LEFT JOIN zitadel.session_factors ON sessions.instance_id = session_factors.instance_id AND sessions.id = session_factors.session_id` +
+	` LEFT JOIN zitadel.session_metadata ON sessions.instance_id = session_metadata.instance_id AND sessions.id = session_metadata.session_id` +
+	` LEFT JOIN zitadel.session_user_agents ON sessions.instance_id = session_user_agents.instance_id AND sessions.user_agent_id = session_user_agents.fingerprint_id`
+
+// Get implements [domain.SessionRepository].
+func (s session) Get(ctx context.Context, client database.QueryExecutor, opts ...database.QueryOption) (*domain.Session, error) {
+	builder, err := s.prepareQuery(opts)
+	if err != nil {
+		return nil, err
+	}
+	return scanSession(ctx, client, builder)
+}
+
+// List implements [domain.SessionRepository].
+func (s session) List(ctx context.Context, client database.QueryExecutor, opts ...database.QueryOption) ([]*domain.Session, error) {
+	builder, err := s.prepareQuery(opts)
+	if err != nil {
+		return nil, err
+	}
+	return scanSessions(ctx, client, builder)
+}
+
+func (s session) prepareQuery(opts []database.QueryOption) (*database.StatementBuilder, error) {
+	opts = append(opts,
+		database.WithGroupBy(
+			s.InstanceIDColumn(),
+			s.IDColumn(),
+			s.userAgentRepo.InstanceIDColumn(),
+			s.userAgentRepo.FingerprintIDColumn(),
+		),
+	)
+
+	options := new(database.QueryOpts)
+	for _, opt := range opts {
+		opt(options)
+	}
+	if err := checkRestrictingColumns(options.Condition, s.InstanceIDColumn()); err != nil {
+		return nil, err
+	}
+
+	builder := database.NewStatementBuilder(querySessionStmt)
+	options.Write(builder)
+	return builder, nil
+}
+
+// Create implements [domain.SessionRepository].
+// Factors, challenges, metadata and the user agent of the session are created as well.
+func (s session) Create(ctx context.Context, client database.QueryExecutor, session *domain.Session) error {
+	var createdAt, updatedAt any = database.DefaultInstruction, database.DefaultInstruction
+	if !session.CreatedAt.IsZero() {
+		createdAt = session.CreatedAt
+	}
+	if !session.UpdatedAt.IsZero() {
+		updatedAt = session.UpdatedAt
+	}
+	var lifetime any = database.NullInstruction
+	if session.Lifetime > 0 {
+		lifetime = session.Lifetime
+	}
+
+	builder := database.NewStatementBuilder(`INSERT INTO ` + s.qualifiedTableName() + ` (instance_id, id, token, lifetime, user_id, created_at, updated_at) VALUES (`)
+	builder.WriteArgs(session.InstanceID, session.ID, nullIfZero(session.Token), lifetime, nullIfZero(session.UserID), createdAt, updatedAt)
+	builder.WriteString(`) RETURNING created_at, updated_at, expiration`)
+
+	var expiration *time.Time
+	if err := client.QueryRow(ctx, builder.String(), builder.Args()...).Scan(&session.CreatedAt, &session.UpdatedAt, &expiration); err != nil {
+		return err
+	}
+	if expiration != nil {
+		session.Expiration = *expiration
+	}
+
+	changes := make([]database.Change, 0, len(session.Factors)+len(session.Challenges)+2)
+	for _, factor := range session.Factors {
+		changes = append(changes, s.SetFactor(factor))
+	}
+	for _, challenge := range session.Challenges {
+		changes = append(changes, s.SetChallenge(challenge))
+	}
+	if len(session.Metadata) > 0 {
+		changes = append(changes, s.SetMetadata(session.Metadata))
+	}
+	if session.UserAgent != nil {
+		changes = append(changes, s.SetUserAgent(*session.UserAgent))
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	changes = append(changes, s.SetUpdatedAt(session.UpdatedAt))
+	_, err := s.Update(ctx, client, s.PrimaryKeyCondition(session.InstanceID, session.ID), changes...)
+	return err
+}
+
+// Update implements [domain.SessionRepository].
+// The condition must at least restrict the instance id.
+func (s session) Update(ctx context.Context, client database.QueryExecutor, condition database.Condition, changes ...database.Change) (int64, error) {
+	if err := checkRestrictingColumns(condition, s.InstanceIDColumn()); err != nil {
+		return 0, err
+	}
+	return update(ctx, client, s, condition, changes...)
+}
+
+// Delete implements [domain.SessionRepository].
+// The condition must at least restrict the instance id.
+func (s session) Delete(ctx context.Context, client database.QueryExecutor, condition database.Condition) error {
+	if err := checkRestrictingColumns(condition, s.InstanceIDColumn()); err != nil {
+		return err
+	}
+
+	builder := database.NewStatementBuilder(`DELETE FROM ` + s.qualifiedTableName())
+	writeCondition(builder, condition)
+
+	_, err := client.Exec(ctx, builder.String(), builder.Args()...)
+	return err
+}
+
+// -------------------------------------------------------------
+// changes
+// -------------------------------------------------------------
+
+// SetUpdatedAt implements [domain.sessionChanges].
+func (s session) SetUpdatedAt(updatedAt time.Time) database.Change {
+	return database.NewChange(s.UpdatedAtColumn(), updatedAt)
+}
+
+// SetToken implements [domain.sessionChanges].
+func (s session) SetToken(token string) database.Change {
+	return database.NewChange(s.TokenColumn(), token)
+}
+
+// SetLifetime implements [domain.sessionChanges].
+// A lifetime of zero or less removes the expiration of the session.
+func (s session) SetLifetime(lifetime time.Duration) database.Change {
+	if lifetime <= 0 {
+		return database.NewChangeToNull(s.LifetimeColumn())
+	}
+	return database.NewChange(s.LifetimeColumn(), lifetime)
+}
+
+// SetFactor implements [domain.sessionChanges].
+// Setting the user factor also sets the user id of the session.
+func (s session) SetFactor(factor domain.SessionFactor) database.Change {
+	var (
+		factorType                   domain.SessionFactorType
+		lastVerifiedAt, lastFailedAt time.Time
+		change                       func(name string) database.Change
+	)
+	switch f := factor.(type) {
+	case *domain.SessionFactorUser:
+		factorType, lastVerifiedAt = domain.SessionFactorTypeUser, f.LastVerifiedAt
+		change = func(string) database.Change {
+			return database.NewChange(s.UserIDColumn(), f.UserID)
+		}
+	case *domain.SessionFactorPassword:
+		factorType, lastVerifiedAt, lastFailedAt = domain.SessionFactorTypePassword, f.LastVerifiedAt, f.LastFailedAt
+	case *domain.SessionFactorIdentityProviderIntent:
+		factorType, lastVerifiedAt = domain.SessionFactorTypeIdentityProviderIntent, f.LastVerifiedAt
+	case *domain.SessionFactorPasskey:
+		factorType, lastVerifiedAt = domain.SessionFactorTypePasskey, f.LastVerifiedAt
+	case *domain.SessionFactorTOTP:
+		factorType, lastVerifiedAt, lastFailedAt = domain.SessionFactorTypeTOTP, f.LastVerifiedAt, f.LastFailedAt
+	case *domain.SessionFactorOTPSMS:
+		factorType, lastVerifiedAt, lastFailedAt = domain.SessionFactorTypeOTPSMS, f.LastVerifiedAt, f.LastFailedAt
+	case *domain.SessionFactorOTPEmail:
+		factorType, lastVerifiedAt, lastFailedAt = domain.SessionFactorTypeOTPEmail, f.LastVerifiedAt, f.LastFailedAt
+	default:
+		return database.NewChanges()
+	}
+	// the factors only contain types which can always be marshaled
+	payload, _ := json.Marshal(factor)
+
+	return database.NewCTEChange(func(builder *database.StatementBuilder) {
+		builder.WriteString(`INSERT INTO ` + s.factorRepo.qualifiedTableName() + ` (instance_id, session_id, type, last_verified_at, last_failed_at, payload)` +
+			` SELECT ` + existingRowsCTE + `.instance_id, ` + existingRowsCTE + `.id, `)
+		builder.WriteArg(factorType)
+		builder.WriteString(`, `)
+		builder.WriteArg(nullIfZero(lastVerifiedAt))
+		builder.WriteString(`::TIMESTAMPTZ, `)
+		builder.WriteArg(nullIfZero(lastFailedAt))
+		builder.WriteString(`::TIMESTAMPTZ, `)
+		builder.WriteArg(string(payload))
+		builder.WriteString(`::JSONB FROM ` + existingRowsCTE +
+			` ON CONFLICT (instance_id, session_id, type) DO UPDATE SET` +
+			` last_verified_at = COALESCE(EXCLUDED.last_verified_at, session_factors.last_verified_at)` +
+			`, last_failed_at = COALESCE(EXCLUDED.last_failed_at, session_factors.last_failed_at)` +
+			`, payload = EXCLUDED.payload`)
+	}, change)
+}
+
+// SetChallenge implements [domain.sessionChanges].
+func (s session) SetChallenge(challenge domain.SessionChallenge) database.Change {
+	var (
+		challengeType    domain.SessionFactorType
+		lastChallengedAt time.Time
+	)
+	switch c := challenge.(type) {
+	case *domain.SessionChallengePasskey:
+		challengeType, lastChallengedAt = domain.SessionFactorTypePasskey, c.LastChallengedAt
+	case *domain.SessionChallengeOTPSMS:
+		challengeType, lastChallengedAt = domain.SessionFactorTypeOTPSMS, c.LastChallengedAt
+	case *domain.SessionChallengeOTPEmail:
+		challengeType, lastChallengedAt = domain.SessionFactorTypeOTPEmail, c.LastChallengedAt
+	default:
+		return database.NewChanges()
+	}
+	// the challenges only contain types which can always be marshaled
+	payload, _ := json.Marshal(challenge)
+
+	return database.NewCTEChange(func(builder *database.StatementBuilder) {
+		builder.WriteString(`INSERT INTO ` + s.factorRepo.qualifiedTableName() + ` (instance_id, session_id, type, last_challenged_at, challenge)` +
+			` SELECT ` + existingRowsCTE + `.instance_id, ` + existingRowsCTE + `.id, `)
+		builder.WriteArg(challengeType)
+		builder.WriteString(`, COALESCE(`)
+		builder.WriteArg(nullIfZero(lastChallengedAt))
+		builder.WriteString(`::TIMESTAMPTZ, NOW()), `)
+		builder.WriteArg(string(payload))
+		builder.WriteString(`::JSONB FROM ` + existingRowsCTE +
+			` ON CONFLICT (instance_id, session_id, type) DO UPDATE SET` +
+			` last_challenged_at = EXCLUDED.last_challenged_at` +
+			`, challenge = EXCLUDED.challenge`)
+	}, nil)
+}
+
+// ClearFactor implements [domain.sessionChanges].
+// Clearing the user factor also removes the user id of the session.
+func (s session) ClearFactor(factor domain.SessionFactorType) database.Change {
+	var change func(name string) database.Change
+	if factor == domain.SessionFactorTypeUser {
+		change = func(string) database.Change {
+			return database.NewChangeToNull(s.UserIDColumn())
+		}
+	}
+	return database.NewCTEChange(func(builder *database.StatementBuilder) {
+		builder.WriteString(`DELETE FROM ` + s.factorRepo.qualifiedTableName() + ` USING ` + existingRowsCTE + ` WHERE `)
+		database.And(
+			database.NewColumnCondition(s.factorRepo.InstanceIDColumn(), database.NewColumn(existingRowsCTE, "instance_id")),
+			database.NewColumnCondition(s.factorRepo.SessionIDColumn(), database.NewColumn(existingRowsCTE, "id")),
+			s.factorRepo.FactorTypeCondition(factor),
+		).Write(builder)
+	}, change)
+}
+
+// SetMetadata implements [domain.sessionChanges].
+// Metadata with an empty value is removed.
+func (s session) SetMetadata(metadata []domain.SessionMetadata) database.Change {
+	set := make([]domain.SessionMetadata, 0, len(metadata))
+	removedKeys := make([]string, 0, len(metadata))
+	for _, md := range metadata {
+		if len(md.Value) == 0 {
+			removedKeys = append(removedKeys, md.Key)
+			continue
+		}
+		set = append(set, md)
+	}
+
+	changes := make(database.Changes, 0, 2)
+	if len(removedKeys) > 0 {
+		changes = append(changes, database.NewCTEChange(func(builder *database.StatementBuilder) {
+			builder.WriteString(`DELETE FROM ` + s.metadataRepo.qualifiedTableName() + ` USING ` + existingRowsCTE + ` WHERE `)
+			database.And(
+				database.NewColumnCondition(s.metadataRepo.InstanceIDColumn(), database.NewColumn(existingRowsCTE, "instance_id")),
+				database.NewColumnCondition(s.metadataRepo.SessionIDColumn(), database.NewColumn(existingRowsCTE, "id")),
+			).Write(builder)
+			builder.WriteString(` AND `)
+			s.metadataRepo.KeyColumn().WriteQualified(builder)
+			builder.WriteString(` = ANY(`)
+			builder.WriteArg(removedKeys)
+			builder.WriteString(`)`)
+		}, nil))
+	}
+	if len(set) > 0 {
+		changes = append(changes, database.NewCTEChange(func(builder *database.StatementBuilder) {
+			builder.WriteString(`INSERT INTO ` + s.metadataRepo.qualifiedTableName() + ` (instance_id, session_id, key, value, created_at, updated_at)` +
+				` SELECT ` + existingRowsCTE + `.instance_id, ` + existingRowsCTE + `.id, md.key, md.value, COALESCE(md.created_at, NOW()), md.updated_at` +
+				` FROM ` + existingRowsCTE + ` CROSS JOIN (VALUES `)
+			for i, md := range set {
+				if i > 0 {
+					builder.WriteString(", ")
+				}
+				builder.WriteRune('(')
+				builder.WriteArg(md.Key)
+				builder.WriteString("::TEXT, ")
+				builder.WriteArg(md.Value)
+				builder.WriteString("::BYTEA, ")
+				builder.WriteArg(nullIfZero(md.CreatedAt))
+				builder.WriteString("::TIMESTAMPTZ, ")
+				builder.WriteArg(nullIfZero(md.UpdatedAt))
+				builder.WriteString("::TIMESTAMPTZ)")
+			}
+			builder.WriteString(`) AS md (key, value, created_at, updated_at)` +
+				` ON CONFLICT (instance_id, session_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at`)
+		}, nil))
+	}
+	return changes
+}
+
+// SetUserAgent implements [domain.sessionChanges].
+// If the user agent has no fingerprint, the user agent of the session is removed.
+func (s session) SetUserAgent(userAgent domain.SessionUserAgent) database.Change {
+	if userAgent.FingerprintID == nil || *userAgent.FingerprintID == "" {
+		return database.NewChangeToNull(s.userAgentIDColumn())
+	}
+	var description, ip, headers any = database.NullInstruction, database.NullInstruction, database.NullInstruction
+	if userAgent.Description != nil {
+		description = *userAgent.Description
+	}
+	if len(userAgent.IP) > 0 {
+		ip = userAgent.IP.String()
+	}
+	if len(userAgent.Header) > 0 {
+		// http.Header can always be marshaled
+		header, _ := json.Marshal(userAgent.Header)
+		headers = string(header)
+	}
+
+	return database.NewCTEChange(func(builder *database.StatementBuilder) {
+		builder.WriteString(`INSERT INTO ` + s.userAgentRepo.qualifiedTableName() + ` (instance_id, fingerprint_id, description, ip, headers)` +
+			` SELECT DISTINCT ` + existingRowsCTE + `.instance_id, `)
+		builder.WriteArg(*userAgent.FingerprintID)
+		builder.WriteString(`::TEXT, `)
+		builder.WriteArg(description)
+		builder.WriteString(`::TEXT, `)
+		builder.WriteArg(ip)
+		builder.WriteString(`::INET, `)
+		builder.WriteArg(headers)
+		builder.WriteString(`::JSONB FROM ` + existingRowsCTE +
+			` ON CONFLICT (instance_id, fingerprint_id) DO UPDATE SET` +
+			` description = EXCLUDED.description, ip = EXCLUDED.ip, headers = EXCLUDED.headers`)
+	}, func(string) database.Change {
+		return database.NewChange(s.userAgentIDColumn(), *userAgent.FingerprintID)
+	})
+}
+
+// -------------------------------------------------------------
+// conditions
+// -------------------------------------------------------------
+
+// PrimaryKeyCondition implements [domain.sessionConditions].
+func (s session) PrimaryKeyCondition(instanceID, sessionID string) database.Condition {
+	return database.And(
+		s.InstanceIDCondition(instanceID),
+		s.IDCondition(sessionID),
+	)
+}
+
+// InstanceIDCondition implements [domain.sessionConditions].
+func (s session) InstanceIDCondition(instanceID string) database.Condition {
+	return database.NewTextCondition(s.InstanceIDColumn(), database.TextOperationEqual, instanceID)
+}
+
+// IDCondition implements [domain.sessionConditions].
+func (s session) IDCondition(sessionID string) database.Condition {
+	return database.NewTextCondition(s.IDColumn(), database.TextOperationEqual, sessionID)
+}
+
+// UserAgentIDCondition implements [domain.sessionConditions].
+func (s session) UserAgentIDCondition(userAgentID string) database.Condition {
+	return database.NewTextCondition(s.userAgentIDColumn(), database.TextOperationEqual, userAgentID)
+}
+
+// UserIDCondition implements [domain.sessionConditions].
+func (s session) UserIDCondition(userID string) database.Condition {
+	return database.NewTextCondition(s.UserIDColumn(), database.TextOperationEqual, userID)
+}
+
+// CreatedAtCondition implements [domain.sessionConditions].
+func (s session) CreatedAtCondition(op database.NumberOperation, createdAt time.Time) database.Condition {
+	return database.NewNumberCondition(s.CreatedAtColumn(), op, createdAt)
+}
+
+// UpdatedAtCondition implements [domain.sessionConditions].
+func (s session) UpdatedAtCondition(op database.NumberOperation, updatedAt time.Time) database.Condition {
+	return database.NewNumberCondition(s.UpdatedAtColumn(), op, updatedAt)
+}
+
+// FactorConditions implements [domain.sessionConditions].
+func (s session) FactorConditions() domain.SessionFactorConditions {
+	return s.factorRepo
+}
+
+// MetadataConditions implements [domain.sessionConditions].
+func (s session) MetadataConditions() domain.SessionMetadataConditions {
+	return s.metadataRepo
+}
+
+// UserAgentConditions implements [domain.sessionConditions].
+func (s session) UserAgentConditions() domain.SessionUserAgentConditions {
+	return s.userAgentRepo
+}
+
+// -------------------------------------------------------------
+// columns
+// -------------------------------------------------------------
+
+// PrimaryKeyColumns implements [domain.Repository].
+func (s session) PrimaryKeyColumns() []database.Column {
+	return []database.Column{
+		s.InstanceIDColumn(),
+		s.IDColumn(),
+	}
+}
+
+// InstanceIDColumn implements [domain.sessionColumns].
+func (s session) InstanceIDColumn() database.Column {
+	return database.NewColumn(s.unqualifiedTableName(), "instance_id")
+}
+
+// IDColumn implements [domain.sessionColumns].
+func (s session) IDColumn() database.Column {
+	return database.NewColumn(s.unqualifiedTableName(), "id")
+}
+
+// TokenColumn implements [domain.sessionColumns].
+func (s session) TokenColumn() database.Column {
+	return database.NewColumn(s.unqualifiedTableName(), "token")
+}
+
+// LifetimeColumn implements [domain.sessionColumns].
+func (s session) LifetimeColumn() database.Column {
+	return database.NewColumn(s.unqualifiedTableName(), "lifetime")
+}
+
+// ExpirationColumn implements [domain.sessionColumns].
+func (s session) ExpirationColumn() database.Column {
+	return database.NewColumn(s.unqualifiedTableName(), "expiration")
+}
+
+// UserIDColumn implements [domain.sessionColumns].
+func (s session) UserIDColumn() database.Column {
+	return database.NewColumn(s.unqualifiedTableName(), "user_id")
+}
+
+// CreatedAtColumn implements [domain.sessionColumns].
+func (s session) CreatedAtColumn() database.Column {
+	return database.NewColumn(s.unqualifiedTableName(), "created_at")
+}
+
+// UpdatedAtColumn implements [domain.sessionColumns].
+func (s session) UpdatedAtColumn() database.Column {
+	return database.NewColumn(s.unqualifiedTableName(), "updated_at")
+}
+
+// FactorColumns implements [domain.sessionColumns].
+func (s session) FactorColumns() domain.SessionFactorColumns {
+	return s.factorRepo
+}
+
+// MetadataColumns implements [domain.sessionColumns].
+func (s session) MetadataColumns() domain.SessionMetadataColumns {
+	return s.metadataRepo
+}
+
+// UserAgentColumns implements [domain.sessionColumns].
+func (s session) UserAgentColumns() domain.SessionUserAgentColumns {
+	return s.userAgentRepo
+}
+
+func (s session) userAgentIDColumn() database.Column {
+	return database.NewColumn(s.unqualifiedTableName(), "user_agent_id")
+}
+
+// -------------------------------------------------------------
+// scanners
+// -------------------------------------------------------------
+
+type rawSession struct {
+	*domain.Session
+	Factors   JSONArray[rawSessionFactor]         `json:"factors,omitempty" db:"factors"`
+	Metadata  JSONArray[domain.SessionMetadata]   `json:"metadata,omitempty" db:"metadata"`
+	UserAgent JSONObject[domain.SessionUserAgent] `json:"userAgent,omitempty" db:"user_agent"`
+}
+
+func (s *rawSession) toDomain() (*domain.Session, error) {
+	s.Session.Factors = make(domain.SessionFactors, 0, len(s.Factors))
+	s.Session.Challenges = make(domain.SessionChallenges, 0, len(s.Factors))
+	for _, raw := range s.Factors {
+		factor, err := raw.factor()
+		if err != nil {
+			return nil, err
+		}
+		if factor != nil {
+			s.Session.Factors = append(s.Session.Factors, factor)
+		}
+		challenge, err := raw.challenge()
+		if err != nil {
+			return nil, err
+		}
+		if challenge != nil {
+			s.Session.Challenges = append(s.Session.Challenges, challenge)
+		}
+	}
+	s.Session.Metadata = make([]domain.SessionMetadata, len(s.Metadata))
+	for i, md := range s.Metadata {
+		s.Session.Metadata[i] = *md
+	}
+	s.Session.UserAgent = s.UserAgent.Value
+	return s.Session, nil
+}
+
+// rawSessionFactor is a row of the session factors table.
+// The payload contains the last set factor and the challenge the last set challenge of the type.
+type rawSessionFactor struct {
+	Type             domain.SessionFactorType `json:"type"`
+	LastChallengedAt time.Time                `json:"lastChallengedAt"`
+	LastVerifiedAt   time.Time                `json:"lastVerifiedAt"`
+	LastFailedAt     time.Time                `json:"lastFailedAt"`
+	Challenge        json.RawMessage          `json:"challenge"`
+	Payload          json.RawMessage          `json:"payload"`
+}
+
+func (f *rawSessionFactor) factor() (domain.SessionFactor, error) {
+	if isJSONNull(f.Payload) {
+		return nil, nil
+	}
+	var factor domain.SessionFactor
+	switch f.Type {
+	case domain.SessionFactorTypeUser:
+		factor = &domain.SessionFactorUser{LastVerifiedAt: f.LastVerifiedAt}
+	case domain.SessionFactorTypePassword:
+		factor = &domain.SessionFactorPassword{LastVerifiedAt: f.LastVerifiedAt, LastFailedAt: f.LastFailedAt}
+	case domain.SessionFactorTypePasskey:
+		factor = &domain.SessionFactorPasskey{LastVerifiedAt: f.LastVerifiedAt}
+	case domain.SessionFactorTypeIdentityProviderIntent:
+		factor = &domain.SessionFactorIdentityProviderIntent{LastVerifiedAt: f.LastVerifiedAt}
+	case domain.SessionFactorTypeTOTP:
+		factor = &domain.SessionFactorTOTP{LastVerifiedAt: f.LastVerifiedAt, LastFailedAt: f.LastFailedAt}
+	case domain.SessionFactorTypeOTPSMS:
+		factor = &domain.SessionFactorOTPSMS{LastVerifiedAt: f.LastVerifiedAt, LastFailedAt: f.LastFailedAt}
+	case domain.SessionFactorTypeOTPEmail:
+		factor = &domain.SessionFactorOTPEmail{LastVerifiedAt: f.LastVerifiedAt, LastFailedAt: f.LastFailedAt}
+	default:
+		return nil, nil
+	}
+	// the dates of the columns take precedence over the dates of the payload
+	dates := *f
+	if err := json.Unmarshal(f.Payload, factor); err != nil {
+		return nil, database.NewScanError(err)
+	}
+	setFactorDates(factor, dates.LastVerifiedAt, dates.LastFailedAt)
+	return factor, nil
+}
+
+func setFactorDates(factor domain.SessionFactor, lastVerifiedAt, lastFailedAt time.Time) {
+	switch f := factor.(type) {
+	case *domain.SessionFactorUser:
+		f.LastVerifiedAt = lastVerifiedAt
+	case *domain.SessionFactorPassword:
+		f.LastVerifiedAt, f.LastFailedAt = lastVerifiedAt, lastFailedAt
+	case *domain.SessionFactorPasskey:
+		f.LastVerifiedAt = lastVerifiedAt
+	case *domain.SessionFactorIdentityProviderIntent:
+		f.LastVerifiedAt = lastVerifiedAt
+	case *domain.SessionFactorTOTP:
+		f.LastVerifiedAt, f.LastFailedAt = lastVerifiedAt, lastFailedAt
+	case *domain.SessionFactorOTPSMS:
+		f.LastVerifiedAt, f.LastFailedAt = lastVerifiedAt, lastFailedAt
+	case *domain.SessionFactorOTPEmail:
+		f.LastVerifiedAt, f.LastFailedAt = lastVerifiedAt, lastFailedAt
+	}
+}
+
+func (f *rawSessionFactor) challenge() (domain.SessionChallenge, error) {
+	if isJSONNull(f.Challenge) {
+		return nil, nil
+	}
+	var challenge domain.SessionChallenge
+	switch f.Type {
+	case domain.SessionFactorTypePasskey:
+		c := new(domain.SessionChallengePasskey)
+		if err := json.Unmarshal(f.Challenge, c); err != nil {
+			return nil, database.NewScanError(err)
+		}
+		c.LastChallengedAt = f.LastChallengedAt
+		challenge = c
+	case domain.SessionFactorTypeOTPSMS:
+		c := new(domain.SessionChallengeOTPSMS)
+		if err := json.Unmarshal(f.Challenge, c); err != nil {
+			return nil, database.NewScanError(err)
+		}
+		c.LastChallengedAt = f.LastChallengedAt
+		challenge = c
+	case domain.SessionFactorTypeOTPEmail:
+		c := new(domain.SessionChallengeOTPEmail)
+		if err := json.Unmarshal(f.Challenge, c); err != nil {
+			return nil, database.NewScanError(err)
+		}
+		c.LastChallengedAt = f.LastChallengedAt
+		challenge = c
+	}
+	return challenge, nil
+}
+
+func isJSONNull(raw json.RawMessage) bool {
+	return len(raw) == 0 || string(raw) == "null"
+}
+
+func scanSession(ctx context.Context, querier database.Querier, builder *database.StatementBuilder) (*domain.Session, error) {
+	rows, err := querier.Query(ctx, builder.String(), builder.Args()...)
+	if err != nil {
+		return nil, err
+	}
+
+	var session rawSession
+	if err := rows.(database.CollectableRows).CollectExactlyOneRow(&session); err != nil {
+		return nil, err
+	}
+	return session.toDomain()
+}
+
+func scanSessions(ctx context.Context, querier database.Querier, builder *database.StatementBuilder) ([]*domain.Session, error) {
+	rows, err := querier.Query(ctx, builder.String(), builder.Args()...)
+	if err != nil {
+		return nil, err
+	}
+
+	var sessions []*rawSession
+	if err := rows.(database.CollectableRows).Collect(&sessions); err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Session, len(sessions))
+	for i, session := range sessions {
+		result[i], err = session.toDomain()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// -------------------------------------------------------------
+// factors
+// -------------------------------------------------------------
+
+var (
+	_ domain.SessionFactorColumns    = (*sessionFactor)(nil)
+	_ domain.SessionFactorConditions = (*sessionFactor)(nil)
+)
+
+type sessionFactor struct{}
+
+func (f sessionFactor) qualifiedTableName() string {
+	return "zitadel." + f.unqualifiedTableName()
+}
+
+func (sessionFactor) unqualifiedTableName() string {
+	return "session_factors"
+}
+
+// PrimaryKeyCondition implements [domain.SessionFactorConditions].
+func (f sessionFactor) PrimaryKeyCondition(instanceID, sessionID string, factorType domain.SessionFactorType) database.Condition {
+	return database.And(
+		f.InstanceIDCondition(instanceID),
+		f.SessionIDCondition(sessionID),
+		f.FactorTypeCondition(factorType),
+	)
+}
+
+// InstanceIDCondition implements [domain.SessionFactorConditions].
+func (f sessionFactor) InstanceIDCondition(instanceID string) database.Condition {
+	return database.NewTextCondition(f.InstanceIDColumn(), database.TextOperationEqual, instanceID)
+}
+
+// SessionIDCondition implements [domain.SessionFactorConditions].
+func (f sessionFactor) SessionIDCondition(sessionID string) database.Condition {
+	return database.NewTextCondition(f.SessionIDColumn(), database.TextOperationEqual, sessionID)
+}
+
+// FactorTypeCondition implements [domain.SessionFactorConditions].
+func (f sessionFactor) FactorTypeCondition(factorType domain.SessionFactorType) database.Condition {
+	return database.NewNumberCondition(f.FactorTypeColumn(), database.NumberOperationEqual, factorType)
+}
+
+// FactorLastVerifiedBeforeCondition implements [domain.SessionFactorConditions].
+func (f sessionFactor) FactorLastVerifiedBeforeCondition(lastVerifiedAt time.Time) database.Condition {
+	return database.NewNumberCondition(f.LastVerifiedAtColumn(), database.NumberOperationLessThan, lastVerifiedAt)
+}
+
+// InstanceIDColumn implements [domain.SessionFactorColumns].
+func (f sessionFactor) InstanceIDColumn() database.Column {
+	return database.NewColumn(f.unqualifiedTableName(), "instance_id")
+}
+
+// SessionIDColumn implements [domain.SessionFactorColumns].
+func (f sessionFactor) SessionIDColumn() database.Column {
+	return database.NewColumn(f.unqualifiedTableName(), "session_id")
+}
+
+// FactorTypeColumn implements [domain.SessionFactorColumns].
+func (f sessionFactor) FactorTypeColumn() database.Column {
+	return database.NewColumn(f.unqualifiedTableName(), "type")
+}
+
+// LastChallengedAtColumn implements [domain.SessionFactorColumns].
+func (f sessionFactor) LastChallengedAtColumn() database.Column {
+	return database.NewColumn(f.unqualifiedTableName(), "last_challenged_at")
+}
+
+// LastFailedAtColumn implements [domain.SessionFactorColumns].
+func (f sessionFactor) LastFailedAtColumn() database.Column {
+	return database.NewColumn(f.unqualifiedTableName(), "last_failed_at")
+}
+
+// LastVerifiedAtColumn implements [domain.SessionFactorColumns].
+func (f sessionFactor) LastVerifiedAtColumn() database.Column {
+	return database.NewColumn(f.unqualifiedTableName(), "last_verified_at")
+}
+
+// -------------------------------------------------------------
+// metadata
+// -------------------------------------------------------------
+
+var (
+	_ domain.SessionMetadataColumns    = (*sessionMetadata)(nil)
+	_ domain.SessionMetadataConditions = (*sessionMetadata)(nil)
+)
+
+type sessionMetadata struct{}
+
+func (m sessionMetadata) qualifiedTableName() string {
+	return "zitadel." + m.unqualifiedTableName()
+}
+
+func (sessionMetadata) unqualifiedTableName() string {
+	return "session_metadata"
+}
+
+// PrimaryKeyCondition implements [domain.SessionMetadataConditions].
+func (m sessionMetadata) PrimaryKeyCondition(instanceID, sessionID, key string) database.Condition {
+	return database.And(
+		m.InstanceIDCondition(instanceID),
+		m.SessionIDCondition(sessionID),
+		m.KeyCondition(database.TextOperationEqual, key),
+	)
+}
+
+// InstanceIDCondition implements [domain.SessionMetadataConditions].
+func (m sessionMetadata) InstanceIDCondition(instanceID string) database.Condition {
+	return database.NewTextCondition(m.InstanceIDColumn(), database.TextOperationEqual, instanceID)
+}
+
+// SessionIDCondition implements [domain.SessionMetadataConditions].
+func (m sessionMetadata) SessionIDCondition(sessionID string) database.Condition {
+	return database.NewTextCondition(m.SessionIDColumn(), database.TextOperationEqual, sessionID)
+}
+
+// KeyCondition implements [domain.SessionMetadataConditions].
+func (m sessionMetadata) KeyCondition(op database.TextOperation, key string) database.Condition {
+	return database.NewTextCondition(m.KeyColumn(), op, key)
+}
+
+// ValueCondition implements [domain.SessionMetadataConditions].
+func (m sessionMetadata) ValueCondition(op database.BytesOperation, value []byte) database.Condition {
+	return database.NewBytesCondition[[]byte](database.SHA256Column(m.ValueColumn()), op, database.SHA256Value(value))
+}
+
+// PrimaryKeyColumns implements [domain.SessionMetadataColumns].
+func (m sessionMetadata) PrimaryKeyColumns() []database.Column {
+	return []database.Column{m.InstanceIDColumn(), m.SessionIDColumn(), m.KeyColumn()}
+}
+
+// InstanceIDColumn implements [domain.SessionMetadataColumns].
+func (m sessionMetadata) InstanceIDColumn() database.Column {
+	return database.NewColumn(m.unqualifiedTableName(), "instance_id")
+}
+
+// SessionIDColumn implements [domain.SessionMetadataColumns].
+func (m sessionMetadata) SessionIDColumn() database.Column {
+	return database.NewColumn(m.unqualifiedTableName(), "session_id")
+}
+
+// KeyColumn implements [domain.SessionMetadataColumns].
+func (m sessionMetadata) KeyColumn() database.Column {
+	return database.NewColumn(m.unqualifiedTableName(), "key")
+}
+
+// ValueColumn implements [domain.SessionMetadataColumns].
+func (m sessionMetadata) ValueColumn() database.Column {
+	return database.NewColumn(m.unqualifiedTableName(), "value")
+}
+
+// CreatedAtColumn implements [domain.SessionMetadataColumns].
+func (m sessionMetadata) CreatedAtColumn() database.Column {
+	return database.NewColumn(m.unqualifiedTableName(), "created_at")
+}
+
+// UpdatedAtColumn implements [domain.SessionMetadataColumns].
+func (m sessionMetadata) UpdatedAtColumn() database.Column {
+	return database.NewColumn(m.unqualifiedTableName(), "updated_at")
+}
+
+// -------------------------------------------------------------
+// user agents
+// -------------------------------------------------------------
+
+var (
+	_ domain.SessionUserAgentColumns    = (*sessionUserAgent)(nil)
+	_ domain.SessionUserAgentConditions = (*sessionUserAgent)(nil)
+)
+
+type sessionUserAgent struct{}
+
+func (a sessionUserAgent) qualifiedTableName() string {
+	return "zitadel." + a.unqualifiedTableName()
+}
+
+func (sessionUserAgent) unqualifiedTableName() string {
+	return "session_user_agents"
+}
+
+// PrimaryKeyCondition implements [domain.SessionUserAgentConditions].
+func (a sessionUserAgent) PrimaryKeyCondition(instanceID, fingerprintID string) database.Condition {
+	return database.And(
+		a.InstanceIDCondition(instanceID),
+		a.FingerprintIDCondition(fingerprintID),
+	)
+}
+
+// InstanceIDCondition implements [domain.SessionUserAgentConditions].
+func (a sessionUserAgent) InstanceIDCondition(instanceID string) database.Condition {
+	return database.NewTextCondition(a.InstanceIDColumn(), database.TextOperationEqual, instanceID)
+}
+
+// FingerprintIDCondition implements [domain.SessionUserAgentConditions].
+func (a sessionUserAgent) FingerprintIDCondition(fingerprintID string) database.Condition {
+	return database.NewTextCondition(a.FingerprintIDColumn(), database.TextOperationEqual, fingerprintID)
+}
+
+// InstanceIDColumn implements [domain.SessionUserAgentColumns].
+func (a sessionUserAgent) InstanceIDColumn() database.Column {
+	return database.NewColumn(a.unqualifiedTableName(), "instance_id")
+}
+
+// FingerprintIDColumn implements [domain.SessionUserAgentColumns].
+func (a sessionUserAgent) FingerprintIDColumn() database.Column {
+	return database.NewColumn(a.unqualifiedTableName(), "fingerprint_id")
+}
+
+// DescriptionColumn implements [domain.SessionUserAgentColumns].
+func (a sessionUserAgent) DescriptionColumn() database.Column {
+	return database.NewColumn(a.unqualifiedTableName(), "description")
+}
+
+// IPColumn implements [domain.SessionUserAgentColumns].
+func (a sessionUserAgent) IPColumn() database.Column {
+	return database.NewColumn(a.unqualifiedTableName(), "ip")
+}
+
+// HeadersColumn implements [domain.SessionUserAgentColumns].
+func (a sessionUserAgent) HeadersColumn() database.Column {
+	return database.NewColumn(a.unqualifiedTableName(), "headers")
+}
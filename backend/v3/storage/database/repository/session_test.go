@@ -0,0 +1,159 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/backend/v3/domain"
+	"github.com/zitadel/zitadel/backend/v3/storage/database"
+	"github.com/zitadel/zitadel/backend/v3/storage/database/repository"
+)
+
+func TestCreateSession(t *testing.T) {
+	tx, rollback := transactionForRollback(t)
+	defer rollback()
+	instanceID := createInstance(t, tx)
+	sessionRepo := repository.SessionRepository()
+
+	session := &domain.Session{
+		InstanceID: instanceID,
+		ID:         gofakeit.UUID(),
+		Lifetime:   time.Hour,
+		Metadata: []domain.SessionMetadata{
+			{Metadata: domain.Metadata{InstanceID: instanceID, Key: "key", Value: []byte("value")}},
+		},
+		UserAgent: &domain.SessionUserAgent{
+			InstanceID:    instanceID,
+			FingerprintID: gu.Ptr("fingerprint"),
+			Description:   gu.Ptr("browser"),
+		},
+	}
+	err := sessionRepo.Create(t.Context(), tx, session)
+	require.NoError(t, err)
+	assert.NotZero(t, session.CreatedAt)
+
+	got, err := sessionRepo.Get(t.Context(), tx, database.WithCondition(sessionRepo.PrimaryKeyCondition(instanceID, session.ID)))
+	require.NoError(t, err)
+	assert.Equal(t, session.ID, got.ID)
+	assert.Equal(t, time.Hour, got.Lifetime)
+	require.Len(t, got.Metadata, 1)
+	assert.Equal(t, "key", got.Metadata[0].Key)
+	require.NotNil(t, got.UserAgent)
+	assert.Equal(t, "fingerprint", *got.UserAgent.FingerprintID)
+}
+
+func TestUpdateSession(t *testing.T) {
+	tx, rollback := transactionForRollback(t)
+	defer rollback()
+	instanceID := createInstance(t, tx)
+	orgID := createOrganization(t, tx, instanceID)
+	userID := createUser(t, tx, instanceID, orgID)
+	sessionRepo := repository.SessionRepository()
+	session := &domain.Session{
+		InstanceID: instanceID,
+		ID:         gofakeit.UUID(),
+	}
+	require.NoError(t, sessionRepo.Create(t.Context(), tx, session))
+	verifiedAt := time.Now().Round(time.Millisecond)
+
+	tests := []struct {
+		name    string
+		changes []database.Change
+		assert  func(t *testing.T, session *domain.Session)
+	}{
+		{
+			name:    "set token",
+			changes: []database.Change{sessionRepo.SetToken("token")},
+			assert: func(t *testing.T, session *domain.Session) {
+				assert.Equal(t, "token", session.Token)
+			},
+		},
+		{
+			name:    "set user factor",
+			changes: []database.Change{sessionRepo.SetFactor(&domain.SessionFactorUser{UserID: userID, LastVerifiedAt: verifiedAt})},
+			assert: func(t *testing.T, session *domain.Session) {
+				assert.Equal(t, userID, session.UserID)
+				factor := session.Factors.GetUserFactor()
+				require.NotNil(t, factor)
+				assert.True(t, verifiedAt.Equal(factor.LastVerifiedAt))
+			},
+		},
+		{
+			name: "clear user factor",
+			changes: []database.Change{
+				sessionRepo.SetFactor(&domain.SessionFactorUser{UserID: userID, LastVerifiedAt: verifiedAt}),
+				sessionRepo.ClearFactor(domain.SessionFactorTypeUser),
+			},
+			assert: func(t *testing.T, session *domain.Session) {
+				assert.Empty(t, session.UserID)
+				assert.Nil(t, session.Factors.GetUserFactor())
+			},
+		},
+		{
+			name: "remove metadata with empty value",
+			changes: []database.Change{
+				sessionRepo.SetMetadata([]domain.SessionMetadata{
+					{Metadata: domain.Metadata{InstanceID: instanceID, Key: "first", Value: []byte("1")}},
+					{Metadata: domain.Metadata{InstanceID: instanceID, Key: "second", Value: []byte("2")}},
+				}),
+				sessionRepo.SetMetadata([]domain.SessionMetadata{
+					{Metadata: domain.Metadata{InstanceID: instanceID, Key: "first"}},
+				}),
+			},
+			assert: func(t *testing.T, session *domain.Session) {
+				require.Len(t, session.Metadata, 1)
+				assert.Equal(t, "second", session.Metadata[0].Key)
+			},
+		},
+		{
+			name:    "set lifetime",
+			changes: []database.Change{sessionRepo.SetLifetime(time.Minute)},
+			assert: func(t *testing.T, session *domain.Session) {
+				assert.Equal(t, time.Minute, session.Lifetime)
+				assert.False(t, session.Expiration.IsZero())
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			savepoint, rollback := savepointForRollback(t, tx)
+			defer rollback()
+
+			for _, change := range test.changes {
+				rowsAffected, err := sessionRepo.Update(t.Context(), savepoint, sessionRepo.PrimaryKeyCondition(instanceID, session.ID), change)
+				require.NoError(t, err)
+				assert.EqualValues(t, 1, rowsAffected)
+			}
+
+			got, err := sessionRepo.Get(t.Context(), savepoint, database.WithCondition(sessionRepo.PrimaryKeyCondition(instanceID, session.ID)))
+			require.NoError(t, err)
+			test.assert(t, got)
+		})
+	}
+}
+
+func TestDeleteSession(t *testing.T) {
+	tx, rollback := transactionForRollback(t)
+	defer rollback()
+	instanceID := createInstance(t, tx)
+	sessionRepo := repository.SessionRepository()
+	session := &domain.Session{
+		InstanceID: instanceID,
+		ID:         gofakeit.UUID(),
+	}
+	require.NoError(t, sessionRepo.Create(t.Context(), tx, session))
+
+	err := sessionRepo.Delete(t.Context(), tx, sessionRepo.IDCondition(session.ID))
+	require.ErrorIs(t, err, database.NewMissingConditionError(sessionRepo.InstanceIDColumn()))
+
+	err = sessionRepo.Delete(t.Context(), tx, sessionRepo.PrimaryKeyCondition(instanceID, session.ID))
+	require.NoError(t, err)
+
+	_, err = sessionRepo.Get(t.Context(), tx, database.WithCondition(sessionRepo.PrimaryKeyCondition(instanceID, session.ID)))
+	require.ErrorIs(t, err, new(database.NoRowFoundError))
+}
@@ -0,0 +1,904 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/backend/v3/domain"
+	"github.com/zitadel/zitadel/backend/v3/storage/database"
+)
+
+// -------------------------------------------------------------
+// repository
+// -------------------------------------------------------------
+
+var _ domain.UserRepository = (*user)(nil)
+
+type user struct {
+	shouldLoadMetadata bool
+	metadataRepo       userMetadata
+	domainRepo         orgDomain
+}
+
+// UserRepository manages human and machine users.
+func UserRepository() domain.UserRepository {
+	return new(user)
+}
+
+func (u user) qualifiedTableName() string {
+	return "zitadel." + u.unqualifiedTableName()
+}
+
+func (user) unqualifiedTableName() string {
+	return "users"
+}
+
+// loginNameIncludesDomainExpr resolves if the login names of the user are suffixed with the domains of the organization.
+// The domain settings of the organization take precedence over the ones of the instance.
+const loginNameIncludesDomainExpr = `COALESCE(` +
+	`(SELECT (settings.settings->>'loginNameIncludesDomain')::BOOLEAN FROM zitadel.settings WHERE settings.instance_id = users.instance_id AND settings.organization_id = users.organization_id AND settings.type = 'domain' AND settings.state = 'active')` +
+	`, (SELECT (settings.settings->>'loginNameIncludesDomain')::BOOLEAN FROM zitadel.settings WHERE settings.instance_id = users.instance_id AND settings.organization_id IS NULL AND settings.type = 'domain' AND settings.state = 'active')` +
+	`, FALSE)`
+
+// loginNamesExpr returns the username suffixed with each verified domain of the organization if the domain is included,
+// otherwise only the username.
+const loginNamesExpr = `CASE WHEN ` + loginNameIncludesDomainExpr + ` THEN COALESCE(` +
+	`(SELECT array_agg(users.username || '@' || org_domains.domain ORDER BY org_domains.domain) FROM zitadel.org_domains WHERE org_domains.instance_id = users.instance_id AND org_domains.org_id = users.organization_id AND org_domains.is_verified)` +
+	`, ARRAY[users.username]) ELSE ARRAY[users.username] END`
+
+// preferredLoginNameExpr returns the username suffixed with the primary domain of the organization if the domain is included,
+// otherwise only the username.
+const preferredLoginNameExpr = `CASE WHEN ` + loginNameIncludesDomainExpr + ` THEN COALESCE(` +
+	`(SELECT users.username || '@' || org_domains.domain FROM zitadel.org_domains WHERE org_domains.instance_id = users.instance_id AND org_domains.org_id = users.organization_id AND org_domains.is_primary)` +
+	`, users.username) ELSE users.username END`
+
+const queryUserStmt = `SELECT users.instance_id, users.organization_id, users.id, users.username, users.state, users.created_at, users.updated_at` +
+	` , ` + loginNamesExpr + ` AS login_names` +
+	` , ` + preferredLoginNameExpr + ` AS preferred_login_name` +
+	` , CASE WHEN users.type = 'human' THEN jsonb_build_object(` +
+	`'firstName', users.first_name, 'lastName', users.last_name, 'nickname', users.nickname, 'displayName', users.display_name` +
+	`, 'preferredLanguage', users.preferred_language, 'gender', users.gender, 'avatarKey', users.avatar_key` +
+	`, 'multifactorInitializationSkippedAt', users.multifactor_initialization_skipped_at` +
+	`, 'email', jsonb_build_object('address', users.email, 'verifiedAt', users.email_verified_at)` +
+	`, 'phone', CASE WHEN users.phone IS NOT NULL THEN jsonb_build_object('number', users.phone, 'verifiedAt', users.phone_verified_at) END` +
+	`, 'password', jsonb_build_object('isChangeRequired', users.password_change_required, 'verifiedAt', users.password_verified_at, 'failedAttempts', users.password_failed_attempts)` +
+	`) END AS human` +
+	` , CASE WHEN users.type = 'machine' THEN jsonb_build_object(` +
+	`'name', users.name, 'description', users.description, 'secret', users.secret, 'access_token_type', users.access_token_type` +
+	`) END AS machine` +
+	` , jsonb_agg(jsonb_build_object('instanceId', user_metadata.instance_id, 'userId', user_metadata.user_id, 'key', user_metadata.key, 'value', encode(user_metadata.value, 'base64'), 'createdAt', user_metadata.created_at, 'updatedAt', user_metadata.updated_at)) FILTER (WHERE user_metadata.user_id IS NOT NULL) AS metadata` +
+	` FROM zitadel.users`
+
+// Get implements [domain.UserRepository].
+func (u user) Get(ctx context.Context, client database.QueryExecutor, opts ...database.QueryOption) (*domain.User, error) {
+	builder, err := u.prepareQuery(opts)
+	if err != nil {
+		return nil, err
+	}
+	return scanUser(ctx, client, builder)
+}
+
+// List implements [domain.UserRepository].
+func (u user) List(ctx context.Context, client database.QueryExecutor, opts ...database.QueryOption) ([]*domain.User, error) {
+	builder, err := u.prepareQuery(opts)
+	if err != nil {
+		return nil, err
+	}
+	return scanUsers(ctx, client, builder)
+}
+
+// Count implements [domain.UserRepository].
+func (u user) Count(ctx context.Context, client database.QueryExecutor, condition database.Condition) (uint64, error) {
+	if err := checkRestrictingColumns(condition, u.InstanceIDColumn()); err != nil {
+		return 0, err
+	}
+
+	builder := database.NewStatementBuilder(`SELECT COUNT(*) FROM ` + u.qualifiedTableName())
+	writeCondition(builder, condition)
+
+	var count uint64
+	err := client.QueryRow(ctx, builder.String(), builder.Args()...).Scan(&count)
+	return count, err
+}
+
+func (u user) prepareQuery(opts []database.QueryOption) (*database.StatementBuilder, error) {
+	opts = append(opts,
+		u.joinMetadata(),
+		database.WithGroupBy(u.InstanceIDColumn(), u.IDColumn()),
+	)
+
+	options := new(database.QueryOpts)
+	for _, opt := range opts {
+		opt(options)
+	}
+	if err := checkRestrictingColumns(options.Condition, u.InstanceIDColumn()); err != nil {
+		return nil, err
+	}
+
+	builder := database.NewStatementBuilder(queryUserStmt)
+	options.Write(builder)
+	return builder, nil
+}
+
+// Create implements [domain.UserRepository].
+// Either the human or the machine field of the user must be set.
+func (u user) Create(ctx context.Context, client database.QueryExecutor, user *domain.User) error {
+	var createdAt, updatedAt any = database.DefaultInstruction, database.DefaultInstruction
+	if !user.CreatedAt.IsZero() {
+		createdAt = user.CreatedAt
+	}
+	if !user.UpdatedAt.IsZero() {
+		updatedAt = user.UpdatedAt
+	}
+
+	builder := database.NewStatementBuilder(`INSERT INTO ` + u.qualifiedTableName() + ` (instance_id, organization_id, id, username, state, created_at, updated_at, type`)
+	var values []any
+	switch {
+	case user.Human != nil:
+		human := user.Human
+		builder.WriteString(`, first_name, last_name, nickname, display_name, preferred_language, gender, avatar_key` +
+			`, multifactor_initialization_skipped_at, email, email_verified_at, phone, phone_verified_at` +
+			`, password, password_change_required, password_verified_at`)
+		var phone, phoneVerifiedAt any = database.NullInstruction, database.NullInstruction
+		if human.Phone != nil {
+			phone, phoneVerifiedAt = human.Phone.Number, nullIfZero(human.Phone.VerifiedAt)
+		}
+		values = []any{
+			domain.UserTypeHuman.String(),
+			human.FirstName,
+			human.LastName,
+			nullIfZero(human.Nickname),
+			nullIfZero(human.DisplayName),
+			nullIfZero(languageValue(human.PreferredLanguage)),
+			nullIfZero(human.Gender),
+			nullIfZero(human.AvatarKey),
+			nullIfZero(human.MultifactorInitializationSkippedAt),
+			human.Email.Address,
+			nullIfZero(human.Email.VerifiedAt),
+			phone,
+			phoneVerifiedAt,
+			nullIfZero(human.Password.Password),
+			human.Password.IsChangeRequired,
+			nullIfZero(human.Password.VerifiedAt),
+		}
+	case user.Machine != nil:
+		machine := user.Machine
+		builder.WriteString(`, name, description, secret, access_token_type`)
+		values = []any{
+			domain.UserTypeMachine.String(),
+			machine.Name,
+			nullIfZero(machine.Description),
+			nullIfZero(machine.Secret),
+			nullIfZero(machine.AccessTokenType),
+		}
+	default:
+		return errors.New("repository: user must either be human or machine")
+	}
+
+	builder.WriteString(`) VALUES (`)
+	builder.WriteArgs(user.InstanceID, user.OrganizationID, user.ID, user.Username, user.State, createdAt, updatedAt)
+	builder.WriteString(`, `)
+	builder.WriteArgs(values...)
+	builder.WriteString(`) RETURNING created_at, updated_at`)
+
+	if err := client.QueryRow(ctx, builder.String(), builder.Args()...).Scan(&user.CreatedAt, &user.UpdatedAt); err != nil {
+		return err
+	}
+	if len(user.Metadata) == 0 {
+		return nil
+	}
+
+	metadata := make([]*domain.Metadata, len(user.Metadata))
+	for i, md := range user.Metadata {
+		metadata[i] = &md.Metadata
+	}
+	_, err := u.Update(ctx, client, u.PrimaryKeyCondition(user.InstanceID, user.ID), u.AddMetadata(metadata...), u.SetUpdatedAt(user.UpdatedAt))
+	return err
+}
+
+// Update implements [domain.UserRepository].
+// The condition must at least restrict the instance id.
+func (u user) Update(ctx context.Context, client database.QueryExecutor, condition database.Condition, changes ...database.Change) (int64, error) {
+	if err := checkRestrictingColumns(condition, u.InstanceIDColumn()); err != nil {
+		return 0, err
+	}
+	return update(ctx, client, u, condition, changes...)
+}
+
+// Delete implements [domain.UserRepository].
+// The condition must at least restrict the instance id.
+func (u user) Delete(ctx context.Context, client database.QueryExecutor, condition database.Condition) (int64, error) {
+	if err := checkRestrictingColumns(condition, u.InstanceIDColumn()); err != nil {
+		return 0, err
+	}
+
+	builder := database.NewStatementBuilder(`DELETE FROM ` + u.qualifiedTableName())
+	writeCondition(builder, condition)
+
+	return client.Exec(ctx, builder.String(), builder.Args()...)
+}
+
+// Human implements [domain.UserRepository].
+func (u user) Human() domain.HumanUserRepository {
+	return userHuman{user: u}
+}
+
+// Machine implements [domain.UserRepository].
+func (u user) Machine() domain.MachineUserRepository {
+	return userMachine{user: u}
+}
+
+// -------------------------------------------------------------
+// changes
+// -------------------------------------------------------------
+
+// SetUsername implements [domain.userChanges].
+func (u user) SetUsername(username string) database.Change {
+	return database.NewChange(u.UsernameColumn(), username)
+}
+
+// SetState implements [domain.userChanges].
+func (u user) SetState(state domain.UserState) database.Change {
+	if state == domain.UserStateUnspecified {
+		return database.NewChanges()
+	}
+	return database.NewChange(u.StateColumn(), state)
+}
+
+// SetUpdatedAt implements [domain.userChanges].
+func (u user) SetUpdatedAt(updatedAt time.Time) database.Change {
+	return database.NewChange(u.UpdatedAtColumn(), updatedAt)
+}
+
+// AddMetadata implements [domain.userChanges].
+func (u user) AddMetadata(metadata ...*domain.Metadata) database.Change {
+	if len(metadata) == 0 {
+		return database.NewChanges()
+	}
+	return database.NewCTEChange(func(builder *database.StatementBuilder) {
+		builder.WriteString(`INSERT INTO ` + u.metadataRepo.qualifiedTableName() + ` (instance_id, user_id, key, value, created_at, updated_at)` +
+			` SELECT ` + existingRowsCTE + `.instance_id, ` + existingRowsCTE + `.id, md.key, md.value, COALESCE(md.created_at, NOW()), md.updated_at` +
+			` FROM ` + existingRowsCTE + ` CROSS JOIN (VALUES `)
+		for i, md := range metadata {
+			if i > 0 {
+				builder.WriteString(", ")
+			}
+			builder.WriteRune('(')
+			builder.WriteArg(md.Key)
+			builder.WriteString("::TEXT, ")
+			builder.WriteArg(md.Value)
+			builder.WriteString("::BYTEA, ")
+			builder.WriteArg(nullIfZero(md.CreatedAt))
+			builder.WriteString("::TIMESTAMPTZ, ")
+			builder.WriteArg(nullIfZero(md.UpdatedAt))
+			builder.WriteString("::TIMESTAMPTZ)")
+		}
+		builder.WriteString(`) AS md (key, value, created_at, updated_at)` +
+			` ON CONFLICT (instance_id, user_id, key) DO UPDATE SET value = EXCLUDED.value, updated_at = EXCLUDED.updated_at`)
+	}, nil)
+}
+
+// RemoveMetadata implements [domain.userChanges].
+func (u user) RemoveMetadata(condition database.Condition) database.Change {
+	return database.NewCTEChange(func(builder *database.StatementBuilder) {
+		builder.WriteString(`DELETE FROM ` + u.metadataRepo.qualifiedTableName() + ` USING ` + existingRowsCTE + ` WHERE `)
+		database.And(
+			database.NewColumnCondition(u.metadataRepo.InstanceIDColumn(), database.NewColumn(existingRowsCTE, "instance_id")),
+			database.NewColumnCondition(u.metadataRepo.UserIDColumn(), database.NewColumn(existingRowsCTE, "id")),
+		).Write(builder)
+		if condition != nil {
+			builder.WriteString(" AND ")
+			condition.Write(builder)
+		}
+	}, nil)
+}
+
+// -------------------------------------------------------------
+// conditions
+// -------------------------------------------------------------
+
+// PrimaryKeyCondition implements [domain.userConditions].
+func (u user) PrimaryKeyCondition(instanceID, userID string) database.Condition {
+	return database.And(
+		u.InstanceIDCondition(instanceID),
+		u.IDCondition(userID),
+	)
+}
+
+// InstanceIDCondition implements [domain.userConditions].
+func (u user) InstanceIDCondition(instanceID string) database.Condition {
+	return database.NewTextCondition(u.InstanceIDColumn(), database.TextOperationEqual, instanceID)
+}
+
+// OrgIDCondition implements [domain.userConditions].
+func (u user) OrgIDCondition(orgID string) database.Condition {
+	return database.NewTextCondition(u.OrgIDColumn(), database.TextOperationEqual, orgID)
+}
+
+// IDCondition implements [domain.userConditions].
+func (u user) IDCondition(userID string) database.Condition {
+	return database.NewTextCondition(u.IDColumn(), database.TextOperationEqual, userID)
+}
+
+// UsernameCondition implements [domain.userConditions].
+func (u user) UsernameCondition(op database.TextOperation, username string) database.Condition {
+	return database.NewTextCondition(u.UsernameColumn(), op, username)
+}
+
+// StateCondition implements [domain.userConditions].
+func (u user) StateCondition(state domain.UserState) database.Condition {
+	return database.NewTextCondition(u.StateColumn(), database.TextOperationEqual, state.String())
+}
+
+// TypeCondition implements [domain.userConditions].
+func (u user) TypeCondition(userType domain.UserType) database.Condition {
+	return database.NewTextCondition(u.TypeColumn(), database.TextOperationEqual, userType.String())
+}
+
+// LoginNameCondition implements [domain.userConditions].
+func (u user) LoginNameCondition(op database.TextOperation, loginName string) database.Condition {
+	return database.Or(
+		u.UsernameCondition(op, loginName),
+		database.Exists(
+			u.domainRepo.qualifiedTableName(),
+			database.And(
+				database.NewColumnCondition(u.InstanceIDColumn(), u.domainRepo.InstanceIDColumn()),
+				database.NewColumnCondition(u.OrgIDColumn(), u.domainRepo.OrgIDColumn()),
+				database.NewBooleanCondition(u.domainRepo.IsVerifiedColumn(), true),
+				database.NewTextCondition(loginNameColumn{username: u.UsernameColumn(), domain: u.domainRepo.DomainColumn()}, op, loginName),
+			),
+		),
+	)
+}
+
+// ExistsMetadata creates a correlated [database.Exists] condition on user_metadata.
+// Use this filter to make sure the user returned contains a specific metadata.
+// Example usage:
+//
+//	user, _ := userRepo.LoadMetadata().Get(ctx,
+//	    database.WithCondition(
+//	        database.And(
+//	            userRepo.InstanceIDCondition(instanceID),
+//	            userRepo.ExistsMetadata(userRepo.MetadataKeyCondition(database.TextOperationEqual, "my-key")),
+//	        ),
+//	    ),
+//	)
+func (u user) ExistsMetadata(condition database.Condition) database.Condition {
+	return database.Exists(
+		u.metadataRepo.qualifiedTableName(),
+		database.And(
+			database.NewColumnCondition(u.InstanceIDColumn(), u.metadataRepo.InstanceIDColumn()),
+			database.NewColumnCondition(u.IDColumn(), u.metadataRepo.UserIDColumn()),
+			condition,
+		),
+	)
+}
+
+// MetadataKeyCondition implements [domain.userMetadataConditions].
+func (u user) MetadataKeyCondition(op database.TextOperation, key string) database.Condition {
+	return database.NewTextCondition(u.metadataRepo.KeyColumn(), op, key)
+}
+
+// MetadataValueCondition implements [domain.userMetadataConditions].
+func (u user) MetadataValueCondition(op database.BytesOperation, value []byte) database.Condition {
+	return database.NewBytesCondition[[]byte](database.SHA256Column(u.metadataRepo.ValueColumn()), op, database.SHA256Value(value))
+}
+
+// -------------------------------------------------------------
+// columns
+// -------------------------------------------------------------
+
+// PrimaryKeyColumns implements [domain.Repository].
+func (u user) PrimaryKeyColumns() []database.Column {
+	return []database.Column{
+		u.InstanceIDColumn(),
+		u.IDColumn(),
+	}
+}
+
+// InstanceIDColumn implements [domain.userColumns].
+func (u user) InstanceIDColumn() database.Column {
+	return database.NewColumn(u.unqualifiedTableName(), "instance_id")
+}
+
+// OrgIDColumn implements [domain.userColumns].
+func (u user) OrgIDColumn() database.Column {
+	return database.NewColumn(u.unqualifiedTableName(), "organization_id")
+}
+
+// IDColumn implements [domain.userColumns].
+func (u user) IDColumn() database.Column {
+	return database.NewColumn(u.unqualifiedTableName(), "id")
+}
+
+// UsernameColumn implements [domain.userColumns].
+func (u user) UsernameColumn() database.Column {
+	return database.NewColumn(u.unqualifiedTableName(), "username")
+}
+
+// StateColumn implements [domain.userColumns].
+func (u user) StateColumn() database.Column {
+	return database.NewColumn(u.unqualifiedTableName(), "state")
+}
+
+// TypeColumn implements [domain.userColumns].
+func (u user) TypeColumn() database.Column {
+	return database.NewColumn(u.unqualifiedTableName(), "type")
+}
+
+// CreatedAtColumn implements [domain.userColumns].
+func (u user) CreatedAtColumn() database.Column {
+	return database.NewColumn(u.unqualifiedTableName(), "created_at")
+}
+
+// UpdatedAtColumn implements [domain.userColumns].
+func (u user) UpdatedAtColumn() database.Column {
+	return database.NewColumn(u.unqualifiedTableName(), "updated_at")
+}
+
+// loginNameColumn represents the username suffixed with a domain of the organization.
+type loginNameColumn struct {
+	username database.Column
+	domain   database.Column
+}
+
+// Matches implements [database.Column].
+func (c loginNameColumn) Matches(x any) bool {
+	toMatch, ok := x.(loginNameColumn)
+	if !ok {
+		return false
+	}
+	return c.Equals(toMatch)
+}
+
+// String implements [database.Column].
+func (c loginNameColumn) String() string {
+	return "repository.loginNameColumn"
+}
+
+// WriteQualified implements [database.Column].
+func (c loginNameColumn) WriteQualified(builder *database.StatementBuilder) {
+	builder.WriteRune('(')
+	c.username.WriteQualified(builder)
+	builder.WriteString(" || '@' || ")
+	c.domain.WriteQualified(builder)
+	builder.WriteRune(')')
+}
+
+// WriteUnqualified implements [database.Column].
+// The columns are of different tables, therefore they are always qualified.
+func (c loginNameColumn) WriteUnqualified(builder *database.StatementBuilder) {
+	c.WriteQualified(builder)
+}
+
+// Equals implements [database.Column].
+func (c loginNameColumn) Equals(col database.Column) bool {
+	toMatch, ok := col.(loginNameColumn)
+	if !ok {
+		return false
+	}
+	return c.username.Equals(toMatch.username) && c.domain.Equals(toMatch.domain)
+}
+
+var _ database.Column = loginNameColumn{}
+
+// -------------------------------------------------------------
+// scanners
+// -------------------------------------------------------------
+
+type rawUser struct {
+	*domain.User
+	Human    JSONObject[domain.HumanUser]   `json:"human,omitempty" db:"human"`
+	Machine  JSONObject[domain.MachineUser] `json:"machine,omitempty" db:"machine"`
+	Metadata JSONArray[domain.UserMetadata] `json:"metadata,omitempty" db:"metadata"`
+}
+
+func (u *rawUser) toDomain() *domain.User {
+	u.User.Human = u.Human.Value
+	u.User.Machine = u.Machine.Value
+	u.User.Metadata = u.Metadata
+	return u.User
+}
+
+func scanUser(ctx context.Context, querier database.Querier, builder *database.StatementBuilder) (*domain.User, error) {
+	rows, err := querier.Query(ctx, builder.String(), builder.Args()...)
+	if err != nil {
+		return nil, err
+	}
+
+	var user rawUser
+	if err := rows.(database.CollectableRows).CollectExactlyOneRow(&user); err != nil {
+		return nil, err
+	}
+	return user.toDomain(), nil
+}
+
+func scanUsers(ctx context.Context, querier database.Querier, builder *database.StatementBuilder) ([]*domain.User, error) {
+	rows, err := querier.Query(ctx, builder.String(), builder.Args()...)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []*rawUser
+	if err := rows.(database.CollectableRows).Collect(&users); err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.User, len(users))
+	for i, user := range users {
+		result[i] = user.toDomain()
+	}
+	return result, nil
+}
+
+// -------------------------------------------------------------
+// sub repositories
+// -------------------------------------------------------------
+
+// LoadMetadata implements [domain.UserRepository].
+func (u user) LoadMetadata() domain.UserRepository {
+	return &user{
+		shouldLoadMetadata: true,
+	}
+}
+
+func (u user) joinMetadata() database.QueryOption {
+	columns := make([]database.Condition, 0, 3)
+	columns = append(columns,
+		database.NewColumnCondition(u.InstanceIDColumn(), u.metadataRepo.InstanceIDColumn()),
+		database.NewColumnCondition(u.IDColumn(), u.metadataRepo.UserIDColumn()),
+	)
+
+	// If metadata should not be joined, we make sure to return null for the metadata columns
+	// the query optimizer of the dialect should optimize this away if no metadata are requested
+	if !u.shouldLoadMetadata {
+		columns = append(columns, database.IsNull(u.metadataRepo.UserIDColumn()))
+	}
+
+	return database.WithLeftJoin(
+		u.metadataRepo.qualifiedTableName(),
+		database.And(columns...),
+	)
+}
+
+// -------------------------------------------------------------
+// human
+// -------------------------------------------------------------
+
+var _ domain.HumanUserRepository = (*userHuman)(nil)
+
+type userHuman struct {
+	user
+}
+
+// Update implements [domain.HumanUserRepository].
+func (h userHuman) Update(ctx context.Context, client database.QueryExecutor, condition database.Condition, changes ...database.Change) (int64, error) {
+	return h.user.Update(ctx, client, database.And(condition, h.TypeCondition(domain.UserTypeHuman)), changes...)
+}
+
+// SetFirstName implements [domain.humanChanges].
+func (h userHuman) SetFirstName(firstName string) database.Change {
+	return database.NewChange(h.FirstNameColumn(), firstName)
+}
+
+// SetLastName implements [domain.humanChanges].
+func (h userHuman) SetLastName(lastName string) database.Change {
+	return database.NewChange(h.LastNameColumn(), lastName)
+}
+
+// SetNickname implements [domain.humanChanges].
+func (h userHuman) SetNickname(nickname string) database.Change {
+	return database.NewChange(h.NicknameColumn(), nickname)
+}
+
+// SetDisplayName implements [domain.humanChanges].
+func (h userHuman) SetDisplayName(displayName string) database.Change {
+	return database.NewChange(h.DisplayNameColumn(), displayName)
+}
+
+// SetPreferredLanguage implements [domain.humanChanges].
+func (h userHuman) SetPreferredLanguage(preferredLanguage language.Tag) database.Change {
+	if preferredLanguage == language.Und {
+		return database.NewChangeToNull(h.preferredLanguageColumn())
+	}
+	return database.NewChange(h.preferredLanguageColumn(), preferredLanguage.String())
+}
+
+// SetGender implements [domain.humanChanges].
+func (h userHuman) SetGender(gender domain.HumanGender) database.Change {
+	if gender == domain.HumanGenderUnspecified {
+		return database.NewChangeToNull(h.genderColumn())
+	}
+	return database.NewChange(h.genderColumn(), gender)
+}
+
+// SetAvatarKey implements [domain.humanChanges].
+func (h userHuman) SetAvatarKey(avatarKey *string) database.Change {
+	return database.NewChangePtr(h.avatarKeyColumn(), avatarKey)
+}
+
+// SetMultifactorInitializationSkippedAt implements [domain.humanChanges].
+func (h userHuman) SetMultifactorInitializationSkippedAt(skippedAt time.Time) database.Change {
+	if skippedAt.IsZero() {
+		return database.NewChangeToNull(h.multifactorInitializationSkippedAtColumn())
+	}
+	return database.NewChange(h.multifactorInitializationSkippedAtColumn(), skippedAt)
+}
+
+// SetPassword implements [domain.humanChanges].
+func (h userHuman) SetPassword(hash string) database.Change {
+	return database.NewChanges(
+		database.NewChange(h.passwordColumn(), hash),
+		database.NewChange(h.passwordFailedAttemptsColumn(), 0),
+	)
+}
+
+// SetPasswordChangeRequired implements [domain.humanChanges].
+func (h userHuman) SetPasswordChangeRequired(required bool) database.Change {
+	return database.NewChange(h.passwordChangeRequiredColumn(), required)
+}
+
+// CheckPassword implements [domain.humanChanges].
+func (h userHuman) CheckPassword(check domain.PasswordCheckType) database.Change {
+	switch check := check.(type) {
+	case *domain.CheckTypeFailed:
+		return database.NewIncrementColumnChange(h.passwordFailedAttemptsColumn())
+	case *domain.CheckTypeSucceeded:
+		verifiedAt := database.NewChange(h.passwordVerifiedAtColumn(), database.NowInstruction)
+		if !check.SucceededAt.IsZero() {
+			verifiedAt = database.NewChange(h.passwordVerifiedAtColumn(), check.SucceededAt)
+		}
+		return database.NewChanges(
+			database.NewChange(h.passwordFailedAttemptsColumn(), 0),
+			verifiedAt,
+		)
+	}
+	return database.NewChanges()
+}
+
+// SetEmail implements [domain.humanEmailChanges].
+func (h userHuman) SetEmail(address string) database.Change {
+	return database.NewChanges(
+		database.NewChange(h.EmailColumn(), address),
+		database.NewChangeToNull(h.emailVerifiedAtColumn()),
+	)
+}
+
+// VerifyEmail implements [domain.humanEmailChanges].
+func (h userHuman) VerifyEmail(verifiedAt time.Time) database.Change {
+	if verifiedAt.IsZero() {
+		return database.NewChange(h.emailVerifiedAtColumn(), database.NowInstruction)
+	}
+	return database.NewChange(h.emailVerifiedAtColumn(), verifiedAt)
+}
+
+// SetPhone implements [domain.humanPhoneChanges].
+func (h userHuman) SetPhone(number string) database.Change {
+	return database.NewChanges(
+		database.NewChange(h.phoneColumn(), number),
+		database.NewChangeToNull(h.phoneVerifiedAtColumn()),
+	)
+}
+
+// VerifyPhone implements [domain.humanPhoneChanges].
+func (h userHuman) VerifyPhone(verifiedAt time.Time) database.Change {
+	if verifiedAt.IsZero() {
+		return database.NewChange(h.phoneVerifiedAtColumn(), database.NowInstruction)
+	}
+	return database.NewChange(h.phoneVerifiedAtColumn(), verifiedAt)
+}
+
+// RemovePhone implements [domain.humanPhoneChanges].
+func (h userHuman) RemovePhone() database.Change {
+	return database.NewChanges(
+		database.NewChangeToNull(h.phoneColumn()),
+		database.NewChangeToNull(h.phoneVerifiedAtColumn()),
+	)
+}
+
+// FirstNameCondition implements [domain.humanConditions].
+func (h userHuman) FirstNameCondition(op database.TextOperation, firstName string) database.Condition {
+	return database.NewTextCondition(h.FirstNameColumn(), op, firstName)
+}
+
+// LastNameCondition implements [domain.humanConditions].
+func (h userHuman) LastNameCondition(op database.TextOperation, lastName string) database.Condition {
+	return database.NewTextCondition(h.LastNameColumn(), op, lastName)
+}
+
+// NicknameCondition implements [domain.humanConditions].
+func (h userHuman) NicknameCondition(op database.TextOperation, nickname string) database.Condition {
+	return database.NewTextCondition(h.NicknameColumn(), op, nickname)
+}
+
+// DisplayNameCondition implements [domain.humanConditions].
+func (h userHuman) DisplayNameCondition(op database.TextOperation, displayName string) database.Condition {
+	return database.NewTextCondition(h.DisplayNameColumn(), op, displayName)
+}
+
+// PreferredLanguageCondition implements [domain.humanConditions].
+func (h userHuman) PreferredLanguageCondition(lang language.Tag) database.Condition {
+	return database.NewTextCondition(h.preferredLanguageColumn(), database.TextOperationEqual, lang.String())
+}
+
+// EmailCondition implements [domain.humanConditions].
+func (h userHuman) EmailCondition(op database.TextOperation, email string) database.Condition {
+	return database.NewTextCondition(h.EmailColumn(), op, email)
+}
+
+// PhoneCondition implements [domain.humanConditions].
+func (h userHuman) PhoneCondition(op database.TextOperation, phone string) database.Condition {
+	return database.NewTextCondition(h.phoneColumn(), op, phone)
+}
+
+// FirstNameColumn implements [domain.humanColumns].
+func (h userHuman) FirstNameColumn() database.Column {
+	return database.NewColumn(h.unqualifiedTableName(), "first_name")
+}
+
+// LastNameColumn implements [domain.humanColumns].
+func (h userHuman) LastNameColumn() database.Column {
+	return database.NewColumn(h.unqualifiedTableName(), "last_name")
+}
+
+// NicknameColumn implements [domain.humanColumns].
+func (h userHuman) NicknameColumn() database.Column {
+	return database.NewColumn(h.unqualifiedTableName(), "nickname")
+}
+
+// DisplayNameColumn implements [domain.humanColumns].
+func (h userHuman) DisplayNameColumn() database.Column {
+	return database.NewColumn(h.unqualifiedTableName(), "display_name")
+}
+
+// EmailColumn implements [domain.humanColumns].
+func (h userHuman) EmailColumn() database.Column {
+	return database.NewColumn(h.unqualifiedTableName(), "email")
+}
+
+func (h userHuman) preferredLanguageColumn() database.Column {
+	return database.NewColumn(h.unqualifiedTableName(), "preferred_language")
+}
+
+func (h userHuman) genderColumn() database.Column {
+	return database.NewColumn(h.unqualifiedTableName(), "gender")
+}
+
+func (h userHuman) avatarKeyColumn() database.Column {
+	return database.NewColumn(h.unqualifiedTableName(), "avatar_key")
+}
+
+func (h userHuman) multifactorInitializationSkippedAtColumn() database.Column {
+	return database.NewColumn(h.unqualifiedTableName(), "multifactor_initialization_skipped_at")
+}
+
+func (h userHuman) emailVerifiedAtColumn() database.Column {
+	return database.NewColumn(h.unqualifiedTableName(), "email_verified_at")
+}
+
+func (h userHuman) phoneColumn() database.Column {
+	return database.NewColumn(h.unqualifiedTableName(), "phone")
+}
+
+func (h userHuman) phoneVerifiedAtColumn() database.Column {
+	return database.NewColumn(h.unqualifiedTableName(), "phone_verified_at")
+}
+
+func (h userHuman) passwordColumn() database.Column {
+	return database.NewColumn(h.unqualifiedTableName(), "password")
+}
+
+func (h userHuman) passwordChangeRequiredColumn() database.Column {
+	return database.NewColumn(h.unqualifiedTableName(), "password_change_required")
+}
+
+func (h userHuman) passwordVerifiedAtColumn() database.Column {
+	return database.NewColumn(h.unqualifiedTableName(), "password_verified_at")
+}
+
+func (h userHuman) passwordFailedAttemptsColumn() database.Column {
+	return database.NewColumn(h.unqualifiedTableName(), "password_failed_attempts")
+}
+
+// -------------------------------------------------------------
+// machine
+// -------------------------------------------------------------
+
+var _ domain.MachineUserRepository = (*userMachine)(nil)
+
+type userMachine struct {
+	user
+}
+
+// Update implements [domain.MachineUserRepository].
+func (m userMachine) Update(ctx context.Context, client database.QueryExecutor, condition database.Condition, changes ...database.Change) (int64, error) {
+	return m.user.Update(ctx, client, database.And(condition, m.TypeCondition(domain.UserTypeMachine)), changes...)
+}
+
+// SetName implements [domain.machineChanges].
+func (m userMachine) SetName(name string) database.Change {
+	return database.NewChange(m.NameColumn(), name)
+}
+
+// SetDescription implements [domain.machineChanges].
+func (m userMachine) SetDescription(description string) database.Change {
+	return database.NewChange(m.descriptionColumn(), description)
+}
+
+// SetSecret implements [domain.machineChanges].
+func (m userMachine) SetSecret(secret *string) database.Change {
+	return database.NewChangePtr(m.secretColumn(), secret)
+}
+
+// SetAccessTokenType implements [domain.machineChanges].
+func (m userMachine) SetAccessTokenType(tokenType domain.PersonalAccessTokenType) database.Change {
+	return database.NewChange(m.accessTokenTypeColumn(), tokenType)
+}
+
+// NameCondition implements [domain.machineConditions].
+func (m userMachine) NameCondition(op database.TextOperation, name string) database.Condition {
+	return database.NewTextCondition(m.NameColumn(), op, name)
+}
+
+// NameColumn implements [domain.machineColumns].
+func (m userMachine) NameColumn() database.Column {
+	return database.NewColumn(m.unqualifiedTableName(), "name")
+}
+
+func (m userMachine) descriptionColumn() database.Column {
+	return database.NewColumn(m.unqualifiedTableName(), "description")
+}
+
+func (m userMachine) secretColumn() database.Column {
+	return database.NewColumn(m.unqualifiedTableName(), "secret")
+}
+
+func (m userMachine) accessTokenTypeColumn() database.Column {
+	return database.NewColumn(m.unqualifiedTableName(), "access_token_type")
+}
+
+// -------------------------------------------------------------
+// metadata
+// -------------------------------------------------------------
+
+// userMetadata describes the table of the metadata of users.
+// Metadata is managed through [domain.userChanges] of the [domain.UserRepository].
+type userMetadata struct{}
+
+func (m userMetadata) qualifiedTableName() string {
+	return "zitadel." + m.unqualifiedTableName()
+}
+
+func (userMetadata) unqualifiedTableName() string {
+	return "user_metadata"
+}
+
+func (m userMetadata) InstanceIDColumn() database.Column {
+	return database.NewColumn(m.unqualifiedTableName(), "instance_id")
+}
+
+func (m userMetadata) UserIDColumn() database.Column {
+	return database.NewColumn(m.unqualifiedTableName(), "user_id")
+}
+
+func (m userMetadata) KeyColumn() database.Column {
+	return database.NewColumn(m.unqualifiedTableName(), "key")
+}
+
+func (m userMetadata) ValueColumn() database.Column {
+	return database.NewColumn(m.unqualifiedTableName(), "value")
+}
+
+// -------------------------------------------------------------
+// helpers
+// -------------------------------------------------------------
+
+func languageValue(tag language.Tag) string {
+	if tag == language.Und {
+		return ""
+	}
+	return tag.String()
+}
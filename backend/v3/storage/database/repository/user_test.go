@@ -0,0 +1,371 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/backend/v3/domain"
+	"github.com/zitadel/zitadel/backend/v3/storage/database"
+	"github.com/zitadel/zitadel/backend/v3/storage/database/repository"
+)
+
+func TestCreateUser(t *testing.T) {
+	tx, rollback := transactionForRollback(t)
+	defer rollback()
+	instanceID := createInstance(t, tx)
+	orgID := createOrganization(t, tx, instanceID)
+	userRepo := repository.UserRepository()
+
+	tests := []struct {
+		name    string
+		user    *domain.User
+		wantErr bool
+	}{
+		{
+			name: "human",
+			user: &domain.User{
+				InstanceID:     instanceID,
+				OrganizationID: orgID,
+				ID:             gofakeit.UUID(),
+				Username:       gofakeit.Username(),
+				State:          domain.UserStateActive,
+				Human: &domain.HumanUser{
+					FirstName:         "Gigi",
+					LastName:          "Giraffe",
+					PreferredLanguage: language.German,
+					Gender:            domain.HumanGenderFemale,
+					Email: domain.HumanEmail{
+						Address: gofakeit.Email(),
+					},
+					Phone: &domain.HumanPhone{
+						Number: "+41791234567",
+					},
+				},
+			},
+		},
+		{
+			name: "machine",
+			user: &domain.User{
+				InstanceID:     instanceID,
+				OrganizationID: orgID,
+				ID:             gofakeit.UUID(),
+				Username:       gofakeit.Username(),
+				State:          domain.UserStateActive,
+				Machine: &domain.MachineUser{
+					Name:            "bot",
+					AccessTokenType: domain.PersonalAccessTokenTypeJWT,
+				},
+			},
+		},
+		{
+			name: "with metadata",
+			user: &domain.User{
+				InstanceID:     instanceID,
+				OrganizationID: orgID,
+				ID:             gofakeit.UUID(),
+				Username:       gofakeit.Username(),
+				State:          domain.UserStateActive,
+				Machine: &domain.MachineUser{
+					Name: "bot",
+				},
+				Metadata: []*domain.UserMetadata{
+					{Metadata: domain.Metadata{InstanceID: instanceID, Key: "key", Value: []byte("value")}},
+				},
+			},
+		},
+		{
+			name: "neither human nor machine",
+			user: &domain.User{
+				InstanceID:     instanceID,
+				OrganizationID: orgID,
+				ID:             gofakeit.UUID(),
+				Username:       gofakeit.Username(),
+				State:          domain.UserStateActive,
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			savepoint, rollback := savepointForRollback(t, tx)
+			defer rollback()
+
+			err := userRepo.Create(t.Context(), savepoint, test.user)
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotZero(t, test.user.CreatedAt)
+			assert.NotZero(t, test.user.UpdatedAt)
+
+			got, err := userRepo.LoadMetadata().Get(t.Context(), savepoint,
+				database.WithCondition(userRepo.PrimaryKeyCondition(instanceID, test.user.ID)),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, test.user.Username, got.Username)
+			assert.Equal(t, test.user.Human != nil, got.Human != nil)
+			assert.Equal(t, test.user.Machine != nil, got.Machine != nil)
+			assert.Len(t, got.Metadata, len(test.user.Metadata))
+		})
+	}
+}
+
+func TestUpdateHumanUser(t *testing.T) {
+	tx, rollback := transactionForRollback(t)
+	defer rollback()
+	instanceID := createInstance(t, tx)
+	orgID := createOrganization(t, tx, instanceID)
+	userID := createUser(t, tx, instanceID, orgID)
+	userRepo := repository.UserRepository()
+	humanRepo := userRepo.Human()
+	skippedAt := time.Now().Round(time.Millisecond)
+
+	tests := []struct {
+		name    string
+		changes []database.Change
+		assert  func(t *testing.T, user *domain.User)
+	}{
+		{
+			name:    "profile",
+			changes: []database.Change{humanRepo.SetFirstName("Gigi"), humanRepo.SetNickname("gigi")},
+			assert: func(t *testing.T, user *domain.User) {
+				assert.Equal(t, "Gigi", user.Human.FirstName)
+				assert.Equal(t, "gigi", user.Human.Nickname)
+			},
+		},
+		{
+			name:    "verify email",
+			changes: []database.Change{humanRepo.VerifyEmail(time.Time{})},
+			assert: func(t *testing.T, user *domain.User) {
+				assert.NotZero(t, user.Human.Email.VerifiedAt)
+			},
+		},
+		{
+			name:    "set and remove phone",
+			changes: []database.Change{humanRepo.SetPhone("+41791234567"), humanRepo.RemovePhone()},
+			assert: func(t *testing.T, user *domain.User) {
+				assert.Nil(t, user.Human.Phone)
+			},
+		},
+		{
+			name:    "multifactor initialization skipped",
+			changes: []database.Change{humanRepo.SetMultifactorInitializationSkippedAt(skippedAt)},
+			assert: func(t *testing.T, user *domain.User) {
+				assert.True(t, skippedAt.Equal(user.Human.MultifactorInitializationSkippedAt))
+			},
+		},
+		{
+			name:    "failed password check",
+			changes: []database.Change{humanRepo.CheckPassword(&domain.CheckTypeFailed{FailedAt: time.Now()})},
+			assert: func(t *testing.T, user *domain.User) {
+				assert.EqualValues(t, 1, user.Human.Password.FailedAttempts)
+			},
+		},
+		{
+			name:    "state",
+			changes: []database.Change{humanRepo.SetState(domain.UserStateLocked)},
+			assert: func(t *testing.T, user *domain.User) {
+				assert.Equal(t, domain.UserStateLocked, user.State)
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			savepoint, rollback := savepointForRollback(t, tx)
+			defer rollback()
+
+			rowsAffected, err := humanRepo.Update(t.Context(), savepoint, userRepo.PrimaryKeyCondition(instanceID, userID), test.changes...)
+			require.NoError(t, err)
+			assert.EqualValues(t, 1, rowsAffected)
+
+			got, err := userRepo.Get(t.Context(), savepoint, database.WithCondition(userRepo.PrimaryKeyCondition(instanceID, userID)))
+			require.NoError(t, err)
+			test.assert(t, got)
+		})
+	}
+}
+
+func TestListUsers(t *testing.T) {
+	tx, rollback := transactionForRollback(t)
+	defer rollback()
+	instanceID := createInstance(t, tx)
+	orgID := createOrganization(t, tx, instanceID)
+	firstUserID := createUser(t, tx, instanceID, orgID)
+	secondUserID := createUser(t, tx, instanceID, orgID)
+	userRepo := repository.UserRepository()
+
+	_, err := userRepo.Update(t.Context(), tx, userRepo.PrimaryKeyCondition(instanceID, secondUserID),
+		userRepo.AddMetadata(&domain.Metadata{InstanceID: instanceID, Key: "color", Value: []byte("green")}),
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		condition database.Condition
+		wantIDs   []string
+	}{
+		{
+			name:      "all of instance",
+			condition: userRepo.InstanceIDCondition(instanceID),
+			wantIDs:   []string{firstUserID, secondUserID},
+		},
+		{
+			name: "by metadata key",
+			condition: database.And(
+				userRepo.InstanceIDCondition(instanceID),
+				userRepo.ExistsMetadata(userRepo.MetadataKeyCondition(database.TextOperationEqual, "color")),
+			),
+			wantIDs: []string{secondUserID},
+		},
+		{
+			name: "by metadata value",
+			condition: database.And(
+				userRepo.InstanceIDCondition(instanceID),
+				userRepo.ExistsMetadata(userRepo.MetadataValueCondition(database.BytesOperationEqual, []byte("green"))),
+			),
+			wantIDs: []string{secondUserID},
+		},
+		{
+			name: "not",
+			condition: database.And(
+				userRepo.InstanceIDCondition(instanceID),
+				database.Not(userRepo.IDCondition(firstUserID)),
+			),
+			wantIDs: []string{secondUserID},
+		},
+		{
+			name: "by type",
+			condition: database.And(
+				userRepo.InstanceIDCondition(instanceID),
+				userRepo.TypeCondition(domain.UserTypeMachine),
+			),
+			wantIDs: []string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := userRepo.List(t.Context(), tx, database.WithCondition(test.condition))
+			require.NoError(t, err)
+			gotIDs := make([]string, len(got))
+			for i, user := range got {
+				gotIDs[i] = user.ID
+			}
+			assert.ElementsMatch(t, test.wantIDs, gotIDs)
+
+			count, err := userRepo.Count(t.Context(), tx, test.condition)
+			require.NoError(t, err)
+			assert.EqualValues(t, len(test.wantIDs), count)
+		})
+	}
+}
+
+func TestUserLoginNames(t *testing.T) {
+	tx, rollback := transactionForRollback(t)
+	defer rollback()
+	instanceID := createInstance(t, tx)
+	orgID := createOrganization(t, tx, instanceID)
+	userID := createUser(t, tx, instanceID, orgID)
+	userRepo := repository.UserRepository()
+	settingsRepo := repository.DomainSettingsRepository()
+
+	domainRepo := repository.OrganizationDomainRepository()
+	for _, orgDomain := range []*domain.AddOrganizationDomain{
+		{InstanceID: instanceID, OrgID: orgID, Domain: "primary.example.com", IsVerified: true, IsPrimary: true},
+		{InstanceID: instanceID, OrgID: orgID, Domain: "secondary.example.com", IsVerified: true},
+		{InstanceID: instanceID, OrgID: orgID, Domain: "unverified.example.com"},
+	} {
+		require.NoError(t, domainRepo.Add(t.Context(), tx, orgDomain))
+	}
+
+	tests := []struct {
+		name                   string
+		settings               []*domain.DomainSettings
+		wantLoginNames         func(username string) []string
+		wantPreferredLoginName func(username string) string
+	}{
+		{
+			name: "without settings",
+			wantLoginNames: func(username string) []string {
+				return []string{username}
+			},
+			wantPreferredLoginName: func(username string) string {
+				return username
+			},
+		},
+		{
+			name: "instance includes domain",
+			settings: []*domain.DomainSettings{
+				{
+					Settings:                 domain.Settings{InstanceID: instanceID},
+					DomainSettingsAttributes: domain.DomainSettingsAttributes{LoginNameIncludesDomain: gu.Ptr(true)},
+				},
+			},
+			wantLoginNames: func(username string) []string {
+				return []string{username + "@primary.example.com", username + "@secondary.example.com"}
+			},
+			wantPreferredLoginName: func(username string) string {
+				return username + "@primary.example.com"
+			},
+		},
+		{
+			name: "organization overwrites instance",
+			settings: []*domain.DomainSettings{
+				{
+					Settings:                 domain.Settings{InstanceID: instanceID},
+					DomainSettingsAttributes: domain.DomainSettingsAttributes{LoginNameIncludesDomain: gu.Ptr(true)},
+				},
+				{
+					Settings:                 domain.Settings{InstanceID: instanceID, OrganizationID: gu.Ptr(orgID)},
+					DomainSettingsAttributes: domain.DomainSettingsAttributes{LoginNameIncludesDomain: gu.Ptr(false)},
+				},
+			},
+			wantLoginNames: func(username string) []string {
+				return []string{username}
+			},
+			wantPreferredLoginName: func(username string) string {
+				return username
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			savepoint, rollback := savepointForRollback(t, tx)
+			defer rollback()
+
+			for _, settings := range test.settings {
+				require.NoError(t, settingsRepo.Set(t.Context(), savepoint, settings))
+			}
+
+			got, err := userRepo.Get(t.Context(), savepoint, database.WithCondition(userRepo.PrimaryKeyCondition(instanceID, userID)))
+			require.NoError(t, err)
+			assert.Equal(t, test.wantLoginNames(got.Username), got.LoginNames)
+			assert.Equal(t, test.wantPreferredLoginName(got.Username), got.PreferredLoginName)
+		})
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	tx, rollback := transactionForRollback(t)
+	defer rollback()
+	instanceID := createInstance(t, tx)
+	orgID := createOrganization(t, tx, instanceID)
+	userID := createUser(t, tx, instanceID, orgID)
+	userRepo := repository.UserRepository()
+
+	_, err := userRepo.Delete(t.Context(), tx, userRepo.IDCondition(userID))
+	require.ErrorIs(t, err, database.NewMissingConditionError(userRepo.InstanceIDColumn()))
+
+	rowsAffected, err := userRepo.Delete(t.Context(), tx, userRepo.PrimaryKeyCondition(instanceID, userID))
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, rowsAffected)
+
+	_, err = userRepo.Get(t.Context(), tx, database.WithCondition(userRepo.PrimaryKeyCondition(instanceID, userID)))
+	require.ErrorIs(t, err, new(database.NoRowFoundError))
+}
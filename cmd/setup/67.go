@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 67.sql
+	addScheduledAccessIndexToFields string
+)
+
+type AddScheduledAccessIndexToFields struct {
+	dbClient *database.DB
+}
+
+func (mig *AddScheduledAccessIndexToFields) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addScheduledAccessIndexToFields)
+	return err
+}
+
+func (mig *AddScheduledAccessIndexToFields) String() string {
+	return "67_add_scheduled_access_index_to_fields"
+}
@@ -0,0 +1,4 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS f_scheduled_access_idx ON eventstore.fields (number_value)
+    WHERE object_type IN ('instance_member_role', 'org_member_role', 'user_grant', 'elevation', 'access_review')
+    AND field_name IN ('instance_valid_until', 'org_valid_until', 'valid_from', 'valid_until', 'activate_at', 'deadline')
+    AND number_value IS NOT NULL;
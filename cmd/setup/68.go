@@ -0,0 +1,39 @@
+package setup
+
+import (
+	"context"
+	"embed"
+	"fmt"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 68/*.sql
+	permittedProjectResources embed.FS
+)
+
+type PermittedProjectResources struct {
+	dbClient *database.DB
+}
+
+func (mig *PermittedProjectResources) Execute(ctx context.Context, _ eventstore.Event) error {
+	statements, err := readStatements(permittedProjectResources, "68")
+	if err != nil {
+		return err
+	}
+	for _, stmt := range statements {
+		logging.WithFields("file", stmt.file, "migration", mig.String()).Info("execute statement")
+		if _, err := mig.dbClient.ExecContext(ctx, stmt.query); err != nil {
+			return fmt.Errorf("%s %s: %w", mig.String(), stmt.file, err)
+		}
+	}
+	return nil
+}
+
+func (mig *PermittedProjectResources) String() string {
+	return "68_permitted_project_resources"
+}
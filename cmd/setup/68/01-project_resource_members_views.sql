@@ -0,0 +1,13 @@
+-- members of single applications, the field name is the id of the user
+CREATE OR REPLACE VIEW eventstore.project_application_members AS
+SELECT instance_id, aggregate_id as project_id, object_id as application_id, field_name as user_id, text_value as role, resource_owner as org_id
+FROM eventstore.fields
+WHERE aggregate_type = 'project'
+AND object_type = 'project_application_member';
+
+-- members of single project roles, the field name is the id of the user
+CREATE OR REPLACE VIEW eventstore.project_role_members AS
+SELECT instance_id, aggregate_id as project_id, object_id as role_key, field_name as user_id, text_value as role, resource_owner as org_id
+FROM eventstore.fields
+WHERE aggregate_type = 'project'
+AND object_type = 'project_role_member';
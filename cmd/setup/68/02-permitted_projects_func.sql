@@ -0,0 +1,75 @@
+DROP FUNCTION IF EXISTS eventstore.permitted_projects;
+
+CREATE OR REPLACE FUNCTION eventstore.permitted_projects(
+    req_instance_id TEXT
+    , auth_user_id TEXT
+    , system_user_perms JSONB
+    , perm TEXT
+    , filter_org TEXT
+
+    , instance_permitted OUT BOOLEAN
+    , org_ids OUT TEXT[]
+    , project_ids OUT TEXT[]
+    , application_ids OUT TEXT[]
+    , project_role_ids OUT TEXT[]
+)
+	LANGUAGE 'plpgsql' STABLE
+AS $$
+BEGIN
+    -- if system user
+    IF system_user_perms IS NOT NULL THEN
+        SELECT p.instance_permitted, p.org_ids INTO instance_permitted, org_ids
+        FROM eventstore.check_system_user_perms(system_user_perms, req_instance_id, perm) p;
+        RETURN;
+    END IF;
+
+    -- if human/machine user
+    SELECT * FROM eventstore.permitted_orgs(
+        req_instance_id
+        , auth_user_id
+        , system_user_perms
+        , perm
+        , filter_org
+    ) INTO instance_permitted, org_ids;
+    IF instance_permitted THEN
+        RETURN;
+    END IF;
+	DECLARE
+    	matched_roles TEXT[] := eventstore.find_roles(req_instance_id, perm);
+	BEGIN
+	    -- Get the projects where permission were granted thru project-level roles
+	    SELECT array_agg(sub.project_id) INTO project_ids
+	    FROM (
+	        SELECT DISTINCT pm.project_id
+	        FROM eventstore.project_members pm
+	        WHERE pm.role = ANY(matched_roles)
+	        AND pm.instance_id = req_instance_id
+	        AND pm.user_id = auth_user_id
+	        AND (filter_org IS NULL OR pm.org_id = filter_org)
+	    ) AS sub;
+
+	    -- Get the applications where permission were granted thru application-level roles
+	    SELECT array_agg(sub.application_id) INTO application_ids
+	    FROM (
+	        SELECT DISTINCT am.application_id
+	        FROM eventstore.project_application_members am
+	        WHERE am.role = ANY(matched_roles)
+	        AND am.instance_id = req_instance_id
+	        AND am.user_id = auth_user_id
+	        AND (filter_org IS NULL OR am.org_id = filter_org)
+	    ) AS sub;
+
+	    -- Get the project roles where permission were granted thru role-level roles,
+	    -- the ids are built as `<project_id>/<role_key>`
+	    SELECT array_agg(sub.project_role_id) INTO project_role_ids
+	    FROM (
+	        SELECT DISTINCT rm.project_id || '/' || rm.role_key AS project_role_id
+	        FROM eventstore.project_role_members rm
+	        WHERE rm.role = ANY(matched_roles)
+	        AND rm.instance_id = req_instance_id
+	        AND rm.user_id = auth_user_id
+	        AND (filter_org IS NULL OR rm.org_id = filter_org)
+	    ) AS sub;
+	END;
+END;
+$$;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 69.sql
+	addLDAPSyncIndexToFields string
+)
+
+type AddLDAPSyncIndexToFields struct {
+	dbClient *database.DB
+}
+
+func (mig *AddLDAPSyncIndexToFields) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addLDAPSyncIndexToFields)
+	return err
+}
+
+func (mig *AddLDAPSyncIndexToFields) String() string {
+	return "69_add_ldap_sync_index_to_fields"
+}
@@ -0,0 +1,3 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS f_ldap_sync_next_run_idx ON eventstore.fields (number_value)
+    WHERE object_type = 'ldap_sync'
+    AND field_name = 'next_run_at';
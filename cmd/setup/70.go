@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 70.sql
+	addSessionPushCheckedAt string
+)
+
+type SessionPushCheckedAt struct {
+	dbClient *database.DB
+}
+
+func (mig *SessionPushCheckedAt) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSessionPushCheckedAt)
+	return err
+}
+
+func (mig *SessionPushCheckedAt) String() string {
+	return "70_session_push_checked_at"
+}
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS projections.sessions8
+ADD COLUMN IF NOT EXISTS mfa_push_checked_at TIMESTAMPTZ;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 71.sql
+	addSessionX509CheckedAt string
+)
+
+type SessionX509CheckedAt struct {
+	dbClient *database.DB
+}
+
+func (mig *SessionX509CheckedAt) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSessionX509CheckedAt)
+	return err
+}
+
+func (mig *SessionX509CheckedAt) String() string {
+	return "71_session_x509_checked_at"
+}
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS projections.sessions8
+ADD COLUMN IF NOT EXISTS x509_checked_at TIMESTAMPTZ;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 72.sql
+	addSAMLFederationIndexToFields string
+)
+
+type AddSAMLFederationIndexToFields struct {
+	dbClient *database.DB
+}
+
+func (mig *AddSAMLFederationIndexToFields) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSAMLFederationIndexToFields)
+	return err
+}
+
+func (mig *AddSAMLFederationIndexToFields) String() string {
+	return "72_add_saml_federation_index_to_fields"
+}
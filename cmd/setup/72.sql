@@ -0,0 +1,3 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS f_saml_federation_next_run_idx ON eventstore.fields (number_value)
+    WHERE object_type = 'saml_federation'
+    AND field_name = 'next_run_at';
@@ -0,0 +1,4 @@
+ALTER TABLE IF EXISTS projections.sessions8
+ADD COLUMN IF NOT EXISTS risk_score BIGINT,
+ADD COLUMN IF NOT EXISTS risk_action SMALLINT,
+ADD COLUMN IF NOT EXISTS risk_signals TEXT[];
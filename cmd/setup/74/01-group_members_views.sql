@@ -0,0 +1,55 @@
+-- the users of a group inherit the roles of the memberships of the group
+CREATE OR REPLACE VIEW eventstore.group_users AS
+SELECT instance_id, aggregate_id as group_id, text_value as user_id, resource_owner as org_id
+FROM eventstore.fields
+WHERE aggregate_type = 'group'
+AND object_type = 'group_user'
+AND field_name = 'user_id';
+
+CREATE OR REPLACE VIEW eventstore.instance_members AS
+SELECT instance_id, object_id as user_id, text_value as role
+FROM eventstore.fields
+WHERE aggregate_type = 'instance'
+AND object_type = 'instance_member_role'
+AND field_name = 'instance_role'
+UNION ALL
+SELECT f.instance_id, gu.user_id, f.text_value as role
+FROM eventstore.fields f
+JOIN eventstore.group_users gu
+    ON gu.instance_id = f.instance_id
+    AND gu.group_id = f.object_id
+WHERE f.aggregate_type = 'instance'
+AND f.object_type = 'instance_group_member_role'
+AND f.field_name = 'instance_role';
+
+CREATE OR REPLACE VIEW eventstore.org_members AS
+SELECT instance_id, aggregate_id as org_id, object_id as user_id, text_value as role
+FROM eventstore.fields
+WHERE aggregate_type = 'org'
+AND object_type = 'org_member_role'
+AND field_name = 'org_role'
+UNION ALL
+SELECT f.instance_id, f.aggregate_id as org_id, gu.user_id, f.text_value as role
+FROM eventstore.fields f
+JOIN eventstore.group_users gu
+    ON gu.instance_id = f.instance_id
+    AND gu.group_id = f.object_id
+WHERE f.aggregate_type = 'org'
+AND f.object_type = 'org_group_member_role'
+AND f.field_name = 'org_role';
+
+CREATE OR REPLACE VIEW eventstore.project_members AS
+SELECT instance_id, aggregate_id as project_id, object_id as user_id, text_value as role, resource_owner as org_id
+FROM eventstore.fields
+WHERE aggregate_type = 'project'
+AND object_type = 'project_member_role'
+AND field_name = 'project_role'
+UNION ALL
+SELECT f.instance_id, f.aggregate_id as project_id, gu.user_id, f.text_value as role, f.resource_owner as org_id
+FROM eventstore.fields f
+JOIN eventstore.group_users gu
+    ON gu.instance_id = f.instance_id
+    AND gu.group_id = f.object_id
+WHERE f.aggregate_type = 'project'
+AND f.object_type = 'project_group_member_role'
+AND f.field_name = 'project_role';
@@ -0,0 +1,41 @@
+package setup
+
+import (
+	"context"
+	"embed"
+	"fmt"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 75/*.sql
+	projectScheduledAccessIndex embed.FS
+)
+
+// ProjectScheduledAccessIndex replaces the index of step 67,
+// so the scheduled access worker also finds the expired memberships of projects, project grants, applications and roles.
+type ProjectScheduledAccessIndex struct {
+	dbClient *database.DB
+}
+
+func (mig *ProjectScheduledAccessIndex) Execute(ctx context.Context, _ eventstore.Event) error {
+	statements, err := readStatements(projectScheduledAccessIndex, "75")
+	if err != nil {
+		return err
+	}
+	for _, stmt := range statements {
+		logging.WithFields("file", stmt.file, "migration", mig.String()).Info("execute statement")
+		if _, err := mig.dbClient.ExecContext(ctx, stmt.query); err != nil {
+			return fmt.Errorf("%s %s: %w", mig.String(), stmt.file, err)
+		}
+	}
+	return nil
+}
+
+func (mig *ProjectScheduledAccessIndex) String() string {
+	return "75_project_scheduled_access_index"
+}
@@ -0,0 +1,9 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS f_scheduled_access_2_idx ON eventstore.fields (number_value)
+    WHERE (
+        (
+            object_type IN ('instance_member_role', 'org_member_role', 'project_member_role', 'user_grant', 'elevation', 'access_review')
+            AND field_name IN ('instance_valid_until', 'org_valid_until', 'project_valid_until', 'valid_from', 'valid_until', 'activate_at', 'deadline')
+        )
+        OR object_type IN ('project_grant_member_validity', 'project_application_member_validity', 'project_role_member_validity')
+    )
+    AND number_value IS NOT NULL;
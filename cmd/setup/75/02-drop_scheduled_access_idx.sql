@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS eventstore.f_scheduled_access_idx;
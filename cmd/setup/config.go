@@ -163,6 +163,15 @@ type Steps struct {
 	s64ChangePushPosition                   *ChangePushPosition
 	s65FixUserMetadata5Index                *FixUserMetadata5Index
 	s66SessionRecoveryCodeCheckedAt         *SessionRecoveryCodeCheckedAt
+	s67AddScheduledAccessIndexToFields      *AddScheduledAccessIndexToFields
+	s68PermittedProjectResources            *PermittedProjectResources
+	s69AddLDAPSyncIndexToFields             *AddLDAPSyncIndexToFields
+	s70SessionPushCheckedAt                 *SessionPushCheckedAt
+	s71SessionX509CheckedAt                 *SessionX509CheckedAt
+	s72AddSAMLFederationIndexToFields       *AddSAMLFederationIndexToFields
+	s73SessionRisk                          *SessionRisk
+	s74GroupMembers                         *GroupMembers
+	s75ProjectScheduledAccessIndex          *ProjectScheduledAccessIndex
 }
 
 func MustNewSteps(v *viper.Viper) *Steps {
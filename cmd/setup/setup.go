@@ -224,6 +224,15 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 	steps.s64ChangePushPosition = &ChangePushPosition{dbClient: dbClient}
 	steps.s65FixUserMetadata5Index = &FixUserMetadata5Index{dbClient: dbClient}
 	steps.s66SessionRecoveryCodeCheckedAt = &SessionRecoveryCodeCheckedAt{dbClient: dbClient}
+	steps.s67AddScheduledAccessIndexToFields = &AddScheduledAccessIndexToFields{dbClient: dbClient}
+	steps.s68PermittedProjectResources = &PermittedProjectResources{dbClient: dbClient}
+	steps.s69AddLDAPSyncIndexToFields = &AddLDAPSyncIndexToFields{dbClient: dbClient}
+	steps.s70SessionPushCheckedAt = &SessionPushCheckedAt{dbClient: dbClient}
+	steps.s71SessionX509CheckedAt = &SessionX509CheckedAt{dbClient: dbClient}
+	steps.s72AddSAMLFederationIndexToFields = &AddSAMLFederationIndexToFields{dbClient: dbClient}
+	steps.s73SessionRisk = &SessionRisk{dbClient: dbClient}
+	steps.s74GroupMembers = &GroupMembers{dbClient: dbClient, eventstore: eventstoreClient}
+	steps.s75ProjectScheduledAccessIndex = &ProjectScheduledAccessIndex{dbClient: dbClient}
 
 	err = projection.Create(ctx, dbClient, eventstoreClient, config.Projections, nil, nil, nil)
 	logging.OnError(err).Fatal("unable to start projections")
@@ -340,6 +349,15 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s48Apps7SAMLConfigsLoginVersion,
 		steps.s59SetupWebkeys, // this step needs commands.
 		steps.s66SessionRecoveryCodeCheckedAt,
+		steps.s67AddScheduledAccessIndexToFields,
+		steps.s68PermittedProjectResources,
+		steps.s69AddLDAPSyncIndexToFields,
+		steps.s70SessionPushCheckedAt,
+		steps.s71SessionX509CheckedAt,
+		steps.s72AddSAMLFederationIndexToFields,
+		steps.s73SessionRisk,
+		steps.s74GroupMembers,
+		steps.s75ProjectScheduledAccessIndex,
 	} {
 		setupErr = executeMigration(ctx, eventstoreClient, step, "migration failed")
 		if setupErr != nil {
@@ -539,6 +557,7 @@ func startCommandsQueries(
 		keys.Target,
 		&http.Client{},
 		permissionCheck,
+		nil,
 		sessionTokenVerifier,
 		config.OIDC.DefaultAccessTokenLifetime,
 		config.OIDC.DefaultRefreshTokenExpiration,
@@ -29,9 +29,13 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/execution"
 	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/ldapsync"
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/notification/handlers"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/samlfederation"
+	"github.com/zitadel/zitadel/internal/scheduledaccess"
+	"github.com/zitadel/zitadel/internal/scimprovisioning"
 	"github.com/zitadel/zitadel/internal/serviceping"
 	static_config "github.com/zitadel/zitadel/internal/static/config"
 	metrics "github.com/zitadel/zitadel/internal/telemetry/metrics/config"
@@ -51,6 +55,7 @@ type Config struct {
 	HTTP2HostHeader     string
 	HTTP1HostHeader     string
 	WebAuthNName        string
+	WebAuthNMetadata    string
 	Database            database.Config
 	Caches              *connector.CachesConfig
 	Tracing             tracing.Config
@@ -83,6 +88,10 @@ type Config struct {
 	Quotas              *QuotasConfig
 	Telemetry           *handlers.TelemetryPusherConfig
 	ServicePing         *serviceping.Config
+	ScheduledAccess     *scheduledaccess.Config
+	LDAPSync            *ldapsync.Config
+	SAMLFederation      *samlfederation.Config
+	SCIMProvisioning    *scimprovisioning.Config
 }
 
 type QuotasConfig struct {
@@ -90,7 +99,11 @@ type QuotasConfig struct {
 		logstore.EmitterConfig  `mapstructure:",squash"`
 		middleware.AccessConfig `mapstructure:",squash"`
 	}
-	Execution *logstore.EmitterConfig
+	Execution         *logstore.EmitterConfig
+	ActiveUsers       *logstore.EmitterConfig
+	IssuedTokens      *logstore.EmitterConfig
+	SentNotifications *logstore.EmitterConfig
+	OrgRequests       *logstore.EmitterConfig
 }
 
 func MustNewConfig(v *viper.Viper) *Config {
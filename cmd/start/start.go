@@ -37,11 +37,14 @@ import (
 	"github.com/zitadel/zitadel/internal/api"
 	"github.com/zitadel/zitadel/internal/api/assets"
 	internal_authz "github.com/zitadel/zitadel/internal/api/authz"
+	access_review_v2 "github.com/zitadel/zitadel/internal/api/grpc/access_review/v2"
 	action_v2 "github.com/zitadel/zitadel/internal/api/grpc/action/v2"
 	action_v2_beta "github.com/zitadel/zitadel/internal/api/grpc/action/v2beta"
 	"github.com/zitadel/zitadel/internal/api/grpc/admin"
+	analytics_v2 "github.com/zitadel/zitadel/internal/api/grpc/analytics/v2"
 	app_v2beta "github.com/zitadel/zitadel/internal/api/grpc/app/v2beta"
 	application "github.com/zitadel/zitadel/internal/api/grpc/application/v2"
+	audit_v2 "github.com/zitadel/zitadel/internal/api/grpc/audit/v2"
 	"github.com/zitadel/zitadel/internal/api/grpc/auth"
 	authorization_v2 "github.com/zitadel/zitadel/internal/api/grpc/authorization/v2"
 	authorization_v2beta "github.com/zitadel/zitadel/internal/api/grpc/authorization/v2beta"
@@ -102,18 +105,28 @@ import (
 	"github.com/zitadel/zitadel/internal/execution"
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/idp/providers/wsfed"
 	"github.com/zitadel/zitadel/internal/integration/sink"
+	"github.com/zitadel/zitadel/internal/ldapsync"
 	"github.com/zitadel/zitadel/internal/logstore"
 	"github.com/zitadel/zitadel/internal/logstore/emitters/access"
 	emit_execution "github.com/zitadel/zitadel/internal/logstore/emitters/execution"
 	emit_stdout "github.com/zitadel/zitadel/internal/logstore/emitters/stdout"
+	emit_syslog "github.com/zitadel/zitadel/internal/logstore/emitters/syslog"
+	emit_usage "github.com/zitadel/zitadel/internal/logstore/emitters/usage"
 	"github.com/zitadel/zitadel/internal/logstore/record"
+	"github.com/zitadel/zitadel/internal/logstore/security"
 	"github.com/zitadel/zitadel/internal/net"
 	"github.com/zitadel/zitadel/internal/notification"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/repository/quota"
+	"github.com/zitadel/zitadel/internal/samlfederation"
+	"github.com/zitadel/zitadel/internal/scheduledaccess"
+	"github.com/zitadel/zitadel/internal/scimprovisioning"
 	"github.com/zitadel/zitadel/internal/serviceping"
 	"github.com/zitadel/zitadel/internal/static"
+	"github.com/zitadel/zitadel/internal/usage"
 	es_v4 "github.com/zitadel/zitadel/internal/v2/eventstore"
 	es_v4_pg "github.com/zitadel/zitadel/internal/v2/eventstore/postgres"
 	"github.com/zitadel/zitadel/internal/webauthn"
@@ -171,6 +184,7 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		return fmt.Errorf("cannot start DB client for queries: %w", err)
 	}
 	new_domain.SetPool(v3_postgres.PGxPool(dbClient.Pool))
+	new_domain.SetReadPool(v3_postgres.PGxReaderPool(dbClient.ReaderPool))
 
 	keyStorage, err := cryptoDB.NewKeyStorage(dbClient, masterKey)
 	if err != nil {
@@ -246,9 +260,14 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 	if err != nil {
 		return fmt.Errorf("cannot start asset storage client: %w", err)
 	}
+	webAuthNMetadata, err := webauthn.LoadMetadata(config.WebAuthNMetadata)
+	if err != nil {
+		return fmt.Errorf("cannot load webauthn metadata: %w", err)
+	}
 	webAuthNConfig := &webauthn.Config{
 		DisplayName:    config.WebAuthNName,
 		ExternalSecure: config.ExternalSecure,
+		Metadata:       webAuthNMetadata,
 	}
 	commands, err := command.StartCommands(ctx,
 		eventstoreClient,
@@ -271,6 +290,7 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		keys.Target,
 		&http.Client{},
 		permissionCheck,
+		usage.NewOrgQuotaCheck(queries),
 		sessionTokenVerifier,
 		config.OIDC.DefaultAccessTokenLifetime,
 		config.OIDC.DefaultRefreshTokenExpiration,
@@ -299,9 +319,56 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		return err
 	}
 
-	actionsLogstoreSvc := logstore.New(queries, actionsExecutionDBEmitter, actionsExecutionStdoutEmitter)
+	actionsExecutionSyslog, err := emit_syslog.NewSyslogEmitter(config.LogStore.Execution.Syslog, emit_syslog.ExecutionLogMessage)
+	if err != nil {
+		return err
+	}
+	actionsExecutionSyslogEmitter, err := logstore.NewEmitter(ctx, clock, config.LogStore.Execution.Syslog.EmitterConfig(), actionsExecutionSyslog)
+	if err != nil {
+		return err
+	}
+
+	actionsLogstoreSvc := logstore.New(queries, actionsExecutionDBEmitter, actionsExecutionStdoutEmitter, actionsExecutionSyslogEmitter)
 	actions.SetLogstoreService(actionsLogstoreSvc)
 
+	securityStdoutEmitter, err := logstore.NewEmitter(ctx, clock, &logstore.EmitterConfig{Enabled: config.LogStore.Security.Stdout.Enabled}, emit_stdout.NewStdoutEmitter[*record.SecurityEvent]())
+	if err != nil {
+		return err
+	}
+	securitySyslog, err := emit_syslog.NewSyslogEmitter(config.LogStore.Security.Syslog, emit_syslog.SecurityEventMessage)
+	if err != nil {
+		return err
+	}
+	securitySyslogEmitter, err := logstore.NewEmitter(ctx, clock, config.LogStore.Security.Syslog.EmitterConfig(), securitySyslog)
+	if err != nil {
+		return err
+	}
+	security.Subscribe(ctx, logstore.New(queries, nil, securityStdoutEmitter, securitySyslogEmitter))
+
+	activeUsersDBEmitter, err := logstore.NewEmitter(ctx, clock, config.Quotas.ActiveUsers, emit_usage.NewDatabaseLogStorage(dbClient, commands, queries, quota.UsersAllActive))
+	if err != nil {
+		return err
+	}
+	issuedTokensDBEmitter, err := logstore.NewEmitter(ctx, clock, config.Quotas.IssuedTokens, emit_usage.NewDatabaseLogStorage(dbClient, commands, queries, quota.TokensAllIssued))
+	if err != nil {
+		return err
+	}
+	sentNotificationsDBEmitter, err := logstore.NewEmitter(ctx, clock, config.Quotas.SentNotifications, emit_usage.NewDatabaseLogStorage(dbClient, commands, queries, quota.NotificationsAllSent))
+	if err != nil {
+		return err
+	}
+	orgRequestsDBEmitter, err := logstore.NewEmitter(ctx, clock, config.Quotas.OrgRequests, emit_usage.NewOrgDatabaseLogStorage(dbClient, commands, queries, quota.RequestsAllAuthenticated))
+	if err != nil {
+		return err
+	}
+	usage.SetLogstoreServices(
+		queries,
+		logstore.New(queries, activeUsersDBEmitter),
+		logstore.New(queries, issuedTokensDBEmitter),
+		logstore.New(queries, sentNotificationsDBEmitter),
+		logstore.New(queries, orgRequestsDBEmitter),
+	)
+
 	notification.Register(
 		ctx,
 		config.Projections.Customizations["notifications"],
@@ -338,6 +405,18 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 	if err := serviceping.Register(ctx, q, queries, eventstoreClient, config.ServicePing); err != nil {
 		return err
 	}
+	scheduledaccess.Register(q, queries, commands, config.ScheduledAccess)
+	ldapsync.Register(q, queries, commands, config.LDAPSync)
+	samlfederation.Register(q, queries, commands, config.SAMLFederation)
+	scimprovisioning.Register(
+		ctx,
+		config.Projections.Customizations["scim_provisioning_requests"],
+		config.SCIMProvisioning,
+		q,
+		queries,
+		commands,
+	)
+	scimprovisioning.Start(ctx)
 
 	if err = q.Start(ctx); err != nil {
 		return err
@@ -347,6 +426,15 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 	if err = serviceping.Start(config.ServicePing, q); err != nil {
 		return err
 	}
+	if err = scheduledaccess.Start(config.ScheduledAccess, q); err != nil {
+		return err
+	}
+	if err = ldapsync.Start(config.LDAPSync, q); err != nil {
+		return err
+	}
+	if err = samlfederation.Start(config.SAMLFederation, q); err != nil {
+		return err
+	}
 
 	router := mux.NewRouter()
 	tlsConfig, err := config.TLS.Config()
@@ -443,7 +531,16 @@ func startAPIs(
 		return nil, err
 	}
 
-	accessSvc := logstore.New(queries, accessDBEmitter, accessStdoutEmitter)
+	accessSyslog, err := emit_syslog.NewSyslogEmitter(config.LogStore.Access.Syslog, emit_syslog.AccessLogMessage)
+	if err != nil {
+		return nil, err
+	}
+	accessSyslogEmitter, err := logstore.NewEmitter(ctx, clock, config.LogStore.Access.Syslog.EmitterConfig(), accessSyslog)
+	if err != nil {
+		return nil, err
+	}
+
+	accessSvc := logstore.New(queries, accessDBEmitter, accessStdoutEmitter, accessSyslogEmitter)
 	exhaustedCookieHandler := http_util.NewCookieHandler(
 		http_util.WithUnsecure(),
 		http_util.WithNonHttpOnly(),
@@ -555,6 +652,15 @@ func startAPIs(
 	if err := apis.RegisterService(ctx, internal_permission_v2.CreateServer(config.SystemDefaults, commands, queries, permissionCheck)); err != nil {
 		return nil, err
 	}
+	if err := apis.RegisterService(ctx, access_review_v2.CreateServer(commands, queries)); err != nil {
+		return nil, err
+	}
+	if err := apis.RegisterService(ctx, audit_v2.CreateServer(queries)); err != nil {
+		return nil, err
+	}
+	if err := apis.RegisterService(ctx, analytics_v2.CreateServer(queries)); err != nil {
+		return nil, err
+	}
 	if err := apis.RegisterService(ctx, userschema_v3_alpha.CreateServer(config.SystemDefaults, commands, queries)); err != nil {
 		return nil, err
 	}
@@ -608,7 +714,12 @@ func startAPIs(
 		return nil, err
 	}
 
-	apis.RegisterHandlerOnPrefix(idp.HandlerPrefix, idp.NewHandler(commands, queries, keys.IDPConfig, instanceInterceptor.Handler, federatedLogoutsCache))
+	wsFederationAssertionsCache, err := connector.StartCache[wsfed.UsedAssertionIndex, string, *wsfed.UsedAssertion](ctx, []wsfed.UsedAssertionIndex{wsfed.UsedAssertionIndexID}, cache.PurposeWSFederationAssertion, cacheConnectors.Config.WSFederationAssertions, cacheConnectors)
+	if err != nil {
+		return nil, err
+	}
+
+	apis.RegisterHandlerOnPrefix(idp.HandlerPrefix, idp.NewHandler(commands, queries, keys.IDPConfig, instanceInterceptor.Handler, federatedLogoutsCache, wsFederationAssertionsCache))
 
 	userAgentInterceptor, err := middleware.NewUserAgentHandler(config.UserAgentCookie, keys.UserAgentCookieKey, id.SonyFlakeGenerator(), config.ExternalSecure, login.EndpointResources, login.EndpointExternalLoginCallbackFormPost, login.EndpointSAMLACS)
 	if err != nil {
@@ -696,8 +807,10 @@ func startAPIs(
 		keys.User,
 		keys.IDPConfig,
 		keys.CSRFCookieKey,
+		keys.UserAgentCookieKey,
 		cacheConnectors,
 		federatedLogoutsCache,
+		wsFederationAssertionsCache,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to start login: %w", err)
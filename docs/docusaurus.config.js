@@ -435,6 +435,33 @@ module.exports = {
               categoryLinkSource: "auto",
             },
           },
+          access_review_v2: {
+            specPath:
+              ".artifacts/openapi3/zitadel/access_review/v2/access_review_service.openapi.yaml",
+            outputDir: "docs/apis/resources/access_review_service_v2",
+            sidebarOptions: {
+              groupPathsBy: "tag",
+              categoryLinkSource: "auto",
+            },
+          },
+          audit_v2: {
+            specPath:
+              ".artifacts/openapi3/zitadel/audit/v2/audit_service.openapi.yaml",
+            outputDir: "docs/apis/resources/audit_service_v2",
+            sidebarOptions: {
+              groupPathsBy: "tag",
+              categoryLinkSource: "auto",
+            },
+          },
+          analytics_v2: {
+            specPath:
+              ".artifacts/openapi3/zitadel/analytics/v2/login_analytics_service.openapi.yaml",
+            outputDir: "docs/apis/resources/login_analytics_service_v2",
+            sidebarOptions: {
+              groupPathsBy: "tag",
+              categoryLinkSource: "auto",
+            },
+          },
         },
       },
     ],
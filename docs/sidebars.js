@@ -19,6 +19,9 @@ const sidebar_api_webkey_service_v2 = require("./docs/apis/resources/webkey_serv
 const sidebar_api_instance_service_v2 = require("./docs/apis/resources/instance_service_v2/sidebar.ts").default
 const sidebar_api_authorization_service_v2 = require("./docs/apis/resources/authorization_service_v2/sidebar.ts").default
 const sidebar_api_internal_permission_service_v2 = require("./docs/apis/resources/internal_permission_service_v2/sidebar.ts").default
+const sidebar_api_access_review_service_v2 = require("./docs/apis/resources/access_review_service_v2/sidebar.ts").default
+const sidebar_api_audit_service_v2 = require("./docs/apis/resources/audit_service_v2/sidebar.ts").default
+const sidebar_api_login_analytics_service_v2 = require("./docs/apis/resources/login_analytics_service_v2/sidebar.ts").default
 const sidebar_api_application_v2 = require("./docs/apis/resources/application_service_v2/sidebar.ts").default
 
 module.exports = {
@@ -909,6 +912,42 @@ module.exports = {
               },
               items: sidebar_api_internal_permission_service_v2,
             },
+            {
+              type: "category",
+              label: "Access Reviews",
+              link: {
+                type: "generated-index",
+                title: "Access Review Service API",
+                slug: "/apis/resources/access_review_service_v2",
+                description:
+                  "AccessReviewService provides methods to periodically certify that authorizations and administrators are still needed."
+              },
+              items: sidebar_api_access_review_service_v2,
+            },
+            {
+              type: "category",
+              label: "Audit",
+              link: {
+                type: "generated-index",
+                title: "Audit Service API",
+                slug: "/apis/resources/audit_service_v2",
+                description:
+                  "AuditService provides normalized, human-readable audit records of the actions in an instance, which can be filtered, paginated and exported as NDJSON."
+              },
+              items: sidebar_api_audit_service_v2,
+            },
+            {
+              type: "category",
+              label: "Login Analytics",
+              link: {
+                type: "generated-index",
+                title: "Login Analytics Service API",
+                slug: "/apis/resources/login_analytics_service_v2",
+                description:
+                  "LoginAnalyticsService provides time series and top-N breakdowns of the login outcomes of an instance, per method, failure reason, application and identity provider."
+              },
+              items: sidebar_api_login_analytics_service_v2,
+            },
           ],
         },
         {
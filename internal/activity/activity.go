@@ -12,6 +12,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/info"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/usage"
 )
 
 const (
@@ -70,6 +71,7 @@ func Trigger(ctx context.Context, orgID, userID string, trigger TriggerMethod, r
 		"",
 		authz.GetCtxData(ctx).SystemMemberships != nil,
 	)
+	userActive(ctx, orgID, userID)
 }
 
 func TriggerGRPCWithContext(ctx context.Context, trigger TriggerMethod) {
@@ -87,6 +89,16 @@ func TriggerGRPCWithContext(ctx context.Context, trigger TriggerMethod) {
 		strconv.Itoa(runtime.HTTPStatusFromCode(ai.GRPCStatus)),
 		authz.GetCtxData(ctx).SystemMemberships != nil,
 	)
+	userActive(ctx, authz.GetCtxData(ctx).ResourceOwner, authz.GetCtxData(ctx).UserID)
+}
+
+// userActive counts the user for the active users quota of the instance and of the user's organization,
+// system users are not part of the instance and therefore not counted.
+func userActive(ctx context.Context, orgID, userID string) {
+	if authz.GetCtxData(ctx).SystemMemberships != nil {
+		return
+	}
+	usage.UserActive(ctx, authz.GetInstance(ctx).InstanceID(), orgID, userID)
 }
 
 func triggerLog(instanceID, orgID, userID, domain string, trigger TriggerMethod, method, path, requestMethod, grpcStatus, httpStatus string, isSystemUser bool) {
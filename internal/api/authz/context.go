@@ -67,6 +67,8 @@ const (
 	MemberTypeProjectGrant
 	MemberTypeIAM
 	MemberTypeSystem
+	MemberTypeApplication
+	MemberTypeProjectRole
 )
 
 type TokenVerifier interface {
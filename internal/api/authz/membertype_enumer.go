@@ -9,11 +9,11 @@ import (
 	"strings"
 )
 
-const _MemberTypeName = "UnspecifiedOrganizationProjectProjectGrantIAMSystem"
+const _MemberTypeName = "UnspecifiedOrganizationProjectProjectGrantIAMSystemApplicationProjectRole"
 
-var _MemberTypeIndex = [...]uint8{0, 11, 23, 30, 42, 45, 51}
+var _MemberTypeIndex = [...]uint8{0, 11, 23, 30, 42, 45, 51, 62, 73}
 
-const _MemberTypeLowerName = "unspecifiedorganizationprojectprojectgrantiamsystem"
+const _MemberTypeLowerName = "unspecifiedorganizationprojectprojectgrantiamsystemapplicationprojectrole"
 
 func (i MemberType) String() string {
 	if i < 0 || i >= MemberType(len(_MemberTypeIndex)-1) {
@@ -32,9 +32,11 @@ func _MemberTypeNoOp() {
 	_ = x[MemberTypeProjectGrant-(3)]
 	_ = x[MemberTypeIAM-(4)]
 	_ = x[MemberTypeSystem-(5)]
+	_ = x[MemberTypeApplication-(6)]
+	_ = x[MemberTypeProjectRole-(7)]
 }
 
-var _MemberTypeValues = []MemberType{MemberTypeUnspecified, MemberTypeOrganization, MemberTypeProject, MemberTypeProjectGrant, MemberTypeIAM, MemberTypeSystem}
+var _MemberTypeValues = []MemberType{MemberTypeUnspecified, MemberTypeOrganization, MemberTypeProject, MemberTypeProjectGrant, MemberTypeIAM, MemberTypeSystem, MemberTypeApplication, MemberTypeProjectRole}
 
 var _MemberTypeNameToValueMap = map[string]MemberType{
 	_MemberTypeName[0:11]:       MemberTypeUnspecified,
@@ -49,6 +51,10 @@ var _MemberTypeNameToValueMap = map[string]MemberType{
 	_MemberTypeLowerName[42:45]: MemberTypeIAM,
 	_MemberTypeName[45:51]:      MemberTypeSystem,
 	_MemberTypeLowerName[45:51]: MemberTypeSystem,
+	_MemberTypeName[51:62]:      MemberTypeApplication,
+	_MemberTypeLowerName[51:62]: MemberTypeApplication,
+	_MemberTypeName[62:73]:      MemberTypeProjectRole,
+	_MemberTypeLowerName[62:73]: MemberTypeProjectRole,
 }
 
 var _MemberTypeNames = []string{
@@ -58,6 +64,8 @@ var _MemberTypeNames = []string{
 	_MemberTypeName[30:42],
 	_MemberTypeName[42:45],
 	_MemberTypeName[45:51],
+	_MemberTypeName[51:62],
+	_MemberTypeName[62:73],
 }
 
 // MemberTypeString retrieves an enum value from the enum constants string name.
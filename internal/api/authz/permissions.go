@@ -134,8 +134,12 @@ func ExistsPerm(existingPermissions []string, perm string) bool {
 }
 
 func roleWithContext(membership *Membership) (roles []string, ctxID string) {
-	if membership.MemberType == MemberTypeProject || membership.MemberType == MemberTypeProjectGrant {
+	switch membership.MemberType {
+	case MemberTypeProject, MemberTypeProjectGrant, MemberTypeApplication, MemberTypeProjectRole:
 		return membership.Roles, membership.ObjectID
+	case MemberTypeUnspecified, MemberTypeOrganization, MemberTypeIAM, MemberTypeSystem:
+		fallthrough
+	default:
+		return membership.Roles, ""
 	}
-	return membership.Roles, ""
 }
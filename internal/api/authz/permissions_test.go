@@ -413,6 +413,54 @@ func Test_MapMembershipToPerm(t *testing.T) {
 			requestPerms: []string{"project.read", "project.read:1"},
 			allPerms:     []string{"org.read", "project.read", "project.read:1"},
 		},
+		{
+			name: "application perm with context id",
+			args: args{
+				requiredPerm: "project.app.write",
+				membership: &Membership{
+					AggregateID: "1",
+					ObjectID:    "2",
+					MemberType:  MemberTypeApplication,
+					Roles:       []string{"PROJECT_APPLICATION_OWNER"},
+				},
+				authConfig: Config{
+					RolePermissionMappings: []RoleMapping{
+						{
+							Role:        "PROJECT_APPLICATION_OWNER",
+							Permissions: []string{"project.app.read", "project.app.write"},
+						},
+					},
+				},
+				requestPerms: []string{},
+				allPerms:     []string{},
+			},
+			requestPerms: []string{"project.app.write:2"},
+			allPerms:     []string{"project.app.read:2", "project.app.write:2"},
+		},
+		{
+			name: "project role perm with context id",
+			args: args{
+				requiredPerm: "user.grant.write",
+				membership: &Membership{
+					AggregateID: "1",
+					ObjectID:    "1/role",
+					MemberType:  MemberTypeProjectRole,
+					Roles:       []string{"PROJECT_ROLE_ASSIGNER"},
+				},
+				authConfig: Config{
+					RolePermissionMappings: []RoleMapping{
+						{
+							Role:        "PROJECT_ROLE_ASSIGNER",
+							Permissions: []string{"user.grant.read", "user.grant.write"},
+						},
+					},
+				},
+				requestPerms: []string{},
+				allPerms:     []string{},
+			},
+			requestPerms: []string{"user.grant.write:1/role"},
+			allPerms:     []string{"user.grant.read:1/role", "user.grant.write:1/role"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -33,7 +33,7 @@ func StartSystemTokenVerifierFromConfig(issuer string, keys map[string]*SystemAP
 			switch membership.MemberType {
 			case MemberTypeSystem, MemberTypeIAM, MemberTypeOrganization:
 				systemUsers[userID] = key.Memberships
-			case MemberTypeUnspecified, MemberTypeProject, MemberTypeProjectGrant:
+			case MemberTypeUnspecified, MemberTypeProject, MemberTypeProjectGrant, MemberTypeApplication, MemberTypeProjectRole:
 				return nil, errors.New("for system users, only the membership types System, IAM and Organization are supported")
 			default:
 				return nil, errors.New("unknown membership type")
@@ -0,0 +1,176 @@
+package access_review
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/pkg/grpc/access_review/v2"
+)
+
+func (s *Server) CreateCampaign(ctx context.Context, req *connect.Request[access_review.CreateCampaignRequest]) (*connect.Response[access_review.CreateCampaignResponse], error) {
+	id, details, err := s.command.CreateAccessReviewCampaign(ctx, createCampaignRequestToCommand(req.Msg))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&access_review.CreateCampaignResponse{
+		Id:           id,
+		CreationDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) GetCampaign(ctx context.Context, req *connect.Request[access_review.GetCampaignRequest]) (*connect.Response[access_review.GetCampaignResponse], error) {
+	campaign, err := s.query.AccessReviewCampaignByID(ctx, req.Msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&access_review.GetCampaignResponse{
+		Campaign: campaignToPb(campaign),
+	}), nil
+}
+
+func (s *Server) ListCampaigns(ctx context.Context, req *connect.Request[access_review.ListCampaignsRequest]) (*connect.Response[access_review.ListCampaignsResponse], error) {
+	campaigns, err := s.query.ListAccessReviewCampaigns(ctx, req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	pbCampaigns := make([]*access_review.Campaign, len(campaigns))
+	for i, campaign := range campaigns {
+		pbCampaigns[i] = campaignToPb(campaign)
+	}
+	return connect.NewResponse(&access_review.ListCampaignsResponse{
+		Campaigns: pbCampaigns,
+	}), nil
+}
+
+func (s *Server) ListItems(ctx context.Context, req *connect.Request[access_review.ListItemsRequest]) (*connect.Response[access_review.ListItemsResponse], error) {
+	items, err := s.query.ListAccessReviewItems(ctx, req.Msg.GetCampaignId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&access_review.ListItemsResponse{
+		Items: itemsToPb(items),
+	}), nil
+}
+
+func (s *Server) ReviewItem(ctx context.Context, req *connect.Request[access_review.ReviewItemRequest]) (*connect.Response[access_review.ReviewItemResponse], error) {
+	details, err := s.command.DecideAccessReviewItem(ctx,
+		req.Msg.GetCampaignId(),
+		req.Msg.GetItemId(),
+		decisionToDomain(req.Msg.GetDecision()),
+		req.Msg.GetComment(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&access_review.ReviewItemResponse{
+		ReviewDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) ListDecisions(ctx context.Context, req *connect.Request[access_review.ListDecisionsRequest]) (*connect.Response[access_review.ListDecisionsResponse], error) {
+	decisions, err := s.query.ListAccessReviewDecisions(ctx, req.Msg.GetCampaignId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&access_review.ListDecisionsResponse{
+		Decisions: itemsToPb(decisions),
+	}), nil
+}
+
+func createCampaignRequestToCommand(req *access_review.CreateCampaignRequest) *command.AccessReviewCampaign {
+	campaign := &command.AccessReviewCampaign{
+		OrganizationID: req.GetOrganizationId(),
+		Name:           req.GetName(),
+		ResourceType:   accessreview.ResourceTypeOrganization,
+		RoleKey:        req.GetRoleKey(),
+		ReviewerIDs:    req.GetReviewerIds(),
+		Deadline:       req.GetDeadline().AsTime(),
+		AutoRevoke:     req.GetAutoRevoke(),
+	}
+	if projectID, ok := req.GetResource().(*access_review.CreateCampaignRequest_ProjectId); ok {
+		campaign.ResourceType = accessreview.ResourceTypeProject
+		campaign.ProjectID = projectID.ProjectId
+	}
+	return campaign
+}
+
+func campaignToPb(campaign *query.AccessReviewCampaign) *access_review.Campaign {
+	pbCampaign := &access_review.Campaign{
+		Id:             campaign.ID,
+		OrganizationId: campaign.ResourceOwner,
+		Name:           campaign.Name,
+		ReviewerIds:    campaign.ReviewerIDs,
+		Deadline:       timestamppb.New(campaign.Deadline),
+		AutoRevoke:     campaign.AutoRevoke,
+		State:          campaignStateToPb(campaign.State),
+	}
+	if campaign.ResourceType == accessreview.ResourceTypeProject {
+		pbCampaign.Resource = &access_review.Campaign_ProjectId{ProjectId: campaign.ProjectID}
+	} else {
+		pbCampaign.Resource = &access_review.Campaign_Organization{Organization: true}
+	}
+	if campaign.RoleKey != "" {
+		pbCampaign.RoleKey = &campaign.RoleKey
+	}
+	return pbCampaign
+}
+
+func campaignStateToPb(state domain.AccessReviewState) access_review.CampaignState {
+	switch state {
+	case domain.AccessReviewStateActive:
+		return access_review.CampaignState_CAMPAIGN_STATE_ACTIVE
+	case domain.AccessReviewStateCompleted:
+		return access_review.CampaignState_CAMPAIGN_STATE_COMPLETED
+	case domain.AccessReviewStateUnspecified:
+		fallthrough
+	default:
+		return access_review.CampaignState_CAMPAIGN_STATE_UNSPECIFIED
+	}
+}
+
+func itemsToPb(items []*query.AccessReviewItem) []*access_review.Item {
+	pbItems := make([]*access_review.Item, len(items))
+	for i, item := range items {
+		pbItems[i] = &access_review.Item{
+			Id:         item.ID,
+			UserId:     item.UserID,
+			Roles:      item.Roles,
+			Decision:   decisionToPb(item.Decision),
+			ReviewerId: item.ReviewerID,
+			Comment:    item.Comment,
+		}
+	}
+	return pbItems
+}
+
+func decisionToPb(decision domain.AccessReviewDecision) access_review.Decision {
+	switch decision {
+	case domain.AccessReviewDecisionApproved:
+		return access_review.Decision_DECISION_APPROVED
+	case domain.AccessReviewDecisionRevoked:
+		return access_review.Decision_DECISION_REVOKED
+	case domain.AccessReviewDecisionUnspecified:
+		fallthrough
+	default:
+		return access_review.Decision_DECISION_UNSPECIFIED
+	}
+}
+
+func decisionToDomain(decision access_review.Decision) domain.AccessReviewDecision {
+	switch decision {
+	case access_review.Decision_DECISION_APPROVED:
+		return domain.AccessReviewDecisionApproved
+	case access_review.Decision_DECISION_REVOKED:
+		return domain.AccessReviewDecisionRevoked
+	case access_review.Decision_DECISION_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.AccessReviewDecisionUnspecified
+	}
+}
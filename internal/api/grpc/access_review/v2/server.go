@@ -0,0 +1,51 @@
+package access_review
+
+import (
+	"net/http"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/access_review/v2"
+	"github.com/zitadel/zitadel/pkg/grpc/access_review/v2/access_reviewconnect"
+)
+
+var _ access_reviewconnect.AccessReviewServiceHandler = (*Server)(nil)
+
+type Server struct {
+	command *command.Commands
+	query   *query.Queries
+}
+
+func CreateServer(
+	command *command.Commands,
+	query *query.Queries,
+) *Server {
+	return &Server{
+		command: command,
+		query:   query,
+	}
+}
+
+func (s *Server) RegisterConnectServer(interceptors ...connect.Interceptor) (string, http.Handler) {
+	return access_reviewconnect.NewAccessReviewServiceHandler(s, connect.WithInterceptors(interceptors...))
+}
+
+func (s *Server) FileDescriptor() protoreflect.FileDescriptor {
+	return access_review.File_zitadel_access_review_v2_access_review_service_proto
+}
+
+func (s *Server) AppName() string {
+	return access_review.AccessReviewService_ServiceDesc.ServiceName
+}
+
+func (s *Server) MethodPrefix() string {
+	return access_review.AccessReviewService_ServiceDesc.ServiceName
+}
+
+func (s *Server) AuthMethods() authz.MethodMapping {
+	return access_review.AccessReviewService_AuthMethods
+}
@@ -447,6 +447,27 @@ func (s *Server) UpdateSAMLProvider(ctx context.Context, req *admin_pb.UpdateSAM
 	}, nil
 }
 
+func (s *Server) AddWSFederationProvider(ctx context.Context, req *admin_pb.AddWSFederationProviderRequest) (*admin_pb.AddWSFederationProviderResponse, error) {
+	id, details, err := s.command.AddInstanceWSFederationProvider(ctx, addWSFederationProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddWSFederationProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateWSFederationProvider(ctx context.Context, req *admin_pb.UpdateWSFederationProviderRequest) (*admin_pb.UpdateWSFederationProviderResponse, error) {
+	details, err := s.command.UpdateInstanceWSFederationProvider(ctx, req.Id, updateWSFederationProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateWSFederationProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) RegenerateSAMLProviderCertificate(ctx context.Context, req *admin_pb.RegenerateSAMLProviderCertificateRequest) (*admin_pb.RegenerateSAMLProviderCertificateResponse, error) {
 	details, err := s.command.RegenerateInstanceSAMLProviderCertificate(ctx, req.Id)
 	if err != nil {
@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	quota_grpc "github.com/zitadel/zitadel/internal/api/grpc/quota"
+	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
+)
+
+func (s *Server) SetOrgQuota(ctx context.Context, req *admin_pb.SetOrgQuotaRequest) (*admin_pb.SetOrgQuotaResponse, error) {
+	details, err := s.command.SetOrgQuota(ctx, req.GetOrgId(), quota_grpc.SetQuotaPbToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.SetOrgQuotaResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) RemoveOrgQuota(ctx context.Context, req *admin_pb.RemoveOrgQuotaRequest) (*admin_pb.RemoveOrgQuotaResponse, error) {
+	details, err := s.command.RemoveOrgQuota(ctx, req.GetOrgId(), quota_grpc.UnitPbToCommand(req.GetUnit()))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.RemoveOrgQuotaResponse{
+		Details: object.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) GetOrgQuotaUsageReport(ctx context.Context, req *admin_pb.GetOrgQuotaUsageReportRequest) (*admin_pb.GetOrgQuotaUsageReportResponse, error) {
+	usages, err := s.query.GetOrgQuotaUsageReport(ctx, authz.GetInstance(ctx).InstanceID(), req.GetOrgId())
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.GetOrgQuotaUsageReportResponse{
+		Usages: quota_grpc.UsagesToPb(usages),
+	}, nil
+}
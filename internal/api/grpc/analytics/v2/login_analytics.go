@@ -0,0 +1,187 @@
+package analytics
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/analytics/v2"
+)
+
+const defaultBreakdownLimit = 10
+
+func (s *Server) GetLoginTimeSeries(ctx context.Context, req *connect.Request[analytics.GetLoginTimeSeriesRequest]) (*connect.Response[analytics.GetLoginTimeSeriesResponse], error) {
+	dataPoints, err := s.query.LoginAnalyticsTimeSeries(ctx, filterToQuery(req.Msg.GetFilter()), intervalToQuery(req.Msg.GetInterval()))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&analytics.GetLoginTimeSeriesResponse{
+		DataPoints: dataPointsToPb(dataPoints),
+	}), nil
+}
+
+func (s *Server) GetLoginBreakdown(ctx context.Context, req *connect.Request[analytics.GetLoginBreakdownRequest]) (*connect.Response[analytics.GetLoginBreakdownResponse], error) {
+	limit := req.Msg.GetLimit()
+	if limit == 0 {
+		limit = defaultBreakdownLimit
+	}
+	entries, err := s.query.LoginAnalyticsBreakdown(ctx, filterToQuery(req.Msg.GetFilter()), dimensionToQuery(req.Msg.GetDimension()), uint64(limit))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&analytics.GetLoginBreakdownResponse{
+		Entries: breakdownEntriesToPb(entries),
+	}), nil
+}
+
+func filterToQuery(filter *analytics.LoginAnalyticsFilter) *query.LoginAnalyticsSearchQuery {
+	searchQuery := &query.LoginAnalyticsSearchQuery{
+		Methods:  make([]domain.LoginMethod, len(filter.GetMethods())),
+		Outcomes: make([]domain.LoginOutcome, len(filter.GetOutcomes())),
+		ClientID: filter.GetClientId(),
+		IDPID:    filter.GetIdpId(),
+	}
+	if filter.GetSince() != nil {
+		searchQuery.Since = filter.GetSince().AsTime()
+	}
+	if filter.GetUntil() != nil {
+		searchQuery.Until = filter.GetUntil().AsTime()
+	}
+	for i, method := range filter.GetMethods() {
+		searchQuery.Methods[i] = methodToDomain(method)
+	}
+	for i, outcome := range filter.GetOutcomes() {
+		searchQuery.Outcomes[i] = outcomeToDomain(outcome)
+	}
+	return searchQuery
+}
+
+func intervalToQuery(interval analytics.LoginAnalyticsInterval) query.LoginAnalyticsInterval {
+	switch interval {
+	case analytics.LoginAnalyticsInterval_LOGIN_ANALYTICS_INTERVAL_DAY:
+		return query.LoginAnalyticsIntervalDay
+	case analytics.LoginAnalyticsInterval_LOGIN_ANALYTICS_INTERVAL_HOUR:
+		fallthrough
+	default:
+		return query.LoginAnalyticsIntervalHour
+	}
+}
+
+func dimensionToQuery(dimension analytics.LoginAnalyticsDimension) query.LoginAnalyticsDimension {
+	switch dimension {
+	case analytics.LoginAnalyticsDimension_LOGIN_ANALYTICS_DIMENSION_REASON:
+		return query.LoginAnalyticsDimensionReason
+	case analytics.LoginAnalyticsDimension_LOGIN_ANALYTICS_DIMENSION_CLIENT:
+		return query.LoginAnalyticsDimensionClient
+	case analytics.LoginAnalyticsDimension_LOGIN_ANALYTICS_DIMENSION_IDP:
+		return query.LoginAnalyticsDimensionIDP
+	case analytics.LoginAnalyticsDimension_LOGIN_ANALYTICS_DIMENSION_METHOD:
+		fallthrough
+	default:
+		return query.LoginAnalyticsDimensionMethod
+	}
+}
+
+func dataPointsToPb(dataPoints []*query.LoginAnalyticsDataPoint) []*analytics.LoginDataPoint {
+	pb := make([]*analytics.LoginDataPoint, len(dataPoints))
+	for i, dataPoint := range dataPoints {
+		pb[i] = &analytics.LoginDataPoint{
+			Bucket:  timestamppb.New(dataPoint.Bucket),
+			Outcome: outcomeToPb(dataPoint.Outcome),
+			Count:   dataPoint.Count,
+		}
+	}
+	return pb
+}
+
+func breakdownEntriesToPb(entries []*query.LoginAnalyticsBreakdownEntry) []*analytics.LoginBreakdownEntry {
+	pb := make([]*analytics.LoginBreakdownEntry, len(entries))
+	for i, entry := range entries {
+		pb[i] = &analytics.LoginBreakdownEntry{
+			Key:      entry.Key,
+			Count:    entry.Count,
+			Failures: entry.Failures,
+		}
+	}
+	return pb
+}
+
+func outcomeToPb(outcome domain.LoginOutcome) analytics.LoginOutcome {
+	switch outcome {
+	case domain.LoginOutcomeSuccess:
+		return analytics.LoginOutcome_LOGIN_OUTCOME_SUCCESS
+	case domain.LoginOutcomeWrongPassword:
+		return analytics.LoginOutcome_LOGIN_OUTCOME_WRONG_PASSWORD
+	case domain.LoginOutcomeLocked:
+		return analytics.LoginOutcome_LOGIN_OUTCOME_LOCKED
+	case domain.LoginOutcomeMFAFailed:
+		return analytics.LoginOutcome_LOGIN_OUTCOME_MFA_FAILED
+	case domain.LoginOutcomeIDPError:
+		return analytics.LoginOutcome_LOGIN_OUTCOME_IDP_ERROR
+	case domain.LoginOutcomeFailed:
+		return analytics.LoginOutcome_LOGIN_OUTCOME_FAILED
+	case domain.LoginOutcomeUnspecified:
+		fallthrough
+	default:
+		return analytics.LoginOutcome_LOGIN_OUTCOME_UNSPECIFIED
+	}
+}
+
+func outcomeToDomain(outcome analytics.LoginOutcome) domain.LoginOutcome {
+	switch outcome {
+	case analytics.LoginOutcome_LOGIN_OUTCOME_SUCCESS:
+		return domain.LoginOutcomeSuccess
+	case analytics.LoginOutcome_LOGIN_OUTCOME_WRONG_PASSWORD:
+		return domain.LoginOutcomeWrongPassword
+	case analytics.LoginOutcome_LOGIN_OUTCOME_LOCKED:
+		return domain.LoginOutcomeLocked
+	case analytics.LoginOutcome_LOGIN_OUTCOME_MFA_FAILED:
+		return domain.LoginOutcomeMFAFailed
+	case analytics.LoginOutcome_LOGIN_OUTCOME_IDP_ERROR:
+		return domain.LoginOutcomeIDPError
+	case analytics.LoginOutcome_LOGIN_OUTCOME_FAILED:
+		return domain.LoginOutcomeFailed
+	case analytics.LoginOutcome_LOGIN_OUTCOME_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.LoginOutcomeUnspecified
+	}
+}
+
+func methodToDomain(method analytics.LoginMethod) domain.LoginMethod {
+	switch method {
+	case analytics.LoginMethod_LOGIN_METHOD_PASSWORD:
+		return domain.LoginMethodPassword
+	case analytics.LoginMethod_LOGIN_METHOD_TOTP:
+		return domain.LoginMethodTOTP
+	case analytics.LoginMethod_LOGIN_METHOD_OTP_SMS:
+		return domain.LoginMethodOTPSMS
+	case analytics.LoginMethod_LOGIN_METHOD_OTP_EMAIL:
+		return domain.LoginMethodOTPEmail
+	case analytics.LoginMethod_LOGIN_METHOD_U2F:
+		return domain.LoginMethodU2F
+	case analytics.LoginMethod_LOGIN_METHOD_PASSWORDLESS:
+		return domain.LoginMethodPasswordless
+	case analytics.LoginMethod_LOGIN_METHOD_WEBAUTHN:
+		return domain.LoginMethodWebAuthN
+	case analytics.LoginMethod_LOGIN_METHOD_RECOVERY_CODE:
+		return domain.LoginMethodRecoveryCode
+	case analytics.LoginMethod_LOGIN_METHOD_X509:
+		return domain.LoginMethodX509
+	case analytics.LoginMethod_LOGIN_METHOD_PUSH:
+		return domain.LoginMethodPush
+	case analytics.LoginMethod_LOGIN_METHOD_IDP:
+		return domain.LoginMethodIDP
+	case analytics.LoginMethod_LOGIN_METHOD_OIDC:
+		return domain.LoginMethodOIDC
+	case analytics.LoginMethod_LOGIN_METHOD_SAML:
+		return domain.LoginMethodSAML
+	case analytics.LoginMethod_LOGIN_METHOD_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.LoginMethodUnspecified
+	}
+}
@@ -0,0 +1,47 @@
+package analytics
+
+import (
+	"net/http"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/analytics/v2"
+	"github.com/zitadel/zitadel/pkg/grpc/analytics/v2/analyticsconnect"
+)
+
+var _ analyticsconnect.LoginAnalyticsServiceHandler = (*Server)(nil)
+
+type Server struct {
+	query *query.Queries
+}
+
+func CreateServer(
+	query *query.Queries,
+) *Server {
+	return &Server{
+		query: query,
+	}
+}
+
+func (s *Server) RegisterConnectServer(interceptors ...connect.Interceptor) (string, http.Handler) {
+	return analyticsconnect.NewLoginAnalyticsServiceHandler(s, connect.WithInterceptors(interceptors...))
+}
+
+func (s *Server) FileDescriptor() protoreflect.FileDescriptor {
+	return analytics.File_zitadel_analytics_v2_login_analytics_service_proto
+}
+
+func (s *Server) AppName() string {
+	return analytics.LoginAnalyticsService_ServiceDesc.ServiceName
+}
+
+func (s *Server) MethodPrefix() string {
+	return analytics.LoginAnalyticsService_ServiceDesc.ServiceName
+}
+
+func (s *Server) AuthMethods() authz.MethodMapping {
+	return analytics.LoginAnalyticsService_AuthMethods
+}
@@ -0,0 +1,170 @@
+package convert
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/filter/v2"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/config/systemdefaults"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/grpc/application/v2"
+)
+
+func SetSCIMProvisioningRequestToCommand(req *application.SetApplicationSCIMProvisioningRequest) *command.SCIMProvisioningConnector {
+	connector := &command.SCIMProvisioningConnector{
+		ProjectID:       strings.TrimSpace(req.GetProjectId()),
+		AppID:           strings.TrimSpace(req.GetApplicationId()),
+		Endpoint:        strings.TrimSpace(req.GetEndpoint()),
+		ProvisionGroups: req.GetProvisionGroups(),
+	}
+	switch auth := req.GetAuthentication().(type) {
+	case *application.SetApplicationSCIMProvisioningRequest_BearerToken:
+		connector.AuthType = domain.SCIMProvisioningAuthTypeBearer
+		connector.Token = auth.BearerToken.GetToken()
+	case *application.SetApplicationSCIMProvisioningRequest_ClientCredentials:
+		connector.AuthType = domain.SCIMProvisioningAuthTypeClientCredentials
+		connector.ClientID = strings.TrimSpace(auth.ClientCredentials.GetClientId())
+		connector.ClientSecret = auth.ClientCredentials.GetClientSecret()
+		connector.TokenEndpoint = strings.TrimSpace(auth.ClientCredentials.GetTokenEndpoint())
+		connector.Scopes = auth.ClientCredentials.GetScopes()
+	}
+	return connector
+}
+
+func SCIMProvisioningConnectorToPb(connector *query.SCIMProvisioningConnector) *application.SCIMProvisioningConnector {
+	pb := &application.SCIMProvisioningConnector{
+		ApplicationId:   connector.AppID,
+		ProjectId:       connector.ProjectID,
+		CreationDate:    timestamppb.New(connector.CreationDate),
+		ChangeDate:      timestamppb.New(connector.ChangeDate),
+		Endpoint:        connector.Endpoint,
+		ProvisionGroups: connector.ProvisionGroups,
+		Status: &application.SCIMProvisioningStatus{
+			LastError: connector.LastError,
+		},
+	}
+	if !connector.LastSuccessDate.IsZero() {
+		pb.Status.LastSuccessDate = timestamppb.New(connector.LastSuccessDate)
+	}
+	if !connector.LastErrorDate.IsZero() {
+		pb.Status.LastErrorDate = timestamppb.New(connector.LastErrorDate)
+	}
+	switch connector.AuthType { //nolint:exhaustive
+	case domain.SCIMProvisioningAuthTypeClientCredentials:
+		pb.Authentication = &application.SCIMProvisioningConnector_ClientCredentials{
+			ClientCredentials: &application.SCIMProvisioningClientCredentials{
+				ClientId:      connector.ClientID,
+				TokenEndpoint: connector.TokenEndpoint,
+				Scopes:        connector.Scopes,
+			},
+		}
+	case domain.SCIMProvisioningAuthTypeBearer:
+		pb.Authentication = &application.SCIMProvisioningConnector_BearerToken{
+			BearerToken: &application.SCIMProvisioningBearerToken{},
+		}
+	}
+	return pb
+}
+
+func ListSCIMProvisionedResourcesRequestToModel(sysDefaults systemdefaults.SystemDefaults, req *application.ListApplicationSCIMProvisionedResourcesRequest) (*query.SCIMProvisionedResourceSearchQueries, error) {
+	offset, limit, asc, err := filter.PaginationPbToQuery(sysDefaults, req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+	queries := make([]query.SearchQuery, len(req.GetFilters()))
+	for i, f := range req.GetFilters() {
+		queries[i], err = scimProvisionedResourceFilterToQuery(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &query.SCIMProvisionedResourceSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset: offset,
+			Limit:  limit,
+			Asc:    asc,
+		},
+		Queries: queries,
+	}, nil
+}
+
+func scimProvisionedResourceFilterToQuery(searchFilter *application.SCIMProvisionedResourceSearchFilter) (query.SearchQuery, error) {
+	switch f := searchFilter.GetFilter().(type) {
+	case *application.SCIMProvisionedResourceSearchFilter_ResourceTypeFilter:
+		return query.NewSCIMProvisionedResourceTypeSearchQuery(scimProvisionedResourceTypeToDomain(f.ResourceTypeFilter))
+	case *application.SCIMProvisionedResourceSearchFilter_StateFilter:
+		return query.NewSCIMProvisionedResourceStateSearchQuery(scimProvisionedResourceStateToDomain(f.StateFilter))
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "CONV-Sp3fI", "List.Query.Invalid")
+	}
+}
+
+func SCIMProvisionedResourcesToPb(resources []*query.SCIMProvisionedResource) []*application.SCIMProvisionedResource {
+	pb := make([]*application.SCIMProvisionedResource, len(resources))
+	for i, resource := range resources {
+		pb[i] = &application.SCIMProvisionedResource{
+			ResourceType: scimProvisionedResourceTypeToPb(resource.ResourceType),
+			ResourceId:   resource.ResourceID,
+			RemoteId:     resource.RemoteID,
+			State:        scimProvisionedResourceStateToPb(resource.State),
+			Error:        resource.Error,
+			CreationDate: timestamppb.New(resource.CreationDate),
+			ChangeDate:   timestamppb.New(resource.ChangeDate),
+		}
+	}
+	return pb
+}
+
+func scimProvisionedResourceTypeToDomain(resourceType application.SCIMProvisionedResourceType) domain.SCIMProvisioningResourceType {
+	switch resourceType {
+	case application.SCIMProvisionedResourceType_SCIM_PROVISIONED_RESOURCE_TYPE_USER:
+		return domain.SCIMProvisioningResourceTypeUser
+	case application.SCIMProvisionedResourceType_SCIM_PROVISIONED_RESOURCE_TYPE_GROUP:
+		return domain.SCIMProvisioningResourceTypeGroup
+	case application.SCIMProvisionedResourceType_SCIM_PROVISIONED_RESOURCE_TYPE_UNSPECIFIED:
+		fallthrough
+	default:
+		return ""
+	}
+}
+
+func scimProvisionedResourceTypeToPb(resourceType domain.SCIMProvisioningResourceType) application.SCIMProvisionedResourceType {
+	switch resourceType {
+	case domain.SCIMProvisioningResourceTypeUser:
+		return application.SCIMProvisionedResourceType_SCIM_PROVISIONED_RESOURCE_TYPE_USER
+	case domain.SCIMProvisioningResourceTypeGroup:
+		return application.SCIMProvisionedResourceType_SCIM_PROVISIONED_RESOURCE_TYPE_GROUP
+	default:
+		return application.SCIMProvisionedResourceType_SCIM_PROVISIONED_RESOURCE_TYPE_UNSPECIFIED
+	}
+}
+
+func scimProvisionedResourceStateToDomain(state application.SCIMProvisionedResourceState) domain.SCIMProvisioningResourceState {
+	switch state {
+	case application.SCIMProvisionedResourceState_SCIM_PROVISIONED_RESOURCE_STATE_PROVISIONED:
+		return domain.SCIMProvisioningResourceStateProvisioned
+	case application.SCIMProvisionedResourceState_SCIM_PROVISIONED_RESOURCE_STATE_FAILED:
+		return domain.SCIMProvisioningResourceStateFailed
+	case application.SCIMProvisionedResourceState_SCIM_PROVISIONED_RESOURCE_STATE_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.SCIMProvisioningResourceStateUnspecified
+	}
+}
+
+func scimProvisionedResourceStateToPb(state domain.SCIMProvisioningResourceState) application.SCIMProvisionedResourceState {
+	switch state {
+	case domain.SCIMProvisioningResourceStateProvisioned:
+		return application.SCIMProvisionedResourceState_SCIM_PROVISIONED_RESOURCE_STATE_PROVISIONED
+	case domain.SCIMProvisioningResourceStateFailed:
+		return application.SCIMProvisionedResourceState_SCIM_PROVISIONED_RESOURCE_STATE_FAILED
+	case domain.SCIMProvisioningResourceStateUnspecified:
+		fallthrough
+	default:
+		return application.SCIMProvisionedResourceState_SCIM_PROVISIONED_RESOURCE_STATE_UNSPECIFIED
+	}
+}
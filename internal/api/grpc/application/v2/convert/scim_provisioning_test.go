@@ -0,0 +1,161 @@
+package convert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/application/v2"
+)
+
+func TestSetSCIMProvisioningRequestToCommand(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		req  *application.SetApplicationSCIMProvisioningRequest
+		want *command.SCIMProvisioningConnector
+	}{
+		{
+			name: "bearer token",
+			req: &application.SetApplicationSCIMProvisioningRequest{
+				ApplicationId: " app1 ",
+				ProjectId:     "project1",
+				Endpoint:      "https://app.example.com/scim/v2",
+				Authentication: &application.SetApplicationSCIMProvisioningRequest_BearerToken{
+					BearerToken: &application.SetSCIMProvisioningBearerToken{Token: "token"},
+				},
+			},
+			want: &command.SCIMProvisioningConnector{
+				ProjectID: "project1",
+				AppID:     "app1",
+				Endpoint:  "https://app.example.com/scim/v2",
+				AuthType:  domain.SCIMProvisioningAuthTypeBearer,
+				Token:     "token",
+			},
+		},
+		{
+			name: "client credentials",
+			req: &application.SetApplicationSCIMProvisioningRequest{
+				ApplicationId: "app1",
+				ProjectId:     "project1",
+				Endpoint:      "https://app.example.com/scim/v2",
+				Authentication: &application.SetApplicationSCIMProvisioningRequest_ClientCredentials{
+					ClientCredentials: &application.SetSCIMProvisioningClientCredentials{
+						ClientId:      "client",
+						ClientSecret:  "secret",
+						TokenEndpoint: "https://app.example.com/oauth/token",
+						Scopes:        []string{"scim"},
+					},
+				},
+				ProvisionGroups: true,
+			},
+			want: &command.SCIMProvisioningConnector{
+				ProjectID:       "project1",
+				AppID:           "app1",
+				Endpoint:        "https://app.example.com/scim/v2",
+				AuthType:        domain.SCIMProvisioningAuthTypeClientCredentials,
+				ClientID:        "client",
+				ClientSecret:    "secret",
+				TokenEndpoint:   "https://app.example.com/oauth/token",
+				Scopes:          []string{"scim"},
+				ProvisionGroups: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := SetSCIMProvisioningRequestToCommand(tt.req)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSCIMProvisioningConnectorToPb(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	tests := []struct {
+		name      string
+		connector *query.SCIMProvisioningConnector
+		want      *application.SCIMProvisioningConnector
+	}{
+		{
+			name: "bearer token, never provisioned",
+			connector: &query.SCIMProvisioningConnector{
+				AppID:        "app1",
+				ProjectID:    "project1",
+				CreationDate: now,
+				ChangeDate:   now,
+				Endpoint:     "https://app.example.com/scim/v2",
+				AuthType:     domain.SCIMProvisioningAuthTypeBearer,
+			},
+			want: &application.SCIMProvisioningConnector{
+				ApplicationId: "app1",
+				ProjectId:     "project1",
+				CreationDate:  timestamppb.New(now),
+				ChangeDate:    timestamppb.New(now),
+				Endpoint:      "https://app.example.com/scim/v2",
+				Authentication: &application.SCIMProvisioningConnector_BearerToken{
+					BearerToken: &application.SCIMProvisioningBearerToken{},
+				},
+				Status: &application.SCIMProvisioningStatus{},
+			},
+		},
+		{
+			name: "client credentials, failed",
+			connector: &query.SCIMProvisioningConnector{
+				AppID:           "app1",
+				ProjectID:       "project1",
+				CreationDate:    now,
+				ChangeDate:      now,
+				Endpoint:        "https://app.example.com/scim/v2",
+				AuthType:        domain.SCIMProvisioningAuthTypeClientCredentials,
+				ClientID:        "client",
+				TokenEndpoint:   "https://app.example.com/oauth/token",
+				Scopes:          []string{"scim"},
+				ProvisionGroups: true,
+				LastSuccessDate: now,
+				LastErrorDate:   now,
+				LastError:       "500 Internal Server Error",
+			},
+			want: &application.SCIMProvisioningConnector{
+				ApplicationId: "app1",
+				ProjectId:     "project1",
+				CreationDate:  timestamppb.New(now),
+				ChangeDate:    timestamppb.New(now),
+				Endpoint:      "https://app.example.com/scim/v2",
+				Authentication: &application.SCIMProvisioningConnector_ClientCredentials{
+					ClientCredentials: &application.SCIMProvisioningClientCredentials{
+						ClientId:      "client",
+						TokenEndpoint: "https://app.example.com/oauth/token",
+						Scopes:        []string{"scim"},
+					},
+				},
+				ProvisionGroups: true,
+				Status: &application.SCIMProvisioningStatus{
+					LastSuccessDate: timestamppb.New(now),
+					LastErrorDate:   timestamppb.New(now),
+					LastError:       "500 Internal Server Error",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := SCIMProvisioningConnectorToPb(tt.connector)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
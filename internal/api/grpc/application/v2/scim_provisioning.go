@@ -0,0 +1,67 @@
+package app
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/application/v2/convert"
+	"github.com/zitadel/zitadel/internal/api/grpc/filter/v2"
+	"github.com/zitadel/zitadel/pkg/grpc/application/v2"
+)
+
+func (s *Server) SetApplicationSCIMProvisioning(ctx context.Context, req *connect.Request[application.SetApplicationSCIMProvisioningRequest]) (*connect.Response[application.SetApplicationSCIMProvisioningResponse], error) {
+	details, err := s.command.SetApplicationSCIMProvisioning(ctx, convert.SetSCIMProvisioningRequestToCommand(req.Msg))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&application.SetApplicationSCIMProvisioningResponse{
+		ChangeDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) RemoveApplicationSCIMProvisioning(ctx context.Context, req *connect.Request[application.RemoveApplicationSCIMProvisioningRequest]) (*connect.Response[application.RemoveApplicationSCIMProvisioningResponse], error) {
+	details, err := s.command.RemoveApplicationSCIMProvisioning(ctx,
+		strings.TrimSpace(req.Msg.GetProjectId()),
+		strings.TrimSpace(req.Msg.GetApplicationId()),
+		"",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&application.RemoveApplicationSCIMProvisioningResponse{
+		DeletionDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) GetApplicationSCIMProvisioning(ctx context.Context, req *connect.Request[application.GetApplicationSCIMProvisioningRequest]) (*connect.Response[application.GetApplicationSCIMProvisioningResponse], error) {
+	connector, err := s.query.GetSCIMProvisioningConnectorWithPermission(ctx, strings.TrimSpace(req.Msg.GetApplicationId()), s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&application.GetApplicationSCIMProvisioningResponse{
+		Connector: convert.SCIMProvisioningConnectorToPb(connector),
+	}), nil
+}
+
+func (s *Server) ListApplicationSCIMProvisionedResources(ctx context.Context, req *connect.Request[application.ListApplicationSCIMProvisionedResourcesRequest]) (*connect.Response[application.ListApplicationSCIMProvisionedResourcesResponse], error) {
+	queries, err := convert.ListSCIMProvisionedResourcesRequestToModel(s.systemDefaults, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.query.SearchSCIMProvisionedResources(ctx, strings.TrimSpace(req.Msg.GetApplicationId()), queries, s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&application.ListApplicationSCIMProvisionedResourcesResponse{
+		Resources:  convert.SCIMProvisionedResourcesToPb(res.Resources),
+		Pagination: filter.QueryToPaginationPb(queries.SearchRequest, res.SearchResponse),
+	}), nil
+}
@@ -0,0 +1,177 @@
+package audit
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/grpc/audit/v2"
+)
+
+const (
+	defaultListLimit   = 100
+	defaultExportLimit = 1000
+)
+
+func (s *Server) ListAuditRecords(ctx context.Context, req *connect.Request[audit.ListAuditRecordsRequest]) (*connect.Response[audit.ListAuditRecordsResponse], error) {
+	records, err := s.query.ListAuditRecords(ctx, filterToQuery(req.Msg.GetFilter(), req.Msg.GetLimit(), defaultListLimit, req.Msg.GetCursor()))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&audit.ListAuditRecordsResponse{
+		Records:    auditRecordsToPb(records.Records),
+		NextCursor: records.NextCursor,
+	}), nil
+}
+
+func (s *Server) ExportAuditRecords(ctx context.Context, req *connect.Request[audit.ExportAuditRecordsRequest]) (*connect.Response[audit.ExportAuditRecordsResponse], error) {
+	records, err := s.query.ListAuditRecords(ctx, filterToQuery(req.Msg.GetFilter(), req.Msg.GetLimit(), defaultExportLimit, req.Msg.GetCursor()))
+	if err != nil {
+		return nil, err
+	}
+	ndjson, err := auditRecordsToNDJSON(auditRecordsToPb(records.Records))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&audit.ExportAuditRecordsResponse{
+		Records:    ndjson,
+		NextCursor: records.NextCursor,
+	}), nil
+}
+
+func filterToQuery(filter *audit.AuditRecordFilter, limit, defaultLimit uint32, cursor string) *query.AuditRecordSearchQuery {
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	searchQuery := &query.AuditRecordSearchQuery{
+		OrganizationID: filter.GetOrganizationId(),
+		ActorID:        filter.GetActorId(),
+		ResourceType:   filter.GetResourceType(),
+		ResourceID:     filter.GetResourceId(),
+		Categories:     make([]domain.AuditActionCategory, len(filter.GetCategories())),
+		Limit:          uint64(limit),
+		Cursor:         cursor,
+	}
+	if filter.GetSince() != nil {
+		searchQuery.Since = filter.GetSince().AsTime()
+	}
+	if filter.GetUntil() != nil {
+		searchQuery.Until = filter.GetUntil().AsTime()
+	}
+	for i, category := range filter.GetCategories() {
+		searchQuery.Categories[i] = actionCategoryToDomain(category)
+	}
+	return searchQuery
+}
+
+func auditRecordsToNDJSON(records []*audit.AuditRecord) ([]byte, error) {
+	ndjson := make([]byte, 0, len(records)*512)
+	for _, record := range records {
+		line, err := protojson.Marshal(record)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "AUDIT-Vb3kR", "Errors.Internal")
+		}
+		ndjson = append(ndjson, line...)
+		ndjson = append(ndjson, '\n')
+	}
+	return ndjson, nil
+}
+
+func auditRecordsToPb(records []*query.AuditRecord) []*audit.AuditRecord {
+	pb := make([]*audit.AuditRecord, len(records))
+	for i, record := range records {
+		pb[i] = auditRecordToPb(record)
+	}
+	return pb
+}
+
+func auditRecordToPb(record *query.AuditRecord) *audit.AuditRecord {
+	return &audit.AuditRecord{
+		Id:           record.ID,
+		CreationDate: timestamppb.New(record.CreationDate),
+		Actor: &audit.Actor{
+			Id:          record.Actor.ID,
+			Type:        actorTypeToPb(record.Actor.Type),
+			DisplayName: record.Actor.DisplayName,
+			LoginName:   record.Actor.LoginName,
+		},
+		Ip:        record.IP,
+		UserAgent: record.UserAgent,
+		Resource: &audit.Resource{
+			Type:           record.Resource.Type,
+			Id:             record.Resource.ID,
+			OrganizationId: record.Resource.OrganizationID,
+		},
+		Action:        record.Action,
+		Category:      actionCategoryToPb(record.Category),
+		Outcome:       outcomeToPb(record.Outcome),
+		ChangedFields: record.ChangedFields,
+	}
+}
+
+func actorTypeToPb(actorType domain.AuditActorType) audit.ActorType {
+	switch actorType {
+	case domain.AuditActorTypeHuman:
+		return audit.ActorType_ACTOR_TYPE_HUMAN
+	case domain.AuditActorTypeMachine:
+		return audit.ActorType_ACTOR_TYPE_MACHINE
+	case domain.AuditActorTypeSystem:
+		return audit.ActorType_ACTOR_TYPE_SYSTEM
+	case domain.AuditActorTypeUnspecified:
+		fallthrough
+	default:
+		return audit.ActorType_ACTOR_TYPE_UNSPECIFIED
+	}
+}
+
+func actionCategoryToPb(category domain.AuditActionCategory) audit.ActionCategory {
+	switch category {
+	case domain.AuditActionCategoryCreate:
+		return audit.ActionCategory_ACTION_CATEGORY_CREATE
+	case domain.AuditActionCategoryUpdate:
+		return audit.ActionCategory_ACTION_CATEGORY_UPDATE
+	case domain.AuditActionCategoryDelete:
+		return audit.ActionCategory_ACTION_CATEGORY_DELETE
+	case domain.AuditActionCategoryAuthentication:
+		return audit.ActionCategory_ACTION_CATEGORY_AUTHENTICATION
+	case domain.AuditActionCategoryUnspecified:
+		fallthrough
+	default:
+		return audit.ActionCategory_ACTION_CATEGORY_UNSPECIFIED
+	}
+}
+
+func actionCategoryToDomain(category audit.ActionCategory) domain.AuditActionCategory {
+	switch category {
+	case audit.ActionCategory_ACTION_CATEGORY_CREATE:
+		return domain.AuditActionCategoryCreate
+	case audit.ActionCategory_ACTION_CATEGORY_UPDATE:
+		return domain.AuditActionCategoryUpdate
+	case audit.ActionCategory_ACTION_CATEGORY_DELETE:
+		return domain.AuditActionCategoryDelete
+	case audit.ActionCategory_ACTION_CATEGORY_AUTHENTICATION:
+		return domain.AuditActionCategoryAuthentication
+	case audit.ActionCategory_ACTION_CATEGORY_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.AuditActionCategoryUnspecified
+	}
+}
+
+func outcomeToPb(outcome domain.AuditOutcome) audit.Outcome {
+	switch outcome {
+	case domain.AuditOutcomeSuccess:
+		return audit.Outcome_OUTCOME_SUCCESS
+	case domain.AuditOutcomeFailure:
+		return audit.Outcome_OUTCOME_FAILURE
+	case domain.AuditOutcomeUnspecified:
+		fallthrough
+	default:
+		return audit.Outcome_OUTCOME_UNSPECIFIED
+	}
+}
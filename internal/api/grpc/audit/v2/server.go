@@ -0,0 +1,47 @@
+package audit
+
+import (
+	"net/http"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/audit/v2"
+	"github.com/zitadel/zitadel/pkg/grpc/audit/v2/auditconnect"
+)
+
+var _ auditconnect.AuditServiceHandler = (*Server)(nil)
+
+type Server struct {
+	query *query.Queries
+}
+
+func CreateServer(
+	query *query.Queries,
+) *Server {
+	return &Server{
+		query: query,
+	}
+}
+
+func (s *Server) RegisterConnectServer(interceptors ...connect.Interceptor) (string, http.Handler) {
+	return auditconnect.NewAuditServiceHandler(s, connect.WithInterceptors(interceptors...))
+}
+
+func (s *Server) FileDescriptor() protoreflect.FileDescriptor {
+	return audit.File_zitadel_audit_v2_audit_service_proto
+}
+
+func (s *Server) AppName() string {
+	return audit.AuditService_ServiceDesc.ServiceName
+}
+
+func (s *Server) MethodPrefix() string {
+	return audit.AuditService_ServiceDesc.ServiceName
+}
+
+func (s *Server) AuthMethods() authz.MethodMapping {
+	return audit.AuditService_AuthMethods
+}
@@ -2,6 +2,7 @@ package authorization
 
 import (
 	"context"
+	"time"
 
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -19,6 +20,8 @@ func (s *Server) CreateAuthorization(ctx context.Context, req *connect.Request[a
 		ObjectRoot: models.ObjectRoot{
 			ResourceOwner: req.Msg.GetOrganizationId(),
 		},
+		ValidFrom:  optionalTime(req.Msg.ValidFrom),
+		ValidUntil: optionalTime(req.Msg.ValidUntil),
 	}
 	grant, err := s.command.AddUserGrant(ctx, grant, s.command.NewPermissionCheckUserGrantWrite(ctx))
 	if err != nil {
@@ -35,7 +38,9 @@ func (s *Server) UpdateAuthorization(ctx context.Context, request *connect.Reque
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: request.Msg.Id,
 		},
-		RoleKeys: request.Msg.RoleKeys,
+		RoleKeys:        request.Msg.RoleKeys,
+		ValidUntil:      optionalTime(request.Msg.ValidUntil),
+		ClearValidUntil: request.Msg.ClearValidUntil,
 	}, true, true, s.command.NewPermissionCheckUserGrantWrite(ctx))
 	if err != nil {
 		return nil, err
@@ -74,3 +79,11 @@ func (s *Server) DeactivateAuthorization(ctx context.Context, request *connect.R
 		ChangeDate: timestamppb.New(details.EventDate),
 	}), nil
 }
+
+func optionalTime(timestamp *timestamppb.Timestamp) *time.Time {
+	if timestamp == nil {
+		return nil
+	}
+	t := timestamp.AsTime()
+	return &t
+}
@@ -0,0 +1,142 @@
+package authorization
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/filter/v2"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/authorization/v2"
+)
+
+func (s *Server) CreateGroupAuthorization(ctx context.Context, req *connect.Request[authorization.CreateGroupAuthorizationRequest]) (*connect.Response[authorization.CreateGroupAuthorizationResponse], error) {
+	grant, err := s.command.AddGroupGrant(ctx, &domain.GroupGrant{
+		GroupID:   req.Msg.GetGroupId(),
+		ProjectID: req.Msg.GetProjectId(),
+		RoleKeys:  req.Msg.GetRoleKeys(),
+	}, s.command.NewPermissionCheckUserGrantWrite(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&authorization.CreateGroupAuthorizationResponse{
+		Id:           grant.AggregateID,
+		CreationDate: timestamppb.New(grant.ChangeDate),
+	}), nil
+}
+
+func (s *Server) UpdateGroupAuthorization(ctx context.Context, req *connect.Request[authorization.UpdateGroupAuthorizationRequest]) (*connect.Response[authorization.UpdateGroupAuthorizationResponse], error) {
+	grant, err := s.command.ChangeGroupGrant(ctx, &domain.GroupGrant{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID: req.Msg.GetId(),
+		},
+		RoleKeys: req.Msg.GetRoleKeys(),
+	}, true, s.command.NewPermissionCheckUserGrantWrite(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&authorization.UpdateGroupAuthorizationResponse{
+		ChangeDate: timestamppb.New(grant.ChangeDate),
+	}), nil
+}
+
+func (s *Server) DeleteGroupAuthorization(ctx context.Context, req *connect.Request[authorization.DeleteGroupAuthorizationRequest]) (*connect.Response[authorization.DeleteGroupAuthorizationResponse], error) {
+	details, err := s.command.RemoveGroupGrant(ctx, req.Msg.GetId(), "", true, s.command.NewPermissionCheckUserGrantDelete(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&authorization.DeleteGroupAuthorizationResponse{
+		DeletionDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) ListGroupAuthorizations(ctx context.Context, req *connect.Request[authorization.ListGroupAuthorizationsRequest]) (*connect.Response[authorization.ListGroupAuthorizationsResponse], error) {
+	queries, err := s.listGroupAuthorizationsRequestToModel(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.query.SearchGroupGrants(ctx, queries, s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&authorization.ListGroupAuthorizationsResponse{
+		Authorizations: groupGrantsToPb(resp.GroupGrants),
+		Pagination:     filter.QueryToPaginationPb(queries.SearchRequest, resp.SearchResponse),
+	}), nil
+}
+
+func (s *Server) listGroupAuthorizationsRequestToModel(req *authorization.ListGroupAuthorizationsRequest) (*query.GroupGrantsQueries, error) {
+	offset, limit, asc, err := filter.PaginationPbToQuery(s.systemDefaults, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	queries := make([]query.SearchQuery, len(req.GetFilters()))
+	for i, f := range req.GetFilters() {
+		queries[i], err = groupAuthorizationSearchFilterToQuery(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &query.GroupGrantsQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.GroupGrantColumnCreationDate,
+		},
+		Queries: queries,
+	}, nil
+}
+
+func groupAuthorizationSearchFilterToQuery(f *authorization.GroupAuthorizationsSearchFilter) (query.SearchQuery, error) {
+	switch q := f.Filter.(type) {
+	case *authorization.GroupAuthorizationsSearchFilter_AuthorizationIds:
+		return query.NewGroupGrantInIDsSearchQuery(q.AuthorizationIds.GetIds())
+	case *authorization.GroupAuthorizationsSearchFilter_OrganizationId:
+		return query.NewGroupGrantResourceOwnerSearchQuery(q.OrganizationId.GetId())
+	case *authorization.GroupAuthorizationsSearchFilter_InGroupIds:
+		return query.NewGroupGrantInGroupIDsSearchQuery(q.InGroupIds.GetIds())
+	case *authorization.GroupAuthorizationsSearchFilter_ProjectId:
+		return query.NewGroupGrantProjectIDSearchQuery(q.ProjectId.GetId())
+	case *authorization.GroupAuthorizationsSearchFilter_ProjectGrantId:
+		return query.NewGroupGrantGrantIDSearchQuery(q.ProjectGrantId.GetId())
+	case *authorization.GroupAuthorizationsSearchFilter_RoleKey:
+		return query.NewGroupGrantRoleQuery(q.RoleKey.GetKey())
+	default:
+		return nil, errors.New("invalid query")
+	}
+}
+
+func groupGrantsToPb(groupGrants []*query.GroupGrant) []*authorization.GroupAuthorization {
+	o := make([]*authorization.GroupAuthorization, len(groupGrants))
+	for i, grant := range groupGrants {
+		o[i] = groupGrantToPb(grant)
+	}
+	return o
+}
+
+func groupGrantToPb(groupGrant *query.GroupGrant) *authorization.GroupAuthorization {
+	return &authorization.GroupAuthorization{
+		Id:           groupGrant.ID,
+		CreationDate: timestamppb.New(groupGrant.CreationDate),
+		ChangeDate:   timestamppb.New(groupGrant.ChangeDate),
+		Project: &authorization.Project{
+			Id:             groupGrant.ProjectID,
+			Name:           groupGrant.ProjectName,
+			OrganizationId: groupGrant.ProjectResourceOwner,
+		},
+		Organization: &authorization.Organization{
+			Id: groupGrant.ResourceOwner,
+		},
+		Group: &authorization.Group{
+			Id:   groupGrant.GroupID,
+			Name: groupGrant.GroupName,
+		},
+		State: userGrantStateToPb(groupGrant.State),
+		Roles: rolesToPb(groupGrant.RoleInformation),
+	}
+}
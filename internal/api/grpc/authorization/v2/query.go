@@ -45,7 +45,8 @@ func (s *Server) listAuthorizationsRequestToModel(req *authorization.ListAuthori
 			Asc:           asc,
 			SortingColumn: authorizationFieldNameToSortingColumn(req.GetSortingColumn()),
 		},
-		Queries: queries,
+		Queries:          queries,
+		IncludeInherited: req.GetIncludeInherited(),
 	}, nil
 }
 
@@ -185,11 +186,19 @@ func userGrantToPb(userGrant *query.UserGrant) *authorization.Authorization {
 			AvatarUrl:          userGrant.AvatarURL,
 			OrganizationId:     userGrant.UserResourceOwner,
 		},
-		State: userGrantStateToPb(userGrant.State),
-		Roles: rolesToPb(userGrant.RoleInformation),
+		State:   userGrantStateToPb(userGrant.State),
+		Roles:   rolesToPb(userGrant.RoleInformation),
+		GroupId: groupIDToPb(userGrant.GroupID),
 	}
 }
 
+func groupIDToPb(groupID string) *string {
+	if groupID == "" {
+		return nil
+	}
+	return &groupID
+}
+
 func rolesToPb(roles []query.Role) []*authorization.Role {
 	r := make([]*authorization.Role, len(roles))
 	for i, role := range roles {
@@ -415,6 +415,8 @@ func providerTypeToPb(idpType domain.IDPType) idp_pb.ProviderType {
 		return idp_pb.ProviderType_PROVIDER_TYPE_APPLE
 	case domain.IDPTypeSAML:
 		return idp_pb.ProviderType_PROVIDER_TYPE_SAML
+	case domain.IDPTypeWSFederation:
+		return idp_pb.ProviderType_PROVIDER_TYPE_WS_FEDERATION
 	case domain.IDPTypeUnspecified:
 		return idp_pb.ProviderType_PROVIDER_TYPE_UNSPECIFIED
 	default:
@@ -480,6 +482,10 @@ func configToPb(config *query.IDPTemplate) *idp_pb.ProviderConfig {
 		samlConfigToPb(providerConfig, config.SAMLIDPTemplate)
 		return providerConfig
 	}
+	if config.WSFederationIDPTemplate != nil {
+		wsFederationConfigToPb(providerConfig, config.WSFederationIDPTemplate)
+		return providerConfig
+	}
 	return providerConfig
 }
 
@@ -676,6 +682,17 @@ func samlConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.SAMLI
 	}
 }
 
+func wsFederationConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.WSFederationIDPTemplate) {
+	providerConfig.Config = &idp_pb.ProviderConfig_Wsfed{
+		Wsfed: &idp_pb.WSFederationConfig{
+			SsoEndpoint: template.SSOEndpoint,
+			Realm:       template.Realm,
+			Certificate: template.SigningCertificate,
+			IdClaim:     template.IDClaim,
+		},
+	}
+}
+
 func bindingToPb(binding string) idp_pb.SAMLBinding {
 	switch binding {
 	case "":
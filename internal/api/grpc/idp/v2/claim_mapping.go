@@ -0,0 +1,68 @@
+package idp
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	idp_pb "github.com/zitadel/zitadel/pkg/grpc/idp/v2"
+)
+
+func (s *Server) SetIDPClaimMappings(ctx context.Context, req *connect.Request[idp_pb.SetIDPClaimMappingsRequest]) (*connect.Response[idp_pb.SetIDPClaimMappingsResponse], error) {
+	details, err := s.command.SetIDPClaimMappings(ctx, strings.TrimSpace(req.Msg.GetIdpId()), idpClaimMappingsToDomain(req.Msg.GetMappings()))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&idp_pb.SetIDPClaimMappingsResponse{
+		ChangeDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) GetIDPClaimMappings(ctx context.Context, req *connect.Request[idp_pb.GetIDPClaimMappingsRequest]) (*connect.Response[idp_pb.GetIDPClaimMappingsResponse], error) {
+	mappings, err := s.query.IDPClaimMappingsByIDPID(ctx, strings.TrimSpace(req.Msg.GetIdpId()), s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&idp_pb.GetIDPClaimMappingsResponse{
+		ChangeDate: timestamppb.New(mappings.ChangeDate),
+		Mappings:   idpClaimMappingsToPb(mappings.Mappings),
+	}), nil
+}
+
+func idpClaimMappingsToDomain(mappings []*idp_pb.IDPClaimMapping) []*domain.IDPClaimMapping {
+	result := make([]*domain.IDPClaimMapping, len(mappings))
+	for i, mapping := range mappings {
+		result[i] = &domain.IDPClaimMapping{
+			Claim:     strings.TrimSpace(mapping.GetClaim()),
+			Value:     strings.TrimSpace(mapping.GetValue()),
+			GroupID:   strings.TrimSpace(mapping.GetGroupId()),
+			ProjectID: strings.TrimSpace(mapping.GetRole().GetProjectId()),
+			RoleKey:   strings.TrimSpace(mapping.GetRole().GetRoleKey()),
+		}
+	}
+	return result
+}
+
+func idpClaimMappingsToPb(mappings []*domain.IDPClaimMapping) []*idp_pb.IDPClaimMapping {
+	result := make([]*idp_pb.IDPClaimMapping, len(mappings))
+	for i, mapping := range mappings {
+		result[i] = &idp_pb.IDPClaimMapping{
+			Claim: mapping.Claim,
+			Value: mapping.Value,
+		}
+		if mapping.GroupID != "" {
+			result[i].Target = &idp_pb.IDPClaimMapping_GroupId{GroupId: mapping.GroupID}
+			continue
+		}
+		result[i].Target = &idp_pb.IDPClaimMapping_Role{
+			Role: &idp_pb.IDPClaimMappingRole{
+				ProjectId: mapping.ProjectID,
+				RoleKey:   mapping.RoleKey,
+			},
+		}
+	}
+	return result
+}
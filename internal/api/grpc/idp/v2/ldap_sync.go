@@ -0,0 +1,161 @@
+package idp
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	idp_pb "github.com/zitadel/zitadel/pkg/grpc/idp/v2"
+)
+
+func (s *Server) SetLDAPSync(ctx context.Context, req *connect.Request[idp_pb.SetLDAPSyncRequest]) (*connect.Response[idp_pb.SetLDAPSyncResponse], error) {
+	details, err := s.command.SetLDAPSync(ctx, setLDAPSyncRequestToCommand(req.Msg))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&idp_pb.SetLDAPSyncResponse{
+		ChangeDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) RemoveLDAPSync(ctx context.Context, req *connect.Request[idp_pb.RemoveLDAPSyncRequest]) (*connect.Response[idp_pb.RemoveLDAPSyncResponse], error) {
+	details, err := s.command.RemoveLDAPSync(ctx, strings.TrimSpace(req.Msg.GetIdpId()))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&idp_pb.RemoveLDAPSyncResponse{
+		DeletionDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) GetLDAPSync(ctx context.Context, req *connect.Request[idp_pb.GetLDAPSyncRequest]) (*connect.Response[idp_pb.GetLDAPSyncResponse], error) {
+	sync, err := s.query.GetLDAPSyncWithPermission(ctx, strings.TrimSpace(req.Msg.GetIdpId()), s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&idp_pb.GetLDAPSyncResponse{
+		LdapSync: ldapSyncToPb(sync),
+	}), nil
+}
+
+func (s *Server) RunLDAPSync(ctx context.Context, req *connect.Request[idp_pb.RunLDAPSyncRequest]) (*connect.Response[idp_pb.RunLDAPSyncResponse], error) {
+	details, err := s.command.RunLDAPSync(ctx, strings.TrimSpace(req.Msg.GetIdpId()), req.Msg.GetFull())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&idp_pb.RunLDAPSyncResponse{
+		RequestDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func setLDAPSyncRequestToCommand(req *idp_pb.SetLDAPSyncRequest) *command.LDAPSync {
+	mappings := make([]*domain.LDAPSyncGroupMapping, len(req.GetGroupMappings()))
+	for i, mapping := range req.GetGroupMappings() {
+		mappings[i] = &domain.LDAPSyncGroupMapping{
+			LDAPGroup: strings.TrimSpace(mapping.GetLdapGroup()),
+			GroupID:   strings.TrimSpace(mapping.GetGroupId()),
+		}
+	}
+	return &command.LDAPSync{
+		IDPID:                strings.TrimSpace(req.GetIdpId()),
+		OrganizationID:       strings.TrimSpace(req.GetOrganizationId()),
+		Interval:             req.GetInterval().AsDuration(),
+		FullSyncInterval:     req.GetFullSyncInterval().AsDuration(),
+		Filter:               strings.TrimSpace(req.GetFilter()),
+		PageSize:             req.GetPageSize(),
+		IncrementalAttribute: ldapSyncIncrementalAttributeToDomain(req.GetIncrementalAttribute()),
+		GroupAttribute:       strings.TrimSpace(req.GetGroupAttribute()),
+		GroupMappings:        mappings,
+	}
+}
+
+func ldapSyncIncrementalAttributeToDomain(attribute idp_pb.LDAPSyncIncrementalAttribute) domain.LDAPSyncIncrementalAttribute {
+	switch attribute {
+	case idp_pb.LDAPSyncIncrementalAttribute_LDAP_SYNC_INCREMENTAL_ATTRIBUTE_MODIFY_TIMESTAMP:
+		return domain.LDAPSyncIncrementalAttributeModifyTimestamp
+	case idp_pb.LDAPSyncIncrementalAttribute_LDAP_SYNC_INCREMENTAL_ATTRIBUTE_USN_CHANGED:
+		return domain.LDAPSyncIncrementalAttributeUSNChanged
+	case idp_pb.LDAPSyncIncrementalAttribute_LDAP_SYNC_INCREMENTAL_ATTRIBUTE_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.LDAPSyncIncrementalAttributeUnspecified
+	}
+}
+
+func ldapSyncIncrementalAttributeToPb(attribute domain.LDAPSyncIncrementalAttribute) idp_pb.LDAPSyncIncrementalAttribute {
+	switch attribute {
+	case domain.LDAPSyncIncrementalAttributeModifyTimestamp:
+		return idp_pb.LDAPSyncIncrementalAttribute_LDAP_SYNC_INCREMENTAL_ATTRIBUTE_MODIFY_TIMESTAMP
+	case domain.LDAPSyncIncrementalAttributeUSNChanged:
+		return idp_pb.LDAPSyncIncrementalAttribute_LDAP_SYNC_INCREMENTAL_ATTRIBUTE_USN_CHANGED
+	default:
+		return idp_pb.LDAPSyncIncrementalAttribute_LDAP_SYNC_INCREMENTAL_ATTRIBUTE_UNSPECIFIED
+	}
+}
+
+func ldapSyncToPb(sync *query.LDAPSync) *idp_pb.LDAPSync {
+	mappings := make([]*idp_pb.LDAPSyncGroupMapping, len(sync.GroupMappings))
+	for i, mapping := range sync.GroupMappings {
+		mappings[i] = &idp_pb.LDAPSyncGroupMapping{
+			LdapGroup: mapping.LDAPGroup,
+			GroupId:   mapping.GroupID,
+		}
+	}
+	var fullSyncInterval *durationpb.Duration
+	if sync.FullSyncInterval > 0 {
+		fullSyncInterval = durationpb.New(sync.FullSyncInterval)
+	}
+	return &idp_pb.LDAPSync{
+		IdpId:                sync.IDPID,
+		CreationDate:         timestamppb.New(sync.CreationDate),
+		ChangeDate:           timestamppb.New(sync.ChangeDate),
+		OrganizationId:       sync.OrganizationID,
+		Interval:             durationpb.New(sync.Interval),
+		FullSyncInterval:     fullSyncInterval,
+		Filter:               sync.Filter,
+		PageSize:             sync.PageSize,
+		IncrementalAttribute: ldapSyncIncrementalAttributeToPb(sync.IncrementalAttribute),
+		GroupAttribute:       sync.GroupAttribute,
+		GroupMappings:        mappings,
+		Status: &idp_pb.LDAPSyncStatus{
+			NextRunDate:       timestampToPb(sync.NextRunAt),
+			LastRunDate:       timestampToPb(sync.LastRunDate),
+			LastFullSyncDate:  timestampToPb(sync.LastFullSyncDate),
+			LastSummary:       ldapSyncSummaryToPb(sync.LastSummary),
+			LastErrorDate:     timestampToPb(sync.LastErrorDate),
+			LastError:         sync.LastError,
+			FullSyncRequested: sync.FullSyncRequested,
+		},
+	}
+}
+
+func ldapSyncSummaryToPb(summary *domain.LDAPSyncSummary) *idp_pb.LDAPSyncSummary {
+	if summary == nil {
+		return nil
+	}
+	return &idp_pb.LDAPSyncSummary{
+		Full:          summary.Full,
+		Created:       summary.Created,
+		Updated:       summary.Updated,
+		Deactivated:   summary.Deactivated,
+		Reactivated:   summary.Reactivated,
+		GroupsAdded:   summary.GroupsAdded,
+		GroupsRemoved: summary.GroupsRemoved,
+		Failed:        summary.Failed,
+		LastError:     summary.LastError,
+	}
+}
+
+func timestampToPb(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -85,6 +85,8 @@ func idpTypeToPb(idpType domain.IDPType) idp_pb.IDPType {
 		return idp_pb.IDPType_IDP_TYPE_APPLE
 	case domain.IDPTypeSAML:
 		return idp_pb.IDPType_IDP_TYPE_SAML
+	case domain.IDPTypeWSFederation:
+		return idp_pb.IDPType_IDP_TYPE_WS_FEDERATION
 	case domain.IDPTypeUnspecified:
 		return idp_pb.IDPType_IDP_TYPE_UNSPECIFIED
 	default:
@@ -150,6 +152,10 @@ func configToPb(config *query.IDPTemplate) *idp_pb.IDPConfig {
 		samlConfigToPb(idpConfig, config.SAMLIDPTemplate)
 		return idpConfig
 	}
+	if config.WSFederationIDPTemplate != nil {
+		wsFederationConfigToPb(idpConfig, config.WSFederationIDPTemplate)
+		return idpConfig
+	}
 	return idpConfig
 }
 
@@ -344,6 +350,17 @@ func samlConfigToPb(idpConfig *idp_pb.IDPConfig, template *query.SAMLIDPTemplate
 	}
 }
 
+func wsFederationConfigToPb(idpConfig *idp_pb.IDPConfig, template *query.WSFederationIDPTemplate) {
+	idpConfig.Config = &idp_pb.IDPConfig_Wsfed{
+		Wsfed: &idp_pb.WSFederationConfig{
+			SsoEndpoint: template.SSOEndpoint,
+			Realm:       template.Realm,
+			Certificate: template.SigningCertificate,
+			IdClaim:     template.IDClaim,
+		},
+	}
+}
+
 func bindingToPb(binding string) idp_pb.SAMLBinding {
 	switch binding {
 	case "":
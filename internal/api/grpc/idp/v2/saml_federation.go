@@ -0,0 +1,270 @@
+package idp
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/crewjam/saml"
+	"github.com/muhlemmer/gu"
+	dsig "github.com/russellhaering/goxmldsig"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	idp_rp "github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/samlfederation"
+	idp_pb "github.com/zitadel/zitadel/pkg/grpc/idp/v2"
+)
+
+func (s *Server) AddSAMLFederation(ctx context.Context, req *connect.Request[idp_pb.AddSAMLFederationRequest]) (*connect.Response[idp_pb.AddSAMLFederationResponse], error) {
+	id, details, err := s.command.AddSAMLFederation(ctx, &command.SAMLFederation{
+		Name:               req.Msg.GetName(),
+		MetadataURL:        strings.TrimSpace(req.Msg.GetMetadataUrl()),
+		SigningCertificate: req.Msg.GetSigningCertificate(),
+		Filter:             samlFederationFilterToDomain(req.Msg.GetFilter()),
+		Interval:           req.Msg.GetInterval().AsDuration(),
+		AddToLoginPolicy:   req.Msg.GetAddToLoginPolicy(),
+		Template:           samlFederationTemplateToCommand(req.Msg.GetTemplate()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&idp_pb.AddSAMLFederationResponse{
+		Id:           id,
+		CreationDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) UpdateSAMLFederation(ctx context.Context, req *connect.Request[idp_pb.UpdateSAMLFederationRequest]) (*connect.Response[idp_pb.UpdateSAMLFederationResponse], error) {
+	details, err := s.command.ChangeSAMLFederation(ctx, strings.TrimSpace(req.Msg.GetId()), &command.SAMLFederation{
+		Name:               req.Msg.GetName(),
+		MetadataURL:        strings.TrimSpace(req.Msg.GetMetadataUrl()),
+		SigningCertificate: req.Msg.GetSigningCertificate(),
+		Filter:             samlFederationFilterToDomain(req.Msg.GetFilter()),
+		Interval:           req.Msg.GetInterval().AsDuration(),
+		AddToLoginPolicy:   req.Msg.GetAddToLoginPolicy(),
+		Template:           samlFederationTemplateToCommand(req.Msg.GetTemplate()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&idp_pb.UpdateSAMLFederationResponse{
+		ChangeDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) RemoveSAMLFederation(ctx context.Context, req *connect.Request[idp_pb.RemoveSAMLFederationRequest]) (*connect.Response[idp_pb.RemoveSAMLFederationResponse], error) {
+	details, err := s.command.RemoveSAMLFederation(ctx, strings.TrimSpace(req.Msg.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&idp_pb.RemoveSAMLFederationResponse{
+		DeletionDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) GetSAMLFederation(ctx context.Context, req *connect.Request[idp_pb.GetSAMLFederationRequest]) (*connect.Response[idp_pb.GetSAMLFederationResponse], error) {
+	federation, err := s.query.GetSAMLFederation(ctx, strings.TrimSpace(req.Msg.GetId()), s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&idp_pb.GetSAMLFederationResponse{
+		SamlFederation: samlFederationToPb(federation),
+	}), nil
+}
+
+func (s *Server) ListSAMLFederations(ctx context.Context, _ *connect.Request[idp_pb.ListSAMLFederationsRequest]) (*connect.Response[idp_pb.ListSAMLFederationsResponse], error) {
+	federations, err := s.query.ListSAMLFederations(ctx, s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*idp_pb.SAMLFederation, len(federations))
+	for i, federation := range federations {
+		result[i] = samlFederationToPb(federation)
+	}
+	return connect.NewResponse(&idp_pb.ListSAMLFederationsResponse{
+		SamlFederations: result,
+	}), nil
+}
+
+func (s *Server) RunSAMLFederationImport(ctx context.Context, req *connect.Request[idp_pb.RunSAMLFederationImportRequest]) (*connect.Response[idp_pb.RunSAMLFederationImportResponse], error) {
+	details, err := s.command.RequestSAMLFederationImport(ctx, strings.TrimSpace(req.Msg.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&idp_pb.RunSAMLFederationImportResponse{
+		RequestDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func samlFederationFilterToDomain(filter *idp_pb.SAMLFederationFilter) *domain.SAMLFederationFilter {
+	if filter == nil {
+		return nil
+	}
+	return &domain.SAMLFederationFilter{
+		EntityIDs:               filter.GetEntityIds(),
+		EntityIDPattern:         strings.TrimSpace(filter.GetEntityIdPattern()),
+		RegistrationAuthorities: filter.GetRegistrationAuthorities(),
+		EntityCategories:        filter.GetEntityCategories(),
+	}
+}
+
+func samlFederationTemplateToCommand(template *idp_pb.SAMLFederationTemplate) *samlfederation.IDPTemplate {
+	if template == nil {
+		return nil
+	}
+	var nameIDFormat *domain.SAMLNameIDFormat
+	if template.NameIdFormat != nil {
+		nameIDFormat = gu.Ptr(nameIDToDomain(template.GetNameIdFormat()))
+	}
+	return &samlfederation.IDPTemplate{
+		Binding:                       bindingToCommand(template.GetBinding()),
+		WithSignedRequest:             template.GetWithSignedRequest(),
+		SignatureAlgorithm:            signatureAlgorithmToCommand(template.GetSignatureAlgorithm()),
+		NameIDFormat:                  nameIDFormat,
+		TransientMappingAttributeName: strings.TrimSpace(template.GetTransientMappingAttributeName()),
+		FederatedLogoutEnabled:        template.GetFederatedLogoutEnabled(),
+		Options: idp_rp.Options{
+			IsCreationAllowed: template.GetOptions().GetIsCreationAllowed(),
+			IsLinkingAllowed:  template.GetOptions().GetIsLinkingAllowed(),
+			IsAutoCreation:    template.GetOptions().GetIsAutoCreation(),
+			IsAutoUpdate:      template.GetOptions().GetIsAutoUpdate(),
+			AutoLinkingOption: autoLinkingOptionToDomain(template.GetOptions().GetAutoLinking()),
+		},
+	}
+}
+
+func bindingToCommand(binding idp_pb.SAMLBinding) string {
+	switch binding {
+	case idp_pb.SAMLBinding_SAML_BINDING_POST:
+		return saml.HTTPPostBinding
+	case idp_pb.SAMLBinding_SAML_BINDING_REDIRECT:
+		return saml.HTTPRedirectBinding
+	case idp_pb.SAMLBinding_SAML_BINDING_ARTIFACT:
+		return saml.HTTPArtifactBinding
+	case idp_pb.SAMLBinding_SAML_BINDING_UNSPECIFIED:
+		fallthrough
+	default:
+		return ""
+	}
+}
+
+func signatureAlgorithmToCommand(signatureAlgorithm idp_pb.SAMLSignatureAlgorithm) string {
+	switch signatureAlgorithm {
+	case idp_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_RSA_SHA1:
+		return dsig.RSASHA1SignatureMethod
+	case idp_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_RSA_SHA256:
+		return dsig.RSASHA256SignatureMethod
+	case idp_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_RSA_SHA512:
+		return dsig.RSASHA512SignatureMethod
+	case idp_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_UNSPECIFIED:
+		fallthrough
+	default:
+		return ""
+	}
+}
+
+func nameIDToDomain(format idp_pb.SAMLNameIDFormat) domain.SAMLNameIDFormat {
+	switch format {
+	case idp_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_EMAIL_ADDRESS:
+		return domain.SAMLNameIDFormatEmailAddress
+	case idp_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_PERSISTENT:
+		return domain.SAMLNameIDFormatPersistent
+	case idp_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_TRANSIENT:
+		return domain.SAMLNameIDFormatTransient
+	case idp_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.SAMLNameIDFormatUnspecified
+	}
+}
+
+func autoLinkingOptionToDomain(linking idp_pb.AutoLinkingOption) domain.AutoLinkingOption {
+	switch linking {
+	case idp_pb.AutoLinkingOption_AUTO_LINKING_OPTION_USERNAME:
+		return domain.AutoLinkingOptionUsername
+	case idp_pb.AutoLinkingOption_AUTO_LINKING_OPTION_EMAIL:
+		return domain.AutoLinkingOptionEmail
+	case idp_pb.AutoLinkingOption_AUTO_LINKING_OPTION_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.AutoLinkingOptionUnspecified
+	}
+}
+
+func samlFederationToPb(federation *query.SAMLFederation) *idp_pb.SAMLFederation {
+	return &idp_pb.SAMLFederation{
+		Id:                 federation.ID,
+		CreationDate:       timestamppb.New(federation.CreationDate),
+		ChangeDate:         timestamppb.New(federation.ChangeDate),
+		Name:               federation.Name,
+		MetadataUrl:        federation.MetadataURL,
+		SigningCertificate: federation.SigningCertificate,
+		Filter:             samlFederationFilterToPb(federation.Filter),
+		Interval:           durationpb.New(federation.Interval),
+		AddToLoginPolicy:   federation.AddToLoginPolicy,
+		Template:           samlFederationTemplateToPb(federation.Template),
+		Status: &idp_pb.SAMLFederationStatus{
+			NextRunDate:   timestampToPb(federation.NextRunAt),
+			LastRunDate:   timestampToPb(federation.LastRunDate),
+			LastSummary:   samlFederationImportSummaryToPb(federation.LastSummary),
+			ValidUntil:    timestampToPb(federation.ValidUntil),
+			LastErrorDate: timestampToPb(federation.LastErrorDate),
+			LastError:     federation.LastError,
+		},
+	}
+}
+
+func samlFederationFilterToPb(filter *domain.SAMLFederationFilter) *idp_pb.SAMLFederationFilter {
+	if filter == nil {
+		return nil
+	}
+	return &idp_pb.SAMLFederationFilter{
+		EntityIds:               filter.EntityIDs,
+		EntityIdPattern:         filter.EntityIDPattern,
+		RegistrationAuthorities: filter.RegistrationAuthorities,
+		EntityCategories:        filter.EntityCategories,
+	}
+}
+
+func samlFederationTemplateToPb(template *samlfederation.IDPTemplate) *idp_pb.SAMLFederationTemplate {
+	if template == nil {
+		return nil
+	}
+	var nameIDFormat *idp_pb.SAMLNameIDFormat
+	if template.NameIDFormat != nil {
+		nameIDFormat = gu.Ptr(nameIDToPb(*template.NameIDFormat))
+	}
+	return &idp_pb.SAMLFederationTemplate{
+		Binding:                       bindingToPb(template.Binding),
+		WithSignedRequest:             template.WithSignedRequest,
+		SignatureAlgorithm:            signatureAlgorithmToPb(template.SignatureAlgorithm),
+		NameIdFormat:                  nameIDFormat,
+		TransientMappingAttributeName: template.TransientMappingAttributeName,
+		FederatedLogoutEnabled:        template.FederatedLogoutEnabled,
+		Options: &idp_pb.Options{
+			IsLinkingAllowed:  template.IsLinkingAllowed,
+			IsCreationAllowed: template.IsCreationAllowed,
+			IsAutoCreation:    template.IsAutoCreation,
+			IsAutoUpdate:      template.IsAutoUpdate,
+			AutoLinking:       AutoLinkingOptionToPb(template.AutoLinkingOption),
+		},
+	}
+}
+
+func samlFederationImportSummaryToPb(summary *domain.SAMLFederationImportSummary) *idp_pb.SAMLFederationImportSummary {
+	if summary == nil {
+		return nil
+	}
+	return &idp_pb.SAMLFederationImportSummary{
+		Entities:  summary.Entities,
+		Created:   summary.Created,
+		Updated:   summary.Updated,
+		Removed:   summary.Removed,
+		Failed:    summary.Failed,
+		LastError: summary.LastError,
+	}
+}
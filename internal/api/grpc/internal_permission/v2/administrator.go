@@ -2,12 +2,14 @@ package internal_permission
 
 import (
 	"context"
+	"time"
 
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 	"github.com/zitadel/zitadel/pkg/grpc/internal_permission/v2"
 )
@@ -18,7 +20,7 @@ func (s *Server) CreateAdministrator(ctx context.Context, req *connect.Request[i
 	switch resource := req.Msg.GetResource().GetResource().(type) {
 	case *internal_permission.ResourceType_Instance:
 		if resource.Instance {
-			member, err := s.command.AddInstanceMember(ctx, createAdministratorInstanceToCommand(authz.GetInstance(ctx).InstanceID(), req.Msg.UserId, req.Msg.Roles))
+			member, err := s.addInstanceAdministrator(ctx, createAdministratorInstanceToCommand(authz.GetInstance(ctx).InstanceID(), req.Msg.UserId, req.Msg.Roles, req.Msg.ValidUntil), req.Msg.ValidFrom)
 			if err != nil {
 				return nil, err
 			}
@@ -27,7 +29,7 @@ func (s *Server) CreateAdministrator(ctx context.Context, req *connect.Request[i
 			}
 		}
 	case *internal_permission.ResourceType_OrganizationId:
-		member, err := s.command.AddOrgMember(ctx, createAdministratorOrganizationToCommand(resource, req.Msg.UserId, req.Msg.Roles))
+		member, err := s.addOrgAdministrator(ctx, createAdministratorOrganizationToCommand(resource, req.Msg.UserId, req.Msg.Roles, req.Msg.ValidUntil), req.Msg.ValidFrom)
 		if err != nil {
 			return nil, err
 		}
@@ -35,7 +37,7 @@ func (s *Server) CreateAdministrator(ctx context.Context, req *connect.Request[i
 			creationDate = timestamppb.New(member.EventDate)
 		}
 	case *internal_permission.ResourceType_ProjectId:
-		member, err := s.command.AddProjectMember(ctx, createAdministratorProjectToCommand(resource, req.Msg.UserId, req.Msg.Roles))
+		member, err := s.addProjectAdministrator(ctx, createAdministratorProjectToCommand(resource, req.Msg.UserId, req.Msg.Roles, req.Msg.ValidUntil), req.Msg.ValidFrom)
 		if err != nil {
 			return nil, err
 		}
@@ -43,7 +45,23 @@ func (s *Server) CreateAdministrator(ctx context.Context, req *connect.Request[i
 			creationDate = timestamppb.New(member.EventDate)
 		}
 	case *internal_permission.ResourceType_ProjectGrant_:
-		member, err := s.command.AddProjectGrantMember(ctx, createAdministratorProjectGrantToCommand(resource, req.Msg.UserId, req.Msg.Roles))
+		member, err := s.addProjectGrantAdministrator(ctx, createAdministratorProjectGrantToCommand(resource, req.Msg.UserId, req.Msg.Roles, req.Msg.ValidUntil), req.Msg.ValidFrom)
+		if err != nil {
+			return nil, err
+		}
+		if !member.EventDate.IsZero() {
+			creationDate = timestamppb.New(member.EventDate)
+		}
+	case *internal_permission.ResourceType_Application_:
+		member, err := s.addApplicationAdministrator(ctx, administratorApplicationToCommand(resource, req.Msg.UserId, req.Msg.Roles, req.Msg.ValidUntil, false), req.Msg.ValidFrom)
+		if err != nil {
+			return nil, err
+		}
+		if !member.EventDate.IsZero() {
+			creationDate = timestamppb.New(member.EventDate)
+		}
+	case *internal_permission.ResourceType_ProjectRole_:
+		member, err := s.addProjectRoleAdministrator(ctx, administratorProjectRoleToCommand(resource, req.Msg.UserId, req.Msg.Roles, req.Msg.ValidUntil, false), req.Msg.ValidFrom)
 		if err != nil {
 			return nil, err
 		}
@@ -59,36 +77,118 @@ func (s *Server) CreateAdministrator(ctx context.Context, req *connect.Request[i
 	}), nil
 }
 
-func createAdministratorInstanceToCommand(instanceID, userID string, roles []string) *command.AddInstanceMember {
+// addInstanceAdministrator schedules the membership if it starts in the future, otherwise it's added directly.
+func (s *Server) addInstanceAdministrator(ctx context.Context, member *command.AddInstanceMember, validFrom *timestamppb.Timestamp) (*domain.ObjectDetails, error) {
+	if validFrom != nil && validFrom.AsTime().After(time.Now()) {
+		return s.command.ScheduleInstanceMember(ctx, member, validFrom.AsTime())
+	}
+	return s.command.AddInstanceMember(ctx, member)
+}
+
+// addOrgAdministrator schedules the membership if it starts in the future, otherwise it's added directly.
+func (s *Server) addOrgAdministrator(ctx context.Context, member *command.AddOrgMember, validFrom *timestamppb.Timestamp) (*domain.ObjectDetails, error) {
+	if validFrom != nil && validFrom.AsTime().After(time.Now()) {
+		return s.command.ScheduleOrgMember(ctx, member, validFrom.AsTime())
+	}
+	return s.command.AddOrgMember(ctx, member)
+}
+
+// addProjectAdministrator schedules the membership if it starts in the future, otherwise it's added directly.
+func (s *Server) addProjectAdministrator(ctx context.Context, member *command.AddProjectMember, validFrom *timestamppb.Timestamp) (*domain.ObjectDetails, error) {
+	if validFrom != nil && validFrom.AsTime().After(time.Now()) {
+		return s.command.ScheduleProjectMember(ctx, member, validFrom.AsTime())
+	}
+	return s.command.AddProjectMember(ctx, member)
+}
+
+// addProjectGrantAdministrator schedules the membership if it starts in the future, otherwise it's added directly.
+func (s *Server) addProjectGrantAdministrator(ctx context.Context, member *command.AddProjectGrantMember, validFrom *timestamppb.Timestamp) (*domain.ObjectDetails, error) {
+	if validFrom != nil && validFrom.AsTime().After(time.Now()) {
+		return s.command.ScheduleProjectGrantMember(ctx, member, validFrom.AsTime())
+	}
+	return s.command.AddProjectGrantMember(ctx, member)
+}
+
+// addApplicationAdministrator schedules the membership if it starts in the future, otherwise it's added directly.
+func (s *Server) addApplicationAdministrator(ctx context.Context, member *command.ApplicationMember, validFrom *timestamppb.Timestamp) (*domain.ObjectDetails, error) {
+	if validFrom != nil && validFrom.AsTime().After(time.Now()) {
+		return s.command.ScheduleApplicationMember(ctx, member, validFrom.AsTime())
+	}
+	return s.command.AddApplicationMember(ctx, member)
+}
+
+// addProjectRoleAdministrator schedules the membership if it starts in the future, otherwise it's added directly.
+func (s *Server) addProjectRoleAdministrator(ctx context.Context, member *command.ProjectRoleMember, validFrom *timestamppb.Timestamp) (*domain.ObjectDetails, error) {
+	if validFrom != nil && validFrom.AsTime().After(time.Now()) {
+		return s.command.ScheduleProjectRoleMember(ctx, member, validFrom.AsTime())
+	}
+	return s.command.AddProjectRoleMember(ctx, member)
+}
+
+func optionalTime(timestamp *timestamppb.Timestamp) *time.Time {
+	if timestamp == nil {
+		return nil
+	}
+	t := timestamp.AsTime()
+	return &t
+}
+
+func createAdministratorInstanceToCommand(instanceID, userID string, roles []string, validUntil *timestamppb.Timestamp) *command.AddInstanceMember {
 	return &command.AddInstanceMember{
 		InstanceID: instanceID,
 		UserID:     userID,
 		Roles:      roles,
+		ValidUntil: optionalTime(validUntil),
 	}
 }
 
-func createAdministratorOrganizationToCommand(req *internal_permission.ResourceType_OrganizationId, userID string, roles []string) *command.AddOrgMember {
+func createAdministratorOrganizationToCommand(req *internal_permission.ResourceType_OrganizationId, userID string, roles []string, validUntil *timestamppb.Timestamp) *command.AddOrgMember {
 	return &command.AddOrgMember{
-		OrgID:  req.OrganizationId,
-		UserID: userID,
-		Roles:  roles,
+		OrgID:      req.OrganizationId,
+		UserID:     userID,
+		Roles:      roles,
+		ValidUntil: optionalTime(validUntil),
 	}
 }
 
-func createAdministratorProjectToCommand(req *internal_permission.ResourceType_ProjectId, userID string, roles []string) *command.AddProjectMember {
+func createAdministratorProjectToCommand(req *internal_permission.ResourceType_ProjectId, userID string, roles []string, validUntil *timestamppb.Timestamp) *command.AddProjectMember {
 	return &command.AddProjectMember{
-		ProjectID: req.ProjectId,
-		UserID:    userID,
-		Roles:     roles,
+		ProjectID:  req.ProjectId,
+		UserID:     userID,
+		Roles:      roles,
+		ValidUntil: optionalTime(validUntil),
 	}
 }
 
-func createAdministratorProjectGrantToCommand(req *internal_permission.ResourceType_ProjectGrant_, userID string, roles []string) *command.AddProjectGrantMember {
+func createAdministratorProjectGrantToCommand(req *internal_permission.ResourceType_ProjectGrant_, userID string, roles []string, validUntil *timestamppb.Timestamp) *command.AddProjectGrantMember {
 	return &command.AddProjectGrantMember{
 		OrganizationID: req.ProjectGrant.OrganizationId,
 		ProjectID:      req.ProjectGrant.ProjectId,
 		UserID:         userID,
 		Roles:          roles,
+		ValidUntil:     optionalTime(validUntil),
+	}
+}
+
+func administratorApplicationToCommand(req *internal_permission.ResourceType_Application_, userID string, roles []string, validUntil *timestamppb.Timestamp, clearValidUntil bool) *command.ApplicationMember {
+	return &command.ApplicationMember{
+		ProjectID:       req.Application.ProjectId,
+		AppID:           req.Application.ApplicationId,
+		UserID:          userID,
+		Roles:           roles,
+		ValidUntil:      optionalTime(validUntil),
+		ClearValidUntil: clearValidUntil,
+	}
+}
+
+func administratorProjectRoleToCommand(req *internal_permission.ResourceType_ProjectRole_, userID string, roles []string, validUntil *timestamppb.Timestamp, clearValidUntil bool) *command.ProjectRoleMember {
+	return &command.ProjectRoleMember{
+		ProjectID:       req.ProjectRole.ProjectId,
+		RoleKey:         req.ProjectRole.RoleKey,
+		UserID:          userID,
+		Roles:           roles,
+		ValidUntil:      optionalTime(validUntil),
+		ClearValidUntil: clearValidUntil,
 	}
 }
 
@@ -98,7 +198,7 @@ func (s *Server) UpdateAdministrator(ctx context.Context, req *connect.Request[i
 	switch resource := req.Msg.GetResource().GetResource().(type) {
 	case *internal_permission.ResourceType_Instance:
 		if resource.Instance {
-			member, err := s.command.ChangeInstanceMember(ctx, updateAdministratorInstanceToCommand(authz.GetInstance(ctx).InstanceID(), req.Msg.UserId, req.Msg.Roles))
+			member, err := s.command.ChangeInstanceMember(ctx, updateAdministratorInstanceToCommand(authz.GetInstance(ctx).InstanceID(), req.Msg.UserId, req.Msg.Roles, req.Msg.ValidUntil, req.Msg.ClearValidUntil))
 			if err != nil {
 				return nil, err
 			}
@@ -107,7 +207,7 @@ func (s *Server) UpdateAdministrator(ctx context.Context, req *connect.Request[i
 			}
 		}
 	case *internal_permission.ResourceType_OrganizationId:
-		member, err := s.command.ChangeOrgMember(ctx, updateAdministratorOrganizationToCommand(resource, req.Msg.UserId, req.Msg.Roles))
+		member, err := s.command.ChangeOrgMember(ctx, updateAdministratorOrganizationToCommand(resource, req.Msg.UserId, req.Msg.Roles, req.Msg.ValidUntil, req.Msg.ClearValidUntil))
 		if err != nil {
 			return nil, err
 		}
@@ -115,7 +215,7 @@ func (s *Server) UpdateAdministrator(ctx context.Context, req *connect.Request[i
 			changeDate = timestamppb.New(member.EventDate)
 		}
 	case *internal_permission.ResourceType_ProjectId:
-		member, err := s.command.ChangeProjectMember(ctx, updateAdministratorProjectToCommand(resource, req.Msg.UserId, req.Msg.Roles))
+		member, err := s.command.ChangeProjectMember(ctx, updateAdministratorProjectToCommand(resource, req.Msg.UserId, req.Msg.Roles, req.Msg.ValidUntil, req.Msg.ClearValidUntil))
 		if err != nil {
 			return nil, err
 		}
@@ -123,7 +223,23 @@ func (s *Server) UpdateAdministrator(ctx context.Context, req *connect.Request[i
 			changeDate = timestamppb.New(member.EventDate)
 		}
 	case *internal_permission.ResourceType_ProjectGrant_:
-		member, err := s.command.ChangeProjectGrantMember(ctx, updateAdministratorProjectGrantToCommand(resource, req.Msg.UserId, req.Msg.Roles))
+		member, err := s.command.ChangeProjectGrantMember(ctx, updateAdministratorProjectGrantToCommand(resource, req.Msg.UserId, req.Msg.Roles, req.Msg.ValidUntil, req.Msg.ClearValidUntil))
+		if err != nil {
+			return nil, err
+		}
+		if !member.EventDate.IsZero() {
+			changeDate = timestamppb.New(member.EventDate)
+		}
+	case *internal_permission.ResourceType_Application_:
+		member, err := s.command.ChangeApplicationMember(ctx, administratorApplicationToCommand(resource, req.Msg.UserId, req.Msg.Roles, req.Msg.ValidUntil, req.Msg.ClearValidUntil))
+		if err != nil {
+			return nil, err
+		}
+		if !member.EventDate.IsZero() {
+			changeDate = timestamppb.New(member.EventDate)
+		}
+	case *internal_permission.ResourceType_ProjectRole_:
+		member, err := s.command.ChangeProjectRoleMember(ctx, administratorProjectRoleToCommand(resource, req.Msg.UserId, req.Msg.Roles, req.Msg.ValidUntil, req.Msg.ClearValidUntil))
 		if err != nil {
 			return nil, err
 		}
@@ -139,36 +255,44 @@ func (s *Server) UpdateAdministrator(ctx context.Context, req *connect.Request[i
 	}), nil
 }
 
-func updateAdministratorInstanceToCommand(instanceID, userID string, roles []string) *command.ChangeInstanceMember {
+func updateAdministratorInstanceToCommand(instanceID, userID string, roles []string, validUntil *timestamppb.Timestamp, clearValidUntil bool) *command.ChangeInstanceMember {
 	return &command.ChangeInstanceMember{
-		InstanceID: instanceID,
-		UserID:     userID,
-		Roles:      roles,
+		InstanceID:      instanceID,
+		UserID:          userID,
+		Roles:           roles,
+		ValidUntil:      optionalTime(validUntil),
+		ClearValidUntil: clearValidUntil,
 	}
 }
 
-func updateAdministratorOrganizationToCommand(req *internal_permission.ResourceType_OrganizationId, userID string, roles []string) *command.ChangeOrgMember {
+func updateAdministratorOrganizationToCommand(req *internal_permission.ResourceType_OrganizationId, userID string, roles []string, validUntil *timestamppb.Timestamp, clearValidUntil bool) *command.ChangeOrgMember {
 	return &command.ChangeOrgMember{
-		OrgID:  req.OrganizationId,
-		UserID: userID,
-		Roles:  roles,
+		OrgID:           req.OrganizationId,
+		UserID:          userID,
+		Roles:           roles,
+		ValidUntil:      optionalTime(validUntil),
+		ClearValidUntil: clearValidUntil,
 	}
 }
 
-func updateAdministratorProjectToCommand(req *internal_permission.ResourceType_ProjectId, userID string, roles []string) *command.ChangeProjectMember {
+func updateAdministratorProjectToCommand(req *internal_permission.ResourceType_ProjectId, userID string, roles []string, validUntil *timestamppb.Timestamp, clearValidUntil bool) *command.ChangeProjectMember {
 	return &command.ChangeProjectMember{
-		ProjectID: req.ProjectId,
-		UserID:    userID,
-		Roles:     roles,
+		ProjectID:       req.ProjectId,
+		UserID:          userID,
+		Roles:           roles,
+		ValidUntil:      optionalTime(validUntil),
+		ClearValidUntil: clearValidUntil,
 	}
 }
 
-func updateAdministratorProjectGrantToCommand(req *internal_permission.ResourceType_ProjectGrant_, userID string, roles []string) *command.ChangeProjectGrantMember {
+func updateAdministratorProjectGrantToCommand(req *internal_permission.ResourceType_ProjectGrant_, userID string, roles []string, validUntil *timestamppb.Timestamp, clearValidUntil bool) *command.ChangeProjectGrantMember {
 	return &command.ChangeProjectGrantMember{
-		OrganizationID: req.ProjectGrant.OrganizationId,
-		ProjectID:      req.ProjectGrant.ProjectId,
-		UserID:         userID,
-		Roles:          roles,
+		OrganizationID:  req.ProjectGrant.OrganizationId,
+		ProjectID:       req.ProjectGrant.ProjectId,
+		UserID:          userID,
+		Roles:           roles,
+		ValidUntil:      optionalTime(validUntil),
+		ClearValidUntil: clearValidUntil,
 	}
 }
 
@@ -210,6 +334,22 @@ func (s *Server) DeleteAdministrator(ctx context.Context, req *connect.Request[i
 		if !member.EventDate.IsZero() {
 			deletionDate = timestamppb.New(member.EventDate)
 		}
+	case *internal_permission.ResourceType_Application_:
+		member, err := s.command.RemoveApplicationMember(ctx, resource.Application.ProjectId, resource.Application.ApplicationId, req.Msg.UserId, "")
+		if err != nil {
+			return nil, err
+		}
+		if !member.EventDate.IsZero() {
+			deletionDate = timestamppb.New(member.EventDate)
+		}
+	case *internal_permission.ResourceType_ProjectRole_:
+		member, err := s.command.RemoveProjectRoleMember(ctx, resource.ProjectRole.ProjectId, resource.ProjectRole.RoleKey, req.Msg.UserId, "")
+		if err != nil {
+			return nil, err
+		}
+		if !member.EventDate.IsZero() {
+			deletionDate = timestamppb.New(member.EventDate)
+		}
 	default:
 		return nil, zerrors.ThrowInvalidArgument(nil, "ADMIN-3UOjLtuohh", "Errors.Invalid.Argument")
 	}
@@ -0,0 +1,83 @@
+package internal_permission
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/internal_permission/v2"
+)
+
+func (s *Server) ListCustomRoles(ctx context.Context, _ *connect.Request[internal_permission.ListCustomRolesRequest]) (*connect.Response[internal_permission.ListCustomRolesResponse], error) {
+	roles, err := s.query.ListCustomRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&internal_permission.ListCustomRolesResponse{
+		CustomRoles: customRolesToPb(roles),
+	}), nil
+}
+
+func customRolesToPb(roles []*query.CustomRole) []*internal_permission.CustomRole {
+	pbRoles := make([]*internal_permission.CustomRole, len(roles))
+	for i, role := range roles {
+		pbRoles[i] = &internal_permission.CustomRole{
+			Role:        role.Role,
+			DisplayName: role.DisplayName,
+			Permissions: role.Permissions,
+		}
+	}
+	return pbRoles
+}
+
+func (s *Server) CreateCustomRole(ctx context.Context, req *connect.Request[internal_permission.CreateCustomRoleRequest]) (*connect.Response[internal_permission.CreateCustomRoleResponse], error) {
+	details, err := s.command.AddCustomRole(ctx, &command.CustomRole{
+		InstanceID:  authz.GetInstance(ctx).InstanceID(),
+		Role:        req.Msg.GetRole(),
+		DisplayName: req.Msg.GetDisplayName(),
+		Permissions: req.Msg.GetPermissions(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&internal_permission.CreateCustomRoleResponse{
+		CreationDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) UpdateCustomRole(ctx context.Context, req *connect.Request[internal_permission.UpdateCustomRoleRequest]) (*connect.Response[internal_permission.UpdateCustomRoleResponse], error) {
+	details, err := s.command.ChangeCustomRole(ctx, &command.CustomRole{
+		InstanceID:  authz.GetInstance(ctx).InstanceID(),
+		Role:        req.Msg.GetRole(),
+		DisplayName: req.Msg.GetDisplayName(),
+		Permissions: req.Msg.GetPermissions(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var changeDate *timestamppb.Timestamp
+	if !details.EventDate.IsZero() {
+		changeDate = timestamppb.New(details.EventDate)
+	}
+	return connect.NewResponse(&internal_permission.UpdateCustomRoleResponse{
+		ChangeDate: changeDate,
+	}), nil
+}
+
+func (s *Server) DeleteCustomRole(ctx context.Context, req *connect.Request[internal_permission.DeleteCustomRoleRequest]) (*connect.Response[internal_permission.DeleteCustomRoleResponse], error) {
+	details, err := s.command.RemoveCustomRole(ctx, authz.GetInstance(ctx).InstanceID(), req.Msg.GetRole())
+	if err != nil {
+		return nil, err
+	}
+	var deletionDate *timestamppb.Timestamp
+	if !details.EventDate.IsZero() {
+		deletionDate = timestamppb.New(details.EventDate)
+	}
+	return connect.NewResponse(&internal_permission.DeleteCustomRoleResponse{
+		DeletionDate: deletionDate,
+	}), nil
+}
@@ -0,0 +1,106 @@
+package internal_permission
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/elevation"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/grpc/internal_permission/v2"
+)
+
+func (s *Server) RequestAdministratorElevation(ctx context.Context, req *connect.Request[internal_permission.RequestAdministratorElevationRequest]) (*connect.Response[internal_permission.RequestAdministratorElevationResponse], error) {
+	resourceType, resourceID, err := elevationResourceToCommand(ctx, req.Msg.GetResource())
+	if err != nil {
+		return nil, err
+	}
+	id, details, err := s.command.RequestElevation(ctx, &command.ElevationRequest{
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Roles:        req.Msg.GetRoles(),
+		Duration:     req.Msg.GetDuration().AsDuration(),
+		ValidFrom:    optionalTime(req.Msg.ValidFrom),
+		Reason:       req.Msg.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&internal_permission.RequestAdministratorElevationResponse{
+		ElevationId:  id,
+		CreationDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) ApproveAdministratorElevation(ctx context.Context, req *connect.Request[internal_permission.ApproveAdministratorElevationRequest]) (*connect.Response[internal_permission.ApproveAdministratorElevationResponse], error) {
+	details, err := s.command.ApproveElevation(ctx, req.Msg.GetElevationId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&internal_permission.ApproveAdministratorElevationResponse{
+		ApprovalDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) DenyAdministratorElevation(ctx context.Context, req *connect.Request[internal_permission.DenyAdministratorElevationRequest]) (*connect.Response[internal_permission.DenyAdministratorElevationResponse], error) {
+	details, err := s.command.DenyElevation(ctx, req.Msg.GetElevationId(), req.Msg.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&internal_permission.DenyAdministratorElevationResponse{
+		DenialDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) ListAdministratorElevationRequests(ctx context.Context, req *connect.Request[internal_permission.ListAdministratorElevationRequestsRequest]) (*connect.Response[internal_permission.ListAdministratorElevationRequestsResponse], error) {
+	_, resourceID, err := elevationResourceToCommand(ctx, req.Msg.GetResource())
+	if err != nil {
+		return nil, err
+	}
+	requests, err := s.query.ListElevationRequests(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&internal_permission.ListAdministratorElevationRequestsResponse{
+		ElevationRequests: elevationRequestsToPb(requests),
+	}), nil
+}
+
+func elevationResourceToCommand(ctx context.Context, resource *internal_permission.ElevationResource) (resourceType, resourceID string, err error) {
+	switch r := resource.GetResource().(type) {
+	case *internal_permission.ElevationResource_Instance:
+		return elevation.ResourceTypeInstance, authz.GetInstance(ctx).InstanceID(), nil
+	case *internal_permission.ElevationResource_OrganizationId:
+		return elevation.ResourceTypeOrganization, r.OrganizationId, nil
+	default:
+		return "", "", zerrors.ThrowInvalidArgument(nil, "ADMIN-El3pQ", "Errors.Invalid.Argument")
+	}
+}
+
+func elevationRequestsToPb(requests []*query.ElevationRequest) []*internal_permission.AdministratorElevationRequest {
+	pbRequests := make([]*internal_permission.AdministratorElevationRequest, len(requests))
+	for i, request := range requests {
+		pbRequest := &internal_permission.AdministratorElevationRequest{
+			Id:       request.ID,
+			UserId:   request.UserID,
+			Roles:    request.Roles,
+			Duration: durationpb.New(request.Duration),
+			Reason:   request.Reason,
+		}
+		if request.IsInstanceElevation() {
+			pbRequest.Resource = &internal_permission.AdministratorElevationRequest_Instance{Instance: true}
+		} else {
+			pbRequest.Resource = &internal_permission.AdministratorElevationRequest_OrganizationId{OrganizationId: request.ResourceOwner}
+		}
+		if request.ValidFrom != nil {
+			pbRequest.ValidFrom = timestamppb.New(*request.ValidFrom)
+		}
+		pbRequests[i] = pbRequest
+	}
+	return pbRequests
+}
@@ -0,0 +1,88 @@
+package internal_permission
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	"github.com/zitadel/zitadel/pkg/grpc/internal_permission/v2"
+)
+
+func (s *Server) CreateGroupAdministrator(ctx context.Context, req *connect.Request[internal_permission.CreateGroupAdministratorRequest]) (*connect.Response[internal_permission.CreateGroupAdministratorResponse], error) {
+	administrator, err := groupAdministratorToCommand(req.Msg.GetGroupId(), req.Msg.GetResource(), req.Msg.GetRoles())
+	if err != nil {
+		return nil, err
+	}
+	details, err := s.command.AddGroupAdministrator(ctx, administrator)
+	if err != nil {
+		return nil, err
+	}
+	var creationDate *timestamppb.Timestamp
+	if !details.EventDate.IsZero() {
+		creationDate = timestamppb.New(details.EventDate)
+	}
+	return connect.NewResponse(&internal_permission.CreateGroupAdministratorResponse{
+		CreationDate: creationDate,
+	}), nil
+}
+
+func (s *Server) UpdateGroupAdministrator(ctx context.Context, req *connect.Request[internal_permission.UpdateGroupAdministratorRequest]) (*connect.Response[internal_permission.UpdateGroupAdministratorResponse], error) {
+	administrator, err := groupAdministratorToCommand(req.Msg.GetGroupId(), req.Msg.GetResource(), req.Msg.GetRoles())
+	if err != nil {
+		return nil, err
+	}
+	details, err := s.command.ChangeGroupAdministrator(ctx, administrator)
+	if err != nil {
+		return nil, err
+	}
+	var changeDate *timestamppb.Timestamp
+	if !details.EventDate.IsZero() {
+		changeDate = timestamppb.New(details.EventDate)
+	}
+	return connect.NewResponse(&internal_permission.UpdateGroupAdministratorResponse{
+		ChangeDate: changeDate,
+	}), nil
+}
+
+func (s *Server) DeleteGroupAdministrator(ctx context.Context, req *connect.Request[internal_permission.DeleteGroupAdministratorRequest]) (*connect.Response[internal_permission.DeleteGroupAdministratorResponse], error) {
+	administrator, err := groupAdministratorToCommand(req.Msg.GetGroupId(), req.Msg.GetResource(), nil)
+	if err != nil {
+		return nil, err
+	}
+	details, err := s.command.RemoveGroupAdministrator(ctx, administrator)
+	if err != nil {
+		return nil, err
+	}
+	var deletionDate *timestamppb.Timestamp
+	if !details.EventDate.IsZero() {
+		deletionDate = timestamppb.New(details.EventDate)
+	}
+	return connect.NewResponse(&internal_permission.DeleteGroupAdministratorResponse{
+		DeletionDate: deletionDate,
+	}), nil
+}
+
+// groupAdministratorToCommand maps the resource of the membership of a group,
+// groups can only be granted administrator roles on the instance, organizations and projects.
+func groupAdministratorToCommand(groupID string, resourceType *internal_permission.ResourceType, roles []string) (*command.GroupAdministrator, error) {
+	administrator := &command.GroupAdministrator{
+		GroupID: groupID,
+		Roles:   roles,
+	}
+	switch resource := resourceType.GetResource().(type) {
+	case *internal_permission.ResourceType_Instance:
+		if !resource.Instance {
+			return nil, zerrors.ThrowInvalidArgument(nil, "ADMIN-Ga1rT", "Errors.Invalid.Argument")
+		}
+	case *internal_permission.ResourceType_OrganizationId:
+		administrator.OrganizationID = resource.OrganizationId
+	case *internal_permission.ResourceType_ProjectId:
+		administrator.ProjectID = resource.ProjectId
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "ADMIN-Ga2rT", "Errors.Member.GroupResourceInvalid")
+	}
+	return administrator, nil
+}
@@ -14,7 +14,7 @@ func checkExplicitProjectPermission(ctx context.Context) command.UserGrantPermis
 		return nil
 	}
 	ids := authz.GetAllPermissionCtxIDs(permissions)
-	return func(projectID, grantID string) command.PermissionCheck {
+	return func(projectID, grantID string, _ ...string) command.PermissionCheck {
 		return func(resourceOwner, aggregateID string) error {
 			if grantID != "" && listContainsID(ids, grantID) {
 				return nil
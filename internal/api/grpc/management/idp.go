@@ -439,6 +439,27 @@ func (s *Server) UpdateSAMLProvider(ctx context.Context, req *mgmt_pb.UpdateSAML
 	}, nil
 }
 
+func (s *Server) AddWSFederationProvider(ctx context.Context, req *mgmt_pb.AddWSFederationProviderRequest) (*mgmt_pb.AddWSFederationProviderResponse, error) {
+	id, details, err := s.command.AddOrgWSFederationProvider(ctx, authz.GetCtxData(ctx).OrgID, addWSFederationProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddWSFederationProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateWSFederationProvider(ctx context.Context, req *mgmt_pb.UpdateWSFederationProviderRequest) (*mgmt_pb.UpdateWSFederationProviderResponse, error) {
+	details, err := s.command.UpdateOrgWSFederationProvider(ctx, authz.GetCtxData(ctx).OrgID, req.Id, updateWSFederationProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateWSFederationProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) RegenerateSAMLProviderCertificate(ctx context.Context, req *mgmt_pb.RegenerateSAMLProviderCertificateRequest) (*mgmt_pb.RegenerateSAMLProviderCertificateResponse, error) {
 	details, err := s.command.RegenerateOrgSAMLProviderCertificate(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
 	if err != nil {
@@ -509,6 +509,28 @@ func updateSAMLProviderToCommand(req *mgmt_pb.UpdateSAMLProviderRequest) *comman
 	}
 }
 
+func addWSFederationProviderToCommand(req *mgmt_pb.AddWSFederationProviderRequest) command.WSFederationProvider {
+	return command.WSFederationProvider{
+		Name:        req.Name,
+		SSOEndpoint: req.SsoEndpoint,
+		Realm:       req.Realm,
+		Certificate: req.Certificate,
+		IDClaim:     req.IdClaim,
+		IDPOptions:  idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func updateWSFederationProviderToCommand(req *mgmt_pb.UpdateWSFederationProviderRequest) command.WSFederationProvider {
+	return command.WSFederationProvider{
+		Name:        req.Name,
+		SSOEndpoint: req.SsoEndpoint,
+		Realm:       req.Realm,
+		Certificate: req.Certificate,
+		IDClaim:     req.IdClaim,
+		IDPOptions:  idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
 func bindingToCommand(binding idp_pb.SAMLBinding) string {
 	switch binding {
 	case idp_pb.SAMLBinding_SAML_BINDING_UNSPECIFIED:
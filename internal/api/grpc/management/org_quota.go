@@ -0,0 +1,19 @@
+package management
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	quota_grpc "github.com/zitadel/zitadel/internal/api/grpc/quota"
+	mgmt_pb "github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+func (s *Server) GetMyOrgQuotaUsageReport(ctx context.Context, _ *mgmt_pb.GetMyOrgQuotaUsageReportRequest) (*mgmt_pb.GetMyOrgQuotaUsageReportResponse, error) {
+	usages, err := s.query.GetOrgQuotaUsageReport(ctx, authz.GetInstance(ctx).InstanceID(), authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.GetMyOrgQuotaUsageReportResponse{
+		Usages: quota_grpc.UsagesToPb(usages),
+	}, nil
+}
@@ -25,6 +25,8 @@ func SecondFactorTypeToDomain(secondFactorType policy_pb.SecondFactorType) domai
 		return domain.SecondFactorTypeOTPSMS
 	case policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_RECOVERY_CODES:
 		return domain.SecondFactorTypeRecoveryCodes
+	case policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_PUSH:
+		return domain.SecondFactorTypePush
 	default:
 		return domain.SecondFactorTypeUnspecified
 	}
@@ -50,6 +52,8 @@ func ModelSecondFactorTypeToPb(secondFactorType domain.SecondFactorType) policy_
 		return policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_OTP_SMS
 	case domain.SecondFactorTypeRecoveryCodes:
 		return policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_RECOVERY_CODES
+	case domain.SecondFactorTypePush:
+		return policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_PUSH
 	default:
 		return policy_pb.SecondFactorType_SECOND_FACTOR_TYPE_UNSPECIFIED
 	}
@@ -67,6 +71,8 @@ func MultiFactorTypeToDomain(multiFactorType policy_pb.MultiFactorType) domain.M
 	switch multiFactorType {
 	case policy_pb.MultiFactorType_MULTI_FACTOR_TYPE_U2F_WITH_VERIFICATION:
 		return domain.MultiFactorTypeU2FWithPIN
+	case policy_pb.MultiFactorType_MULTI_FACTOR_TYPE_X509_CERTIFICATE:
+		return domain.MultiFactorTypeX509Certificate
 	default:
 		return domain.MultiFactorTypeUnspecified
 	}
@@ -84,6 +90,8 @@ func ModelMultiFactorTypeToPb(typ domain.MultiFactorType) policy_pb.MultiFactorT
 	switch typ {
 	case domain.MultiFactorTypeU2FWithPIN:
 		return policy_pb.MultiFactorType_MULTI_FACTOR_TYPE_U2F_WITH_VERIFICATION
+	case domain.MultiFactorTypeX509Certificate:
+		return policy_pb.MultiFactorType_MULTI_FACTOR_TYPE_X509_CERTIFICATE
 	default:
 		return policy_pb.MultiFactorType_MULTI_FACTOR_TYPE_UNSPECIFIED
 	}
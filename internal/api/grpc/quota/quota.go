@@ -0,0 +1,109 @@
+package quota
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/query"
+	quota_repo "github.com/zitadel/zitadel/internal/repository/quota"
+	quota_pb "github.com/zitadel/zitadel/pkg/grpc/quota"
+)
+
+type SetQuotaRequest interface {
+	GetUnit() quota_pb.Unit
+	GetFrom() *timestamppb.Timestamp
+	GetResetInterval() *durationpb.Duration
+	GetAmount() uint64
+	GetLimit() bool
+	GetNotifications() []*quota_pb.Notification
+}
+
+func SetQuotaPbToCommand(req SetQuotaRequest) *command.SetQuota {
+	return &command.SetQuota{
+		Unit:          UnitPbToCommand(req.GetUnit()),
+		From:          req.GetFrom().AsTime(),
+		ResetInterval: req.GetResetInterval().AsDuration(),
+		Amount:        req.GetAmount(),
+		Limit:         req.GetLimit(),
+		Notifications: notificationsPbToCommand(req.GetNotifications()),
+	}
+}
+
+func UnitPbToCommand(unit quota_pb.Unit) command.QuotaUnit {
+	switch unit {
+	case quota_pb.Unit_UNIT_REQUESTS_ALL_AUTHENTICATED:
+		return command.QuotaRequestsAllAuthenticated
+	case quota_pb.Unit_UNIT_ACTIONS_ALL_RUN_SECONDS:
+		return command.QuotaActionsAllRunsSeconds
+	case quota_pb.Unit_UNIT_USERS_ALL_ACTIVE:
+		return command.QuotaUsersAllActive
+	case quota_pb.Unit_UNIT_TOKENS_ALL_ISSUED:
+		return command.QuotaTokensAllIssued
+	case quota_pb.Unit_UNIT_NOTIFICATIONS_ALL_SENT:
+		return command.QuotaNotificationsAllSent
+	case quota_pb.Unit_UNIT_USERS_ALL:
+		return command.QuotaUsersAll
+	case quota_pb.Unit_UNIT_PROJECTS_ALL:
+		return command.QuotaProjectsAll
+	case quota_pb.Unit_UNIT_APPLICATIONS_ALL:
+		return command.QuotaApplicationsAll
+	case quota_pb.Unit_UNIT_UNIMPLEMENTED:
+		fallthrough
+	default:
+		return command.QuotaUnit(unit.String())
+	}
+}
+
+func notificationsPbToCommand(req []*quota_pb.Notification) command.QuotaNotifications {
+	notifications := make([]*command.QuotaNotification, len(req))
+	for idx, item := range req {
+		notifications[idx] = &command.QuotaNotification{
+			Percent: uint16(item.Percent),
+			Repeat:  item.Repeat,
+			CallURL: item.CallUrl,
+		}
+	}
+	return notifications
+}
+
+func UsagesToPb(usages []*query.QuotaUsage) []*quota_pb.QuotaUsage {
+	pb := make([]*quota_pb.QuotaUsage, len(usages))
+	for i, usage := range usages {
+		pb[i] = &quota_pb.QuotaUsage{
+			Unit:               UnitToPb(usage.Unit),
+			From:               timestamppb.New(usage.From),
+			ResetInterval:      durationpb.New(usage.ResetInterval),
+			Amount:             usage.Amount,
+			Limit:              usage.Limit,
+			CurrentPeriodStart: timestamppb.New(usage.CurrentPeriodStart),
+			Usage:              usage.Usage,
+		}
+	}
+	return pb
+}
+
+func UnitToPb(unit quota_repo.Unit) quota_pb.Unit {
+	switch unit {
+	case quota_repo.RequestsAllAuthenticated:
+		return quota_pb.Unit_UNIT_REQUESTS_ALL_AUTHENTICATED
+	case quota_repo.ActionsAllRunsSeconds:
+		return quota_pb.Unit_UNIT_ACTIONS_ALL_RUN_SECONDS
+	case quota_repo.UsersAllActive:
+		return quota_pb.Unit_UNIT_USERS_ALL_ACTIVE
+	case quota_repo.TokensAllIssued:
+		return quota_pb.Unit_UNIT_TOKENS_ALL_ISSUED
+	case quota_repo.NotificationsAllSent:
+		return quota_pb.Unit_UNIT_NOTIFICATIONS_ALL_SENT
+	case quota_repo.UsersAll:
+		return quota_pb.Unit_UNIT_USERS_ALL
+	case quota_repo.ProjectsAll:
+		return quota_pb.Unit_UNIT_PROJECTS_ALL
+	case quota_repo.ApplicationsAll:
+		return quota_pb.Unit_UNIT_APPLICATIONS_ALL
+	case quota_repo.Unimplemented:
+		fallthrough
+	default:
+		return quota_pb.Unit_UNIT_UNIMPLEMENTED
+	}
+}
@@ -0,0 +1,48 @@
+package connect_middleware
+
+import (
+	"context"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/repository/quota"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/usage"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// OrgQuotaExhaustedInterceptor limits the authenticated requests by the quota of the calling user's organization
+// and records the requests for the organization's quota.
+func OrgQuotaExhaustedInterceptor(ignoreService ...string) connect.UnaryInterceptorFunc {
+	for idx, service := range ignoreService {
+		if !strings.HasPrefix(service, "/") {
+			ignoreService[idx] = "/" + service
+		}
+	}
+	return func(handler connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (_ connect.AnyResponse, err error) {
+			// system users don't belong to an organization
+			ctxData := authz.GetCtxData(ctx)
+			if ctxData.IsZero() || ctxData.SystemMemberships != nil || ctxData.ResourceOwner == "" {
+				return handler(ctx, req)
+			}
+			for _, service := range ignoreService {
+				if strings.HasPrefix(req.Spec().Procedure, service) {
+					return handler(ctx, req)
+				}
+			}
+			interceptorCtx, span := tracing.NewServerInterceptorSpan(ctx)
+			defer func() { span.EndWithError(err) }()
+
+			instanceID := authz.GetInstance(ctx).InstanceID()
+			if usage.OrgQuotaExhausted(interceptorCtx, instanceID, ctxData.ResourceOwner, quota.RequestsAllAuthenticated) {
+				return nil, zerrors.ThrowResourceExhausted(nil, "QUOTA-Tn6gK", "Errors.Quota.Org.Exhausted")
+			}
+			usage.RequestAuthenticated(interceptorCtx, instanceID, ctxData.ResourceOwner)
+			span.End()
+			return handler(ctx, req)
+		}
+	}
+}
@@ -79,6 +79,7 @@ func CreateServer(
 				middleware.AuthorizationInterceptor(verifier, systemAuthz, authConfig),
 				middleware.TranslationHandler(),
 				middleware.QuotaExhaustedInterceptor(accessSvc, system_pb.SystemService_ServiceDesc.ServiceName),
+				middleware.OrgQuotaExhaustedInterceptor(system_pb.SystemService_ServiceDesc.ServiceName),
 				middleware.ExecutionHandler(targetEncAlg),
 				middleware.ValidationHandler(),
 				middleware.ServiceHandler(),
@@ -179,6 +179,54 @@ func sessionToPb(s *query.Session) *session.Session {
 		Metadata:       s.Metadata,
 		UserAgent:      userAgentToPb(s.UserAgent),
 		ExpirationDate: expirationToPb(s.Expiration),
+		Risk:           riskToPb(s.Risk),
+	}
+}
+
+func riskToPb(risk domain.SessionRisk) *session.Risk {
+	if risk.Score == 0 && len(risk.Signals) == 0 {
+		return nil
+	}
+	signals := make([]session.RiskSignal, len(risk.Signals))
+	for i, signal := range risk.Signals {
+		signals[i] = riskSignalToPb(signal)
+	}
+	return &session.Risk{
+		Score:   risk.Score,
+		Signals: signals,
+		Action:  riskActionToPb(risk.Action),
+	}
+}
+
+func riskSignalToPb(signal domain.RiskSignal) session.RiskSignal {
+	switch signal {
+	case domain.RiskSignalFailedAttempts:
+		return session.RiskSignal_RISK_SIGNAL_FAILED_ATTEMPTS
+	case domain.RiskSignalCredentialStuffing:
+		return session.RiskSignal_RISK_SIGNAL_CREDENTIAL_STUFFING
+	case domain.RiskSignalImpossibleTravel:
+		return session.RiskSignal_RISK_SIGNAL_IMPOSSIBLE_TRAVEL
+	case domain.RiskSignalNewCountry:
+		return session.RiskSignal_RISK_SIGNAL_NEW_COUNTRY
+	case domain.RiskSignalNewUserAgent:
+		return session.RiskSignal_RISK_SIGNAL_NEW_USER_AGENT
+	default:
+		return session.RiskSignal_RISK_SIGNAL_UNSPECIFIED
+	}
+}
+
+func riskActionToPb(action domain.RiskAction) session.RiskAction {
+	switch action {
+	case domain.RiskActionNotify:
+		return session.RiskAction_RISK_ACTION_NOTIFY
+	case domain.RiskActionRequireMFA:
+		return session.RiskAction_RISK_ACTION_REQUIRE_MFA
+	case domain.RiskActionBlock:
+		return session.RiskAction_RISK_ACTION_BLOCK
+	case domain.RiskActionNone:
+		fallthrough
+	default:
+		return session.RiskAction_RISK_ACTION_NONE
 	}
 }
 
@@ -227,6 +275,8 @@ func factorsToPb(s *query.Session) *session.Factors {
 		OtpSms:       otpFactorToPb(s.OTPSMSFactor),
 		OtpEmail:     otpFactorToPb(s.OTPEmailFactor),
 		RecoveryCode: recoveryCodeFactorToPb(s.RecoveryCodeFactor),
+		Push:         pushFactorToPb(s.PushFactor),
+		X509:         x509FactorToPb(s.X509Factor),
 	}
 }
 
@@ -285,6 +335,24 @@ func recoveryCodeFactorToPb(factor query.SessionRecoveryCodeFactor) *session.Rec
 	}
 }
 
+func pushFactorToPb(factor query.SessionPushFactor) *session.PushFactor {
+	if factor.PushCheckedAt.IsZero() {
+		return nil
+	}
+	return &session.PushFactor{
+		VerifiedAt: timestamppb.New(factor.PushCheckedAt),
+	}
+}
+
+func x509FactorToPb(factor query.SessionX509Factor) *session.X509Factor {
+	if factor.X509CheckedAt.IsZero() {
+		return nil
+	}
+	return &session.X509Factor{
+		VerifiedAt: timestamppb.New(factor.X509CheckedAt),
+	}
+}
+
 func userFactorToPb(factor query.SessionUserFactor) *session.UserFactor {
 	if factor.UserID == "" || factor.UserCheckedAt.IsZero() {
 		return nil
@@ -72,6 +72,22 @@ func (s *Server) DeleteSession(ctx context.Context, req *connect.Request[session
 	}), nil
 }
 
+func (s *Server) RespondToPushChallenge(ctx context.Context, req *connect.Request[session.RespondToPushChallengeRequest]) (*connect.Response[session.RespondToPushChallengeResponse], error) {
+	details, err := s.command.RespondToPushChallenge(ctx, &command.PushChallengeResponse{
+		SessionID: req.Msg.GetSessionId(),
+		DeviceID:  req.Msg.GetDeviceId(),
+		Code:      req.Msg.GetCode(),
+		Approved:  req.Msg.GetApproved(),
+		Signature: req.Msg.GetSignature(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&session.RespondToPushChallengeResponse{
+		Details: object.DomainToDetailsPb(details),
+	}), nil
+}
+
 func (s *Server) createSessionRequestToCommand(ctx context.Context, req *session.CreateSessionRequest) ([]command.SessionCommand, map[string][]byte, *domain.UserAgent, time.Duration, error) {
 	checks, err := s.checksToCommand(ctx, req.Checks)
 	if err != nil {
@@ -111,6 +127,10 @@ func (s *Server) checksToCommand(ctx context.Context, checks *session.Checks) ([
 	if err != nil {
 		return nil, err
 	}
+	// the user can be identified by the client certificate itself
+	if x509Check := checks.GetX509(); checkUser == nil && x509Check != nil {
+		checkUser = userByX509Certificate(x509Check.GetCertificate())
+	}
 	sessionChecks := make([]command.SessionCommand, 0, 7)
 	if checkUser != nil {
 		user, err := checkUser.search(ctx, s.query)
@@ -148,6 +168,12 @@ func (s *Server) checksToCommand(ctx context.Context, checks *session.Checks) ([
 	if recoveryCode := checks.GetRecoveryCode(); recoveryCode != nil {
 		sessionChecks = append(sessionChecks, command.CheckRecoveryCode(recoveryCode.GetCode()))
 	}
+	if checks.GetPush() != nil {
+		sessionChecks = append(sessionChecks, command.CheckPush())
+	}
+	if x509Check := checks.GetX509(); x509Check != nil {
+		sessionChecks = append(sessionChecks, s.command.CheckX509Certificate(x509Check.GetCertificate(), x509Check.GetIntermediates(), x509Check.GetSignature()))
+	}
 	return sessionChecks, nil
 }
 
@@ -174,6 +200,16 @@ func (s *Server) challengesToCommand(challenges *session.RequestChallenges, cmds
 		resp.OtpEmail = challenge
 		cmds = append(cmds, cmd)
 	}
+	if req := challenges.GetPush(); req != nil {
+		challenge, cmd := s.createPushChallengeCommand(req)
+		resp.Push = challenge
+		cmds = append(cmds, cmd)
+	}
+	if req := challenges.GetX509(); req != nil {
+		challenge, cmd := s.createX509ChallengeCommand()
+		resp.X509 = challenge
+		cmds = append(cmds, cmd)
+	}
 	return resp, cmds, nil
 }
 
@@ -228,6 +264,16 @@ func (s *Server) createOTPEmailChallengeCommand(req *session.RequestChallenges_O
 	}
 }
 
+func (s *Server) createPushChallengeCommand(req *session.RequestChallenges_Push) (*session.Challenges_Push, command.SessionCommand) {
+	challenge := new(session.Challenges_Push)
+	return challenge, s.command.CreatePushChallenge(req.GetAppName(), req.GetLocation(), &challenge.Code)
+}
+
+func (s *Server) createX509ChallengeCommand() (*session.Challenges_X509, command.SessionCommand) {
+	challenge := new(session.Challenges_X509)
+	return challenge, s.command.CreateX509Challenge(&challenge.Challenge)
+}
+
 func userCheck(user *session.CheckUser) (userSearch, error) {
 	if user == nil {
 		return nil, nil
@@ -262,6 +308,22 @@ func (u userSearchByID) search(ctx context.Context, q *query.Queries) (*query.Us
 	return q.GetUserByID(ctx, false, u.id)
 }
 
+func userByX509Certificate(certificate []byte) userSearch {
+	return userSearchByX509Certificate{certificate}
+}
+
+type userSearchByX509Certificate struct {
+	certificate []byte
+}
+
+func (u userSearchByX509Certificate) search(ctx context.Context, q *query.Queries) (*query.User, error) {
+	userID, _, err := q.UserByX509Certificate(ctx, "", u.certificate)
+	if err != nil {
+		return nil, err
+	}
+	return q.GetUserByID(ctx, false, userID)
+}
+
 type userSearchByLoginName struct {
 	loginName string
 }
@@ -203,6 +203,59 @@ func (s *Server) GetSecuritySettings(ctx context.Context, req *connect.Request[s
 	}), nil
 }
 
+func (s *Server) GetWebAuthNSettings(ctx context.Context, _ *connect.Request[settings.GetWebAuthNSettingsRequest]) (*connect.Response[settings.GetWebAuthNSettingsResponse], error) {
+	policy, err := s.query.WebAuthNPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&settings.GetWebAuthNSettingsResponse{
+		Details:  object.DomainToDetailsPb(policy.Details),
+		Settings: webAuthNPolicyToSettingsPb(policy),
+	}), nil
+}
+
+func (s *Server) GetX509Settings(ctx context.Context, req *connect.Request[settings.GetX509SettingsRequest]) (*connect.Response[settings.GetX509SettingsResponse], error) {
+	orgID := object.ResourceOwnerFromReq(ctx, req.Msg.GetCtx())
+	if req.Msg.GetCtx().GetInstance() {
+		orgID = ""
+	}
+	policy, err := s.query.X509Policy(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&settings.GetX509SettingsResponse{
+		Settings:  x509PolicyToSettingsPb(policy),
+		IsDefault: policy.IsDefault,
+		Details: &object_pb.Details{
+			Sequence:      policy.Sequence,
+			CreationDate:  timestamppb.New(policy.CreationDate),
+			ChangeDate:    timestamppb.New(policy.ChangeDate),
+			ResourceOwner: policy.ResourceOwner,
+		},
+	}), nil
+}
+
+func (s *Server) GetDeviceTrustSettings(ctx context.Context, req *connect.Request[settings.GetDeviceTrustSettingsRequest]) (*connect.Response[settings.GetDeviceTrustSettingsResponse], error) {
+	orgID := object.ResourceOwnerFromReq(ctx, req.Msg.GetCtx())
+	if req.Msg.GetCtx().GetInstance() {
+		orgID = ""
+	}
+	policy, err := s.query.DeviceTrustPolicy(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&settings.GetDeviceTrustSettingsResponse{
+		Settings:  deviceTrustPolicyToSettingsPb(policy),
+		IsDefault: policy.IsDefault,
+		Details: &object_pb.Details{
+			Sequence:      policy.Sequence,
+			CreationDate:  timestamppb.New(policy.CreationDate),
+			ChangeDate:    timestamppb.New(policy.ChangeDate),
+			ResourceOwner: policy.ResourceOwner,
+		},
+	}), nil
+}
+
 func (s *Server) GetHostedLoginTranslation(ctx context.Context, req *connect.Request[settings.GetHostedLoginTranslationRequest]) (*connect.Response[settings.GetHostedLoginTranslationResponse], error) {
 	translation, err := s.query.GetHostedLoginTranslation(ctx, req.Msg)
 	if err != nil {
@@ -6,7 +6,9 @@ import (
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/pkg/grpc/settings/v2"
 )
 
@@ -20,6 +22,96 @@ func (s *Server) SetSecuritySettings(ctx context.Context, req *connect.Request[s
 	}), nil
 }
 
+func (s *Server) SetWebAuthNSettings(ctx context.Context, req *connect.Request[settings.SetWebAuthNSettingsRequest]) (*connect.Response[settings.SetWebAuthNSettingsResponse], error) {
+	details, err := s.command.SetWebAuthNPolicy(ctx, webAuthNSettingsToCommand(req.Msg.GetSettings()))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&settings.SetWebAuthNSettingsResponse{
+		Details: object.DomainToDetailsPb(details),
+	}), nil
+}
+
+func (s *Server) SetX509Settings(ctx context.Context, req *connect.Request[settings.SetX509SettingsRequest]) (*connect.Response[settings.SetX509SettingsResponse], error) {
+	policy := x509SettingsToDomain(req.Msg.GetSettings())
+	var (
+		details *domain.ObjectDetails
+		err     error
+	)
+	if orgID := req.Msg.GetOrganizationId(); orgID != "" {
+		if err = s.checkPermission(ctx, domain.PermissionPolicyWrite, orgID, orgID); err != nil {
+			return nil, err
+		}
+		details, err = s.command.SetOrgX509Policy(ctx, orgID, policy)
+	} else {
+		instanceID := authz.GetInstance(ctx).InstanceID()
+		if err = s.checkPermission(ctx, domain.PermissionIAMPolicyWrite, instanceID, instanceID); err != nil {
+			return nil, err
+		}
+		details, err = s.command.SetDefaultX509Policy(ctx, policy)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&settings.SetX509SettingsResponse{
+		Details: object.DomainToDetailsPb(details),
+	}), nil
+}
+
+func (s *Server) DeleteOrganizationX509Settings(ctx context.Context, req *connect.Request[settings.DeleteOrganizationX509SettingsRequest]) (*connect.Response[settings.DeleteOrganizationX509SettingsResponse], error) {
+	orgID := req.Msg.GetOrganizationId()
+	if err := s.checkPermission(ctx, domain.PermissionPolicyDelete, orgID, orgID); err != nil {
+		return nil, err
+	}
+	details, err := s.command.RemoveOrgX509Policy(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&settings.DeleteOrganizationX509SettingsResponse{
+		Details: object.DomainToDetailsPb(details),
+	}), nil
+}
+
+func (s *Server) SetDeviceTrustSettings(ctx context.Context, req *connect.Request[settings.SetDeviceTrustSettingsRequest]) (*connect.Response[settings.SetDeviceTrustSettingsResponse], error) {
+	policy := deviceTrustSettingsToDomain(req.Msg.GetSettings())
+	var (
+		details *domain.ObjectDetails
+		err     error
+	)
+	if orgID := req.Msg.GetOrganizationId(); orgID != "" {
+		if err = s.checkPermission(ctx, domain.PermissionPolicyWrite, orgID, orgID); err != nil {
+			return nil, err
+		}
+		details, err = s.command.SetOrgDeviceTrustPolicy(ctx, orgID, policy)
+	} else {
+		instanceID := authz.GetInstance(ctx).InstanceID()
+		if err = s.checkPermission(ctx, domain.PermissionIAMPolicyWrite, instanceID, instanceID); err != nil {
+			return nil, err
+		}
+		details, err = s.command.SetDefaultDeviceTrustPolicy(ctx, policy)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&settings.SetDeviceTrustSettingsResponse{
+		Details: object.DomainToDetailsPb(details),
+	}), nil
+}
+
+func (s *Server) DeleteOrganizationDeviceTrustSettings(ctx context.Context, req *connect.Request[settings.DeleteOrganizationDeviceTrustSettingsRequest]) (*connect.Response[settings.DeleteOrganizationDeviceTrustSettingsResponse], error) {
+	orgID := req.Msg.GetOrganizationId()
+	if err := s.checkPermission(ctx, domain.PermissionPolicyDelete, orgID, orgID); err != nil {
+		return nil, err
+	}
+	details, err := s.command.RemoveOrgDeviceTrustPolicy(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&settings.DeleteOrganizationDeviceTrustSettingsResponse{
+		Details: object.DomainToDetailsPb(details),
+	}), nil
+}
+
 func (s *Server) SetHostedLoginTranslation(ctx context.Context, req *connect.Request[settings.SetHostedLoginTranslationRequest]) (*connect.Response[settings.SetHostedLoginTranslationResponse], error) {
 	res, err := s.command.SetHostedLoginTranslation(ctx, req.Msg)
 	if err != nil {
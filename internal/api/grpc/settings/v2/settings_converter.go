@@ -78,6 +78,8 @@ func secondFactorTypeToPb(secondFactorType domain.SecondFactorType) settings.Sec
 		return settings.SecondFactorType_SECOND_FACTOR_TYPE_OTP_SMS
 	case domain.SecondFactorTypeRecoveryCodes:
 		return settings.SecondFactorType_SECOND_FACTOR_TYPE_RECOVERY_CODES
+	case domain.SecondFactorTypePush:
+		return settings.SecondFactorType_SECOND_FACTOR_TYPE_PUSH
 	case domain.SecondFactorTypeUnspecified:
 		return settings.SecondFactorType_SECOND_FACTOR_TYPE_UNSPECIFIED
 	default:
@@ -89,6 +91,8 @@ func multiFactorTypeToPb(typ domain.MultiFactorType) settings.MultiFactorType {
 	switch typ {
 	case domain.MultiFactorTypeU2FWithPIN:
 		return settings.MultiFactorType_MULTI_FACTOR_TYPE_U2F_WITH_VERIFICATION
+	case domain.MultiFactorTypeX509Certificate:
+		return settings.MultiFactorType_MULTI_FACTOR_TYPE_X509_CERTIFICATE
 	case domain.MultiFactorTypeUnspecified:
 		return settings.MultiFactorType_MULTI_FACTOR_TYPE_UNSPECIFIED
 	default:
@@ -232,6 +236,8 @@ func idpTypeToPb(idpType domain.IDPType) settings.IdentityProviderType {
 		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_APPLE
 	case domain.IDPTypeSAML:
 		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_SAML
+	case domain.IDPTypeWSFederation:
+		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_WS_FEDERATION
 	default:
 		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_UNSPECIFIED
 	}
@@ -255,6 +261,133 @@ func securitySettingsToCommand(req *settings.SetSecuritySettingsRequest) *comman
 	}
 }
 
+func webAuthNPolicyToSettingsPb(policy *query.WebAuthNPolicy) *settings.WebAuthNSettings {
+	return &settings.WebAuthNSettings{
+		RequireAttestation: policy.RequireAttestation,
+		VerifyMetadata:     policy.VerifyMetadata,
+		AllowedAaguids:     policy.AllowedAAGUIDs,
+		DeniedAaguids:      policy.DeniedAAGUIDs,
+	}
+}
+
+func webAuthNSettingsToCommand(req *settings.WebAuthNSettings) *command.WebAuthNPolicy {
+	return &command.WebAuthNPolicy{
+		RequireAttestation: req.GetRequireAttestation(),
+		VerifyMetadata:     req.GetVerifyMetadata(),
+		AllowedAAGUIDs:     req.GetAllowedAaguids(),
+		DeniedAAGUIDs:      req.GetDeniedAaguids(),
+	}
+}
+
+func x509PolicyToSettingsPb(policy *domain.X509Policy) *settings.X509Settings {
+	rules := make([]*settings.X509MappingRule, len(policy.MappingRules))
+	for i, rule := range policy.MappingRules {
+		rules[i] = &settings.X509MappingRule{
+			Source:  x509MappingSourceToPb(rule.Source),
+			Target:  x509MappingTargetToPb(rule.Target),
+			Pattern: rule.Pattern,
+		}
+	}
+	return &settings.X509Settings{
+		TrustedCas:       policy.TrustedCAs,
+		MappingRules:     rules,
+		OcspResponderUrl: policy.OCSPResponderURL,
+		CrlUrls:          policy.CRLURLs,
+	}
+}
+
+func x509SettingsToDomain(req *settings.X509Settings) *domain.X509Policy {
+	var rules []domain.X509MappingRule
+	if len(req.GetMappingRules()) > 0 {
+		rules = make([]domain.X509MappingRule, len(req.GetMappingRules()))
+		for i, rule := range req.GetMappingRules() {
+			rules[i] = domain.X509MappingRule{
+				Source:  x509MappingSourceToDomain(rule.GetSource()),
+				Target:  x509MappingTargetToDomain(rule.GetTarget()),
+				Pattern: rule.GetPattern(),
+			}
+		}
+	}
+	return &domain.X509Policy{
+		TrustedCAs:       req.GetTrustedCas(),
+		MappingRules:     rules,
+		OCSPResponderURL: req.GetOcspResponderUrl(),
+		CRLURLs:          req.GetCrlUrls(),
+	}
+}
+
+func deviceTrustPolicyToSettingsPb(policy *domain.DeviceTrustPolicy) *settings.DeviceTrustSettings {
+	return &settings.DeviceTrustSettings{
+		Lifetime: durationpb.New(policy.Lifetime),
+	}
+}
+
+func deviceTrustSettingsToDomain(req *settings.DeviceTrustSettings) *domain.DeviceTrustPolicy {
+	return &domain.DeviceTrustPolicy{
+		Lifetime: req.GetLifetime().AsDuration(),
+	}
+}
+
+func x509MappingSourceToPb(source domain.X509MappingSource) settings.X509MappingSource {
+	switch source {
+	case domain.X509MappingSourceSubjectCommonName:
+		return settings.X509MappingSource_X509_MAPPING_SOURCE_SUBJECT_COMMON_NAME
+	case domain.X509MappingSourceSubjectEmail:
+		return settings.X509MappingSource_X509_MAPPING_SOURCE_SUBJECT_EMAIL
+	case domain.X509MappingSourceSANEmail:
+		return settings.X509MappingSource_X509_MAPPING_SOURCE_SAN_EMAIL
+	case domain.X509MappingSourceSANUPN:
+		return settings.X509MappingSource_X509_MAPPING_SOURCE_SAN_USER_PRINCIPAL_NAME
+	case domain.X509MappingSourceUnspecified:
+		fallthrough
+	default:
+		return settings.X509MappingSource_X509_MAPPING_SOURCE_UNSPECIFIED
+	}
+}
+
+func x509MappingSourceToDomain(source settings.X509MappingSource) domain.X509MappingSource {
+	switch source {
+	case settings.X509MappingSource_X509_MAPPING_SOURCE_SUBJECT_COMMON_NAME:
+		return domain.X509MappingSourceSubjectCommonName
+	case settings.X509MappingSource_X509_MAPPING_SOURCE_SUBJECT_EMAIL:
+		return domain.X509MappingSourceSubjectEmail
+	case settings.X509MappingSource_X509_MAPPING_SOURCE_SAN_EMAIL:
+		return domain.X509MappingSourceSANEmail
+	case settings.X509MappingSource_X509_MAPPING_SOURCE_SAN_USER_PRINCIPAL_NAME:
+		return domain.X509MappingSourceSANUPN
+	case settings.X509MappingSource_X509_MAPPING_SOURCE_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.X509MappingSourceUnspecified
+	}
+}
+
+func x509MappingTargetToPb(target domain.X509MappingTarget) settings.X509MappingTarget {
+	switch target {
+	case domain.X509MappingTargetLoginName:
+		return settings.X509MappingTarget_X509_MAPPING_TARGET_LOGIN_NAME
+	case domain.X509MappingTargetEmail:
+		return settings.X509MappingTarget_X509_MAPPING_TARGET_VERIFIED_EMAIL
+	case domain.X509MappingTargetUnspecified:
+		fallthrough
+	default:
+		return settings.X509MappingTarget_X509_MAPPING_TARGET_UNSPECIFIED
+	}
+}
+
+func x509MappingTargetToDomain(target settings.X509MappingTarget) domain.X509MappingTarget {
+	switch target {
+	case settings.X509MappingTarget_X509_MAPPING_TARGET_LOGIN_NAME:
+		return domain.X509MappingTargetLoginName
+	case settings.X509MappingTarget_X509_MAPPING_TARGET_VERIFIED_EMAIL:
+		return domain.X509MappingTargetEmail
+	case settings.X509MappingTarget_X509_MAPPING_TARGET_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.X509MappingTargetUnspecified
+	}
+}
+
 func organizationSettingsToCommand(req *settings.SetOrganizationSettingsRequest) *command.SetOrganizationSettings {
 	return &command.SetOrganizationSettings{
 		OrganizationID:              req.OrganizationId,
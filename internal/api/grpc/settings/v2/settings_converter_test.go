@@ -188,6 +188,10 @@ func Test_secondFactorTypeToPb(t *testing.T) {
 			args: args{domain.SecondFactorTypeRecoveryCodes},
 			want: settings.SecondFactorType_SECOND_FACTOR_TYPE_RECOVERY_CODES,
 		},
+		{
+			args: args{domain.SecondFactorTypePush},
+			want: settings.SecondFactorType_SECOND_FACTOR_TYPE_PUSH,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want.String(), func(t *testing.T) {
@@ -209,6 +213,10 @@ func Test_multiFactorTypeToPb(t *testing.T) {
 			args: args{domain.MultiFactorTypeU2FWithPIN},
 			want: settings.MultiFactorType_MULTI_FACTOR_TYPE_U2F_WITH_VERIFICATION,
 		},
+		{
+			args: args{domain.MultiFactorTypeX509Certificate},
+			want: settings.MultiFactorType_MULTI_FACTOR_TYPE_X509_CERTIFICATE,
+		},
 		{
 			args: args{domain.MultiFactorTypeUnspecified},
 			want: settings.MultiFactorType_MULTI_FACTOR_TYPE_UNSPECIFIED,
@@ -511,6 +519,10 @@ func Test_idpTypeToPb(t *testing.T) {
 			args: args{domain.IDPTypeSAML},
 			want: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_SAML,
 		},
+		{
+			args: args{domain.IDPTypeWSFederation},
+			want: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_WS_FEDERATION,
+		},
 		{
 			args: args{99},
 			want: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_UNSPECIFIED,
@@ -556,3 +568,119 @@ func Test_securitySettingsToCommand(t *testing.T) {
 	})
 	assert.Equal(t, want, got)
 }
+
+func Test_webAuthNPolicyToSettingsPb(t *testing.T) {
+	want := &settings.WebAuthNSettings{
+		RequireAttestation: true,
+		VerifyMetadata:     true,
+		AllowedAaguids:     []string{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+		DeniedAaguids:      []string{"ee882879-721c-4913-9775-3dfcce97072a"},
+	}
+	got := webAuthNPolicyToSettingsPb(&query.WebAuthNPolicy{
+		RequireAttestation: true,
+		VerifyMetadata:     true,
+		AllowedAAGUIDs:     []string{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+		DeniedAAGUIDs:      []string{"ee882879-721c-4913-9775-3dfcce97072a"},
+	})
+	assert.Equal(t, want, got)
+}
+
+func Test_webAuthNSettingsToCommand(t *testing.T) {
+	want := &command.WebAuthNPolicy{
+		RequireAttestation: true,
+		AllowedAAGUIDs:     []string{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+	}
+	got := webAuthNSettingsToCommand(&settings.WebAuthNSettings{
+		RequireAttestation: true,
+		AllowedAaguids:     []string{"cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+	})
+	assert.Equal(t, want, got)
+}
+
+func Test_x509PolicyToSettingsPb(t *testing.T) {
+	want := &settings.X509Settings{
+		TrustedCas: []byte("ca"),
+		MappingRules: []*settings.X509MappingRule{
+			{
+				Source:  settings.X509MappingSource_X509_MAPPING_SOURCE_SAN_USER_PRINCIPAL_NAME,
+				Target:  settings.X509MappingTarget_X509_MAPPING_TARGET_LOGIN_NAME,
+				Pattern: "^(.+)@example\\.com$",
+			},
+		},
+		OcspResponderUrl: "http://ocsp.example.com",
+		CrlUrls:          []string{"http://crl.example.com/ca.crl"},
+	}
+	got := x509PolicyToSettingsPb(&domain.X509Policy{
+		TrustedCAs: []byte("ca"),
+		MappingRules: []domain.X509MappingRule{
+			{
+				Source:  domain.X509MappingSourceSANUPN,
+				Target:  domain.X509MappingTargetLoginName,
+				Pattern: "^(.+)@example\\.com$",
+			},
+		},
+		OCSPResponderURL: "http://ocsp.example.com",
+		CRLURLs:          []string{"http://crl.example.com/ca.crl"},
+	})
+	assert.Equal(t, want, got)
+}
+
+func Test_x509SettingsToDomain(t *testing.T) {
+	want := &domain.X509Policy{
+		TrustedCAs: []byte("ca"),
+		MappingRules: []domain.X509MappingRule{
+			{
+				Source: domain.X509MappingSourceSubjectEmail,
+				Target: domain.X509MappingTargetEmail,
+			},
+		},
+	}
+	got := x509SettingsToDomain(&settings.X509Settings{
+		TrustedCas: []byte("ca"),
+		MappingRules: []*settings.X509MappingRule{
+			{
+				Source: settings.X509MappingSource_X509_MAPPING_SOURCE_SUBJECT_EMAIL,
+				Target: settings.X509MappingTarget_X509_MAPPING_TARGET_VERIFIED_EMAIL,
+			},
+		},
+	})
+	assert.Equal(t, want, got)
+}
+
+func Test_deviceTrustPolicyToSettingsPb(t *testing.T) {
+	want := &settings.DeviceTrustSettings{
+		Lifetime: durationpb.New(30 * 24 * time.Hour),
+	}
+	got := deviceTrustPolicyToSettingsPb(&domain.DeviceTrustPolicy{
+		Lifetime: 30 * 24 * time.Hour,
+	})
+	assert.Equal(t, want, got)
+}
+
+func Test_deviceTrustSettingsToDomain(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *settings.DeviceTrustSettings
+		want *domain.DeviceTrustPolicy
+	}{
+		{
+			name: "lifetime",
+			req: &settings.DeviceTrustSettings{
+				Lifetime: durationpb.New(7 * 24 * time.Hour),
+			},
+			want: &domain.DeviceTrustPolicy{
+				Lifetime: 7 * 24 * time.Hour,
+			},
+		},
+		{
+			name: "no lifetime, disabled",
+			req:  &settings.DeviceTrustSettings{},
+			want: &domain.DeviceTrustPolicy{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, deviceTrustSettingsToDomain(tt.req))
+		})
+	}
+}
@@ -218,7 +218,7 @@ func idpTypeToPb(idpType domain.IDPType) settings.IdentityProviderType {
 		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_GOOGLE
 	case domain.IDPTypeSAML:
 		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_SAML
-	case domain.IDPTypeApple:
+	case domain.IDPTypeApple, domain.IDPTypeWSFederation:
 		// Handle all remaining cases so the linter succeeds
 		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_UNSPECIFIED
 	default:
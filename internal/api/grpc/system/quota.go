@@ -4,13 +4,14 @@ import (
 	"context"
 
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
+	quota_grpc "github.com/zitadel/zitadel/internal/api/grpc/quota"
 	"github.com/zitadel/zitadel/pkg/grpc/system"
 )
 
 func (s *Server) AddQuota(ctx context.Context, req *system.AddQuotaRequest) (*system.AddQuotaResponse, error) {
 	details, err := s.command.AddQuota(
 		ctx,
-		instanceQuotaPbToCommand(req),
+		quota_grpc.SetQuotaPbToCommand(req),
 	)
 	if err != nil {
 		return nil, err
@@ -23,7 +24,7 @@ func (s *Server) AddQuota(ctx context.Context, req *system.AddQuotaRequest) (*sy
 func (s *Server) SetQuota(ctx context.Context, req *system.SetQuotaRequest) (*system.SetQuotaResponse, error) {
 	details, err := s.command.SetQuota(
 		ctx,
-		instanceQuotaPbToCommand(req),
+		quota_grpc.SetQuotaPbToCommand(req),
 	)
 	if err != nil {
 		return nil, err
@@ -34,7 +35,7 @@ func (s *Server) SetQuota(ctx context.Context, req *system.SetQuotaRequest) (*sy
 }
 
 func (s *Server) RemoveQuota(ctx context.Context, req *system.RemoveQuotaRequest) (*system.RemoveQuotaResponse, error) {
-	details, err := s.command.RemoveQuota(ctx, instanceQuotaUnitPbToCommand(req.Unit))
+	details, err := s.command.RemoveQuota(ctx, quota_grpc.UnitPbToCommand(req.Unit))
 	if err != nil {
 		return nil, err
 	}
@@ -42,3 +43,13 @@ func (s *Server) RemoveQuota(ctx context.Context, req *system.RemoveQuotaRequest
 		Details: object.ChangeToDetailsPb(details.Sequence, details.EventDate, details.ResourceOwner),
 	}, nil
 }
+
+func (s *Server) GetQuotaUsageReport(ctx context.Context, req *system.GetQuotaUsageReportRequest) (*system.GetQuotaUsageReportResponse, error) {
+	usages, err := s.query.GetQuotaUsageReport(ctx, req.GetInstanceId())
+	if err != nil {
+		return nil, err
+	}
+	return &system.GetQuotaUsageReportResponse{
+		Usages: quota_grpc.UsagesToPb(usages),
+	}, nil
+}
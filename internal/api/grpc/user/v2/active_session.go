@@ -0,0 +1,77 @@
+package user
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func (s *Server) ListActiveSessions(ctx context.Context, req *connect.Request[user.ListActiveSessionsRequest]) (*connect.Response[user.ListActiveSessionsResponse], error) {
+	sessions, err := s.query.UserActiveSessions(ctx, req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.ListActiveSessionsResponse{
+		Result: activeSessionsToPb(sessions),
+	}), nil
+}
+
+func (s *Server) SignOutEverywhere(ctx context.Context, req *connect.Request[user.SignOutEverywhereRequest]) (*connect.Response[user.SignOutEverywhereResponse], error) {
+	details, err := s.command.HumanSignOutEverywhere(ctx, req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.SignOutEverywhereResponse{
+		Details: object.DomainToDetailsPb(details),
+	}), nil
+}
+
+func activeSessionsToPb(sessions []*query.ActiveSession) []*user.ActiveSession {
+	result := make([]*user.ActiveSession, len(sessions))
+	for i, session := range sessions {
+		result[i] = &user.ActiveSession{
+			Id:            session.ID,
+			Type:          activeSessionTypeToPb(session.Type),
+			FingerprintId: session.UserAgent.FingerprintID,
+			Description:   session.UserAgent.Description,
+			CreationDate:  timestamppb.New(session.CreationDate),
+			LastUsed:      timestamppb.New(session.LastUsed),
+		}
+		if session.ClientID != "" {
+			result[i].ClientId = &session.ClientID
+		}
+		if session.SessionID != "" {
+			result[i].SessionId = &session.SessionID
+		}
+		if len(session.UserAgent.IP) > 0 {
+			ip := session.UserAgent.IP.String()
+			result[i].Ip = &ip
+		}
+		if !session.ExpirationDate.IsZero() {
+			result[i].ExpirationDate = timestamppb.New(session.ExpirationDate)
+		}
+	}
+	return result
+}
+
+func activeSessionTypeToPb(sessionType query.ActiveSessionType) user.ActiveSessionType {
+	switch sessionType {
+	case query.ActiveSessionTypeSession:
+		return user.ActiveSessionType_ACTIVE_SESSION_TYPE_SESSION
+	case query.ActiveSessionTypeLogin:
+		return user.ActiveSessionType_ACTIVE_SESSION_TYPE_LOGIN
+	case query.ActiveSessionTypeOIDCSession:
+		return user.ActiveSessionType_ACTIVE_SESSION_TYPE_OIDC_SESSION
+	case query.ActiveSessionTypeRefreshToken:
+		return user.ActiveSessionType_ACTIVE_SESSION_TYPE_REFRESH_TOKEN
+	case query.ActiveSessionTypeUnspecified:
+		fallthrough
+	default:
+		return user.ActiveSessionType_ACTIVE_SESSION_TYPE_UNSPECIFIED
+	}
+}
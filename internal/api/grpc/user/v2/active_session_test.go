@@ -0,0 +1,68 @@
+package user
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func Test_activeSessionsToPb(t *testing.T) {
+	created := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	lastUsed := created.Add(time.Hour)
+	expiration := created.Add(24 * time.Hour)
+	fingerprintID := "agent1"
+	description := "Firefox"
+	got := activeSessionsToPb([]*query.ActiveSession{
+		{
+			ID:   "agent1",
+			Type: query.ActiveSessionTypeLogin,
+			UserAgent: domain.UserAgent{
+				FingerprintID: &fingerprintID,
+				IP:            net.IPv4(192, 168, 1, 1),
+				Description:   &description,
+			},
+			CreationDate:   created,
+			LastUsed:       lastUsed,
+			ExpirationDate: expiration,
+		},
+		{
+			ID:           "oidcSession1",
+			Type:         query.ActiveSessionTypeOIDCSession,
+			ClientID:     "client1",
+			SessionID:    "session1",
+			CreationDate: created,
+			LastUsed:     lastUsed,
+		},
+	})
+	clientID := "client1"
+	sessionID := "session1"
+	ip := "192.168.1.1"
+	want := []*user.ActiveSession{
+		{
+			Id:             "agent1",
+			Type:           user.ActiveSessionType_ACTIVE_SESSION_TYPE_LOGIN,
+			FingerprintId:  &fingerprintID,
+			Ip:             &ip,
+			Description:    &description,
+			CreationDate:   timestamppb.New(created),
+			LastUsed:       timestamppb.New(lastUsed),
+			ExpirationDate: timestamppb.New(expiration),
+		},
+		{
+			Id:           "oidcSession1",
+			Type:         user.ActiveSessionType_ACTIVE_SESSION_TYPE_OIDC_SESSION,
+			ClientId:     &clientID,
+			SessionId:    &sessionID,
+			CreationDate: timestamppb.New(created),
+			LastUsed:     timestamppb.New(lastUsed),
+		},
+	}
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/command"
+	user "github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func (s *Server) AddPushDevice(ctx context.Context, req *connect.Request[user.AddPushDeviceRequest]) (*connect.Response[user.AddPushDeviceResponse], error) {
+	registration, err := s.command.AddUserPushDevice(ctx, req.Msg.GetUserId(), "")
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.AddPushDeviceResponse{
+		Details:  object.DomainToDetailsPb(registration.ObjectDetails),
+		DeviceId: registration.DeviceID,
+		Code:     registration.Code,
+		Uri:      registration.URI,
+		Expiry:   durationpb.New(registration.Expiry),
+	}), nil
+}
+
+func (s *Server) VerifyPushDeviceRegistration(ctx context.Context, req *connect.Request[user.VerifyPushDeviceRegistrationRequest]) (*connect.Response[user.VerifyPushDeviceRegistrationResponse], error) {
+	objectDetails, err := s.command.VerifyUserPushDevice(ctx, &command.VerifyPushDevice{
+		UserID:    req.Msg.GetUserId(),
+		DeviceID:  req.Msg.GetDeviceId(),
+		Code:      req.Msg.GetCode(),
+		Name:      req.Msg.GetName(),
+		PublicKey: req.Msg.GetPublicKey(),
+		PushToken: req.Msg.GetPushToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.VerifyPushDeviceRegistrationResponse{Details: object.DomainToDetailsPb(objectDetails)}), nil
+}
+
+func (s *Server) RemovePushDevice(ctx context.Context, req *connect.Request[user.RemovePushDeviceRequest]) (*connect.Response[user.RemovePushDeviceResponse], error) {
+	objectDetails, err := s.command.RemoveUserPushDevice(ctx, req.Msg.GetUserId(), req.Msg.GetDeviceId(), "")
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.RemovePushDeviceResponse{Details: object.DomainToDetailsPb(objectDetails)}), nil
+}
@@ -7,10 +7,16 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
 )
 
 func (s *Server) RegisterTOTP(ctx context.Context, req *connect.Request[user.RegisterTOTPRequest]) (*connect.Response[user.RegisterTOTPResponse], error) {
+	if req.Msg.Name != nil {
+		return totpDetailsToPb(
+			s.command.AddUserNamedTOTP(ctx, req.Msg.GetUserId(), "", req.Msg.GetName()),
+		)
+	}
 	return totpDetailsToPb(
 		s.command.AddUserTOTP(ctx, req.Msg.GetUserId(), ""),
 	)
@@ -24,11 +30,18 @@ func totpDetailsToPb(totp *domain.TOTP, err error) (*connect.Response[user.Regis
 		Details: object.DomainToDetailsPb(totp.ObjectDetails),
 		Uri:     totp.URI,
 		Secret:  totp.Secret,
+		TotpId:  totp.ID,
 	}), nil
 }
 
 func (s *Server) VerifyTOTPRegistration(ctx context.Context, req *connect.Request[user.VerifyTOTPRegistrationRequest]) (*connect.Response[user.VerifyTOTPRegistrationResponse], error) {
-	objectDetails, err := s.command.CheckUserTOTP(ctx, req.Msg.GetUserId(), req.Msg.GetCode(), "")
+	var objectDetails *domain.ObjectDetails
+	var err error
+	if totpID := req.Msg.GetTotpId(); totpID != "" {
+		objectDetails, err = s.command.CheckUserNamedTOTP(ctx, req.Msg.GetUserId(), totpID, req.Msg.GetCode(), "")
+	} else {
+		objectDetails, err = s.command.CheckUserTOTP(ctx, req.Msg.GetUserId(), req.Msg.GetCode(), "")
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -38,9 +51,47 @@ func (s *Server) VerifyTOTPRegistration(ctx context.Context, req *connect.Reques
 }
 
 func (s *Server) RemoveTOTP(ctx context.Context, req *connect.Request[user.RemoveTOTPRequest]) (*connect.Response[user.RemoveTOTPResponse], error) {
-	objectDetails, err := s.command.HumanRemoveTOTP(ctx, req.Msg.GetUserId(), "")
+	var objectDetails *domain.ObjectDetails
+	var err error
+	if totpID := req.Msg.GetTotpId(); totpID != "" {
+		objectDetails, err = s.command.HumanRemoveNamedTOTP(ctx, req.Msg.GetUserId(), totpID, "")
+	} else {
+		objectDetails, err = s.command.HumanRemoveTOTP(ctx, req.Msg.GetUserId(), "")
+	}
 	if err != nil {
 		return nil, err
 	}
 	return connect.NewResponse(&user.RemoveTOTPResponse{Details: object.DomainToDetailsPb(objectDetails)}), nil
 }
+
+func (s *Server) ListTOTPs(ctx context.Context, req *connect.Request[user.ListTOTPsRequest]) (*connect.Response[user.ListTOTPsResponse], error) {
+	query := new(query.UserAuthMethodSearchQueries)
+	err := query.AppendUserIDQuery(req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	err = query.AppendAuthMethodQuery(domain.UserAuthMethodTypeTOTP)
+	if err != nil {
+		return nil, err
+	}
+	authMethods, err := s.query.SearchUserAuthMethods(ctx, query, s.checkPermission)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.ListTOTPsResponse{
+		Details: object.ToListDetails(authMethods.SearchResponse),
+		Result:  authMethodsToTOTPPb(authMethods),
+	}), nil
+}
+
+func authMethodsToTOTPPb(methods *query.AuthMethods) []*user.TOTP {
+	t := make([]*user.TOTP, len(methods.AuthMethods))
+	for i, method := range methods.AuthMethods {
+		t[i] = &user.TOTP{
+			Id:    method.TokenID,
+			State: mfaStateToPb(method.State),
+			Name:  method.Name,
+		}
+	}
+	return t
+}
@@ -58,6 +58,34 @@ func Test_totpDetailsToPb(t *testing.T) {
 				Uri:    "URI",
 			},
 		},
+		{
+			name: "success, named",
+			args: args{
+				otp: &domain.TOTP{
+					ObjectDetails: &domain.ObjectDetails{
+						Sequence:      123,
+						EventDate:     time.Unix(456, 789),
+						ResourceOwner: "me",
+					},
+					ID:     "totp1",
+					Secret: "secret",
+					URI:    "URI",
+				},
+			},
+			want: &user.RegisterTOTPResponse{
+				Details: &object.Details{
+					Sequence: 123,
+					ChangeDate: &timestamppb.Timestamp{
+						Seconds: 456,
+						Nanos:   789,
+					},
+					ResourceOwner: "me",
+				},
+				Secret: "secret",
+				Uri:    "URI",
+				TotpId: "totp1",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
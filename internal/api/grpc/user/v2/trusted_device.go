@@ -0,0 +1,70 @@
+package user
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func (s *Server) ListTrustedDevices(ctx context.Context, req *connect.Request[user.ListTrustedDevicesRequest]) (*connect.Response[user.ListTrustedDevicesResponse], error) {
+	devices, err := s.query.TrustedDevices(ctx, req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.ListTrustedDevicesResponse{
+		Result: trustedDevicesToPb(devices),
+	}), nil
+}
+
+func (s *Server) RemoveTrustedDevice(ctx context.Context, req *connect.Request[user.RemoveTrustedDeviceRequest]) (*connect.Response[user.RemoveTrustedDeviceResponse], error) {
+	details, err := s.command.RemoveHumanTrustedDevice(ctx, req.Msg.GetUserId(), "", req.Msg.GetDeviceId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&user.RemoveTrustedDeviceResponse{
+		Details: object.DomainToDetailsPb(details),
+	}), nil
+}
+
+func trustedDevicesToPb(devices []*domain.TrustedDevice) []*user.TrustedDevice {
+	result := make([]*user.TrustedDevice, len(devices))
+	for i, device := range devices {
+		result[i] = &user.TrustedDevice{
+			Id:             device.ID,
+			Name:           device.Name,
+			SecondFactor:   mfaTypeToAuthMethodTypePb(device.MFAType),
+			CreationDate:   timestamppb.New(device.CreationDate),
+			ExpirationDate: timestamppb.New(device.ExpirationDate),
+		}
+		if !device.LastUsed.IsZero() {
+			result[i].LastUsed = timestamppb.New(device.LastUsed)
+		}
+	}
+	return result
+}
+
+func mfaTypeToAuthMethodTypePb(mfaType domain.MFAType) user.AuthenticationMethodType {
+	switch mfaType {
+	case domain.MFATypeTOTP:
+		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_TOTP
+	case domain.MFATypeU2F:
+		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_U2F
+	case domain.MFATypeU2FUserVerification:
+		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_PASSKEY
+	case domain.MFATypeOTPSMS:
+		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_OTP_SMS
+	case domain.MFATypeOTPEmail:
+		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_OTP_EMAIL
+	case domain.MFATypeRecoveryCode:
+		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_RECOVERY_CODE
+	case domain.MFATypeX509Certificate:
+		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_X509_CERTIFICATE
+	default:
+		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,53 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/pkg/grpc/user/v2"
+)
+
+func Test_trustedDevicesToPb(t *testing.T) {
+	created := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	lastUsed := created.Add(24 * time.Hour)
+	expiration := created.Add(30 * 24 * time.Hour)
+	got := trustedDevicesToPb([]*domain.TrustedDevice{
+		{
+			ID:             "device1",
+			Name:           "Firefox",
+			MFAType:        domain.MFATypeOTPEmail,
+			CreationDate:   created,
+			ExpirationDate: expiration,
+			LastUsed:       lastUsed,
+		},
+		{
+			ID:             "device2",
+			Name:           "Chrome",
+			MFAType:        domain.MFATypeU2F,
+			CreationDate:   created,
+			ExpirationDate: expiration,
+		},
+	})
+	want := []*user.TrustedDevice{
+		{
+			Id:             "device1",
+			Name:           "Firefox",
+			SecondFactor:   user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_OTP_EMAIL,
+			CreationDate:   timestamppb.New(created),
+			ExpirationDate: timestamppb.New(expiration),
+			LastUsed:       timestamppb.New(lastUsed),
+		},
+		{
+			Id:             "device2",
+			Name:           "Chrome",
+			SecondFactor:   user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_U2F,
+			CreationDate:   timestamppb.New(created),
+			ExpirationDate: timestamppb.New(expiration),
+		},
+	}
+	assert.Equal(t, want, got)
+}
@@ -345,6 +345,10 @@ func authMethodTypeToPb(methodType domain.UserAuthMethodType) user.Authenticatio
 		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_UNSPECIFIED
 	case domain.UserAuthMethodTypeRecoveryCode:
 		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_RECOVERY_CODE
+	case domain.UserAuthMethodTypePush:
+		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_PUSH
+	case domain.UserAuthMethodTypeX509Certificate:
+		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_X509_CERTIFICATE
 	default:
 		return user.AuthenticationMethodType_AUTHENTICATION_METHOD_TYPE_UNSPECIFIED
 	}
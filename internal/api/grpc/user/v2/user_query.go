@@ -5,6 +5,8 @@ import (
 
 	"connectrpc.com/connect"
 
+	userv2 "github.com/zitadel/zitadel/backend/v3/api/user/v2"
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
 	"github.com/zitadel/zitadel/internal/api/grpc/user/v2/convert"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -12,6 +14,10 @@ import (
 )
 
 func (s *Server) GetUserByID(ctx context.Context, req *connect.Request[user.GetUserByIDRequest]) (_ *connect.Response[user.GetUserByIDResponse], err error) {
+	if authz.GetFeatures(ctx).EnableRelationalTables {
+		return userv2.GetUserByID(ctx, req, s.assetAPIPrefix(ctx), s.checkPermission)
+	}
+
 	resp, err := s.query.GetUserByIDWithPermission(ctx, true, req.Msg.GetUserId(), s.checkPermission)
 	if err != nil {
 		return nil, err
@@ -28,6 +34,10 @@ func (s *Server) GetUserByID(ctx context.Context, req *connect.Request[user.GetU
 }
 
 func (s *Server) ListUsers(ctx context.Context, req *connect.Request[user.ListUsersRequest]) (*connect.Response[user.ListUsersResponse], error) {
+	if authz.GetFeatures(ctx).EnableRelationalTables {
+		return userv2.ListUsers(ctx, req, s.assetAPIPrefix(ctx), s.checkPermission)
+	}
+
 	queries, err := convert.ListUsersRequestToModel(req.Msg)
 	if err != nil {
 		return nil, err
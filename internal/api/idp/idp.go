@@ -35,6 +35,7 @@ import (
 	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
 	openid "github.com/zitadel/zitadel/internal/idp/providers/oidc"
 	saml2 "github.com/zitadel/zitadel/internal/idp/providers/saml"
+	"github.com/zitadel/zitadel/internal/idp/providers/wsfed"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -79,6 +80,10 @@ type externalIDPCallbackData struct {
 
 	// Apple returns a user on first registration
 	User string `schema:"user"`
+
+	// WS-Federation returns the state as wctx and the token response as wresult
+	WCtx    string `schema:"wctx"`
+	WResult string `schema:"wresult"`
 }
 
 type externalSAMLIDPCallbackData struct {
@@ -112,6 +117,7 @@ func NewHandler(
 	encryptionAlgorithm crypto.EncryptionAlgorithm,
 	instanceInterceptor func(next http.Handler) http.Handler,
 	federatedLogoutCache cache.Cache[federatedlogout.Index, string, *federatedlogout.FederatedLogout],
+	wsFederationAssertionCache cache.Cache[wsfed.UsedAssertionIndex, string, *wsfed.UsedAssertion],
 ) http.Handler {
 	h := &Handler{
 		commands:            commands,
@@ -121,7 +127,7 @@ func NewHandler(
 		callbackURL:         CallbackURL(),
 		samlRootURL:         SAMLRootURL(),
 		loginSAMLRootURL:    LoginSAMLRootURL(),
-		caches:              &Caches{federatedLogouts: federatedLogoutCache},
+		caches:              &Caches{federatedLogouts: federatedLogoutCache, wsFederationAssertions: wsFederationAssertionCache},
 	}
 
 	router := mux.NewRouter()
@@ -136,7 +142,8 @@ func NewHandler(
 }
 
 type Caches struct {
-	federatedLogouts cache.Cache[federatedlogout.Index, string, *federatedlogout.FederatedLogout]
+	federatedLogouts       cache.Cache[federatedlogout.Index, string, *federatedlogout.FederatedLogout]
+	wsFederationAssertions cache.Cache[wsfed.UsedAssertionIndex, string, *wsfed.UsedAssertion]
 }
 
 func parseSAMLRequest(r *http.Request) *externalSAMLIDPCallbackData {
@@ -426,7 +433,7 @@ func (h *Handler) handleCallback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	idpUser, idpSession, err := h.fetchIDPUserFromCode(ctx, provider, data.Code, data.User, intent.IDPArguments)
+	idpUser, idpSession, err := h.fetchIDPUser(ctx, provider, data, intent.IDPArguments)
 	if err != nil {
 		cmdErr := h.commands.FailIDPIntent(ctx, intent, err.Error())
 		logging.WithFields("intent", intent.AggregateID).OnError(cmdErr).Error("failed to push failed event on idp intent")
@@ -503,6 +510,9 @@ func (h *Handler) parseCallbackRequest(r *http.Request) (*externalIDPCallbackDat
 	if err != nil {
 		return nil, err
 	}
+	if data.State == "" {
+		data.State = data.WCtx
+	}
 	if data.State == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "IDP-Hk38e", "Errors.Intent.StateMissing")
 	}
@@ -540,7 +550,8 @@ func redirectToFailureURL(w http.ResponseWriter, r *http.Request, i *command.IDP
 	http.Redirect(w, r, i.FailureURL.String(), http.StatusFound)
 }
 
-func (h *Handler) fetchIDPUserFromCode(ctx context.Context, identityProvider idp.Provider, code string, appleUser string, idpArguments map[string]any) (user idp.User, idpTokens idp.Session, err error) {
+func (h *Handler) fetchIDPUser(ctx context.Context, identityProvider idp.Provider, data *externalIDPCallbackData, idpArguments map[string]any) (user idp.User, idpTokens idp.Session, err error) {
+	code := data.Code
 	var session idp.Session
 	switch provider := identityProvider.(type) {
 	case *oauth.Provider:
@@ -556,7 +567,9 @@ func (h *Handler) fetchIDPUserFromCode(ctx context.Context, identityProvider idp
 	case *google.Provider:
 		session = openid.NewSession(provider.Provider, code, idpArguments)
 	case *apple.Provider:
-		session = apple.NewSession(provider, code, appleUser)
+		session = apple.NewSession(provider, code, data.User)
+	case *wsfed.Provider:
+		session = wsfed.NewSession(provider, data.State, data.WCtx, data.WResult, h.caches.wsFederationAssertions)
 	case *jwt.Provider, *ldap.Provider, *saml2.Provider:
 		return nil, nil, zerrors.ThrowInvalidArgument(nil, "IDP-52jmn", "Errors.ExternalIDP.IDPTypeNotImplemented")
 	default:
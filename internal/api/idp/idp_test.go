@@ -208,6 +208,19 @@ func Test_parseCallbackRequest(t *testing.T) {
 				},
 			},
 		},
+		{
+			"parse ws-federation",
+			args{
+				url: "https://example.com?wa=wsignin1.0&wctx=state&wresult=result",
+			},
+			res{
+				want: &externalIDPCallbackData{
+					State:   "state",
+					WCtx:    "state",
+					WResult: "result",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
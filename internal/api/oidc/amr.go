@@ -18,6 +18,12 @@ const (
 	OTP = "otp"
 	// UserPresence states that the end users presence has been verified (e.g. passkey and u2f)
 	UserPresence = "user"
+	// SWK states that the possession of a software-secured key has been proven (e.g. push approval signed by a registered device)
+	SWK = "swk"
+	// SC states that a smart card has been used (e.g. PIV or CAC card with a client certificate)
+	SC = "sc"
+	// HWK states that the possession of a hardware-secured key has been proven (e.g. the private key of a smart card)
+	HWK = "hwk"
 )
 
 // AuthMethodTypesToAMR maps zitadel auth method types to Authentication Method Reference Values
@@ -49,6 +55,13 @@ func AuthMethodTypesToAMR(methodTypes []domain.UserAuthMethodType) []string {
 			// a user could use multiple (t)otp, which is a factor, but still will be returned as a single `otp` entry
 			otp++
 			factors++
+		case domain.UserAuthMethodTypePush:
+			amr = append(amr, SWK)
+			factors++
+		case domain.UserAuthMethodTypeX509Certificate:
+			// the private key on the smart card is protected by a PIN
+			amr = append(amr, SC, HWK)
+			factors += 2
 		case domain.UserAuthMethodTypeIDP:
 			// no AMR value according to specification
 			factors++
@@ -78,6 +91,10 @@ func AMRToAuthMethodTypes(amr []string) []domain.UserAuthMethodType {
 			authMethods = append(authMethods, domain.UserAuthMethodTypePassword)
 		case OTP:
 			authMethods = append(authMethods, domain.UserAuthMethodTypeOTP)
+		case SWK:
+			authMethods = append(authMethods, domain.UserAuthMethodTypePush)
+		case SC:
+			authMethods = append(authMethods, domain.UserAuthMethodTypeX509Certificate)
 		case UserPresence:
 			userPresence = true
 		case MFA:
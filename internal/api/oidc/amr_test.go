@@ -45,6 +45,13 @@ func TestAMR(t *testing.T) {
 			},
 			[]string{UserPresence},
 		},
+		{
+			"x509 certificate checked",
+			args{
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypeX509Certificate},
+			},
+			[]string{SC, HWK, MFA},
+		},
 		{
 			"totp checked",
 			args{
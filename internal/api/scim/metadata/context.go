@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -17,7 +18,9 @@ type ScimContextData struct {
 	ProvisioningDomain          string
 	IgnorePasswordOnCreate      bool
 	ExternalIDScopedMetadataKey ScopedKey
-	bulkIDMapping               map[string]string
+	// CustomUserSchemas the custom schema extensions of the user resource defined for the organization
+	CustomUserSchemas []*schemas.ResourceSchema
+	bulkIDMapping     map[string]string
 }
 
 func NewScimContextData() ScimContextData {
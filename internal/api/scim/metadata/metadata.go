@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
 	"github.com/zitadel/zitadel/internal/query"
 )
 
@@ -32,6 +33,17 @@ const (
 	KeyEntitlements             Key = KeyPrefix + "entitlements"
 	KeyRoles                    Key = KeyPrefix + "roles"
 	KeyEmails                   Key = KeyPrefix + "emails"
+
+	KeyEnterpriseEmployeeNumber Key = KeyPrefix + "enterprise.employeeNumber"
+	KeyEnterpriseCostCenter     Key = KeyPrefix + "enterprise.costCenter"
+	KeyEnterpriseOrganization   Key = KeyPrefix + "enterprise.organization"
+	KeyEnterpriseDivision       Key = KeyPrefix + "enterprise.division"
+	KeyEnterpriseDepartment     Key = KeyPrefix + "enterprise.department"
+	KeyEnterpriseManager        Key = KeyPrefix + "enterprise.manager"
+
+	// KeyCustomSchemaPrefix prefixes the organization metadata keys holding the definitions of custom schema extensions,
+	// the id of the schema is appended to the prefix.
+	KeyCustomSchemaPrefix = schemas.CustomSchemaMetadataKeyPrefix
 )
 
 var (
@@ -50,8 +62,16 @@ var (
 		KeyEntitlements,
 		KeyRoles,
 		KeyEmails,
+		KeyEnterpriseEmployeeNumber,
+		KeyEnterpriseCostCenter,
+		KeyEnterpriseOrganization,
+		KeyEnterpriseDivision,
+		KeyEnterpriseDepartment,
+		KeyEnterpriseManager,
 	}
 
+	enterpriseAttributePathPrefix = strings.ToLower(string(schemas.IdEnterpriseUser))
+
 	AttributePathToMetadataKeys = map[string][]Key{
 		"externalid":           {KeyExternalId},
 		"name":                 {KeyMiddleName, KeyHonorificPrefix, KeyHonorificSuffix},
@@ -68,6 +88,22 @@ var (
 		"entitlements":         {KeyEntitlements},
 		"roles":                {KeyRoles},
 		"emails":               {KeyEmails},
+
+		enterpriseAttributePathPrefix: {
+			KeyEnterpriseEmployeeNumber,
+			KeyEnterpriseCostCenter,
+			KeyEnterpriseOrganization,
+			KeyEnterpriseDivision,
+			KeyEnterpriseDepartment,
+			KeyEnterpriseManager,
+		},
+		enterpriseAttributePathPrefix + ".employeenumber": {KeyEnterpriseEmployeeNumber},
+		enterpriseAttributePathPrefix + ".costcenter":     {KeyEnterpriseCostCenter},
+		enterpriseAttributePathPrefix + ".organization":   {KeyEnterpriseOrganization},
+		enterpriseAttributePathPrefix + ".division":       {KeyEnterpriseDivision},
+		enterpriseAttributePathPrefix + ".department":     {KeyEnterpriseDepartment},
+		enterpriseAttributePathPrefix + ".manager":        {KeyEnterpriseManager},
+		enterpriseAttributePathPrefix + ".manager.value":  {KeyEnterpriseManager},
 	}
 )
 
@@ -75,6 +111,12 @@ func ScopeExternalIdKey(provisioningDomain string) ScopedKey {
 	return ScopedKey(strings.Replace(keyScopedExternalIdTemplate, externalIdProvisioningDomainPlaceholder, provisioningDomain, 1))
 }
 
+// CustomSchemaAttributeKey returns the user metadata key of an attribute of a custom schema extension.
+// The key is the fully qualified urn of the attribute prefixed with KeyPrefix.
+func CustomSchemaAttributeKey(schema schemas.ScimSchemaType, attributeName string) Key {
+	return Key(KeyPrefix + string(schema) + ":" + attributeName)
+}
+
 func ScopeKey(ctx context.Context, key Key) ScopedKey {
 	// only the externalID is scoped
 	if key == KeyExternalId {
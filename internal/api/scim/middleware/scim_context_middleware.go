@@ -31,9 +31,20 @@ func ScimContextMiddleware(q *query.Queries) func(next zhttp.HandlerFuncWithErro
 
 func initScimContext(ctx context.Context, q *query.Queries) (context.Context, error) {
 	data := smetadata.NewScimContextData()
+	ctxData := authz.GetCtxData(ctx)
+
+	// get the custom schema extensions defined for the organization
+	if ctxData.OrgID != "" {
+		customSchemas, err := sresources.QueryCustomUserSchemas(ctx, q, ctxData.OrgID)
+		if err != nil {
+			return ctx, err
+		}
+		data.CustomUserSchemas = customSchemas
+	}
+
 	ctx = smetadata.SetScimContextData(ctx, data)
 
-	userID := authz.GetCtxData(ctx).UserID
+	userID := ctxData.UserID
 
 	// get the provisioningDomain and ignorePasswordOnCreate metadata keys associated with the service user
 	metadataKeys := []smetadata.Key{
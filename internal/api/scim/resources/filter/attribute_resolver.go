@@ -17,7 +17,8 @@ var jsonFieldCache sync.Map
 type structFieldCache map[string]reflect.StructField
 
 type AttributeResolver struct {
-	schema schemas.ScimSchemaType
+	schema     schemas.ScimSchemaType
+	extensions []schemas.ScimSchemaType
 }
 
 func (c structFieldCache) get(name string) (reflect.StructField, error) {
@@ -47,18 +48,19 @@ func (c structFieldCache) set(field reflect.StructField) {
 	c[strings.ToLower(fieldName)] = field
 }
 
-func newAttributeResolver(schema schemas.ScimSchemaType) *AttributeResolver {
+func newAttributeResolver(schema schemas.ScimSchemaType, extensions ...schemas.ScimSchemaType) *AttributeResolver {
 	return &AttributeResolver{
-		schema: schema,
+		schema:     schema,
+		extensions: extensions,
 	}
 }
 
 func (r *AttributeResolver) resolveAttrPath(item reflect.Value, attrPath *AttrPath) ([]string, reflect.Value, error) {
-	if err := attrPath.validateSchema(r.schema); err != nil {
+	segments, err := attrPath.schemaSegments(r.schema, r.extensions)
+	if err != nil {
 		return nil, reflect.Value{}, err
 	}
 
-	segments := attrPath.Segments()
 	for _, segment := range segments {
 		var err error
 		item, err = r.resolveField(item, segment)
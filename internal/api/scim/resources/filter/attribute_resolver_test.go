@@ -14,12 +14,18 @@ type attributeResolverTestType struct {
 	Nested      struct {
 		IntValue int
 	}
+	Extension *attributeResolverTestExtension `json:"urn:foo:ext:Bar"`
+}
+
+type attributeResolverTestExtension struct {
+	Department string
 }
 
 func TestAttributeResolver_resolveAttrPath(t *testing.T) {
 	tests := []struct {
 		name         string
 		schema       schemas.ScimSchemaType
+		extensions   []schemas.ScimSchemaType
 		item         interface{}
 		attrPath     *AttrPath
 		wantSegments []string
@@ -110,10 +116,44 @@ func TestAttributeResolver_resolveAttrPath(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:       "extension attribute",
+			schema:     "fooBar",
+			extensions: []schemas.ScimSchemaType{"urn:foo:ext:Bar"},
+			item:       &attributeResolverTestType{Extension: &attributeResolverTestExtension{Department: "sales"}},
+			attrPath: &AttrPath{
+				UrnAttributePrefix: gu.Ptr("urn:foo:ext:Bar:"),
+				AttrName:           "department",
+			},
+			wantSegments: []string{"urn:foo:ext:bar", "department"},
+			wantValue:    "sales",
+		},
+		{
+			name:       "extension itself",
+			schema:     "fooBar",
+			extensions: []schemas.ScimSchemaType{"urn:foo:ext:Bar"},
+			item:       &attributeResolverTestType{Extension: &attributeResolverTestExtension{Department: "sales"}},
+			attrPath: &AttrPath{
+				UrnAttributePrefix: gu.Ptr("urn:foo:ext:"),
+				AttrName:           "Bar",
+			},
+			wantSegments: []string{"urn:foo:ext:bar"},
+			wantValue:    &attributeResolverTestExtension{Department: "sales"},
+		},
+		{
+			name:   "extension not supported",
+			schema: "fooBar",
+			item:   &attributeResolverTestType{Extension: &attributeResolverTestExtension{Department: "sales"}},
+			attrPath: &AttrPath{
+				UrnAttributePrefix: gu.Ptr("urn:foo:ext:Bar:"),
+				AttrName:           "department",
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r := newAttributeResolver(tt.schema)
+			r := newAttributeResolver(tt.schema, tt.extensions...)
 
 			gotSegments, gotValue, err := r.resolveAttrPath(reflect.ValueOf(tt.item), tt.attrPath)
 			if (err != nil) != tt.wantErr {
@@ -35,10 +35,10 @@ type FilteredValuesEvaluationResultMatch struct {
 
 type EvaluationResult interface{}
 
-func NewEvaluator(schema schemas.ScimSchemaType) *Evaluator {
+func NewEvaluator(schema schemas.ScimSchemaType, extensions ...schemas.ScimSchemaType) *Evaluator {
 	return &Evaluator{
 		schema:            schema,
-		attributeResolver: newAttributeResolver(schema),
+		attributeResolver: newAttributeResolver(schema, extensions...),
 	}
 }
 
@@ -326,6 +326,29 @@ func (a *AttrPath) validateSchema(expectedSchema schemas.ScimSchemaType) error {
 	return serrors.ThrowInvalidFilter(zerrors.ThrowInvalidArgument(nil, "SCIM-FF431", "Invalid filter expression: unknown urn attribute prefix"))
 }
 
+// schemaSegments validates the urn prefix of the attribute path and returns its segments.
+// Attributes of a schema extension are nested under the lowercase id of the extension
+// (the json field name of the extension), which is returned as the first segment.
+// A path referencing the extension itself results in the extension id as the only segment.
+func (a *AttrPath) schemaSegments(expectedSchema schemas.ScimSchemaType, extensions []schemas.ScimSchemaType) ([]string, error) {
+	if a.UrnAttributePrefix == nil || *a.UrnAttributePrefix == string(expectedSchema)+":" {
+		return a.Segments(), nil
+	}
+
+	for _, extension := range extensions {
+		extensionSegment := strings.ToLower(string(extension))
+		if strings.EqualFold(*a.UrnAttributePrefix, string(extension)+":") {
+			return append([]string{extensionSegment}, a.Segments()...), nil
+		}
+
+		if a.SubAttr == nil && strings.EqualFold(*a.UrnAttributePrefix+a.AttrName, string(extension)) {
+			return []string{extensionSegment}, nil
+		}
+	}
+
+	return nil, a.validateSchema(expectedSchema)
+}
+
 func (a *AttrPath) Segments() []string {
 	// user lower, since attribute names in scim are always case-insensitive
 	if a.SubAttr != nil {
@@ -20,6 +20,7 @@ type FieldPathMapping map[string]*QueryFieldInfo
 type queryBuilder struct {
 	ctx              context.Context
 	schema           schemas.ScimSchemaType
+	extensions       []schemas.ScimSchemaType
 	fieldPathMapping FieldPathMapping
 
 	// attrPathPrefixes prefixes of attributes that
@@ -57,10 +58,11 @@ func (m FieldPathMapping) Resolve(path string) (*QueryFieldInfo, error) {
 	return info, nil
 }
 
-func (f *Filter) BuildQuery(ctx context.Context, schema schemas.ScimSchemaType, fieldPathColumnMapping FieldPathMapping) (query.SearchQuery, error) {
+func (f *Filter) BuildQuery(ctx context.Context, schema schemas.ScimSchemaType, fieldPathColumnMapping FieldPathMapping, extensions ...schemas.ScimSchemaType) (query.SearchQuery, error) {
 	builder := &queryBuilder{
 		ctx:              ctx,
 		schema:           schema,
+		extensions:       extensions,
 		fieldPathMapping: fieldPathColumnMapping,
 	}
 	return builder.visitSegment(&f.Root)
@@ -341,6 +343,7 @@ func (b *queryBuilder) visitAttrPath(attrPath *AttrPath) (*QueryFieldInfo, error
 // reduceAttrPaths reduces a slice of AttrPath
 // to a simple urn + fieldPath combination.
 // The urn is ensured to be unique across all segments and either to be empty or to match the schema of the builder.
+// Attributes of a schema extension of the builder are prefixed with the lowercase id of the extension.
 // The resulting fieldPath is in the form of a.b.c with a minimum of one path segment.
 func (b *queryBuilder) reduceAttrPaths(attrPaths []*AttrPath) (fieldPath string, err error) {
 	if len(attrPaths) == 0 {
@@ -351,11 +354,12 @@ func (b *queryBuilder) reduceAttrPaths(attrPaths []*AttrPath) (fieldPath string,
 	sb := strings.Builder{}
 
 	for _, p := range attrPaths {
-		if err = p.validateSchema(b.schema); err != nil {
+		var segments []string
+		if segments, err = p.schemaSegments(b.schema, b.extensions); err != nil {
 			return
 		}
 
-		sb.WriteString(p.FieldPath())
+		sb.WriteString(strings.Join(segments, "."))
 		sb.WriteRune('.')
 	}
 
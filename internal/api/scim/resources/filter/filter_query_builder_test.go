@@ -340,6 +340,7 @@ func Test_queryBuilder_reduceAttrPaths(t *testing.T) {
 	tests := []struct {
 		name          string
 		schema        string
+		extensions    []schemas.ScimSchemaType
 		attrPaths     []*AttrPath
 		wantFieldPath string
 		wantErr       bool
@@ -479,11 +480,49 @@ func Test_queryBuilder_reduceAttrPaths(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:       "extension urn",
+			schema:     "urn:foo:bar",
+			extensions: []schemas.ScimSchemaType{"urn:foo:ext:Bar"},
+			attrPaths: []*AttrPath{
+				{
+					UrnAttributePrefix: gu.Ptr("urn:foo:ext:Bar:"),
+					AttrName:           "foo",
+					SubAttr:            gu.Ptr("bar"),
+				},
+			},
+			wantFieldPath: "urn:foo:ext:bar.foo.bar",
+		},
+		{
+			name:       "extension urn case-insensitive",
+			schema:     "urn:foo:bar",
+			extensions: []schemas.ScimSchemaType{"urn:foo:ext:Bar"},
+			attrPaths: []*AttrPath{
+				{
+					UrnAttributePrefix: gu.Ptr("urn:FOO:ext:bar:"),
+					AttrName:           "foo",
+				},
+			},
+			wantFieldPath: "urn:foo:ext:bar.foo",
+		},
+		{
+			name:       "unknown extension urn",
+			schema:     "urn:foo:bar",
+			extensions: []schemas.ScimSchemaType{"urn:foo:ext:Bar"},
+			attrPaths: []*AttrPath{
+				{
+					UrnAttributePrefix: gu.Ptr("urn:foo:ext:Baz:"),
+					AttrName:           "foo",
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			b := &queryBuilder{
-				schema: schemas.ScimSchemaType(tt.schema),
+				schema:     schemas.ScimSchemaType(tt.schema),
+				extensions: tt.extensions,
 			}
 			gotFieldPath, err := b.reduceAttrPaths(tt.attrPaths)
 			if (err != nil) != tt.wantErr {
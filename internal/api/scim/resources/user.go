@@ -48,6 +48,25 @@ type ScimUser struct {
 	Photos                 []*ScimPhoto                  `json:"photos,omitempty"`
 	Entitlements           []*ScimEntitlement            `json:"entitlements,omitempty"`
 	Roles                  []*ScimRole                   `json:"roles,omitempty"`
+	EnterpriseUser         *ScimEnterpriseUser           `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty" scim:"ignoreInSchema"`
+
+	// CustomExtensions holds the attributes of the custom schema extensions of the organization.
+	// They are (de)serialized by the MarshalJSON and UnmarshalJSON methods of the ScimUser.
+	CustomExtensions map[scim_schemas.ScimSchemaType]ScimCustomExtension `json:"-" scim:"ignoreInSchema"`
+}
+
+type ScimEnterpriseUser struct {
+	EmployeeNumber string                     `json:"employeeNumber,omitempty"`
+	CostCenter     string                     `json:"costCenter,omitempty"`
+	Organization   string                     `json:"organization,omitempty"`
+	Division       string                     `json:"division,omitempty"`
+	Department     string                     `json:"department,omitempty"`
+	Manager        *ScimEnterpriseUserManager `json:"manager,omitempty"`
+}
+
+type ScimEnterpriseUserManager struct {
+	Value string `json:"value,omitempty"`
+	Ref   string `json:"$ref,omitempty" scim:"readOnly"`
 }
 
 type ScimEntitlement struct {
@@ -117,13 +136,21 @@ func NewUsersHandler(
 		query,
 		userCodeAlg,
 		config,
-		filter.NewEvaluator(scim_schemas.IdUser),
+		filter.NewEvaluator(scim_schemas.IdUser, scim_schemas.IdEnterpriseUser),
 		scim_schemas.BuildSchema(scim_schemas.SchemaBuilderArgs{
 			ID:           scim_schemas.IdUser,
 			Name:         scim_schemas.UserResourceType,
 			EndpointName: scim_schemas.UsersResourceType,
 			Description:  "User Account",
 			Resource:     new(ScimUser),
+			Extensions: []*scim_schemas.ResourceSchema{
+				scim_schemas.BuildSchema(scim_schemas.SchemaBuilderArgs{
+					ID:          scim_schemas.IdEnterpriseUser,
+					Name:        scim_schemas.EnterpriseUserResourceType,
+					Description: "Enterprise User",
+					Resource:    new(ScimEnterpriseUser),
+				}),
+			},
 		}),
 	}
 }
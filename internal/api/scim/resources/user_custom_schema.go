@@ -0,0 +1,541 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/scim/metadata"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/filter"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/patch"
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/api/scim/serrors"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ScimCustomExtension the attribute values of a custom schema extension of a user.
+type ScimCustomExtension map[string]json.RawMessage
+
+// scimUserJson is used to (de)serialize the ScimUser without its custom json methods.
+type scimUserJson ScimUser
+
+type customSchemaMetadata struct {
+	key   metadata.Key
+	value []byte
+}
+
+// QueryCustomUserSchemas returns the custom schema extensions of the user resource defined for an organization.
+// The definitions are stored as organization metadata with the key metadata.KeyCustomSchemaPrefix + schema id,
+// invalid definitions are skipped.
+func QueryCustomUserSchemas(ctx context.Context, q *query.Queries, orgID string) ([]*schemas.ResourceSchema, error) {
+	keyQuery, err := query.NewOrgMetadataKeySearchQuery(metadata.KeyCustomSchemaPrefix, query.TextStartsWith)
+	if err != nil {
+		return nil, err
+	}
+
+	md, err := q.SearchOrgMetadata(ctx, false, orgID, &query.OrgMetadataSearchQueries{Queries: []query.SearchQuery{keyQuery}}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	customSchemas := make([]*schemas.ResourceSchema, 0, len(md.Metadata))
+	for _, entry := range md.Metadata {
+		id := schemas.ScimSchemaType(strings.TrimPrefix(entry.Key, metadata.KeyCustomSchemaPrefix))
+		schema, err := schemas.ParseCustomSchema(id, entry.Value)
+		if err != nil {
+			logging.WithFields("schema", id, "org", orgID).OnError(err).Warn("invalid scim custom schema definition")
+			continue
+		}
+
+		customSchemas = append(customSchemas, schema)
+	}
+
+	slices.SortFunc(customSchemas, func(a, b *schemas.ResourceSchema) int {
+		return strings.Compare(string(a.ID), string(b.ID))
+	})
+	return customSchemas, nil
+}
+
+func customUserSchemas(ctx context.Context) []*schemas.ResourceSchema {
+	return metadata.GetScimContextData(ctx).CustomUserSchemas
+}
+
+// scimUserMetadataKeys returns the metadata keys of the built-in attributes
+// and the attributes of all custom schema extensions.
+func scimUserMetadataKeys(ctx context.Context) []metadata.Key {
+	customSchemas := customUserSchemas(ctx)
+	if len(customSchemas) == 0 {
+		return metadata.ScimUserRelevantMetadataKeys
+	}
+
+	keys := slices.Clone(metadata.ScimUserRelevantMetadataKeys)
+	for _, schema := range customSchemas {
+		for _, attribute := range schema.Attributes {
+			keys = append(keys, metadata.CustomSchemaAttributeKey(schema.ID, attribute.Name))
+		}
+	}
+	return keys
+}
+
+func (u *ScimUser) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal((*scimUserJson)(u))
+	if err != nil || len(u.CustomExtensions) == 0 {
+		return data, err
+	}
+
+	// append the custom extensions to the serialized object
+	buf := bytes.NewBuffer(data[:len(data)-1])
+	for _, schema := range slices.Sorted(maps.Keys(u.CustomExtensions)) {
+		key, err := json.Marshal(schema)
+		if err != nil {
+			return nil, err
+		}
+
+		value, err := json.Marshal(u.CustomExtensions[schema])
+		if err != nil {
+			return nil, err
+		}
+
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func (u *ScimUser) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, (*scimUserJson)(u)); err != nil {
+		return err
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	for key, value := range fields {
+		schema := schemas.ScimSchemaType(key)
+		if !strings.HasPrefix(strings.ToLower(key), "urn:") || schemas.IsBuiltInSchema(schema) {
+			continue
+		}
+
+		extension := make(ScimCustomExtension)
+		if err := json.Unmarshal(value, &extension); err != nil {
+			return serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgumentf(err, "SCIM-CSx1", "Invalid value for schema extension %s", key))
+		}
+
+		if u.CustomExtensions == nil {
+			u.CustomExtensions = make(map[schemas.ScimSchemaType]ScimCustomExtension)
+		}
+		u.CustomExtensions[schema] = extension
+	}
+
+	return nil
+}
+
+// extensionSchemas returns the ids of the schema extensions the user has values for.
+func (u *ScimUser) extensionSchemas() []schemas.ScimSchemaType {
+	extensions := make([]schemas.ScimSchemaType, 0, len(u.CustomExtensions)+1)
+	if u.EnterpriseUser != nil {
+		extensions = append(extensions, schemas.IdEnterpriseUser)
+	}
+	return append(extensions, slices.Sorted(maps.Keys(u.CustomExtensions))...)
+}
+
+func (u *ScimUser) customExtension(schema schemas.ScimSchemaType) (schemas.ScimSchemaType, ScimCustomExtension) {
+	for id, extension := range u.CustomExtensions {
+		if strings.EqualFold(string(id), string(schema)) {
+			return id, extension
+		}
+	}
+	return schema, nil
+}
+
+func (e ScimCustomExtension) get(attributeName string) json.RawMessage {
+	for name, value := range e {
+		if strings.EqualFold(name, attributeName) {
+			return value
+		}
+	}
+	return nil
+}
+
+func (e ScimCustomExtension) remove(attributeName string) {
+	for name := range e {
+		if strings.EqualFold(name, attributeName) {
+			delete(e, name)
+		}
+	}
+}
+
+// mapCustomSchemasToMetadata validates the custom schema extensions of the user
+// and maps all their attributes to metadata entries.
+// Attributes without a value result in an entry without value.
+func mapCustomSchemasToMetadata(ctx context.Context, user *ScimUser) ([]*customSchemaMetadata, error) {
+	customSchemas := customUserSchemas(ctx)
+	md := make([]*customSchemaMetadata, 0, len(customSchemas))
+	for _, schema := range customSchemas {
+		_, extension := user.customExtension(schema.ID)
+		for name := range extension {
+			if schema.Attribute(name) == nil {
+				return nil, serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgumentf(nil, "SCIM-CSx2", "Unknown attribute %s of schema %s", name, schema.ID))
+			}
+		}
+
+		for _, attribute := range schema.Attributes {
+			value, err := customSchemaAttributeValue(attribute, extension.get(attribute.Name))
+			if err != nil {
+				return nil, err
+			}
+
+			if attribute.Required && len(value) == 0 {
+				return nil, serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgumentf(nil, "SCIM-CSx3", "Attribute %s of schema %s is required", attribute.Name, schema.ID))
+			}
+
+			md = append(md, &customSchemaMetadata{
+				key:   metadata.CustomSchemaAttributeKey(schema.ID, attribute.Name),
+				value: value,
+			})
+		}
+	}
+
+	return md, nil
+}
+
+// extractCustomSchemasMetadata sets the custom schema extensions of the user based on the metadata.
+func extractCustomSchemasMetadata(ctx context.Context, user *ScimUser, md map[metadata.ScopedKey][]byte) {
+	for _, schema := range customUserSchemas(ctx) {
+		extension := make(ScimCustomExtension, len(schema.Attributes))
+		for _, attribute := range schema.Attributes {
+			value, ok := md[metadata.ScopedKey(metadata.CustomSchemaAttributeKey(schema.ID, attribute.Name))]
+			if !ok {
+				continue
+			}
+
+			jsonValue, err := customSchemaAttributeJson(attribute, value)
+			if err != nil {
+				logging.WithFields("schema", schema.ID, "attribute", attribute.Name).OnError(err).Warn("Could not deserialize scim custom schema metadata")
+				continue
+			}
+
+			extension[attribute.Name] = jsonValue
+		}
+
+		if len(extension) == 0 {
+			continue
+		}
+
+		if user.CustomExtensions == nil {
+			user.CustomExtensions = make(map[schemas.ScimSchemaType]ScimCustomExtension)
+		}
+		user.CustomExtensions[schema.ID] = extension
+	}
+}
+
+// customSchemaAttributeValue converts the json value of a custom schema attribute to its metadata value.
+// Strings are stored as is, all other types are stored in their json representation.
+func customSchemaAttributeValue(attribute *schemas.SchemaAttribute, value json.RawMessage) ([]byte, error) {
+	if len(value) == 0 || string(value) == "null" {
+		return nil, nil
+	}
+
+	var err error
+	var metadataValue []byte
+	switch attribute.Type { //nolint:exhaustive
+	case schemas.SchemaAttributeTypeString:
+		var v string
+		if err = json.Unmarshal(value, &v); err == nil {
+			metadataValue = []byte(v)
+		}
+	case schemas.SchemaAttributeTypeBoolean:
+		var v schemas.RelaxedBool
+		if err = json.Unmarshal(value, &v); err == nil {
+			metadataValue = []byte(strconv.FormatBool(bool(v)))
+		}
+	case schemas.SchemaAttributeTypeInteger:
+		var v int64
+		if err = json.Unmarshal(value, &v); err == nil {
+			metadataValue = []byte(strconv.FormatInt(v, 10))
+		}
+	case schemas.SchemaAttributeTypeDecimal:
+		var v float64
+		if err = json.Unmarshal(value, &v); err == nil {
+			metadataValue, err = json.Marshal(v)
+		}
+	default:
+		err = zerrors.ThrowInvalidArgumentf(nil, "SCIM-CSv2", "Unsupported attribute type %s", attribute.Type)
+	}
+
+	if err != nil {
+		return nil, serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgumentf(err, "SCIM-CSv1", "Invalid value for attribute %s", attribute.Name))
+	}
+
+	return metadataValue, nil
+}
+
+// customSchemaAttributeJson converts the metadata value of a custom schema attribute to its json value.
+func customSchemaAttributeJson(attribute *schemas.SchemaAttribute, value []byte) (json.RawMessage, error) {
+	if attribute.Type == schemas.SchemaAttributeTypeString {
+		return json.Marshal(string(value))
+	}
+
+	if !json.Valid(value) {
+		return nil, zerrors.ThrowInternalf(nil, "SCIM-CSv3", "Invalid metadata value for attribute %s", attribute.Name)
+	}
+	return value, nil
+}
+
+// customSchemaAttributeCompareValue converts the value of a filter expression to the metadata value of a custom schema attribute.
+func customSchemaAttributeCompareValue(attribute *schemas.SchemaAttribute, value *filter.CompValue) ([]byte, error) {
+	var jsonValue any
+	switch {
+	case value.StringValue != nil && attribute.Type == schemas.SchemaAttributeTypeString:
+		jsonValue = *value.StringValue
+	case (value.BooleanTrue || value.BooleanFalse) && attribute.Type == schemas.SchemaAttributeTypeBoolean:
+		jsonValue = value.BooleanTrue
+	case value.Int != nil && (attribute.Type == schemas.SchemaAttributeTypeInteger || attribute.Type == schemas.SchemaAttributeTypeDecimal):
+		jsonValue = *value.Int
+	case value.Float != nil && attribute.Type == schemas.SchemaAttributeTypeDecimal:
+		jsonValue = *value.Float
+	default:
+		return nil, serrors.ThrowInvalidFilter(zerrors.ThrowInvalidArgumentf(nil, "SCIM-CSf1", "invalid filter expression: unsupported comparison value for attribute %s", attribute.Name))
+	}
+
+	rawValue, err := json.Marshal(jsonValue)
+	if err != nil {
+		return nil, err
+	}
+	return customSchemaAttributeValue(attribute, rawValue)
+}
+
+// buildCustomSchemasFieldPathMapping extends the field path mapping of the user
+// with the attributes of the custom schema extensions.
+func buildCustomSchemasFieldPathMapping(customSchemas []*schemas.ResourceSchema) filter.FieldPathMapping {
+	mapping := maps.Clone(fieldPathColumnMapping)
+	for _, schema := range customSchemas {
+		for _, attribute := range schema.Attributes {
+			key := metadata.CustomSchemaAttributeKey(schema.ID, attribute.Name)
+			mapping[strings.ToLower(string(schema.ID)+"."+attribute.Name)] = &filter.QueryFieldInfo{
+				FieldType: filter.FieldTypeCustom,
+				BuildMappedQuery: func(_ context.Context, compareValue *filter.CompValue, op *filter.CompareOp) (query.SearchQuery, error) {
+					value, err := customSchemaAttributeCompareValue(attribute, compareValue)
+					if err != nil {
+						return nil, err
+					}
+					return buildMetadataValueQuery(string(key), value, op)
+				},
+			}
+		}
+	}
+	return mapping
+}
+
+// applyCustomSchemaPatches applies all patch operations targeting custom schema extensions
+// and returns the remaining operations.
+// Custom schema extensions are not backed by a struct
+// and can therefore not be patched by the reflection based patch implementation.
+func (p *userPatcher) applyCustomSchemaPatches(operations patch.OperationCollection) (patch.OperationCollection, error) {
+	if len(customUserSchemas(p.ctx)) == 0 {
+		return operations, nil
+	}
+
+	remainingOperations := make(patch.OperationCollection, 0, len(operations))
+	for _, op := range operations {
+		opType := patch.OperationType(strings.ToLower(string(op.Operation)))
+		if opType != patch.OperationTypeAdd && opType != patch.OperationTypeReplace && opType != patch.OperationTypeRemove {
+			remainingOperations = append(remainingOperations, op)
+			continue
+		}
+
+		if op.Path.IsZero() {
+			remainingOperation, err := p.applyCustomSchemaPatchWithoutPath(opType, op)
+			if err != nil {
+				return nil, err
+			}
+
+			if remainingOperation != nil {
+				remainingOperations = append(remainingOperations, remainingOperation)
+			}
+			continue
+		}
+
+		schema, attributeName, ok := p.resolveCustomSchemaPath(op.Path)
+		if !ok {
+			remainingOperations = append(remainingOperations, op)
+			continue
+		}
+
+		if err := p.applyCustomSchemaPatch(opType, schema, attributeName, op.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	return remainingOperations, nil
+}
+
+// applyCustomSchemaPatchWithoutPath applies all values of an operation without a path
+// which target a custom schema extension (e.g. "urn:foo:User": { "bar": "baz" } or "urn:foo:User:bar": "baz").
+// The operation with the remaining values is returned.
+func (p *userPatcher) applyCustomSchemaPatchWithoutPath(opType patch.OperationType, op *patch.Operation) (*patch.Operation, error) {
+	values := make(map[string]json.RawMessage)
+	if opType == patch.OperationTypeRemove || json.Unmarshal(op.Value, &values) != nil {
+		// invalid operations are handled by the generic patch implementation
+		return op, nil
+	}
+
+	applied := false
+	for key, value := range values {
+		for _, schema := range customUserSchemas(p.ctx) {
+			var attributeName string
+			switch {
+			case strings.EqualFold(key, string(schema.ID)):
+			case len(key) > len(schema.ID)+1 && strings.EqualFold(key[:len(schema.ID)+1], string(schema.ID)+":"):
+				attributeName = key[len(schema.ID)+1:]
+			default:
+				continue
+			}
+
+			if err := p.applyCustomSchemaPatch(opType, schema, attributeName, value); err != nil {
+				return nil, err
+			}
+
+			delete(values, key)
+			applied = true
+			break
+		}
+	}
+
+	if !applied {
+		return op, nil
+	}
+
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	remainingValue, err := json.Marshal(values)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "SCIM-CSp3", "Could not serialize patch value")
+	}
+
+	return &patch.Operation{
+		Operation: op.Operation,
+		Value:     remainingValue,
+	}, nil
+}
+
+// resolveCustomSchemaPath resolves the custom schema extension and the attribute name of a patch path.
+// The attribute name is empty if the path references the extension itself.
+func (p *userPatcher) resolveCustomSchemaPath(path *filter.Path) (*schemas.ResourceSchema, string, bool) {
+	if path.AttrPath == nil || path.AttrPath.UrnAttributePrefix == nil {
+		return nil, "", false
+	}
+
+	attrPath := path.AttrPath
+	for _, schema := range customUserSchemas(p.ctx) {
+		if strings.EqualFold(*attrPath.UrnAttributePrefix, string(schema.ID)+":") {
+			if attrPath.SubAttr != nil {
+				// custom schemas do not support complex attributes, this results in an unknown attribute
+				return schema, attrPath.AttrName + "." + *attrPath.SubAttr, true
+			}
+			return schema, attrPath.AttrName, true
+		}
+
+		if attrPath.SubAttr == nil && strings.EqualFold(*attrPath.UrnAttributePrefix+attrPath.AttrName, string(schema.ID)) {
+			return schema, "", true
+		}
+	}
+
+	return nil, "", false
+}
+
+func (p *userPatcher) applyCustomSchemaPatch(opType patch.OperationType, schema *schemas.ResourceSchema, attributeName string, value json.RawMessage) error {
+	if p.user.CustomExtensions == nil {
+		p.user.CustomExtensions = make(map[schemas.ScimSchemaType]ScimCustomExtension)
+	}
+
+	id, extension := p.user.customExtension(schema.ID)
+	if extension == nil {
+		extension = make(ScimCustomExtension)
+		p.user.CustomExtensions[id] = extension
+	}
+
+	if attributeName != "" {
+		attribute := schema.Attribute(attributeName)
+		if attribute == nil {
+			return serrors.ThrowInvalidPath(zerrors.ThrowInvalidArgumentf(nil, "SCIM-CSp1", "Unknown attribute %s of schema %s", attributeName, schema.ID))
+		}
+
+		extension.remove(attribute.Name)
+		if opType != patch.OperationTypeRemove {
+			extension[attribute.Name] = value
+		}
+
+		return p.updateCustomSchemaMetadata(schema, attribute, extension)
+	}
+
+	values := make(ScimCustomExtension)
+	if opType != patch.OperationTypeRemove {
+		if err := json.Unmarshal(value, &values); err != nil {
+			return serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgumentf(err, "SCIM-CSp2", "Invalid value for schema extension %s", schema.ID))
+		}
+	}
+
+	for name := range values {
+		if schema.Attribute(name) == nil {
+			return serrors.ThrowInvalidPath(zerrors.ThrowInvalidArgumentf(nil, "SCIM-CSp1", "Unknown attribute %s of schema %s", name, schema.ID))
+		}
+	}
+
+	for _, attribute := range schema.Attributes {
+		newValue := values.get(attribute.Name)
+		if newValue == nil && opType == patch.OperationTypeAdd {
+			// add only modifies the provided attributes
+			continue
+		}
+
+		extension.remove(attribute.Name)
+		if newValue != nil {
+			extension[attribute.Name] = newValue
+		}
+
+		if err := p.updateCustomSchemaMetadata(schema, attribute, extension); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *userPatcher) updateCustomSchemaMetadata(schema *schemas.ResourceSchema, attribute *schemas.SchemaAttribute, extension ScimCustomExtension) error {
+	value, err := customSchemaAttributeValue(attribute, extension.get(attribute.Name))
+	if err != nil {
+		return err
+	}
+
+	key := metadata.CustomSchemaAttributeKey(schema.ID, attribute.Name)
+	if len(value) == 0 {
+		p.metadataKeysToRemove[key] = true
+		delete(p.metadataChanges, key)
+		return nil
+	}
+
+	delete(p.metadataKeysToRemove, key)
+	p.metadataChanges[key] = &domain.Metadata{
+		Key:   string(key),
+		Value: value,
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/scim/metadata"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/filter"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/patch"
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/test"
+)
+
+const testCustomSchemaID schemas.ScimSchemaType = "urn:acme:scim:User"
+
+func testCustomSchemaContext(t *testing.T) context.Context {
+	schema, err := schemas.ParseCustomSchema(testCustomSchemaID, []byte(`{"name":"AcmeUser","attributes":[{"name":"badge","type":"string"},{"name":"floor","type":"integer"},{"name":"remote","type":"boolean"}]}`))
+	require.NoError(t, err)
+
+	data := metadata.NewScimContextData()
+	data.CustomUserSchemas = []*schemas.ResourceSchema{schema}
+	return metadata.SetScimContextData(context.Background(), data)
+}
+
+func TestScimUser_JSON(t *testing.T) {
+	data := []byte(`{"userName":"acme","urn:ietf:params:scim:schemas:extension:enterprise:2.0:User":{"department":"R&D"},"urn:acme:scim:User":{"badge":"A-1","floor":3}}`)
+
+	user := new(ScimUser)
+	require.NoError(t, json.Unmarshal(data, user))
+	assert.Equal(t, "acme", user.UserName)
+	assert.Equal(t, "R&D", user.EnterpriseUser.Department)
+	assert.Equal(t, map[schemas.ScimSchemaType]ScimCustomExtension{
+		testCustomSchemaID: {
+			"badge": json.RawMessage(`"A-1"`),
+			"floor": json.RawMessage(`3`),
+		},
+	}, user.CustomExtensions)
+	assert.Equal(t, []schemas.ScimSchemaType{schemas.IdEnterpriseUser, testCustomSchemaID}, user.extensionSchemas())
+
+	serialized, err := json.Marshal(user)
+	require.NoError(t, err)
+
+	roundTripped := new(ScimUser)
+	require.NoError(t, json.Unmarshal(serialized, roundTripped))
+	assert.Equal(t, user.EnterpriseUser, roundTripped.EnterpriseUser)
+	assert.Equal(t, user.CustomExtensions, roundTripped.CustomExtensions)
+}
+
+func TestScimUser_UnmarshalJSON_invalidExtension(t *testing.T) {
+	err := json.Unmarshal([]byte(`{"urn:acme:scim:User":"badge"}`), new(ScimUser))
+	require.Error(t, err)
+}
+
+func Test_customSchemaAttributeValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		attrType schemas.SchemaAttributeType
+		value    string
+		want     []byte
+		wantErr  bool
+	}{
+		{
+			name:     "null",
+			attrType: schemas.SchemaAttributeTypeString,
+			value:    "null",
+		},
+		{
+			name:     "string",
+			attrType: schemas.SchemaAttributeTypeString,
+			value:    `"A-1"`,
+			want:     []byte("A-1"),
+		},
+		{
+			name:     "string invalid",
+			attrType: schemas.SchemaAttributeTypeString,
+			value:    `1`,
+			wantErr:  true,
+		},
+		{
+			name:     "boolean relaxed",
+			attrType: schemas.SchemaAttributeTypeBoolean,
+			value:    `"True"`,
+			want:     []byte("true"),
+		},
+		{
+			name:     "integer",
+			attrType: schemas.SchemaAttributeTypeInteger,
+			value:    `42`,
+			want:     []byte("42"),
+		},
+		{
+			name:     "integer invalid",
+			attrType: schemas.SchemaAttributeTypeInteger,
+			value:    `4.2`,
+			wantErr:  true,
+		},
+		{
+			name:     "decimal",
+			attrType: schemas.SchemaAttributeTypeDecimal,
+			value:    `4.20`,
+			want:     []byte("4.2"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := customSchemaAttributeValue(&schemas.SchemaAttribute{Name: "attr", Type: tt.attrType}, json.RawMessage(tt.value))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_userPatcher_applyCustomSchemaPatches(t *testing.T) {
+	badgeKey := metadata.CustomSchemaAttributeKey(testCustomSchemaID, "badge")
+	floorKey := metadata.CustomSchemaAttributeKey(testCustomSchemaID, "floor")
+	remoteKey := metadata.CustomSchemaAttributeKey(testCustomSchemaID, "remote")
+
+	tests := []struct {
+		name                     string
+		op                       *patch.Operation
+		wantRemaining            bool
+		wantExtension            ScimCustomExtension
+		wantMetadataChanges      map[metadata.Key]*domain.Metadata
+		wantMetadataKeysToRemove map[metadata.Key]bool
+		wantErr                  bool
+	}{
+		{
+			name: "core attribute",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeReplace,
+				Path:      test.Must(filter.ParsePath("nickName")),
+				Value:     json.RawMessage(`"acme"`),
+			},
+			wantRemaining: true,
+		},
+		{
+			name: "replace attribute",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeReplace,
+				Path:      test.Must(filter.ParsePath("urn:acme:scim:User:badge")),
+				Value:     json.RawMessage(`"B-2"`),
+			},
+			wantExtension: ScimCustomExtension{
+				"badge": json.RawMessage(`"B-2"`),
+				"floor": json.RawMessage(`3`),
+			},
+			wantMetadataChanges: map[metadata.Key]*domain.Metadata{
+				badgeKey: {Key: string(badgeKey), Value: []byte("B-2")},
+			},
+		},
+		{
+			name: "replace unknown attribute",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeReplace,
+				Path:      test.Must(filter.ParsePath("urn:acme:scim:User:room")),
+				Value:     json.RawMessage(`"B-2"`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "replace attribute invalid value",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeReplace,
+				Path:      test.Must(filter.ParsePath("urn:acme:scim:User:floor")),
+				Value:     json.RawMessage(`"third"`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "remove attribute",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeRemove,
+				Path:      test.Must(filter.ParsePath("urn:acme:scim:User:floor")),
+			},
+			wantExtension: ScimCustomExtension{
+				"badge": json.RawMessage(`"A-1"`),
+			},
+			wantMetadataKeysToRemove: map[metadata.Key]bool{
+				floorKey: true,
+			},
+		},
+		{
+			name: "add extension",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeAdd,
+				Path:      test.Must(filter.ParsePath("urn:acme:scim:User")),
+				Value:     json.RawMessage(`{"remote":true}`),
+			},
+			wantExtension: ScimCustomExtension{
+				"badge":  json.RawMessage(`"A-1"`),
+				"floor":  json.RawMessage(`3`),
+				"remote": json.RawMessage(`true`),
+			},
+			wantMetadataChanges: map[metadata.Key]*domain.Metadata{
+				remoteKey: {Key: string(remoteKey), Value: []byte("true")},
+			},
+		},
+		{
+			name: "replace extension",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeReplace,
+				Path:      test.Must(filter.ParsePath("urn:acme:scim:User")),
+				Value:     json.RawMessage(`{"floor":4}`),
+			},
+			wantExtension: ScimCustomExtension{
+				"floor": json.RawMessage(`4`),
+			},
+			wantMetadataChanges: map[metadata.Key]*domain.Metadata{
+				floorKey: {Key: string(floorKey), Value: []byte("4")},
+			},
+			wantMetadataKeysToRemove: map[metadata.Key]bool{
+				badgeKey:  true,
+				remoteKey: true,
+			},
+		},
+		{
+			name: "add without path",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeAdd,
+				Value:     json.RawMessage(`{"nickName":"acme","urn:acme:scim:User:remote":false}`),
+			},
+			wantRemaining: true,
+			wantExtension: ScimCustomExtension{
+				"badge":  json.RawMessage(`"A-1"`),
+				"floor":  json.RawMessage(`3`),
+				"remote": json.RawMessage(`false`),
+			},
+			wantMetadataChanges: map[metadata.Key]*domain.Metadata{
+				remoteKey: {Key: string(remoteKey), Value: []byte("false")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &userPatcher{
+				ctx: testCustomSchemaContext(t),
+				user: &ScimUser{
+					CustomExtensions: map[schemas.ScimSchemaType]ScimCustomExtension{
+						testCustomSchemaID: {
+							"badge": json.RawMessage(`"A-1"`),
+							"floor": json.RawMessage(`3`),
+						},
+					},
+				},
+				metadataChanges:      make(map[metadata.Key]*domain.Metadata),
+				metadataKeysToRemove: make(map[metadata.Key]bool),
+			}
+
+			remaining, err := p.applyCustomSchemaPatches(patch.OperationCollection{tt.op})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			if tt.wantRemaining {
+				assert.Len(t, remaining, 1)
+				return
+			}
+
+			assert.Empty(t, remaining)
+			assert.Equal(t, tt.wantExtension, p.user.CustomExtensions[testCustomSchemaID])
+			assert.Equal(t, len(tt.wantMetadataChanges), len(p.metadataChanges))
+			for key, md := range tt.wantMetadataChanges {
+				assert.Equal(t, md, p.metadataChanges[key])
+			}
+			assert.Equal(t, len(tt.wantMetadataKeysToRemove), len(p.metadataKeysToRemove))
+			for key := range tt.wantMetadataKeysToRemove {
+				assert.True(t, p.metadataKeysToRemove[key])
+			}
+		})
+	}
+}
@@ -200,7 +200,9 @@ func (h *UsersHandler) mapPrimaryPhone(scimUser *ScimUser) *command.Phone {
 func (h *UsersHandler) mapAddCommandToScimUser(ctx context.Context, user *ScimUser, addHuman *command.AddHuman) {
 	user.ID = addHuman.Details.ID
 	user.Resource = buildResource(ctx, h, addHuman.Details)
+	user.Resource.Schemas = append(user.Resource.Schemas, user.extensionSchemas()...)
 	user.Password = nil
+	mapEnterpriseManagerRef(ctx, user)
 
 	// ZITADEL supports only one (primary) phone number or email.
 	// Therefore, only the primary one should be returned.
@@ -227,7 +229,9 @@ func (h *UsersHandler) mapAddCommandToScimUser(ctx context.Context, user *ScimUs
 func (h *UsersHandler) mapChangeCommandToScimUser(ctx context.Context, user *ScimUser, changeHuman *command.ChangeHuman) {
 	user.ID = changeHuman.Details.ID
 	user.Resource = buildResource(ctx, h, changeHuman.Details)
+	user.Resource.Schemas = append(user.Resource.Schemas, user.extensionSchemas()...)
 	user.Password = nil
+	mapEnterpriseManagerRef(ctx, user)
 
 	// ZITADEL supports only one (primary) phone number or email.
 	// Therefore, only the primary one should be returned.
@@ -251,6 +255,20 @@ func (h *UsersHandler) mapChangeCommandToScimUser(ctx context.Context, user *Sci
 	}
 }
 
+// mapEnterpriseManagerRef sets the read-only reference to the manager of the user.
+func mapEnterpriseManagerRef(ctx context.Context, user *ScimUser) {
+	if user.EnterpriseUser == nil || user.EnterpriseUser.Manager == nil {
+		return
+	}
+
+	if user.EnterpriseUser.Manager.Value == "" {
+		user.EnterpriseUser.Manager = nil
+		return
+	}
+
+	user.EnterpriseUser.Manager.Ref = schemas.BuildLocationForResource(ctx, schemas.UsersResourceType, user.EnterpriseUser.Manager.Value)
+}
+
 func (h *UsersHandler) mapToScimUsers(ctx context.Context, users []*query.User, md map[string]map[metadata.ScopedKey][]byte) []*ScimUser {
 	result := make([]*ScimUser, len(users))
 	for i, user := range users {
@@ -391,6 +409,32 @@ func (h *UsersHandler) mapAndValidateMetadata(ctx context.Context, user *ScimUse
 	if err := extractJsonMetadata(ctx, md, metadata.KeyEmails, &user.Emails); err != nil {
 		logging.OnError(err).Warn("Could not deserialize scim emails metadata")
 	}
+
+	user.EnterpriseUser = extractEnterpriseMetadata(ctx, md)
+	extractCustomSchemasMetadata(ctx, user, md)
+	user.Resource.Schemas = append(user.Resource.Schemas, user.extensionSchemas()...)
+}
+
+func extractEnterpriseMetadata(ctx context.Context, md map[metadata.ScopedKey][]byte) *ScimEnterpriseUser {
+	enterpriseUser := &ScimEnterpriseUser{
+		EmployeeNumber: extractScalarMetadata(ctx, md, metadata.KeyEnterpriseEmployeeNumber),
+		CostCenter:     extractScalarMetadata(ctx, md, metadata.KeyEnterpriseCostCenter),
+		Organization:   extractScalarMetadata(ctx, md, metadata.KeyEnterpriseOrganization),
+		Division:       extractScalarMetadata(ctx, md, metadata.KeyEnterpriseDivision),
+		Department:     extractScalarMetadata(ctx, md, metadata.KeyEnterpriseDepartment),
+	}
+
+	if managerID := extractScalarMetadata(ctx, md, metadata.KeyEnterpriseManager); managerID != "" {
+		enterpriseUser.Manager = &ScimEnterpriseUserManager{
+			Value: managerID,
+			Ref:   schemas.BuildLocationForResource(ctx, schemas.UsersResourceType, managerID),
+		}
+	}
+
+	if *enterpriseUser == (ScimEnterpriseUser{}) {
+		return nil
+	}
+	return enterpriseUser
 }
 
 func (h *UsersHandler) buildResourceForQuery(ctx context.Context, user *query.User) *schemas.Resource {
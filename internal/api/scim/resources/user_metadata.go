@@ -21,7 +21,7 @@ import (
 )
 
 func (h *UsersHandler) queryMetadataForUsers(ctx context.Context, userIds []string) (map[string]map[metadata.ScopedKey][]byte, error) {
-	queries := BuildMetadataQueries(ctx, metadata.ScimUserRelevantMetadataKeys)
+	queries := BuildMetadataQueries(ctx, scimUserMetadataKeys(ctx))
 
 	md, err := h.query.SearchUserMetadataForUsers(ctx, false, userIds, queries)
 	if err != nil {
@@ -43,7 +43,7 @@ func (h *UsersHandler) queryMetadataForUsers(ctx context.Context, userIds []stri
 }
 
 func (h *UsersHandler) queryMetadataForUser(ctx context.Context, id string) (map[metadata.ScopedKey][]byte, error) {
-	queries := BuildMetadataQueries(ctx, metadata.ScimUserRelevantMetadataKeys)
+	queries := BuildMetadataQueries(ctx, scimUserMetadataKeys(ctx))
 
 	md, err := h.query.SearchUserMetadata(ctx, false, id, queries, nil)
 	if err != nil {
@@ -95,6 +95,22 @@ func (h *UsersHandler) mapMetadataToDomain(ctx context.Context, user *ScimUser)
 		}
 	}
 
+	customMetadata, err := mapCustomSchemasToMetadata(ctx, user)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range customMetadata {
+		if len(entry.value) > 0 {
+			md = append(md, &domain.Metadata{
+				Key:   string(entry.key),
+				Value: entry.value,
+			})
+		} else {
+			skippedMetadata = append(skippedMetadata, string(entry.key))
+		}
+	}
+
 	return
 }
 
@@ -114,6 +130,20 @@ func (h *UsersHandler) mapMetadataToCommands(ctx context.Context, user *ScimUser
 		}
 	}
 
+	customMetadata, err := mapCustomSchemasToMetadata(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range customMetadata {
+		if len(entry.value) > 0 {
+			md = append(md, &command.AddMetadataEntry{
+				Key:   string(entry.key),
+				Value: entry.value,
+			})
+		}
+	}
+
 	return md, nil
 }
 
@@ -155,6 +185,12 @@ func getValueForMetadataKey(user *ScimUser, key metadata.Key) ([]byte, error) {
 		metadata.KeyHonorificSuffix,
 		metadata.KeyMiddleName,
 		metadata.KeyExternalId,
+		metadata.KeyEnterpriseEmployeeNumber,
+		metadata.KeyEnterpriseCostCenter,
+		metadata.KeyEnterpriseOrganization,
+		metadata.KeyEnterpriseDivision,
+		metadata.KeyEnterpriseDepartment,
+		metadata.KeyEnterpriseManager,
 		metadata.KeyProvisioningDomain:
 		valueStr := value.(string)
 		if valueStr == "" {
@@ -226,6 +262,13 @@ func getRawValueForMetadataKey(user *ScimUser, key metadata.Key) interface{} {
 		return user.Timezone
 	case metadata.KeyEmails:
 		return user.Emails
+	case metadata.KeyEnterpriseEmployeeNumber,
+		metadata.KeyEnterpriseCostCenter,
+		metadata.KeyEnterpriseOrganization,
+		metadata.KeyEnterpriseDivision,
+		metadata.KeyEnterpriseDepartment,
+		metadata.KeyEnterpriseManager:
+		return getRawEnterpriseValueForMetadataKey(user.EnterpriseUser, key)
 	case metadata.KeyProvisioningDomain:
 		break
 	}
@@ -234,6 +277,33 @@ func getRawValueForMetadataKey(user *ScimUser, key metadata.Key) interface{} {
 	return nil
 }
 
+func getRawEnterpriseValueForMetadataKey(user *ScimEnterpriseUser, key metadata.Key) string {
+	if user == nil {
+		return ""
+	}
+
+	//nolint:exhaustive
+	switch key {
+	case metadata.KeyEnterpriseEmployeeNumber:
+		return user.EmployeeNumber
+	case metadata.KeyEnterpriseCostCenter:
+		return user.CostCenter
+	case metadata.KeyEnterpriseOrganization:
+		return user.Organization
+	case metadata.KeyEnterpriseDivision:
+		return user.Division
+	case metadata.KeyEnterpriseDepartment:
+		return user.Department
+	case metadata.KeyEnterpriseManager:
+		if user.Manager == nil {
+			return ""
+		}
+		return user.Manager.Value
+	}
+
+	return ""
+}
+
 func extractScalarMetadata(ctx context.Context, md map[metadata.ScopedKey][]byte, key metadata.Key) string {
 	val, ok := md[metadata.ScopeKey(ctx, key)]
 	if !ok {
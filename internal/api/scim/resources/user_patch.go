@@ -28,7 +28,20 @@ func (h *UsersHandler) applyPatchesToChangeHuman(ctx context.Context, user *Scim
 		handler:              h,
 	}
 
-	if err := operations.Apply(patcher, user); err != nil {
+	// ensure the attributes of the enterprise extension can be resolved by patch paths
+	if user.EnterpriseUser == nil {
+		user.EnterpriseUser = new(ScimEnterpriseUser)
+	}
+	if user.EnterpriseUser.Manager == nil {
+		user.EnterpriseUser.Manager = new(ScimEnterpriseUserManager)
+	}
+
+	operations, err := patcher.applyCustomSchemaPatches(operations)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = operations.Apply(patcher, user); err != nil {
 		return nil, err
 	}
 
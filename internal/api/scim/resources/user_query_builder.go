@@ -2,10 +2,12 @@ package resources
 
 import (
 	"context"
+	"strings"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/api/scim/metadata"
 	"github.com/zitadel/zitadel/internal/api/scim/resources/filter"
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
 	"github.com/zitadel/zitadel/internal/api/scim/serrors"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
@@ -57,6 +59,40 @@ var fieldPathColumnMapping = filter.FieldPathMapping{
 		FieldType:        filter.FieldTypeCustom,
 		BuildMappedQuery: newMetadataQueryBuilder(metadata.KeyExternalId),
 	},
+	enterpriseFieldPath("employeenumber"): {
+		FieldType:        filter.FieldTypeCustom,
+		BuildMappedQuery: newMetadataQueryBuilder(metadata.KeyEnterpriseEmployeeNumber),
+	},
+	enterpriseFieldPath("costcenter"): {
+		FieldType:        filter.FieldTypeCustom,
+		BuildMappedQuery: newMetadataQueryBuilder(metadata.KeyEnterpriseCostCenter),
+	},
+	enterpriseFieldPath("organization"): {
+		FieldType:        filter.FieldTypeCustom,
+		BuildMappedQuery: newMetadataQueryBuilder(metadata.KeyEnterpriseOrganization),
+	},
+	enterpriseFieldPath("division"): {
+		FieldType:        filter.FieldTypeCustom,
+		BuildMappedQuery: newMetadataQueryBuilder(metadata.KeyEnterpriseDivision),
+	},
+	enterpriseFieldPath("department"): {
+		FieldType:        filter.FieldTypeCustom,
+		BuildMappedQuery: newMetadataQueryBuilder(metadata.KeyEnterpriseDepartment),
+	},
+	enterpriseFieldPath("manager"): {
+		FieldType:        filter.FieldTypeCustom,
+		BuildMappedQuery: newMetadataQueryBuilder(metadata.KeyEnterpriseManager),
+	},
+	enterpriseFieldPath("manager.value"): {
+		FieldType:        filter.FieldTypeCustom,
+		BuildMappedQuery: newMetadataQueryBuilder(metadata.KeyEnterpriseManager),
+	},
+}
+
+// enterpriseFieldPath returns the field path of an attribute of the enterprise user extension,
+// the attributes of an extension are nested under the lowercase id of the extension.
+func enterpriseFieldPath(attribute string) string {
+	return strings.ToLower(string(schemas.IdEnterpriseUser)) + "." + attribute
 }
 
 func (h *UsersHandler) buildListQuery(ctx context.Context, request *ListRequest) (*query.UserSearchQueries, error) {
@@ -88,7 +124,17 @@ func (h *UsersHandler) buildListQuery(ctx context.Context, request *ListRequest)
 		return q, nil
 	}
 
-	filterQuery, err := request.Filter.BuildQuery(ctx, h.schema.ID, fieldPathColumnMapping)
+	customSchemas := customUserSchemas(ctx)
+	fieldPathMapping := fieldPathColumnMapping
+	extensions := h.schema.ExtensionIDs()
+	if len(customSchemas) > 0 {
+		fieldPathMapping = buildCustomSchemasFieldPathMapping(customSchemas)
+		for _, schema := range customSchemas {
+			extensions = append(extensions, schema.ID)
+		}
+	}
+
+	filterQuery, err := request.Filter.BuildQuery(ctx, h.schema.ID, fieldPathMapping, extensions...)
 	if err != nil {
 		return nil, err
 	}
@@ -108,6 +154,11 @@ func buildMetadataQuery(ctx context.Context, key metadata.Key, value *filter.Com
 		return nil, serrors.ThrowInvalidFilter(zerrors.ThrowInvalidArgument(nil, "SCIM-EXid1", "invalid filter expression: unsupported comparison value"))
 	}
 
+	scopedKey := string(metadata.ScopeKey(ctx, key))
+	return buildMetadataValueQuery(scopedKey, []byte(*value.StringValue), op)
+}
+
+func buildMetadataValueQuery(scopedKey string, value []byte, op *filter.CompareOp) (query.SearchQuery, error) {
 	var comparisonOperator query.BytesComparison
 
 	switch {
@@ -119,8 +170,7 @@ func buildMetadataQuery(ctx context.Context, key metadata.Key, value *filter.Com
 		return nil, serrors.ThrowInvalidFilter(zerrors.ThrowInvalidArgument(nil, "SCIM-EXid1", "invalid filter expression: unsupported comparison operator"))
 	}
 
-	scopedKey := string(metadata.ScopeKey(ctx, key))
-	return query.NewUserMetadataExistsQuery(scopedKey, []byte(*value.StringValue), query.TextEquals, comparisonOperator)
+	return query.NewUserMetadataExistsQuery(scopedKey, value, query.TextEquals, comparisonOperator)
 }
 
 func buildActiveUserStateQuery(_ context.Context, compareValue *filter.CompValue, op *filter.CompareOp) (query.SearchQuery, error) {
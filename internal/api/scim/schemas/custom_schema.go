@@ -0,0 +1,68 @@
+package schemas
+
+import (
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+// CustomSchemaMetadataKeyPrefix prefixes the organization metadata keys holding the definitions of custom schema extensions,
+// the id of the schema is appended to the prefix.
+const CustomSchemaMetadataKeyPrefix = domain.ScimCustomSchemaMetadataKeyPrefix
+
+// ValidateCustomSchemaMetadata validates the definition of a custom schema extension before it is stored as organization metadata.
+// Metadata with other keys is not validated.
+func ValidateCustomSchemaMetadata(key string, value []byte) error {
+	return domain.ValidateScimCustomSchemaMetadata(key, value)
+}
+
+// ParseCustomSchema parses and validates the json encoded definition of a custom schema extension.
+func ParseCustomSchema(id ScimSchemaType, data []byte) (*ResourceSchema, error) {
+	definition, err := domain.ParseScimCustomSchemaDefinition(string(id), data)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make([]*SchemaAttribute, len(definition.Attributes))
+	for i, attribute := range definition.Attributes {
+		attributes[i] = &SchemaAttribute{
+			Name:        attribute.Name,
+			Description: attribute.Description,
+			Type:        SchemaAttributeType(attribute.Type),
+			Required:    attribute.Required,
+			CaseExact:   attribute.CaseExact,
+			Mutability:  SchemaAttributeMutabilityReadWrite,
+			Returned:    SchemaAttributeReturnedAlways,
+			Uniqueness:  SchemaAttributeUniquenessNone,
+		}
+	}
+
+	return &ResourceSchema{
+		Resource: &Resource{
+			Schemas: []ScimSchemaType{IdSchema},
+			ID:      string(id),
+			Meta: &ResourceMeta{
+				ResourceType: SchemaResourceType,
+			},
+		},
+		ID:          id,
+		Name:        ScimResourceTypeSingular(definition.Name),
+		Description: definition.Description,
+		Attributes:  attributes,
+	}, nil
+}
+
+// IsBuiltInSchema returns true if the id matches a schema provided by ZITADEL itself.
+func IsBuiltInSchema(id ScimSchemaType) bool {
+	return domain.IsScimBuiltInSchema(string(id))
+}
+
+// Attribute returns the attribute with the provided name (case-insensitive) or nil if none matches.
+func (s *ResourceSchema) Attribute(name string) *SchemaAttribute {
+	for _, attribute := range s.Attributes {
+		if strings.EqualFold(attribute.Name, name) {
+			return attribute
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCustomSchema(t *testing.T) {
+	tests := []struct {
+		name           string
+		id             ScimSchemaType
+		data           string
+		wantAttributes []*SchemaAttribute
+		wantErr        bool
+	}{
+		{
+			name:    "no urn",
+			id:      "acme:User",
+			data:    `{"name":"AcmeUser","attributes":[{"name":"badge","type":"string"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "core schema",
+			id:      IdUser,
+			data:    `{"name":"AcmeUser","attributes":[{"name":"badge","type":"string"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "enterprise schema",
+			id:      IdEnterpriseUser,
+			data:    `{"name":"AcmeUser","attributes":[{"name":"badge","type":"string"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			id:      "urn:acme:scim:User",
+			data:    `{"name":`,
+			wantErr: true,
+		},
+		{
+			name:    "no attributes",
+			id:      "urn:acme:scim:User",
+			data:    `{"name":"AcmeUser","attributes":[]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid attribute name",
+			id:      "urn:acme:scim:User",
+			data:    `{"name":"AcmeUser","attributes":[{"name":"badge.id","type":"string"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "duplicate attribute name",
+			id:      "urn:acme:scim:User",
+			data:    `{"name":"AcmeUser","attributes":[{"name":"badge","type":"string"},{"name":"Badge","type":"integer"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "complex attribute",
+			id:      "urn:acme:scim:User",
+			data:    `{"name":"AcmeUser","attributes":[{"name":"badge","type":"complex"}]}`,
+			wantErr: true,
+		},
+		{
+			name: "valid",
+			id:   "urn:acme:scim:User",
+			data: `{"name":"AcmeUser","description":"Acme","attributes":[{"name":"badge","type":"string","required":true},{"name":"floor","type":"integer"}]}`,
+			wantAttributes: []*SchemaAttribute{
+				{
+					Name:       "badge",
+					Type:       SchemaAttributeTypeString,
+					Required:   true,
+					Mutability: SchemaAttributeMutabilityReadWrite,
+					Returned:   SchemaAttributeReturnedAlways,
+					Uniqueness: SchemaAttributeUniquenessNone,
+				},
+				{
+					Name:       "floor",
+					Type:       SchemaAttributeTypeInteger,
+					Mutability: SchemaAttributeMutabilityReadWrite,
+					Returned:   SchemaAttributeReturnedAlways,
+					Uniqueness: SchemaAttributeUniquenessNone,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCustomSchema(tt.id, []byte(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.id, got.ID)
+			assert.Equal(t, string(tt.id), got.Resource.ID)
+			assert.Equal(t, tt.wantAttributes, got.Attributes)
+			assert.Equal(t, "floor", got.Attribute("FLOOR").Name)
+		})
+	}
+}
+
+func TestValidateCustomSchemaMetadata(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		value   string
+		wantErr bool
+	}{
+		{
+			name:  "other metadata",
+			key:   "urn:zitadel:scim:externalId",
+			value: "not a schema",
+		},
+		{
+			name:    "invalid definition",
+			key:     CustomSchemaMetadataKeyPrefix + "urn:acme:scim:User",
+			value:   `{"name":"AcmeUser","attributes":[{"name":"badge","type":"complex"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "built in schema",
+			key:     CustomSchemaMetadataKeyPrefix + string(IdUser),
+			value:   `{"name":"AcmeUser","attributes":[{"name":"badge","type":"string"}]}`,
+			wantErr: true,
+		},
+		{
+			name:  "valid definition",
+			key:   CustomSchemaMetadataKeyPrefix + "urn:acme:scim:User",
+			value: `{"name":"AcmeUser","attributes":[{"name":"badge","type":"string"}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCustomSchemaMetadata(tt.key, []byte(tt.value))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -16,6 +16,7 @@ type SchemaBuilderArgs struct {
 	EndpointName ScimResourceTypePlural
 	Description  string
 	Resource     any
+	Extensions   []*ResourceSchema
 }
 
 type fieldSchemaInfo struct {
@@ -23,6 +24,7 @@ type fieldSchemaInfo struct {
 	Required  bool
 	CaseExact bool
 	Unique    bool
+	ReadOnly  bool
 }
 
 var (
@@ -46,6 +48,7 @@ func BuildSchema(args SchemaBuilderArgs) *ResourceSchema {
 		PluralName:  args.EndpointName,
 		Description: args.Description,
 		Attributes:  buildSchemaAttributes(reflect.TypeOf(args.Resource)),
+		Extensions:  args.Extensions,
 	}
 }
 
@@ -99,6 +102,10 @@ func buildAttribute(field reflect.StructField) *SchemaAttribute {
 		attribute.Mutability = SchemaAttributeMutabilityWriteOnly
 	}
 
+	if info.ReadOnly {
+		attribute.Mutability = SchemaAttributeMutabilityReadOnly
+	}
+
 	if info.Unique {
 		attribute.Uniqueness = SchemaAttributeUniquenessServer
 	}
@@ -162,6 +169,7 @@ func getFieldSchemaInfo(field reflect.StructField) *fieldSchemaInfo {
 		Required:  slices.Contains(tagOptions, "required"),
 		CaseExact: !slices.Contains(tagOptions, "caseInsensitive"),
 		Unique:    slices.Contains(tagOptions, "unique"),
+		ReadOnly:  slices.Contains(tagOptions, "readOnly"),
 	}
 }
 
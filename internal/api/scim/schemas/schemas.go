@@ -17,8 +17,10 @@ const (
 	idPrefixMessages        = "urn:ietf:params:scim:api:messages:2.0:"
 	idPrefixCore            = "urn:ietf:params:scim:schemas:core:2.0:"
 	idPrefixZitadelMessages = "urn:ietf:params:scim:api:zitadel:messages:2.0:"
+	idPrefixExtension       = "urn:ietf:params:scim:schemas:extension:"
 
 	IdUser                  ScimSchemaType = idPrefixCore + "User"
+	IdEnterpriseUser        ScimSchemaType = idPrefixExtension + "enterprise:2.0:User"
 	IdServiceProviderConfig ScimSchemaType = idPrefixCore + "ServiceProviderConfig"
 	IdResourceType          ScimSchemaType = idPrefixCore + "ResourceType"
 	IdSchema                ScimSchemaType = idPrefixCore + "Schema"
@@ -33,6 +35,8 @@ const (
 	UserResourceType  ScimResourceTypeSingular = "User"
 	UsersResourceType ScimResourceTypePlural   = "Users"
 
+	EnterpriseUserResourceType ScimResourceTypeSingular = "EnterpriseUser"
+
 	ServiceProviderConfigResourceType  ScimResourceTypeSingular = "ServiceProviderConfig"
 	ServiceProviderConfigsResourceType ScimResourceTypePlural   = "ServiceProviderConfig"
 
@@ -60,11 +64,17 @@ type ResourceMeta struct {
 
 type ResourceType struct {
 	*Resource
-	ID          ScimResourceTypeSingular `json:"id"`
-	Name        ScimResourceTypeSingular `json:"name"`
-	Endpoint    ScimResourceTypePlural   `json:"endpoint"`
-	Schema      ScimSchemaType           `json:"schema"`
-	Description string                   `json:"description"`
+	ID               ScimResourceTypeSingular       `json:"id"`
+	Name             ScimResourceTypeSingular       `json:"name"`
+	Endpoint         ScimResourceTypePlural         `json:"endpoint"`
+	Schema           ScimSchemaType                 `json:"schema"`
+	SchemaExtensions []*ResourceTypeSchemaExtension `json:"schemaExtensions,omitempty"`
+	Description      string                         `json:"description"`
+}
+
+type ResourceTypeSchemaExtension struct {
+	Schema   ScimSchemaType `json:"schema"`
+	Required bool           `json:"required"`
 }
 
 type ResourceSchema struct {
@@ -74,6 +84,10 @@ type ResourceSchema struct {
 	PluralName  ScimResourceTypePlural   `json:"-"`
 	Description string                   `json:"description,omitempty"`
 	Attributes  []*SchemaAttribute       `json:"attributes"`
+
+	// Extensions the schema extensions supported by this resource,
+	// these are advertised as schemaExtensions of the resource type.
+	Extensions []*ResourceSchema `json:"-"`
 }
 
 type SchemaAttribute struct {
@@ -105,6 +119,7 @@ type SchemaAttributeMutability string
 const (
 	SchemaAttributeMutabilityReadWrite SchemaAttributeMutability = "readWrite"
 	SchemaAttributeMutabilityWriteOnly SchemaAttributeMutability = "writeOnly"
+	SchemaAttributeMutabilityReadOnly  SchemaAttributeMutability = "readOnly"
 )
 
 type SchemaAttributeReturned string
@@ -137,7 +152,15 @@ func (s *ResourceSchema) GetResource() *Resource {
 	return s.Resource
 }
 
-func (s *ResourceSchema) ToResourceType(ctx context.Context, orgID string) *ResourceType {
+func (s *ResourceSchema) ToResourceType(ctx context.Context, orgID string, additionalExtensions ...*ResourceSchema) *ResourceType {
+	extensions := make([]*ResourceTypeSchemaExtension, 0, len(s.Extensions)+len(additionalExtensions))
+	for _, extension := range s.Extensions {
+		extensions = append(extensions, &ResourceTypeSchemaExtension{Schema: extension.ID})
+	}
+	for _, extension := range additionalExtensions {
+		extensions = append(extensions, &ResourceTypeSchemaExtension{Schema: extension.ID})
+	}
+
 	return &ResourceType{
 		Resource: &Resource{
 			Schemas: []ScimSchemaType{IdResourceType},
@@ -147,12 +170,22 @@ func (s *ResourceSchema) ToResourceType(ctx context.Context, orgID string) *Reso
 				Location:     BuildLocationWithOrg(ctx, orgID, ResourceTypesResourceType, string(s.Name)),
 			},
 		},
-		ID:          s.Name,
-		Name:        s.Name,
-		Endpoint:    s.PluralName,
-		Schema:      s.ID,
-		Description: s.Description,
+		ID:               s.Name,
+		Name:             s.Name,
+		Endpoint:         s.PluralName,
+		Schema:           s.ID,
+		SchemaExtensions: extensions,
+		Description:      s.Description,
+	}
+}
+
+// ExtensionIDs returns the ids of all extensions of the schema.
+func (s *ResourceSchema) ExtensionIDs() []ScimSchemaType {
+	ids := make([]ScimSchemaType, len(s.Extensions))
+	for i, extension := range s.Extensions {
+		ids[i] = extension.ID
 	}
+	return ids
 }
 
 func BuildLocationForResource(ctx context.Context, resourceName ScimResourceTypePlural, id string) string {
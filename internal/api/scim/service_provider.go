@@ -16,6 +16,7 @@ import (
 
 type serviceProviderHandler struct {
 	config                *scim_config.Config
+	query                 *query.Queries
 	schemas               []*sschemas.ResourceSchema
 	schemasByID           map[sschemas.ScimSchemaType]*sschemas.ResourceSchema
 	schemasByResourceName map[sschemas.ScimResourceTypeSingular]*sschemas.ResourceSchema
@@ -55,7 +56,7 @@ var (
 	}
 )
 
-func newServiceProviderHandler(cfg *scim_config.Config, handlers ...sresources.RawResourceHandlerAdapter) *serviceProviderHandler {
+func newServiceProviderHandler(cfg *scim_config.Config, query *query.Queries, handlers ...sresources.RawResourceHandlerAdapter) *serviceProviderHandler {
 	schemas := make([]*sschemas.ResourceSchema, len(handlers))
 	schemasByID := make(map[sschemas.ScimSchemaType]*sschemas.ResourceSchema, len(handlers))
 	schemasByResourceName := make(map[sschemas.ScimResourceTypeSingular]*sschemas.ResourceSchema, len(handlers))
@@ -64,10 +65,14 @@ func newServiceProviderHandler(cfg *scim_config.Config, handlers ...sresources.R
 		schemas[i] = schema
 		schemasByID[schema.ID] = schema
 		schemasByResourceName[schema.Name] = schema
+		for _, extension := range schema.Extensions {
+			schemasByID[extension.ID] = extension
+		}
 	}
 
 	return &serviceProviderHandler{
 		config:                cfg,
+		query:                 query,
 		schemas:               schemas,
 		schemasByID:           schemasByID,
 		schemasByResourceName: schemasByResourceName,
@@ -116,9 +121,14 @@ func (h *serviceProviderHandler) ListResourceTypes(r *http.Request) (*sresources
 	ctx := r.Context()
 	orgID := mux.Vars(r)[zhttp.OrgIdInPathVariableName]
 
+	customUserSchemas, err := sresources.QueryCustomUserSchemas(ctx, h.query, orgID)
+	if err != nil {
+		return nil, err
+	}
+
 	resourceTypes := make([]*sschemas.ResourceType, len(h.schemas))
 	for i, schema := range h.schemas {
-		resourceTypes[i] = schema.ToResourceType(ctx, orgID)
+		resourceTypes[i] = toResourceType(ctx, orgID, schema, customUserSchemas)
 	}
 
 	return sresources.NewListResponse(uint64(len(resourceTypes)), defaultConfigSearchRequest, resourceTypes), nil
@@ -136,7 +146,22 @@ func (h *serviceProviderHandler) GetResourceType(r *http.Request) (*sschemas.Res
 		return nil, zerrors.ThrowNotFoundf(nil, "SCIMSP-148z", "Scim resource type %s not found", name)
 	}
 
-	return schema.ToResourceType(ctx, orgID), nil
+	customUserSchemas, err := sresources.QueryCustomUserSchemas(ctx, h.query, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toResourceType(ctx, orgID, schema, customUserSchemas), nil
+}
+
+// toResourceType builds the resource type of the schema,
+// the custom schemas of the organization are advertised as extensions of the user resource type.
+func toResourceType(ctx context.Context, orgID string, schema *sschemas.ResourceSchema, customUserSchemas []*sschemas.ResourceSchema) *sschemas.ResourceType {
+	if schema.ID != sschemas.IdUser {
+		return schema.ToResourceType(ctx, orgID)
+	}
+
+	return schema.ToResourceType(ctx, orgID, customUserSchemas...)
 }
 
 func (h *serviceProviderHandler) ListSchemas(r *http.Request) (*sresources.ListResponse[*sschemas.ResourceSchema], error) {
@@ -144,12 +169,24 @@ func (h *serviceProviderHandler) ListSchemas(r *http.Request) (*sresources.ListR
 	ctx := r.Context()
 	orgID := mux.Vars(r)[zhttp.OrgIdInPathVariableName]
 
-	schemas := make([]*sschemas.ResourceSchema, len(h.schemas))
-	for i, schema := range h.schemas {
-		schemas[i] = buildSchema(ctx, orgID, schema)
+	customUserSchemas, err := sresources.QueryCustomUserSchemas(ctx, h.query, orgID)
+	if err != nil {
+		return nil, err
 	}
 
-	return sresources.NewListResponse(uint64(len(h.schemas)), defaultConfigSearchRequest, schemas), nil
+	schemas := make([]*sschemas.ResourceSchema, 0, len(h.schemasByID)+len(customUserSchemas))
+	for _, schema := range h.schemas {
+		schemas = append(schemas, buildSchema(ctx, orgID, schema))
+		for _, extension := range schema.Extensions {
+			schemas = append(schemas, buildSchema(ctx, orgID, extension))
+		}
+	}
+
+	for _, schema := range customUserSchemas {
+		schemas = append(schemas, buildSchema(ctx, orgID, schema))
+	}
+
+	return sresources.NewListResponse(uint64(len(schemas)), defaultConfigSearchRequest, schemas), nil
 }
 
 func (h *serviceProviderHandler) GetSchema(r *http.Request) (*sschemas.ResourceSchema, error) {
@@ -159,12 +196,22 @@ func (h *serviceProviderHandler) GetSchema(r *http.Request) (*sschemas.ResourceS
 	orgID := vars[zhttp.OrgIdInPathVariableName]
 	id := sschemas.ScimSchemaType(vars["id"])
 
-	schema, ok := h.schemasByID[id]
-	if !ok {
-		return nil, zerrors.ThrowNotFoundf(nil, "SCIMSP-148y", "Scim schema %s not found", id)
+	if schema, ok := h.schemasByID[id]; ok {
+		return buildSchema(ctx, orgID, schema), nil
+	}
+
+	customUserSchemas, err := sresources.QueryCustomUserSchemas(ctx, h.query, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, schema := range customUserSchemas {
+		if schema.ID == id {
+			return buildSchema(ctx, orgID, schema), nil
+		}
 	}
 
-	return buildSchema(ctx, orgID, schema), nil
+	return nil, zerrors.ThrowNotFoundf(nil, "SCIMSP-148y", "Scim schema %s not found", id)
 }
 
 // buildSchema shallow copies the provided schema and sets the correct location based on the provided context information.
@@ -165,13 +165,14 @@ func (l *Login) runPostExternalAuthenticationActions(
 type authMethod string
 
 const (
-	authMethodPassword     authMethod = "password"
-	authMethodOTP          authMethod = "OTP"
-	authMethodOTPSMS       authMethod = "OTP SMS"
-	authMethodOTPEmail     authMethod = "OTP Email"
-	authMethodRecoveryCode authMethod = "recovery code"
-	authMethodU2F          authMethod = "U2F"
-	authMethodPasswordless authMethod = "passwordless"
+	authMethodPassword        authMethod = "password"
+	authMethodOTP             authMethod = "OTP"
+	authMethodOTPSMS          authMethod = "OTP SMS"
+	authMethodOTPEmail        authMethod = "OTP Email"
+	authMethodRecoveryCode    authMethod = "recovery code"
+	authMethodU2F             authMethod = "U2F"
+	authMethodPasswordless    authMethod = "passwordless"
+	authMethodX509Certificate authMethod = "X.509 certificate"
 )
 
 func (l *Login) runPostInternalAuthenticationActions(
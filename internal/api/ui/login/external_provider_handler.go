@@ -20,6 +20,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	http_utils "github.com/zitadel/zitadel/internal/api/http"
 	http_mw "github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/domain/federatedlogout"
@@ -36,6 +37,7 @@ import (
 	openid "github.com/zitadel/zitadel/internal/idp/providers/oidc"
 	"github.com/zitadel/zitadel/internal/idp/providers/saml"
 	"github.com/zitadel/zitadel/internal/idp/providers/saml/requesttracker"
+	"github.com/zitadel/zitadel/internal/idp/providers/wsfed"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -44,6 +46,7 @@ const (
 	queryIDPConfigID           = "idpConfigID"
 	queryState                 = "state"
 	queryRelayState            = "RelayState"
+	queryWCtx                  = "wctx"
 	queryMethod                = "method"
 	tmplExternalNotFoundOption = "externalnotfoundoption"
 )
@@ -71,6 +74,10 @@ type externalIDPCallbackData struct {
 	RelayState string `schema:"RelayState"`
 	Method     string `schema:"Method"`
 
+	// WS-Federation returns the state as wctx and the token as wresult
+	WCtx    string `schema:"wctx"`
+	WResult string `schema:"wresult"`
+
 	// Apple returns a user on first registration
 	User string `schema:"user"`
 }
@@ -190,6 +197,8 @@ func (l *Login) handleIDP(w http.ResponseWriter, r *http.Request, authReq *domai
 		provider, err = l.ldapProvider(r.Context(), identityProvider)
 	case domain.IDPTypeSAML:
 		provider, err = l.samlProvider(r.Context(), identityProvider)
+	case domain.IDPTypeWSFederation:
+		provider, err = l.wsFederationProvider(r.Context(), identityProvider)
 	case domain.IDPTypeUnspecified:
 		fallthrough
 	default:
@@ -245,6 +254,9 @@ func (l *Login) handleExternalLoginCallbackForm(w http.ResponseWriter, r *http.R
 	if state == "" {
 		state = r.Form.Get(queryRelayState)
 	}
+	if state == "" {
+		state = r.Form.Get(queryWCtx)
+	}
 	if state == "" {
 		l.externalAuthFailed(w, r, nil, zerrors.ThrowInvalidArgument(nil, "LOGIN-dsg3f", "Errors.AuthRequest.NotFound"))
 		return
@@ -280,6 +292,9 @@ func (l *Login) handleExternalLoginCallback(w http.ResponseWriter, r *http.Reque
 	if data.State == "" {
 		data.State = data.RelayState
 	}
+	if data.State == "" {
+		data.State = data.WCtx
+	}
 
 	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
 	authReq, err := l.authRepo.AuthRequestByID(r.Context(), data.State, userAgentID)
@@ -368,6 +383,13 @@ func (l *Login) handleExternalLoginCallback(w http.ResponseWriter, r *http.Reque
 			l.externalAuthCallbackFailed(w, r, authReq, nil, nil, err)
 			return
 		}
+	case domain.IDPTypeWSFederation:
+		provider, err := l.wsFederationProvider(r.Context(), identityProvider)
+		if err != nil {
+			l.externalAuthCallbackFailed(w, r, authReq, nil, nil, err)
+			return
+		}
+		session = wsfed.NewSession(provider, authReq.ID, data.WCtx, data.WResult, l.caches.wsFederationAssertions)
 	case domain.IDPTypeJWT,
 		domain.IDPTypeLDAP,
 		domain.IDPTypeUnspecified:
@@ -459,6 +481,8 @@ func (l *Login) handleExternalUserAuthenticated(
 	callback func(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest),
 ) {
 	externalUser := mapIDPUserToExternalUser(user, provider.ID)
+	externalUser.Claims = command.IDPSessionUserClaims(session, user)
+	claims := externalUser.Claims
 	// ensure the linked IDP is added to the login policy
 	if err := l.authRepo.SelectExternalIDP(r.Context(), authReq.ID, provider.ID, authReq.AgentID, authReq.SelectedIDPConfigArgs); err != nil {
 		l.renderError(w, r, authReq, err)
@@ -516,6 +540,7 @@ func (l *Login) handleExternalUserAuthenticated(
 			return
 		}
 	}
+	l.command.ApplyIDPClaimMappings(setContext(r.Context(), authReq.UserOrgID), provider.ID, authReq.UserID, claims)
 	callback(w, r, authReq)
 }
 
@@ -786,6 +811,12 @@ func (l *Login) handleExternalNotFoundOptionCheck(w http.ResponseWriter, r *http
 		return
 	}
 	linkingUser := mapExternalNotFoundOptionFormDataToLoginUser(data)
+	// the claims are not part of the form, but of the external user stored on the authentication
+	for _, externalUser := range authReq.LinkingUsers {
+		if externalUser.IDPConfigID == linkingUser.IDPConfigID && externalUser.ExternalUserID == linkingUser.ExternalUserID {
+			linkingUser.Claims = externalUser.Claims
+		}
+	}
 	l.registerExternalUser(w, r, authReq, linkingUser)
 }
 
@@ -829,6 +860,7 @@ func (l *Login) registerExternalUser(w http.ResponseWriter, r *http.Request, aut
 		l.renderError(w, r, authReq, err)
 		return
 	}
+	l.command.ApplyIDPClaimMappings(setContext(r.Context(), resourceOwner), externalUser.IDPConfigID, authReq.UserID, externalUser.Claims)
 	l.renderNextStep(w, r, authReq)
 }
 
@@ -1274,6 +1306,21 @@ func (l *Login) appleProvider(ctx context.Context, identityProvider *query.IDPTe
 	)
 }
 
+func (l *Login) wsFederationProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*wsfed.Provider, error) {
+	opts := make([]wsfed.ProviderOpts, 0, 1)
+	if identityProvider.WSFederationIDPTemplate.IDClaim != "" {
+		opts = append(opts, wsfed.WithIDClaim(identityProvider.WSFederationIDPTemplate.IDClaim))
+	}
+	return wsfed.New(
+		identityProvider.Name,
+		identityProvider.WSFederationIDPTemplate.SSOEndpoint,
+		identityProvider.WSFederationIDPTemplate.Realm,
+		l.baseURL(ctx)+EndpointExternalLoginCallbackFormPost,
+		identityProvider.WSFederationIDPTemplate.SigningCertificate,
+		opts...,
+	)
+}
+
 func (l *Login) appendUserGrants(ctx context.Context, userGrants []*domain.UserGrant, resourceOwner string) error {
 	if len(userGrants) == 0 {
 		return nil
@@ -14,6 +14,11 @@ const (
 func (l *Login) linkUsers(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error) {
 	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
 	err = l.authRepo.LinkExternalUsers(setContext(r.Context(), authReq.UserOrgID), authReq.ID, userAgentID, domain.BrowserInfoFromRequest(r))
+	if err == nil {
+		for _, linkingUser := range authReq.LinkingUsers {
+			l.command.ApplyIDPClaimMappings(setContext(r.Context(), authReq.UserOrgID), linkingUser.IDPConfigID, authReq.UserID, linkingUser.Claims)
+		}
+	}
 	l.renderLinkUsersDone(w, r, authReq, err)
 }
 
@@ -23,6 +23,7 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/domain/federatedlogout"
 	"github.com/zitadel/zitadel/internal/form"
+	"github.com/zitadel/zitadel/internal/idp/providers/wsfed"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/static"
 )
@@ -43,6 +44,11 @@ type Login struct {
 	idpConfigAlg        crypto.EncryptionAlgorithm
 	userCodeAlg         crypto.EncryptionAlgorithm
 	caches              *Caches
+
+	x509ClientCertificateHeader string
+
+	trustedDeviceCookieHandler *http_utils.CookieHandler
+	trustedDeviceCookieName    string
 }
 
 type Config struct {
@@ -50,6 +56,11 @@ type Config struct {
 	CSRFCookieName     string
 	Cache              middleware.CacheConfig
 	AssetCache         middleware.CacheConfig
+	// X509ClientCertificateHeader is the header, in which a TLS terminating proxy passes the URL encoded PEM client certificate.
+	// The proxy must overwrite the header on every request, so it can't be set by the client itself.
+	X509ClientCertificateHeader string
+	// TrustedDeviceCookieName is the name of the cookie, which stores the devices trusted by the users of the user agent.
+	TrustedDeviceCookieName string
 
 	// LoginV2
 	DefaultPaths *DefaultPaths
@@ -109,8 +120,10 @@ func CreateLogin(
 	userAgentCookie, issuerInterceptor, oidcInstanceHandler, samlInstanceHandler, assetCache, accessHandler mux.MiddlewareFunc,
 	userCodeAlg, idpConfigAlg crypto.EncryptionAlgorithm,
 	csrfCookieKey []byte,
+	trustedDeviceCookieKey []byte,
 	cacheConnectors connector.Connectors,
 	federateLogoutCache cache.Cache[federatedlogout.Index, string, *federatedlogout.FederatedLogout],
+	wsFederationAssertionCache cache.Cache[wsfed.UsedAssertionIndex, string, *wsfed.UsedAssertion],
 ) (*Login, error) {
 	login := &Login{
 		oidcAuthCallbackURL: oidcAuthCallbackURL,
@@ -123,6 +136,11 @@ func CreateLogin(
 		authRepo:            authRepo,
 		idpConfigAlg:        idpConfigAlg,
 		userCodeAlg:         userCodeAlg,
+
+		x509ClientCertificateHeader: config.X509ClientCertificateHeader,
+
+		trustedDeviceCookieHandler: newTrustedDeviceCookieHandler(trustedDeviceCookieKey, externalSecure),
+		trustedDeviceCookieName:    config.TrustedDeviceCookieName,
 	}
 	csrfInterceptor := createCSRFInterceptor(config.CSRFCookieName, csrfCookieKey, externalSecure, login.csrfErrorHandler())
 	cacheInterceptor := createCacheInterceptor(config.Cache.MaxAge, config.Cache.SharedMaxAge, assetCache)
@@ -133,7 +151,7 @@ func CreateLogin(
 	login.parser = form.NewParser()
 
 	var err error
-	login.caches, err = startCaches(context.Background(), cacheConnectors, federateLogoutCache)
+	login.caches, err = startCaches(context.Background(), cacheConnectors, federateLogoutCache, wsFederationAssertionCache)
 	if err != nil {
 		return nil, err
 	}
@@ -238,17 +256,19 @@ func (l *Login) baseURL(ctx context.Context) string {
 }
 
 type Caches struct {
-	idpFormCallbacks cache.Cache[idpFormCallbackIndex, string, *idpFormCallback]
-	federatedLogouts cache.Cache[federatedlogout.Index, string, *federatedlogout.FederatedLogout]
+	idpFormCallbacks       cache.Cache[idpFormCallbackIndex, string, *idpFormCallback]
+	federatedLogouts       cache.Cache[federatedlogout.Index, string, *federatedlogout.FederatedLogout]
+	wsFederationAssertions cache.Cache[wsfed.UsedAssertionIndex, string, *wsfed.UsedAssertion]
 }
 
-func startCaches(background context.Context, connectors connector.Connectors, federateLogoutCache cache.Cache[federatedlogout.Index, string, *federatedlogout.FederatedLogout]) (_ *Caches, err error) {
+func startCaches(background context.Context, connectors connector.Connectors, federateLogoutCache cache.Cache[federatedlogout.Index, string, *federatedlogout.FederatedLogout], wsFederationAssertionCache cache.Cache[wsfed.UsedAssertionIndex, string, *wsfed.UsedAssertion]) (_ *Caches, err error) {
 	caches := new(Caches)
 	caches.idpFormCallbacks, err = connector.StartCache[idpFormCallbackIndex, string, *idpFormCallback](background, []idpFormCallbackIndex{idpFormCallbackIndexRequestID}, cache.PurposeIdPFormCallback, connectors.Config.IdPFormCallbacks, connectors)
 	if err != nil {
 		return nil, err
 	}
 	caches.federatedLogouts = federateLogoutCache
+	caches.wsFederationAssertions = wsFederationAssertionCache
 	return caches, nil
 }
 
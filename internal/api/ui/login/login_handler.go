@@ -97,6 +97,8 @@ func (l *Login) renderLogin(w http.ResponseWriter, r *http.Request, authReq *dom
 	}
 	translator := l.getTranslator(r.Context(), authReq)
 	data := l.getUserData(r, authReq, translator, "Login.Title", "Login.Description", err)
+	var hasSAMLDiscovery bool
+	data.IDPProviders, hasSAMLDiscovery = l.withoutSAMLFederationIDPs(r, authReq, data.IDPProviders)
 	funcs := map[string]interface{}{
 		"hasUsernamePasswordLogin": func() bool {
 			return authReq != nil && authReq.LoginPolicy != nil && authReq.LoginPolicy.AllowUsernamePassword
@@ -104,9 +106,15 @@ func (l *Login) renderLogin(w http.ResponseWriter, r *http.Request, authReq *dom
 		"hasExternalLogin": func() bool {
 			return authReq != nil && authReq.LoginPolicy != nil && authReq.LoginPolicy.AllowExternalIDP && authReq.AllowedExternalIDPs != nil && len(authReq.AllowedExternalIDPs) > 0
 		},
+		"hasSAMLDiscovery": func() bool {
+			return hasSAMLDiscovery
+		},
 		"hasRegistration": func() bool {
 			return authReq != nil && authReq.LoginPolicy != nil && authReq.LoginPolicy.AllowRegister
 		},
+		"hasX509Login": func() bool {
+			return authReq != nil && authReq.LoginPolicy != nil && authReq.LoginPolicy.AllowX509Certificate()
+		},
 	}
 	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplLogin], data, funcs)
 }
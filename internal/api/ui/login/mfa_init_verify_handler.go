@@ -19,6 +19,8 @@ const (
 
 type mfaInitVerifyData struct {
 	MFAType domain.MFAType `schema:"mfaType"`
+	TOTPID  string         `schema:"totpID"`
+	Name    string         `schema:"name"`
 	Code    string         `schema:"code"`
 	URL     string         `schema:"url"`
 	Secret  string         `schema:"secret"`
@@ -50,13 +52,20 @@ func (l *Login) handleMFAInitVerify(w http.ResponseWriter, r *http.Request) {
 
 func (l *Login) handleOTPVerify(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, data *mfaInitVerifyData) *mfaVerifyData {
 	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
-	_, err := l.command.HumanCheckMFATOTPSetup(setUserContext(r.Context(), authReq.UserID, authReq.UserOrgID), authReq.UserID, data.Code, userAgentID, authReq.UserOrgID)
+	ctx := setUserContext(r.Context(), authReq.UserID, authReq.UserOrgID)
+	var err error
+	if data.TOTPID != "" {
+		_, err = l.command.HumanCheckMFANamedTOTPSetup(ctx, authReq.UserID, data.TOTPID, data.Name, data.Code, userAgentID, authReq.UserOrgID)
+	} else {
+		_, err = l.command.HumanCheckMFATOTPSetup(ctx, authReq.UserID, data.Code, userAgentID, authReq.UserOrgID)
+	}
 	if err == nil {
 		return nil
 	}
 	mfadata := &mfaVerifyData{
 		MFAType: data.MFAType,
 		totpData: totpData{
+			TOTPID: data.TOTPID,
 			Secret: data.Secret,
 			Url:    data.URL,
 		},
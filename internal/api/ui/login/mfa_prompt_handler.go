@@ -92,13 +92,15 @@ func (l *Login) handleMFACreation(w http.ResponseWriter, r *http.Request, authRe
 }
 
 func (l *Login) handleTOTPCreation(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, data *mfaVerifyData) {
-	otp, err := l.command.AddHumanTOTP(setUserContext(r.Context(), authReq.UserID, authReq.UserOrgID), authReq.UserID, authReq.UserOrgID)
+	// the name is provided by the user on verification
+	otp, err := l.command.AddHumanNamedTOTP(setUserContext(r.Context(), authReq.UserID, authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, "")
 	if err != nil {
 		l.renderError(w, r, authReq, err)
 		return
 	}
 
 	data.totpData = totpData{
+		TOTPID: otp.ID,
 		Secret: otp.Secret,
 		Url:    otp.URI,
 	}
@@ -0,0 +1,90 @@
+package login
+
+import (
+	"net/http"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	tmplMFATOTPs = "mfatotps"
+)
+
+type mfaTOTPsFormData struct {
+	TOTPID string `schema:"totpID"`
+}
+
+type mfaTOTPsData struct {
+	baseData
+	profileData
+	TOTPs []*mfaTOTPData
+}
+
+type mfaTOTPData struct {
+	ID   string
+	Name string
+}
+
+// handleMFATOTPs lists the TOTP authenticators of the user and removes the selected one.
+// The authenticators can only be managed after the user verified a second factor in the current login.
+func (l *Login) handleMFATOTPs(w http.ResponseWriter, r *http.Request) {
+	data := new(mfaTOTPsFormData)
+	authReq, err := l.ensureAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if len(authReq.MFAsVerified) == 0 {
+		l.renderError(w, r, authReq, zerrors.ThrowPreconditionFailed(nil, "LOGIN-Tq5mV", "Errors.User.MFA.NotVerified"))
+		return
+	}
+	if data.TOTPID != "" {
+		ctx := setUserContext(r.Context(), authReq.UserID, authReq.UserOrgID)
+		_, err = l.command.HumanRemoveNamedTOTP(ctx, authReq.UserID, data.TOTPID, authReq.UserOrgID)
+	}
+	l.renderMFATOTPs(w, r, authReq, err)
+}
+
+func (l *Login) renderMFATOTPs(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, err error) {
+	data := new(mfaTOTPsData)
+	var totps []*mfaTOTPData
+	if err == nil {
+		totps, err = l.userTOTPs(r, authReq.UserID)
+	}
+	translator := l.getTranslator(r.Context(), authReq)
+	data.baseData = l.getBaseData(r, authReq, translator, "MFATOTPs.Title", "MFATOTPs.Description", err)
+	data.profileData = l.getProfileData(authReq)
+	data.TOTPs = totps
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplMFATOTPs], data, nil)
+}
+
+// userTOTPs returns the verified TOTP authenticators of the user, which can be removed by their id.
+func (l *Login) userTOTPs(r *http.Request, userID string) ([]*mfaTOTPData, error) {
+	queries := new(query.UserAuthMethodSearchQueries)
+	if err := queries.AppendUserIDQuery(userID); err != nil {
+		return nil, err
+	}
+	if err := queries.AppendAuthMethodQuery(domain.UserAuthMethodTypeTOTP); err != nil {
+		return nil, err
+	}
+	if err := queries.AppendStateQuery(domain.MFAStateReady); err != nil {
+		return nil, err
+	}
+	authMethods, err := l.query.SearchUserAuthMethods(r.Context(), queries, nil)
+	if err != nil {
+		return nil, err
+	}
+	totps := make([]*mfaTOTPData, 0, len(authMethods.AuthMethods))
+	for _, method := range authMethods.AuthMethods {
+		if method.TokenID == "" {
+			continue
+		}
+		totps = append(totps, &mfaTOTPData{
+			ID:   method.TokenID,
+			Name: method.Name,
+		})
+	}
+	return totps, nil
+}
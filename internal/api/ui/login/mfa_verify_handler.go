@@ -15,6 +15,7 @@ type mfaVerifyFormData struct {
 	MFAType          domain.MFAType `schema:"mfaType"`
 	Code             string         `schema:"code"`
 	SelectedProvider domain.MFAType `schema:"provider"`
+	TrustDevice      bool           `schema:"trustDevice"`
 }
 
 func (l *Login) handleMFAVerify(w http.ResponseWriter, r *http.Request) {
@@ -48,6 +49,7 @@ func (l *Login) handleMFAVerify(w http.ResponseWriter, r *http.Request) {
 			l.renderMFAVerifySelected(w, r, authReq, step, domain.MFATypeTOTP, err)
 			return
 		}
+		l.trustDevice(w, r, authReq, data.TrustDevice)
 	}
 	l.renderNextStep(w, r, authReq)
 }
@@ -78,6 +80,7 @@ func (l *Login) renderMFAVerifySelected(w http.ResponseWriter, r *http.Request,
 	case domain.MFATypeTOTP:
 		data.MFAProviders = removeSelectedProviderFromList(verificationStep.MFAProviders, domain.MFATypeTOTP)
 		data.SelectedMFAProvider = domain.MFATypeTOTP
+		data.TrustDeviceAllowed = l.deviceTrustAllowed(r.Context(), authReq)
 		data.Title = translator.LocalizeWithoutArgs("VerifyMFAOTP.Title")
 		data.Description = translator.LocalizeWithoutArgs("VerifyMFAOTP.Description")
 	case domain.MFATypeOTPSMS:
@@ -25,6 +25,7 @@ type mfaOTPFormData struct {
 	Code             string         `schema:"code"`
 	SelectedProvider domain.MFAType `schema:"selectedProvider"`
 	Provider         domain.MFAType `schema:"provider"`
+	TrustDevice      bool           `schema:"trustDevice"`
 }
 
 func OTPLink(origin, authRequestID, code string, provider domain.MFAType) string {
@@ -67,6 +68,7 @@ func (l *Login) renderOTPVerification(w http.ResponseWriter, r *http.Request, au
 		MFAProviders:     removeSelectedProviderFromList(providers, selectedProvider),
 		SelectedProvider: selectedProvider,
 	}
+	data.TrustDeviceAllowed = l.deviceTrustAllowed(r.Context(), authReq)
 	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplOTPVerification], data, nil)
 }
 
@@ -125,5 +127,7 @@ func (l *Login) handleOTPVerificationCheck(w http.ResponseWriter, r *http.Reques
 		l.renderOTPVerification(w, r, authReq, step.MFAProviders, formData.SelectedProvider, err)
 		return
 	}
+	// recovery codes are meant for the case of a lost second factor, so the device can't be trusted with them
+	l.trustDevice(w, r, authReq, formData.TrustDevice && formData.SelectedProvider != domain.MFATypeRecoveryCode)
 	l.renderNextStep(w, r, authReq)
 }
@@ -21,6 +21,7 @@ type mfaU2FData struct {
 type mfaU2FFormData struct {
 	webAuthNFormData
 	SelectedProvider domain.MFAType `schema:"provider"`
+	TrustDevice      bool           `schema:"trustDevice"`
 }
 
 func (l *Login) renderU2FVerification(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, providers []domain.MFAType, err error) {
@@ -42,6 +43,7 @@ func (l *Login) renderU2FVerification(w http.ResponseWriter, r *http.Request, au
 		MFAProviders:     providers,
 		SelectedProvider: -1,
 	}
+	data.TrustDeviceAllowed = l.deviceTrustAllowed(r.Context(), authReq)
 	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplU2FVerification], data, nil)
 }
 
@@ -80,5 +82,6 @@ func (l *Login) handleU2FVerification(w http.ResponseWriter, r *http.Request) {
 		l.renderU2FVerification(w, r, authReq, step.MFAProviders, err)
 		return
 	}
+	l.trustDevice(w, r, authReq, formData.TrustDevice)
 	l.renderNextStep(w, r, authReq)
 }
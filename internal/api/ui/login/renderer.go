@@ -63,6 +63,7 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		tmplMFAU2FInit:                   "mfa_init_u2f.html",
 		tmplU2FVerification:              "mfa_verification_u2f.html",
 		tmplMFAInitDone:                  "mfa_init_done.html",
+		tmplMFATOTPs:                     "mfa_totps.html",
 		tmplMailVerification:             "mail_verification.html",
 		tmplMailVerified:                 "mail_verified.html",
 		tmplInitPassword:                 "init_password.html",
@@ -83,6 +84,7 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		tmplExternalNotFoundOption:       "external_not_found_option.html",
 		tmplLoginSuccess:                 "login_success.html",
 		tmplLDAPLogin:                    "ldap_login.html",
+		tmplSAMLDiscovery:                "saml_discovery.html",
 		tmplDeviceAuthUserCode:           "device_usercode.html",
 		tmplDeviceAuthAction:             "device_action.html",
 	}
@@ -141,6 +143,12 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"loginNameUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointLoginName)
 		},
+		"x509LoginUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointX509Login)
+		},
+		"samlDiscoveryUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointSAMLDiscovery)
+		},
 		"loginNameChangeUrl": func(id string) string {
 			return path.Join(r.pathPrefix, fmt.Sprintf("%s?%s=%s", EndpointLoginName, QueryAuthRequestID, id))
 		},
@@ -174,6 +182,9 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"mfaInitVerifyUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointMFAInitVerify)
 		},
+		"mfaTOTPsUrl": func() string {
+			return path.Join(r.pathPrefix, EndpointMFATOTPs)
+		},
 		"mfaInitSMSVerifyUrl": func() string {
 			return path.Join(r.pathPrefix, EndpointMFASMSInitVerify)
 		},
@@ -234,6 +245,9 @@ func CreateRenderer(pathPrefix string, staticStorage static.Storage, cookieName
 		"hasRegistration": func() bool {
 			return true
 		},
+		"hasX509Login": func() bool {
+			return false
+		},
 		"idpProviderClass": func(idpType domain.IDPType) string {
 			return idpType.GetCSSClass()
 		},
@@ -305,6 +319,13 @@ func (l *Login) chooseNextStep(w http.ResponseWriter, r *http.Request, authReq *
 	case *domain.PasswordlessRegistrationPromptStep:
 		l.renderPasswordlessPrompt(w, r, authReq, nil)
 	case *domain.MFAVerificationStep:
+		if err == nil {
+			var trusted bool
+			if r, trusted = l.checkTrustedDevice(w, r, authReq); trusted {
+				l.renderNextStep(w, r, authReq)
+				return
+			}
+		}
 		l.renderMFAVerify(w, r, authReq, step, err)
 	case *domain.RedirectToCallbackStep:
 		if len(authReq.PossibleSteps) > 1 {
@@ -678,6 +699,7 @@ type userData struct {
 	MFAProviders        []domain.MFAType
 	SelectedMFAProvider domain.MFAType
 	Linking             bool
+	TrustDeviceAllowed  bool
 }
 
 type profileData struct {
@@ -725,6 +747,7 @@ type mfaDoneData struct {
 }
 
 type totpData struct {
+	TOTPID string
 	Url    string
 	Secret string
 	QrCode template.HTML
@@ -0,0 +1,95 @@
+package login
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	tmplSAMLDiscovery = "samldiscovery"
+
+	// samlDiscoveryLimit is the maximum number of institutions listed by the discovery service
+	samlDiscoveryLimit = 50
+)
+
+type samlDiscoveryFormData struct {
+	Search string `schema:"search"`
+}
+
+type samlDiscoveryData struct {
+	baseData
+	Search   string
+	Entities []*query.SAMLFederationEntity
+}
+
+// handleSAMLDiscovery renders the discovery service, which lets the user search their home institution
+// among the identity providers imported from SAML federations.
+func (l *Login) handleSAMLDiscovery(w http.ResponseWriter, r *http.Request) {
+	data := new(samlDiscoveryFormData)
+	authReq, err := l.getAuthRequestAndParseData(r, data)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	l.renderSAMLDiscovery(w, r, authReq, strings.TrimSpace(data.Search))
+}
+
+func (l *Login) renderSAMLDiscovery(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, search string) {
+	var entities []*query.SAMLFederationEntity
+	var err error
+	if externalLoginAllowed(authReq) {
+		entities, err = l.query.SearchSAMLFederationEntities(r.Context(), search, allowedIDPIDs(authReq), samlDiscoveryLimit)
+	}
+	translator := l.getTranslator(r.Context(), authReq)
+	data := samlDiscoveryData{
+		baseData: l.getBaseData(r, authReq, translator, "SAMLDiscovery.Title", "SAMLDiscovery.Description", err),
+		Search:   search,
+		Entities: entities,
+	}
+	l.renderer.RenderTemplate(w, r, translator, l.renderer.Templates[tmplSAMLDiscovery], data, nil)
+}
+
+// withoutSAMLFederationIDPs removes the identity providers imported from SAML federations,
+// which can be thousands, from the providers rendered as buttons.
+// They are selected using the discovery service instead, which is linked if any of them is allowed.
+func (l *Login) withoutSAMLFederationIDPs(r *http.Request, authReq *domain.AuthRequest, providers []*domain.IDPProvider) (_ []*domain.IDPProvider, hasFederationIDPs bool) {
+	if !externalLoginAllowed(authReq) {
+		return providers, false
+	}
+	entities, err := l.query.SearchSAMLFederationEntities(r.Context(), "", allowedIDPIDs(authReq), 0)
+	if err != nil {
+		logging.WithFields("authRequest", authReq.ID).WithError(err).Warn("unable to load identity providers of saml federations")
+		return providers, false
+	}
+	if len(entities) == 0 {
+		return providers, false
+	}
+	federationIDPs := make(map[string]struct{}, len(entities))
+	for _, entity := range entities {
+		federationIDPs[entity.IDPID] = struct{}{}
+	}
+	filtered := make([]*domain.IDPProvider, 0, len(providers))
+	for _, provider := range providers {
+		if _, ok := federationIDPs[provider.IDPConfigID]; !ok {
+			filtered = append(filtered, provider)
+		}
+	}
+	return filtered, true
+}
+
+func externalLoginAllowed(authReq *domain.AuthRequest) bool {
+	return authReq != nil && authReq.LoginPolicy != nil && authReq.LoginPolicy.AllowExternalIDP && len(authReq.AllowedExternalIDPs) > 0
+}
+
+func allowedIDPIDs(authReq *domain.AuthRequest) []string {
+	ids := make([]string, len(authReq.AllowedExternalIDPs))
+	for i, provider := range authReq.AllowedExternalIDPs {
+		ids[i] = provider.IDPConfigID
+	}
+	return ids
+}
@@ -0,0 +1,128 @@
+package login
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	http_mw "github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+// maxTrustedDeviceNameLength limits the user agent header stored as name of a trusted device.
+const maxTrustedDeviceNameLength = 200
+
+type trustedDeviceCheckedKey struct{}
+
+// trustedDevices is the content of the trusted device cookie.
+// A device is only trusted for the user agent, on which the second factor was checked.
+// The user agent is identified by the ID of the user agent cookie, which is used as fingerprint of the device.
+// This binding is limited: the user agent cookie can be copied along with this cookie,
+// which is why the trust is also revoked on a password change, the removal of the second factor or a sign out everywhere.
+type trustedDevices struct {
+	Devices []*trustedDevice `json:"devices"`
+}
+
+type trustedDevice struct {
+	UserID   string `json:"userId"`
+	DeviceID string `json:"deviceId"`
+}
+
+func newTrustedDeviceCookieHandler(cookieKey []byte, externalSecure bool) *http_utils.CookieHandler {
+	opts := []http_utils.CookieHandlerOpt{
+		http_utils.WithEncryption(cookieKey, cookieKey),
+		http_utils.WithMaxAge(int(domain.MaxDeviceTrustLifetime.Seconds())),
+		http_utils.WithPrefix(http_utils.PrefixHost),
+	}
+	if !externalSecure {
+		opts = append(opts, http_utils.WithUnsecure())
+	}
+	return http_utils.NewCookieHandler(opts...)
+}
+
+// deviceTrustAllowed returns true, if the user is allowed to trust the device after checking the second factor.
+func (l *Login) deviceTrustAllowed(ctx context.Context, authReq *domain.AuthRequest) bool {
+	if authReq == nil || authReq.UserID == "" {
+		return false
+	}
+	policy, err := l.query.DeviceTrustPolicy(ctx, authReq.UserOrgID)
+	if err != nil {
+		logging.WithFields("authRequestID", authReq.ID).WithError(err).Warn("unable to get device trust policy")
+		return false
+	}
+	return policy.Enabled()
+}
+
+// checkTrustedDevice checks, if the user trusted the device previously, in which case the second factor check is skipped.
+// The device is only checked once per request to prevent loops, if the check doesn't satisfy the auth request.
+func (l *Login) checkTrustedDevice(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest) (*http.Request, bool) {
+	if checked, _ := r.Context().Value(trustedDeviceCheckedKey{}).(bool); checked {
+		return r, false
+	}
+	r = r.WithContext(context.WithValue(r.Context(), trustedDeviceCheckedKey{}, true))
+	devices := l.getTrustedDevices(r)
+	index := slices.IndexFunc(devices.Devices, func(device *trustedDevice) bool {
+		return device.UserID == authReq.UserID
+	})
+	if index < 0 {
+		return r, false
+	}
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	err := l.authRepo.VerifyTrustedDevice(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, authReq.ID, userAgentID, devices.Devices[index].DeviceID, domain.BrowserInfoFromRequest(r))
+	if err != nil {
+		logging.WithFields("authRequestID", authReq.ID).WithError(err).Info("trusted device not valid")
+		devices.Devices = slices.Delete(devices.Devices, index, index+1)
+		l.setTrustedDevices(w, r, devices)
+		return r, false
+	}
+	return r, true
+}
+
+// trustDevice trusts the device of the user after a successful check of the second factor, if requested by the user.
+// The login continues even if the device could not be trusted.
+func (l *Login) trustDevice(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest, requested bool) {
+	if !requested {
+		return
+	}
+	name := r.UserAgent()
+	if len(name) > maxTrustedDeviceNameLength {
+		name = name[:maxTrustedDeviceNameLength]
+	}
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	device, err := l.authRepo.TrustDevice(setContext(r.Context(), authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, authReq.ID, userAgentID, name, domain.BrowserInfoFromRequest(r))
+	if err != nil {
+		logging.WithFields("authRequestID", authReq.ID).WithError(err).Warn("unable to trust device")
+		return
+	}
+	devices := l.getTrustedDevices(r)
+	devices.Devices = slices.DeleteFunc(devices.Devices, func(device *trustedDevice) bool {
+		return device.UserID == authReq.UserID
+	})
+	devices.Devices = append(devices.Devices, &trustedDevice{
+		UserID:   authReq.UserID,
+		DeviceID: device.ID,
+	})
+	l.setTrustedDevices(w, r, devices)
+}
+
+func (l *Login) getTrustedDevices(r *http.Request) *trustedDevices {
+	devices := new(trustedDevices)
+	if err := l.trustedDeviceCookieHandler.GetEncryptedCookieValue(r, l.trustedDeviceCookieName, devices); err != nil {
+		return new(trustedDevices)
+	}
+	return devices
+}
+
+func (l *Login) setTrustedDevices(w http.ResponseWriter, r *http.Request, devices *trustedDevices) {
+	if len(devices.Devices) == 0 {
+		l.trustedDeviceCookieHandler.DeleteCookie(w, l.trustedDeviceCookieName)
+		return
+	}
+	iframe := len(authz.GetInstance(r.Context()).SecurityPolicyAllowedOrigins()) > 0
+	err := l.trustedDeviceCookieHandler.SetEncryptedCookie(w, l.trustedDeviceCookieName, r.Host, devices, iframe)
+	logging.OnError(err).Error("unable to set trusted device cookie")
+}
@@ -0,0 +1,100 @@
+package login
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/url"
+
+	http_mw "github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// handleX509Login authenticates the user with the client certificate (e.g. of a PIV or CAC smart card),
+// which was presented in the TLS handshake.
+// If TLS is terminated in front of ZITADEL, the proxy has to pass the certificate in the configured header.
+func (l *Login) handleX509Login(w http.ResponseWriter, r *http.Request) {
+	authReq, err := l.ensureAuthRequest(r)
+	if err != nil {
+		l.renderError(w, r, authReq, err)
+		return
+	}
+	if authReq.LoginPolicy == nil || !authReq.LoginPolicy.AllowX509Certificate() {
+		l.renderLogin(w, r, authReq, zerrors.ThrowPreconditionFailed(nil, "LOGIN-X5l1E", "Errors.User.X509.NotEnabled"))
+		return
+	}
+	certificate, intermediates, err := l.clientCertificate(r)
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	userID, resourceOwner, err := l.query.UserByX509Certificate(r.Context(), authReq.RequestedOrgID, certificate)
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	userAgentID, _ := http_mw.UserAgentIDFromCtx(r.Context())
+	if err = l.authRepo.SelectUser(r.Context(), authReq.ID, userID, userAgentID, false); err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	err = l.authRepo.VerifyX509Certificate(setContext(r.Context(), resourceOwner), userID, resourceOwner, authReq.ID, userAgentID, certificate, intermediates, domain.BrowserInfoFromRequest(r))
+
+	metadata, actionErr := l.runPostInternalAuthenticationActions(authReq, r, authMethodX509Certificate, err)
+	if err == nil && actionErr == nil && len(metadata) > 0 {
+		err = l.bulkSetUserMetadata(r.Context(), userID, resourceOwner, metadata)
+	} else if actionErr != nil && err == nil {
+		err = actionErr
+	}
+
+	if err != nil {
+		l.renderLogin(w, r, authReq, err)
+		return
+	}
+	l.renderNextStep(w, r, authReq)
+}
+
+// clientCertificate returns the DER encoded client certificate and its intermediates.
+// The header set by a TLS terminating proxy takes precedence over the TLS connection,
+// it must contain the URL encoded PEM certificates (e.g. `$ssl_client_escaped_cert` of NGINX).
+func (l *Login) clientCertificate(r *http.Request) (certificate []byte, intermediates [][]byte, err error) {
+	if l.x509ClientCertificateHeader != "" {
+		if header := r.Header.Get(l.x509ClientCertificateHeader); header != "" {
+			return clientCertificateFromHeader(header)
+		}
+	}
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return nil, nil, zerrors.ThrowInvalidArgument(nil, "LOGIN-X5l1M", "Errors.User.X509.Missing")
+	}
+	for _, cert := range r.TLS.PeerCertificates[1:] {
+		intermediates = append(intermediates, cert.Raw)
+	}
+	return r.TLS.PeerCertificates[0].Raw, intermediates, nil
+}
+
+func clientCertificateFromHeader(header string) (certificate []byte, intermediates [][]byte, err error) {
+	unescaped, err := url.QueryUnescape(header)
+	if err != nil {
+		return nil, nil, zerrors.ThrowInvalidArgument(err, "LOGIN-X5l1H", "Errors.User.X509.Invalid")
+	}
+	rest := []byte(unescaped)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if certificate == nil {
+			certificate = block.Bytes
+			continue
+		}
+		intermediates = append(intermediates, block.Bytes)
+	}
+	if certificate == nil {
+		return nil, nil, zerrors.ThrowInvalidArgument(nil, "LOGIN-X5l1P", "Errors.User.X509.Invalid")
+	}
+	return certificate, intermediates, nil
+}
@@ -37,6 +37,9 @@ type AuthRequestRepository interface {
 	VerifyPasswordlessInitCodeSetup(ctx context.Context, userID, resourceOwner, userAgentID, tokenName, codeID, verificationCode string, credentialData []byte) (err error)
 	BeginPasswordlessLogin(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string) (*domain.WebAuthNLogin, error)
 	VerifyPasswordless(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string, credentialData []byte, info *domain.BrowserInfo) error
+	VerifyX509Certificate(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string, certificate []byte, intermediates [][]byte, info *domain.BrowserInfo) error
+	TrustDevice(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID, name string, info *domain.BrowserInfo) (*domain.TrustedDevice, error)
+	VerifyTrustedDevice(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID, deviceID string, info *domain.BrowserInfo) error
 
 	LinkExternalUsers(ctx context.Context, authReqID, userAgentID string, info *domain.BrowserInfo) error
 	AutoRegisterExternalUser(ctx context.Context, user *domain.Human, externalIDP *domain.UserIDPLink, orgMemberRoles []string, authReqID, userAgentID, resourceOwner string, metadatas []*domain.Metadata, info *domain.BrowserInfo) error
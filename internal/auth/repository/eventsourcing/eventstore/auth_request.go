@@ -545,6 +545,39 @@ func (repo *AuthRequestRepo) VerifyPasswordless(ctx context.Context, userID, res
 	return repo.Command.HumanFinishPasswordlessLogin(ctx, userID, resourceOwner, credentialData, request)
 }
 
+func (repo *AuthRequestRepo) VerifyX509Certificate(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID string, certificate []byte, intermediates [][]byte, info *domain.BrowserInfo) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authRequestID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanCheckX509Certificate(ctx, userID, resourceOwner, certificate, intermediates, request.WithCurrentInfo(info))
+}
+
+// TrustDevice trusts the user agent of the auth request after the second factor was checked on it,
+// so the check of the second factor is skipped on later logins.
+func (repo *AuthRequestRepo) TrustDevice(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID, name string, info *domain.BrowserInfo) (_ *domain.TrustedDevice, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authRequestID, userAgentID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return repo.Command.HumanTrustDevice(ctx, userID, resourceOwner, userAgentID, name, request.WithCurrentInfo(info))
+}
+
+// VerifyTrustedDevice replaces the check of the second factor, if the device is trusted on the user agent of the auth request.
+func (repo *AuthRequestRepo) VerifyTrustedDevice(ctx context.Context, userID, resourceOwner, authRequestID, userAgentID, deviceID string, info *domain.BrowserInfo) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	request, err := repo.getAuthRequestEnsureUser(ctx, authRequestID, userAgentID, userID)
+	if err != nil {
+		return err
+	}
+	return repo.Command.HumanCheckTrustedDevice(ctx, userID, resourceOwner, deviceID, userAgentID, request.WithCurrentInfo(info))
+}
+
 func (repo *AuthRequestRepo) LinkExternalUsers(ctx context.Context, authReqID, userAgentID string, info *domain.BrowserInfo) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -1298,6 +1331,15 @@ func (repo *AuthRequestRepo) firstFactorChecked(ctx context.Context, request *do
 		return &domain.InitUserStep{PasswordSet: user.PasswordSet}
 	}
 
+	// a verified client certificate (smart card) is a phishing resistant multi factor on its own
+	if request.LoginPolicy.AllowX509Certificate() &&
+		userSession.MultiFactorVerificationType == domain.MFATypeX509Certificate &&
+		checkVerificationTimeMaxAge(userSession.MultiFactorVerification, request.LoginPolicy.MultiFactorCheckLifetime, request) {
+		request.MFAsVerified = append(request.MFAsVerified, domain.MFATypeX509Certificate)
+		request.AuthTime = userSession.MultiFactorVerification
+		return nil
+	}
+
 	var step domain.NextStep
 	if request.LoginPolicy.PasswordlessType != domain.PasswordlessTypeNotAllowed && user.IsPasswordlessReady() {
 		if checkVerificationTimeMaxAge(userSession.PasswordlessVerification, request.LoginPolicy.MultiFactorCheckLifetime, request) {
@@ -1387,7 +1429,8 @@ func (repo *AuthRequestRepo) idpChecked(request *domain.AuthRequest, idps []stri
 
 func (repo *AuthRequestRepo) mfaChecked(userSession *user_model.UserSessionView, request *domain.AuthRequest, user *user_model.UserView, isInternalAuthentication bool) (domain.NextStep, bool, error) {
 	mfaLevel := request.MFALevel()
-	if slices.Contains(request.MFAsVerified, domain.MFATypeU2FUserVerification) {
+	if slices.Contains(request.MFAsVerified, domain.MFATypeU2FUserVerification) ||
+		slices.Contains(request.MFAsVerified, domain.MFATypeX509Certificate) {
 		return nil, true, nil
 	}
 	allowedProviders, required := user.MFATypesAllowed(mfaLevel, request.LoginPolicy, isInternalAuthentication)
@@ -1658,6 +1701,10 @@ var (
 		user_repo.HumanPasswordlessTokenCheckFailedType,
 		user_repo.HumanU2FTokenCheckSucceededType,
 		user_repo.HumanU2FTokenCheckFailedType,
+		user_repo.HumanX509CheckSucceededType,
+		user_repo.HumanX509CheckFailedType,
+		user_repo.HumanTrustedDeviceCheckSucceededType,
+		user_repo.HumanSignedOutEverywhereType,
 		user_repo.UserRemovedType,
 	}
 )
@@ -1708,7 +1755,10 @@ func userSessionByIDs(ctx context.Context, provider userSessionViewProvider, eve
 			user_repo.HumanPasswordlessTokenCheckSucceededType,
 			user_repo.HumanPasswordlessTokenCheckFailedType,
 			user_repo.HumanU2FTokenCheckSucceededType,
-			user_repo.HumanU2FTokenCheckFailedType:
+			user_repo.HumanU2FTokenCheckFailedType,
+			user_repo.HumanX509CheckSucceededType,
+			user_repo.HumanX509CheckFailedType,
+			user_repo.HumanTrustedDeviceCheckSucceededType:
 			userAgentID, err := user_view_model.UserAgentIDFromEvent(event)
 			if err != nil {
 				logging.WithFields("traceID", tracing.TraceIDFromCtx(ctx)).WithError(err).Debug("error getting event data")
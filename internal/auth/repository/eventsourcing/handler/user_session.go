@@ -131,6 +131,18 @@ func (s *UserSession) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanPasswordlessTokenCheckFailedType,
 					Reduce: s.Reduce,
 				},
+				{
+					Event:  user.HumanX509CheckSucceededType,
+					Reduce: s.Reduce,
+				},
+				{
+					Event:  user.HumanX509CheckFailedType,
+					Reduce: s.Reduce,
+				},
+				{
+					Event:  user.HumanTrustedDeviceCheckSucceededType,
+					Reduce: s.Reduce,
+				},
 				{
 					Event:  user.HumanSignedOutType,
 					Reduce: s.Reduce,
@@ -151,6 +163,10 @@ func (s *UserSession) Reducers() []handler.AggregateReducer {
 					Event:  user.UserDeactivatedType,
 					Reduce: s.Reduce,
 				},
+				{
+					Event:  user.HumanSignedOutEverywhereType,
+					Reduce: s.Reduce,
+				},
 				{
 					Event:  user.HumanPasswordChangedType,
 					Reduce: s.Reduce,
@@ -374,8 +390,50 @@ func (u *UserSession) Reduce(event eventstore.Event) (_ *handler.Statement, err
 			return nil, err
 		}
 		return handler.NewUpsertStatement(event, columns[0:3], columns), nil
+	case user.HumanX509CheckSucceededType:
+		data := new(es_model.AuthRequest)
+		err := data.SetData(event)
+		if err != nil {
+			return nil, err
+		}
+		columns, err := u.sessionColumnsActivate(event,
+			handler.NewCol(view_model.UserSessionKeyMultiFactorVerification, event.CreatedAt()),
+			handler.NewCol(view_model.UserSessionKeyMultiFactorVerificationType, domain.MFATypeX509Certificate),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return handler.NewUpsertStatement(event, columns[0:3], columns), nil
+	case user.HumanX509CheckFailedType:
+		data := new(es_model.AuthRequest)
+		err := data.SetData(event)
+		if err != nil {
+			return nil, err
+		}
+		columns, err := u.sessionColumnsActivate(event,
+			handler.NewCol(view_model.UserSessionKeyMultiFactorVerification, time.Time{}),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return handler.NewUpsertStatement(event, columns[0:3], columns), nil
+	case user.HumanTrustedDeviceCheckSucceededType:
+		data := new(es_model.TrustedDeviceCheck)
+		err := data.SetData(event)
+		if err != nil {
+			return nil, err
+		}
+		columns, err := u.sessionColumnsActivate(event,
+			handler.NewCol(view_model.UserSessionKeySecondFactorVerification, event.CreatedAt()),
+			handler.NewCol(view_model.UserSessionKeySecondFactorVerificationType, data.MFAType),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return handler.NewUpsertStatement(event, columns[0:3], columns), nil
 	case user.UserLockedType,
-		user.UserDeactivatedType:
+		user.UserDeactivatedType,
+		user.HumanSignedOutEverywhereType:
 		return handler.NewUpdateStatement(event,
 			[]handler.Column{
 				handler.NewCol(view_model.UserSessionKeyPasswordlessVerification, time.Time{}),
@@ -426,6 +484,8 @@ func (u *UserSession) Reduce(event eventstore.Event) (_ *handler.Statement, err
 					handler.Not(handler.NewCond(view_model.UserSessionKeyState, domain.UserSessionStateTerminated)),
 				}),
 		), nil
+	// the removal of a named TOTP authenticator resets the verification as well,
+	// as the check events don't tell which of the authenticators verified the session
 	case user.UserV1MFAOTPRemovedType,
 		user.HumanMFAOTPRemovedType,
 		user.HumanU2FTokenRemovedType,
@@ -254,6 +254,12 @@ func authMethodsFromSession(session *query.Session) []domain.UserAuthMethodType
 	if !session.RecoveryCodeFactor.RecoveryCodeCheckedAt.IsZero() {
 		types = append(types, domain.UserAuthMethodTypeRecoveryCode)
 	}
+	if !session.PushFactor.PushCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypePush)
+	}
+	if !session.X509Factor.X509CheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeX509Certificate)
+	}
 	return types
 }
 
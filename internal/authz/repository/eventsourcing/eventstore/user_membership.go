@@ -4,7 +4,11 @@ import (
 	"context"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 )
 
@@ -20,7 +24,16 @@ func (repo *UserMembershipRepo) SearchMyMemberships(ctx context.Context, orgID s
 	if err != nil {
 		return nil, err
 	}
-	return userMembershipsToMemberships(memberships), nil
+	resourceMemberships, err := repo.Queries.ProjectResourceMembershipsByUser(ctx, authz.GetCtxData(ctx).UserID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	groupMemberships, err := repo.Queries.GroupMembershipsByUser(ctx, authz.GetCtxData(ctx).UserID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	result := append(userMembershipsToMemberships(memberships), projectResourceMembershipsToMemberships(resourceMemberships)...)
+	return append(result, groupMembershipsToMemberships(groupMemberships)...), nil
 }
 
 func (repo *UserMembershipRepo) searchUserMemberships(ctx context.Context, orgID string, shouldTriggerBulk bool) (_ []*query.Membership, err error) {
@@ -88,3 +101,50 @@ func userMembershipsToMemberships(memberships []*query.Membership) []*authz.Memb
 	}
 	return result
 }
+
+func projectResourceMembershipsToMemberships(memberships []*query.ProjectResourceMembership) []*authz.Membership {
+	result := make([]*authz.Membership, 0, len(memberships))
+	for _, m := range memberships {
+		switch m.ResourceType {
+		case project.ApplicationMemberSearchType:
+			result = append(result, &authz.Membership{
+				MemberType:  authz.MemberTypeApplication,
+				AggregateID: m.ProjectID,
+				ObjectID:    m.ResourceID,
+				Roles:       m.Roles,
+			})
+		case project.RoleMemberSearchType:
+			result = append(result, &authz.Membership{
+				MemberType:  authz.MemberTypeProjectRole,
+				AggregateID: m.ProjectID,
+				ObjectID:    domain.ProjectRoleContextID(m.ProjectID, m.ResourceID),
+				Roles:       m.Roles,
+			})
+		}
+	}
+	return result
+}
+
+func groupMembershipsToMemberships(memberships []*query.GroupMembership) []*authz.Membership {
+	result := make([]*authz.Membership, 0, len(memberships))
+	for _, m := range memberships {
+		var memberType authz.MemberType
+		switch m.AggregateType {
+		case instance.AggregateType:
+			memberType = authz.MemberTypeIAM
+		case org.AggregateType:
+			memberType = authz.MemberTypeOrganization
+		case project.AggregateType:
+			memberType = authz.MemberTypeProject
+		default:
+			continue
+		}
+		result = append(result, &authz.Membership{
+			MemberType:  memberType,
+			AggregateID: m.AggregateID,
+			ObjectID:    m.AggregateID,
+			Roles:       m.Roles,
+		})
+	}
+	return result
+}
@@ -19,6 +19,7 @@ const (
 	PurposeOrganization
 	PurposeIdPFormCallback
 	PurposeFederatedLogout
+	PurposeWSFederationAssertion
 )
 
 // Cache stores objects with a value of type `V`.
@@ -25,6 +25,8 @@ type CachesConfig struct {
 	Organization     *cache.Config
 	IdPFormCallbacks *cache.Config
 	FederatedLogouts *cache.Config
+	// WSFederationAssertions keeps the ids of used WS-Federation assertions to prevent replays
+	WSFederationAssertions *cache.Config
 }
 
 type Connectors struct {
@@ -0,0 +1,158 @@
+// Package clientcert checks the revocation status of client certificates
+// against the OCSP responder and certificate revocation lists configured in the [domain.X509Policy].
+package clientcert
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/zitadel/logging"
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// maxResponseSize limits the size of OCSP responses and revocation lists.
+const maxResponseSize = 10 << 20
+
+type RevocationChecker struct {
+	client *http.Client
+	now    func() time.Time
+
+	mu   sync.Mutex
+	crls map[string]*x509.RevocationList
+}
+
+func NewRevocationChecker(client *http.Client) *RevocationChecker {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &RevocationChecker{
+		client: client,
+		now:    time.Now,
+		crls:   make(map[string]*x509.RevocationList),
+	}
+}
+
+// CheckRevocation checks if the certificate, which was issued by the issuer, is revoked.
+// The OCSP responder is asked first, the revocation lists are only checked if it can't give a definite answer.
+// If the policy configures any responder, but the status can't be determined, the check fails,
+// so an unreachable responder doesn't allow revoked certificates.
+func (c *RevocationChecker) CheckRevocation(ctx context.Context, policy *domain.X509Policy, cert, issuer *x509.Certificate) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if policy.OCSPResponderURL == "" && len(policy.CRLURLs) == 0 {
+		return nil
+	}
+	if policy.OCSPResponderURL != "" {
+		status, err := c.ocspStatus(ctx, policy.OCSPResponderURL, cert, issuer)
+		logging.OnError(err).WithField("responder", policy.OCSPResponderURL).Warn("unable to get ocsp status of client certificate")
+		switch {
+		case err != nil:
+		case status == ocsp.Good:
+			return nil
+		case status == ocsp.Revoked:
+			return zerrors.ThrowPreconditionFailed(nil, "CERT-Rv5kO", "Errors.User.X509.Revoked")
+		}
+	}
+	var checked bool
+	for _, crlURL := range policy.CRLURLs {
+		crl, err := c.revocationList(ctx, crlURL, issuer)
+		if err != nil {
+			logging.WithError(err).WithField("crl", crlURL).Warn("unable to get revocation list")
+			continue
+		}
+		// the list might be issued by another certificate authority of the policy
+		if crl == nil {
+			continue
+		}
+		for _, entry := range crl.RevokedCertificateEntries {
+			if entry.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+				return zerrors.ThrowPreconditionFailed(nil, "CERT-Rv5kC", "Errors.User.X509.Revoked")
+			}
+		}
+		checked = true
+	}
+	if !checked {
+		return zerrors.ThrowPreconditionFailed(nil, "CERT-Rv5kU", "Errors.User.X509.RevocationUnknown")
+	}
+	return nil
+}
+
+func (c *RevocationChecker) ocspStatus(ctx context.Context, responderURL string, cert, issuer *x509.Certificate) (int, error) {
+	request, err := ocsp.CreateRequest(cert, issuer, nil)
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, responderURL, bytes.NewReader(request))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/ocsp-request")
+	body, err := c.get(req)
+	if err != nil {
+		return 0, err
+	}
+	response, err := ocsp.ParseResponseForCert(body, cert, issuer)
+	if err != nil {
+		return 0, err
+	}
+	if !response.NextUpdate.IsZero() && c.now().After(response.NextUpdate) {
+		return ocsp.Unknown, nil
+	}
+	return response.Status, nil
+}
+
+// revocationList returns the current list of the url, if it's issued by the issuer.
+// Lists are cached until their next update.
+func (c *RevocationChecker) revocationList(ctx context.Context, crlURL string, issuer *x509.Certificate) (*x509.RevocationList, error) {
+	c.mu.Lock()
+	crl, ok := c.crls[crlURL]
+	c.mu.Unlock()
+	if !ok || c.now().After(crl.NextUpdate) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, crlURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		body, err := c.get(req)
+		if err != nil {
+			return nil, err
+		}
+		if crl, err = x509.ParseRevocationList(body); err != nil {
+			return nil, err
+		}
+		c.mu.Lock()
+		c.crls[crlURL] = crl
+		c.mu.Unlock()
+	}
+	if !bytes.Equal(crl.RawIssuer, issuer.RawSubject) {
+		return nil, nil
+	}
+	if err := crl.CheckSignatureFrom(issuer); err != nil {
+		return nil, err
+	}
+	if !crl.NextUpdate.IsZero() && c.now().After(crl.NextUpdate) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "CERT-Rv5kE", "revocation list expired")
+	}
+	return crl, nil
+}
+
+func (c *RevocationChecker) get(req *http.Request) ([]byte, error) {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, zerrors.ThrowUnavailablef(nil, "CERT-Rv5kS", "unexpected status %d", resp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+}
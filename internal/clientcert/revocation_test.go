@@ -0,0 +1,176 @@
+package clientcert
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+func (ca *testCA) issue(t *testing.T, serial int64) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "john"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, key.Public(), ca.key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func (ca *testCA) crl(t *testing.T, revoked ...int64) []byte {
+	entries := make([]x509.RevocationListEntry, len(revoked))
+	for i, serial := range revoked {
+		entries[i] = x509.RevocationListEntry{SerialNumber: big.NewInt(serial), RevocationTime: time.Now()}
+	}
+	crl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:                    big.NewInt(1),
+		ThisUpdate:                time.Now().Add(-time.Minute),
+		NextUpdate:                time.Now().Add(time.Hour),
+		RevokedCertificateEntries: entries,
+	}, ca.cert, ca.key)
+	require.NoError(t, err)
+	return crl
+}
+
+func ocspResponder(t *testing.T, ca *testCA, status int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		request, err := ocsp.ParseRequest(body)
+		require.NoError(t, err)
+		response, err := ocsp.CreateResponse(ca.cert, ca.cert, ocsp.Response{
+			Status:       status,
+			SerialNumber: request.SerialNumber,
+			ThisUpdate:   time.Now().Add(-time.Minute),
+			NextUpdate:   time.Now().Add(time.Hour),
+			RevokedAt:    time.Now().Add(-time.Minute),
+		}, ca.key)
+		require.NoError(t, err)
+		_, _ = w.Write(response)
+	}))
+}
+
+func staticServer(status int, body []byte) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write(body)
+	}))
+}
+
+func TestRevocationChecker_CheckRevocation(t *testing.T) {
+	ca := newTestCA(t, "CA")
+	otherCA := newTestCA(t, "Other CA")
+	cert := ca.issue(t, 2)
+
+	good := ocspResponder(t, ca, ocsp.Good)
+	defer good.Close()
+	revoked := ocspResponder(t, ca, ocsp.Revoked)
+	defer revoked.Close()
+	unknown := ocspResponder(t, ca, ocsp.Unknown)
+	defer unknown.Close()
+	unavailable := staticServer(http.StatusServiceUnavailable, nil)
+	defer unavailable.Close()
+	crlRevoked := staticServer(http.StatusOK, ca.crl(t, 2))
+	defer crlRevoked.Close()
+	crlGood := staticServer(http.StatusOK, ca.crl(t, 3))
+	defer crlGood.Close()
+	crlOtherCA := staticServer(http.StatusOK, otherCA.crl(t))
+	defer crlOtherCA.Close()
+
+	tests := []struct {
+		name    string
+		policy  *domain.X509Policy
+		wantErr error
+	}{
+		{
+			name:   "no responders, ok",
+			policy: &domain.X509Policy{},
+		},
+		{
+			name:   "ocsp good, ok",
+			policy: &domain.X509Policy{OCSPResponderURL: good.URL},
+		},
+		{
+			name:    "ocsp revoked, precondition error",
+			policy:  &domain.X509Policy{OCSPResponderURL: revoked.URL},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "CERT-Rv5kO", "Errors.User.X509.Revoked"),
+		},
+		{
+			name:    "ocsp unknown, precondition error",
+			policy:  &domain.X509Policy{OCSPResponderURL: unknown.URL},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "CERT-Rv5kU", "Errors.User.X509.RevocationUnknown"),
+		},
+		{
+			name:    "ocsp unavailable, precondition error",
+			policy:  &domain.X509Policy{OCSPResponderURL: unavailable.URL},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "CERT-Rv5kU", "Errors.User.X509.RevocationUnknown"),
+		},
+		{
+			name:   "ocsp unavailable, crl fallback, ok",
+			policy: &domain.X509Policy{OCSPResponderURL: unavailable.URL, CRLURLs: []string{crlGood.URL}},
+		},
+		{
+			name:    "crl revoked, precondition error",
+			policy:  &domain.X509Policy{CRLURLs: []string{crlOtherCA.URL, crlRevoked.URL}},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "CERT-Rv5kC", "Errors.User.X509.Revoked"),
+		},
+		{
+			name:    "crl of other issuer only, precondition error",
+			policy:  &domain.X509Policy{CRLURLs: []string{crlOtherCA.URL}},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "CERT-Rv5kU", "Errors.User.X509.RevocationUnknown"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewRevocationChecker(nil).CheckRevocation(context.Background(), tt.policy, cert, ca.cert)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
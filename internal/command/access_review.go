@@ -0,0 +1,305 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AccessReviewCampaign reviews the authorizations of a project or the administrators of an organization.
+type AccessReviewCampaign struct {
+	OrganizationID string
+	Name           string
+	// ResourceType is either [accessreview.ResourceTypeProject] or [accessreview.ResourceTypeOrganization].
+	ResourceType string
+	// ProjectID is required for the review of authorizations.
+	ProjectID string
+	// RoleKey optionally restricts the review to a single project or administrator role.
+	// Revoking an item only removes this role, as long as other roles remain.
+	RoleKey     string
+	ReviewerIDs []string
+	Deadline    time.Time
+	// AutoRevoke revokes all items, which are not reviewed until the deadline.
+	AutoRevoke bool
+}
+
+func (c *AccessReviewCampaign) IsValid() error {
+	if c.OrganizationID == "" || c.Name == "" || len(c.ReviewerIDs) == 0 || !c.Deadline.After(time.Now()) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar1vQ", "Errors.AccessReview.Invalid")
+	}
+	switch c.ResourceType {
+	case accessreview.ResourceTypeProject:
+		if c.ProjectID == "" {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar2pW", "Errors.AccessReview.Invalid")
+		}
+	case accessreview.ResourceTypeOrganization:
+		if c.ProjectID != "" {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar3xE", "Errors.AccessReview.Invalid")
+		}
+	default:
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar4zR", "Errors.AccessReview.Invalid")
+	}
+	return nil
+}
+
+// CreateAccessReviewCampaign starts a new campaign and returns its id.
+func (c *Commands) CreateAccessReviewCampaign(ctx context.Context, campaign *AccessReviewCampaign) (_ string, _ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := campaign.IsValid(); err != nil {
+		return "", nil, err
+	}
+	if err := c.checkOrgExists(ctx, campaign.OrganizationID); err != nil {
+		return "", nil, err
+	}
+	if err := c.checkPermissionWriteAccessReview(ctx, campaign.OrganizationID, campaign.ResourceType, campaign.ProjectID); err != nil {
+		return "", nil, err
+	}
+	if err := c.checkAccessReviewScope(ctx, campaign); err != nil {
+		return "", nil, err
+	}
+	campaign.ReviewerIDs = slices.Compact(slices.Sorted(slices.Values(campaign.ReviewerIDs)))
+	for _, reviewerID := range campaign.ReviewerIDs {
+		if _, err := c.checkUserExists(ctx, reviewerID, ""); err != nil {
+			return "", nil, err
+		}
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	details, err := c.pushAppendAndReduceDetails(ctx, NewAccessReviewWriteModel(id, campaign.OrganizationID),
+		accessreview.NewCampaignCreatedEvent(ctx,
+			&accessreview.NewAggregate(id, campaign.OrganizationID).Aggregate,
+			campaign.Name,
+			campaign.ResourceType,
+			campaign.ProjectID,
+			campaign.RoleKey,
+			campaign.ReviewerIDs,
+			campaign.Deadline,
+			campaign.AutoRevoke,
+		),
+	)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, details, nil
+}
+
+// DecideAccessReviewItem keeps the decision of the reviewer of the context about a single item of an active campaign.
+// The item is the id of the authorization for project reviews and the id of the user for organization reviews.
+// Revoking the item removes the authorization or membership immediately.
+func (c *Commands) DecideAccessReviewItem(ctx context.Context, campaignID, itemID string, decision domain.AccessReviewDecision, comment string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if campaignID == "" || itemID == "" || !decision.Valid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar5dK", "Errors.AccessReview.Invalid")
+	}
+	wm, err := c.accessReviewWriteModelByID(ctx, campaignID, "")
+	if err != nil {
+		return nil, err
+	}
+	if wm.State != domain.AccessReviewStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ar6nT", "Errors.AccessReview.NotFound")
+	}
+	reviewerID := authz.GetCtxData(ctx).UserID
+	if !wm.IsReviewer(reviewerID) {
+		return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-Ar7rV", "Errors.AccessReview.NotReviewer")
+	}
+	if _, ok := wm.Decisions[itemID]; ok {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ar8aD", "Errors.AccessReview.AlreadyDecided")
+	}
+	item, err := c.accessReviewItem(ctx, wm, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if item.userID == reviewerID {
+		return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-Ar9sR", "Errors.AccessReview.SelfReview")
+	}
+	cmds := make([]eventstore.Command, 0, 2)
+	if decision == domain.AccessReviewDecisionRevoked {
+		cmds = append(cmds, item.revoke)
+	}
+	// the decision is pushed last, so the details reflect the campaign
+	cmds = append(cmds, accessreview.NewItemDecidedEvent(ctx,
+		&accessreview.NewAggregate(wm.AggregateID, wm.ResourceOwner).Aggregate,
+		itemID,
+		item.userID,
+		decision,
+		reviewerID,
+		comment,
+	))
+	return c.pushAppendAndReduceDetails(ctx, wm, cmds...)
+}
+
+// CompleteAccessReviewCampaign completes a campaign once its deadline passed.
+// If the campaign revokes automatically, all given items without decision are revoked.
+// It is executed by the scheduled access worker and therefore doesn't check any permission.
+func (c *Commands) CompleteAccessReviewCampaign(ctx context.Context, campaignID, resourceOwner string, itemIDs []string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm, err := c.accessReviewWriteModelByID(ctx, campaignID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if wm.State != domain.AccessReviewStateActive || wm.Deadline.After(time.Now()) {
+		return nil
+	}
+	agg := &accessreview.NewAggregate(wm.AggregateID, wm.ResourceOwner).Aggregate
+	cmds := make([]eventstore.Command, 0, len(itemIDs)*2+1)
+	if wm.AutoRevoke {
+		for _, itemID := range itemIDs {
+			if _, ok := wm.Decisions[itemID]; ok {
+				continue
+			}
+			item, err := c.accessReviewItem(ctx, wm, itemID)
+			// the item might have been removed since it was searched
+			if zerrors.IsNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			cmds = append(cmds,
+				item.revoke,
+				accessreview.NewItemDecidedEvent(ctx, agg, itemID, item.userID, domain.AccessReviewDecisionRevoked, "", ""),
+			)
+		}
+	}
+	cmds = append(cmds, accessreview.NewCampaignCompletedEvent(ctx, agg))
+	_, err = c.eventstore.Push(ctx, cmds...)
+	return err
+}
+
+func (c *Commands) accessReviewWriteModelByID(ctx context.Context, id, resourceOwner string) (_ *AccessReviewWriteModel, err error) {
+	wm := NewAccessReviewWriteModel(id, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+func (c *Commands) checkPermissionWriteAccessReview(ctx context.Context, orgID, resourceType, projectID string) error {
+	if resourceType == accessreview.ResourceTypeProject {
+		return c.newPermissionCheck(ctx, domain.PermissionUserGrantWrite, project.AggregateType)(orgID, projectID)
+	}
+	return c.checkPermissionUpdateOrgMember(ctx, orgID, orgID)
+}
+
+// checkAccessReviewScope checks that the project and the role to review exist.
+func (c *Commands) checkAccessReviewScope(ctx context.Context, campaign *AccessReviewCampaign) error {
+	if campaign.ResourceType == accessreview.ResourceTypeOrganization {
+		if campaign.RoleKey == "" {
+			return nil
+		}
+		validRoles, err := c.administratorRoles(ctx, authz.GetInstance(ctx).InstanceID(), []string{campaign.RoleKey})
+		if err != nil {
+			return err
+		}
+		if len(domain.CheckForInvalidRoles([]string{campaign.RoleKey}, domain.OrgRolePrefix, validRoles)) > 0 {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar0hB", "Errors.AccessReview.RoleInvalid")
+		}
+		return nil
+	}
+	projectOwner, err := c.checkProjectExists(ctx, campaign.ProjectID, "")
+	if err != nil {
+		return err
+	}
+	if campaign.RoleKey == "" {
+		return nil
+	}
+	role, err := c.getProjectRoleWriteModelByID(ctx, campaign.RoleKey, campaign.ProjectID, projectOwner)
+	if err != nil {
+		return err
+	}
+	if role.State != domain.ProjectRoleStateActive {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ar1cM", "Errors.AccessReview.RoleInvalid")
+	}
+	return nil
+}
+
+// accessReviewItem is an authorization or membership in the scope of a campaign.
+// Authorizations and memberships added after the start of the campaign are not in its scope.
+type accessReviewItem struct {
+	userID string
+	// revoke removes the reviewed role, or the whole authorization or membership if no other role remains.
+	revoke eventstore.Command
+}
+
+func (c *Commands) accessReviewItem(ctx context.Context, wm *AccessReviewWriteModel, itemID string) (*accessReviewItem, error) {
+	if wm.ResourceType == accessreview.ResourceTypeProject {
+		return c.accessReviewUserGrant(ctx, wm, itemID)
+	}
+	return c.accessReviewOrgMember(ctx, wm, itemID)
+}
+
+func (c *Commands) accessReviewUserGrant(ctx context.Context, wm *AccessReviewWriteModel, grantID string) (*accessReviewItem, error) {
+	grant, err := c.userGrantWriteModelByID(ctx, grantID, wm.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if (grant.State != domain.UserGrantStateActive && grant.State != domain.UserGrantStateInactive) ||
+		grant.CreationDate.After(wm.StartedAt) ||
+		grant.ProjectID != wm.ProjectID ||
+		(wm.RoleKey != "" && !slices.Contains(grant.RoleKeys, wm.RoleKey)) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ar2gN", "Errors.AccessReview.ItemNotFound")
+	}
+	agg := UserGrantAggregateFromWriteModel(&grant.WriteModel)
+	if remaining := remainingRoles(grant.RoleKeys, wm.RoleKey); len(remaining) > 0 {
+		return &accessReviewItem{
+			userID: grant.UserID,
+			revoke: usergrant.NewUserGrantChangedEvent(ctx, agg, grant.UserID, remaining),
+		}, nil
+	}
+	return &accessReviewItem{
+		userID: grant.UserID,
+		revoke: usergrant.NewUserGrantRemovedEvent(ctx, agg, grant.UserID, grant.ProjectID, grant.ProjectGrantID),
+	}, nil
+}
+
+func (c *Commands) accessReviewOrgMember(ctx context.Context, wm *AccessReviewWriteModel, userID string) (*accessReviewItem, error) {
+	member, err := c.orgMemberWriteModelByID(ctx, wm.ResourceOwner, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.State.Exists() || member.CreationDate.After(wm.StartedAt) || (wm.RoleKey != "" && !slices.Contains(member.Roles, wm.RoleKey)) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ar3mN", "Errors.AccessReview.ItemNotFound")
+	}
+	agg := OrgAggregateFromWriteModelWithCTX(ctx, &member.WriteModel)
+	if remaining := remainingRoles(member.Roles, wm.RoleKey); len(remaining) > 0 {
+		changed := org.NewMemberChangedEvent(ctx, agg, userID, remaining...)
+		changed.ValidUntil = member.ValidUntil
+		return &accessReviewItem{
+			userID: userID,
+			revoke: changed,
+		}, nil
+	}
+	return &accessReviewItem{
+		userID: userID,
+		revoke: c.removeOrgMember(ctx, agg, userID, false),
+	}, nil
+}
+
+// remainingRoles returns the roles without the reviewed role.
+// If the whole authorization or membership is reviewed, no role remains.
+func remainingRoles(roles []string, reviewed string) []string {
+	if reviewed == "" {
+		return nil
+	}
+	return slices.DeleteFunc(slices.Clone(roles), func(role string) bool {
+		return role == reviewed
+	})
+}
@@ -0,0 +1,84 @@
+package command
+
+import (
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+)
+
+type AccessReviewWriteModel struct {
+	eventstore.WriteModel
+
+	ResourceType string
+	ProjectID    string
+	RoleKey      string
+	ReviewerIDs  []string
+	Deadline     time.Time
+	AutoRevoke   bool
+	// StartedAt is the time the campaign was created.
+	// Only authorizations and memberships, which existed at this time, are reviewed.
+	StartedAt time.Time
+	Decisions map[string]domain.AccessReviewDecision
+	State     domain.AccessReviewState
+}
+
+func NewAccessReviewWriteModel(id, resourceOwner string) *AccessReviewWriteModel {
+	return &AccessReviewWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+		Decisions: make(map[string]domain.AccessReviewDecision),
+	}
+}
+
+func (wm *AccessReviewWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *accessreview.CampaignCreatedEvent:
+			wm.ResourceType = e.ResourceType
+			wm.ProjectID = e.ProjectID
+			wm.RoleKey = e.RoleKey
+			wm.ReviewerIDs = e.ReviewerIDs
+			wm.Deadline = e.Deadline
+			wm.AutoRevoke = e.AutoRevoke
+			wm.StartedAt = e.CreationDate()
+			wm.ResourceOwner = e.Aggregate().ResourceOwner
+			wm.State = domain.AccessReviewStateActive
+		case *accessreview.ItemDecidedEvent:
+			wm.Decisions[e.ItemID] = e.Decision
+		case *accessreview.CampaignCompletedEvent:
+			wm.State = domain.AccessReviewStateCompleted
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *AccessReviewWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(accessreview.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			accessreview.CampaignCreatedType,
+			accessreview.ItemDecidedType,
+			accessreview.CampaignCompletedType,
+		).
+		Builder()
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+func (wm *AccessReviewWriteModel) GetWriteModel() *eventstore.WriteModel {
+	return &wm.WriteModel
+}
+
+// IsReviewer returns true if the user is assigned as reviewer of the campaign.
+func (wm *AccessReviewWriteModel) IsReviewer(userID string) bool {
+	return slices.Contains(wm.ReviewerIDs, userID)
+}
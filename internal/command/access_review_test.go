@@ -0,0 +1,588 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func accessReviewCreatedEvent(ctx context.Context, resourceType, projectID, roleKey string, deadline time.Time, autoRevoke bool) *accessreview.CampaignCreatedEvent {
+	return accessreview.NewCampaignCreatedEvent(ctx,
+		&accessreview.NewAggregate("campaign1", "org1").Aggregate,
+		"Q3",
+		resourceType,
+		projectID,
+		roleKey,
+		[]string{"reviewer1"},
+		deadline,
+		autoRevoke,
+	)
+}
+
+func accessReviewUserGrantAddedEvent(grantID, userID, projectID string, roles ...string) *usergrant.UserGrantAddedEvent {
+	return usergrant.NewUserGrantAddedEvent(context.Background(),
+		&usergrant.NewAggregate(grantID, "org1").Aggregate,
+		userID,
+		projectID,
+		"",
+		roles,
+	)
+}
+
+func TestCommandSide_CreateAccessReviewCampaign(t *testing.T) {
+	t.Parallel()
+	ctx := authz.NewMockContext("instance1", "org1", "admin1")
+	deadline := time.Now().Add(24 * time.Hour).UTC()
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+		idGenerator     id.Generator
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		campaign *AccessReviewCampaign
+		wantID   string
+		want     *domain.ObjectDetails
+		wantErr  func(error) bool
+	}{
+		{
+			name: "no reviewers, error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			campaign: &AccessReviewCampaign{
+				OrganizationID: "org1",
+				Name:           "Q3",
+				ResourceType:   accessreview.ResourceTypeOrganization,
+				Deadline:       deadline,
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "deadline passed, error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			campaign: &AccessReviewCampaign{
+				OrganizationID: "org1",
+				Name:           "Q3",
+				ResourceType:   accessreview.ResourceTypeOrganization,
+				ReviewerIDs:    []string{"reviewer1"},
+				Deadline:       time.Now().Add(-time.Hour),
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "project review without project, error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			campaign: &AccessReviewCampaign{
+				OrganizationID: "org1",
+				Name:           "Q3",
+				ResourceType:   accessreview.ResourceTypeProject,
+				ReviewerIDs:    []string{"reviewer1"},
+				Deadline:       deadline,
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "no permission, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			campaign: &AccessReviewCampaign{
+				OrganizationID: "org1",
+				Name:           "Q3",
+				ResourceType:   accessreview.ResourceTypeOrganization,
+				ReviewerIDs:    []string{"reviewer1"},
+				Deadline:       deadline,
+			},
+			wantErr: zerrors.IsPermissionDenied,
+		},
+		{
+			name: "project role on organization, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			campaign: &AccessReviewCampaign{
+				OrganizationID: "org1",
+				Name:           "Q3",
+				ResourceType:   accessreview.ResourceTypeOrganization,
+				RoleKey:        "IAM_OWNER",
+				ReviewerIDs:    []string{"reviewer1"},
+				Deadline:       deadline,
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "create organization review, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org"),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("reviewer1", "org1").Aggregate,
+								"username1",
+								"firstname1",
+								"lastname1",
+								"nickname1",
+								"displayname1",
+								language.German,
+								domain.GenderMale,
+								"email1",
+								true,
+							),
+						),
+					),
+					expectPush(
+						accessReviewCreatedEvent(ctx, accessreview.ResourceTypeOrganization, "", "ORG_OWNER", deadline, true),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "campaign1"),
+			},
+			campaign: &AccessReviewCampaign{
+				OrganizationID: "org1",
+				Name:           "Q3",
+				ResourceType:   accessreview.ResourceTypeOrganization,
+				RoleKey:        "ORG_OWNER",
+				ReviewerIDs:    []string{"reviewer1", "reviewer1"},
+				Deadline:       deadline,
+				AutoRevoke:     true,
+			},
+			wantID: "campaign1",
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+				idGenerator:     tt.fields.idGenerator,
+				zitadelRoles:    elevationTestZitadelRoles,
+			}
+			gotID, got, err := c.CreateAccessReviewCampaign(ctx, tt.campaign)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, gotID)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommandSide_DecideAccessReviewItem(t *testing.T) {
+	t.Parallel()
+	ctx := authz.NewMockContext("instance1", "org1", "reviewer1")
+	deadline := time.Now().Add(24 * time.Hour).UTC()
+	projectReview := accessReviewCreatedEvent(context.Background(), accessreview.ResourceTypeProject, "project1", "", deadline, false)
+	type args struct {
+		ctx      context.Context
+		itemID   string
+		decision domain.AccessReviewDecision
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		args       args
+		want       *domain.ObjectDetails
+		wantErr    func(error) bool
+	}{
+		{
+			name:       "missing decision, error",
+			eventstore: expectEventstore(),
+			args: args{
+				ctx:    ctx,
+				itemID: "grant1",
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "campaign not found, error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			args: args{
+				ctx:      ctx,
+				itemID:   "grant1",
+				decision: domain.AccessReviewDecisionApproved,
+			},
+			wantErr: zerrors.IsNotFound,
+		},
+		{
+			name: "campaign completed, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(projectReview),
+					eventFromEventPusher(accessreview.NewCampaignCompletedEvent(context.Background(),
+						&accessreview.NewAggregate("campaign1", "org1").Aggregate,
+					)),
+				),
+			),
+			args: args{
+				ctx:      ctx,
+				itemID:   "grant1",
+				decision: domain.AccessReviewDecisionApproved,
+			},
+			wantErr: zerrors.IsNotFound,
+		},
+		{
+			name: "not a reviewer, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(projectReview),
+				),
+			),
+			args: args{
+				ctx:      authz.NewMockContext("instance1", "org1", "user2"),
+				itemID:   "grant1",
+				decision: domain.AccessReviewDecisionApproved,
+			},
+			wantErr: zerrors.IsPermissionDenied,
+		},
+		{
+			name: "already decided, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(projectReview),
+					eventFromEventPusher(accessreview.NewItemDecidedEvent(context.Background(),
+						&accessreview.NewAggregate("campaign1", "org1").Aggregate,
+						"grant1",
+						"user1",
+						domain.AccessReviewDecisionApproved,
+						"reviewer1",
+						"",
+					)),
+				),
+			),
+			args: args{
+				ctx:      ctx,
+				itemID:   "grant1",
+				decision: domain.AccessReviewDecisionRevoked,
+			},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "grant of other project, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(projectReview),
+				),
+				expectFilter(
+					eventFromEventPusher(accessReviewUserGrantAddedEvent("grant1", "user1", "project2", "role1")),
+				),
+			),
+			args: args{
+				ctx:      ctx,
+				itemID:   "grant1",
+				decision: domain.AccessReviewDecisionApproved,
+			},
+			wantErr: zerrors.IsNotFound,
+		},
+		{
+			name: "grant added after campaign start, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(projectReview),
+				),
+				expectFilter(
+					eventFromEventPusherWithCreationDateNow(accessReviewUserGrantAddedEvent("grant1", "user1", "project1", "role1")),
+				),
+			),
+			args: args{
+				ctx:      ctx,
+				itemID:   "grant1",
+				decision: domain.AccessReviewDecisionRevoked,
+			},
+			wantErr: zerrors.IsNotFound,
+		},
+		{
+			name: "own grant, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(projectReview),
+				),
+				expectFilter(
+					eventFromEventPusher(accessReviewUserGrantAddedEvent("grant1", "reviewer1", "project1", "role1")),
+				),
+			),
+			args: args{
+				ctx:      ctx,
+				itemID:   "grant1",
+				decision: domain.AccessReviewDecisionApproved,
+			},
+			wantErr: zerrors.IsPermissionDenied,
+		},
+		{
+			name: "approve grant, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(projectReview),
+				),
+				expectFilter(
+					eventFromEventPusher(accessReviewUserGrantAddedEvent("grant1", "user1", "project1", "role1")),
+				),
+				expectPush(
+					accessreview.NewItemDecidedEvent(ctx,
+						&accessreview.NewAggregate("campaign1", "org1").Aggregate,
+						"grant1",
+						"user1",
+						domain.AccessReviewDecisionApproved,
+						"reviewer1",
+						"still needed",
+					),
+				),
+			),
+			args: args{
+				ctx:      ctx,
+				itemID:   "grant1",
+				decision: domain.AccessReviewDecisionApproved,
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+		{
+			name: "revoke reviewed role of grant, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(accessReviewCreatedEvent(context.Background(), accessreview.ResourceTypeProject, "project1", "role1", deadline, false)),
+				),
+				expectFilter(
+					eventFromEventPusher(accessReviewUserGrantAddedEvent("grant1", "user1", "project1", "role1", "role2")),
+				),
+				expectPush(
+					usergrant.NewUserGrantChangedEvent(ctx,
+						&usergrant.NewAggregate("grant1", "org1").Aggregate,
+						"user1",
+						[]string{"role2"},
+					),
+					accessreview.NewItemDecidedEvent(ctx,
+						&accessreview.NewAggregate("campaign1", "org1").Aggregate,
+						"grant1",
+						"user1",
+						domain.AccessReviewDecisionRevoked,
+						"reviewer1",
+						"still needed",
+					),
+				),
+			),
+			args: args{
+				ctx:      ctx,
+				itemID:   "grant1",
+				decision: domain.AccessReviewDecisionRevoked,
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+		{
+			name: "revoke administrator, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(accessReviewCreatedEvent(context.Background(), accessreview.ResourceTypeOrganization, "", "", deadline, false)),
+				),
+				expectFilter(
+					eventFromEventPusher(org.NewMemberAddedEvent(context.Background(),
+						&org.NewAggregate("org1").Aggregate,
+						"user1",
+						"ORG_OWNER",
+					)),
+				),
+				expectPush(
+					org.NewMemberRemovedEvent(ctx,
+						&org.NewAggregate("org1").Aggregate,
+						"user1",
+					),
+					accessreview.NewItemDecidedEvent(ctx,
+						&accessreview.NewAggregate("campaign1", "org1").Aggregate,
+						"user1",
+						"user1",
+						domain.AccessReviewDecisionRevoked,
+						"reviewer1",
+						"still needed",
+					),
+				),
+			),
+			args: args{
+				ctx:      ctx,
+				itemID:   "user1",
+				decision: domain.AccessReviewDecisionRevoked,
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.DecideAccessReviewItem(tt.args.ctx, "campaign1", tt.args.itemID, tt.args.decision, "still needed")
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommandSide_CompleteAccessReviewCampaign(t *testing.T) {
+	t.Parallel()
+	passed := time.Now().Add(-time.Minute).UTC()
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		itemIDs    []string
+	}{
+		{
+			name: "deadline not passed, no change",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(accessReviewCreatedEvent(context.Background(), accessreview.ResourceTypeProject, "project1", "", time.Now().Add(time.Hour), true)),
+				),
+			),
+			itemIDs: []string{"grant1"},
+		},
+		{
+			name: "already completed, no change",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(accessReviewCreatedEvent(context.Background(), accessreview.ResourceTypeProject, "project1", "", passed, true)),
+					eventFromEventPusher(accessreview.NewCampaignCompletedEvent(context.Background(),
+						&accessreview.NewAggregate("campaign1", "org1").Aggregate,
+					)),
+				),
+			),
+			itemIDs: []string{"grant1"},
+		},
+		{
+			name: "without auto revoke, completed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(accessReviewCreatedEvent(context.Background(), accessreview.ResourceTypeProject, "project1", "", passed, false)),
+				),
+				expectPush(
+					accessreview.NewCampaignCompletedEvent(context.Background(),
+						&accessreview.NewAggregate("campaign1", "org1").Aggregate,
+					),
+				),
+			),
+			itemIDs: []string{"grant1"},
+		},
+		{
+			name: "auto revoke undecided items, completed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(accessReviewCreatedEvent(context.Background(), accessreview.ResourceTypeProject, "project1", "", passed, true)),
+					eventFromEventPusher(accessreview.NewItemDecidedEvent(context.Background(),
+						&accessreview.NewAggregate("campaign1", "org1").Aggregate,
+						"grant1",
+						"user1",
+						domain.AccessReviewDecisionApproved,
+						"reviewer1",
+						"",
+					)),
+				),
+				// grant2 was removed in the meantime
+				expectFilter(),
+				expectFilter(
+					eventFromEventPusher(accessReviewUserGrantAddedEvent("grant3", "user3", "project1", "role1")),
+				),
+				expectPush(
+					usergrant.NewUserGrantRemovedEvent(context.Background(),
+						&usergrant.NewAggregate("grant3", "org1").Aggregate,
+						"user3",
+						"project1",
+						"",
+					),
+					accessreview.NewItemDecidedEvent(context.Background(),
+						&accessreview.NewAggregate("campaign1", "org1").Aggregate,
+						"grant3",
+						"user3",
+						domain.AccessReviewDecisionRevoked,
+						"",
+						"",
+					),
+					accessreview.NewCampaignCompletedEvent(context.Background(),
+						&accessreview.NewAggregate("campaign1", "org1").Aggregate,
+					),
+				),
+			),
+			itemIDs: []string{"grant1", "grant2", "grant3"},
+		},
+		{
+			name: "auto revoke skips grants added after campaign start, completed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(accessReviewCreatedEvent(context.Background(), accessreview.ResourceTypeProject, "project1", "", passed, true)),
+				),
+				expectFilter(
+					eventFromEventPusherWithCreationDateNow(accessReviewUserGrantAddedEvent("grant1", "user1", "project1", "role1")),
+				),
+				expectPush(
+					accessreview.NewCampaignCompletedEvent(context.Background(),
+						&accessreview.NewAggregate("campaign1", "org1").Aggregate,
+					),
+				),
+			),
+			itemIDs: []string{"grant1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.CompleteAccessReviewCampaign(context.Background(), "campaign1", "org1", tt.itemIDs)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	api_http "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/cache/connector"
+	"github.com/zitadel/zitadel/internal/clientcert"
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	sd "github.com/zitadel/zitadel/internal/config/systemdefaults"
 	"github.com/zitadel/zitadel/internal/crypto"
@@ -28,6 +29,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/risk"
 	"github.com/zitadel/zitadel/internal/static"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	webauthn_helper "github.com/zitadel/zitadel/internal/webauthn"
@@ -40,6 +42,7 @@ type Commands struct {
 	jobs sync.WaitGroup
 
 	checkPermission             domain.PermissionCheck
+	orgQuotaCheck               OrgQuotaCheck
 	newEncryptedCode            encrypedCodeFunc
 	newEncryptedCodeWithDefault encryptedCodeWithDefaultFunc
 	newHashedSecret             hashedSecretFunc
@@ -71,9 +74,11 @@ type Commands struct {
 	defaultRefreshTokenIdleLifetime time.Duration
 	phoneCodeVerifier               func(ctx context.Context, id string) (senders.CodeGenerator, error)
 	tarpit                          func(failedAttempts uint64)
+	riskEngine                      *risk.Engine
 
 	multifactors            domain.MultifactorConfigs
 	webauthnConfig          *webauthn_helper.Config
+	x509Revocation          X509RevocationChecker
 	keySize                 int
 	keyAlgorithm            crypto.EncryptionAlgorithm
 	certificateAlgorithm    crypto.EncryptionAlgorithm
@@ -119,6 +124,7 @@ func StartCommands(
 	idpConfigEncryption, otpEncryption, smtpEncryption, smsEncryption, userEncryption, domainVerificationEncryption, oidcEncryption, samlEncryption, targetEncryption crypto.EncryptionAlgorithm,
 	httpClient *http.Client,
 	permissionCheck domain.PermissionCheck,
+	orgQuotaCheck OrgQuotaCheck,
 	sessionTokenVerifier func(ctx context.Context, sessionToken string, sessionID string, tokenID string) (err error),
 	defaultAccessTokenLifetime,
 	defaultRefreshTokenLifetime,
@@ -146,6 +152,10 @@ func StartCommands(
 	if err != nil {
 		return nil, fmt.Errorf("caches: %w", err)
 	}
+	riskEngine, err := risk.NewEngine(&defaults.Risk)
+	if err != nil {
+		return nil, fmt.Errorf("risk engine: %w", err)
+	}
 	repo = &Commands{
 		eventstore:                      es,
 		static:                          staticStore,
@@ -160,6 +170,7 @@ func StartCommands(
 		publicKeyLifetime:               defaults.KeyConfig.PublicKeyLifetime,
 		certificateLifetime:             defaults.KeyConfig.CertificateLifetime,
 		maxIdPIntentLifetime:            defaults.MaxIdPIntentLifetime,
+		riskEngine:                      riskEngine,
 		idpConfigEncryption:             idpConfigEncryption,
 		smtpEncryption:                  smtpEncryption,
 		smsEncryption:                   smsEncryption,
@@ -175,8 +186,10 @@ func StartCommands(
 		keyAlgorithm:                    oidcEncryption,
 		certificateAlgorithm:            samlEncryption,
 		webauthnConfig:                  webAuthN,
+		x509Revocation:                  clientcert.NewRevocationChecker(httpClient),
 		httpClient:                      httpClient,
 		checkPermission:                 permissionCheck,
+		orgQuotaCheck:                   orgQuotaCheck,
 		newEncryptedCode:                newEncryptedCode,
 		newEncryptedCodeWithDefault:     newEncryptedCodeWithDefaultConfig,
 		sessionTokenCreator:             sessionTokenCreator(idGenerator, sessionAlg),
@@ -210,6 +223,9 @@ func StartCommands(
 				Length:     defaults.Multifactors.RecoveryCodes.Length,
 				WithHyphen: defaults.Multifactors.RecoveryCodes.WithHyphen,
 			},
+			Push: domain.PushConfig{
+				ChallengeLifetime: defaults.Multifactors.Push.ChallengeLifetime,
+			},
 		},
 		GenerateDomain:                domain.NewGeneratedInstanceDomain,
 		caches:                        caches,
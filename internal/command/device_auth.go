@@ -102,6 +102,9 @@ func (c *Commands) ApproveDeviceAuthWithSession(
 	if err = sessionWriteModel.CheckIsActive(); err != nil {
 		return nil, err
 	}
+	if err = sessionWriteModel.CheckRisk(); err != nil {
+		return nil, err
+	}
 	if err := c.sessionTokenVerifier(ctx, sessionToken, sessionWriteModel.AggregateID, sessionWriteModel.TokenID); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,550 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/elevation"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ElevationRequest requests administrator roles on the instance or an organization for a limited duration.
+// The roles are granted as soon as another administrator approves the request,
+// either as time-bound membership or, for existing administrators, in addition to the roles of their membership.
+type ElevationRequest struct {
+	// ResourceType is either [elevation.ResourceTypeInstance] or [elevation.ResourceTypeOrganization].
+	ResourceType string
+	// ResourceID is the id of the instance or organization.
+	ResourceID string
+	Roles      []string
+	Duration   time.Duration
+	// ValidFrom defers the membership to the given time, even if the request is approved earlier.
+	ValidFrom *time.Time
+	Reason    string
+}
+
+func (r *ElevationRequest) IsValid() error {
+	if r.ResourceID == "" || len(r.Roles) == 0 || r.Duration <= 0 {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-El1qR", "Errors.Elevation.Invalid")
+	}
+	if r.ResourceType != elevation.ResourceTypeInstance && r.ResourceType != elevation.ResourceTypeOrganization {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-El2tY", "Errors.Elevation.Invalid")
+	}
+	return nil
+}
+
+// RequestElevation requests the roles for the user of the context.
+// It returns the id of the elevation, which is needed by the approver.
+func (c *Commands) RequestElevation(ctx context.Context, request *ElevationRequest) (_ string, _ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := request.IsValid(); err != nil {
+		return "", nil, err
+	}
+	userID := authz.GetCtxData(ctx).UserID
+	if err := c.checkElevationRoles(ctx, request.ResourceType, request.Roles); err != nil {
+		return "", nil, err
+	}
+	if request.ResourceType == elevation.ResourceTypeOrganization {
+		if err := c.checkOrgExists(ctx, request.ResourceID); err != nil {
+			return "", nil, err
+		}
+	}
+	member, err := c.elevationMember(ctx, request.ResourceType, request.ResourceID, userID)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(elevatedRoles(member, request.Roles)) == 0 {
+		return "", nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-El3mB", "Errors.Elevation.RolesGranted")
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	wm := NewElevationWriteModel(id, request.ResourceID)
+	details, err := c.pushAppendAndReduceDetails(ctx, wm,
+		elevation.NewRequestedEvent(ctx,
+			&elevation.NewAggregate(id, request.ResourceID).Aggregate,
+			userID,
+			request.ResourceType,
+			request.Roles,
+			request.Duration,
+			request.ValidFrom,
+			request.Reason,
+		),
+	)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, details, nil
+}
+
+// ApproveElevation approves a pending elevation.
+// The approver must be allowed to manage the members of the resource and must not be the requester.
+// The roles are granted immediately, unless the request defines a later start.
+func (c *Commands) ApproveElevation(ctx context.Context, id string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm, err := c.pendingElevationWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	approverID := authz.GetCtxData(ctx).UserID
+	if approverID == wm.UserID || approverID == wm.RequesterID {
+		return nil, zerrors.ThrowPermissionDenied(nil, "COMMAND-El4sA", "Errors.Elevation.SelfApproval")
+	}
+	if err := c.checkPermissionUpdateElevation(ctx, wm); err != nil {
+		return nil, err
+	}
+	agg := &elevation.NewAggregate(wm.AggregateID, wm.ResourceOwner).Aggregate
+	if wm.ValidFrom != nil && wm.ValidFrom.After(time.Now()) {
+		return c.pushAppendAndReduceDetails(ctx, wm, elevation.NewApprovedEvent(ctx, agg, approverID, wm.ValidFrom))
+	}
+	member, err := c.elevationMember(ctx, wm.ResourceType, wm.ResourceOwner, wm.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(elevatedRoles(member, wm.Roles)) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-El5kC", "Errors.Elevation.RolesGranted")
+	}
+	events := append(
+		[]eventstore.Command{elevation.NewApprovedEvent(ctx, agg, approverID, nil)},
+		elevationActivatedEvents(ctx, wm, member, time.Now().Add(wm.Duration))...,
+	)
+	return c.pushAppendAndReduceDetails(ctx, wm, events...)
+}
+
+// DenyElevation denies a pending elevation, the roles are not granted.
+func (c *Commands) DenyElevation(ctx context.Context, id, reason string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm, err := c.pendingElevationWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPermissionUpdateElevation(ctx, wm); err != nil {
+		return nil, err
+	}
+	return c.pushAppendAndReduceDetails(ctx, wm,
+		elevation.NewDeniedEvent(ctx,
+			&elevation.NewAggregate(wm.AggregateID, wm.ResourceOwner).Aggregate,
+			authz.GetCtxData(ctx).UserID,
+			reason,
+		),
+	)
+}
+
+// ScheduleInstanceMember adds an already approved elevation, which grants the roles between validFrom and validUntil.
+// It is used if an administrator creates a membership starting in the future.
+func (c *Commands) ScheduleInstanceMember(ctx context.Context, member *AddInstanceMember, validFrom time.Time) (_ *domain.ObjectDetails, err error) {
+	if err := c.checkPermissionUpdateInstanceMember(ctx, member.InstanceID); err != nil {
+		return nil, err
+	}
+	return c.scheduleMember(ctx, elevation.ResourceTypeInstance, member.InstanceID, "", "", member.UserID, member.Roles, validFrom, member.ValidUntil)
+}
+
+// ScheduleOrgMember adds an already approved elevation, which grants the roles between validFrom and validUntil.
+// It is used if an administrator creates a membership starting in the future.
+func (c *Commands) ScheduleOrgMember(ctx context.Context, member *AddOrgMember, validFrom time.Time) (_ *domain.ObjectDetails, err error) {
+	if err := c.checkOrgExists(ctx, member.OrgID); err != nil {
+		return nil, err
+	}
+	if err := c.checkPermissionUpdateOrgMember(ctx, member.OrgID, member.OrgID); err != nil {
+		return nil, err
+	}
+	return c.scheduleMember(ctx, elevation.ResourceTypeOrganization, member.OrgID, "", "", member.UserID, member.Roles, validFrom, member.ValidUntil)
+}
+
+// ScheduleProjectMember adds an already approved elevation, which grants the roles on the project between validFrom and validUntil.
+// It is used if an administrator creates a membership starting in the future.
+func (c *Commands) ScheduleProjectMember(ctx context.Context, member *AddProjectMember, validFrom time.Time) (_ *domain.ObjectDetails, err error) {
+	if err := member.IsValid(c.zitadelRoles); err != nil {
+		return nil, err
+	}
+	resourceOwner, err := c.checkProjectExists(ctx, member.ProjectID, member.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPermissionUpdateProjectMember(ctx, resourceOwner, member.ProjectID); err != nil {
+		return nil, err
+	}
+	existingMember, err := c.projectMemberWriteModelByID(ctx, member.ProjectID, member.UserID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingMember.State.Exists() {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-El1pA", "Errors.Project.Member.AlreadyExists")
+	}
+	return c.scheduleMember(ctx, elevation.ResourceTypeProject, resourceOwner, member.ProjectID, "", member.UserID, member.Roles, validFrom, member.ValidUntil)
+}
+
+// ScheduleProjectGrantMember adds an already approved elevation, which grants the roles on the project grant between validFrom and validUntil.
+// It is used if an administrator creates a membership starting in the future.
+func (c *Commands) ScheduleProjectGrantMember(ctx context.Context, member *AddProjectGrantMember, validFrom time.Time) (_ *domain.ObjectDetails, err error) {
+	if err := member.IsValid(c.zitadelRoles); err != nil {
+		return nil, err
+	}
+	grantID, grantedOrgID, resourceOwner, err := c.checkProjectGrantExists(ctx, member.ProjectGrantID, member.OrganizationID, member.ProjectID, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPermissionUpdateProjectGrantMember(ctx, grantedOrgID, grantID); err != nil {
+		return nil, err
+	}
+	existingMember, err := c.projectGrantMemberWriteModelByID(ctx, member.ProjectID, member.UserID, grantID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if existingMember.State.Exists() {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-El2pG", "Errors.Project.Member.AlreadyExists")
+	}
+	return c.scheduleMember(ctx, elevation.ResourceTypeProjectGrant, resourceOwner, member.ProjectID, grantID, member.UserID, member.Roles, validFrom, member.ValidUntil)
+}
+
+// ScheduleApplicationMember adds an already approved elevation, which grants the roles on the application between validFrom and validUntil.
+// It is used if an administrator creates a membership starting in the future.
+func (c *Commands) ScheduleApplicationMember(ctx context.Context, member *ApplicationMember, validFrom time.Time) (_ *domain.ObjectDetails, err error) {
+	if err := member.IsValid(c.zitadelRoles); err != nil {
+		return nil, err
+	}
+	existingMember, err := c.applicationMemberWriteModelByID(ctx, member.ProjectID, member.AppID, member.UserID, member.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingMember.AppState.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-El3pN", "Errors.Project.App.NotExisting")
+	}
+	if err := c.checkPermissionUpdateProjectMember(ctx, existingMember.ResourceOwner, existingMember.AggregateID); err != nil {
+		return nil, err
+	}
+	if existingMember.State.Exists() {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-El4pA", "Errors.Project.App.Member.AlreadyExists")
+	}
+	return c.scheduleMember(ctx, elevation.ResourceTypeApplication, existingMember.ResourceOwner, member.ProjectID, member.AppID, member.UserID, member.Roles, validFrom, member.ValidUntil)
+}
+
+// ScheduleProjectRoleMember adds an already approved elevation, which grants the roles on the project role between validFrom and validUntil.
+// It is used if an administrator creates a membership starting in the future.
+func (c *Commands) ScheduleProjectRoleMember(ctx context.Context, member *ProjectRoleMember, validFrom time.Time) (_ *domain.ObjectDetails, err error) {
+	if err := member.IsValid(c.zitadelRoles); err != nil {
+		return nil, err
+	}
+	existingMember, err := c.projectRoleMemberWriteModelByID(ctx, member.ProjectID, member.RoleKey, member.UserID, member.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingMember.RoleState.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-El5pN", "Errors.Project.Role.NotExisting")
+	}
+	if err := c.checkPermissionUpdateProjectMember(ctx, existingMember.ResourceOwner, existingMember.AggregateID); err != nil {
+		return nil, err
+	}
+	if existingMember.State.Exists() {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-El6pA", "Errors.Project.Role.Member.AlreadyExists")
+	}
+	return c.scheduleMember(ctx, elevation.ResourceTypeProjectRole, existingMember.ResourceOwner, member.ProjectID, member.RoleKey, member.UserID, member.Roles, validFrom, member.ValidUntil)
+}
+
+// scheduleMember pushes the approved elevation on the resource owner, which is the instance or organization itself
+// or the organization of the project for the memberships of projects, project grants, applications and roles.
+func (c *Commands) scheduleMember(ctx context.Context, resourceType, resourceOwner, projectID, objectID, userID string, roles []string, validFrom time.Time, validUntil *time.Time) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || validUntil == nil || !validUntil.After(validFrom) || !validFrom.After(time.Now()) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-El6rV", "Errors.Elevation.Invalid")
+	}
+	if err := c.checkElevationRoles(ctx, resourceType, roles); err != nil {
+		return nil, err
+	}
+	if _, err := c.checkUserExists(ctx, userID, ""); err != nil {
+		return nil, err
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	agg := &elevation.NewAggregate(id, resourceOwner).Aggregate
+	requested := elevation.NewRequestedEvent(ctx, agg, userID, resourceType, roles, validUntil.Sub(validFrom), &validFrom, "")
+	requested.ProjectID = projectID
+	requested.ObjectID = objectID
+	return c.pushAppendAndReduceDetails(ctx, NewElevationWriteModel(id, resourceOwner),
+		requested,
+		elevation.NewApprovedEvent(ctx, agg, authz.GetCtxData(ctx).UserID, &validFrom),
+	)
+}
+
+// ActivateElevation grants the roles of an approved elevation, once its validity started.
+// It is executed by the scheduled access worker and therefore doesn't check any permission.
+func (c *Commands) ActivateElevation(ctx context.Context, id, resourceOwner string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm := NewElevationWriteModel(id, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return err
+	}
+	if wm.State != domain.ElevationStateApproved || !isDue(wm.ActivateAt) {
+		return nil
+	}
+	if wm.ProjectID != "" {
+		return c.activateProjectElevation(ctx, wm)
+	}
+	member, err := c.elevationMember(ctx, wm.ResourceType, wm.ResourceOwner, wm.UserID)
+	if err != nil {
+		return err
+	}
+	if len(elevatedRoles(member, wm.Roles)) == 0 {
+		_, err = c.eventstore.Push(ctx, elevation.NewCancelledEvent(ctx, &elevation.NewAggregate(wm.AggregateID, wm.ResourceOwner).Aggregate))
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, elevationActivatedEvents(ctx, wm, member, wm.ActivateAt.Add(wm.Duration))...)
+	return err
+}
+
+// ExpireElevation revokes the roles an elevation added to an existing membership, once its validity ended.
+// The roles the membership had before the elevation are kept.
+// Elevations of users without previous membership end with the time-bound membership itself.
+// It is executed by the scheduled access worker and therefore doesn't check any permission.
+func (c *Commands) ExpireElevation(ctx context.Context, id, resourceOwner string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm := NewElevationWriteModel(id, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return err
+	}
+	if wm.State != domain.ElevationStateActive || len(wm.ElevatedRoles) == 0 || !isDue(wm.ValidUntil) {
+		return nil
+	}
+	member, err := c.elevationMember(ctx, wm.ResourceType, wm.ResourceOwner, wm.UserID)
+	if err != nil {
+		return err
+	}
+	events := []eventstore.Command{
+		elevation.NewExpiredEvent(ctx, &elevation.NewAggregate(wm.AggregateID, wm.ResourceOwner).Aggregate),
+	}
+	if member.State.Exists() {
+		events = append(events, c.elevationMemberRevokedEvent(ctx, wm, member))
+	}
+	_, err = c.eventstore.Push(ctx, events...)
+	return err
+}
+
+func (c *Commands) pendingElevationWriteModel(ctx context.Context, id string) (_ *ElevationWriteModel, err error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-El7wN", "Errors.IDMissing")
+	}
+	wm := NewElevationWriteModel(id, "")
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	if !wm.State.IsPending() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-El8hP", "Errors.Elevation.NotFound")
+	}
+	return wm, nil
+}
+
+func (c *Commands) checkPermissionUpdateElevation(ctx context.Context, wm *ElevationWriteModel) error {
+	if wm.ResourceType == elevation.ResourceTypeInstance {
+		return c.checkPermissionUpdateInstanceMember(ctx, wm.ResourceOwner)
+	}
+	return c.checkPermissionUpdateOrgMember(ctx, wm.ResourceOwner, wm.ResourceOwner)
+}
+
+// checkElevationRoles checks that the roles can be granted on the resource type,
+// custom administrator roles included.
+func (c *Commands) checkElevationRoles(ctx context.Context, resourceType string, roles []string) error {
+	validRoles, err := c.administratorRoles(ctx, authz.GetInstance(ctx).InstanceID(), roles)
+	if err != nil {
+		return err
+	}
+	prefix := domain.IAMRolePrefix
+	switch resourceType {
+	case elevation.ResourceTypeOrganization:
+		prefix = domain.OrgRolePrefix
+	case elevation.ResourceTypeProject:
+		prefix = domain.ProjectRolePrefix
+	case elevation.ResourceTypeProjectGrant:
+		prefix = domain.ProjectGrantRolePrefix
+	case elevation.ResourceTypeApplication:
+		prefix = domain.ApplicationRolePrefix
+	case elevation.ResourceTypeProjectRole:
+		prefix = domain.ProjectRoleRolePrefix
+	}
+	if len(domain.CheckForInvalidRoles(roles, prefix, validRoles)) > 0 {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-El9vF", "Errors.Elevation.RoleInvalid")
+	}
+	return nil
+}
+
+// elevationMember returns the current membership of the user on the resource of the elevation.
+func (c *Commands) elevationMember(ctx context.Context, resourceType, resourceID, userID string) (*MemberWriteModel, error) {
+	if resourceType == elevation.ResourceTypeInstance {
+		member, err := c.instanceMemberWriteModelByID(ctx, resourceID, userID)
+		if err != nil {
+			return nil, err
+		}
+		return &member.MemberWriteModel, nil
+	}
+	member, err := c.orgMemberWriteModelByID(ctx, resourceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &member.MemberWriteModel, nil
+}
+
+// elevatedRoles returns the requested roles, which are not granted by the membership yet.
+func elevatedRoles(member *MemberWriteModel, roles []string) []string {
+	if !member.State.Exists() {
+		return roles
+	}
+	return slices.DeleteFunc(slices.Clone(roles), func(role string) bool {
+		return slices.Contains(member.Roles, role)
+	})
+}
+
+// elevationActivatedEvents grants the roles of the elevation until validUntil.
+// Users without membership are added as time-bound member,
+// existing memberships are extended by the missing roles, which are revoked by [Commands.ExpireElevation].
+func elevationActivatedEvents(ctx context.Context, wm *ElevationWriteModel, member *MemberWriteModel, validUntil time.Time) []eventstore.Command {
+	agg := &elevation.NewAggregate(wm.AggregateID, wm.ResourceOwner).Aggregate
+	if !member.State.Exists() {
+		return []eventstore.Command{
+			elevation.NewActivatedEvent(ctx, agg, validUntil),
+			elevationMemberAddedEvent(ctx, wm, validUntil),
+		}
+	}
+	roles := elevatedRoles(member, wm.Roles)
+	return []eventstore.Command{
+		elevation.NewActivatedEvent(ctx, agg, validUntil, roles...),
+		elevationMemberChangedEvent(ctx, wm, member, append(slices.Clone(member.Roles), roles...)),
+	}
+}
+
+// elevationMemberRevokedEvent removes the elevated roles from the membership
+// or the whole membership, if no other roles remain.
+func (c *Commands) elevationMemberRevokedEvent(ctx context.Context, wm *ElevationWriteModel, member *MemberWriteModel) eventstore.Command {
+	remaining := slices.DeleteFunc(slices.Clone(member.Roles), func(role string) bool {
+		return slices.Contains(wm.ElevatedRoles, role)
+	})
+	if len(remaining) > 0 {
+		return elevationMemberChangedEvent(ctx, wm, member, remaining)
+	}
+	if wm.ResourceType == elevation.ResourceTypeInstance {
+		return c.removeInstanceMember(ctx, &instance.NewAggregate(wm.ResourceOwner).Aggregate, wm.UserID, false)
+	}
+	return c.removeOrgMember(ctx, &org.NewAggregate(wm.ResourceOwner).Aggregate, wm.UserID, false)
+}
+
+// elevationMemberChangedEvent sets the roles of the membership and keeps its validity.
+func elevationMemberChangedEvent(ctx context.Context, wm *ElevationWriteModel, member *MemberWriteModel, roles []string) eventstore.Command {
+	if wm.ResourceType == elevation.ResourceTypeInstance {
+		changed := instance.NewMemberChangedEvent(ctx, &instance.NewAggregate(wm.ResourceOwner).Aggregate, wm.UserID, roles...)
+		changed.ValidUntil = member.ValidUntil
+		return changed
+	}
+	changed := org.NewMemberChangedEvent(ctx, &org.NewAggregate(wm.ResourceOwner).Aggregate, wm.UserID, roles...)
+	changed.ValidUntil = member.ValidUntil
+	return changed
+}
+
+func elevationMemberAddedEvent(ctx context.Context, wm *ElevationWriteModel, validUntil time.Time) eventstore.Command {
+	if wm.ResourceType == elevation.ResourceTypeInstance {
+		added := instance.NewMemberAddedEvent(ctx, &instance.NewAggregate(wm.ResourceOwner).Aggregate, wm.UserID, wm.Roles...)
+		added.ValidUntil = &validUntil
+		return added
+	}
+	added := org.NewMemberAddedEvent(ctx, &org.NewAggregate(wm.ResourceOwner).Aggregate, wm.UserID, wm.Roles...)
+	added.ValidUntil = &validUntil
+	return added
+}
+
+// activateProjectElevation adds the time-bound membership on the project, project grant, application or role.
+// The elevation is cancelled if the user became member in the meantime or the resource was removed.
+func (c *Commands) activateProjectElevation(ctx context.Context, wm *ElevationWriteModel) error {
+	validUntil := wm.ActivateAt.Add(wm.Duration)
+	added, err := c.projectElevationMemberAddedEvent(ctx, wm, validUntil)
+	if err != nil {
+		return err
+	}
+	agg := &elevation.NewAggregate(wm.AggregateID, wm.ResourceOwner).Aggregate
+	if added == nil {
+		_, err = c.eventstore.Push(ctx, elevation.NewCancelledEvent(ctx, agg))
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, elevation.NewActivatedEvent(ctx, agg, validUntil), added)
+	return err
+}
+
+// projectElevationMemberAddedEvent returns the event adding the membership of the elevation
+// or nil if the membership can't be added anymore.
+func (c *Commands) projectElevationMemberAddedEvent(ctx context.Context, wm *ElevationWriteModel, validUntil time.Time) (eventstore.Command, error) {
+	projectAgg := &project.NewAggregate(wm.ProjectID, wm.ResourceOwner).Aggregate
+	switch wm.ResourceType {
+	case elevation.ResourceTypeProject:
+		existingProject, err := c.getProjectWriteModelByID(ctx, wm.ProjectID, wm.ResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		member, err := c.projectMemberWriteModelByID(ctx, wm.ProjectID, wm.UserID, wm.ResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if !isProjectStateExists(existingProject.State) || member.State.Exists() {
+			return nil, nil
+		}
+		added := project.NewProjectMemberAddedEvent(ctx, projectAgg, wm.UserID, wm.Roles...)
+		added.ValidUntil = &validUntil
+		return added, nil
+	case elevation.ResourceTypeProjectGrant:
+		grant, err := c.projectGrantWriteModelByID(ctx, wm.ObjectID, "", wm.ProjectID, wm.ResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		member, err := c.projectGrantMemberWriteModelByID(ctx, wm.ProjectID, wm.UserID, wm.ObjectID, wm.ResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if !grant.State.Exists() || member.State.Exists() {
+			return nil, nil
+		}
+		added := project.NewProjectGrantMemberAddedEvent(ctx, projectAgg, wm.UserID, wm.ObjectID, wm.Roles...)
+		added.ValidUntil = &validUntil
+		return added, nil
+	case elevation.ResourceTypeApplication:
+		member, err := c.applicationMemberWriteModelByID(ctx, wm.ProjectID, wm.ObjectID, wm.UserID, wm.ResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if !member.AppState.Exists() || member.State.Exists() {
+			return nil, nil
+		}
+		added := project.NewApplicationMemberAddedEvent(ctx, projectAgg, wm.ObjectID, wm.UserID, wm.Roles...)
+		added.ValidUntil = &validUntil
+		return added, nil
+	case elevation.ResourceTypeProjectRole:
+		member, err := c.projectRoleMemberWriteModelByID(ctx, wm.ProjectID, wm.ObjectID, wm.UserID, wm.ResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if !member.RoleState.Exists() || member.State.Exists() {
+			return nil, nil
+		}
+		added := project.NewRoleMemberAddedEvent(ctx, projectAgg, wm.ObjectID, wm.UserID, wm.Roles...)
+		added.ValidUntil = &validUntil
+		return added, nil
+	}
+	return nil, nil
+}
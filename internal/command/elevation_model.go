@@ -0,0 +1,94 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/elevation"
+)
+
+type ElevationWriteModel struct {
+	eventstore.WriteModel
+
+	UserID       string
+	RequesterID  string
+	ResourceType string
+	Roles        []string
+	Duration     time.Duration
+	ValidFrom    *time.Time
+	ActivateAt   *time.Time
+	// ProjectID and ObjectID are set for the memberships of projects, project grants, applications and roles.
+	ProjectID string
+	ObjectID  string
+	// ValidUntil and ElevatedRoles are set once the elevation is activated.
+	// ElevatedRoles are the roles added to an existing membership.
+	ValidUntil    *time.Time
+	ElevatedRoles []string
+	State         domain.ElevationState
+}
+
+func NewElevationWriteModel(id, resourceOwner string) *ElevationWriteModel {
+	return &ElevationWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *ElevationWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *elevation.RequestedEvent:
+			wm.UserID = e.UserID
+			wm.RequesterID = e.Creator()
+			wm.ResourceType = e.ResourceType
+			wm.ProjectID = e.ProjectID
+			wm.ObjectID = e.ObjectID
+			wm.Roles = e.Roles
+			wm.Duration = e.Duration
+			wm.ValidFrom = e.ValidFrom
+			wm.ResourceOwner = e.Aggregate().ResourceOwner
+			wm.State = domain.ElevationStateRequested
+		case *elevation.ApprovedEvent:
+			wm.ActivateAt = e.ActivateAt
+			wm.State = domain.ElevationStateApproved
+		case *elevation.DeniedEvent:
+			wm.State = domain.ElevationStateDenied
+		case *elevation.ActivatedEvent:
+			wm.ValidUntil = &e.ValidUntil
+			wm.ElevatedRoles = e.ElevatedRoles
+			wm.State = domain.ElevationStateActive
+		case *elevation.CancelledEvent:
+			wm.State = domain.ElevationStateCancelled
+		case *elevation.ExpiredEvent:
+			wm.State = domain.ElevationStateExpired
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ElevationWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(elevation.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			elevation.RequestedType,
+			elevation.ApprovedType,
+			elevation.DeniedType,
+			elevation.ActivatedType,
+			elevation.CancelledType,
+			elevation.ExpiredType,
+		).
+		Builder()
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+func (wm *ElevationWriteModel) GetWriteModel() *eventstore.WriteModel {
+	return &wm.WriteModel
+}
@@ -0,0 +1,588 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/elevation"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var elevationTestZitadelRoles = []authz.RoleMapping{
+	{Role: "IAM_OWNER"},
+	{Role: "ORG_OWNER"},
+}
+
+func elevationRequestedEvent(ctx context.Context, resourceType, resourceOwner string, validFrom *time.Time) *elevation.RequestedEvent {
+	return elevation.NewRequestedEvent(ctx,
+		&elevation.NewAggregate("elevation1", resourceOwner).Aggregate,
+		"user1",
+		resourceType,
+		[]string{"IAM_OWNER"},
+		time.Hour,
+		validFrom,
+		"incident",
+	)
+}
+
+func TestCommandSide_RequestElevation(t *testing.T) {
+	t.Parallel()
+	ctx := authz.NewMockContext("INSTANCE", "ORG", "user1")
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		request *ElevationRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantID  string
+		want    *domain.ObjectDetails
+		wantErr func(error) bool
+	}{
+		{
+			name: "no duration, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				request: &ElevationRequest{
+					ResourceType: elevation.ResourceTypeInstance,
+					ResourceID:   "INSTANCE",
+					Roles:        []string{"IAM_OWNER"},
+				},
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "org role on instance, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				request: &ElevationRequest{
+					ResourceType: elevation.ResourceTypeInstance,
+					ResourceID:   "INSTANCE",
+					Roles:        []string{"ORG_OWNER"},
+					Duration:     time.Hour,
+				},
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "roles already granted, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewMemberAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"user1",
+								"IAM_OWNER",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				request: &ElevationRequest{
+					ResourceType: elevation.ResourceTypeInstance,
+					ResourceID:   "INSTANCE",
+					Roles:        []string{"IAM_OWNER"},
+					Duration:     time.Hour,
+				},
+			},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "request by existing member, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewMemberAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"user1",
+								"IAM_OWNER_VIEWER",
+							),
+						),
+					),
+					expectPush(
+						elevationRequestedEvent(ctx, elevation.ResourceTypeInstance, "INSTANCE", nil),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "elevation1"),
+			},
+			args: args{
+				request: &ElevationRequest{
+					ResourceType: elevation.ResourceTypeInstance,
+					ResourceID:   "INSTANCE",
+					Roles:        []string{"IAM_OWNER"},
+					Duration:     time.Hour,
+					Reason:       "incident",
+				},
+			},
+			wantID: "elevation1",
+			want: &domain.ObjectDetails{
+				ResourceOwner: "INSTANCE",
+			},
+		},
+		{
+			name: "request, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						elevationRequestedEvent(ctx, elevation.ResourceTypeInstance, "INSTANCE", nil),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "elevation1"),
+			},
+			args: args{
+				request: &ElevationRequest{
+					ResourceType: elevation.ResourceTypeInstance,
+					ResourceID:   "INSTANCE",
+					Roles:        []string{"IAM_OWNER"},
+					Duration:     time.Hour,
+					Reason:       "incident",
+				},
+			},
+			wantID: "elevation1",
+			want: &domain.ObjectDetails{
+				ResourceOwner: "INSTANCE",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:   tt.fields.eventstore(t),
+				idGenerator:  tt.fields.idGenerator,
+				zitadelRoles: elevationTestZitadelRoles,
+			}
+			gotID, got, err := c.RequestElevation(ctx, tt.args.request)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, gotID)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommandSide_ApproveElevation(t *testing.T) {
+	t.Parallel()
+	ctx := authz.NewMockContext("INSTANCE", "ORG", "approver1")
+	validFrom := time.Now().Add(time.Hour).UTC()
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		ctx     context.Context
+		want    *domain.ObjectDetails
+		wantErr func(error) bool
+	}{
+		{
+			name: "not found, error",
+			fields: fields{
+				eventstore:      expectEventstore(expectFilter()),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			ctx:     ctx,
+			wantErr: zerrors.IsNotFound,
+		},
+		{
+			name: "already denied, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(elevationRequestedEvent(context.Background(), elevation.ResourceTypeInstance, "INSTANCE", nil)),
+						eventFromEventPusher(elevation.NewDeniedEvent(context.Background(),
+							&elevation.NewAggregate("elevation1", "INSTANCE").Aggregate,
+							"approver1",
+							"",
+						)),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			ctx:     ctx,
+			wantErr: zerrors.IsNotFound,
+		},
+		{
+			name: "self approval, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(elevationRequestedEvent(context.Background(), elevation.ResourceTypeInstance, "INSTANCE", nil)),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			ctx:     authz.NewMockContext("INSTANCE", "ORG", "user1"),
+			wantErr: zerrors.IsPermissionDenied,
+		},
+		{
+			name: "no permission, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(elevationRequestedEvent(context.Background(), elevation.ResourceTypeInstance, "INSTANCE", nil)),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			ctx:     ctx,
+			wantErr: zerrors.IsPermissionDenied,
+		},
+		{
+			name: "roles granted meanwhile, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(elevationRequestedEvent(context.Background(), elevation.ResourceTypeInstance, "INSTANCE", nil)),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewMemberAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"user1",
+								"IAM_OWNER",
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			ctx:     ctx,
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "approve with future validity, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(elevationRequestedEvent(context.Background(), elevation.ResourceTypeInstance, "INSTANCE", &validFrom)),
+					),
+					expectPush(
+						elevation.NewApprovedEvent(ctx,
+							&elevation.NewAggregate("elevation1", "INSTANCE").Aggregate,
+							"approver1",
+							&validFrom,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			ctx: ctx,
+			want: &domain.ObjectDetails{
+				ResourceOwner: "INSTANCE",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.ApproveElevation(tt.ctx, "elevation1")
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommandSide_DenyElevation(t *testing.T) {
+	t.Parallel()
+	ctx := authz.NewMockContext("INSTANCE", "ORG", "approver1")
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		want    *domain.ObjectDetails
+		wantErr func(error) bool
+	}{
+		{
+			name: "no permission, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(elevationRequestedEvent(context.Background(), elevation.ResourceTypeOrganization, "org1", nil)),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			wantErr: zerrors.IsPermissionDenied,
+		},
+		{
+			name: "deny, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(elevationRequestedEvent(context.Background(), elevation.ResourceTypeOrganization, "org1", nil)),
+					),
+					expectPush(
+						elevation.NewDeniedEvent(ctx,
+							&elevation.NewAggregate("elevation1", "org1").Aggregate,
+							"approver1",
+							"not needed",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.DenyElevation(ctx, "elevation1", "not needed")
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommandSide_ActivateElevation(t *testing.T) {
+	t.Parallel()
+	activateAt := time.Now().Add(-time.Minute).UTC()
+	notDue := time.Now().Add(time.Hour).UTC()
+	validUntil := activateAt.Add(time.Hour)
+	approved := func(at *time.Time) *elevation.ApprovedEvent {
+		return elevation.NewApprovedEvent(context.Background(),
+			&elevation.NewAggregate("elevation1", "org1").Aggregate,
+			"approver1",
+			at,
+		)
+	}
+	memberAdded := org.NewMemberAddedEvent(context.Background(),
+		&org.NewAggregate("org1").Aggregate,
+		"user1",
+		"IAM_OWNER",
+	)
+	memberAdded.ValidUntil = &validUntil
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}{
+		{
+			name: "not approved, no change",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(elevationRequestedEvent(context.Background(), elevation.ResourceTypeOrganization, "org1", &activateAt)),
+				),
+			),
+		},
+		{
+			name: "not due, no change",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(elevationRequestedEvent(context.Background(), elevation.ResourceTypeOrganization, "org1", &notDue)),
+					eventFromEventPusher(approved(&notDue)),
+				),
+			),
+		},
+		{
+			name: "roles granted meanwhile, cancelled",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(elevationRequestedEvent(context.Background(), elevation.ResourceTypeOrganization, "org1", &activateAt)),
+					eventFromEventPusher(approved(&activateAt)),
+				),
+				expectFilter(
+					eventFromEventPusher(
+						org.NewMemberAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"user1",
+							"IAM_OWNER",
+						),
+					),
+				),
+				expectPush(
+					elevation.NewCancelledEvent(context.Background(),
+						&elevation.NewAggregate("elevation1", "org1").Aggregate,
+					),
+				),
+			),
+		},
+		{
+			name: "due, activated",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(elevationRequestedEvent(context.Background(), elevation.ResourceTypeOrganization, "org1", &activateAt)),
+					eventFromEventPusher(approved(&activateAt)),
+				),
+				expectFilter(),
+				expectPush(
+					elevation.NewActivatedEvent(context.Background(),
+						&elevation.NewAggregate("elevation1", "org1").Aggregate,
+						validUntil,
+					),
+					memberAdded,
+				),
+			),
+		},
+		{
+			name: "due for existing member, roles added",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(elevationRequestedEvent(context.Background(), elevation.ResourceTypeOrganization, "org1", &activateAt)),
+					eventFromEventPusher(approved(&activateAt)),
+				),
+				expectFilter(
+					eventFromEventPusher(
+						org.NewMemberAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"user1",
+							"ORG_USER_MANAGER",
+						),
+					),
+				),
+				expectPush(
+					elevation.NewActivatedEvent(context.Background(),
+						&elevation.NewAggregate("elevation1", "org1").Aggregate,
+						validUntil,
+						"IAM_OWNER",
+					),
+					org.NewMemberChangedEvent(context.Background(),
+						&org.NewAggregate("org1").Aggregate,
+						"user1",
+						"ORG_USER_MANAGER", "IAM_OWNER",
+					),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.ActivateElevation(context.Background(), "elevation1", "org1")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCommandSide_ExpireElevation(t *testing.T) {
+	t.Parallel()
+	activateAt := time.Now().Add(-2 * time.Hour).UTC()
+	validUntil := activateAt.Add(time.Hour)
+	notDue := time.Now().Add(time.Hour).UTC()
+	elevationAgg := &elevation.NewAggregate("elevation1", "org1").Aggregate
+	orgAgg := &org.NewAggregate("org1").Aggregate
+	elevated := func(validUntil time.Time, roles ...string) []eventstore.Event {
+		return []eventstore.Event{
+			eventFromEventPusher(elevationRequestedEvent(context.Background(), elevation.ResourceTypeOrganization, "org1", &activateAt)),
+			eventFromEventPusher(elevation.NewApprovedEvent(context.Background(), elevationAgg, "approver1", &activateAt)),
+			eventFromEventPusher(elevation.NewActivatedEvent(context.Background(), elevationAgg, validUntil, roles...)),
+		}
+	}
+	timeBoundMember := org.NewMemberAddedEvent(context.Background(), orgAgg, "user1", "ORG_USER_MANAGER")
+	timeBoundMember.ValidUntil = &notDue
+	timeBoundMemberChanged := org.NewMemberChangedEvent(context.Background(), orgAgg, "user1", "ORG_USER_MANAGER")
+	timeBoundMemberChanged.ValidUntil = &notDue
+	timeBoundMemberElevated := org.NewMemberChangedEvent(context.Background(), orgAgg, "user1", "ORG_USER_MANAGER", "IAM_OWNER")
+	timeBoundMemberElevated.ValidUntil = &notDue
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}{
+		{
+			name: "membership added by elevation, no change",
+			eventstore: expectEventstore(
+				expectFilter(elevated(validUntil)...),
+			),
+		},
+		{
+			name: "not due, no change",
+			eventstore: expectEventstore(
+				expectFilter(elevated(notDue, "IAM_OWNER")...),
+			),
+		},
+		{
+			name: "member removed meanwhile, expired",
+			eventstore: expectEventstore(
+				expectFilter(elevated(validUntil, "IAM_OWNER")...),
+				expectFilter(),
+				expectPush(
+					elevation.NewExpiredEvent(context.Background(), elevationAgg),
+				),
+			),
+		},
+		{
+			name: "elevated roles revoked, previous roles and validity kept",
+			eventstore: expectEventstore(
+				expectFilter(elevated(validUntil, "IAM_OWNER")...),
+				expectFilter(
+					eventFromEventPusher(timeBoundMember),
+					eventFromEventPusher(timeBoundMemberElevated),
+				),
+				expectPush(
+					elevation.NewExpiredEvent(context.Background(), elevationAgg),
+					timeBoundMemberChanged,
+				),
+			),
+		},
+		{
+			name: "no roles remain, member removed",
+			eventstore: expectEventstore(
+				expectFilter(elevated(validUntil, "IAM_OWNER")...),
+				expectFilter(
+					eventFromEventPusher(org.NewMemberAddedEvent(context.Background(), orgAgg, "user1", "ORG_USER_MANAGER")),
+					eventFromEventPusher(org.NewMemberChangedEvent(context.Background(), orgAgg, "user1", "IAM_OWNER")),
+				),
+				expectPush(
+					elevation.NewExpiredEvent(context.Background(), elevationAgg),
+					org.NewMemberRemovedEvent(context.Background(), orgAgg, "user1"),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.ExpireElevation(context.Background(), "elevation1", "org1")
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// GroupAdministrator is the membership of a group on the instance, an organization or a project.
+// All users of the group inherit its roles (e.g. ORG_OWNER) as long as they are part of the group.
+// A group can only administrate the instance, its own organization and the projects of its organization.
+type GroupAdministrator struct {
+	GroupID string
+	// OrganizationID is set for memberships on the organization of the group.
+	OrganizationID string
+	// ProjectID is set for memberships on a project of the organization of the group.
+	// If neither OrganizationID nor ProjectID is set, the membership is on the instance.
+	ProjectID string
+	Roles     []string
+}
+
+func (a *GroupAdministrator) IsValid() error {
+	if a.GroupID == "" || len(a.Roles) == 0 || (a.OrganizationID != "" && a.ProjectID != "") {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ga1vI", "Errors.Member.Invalid")
+	}
+	return nil
+}
+
+func (c *Commands) AddGroupAdministrator(ctx context.Context, administrator *GroupAdministrator) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := administrator.IsValid(); err != nil {
+		return nil, err
+	}
+	group, err := c.checkGroupExists(ctx, administrator.GroupID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkGroupAdministratorResource(ctx, administrator, group.ResourceOwner); err != nil {
+		return nil, err
+	}
+	wm, err := c.groupAdministratorWriteModel(ctx, administrator, group.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkPermissionUpdateGroupAdministrator(ctx, wm); err != nil {
+		return nil, err
+	}
+	if err := c.checkGroupAdministratorRoles(ctx, wm.aggregateType, administrator.Roles); err != nil {
+		return nil, err
+	}
+	if wm.State.Exists() {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Ga2aE", "Errors.Member.AlreadyExists")
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, newGroupAdministratorAddedEvent(ctx, wm, administrator.Roles))
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(wm, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// ChangeGroupAdministrator replaces the roles of an existing membership of a group.
+func (c *Commands) ChangeGroupAdministrator(ctx context.Context, administrator *GroupAdministrator) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := administrator.IsValid(); err != nil {
+		return nil, err
+	}
+	group, err := c.checkGroupExists(ctx, administrator.GroupID, nil)
+	if err != nil {
+		return nil, err
+	}
+	wm, err := c.groupAdministratorWriteModel(ctx, administrator, group.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ga3nF", "Errors.Member.NotFound")
+	}
+	if err := c.checkPermissionUpdateGroupAdministrator(ctx, wm); err != nil {
+		return nil, err
+	}
+	if err := c.checkGroupAdministratorRoles(ctx, wm.aggregateType, administrator.Roles); err != nil {
+		return nil, err
+	}
+	if slices.Compare(wm.Roles, administrator.Roles) == 0 {
+		return writeModelToObjectDetails(&wm.WriteModel), nil
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, newGroupAdministratorChangedEvent(ctx, wm, administrator.Roles))
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(wm, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// RemoveGroupAdministrator removes the membership of a group, the membership can be removed even if the group was already removed.
+func (c *Commands) RemoveGroupAdministrator(ctx context.Context, administrator *GroupAdministrator) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if administrator.GroupID == "" || (administrator.OrganizationID != "" && administrator.ProjectID != "") {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ga4vI", "Errors.Member.Invalid")
+	}
+	// the organization of the group is still known after its removal
+	group, err := c.getGroupWriteModelByID(ctx, administrator.GroupID, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	wm, err := c.groupAdministratorWriteModel(ctx, administrator, group.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return writeModelToObjectDetails(&wm.WriteModel), nil
+	}
+	if err := c.checkPermissionDeleteGroupAdministrator(ctx, wm); err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, newGroupAdministratorRemovedEvent(ctx, wm))
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(wm, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// checkGroupAdministratorResource ensures the group only administrates its own organization and the projects of it.
+func (c *Commands) checkGroupAdministratorResource(ctx context.Context, administrator *GroupAdministrator, groupOrgID string) error {
+	if administrator.OrganizationID != "" && administrator.OrganizationID != groupOrgID {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ga5rP", "Errors.Member.GroupResourceInvalid")
+	}
+	if administrator.ProjectID == "" {
+		return nil
+	}
+	_, err := c.checkProjectExists(ctx, administrator.ProjectID, groupOrgID)
+	return err
+}
+
+func (c *Commands) checkGroupAdministratorRoles(ctx context.Context, aggregateType eventstore.AggregateType, roles []string) error {
+	switch aggregateType {
+	case instance.AggregateType:
+		validRoles, err := c.administratorRoles(ctx, authz.GetInstance(ctx).InstanceID(), roles)
+		if err != nil {
+			return err
+		}
+		if len(domain.CheckForInvalidRoles(roles, domain.IAMRolePrefix, validRoles)) > 0 {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ga6rI", "Errors.Member.Invalid")
+		}
+	case org.AggregateType:
+		validRoles, err := c.administratorRoles(ctx, authz.GetInstance(ctx).InstanceID(), roles)
+		if err != nil {
+			return err
+		}
+		if len(domain.CheckForInvalidRoles(roles, domain.OrgRolePrefix, validRoles)) > 0 {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ga7rI", "Errors.Member.Invalid")
+		}
+	case project.AggregateType:
+		if len(domain.CheckForInvalidRoles(roles, domain.ProjectRolePrefix, c.zitadelRoles)) > 0 {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ga8rI", "Errors.Member.Invalid")
+		}
+	}
+	return nil
+}
+
+func (c *Commands) checkPermissionUpdateGroupAdministrator(ctx context.Context, wm *GroupAdministratorWriteModel) error {
+	switch wm.aggregateType {
+	case instance.AggregateType:
+		return c.checkPermissionUpdateInstanceMember(ctx, wm.AggregateID)
+	case org.AggregateType:
+		return c.checkPermissionUpdateOrgMember(ctx, wm.AggregateID, wm.AggregateID)
+	default:
+		return c.checkPermissionUpdateProjectMember(ctx, wm.ResourceOwner, wm.AggregateID)
+	}
+}
+
+func (c *Commands) checkPermissionDeleteGroupAdministrator(ctx context.Context, wm *GroupAdministratorWriteModel) error {
+	switch wm.aggregateType {
+	case instance.AggregateType:
+		return c.checkPermissionDeleteInstanceMember(ctx, wm.AggregateID)
+	case org.AggregateType:
+		return c.checkPermissionDeleteOrgMember(ctx, wm.AggregateID, wm.AggregateID)
+	default:
+		return c.checkPermissionDeleteProjectMember(ctx, wm.ResourceOwner, wm.AggregateID)
+	}
+}
+
+func (c *Commands) groupAdministratorWriteModel(ctx context.Context, administrator *GroupAdministrator, groupOrgID string) (_ *GroupAdministratorWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	var wm *GroupAdministratorWriteModel
+	switch {
+	case administrator.ProjectID != "":
+		wm = NewGroupAdministratorWriteModel(project.AggregateType, administrator.ProjectID, groupOrgID, administrator.GroupID)
+	case administrator.OrganizationID != "":
+		wm = NewGroupAdministratorWriteModel(org.AggregateType, administrator.OrganizationID, administrator.OrganizationID, administrator.GroupID)
+	default:
+		instanceID := authz.GetInstance(ctx).InstanceID()
+		wm = NewGroupAdministratorWriteModel(instance.AggregateType, instanceID, instanceID, administrator.GroupID)
+	}
+	if err = c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+func newGroupAdministratorAddedEvent(ctx context.Context, wm *GroupAdministratorWriteModel, roles []string) eventstore.Command {
+	switch wm.aggregateType {
+	case instance.AggregateType:
+		return instance.NewGroupMemberAddedEvent(ctx, InstanceAggregateFromWriteModel(&wm.WriteModel), wm.GroupID, roles...)
+	case org.AggregateType:
+		return org.NewGroupMemberAddedEvent(ctx, OrgAggregateFromWriteModelWithCTX(ctx, &wm.WriteModel), wm.GroupID, roles...)
+	default:
+		return project.NewGroupMemberAddedEvent(ctx, ProjectAggregateFromWriteModelWithCTX(ctx, &wm.WriteModel), wm.GroupID, roles...)
+	}
+}
+
+func newGroupAdministratorChangedEvent(ctx context.Context, wm *GroupAdministratorWriteModel, roles []string) eventstore.Command {
+	switch wm.aggregateType {
+	case instance.AggregateType:
+		return instance.NewGroupMemberChangedEvent(ctx, InstanceAggregateFromWriteModel(&wm.WriteModel), wm.GroupID, roles...)
+	case org.AggregateType:
+		return org.NewGroupMemberChangedEvent(ctx, OrgAggregateFromWriteModelWithCTX(ctx, &wm.WriteModel), wm.GroupID, roles...)
+	default:
+		return project.NewGroupMemberChangedEvent(ctx, ProjectAggregateFromWriteModelWithCTX(ctx, &wm.WriteModel), wm.GroupID, roles...)
+	}
+}
+
+func newGroupAdministratorRemovedEvent(ctx context.Context, wm *GroupAdministratorWriteModel) eventstore.Command {
+	switch wm.aggregateType {
+	case instance.AggregateType:
+		return instance.NewGroupMemberRemovedEvent(ctx, InstanceAggregateFromWriteModel(&wm.WriteModel), wm.GroupID)
+	case org.AggregateType:
+		return org.NewGroupMemberRemovedEvent(ctx, OrgAggregateFromWriteModelWithCTX(ctx, &wm.WriteModel), wm.GroupID)
+	default:
+		return project.NewGroupMemberRemovedEvent(ctx, ProjectAggregateFromWriteModelWithCTX(ctx, &wm.WriteModel), wm.GroupID)
+	}
+}
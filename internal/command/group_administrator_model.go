@@ -0,0 +1,140 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+// GroupAdministratorWriteModel is the membership of a group on the instance, an organization or a project.
+// The membership ends with the removal of the resource.
+type GroupAdministratorWriteModel struct {
+	eventstore.WriteModel
+
+	aggregateType eventstore.AggregateType
+	GroupID       string
+	Roles         []string
+	State         domain.MemberState
+}
+
+func NewGroupAdministratorWriteModel(aggregateType eventstore.AggregateType, aggregateID, resourceOwner, groupID string) *GroupAdministratorWriteModel {
+	return &GroupAdministratorWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   aggregateID,
+			ResourceOwner: resourceOwner,
+		},
+		aggregateType: aggregateType,
+		GroupID:       groupID,
+	}
+}
+
+func (wm *GroupAdministratorWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.GroupMemberAddedEvent:
+			if e.GroupID != wm.GroupID {
+				continue
+			}
+		case *instance.GroupMemberChangedEvent:
+			if e.GroupID != wm.GroupID {
+				continue
+			}
+		case *instance.GroupMemberRemovedEvent:
+			if e.GroupID != wm.GroupID {
+				continue
+			}
+		case *org.GroupMemberAddedEvent:
+			if e.GroupID != wm.GroupID {
+				continue
+			}
+		case *org.GroupMemberChangedEvent:
+			if e.GroupID != wm.GroupID {
+				continue
+			}
+		case *org.GroupMemberRemovedEvent:
+			if e.GroupID != wm.GroupID {
+				continue
+			}
+		case *project.GroupMemberAddedEvent:
+			if e.GroupID != wm.GroupID {
+				continue
+			}
+		case *project.GroupMemberChangedEvent:
+			if e.GroupID != wm.GroupID {
+				continue
+			}
+		case *project.GroupMemberRemovedEvent:
+			if e.GroupID != wm.GroupID {
+				continue
+			}
+		}
+		wm.WriteModel.AppendEvents(event)
+	}
+}
+
+func (wm *GroupAdministratorWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.GroupMemberAddedEvent:
+			wm.Roles = e.Roles
+			wm.State = domain.MemberStateActive
+		case *org.GroupMemberAddedEvent:
+			wm.Roles = e.Roles
+			wm.State = domain.MemberStateActive
+		case *project.GroupMemberAddedEvent:
+			wm.Roles = e.Roles
+			wm.State = domain.MemberStateActive
+		case *instance.GroupMemberChangedEvent:
+			wm.Roles = e.Roles
+		case *org.GroupMemberChangedEvent:
+			wm.Roles = e.Roles
+		case *project.GroupMemberChangedEvent:
+			wm.Roles = e.Roles
+		case *instance.GroupMemberRemovedEvent,
+			*org.GroupMemberRemovedEvent,
+			*project.GroupMemberRemovedEvent,
+			*instance.InstanceRemovedEvent,
+			*org.OrgRemovedEvent,
+			*project.ProjectRemovedEvent:
+			wm.Roles = nil
+			wm.State = domain.MemberStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *GroupAdministratorWriteModel) Query() *eventstore.SearchQueryBuilder {
+	var eventTypes []eventstore.EventType
+	switch wm.aggregateType {
+	case instance.AggregateType:
+		eventTypes = []eventstore.EventType{
+			instance.GroupMemberAddedEventType,
+			instance.GroupMemberChangedEventType,
+			instance.GroupMemberRemovedEventType,
+			instance.InstanceRemovedEventType,
+		}
+	case org.AggregateType:
+		eventTypes = []eventstore.EventType{
+			org.GroupMemberAddedEventType,
+			org.GroupMemberChangedEventType,
+			org.GroupMemberRemovedEventType,
+			org.OrgRemovedEventType,
+		}
+	case project.AggregateType:
+		eventTypes = []eventstore.EventType{
+			project.GroupMemberAddedEventType,
+			project.GroupMemberChangedEventType,
+			project.GroupMemberRemovedEventType,
+			project.ProjectRemovedType,
+		}
+	}
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(wm.aggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(eventTypes...).
+		Builder()
+}
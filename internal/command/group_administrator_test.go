@@ -0,0 +1,469 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_AddGroupAdministrator(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		administrator *GroupAdministrator
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	zitadelRoles := []authz.RoleMapping{
+		{Role: domain.RoleOrgOwner},
+		{Role: domain.RoleProjectOwner},
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "organization and project, invalid argument error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				administrator: &GroupAdministrator{
+					GroupID:        "group1",
+					OrganizationID: "org1",
+					ProjectID:      "project1",
+					Roles:          []string{domain.RoleOrgOwner},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "group not existing, precondition failed error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				administrator: &GroupAdministrator{
+					GroupID:        "group1",
+					OrganizationID: "org1",
+					Roles:          []string{domain.RoleOrgOwner},
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "other organization, precondition failed error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAdministratorGroupAddedEvent()),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				administrator: &GroupAdministrator{
+					GroupID:        "group1",
+					OrganizationID: "org2",
+					Roles:          []string{domain.RoleOrgOwner},
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "no permission, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAdministratorGroupAddedEvent()),
+					),
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				administrator: &GroupAdministrator{
+					GroupID:        "group1",
+					OrganizationID: "org1",
+					Roles:          []string{domain.RoleOrgOwner},
+				},
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "project role on organization, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAdministratorGroupAddedEvent()),
+					),
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				administrator: &GroupAdministrator{
+					GroupID:        "group1",
+					OrganizationID: "org1",
+					Roles:          []string{domain.RoleProjectOwner},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "membership already exists, already exists error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAdministratorGroupAddedEvent()),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewGroupMemberAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"group1",
+								domain.RoleOrgOwner,
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				administrator: &GroupAdministrator{
+					GroupID:        "group1",
+					OrganizationID: "org1",
+					Roles:          []string{domain.RoleOrgOwner},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "membership added, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAdministratorGroupAddedEvent()),
+					),
+					expectFilter(),
+					expectPush(
+						org.NewGroupMemberAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"group1",
+							domain.RoleOrgOwner,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				administrator: &GroupAdministrator{
+					GroupID:        "group1",
+					OrganizationID: "org1",
+					Roles:          []string{domain.RoleOrgOwner},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				zitadelRoles:    zitadelRoles,
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := r.AddGroupAdministrator(context.Background(), tt.args.administrator)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeGroupAdministrator(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		administrator *GroupAdministrator
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	zitadelRoles := []authz.RoleMapping{
+		{Role: domain.RoleOrgOwner},
+		{Role: domain.RoleOrgProjectCreator},
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "membership not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAdministratorGroupAddedEvent()),
+					),
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				administrator: &GroupAdministrator{
+					GroupID:        "group1",
+					OrganizationID: "org1",
+					Roles:          []string{domain.RoleOrgOwner},
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "membership changed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAdministratorGroupAddedEvent()),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewGroupMemberAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"group1",
+								domain.RoleOrgOwner,
+							),
+						),
+					),
+					expectPush(
+						org.NewGroupMemberChangedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"group1",
+							domain.RoleOrgProjectCreator,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				administrator: &GroupAdministrator{
+					GroupID:        "group1",
+					OrganizationID: "org1",
+					Roles:          []string{domain.RoleOrgProjectCreator},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				zitadelRoles:    zitadelRoles,
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := r.ChangeGroupAdministrator(context.Background(), tt.args.administrator)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveGroupAdministrator(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		administrator *GroupAdministrator
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "membership not existing, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAdministratorGroupAddedEvent()),
+					),
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				administrator: &GroupAdministrator{
+					GroupID:        "group1",
+					OrganizationID: "org1",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "no permission, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAdministratorGroupAddedEvent()),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewGroupMemberAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"group1",
+								domain.RoleOrgOwner,
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				administrator: &GroupAdministrator{
+					GroupID:        "group1",
+					OrganizationID: "org1",
+				},
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "membership of removed group removed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAdministratorGroupAddedEvent()),
+						eventFromEventPusher(
+							group.NewGroupRemovedEvent(context.Background(),
+								&group.NewAggregate("group1", "org1").Aggregate,
+								"group",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewGroupMemberAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"group1",
+								domain.RoleOrgOwner,
+							),
+						),
+					),
+					expectPush(
+						org.NewGroupMemberRemovedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"group1",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				administrator: &GroupAdministrator{
+					GroupID:        "group1",
+					OrganizationID: "org1",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := r.RemoveGroupAdministrator(context.Background(), tt.args.administrator)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func groupAdministratorGroupAddedEvent() *group.GroupAddedEvent {
+	return group.NewGroupAddedEvent(context.Background(),
+		&group.NewAggregate("group1", "org1").Aggregate,
+		"group",
+		"",
+	)
+}
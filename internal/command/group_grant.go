@@ -0,0 +1,189 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/groupgrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddGroupGrant authorizes all members of a group for a project with the given role keys.
+// The grant is always created in the organization of the group,
+// which must either own the project or have it granted.
+// Group grants only cover project roles, administrator roles are granted to groups with [Commands.AddGroupAdministrator].
+func (c *Commands) AddGroupGrant(ctx context.Context, groupGrant *domain.GroupGrant, check UserGrantPermissionCheck) (_ *domain.GroupGrant, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !groupGrant.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Gg3vQ", "Errors.GroupGrant.Invalid")
+	}
+	if err = c.checkGroupGrantPreCondition(ctx, groupGrant, check); err != nil {
+		return nil, err
+	}
+	groupGrant.AggregateID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+
+	addedGroupGrant := NewGroupGrantWriteModel(groupGrant.AggregateID, groupGrant.ResourceOwner)
+	err = c.pushAppendAndReduce(ctx,
+		addedGroupGrant,
+		groupgrant.NewGroupGrantAddedEvent(
+			ctx,
+			GroupGrantAggregateFromWriteModel(&addedGroupGrant.WriteModel),
+			groupGrant.GroupID,
+			groupGrant.ProjectID,
+			groupGrant.ProjectGrantID,
+			groupGrant.RoleKeys,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return groupGrantWriteModelToGroupGrant(addedGroupGrant), nil
+}
+
+// ChangeGroupGrant replaces the role keys of an existing group grant.
+func (c *Commands) ChangeGroupGrant(ctx context.Context, groupGrant *domain.GroupGrant, ignoreUnchanged bool, check UserGrantPermissionCheck) (_ *domain.GroupGrant, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupGrant.AggregateID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-b2Fxk", "Errors.GroupGrant.Invalid")
+	}
+	existingGroupGrant, err := c.groupGrantWriteModelByID(ctx, groupGrant.AggregateID, "")
+	if err != nil {
+		return nil, err
+	}
+	if !existingGroupGrant.exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pe0gV", "Errors.GroupGrant.NotFound")
+	}
+	if slices.Equal(existingGroupGrant.RoleKeys, groupGrant.RoleKeys) {
+		if ignoreUnchanged {
+			return groupGrantWriteModelToGroupGrant(existingGroupGrant), nil
+		}
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Wq5dk", "Errors.GroupGrant.NotChanged")
+	}
+	groupGrant.GroupID = existingGroupGrant.GroupID
+	groupGrant.ProjectID = existingGroupGrant.ProjectID
+	groupGrant.ProjectGrantID = existingGroupGrant.ProjectGrantID
+	groupGrant.ResourceOwner = existingGroupGrant.ResourceOwner
+
+	if err = c.checkGroupGrantPreCondition(ctx, groupGrant, check); err != nil {
+		return nil, err
+	}
+
+	err = c.pushAppendAndReduce(ctx,
+		existingGroupGrant,
+		groupgrant.NewGroupGrantChangedEvent(
+			ctx,
+			GroupGrantAggregateFromWriteModel(&existingGroupGrant.WriteModel),
+			existingGroupGrant.GroupID,
+			groupGrant.RoleKeys,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return groupGrantWriteModelToGroupGrant(existingGroupGrant), nil
+}
+
+// RemoveGroupGrant removes the group grant and with it the authorizations the members of the group inherited.
+func (c *Commands) RemoveGroupGrant(ctx context.Context, grantID, resourceOwner string, ignoreNotFound bool, check UserGrantPermissionCheck) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if grantID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ux7rN", "Errors.GroupGrant.IDMissing")
+	}
+	existingGroupGrant, err := c.groupGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingGroupGrant.exists() {
+		if ignoreNotFound {
+			return writeModelToObjectDetails(&existingGroupGrant.WriteModel), nil
+		}
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ko2rT", "Errors.GroupGrant.NotFound")
+	}
+	if check != nil {
+		if err = check(existingGroupGrant.ProjectID, existingGroupGrant.ProjectGrantID)(existingGroupGrant.ResourceOwner, ""); err != nil {
+			return nil, err
+		}
+	}
+	return c.pushAppendAndReduceDetails(ctx,
+		existingGroupGrant,
+		groupgrant.NewGroupGrantRemovedEvent(
+			ctx,
+			GroupGrantAggregateFromWriteModel(&existingGroupGrant.WriteModel),
+			existingGroupGrant.GroupID,
+			existingGroupGrant.ProjectID,
+			existingGroupGrant.ProjectGrantID,
+		),
+	)
+}
+
+func (c *Commands) groupGrantWriteModelByID(ctx context.Context, groupGrantID, resourceOwner string) (writeModel *GroupGrantWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewGroupGrantWriteModel(groupGrantID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) checkGroupGrantPreCondition(ctx context.Context, groupGrant *domain.GroupGrant, check UserGrantPermissionCheck) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existingGroup, err := c.checkGroupExists(ctx, groupGrant.GroupID, nil)
+	if err != nil {
+		return err
+	}
+	if groupGrant.ResourceOwner == "" {
+		groupGrant.ResourceOwner = existingGroup.ResourceOwner
+	}
+	if groupGrant.ResourceOwner != existingGroup.ResourceOwner {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Hq9vE", "Errors.Group.NotFound")
+	}
+	preConditions := NewGroupGrantPreConditionReadModel(groupGrant.ProjectID, groupGrant.ProjectGrantID, groupGrant.ResourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, preConditions)
+	if err != nil {
+		return err
+	}
+	if groupGrant.ProjectGrantID == "" {
+		groupGrant.ProjectGrantID = preConditions.FoundGrantID
+	}
+	projectIsOwned := groupGrant.ResourceOwner == preConditions.ProjectResourceOwner
+	if projectIsOwned && !preConditions.ProjectExists {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rz4mL", "Errors.Project.NotFound")
+	}
+	if !projectIsOwned && preConditions.FoundGrantID == "" {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Vd8sJ", "Errors.Project.Grant.NotFound")
+	}
+	if groupGrant.HasInvalidRoles(preConditions.existingRoles()) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tn2cF", "Errors.Project.Role.NotFound")
+	}
+	if check != nil {
+		return check(groupGrant.ProjectID, groupGrant.ProjectGrantID)(groupGrant.ResourceOwner, "")
+	}
+	return nil
+}
+
+func groupGrantWriteModelToGroupGrant(writeModel *GroupGrantWriteModel) *domain.GroupGrant {
+	return &domain.GroupGrant{
+		ObjectRoot:     writeModelToObjectRoot(writeModel.WriteModel),
+		GroupID:        writeModel.GroupID,
+		ProjectID:      writeModel.ProjectID,
+		ProjectGrantID: writeModel.ProjectGrantID,
+		RoleKeys:       writeModel.RoleKeys,
+		State:          writeModel.State,
+	}
+}
@@ -0,0 +1,577 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/groupgrant"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_AddGroupGrant(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator func(t *testing.T) id.Generator
+	}
+	type args struct {
+		groupGrant *domain.GroupGrant
+		check      UserGrantPermissionCheck
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *domain.GroupGrant
+		wantErr func(error) bool
+	}{
+		{
+			name: "invalid group grant, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				groupGrant: &domain.GroupGrant{
+					GroupID: "group1",
+				},
+				check: succeedingUserGrantPermissionCheck,
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "group not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				groupGrant: &domain.GroupGrant{
+					GroupID:   "group1",
+					ProjectID: "project1",
+				},
+				check: succeedingUserGrantPermissionCheck,
+			},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "group of other organization, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(addNewGroupEvent("group1", "org2")),
+					),
+				),
+			},
+			args: args{
+				groupGrant: &domain.GroupGrant{
+					ObjectRoot: models.ObjectRoot{ResourceOwner: "org1"},
+					GroupID:    "group1",
+					ProjectID:  "project1",
+				},
+				check: succeedingUserGrantPermissionCheck,
+			},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "project not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(addNewGroupEvent("group1", "org1")),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				groupGrant: &domain.GroupGrant{
+					GroupID:   "group1",
+					ProjectID: "project1",
+				},
+				check: succeedingUserGrantPermissionCheck,
+			},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "project of other organization not granted, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(addNewGroupEvent("group1", "org1")),
+					),
+					expectFilter(
+						eventFromEventPusher(addNewGroupGrantProjectEvent("project1", "org2")),
+						eventFromEventPusher(addNewGroupGrantProjectRoleEvent("project1", "org2", "rolekey1")),
+					),
+				),
+			},
+			args: args{
+				groupGrant: &domain.GroupGrant{
+					GroupID:   "group1",
+					ProjectID: "project1",
+					RoleKeys:  []string{"rolekey1"},
+				},
+				check: succeedingUserGrantPermissionCheck,
+			},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "role not found, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(addNewGroupEvent("group1", "org1")),
+					),
+					expectFilter(
+						eventFromEventPusher(addNewGroupGrantProjectEvent("project1", "org1")),
+						eventFromEventPusher(addNewGroupGrantProjectRoleEvent("project1", "org1", "rolekey1")),
+					),
+				),
+			},
+			args: args{
+				groupGrant: &domain.GroupGrant{
+					GroupID:   "group1",
+					ProjectID: "project1",
+					RoleKeys:  []string{"rolekey2"},
+				},
+				check: succeedingUserGrantPermissionCheck,
+			},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "missing permission, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(addNewGroupEvent("group1", "org1")),
+					),
+					expectFilter(
+						eventFromEventPusher(addNewGroupGrantProjectEvent("project1", "org1")),
+						eventFromEventPusher(addNewGroupGrantProjectRoleEvent("project1", "org1", "rolekey1")),
+					),
+				),
+			},
+			args: args{
+				groupGrant: &domain.GroupGrant{
+					GroupID:   "group1",
+					ProjectID: "project1",
+					RoleKeys:  []string{"rolekey1"},
+				},
+				check: failingUserGrantPermissionCheck,
+			},
+			wantErr: isMockedPermissionCheckErr,
+		},
+		{
+			name: "group grant on owned project, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(addNewGroupEvent("group1", "org1")),
+					),
+					expectFilter(
+						eventFromEventPusher(addNewGroupGrantProjectEvent("project1", "org1")),
+						eventFromEventPusher(addNewGroupGrantProjectRoleEvent("project1", "org1", "rolekey1")),
+					),
+					expectPush(
+						groupgrant.NewGroupGrantAddedEvent(context.Background(),
+							&groupgrant.NewAggregate("groupgrant1", "org1").Aggregate,
+							"group1",
+							"project1",
+							"",
+							[]string{"rolekey1"},
+						),
+					),
+				),
+				idGenerator: func(t *testing.T) id.Generator {
+					return id_mock.NewIDGeneratorExpectIDs(t, "groupgrant1")
+				},
+			},
+			args: args{
+				groupGrant: &domain.GroupGrant{
+					GroupID:   "group1",
+					ProjectID: "project1",
+					RoleKeys:  []string{"rolekey1"},
+				},
+				check: succeedingUserGrantPermissionCheck,
+			},
+			want: &domain.GroupGrant{
+				ObjectRoot: models.ObjectRoot{
+					AggregateID:   "groupgrant1",
+					ResourceOwner: "org1",
+				},
+				GroupID:   "group1",
+				ProjectID: "project1",
+				RoleKeys:  []string{"rolekey1"},
+				State:     domain.UserGrantStateActive,
+			},
+		},
+		{
+			name: "group grant on granted project, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(addNewGroupEvent("group1", "org2")),
+					),
+					expectFilter(
+						eventFromEventPusher(addNewGroupGrantProjectEvent("project1", "org1")),
+						eventFromEventPusher(addNewGroupGrantProjectRoleEvent("project1", "org1", "rolekey1")),
+						eventFromEventPusher(
+							project.NewGrantAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectgrant1",
+								"org2",
+								[]string{"rolekey1"},
+							),
+						),
+					),
+					expectPush(
+						groupgrant.NewGroupGrantAddedEvent(context.Background(),
+							&groupgrant.NewAggregate("groupgrant1", "org2").Aggregate,
+							"group1",
+							"project1",
+							"projectgrant1",
+							[]string{"rolekey1"},
+						),
+					),
+				),
+				idGenerator: func(t *testing.T) id.Generator {
+					return id_mock.NewIDGeneratorExpectIDs(t, "groupgrant1")
+				},
+			},
+			args: args{
+				groupGrant: &domain.GroupGrant{
+					GroupID:   "group1",
+					ProjectID: "project1",
+					RoleKeys:  []string{"rolekey1"},
+				},
+				check: succeedingUserGrantPermissionCheck,
+			},
+			want: &domain.GroupGrant{
+				ObjectRoot: models.ObjectRoot{
+					AggregateID:   "groupgrant1",
+					ResourceOwner: "org2",
+				},
+				GroupID:        "group1",
+				ProjectID:      "project1",
+				ProjectGrantID: "projectgrant1",
+				RoleKeys:       []string{"rolekey1"},
+				State:          domain.UserGrantStateActive,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			if tt.fields.idGenerator != nil {
+				c.idGenerator = tt.fields.idGenerator(t)
+			}
+			got, err := c.AddGroupGrant(context.Background(), tt.args.groupGrant, tt.args.check)
+			if tt.wantErr != nil {
+				require.True(t, tt.wantErr(err), err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommandSide_ChangeGroupGrant(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		groupGrant      *domain.GroupGrant
+		ignoreUnchanged bool
+		check           UserGrantPermissionCheck
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		args       args
+		want       *domain.GroupGrant
+		wantErr    func(error) bool
+	}{
+		{
+			name:       "missing id, error",
+			eventstore: expectEventstore(),
+			args: args{
+				groupGrant: &domain.GroupGrant{},
+				check:      succeedingUserGrantPermissionCheck,
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "group grant not found, error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			args: args{
+				groupGrant: &domain.GroupGrant{
+					ObjectRoot: models.ObjectRoot{AggregateID: "groupgrant1"},
+					RoleKeys:   []string{"rolekey1"},
+				},
+				check: succeedingUserGrantPermissionCheck,
+			},
+			wantErr: zerrors.IsNotFound,
+		},
+		{
+			name: "roles unchanged, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(addNewGroupGrantEvent("groupgrant1", "org1", "rolekey1")),
+				),
+			),
+			args: args{
+				groupGrant: &domain.GroupGrant{
+					ObjectRoot: models.ObjectRoot{AggregateID: "groupgrant1"},
+					RoleKeys:   []string{"rolekey1"},
+				},
+				check: succeedingUserGrantPermissionCheck,
+			},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "roles unchanged, ignored, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(addNewGroupGrantEvent("groupgrant1", "org1", "rolekey1")),
+				),
+			),
+			args: args{
+				groupGrant: &domain.GroupGrant{
+					ObjectRoot: models.ObjectRoot{AggregateID: "groupgrant1"},
+					RoleKeys:   []string{"rolekey1"},
+				},
+				ignoreUnchanged: true,
+				check:           succeedingUserGrantPermissionCheck,
+			},
+			want: &domain.GroupGrant{
+				ObjectRoot: models.ObjectRoot{
+					AggregateID:   "groupgrant1",
+					ResourceOwner: "org1",
+				},
+				GroupID:   "group1",
+				ProjectID: "project1",
+				RoleKeys:  []string{"rolekey1"},
+				State:     domain.UserGrantStateActive,
+			},
+		},
+		{
+			name: "missing permission, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(addNewGroupGrantEvent("groupgrant1", "org1", "rolekey1")),
+				),
+				expectFilter(
+					eventFromEventPusher(addNewGroupEvent("group1", "org1")),
+				),
+				expectFilter(
+					eventFromEventPusher(addNewGroupGrantProjectEvent("project1", "org1")),
+					eventFromEventPusher(addNewGroupGrantProjectRoleEvent("project1", "org1", "rolekey1")),
+					eventFromEventPusher(addNewGroupGrantProjectRoleEvent("project1", "org1", "rolekey2")),
+				),
+			),
+			args: args{
+				groupGrant: &domain.GroupGrant{
+					ObjectRoot: models.ObjectRoot{AggregateID: "groupgrant1"},
+					RoleKeys:   []string{"rolekey1", "rolekey2"},
+				},
+				check: failingUserGrantPermissionCheck,
+			},
+			wantErr: isMockedPermissionCheckErr,
+		},
+		{
+			name: "roles changed, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(addNewGroupGrantEvent("groupgrant1", "org1", "rolekey1")),
+				),
+				expectFilter(
+					eventFromEventPusher(addNewGroupEvent("group1", "org1")),
+				),
+				expectFilter(
+					eventFromEventPusher(addNewGroupGrantProjectEvent("project1", "org1")),
+					eventFromEventPusher(addNewGroupGrantProjectRoleEvent("project1", "org1", "rolekey1")),
+					eventFromEventPusher(addNewGroupGrantProjectRoleEvent("project1", "org1", "rolekey2")),
+				),
+				expectPush(
+					groupgrant.NewGroupGrantChangedEvent(context.Background(),
+						&groupgrant.NewAggregate("groupgrant1", "org1").Aggregate,
+						"group1",
+						[]string{"rolekey1", "rolekey2"},
+					),
+				),
+			),
+			args: args{
+				groupGrant: &domain.GroupGrant{
+					ObjectRoot: models.ObjectRoot{AggregateID: "groupgrant1"},
+					RoleKeys:   []string{"rolekey1", "rolekey2"},
+				},
+				check: succeedingUserGrantPermissionCheck,
+			},
+			want: &domain.GroupGrant{
+				ObjectRoot: models.ObjectRoot{
+					AggregateID:   "groupgrant1",
+					ResourceOwner: "org1",
+				},
+				GroupID:   "group1",
+				ProjectID: "project1",
+				RoleKeys:  []string{"rolekey1", "rolekey2"},
+				State:     domain.UserGrantStateActive,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.ChangeGroupGrant(context.Background(), tt.args.groupGrant, tt.args.ignoreUnchanged, tt.args.check)
+			if tt.wantErr != nil {
+				require.True(t, tt.wantErr(err), err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommandSide_RemoveGroupGrant(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		grantID        string
+		resourceOwner  string
+		ignoreNotFound bool
+		check          UserGrantPermissionCheck
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		args       args
+		want       *domain.ObjectDetails
+		wantErr    func(error) bool
+	}{
+		{
+			name:       "missing id, error",
+			eventstore: expectEventstore(),
+			args: args{
+				check: succeedingUserGrantPermissionCheck,
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "group grant not found, error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			args: args{
+				grantID: "groupgrant1",
+				check:   succeedingUserGrantPermissionCheck,
+			},
+			wantErr: zerrors.IsNotFound,
+		},
+		{
+			name: "group grant not found, ignored, ok",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			args: args{
+				grantID:        "groupgrant1",
+				ignoreNotFound: true,
+				check:          succeedingUserGrantPermissionCheck,
+			},
+			want: &domain.ObjectDetails{},
+		},
+		{
+			name: "missing permission, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(addNewGroupGrantEvent("groupgrant1", "org1", "rolekey1")),
+				),
+			),
+			args: args{
+				grantID: "groupgrant1",
+				check:   failingUserGrantPermissionCheck,
+			},
+			wantErr: isMockedPermissionCheckErr,
+		},
+		{
+			name: "group grant removed, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(addNewGroupGrantEvent("groupgrant1", "org1", "rolekey1")),
+				),
+				expectPush(
+					groupgrant.NewGroupGrantRemovedEvent(context.Background(),
+						&groupgrant.NewAggregate("groupgrant1", "org1").Aggregate,
+						"group1",
+						"project1",
+						"",
+					),
+				),
+			),
+			args: args{
+				grantID: "groupgrant1",
+				check:   succeedingUserGrantPermissionCheck,
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.RemoveGroupGrant(context.Background(), tt.args.grantID, tt.args.resourceOwner, tt.args.ignoreNotFound, tt.args.check)
+			if tt.wantErr != nil {
+				require.True(t, tt.wantErr(err), err)
+				return
+			}
+			require.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func addNewGroupGrantEvent(groupGrantID, orgID string, roleKeys ...string) *groupgrant.GroupGrantAddedEvent {
+	return groupgrant.NewGroupGrantAddedEvent(context.Background(),
+		&groupgrant.NewAggregate(groupGrantID, orgID).Aggregate,
+		"group1",
+		"project1",
+		"",
+		roleKeys,
+	)
+}
+
+func addNewGroupGrantProjectEvent(projectID, orgID string) *project.ProjectAddedEvent {
+	return project.NewProjectAddedEvent(context.Background(),
+		&project.NewAggregate(projectID, orgID).Aggregate,
+		"projectname1", true, true, true,
+		domain.PrivateLabelingSettingUnspecified,
+	)
+}
+
+func addNewGroupGrantProjectRoleEvent(projectID, orgID, key string) *project.RoleAddedEvent {
+	return project.NewRoleAddedEvent(context.Background(),
+		&project.NewAggregate(projectID, orgID).Aggregate,
+		key,
+		key,
+		"",
+	)
+}
@@ -2,10 +2,13 @@ package command
 
 import (
 	"context"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/command/preparation"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/idp/providers/wsfed"
 	"github.com/zitadel/zitadel/internal/repository/idp"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -137,6 +140,40 @@ type AppleProvider struct {
 	IDPOptions idp.Options
 }
 
+type WSFederationProvider struct {
+	Name        string
+	SSOEndpoint string
+	Realm       string
+	// Certificate contains the PEM encoded token signing certificate(s) of the provider
+	Certificate []byte
+	// IDClaim is the claim used as id of the federated user, the NameID is used if empty
+	IDClaim    string
+	IDPOptions idp.Options
+}
+
+// validate trims and checks the configuration,
+// the certificate is only required when adding the provider, on updates the existing one is kept if empty.
+func (p *WSFederationProvider) validate(certificateRequired bool) error {
+	if p.Name = strings.TrimSpace(p.Name); p.Name == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Wf4nA", "Errors.Invalid.Argument")
+	}
+	p.SSOEndpoint = strings.TrimSpace(p.SSOEndpoint)
+	if endpoint, err := url.Parse(p.SSOEndpoint); err != nil || !endpoint.IsAbs() || endpoint.Host == "" {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-Wf4nE", "Errors.IDP.WSFederation.SSOEndpointInvalid")
+	}
+	if p.Realm = strings.TrimSpace(p.Realm); p.Realm == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Wf4nR", "Errors.IDP.WSFederation.RealmMissing")
+	}
+	p.IDClaim = strings.TrimSpace(p.IDClaim)
+	if len(p.Certificate) == 0 && !certificateRequired {
+		return nil
+	}
+	if _, err := wsfed.ParseCertificates(p.Certificate); err != nil {
+		return err
+	}
+	return nil
+}
+
 // ExistsIDPOnOrgOrInstance query first org level IDPs and then instance level IDPs, no check if the IDP is active
 func ExistsIDPOnOrgOrInstance(ctx context.Context, filter preparation.FilterToQueryReducer, instanceID, orgID, id string) (exists bool, err error) {
 	ctx, span := tracing.NewSpan(ctx)
@@ -0,0 +1,381 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/zitadel/logging"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetIDPClaimMappings replaces the claim mappings of the identity provider.
+// The groups of the mappings of an identity provider of an organization must belong to the organization
+// and the roles to a project of the organization.
+func (c *Commands) SetIDPClaimMappings(ctx context.Context, idpID string, mappings []*domain.IDPClaimMapping) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if idpID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Cm3vI", "Errors.IDMissing")
+	}
+	for _, mapping := range mappings {
+		if err := mapping.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	idpWriteModel := NewIDPTypeWriteModel(idpID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, idpWriteModel); err != nil {
+		return nil, err
+	}
+	if idpWriteModel.State != domain.IDPStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Cm3iN", "Errors.IDPConfig.NotExisting")
+	}
+	if err := c.checkPermissionIDPWrite(ctx, idpWriteModel.ResourceOwner, idpID); err != nil {
+		return nil, err
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	for _, mapping := range mappings {
+		if err := c.checkIDPClaimMappingTarget(ctx, mapping, idpWriteModel.ResourceOwner, instanceID); err != nil {
+			return nil, err
+		}
+	}
+
+	writeModel := NewIDPClaimMappingsWriteModel(idpID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	var event eventstore.Command
+	if idpWriteModel.ResourceOwner == instanceID {
+		event = instance.NewIDPClaimMappingsSetEvent(ctx, &instance.NewAggregate(instanceID).Aggregate, idpID, mappings)
+	} else {
+		event = org.NewIDPClaimMappingsSetEvent(ctx, &org.NewAggregate(idpWriteModel.ResourceOwner).Aggregate, idpID, mappings)
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, event); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) checkIDPClaimMappingTarget(ctx context.Context, mapping *domain.IDPClaimMapping, idpResourceOwner, instanceID string) error {
+	if mapping.GroupID != "" {
+		group, err := c.checkGroupExists(ctx, mapping.GroupID, nil)
+		if err != nil {
+			return err
+		}
+		if idpResourceOwner != instanceID && group.ResourceOwner != idpResourceOwner {
+			return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cm3tG", "Errors.Group.NotFound")
+		}
+		return nil
+	}
+	projectResourceOwner, err := c.checkProjectExists(ctx, mapping.ProjectID, "")
+	if err != nil {
+		return err
+	}
+	if idpResourceOwner != instanceID && projectResourceOwner != idpResourceOwner {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cm3tP", "Errors.Project.NotFound")
+	}
+	role, err := c.getProjectRoleWriteModelByID(ctx, mapping.RoleKey, mapping.ProjectID, projectResourceOwner)
+	if err != nil {
+		return err
+	}
+	if role.State != domain.ProjectRoleStateActive {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Cm3tR", "Errors.Project.Role.NotExisting")
+	}
+	return nil
+}
+
+// applyIDPClaimMappings grants the group memberships and roles of the mappings matching the claims to the user
+// and revokes the ones granted on a previous login, which don't match anymore.
+func (c *Commands) applyIDPClaimMappings(ctx context.Context, idpID, userID string, claims map[string][]string) error {
+	cmds, err := c.idpClaimMappingsCommands(ctx, idpID, userID, claims)
+	if err != nil || len(cmds) == 0 {
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, cmds...)
+	return err
+}
+
+// idpClaimMappingsCommands returns the commands applying the claim mappings to the user.
+// Memberships and roles the user already had without the mappings are neither recorded nor revoked.
+// Roles are granted on the organization owning the project.
+func (c *Commands) idpClaimMappingsCommands(ctx context.Context, idpID, userID string, claims map[string][]string) (_ []eventstore.Command, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	mappings := NewIDPClaimMappingsWriteModel(idpID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, mappings); err != nil {
+		return nil, err
+	}
+	applied := NewUserIDPClaimMappingsWriteModel(userID, idpID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, applied); err != nil {
+		return nil, err
+	}
+	if len(mappings.Mappings) == 0 && len(applied.GroupIDs) == 0 && len(applied.Roles) == 0 {
+		return nil, nil
+	}
+	userResourceOwner, err := c.checkUserExists(ctx, userID, "")
+	if err != nil {
+		return nil, err
+	}
+	groupIDs, roles := domain.MappedIDPClaims(mappings.Mappings, claims)
+
+	cmds, appliedGroupIDs, err := c.applyIDPClaimMappingGroups(ctx, userID, userResourceOwner, applied.GroupIDs, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+	grantCmds, appliedRoles, err := c.applyIDPClaimMappingRoles(ctx, userID, applied.Roles, roles)
+	if err != nil {
+		return nil, err
+	}
+	cmds = append(cmds, grantCmds...)
+	if !slices.Equal(appliedGroupIDs, applied.GroupIDs) || !slices.Equal(appliedRoles, applied.Roles) {
+		cmds = append(cmds, user.NewUserIDPClaimMappingsAppliedEvent(
+			ctx,
+			&user.NewAggregate(userID, userResourceOwner).Aggregate,
+			idpID,
+			appliedGroupIDs,
+			appliedRoles,
+		))
+	}
+	return cmds, nil
+}
+
+func (c *Commands) applyIDPClaimMappingGroups(ctx context.Context, userID, userResourceOwner string, previous, mapped []string) (cmds []eventstore.Command, applied []string, err error) {
+	applied = make([]string, 0, len(mapped))
+	for _, groupID := range mapped {
+		groupWriteModel, err := c.idpClaimMappingGroup(ctx, groupID, userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		// users can only be members of the groups of their organization
+		if groupWriteModel == nil || groupWriteModel.ResourceOwner != userResourceOwner {
+			continue
+		}
+		if _, isMember := groupWriteModel.existingUserIDs[userID]; isMember {
+			if slices.Contains(previous, groupID) {
+				applied = append(applied, groupID)
+			}
+			continue
+		}
+		cmds = append(cmds, group.NewGroupUsersAddedEvent(ctx, GroupAggregateFromWriteModel(ctx, &groupWriteModel.WriteModel), []string{userID}))
+		applied = append(applied, groupID)
+	}
+	for _, groupID := range previous {
+		if slices.Contains(mapped, groupID) {
+			continue
+		}
+		groupWriteModel, err := c.idpClaimMappingGroup(ctx, groupID, userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if groupWriteModel == nil {
+			continue
+		}
+		if _, isMember := groupWriteModel.existingUserIDs[userID]; isMember {
+			cmds = append(cmds, group.NewGroupUsersRemovedEvent(ctx, GroupAggregateFromWriteModel(ctx, &groupWriteModel.WriteModel), []string{userID}))
+		}
+	}
+	return cmds, applied, nil
+}
+
+// idpClaimMappingGroup returns the group or nil if it was removed since the mapping was set.
+func (c *Commands) idpClaimMappingGroup(ctx context.Context, groupID, userID string) (*GroupWriteModel, error) {
+	groupWriteModel, err := c.checkGroupExists(ctx, groupID, []string{userID})
+	if zerrors.IsPreconditionFailed(err) {
+		return nil, nil
+	}
+	return groupWriteModel, err
+}
+
+func (c *Commands) applyIDPClaimMappingRoles(ctx context.Context, userID string, previous, mapped []domain.IDPClaimMappingRole) (cmds []eventstore.Command, applied []domain.IDPClaimMappingRole, err error) {
+	applied = make([]domain.IDPClaimMappingRole, 0, len(mapped))
+	projectIDs := make([]string, 0)
+	for _, role := range slices.Concat(mapped, previous) {
+		if !slices.Contains(projectIDs, role.ProjectID) {
+			projectIDs = append(projectIDs, role.ProjectID)
+		}
+	}
+	for _, projectID := range projectIDs {
+		mappedKeys := idpClaimMappingRoleKeys(mapped, projectID)
+		previousKeys := idpClaimMappingRoleKeys(previous, projectID)
+		grant, err := c.idpClaimMappingUserGrant(ctx, userID, projectID)
+		if err != nil {
+			return nil, nil, err
+		}
+		var existingKeys []string
+		if grant != nil {
+			existingKeys = grant.RoleKeys
+		}
+		roleKeys := make([]string, 0, len(existingKeys)+len(mappedKeys))
+		for _, key := range existingKeys {
+			if slices.Contains(previousKeys, key) && !slices.Contains(mappedKeys, key) {
+				continue
+			}
+			roleKeys = append(roleKeys, key)
+		}
+		projectApplied := make([]domain.IDPClaimMappingRole, 0, len(mappedKeys))
+		for _, key := range mappedKeys {
+			// roles granted without the mappings are kept on revocation
+			if slices.Contains(existingKeys, key) && !slices.Contains(previousKeys, key) {
+				continue
+			}
+			if !slices.Contains(roleKeys, key) {
+				roleKeys = append(roleKeys, key)
+			}
+			projectApplied = append(projectApplied, domain.IDPClaimMappingRole{ProjectID: projectID, RoleKey: key})
+		}
+		grantCmds, err := c.idpClaimMappingUserGrantCommands(ctx, userID, projectID, grant, roleKeys)
+		if err != nil {
+			logging.WithFields("user", userID, "project", projectID).WithError(err).Warn("unable to grant roles of idp claim mappings")
+			continue
+		}
+		cmds = append(cmds, grantCmds...)
+		applied = append(applied, projectApplied...)
+	}
+	return cmds, applied, nil
+}
+
+func idpClaimMappingRoleKeys(roles []domain.IDPClaimMappingRole, projectID string) []string {
+	keys := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if role.ProjectID == projectID {
+			keys = append(keys, role.RoleKey)
+		}
+	}
+	return keys
+}
+
+// idpClaimMappingUserGrant returns the existing grant of the user on the organization owning the project, if any.
+func (c *Commands) idpClaimMappingUserGrant(ctx context.Context, userID, projectID string) (*UserGrantWriteModel, error) {
+	grantIDs := newUserGrantIDsOfProjectWriteModel(userID, projectID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, grantIDs); err != nil {
+		return nil, err
+	}
+	for _, grantID := range grantIDs.grantIDs {
+		grant, err := c.userGrantWriteModelByID(ctx, grantID, "")
+		if err != nil {
+			return nil, err
+		}
+		if grant.ProjectGrantID == "" && (grant.State == domain.UserGrantStateActive || grant.State == domain.UserGrantStateInactive) {
+			return grant, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *Commands) idpClaimMappingUserGrantCommands(ctx context.Context, userID, projectID string, grant *UserGrantWriteModel, roleKeys []string) ([]eventstore.Command, error) {
+	if grant == nil {
+		if len(roleKeys) == 0 {
+			return nil, nil
+		}
+		cmds, _, err := c.addUserGrant(ctx, &domain.UserGrant{
+			UserID:    userID,
+			ProjectID: projectID,
+			RoleKeys:  roleKeys,
+		}, nil)
+		return cmds, err
+	}
+	if slices.Equal(grant.RoleKeys, roleKeys) {
+		return nil, nil
+	}
+	aggregate := UserGrantAggregateFromWriteModel(&grant.WriteModel)
+	// the grant only consisted of revoked roles of the mappings
+	if len(roleKeys) == 0 {
+		return []eventstore.Command{
+			usergrant.NewUserGrantRemovedEvent(ctx, aggregate, grant.UserID, grant.ProjectID, grant.ProjectGrantID),
+		}, nil
+	}
+	return []eventstore.Command{
+		usergrant.NewUserGrantChangedEvent(ctx, aggregate, grant.UserID, roleKeys),
+	}, nil
+}
+
+// IDPSessionUserClaims returns the claims of the external user authenticated by the session,
+// e.g. to apply the claim mappings on a login through the login UI.
+func IDPSessionUserClaims(session idp.Session, idpUser idp.User) map[string][]string {
+	if ldapSession, ok := session.(*ldap.Session); ok {
+		return ldapEntryAttributes(ldapSession)
+	}
+	idpInfo, err := json.Marshal(idpUser)
+	if err != nil {
+		return make(map[string][]string)
+	}
+	return idpUserClaims(idpInfo, oidcTokensOfIDPSession(session))
+}
+
+// idpIntentUserClaims returns the claims of the external user of a succeeded intent.
+func idpIntentUserClaims(intent *IDPIntentWriteModel) map[string][]string {
+	if intent.IDPEntryAttributes != nil {
+		return intent.IDPEntryAttributes
+	}
+	var tokens *oidc.Tokens[*oidc.IDTokenClaims]
+	if intent.IDPIDToken != "" {
+		idTokenClaims := new(oidc.IDTokenClaims)
+		// the id token was already verified when the intent succeeded
+		if _, err := oidc.ParseToken(intent.IDPIDToken, idTokenClaims); err == nil {
+			tokens = &oidc.Tokens[*oidc.IDTokenClaims]{IDTokenClaims: idTokenClaims}
+		}
+	}
+	return idpUserClaims(intent.IDPUser, tokens)
+}
+
+// idpUserClaims returns the claims of the external user as strings by their name.
+// Besides the information of the user, the attributes of SAML and the claims of the ID token are included.
+func idpUserClaims(idpInfo []byte, tokens *oidc.Tokens[*oidc.IDTokenClaims]) map[string][]string {
+	claims := make(map[string][]string)
+	info := make(map[string]any)
+	if err := json.Unmarshal(idpInfo, &info); err == nil {
+		addIDPUserClaims(claims, info)
+		if attributes, ok := info["attributes"].(map[string]any); ok {
+			addIDPUserClaims(claims, attributes)
+		}
+	}
+	if tokens != nil && tokens.IDTokenClaims != nil {
+		addIDPUserClaims(claims, tokens.IDTokenClaims.Claims)
+	}
+	return claims
+}
+
+func addIDPUserClaims(claims map[string][]string, values map[string]any) {
+	for name, value := range values {
+		switch v := value.(type) {
+		case string:
+			claims[name] = append(claims[name], v)
+		case []any:
+			for _, item := range v {
+				if s, ok := item.(string); ok {
+					claims[name] = append(claims[name], s)
+				}
+			}
+		case float64, bool:
+			claims[name] = append(claims[name], fmt.Sprint(v))
+		}
+	}
+}
+
+// ApplyIDPClaimMappings applies the claim mappings of the identity provider to the user the external user is linked to.
+// The user is already authenticated, so failures are only logged and the mappings are applied on the next login.
+// If the external user is not linked yet, the mappings are applied once the link is used, e.g. on the check of the intent.
+func (c *Commands) ApplyIDPClaimMappings(ctx context.Context, idpID, userID string, claims map[string][]string) {
+	if userID == "" {
+		return
+	}
+	if err := c.applyIDPClaimMappings(ctx, idpID, userID, claims); err != nil {
+		logging.WithFields("idp", idpID, "user", userID).WithError(err).Warn("unable to apply idp claim mappings")
+	}
+}
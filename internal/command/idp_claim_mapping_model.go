@@ -0,0 +1,128 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+// IDPClaimMappingsWriteModel contains the claim mappings of an identity provider of the instance or an organization.
+type IDPClaimMappingsWriteModel struct {
+	eventstore.WriteModel
+
+	ID       string
+	Mappings []*domain.IDPClaimMapping
+}
+
+func NewIDPClaimMappingsWriteModel(id string) *IDPClaimMappingsWriteModel {
+	return &IDPClaimMappingsWriteModel{
+		ID: id,
+	}
+}
+
+func (wm *IDPClaimMappingsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *instance.IDPClaimMappingsSetEvent:
+			wm.Mappings = e.Mappings
+		case *org.IDPClaimMappingsSetEvent:
+			wm.Mappings = e.Mappings
+		case *instance.IDPRemovedEvent, *org.IDPRemovedEvent:
+			wm.Mappings = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *IDPClaimMappingsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		EventTypes(
+			instance.IDPClaimMappingsSetEventType,
+			instance.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Or().
+		AggregateTypes(org.AggregateType).
+		EventTypes(
+			org.IDPClaimMappingsSetEventType,
+			org.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+// UserIDPClaimMappingsWriteModel contains the group memberships and roles,
+// which were granted to the user by the claim mappings of the identity provider.
+type UserIDPClaimMappingsWriteModel struct {
+	eventstore.WriteModel
+
+	IDPConfigID string
+	GroupIDs    []string
+	Roles       []domain.IDPClaimMappingRole
+}
+
+func NewUserIDPClaimMappingsWriteModel(userID, idpConfigID string) *UserIDPClaimMappingsWriteModel {
+	return &UserIDPClaimMappingsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID: userID,
+		},
+		IDPConfigID: idpConfigID,
+	}
+}
+
+func (wm *UserIDPClaimMappingsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		if e, ok := event.(*user.UserIDPClaimMappingsAppliedEvent); ok {
+			wm.GroupIDs = e.GroupIDs
+			wm.Roles = e.Roles
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserIDPClaimMappingsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.UserIDPClaimMappingsAppliedType).
+		EventData(map[string]interface{}{"idpConfigId": wm.IDPConfigID}).
+		Builder()
+}
+
+// userGrantIDsOfProjectWriteModel returns the IDs of all grants ever added to the user for the project.
+type userGrantIDsOfProjectWriteModel struct {
+	eventstore.WriteModel
+
+	userID    string
+	projectID string
+	grantIDs  []string
+}
+
+func newUserGrantIDsOfProjectWriteModel(userID, projectID string) *userGrantIDsOfProjectWriteModel {
+	return &userGrantIDsOfProjectWriteModel{
+		userID:    userID,
+		projectID: projectID,
+	}
+}
+
+func (wm *userGrantIDsOfProjectWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		wm.grantIDs = append(wm.grantIDs, event.Aggregate().ID)
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *userGrantIDsOfProjectWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(usergrant.AggregateType).
+		EventTypes(usergrant.UserGrantAddedType).
+		EventData(map[string]interface{}{"userId": wm.userID, "projectId": wm.projectID}).
+		Builder()
+}
@@ -0,0 +1,253 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SetIDPClaimMappings(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "admin1")
+	mappings := []*domain.IDPClaimMapping{{Claim: "groups", Value: "admins", GroupID: "group1"}}
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		mappings []*domain.IDPClaimMapping
+		res      res
+	}{
+		{
+			name: "invalid mapping, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			mappings: []*domain.IDPClaimMapping{{Claim: "groups", Value: "admins", GroupID: "group1", ProjectID: "project1", RoleKey: "role1"}},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "idp not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			mappings: mappings,
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "no permission, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(ldapSyncInstanceIDPAddedEvent()),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			mappings: mappings,
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "group of other organization than idp, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(ldapSyncOrgIDPAddedEvent()),
+					),
+					expectFilter(
+						eventFromEventPusher(group.NewGroupAddedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "admins", "")),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			mappings: mappings,
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(ldapSyncInstanceIDPAddedEvent()),
+					),
+					expectFilter(
+						eventFromEventPusher(group.NewGroupAddedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "admins", "")),
+					),
+					expectFilter(),
+					expectPush(
+						instance.NewIDPClaimMappingsSetEvent(ctx, &instance.NewAggregate("instance1").Aggregate, "idp1", mappings),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			mappings: mappings,
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.SetIDPClaimMappings(ctx, "idp1", tt.mappings)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_applyIDPClaimMappings(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	groupAgg := &group.NewAggregate("group1", "org1").Aggregate
+	grantAgg := &usergrant.NewAggregate("grant1", "org1").Aggregate
+	role := domain.IDPClaimMappingRole{ProjectID: "project1", RoleKey: "role1"}
+	mappingsSet := eventFromEventPusher(org.NewIDPClaimMappingsSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "idp1",
+		[]*domain.IDPClaimMapping{
+			{Claim: "groups", Value: "admins", GroupID: "group1"},
+			{Claim: "groups", Value: "admins", ProjectID: "project1", RoleKey: "role1"},
+		},
+	))
+	humanAdded := eventFromEventPusher(newAddHumanEvent("", false, false, "", language.English))
+	groupAdded := eventFromEventPusher(group.NewGroupAddedEvent(context.Background(), groupAgg, "admins", ""))
+	grantAdded := func(roleKeys ...string) eventstore.Event {
+		return eventFromEventPusher(usergrant.NewUserGrantAddedEvent(context.Background(), grantAgg, "user1", "project1", "", roleKeys))
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		claims     map[string][]string
+		wantErr    func(error) bool
+	}{
+		{
+			name: "no mappings, no changes",
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectFilter(),
+			),
+			claims: map[string][]string{"groups": {"admins"}},
+		},
+		{
+			name: "user not existing, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(mappingsSet),
+				expectFilter(),
+				expectFilter(),
+			),
+			claims:  map[string][]string{"groups": {"admins"}},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "matching claim, membership added and role granted",
+			eventstore: expectEventstore(
+				expectFilter(mappingsSet),
+				expectFilter(),
+				expectFilter(humanAdded),
+				expectFilter(groupAdded),
+				expectFilter(grantAdded()),
+				expectFilter(grantAdded("other")),
+				expectPush(
+					group.NewGroupUsersAddedEvent(ctx, groupAgg, []string{"user1"}),
+					usergrant.NewUserGrantChangedEvent(ctx, grantAgg, "user1", []string{"other", "role1"}),
+					user.NewUserIDPClaimMappingsAppliedEvent(ctx, userAgg, "idp1", []string{"group1"}, []domain.IDPClaimMappingRole{role}),
+				),
+			),
+			claims: map[string][]string{"groups": {"Admins"}},
+		},
+		{
+			name: "existing membership and role, not recorded",
+			eventstore: expectEventstore(
+				expectFilter(mappingsSet),
+				expectFilter(),
+				expectFilter(humanAdded),
+				expectFilter(groupAdded, eventFromEventPusher(group.NewGroupUsersAddedEvent(context.Background(), groupAgg, []string{"user1"}))),
+				expectFilter(grantAdded()),
+				expectFilter(grantAdded("role1")),
+			),
+			claims: map[string][]string{"groups": {"admins"}},
+		},
+		{
+			name: "claim removed, membership and grant revoked",
+			eventstore: expectEventstore(
+				expectFilter(mappingsSet),
+				expectFilter(
+					eventFromEventPusher(user.NewUserIDPClaimMappingsAppliedEvent(context.Background(), userAgg, "idp1", []string{"group1"}, []domain.IDPClaimMappingRole{role})),
+				),
+				expectFilter(humanAdded),
+				expectFilter(groupAdded, eventFromEventPusher(group.NewGroupUsersAddedEvent(context.Background(), groupAgg, []string{"user1"}))),
+				expectFilter(grantAdded()),
+				expectFilter(grantAdded("role1")),
+				expectPush(
+					group.NewGroupUsersRemovedEvent(ctx, groupAgg, []string{"user1"}),
+					usergrant.NewUserGrantRemovedEvent(ctx, grantAgg, "user1", "project1", ""),
+					user.NewUserIDPClaimMappingsAppliedEvent(ctx, userAgg, "idp1", []string{}, []domain.IDPClaimMappingRole{}),
+				),
+			),
+			claims: map[string][]string{"groups": {"users"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.applyIDPClaimMappings(ctx, "idp1", "user1", tt.claims)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			}
+			if tt.wantErr != nil && !tt.wantErr(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
+func Test_idpUserClaims(t *testing.T) {
+	got := idpUserClaims([]byte(`{"id":"id","email_verified":true,"groups":["a","b",1],"attributes":{"memberOf":["cn=admins"]}}`), nil)
+	assert.Equal(t, map[string][]string{
+		"id":             {"id"},
+		"email_verified": {"true"},
+		"groups":         {"a", "b"},
+		"memberOf":       {"cn=admins"},
+	}, got)
+}
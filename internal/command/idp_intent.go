@@ -188,6 +188,7 @@ func (c *Commands) SucceedIDPIntent(ctx context.Context, writeModel *IDPIntentWr
 	if err != nil {
 		return "", err
 	}
+	c.ApplyIDPClaimMappings(ctx, writeModel.IDPID, userID, idpUserClaims(idpInfo, oidcTokensOfIDPSession(idpSession)))
 	return token, nil
 }
 
@@ -222,6 +223,7 @@ func (c *Commands) SucceedSAMLIDPIntent(ctx context.Context, writeModel *IDPInte
 	if err != nil {
 		return "", err
 	}
+	c.ApplyIDPClaimMappings(ctx, writeModel.IDPID, userID, idpUserClaims(idpInfo, nil))
 	return token, nil
 }
 
@@ -250,10 +252,7 @@ func (c *Commands) SucceedLDAPIDPIntent(ctx context.Context, writeModel *IDPInte
 	if err != nil {
 		return "", err
 	}
-	attributes := make(map[string][]string, len(session.Entry.Attributes))
-	for _, item := range session.Entry.Attributes {
-		attributes[item.Name] = item.Values
-	}
+	attributes := ldapEntryAttributes(session)
 	cmd := idpintent.NewLDAPSucceededEvent(
 		ctx,
 		IDPIntentAggregateFromWriteModel(&writeModel.WriteModel),
@@ -268,6 +267,7 @@ func (c *Commands) SucceedLDAPIDPIntent(ctx context.Context, writeModel *IDPInte
 	if err != nil {
 		return "", err
 	}
+	c.ApplyIDPClaimMappings(ctx, writeModel.IDPID, userID, attributes)
 	return token, nil
 }
 
@@ -290,28 +290,43 @@ func (c *Commands) GetIntentWriteModel(ctx context.Context, id, resourceOwner st
 	return writeModel, err
 }
 
+func ldapEntryAttributes(session *ldap.Session) map[string][]string {
+	attributes := make(map[string][]string, len(session.Entry.Attributes))
+	for _, item := range session.Entry.Attributes {
+		attributes[item.Name] = item.Values
+	}
+	return attributes
+}
+
 // tokensForSucceededIDPIntent extracts the oidc.Tokens if available (and encrypts the access_token) for the succeeded event payload
 func tokensForSucceededIDPIntent(session idp.Session, encryptionAlg crypto.EncryptionAlgorithm) (*crypto.CryptoValue, string, error) {
-	var tokens *oidc.Tokens[*oidc.IDTokenClaims]
+	tokens := oidcTokensOfIDPSession(session)
+	if tokens == nil {
+		return nil, "", nil
+	}
+	if tokens.Token == nil || tokens.AccessToken == "" {
+		return nil, tokens.IDToken, nil
+	}
+	accessToken, err := crypto.Encrypt([]byte(tokens.AccessToken), encryptionAlg)
+	return accessToken, tokens.IDToken, err
+}
+
+// oidcTokensOfIDPSession returns the oidc.Tokens of the session, if the provider issued any
+func oidcTokensOfIDPSession(session idp.Session) *oidc.Tokens[*oidc.IDTokenClaims] {
 	switch s := session.(type) {
 	case *oauth.Session:
-		tokens = s.Tokens
+		return s.Tokens
 	case *openid.Session:
-		tokens = s.Tokens
+		return s.Tokens
 	case *jwt.Session:
-		tokens = s.Tokens
+		return s.Tokens
 	case *github.Session:
-		tokens = s.Tokens()
+		return s.Tokens()
 	case *azuread.Session:
-		tokens = s.Tokens()
+		return s.Tokens()
 	case *apple.Session:
-		tokens = s.Tokens
+		return s.Tokens
 	default:
-		return nil, "", nil
-	}
-	if tokens.Token == nil || tokens.AccessToken == "" {
-		return nil, tokens.IDToken, nil
+		return nil
 	}
-	accessToken, err := crypto.Encrypt([]byte(tokens.AccessToken), encryptionAlg)
-	return accessToken, tokens.IDToken, err
 }
@@ -1179,6 +1179,8 @@ func TestCommands_SucceedSAMLIDPIntent(t *testing.T) {
 							time.Time{},
 						),
 					),
+					expectFilter(),
+					expectFilter(),
 				),
 			},
 			args{
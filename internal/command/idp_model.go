@@ -26,6 +26,7 @@ import (
 	"github.com/zitadel/zitadel/internal/idp/providers/oidc"
 	saml2 "github.com/zitadel/zitadel/internal/idp/providers/saml"
 	"github.com/zitadel/zitadel/internal/idp/providers/saml/requesttracker"
+	"github.com/zitadel/zitadel/internal/idp/providers/wsfed"
 	"github.com/zitadel/zitadel/internal/repository/idp"
 	"github.com/zitadel/zitadel/internal/repository/idpconfig"
 	"github.com/zitadel/zitadel/internal/repository/instance"
@@ -1941,6 +1942,125 @@ func (wm *SAMLIDPWriteModel) GetProviderOptions() idp.Options {
 	return wm.Options
 }
 
+type WSFederationIDPWriteModel struct {
+	eventstore.WriteModel
+
+	ID          string
+	Name        string
+	SSOEndpoint string
+	Realm       string
+	Certificate []byte
+	IDClaim     string
+	idp.Options
+
+	State domain.IDPState
+}
+
+func (wm *WSFederationIDPWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.WSFederationIDPAddedEvent:
+			wm.reduceAddedEvent(e)
+		case *idp.WSFederationIDPChangedEvent:
+			wm.reduceChangedEvent(e)
+		case *idp.RemovedEvent:
+			wm.State = domain.IDPStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *WSFederationIDPWriteModel) reduceAddedEvent(e *idp.WSFederationIDPAddedEvent) {
+	wm.Name = e.Name
+	wm.SSOEndpoint = e.SSOEndpoint
+	wm.Realm = e.Realm
+	wm.Certificate = e.Certificate
+	wm.IDClaim = e.IDClaim
+	wm.Options = e.Options
+	wm.State = domain.IDPStateActive
+}
+
+func (wm *WSFederationIDPWriteModel) reduceChangedEvent(e *idp.WSFederationIDPChangedEvent) {
+	if e.Name != nil {
+		wm.Name = *e.Name
+	}
+	if e.SSOEndpoint != nil {
+		wm.SSOEndpoint = *e.SSOEndpoint
+	}
+	if e.Realm != nil {
+		wm.Realm = *e.Realm
+	}
+	if e.Certificate != nil {
+		wm.Certificate = e.Certificate
+	}
+	if e.IDClaim != nil {
+		wm.IDClaim = *e.IDClaim
+	}
+	wm.Options.ReduceChanges(e.OptionChanges)
+}
+
+func (wm *WSFederationIDPWriteModel) NewChanges(
+	name,
+	ssoEndpoint,
+	realm string,
+	certificate []byte,
+	idClaim string,
+	options idp.Options,
+) []idp.WSFederationIDPChanges {
+	changes := make([]idp.WSFederationIDPChanges, 0)
+	if wm.Name != name {
+		changes = append(changes, idp.ChangeWSFederationName(name))
+	}
+	if wm.SSOEndpoint != ssoEndpoint {
+		changes = append(changes, idp.ChangeWSFederationSSOEndpoint(ssoEndpoint))
+	}
+	if wm.Realm != realm {
+		changes = append(changes, idp.ChangeWSFederationRealm(realm))
+	}
+	if len(certificate) > 0 && !bytes.Equal(wm.Certificate, certificate) {
+		changes = append(changes, idp.ChangeWSFederationCertificate(certificate))
+	}
+	if wm.IDClaim != idClaim {
+		changes = append(changes, idp.ChangeWSFederationIDClaim(idClaim))
+	}
+	opts := wm.Options.Changes(options)
+	if !opts.IsZero() {
+		changes = append(changes, idp.ChangeWSFederationOptions(opts))
+	}
+	return changes
+}
+
+func (wm *WSFederationIDPWriteModel) ToProvider(callbackURL string, _ crypto.EncryptionAlgorithm) (providers.Provider, error) {
+	opts := make([]wsfed.ProviderOpts, 0, 5)
+	if wm.IsCreationAllowed {
+		opts = append(opts, wsfed.WithCreationAllowed())
+	}
+	if wm.IsLinkingAllowed {
+		opts = append(opts, wsfed.WithLinkingAllowed())
+	}
+	if wm.IsAutoCreation {
+		opts = append(opts, wsfed.WithAutoCreation())
+	}
+	if wm.IsAutoUpdate {
+		opts = append(opts, wsfed.WithAutoUpdate())
+	}
+	if wm.IDClaim != "" {
+		opts = append(opts, wsfed.WithIDClaim(wm.IDClaim))
+	}
+	return wsfed.New(
+		wm.Name,
+		wm.SSOEndpoint,
+		wm.Realm,
+		callbackURL,
+		wm.Certificate,
+		opts...,
+	)
+}
+
+func (wm *WSFederationIDPWriteModel) GetProviderOptions() idp.Options {
+	return wm.Options
+}
+
 type IDPRemoveWriteModel struct {
 	eventstore.WriteModel
 
@@ -1975,6 +2095,8 @@ func (wm *IDPRemoveWriteModel) Reduce() error {
 			wm.reduceAdded(e.ID)
 		case *idp.SAMLIDPAddedEvent:
 			wm.reduceAdded(e.ID)
+		case *idp.WSFederationIDPAddedEvent:
+			wm.reduceAdded(e.ID)
 		case *idp.RemovedEvent:
 			wm.reduceRemoved(e.ID)
 		case *idpconfig.IDPConfigAddedEvent:
@@ -2065,6 +2187,10 @@ func (wm *IDPTypeWriteModel) Reduce() error {
 			wm.reduceAdded(e.ID, domain.IDPTypeSAML, e.Aggregate())
 		case *org.SAMLIDPAddedEvent:
 			wm.reduceAdded(e.ID, domain.IDPTypeSAML, e.Aggregate())
+		case *instance.WSFederationIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeWSFederation, e.Aggregate())
+		case *org.WSFederationIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeWSFederation, e.Aggregate())
 		case *instance.OIDCIDPMigratedAzureADEvent:
 			wm.reduceChanged(e.ID, domain.IDPTypeAzureAD)
 		case *org.OIDCIDPMigratedAzureADEvent:
@@ -2142,6 +2268,7 @@ func (wm *IDPTypeWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.LDAPIDPAddedEventType,
 			instance.AppleIDPAddedEventType,
 			instance.SAMLIDPAddedEventType,
+			instance.WSFederationIDPAddedEventType,
 			instance.OIDCIDPMigratedAzureADEventType,
 			instance.OIDCIDPMigratedGoogleEventType,
 			instance.IDPRemovedEventType,
@@ -2162,6 +2289,7 @@ func (wm *IDPTypeWriteModel) Query() *eventstore.SearchQueryBuilder {
 			org.LDAPIDPAddedEventType,
 			org.AppleIDPAddedEventType,
 			org.SAMLIDPAddedEventType,
+			org.WSFederationIDPAddedEventType,
 			org.OIDCIDPMigratedAzureADEventType,
 			org.OIDCIDPMigratedGoogleEventType,
 			org.IDPRemovedEventType,
@@ -2240,6 +2368,8 @@ func NewAllIDPWriteModel(resourceOwner string, instanceBool bool, id string, idp
 			writeModel.model = NewAppleInstanceIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeSAML:
 			writeModel.samlModel = NewSAMLInstanceIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeWSFederation:
+			writeModel.model = NewWSFederationInstanceIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeUnspecified:
 			fallthrough
 		default:
@@ -2271,6 +2401,8 @@ func NewAllIDPWriteModel(resourceOwner string, instanceBool bool, id string, idp
 			writeModel.model = NewAppleOrgIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeSAML:
 			writeModel.samlModel = NewSAMLOrgIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeWSFederation:
+			writeModel.model = NewWSFederationOrgIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeUnspecified:
 			fallthrough
 		default:
@@ -0,0 +1,196 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/permission"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// CustomRole is an administrator role defined by the instance administrators
+// with a subset of the permissions available in the runtime configuration.
+type CustomRole struct {
+	InstanceID  string
+	Role        string
+	DisplayName string
+	Permissions []string
+}
+
+func (r *CustomRole) IsValid(zitadelRoles []authz.RoleMapping) error {
+	if !domain.IsValidCustomRoleName(r.Role) || len(r.Permissions) == 0 {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Cr8sL", "Errors.IAM.CustomRole.Invalid")
+	}
+	if slices.ContainsFunc(zitadelRoles, func(mapping authz.RoleMapping) bool {
+		return mapping.Role == r.Role
+	}) {
+		return zerrors.ThrowAlreadyExists(nil, "COMMAND-Cr2eX", "Errors.IAM.CustomRole.AlreadyExists")
+	}
+	available := availableRolePermissions(zitadelRoles)
+	for _, p := range r.Permissions {
+		if !slices.Contains(available, p) {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Cr5pN", "Errors.IAM.CustomRole.PermissionInvalid")
+		}
+	}
+	return nil
+}
+
+// availableRolePermissions returns all permissions granted by the instance level roles of the runtime configuration.
+// System level roles are excluded, as their permissions can not be granted to instance administrators.
+func availableRolePermissions(zitadelRoles []authz.RoleMapping) []string {
+	permissions := make([]string, 0)
+	for _, mapping := range zitadelRoles {
+		if strings.HasPrefix(mapping.Role, "SYSTEM") {
+			continue
+		}
+		for _, p := range mapping.Permissions {
+			if !slices.Contains(permissions, p) {
+				permissions = append(permissions, p)
+			}
+		}
+	}
+	return permissions
+}
+
+// AddCustomRole defines a new administrator role with the given permissions on the instance.
+// The role can then be granted to instance or organization administrators, depending on its prefix.
+func (c *Commands) AddCustomRole(ctx context.Context, role *CustomRole) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if role.InstanceID == "" {
+		role.InstanceID = authz.GetInstance(ctx).InstanceID()
+	}
+	if err := role.IsValid(c.zitadelRoles); err != nil {
+		return nil, err
+	}
+	if err := c.checkPermissionUpdateInstanceCustomRole(ctx, role.InstanceID); err != nil {
+		return nil, err
+	}
+	wm, err := c.instanceCustomRoleWriteModel(ctx, role.InstanceID, role.Role)
+	if err != nil {
+		return nil, err
+	}
+	if wm.State.Exists() {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-Cr3kW", "Errors.IAM.CustomRole.AlreadyExists")
+	}
+	agg := permission.NewAggregate(role.InstanceID)
+	cmds := make([]eventstore.Command, 0, len(role.Permissions)+1)
+	cmds = append(cmds, permission.NewCustomRoleAddedEvent(ctx, agg, role.Role, role.DisplayName))
+	for _, p := range role.Permissions {
+		cmds = append(cmds, permission.NewAddedEvent(ctx, agg, role.Role, p))
+	}
+	return c.pushAppendAndReduceDetails(ctx, wm, cmds...)
+}
+
+// ChangeCustomRole updates the display name and the permissions of a custom administrator role.
+// Any permission previously granted to the role and not present in the request will be revoked.
+func (c *Commands) ChangeCustomRole(ctx context.Context, role *CustomRole) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if role.InstanceID == "" {
+		role.InstanceID = authz.GetInstance(ctx).InstanceID()
+	}
+	if err := role.IsValid(c.zitadelRoles); err != nil {
+		return nil, err
+	}
+	if err := c.checkPermissionUpdateInstanceCustomRole(ctx, role.InstanceID); err != nil {
+		return nil, err
+	}
+	wm, err := c.instanceCustomRoleWriteModel(ctx, role.InstanceID, role.Role)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Cr6tV", "Errors.IAM.CustomRole.NotFound")
+	}
+	agg := permission.NewAggregate(role.InstanceID)
+	cmds := make([]eventstore.Command, 0)
+	if wm.DisplayName != role.DisplayName {
+		cmds = append(cmds, permission.NewCustomRoleChangedEvent(ctx, agg, role.Role, role.DisplayName))
+	}
+	added, removed := wm.changedPermissions(role.Permissions)
+	for _, p := range added {
+		cmds = append(cmds, permission.NewAddedEvent(ctx, agg, role.Role, p))
+	}
+	for _, p := range removed {
+		cmds = append(cmds, permission.NewRemovedEvent(ctx, agg, role.Role, p))
+	}
+	if len(cmds) == 0 {
+		return writeModelToObjectDetails(&wm.WriteModel), nil
+	}
+	return c.pushAppendAndReduceDetails(ctx, wm, cmds...)
+}
+
+// RemoveCustomRole removes a custom administrator role and all of its permissions.
+// Administrators which were granted the role keep it, but it no longer grants any permission.
+func (c *Commands) RemoveCustomRole(ctx context.Context, instanceID, role string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if role == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Cr9qM", "Errors.IAM.CustomRole.Invalid")
+	}
+	if instanceID == "" {
+		instanceID = authz.GetInstance(ctx).InstanceID()
+	}
+	if err := c.checkPermissionUpdateInstanceCustomRole(ctx, instanceID); err != nil {
+		return nil, err
+	}
+	wm, err := c.instanceCustomRoleWriteModel(ctx, instanceID, role)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return writeModelToObjectDetails(&wm.WriteModel), nil
+	}
+	return c.pushAppendAndReduceDetails(ctx, wm,
+		permission.NewCustomRoleRemovedEvent(ctx, permission.NewAggregate(instanceID), role),
+	)
+}
+
+func (c *Commands) instanceCustomRoleWriteModel(ctx context.Context, instanceID, role string) (_ *InstanceCustomRoleWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm := NewInstanceCustomRoleWriteModel(instanceID, role)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+// administratorRoles returns the roles which can be granted to administrators of the instance.
+// The custom roles of the instance are only queried if any of the requested roles
+// is not part of the runtime configuration and could be a custom role.
+func (c *Commands) administratorRoles(ctx context.Context, instanceID string, roles []string) (_ []authz.RoleMapping, err error) {
+	if !slices.ContainsFunc(roles, func(role string) bool {
+		return domain.IsValidCustomRoleName(role) && !slices.ContainsFunc(c.zitadelRoles, func(mapping authz.RoleMapping) bool {
+			return mapping.Role == role
+		})
+	}) {
+		return c.zitadelRoles, nil
+	}
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm := NewInstanceCustomRolesWriteModel(instanceID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	if len(wm.Roles) == 0 {
+		return c.zitadelRoles, nil
+	}
+	mappings := make([]authz.RoleMapping, 0, len(c.zitadelRoles)+len(wm.Roles))
+	mappings = append(mappings, c.zitadelRoles...)
+	for _, role := range wm.Roles {
+		mappings = append(mappings, authz.RoleMapping{Role: role})
+	}
+	return mappings, nil
+}
@@ -0,0 +1,162 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/permission"
+)
+
+type InstanceCustomRoleWriteModel struct {
+	eventstore.WriteModel
+
+	Role        string
+	DisplayName string
+	Permissions []string
+	State       domain.CustomRoleState
+}
+
+func NewInstanceCustomRoleWriteModel(instanceID, role string) *InstanceCustomRoleWriteModel {
+	return &InstanceCustomRoleWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+		Role: role,
+	}
+}
+
+func (wm *InstanceCustomRoleWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *permission.CustomRoleAddedEvent:
+			if e.Role != wm.Role {
+				continue
+			}
+		case *permission.CustomRoleChangedEvent:
+			if e.Role != wm.Role {
+				continue
+			}
+		case *permission.CustomRoleRemovedEvent:
+			if e.Role != wm.Role {
+				continue
+			}
+		case *permission.AddedEvent:
+			if e.Role != wm.Role {
+				continue
+			}
+		case *permission.RemovedEvent:
+			if e.Role != wm.Role {
+				continue
+			}
+		}
+		wm.WriteModel.AppendEvents(event)
+	}
+}
+
+func (wm *InstanceCustomRoleWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *permission.CustomRoleAddedEvent:
+			wm.DisplayName = e.DisplayName
+			wm.Permissions = nil
+			wm.State = domain.CustomRoleStateActive
+		case *permission.CustomRoleChangedEvent:
+			wm.DisplayName = e.DisplayName
+		case *permission.CustomRoleRemovedEvent:
+			wm.DisplayName = ""
+			wm.Permissions = nil
+			wm.State = domain.CustomRoleStateRemoved
+		case *permission.AddedEvent:
+			if !slices.Contains(wm.Permissions, e.Permission) {
+				wm.Permissions = append(wm.Permissions, e.Permission)
+			}
+		case *permission.RemovedEvent:
+			wm.Permissions = slices.DeleteFunc(wm.Permissions, func(p string) bool {
+				return p == e.Permission
+			})
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceCustomRoleWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(permission.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			permission.CustomRoleAddedType,
+			permission.CustomRoleChangedType,
+			permission.CustomRoleRemovedType,
+			permission.AddedType,
+			permission.RemovedType,
+		).
+		Builder()
+}
+
+func (wm *InstanceCustomRoleWriteModel) GetWriteModel() *eventstore.WriteModel {
+	return &wm.WriteModel
+}
+
+// changedPermissions returns the permissions which need to be added and removed to reach the desired state.
+func (wm *InstanceCustomRoleWriteModel) changedPermissions(permissions []string) (added, removed []string) {
+	for _, p := range permissions {
+		if !slices.Contains(wm.Permissions, p) {
+			added = append(added, p)
+		}
+	}
+	for _, p := range wm.Permissions {
+		if !slices.Contains(permissions, p) {
+			removed = append(removed, p)
+		}
+	}
+	return added, removed
+}
+
+// InstanceCustomRolesWriteModel collects the names of all custom administrator roles of an instance.
+type InstanceCustomRolesWriteModel struct {
+	eventstore.WriteModel
+
+	Roles []string
+}
+
+func NewInstanceCustomRolesWriteModel(instanceID string) *InstanceCustomRolesWriteModel {
+	return &InstanceCustomRolesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+	}
+}
+
+func (wm *InstanceCustomRolesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *permission.CustomRoleAddedEvent:
+			wm.Roles = append(wm.Roles, e.Role)
+		case *permission.CustomRoleRemovedEvent:
+			wm.Roles = slices.DeleteFunc(wm.Roles, func(role string) bool {
+				return role == e.Role
+			})
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceCustomRolesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(permission.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			permission.CustomRoleAddedType,
+			permission.CustomRoleRemovedType,
+		).
+		Builder()
+}
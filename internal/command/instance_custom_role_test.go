@@ -0,0 +1,469 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/permission"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var customRoleTestZitadelRoles = []authz.RoleMapping{
+	{Role: "IAM_OWNER", Permissions: []string{"iam.write", "user.read", "user.write", "user.credential.write"}},
+	{Role: "ORG_OWNER", Permissions: []string{"org.read", "user.read", "user.write", "user.credential.write"}},
+	{Role: "SYSTEM_OWNER", Permissions: []string{"system.instance.write"}},
+}
+
+func TestCommandSide_AddCustomRole(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		role *CustomRole
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *domain.ObjectDetails
+		wantErr func(error) bool
+	}{
+		{
+			name: "invalid prefix, error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				role: &CustomRole{
+					InstanceID:  "instance1",
+					Role:        "HELPDESK",
+					Permissions: []string{"user.read"},
+				},
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "no permissions, error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				role: &CustomRole{
+					InstanceID: "instance1",
+					Role:       "ORG_HELPDESK",
+				},
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "predefined role, already exists error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				role: &CustomRole{
+					InstanceID:  "instance1",
+					Role:        "ORG_OWNER",
+					Permissions: []string{"user.read"},
+				},
+			},
+			wantErr: zerrors.IsErrorAlreadyExists,
+		},
+		{
+			name: "unknown permission, error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				role: &CustomRole{
+					InstanceID:  "instance1",
+					Role:        "ORG_HELPDESK",
+					Permissions: []string{"user.read", "unknown"},
+				},
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "system permission, error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				role: &CustomRole{
+					InstanceID:  "instance1",
+					Role:        "IAM_HELPDESK",
+					Permissions: []string{"system.instance.write"},
+				},
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "missing permission, error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				role: &CustomRole{
+					InstanceID:  "instance1",
+					Role:        "ORG_HELPDESK",
+					Permissions: []string{"user.read"},
+				},
+			},
+			wantErr: zerrors.IsPermissionDenied,
+		},
+		{
+			name: "already existing, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							permission.NewCustomRoleAddedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", "Helpdesk"),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				role: &CustomRole{
+					InstanceID:  "instance1",
+					Role:        "ORG_HELPDESK",
+					Permissions: []string{"user.read"},
+				},
+			},
+			wantErr: zerrors.IsErrorAlreadyExists,
+		},
+		{
+			name: "add custom role, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						permission.NewCustomRoleAddedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", "Helpdesk"),
+						permission.NewAddedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", "user.read"),
+						permission.NewAddedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", "user.credential.write"),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				role: &CustomRole{
+					InstanceID:  "instance1",
+					Role:        "ORG_HELPDESK",
+					DisplayName: "Helpdesk",
+					Permissions: []string{"user.read", "user.credential.write"},
+				},
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+		{
+			name: "re-add removed custom role, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							permission.NewCustomRoleAddedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", "Helpdesk"),
+						),
+						eventFromEventPusher(
+							permission.NewAddedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", "user.write"),
+						),
+						eventFromEventPusher(
+							permission.NewCustomRoleRemovedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK"),
+						),
+					),
+					expectPush(
+						permission.NewCustomRoleAddedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", ""),
+						permission.NewAddedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", "user.read"),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				role: &CustomRole{
+					InstanceID:  "instance1",
+					Role:        "ORG_HELPDESK",
+					Permissions: []string{"user.read"},
+				},
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+				zitadelRoles:    customRoleTestZitadelRoles,
+			}
+			got, err := c.AddCustomRole(context.Background(), tt.args.role)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommandSide_ChangeCustomRole(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		role *CustomRole
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *domain.ObjectDetails
+		wantErr func(error) bool
+	}{
+		{
+			name: "unknown permission, error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				role: &CustomRole{
+					InstanceID:  "instance1",
+					Role:        "ORG_HELPDESK",
+					Permissions: []string{"unknown"},
+				},
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "missing permission, error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				role: &CustomRole{
+					InstanceID:  "instance1",
+					Role:        "ORG_HELPDESK",
+					Permissions: []string{"user.read"},
+				},
+			},
+			wantErr: zerrors.IsPermissionDenied,
+		},
+		{
+			name: "not found, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				role: &CustomRole{
+					InstanceID:  "instance1",
+					Role:        "ORG_HELPDESK",
+					Permissions: []string{"user.read"},
+				},
+			},
+			wantErr: zerrors.IsNotFound,
+		},
+		{
+			name: "no changes, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							permission.NewCustomRoleAddedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", "Helpdesk"),
+						),
+						eventFromEventPusher(
+							permission.NewAddedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", "user.read"),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				role: &CustomRole{
+					InstanceID:  "instance1",
+					Role:        "ORG_HELPDESK",
+					DisplayName: "Helpdesk",
+					Permissions: []string{"user.read"},
+				},
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+		{
+			name: "change display name and permissions, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							permission.NewCustomRoleAddedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", "Helpdesk"),
+						),
+						eventFromEventPusher(
+							permission.NewAddedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", "user.read"),
+						),
+						eventFromEventPusher(
+							permission.NewAddedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", "user.write"),
+						),
+					),
+					expectPush(
+						permission.NewCustomRoleChangedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", "Support"),
+						permission.NewAddedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", "user.credential.write"),
+						permission.NewRemovedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", "user.write"),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				role: &CustomRole{
+					InstanceID:  "instance1",
+					Role:        "ORG_HELPDESK",
+					DisplayName: "Support",
+					Permissions: []string{"user.read", "user.credential.write"},
+				},
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+				zitadelRoles:    customRoleTestZitadelRoles,
+			}
+			got, err := c.ChangeCustomRole(context.Background(), tt.args.role)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommandSide_RemoveCustomRole(t *testing.T) {
+	t.Parallel()
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		instanceID string
+		role       string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *domain.ObjectDetails
+		wantErr func(error) bool
+	}{
+		{
+			name: "missing role, error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				instanceID: "instance1",
+			},
+			wantErr: zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "missing permission, error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				instanceID: "instance1",
+				role:       "ORG_HELPDESK",
+			},
+			wantErr: zerrors.IsPermissionDenied,
+		},
+		{
+			name: "not found, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				instanceID: "instance1",
+				role:       "ORG_HELPDESK",
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+		{
+			name: "remove custom role, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							permission.NewCustomRoleAddedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", "Helpdesk"),
+						),
+						eventFromEventPusher(
+							permission.NewAddedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK", "user.read"),
+						),
+					),
+					expectPush(
+						permission.NewCustomRoleRemovedEvent(context.Background(), permission.NewAggregate("instance1"), "ORG_HELPDESK"),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				instanceID: "instance1",
+				role:       "ORG_HELPDESK",
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+				zitadelRoles:    customRoleTestZitadelRoles,
+			}
+			got, err := c.RemoveCustomRole(context.Background(), tt.args.instanceID, tt.args.role)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
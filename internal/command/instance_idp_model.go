@@ -866,6 +866,70 @@ func (wm *InstanceAppleIDPWriteModel) NewChangedEvent(
 	return instance.NewAppleIDPChangedEvent(ctx, aggregate, id, changes)
 }
 
+type InstanceWSFederationIDPWriteModel struct {
+	WSFederationIDPWriteModel
+}
+
+func NewWSFederationInstanceIDPWriteModel(instanceID, id string) *InstanceWSFederationIDPWriteModel {
+	return &InstanceWSFederationIDPWriteModel{
+		WSFederationIDPWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   instanceID,
+				ResourceOwner: instanceID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *InstanceWSFederationIDPWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.WSFederationIDPAddedEvent:
+			wm.WSFederationIDPWriteModel.AppendEvents(&e.WSFederationIDPAddedEvent)
+		case *instance.WSFederationIDPChangedEvent:
+			wm.WSFederationIDPWriteModel.AppendEvents(&e.WSFederationIDPChangedEvent)
+		case *instance.IDPRemovedEvent:
+			wm.WSFederationIDPWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.WSFederationIDPWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceWSFederationIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.WSFederationIDPAddedEventType,
+			instance.WSFederationIDPChangedEventType,
+			instance.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+func (wm *InstanceWSFederationIDPWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	ssoEndpoint,
+	realm string,
+	certificate []byte,
+	idClaim string,
+	options idp.Options,
+) (*instance.WSFederationIDPChangedEvent, error) {
+	changes := wm.WSFederationIDPWriteModel.NewChanges(name, ssoEndpoint, realm, certificate, idClaim, options)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	return instance.NewWSFederationIDPChangedEvent(ctx, aggregate, id, changes)
+}
+
 type InstanceSAMLIDPWriteModel struct {
 	SAMLIDPWriteModel
 }
@@ -990,6 +1054,8 @@ func (wm *InstanceIDPRemoveWriteModel) AppendEvents(events ...eventstore.Event)
 			wm.IDPRemoveWriteModel.AppendEvents(&e.LDAPIDPAddedEvent)
 		case *instance.AppleIDPAddedEvent:
 			wm.IDPRemoveWriteModel.AppendEvents(&e.AppleIDPAddedEvent)
+		case *instance.WSFederationIDPAddedEvent:
+			wm.IDPRemoveWriteModel.AppendEvents(&e.WSFederationIDPAddedEvent)
 		case *instance.IDPRemovedEvent:
 			wm.IDPRemoveWriteModel.AppendEvents(&e.RemovedEvent)
 		case *instance.IDPConfigAddedEvent:
@@ -1021,6 +1087,7 @@ func (wm *InstanceIDPRemoveWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.LDAPIDPAddedEventType,
 			instance.AppleIDPAddedEventType,
 			instance.SAMLIDPAddedEventType,
+			instance.WSFederationIDPAddedEventType,
 			instance.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
@@ -5914,3 +5914,323 @@ func TestCommandSide_RegenerateInstanceSAMLProviderCertificate(t *testing.T) {
 		})
 	}
 }
+
+func TestCommandSide_AddInstanceWSFederationIDP(t *testing.T) {
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		ctx      context.Context
+		provider WSFederationProvider
+	}
+	type res struct {
+		id   string
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"invalid name",
+			fields{
+				eventstore:  expectEventstore(),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "id1"),
+			},
+			args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				provider: WSFederationProvider{},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wf4nA", "Errors.Invalid.Argument"))
+				},
+			},
+		},
+		{
+			"invalid sso endpoint",
+			fields{
+				eventstore:  expectEventstore(),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "id1"),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				provider: WSFederationProvider{
+					Name:        "name",
+					SSOEndpoint: "/adfs/ls/",
+				},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wf4nE", "Errors.IDP.WSFederation.SSOEndpointInvalid"))
+				},
+			},
+		},
+		{
+			"invalid realm",
+			fields{
+				eventstore:  expectEventstore(),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "id1"),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				provider: WSFederationProvider{
+					Name:        "name",
+					SSOEndpoint: "https://adfs.example.com/adfs/ls/",
+				},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "COMMAND-Wf4nR", "Errors.IDP.WSFederation.RealmMissing"))
+				},
+			},
+		},
+		{
+			"invalid certificate",
+			fields{
+				eventstore:  expectEventstore(),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "id1"),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				provider: WSFederationProvider{
+					Name:        "name",
+					SSOEndpoint: "https://adfs.example.com/adfs/ls/",
+					Realm:       "urn:zitadel",
+					Certificate: []byte("certificate"),
+				},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						instance.NewWSFederationIDPAddedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+							"id1",
+							"name",
+							"https://adfs.example.com/adfs/ls/",
+							"urn:zitadel",
+							validLDAPRootCA,
+							"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/upn",
+							idp.Options{
+								IsCreationAllowed: true,
+								IsLinkingAllowed:  true,
+								IsAutoCreation:    true,
+								IsAutoUpdate:      true,
+							},
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "id1"),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				provider: WSFederationProvider{
+					Name:        " name ",
+					SSOEndpoint: "https://adfs.example.com/adfs/ls/",
+					Realm:       "urn:zitadel",
+					Certificate: validLDAPRootCA,
+					IDClaim:     "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/upn",
+					IDPOptions: idp.Options{
+						IsCreationAllowed: true,
+						IsLinkingAllowed:  true,
+						IsAutoCreation:    true,
+						IsAutoUpdate:      true,
+					},
+				},
+			},
+			res: res{
+				id:   "id1",
+				want: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			id, got, err := c.AddInstanceWSFederationProvider(tt.args.ctx, tt.args.provider)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assert.Equal(t, tt.res.id, id)
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_UpdateInstanceWSFederationIDP(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		id       string
+		provider WSFederationProvider
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	wsFederationAdded := func() eventstore.Event {
+		return eventFromEventPusher(
+			instance.NewWSFederationIDPAddedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+				"id1",
+				"name",
+				"https://adfs.example.com/adfs/ls/",
+				"urn:zitadel",
+				validLDAPRootCA,
+				"",
+				idp.Options{},
+			),
+		)
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"invalid id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:      authz.WithInstanceID(context.Background(), "instance1"),
+				provider: WSFederationProvider{},
+			},
+			res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "INST-Wf5aI", "Errors.IDMissing"))
+				},
+			},
+		},
+		{
+			name: "not found",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				id:  "id1",
+				provider: WSFederationProvider{
+					Name:        "name",
+					SSOEndpoint: "https://adfs.example.com/adfs/ls/",
+					Realm:       "urn:zitadel",
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "no changes",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						wsFederationAdded(),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				id:  "id1",
+				provider: WSFederationProvider{
+					Name:        "name",
+					SSOEndpoint: "https://adfs.example.com/adfs/ls/",
+					Realm:       "urn:zitadel",
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+		{
+			name: "change ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						wsFederationAdded(),
+					),
+					expectPush(
+						func() eventstore.Command {
+							t := true
+							event, _ := instance.NewWSFederationIDPChangedEvent(context.Background(), &instance.NewAggregate("instance1").Aggregate,
+								"id1",
+								[]idp.WSFederationIDPChanges{
+									idp.ChangeWSFederationName("new name"),
+									idp.ChangeWSFederationSSOEndpoint("https://adfs2.example.com/adfs/ls/"),
+									idp.ChangeWSFederationRealm("urn:zitadel:new"),
+									idp.ChangeWSFederationIDClaim("http://schemas.xmlsoap.org/ws/2005/05/identity/claims/upn"),
+									idp.ChangeWSFederationOptions(idp.OptionChanges{
+										IsCreationAllowed: &t,
+										IsLinkingAllowed:  &t,
+										IsAutoCreation:    &t,
+										IsAutoUpdate:      &t,
+									}),
+								},
+							)
+							return event
+						}(),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instance1"),
+				id:  "id1",
+				provider: WSFederationProvider{
+					Name:        "new name",
+					SSOEndpoint: "https://adfs2.example.com/adfs/ls/",
+					Realm:       "urn:zitadel:new",
+					Certificate: validLDAPRootCA,
+					IDClaim:     "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/upn",
+					IDPOptions: idp.Options{
+						IsCreationAllowed: true,
+						IsLinkingAllowed:  true,
+						IsAutoCreation:    true,
+						IsAutoUpdate:      true,
+					},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.UpdateInstanceWSFederationProvider(tt.args.ctx, tt.args.id, tt.args.provider)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
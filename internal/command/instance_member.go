@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"slices"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/command/preparation"
@@ -14,11 +15,18 @@ import (
 )
 
 func (c *Commands) AddInstanceMemberCommand(a *instance.Aggregate, userID string, roles ...string) preparation.Validation {
+	return c.addInstanceMemberCommand(a, c.zitadelRoles, userID, nil, roles...)
+}
+
+func (c *Commands) addInstanceMemberCommand(a *instance.Aggregate, validRoles []authz.RoleMapping, userID string, validUntil *time.Time, roles ...string) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
 		if userID == "" {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INSTA-SDSfs", "Errors.Invalid.Argument")
 		}
-		if len(domain.CheckForInvalidRoles(roles, domain.IAMRolePrefix, c.zitadelRoles)) > 0 {
+		if validUntil != nil && !validUntil.After(time.Now()) {
+			return nil, zerrors.ThrowInvalidArgument(nil, "INSTA-Vu3xq", "Errors.IAM.MemberValidityInvalid")
+		}
+		if len(domain.CheckForInvalidRoles(roles, domain.IAMRolePrefix, validRoles)) > 0 {
 			return nil, zerrors.ThrowInvalidArgument(nil, "INSTANCE-4m0fS", "Errors.IAM.MemberInvalid")
 		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
@@ -28,7 +36,9 @@ func (c *Commands) AddInstanceMemberCommand(a *instance.Aggregate, userID string
 				if isMember, err := IsInstanceMember(ctx, filter, a.ID, userID); err != nil || isMember {
 					return nil, zerrors.ThrowAlreadyExists(err, "INSTA-pFDwe", "Errors.Instance.Member.AlreadyExists")
 				}
-				return []eventstore.Command{instance.NewMemberAddedEvent(ctx, &a.Aggregate, userID, roles...)}, nil
+				added := instance.NewMemberAddedEvent(ctx, &a.Aggregate, userID, roles...)
+				added.ValidUntil = validUntil
+				return []eventstore.Command{added}, nil
 			},
 			nil
 	}
@@ -73,6 +83,8 @@ type AddInstanceMember struct {
 	InstanceID string
 	UserID     string
 	Roles      []string
+	// ValidUntil limits the membership in time, it is removed automatically after expiry.
+	ValidUntil *time.Time
 }
 
 func (c *Commands) AddInstanceMember(ctx context.Context, member *AddInstanceMember) (*domain.ObjectDetails, error) {
@@ -80,8 +92,12 @@ func (c *Commands) AddInstanceMember(ctx context.Context, member *AddInstanceMem
 	if err := c.checkPermissionUpdateInstanceMember(ctx, member.InstanceID); err != nil {
 		return nil, err
 	}
+	validRoles, err := c.administratorRoles(ctx, member.InstanceID, member.Roles)
+	if err != nil {
+		return nil, err
+	}
 	//nolint:staticcheck
-	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, c.AddInstanceMemberCommand(instanceAgg, member.UserID, member.Roles...))
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, c.addInstanceMemberCommand(instanceAgg, validRoles, member.UserID, member.ValidUntil, member.Roles...))
 	if err != nil {
 		return nil, err
 	}
@@ -101,12 +117,20 @@ type ChangeInstanceMember struct {
 	InstanceID string
 	UserID     string
 	Roles      []string
+	// ValidUntil limits the membership in time.
+	// If not set, the current validity of the membership is kept.
+	ValidUntil *time.Time
+	// ClearValidUntil makes a time-bound membership permanent.
+	ClearValidUntil bool
 }
 
 func (i *ChangeInstanceMember) IsValid(zitadelRoles []authz.RoleMapping) error {
 	if i.InstanceID == "" || i.UserID == "" || len(i.Roles) == 0 {
 		return zerrors.ThrowInvalidArgument(nil, "INSTANCE-LiaZi", "Errors.IAM.MemberInvalid")
 	}
+	if i.ValidUntil != nil && (i.ClearValidUntil || !i.ValidUntil.After(time.Now())) {
+		return zerrors.ThrowInvalidArgument(nil, "INSTANCE-Vu4kd", "Errors.IAM.MemberValidityInvalid")
+	}
 	if len(domain.CheckForInvalidRoles(i.Roles, domain.IAMRolePrefix, zitadelRoles)) > 0 {
 		return zerrors.ThrowInvalidArgument(nil, "INSTANCE-3m9fs", "Errors.IAM.MemberInvalid")
 	}
@@ -115,7 +139,11 @@ func (i *ChangeInstanceMember) IsValid(zitadelRoles []authz.RoleMapping) error {
 
 // ChangeInstanceMember updates an existing member
 func (c *Commands) ChangeInstanceMember(ctx context.Context, member *ChangeInstanceMember) (*domain.ObjectDetails, error) {
-	if err := member.IsValid(c.zitadelRoles); err != nil {
+	validRoles, err := c.administratorRoles(ctx, member.InstanceID, member.Roles)
+	if err != nil {
+		return nil, err
+	}
+	if err := member.IsValid(validRoles); err != nil {
 		return nil, err
 	}
 
@@ -129,16 +157,17 @@ func (c *Commands) ChangeInstanceMember(ctx context.Context, member *ChangeInsta
 	if err := c.checkPermissionUpdateInstanceMember(ctx, existingMember.AggregateID); err != nil {
 		return nil, err
 	}
-	if slices.Compare(existingMember.Roles, member.Roles) == 0 {
+	validUntil := changedMemberValidity(existingMember.ValidUntil, member.ValidUntil, member.ClearValidUntil)
+	if slices.Compare(existingMember.Roles, member.Roles) == 0 && equalValidity(existingMember.ValidUntil, validUntil) {
 		return writeModelToObjectDetails(&existingMember.WriteModel), nil
 	}
-	pushedEvents, err := c.eventstore.Push(ctx,
-		instance.NewMemberChangedEvent(ctx,
-			InstanceAggregateFromWriteModel(&existingMember.WriteModel),
-			member.UserID,
-			member.Roles...,
-		),
+	changed := instance.NewMemberChangedEvent(ctx,
+		InstanceAggregateFromWriteModel(&existingMember.WriteModel),
+		member.UserID,
+		member.Roles...,
 	)
+	changed.ValidUntil = validUntil
+	pushedEvents, err := c.eventstore.Push(ctx, changed)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/text/language"
@@ -11,6 +12,7 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/permission"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -50,7 +52,9 @@ func TestCommandSide_AddInstanceMember(t *testing.T) {
 		{
 			name: "invalid roles, error",
 			fields: fields{
-				eventstore:      expectEventstore(),
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
 				checkPermission: newMockPermissionCheckAllowed(),
 			},
 			args: args{
@@ -277,6 +281,7 @@ func TestCommandSide_AddInstanceMember(t *testing.T) {
 }
 
 func TestCommandSide_ChangeInstanceMember(t *testing.T) {
+	validUntil := time.Now().Add(time.Hour).Truncate(time.Second)
 	type fields struct {
 		eventstore      func(t *testing.T) *eventstore.Eventstore
 		zitadelRoles    []authz.RoleMapping
@@ -311,7 +316,9 @@ func TestCommandSide_ChangeInstanceMember(t *testing.T) {
 		{
 			name: "invalid roles, error",
 			fields: fields{
-				eventstore:      expectEventstore(),
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
 				checkPermission: newMockPermissionCheckAllowed(),
 			},
 			args: args{
@@ -383,6 +390,56 @@ func TestCommandSide_ChangeInstanceMember(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "member change custom role, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							permission.NewCustomRoleAddedEvent(context.Background(),
+								permission.NewAggregate("INSTANCE"),
+								"IAM_HELPDESK",
+								"Helpdesk",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							instance.NewMemberAddedEvent(context.Background(),
+								&instance.NewAggregate("INSTANCE").Aggregate,
+								"user1",
+								[]string{"IAM_OWNER"}...,
+							),
+						),
+					),
+					expectPush(
+						instance.NewMemberChangedEvent(context.Background(),
+							&instance.NewAggregate("INSTANCE").Aggregate,
+							"user1",
+							[]string{"IAM_HELPDESK"}...,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				zitadelRoles: []authz.RoleMapping{
+					{
+						Role: "IAM_OWNER",
+					},
+				},
+			},
+			args: args{
+				member: &ChangeInstanceMember{
+					InstanceID: "INSTANCE",
+					UserID:     "user1",
+					Roles:      []string{"IAM_HELPDESK"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
 		{
 			name: "member change, ok",
 			fields: fields{
@@ -427,6 +484,76 @@ func TestCommandSide_ChangeInstanceMember(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "time-bound member change, validity kept",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							timeBoundInstanceMemberAddedEvent(validUntil, "IAM_OWNER"),
+						),
+					),
+					expectPush(
+						timeBoundInstanceMemberChangedEvent(&validUntil, "IAM_OWNER", "IAM_OWNER_VIEWER"),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				zitadelRoles: []authz.RoleMapping{
+					{
+						Role: "IAM_OWNER",
+					},
+					{
+						Role: "IAM_OWNER_VIEWER",
+					},
+				},
+			},
+			args: args{
+				member: &ChangeInstanceMember{
+					InstanceID: "INSTANCE",
+					UserID:     "user1",
+					Roles:      []string{"IAM_OWNER", "IAM_OWNER_VIEWER"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+		{
+			name: "time-bound member change, validity cleared",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							timeBoundInstanceMemberAddedEvent(validUntil, "IAM_OWNER"),
+						),
+					),
+					expectPush(
+						timeBoundInstanceMemberChangedEvent(nil, "IAM_OWNER"),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				zitadelRoles: []authz.RoleMapping{
+					{
+						Role: "IAM_OWNER",
+					},
+				},
+			},
+			args: args{
+				member: &ChangeInstanceMember{
+					InstanceID:      "INSTANCE",
+					UserID:          "user1",
+					Roles:           []string{"IAM_OWNER"},
+					ClearValidUntil: true,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
 		{
 			name: "member change, ok",
 			fields: fields{
@@ -484,6 +611,18 @@ func TestCommandSide_ChangeInstanceMember(t *testing.T) {
 	}
 }
 
+func timeBoundInstanceMemberAddedEvent(validUntil time.Time, roles ...string) *instance.MemberAddedEvent {
+	added := instance.NewMemberAddedEvent(context.Background(), &instance.NewAggregate("INSTANCE").Aggregate, "user1", roles...)
+	added.ValidUntil = &validUntil
+	return added
+}
+
+func timeBoundInstanceMemberChangedEvent(validUntil *time.Time, roles ...string) *instance.MemberChangedEvent {
+	changed := instance.NewMemberChangedEvent(context.Background(), &instance.NewAggregate("INSTANCE").Aggregate, "user1", roles...)
+	changed.ValidUntil = validUntil
+	return changed
+}
+
 func TestCommandSide_RemoveInstanceMember(t *testing.T) {
 	type fields struct {
 		eventstore      func(t *testing.T) *eventstore.Eventstore
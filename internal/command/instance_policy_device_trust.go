@@ -0,0 +1,34 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetDefaultDeviceTrustPolicy sets how long users of the instance can trust a device after checking a second factor.
+// It applies to all organizations, which don't have their own policy.
+func (c *Commands) SetDefaultDeviceTrustPolicy(ctx context.Context, deviceTrustPolicy *domain.DeviceTrustPolicy) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err = deviceTrustPolicy.Validate(); err != nil {
+		return nil, err
+	}
+	writeModel := NewInstanceDeviceTrustPolicyWriteModel(ctx)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.Equal(deviceTrustPolicy) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-Dtp2C", "Errors.NoChangesFound")
+	}
+	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
+	if err = c.pushAppendAndReduce(ctx, writeModel, instance.NewDeviceTrustPolicySetEvent(ctx, &instanceAgg.Aggregate, deviceTrustPolicy.Lifetime)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
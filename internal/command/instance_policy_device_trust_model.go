@@ -0,0 +1,46 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceDeviceTrustPolicyWriteModel struct {
+	DeviceTrustPolicyWriteModel
+}
+
+func NewInstanceDeviceTrustPolicyWriteModel(ctx context.Context) *InstanceDeviceTrustPolicyWriteModel {
+	return &InstanceDeviceTrustPolicyWriteModel{
+		DeviceTrustPolicyWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   authz.GetInstance(ctx).InstanceID(),
+				ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+			},
+		},
+	}
+}
+
+func (wm *InstanceDeviceTrustPolicyWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		if e, ok := event.(*instance.DeviceTrustPolicySetEvent); ok {
+			wm.DeviceTrustPolicyWriteModel.AppendEvents(&e.DeviceTrustPolicySetEvent)
+		}
+	}
+}
+
+func (wm *InstanceDeviceTrustPolicyWriteModel) Reduce() error {
+	return wm.DeviceTrustPolicyWriteModel.Reduce()
+}
+
+func (wm *InstanceDeviceTrustPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.DeviceTrustPolicyWriteModel.AggregateID).
+		EventTypes(instance.DeviceTrustPolicySetEventType).
+		Builder()
+}
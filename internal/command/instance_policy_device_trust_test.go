@@ -0,0 +1,86 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SetDefaultDeviceTrustPolicy(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		policy     *domain.DeviceTrustPolicy
+		want       *domain.ObjectDetails
+		wantErr    error
+	}{
+		{
+			name:       "lifetime too long, invalid argument error",
+			eventstore: expectEventstore(),
+			policy:     &domain.DeviceTrustPolicy{Lifetime: domain.MaxDeviceTrustLifetime + time.Hour},
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "DOMAIN-Dtp1L", "Errors.Policy.DeviceTrust.LifetimeInvalid"),
+		},
+		{
+			name: "unchanged, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						instance.NewDeviceTrustPolicySetEvent(ctx, &instance.NewAggregate("instance1").Aggregate, 720*time.Hour),
+					),
+				),
+			),
+			policy:  &domain.DeviceTrustPolicy{Lifetime: 720 * time.Hour},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "INSTANCE-Dtp2C", "Errors.NoChangesFound"),
+		},
+		{
+			name: "set, ok",
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectPush(
+					instance.NewDeviceTrustPolicySetEvent(ctx, &instance.NewAggregate("instance1").Aggregate, 720*time.Hour),
+				),
+			),
+			policy: &domain.DeviceTrustPolicy{Lifetime: 720 * time.Hour},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+		{
+			name: "disable, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						instance.NewDeviceTrustPolicySetEvent(ctx, &instance.NewAggregate("instance1").Aggregate, 720*time.Hour),
+					),
+				),
+				expectPush(
+					instance.NewDeviceTrustPolicySetEvent(ctx, &instance.NewAggregate("instance1").Aggregate, 0),
+				),
+			),
+			policy: &domain.DeviceTrustPolicy{},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.SetDefaultDeviceTrustPolicy(ctx, tt.policy)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assertObjectDetails(t, tt.want, got)
+			}
+		})
+	}
+}
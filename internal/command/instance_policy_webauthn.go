@@ -0,0 +1,109 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type WebAuthNPolicy struct {
+	RequireAttestation bool
+	VerifyMetadata     bool
+	AllowedAAGUIDs     []string
+	DeniedAAGUIDs      []string
+}
+
+func (c *Commands) SetWebAuthNPolicy(ctx context.Context, policy *WebAuthNPolicy) (*domain.ObjectDetails, error) {
+	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
+	validation := c.prepareSetWebAuthNPolicy(instanceAgg, policy)
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, validation)
+	if err != nil {
+		return nil, err
+	}
+	events, err := c.eventstore.Push(ctx, cmds...)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ObjectDetails{
+		Sequence:      events[len(events)-1].Sequence(),
+		EventDate:     events[len(events)-1].CreatedAt(),
+		ResourceOwner: events[len(events)-1].Aggregate().InstanceID,
+	}, nil
+}
+
+func (c *Commands) prepareSetWebAuthNPolicy(a *instance.Aggregate, policy *WebAuthNPolicy) preparation.Validation {
+	return func() (_ preparation.CreateCommands, err error) {
+		if policy.AllowedAAGUIDs, err = normalizeAAGUIDs(policy.AllowedAAGUIDs); err != nil {
+			return nil, err
+		}
+		if policy.DeniedAAGUIDs, err = normalizeAAGUIDs(policy.DeniedAAGUIDs); err != nil {
+			return nil, err
+		}
+		if policy.VerifyMetadata && !c.webauthnConfig.HasMetadata() {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Wa8nM", "Errors.Policy.WebAuthN.MetadataUnavailable")
+		}
+		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			writeModel, err := c.getWebAuthNPolicyWriteModel(ctx, filter)
+			if err != nil {
+				return nil, err
+			}
+			cmd, err := writeModel.NewSetEvent(ctx, &a.Aggregate, policy)
+			if err != nil {
+				return nil, err
+			}
+			return []eventstore.Command{cmd}, nil
+		}, nil
+	}
+}
+
+// normalizeAAGUIDs validates the AAGUIDs and returns them in their canonical lowercase form without duplicates.
+func normalizeAAGUIDs(aaguids []string) ([]string, error) {
+	normalized := make([]string, 0, len(aaguids))
+	for _, aaguid := range aaguids {
+		parsed, err := uuid.Parse(strings.TrimSpace(aaguid))
+		if err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-Wa8nA", "Errors.Policy.WebAuthN.AAGUIDInvalid")
+		}
+		if id := parsed.String(); !slices.Contains(normalized, id) {
+			normalized = append(normalized, id)
+		}
+	}
+	return normalized, nil
+}
+
+func (c *Commands) getWebAuthNPolicyWriteModel(ctx context.Context, filter preparation.FilterToQueryReducer) (_ *InstanceWebAuthNPolicyWriteModel, err error) {
+	writeModel := NewInstanceWebAuthNPolicyWriteModel(ctx)
+	events, err := filter(ctx, writeModel.Query())
+	if err != nil {
+		return nil, err
+	}
+	if len(events) == 0 {
+		return writeModel, nil
+	}
+	writeModel.AppendEvents(events...)
+	err = writeModel.Reduce()
+	return writeModel, err
+}
+
+// webAuthNPolicy returns the restrictions for the registration of passkeys and U2F tokens of the instance.
+func (c *Commands) webAuthNPolicy(ctx context.Context) (*domain.WebAuthNPolicy, error) {
+	writeModel := NewInstanceWebAuthNPolicyWriteModel(ctx)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return &domain.WebAuthNPolicy{
+		RequireAttestation: writeModel.RequireAttestation,
+		VerifyMetadata:     writeModel.VerifyMetadata,
+		AllowedAAGUIDs:     writeModel.AllowedAAGUIDs,
+		DeniedAAGUIDs:      writeModel.DeniedAAGUIDs,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceWebAuthNPolicyWriteModel struct {
+	eventstore.WriteModel
+	WebAuthNPolicy
+}
+
+func NewInstanceWebAuthNPolicyWriteModel(ctx context.Context) *InstanceWebAuthNPolicyWriteModel {
+	return &InstanceWebAuthNPolicyWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   authz.GetInstance(ctx).InstanceID(),
+			ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+		},
+	}
+}
+
+func (wm *InstanceWebAuthNPolicyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		if e, ok := event.(*instance.WebAuthNPolicySetEvent); ok {
+			if e.RequireAttestation != nil {
+				wm.RequireAttestation = *e.RequireAttestation
+			}
+			if e.VerifyMetadata != nil {
+				wm.VerifyMetadata = *e.VerifyMetadata
+			}
+			if e.AllowedAAGUIDs != nil {
+				wm.AllowedAAGUIDs = *e.AllowedAAGUIDs
+			}
+			if e.DeniedAAGUIDs != nil {
+				wm.DeniedAAGUIDs = *e.DeniedAAGUIDs
+			}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InstanceWebAuthNPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.WebAuthNPolicySetEventType).
+		Builder()
+}
+
+func (wm *InstanceWebAuthNPolicyWriteModel) NewSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	policy *WebAuthNPolicy,
+) (*instance.WebAuthNPolicySetEvent, error) {
+	changes := make([]instance.WebAuthNPolicyChanges, 0, 4)
+	if wm.RequireAttestation != policy.RequireAttestation {
+		changes = append(changes, instance.ChangeWebAuthNPolicyRequireAttestation(policy.RequireAttestation))
+	}
+	if wm.VerifyMetadata != policy.VerifyMetadata {
+		changes = append(changes, instance.ChangeWebAuthNPolicyVerifyMetadata(policy.VerifyMetadata))
+	}
+	if !slices.Equal(wm.AllowedAAGUIDs, policy.AllowedAAGUIDs) {
+		changes = append(changes, instance.ChangeWebAuthNPolicyAllowedAAGUIDs(policy.AllowedAAGUIDs))
+	}
+	if !slices.Equal(wm.DeniedAAGUIDs, policy.DeniedAAGUIDs) {
+		changes = append(changes, instance.ChangeWebAuthNPolicyDeniedAAGUIDs(policy.DeniedAAGUIDs))
+	}
+	return instance.NewWebAuthNPolicySetEvent(ctx, aggregate, changes)
+}
@@ -0,0 +1,187 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	webauthn_helper "github.com/zitadel/zitadel/internal/webauthn"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SetWebAuthNPolicy(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "INSTANCE")
+	instanceAgg := &instance.NewAggregate("INSTANCE").Aggregate
+	type fields struct {
+		eventstore     func(t *testing.T) *eventstore.Eventstore
+		webauthnConfig *webauthn_helper.Config
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		policy *WebAuthNPolicy
+		res    res
+	}{
+		{
+			name: "invalid aaguid, invalid argument error",
+			fields: fields{
+				eventstore:     expectEventstore(),
+				webauthnConfig: &webauthn_helper.Config{},
+			},
+			policy: &WebAuthNPolicy{
+				AllowedAAGUIDs: []string{"yubikey"},
+			},
+			res: res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Wa8nA", "Errors.Policy.WebAuthN.AAGUIDInvalid"),
+			},
+		},
+		{
+			name: "verify metadata without metadata, precondition error",
+			fields: fields{
+				eventstore:     expectEventstore(),
+				webauthnConfig: &webauthn_helper.Config{},
+			},
+			policy: &WebAuthNPolicy{
+				VerifyMetadata: true,
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Wa8nM", "Errors.Policy.WebAuthN.MetadataUnavailable"),
+			},
+		},
+		{
+			name: "no changes, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							mustNewWebAuthNPolicySetEvent(t, instanceAgg,
+								instance.ChangeWebAuthNPolicyRequireAttestation(true),
+								instance.ChangeWebAuthNPolicyAllowedAAGUIDs([]string{"cb69481e-8ff7-4039-93ec-0a2729a154a8"}),
+							),
+						),
+					),
+				),
+				webauthnConfig: &webauthn_helper.Config{},
+			},
+			policy: &WebAuthNPolicy{
+				RequireAttestation: true,
+				AllowedAAGUIDs:     []string{"CB69481E-8FF7-4039-93EC-0A2729A154A8"},
+			},
+			res: res{
+				err: zerrors.ThrowPreconditionFailed(nil, "POLICY-Wa8nC", "Errors.NoChangesFound"),
+			},
+		},
+		{
+			name: "set, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						mustNewWebAuthNPolicySetEvent(t, instanceAgg,
+							instance.ChangeWebAuthNPolicyRequireAttestation(true),
+							instance.ChangeWebAuthNPolicyAllowedAAGUIDs([]string{"cb69481e-8ff7-4039-93ec-0a2729a154a8"}),
+							instance.ChangeWebAuthNPolicyDeniedAAGUIDs([]string{"ee882879-721c-4913-9775-3dfcce97072a"}),
+						),
+					),
+				),
+				webauthnConfig: &webauthn_helper.Config{},
+			},
+			policy: &WebAuthNPolicy{
+				RequireAttestation: true,
+				AllowedAAGUIDs:     []string{"CB69481E-8FF7-4039-93EC-0A2729A154A8", "cb69481e-8ff7-4039-93ec-0a2729a154a8"},
+				DeniedAAGUIDs:      []string{" ee882879-721c-4913-9775-3dfcce97072a "},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+		{
+			name: "remove restrictions, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							mustNewWebAuthNPolicySetEvent(t, instanceAgg,
+								instance.ChangeWebAuthNPolicyRequireAttestation(true),
+								instance.ChangeWebAuthNPolicyAllowedAAGUIDs([]string{"cb69481e-8ff7-4039-93ec-0a2729a154a8"}),
+							),
+						),
+					),
+					expectPush(
+						mustNewWebAuthNPolicySetEvent(t, instanceAgg,
+							instance.ChangeWebAuthNPolicyRequireAttestation(false),
+							instance.ChangeWebAuthNPolicyAllowedAAGUIDs(nil),
+						),
+					),
+				),
+				webauthnConfig: &webauthn_helper.Config{},
+			},
+			policy: &WebAuthNPolicy{},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "INSTANCE",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:     tt.fields.eventstore(t),
+				webauthnConfig: tt.fields.webauthnConfig,
+			}
+			got, err := r.SetWebAuthNPolicy(ctx, tt.policy)
+			require.ErrorIs(t, err, tt.res.err)
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_webAuthNPolicy(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "INSTANCE")
+	instanceAgg := &instance.NewAggregate("INSTANCE").Aggregate
+	c := &Commands{
+		eventstore: eventstoreExpect(t,
+			expectFilter(
+				eventFromEventPusher(
+					mustNewWebAuthNPolicySetEvent(t, instanceAgg,
+						instance.ChangeWebAuthNPolicyRequireAttestation(true),
+						instance.ChangeWebAuthNPolicyDeniedAAGUIDs([]string{"ee882879-721c-4913-9775-3dfcce97072a"}),
+					),
+				),
+				eventFromEventPusher(
+					mustNewWebAuthNPolicySetEvent(t, instanceAgg,
+						instance.ChangeWebAuthNPolicyVerifyMetadata(true),
+					),
+				),
+			),
+		),
+	}
+	got, err := c.webAuthNPolicy(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &domain.WebAuthNPolicy{
+		RequireAttestation: true,
+		VerifyMetadata:     true,
+		DeniedAAGUIDs:      []string{"ee882879-721c-4913-9775-3dfcce97072a"},
+	}, got)
+}
+
+func mustNewWebAuthNPolicySetEvent(t *testing.T, agg *eventstore.Aggregate, changes ...instance.WebAuthNPolicyChanges) *instance.WebAuthNPolicySetEvent {
+	event, err := instance.NewWebAuthNPolicySetEvent(context.Background(), agg, changes)
+	require.NoError(t, err)
+	return event
+}
@@ -0,0 +1,34 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetDefaultX509Policy sets the trusted certificate authorities and mapping rules for the certificate based authentication of the instance.
+// It applies to all organizations, which don't have their own policy.
+func (c *Commands) SetDefaultX509Policy(ctx context.Context, x509Policy *domain.X509Policy) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err = x509Policy.Validate(); err != nil {
+		return nil, err
+	}
+	writeModel := NewInstanceX509PolicyWriteModel(ctx)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.Equal(x509Policy) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "INSTANCE-X5p2C", "Errors.NoChangesFound")
+	}
+	instanceAgg := instance.NewAggregate(authz.GetInstance(ctx).InstanceID())
+	if err = c.pushAppendAndReduce(ctx, writeModel, instance.NewX509PolicySetEvent(ctx, &instanceAgg.Aggregate, x509Policy)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
@@ -0,0 +1,46 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+)
+
+type InstanceX509PolicyWriteModel struct {
+	X509PolicyWriteModel
+}
+
+func NewInstanceX509PolicyWriteModel(ctx context.Context) *InstanceX509PolicyWriteModel {
+	return &InstanceX509PolicyWriteModel{
+		X509PolicyWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   authz.GetInstance(ctx).InstanceID(),
+				ResourceOwner: authz.GetInstance(ctx).InstanceID(),
+			},
+		},
+	}
+}
+
+func (wm *InstanceX509PolicyWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		if e, ok := event.(*instance.X509PolicySetEvent); ok {
+			wm.X509PolicyWriteModel.AppendEvents(&e.X509PolicySetEvent)
+		}
+	}
+}
+
+func (wm *InstanceX509PolicyWriteModel) Reduce() error {
+	return wm.X509PolicyWriteModel.Reduce()
+}
+
+func (wm *InstanceX509PolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.X509PolicyWriteModel.AggregateID).
+		EventTypes(instance.X509PolicySetEventType).
+		Builder()
+}
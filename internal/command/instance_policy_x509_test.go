@@ -0,0 +1,73 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SetDefaultX509Policy(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	ca := newTestX509CA(t)
+	x509Policy := &domain.X509Policy{
+		TrustedCAs:       ca.pem(),
+		OCSPResponderURL: "http://ocsp.example.com",
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		policy     *domain.X509Policy
+		want       *domain.ObjectDetails
+		wantErr    error
+	}{
+		{
+			name:       "invalid policy, invalid argument error",
+			eventstore: expectEventstore(),
+			policy:     &domain.X509Policy{},
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "DOMAIN-X5p1C", "Errors.Policy.X509.CAInvalid"),
+		},
+		{
+			name: "unchanged, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						instance.NewX509PolicySetEvent(ctx, &instance.NewAggregate("instance1").Aggregate, x509Policy),
+					),
+				),
+			),
+			policy:  x509Policy,
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "INSTANCE-X5p2C", "Errors.NoChangesFound"),
+		},
+		{
+			name: "set, ok",
+			eventstore: expectEventstore(
+				expectFilter(),
+				expectPush(
+					instance.NewX509PolicySetEvent(ctx, &instance.NewAggregate("instance1").Aggregate, x509Policy),
+				),
+			),
+			policy: x509Policy,
+			want: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.SetDefaultX509Policy(ctx, tt.policy)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assertObjectDetails(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -11,6 +11,9 @@ against a passed role permission mapping as JSON, created from Zitadel's config:
 
 It uses an aggregate_id as first argument which may be an instance_id or 'SYSTEM'
 for system level permissions.
+
+Permissions of custom roles, defined by the instance administrators,
+are not part of the config and are therefore never removed.
 */
 WITH target AS (
 	-- unmarshal JSON representation into flattened tabular data
@@ -36,6 +39,15 @@ WITH target AS (
 		AND p.permission = t.permission
 	WHERE p.aggregate_id = $1::text
 	AND t.role IS NULL
+	AND NOT EXISTS (
+		SELECT 1
+		FROM eventstore.fields c
+		WHERE c.instance_id = p.instance_id
+		AND c.aggregate_type = 'permission'
+		AND c.aggregate_id = p.aggregate_id
+		AND c.object_type = 'custom_role'
+		AND c.object_id = p.role
+	)
 )
 -- return the required operations
 SELECT
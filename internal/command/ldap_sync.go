@@ -0,0 +1,456 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	repo "github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/ldapsync"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ldapSyncMinInterval prevents directories from being queried permanently.
+const ldapSyncMinInterval = time.Minute
+
+// LDAPSync configures the periodic synchronization of the users of an LDAP identity provider into an organization.
+type LDAPSync struct {
+	IDPID          string
+	OrganizationID string
+	// Interval is the time between two synchronizations
+	Interval time.Duration
+	// FullSyncInterval is the time between two full synchronizations if IncrementalAttribute is set,
+	// if empty only the first synchronization reads all entries.
+	FullSyncInterval     time.Duration
+	Filter               string
+	PageSize             uint32
+	IncrementalAttribute domain.LDAPSyncIncrementalAttribute
+	GroupAttribute       string
+	GroupMappings        []*domain.LDAPSyncGroupMapping
+}
+
+func (s *LDAPSync) IsValid() error {
+	if s.IDPID == "" || s.OrganizationID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ls9vI", "Errors.IDMissing")
+	}
+	if s.Interval < ldapSyncMinInterval || (s.FullSyncInterval != 0 && s.FullSyncInterval < s.Interval) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ls9vT", "Errors.IDP.LDAPSync.InvalidInterval")
+	}
+	if !s.IncrementalAttribute.Valid() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ls9vA", "Errors.IDP.LDAPSync.InvalidIncrementalAttribute")
+	}
+	if len(s.GroupMappings) > 0 && s.GroupAttribute == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ls9vG", "Errors.IDP.LDAPSync.InvalidGroupMapping")
+	}
+	for _, mapping := range s.GroupMappings {
+		if mapping == nil || mapping.LDAPGroup == "" || mapping.GroupID == "" {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ls9vM", "Errors.IDP.LDAPSync.InvalidGroupMapping")
+		}
+	}
+	return nil
+}
+
+// SetLDAPSync creates or replaces the synchronization of an LDAP identity provider.
+// The synchronization is due immediately.
+func (c *Commands) SetLDAPSync(ctx context.Context, sync *LDAPSync) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := sync.IsValid(); err != nil {
+		return nil, err
+	}
+	idp, err := c.ldapSyncIDP(ctx, sync.IDPID)
+	if err != nil {
+		return nil, err
+	}
+	// users of an organization can only be linked to the identity providers of the instance and their own organization
+	if idp.ResourceOwner != authz.GetInstance(ctx).InstanceID() && idp.ResourceOwner != sync.OrganizationID {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ls9vO", "Errors.IDP.LDAPSync.InvalidOrganization")
+	}
+	if err := c.checkOrgExists(ctx, sync.OrganizationID); err != nil {
+		return nil, err
+	}
+	if err := c.checkPermission(ctx, domain.PermissionUserWrite, sync.OrganizationID, ""); err != nil {
+		return nil, err
+	}
+	for _, mapping := range sync.GroupMappings {
+		group, err := c.checkGroupExists(ctx, mapping.GroupID, nil)
+		if err != nil {
+			return nil, err
+		}
+		if group.ResourceOwner != sync.OrganizationID {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ls9vR", "Errors.Group.NotFound")
+		}
+	}
+
+	writeModel, err := c.getLDAPSyncWriteModel(ctx, sync.IDPID)
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, ldapsync.NewSetEvent(
+		ctx,
+		&ldapsync.NewAggregate(sync.IDPID, idp.ResourceOwner).Aggregate,
+		sync.OrganizationID,
+		sync.Interval,
+		sync.FullSyncInterval,
+		sync.Filter,
+		sync.PageSize,
+		sync.IncrementalAttribute,
+		sync.GroupAttribute,
+		sync.GroupMappings,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveLDAPSync stops the synchronization of an LDAP identity provider, the synchronized users are kept.
+func (c *Commands) RemoveLDAPSync(ctx context.Context, idpID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.existingLDAPSyncWriteModel(ctx, idpID)
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, ldapsync.NewRemovedEvent(
+		ctx,
+		&ldapsync.NewAggregate(idpID, writeModel.ResourceOwner).Aggregate,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RunLDAPSync requests a synchronization independent of the interval.
+// A full synchronization reads all entries and deactivates the users not found in the directory anymore.
+func (c *Commands) RunLDAPSync(ctx context.Context, idpID string, full bool) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.existingLDAPSyncWriteModel(ctx, idpID)
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, ldapsync.NewRunRequestedEvent(
+		ctx,
+		&ldapsync.NewAggregate(idpID, writeModel.ResourceOwner).Aggregate,
+		full,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// existingLDAPSyncWriteModel returns the synchronization
+// if the caller is allowed to manage the identity provider.
+func (c *Commands) existingLDAPSyncWriteModel(ctx context.Context, idpID string) (*LDAPSyncWriteModel, error) {
+	if idpID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ls9rI", "Errors.IDMissing")
+	}
+	writeModel, err := c.getLDAPSyncWriteModel(ctx, idpID)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ls9rN", "Errors.IDP.LDAPSync.NotFound")
+	}
+	if err := c.checkPermissionIDPWrite(ctx, writeModel.ResourceOwner, idpID); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) getLDAPSyncWriteModel(ctx context.Context, idpID string) (_ *LDAPSyncWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewLDAPSyncWriteModel(idpID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// ldapSyncIDP returns the existing LDAP identity provider if the caller is allowed to manage it.
+func (c *Commands) ldapSyncIDP(ctx context.Context, idpID string) (*IDPTypeWriteModel, error) {
+	idp := NewIDPTypeWriteModel(idpID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, idp); err != nil {
+		return nil, err
+	}
+	if idp.State != domain.IDPStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ls9iN", "Errors.IDPConfig.NotExisting")
+	}
+	if idp.Type != domain.IDPTypeLDAP {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ls9iT", "Errors.IDP.LDAPSync.NoLDAP")
+	}
+	if err := c.checkPermissionIDPWrite(ctx, idp.ResourceOwner, idpID); err != nil {
+		return nil, err
+	}
+	return idp, nil
+}
+
+func (c *Commands) checkPermissionIDPWrite(ctx context.Context, resourceOwner, idpID string) error {
+	if resourceOwner == authz.GetInstance(ctx).InstanceID() {
+		return c.checkPermission(ctx, domain.PermissionIDPWrite, resourceOwner, idpID)
+	}
+	return c.checkPermission(ctx, domain.PermissionOrgIDPWrite, resourceOwner, idpID)
+}
+
+// LDAPSyncProvider returns the LDAP provider of the identity provider to read the directory.
+// It is called by the synchronization worker and therefore doesn't check any permission.
+func (c *Commands) LDAPSyncProvider(ctx context.Context, idpID string) (*ldap.Provider, error) {
+	provider, err := c.GetProvider(ctx, idpID, "", "")
+	if err != nil {
+		return nil, err
+	}
+	ldapProvider, ok := provider.(*ldap.Provider)
+	if !ok {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ls9pT", "Errors.IDP.LDAPSync.NoLDAP")
+	}
+	return ldapProvider, nil
+}
+
+// AddLDAPSyncedUser creates a user of the directory in the organization and links it to the identity provider.
+// No verification codes are sent, the email and phone are verified if the directory states so.
+// It is called by the synchronization worker and therefore doesn't check any permission.
+func (c *Commands) AddLDAPSyncedUser(ctx context.Context, orgID, idpID string, ldapUser *ldap.User) (_ string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	human := &AddHuman{
+		Username:    ldapSyncUsername(ldapUser),
+		FirstName:   ldapUser.FirstName,
+		LastName:    ldapUser.LastName,
+		NickName:    ldapUser.NickName,
+		DisplayName: ldapUser.DisplayName,
+		Email: Email{
+			Address:             ldapUser.Email,
+			Verified:            ldapUser.EmailVerified,
+			NoEmailVerification: true,
+		},
+		PreferredLanguage: ldapUser.PreferredLanguage,
+		ExternalIDP:       true,
+		Links: []*AddLink{
+			{
+				IDPID:         idpID,
+				DisplayName:   ldapSyncUsername(ldapUser),
+				IDPExternalID: ldapUser.ID,
+			},
+		},
+	}
+	// unverified phone numbers are added without a verification code, so no messages are sent during the synchronization
+	if ldapUser.PhoneVerified {
+		human.Phone = Phone{Number: ldapUser.Phone, Verified: true}
+	}
+	if human.FirstName == "" {
+		human.FirstName = human.Username
+	}
+	if human.LastName == "" {
+		human.LastName = human.Username
+	}
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter,
+		c.AddHumanCommand(human, orgID, c.userPasswordHasher, c.userEncryption, false),
+	)
+	if err != nil {
+		return "", err
+	}
+	if !ldapUser.PhoneVerified && ldapUser.Phone != "" {
+		phone, err := ldapUser.Phone.Normalize()
+		if err == nil {
+			cmds = append(cmds, user.NewHumanPhoneChangedEvent(ctx, &user.NewAggregate(human.ID, orgID).Aggregate, phone))
+		}
+	}
+	if _, err = c.eventstore.Push(ctx, cmds...); err != nil {
+		return "", err
+	}
+	return human.ID, nil
+}
+
+func ldapSyncUsername(ldapUser *ldap.User) string {
+	if ldapUser.PreferredUsername != "" {
+		return ldapUser.PreferredUsername
+	}
+	if ldapUser.Email != "" {
+		return string(ldapUser.Email)
+	}
+	return ldapUser.ID
+}
+
+// UpdateLDAPSyncedUser applies the attributes of the directory to a synchronized user and reactivates it if necessary.
+// Attributes not set in the directory are kept, changed phone numbers and emails are not verified
+// unless the directory states so and no verification codes are sent.
+// It is called by the synchronization worker and therefore doesn't check any permission.
+func (c *Commands) UpdateLDAPSyncedUser(ctx context.Context, userID, resourceOwner string, ldapUser *ldap.User) (updated, reactivated bool, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return false, false, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ls9uI", "Errors.User.UserIDMissing")
+	}
+	writeModel := NewUserHumanWriteModel(userID, resourceOwner, true, true, true, false, false, false, false)
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return false, false, err
+	}
+	if !isUserStateExists(writeModel.UserState) {
+		return false, false, zerrors.ThrowNotFound(nil, "COMMAND-Ls9uN", "Errors.User.NotFound")
+	}
+	cmds, err := ldapSyncUserChanges(ctx, writeModel, ldapUser)
+	if err != nil {
+		return false, false, err
+	}
+	updated = len(cmds) > 0
+	if isUserStateInactive(writeModel.UserState) {
+		cmds = append(cmds, user.NewUserReactivatedEvent(ctx, &writeModel.Aggregate().Aggregate))
+		reactivated = true
+	}
+	if len(cmds) == 0 {
+		return false, false, nil
+	}
+	if err = c.pushAppendAndReduce(ctx, writeModel, cmds...); err != nil {
+		return false, false, err
+	}
+	return updated, reactivated, nil
+}
+
+func ldapSyncUserChanges(ctx context.Context, wm *UserV2WriteModel, ldapUser *ldap.User) ([]eventstore.Command, error) {
+	cmds := make([]eventstore.Command, 0, 5)
+	profile, err := wm.NewProfileChangedEvent(ctx,
+		nonEmpty(ldapUser.FirstName),
+		nonEmpty(ldapUser.LastName),
+		nonEmpty(ldapUser.NickName),
+		nonEmpty(ldapUser.DisplayName),
+		nonUndefinedLanguage(ldapUser.PreferredLanguage),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if profile != nil {
+		cmds = append(cmds, profile)
+	}
+	aggregate := &wm.Aggregate().Aggregate
+	if ldapUser.Email != "" {
+		email := ldapUser.Email.Normalize()
+		if email != wm.Email {
+			cmds = append(cmds, user.NewHumanEmailChangedEvent(ctx, aggregate, email))
+		}
+		if ldapUser.EmailVerified && (email != wm.Email || !wm.IsEmailVerified) {
+			cmds = append(cmds, user.NewHumanEmailVerifiedEvent(ctx, aggregate))
+		}
+	}
+	if ldapUser.Phone != "" {
+		phone, err := ldapUser.Phone.Normalize()
+		if err != nil {
+			return nil, err
+		}
+		if phone != wm.Phone {
+			cmds = append(cmds, user.NewHumanPhoneChangedEvent(ctx, aggregate, phone))
+		}
+		if ldapUser.PhoneVerified && (phone != wm.Phone || !wm.IsPhoneVerified) {
+			cmds = append(cmds, user.NewHumanPhoneVerifiedEvent(ctx, aggregate))
+		}
+	}
+	return cmds, nil
+}
+
+func nonEmpty(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func nonUndefinedLanguage(tag language.Tag) *language.Tag {
+	if tag.IsRoot() {
+		return nil
+	}
+	return &tag
+}
+
+// SyncLDAPGroupMembers adds and removes synchronized users to and from a mapped group.
+// Users already in the desired state are ignored.
+// It is called by the synchronization worker and therefore doesn't check any permission.
+func (c *Commands) SyncLDAPGroupMembers(ctx context.Context, groupID string, addUserIDs, removeUserIDs []string) (added, removed int, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	group, err := c.checkGroupExists(ctx, groupID, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	toAdd := make([]string, 0, len(addUserIDs))
+	for _, userID := range addUserIDs {
+		if _, ok := group.existingUserIDs[userID]; !ok && !slices.Contains(toAdd, userID) {
+			toAdd = append(toAdd, userID)
+		}
+	}
+	toRemove := make([]string, 0, len(removeUserIDs))
+	for _, userID := range removeUserIDs {
+		if _, ok := group.existingUserIDs[userID]; ok && !slices.Contains(toRemove, userID) {
+			toRemove = append(toRemove, userID)
+		}
+	}
+	cmds := make([]eventstore.Command, 0, 2)
+	aggregate := GroupAggregateFromWriteModel(ctx, &group.WriteModel)
+	if len(toAdd) > 0 {
+		cmds = append(cmds, repo.NewGroupUsersAddedEvent(ctx, aggregate, toAdd))
+	}
+	if len(toRemove) > 0 {
+		cmds = append(cmds, repo.NewGroupUsersRemovedEvent(ctx, aggregate, toRemove))
+	}
+	if len(cmds) == 0 {
+		return 0, 0, nil
+	}
+	if err = c.pushAppendAndReduce(ctx, group, cmds...); err != nil {
+		return 0, 0, err
+	}
+	return len(toAdd), len(toRemove), nil
+}
+
+// LDAPSyncSucceeded reports the summary of a synchronization and schedules the next one.
+// It is called by the synchronization worker and therefore doesn't check any permission.
+func (c *Commands) LDAPSyncSucceeded(ctx context.Context, idpID, resourceOwner string, startedAt time.Time, summary *domain.LDAPSyncSummary, watermark string, nextRunAt time.Time) error {
+	return c.pushLDAPSyncEvent(ctx, func(aggregate *eventstore.Aggregate) eventstore.Command {
+		return ldapsync.NewRunSucceededEvent(ctx, aggregate, startedAt, summary, watermark, nextRunAt)
+	}, idpID, resourceOwner)
+}
+
+// LDAPSyncFailed reports a synchronization which could not be executed and schedules the next one.
+// It is called by the synchronization worker and therefore doesn't check any permission.
+func (c *Commands) LDAPSyncFailed(ctx context.Context, idpID, resourceOwner string, startedAt time.Time, errorMessage string, nextRunAt time.Time) error {
+	return c.pushLDAPSyncEvent(ctx, func(aggregate *eventstore.Aggregate) eventstore.Command {
+		return ldapsync.NewRunFailedEvent(ctx, aggregate, startedAt, errorMessage, nextRunAt)
+	}, idpID, resourceOwner)
+}
+
+// RemoveLDAPSyncOfRemovedIDP removes the synchronization of an identity provider which doesn't exist anymore.
+// It is called by the synchronization worker and therefore doesn't check any permission.
+func (c *Commands) RemoveLDAPSyncOfRemovedIDP(ctx context.Context, idpID, resourceOwner string) error {
+	return c.pushLDAPSyncEvent(ctx, func(aggregate *eventstore.Aggregate) eventstore.Command {
+		return ldapsync.NewRemovedEvent(ctx, aggregate)
+	}, idpID, resourceOwner)
+}
+
+func (c *Commands) pushLDAPSyncEvent(ctx context.Context, event func(aggregate *eventstore.Aggregate) eventstore.Command, idpID, resourceOwner string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if idpID == "" || resourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ls9eI", "Errors.IDMissing")
+	}
+	_, err = c.eventstore.Push(ctx, event(&ldapsync.NewAggregate(idpID, resourceOwner).Aggregate))
+	return err
+}
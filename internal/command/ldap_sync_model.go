@@ -0,0 +1,64 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/ldapsync"
+)
+
+type LDAPSyncWriteModel struct {
+	eventstore.WriteModel
+
+	State                domain.LDAPSyncState
+	OrganizationID       string
+	Interval             time.Duration
+	FullSyncInterval     time.Duration
+	Filter               string
+	PageSize             uint32
+	IncrementalAttribute domain.LDAPSyncIncrementalAttribute
+	GroupAttribute       string
+	GroupMappings        []*domain.LDAPSyncGroupMapping
+}
+
+func NewLDAPSyncWriteModel(idpID string) *LDAPSyncWriteModel {
+	return &LDAPSyncWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID: idpID,
+		},
+	}
+}
+
+func (wm *LDAPSyncWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *ldapsync.SetEvent:
+			wm.State = domain.LDAPSyncStateActive
+			wm.OrganizationID = e.OrganizationID
+			wm.Interval = e.Interval
+			wm.FullSyncInterval = e.FullSyncInterval
+			wm.Filter = e.Filter
+			wm.PageSize = e.PageSize
+			wm.IncrementalAttribute = e.IncrementalAttribute
+			wm.GroupAttribute = e.GroupAttribute
+			wm.GroupMappings = e.GroupMappings
+		case *ldapsync.RemovedEvent:
+			wm.State = domain.LDAPSyncStateRemoved
+			wm.GroupMappings = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *LDAPSyncWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(ldapsync.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			ldapsync.SetType,
+			ldapsync.RemovedType,
+		).
+		Builder()
+}
@@ -0,0 +1,636 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/ldapsync"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func ldapSyncInstanceIDPAddedEvent() *instance.LDAPIDPAddedEvent {
+	return instance.NewLDAPIDPAddedEvent(context.Background(),
+		&instance.NewAggregate("instance1").Aggregate,
+		"idp1",
+		"ldap",
+		[]string{"ldap://localhost:389"},
+		false,
+		"dc=example,dc=com",
+		"cn=admin,dc=example,dc=com",
+		nil,
+		"uid",
+		[]string{"inetOrgPerson"},
+		[]string{"uid"},
+		time.Second,
+		nil,
+		idp.LDAPAttributes{IDAttribute: "uid"},
+		idp.Options{},
+	)
+}
+
+func ldapSyncOrgIDPAddedEvent() *org.LDAPIDPAddedEvent {
+	return org.NewLDAPIDPAddedEvent(context.Background(),
+		&org.NewAggregate("org2").Aggregate,
+		"idp1",
+		"ldap",
+		[]string{"ldap://localhost:389"},
+		false,
+		"dc=example,dc=com",
+		"cn=admin,dc=example,dc=com",
+		nil,
+		"uid",
+		[]string{"inetOrgPerson"},
+		[]string{"uid"},
+		time.Second,
+		nil,
+		idp.LDAPAttributes{IDAttribute: "uid"},
+		idp.Options{},
+	)
+}
+
+func ldapSyncSetEvent(ctx context.Context, mappings []*domain.LDAPSyncGroupMapping) *ldapsync.SetEvent {
+	groupAttribute := ""
+	if len(mappings) > 0 {
+		groupAttribute = "memberOf"
+	}
+	return ldapsync.NewSetEvent(ctx,
+		&ldapsync.NewAggregate("idp1", "instance1").Aggregate,
+		"org1",
+		time.Hour,
+		24*time.Hour,
+		"(!(employeeType=disabled))",
+		100,
+		domain.LDAPSyncIncrementalAttributeModifyTimestamp,
+		groupAttribute,
+		mappings,
+	)
+}
+
+func TestCommands_SetLDAPSync(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "admin1")
+	mappings := []*domain.LDAPSyncGroupMapping{{LDAPGroup: "cn=admins,ou=groups,dc=example,dc=com", GroupID: "group1"}}
+	validSync := func(mappings []*domain.LDAPSyncGroupMapping) *LDAPSync {
+		sync := &LDAPSync{
+			IDPID:                "idp1",
+			OrganizationID:       "org1",
+			Interval:             time.Hour,
+			FullSyncInterval:     24 * time.Hour,
+			Filter:               "(!(employeeType=disabled))",
+			PageSize:             100,
+			IncrementalAttribute: domain.LDAPSyncIncrementalAttributeModifyTimestamp,
+			GroupMappings:        mappings,
+		}
+		if len(mappings) > 0 {
+			sync.GroupAttribute = "memberOf"
+		}
+		return sync
+	}
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		sync   *LDAPSync
+		res    res
+	}{
+		{
+			name: "interval too short, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			sync: &LDAPSync{
+				IDPID:          "idp1",
+				OrganizationID: "org1",
+				Interval:       time.Second,
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "group mapping without attribute, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			sync: &LDAPSync{
+				IDPID:          "idp1",
+				OrganizationID: "org1",
+				Interval:       time.Hour,
+				GroupMappings:  mappings,
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "idp not existing, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			sync: validSync(nil),
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "no permission, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(ldapSyncInstanceIDPAddedEvent()),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			sync: validSync(nil),
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "idp of other organization, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(ldapSyncOrgIDPAddedEvent()),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			sync: validSync(nil),
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "group of other organization, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(ldapSyncInstanceIDPAddedEvent()),
+					),
+					expectFilter(
+						eventFromEventPusher(org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org")),
+					),
+					expectFilter(
+						eventFromEventPusher(group.NewGroupAddedEvent(context.Background(), &group.NewAggregate("group1", "org2").Aggregate, "admins", "")),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			sync: validSync(mappings),
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(ldapSyncInstanceIDPAddedEvent()),
+					),
+					expectFilter(
+						eventFromEventPusher(org.NewOrgAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "org")),
+					),
+					expectFilter(
+						eventFromEventPusher(group.NewGroupAddedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "admins", "")),
+					),
+					expectFilter(),
+					expectPush(
+						ldapSyncSetEvent(ctx, mappings),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			sync: validSync(mappings),
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.SetLDAPSync(ctx, tt.sync)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RunLDAPSync(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "admin1")
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		idpID  string
+		res    res
+	}{
+		{
+			name: "missing id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			idpID: "idp1",
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "removed, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(ldapSyncSetEvent(context.Background(), nil)),
+						eventFromEventPusher(ldapsync.NewRemovedEvent(context.Background(), &ldapsync.NewAggregate("idp1", "instance1").Aggregate)),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			idpID: "idp1",
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "no permission, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(ldapSyncSetEvent(context.Background(), nil)),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			idpID: "idp1",
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(ldapSyncSetEvent(context.Background(), nil)),
+					),
+					expectPush(
+						ldapsync.NewRunRequestedEvent(ctx, &ldapsync.NewAggregate("idp1", "instance1").Aggregate, true),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			idpID: "idp1",
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "instance1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.RunLDAPSync(ctx, tt.idpID, true)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveLDAPSync(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "admin1")
+	c := &Commands{
+		eventstore: expectEventstore(
+			expectFilter(
+				eventFromEventPusher(ldapSyncSetEvent(context.Background(), nil)),
+			),
+			expectPush(
+				ldapsync.NewRemovedEvent(ctx, &ldapsync.NewAggregate("idp1", "instance1").Aggregate),
+			),
+		)(t),
+		checkPermission: newMockPermissionCheckAllowed(),
+	}
+	got, err := c.RemoveLDAPSync(ctx, "idp1")
+	assert.NoError(t, err)
+	assertObjectDetails(t, &domain.ObjectDetails{ResourceOwner: "instance1"}, got)
+}
+
+func TestCommands_AddLDAPSyncedUser(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type res struct {
+		wantID string
+		err    func(error) bool
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		ldapUser *ldap.User
+		res      res
+	}{
+		{
+			name: "missing email, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			ldapUser: &ldap.User{ID: "alice", FirstName: "Alice", LastName: "Doe"},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "unverified phone, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainPolicyAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, true, true, true),
+						),
+					),
+					expectFilterOrganizationSettings("org1", false, false),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(ldapSyncInstanceIDPAddedEvent()),
+					),
+					expectPush(
+						user.NewHumanAddedEvent(context.Background(),
+							userAgg,
+							"alice@example.com",
+							"Alice",
+							"alice@example.com",
+							"",
+							"Alice alice@example.com",
+							language.Und,
+							domain.GenderUnspecified,
+							"alice@example.com",
+							true,
+						),
+						user.NewHumanEmailVerifiedEvent(context.Background(), userAgg),
+						user.NewUserIDPLinkAddedEvent(context.Background(), userAgg, "idp1", "alice@example.com", "alice"),
+						user.NewHumanPhoneChangedEvent(context.Background(), userAgg, "+41791234567"),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "user1"),
+			},
+			ldapUser: &ldap.User{
+				ID:            "alice",
+				FirstName:     "Alice",
+				Email:         "alice@example.com",
+				EmailVerified: true,
+				Phone:         "+41791234567",
+			},
+			res: res{
+				wantID: "user1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.AddLDAPSyncedUser(ctx, "org1", "idp1", tt.ldapUser)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			assert.Equal(t, tt.res.wantID, got)
+		})
+	}
+}
+
+func TestCommands_UpdateLDAPSyncedUser(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	humanAdded := func() eventstore.Event {
+		return eventFromEventPusher(newAddHumanEvent("", false, false, "", language.English))
+	}
+	type res struct {
+		updated     bool
+		reactivated bool
+		err         func(error) bool
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		ldapUser   *ldap.User
+		res        res
+	}{
+		{
+			name: "not existing, not found error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			ldapUser: &ldap.User{ID: "alice"},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "unchanged",
+			eventstore: expectEventstore(
+				expectFilter(
+					humanAdded(),
+				),
+			),
+			ldapUser: &ldap.User{ID: "alice", FirstName: "firstname", Email: "email@test.ch"},
+		},
+		{
+			name: "changed and reactivated",
+			eventstore: expectEventstore(
+				expectFilter(
+					humanAdded(),
+					eventFromEventPusher(user.NewUserDeactivatedEvent(context.Background(), userAgg)),
+				),
+				expectPush(
+					func() eventstore.Command {
+						event, _ := user.NewHumanProfileChangedEvent(context.Background(), userAgg, []user.ProfileChanges{user.ChangeLastName("Doe")})
+						return event
+					}(),
+					user.NewHumanEmailChangedEvent(context.Background(), userAgg, "alice@example.com"),
+					user.NewHumanEmailVerifiedEvent(context.Background(), userAgg),
+					user.NewUserReactivatedEvent(context.Background(), userAgg),
+				),
+			),
+			ldapUser: &ldap.User{ID: "alice", LastName: "Doe", Email: "alice@example.com", EmailVerified: true},
+			res: res{
+				updated:     true,
+				reactivated: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			updated, reactivated, err := c.UpdateLDAPSyncedUser(ctx, "user1", "org1", tt.ldapUser)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			assert.Equal(t, tt.res.updated, updated)
+			assert.Equal(t, tt.res.reactivated, reactivated)
+		})
+	}
+}
+
+func TestCommands_SyncLDAPGroupMembers(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	groupAgg := &group.NewAggregate("group1", "org1").Aggregate
+	groupAdded := eventFromEventPusher(group.NewGroupAddedEvent(context.Background(), groupAgg, "admins", ""))
+	usersAdded := eventFromEventPusher(group.NewGroupUsersAddedEvent(context.Background(), groupAgg, []string{"user1", "user3"}))
+	tests := []struct {
+		name        string
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		add         []string
+		remove      []string
+		wantAdded   int
+		wantRemoved int
+		wantErr     func(error) bool
+	}{
+		{
+			name: "group not existing, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			add:     []string{"user1"},
+			wantErr: zerrors.IsPreconditionFailed,
+		},
+		{
+			name: "desired state, no changes",
+			eventstore: expectEventstore(
+				expectFilter(groupAdded, usersAdded),
+			),
+			add:    []string{"user1"},
+			remove: []string{"user2"},
+		},
+		{
+			name: "add and remove",
+			eventstore: expectEventstore(
+				expectFilter(groupAdded, usersAdded),
+				expectPush(
+					group.NewGroupUsersAddedEvent(context.Background(), groupAgg, []string{"user2"}),
+					group.NewGroupUsersRemovedEvent(context.Background(), groupAgg, []string{"user3"}),
+				),
+			),
+			add:         []string{"user1", "user2", "user2"},
+			remove:      []string{"user3", "user4"},
+			wantAdded:   1,
+			wantRemoved: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			added, removed, err := c.SyncLDAPGroupMembers(ctx, "group1", tt.add, tt.remove)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+			}
+			if tt.wantErr != nil && !tt.wantErr(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			assert.Equal(t, tt.wantAdded, added)
+			assert.Equal(t, tt.wantRemoved, removed)
+		})
+	}
+}
+
+func TestCommands_LDAPSyncSucceeded(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	startedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	nextRunAt := startedAt.Add(time.Hour)
+	summary := &domain.LDAPSyncSummary{Full: true, Created: 2}
+	c := &Commands{
+		eventstore: expectEventstore(
+			expectPush(
+				ldapsync.NewRunSucceededEvent(context.Background(), &ldapsync.NewAggregate("idp1", "instance1").Aggregate, startedAt, summary, "20250101100000Z", nextRunAt),
+			),
+		)(t),
+	}
+	err := c.LDAPSyncSucceeded(ctx, "idp1", "instance1", startedAt, summary, "20250101100000Z", nextRunAt)
+	assert.NoError(t, err)
+
+	err = c.LDAPSyncSucceeded(ctx, "", "instance1", startedAt, summary, "", nextRunAt)
+	assert.True(t, zerrors.IsErrorInvalidArgument(err))
+}
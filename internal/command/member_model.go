@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/member"
@@ -9,8 +11,11 @@ import (
 type MemberWriteModel struct {
 	eventstore.WriteModel
 
-	UserID string
-	Roles  []string
+	UserID     string
+	Roles      []string
+	ValidUntil *time.Time
+	// CreationDate is the time the member was added.
+	CreationDate time.Time
 
 	State domain.MemberState
 }
@@ -27,11 +32,15 @@ func (wm *MemberWriteModel) Reduce() error {
 		case *member.MemberAddedEvent:
 			wm.UserID = e.UserID
 			wm.Roles = e.Roles
+			wm.ValidUntil = e.ValidUntil
+			wm.CreationDate = e.CreationDate()
 			wm.State = domain.MemberStateActive
 		case *member.MemberChangedEvent:
 			wm.Roles = e.Roles
+			wm.ValidUntil = e.ValidUntil
 		case *member.MemberRemovedEvent:
 			wm.Roles = nil
+			wm.ValidUntil = nil
 			wm.State = domain.MemberStateRemoved
 		}
 	}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/zitadel/logging"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"golang.org/x/text/language"
 
 	"github.com/zitadel/zitadel/internal/activity"
@@ -21,6 +23,7 @@ import (
 	"github.com/zitadel/zitadel/internal/repository/sessionlogout"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/usage"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -467,6 +470,16 @@ func (c *OIDCSessionEvents) RegisterLogout(ctx context.Context, sessionID, userI
 }
 
 func (c *OIDCSessionEvents) AddAccessToken(ctx context.Context, scope []string, userID, resourceOwner string, reason domain.TokenReason, actor *domain.TokenActor) error {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if usage.TokensExhausted(ctx, instanceID) {
+		return zerrors.ThrowResourceExhausted(nil, "OIDCS-Xo4rT", "Errors.Quota.Tokens.Exhausted")
+	}
+	if usage.ActiveUsersExhausted(ctx, instanceID, userID) {
+		return zerrors.ThrowResourceExhausted(nil, "OIDCS-Ga7mL", "Errors.Quota.ActiveUsers.Exhausted")
+	}
+	if usage.OrgActiveUsersExhausted(ctx, instanceID, resourceOwner, userID) {
+		return zerrors.ThrowResourceExhausted(nil, "OIDCS-Pm4vQ", "Errors.Quota.Org.Exhausted")
+	}
 	accessTokenID, err := c.idGenerator.Next()
 	if err != nil {
 		return err
@@ -543,9 +556,22 @@ func (c *OIDCSessionEvents) PushEvents(ctx context.Context) (*OIDCSession, error
 		session.TokenID = c.oidcSessionWriteModel.AggregateID + TokenDelimiter + c.accessTokenID
 	}
 	activity.Trigger(ctx, c.oidcSessionWriteModel.UserResourceOwner, c.oidcSessionWriteModel.UserID, tokenReasonToActivityMethodType(c.oidcSessionWriteModel.AccessTokenReason), c.commands.eventstore.FilterToQueryReducer)
+	usage.TokensIssued(ctx, authz.GetInstance(ctx).InstanceID(), c.issuedTokens())
 	return session, nil
 }
 
+// issuedTokens returns the amount of tokens issued by the pushed events:
+// the access token and the id token, if the openid scope was requested.
+func (c *OIDCSessionEvents) issuedTokens() uint64 {
+	if c.accessTokenID == "" {
+		return 0
+	}
+	if slices.Contains(c.oidcSessionWriteModel.Scope, oidc.ScopeOpenID) {
+		return 2
+	}
+	return 1
+}
+
 func (c *Commands) tokenTokenLifetimes(ctx context.Context) (accessTokenLifetime time.Duration, refreshTokenLifetime time.Duration, refreshTokenIdleLifetime time.Duration, err error) {
 	oidcSettings := NewInstanceOIDCSettingsWriteModel(ctx)
 	err = c.eventstore.FilterToQueryReducer(ctx, oidcSettings)
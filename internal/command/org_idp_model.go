@@ -876,6 +876,70 @@ func (wm *OrgAppleIDPWriteModel) NewChangedEvent(
 	return org.NewAppleIDPChangedEvent(ctx, aggregate, id, changes)
 }
 
+type OrgWSFederationIDPWriteModel struct {
+	WSFederationIDPWriteModel
+}
+
+func NewWSFederationOrgIDPWriteModel(orgID, id string) *OrgWSFederationIDPWriteModel {
+	return &OrgWSFederationIDPWriteModel{
+		WSFederationIDPWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *OrgWSFederationIDPWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.WSFederationIDPAddedEvent:
+			wm.WSFederationIDPWriteModel.AppendEvents(&e.WSFederationIDPAddedEvent)
+		case *org.WSFederationIDPChangedEvent:
+			wm.WSFederationIDPWriteModel.AppendEvents(&e.WSFederationIDPChangedEvent)
+		case *org.IDPRemovedEvent:
+			wm.WSFederationIDPWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.WSFederationIDPWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *OrgWSFederationIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.WSFederationIDPAddedEventType,
+			org.WSFederationIDPChangedEventType,
+			org.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+func (wm *OrgWSFederationIDPWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	ssoEndpoint,
+	realm string,
+	certificate []byte,
+	idClaim string,
+	options idp.Options,
+) (*org.WSFederationIDPChangedEvent, error) {
+	changes := wm.WSFederationIDPWriteModel.NewChanges(name, ssoEndpoint, realm, certificate, idClaim, options)
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	return org.NewWSFederationIDPChangedEvent(ctx, aggregate, id, changes)
+}
+
 type OrgSAMLIDPWriteModel struct {
 	SAMLIDPWriteModel
 }
@@ -1000,6 +1064,8 @@ func (wm *OrgIDPRemoveWriteModel) AppendEvents(events ...eventstore.Event) {
 			wm.IDPRemoveWriteModel.AppendEvents(&e.LDAPIDPAddedEvent)
 		case *org.AppleIDPAddedEvent:
 			wm.IDPRemoveWriteModel.AppendEvents(&e.AppleIDPAddedEvent)
+		case *org.WSFederationIDPAddedEvent:
+			wm.IDPRemoveWriteModel.AppendEvents(&e.WSFederationIDPAddedEvent)
 		case *org.SAMLIDPAddedEvent:
 			wm.IDPRemoveWriteModel.AppendEvents(&e.SAMLIDPAddedEvent)
 		case *org.IDPRemovedEvent:
@@ -1033,6 +1099,7 @@ func (wm *OrgIDPRemoveWriteModel) Query() *eventstore.SearchQueryBuilder {
 			org.LDAPIDPAddedEventType,
 			org.AppleIDPAddedEventType,
 			org.SAMLIDPAddedEventType,
+			org.WSFederationIDPAddedEventType,
 			org.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
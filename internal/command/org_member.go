@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"slices"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/command/preparation"
@@ -14,8 +15,12 @@ import (
 )
 
 func (c *Commands) AddOrgMemberCommand(member *AddOrgMember) preparation.Validation {
+	return c.addOrgMemberCommand(member, c.zitadelRoles)
+}
+
+func (c *Commands) addOrgMemberCommand(member *AddOrgMember, validRoles []authz.RoleMapping) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
-		if err := member.IsValid(c.zitadelRoles); err != nil {
+		if err := member.IsValid(validRoles); err != nil {
 			return nil, err
 		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) (_ []eventstore.Command, err error) {
@@ -28,7 +33,9 @@ func (c *Commands) AddOrgMemberCommand(member *AddOrgMember) preparation.Validat
 				if isMember, err := IsOrgMember(ctx, filter, member.OrgID, member.UserID); err != nil || isMember {
 					return nil, zerrors.ThrowAlreadyExists(err, "ORG-poWwe", "Errors.Org.Member.AlreadyExists")
 				}
-				return []eventstore.Command{org.NewMemberAddedEvent(ctx, &org.NewAggregate(member.OrgID).Aggregate, member.UserID, member.Roles...)}, nil
+				added := org.NewMemberAddedEvent(ctx, &org.NewAggregate(member.OrgID).Aggregate, member.UserID, member.Roles...)
+				added.ValidUntil = member.ValidUntil
+				return []eventstore.Command{added}, nil
 			},
 			nil
 	}
@@ -74,6 +81,8 @@ type AddOrgMember struct {
 	OrgID  string
 	UserID string
 	Roles  []string
+	// ValidUntil limits the membership in time, it is removed automatically after expiry.
+	ValidUntil *time.Time
 }
 
 func (m *AddOrgMember) IsValid(zitadelRoles []authz.RoleMapping) error {
@@ -83,6 +92,9 @@ func (m *AddOrgMember) IsValid(zitadelRoles []authz.RoleMapping) error {
 	if len(domain.CheckForInvalidRoles(m.Roles, domain.OrgRolePrefix, zitadelRoles)) > 0 && len(domain.CheckForInvalidRoles(m.Roles, domain.RoleSelfManagementGlobal, zitadelRoles)) > 0 {
 		return zerrors.ThrowInvalidArgument(nil, "Org-4N8es", "Errors.Org.MemberInvalid")
 	}
+	if m.ValidUntil != nil && !m.ValidUntil.After(time.Now()) {
+		return zerrors.ThrowInvalidArgument(nil, "Org-Vu5mw", "Errors.Org.MemberValidityInvalid")
+	}
 	return nil
 }
 
@@ -95,8 +107,12 @@ func (c *Commands) AddOrgMember(ctx context.Context, member *AddOrgMember) (_ *d
 	if err := c.checkPermissionUpdateOrgMember(ctx, member.OrgID, member.OrgID); err != nil {
 		return nil, err
 	}
+	validRoles, err := c.administratorRoles(ctx, authz.GetInstance(ctx).InstanceID(), member.Roles)
+	if err != nil {
+		return nil, err
+	}
 	//nolint:staticcheck
-	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, c.AddOrgMemberCommand(member))
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, c.addOrgMemberCommand(member, validRoles))
 	if err != nil {
 		return nil, err
 	}
@@ -116,6 +132,11 @@ type ChangeOrgMember struct {
 	OrgID  string
 	UserID string
 	Roles  []string
+	// ValidUntil limits the membership in time.
+	// If not set, the current validity of the membership is kept.
+	ValidUntil *time.Time
+	// ClearValidUntil makes a time-bound membership permanent.
+	ClearValidUntil bool
 }
 
 func (c *ChangeOrgMember) IsValid(zitadelRoles []authz.RoleMapping) error {
@@ -125,13 +146,20 @@ func (c *ChangeOrgMember) IsValid(zitadelRoles []authz.RoleMapping) error {
 	if len(domain.CheckForInvalidRoles(c.Roles, domain.OrgRolePrefix, zitadelRoles)) > 0 {
 		return zerrors.ThrowInvalidArgument(nil, "IAM-m9fG8", "Errors.Org.MemberInvalid")
 	}
+	if c.ValidUntil != nil && (c.ClearValidUntil || !c.ValidUntil.After(time.Now())) {
+		return zerrors.ThrowInvalidArgument(nil, "Org-Vu6pe", "Errors.Org.MemberValidityInvalid")
+	}
 
 	return nil
 }
 
 // ChangeOrgMember updates an existing member
 func (c *Commands) ChangeOrgMember(ctx context.Context, member *ChangeOrgMember) (*domain.ObjectDetails, error) {
-	if err := member.IsValid(c.zitadelRoles); err != nil {
+	validRoles, err := c.administratorRoles(ctx, authz.GetInstance(ctx).InstanceID(), member.Roles)
+	if err != nil {
+		return nil, err
+	}
+	if err := member.IsValid(validRoles); err != nil {
 		return nil, err
 	}
 
@@ -146,17 +174,18 @@ func (c *Commands) ChangeOrgMember(ctx context.Context, member *ChangeOrgMember)
 		return nil, err
 	}
 
-	if slices.Compare(existingMember.Roles, member.Roles) == 0 {
+	validUntil := changedMemberValidity(existingMember.ValidUntil, member.ValidUntil, member.ClearValidUntil)
+	if slices.Compare(existingMember.Roles, member.Roles) == 0 && equalValidity(existingMember.ValidUntil, validUntil) {
 		return writeModelToObjectDetails(&existingMember.WriteModel), nil
 	}
 
-	pushedEvents, err := c.eventstore.Push(ctx,
-		org.NewMemberChangedEvent(ctx,
-			OrgAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel),
-			member.UserID,
-			member.Roles...,
-		),
+	changed := org.NewMemberChangedEvent(ctx,
+		OrgAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel),
+		member.UserID,
+		member.Roles...,
 	)
+	changed.ValidUntil = validUntil
+	pushedEvents, err := c.eventstore.Push(ctx, changed)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/text/language"
@@ -347,6 +348,7 @@ func TestCommandSide_AddOrgMember(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
 				),
 				checkPermission: newMockPermissionCheckAllowed(),
 			},
@@ -614,6 +616,7 @@ func TestCommandSide_AddOrgMember(t *testing.T) {
 }
 
 func TestCommandSide_ChangeOrgMember(t *testing.T) {
+	validUntil := time.Now().Add(time.Hour).Truncate(time.Second)
 	type fields struct {
 		checkPermission domain.PermissionCheck
 		eventstore      func(t *testing.T) *eventstore.Eventstore
@@ -766,6 +769,100 @@ func TestCommandSide_ChangeOrgMember(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "time-bound member change, validity kept",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							timeBoundOrgMemberAddedEvent(validUntil, "ORG_OWNER"),
+						),
+					),
+					expectPush(
+						timeBoundOrgMemberChangedEvent(&validUntil, "ORG_OWNER", "ORG_OWNER_VIEWER"),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				zitadelRoles: []authz.RoleMapping{
+					{
+						Role: "ORG_OWNER",
+					},
+					{
+						Role: "ORG_OWNER_VIEWER",
+					},
+				},
+			},
+			args: args{
+				member: &ChangeOrgMember{
+					OrgID:  "org1",
+					UserID: "user1",
+					Roles:  []string{"ORG_OWNER", "ORG_OWNER_VIEWER"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "time-bound member change, validity cleared",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							timeBoundOrgMemberAddedEvent(validUntil, "ORG_OWNER"),
+						),
+					),
+					expectPush(
+						timeBoundOrgMemberChangedEvent(nil, "ORG_OWNER"),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+				zitadelRoles: []authz.RoleMapping{
+					{
+						Role: "ORG_OWNER",
+					},
+				},
+			},
+			args: args{
+				member: &ChangeOrgMember{
+					OrgID:           "org1",
+					UserID:          "user1",
+					Roles:           []string{"ORG_OWNER"},
+					ClearValidUntil: true,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "validity set and cleared, error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+				zitadelRoles: []authz.RoleMapping{
+					{
+						Role: "ORG_OWNER",
+					},
+				},
+			},
+			args: args{
+				member: &ChangeOrgMember{
+					OrgID:           "org1",
+					UserID:          "user1",
+					Roles:           []string{"ORG_OWNER"},
+					ValidUntil:      &validUntil,
+					ClearValidUntil: true,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
 		{
 			name: "member change, no permission",
 			fields: fields{
@@ -823,6 +920,18 @@ func TestCommandSide_ChangeOrgMember(t *testing.T) {
 	}
 }
 
+func timeBoundOrgMemberAddedEvent(validUntil time.Time, roles ...string) *org.MemberAddedEvent {
+	added := org.NewMemberAddedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "user1", roles...)
+	added.ValidUntil = &validUntil
+	return added
+}
+
+func timeBoundOrgMemberChangedEvent(validUntil *time.Time, roles ...string) *org.MemberChangedEvent {
+	changed := org.NewMemberChangedEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "user1", roles...)
+	changed.ValidUntil = validUntil
+	return changed
+}
+
 func TestCommandSide_RemoveOrgMember(t *testing.T) {
 	type fields struct {
 		eventstore      func(t *testing.T) *eventstore.Eventstore
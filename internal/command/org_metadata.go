@@ -68,6 +68,10 @@ func (c *Commands) setOrgMetadata(ctx context.Context, orgAgg *eventstore.Aggreg
 	if !metadata.IsValid() {
 		return nil, zerrors.ThrowInvalidArgument(nil, "META-2ml0f", "Errors.Metadata.Invalid")
 	}
+	// custom scim schemas are read from the organization metadata, invalid definitions must not be stored
+	if err := domain.ValidateScimCustomSchemaMetadata(metadata.Key, metadata.Value); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "META-Sc5mV", "Errors.Metadata.Invalid")
+	}
 	return org.NewMetadataSetEvent(
 		ctx,
 		orgAgg,
@@ -80,6 +80,33 @@ func TestCommandSide_SetOrgMetadata(t *testing.T) {
 				err: zerrors.IsErrorInvalidArgument,
 			},
 		},
+		{
+			name: "invalid custom scim schema, invalid argument error",
+			fields: fields{
+				eventstore: eventstoreExpect(
+					t,
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"ZITADEL",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				metadata: &domain.Metadata{
+					Key:   "urn:zitadel:scim:schemas:urn:example:scim:schemas:Badge",
+					Value: []byte(`{"name":"Badge","attributes":[{"name":"number","type":"complex"}]}`),
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
 		{
 			name: "add metadata, ok",
 			fields: fields{
@@ -0,0 +1,78 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgDeviceTrustPolicy sets how long users of the organization can trust a device after checking a second factor.
+// It replaces the policy of the instance for the users of the organization.
+func (c *Commands) SetOrgDeviceTrustPolicy(ctx context.Context, orgID string, deviceTrustPolicy *domain.DeviceTrustPolicy) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Dtp3R", "Errors.ResourceOwnerMissing")
+	}
+	if err = deviceTrustPolicy.Validate(); err != nil {
+		return nil, err
+	}
+	if err = c.checkOrgExists(ctx, orgID); err != nil {
+		return nil, err
+	}
+	writeModel := NewOrgDeviceTrustPolicyWriteModel(orgID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.Equal(deviceTrustPolicy) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-Dtp3C", "Errors.NoChangesFound")
+	}
+	orgAgg := OrgAggregateFromWriteModel(&writeModel.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, writeModel, org.NewDeviceTrustPolicySetEvent(ctx, orgAgg, deviceTrustPolicy.Lifetime)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveOrgDeviceTrustPolicy removes the policy of the organization, so the policy of the instance applies again.
+func (c *Commands) RemoveOrgDeviceTrustPolicy(ctx context.Context, orgID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-Dtp4R", "Errors.ResourceOwnerMissing")
+	}
+	writeModel := NewOrgDeviceTrustPolicyWriteModel(orgID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.PolicyStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-Dtp4N", "Errors.Policy.DeviceTrust.NotExisting")
+	}
+	orgAgg := OrgAggregateFromWriteModel(&writeModel.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, writeModel, org.NewDeviceTrustPolicyRemovedEvent(ctx, orgAgg)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// deviceTrustPolicy returns the policy for the trusted devices of the users of the organization.
+// The policy of the instance is returned if the organization has none.
+func (c *Commands) deviceTrustPolicy(ctx context.Context, orgID string) (*domain.DeviceTrustPolicy, error) {
+	orgWriteModel := NewOrgDeviceTrustPolicyWriteModel(orgID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, orgWriteModel); err != nil {
+		return nil, err
+	}
+	if orgWriteModel.State == domain.PolicyStateActive {
+		return orgWriteModel.policy(false), nil
+	}
+	instanceWriteModel := NewInstanceDeviceTrustPolicyWriteModel(ctx)
+	if err := c.eventstore.FilterToQueryReducer(ctx, instanceWriteModel); err != nil {
+		return nil, err
+	}
+	return instanceWriteModel.policy(true), nil
+}
@@ -0,0 +1,48 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgDeviceTrustPolicyWriteModel struct {
+	DeviceTrustPolicyWriteModel
+}
+
+func NewOrgDeviceTrustPolicyWriteModel(orgID string) *OrgDeviceTrustPolicyWriteModel {
+	return &OrgDeviceTrustPolicyWriteModel{
+		DeviceTrustPolicyWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+		},
+	}
+}
+
+func (wm *OrgDeviceTrustPolicyWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.DeviceTrustPolicySetEvent:
+			wm.DeviceTrustPolicyWriteModel.AppendEvents(&e.DeviceTrustPolicySetEvent)
+		case *org.DeviceTrustPolicyRemovedEvent:
+			wm.DeviceTrustPolicyWriteModel.AppendEvents(&e.DeviceTrustPolicyRemovedEvent)
+		}
+	}
+}
+
+func (wm *OrgDeviceTrustPolicyWriteModel) Reduce() error {
+	return wm.DeviceTrustPolicyWriteModel.Reduce()
+}
+
+func (wm *OrgDeviceTrustPolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.DeviceTrustPolicyWriteModel.AggregateID).
+		EventTypes(
+			org.DeviceTrustPolicySetEventType,
+			org.DeviceTrustPolicyRemovedEventType).
+		Builder()
+}
@@ -0,0 +1,167 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SetOrgDeviceTrustPolicy(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	orgAgg := &org.NewAggregate("org1").Aggregate
+	deviceTrustPolicy := &domain.DeviceTrustPolicy{Lifetime: 168 * time.Hour}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		orgID      string
+		want       *domain.ObjectDetails
+		wantErr    error
+	}{
+		{
+			name:       "missing org, invalid argument error",
+			eventstore: expectEventstore(),
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "ORG-Dtp3R", "Errors.ResourceOwnerMissing"),
+		},
+		{
+			name: "org not existing, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			orgID:   "org1",
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-QXPGs", "Errors.Org.NotFound"),
+		},
+		{
+			name: "unchanged, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(org.NewOrgAddedEvent(ctx, orgAgg, "org")),
+				),
+				expectFilter(
+					eventFromEventPusher(org.NewDeviceTrustPolicySetEvent(ctx, orgAgg, 168*time.Hour)),
+				),
+			),
+			orgID:   "org1",
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "ORG-Dtp3C", "Errors.NoChangesFound"),
+		},
+		{
+			name: "set after removal, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(org.NewOrgAddedEvent(ctx, orgAgg, "org")),
+				),
+				expectFilter(
+					eventFromEventPusher(org.NewDeviceTrustPolicySetEvent(ctx, orgAgg, 168*time.Hour)),
+					eventFromEventPusher(org.NewDeviceTrustPolicyRemovedEvent(ctx, orgAgg)),
+				),
+				expectPush(
+					org.NewDeviceTrustPolicySetEvent(ctx, orgAgg, 168*time.Hour),
+				),
+			),
+			orgID: "org1",
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.SetOrgDeviceTrustPolicy(ctx, tt.orgID, deviceTrustPolicy)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assertObjectDetails(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveOrgDeviceTrustPolicy(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	orgAgg := &org.NewAggregate("org1").Aggregate
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		want       *domain.ObjectDetails
+		wantErr    error
+	}{
+		{
+			name: "not existing, not found error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			wantErr: zerrors.ThrowNotFound(nil, "ORG-Dtp4N", "Errors.Policy.DeviceTrust.NotExisting"),
+		},
+		{
+			name: "remove, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(org.NewDeviceTrustPolicySetEvent(ctx, orgAgg, 0)),
+				),
+				expectPush(
+					org.NewDeviceTrustPolicyRemovedEvent(ctx, orgAgg),
+				),
+			),
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.RemoveOrgDeviceTrustPolicy(ctx, "org1")
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assertObjectDetails(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_deviceTrustPolicy(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+
+	t.Run("instance default", func(t *testing.T) {
+		c := &Commands{
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(org.NewDeviceTrustPolicySetEvent(ctx, &org.NewAggregate("org1").Aggregate, time.Hour)),
+					eventFromEventPusher(org.NewDeviceTrustPolicyRemovedEvent(ctx, &org.NewAggregate("org1").Aggregate)),
+				),
+				expectFilter(
+					eventFromEventPusher(instance.NewDeviceTrustPolicySetEvent(ctx, &instance.NewAggregate("instance1").Aggregate, 720*time.Hour)),
+				),
+			)(t),
+		}
+		got, err := c.deviceTrustPolicy(ctx, "org1")
+		require.NoError(t, err)
+		assert.True(t, got.IsDefault)
+		assert.Equal(t, 720*time.Hour, got.Lifetime)
+	})
+	t.Run("org disabled", func(t *testing.T) {
+		c := &Commands{
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(org.NewDeviceTrustPolicySetEvent(ctx, &org.NewAggregate("org1").Aggregate, 0)),
+				),
+			)(t),
+		}
+		got, err := c.deviceTrustPolicy(ctx, "org1")
+		require.NoError(t, err)
+		assert.False(t, got.IsDefault)
+		assert.False(t, got.Enabled())
+	})
+}
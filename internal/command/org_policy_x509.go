@@ -0,0 +1,78 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgX509Policy sets the trusted certificate authorities and mapping rules for the certificate based authentication of the organization.
+// It replaces the policy of the instance for the users of the organization.
+func (c *Commands) SetOrgX509Policy(ctx context.Context, orgID string, x509Policy *domain.X509Policy) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-X5p3R", "Errors.ResourceOwnerMissing")
+	}
+	if err = x509Policy.Validate(); err != nil {
+		return nil, err
+	}
+	if err = c.checkOrgExists(ctx, orgID); err != nil {
+		return nil, err
+	}
+	writeModel := NewOrgX509PolicyWriteModel(orgID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.Equal(x509Policy) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "ORG-X5p3C", "Errors.NoChangesFound")
+	}
+	orgAgg := OrgAggregateFromWriteModel(&writeModel.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, writeModel, org.NewX509PolicySetEvent(ctx, orgAgg, x509Policy)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveOrgX509Policy removes the policy of the organization, so the policy of the instance applies again.
+func (c *Commands) RemoveOrgX509Policy(ctx context.Context, orgID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "ORG-X5p4R", "Errors.ResourceOwnerMissing")
+	}
+	writeModel := NewOrgX509PolicyWriteModel(orgID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.State != domain.PolicyStateActive {
+		return nil, zerrors.ThrowNotFound(nil, "ORG-X5p4N", "Errors.Policy.X509.NotExisting")
+	}
+	orgAgg := OrgAggregateFromWriteModel(&writeModel.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, writeModel, org.NewX509PolicyRemovedEvent(ctx, orgAgg)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// x509Policy returns the policy for the certificate based authentication of the users of the organization.
+// The policy of the instance is returned if the organization has none.
+func (c *Commands) x509Policy(ctx context.Context, orgID string) (*domain.X509Policy, error) {
+	orgWriteModel := NewOrgX509PolicyWriteModel(orgID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, orgWriteModel); err != nil {
+		return nil, err
+	}
+	if orgWriteModel.State == domain.PolicyStateActive {
+		return orgWriteModel.policy(false), nil
+	}
+	instanceWriteModel := NewInstanceX509PolicyWriteModel(ctx)
+	if err := c.eventstore.FilterToQueryReducer(ctx, instanceWriteModel); err != nil {
+		return nil, err
+	}
+	return instanceWriteModel.policy(true), nil
+}
@@ -0,0 +1,48 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+)
+
+type OrgX509PolicyWriteModel struct {
+	X509PolicyWriteModel
+}
+
+func NewOrgX509PolicyWriteModel(orgID string) *OrgX509PolicyWriteModel {
+	return &OrgX509PolicyWriteModel{
+		X509PolicyWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+		},
+	}
+}
+
+func (wm *OrgX509PolicyWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.X509PolicySetEvent:
+			wm.X509PolicyWriteModel.AppendEvents(&e.X509PolicySetEvent)
+		case *org.X509PolicyRemovedEvent:
+			wm.X509PolicyWriteModel.AppendEvents(&e.X509PolicyRemovedEvent)
+		}
+	}
+}
+
+func (wm *OrgX509PolicyWriteModel) Reduce() error {
+	return wm.X509PolicyWriteModel.Reduce()
+}
+
+func (wm *OrgX509PolicyWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.X509PolicyWriteModel.AggregateID).
+		EventTypes(
+			org.X509PolicySetEventType,
+			org.X509PolicyRemovedEventType).
+		Builder()
+}
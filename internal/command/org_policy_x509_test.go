@@ -0,0 +1,179 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_SetOrgX509Policy(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	ca := newTestX509CA(t)
+	orgAgg := &org.NewAggregate("org1").Aggregate
+	x509Policy := &domain.X509Policy{
+		TrustedCAs: ca.pem(),
+		MappingRules: []domain.X509MappingRule{
+			{Source: domain.X509MappingSourceSubjectCommonName, Target: domain.X509MappingTargetLoginName},
+		},
+		CRLURLs: []string{"http://crl.example.com/ca.crl"},
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		orgID      string
+		want       *domain.ObjectDetails
+		wantErr    error
+	}{
+		{
+			name:       "missing org, invalid argument error",
+			eventstore: expectEventstore(),
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "ORG-X5p3R", "Errors.ResourceOwnerMissing"),
+		},
+		{
+			name: "org not existing, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			orgID:   "org1",
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-QXPGs", "Errors.Org.NotFound"),
+		},
+		{
+			name: "unchanged, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(org.NewOrgAddedEvent(ctx, orgAgg, "org")),
+				),
+				expectFilter(
+					eventFromEventPusher(org.NewX509PolicySetEvent(ctx, orgAgg, x509Policy)),
+				),
+			),
+			orgID:   "org1",
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "ORG-X5p3C", "Errors.NoChangesFound"),
+		},
+		{
+			name: "set after removal, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(org.NewOrgAddedEvent(ctx, orgAgg, "org")),
+				),
+				expectFilter(
+					eventFromEventPusher(org.NewX509PolicySetEvent(ctx, orgAgg, x509Policy)),
+					eventFromEventPusher(org.NewX509PolicyRemovedEvent(ctx, orgAgg)),
+				),
+				expectPush(
+					org.NewX509PolicySetEvent(ctx, orgAgg, x509Policy),
+				),
+			),
+			orgID: "org1",
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.SetOrgX509Policy(ctx, tt.orgID, x509Policy)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assertObjectDetails(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveOrgX509Policy(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	ca := newTestX509CA(t)
+	orgAgg := &org.NewAggregate("org1").Aggregate
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		want       *domain.ObjectDetails
+		wantErr    error
+	}{
+		{
+			name: "not existing, not found error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			wantErr: zerrors.ThrowNotFound(nil, "ORG-X5p4N", "Errors.Policy.X509.NotExisting"),
+		},
+		{
+			name: "remove, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(org.NewX509PolicySetEvent(ctx, orgAgg, &domain.X509Policy{TrustedCAs: ca.pem()})),
+				),
+				expectPush(
+					org.NewX509PolicyRemovedEvent(ctx, orgAgg),
+				),
+			),
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.RemoveOrgX509Policy(ctx, "org1")
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assertObjectDetails(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_x509Policy(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	ca := newTestX509CA(t)
+	instancePolicy := &domain.X509Policy{TrustedCAs: ca.pem(), OCSPResponderURL: "http://ocsp.example.com"}
+	orgPolicy := &domain.X509Policy{TrustedCAs: ca.pem(), CRLURLs: []string{"http://crl.example.com"}}
+
+	t.Run("instance default", func(t *testing.T) {
+		c := &Commands{
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(org.NewX509PolicySetEvent(ctx, &org.NewAggregate("org1").Aggregate, orgPolicy)),
+					eventFromEventPusher(org.NewX509PolicyRemovedEvent(ctx, &org.NewAggregate("org1").Aggregate)),
+				),
+				expectFilter(
+					eventFromEventPusher(instance.NewX509PolicySetEvent(ctx, &instance.NewAggregate("instance1").Aggregate, instancePolicy)),
+				),
+			)(t),
+		}
+		got, err := c.x509Policy(ctx, "org1")
+		require.NoError(t, err)
+		assert.True(t, got.IsDefault)
+		assert.Equal(t, instancePolicy.OCSPResponderURL, got.OCSPResponderURL)
+		assert.Empty(t, got.CRLURLs)
+	})
+	t.Run("org", func(t *testing.T) {
+		c := &Commands{
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(org.NewX509PolicySetEvent(ctx, &org.NewAggregate("org1").Aggregate, orgPolicy)),
+				),
+			)(t),
+		}
+		got, err := c.x509Policy(ctx, "org1")
+		require.NoError(t, err)
+		assert.False(t, got.IsDefault)
+		assert.Empty(t, got.OCSPResponderURL)
+		assert.Equal(t, orgPolicy.CRLURLs, got.CRLURLs)
+	})
+}
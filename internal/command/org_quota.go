@@ -0,0 +1,94 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/quota"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SetOrgQuota creates a new quota for the organization or updates an existing one.
+// In contrast to the instance quotas, it doesn't apply to the other organizations of the instance.
+func (c *Commands) SetOrgQuota(
+	ctx context.Context,
+	orgID string,
+	q *SetQuota,
+) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Fq2Kd", "Errors.Org.Empty")
+	}
+	if err := c.checkOrgExists(ctx, orgID); err != nil {
+		return nil, err
+	}
+	instanceId := authz.GetInstance(ctx).InstanceID()
+	wm, err := c.getQuotaWriteModel(ctx, instanceId, orgID, q.Unit.Enum())
+	if err != nil {
+		return nil, err
+	}
+	aggregateId := wm.AggregateID
+	createNewQuota := aggregateId == ""
+	if aggregateId == "" {
+		aggregateId, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
+	}
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, c.SetQuotaCommand(quota.NewOrgAggregate(aggregateId, instanceId, orgID), wm, createNewQuota, q))
+	if err != nil {
+		return nil, err
+	}
+	if len(cmds) > 0 {
+		events, err := c.eventstore.Push(ctx, cmds...)
+		if err != nil {
+			return nil, err
+		}
+		err = AppendAndReduce(wm, events...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+func (c *Commands) RemoveOrgQuota(ctx context.Context, orgID string, unit QuotaUnit) (*domain.ObjectDetails, error) {
+	if orgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Rk8vN", "Errors.Org.Empty")
+	}
+	instanceId := authz.GetInstance(ctx).InstanceID()
+	wm, err := c.getQuotaWriteModel(ctx, instanceId, orgID, unit.Enum())
+	if err != nil {
+		return nil, err
+	}
+	if wm.AggregateID == "" {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ud3Wm", "Errors.Quota.NotFound")
+	}
+	aggregate := quota.NewOrgAggregate(wm.AggregateID, instanceId, orgID)
+	events := []eventstore.Command{quota.NewRemovedEvent(ctx, &aggregate.Aggregate, unit.Enum())}
+	pushedEvents, err := c.eventstore.Push(ctx, events...)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(wm, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// OrgQuotaCheck returns true if the organization has a limiting quota for the unit which is exhausted.
+type OrgQuotaCheck func(ctx context.Context, instanceID, orgID string, unit quota.Unit) bool
+
+// checkOrgQuota returns an error if the organization has a limiting quota for the unit which is exhausted.
+func (c *Commands) checkOrgQuota(ctx context.Context, orgID string, unit quota.Unit) error {
+	if c.orgQuotaCheck == nil || orgID == "" {
+		return nil
+	}
+	if c.orgQuotaCheck(ctx, authz.GetInstance(ctx).InstanceID(), orgID, unit) {
+		return zerrors.ThrowResourceExhausted(nil, "COMMAND-Qo7xK", "Errors.Quota.Org.Exhausted")
+	}
+	return nil
+}
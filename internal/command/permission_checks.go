@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"slices"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -16,7 +17,20 @@ import (
 
 type PermissionCheck func(resourceOwner, aggregateID string) error
 
-type UserGrantPermissionCheck func(projectID, projectGrantID string) PermissionCheck
+// UserGrantPermissionCheck checks the permission on the project or project grant of a user grant.
+// The role keys of the grant allow administrators of single project roles to manage grants with these roles only.
+type UserGrantPermissionCheck func(projectID, projectGrantID string, roleKeys ...string) PermissionCheck
+
+// withRoleKeys adds the role keys which are affected in addition to the requested ones,
+// e.g. the roles revoked by a change of the grant.
+func (check UserGrantPermissionCheck) withRoleKeys(additional ...string) UserGrantPermissionCheck {
+	if check == nil {
+		return nil
+	}
+	return func(projectID, projectGrantID string, roleKeys ...string) PermissionCheck {
+		return check(projectID, projectGrantID, append(slices.Clone(roleKeys), additional...)...)
+	}
+}
 
 func (c *Commands) newPermissionCheck(ctx context.Context, permission string, aggregateType eventstore.AggregateType) PermissionCheck {
 	return func(resourceOwner, aggregateID string) error {
@@ -102,12 +116,25 @@ func (c *Commands) checkPermissionDeleteProjectGrant(ctx context.Context, resour
 	return nil
 }
 
-func (c *Commands) checkPermissionUpdateApplication(ctx context.Context, resourceOwner, appID string) error {
-	return c.newPermissionCheck(ctx, domain.PermissionProjectAppWrite, project.AggregateType)(resourceOwner, appID)
+// checkPermissionOnApplication allows the administrators of the project
+// and the administrators of the application, if the appID is provided.
+func (c *Commands) checkPermissionOnApplication(ctx context.Context, permission, resourceOwner, projectID, appID string) error {
+	err := c.newPermissionCheck(ctx, permission, project.AggregateType)(resourceOwner, projectID)
+	if err == nil || appID == "" || resourceOwner == "" {
+		return err
+	}
+	if appErr := c.checkPermission(ctx, permission, resourceOwner, appID); appErr != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *Commands) checkPermissionUpdateApplication(ctx context.Context, resourceOwner, projectID, appID string) error {
+	return c.checkPermissionOnApplication(ctx, domain.PermissionProjectAppWrite, resourceOwner, projectID, appID)
 }
 
-func (c *Commands) checkPermissionDeleteApp(ctx context.Context, resourceOwner, appID string) error {
-	return c.newPermissionCheck(ctx, domain.PermissionProjectAppDelete, project.AggregateType)(resourceOwner, appID)
+func (c *Commands) checkPermissionDeleteApp(ctx context.Context, resourceOwner, projectID, appID string) error {
+	return c.checkPermissionOnApplication(ctx, domain.PermissionProjectAppDelete, resourceOwner, projectID, appID)
 }
 
 func (c *Commands) checkPermissionUpdateInstanceMember(ctx context.Context, instanceID string) error {
@@ -118,6 +145,10 @@ func (c *Commands) checkPermissionDeleteInstanceMember(ctx context.Context, inst
 	return c.newPermissionCheck(ctx, domain.PermissionInstanceMemberDelete, instance.AggregateType)(instanceID, instanceID)
 }
 
+func (c *Commands) checkPermissionUpdateInstanceCustomRole(ctx context.Context, instanceID string) error {
+	return c.newPermissionCheck(ctx, domain.PermissionInstanceWrite, instance.AggregateType)(instanceID, instanceID)
+}
+
 func (c *Commands) checkPermissionUpdateOrgMember(ctx context.Context, instanceID, orgID string) error {
 	return c.newPermissionCheck(ctx, domain.PermissionOrgMemberWrite, org.AggregateType)(instanceID, orgID)
 }
@@ -143,12 +174,22 @@ func (c *Commands) checkPermissionDeleteProjectGrantMember(ctx context.Context,
 
 func (c *Commands) newUserGrantPermissionCheck(ctx context.Context, permission string) UserGrantPermissionCheck {
 	check := c.newPermissionCheck(ctx, permission, project.AggregateType)
-	return func(projectID, projectGrantID string) PermissionCheck {
+	return func(projectID, projectGrantID string, roleKeys ...string) PermissionCheck {
 		return func(resourceOwner, _ string) error {
 			if projectGrantID != "" {
 				return check(resourceOwner, projectGrantID)
 			}
-			return check(resourceOwner, projectID)
+			err := check(resourceOwner, projectID)
+			if err == nil || len(roleKeys) == 0 || resourceOwner == "" {
+				return err
+			}
+			// administrators of project roles must be permitted on every role of the grant
+			for _, roleKey := range roleKeys {
+				if roleErr := c.checkPermission(ctx, permission, resourceOwner, domain.ProjectRoleContextID(projectID, roleKey)); roleErr != nil {
+					return err
+				}
+			}
+			return nil
 		}
 	}
 }
@@ -320,6 +320,129 @@ func TestCommands_CheckPermissionUserDelete(t *testing.T) {
 	}
 }
 
+func TestCommands_CheckPermissionOnApplication(t *testing.T) {
+	ctx := context.Background()
+	errDenied := zerrors.ThrowPermissionDenied(nil, "id", "permission denied")
+	type args struct {
+		resourceOwner, projectID, appID string
+	}
+	tests := []struct {
+		name                  string
+		domainPermissionCheck func(*testing.T) domain.PermissionCheck
+		args                  args
+		wantErr               error
+	}{
+		{
+			name: "project permission",
+			domainPermissionCheck: mockDomainPermissionChecks(
+				expectedCheck{ctx, "project.app.write", "org1", "project1", nil},
+			),
+			args: args{"org1", "project1", "app1"},
+		},
+		{
+			name: "application permission",
+			domainPermissionCheck: mockDomainPermissionChecks(
+				expectedCheck{ctx, "project.app.write", "org1", "project1", errDenied},
+				expectedCheck{ctx, "project.app.write", "org1", "app1", nil},
+			),
+			args: args{"org1", "project1", "app1"},
+		},
+		{
+			name: "no application, denied",
+			domainPermissionCheck: mockDomainPermissionChecks(
+				expectedCheck{ctx, "project.app.write", "org1", "project1", errDenied},
+			),
+			args:    args{"org1", "project1", ""},
+			wantErr: errDenied,
+		},
+		{
+			name: "no application permission, denied",
+			domainPermissionCheck: mockDomainPermissionChecks(
+				expectedCheck{ctx, "project.app.write", "org1", "project1", errDenied},
+				expectedCheck{ctx, "project.app.write", "org1", "app1", zerrors.ThrowPermissionDenied(nil, "id2", "permission denied")},
+			),
+			args:    args{"org1", "project1", "app1"},
+			wantErr: errDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				checkPermission: tt.domainPermissionCheck(t),
+			}
+			err := c.checkPermissionUpdateApplication(ctx, tt.args.resourceOwner, tt.args.projectID, tt.args.appID)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_NewUserGrantPermissionCheck(t *testing.T) {
+	ctx := context.Background()
+	errDenied := zerrors.ThrowPermissionDenied(nil, "id", "permission denied")
+	type args struct {
+		projectID, projectGrantID string
+		roleKeys                  []string
+	}
+	tests := []struct {
+		name                  string
+		domainPermissionCheck func(*testing.T) domain.PermissionCheck
+		args                  args
+		wantErr               error
+	}{
+		{
+			name: "project grant permission",
+			domainPermissionCheck: mockDomainPermissionChecks(
+				expectedCheck{ctx, "user.grant.write", "org1", "grant1", nil},
+			),
+			args: args{"project1", "grant1", []string{"role1"}},
+		},
+		{
+			name: "project permission",
+			domainPermissionCheck: mockDomainPermissionChecks(
+				expectedCheck{ctx, "user.grant.write", "org1", "project1", nil},
+			),
+			args: args{"project1", "", []string{"role1"}},
+		},
+		{
+			name: "project role permissions",
+			domainPermissionCheck: mockDomainPermissionChecks(
+				expectedCheck{ctx, "user.grant.write", "org1", "project1", errDenied},
+				expectedCheck{ctx, "user.grant.write", "org1", "project1/role1", nil},
+				expectedCheck{ctx, "user.grant.write", "org1", "project1/role2", nil},
+			),
+			args: args{"project1", "", []string{"role1", "role2"}},
+		},
+		{
+			name: "missing project role permission, denied",
+			domainPermissionCheck: mockDomainPermissionChecks(
+				expectedCheck{ctx, "user.grant.write", "org1", "project1", errDenied},
+				expectedCheck{ctx, "user.grant.write", "org1", "project1/role1", nil},
+				expectedCheck{ctx, "user.grant.write", "org1", "project1/role2", zerrors.ThrowPermissionDenied(nil, "id2", "permission denied")},
+			),
+			args:    args{"project1", "", []string{"role1", "role2"}},
+			wantErr: errDenied,
+		},
+		{
+			name: "no roles, denied",
+			domainPermissionCheck: mockDomainPermissionChecks(
+				expectedCheck{ctx, "user.grant.write", "org1", "project1", errDenied},
+			),
+			args:    args{"project1", "", nil},
+			wantErr: errDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				checkPermission: tt.domainPermissionCheck(t),
+			}
+			check := c.newUserGrantPermissionCheck(ctx, "user.grant.write")
+			err := check(tt.args.projectID, tt.args.projectGrantID, tt.args.roleKeys...)("org1", "")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
 func mockDomainPermissionCheck(expectCtx context.Context, expectPermission, expectResourceOwner, expectResourceID string) func(t *testing.T) domain.PermissionCheck {
 	return func(t *testing.T) domain.PermissionCheck {
 		return func(ctx context.Context, permission, orgID, resourceID string) (err error) {
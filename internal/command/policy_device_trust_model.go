@@ -0,0 +1,44 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+type DeviceTrustPolicyWriteModel struct {
+	eventstore.WriteModel
+
+	Lifetime time.Duration
+	State    domain.PolicyState
+}
+
+func (wm *DeviceTrustPolicyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *policy.DeviceTrustPolicySetEvent:
+			wm.Lifetime = e.Lifetime
+			wm.State = domain.PolicyStateActive
+		case *policy.DeviceTrustPolicyRemovedEvent:
+			wm.Lifetime = 0
+			wm.State = domain.PolicyStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+// Equal returns true if the policy would not change the current state.
+func (wm *DeviceTrustPolicyWriteModel) Equal(deviceTrustPolicy *domain.DeviceTrustPolicy) bool {
+	return wm.State == domain.PolicyStateActive &&
+		wm.Lifetime == deviceTrustPolicy.Lifetime
+}
+
+func (wm *DeviceTrustPolicyWriteModel) policy(isDefault bool) *domain.DeviceTrustPolicy {
+	return &domain.DeviceTrustPolicy{
+		ObjectRoot: writeModelToObjectRoot(wm.WriteModel),
+		IsDefault:  isDefault,
+		Lifetime:   wm.Lifetime,
+	}
+}
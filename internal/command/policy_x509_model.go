@@ -0,0 +1,60 @@
+package command
+
+import (
+	"bytes"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+type X509PolicyWriteModel struct {
+	eventstore.WriteModel
+
+	TrustedCAs       []byte
+	MappingRules     []domain.X509MappingRule
+	OCSPResponderURL string
+	CRLURLs          []string
+	State            domain.PolicyState
+}
+
+func (wm *X509PolicyWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *policy.X509PolicySetEvent:
+			wm.TrustedCAs = e.TrustedCAs
+			wm.MappingRules = e.DomainMappingRules()
+			wm.OCSPResponderURL = e.OCSPResponderURL
+			wm.CRLURLs = e.CRLURLs
+			wm.State = domain.PolicyStateActive
+		case *policy.X509PolicyRemovedEvent:
+			wm.TrustedCAs = nil
+			wm.MappingRules = nil
+			wm.OCSPResponderURL = ""
+			wm.CRLURLs = nil
+			wm.State = domain.PolicyStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+// Equal returns true if the policy would not change the current state.
+func (wm *X509PolicyWriteModel) Equal(x509Policy *domain.X509Policy) bool {
+	return wm.State == domain.PolicyStateActive &&
+		bytes.Equal(wm.TrustedCAs, x509Policy.TrustedCAs) &&
+		slices.Equal(wm.MappingRules, x509Policy.MappingRules) &&
+		wm.OCSPResponderURL == x509Policy.OCSPResponderURL &&
+		slices.Equal(wm.CRLURLs, x509Policy.CRLURLs)
+}
+
+func (wm *X509PolicyWriteModel) policy(isDefault bool) *domain.X509Policy {
+	return &domain.X509Policy{
+		ObjectRoot:       writeModelToObjectRoot(wm.WriteModel),
+		IsDefault:        isDefault,
+		TrustedCAs:       wm.TrustedCAs,
+		MappingRules:     wm.MappingRules,
+		OCSPResponderURL: wm.OCSPResponderURL,
+		CRLURLs:          wm.CRLURLs,
+	}
+}
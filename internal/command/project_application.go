@@ -33,7 +33,7 @@ func (c *Commands) UpdateApplicationName(ctx context.Context, projectID string,
 	if err := c.eventstore.FilterToQueryReducer(ctx, existingApp); err != nil {
 		return nil, err
 	}
-	if err := c.checkPermissionUpdateApplication(ctx, existingApp.ResourceOwner, existingApp.AggregateID); err != nil {
+	if err := c.checkPermissionUpdateApplication(ctx, existingApp.ResourceOwner, existingApp.AggregateID, existingApp.AppID); err != nil {
 		return nil, err
 	}
 
@@ -69,7 +69,7 @@ func (c *Commands) DeactivateApplication(ctx context.Context, projectID, appID,
 	if err := c.eventstore.FilterToQueryReducer(ctx, existingApp); err != nil {
 		return nil, err
 	}
-	if err := c.checkPermissionUpdateApplication(ctx, existingApp.ResourceOwner, existingApp.AggregateID); err != nil {
+	if err := c.checkPermissionUpdateApplication(ctx, existingApp.ResourceOwner, existingApp.AggregateID, existingApp.AppID); err != nil {
 		return nil, err
 	}
 
@@ -101,7 +101,7 @@ func (c *Commands) ReactivateApplication(ctx context.Context, projectID, appID,
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-1n8cM", "Errors.Project.App.NotInactive")
 	}
 
-	if err := c.checkPermissionUpdateApplication(ctx, existingApp.ResourceOwner, existingApp.AggregateID); err != nil {
+	if err := c.checkPermissionUpdateApplication(ctx, existingApp.ResourceOwner, existingApp.AggregateID, existingApp.AppID); err != nil {
 		return nil, err
 	}
 
@@ -133,7 +133,7 @@ func (c *Commands) RemoveApplication(ctx context.Context, projectID, appID, reso
 	if err := c.eventstore.FilterToQueryReducer(ctx, existingApp); err != nil {
 		return nil, err
 	}
-	if err := c.checkPermissionDeleteApp(ctx, existingApp.ResourceOwner, existingApp.AggregateID); err != nil {
+	if err := c.checkPermissionDeleteApp(ctx, existingApp.ResourceOwner, existingApp.AggregateID, existingApp.AppID); err != nil {
 		return nil, err
 	}
 
@@ -64,7 +64,7 @@ func (c *Commands) addApplicationKey(ctx context.Context, key *domain.Applicatio
 		return nil, err
 	}
 
-	if err := c.checkPermissionUpdateApplication(ctx, keyWriteModel.ResourceOwner, keyWriteModel.AggregateID); err != nil {
+	if err := c.checkPermissionUpdateApplication(ctx, keyWriteModel.ResourceOwner, keyWriteModel.AggregateID, keyWriteModel.AppID); err != nil {
 		return nil, err
 	}
 
@@ -119,7 +119,7 @@ func (c *Commands) RemoveApplicationKey(ctx context.Context, projectID, applicat
 		return nil, zerrors.ThrowNotFound(nil, "COMMAND-4m77G", "Errors.Project.App.Key.NotFound")
 	}
 
-	if err := c.checkPermissionUpdateApplication(ctx, keyWriteModel.ResourceOwner, keyWriteModel.AggregateID); err != nil {
+	if err := c.checkPermissionUpdateApplication(ctx, keyWriteModel.ResourceOwner, keyWriteModel.AggregateID, keyWriteModel.AppID); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,164 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ApplicationMember is an administrator of a single application of a project.
+// Its roles (e.g. PROJECT_APPLICATION_OWNER) only grant permissions on the application.
+type ApplicationMember struct {
+	ResourceOwner string
+	ProjectID     string
+	AppID         string
+	UserID        string
+	Roles         []string
+	// ValidUntil limits the membership in time, it is removed automatically after expiry.
+	// On changes, the current validity of the membership is kept if not set.
+	ValidUntil *time.Time
+	// ClearValidUntil makes a time-bound membership permanent on changes.
+	ClearValidUntil bool
+}
+
+func (i *ApplicationMember) IsValid(zitadelRoles []authz.RoleMapping) error {
+	if i.ProjectID == "" || i.AppID == "" || i.UserID == "" || len(i.Roles) == 0 {
+		return zerrors.ThrowInvalidArgument(nil, "PROJECT-Am1vI", "Errors.Project.App.Member.Invalid")
+	}
+	if len(domain.CheckForInvalidRoles(i.Roles, domain.ApplicationRolePrefix, zitadelRoles)) > 0 {
+		return zerrors.ThrowInvalidArgument(nil, "PROJECT-Am2rI", "Errors.Project.App.Member.Invalid")
+	}
+	if i.ValidUntil != nil && (i.ClearValidUntil || !i.ValidUntil.After(time.Now())) {
+		return zerrors.ThrowInvalidArgument(nil, "PROJECT-Am7vU", "Errors.Member.ValidityInvalid")
+	}
+	return nil
+}
+
+func (c *Commands) AddApplicationMember(ctx context.Context, member *ApplicationMember) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := member.IsValid(c.zitadelRoles); err != nil {
+		return nil, err
+	}
+	if _, err = c.checkUserExists(ctx, member.UserID, ""); err != nil {
+		return nil, err
+	}
+	addedMember, err := c.applicationMemberWriteModelByID(ctx, member.ProjectID, member.AppID, member.UserID, member.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !addedMember.AppState.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "PROJECT-Am3nF", "Errors.Project.App.NotExisting")
+	}
+	if err := c.checkPermissionUpdateProjectMember(ctx, addedMember.ResourceOwner, addedMember.AggregateID); err != nil {
+		return nil, err
+	}
+	if addedMember.State.Exists() {
+		return nil, zerrors.ThrowAlreadyExists(nil, "PROJECT-Am4aE", "Errors.Project.App.Member.AlreadyExists")
+	}
+	added := project.NewApplicationMemberAddedEvent(ctx,
+		ProjectAggregateFromWriteModelWithCTX(ctx, &addedMember.WriteModel),
+		member.AppID,
+		member.UserID,
+		member.Roles...,
+	)
+	added.ValidUntil = member.ValidUntil
+	pushedEvents, err := c.eventstore.Push(ctx, added)
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(addedMember, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&addedMember.WriteModel), nil
+}
+
+// ChangeApplicationMember replaces the roles of an existing application member.
+func (c *Commands) ChangeApplicationMember(ctx context.Context, member *ApplicationMember) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := member.IsValid(c.zitadelRoles); err != nil {
+		return nil, err
+	}
+	existingMember, err := c.applicationMemberWriteModelByID(ctx, member.ProjectID, member.AppID, member.UserID, member.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingMember.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "PROJECT-Am5nF", "Errors.Project.App.Member.NotFound")
+	}
+	if err := c.checkPermissionUpdateProjectMember(ctx, existingMember.ResourceOwner, existingMember.AggregateID); err != nil {
+		return nil, err
+	}
+	validUntil := changedMemberValidity(existingMember.ValidUntil, member.ValidUntil, member.ClearValidUntil)
+	if slices.Compare(existingMember.Roles, member.Roles) == 0 && equalValidity(existingMember.ValidUntil, validUntil) {
+		return writeModelToObjectDetails(&existingMember.WriteModel), nil
+	}
+	changed := project.NewApplicationMemberChangedEvent(ctx,
+		ProjectAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel),
+		member.AppID,
+		member.UserID,
+		member.Roles...,
+	)
+	changed.ValidUntil = validUntil
+	pushedEvents, err := c.eventstore.Push(ctx, changed)
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(existingMember, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingMember.WriteModel), nil
+}
+
+func (c *Commands) RemoveApplicationMember(ctx context.Context, projectID, appID, userID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || appID == "" || userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-Am6vI", "Errors.Project.App.Member.Invalid")
+	}
+	existingMember, err := c.applicationMemberWriteModelByID(ctx, projectID, appID, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingMember.State.Exists() {
+		return writeModelToObjectDetails(&existingMember.WriteModel), nil
+	}
+	if err := c.checkPermissionDeleteProjectMember(ctx, existingMember.ResourceOwner, existingMember.AggregateID); err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx,
+		project.NewApplicationMemberRemovedEvent(ctx,
+			ProjectAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel),
+			appID,
+			userID,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(existingMember, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingMember.WriteModel), nil
+}
+
+func (c *Commands) applicationMemberWriteModelByID(ctx context.Context, projectID, appID, userID, resourceOwner string) (_ *ApplicationMemberWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewApplicationMemberWriteModel(projectID, appID, userID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+// ApplicationMemberWriteModel is the membership of a user on a single application of a project.
+// The membership ends with the removal of the application or the project.
+type ApplicationMemberWriteModel struct {
+	eventstore.WriteModel
+
+	AppID      string
+	UserID     string
+	Roles      []string
+	ValidUntil *time.Time
+	AppState   domain.AppState
+	State      domain.MemberState
+}
+
+func NewApplicationMemberWriteModel(projectID, appID, userID, resourceOwner string) *ApplicationMemberWriteModel {
+	return &ApplicationMemberWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+		AppID:  appID,
+		UserID: userID,
+	}
+}
+
+func (wm *ApplicationMemberWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *project.ApplicationAddedEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+		case *project.ApplicationRemovedEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+		case *project.ApplicationMemberAddedEvent:
+			if e.AppID != wm.AppID || e.UserID != wm.UserID {
+				continue
+			}
+		case *project.ApplicationMemberChangedEvent:
+			if e.AppID != wm.AppID || e.UserID != wm.UserID {
+				continue
+			}
+		case *project.ApplicationMemberRemovedEvent:
+			if e.AppID != wm.AppID || e.UserID != wm.UserID {
+				continue
+			}
+		}
+		wm.WriteModel.AppendEvents(event)
+	}
+}
+
+func (wm *ApplicationMemberWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.ApplicationAddedEvent:
+			wm.AppState = domain.AppStateActive
+		case *project.ApplicationRemovedEvent, *project.ProjectRemovedEvent:
+			wm.AppState = domain.AppStateRemoved
+			wm.Roles = nil
+			wm.State = domain.MemberStateRemoved
+		case *project.ApplicationMemberAddedEvent:
+			wm.Roles = e.Roles
+			wm.ValidUntil = e.ValidUntil
+			wm.State = domain.MemberStateActive
+		case *project.ApplicationMemberChangedEvent:
+			wm.Roles = e.Roles
+			wm.ValidUntil = e.ValidUntil
+		case *project.ApplicationMemberRemovedEvent:
+			wm.Roles = nil
+			wm.State = domain.MemberStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ApplicationMemberWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.ApplicationAddedType,
+			project.ApplicationRemovedType,
+			project.ProjectRemovedType,
+			project.ApplicationMemberAddedType,
+			project.ApplicationMemberChangedType,
+			project.ApplicationMemberRemovedType,
+		).
+		Builder()
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/quota"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
@@ -29,7 +30,10 @@ func (c *Commands) AddSAMLApplication(ctx context.Context, application *domain.S
 	if err := c.eventstore.FilterToQueryReducer(ctx, addedApplication); err != nil {
 		return nil, err
 	}
-	if err := c.checkPermissionUpdateApplication(ctx, addedApplication.ResourceOwner, addedApplication.AggregateID); err != nil {
+	if err := c.checkPermissionUpdateApplication(ctx, addedApplication.ResourceOwner, addedApplication.AggregateID, ""); err != nil {
+		return nil, err
+	}
+	if err := c.checkOrgQuota(ctx, addedApplication.ResourceOwner, quota.ApplicationsAll); err != nil {
 		return nil, err
 	}
 
@@ -110,7 +114,7 @@ func (c *Commands) UpdateSAMLApplication(ctx context.Context, samlApp *domain.SA
 		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-GBr35", "Errors.Project.App.IsNotSAML")
 	}
 
-	if err := c.checkPermissionUpdateApplication(ctx, existingSAML.ResourceOwner, existingSAML.AggregateID); err != nil {
+	if err := c.checkPermissionUpdateApplication(ctx, existingSAML.ResourceOwner, existingSAML.AggregateID, existingSAML.AppID); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,221 @@
+package command
+
+import (
+	"context"
+	"net/url"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/scimprovisioning"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SCIMProvisioningConnector configures the outbound SCIM provisioning into a downstream application.
+// The Token and ClientSecret can be omitted on updates, the previous ones are kept
+// as long as the AuthType does not change.
+type SCIMProvisioningConnector struct {
+	ProjectID     string
+	AppID         string
+	ResourceOwner string
+
+	Endpoint        string
+	AuthType        domain.SCIMProvisioningAuthType
+	Token           string
+	ClientID        string
+	ClientSecret    string
+	TokenEndpoint   string
+	Scopes          []string
+	ProvisionGroups bool
+}
+
+func (c *SCIMProvisioningConnector) IsValid() error {
+	if c.ProjectID == "" || c.AppID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sp3vI", "Errors.IDMissing")
+	}
+	if !isValidHTTPURL(c.Endpoint) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sp3vE", "Errors.Project.App.SCIMProvisioning.InvalidEndpoint")
+	}
+	switch c.AuthType { //nolint:exhaustive
+	case domain.SCIMProvisioningAuthTypeBearer:
+		return nil
+	case domain.SCIMProvisioningAuthTypeClientCredentials:
+		if c.ClientID == "" || !isValidHTTPURL(c.TokenEndpoint) {
+			return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sp3vC", "Errors.Project.App.SCIMProvisioning.InvalidAuth")
+		}
+		return nil
+	default:
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sp3vA", "Errors.Project.App.SCIMProvisioning.InvalidAuth")
+	}
+}
+
+func isValidHTTPURL(rawURL string) bool {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	return (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+// SetApplicationSCIMProvisioning creates or replaces the outbound SCIM connector of an application.
+func (c *Commands) SetApplicationSCIMProvisioning(ctx context.Context, connector *SCIMProvisioningConnector) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := connector.IsValid(); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.getApplicationSCIMProvisioningWriteModel(ctx, connector.ProjectID, connector.AppID, connector.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.AppState.Exists() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Sp3vN", "Errors.Project.App.NotFound")
+	}
+	if err := c.checkPermissionUpdateApplication(ctx, writeModel.ResourceOwner, writeModel.AggregateID, writeModel.AppID); err != nil {
+		return nil, err
+	}
+
+	token, clientSecret, err := c.scimProvisioningSecrets(writeModel, connector)
+	if err != nil {
+		return nil, err
+	}
+	if writeModel.State.Exists() && !writeModel.hasChanged(connector, token, clientSecret) {
+		return writeModelToObjectDetails(&writeModel.WriteModel), nil
+	}
+
+	err = c.pushAppendAndReduce(ctx, writeModel, project.NewApplicationSCIMProvisioningSetEvent(
+		ctx,
+		ProjectAggregateFromWriteModelWithCTX(ctx, &writeModel.WriteModel),
+		connector.AppID,
+		connector.Endpoint,
+		connector.AuthType,
+		token,
+		connector.ClientID,
+		clientSecret,
+		connector.TokenEndpoint,
+		connector.Scopes,
+		connector.ProvisionGroups,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// scimProvisioningSecrets encrypts the provided token or client secret
+// or falls back to the existing one if the auth type did not change.
+func (c *Commands) scimProvisioningSecrets(writeModel *ApplicationSCIMProvisioningWriteModel, connector *SCIMProvisioningConnector) (token, clientSecret *crypto.CryptoValue, err error) {
+	sameAuthType := writeModel.State.Exists() && writeModel.AuthType == connector.AuthType
+	switch connector.AuthType { //nolint:exhaustive
+	case domain.SCIMProvisioningAuthTypeBearer:
+		if connector.Token == "" {
+			if !sameAuthType || writeModel.Token == nil {
+				return nil, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sp3vT", "Errors.Project.App.SCIMProvisioning.InvalidAuth")
+			}
+			return writeModel.Token, nil, nil
+		}
+		token, err = crypto.Encrypt([]byte(connector.Token), c.targetEncryption)
+		return token, nil, err
+	case domain.SCIMProvisioningAuthTypeClientCredentials:
+		if connector.ClientSecret == "" {
+			if !sameAuthType || writeModel.ClientSecret == nil {
+				return nil, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sp3vS", "Errors.Project.App.SCIMProvisioning.InvalidAuth")
+			}
+			return nil, writeModel.ClientSecret, nil
+		}
+		clientSecret, err = crypto.Encrypt([]byte(connector.ClientSecret), c.targetEncryption)
+		return nil, clientSecret, err
+	}
+	return nil, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sp3vU", "Errors.Project.App.SCIMProvisioning.InvalidAuth")
+}
+
+func (wm *ApplicationSCIMProvisioningWriteModel) hasChanged(connector *SCIMProvisioningConnector, token, clientSecret *crypto.CryptoValue) bool {
+	return wm.Endpoint != connector.Endpoint ||
+		wm.AuthType != connector.AuthType ||
+		wm.Token != token ||
+		wm.ClientID != connector.ClientID ||
+		wm.ClientSecret != clientSecret ||
+		wm.TokenEndpoint != connector.TokenEndpoint ||
+		!slices.Equal(wm.Scopes, connector.Scopes) ||
+		wm.ProvisionGroups != connector.ProvisionGroups
+}
+
+// RemoveApplicationSCIMProvisioning stops the provisioning into the downstream application.
+func (c *Commands) RemoveApplicationSCIMProvisioning(ctx context.Context, projectID, appID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || appID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sp3rI", "Errors.IDMissing")
+	}
+	writeModel, err := c.getApplicationSCIMProvisioningWriteModel(ctx, projectID, appID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Sp3rN", "Errors.Project.App.SCIMProvisioning.NotFound")
+	}
+	if err := c.checkPermissionUpdateApplication(ctx, writeModel.ResourceOwner, writeModel.AggregateID, writeModel.AppID); err != nil {
+		return nil, err
+	}
+
+	err = c.pushAppendAndReduce(ctx, writeModel, project.NewApplicationSCIMProvisioningRemovedEvent(
+		ctx,
+		ProjectAggregateFromWriteModelWithCTX(ctx, &writeModel.WriteModel),
+		appID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) getApplicationSCIMProvisioningWriteModel(ctx context.Context, projectID, appID, resourceOwner string) (_ *ApplicationSCIMProvisioningWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewApplicationSCIMProvisioningWriteModel(projectID, appID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// SCIMResourceProvisioned records the id of a user or group in the downstream application.
+// It is called by the provisioning worker and therefore doesn't check any permission.
+func (c *Commands) SCIMResourceProvisioned(ctx context.Context, appID, resourceOwner string, resourceType domain.SCIMProvisioningResourceType, resourceID, remoteID string) error {
+	return c.pushSCIMProvisioningEvent(ctx, func(aggregate *eventstore.Aggregate) eventstore.Command {
+		return scimprovisioning.NewResourceProvisionedEvent(ctx, aggregate, resourceType, resourceID, remoteID)
+	}, appID, resourceOwner)
+}
+
+// SCIMResourceDeprovisioned removes the mapping of a user or group deleted in the downstream application.
+// It is called by the provisioning worker and therefore doesn't check any permission.
+func (c *Commands) SCIMResourceDeprovisioned(ctx context.Context, appID, resourceOwner string, resourceType domain.SCIMProvisioningResourceType, resourceID string) error {
+	return c.pushSCIMProvisioningEvent(ctx, func(aggregate *eventstore.Aggregate) eventstore.Command {
+		return scimprovisioning.NewResourceDeprovisionedEvent(ctx, aggregate, resourceType, resourceID)
+	}, appID, resourceOwner)
+}
+
+// SCIMResourceProvisioningFailed records the error of a failed provisioning attempt, so it's visible on the application.
+// It is called by the provisioning worker and therefore doesn't check any permission.
+func (c *Commands) SCIMResourceProvisioningFailed(ctx context.Context, appID, resourceOwner string, resourceType domain.SCIMProvisioningResourceType, resourceID, errorMessage string) error {
+	return c.pushSCIMProvisioningEvent(ctx, func(aggregate *eventstore.Aggregate) eventstore.Command {
+		return scimprovisioning.NewResourceFailedEvent(ctx, aggregate, resourceType, resourceID, errorMessage)
+	}, appID, resourceOwner)
+}
+
+func (c *Commands) pushSCIMProvisioningEvent(ctx context.Context, event func(aggregate *eventstore.Aggregate) eventstore.Command, appID, resourceOwner string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if appID == "" || resourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sp3eI", "Errors.IDMissing")
+	}
+	_, err = c.eventstore.Push(ctx, event(&scimprovisioning.NewAggregate(appID, resourceOwner).Aggregate))
+	return err
+}
@@ -0,0 +1,113 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+type ApplicationSCIMProvisioningWriteModel struct {
+	eventstore.WriteModel
+
+	AppID    string
+	AppState domain.AppState
+
+	State           domain.SCIMProvisioningConnectorState
+	Endpoint        string
+	AuthType        domain.SCIMProvisioningAuthType
+	Token           *crypto.CryptoValue
+	ClientID        string
+	ClientSecret    *crypto.CryptoValue
+	TokenEndpoint   string
+	Scopes          []string
+	ProvisionGroups bool
+}
+
+func NewApplicationSCIMProvisioningWriteModel(projectID, appID, resourceOwner string) *ApplicationSCIMProvisioningWriteModel {
+	return &ApplicationSCIMProvisioningWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+		AppID: appID,
+	}
+}
+
+func (wm *ApplicationSCIMProvisioningWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *project.ApplicationAddedEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ApplicationRemovedEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ApplicationSCIMProvisioningSetEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ApplicationSCIMProvisioningRemovedEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ProjectRemovedEvent:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *ApplicationSCIMProvisioningWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.ApplicationAddedEvent:
+			wm.AppState = domain.AppStateActive
+		case *project.ApplicationRemovedEvent:
+			wm.AppState = domain.AppStateRemoved
+			wm.reduceRemoved()
+		case *project.ApplicationSCIMProvisioningSetEvent:
+			wm.State = domain.SCIMProvisioningConnectorStateActive
+			wm.Endpoint = e.Endpoint
+			wm.AuthType = e.AuthType
+			wm.Token = e.Token
+			wm.ClientID = e.ClientID
+			wm.ClientSecret = e.ClientSecret
+			wm.TokenEndpoint = e.TokenEndpoint
+			wm.Scopes = e.Scopes
+			wm.ProvisionGroups = e.ProvisionGroups
+		case *project.ApplicationSCIMProvisioningRemovedEvent:
+			wm.reduceRemoved()
+		case *project.ProjectRemovedEvent:
+			wm.AppState = domain.AppStateRemoved
+			wm.reduceRemoved()
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ApplicationSCIMProvisioningWriteModel) reduceRemoved() {
+	wm.State = domain.SCIMProvisioningConnectorStateRemoved
+	wm.Token = nil
+	wm.ClientSecret = nil
+}
+
+func (wm *ApplicationSCIMProvisioningWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.ApplicationAddedType,
+			project.ApplicationRemovedType,
+			project.ApplicationSCIMProvisioningSetType,
+			project.ApplicationSCIMProvisioningRemovedType,
+			project.ProjectRemovedType).
+		Builder()
+}
@@ -0,0 +1,486 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/scimprovisioning"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func scimProvisioningAppAddedEvent() *project.ApplicationAddedEvent {
+	return project.NewApplicationAddedEvent(context.Background(),
+		&project.NewAggregate("project1", "org1").Aggregate,
+		"app1",
+		"app",
+	)
+}
+
+func scimProvisioningSetEvent(authType domain.SCIMProvisioningAuthType, token, clientSecret *crypto.CryptoValue) *project.ApplicationSCIMProvisioningSetEvent {
+	var clientID, tokenEndpoint string
+	if authType == domain.SCIMProvisioningAuthTypeClientCredentials {
+		clientID = "client"
+		tokenEndpoint = "https://app.example.com/oauth/token"
+	}
+	return project.NewApplicationSCIMProvisioningSetEvent(context.Background(),
+		&project.NewAggregate("project1", "org1").Aggregate,
+		"app1",
+		"https://app.example.com/scim/v2",
+		authType,
+		token,
+		clientID,
+		clientSecret,
+		tokenEndpoint,
+		nil,
+		true,
+	)
+}
+
+func scimProvisioningCryptoValue(value string) *crypto.CryptoValue {
+	return &crypto.CryptoValue{
+		CryptoType: crypto.TypeEncryption,
+		Algorithm:  "enc",
+		KeyID:      "id",
+		Crypted:    []byte(value),
+	}
+}
+
+func TestCommands_SetApplicationSCIMProvisioning(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		connector *SCIMProvisioningConnector
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "missing app id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				connector: &SCIMProvisioningConnector{
+					ProjectID: "project1",
+					Endpoint:  "https://app.example.com/scim/v2",
+					AuthType:  domain.SCIMProvisioningAuthTypeBearer,
+					Token:     "token",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid endpoint, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				connector: &SCIMProvisioningConnector{
+					ProjectID: "project1",
+					AppID:     "app1",
+					Endpoint:  "app.example.com/scim/v2",
+					AuthType:  domain.SCIMProvisioningAuthTypeBearer,
+					Token:     "token",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "client credentials without token endpoint, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				connector: &SCIMProvisioningConnector{
+					ProjectID:    "project1",
+					AppID:        "app1",
+					Endpoint:     "https://app.example.com/scim/v2",
+					AuthType:     domain.SCIMProvisioningAuthTypeClientCredentials,
+					ClientID:     "client",
+					ClientSecret: "secret",
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "application not existing, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				connector: &SCIMProvisioningConnector{
+					ProjectID: "project1",
+					AppID:     "app1",
+					Endpoint:  "https://app.example.com/scim/v2",
+					AuthType:  domain.SCIMProvisioningAuthTypeBearer,
+					Token:     "token",
+				},
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "no permission, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(scimProvisioningAppAddedEvent()),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				connector: &SCIMProvisioningConnector{
+					ProjectID: "project1",
+					AppID:     "app1",
+					Endpoint:  "https://app.example.com/scim/v2",
+					AuthType:  domain.SCIMProvisioningAuthTypeBearer,
+					Token:     "token",
+				},
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "missing token, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(scimProvisioningAppAddedEvent()),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				connector: &SCIMProvisioningConnector{
+					ProjectID: "project1",
+					AppID:     "app1",
+					Endpoint:  "https://app.example.com/scim/v2",
+					AuthType:  domain.SCIMProvisioningAuthTypeBearer,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "bearer token, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(scimProvisioningAppAddedEvent()),
+					),
+					expectPush(
+						scimProvisioningSetEvent(domain.SCIMProvisioningAuthTypeBearer, scimProvisioningCryptoValue("token"), nil),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				connector: &SCIMProvisioningConnector{
+					ProjectID:       "project1",
+					AppID:           "app1",
+					Endpoint:        "https://app.example.com/scim/v2",
+					AuthType:        domain.SCIMProvisioningAuthTypeBearer,
+					Token:           "token",
+					ProvisionGroups: true,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "client credentials, keep secret, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(scimProvisioningAppAddedEvent()),
+						eventFromEventPusher(
+							scimProvisioningSetEvent(domain.SCIMProvisioningAuthTypeClientCredentials, nil, scimProvisioningCryptoValue("secret")),
+						),
+					),
+					expectPush(
+						project.NewApplicationSCIMProvisioningSetEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"https://app.example.com/scim/v2",
+							domain.SCIMProvisioningAuthTypeClientCredentials,
+							nil,
+							"client",
+							scimProvisioningCryptoValue("secret"),
+							"https://app.example.com/oauth/token",
+							[]string{"scim"},
+							true,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				connector: &SCIMProvisioningConnector{
+					ProjectID:       "project1",
+					AppID:           "app1",
+					Endpoint:        "https://app.example.com/scim/v2",
+					AuthType:        domain.SCIMProvisioningAuthTypeClientCredentials,
+					ClientID:        "client",
+					TokenEndpoint:   "https://app.example.com/oauth/token",
+					Scopes:          []string{"scim"},
+					ProvisionGroups: true,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+		{
+			name: "changed auth type without token, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(scimProvisioningAppAddedEvent()),
+						eventFromEventPusher(
+							scimProvisioningSetEvent(domain.SCIMProvisioningAuthTypeClientCredentials, nil, scimProvisioningCryptoValue("secret")),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				connector: &SCIMProvisioningConnector{
+					ProjectID: "project1",
+					AppID:     "app1",
+					Endpoint:  "https://app.example.com/scim/v2",
+					AuthType:  domain.SCIMProvisioningAuthTypeBearer,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "unchanged, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(scimProvisioningAppAddedEvent()),
+						eventFromEventPusher(
+							scimProvisioningSetEvent(domain.SCIMProvisioningAuthTypeBearer, scimProvisioningCryptoValue("token"), nil),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				connector: &SCIMProvisioningConnector{
+					ProjectID:       "project1",
+					AppID:           "app1",
+					Endpoint:        "https://app.example.com/scim/v2",
+					AuthType:        domain.SCIMProvisioningAuthTypeBearer,
+					ProvisionGroups: true,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:       tt.fields.eventstore(t),
+				checkPermission:  tt.fields.checkPermission,
+				targetEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			got, err := c.SetApplicationSCIMProvisioning(context.Background(), tt.args.connector)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveApplicationSCIMProvisioning(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		appID  string
+		res    res
+	}{
+		{
+			name: "missing app id, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "not configured, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(scimProvisioningAppAddedEvent()),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			appID: "app1",
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "no permission, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(scimProvisioningAppAddedEvent()),
+						eventFromEventPusher(
+							scimProvisioningSetEvent(domain.SCIMProvisioningAuthTypeBearer, scimProvisioningCryptoValue("token"), nil),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			appID: "app1",
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "remove, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(scimProvisioningAppAddedEvent()),
+						eventFromEventPusher(
+							scimProvisioningSetEvent(domain.SCIMProvisioningAuthTypeBearer, scimProvisioningCryptoValue("token"), nil),
+						),
+					),
+					expectPush(
+						project.NewApplicationSCIMProvisioningRemovedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			appID: "app1",
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.RemoveApplicationSCIMProvisioning(context.Background(), "project1", tt.appID, "org1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_SCIMResourceProvisioned(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		appID      string
+		err        func(error) bool
+	}{
+		{
+			name:       "missing app id, invalid argument error",
+			eventstore: expectEventstore(),
+			err:        zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name: "provisioned, ok",
+			eventstore: expectEventstore(
+				expectPush(
+					scimprovisioning.NewResourceProvisionedEvent(context.Background(),
+						&scimprovisioning.NewAggregate("app1", "org1").Aggregate,
+						domain.SCIMProvisioningResourceTypeUser,
+						"user1",
+						"remote1",
+					),
+				),
+			),
+			appID: "app1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.SCIMResourceProvisioned(context.Background(), tt.appID, "org1", domain.SCIMProvisioningResourceTypeUser, "user1", "remote1")
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, tt.err(err), "got wrong err: %v", err)
+		})
+	}
+}
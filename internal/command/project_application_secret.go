@@ -23,7 +23,7 @@ func (c *Commands) ChangeApplicationSecret(ctx context.Context, projectID, appli
 		return "", time.Time{}, zerrors.ThrowNotFound(nil, "COMMAND-Kd92s", "Errors.Project.App.NotExisting")
 	}
 
-	if err := c.checkPermissionUpdateApplication(ctx, existingApplication.ResourceOwner, existingApplication.AggregateID); err != nil {
+	if err := c.checkPermissionUpdateApplication(ctx, existingApplication.ResourceOwner, existingApplication.AggregateID, existingApplication.ApplicationID); err != nil {
 		return "", time.Time{}, err
 	}
 
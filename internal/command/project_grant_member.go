@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"slices"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -19,6 +20,8 @@ type AddProjectGrantMember struct {
 	ProjectGrantID string
 	ProjectID      string
 	Roles          []string
+	// ValidUntil limits the membership in time, it is removed automatically after expiry.
+	ValidUntil *time.Time
 }
 
 func (i *AddProjectGrantMember) IsValid(zitadelRoles []authz.RoleMapping) error {
@@ -28,6 +31,9 @@ func (i *AddProjectGrantMember) IsValid(zitadelRoles []authz.RoleMapping) error
 	if len(domain.CheckForInvalidRoles(i.Roles, domain.ProjectGrantRolePrefix, zitadelRoles)) > 0 {
 		return zerrors.ThrowInvalidArgument(nil, "PROJECT-m9gKK", "Errors.Project.Grant.Member.Invalid")
 	}
+	if i.ValidUntil != nil && !i.ValidUntil.After(time.Now()) {
+		return zerrors.ThrowInvalidArgument(nil, "PROJECT-Vu9dk", "Errors.Member.ValidityInvalid")
+	}
 	return nil
 }
 
@@ -69,14 +75,14 @@ func (c *Commands) AddProjectGrantMember(ctx context.Context, member *AddProject
 		return nil, err
 	}
 
-	pushedEvents, err := c.eventstore.Push(
-		ctx,
-		project.NewProjectGrantMemberAddedEvent(ctx,
-			ProjectAggregateFromWriteModelWithCTX(ctx, &addedMember.WriteModel),
-			member.UserID,
-			member.ProjectGrantID,
-			member.Roles...,
-		))
+	added := project.NewProjectGrantMemberAddedEvent(ctx,
+		ProjectAggregateFromWriteModelWithCTX(ctx, &addedMember.WriteModel),
+		member.UserID,
+		member.ProjectGrantID,
+		member.Roles...,
+	)
+	added.ValidUntil = member.ValidUntil
+	pushedEvents, err := c.eventstore.Push(ctx, added)
 	if err != nil {
 		return nil, err
 	}
@@ -94,6 +100,11 @@ type ChangeProjectGrantMember struct {
 	OrganizationID string
 	ProjectID      string
 	Roles          []string
+	// ValidUntil limits the membership in time.
+	// If not set, the current validity of the membership is kept.
+	ValidUntil *time.Time
+	// ClearValidUntil makes a time-bound membership permanent.
+	ClearValidUntil bool
 }
 
 func (i *ChangeProjectGrantMember) IsValid(zitadelRoles []authz.RoleMapping) error {
@@ -103,6 +114,9 @@ func (i *ChangeProjectGrantMember) IsValid(zitadelRoles []authz.RoleMapping) err
 	if len(domain.CheckForInvalidRoles(i.Roles, domain.ProjectGrantRolePrefix, zitadelRoles)) > 0 {
 		return zerrors.ThrowInvalidArgument(nil, "PROJECT-m0sDf", "Errors.Project.Grant.Member.Invalid")
 	}
+	if i.ValidUntil != nil && (i.ClearValidUntil || !i.ValidUntil.After(time.Now())) {
+		return zerrors.ThrowInvalidArgument(nil, "PROJECT-Vu1en", "Errors.Member.ValidityInvalid")
+	}
 	return nil
 }
 
@@ -129,18 +143,19 @@ func (c *Commands) ChangeProjectGrantMember(ctx context.Context, member *ChangeP
 	if err := c.checkPermissionUpdateProjectGrantMember(ctx, existingGrant.GrantedOrgID, existingMember.GrantID); err != nil {
 		return nil, err
 	}
-	if slices.Compare(existingMember.Roles, member.Roles) == 0 {
+	validUntil := changedMemberValidity(existingMember.ValidUntil, member.ValidUntil, member.ClearValidUntil)
+	if slices.Compare(existingMember.Roles, member.Roles) == 0 && equalValidity(existingMember.ValidUntil, validUntil) {
 		return writeModelToObjectDetails(&existingMember.WriteModel), nil
 	}
 
-	pushedEvents, err := c.eventstore.Push(
-		ctx,
-		project.NewProjectGrantMemberChangedEvent(ctx,
-			ProjectAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel),
-			member.UserID,
-			member.ProjectGrantID,
-			member.Roles...,
-		))
+	changed := project.NewProjectGrantMemberChangedEvent(ctx,
+		ProjectAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel),
+		member.UserID,
+		member.ProjectGrantID,
+		member.Roles...,
+	)
+	changed.ValidUntil = validUntil
+	pushedEvents, err := c.eventstore.Push(ctx, changed)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/project"
@@ -9,9 +11,10 @@ import (
 type ProjectGrantMemberWriteModel struct {
 	eventstore.WriteModel
 
-	GrantID string
-	UserID  string
-	Roles   []string
+	GrantID    string
+	UserID     string
+	Roles      []string
+	ValidUntil *time.Time
 
 	State domain.MemberState
 }
@@ -66,10 +69,12 @@ func (wm *ProjectGrantMemberWriteModel) Reduce() error {
 		switch e := event.(type) {
 		case *project.GrantMemberAddedEvent:
 			wm.Roles = e.Roles
+			wm.ValidUntil = e.ValidUntil
 			wm.State = domain.MemberStateActive
 			wm.ResourceOwner = e.Aggregate().ResourceOwner
 		case *project.GrantMemberChangedEvent:
 			wm.Roles = e.Roles
+			wm.ValidUntil = e.ValidUntil
 		case *project.GrantMemberRemovedEvent:
 			wm.State = domain.MemberStateRemoved
 		case *project.GrantMemberCascadeRemovedEvent:
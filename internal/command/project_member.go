@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"slices"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -17,6 +18,8 @@ type AddProjectMember struct {
 	ProjectID     string
 	UserID        string
 	Roles         []string
+	// ValidUntil limits the membership in time, it is removed automatically after expiry.
+	ValidUntil *time.Time
 }
 
 func (i *AddProjectMember) IsValid(zitadelRoles []authz.RoleMapping) error {
@@ -26,6 +29,9 @@ func (i *AddProjectMember) IsValid(zitadelRoles []authz.RoleMapping) error {
 	if len(domain.CheckForInvalidRoles(i.Roles, domain.ProjectRolePrefix, zitadelRoles)) > 0 {
 		return zerrors.ThrowInvalidArgument(nil, "PROJECT-3m9ds", "Errors.Project.Member.Invalid")
 	}
+	if i.ValidUntil != nil && !i.ValidUntil.After(time.Now()) {
+		return zerrors.ThrowInvalidArgument(nil, "PROJECT-Vu7bn", "Errors.Member.ValidityInvalid")
+	}
 	return nil
 }
 
@@ -63,13 +69,13 @@ func (c *Commands) AddProjectMember(ctx context.Context, member *AddProjectMembe
 		return nil, zerrors.ThrowAlreadyExists(nil, "PROJECT-PtXi1", "Errors.Project.Member.AlreadyExists")
 	}
 
-	pushedEvents, err := c.eventstore.Push(ctx,
-		project.NewProjectMemberAddedEvent(ctx,
-			ProjectAggregateFromWriteModelWithCTX(ctx, &addedMember.WriteModel),
-			member.UserID,
-			member.Roles...,
-		),
+	added := project.NewProjectMemberAddedEvent(ctx,
+		ProjectAggregateFromWriteModelWithCTX(ctx, &addedMember.WriteModel),
+		member.UserID,
+		member.Roles...,
 	)
+	added.ValidUntil = member.ValidUntil
+	pushedEvents, err := c.eventstore.Push(ctx, added)
 	if err != nil {
 		return nil, err
 	}
@@ -86,6 +92,11 @@ type ChangeProjectMember struct {
 	ProjectID     string
 	UserID        string
 	Roles         []string
+	// ValidUntil limits the membership in time.
+	// If not set, the current validity of the membership is kept.
+	ValidUntil *time.Time
+	// ClearValidUntil makes a time-bound membership permanent.
+	ClearValidUntil bool
 }
 
 func (i *ChangeProjectMember) IsValid(zitadelRoles []authz.RoleMapping) error {
@@ -95,6 +106,9 @@ func (i *ChangeProjectMember) IsValid(zitadelRoles []authz.RoleMapping) error {
 	if len(domain.CheckForInvalidRoles(i.Roles, domain.ProjectRolePrefix, zitadelRoles)) > 0 {
 		return zerrors.ThrowInvalidArgument(nil, "PROJECT-3m9d", "Errors.Project.Member.Invalid")
 	}
+	if i.ValidUntil != nil && (i.ClearValidUntil || !i.ValidUntil.After(time.Now())) {
+		return zerrors.ThrowInvalidArgument(nil, "PROJECT-Vu8cm", "Errors.Member.ValidityInvalid")
+	}
 	return nil
 }
 
@@ -114,11 +128,14 @@ func (c *Commands) ChangeProjectMember(ctx context.Context, member *ChangeProjec
 	if err := c.checkPermissionUpdateProjectMember(ctx, existingMember.ResourceOwner, existingMember.AggregateID); err != nil {
 		return nil, err
 	}
-	if slices.Compare(existingMember.Roles, member.Roles) == 0 {
+	validUntil := changedMemberValidity(existingMember.ValidUntil, member.ValidUntil, member.ClearValidUntil)
+	if slices.Compare(existingMember.Roles, member.Roles) == 0 && equalValidity(existingMember.ValidUntil, validUntil) {
 		return writeModelToObjectDetails(&existingMember.WriteModel), nil
 	}
 	projectAgg := ProjectAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel)
-	pushedEvents, err := c.eventstore.Push(ctx, project.NewProjectMemberChangedEvent(ctx, projectAgg, member.UserID, member.Roles...))
+	changed := project.NewProjectMemberChangedEvent(ctx, projectAgg, member.UserID, member.Roles...)
+	changed.ValidUntil = validUntil
+	pushedEvents, err := c.eventstore.Push(ctx, changed)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,169 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ProjectRoleMember is an administrator of a single role of a project.
+// Its roles (e.g. PROJECT_ROLE_ASSIGNER) only grant permissions on user grants containing the role.
+type ProjectRoleMember struct {
+	ResourceOwner string
+	ProjectID     string
+	RoleKey       string
+	UserID        string
+	Roles         []string
+	// ValidUntil limits the membership in time, it is removed automatically after expiry.
+	// On changes, the current validity of the membership is kept if not set.
+	ValidUntil *time.Time
+	// ClearValidUntil makes a time-bound membership permanent on changes.
+	ClearValidUntil bool
+}
+
+func (i *ProjectRoleMember) IsValid(zitadelRoles []authz.RoleMapping) error {
+	if i.ProjectID == "" || i.RoleKey == "" || i.UserID == "" || len(i.Roles) == 0 {
+		return zerrors.ThrowInvalidArgument(nil, "PROJECT-Rm1vI", "Errors.Project.Role.Member.Invalid")
+	}
+	// the colon separates the context from the permission, see [domain.ProjectRoleContextID]
+	if strings.Contains(i.RoleKey, ":") {
+		return zerrors.ThrowInvalidArgument(nil, "PROJECT-Rm7kI", "Errors.Project.Role.Member.Invalid")
+	}
+	if len(domain.CheckForInvalidRoles(i.Roles, domain.ProjectRoleRolePrefix, zitadelRoles)) > 0 {
+		return zerrors.ThrowInvalidArgument(nil, "PROJECT-Rm2rI", "Errors.Project.Role.Member.Invalid")
+	}
+	if i.ValidUntil != nil && (i.ClearValidUntil || !i.ValidUntil.After(time.Now())) {
+		return zerrors.ThrowInvalidArgument(nil, "PROJECT-Rm8vU", "Errors.Member.ValidityInvalid")
+	}
+	return nil
+}
+
+func (c *Commands) AddProjectRoleMember(ctx context.Context, member *ProjectRoleMember) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := member.IsValid(c.zitadelRoles); err != nil {
+		return nil, err
+	}
+	if _, err = c.checkUserExists(ctx, member.UserID, ""); err != nil {
+		return nil, err
+	}
+	addedMember, err := c.projectRoleMemberWriteModelByID(ctx, member.ProjectID, member.RoleKey, member.UserID, member.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !addedMember.RoleState.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "PROJECT-Rm3nF", "Errors.Project.Role.NotExisting")
+	}
+	if err := c.checkPermissionUpdateProjectMember(ctx, addedMember.ResourceOwner, addedMember.AggregateID); err != nil {
+		return nil, err
+	}
+	if addedMember.State.Exists() {
+		return nil, zerrors.ThrowAlreadyExists(nil, "PROJECT-Rm4aE", "Errors.Project.Role.Member.AlreadyExists")
+	}
+	added := project.NewRoleMemberAddedEvent(ctx,
+		ProjectAggregateFromWriteModelWithCTX(ctx, &addedMember.WriteModel),
+		member.RoleKey,
+		member.UserID,
+		member.Roles...,
+	)
+	added.ValidUntil = member.ValidUntil
+	pushedEvents, err := c.eventstore.Push(ctx, added)
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(addedMember, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&addedMember.WriteModel), nil
+}
+
+// ChangeProjectRoleMember replaces the roles of an existing project role member.
+func (c *Commands) ChangeProjectRoleMember(ctx context.Context, member *ProjectRoleMember) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := member.IsValid(c.zitadelRoles); err != nil {
+		return nil, err
+	}
+	existingMember, err := c.projectRoleMemberWriteModelByID(ctx, member.ProjectID, member.RoleKey, member.UserID, member.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingMember.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "PROJECT-Rm5nF", "Errors.Project.Role.Member.NotFound")
+	}
+	if err := c.checkPermissionUpdateProjectMember(ctx, existingMember.ResourceOwner, existingMember.AggregateID); err != nil {
+		return nil, err
+	}
+	validUntil := changedMemberValidity(existingMember.ValidUntil, member.ValidUntil, member.ClearValidUntil)
+	if slices.Compare(existingMember.Roles, member.Roles) == 0 && equalValidity(existingMember.ValidUntil, validUntil) {
+		return writeModelToObjectDetails(&existingMember.WriteModel), nil
+	}
+	changed := project.NewRoleMemberChangedEvent(ctx,
+		ProjectAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel),
+		member.RoleKey,
+		member.UserID,
+		member.Roles...,
+	)
+	changed.ValidUntil = validUntil
+	pushedEvents, err := c.eventstore.Push(ctx, changed)
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(existingMember, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingMember.WriteModel), nil
+}
+
+func (c *Commands) RemoveProjectRoleMember(ctx context.Context, projectID, roleKey, userID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if projectID == "" || roleKey == "" || userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-Rm6vI", "Errors.Project.Role.Member.Invalid")
+	}
+	existingMember, err := c.projectRoleMemberWriteModelByID(ctx, projectID, roleKey, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !existingMember.State.Exists() {
+		return writeModelToObjectDetails(&existingMember.WriteModel), nil
+	}
+	if err := c.checkPermissionDeleteProjectMember(ctx, existingMember.ResourceOwner, existingMember.AggregateID); err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx,
+		project.NewRoleMemberRemovedEvent(ctx,
+			ProjectAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel),
+			roleKey,
+			userID,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err = AppendAndReduce(existingMember, pushedEvents...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&existingMember.WriteModel), nil
+}
+
+func (c *Commands) projectRoleMemberWriteModelByID(ctx context.Context, projectID, roleKey, userID, resourceOwner string) (_ *ProjectRoleMemberWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewProjectRoleMemberWriteModel(projectID, roleKey, userID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+// ProjectRoleMemberWriteModel is the membership of a user on a single role of a project.
+// The membership ends with the removal of the role or the project.
+type ProjectRoleMemberWriteModel struct {
+	eventstore.WriteModel
+
+	RoleKey    string
+	UserID     string
+	Roles      []string
+	ValidUntil *time.Time
+	RoleState  domain.ProjectRoleState
+	State      domain.MemberState
+}
+
+func NewProjectRoleMemberWriteModel(projectID, roleKey, userID, resourceOwner string) *ProjectRoleMemberWriteModel {
+	return &ProjectRoleMemberWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+		RoleKey: roleKey,
+		UserID:  userID,
+	}
+}
+
+func (wm *ProjectRoleMemberWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *project.RoleAddedEvent:
+			if e.Key != wm.RoleKey {
+				continue
+			}
+		case *project.RoleRemovedEvent:
+			if e.Key != wm.RoleKey {
+				continue
+			}
+		case *project.RoleMemberAddedEvent:
+			if e.RoleKey != wm.RoleKey || e.UserID != wm.UserID {
+				continue
+			}
+		case *project.RoleMemberChangedEvent:
+			if e.RoleKey != wm.RoleKey || e.UserID != wm.UserID {
+				continue
+			}
+		case *project.RoleMemberRemovedEvent:
+			if e.RoleKey != wm.RoleKey || e.UserID != wm.UserID {
+				continue
+			}
+		}
+		wm.WriteModel.AppendEvents(event)
+	}
+}
+
+func (wm *ProjectRoleMemberWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.RoleAddedEvent:
+			wm.RoleState = domain.ProjectRoleStateActive
+		case *project.RoleRemovedEvent, *project.ProjectRemovedEvent:
+			wm.RoleState = domain.ProjectRoleStateRemoved
+			wm.Roles = nil
+			wm.State = domain.MemberStateRemoved
+		case *project.RoleMemberAddedEvent:
+			wm.Roles = e.Roles
+			wm.ValidUntil = e.ValidUntil
+			wm.State = domain.MemberStateActive
+		case *project.RoleMemberChangedEvent:
+			wm.Roles = e.Roles
+			wm.ValidUntil = e.ValidUntil
+		case *project.RoleMemberRemovedEvent:
+			wm.Roles = nil
+			wm.State = domain.MemberStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *ProjectRoleMemberWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.RoleAddedType,
+			project.RoleRemovedType,
+			project.ProjectRemovedType,
+			project.RoleMemberAddedType,
+			project.RoleMemberChangedType,
+			project.RoleMemberRemovedType,
+		).
+		Builder()
+}
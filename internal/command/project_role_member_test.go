@@ -0,0 +1,457 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_AddProjectRoleMember(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		member *ProjectRoleMember
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	zitadelRoles := []authz.RoleMapping{
+		{Role: "PROJECT_ROLE_ASSIGNER"},
+		{Role: domain.RoleProjectOwner},
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "role key with colon, invalid argument error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				member: &ProjectRoleMember{
+					ProjectID: "project1",
+					RoleKey:   "role:1",
+					UserID:    "user1",
+					Roles:     []string{"PROJECT_ROLE_ASSIGNER"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "project role, invalid argument error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				member: &ProjectRoleMember{
+					ProjectID: "project1",
+					RoleKey:   "role1",
+					UserID:    "user1",
+					Roles:     []string{domain.RoleProjectOwner},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "role not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(projectResourceMemberUserAddedEvent()),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"role1",
+								"Role 1",
+								"",
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleRemovedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"role1",
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				member: &ProjectRoleMember{
+					ProjectID: "project1",
+					RoleKey:   "role1",
+					UserID:    "user1",
+					Roles:     []string{"PROJECT_ROLE_ASSIGNER"},
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "no permission, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(projectResourceMemberUserAddedEvent()),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"role1",
+								"Role 1",
+								"",
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				member: &ProjectRoleMember{
+					ProjectID: "project1",
+					RoleKey:   "role1",
+					UserID:    "user1",
+					Roles:     []string{"PROJECT_ROLE_ASSIGNER"},
+				},
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "member already exists, already exists error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(projectResourceMemberUserAddedEvent()),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"role1",
+								"Role 1",
+								"",
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleMemberAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"role1",
+								"user1",
+								"PROJECT_ROLE_ASSIGNER",
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				member: &ProjectRoleMember{
+					ProjectID: "project1",
+					RoleKey:   "role1",
+					UserID:    "user1",
+					Roles:     []string{"PROJECT_ROLE_ASSIGNER"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			name: "member added, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(projectResourceMemberUserAddedEvent()),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"role1",
+								"Role 1",
+								"",
+							),
+						),
+					),
+					expectPush(
+						project.NewRoleMemberAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"role1",
+							"user1",
+							"PROJECT_ROLE_ASSIGNER",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				member: &ProjectRoleMember{
+					ProjectID: "project1",
+					RoleKey:   "role1",
+					UserID:    "user1",
+					Roles:     []string{"PROJECT_ROLE_ASSIGNER"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				zitadelRoles:    zitadelRoles,
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := r.AddProjectRoleMember(context.Background(), tt.args.member)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_ChangeProjectRoleMember(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		member *ProjectRoleMember
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	zitadelRoles := []authz.RoleMapping{
+		{Role: "PROJECT_ROLE_ASSIGNER"},
+		{Role: "PROJECT_ROLE_ASSIGNER_VIEWER"},
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "member removed with role, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"role1",
+								"Role 1",
+								"",
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleMemberAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"role1",
+								"user1",
+								"PROJECT_ROLE_ASSIGNER",
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleRemovedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"role1",
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				member: &ProjectRoleMember{
+					ProjectID: "project1",
+					RoleKey:   "role1",
+					UserID:    "user1",
+					Roles:     []string{"PROJECT_ROLE_ASSIGNER_VIEWER"},
+				},
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "member changed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"role1",
+								"Role 1",
+								"",
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleMemberAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"role1",
+								"user1",
+								"PROJECT_ROLE_ASSIGNER",
+							),
+						),
+					),
+					expectPush(
+						project.NewRoleMemberChangedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"role1",
+							"user1",
+							"PROJECT_ROLE_ASSIGNER_VIEWER",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				member: &ProjectRoleMember{
+					ProjectID: "project1",
+					RoleKey:   "role1",
+					UserID:    "user1",
+					Roles:     []string{"PROJECT_ROLE_ASSIGNER_VIEWER"},
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				zitadelRoles:    zitadelRoles,
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := r.ChangeProjectRoleMember(context.Background(), tt.args.member)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
+func TestCommandSide_RemoveProjectRoleMember(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			name: "member not existing, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			res: res{
+				want: &domain.ObjectDetails{},
+			},
+		},
+		{
+			name: "member removed, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewRoleMemberAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"role1",
+								"user1",
+								"PROJECT_ROLE_ASSIGNER",
+							),
+						),
+					),
+					expectPush(
+						project.NewRoleMemberRemovedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"role1",
+							"user1",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := r.RemoveProjectRoleMember(context.Background(), "project1", "role1", "user1", "")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
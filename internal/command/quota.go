@@ -18,6 +18,12 @@ type QuotaUnit string
 const (
 	QuotaRequestsAllAuthenticated QuotaUnit = "requests.all.authenticated"
 	QuotaActionsAllRunsSeconds    QuotaUnit = "actions.all.runs.seconds"
+	QuotaUsersAllActive           QuotaUnit = "users.all.active"
+	QuotaTokensAllIssued          QuotaUnit = "tokens.all.issued"
+	QuotaNotificationsAllSent     QuotaUnit = "notifications.all.sent"
+	QuotaUsersAll                 QuotaUnit = "users.all"
+	QuotaProjectsAll              QuotaUnit = "projects.all"
+	QuotaApplicationsAll          QuotaUnit = "applications.all"
 )
 
 func (q QuotaUnit) Enum() quota.Unit {
@@ -26,11 +32,47 @@ func (q QuotaUnit) Enum() quota.Unit {
 		return quota.RequestsAllAuthenticated
 	case QuotaActionsAllRunsSeconds:
 		return quota.ActionsAllRunsSeconds
+	case QuotaUsersAllActive:
+		return quota.UsersAllActive
+	case QuotaTokensAllIssued:
+		return quota.TokensAllIssued
+	case QuotaNotificationsAllSent:
+		return quota.NotificationsAllSent
+	case QuotaUsersAll:
+		return quota.UsersAll
+	case QuotaProjectsAll:
+		return quota.ProjectsAll
+	case QuotaApplicationsAll:
+		return quota.ApplicationsAll
 	default:
 		return quota.Unimplemented
 	}
 }
 
+// QuotaUnitFromEnum returns the name of the unit, it is empty if the unit is not implemented.
+func QuotaUnitFromEnum(unit quota.Unit) QuotaUnit {
+	switch unit {
+	case quota.RequestsAllAuthenticated:
+		return QuotaRequestsAllAuthenticated
+	case quota.ActionsAllRunsSeconds:
+		return QuotaActionsAllRunsSeconds
+	case quota.UsersAllActive:
+		return QuotaUsersAllActive
+	case quota.TokensAllIssued:
+		return QuotaTokensAllIssued
+	case quota.NotificationsAllSent:
+		return QuotaNotificationsAllSent
+	case quota.UsersAll:
+		return QuotaUsersAll
+	case quota.ProjectsAll:
+		return QuotaProjectsAll
+	case quota.ApplicationsAll:
+		return QuotaApplicationsAll
+	default:
+		return ""
+	}
+}
+
 // AddQuota returns and error if the quota already exists.
 // AddQuota is deprecated. Use SetQuota instead.
 func (c *Commands) AddQuota(
@@ -159,6 +201,9 @@ func (q *QuotaNotification) validate() error {
 }
 
 func (q *SetQuota) validate() error {
+	if q.Unit.isOrgOnly() {
+		return zerrors.ThrowInvalidArgument(nil, "QUOTA-Lp3vW", "Errors.Quota.Invalid.OrgUnit")
+	}
 	for _, notification := range q.Notifications {
 		if err := notification.validate(); err != nil {
 			return err
@@ -173,9 +218,45 @@ func (q *SetQuota) validate() error {
 	return nil
 }
 
+// validateOrg validates a quota which only applies to an organization.
+// Notifications without a call URL are only sent to the organization owners.
+// The units counting existing resources don't reset and don't support notifications.
+func (q *SetQuota) validateOrg() error {
+	switch q.Unit {
+	case QuotaRequestsAllAuthenticated, QuotaUsersAllActive:
+		if q.ResetInterval < time.Minute {
+			return zerrors.ThrowInvalidArgument(nil, "QUOTA-Ck7Rz", "Errors.Quota.Invalid.ResetInterval")
+		}
+	case QuotaUsersAll, QuotaProjectsAll, QuotaApplicationsAll:
+		if len(q.Notifications) > 0 {
+			return zerrors.ThrowInvalidArgument(nil, "QUOTA-Hs4nD", "Errors.Quota.Invalid.CountNotifications")
+		}
+	default:
+		return zerrors.ThrowInvalidArgument(nil, "QUOTA-Ye9tB", "Errors.Quota.Invalid.InstanceUnit")
+	}
+	for _, notification := range q.Notifications {
+		if notification.CallURL == "" && notification.Percent >= 1 {
+			continue
+		}
+		if err := notification.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isOrgOnly returns true for the units which count the existing resources of an organization.
+func (q QuotaUnit) isOrgOnly() bool {
+	return q == QuotaUsersAll || q == QuotaProjectsAll || q == QuotaApplicationsAll
+}
+
 func (c *Commands) SetQuotaCommand(a *quota.Aggregate, wm *quotaWriteModel, createNew bool, q *SetQuota) preparation.Validation {
 	return func() (preparation.CreateCommands, error) {
-		if err := q.validate(); err != nil {
+		validate := q.validate
+		if quota.IsOrgAggregate(&a.Aggregate) {
+			validate = q.validateOrg
+		}
+		if err := validate(); err != nil {
 			return nil, err
 		}
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) (cmd []eventstore.Command, err error) {
@@ -884,3 +884,116 @@ func TestQuota_SetQuota_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestQuota_SetQuota_validateOrg(t *testing.T) {
+	type args struct {
+		setQuota *SetQuota
+	}
+	type res struct {
+		err func(error) bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "instance unit",
+			args: args{
+				setQuota: &SetQuota{
+					Unit:          QuotaTokensAllIssued,
+					From:          time.Now(),
+					ResetInterval: time.Minute * 10,
+					Amount:        100,
+					Limit:         true,
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "QUOTA-Ye9tB", ""))
+				},
+			},
+		},
+		{
+			name: "reset interval under 1 min",
+			args: args{
+				setQuota: &SetQuota{
+					Unit:          QuotaRequestsAllAuthenticated,
+					From:          time.Now(),
+					ResetInterval: time.Second * 10,
+					Amount:        100,
+					Limit:         true,
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "QUOTA-Ck7Rz", ""))
+				},
+			},
+		},
+		{
+			name: "count unit with notifications",
+			args: args{
+				setQuota: &SetQuota{
+					Unit:   QuotaUsersAll,
+					Amount: 100,
+					Limit:  true,
+					Notifications: QuotaNotifications{
+						{
+							Percent: 80,
+						},
+					},
+				},
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowInvalidArgument(nil, "QUOTA-Hs4nD", ""))
+				},
+			},
+		},
+		{
+			name: "count unit, ok",
+			args: args{
+				setQuota: &SetQuota{
+					Unit:   QuotaProjectsAll,
+					Amount: 10,
+					Limit:  true,
+				},
+			},
+			res: res{
+				err: nil,
+			},
+		},
+		{
+			name: "notification without call url, ok",
+			args: args{
+				setQuota: &SetQuota{
+					Unit:          QuotaUsersAllActive,
+					From:          time.Now(),
+					ResetInterval: time.Hour * 24,
+					Amount:        100,
+					Limit:         false,
+					Notifications: QuotaNotifications{
+						{
+							Percent: 80,
+						},
+					},
+				},
+			},
+			res: res{
+				err: nil,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.setQuota.validateOrg()
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
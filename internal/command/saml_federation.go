@@ -0,0 +1,373 @@
+package command
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command/preparation"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/idp/providers/saml"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/samlfederation"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// samlFederationMinInterval prevents the metadata aggregates, which are often large, from being downloaded permanently.
+const samlFederationMinInterval = time.Hour
+
+// SAMLFederation imports the identity providers of a signed SAML metadata aggregate (e.g. eduGAIN or InCommon)
+// into the instance and keeps them up to date.
+type SAMLFederation struct {
+	Name        string
+	MetadataURL string
+	// SigningCertificate is the PEM encoded certificate the metadata aggregate must be signed with
+	SigningCertificate []byte
+	Filter             *domain.SAMLFederationFilter
+	Interval           time.Duration
+	AddToLoginPolicy   bool
+	Template           *samlfederation.IDPTemplate
+}
+
+func (f *SAMLFederation) IsValid() error {
+	if f.Name = strings.TrimSpace(f.Name); f.Name == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sf2vN", "Errors.IDP.SAMLFederation.NameMissing")
+	}
+	if metadataURL, err := url.Parse(f.MetadataURL); err != nil || metadataURL.Host == "" ||
+		(metadataURL.Scheme != "https" && metadataURL.Scheme != "http") {
+		return zerrors.ThrowInvalidArgument(err, "COMMAND-Sf2vU", "Errors.IDP.SAMLFederation.InvalidMetadataURL")
+	}
+	if _, err := samlFederationSigningCertificate(f.SigningCertificate); err != nil {
+		return err
+	}
+	if f.Interval < samlFederationMinInterval {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Sf2vT", "Errors.IDP.SAMLFederation.InvalidInterval")
+	}
+	return f.Filter.Validate()
+}
+
+func (f *SAMLFederation) config() samlfederation.Config {
+	template := f.Template
+	if template == nil {
+		template = new(samlfederation.IDPTemplate)
+	}
+	return samlfederation.Config{
+		Name:               f.Name,
+		MetadataURL:        f.MetadataURL,
+		SigningCertificate: f.SigningCertificate,
+		Filter:             f.Filter,
+		Interval:           f.Interval,
+		AddToLoginPolicy:   f.AddToLoginPolicy,
+		Template:           template,
+	}
+}
+
+func samlFederationSigningCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sf2cP", "Errors.IDP.SAMLFederation.InvalidSigningCertificate")
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-Sf2cC", "Errors.IDP.SAMLFederation.InvalidSigningCertificate")
+	}
+	return certificate, nil
+}
+
+// AddSAMLFederation creates a federation of the instance, its metadata aggregate is imported immediately.
+func (c *Commands) AddSAMLFederation(ctx context.Context, federation *SAMLFederation) (_ string, _ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := federation.IsValid(); err != nil {
+		return "", nil, err
+	}
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if err := c.checkPermission(ctx, domain.PermissionIDPWrite, instanceID, instanceID); err != nil {
+		return "", nil, err
+	}
+	id, err := c.idGenerator.Next()
+	if err != nil {
+		return "", nil, err
+	}
+	writeModel := NewSAMLFederationWriteModel(id, instanceID)
+	err = c.pushAppendAndReduce(ctx, writeModel, samlfederation.NewAddedEvent(
+		ctx,
+		&samlfederation.NewAggregate(id, instanceID).Aggregate,
+		federation.config(),
+	))
+	if err != nil {
+		return "", nil, err
+	}
+	return id, writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// ChangeSAMLFederation replaces the configuration of the federation,
+// the metadata aggregate is imported immediately to apply the changed filter and template.
+func (c *Commands) ChangeSAMLFederation(ctx context.Context, id string, federation *SAMLFederation) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := federation.IsValid(); err != nil {
+		return nil, err
+	}
+	writeModel, err := c.existingSAMLFederationWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, samlfederation.NewChangedEvent(
+		ctx,
+		&samlfederation.NewAggregate(id, writeModel.ResourceOwner).Aggregate,
+		federation.config(),
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RemoveSAMLFederation removes the federation together with the identity providers created for its entities.
+func (c *Commands) RemoveSAMLFederation(ctx context.Context, id string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.existingSAMLFederationWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	instanceAgg := instance.NewAggregate(writeModel.ResourceOwner)
+	cmds := make([]eventstore.Command, 0, len(writeModel.Entities)+1)
+	for _, entityID := range slices.Sorted(maps.Keys(writeModel.Entities)) {
+		removeCmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter, c.prepareDeleteInstanceProvider(instanceAgg, writeModel.Entities[entityID].IDPID))
+		// the identity provider might have been removed manually
+		if zerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		cmds = append(cmds, removeCmds...)
+	}
+	cmds = append(cmds, samlfederation.NewRemovedEvent(
+		ctx,
+		&samlfederation.NewAggregate(id, writeModel.ResourceOwner).Aggregate,
+	))
+	if err = c.pushAppendAndReduce(ctx, writeModel, cmds...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// RequestSAMLFederationImport requests an import of the metadata aggregate independent of the interval.
+func (c *Commands) RequestSAMLFederationImport(ctx context.Context, id string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.existingSAMLFederationWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel, samlfederation.NewImportRequestedEvent(
+		ctx,
+		&samlfederation.NewAggregate(id, writeModel.ResourceOwner).Aggregate,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+// existingSAMLFederationWriteModel returns the federation if the caller is allowed to manage it.
+func (c *Commands) existingSAMLFederationWriteModel(ctx context.Context, id string) (*SAMLFederationWriteModel, error) {
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Sf2rI", "Errors.IDMissing")
+	}
+	writeModel, err := c.getSAMLFederationWriteModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Sf2rN", "Errors.IDP.SAMLFederation.NotFound")
+	}
+	if err := c.checkPermission(ctx, domain.PermissionIDPWrite, writeModel.ResourceOwner, id); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) getSAMLFederationWriteModel(ctx context.Context, id string) (_ *SAMLFederationWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewSAMLFederationWriteModel(id, authz.GetInstance(ctx).InstanceID())
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+// ImportSAMLFederation downloads and verifies the metadata aggregate of the federation
+// and creates, updates and removes the identity providers of the entities matching the filter.
+// An unavailable or invalid metadata aggregate fails the import, the identity providers are kept in this case.
+// Entities which cannot be imported don't fail the import, they are counted in the summary.
+// It is called by the import worker and therefore doesn't check any permission.
+func (c *Commands) ImportSAMLFederation(ctx context.Context, id string, startedAt time.Time) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel, err := c.getSAMLFederationWriteModel(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !writeModel.State.Exists() {
+		return zerrors.ThrowNotFound(nil, "COMMAND-Sf2iN", "Errors.IDP.SAMLFederation.NotFound")
+	}
+	aggregate := &samlfederation.NewAggregate(id, writeModel.ResourceOwner).Aggregate
+	nextRunAt := startedAt.Add(writeModel.Interval)
+
+	entities, validUntil, err := c.samlFederationEntities(ctx, writeModel, startedAt)
+	if err != nil {
+		_, err = c.eventstore.Push(ctx, samlfederation.NewImportFailedEvent(ctx, aggregate, startedAt, err.Error(), nextRunAt))
+		return err
+	}
+	summary := new(domain.SAMLFederationImportSummary)
+	matched := make(map[string]struct{}, len(entities))
+	for _, entity := range entities {
+		if !writeModel.Filter.Matches(entity) {
+			continue
+		}
+		if _, ok := matched[entity.EntityID]; ok {
+			continue
+		}
+		matched[entity.EntityID] = struct{}{}
+		summary.Entities++
+		if err := c.importSAMLFederationEntity(ctx, writeModel, aggregate, entity, summary); err != nil {
+			samlFederationEntityFailed(summary, entity.EntityID, err)
+		}
+	}
+	for _, entityID := range slices.Sorted(maps.Keys(writeModel.Entities)) {
+		if _, ok := matched[entityID]; ok {
+			continue
+		}
+		if err := c.removeSAMLFederationEntity(ctx, aggregate, entityID, writeModel.Entities[entityID].IDPID); err != nil {
+			samlFederationEntityFailed(summary, entityID, err)
+			continue
+		}
+		summary.Removed++
+	}
+	_, err = c.eventstore.Push(ctx, samlfederation.NewImportSucceededEvent(ctx, aggregate, startedAt, summary, validUntil, nextRunAt))
+	return err
+}
+
+func (c *Commands) samlFederationEntities(ctx context.Context, writeModel *SAMLFederationWriteModel, now time.Time) ([]*domain.SAMLFederationEntity, time.Time, error) {
+	certificate, err := samlFederationSigningCertificate(writeModel.SigningCertificate)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	data, err := saml.FetchFederationMetadata(ctx, c.httpClient, writeModel.MetadataURL)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return saml.ParseFederationMetadata(data, certificate, now)
+}
+
+// importSAMLFederationEntity creates or updates the identity provider of the entity.
+// Identity providers removed manually are created again.
+func (c *Commands) importSAMLFederationEntity(
+	ctx context.Context,
+	writeModel *SAMLFederationWriteModel,
+	aggregate *eventstore.Aggregate,
+	entity *domain.SAMLFederationEntity,
+	summary *domain.SAMLFederationImportSummary,
+) error {
+	instanceAgg := instance.NewAggregate(writeModel.ResourceOwner)
+	provider := samlFederationProvider(writeModel.Template, entity)
+	if linked, ok := writeModel.Entities[entity.EntityID]; ok {
+		cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter,
+			c.prepareUpdateInstanceSAMLProvider(instanceAgg, NewSAMLInstanceIDPWriteModel(writeModel.ResourceOwner, linked.IDPID), provider),
+		)
+		if err == nil {
+			if linked.DisplayName != entity.DisplayName {
+				cmds = append(cmds, samlfederation.NewEntityLinkedEvent(ctx, aggregate, entity.EntityID, linked.IDPID, entity.DisplayName))
+			}
+			if len(cmds) == 0 {
+				return nil
+			}
+			if _, err = c.eventstore.Push(ctx, cmds...); err != nil {
+				return err
+			}
+			summary.Updated++
+			return nil
+		}
+		if !zerrors.IsNotFound(err) {
+			return err
+		}
+	}
+	idpID, err := c.idGenerator.Next()
+	if err != nil {
+		return err
+	}
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter,
+		c.prepareAddInstanceSAMLProvider(instanceAgg, NewSAMLInstanceIDPWriteModel(writeModel.ResourceOwner, idpID), provider),
+	)
+	if err != nil {
+		return err
+	}
+	cmds = append(cmds, samlfederation.NewEntityLinkedEvent(ctx, aggregate, entity.EntityID, idpID, entity.DisplayName))
+	if writeModel.AddToLoginPolicy {
+		cmds = append(cmds, instance.NewIdentityProviderAddedEvent(ctx, &instanceAgg.Aggregate, idpID))
+	}
+	if _, err = c.eventstore.Push(ctx, cmds...); err != nil {
+		return err
+	}
+	summary.Created++
+	return nil
+}
+
+// removeSAMLFederationEntity removes the identity provider of an entity,
+// which was removed from the metadata aggregate or doesn't match the filter anymore.
+func (c *Commands) removeSAMLFederationEntity(ctx context.Context, aggregate *eventstore.Aggregate, entityID, idpID string) error {
+	cmds, err := preparation.PrepareCommands(ctx, c.eventstore.Filter,
+		c.prepareDeleteInstanceProvider(instance.NewAggregate(aggregate.ResourceOwner), idpID),
+	)
+	// the identity provider might have been removed manually
+	if err != nil && !zerrors.IsNotFound(err) {
+		return err
+	}
+	cmds = append(cmds, samlfederation.NewEntityUnlinkedEvent(ctx, aggregate, entityID, idpID))
+	_, err = c.eventstore.Push(ctx, cmds...)
+	return err
+}
+
+func samlFederationProvider(template *samlfederation.IDPTemplate, entity *domain.SAMLFederationEntity) *SAMLProvider {
+	if template == nil {
+		template = new(samlfederation.IDPTemplate)
+	}
+	return &SAMLProvider{
+		Name:                          entity.DisplayName,
+		Metadata:                      entity.Metadata,
+		Binding:                       template.Binding,
+		WithSignedRequest:             template.WithSignedRequest,
+		SignatureAlgorithm:            template.SignatureAlgorithm,
+		NameIDFormat:                  template.NameIDFormat,
+		TransientMappingAttributeName: template.TransientMappingAttributeName,
+		FederatedLogoutEnabled:        template.FederatedLogoutEnabled,
+		IDPOptions:                    template.Options,
+	}
+}
+
+func samlFederationEntityFailed(summary *domain.SAMLFederationImportSummary, entityID string, err error) {
+	logging.WithFields("entity", entityID).WithError(err).Warn("unable to import saml federation entity")
+	summary.Failed++
+	summary.LastError = err.Error()
+}
@@ -0,0 +1,71 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/samlfederation"
+)
+
+type SAMLFederationWriteModel struct {
+	eventstore.WriteModel
+
+	State domain.SAMLFederationState
+	samlfederation.Config
+	// Entities are the entities of the metadata aggregate with an identity provider by their entity id
+	Entities map[string]*SAMLFederationLinkedEntity
+}
+
+type SAMLFederationLinkedEntity struct {
+	IDPID       string
+	DisplayName string
+}
+
+func NewSAMLFederationWriteModel(id, instanceID string) *SAMLFederationWriteModel {
+	return &SAMLFederationWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: instanceID,
+			InstanceID:    instanceID,
+		},
+		Entities: make(map[string]*SAMLFederationLinkedEntity),
+	}
+}
+
+func (wm *SAMLFederationWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *samlfederation.AddedEvent:
+			wm.State = domain.SAMLFederationStateActive
+			wm.Config = e.Config
+		case *samlfederation.ChangedEvent:
+			wm.Config = e.Config
+		case *samlfederation.RemovedEvent:
+			wm.State = domain.SAMLFederationStateRemoved
+			wm.Entities = make(map[string]*SAMLFederationLinkedEntity)
+		case *samlfederation.EntityLinkedEvent:
+			wm.Entities[e.EntityID] = &SAMLFederationLinkedEntity{
+				IDPID:       e.IDPID,
+				DisplayName: e.DisplayName,
+			}
+		case *samlfederation.EntityUnlinkedEvent:
+			delete(wm.Entities, e.EntityID)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *SAMLFederationWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(samlfederation.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			samlfederation.AddedType,
+			samlfederation.ChangedType,
+			samlfederation.RemovedType,
+			samlfederation.EntityLinkedType,
+			samlfederation.EntityUnlinkedType,
+		).
+		Builder()
+}
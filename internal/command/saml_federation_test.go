@@ -0,0 +1,492 @@
+package command
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/idp/providers/saml"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/samlfederation"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const samlFederationTestAggregate = `<EntitiesDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:mdui="urn:oasis:names:tc:SAML:metadata:ui" validUntil="%s">
+  <EntityDescriptor entityID="https://idp.university.example/idp">
+    <IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+      <Extensions>
+        <mdui:UIInfo>
+          <mdui:DisplayName xml:lang="en">University</mdui:DisplayName>
+        </mdui:UIInfo>
+      </Extensions>
+      <SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.university.example/sso"/>
+    </IDPSSODescriptor>
+  </EntityDescriptor>
+  <EntityDescriptor entityID="https://idp.college.example/idp">
+    <IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+      <SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.college.example/sso"/>
+    </IDPSSODescriptor>
+  </EntityDescriptor>
+</EntitiesDescriptor>`
+
+type samlFederationTestKeys struct {
+	keyStore    dsig.X509KeyStore
+	certificate []byte
+}
+
+func newSAMLFederationTestKeys(t *testing.T) *samlFederationTestKeys {
+	keyStore := dsig.RandomKeyStoreForTest()
+	_, der, err := keyStore.GetKeyPair()
+	require.NoError(t, err)
+	return &samlFederationTestKeys{
+		keyStore:    keyStore,
+		certificate: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+func (k *samlFederationTestKeys) signedAggregate(t *testing.T, validUntil time.Time) []byte {
+	doc := etree.NewDocument()
+	require.NoError(t, doc.ReadFromString(fmt.Sprintf(samlFederationTestAggregate, validUntil.UTC().Format(time.RFC3339))))
+	signed, err := dsig.NewDefaultSigningContext(k.keyStore).SignEnveloped(doc.Root())
+	require.NoError(t, err)
+	doc.SetRoot(signed)
+	data, err := doc.WriteToBytes()
+	require.NoError(t, err)
+	return data
+}
+
+// entityMetadata returns the metadata of an entity as it's stored on the identity provider.
+func (k *samlFederationTestKeys) entityMetadata(t *testing.T, aggregate []byte, now time.Time, entityID string) []byte {
+	block, _ := pem.Decode(k.certificate)
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	entities, _, err := saml.ParseFederationMetadata(aggregate, certificate, now)
+	require.NoError(t, err)
+	for _, entity := range entities {
+		if entity.EntityID == entityID {
+			return entity.Metadata
+		}
+	}
+	t.Fatalf("entity %s not found", entityID)
+	return nil
+}
+
+func samlFederationConfig(metadataURL string, certificate []byte) samlfederation.Config {
+	return samlfederation.Config{
+		Name:               "federation",
+		MetadataURL:        metadataURL,
+		SigningCertificate: certificate,
+		Filter: &domain.SAMLFederationFilter{
+			EntityIDs: []string{"https://idp.university.example/idp"},
+		},
+		Interval:         24 * time.Hour,
+		AddToLoginPolicy: true,
+		Template: &samlfederation.IDPTemplate{
+			Binding: "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST",
+			Options: idp.Options{IsLinkingAllowed: true},
+		},
+	}
+}
+
+func TestCommands_AddSAMLFederation(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "admin1")
+	keys := newSAMLFederationTestKeys(t)
+	validFederation := func() *SAMLFederation {
+		config := samlFederationConfig("https://federation.example/metadata.xml", keys.certificate)
+		return &SAMLFederation{
+			Name:               config.Name,
+			MetadataURL:        config.MetadataURL,
+			SigningCertificate: config.SigningCertificate,
+			Filter:             config.Filter,
+			Interval:           config.Interval,
+			AddToLoginPolicy:   config.AddToLoginPolicy,
+			Template:           config.Template,
+		}
+	}
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		idGenerator     id.Generator
+		checkPermission domain.PermissionCheck
+	}
+	type res struct {
+		id   string
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name       string
+		fields     fields
+		federation func() *SAMLFederation
+		res        res
+	}{
+		{
+			name: "name missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			federation: func() *SAMLFederation {
+				federation := validFederation()
+				federation.Name = " "
+				return federation
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid signing certificate, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			federation: func() *SAMLFederation {
+				federation := validFederation()
+				federation.SigningCertificate = []byte("certificate")
+				return federation
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "interval too short, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			federation: func() *SAMLFederation {
+				federation := validFederation()
+				federation.Interval = time.Minute
+				return federation
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid filter, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			federation: func() *SAMLFederation {
+				federation := validFederation()
+				federation.Filter = &domain.SAMLFederationFilter{EntityIDPattern: "("}
+				return federation
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "missing permission, permission denied error",
+			fields: fields{
+				eventstore:      expectEventstore(),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			federation: validFederation,
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectPush(
+						samlfederation.NewAddedEvent(ctx,
+							&samlfederation.NewAggregate("federation1", "instance1").Aggregate,
+							samlFederationConfig("https://federation.example/metadata.xml", keys.certificate),
+						),
+					),
+				),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "federation1"),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			federation: validFederation,
+			res: res{
+				id:   "federation1",
+				want: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				idGenerator:     tt.fields.idGenerator,
+				checkPermission: tt.fields.checkPermission,
+			}
+			id, got, err := c.AddSAMLFederation(ctx, tt.federation())
+			if tt.res.err != nil {
+				assert.True(t, tt.res.err(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.res.id, id)
+			assertObjectDetails(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommands_RemoveSAMLFederation(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "admin1")
+	keys := newSAMLFederationTestKeys(t)
+	federationAgg := &samlfederation.NewAggregate("federation1", "instance1").Aggregate
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		id     string
+		res    res
+	}{
+		{
+			name: "not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			id: "federation1",
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "missing permission, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(samlfederation.NewAddedEvent(ctx, federationAgg, samlFederationConfig("https://federation.example/metadata.xml", keys.certificate))),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			id: "federation1",
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "ok, identity providers removed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(samlfederation.NewAddedEvent(ctx, federationAgg, samlFederationConfig("https://federation.example/metadata.xml", keys.certificate))),
+						eventFromEventPusher(samlfederation.NewEntityLinkedEvent(ctx, federationAgg, "https://idp.college.example/idp", "idp2", "College")),
+						eventFromEventPusher(samlfederation.NewEntityLinkedEvent(ctx, federationAgg, "https://idp.university.example/idp", "idp1", "University")),
+					),
+					// the identity provider of the college was removed manually
+					expectFilter(
+						eventFromEventPusher(samlFederationInstanceIDPAddedEvent(ctx, "idp2", "College", validSAMLMetadata)),
+						eventFromEventPusher(instance.NewIDPRemovedEvent(ctx, &instance.NewAggregate("instance1").Aggregate, "idp2")),
+					),
+					expectFilter(
+						eventFromEventPusher(samlFederationInstanceIDPAddedEvent(ctx, "idp1", "University", validSAMLMetadata)),
+					),
+					expectPush(
+						instance.NewIDPRemovedEvent(ctx, &instance.NewAggregate("instance1").Aggregate, "idp1"),
+						samlfederation.NewRemovedEvent(ctx, federationAgg),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			id: "federation1",
+			res: res{
+				want: &domain.ObjectDetails{ResourceOwner: "instance1"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.RemoveSAMLFederation(ctx, tt.id)
+			if tt.res.err != nil {
+				assert.True(t, tt.res.err(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assertObjectDetails(t, tt.res.want, got)
+		})
+	}
+}
+
+func samlFederationInstanceIDPAddedEvent(ctx context.Context, idpID, name string, metadata []byte) *instance.SAMLIDPAddedEvent {
+	return instance.NewSAMLIDPAddedEvent(ctx, &instance.NewAggregate("instance1").Aggregate,
+		idpID,
+		name,
+		metadata,
+		&crypto.CryptoValue{
+			CryptoType: crypto.TypeEncryption,
+			Algorithm:  "enc",
+			KeyID:      "id",
+			Crypted:    []byte("key"),
+		},
+		[]byte("certificate"),
+		"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST",
+		false,
+		"",
+		nil,
+		"",
+		false,
+		idp.Options{IsLinkingAllowed: true},
+	)
+}
+
+func TestCommands_ImportSAMLFederation(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "admin1")
+	now := time.Now()
+	validUntil := now.Add(24 * time.Hour).Truncate(time.Second)
+	keys := newSAMLFederationTestKeys(t)
+	aggregate := keys.signedAggregate(t, validUntil)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metadata.xml" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(aggregate)
+	}))
+	defer server.Close()
+	universityMetadata := keys.entityMetadata(t, aggregate, now, "https://idp.university.example/idp")
+	federationAgg := &samlfederation.NewAggregate("federation1", "instance1").Aggregate
+	instanceAgg := &instance.NewAggregate("instance1").Aggregate
+
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		err    func(error) bool
+	}{
+		{
+			name: "not found, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			err: zerrors.IsNotFound,
+		},
+		{
+			name: "metadata unavailable, import failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(samlfederation.NewAddedEvent(ctx, federationAgg, samlFederationConfig(server.URL+"/unknown.xml", keys.certificate))),
+					),
+					expectPush(
+						samlfederation.NewImportFailedEvent(ctx, federationAgg, now, "ID=SAML-Fd8fS Message=Errors.IDP.SAMLFederation.MetadataUnavailable", now.Add(24*time.Hour)),
+					),
+				),
+			},
+		},
+		{
+			name: "signed by other key, import failed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(samlfederation.NewAddedEvent(ctx, federationAgg, samlFederationConfig(server.URL+"/metadata.xml", newSAMLFederationTestKeys(t).certificate))),
+					),
+					expectPush(
+						samlfederation.NewImportFailedEvent(ctx, federationAgg, now, "ID=SAML-Fp9fS Message=Errors.IDP.SAMLFederation.SignatureInvalid Parent=(Could not verify certificate against trusted certs)", now.Add(24*time.Hour)),
+					),
+				),
+			},
+		},
+		{
+			name: "ok, entity created and unlisted entity removed",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(samlfederation.NewAddedEvent(ctx, federationAgg, samlFederationConfig(server.URL+"/metadata.xml", keys.certificate))),
+						eventFromEventPusher(samlfederation.NewEntityLinkedEvent(ctx, federationAgg, "https://idp.old.example/idp", "idp0", "Old")),
+					),
+					expectFilter(),
+					expectPush(
+						samlFederationInstanceIDPAddedEvent(ctx, "idp1", "University", universityMetadata),
+						samlfederation.NewEntityLinkedEvent(ctx, federationAgg, "https://idp.university.example/idp", "idp1", "University"),
+						instance.NewIdentityProviderAddedEvent(ctx, instanceAgg, "idp1"),
+					),
+					expectFilter(
+						eventFromEventPusher(samlFederationInstanceIDPAddedEvent(ctx, "idp0", "Old", validSAMLMetadata)),
+					),
+					expectPush(
+						instance.NewIDPRemovedEvent(ctx, instanceAgg, "idp0"),
+						samlfederation.NewEntityUnlinkedEvent(ctx, federationAgg, "https://idp.old.example/idp", "idp0"),
+					),
+					expectPush(
+						samlfederation.NewImportSucceededEvent(ctx, federationAgg, now, &domain.SAMLFederationImportSummary{
+							Entities: 1,
+							Created:  1,
+							Removed:  1,
+						}, validUntil, now.Add(24*time.Hour)),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "idp1"),
+			},
+		},
+		{
+			name: "ok, linked entity unchanged",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(samlfederation.NewAddedEvent(ctx, federationAgg, samlFederationConfig(server.URL+"/metadata.xml", keys.certificate))),
+						eventFromEventPusher(samlfederation.NewEntityLinkedEvent(ctx, federationAgg, "https://idp.university.example/idp", "idp1", "University")),
+					),
+					expectFilter(
+						eventFromEventPusher(samlFederationInstanceIDPAddedEvent(ctx, "idp1", "University", universityMetadata)),
+					),
+					expectPush(
+						samlfederation.NewImportSucceededEvent(ctx, federationAgg, now, &domain.SAMLFederationImportSummary{
+							Entities: 1,
+						}, validUntil, now.Add(24*time.Hour)),
+					),
+				),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:                     tt.fields.eventstore(t),
+				idGenerator:                    tt.fields.idGenerator,
+				httpClient:                     server.Client(),
+				idpConfigEncryption:            crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				samlCertificateAndKeyGenerator: func(id string) ([]byte, []byte, error) { return []byte("key"), []byte("certificate"), nil },
+			}
+			err := c.ImportSAMLFederation(ctx, "federation1", now)
+			if tt.err != nil {
+				assert.True(t, tt.err(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
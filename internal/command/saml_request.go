@@ -89,6 +89,9 @@ func (c *Commands) LinkSessionToSAMLRequest(ctx context.Context, id, sessionID,
 	if err = sessionWriteModel.CheckIsActive(); err != nil {
 		return nil, nil, err
 	}
+	if err = sessionWriteModel.CheckRisk(); err != nil {
+		return nil, nil, err
+	}
 	if err := c.sessionTokenVerifier(ctx, sessionToken, sessionWriteModel.AggregateID, sessionWriteModel.TokenID); err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,193 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+// The commands in this file are executed by the scheduled access worker
+// and therefore don't check any permission.
+// They verify on the current state, that the membership or grant is still due,
+// as it might have been changed after the worker searched for it.
+
+// ExpireInstanceMember removes the membership of the user on the instance if its validity ended.
+func (c *Commands) ExpireInstanceMember(ctx context.Context, instanceID, userID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existingMember, err := c.instanceMemberWriteModelByID(ctx, instanceID, userID)
+	if err != nil {
+		return err
+	}
+	if !existingMember.State.Exists() || !isDue(existingMember.ValidUntil) {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx,
+		c.removeInstanceMember(ctx, InstanceAggregateFromWriteModel(&existingMember.WriteModel), userID, false),
+	)
+	return err
+}
+
+// ExpireOrgMember removes the membership of the user on the organization if its validity ended.
+func (c *Commands) ExpireOrgMember(ctx context.Context, orgID, userID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existingMember, err := c.orgMemberWriteModelByID(ctx, orgID, userID)
+	if err != nil {
+		return err
+	}
+	if !existingMember.State.Exists() || !isDue(existingMember.ValidUntil) {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx,
+		c.removeOrgMember(ctx, OrgAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel), userID, false),
+	)
+	return err
+}
+
+// ExpireProjectMember removes the membership of the user on the project if its validity ended.
+func (c *Commands) ExpireProjectMember(ctx context.Context, projectID, userID, resourceOwner string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existingMember, err := c.projectMemberWriteModelByID(ctx, projectID, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if !existingMember.State.Exists() || !isDue(existingMember.ValidUntil) {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx,
+		c.removeProjectMember(ctx, ProjectAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel), userID, false),
+	)
+	return err
+}
+
+// ExpireProjectGrantMember removes the membership of the user on the project grant if its validity ended.
+func (c *Commands) ExpireProjectGrantMember(ctx context.Context, projectID, grantID, userID, resourceOwner string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existingMember, err := c.projectGrantMemberWriteModelByID(ctx, projectID, userID, grantID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if !existingMember.State.Exists() || !isDue(existingMember.ValidUntil) {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx,
+		c.removeProjectGrantMember(ctx, ProjectAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel), userID, grantID, false),
+	)
+	return err
+}
+
+// ExpireApplicationMember removes the membership of the user on the application if its validity ended.
+func (c *Commands) ExpireApplicationMember(ctx context.Context, projectID, appID, userID, resourceOwner string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existingMember, err := c.applicationMemberWriteModelByID(ctx, projectID, appID, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if !existingMember.State.Exists() || !isDue(existingMember.ValidUntil) {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx,
+		project.NewApplicationMemberRemovedEvent(ctx, ProjectAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel), appID, userID),
+	)
+	return err
+}
+
+// ExpireProjectRoleMember removes the membership of the user on the project role if its validity ended.
+func (c *Commands) ExpireProjectRoleMember(ctx context.Context, projectID, roleKey, userID, resourceOwner string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existingMember, err := c.projectRoleMemberWriteModelByID(ctx, projectID, roleKey, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if !existingMember.State.Exists() || !isDue(existingMember.ValidUntil) {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx,
+		project.NewRoleMemberRemovedEvent(ctx, ProjectAggregateFromWriteModelWithCTX(ctx, &existingMember.WriteModel), roleKey, userID),
+	)
+	return err
+}
+
+// ActivateScheduledUserGrant activates a grant which was added with a validity starting in the future.
+// Grants of which the schedule was cancelled by a deactivation stay inactive.
+func (c *Commands) ActivateScheduledUserGrant(ctx context.Context, grantID, resourceOwner string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if existingUserGrant.State != domain.UserGrantStateInactive || !isDue(existingUserGrant.ValidFrom) {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx,
+		usergrant.NewUserGrantReactivatedEvent(ctx, UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel)),
+	)
+	return err
+}
+
+// ExpireUserGrant removes the grant if its validity ended.
+func (c *Commands) ExpireUserGrant(ctx context.Context, grantID, resourceOwner string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	existingUserGrant, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if existingUserGrant.State == domain.UserGrantStateUnspecified ||
+		existingUserGrant.State == domain.UserGrantStateRemoved ||
+		!isDue(existingUserGrant.ValidUntil) {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx,
+		usergrant.NewUserGrantRemovedEvent(
+			ctx,
+			UserGrantAggregateFromWriteModel(&existingUserGrant.WriteModel),
+			existingUserGrant.UserID,
+			existingUserGrant.ProjectID,
+			existingUserGrant.ProjectGrantID,
+		),
+	)
+	return err
+}
+
+func isDue(at *time.Time) bool {
+	return at != nil && !at.After(time.Now())
+}
+
+// changedMemberValidity returns the validity of a membership after a change.
+// The current validity is kept, unless a new one is set or it is cleared explicitly.
+// The validity must always be part of the change event, as the fields of the membership are recreated.
+func changedMemberValidity(current, validUntil *time.Time, permanent bool) *time.Time {
+	if permanent {
+		return nil
+	}
+	if validUntil != nil {
+		return validUntil
+	}
+	return current
+}
+
+func equalValidity(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
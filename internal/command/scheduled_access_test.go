@@ -0,0 +1,424 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+func TestCommandSide_ExpireInstanceMember(t *testing.T) {
+	t.Parallel()
+	memberAdded := func(validUntil *time.Time) *instance.MemberAddedEvent {
+		event := instance.NewMemberAddedEvent(context.Background(),
+			&instance.NewAggregate("INSTANCE").Aggregate,
+			"user1",
+			"IAM_OWNER",
+		)
+		event.ValidUntil = validUntil
+		return event
+	}
+	expired := time.Now().Add(-time.Minute)
+	valid := time.Now().Add(time.Hour)
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}{
+		{
+			name: "member not existing, no change",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+		},
+		{
+			name: "permanent member, no change",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(memberAdded(nil)),
+				),
+			),
+		},
+		{
+			name: "member still valid, no change",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(memberAdded(&valid)),
+				),
+			),
+		},
+		{
+			name: "member expired, removed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(memberAdded(&expired)),
+				),
+				expectPush(
+					instance.NewMemberRemovedEvent(context.Background(),
+						&instance.NewAggregate("INSTANCE").Aggregate,
+						"user1",
+					),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.ExpireInstanceMember(context.Background(), "INSTANCE", "user1")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCommandSide_ExpireOrgMember(t *testing.T) {
+	t.Parallel()
+	expired := time.Now().Add(-time.Minute)
+	memberAdded := org.NewMemberAddedEvent(context.Background(),
+		&org.NewAggregate("org1").Aggregate,
+		"user1",
+		"ORG_OWNER",
+	)
+	memberAdded.ValidUntil = &expired
+	memberChanged := org.NewMemberChangedEvent(context.Background(),
+		&org.NewAggregate("org1").Aggregate,
+		"user1",
+		"ORG_OWNER",
+	)
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}{
+		{
+			name: "validity removed, no change",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(memberAdded),
+					eventFromEventPusher(memberChanged),
+				),
+			),
+		},
+		{
+			name: "member expired, removed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(memberAdded),
+				),
+				expectPush(
+					org.NewMemberRemovedEvent(context.Background(),
+						&org.NewAggregate("org1").Aggregate,
+						"user1",
+					),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.ExpireOrgMember(context.Background(), "org1", "user1")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCommandSide_ExpireProjectMember(t *testing.T) {
+	t.Parallel()
+	expired := time.Now().Add(-time.Minute)
+	memberAdded := project.NewProjectMemberAddedEvent(context.Background(),
+		&project.NewAggregate("project1", "org1").Aggregate,
+		"user1",
+		"PROJECT_OWNER",
+	)
+	memberAdded.ValidUntil = &expired
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}{
+		{
+			name: "member not existing, no change",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+		},
+		{
+			name: "member expired, removed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(memberAdded),
+				),
+				expectPush(
+					project.NewProjectMemberRemovedEvent(context.Background(),
+						&project.NewAggregate("project1", "org1").Aggregate,
+						"user1",
+					),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.ExpireProjectMember(context.Background(), "project1", "user1", "org1")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCommandSide_ExpireProjectGrantMember(t *testing.T) {
+	t.Parallel()
+	expired := time.Now().Add(-time.Minute)
+	valid := time.Now().Add(time.Hour)
+	memberAdded := func(validUntil *time.Time) *project.GrantMemberAddedEvent {
+		event := project.NewProjectGrantMemberAddedEvent(context.Background(),
+			&project.NewAggregate("project1", "org1").Aggregate,
+			"user1",
+			"projectgrant1",
+			"PROJECT_GRANT_OWNER",
+		)
+		event.ValidUntil = validUntil
+		return event
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}{
+		{
+			name: "member still valid, no change",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(memberAdded(&valid)),
+				),
+			),
+		},
+		{
+			name: "grant removed, no change",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(memberAdded(&expired)),
+					eventFromEventPusher(project.NewGrantRemovedEvent(context.Background(),
+						&project.NewAggregate("project1", "org1").Aggregate,
+						"projectgrant1",
+						"org2",
+					)),
+				),
+			),
+		},
+		{
+			name: "member expired, removed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(memberAdded(&expired)),
+				),
+				expectPush(
+					project.NewProjectGrantMemberRemovedEvent(context.Background(),
+						&project.NewAggregate("project1", "org1").Aggregate,
+						"user1",
+						"projectgrant1",
+					),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.ExpireProjectGrantMember(context.Background(), "project1", "projectgrant1", "user1", "org1")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCommandSide_ExpireApplicationMember(t *testing.T) {
+	t.Parallel()
+	expired := time.Now().Add(-time.Minute)
+	memberAdded := project.NewApplicationMemberAddedEvent(context.Background(),
+		&project.NewAggregate("project1", "org1").Aggregate,
+		"app1",
+		"user1",
+		"PROJECT_APPLICATION_OWNER",
+	)
+	memberAdded.ValidUntil = &expired
+	memberChanged := project.NewApplicationMemberChangedEvent(context.Background(),
+		&project.NewAggregate("project1", "org1").Aggregate,
+		"app1",
+		"user1",
+		"PROJECT_APPLICATION_OWNER",
+	)
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}{
+		{
+			name: "validity removed, no change",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(memberAdded),
+					eventFromEventPusher(memberChanged),
+				),
+			),
+		},
+		{
+			name: "member expired, removed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(memberAdded),
+				),
+				expectPush(
+					project.NewApplicationMemberRemovedEvent(context.Background(),
+						&project.NewAggregate("project1", "org1").Aggregate,
+						"app1",
+						"user1",
+					),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.ExpireApplicationMember(context.Background(), "project1", "app1", "user1", "org1")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCommandSide_ActivateScheduledUserGrant(t *testing.T) {
+	t.Parallel()
+	grantAdded := func(validFrom time.Time) *usergrant.UserGrantAddedEvent {
+		event := usergrant.NewUserGrantAddedEvent(context.Background(),
+			&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+			"user1",
+			"project1",
+			"",
+			[]string{"rolekey1"},
+		)
+		event.ValidFrom = &validFrom
+		return event
+	}
+	grantDeactivated := usergrant.NewUserGrantDeactivatedEvent(context.Background(),
+		&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+	)
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}{
+		{
+			name: "not due, no change",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(grantAdded(time.Now().Add(time.Hour))),
+					eventFromEventPusher(grantDeactivated),
+				),
+			),
+		},
+		{
+			name: "schedule cancelled, no change",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(grantAdded(time.Now().Add(-time.Minute))),
+					eventFromEventPusher(grantDeactivated),
+					eventFromEventPusher(scheduleCancelledUserGrantDeactivatedEvent()),
+				),
+			),
+		},
+		{
+			name: "due, reactivated",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(grantAdded(time.Now().Add(-time.Minute))),
+					eventFromEventPusher(grantDeactivated),
+				),
+				expectPush(
+					usergrant.NewUserGrantReactivatedEvent(context.Background(),
+						&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+					),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.ActivateScheduledUserGrant(context.Background(), "usergrant1", "org1")
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCommandSide_ExpireUserGrant(t *testing.T) {
+	t.Parallel()
+	grantAdded := func(validUntil time.Time) *usergrant.UserGrantAddedEvent {
+		event := usergrant.NewUserGrantAddedEvent(context.Background(),
+			&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+			"user1",
+			"project1",
+			"",
+			[]string{"rolekey1"},
+		)
+		event.ValidUntil = &validUntil
+		return event
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}{
+		{
+			name: "grant not existing, no change",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+		},
+		{
+			name: "still valid, no change",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(grantAdded(time.Now().Add(time.Hour))),
+				),
+			),
+		},
+		{
+			name: "expired, removed",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(grantAdded(time.Now().Add(-time.Minute))),
+				),
+				expectPush(
+					usergrant.NewUserGrantRemovedEvent(context.Background(),
+						&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+						"user1",
+						"project1",
+						"",
+					),
+				),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.ExpireUserGrant(context.Background(), "usergrant1", "org1")
+			require.NoError(t, err)
+		})
+	}
+}
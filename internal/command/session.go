@@ -40,10 +40,14 @@ type SessionCommands struct {
 	createCode           encryptedCodeWithDefaultFunc
 	createPhoneCode      encryptedCodeGeneratorWithDefaultFunc
 	createToken          func(sessionID string) (id string, token string, err error)
+	createPushChallenge  func() (challenge, code string, err error)
+	createX509Challenge  func() (challenge string, err error)
 	getCodeVerifier      func(ctx context.Context, id string) (senders.CodeGenerator, error)
 	now                  func() time.Time
 	maxIdPIntentLifetime time.Duration
 	tarpit               func(failedAttempts uint64)
+
+	idpClaimMappings func(ctx context.Context, idpID, userID string, claims map[string][]string) ([]eventstore.Command, error)
 }
 
 func (c *Commands) NewSessionCommands(cmds []SessionCommand, session *SessionWriteModel) *SessionCommands {
@@ -58,10 +62,13 @@ func (c *Commands) NewSessionCommands(cmds []SessionCommand, session *SessionWri
 		createCode:           c.newEncryptedCodeWithDefault,
 		createPhoneCode:      c.newPhoneCode,
 		createToken:          c.sessionTokenCreator,
+		createPushChallenge:  domain.NewPushChallenge,
+		createX509Challenge:  domain.NewX509Challenge,
 		getCodeVerifier:      c.phoneCodeVerifierFromConfig,
 		now:                  time.Now,
 		maxIdPIntentLifetime: c.maxIdPIntentLifetime,
 		tarpit:               c.tarpit,
+		idpClaimMappings:     c.idpClaimMappingsCommands,
 	}
 }
 
@@ -121,12 +128,26 @@ func CheckIntent(intentID, token string) SessionCommand {
 			if linkWriteModel.State != domain.UserIDPLinkStateActive {
 				return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-O8xk3w", "Errors.Intent.OtherUser")
 			}
+			// the external user was linked after the intent succeeded (e.g. on the first login),
+			// so the claim mappings could not be applied yet
+			cmd.applyIDPClaimMappings(ctx)
 		}
 		cmd.IntentChecked(ctx, cmd.now())
 		return nil, nil
 	}
 }
 
+// applyIDPClaimMappings applies the claim mappings with the claims of the checked intent.
+// The authentication already succeeded, so failures are only logged and the mappings are applied on the next login.
+func (s *SessionCommands) applyIDPClaimMappings(ctx context.Context) {
+	cmds, err := s.idpClaimMappings(ctx, s.intentWriteModel.IDPID, s.sessionWriteModel.UserID, idpIntentUserClaims(s.intentWriteModel))
+	if err != nil {
+		logging.WithFields("idp", s.intentWriteModel.IDPID, "user", s.sessionWriteModel.UserID).WithError(err).Warn("unable to apply idp claim mappings")
+		return
+	}
+	s.eventCommands = append(s.eventCommands, cmds...)
+}
+
 func CheckTOTP(code string) SessionCommand {
 	return func(ctx context.Context, cmd *SessionCommands) (_ []eventstore.Command, err error) {
 		commands, err := checkTOTP(
@@ -161,6 +182,8 @@ func (s *SessionCommands) Exec(ctx context.Context) ([]eventstore.Command, error
 
 func (s *SessionCommands) Start(ctx context.Context, userAgent *domain.UserAgent) {
 	s.eventCommands = append(s.eventCommands, session.NewAddedEvent(ctx, s.sessionWriteModel.aggregate, userAgent))
+	// set the user agent so the risk of the checks can be evaluated
+	s.sessionWriteModel.UserAgent = userAgent
 }
 
 func (s *SessionCommands) UserChecked(ctx context.Context, userID, resourceOwner string, checkedAt time.Time, preferredLanguage *language.Tag) error {
@@ -223,6 +246,22 @@ func (s *SessionCommands) RecoveryCodeChecked(ctx context.Context, checkedAt tim
 	s.eventCommands = append(s.eventCommands, session.NewRecoveryCodeCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
 }
 
+func (s *SessionCommands) PushChallenged(ctx context.Context, challenge, code string, expiry time.Duration, deviceIDs []string, appName, ip, location string) {
+	s.eventCommands = append(s.eventCommands, session.NewPushChallengedEvent(ctx, s.sessionWriteModel.aggregate, challenge, code, expiry, s.sessionWriteModel.UserID, deviceIDs, appName, ip, location))
+}
+
+func (s *SessionCommands) PushChecked(ctx context.Context, checkedAt time.Time) {
+	s.eventCommands = append(s.eventCommands, session.NewPushCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
+}
+
+func (s *SessionCommands) X509Challenged(ctx context.Context, challenge string, expiry time.Duration) {
+	s.eventCommands = append(s.eventCommands, session.NewX509ChallengedEvent(ctx, s.sessionWriteModel.aggregate, challenge, expiry))
+}
+
+func (s *SessionCommands) X509Checked(ctx context.Context, checkedAt time.Time, fingerprint string) {
+	s.eventCommands = append(s.eventCommands, session.NewX509CheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt, fingerprint))
+}
+
 func (s *SessionCommands) SetToken(ctx context.Context, tokenID string) {
 	// trigger activity log for session for user
 	activity.Trigger(ctx, s.sessionWriteModel.UserResourceOwner, s.sessionWriteModel.UserID, activity.SessionAPI, s.eventstore.FilterToQueryReducer)
@@ -379,9 +418,13 @@ func (c *Commands) updateSession(ctx context.Context, checks *SessionCommands, m
 		if len(cmds) > 0 {
 			_, pushErr := c.eventstore.Push(ctx, cmds...)
 			logging.OnError(pushErr).Error("unable to store check failures")
+			c.recordSessionCheckFailure(checks)
 		}
 		return nil, err
 	}
+	if err = c.evaluateSessionRisk(ctx, checks); err != nil {
+		return nil, err
+	}
 	checks.ChangeMetadata(ctx, metadata)
 	err = checks.SetLifetime(ctx, lifetime)
 	if err != nil {
@@ -27,6 +27,40 @@ type OTPCode struct {
 	VerificationID string
 }
 
+// PushChallengeModel is the pending approval of the session on one of the push devices of the user.
+type PushChallengeModel struct {
+	Challenge    string
+	Code         string
+	Expiry       time.Duration
+	CreationDate time.Time
+	UserID       string
+	DeviceIDs    []string
+	ApprovedBy   string
+	RejectedBy   string
+}
+
+// Responded returns true if one of the devices already approved or rejected the challenge.
+func (p *PushChallengeModel) Responded() bool {
+	return p.ApprovedBy != "" || p.RejectedBy != ""
+}
+
+// Expired returns true if the challenge can no longer be approved.
+func (p *PushChallengeModel) Expired(now time.Time) bool {
+	return now.After(p.CreationDate.Add(p.Expiry))
+}
+
+// X509ChallengeModel is the pending proof of possession of the private key of a client certificate.
+type X509ChallengeModel struct {
+	Challenge    string
+	Expiry       time.Duration
+	CreationDate time.Time
+}
+
+// Expired returns true if the challenge can no longer be signed.
+func (p *X509ChallengeModel) Expired(now time.Time) bool {
+	return now.After(p.CreationDate.Add(p.Expiry))
+}
+
 func (p *WebAuthNChallengeModel) WebAuthNLogin(human *domain.Human, credentialAssertionData []byte) *domain.WebAuthNLogin {
 	return &domain.WebAuthNLogin{
 		ObjectRoot:              human.ObjectRoot,
@@ -53,15 +87,21 @@ type SessionWriteModel struct {
 	OTPSMSCheckedAt       time.Time
 	OTPEmailCheckedAt     time.Time
 	RecoveryCodeCheckedAt time.Time
+	PushCheckedAt         time.Time
+	X509CheckedAt         time.Time
 	WebAuthNUserVerified  bool
 	Metadata              map[string][]byte
 	State                 domain.SessionState
 	UserAgent             *domain.UserAgent
 	Expiration            time.Time
+	// Risk is the highest risk evaluated for the checks of the session, nil if it was never evaluated.
+	Risk *domain.SessionRisk
 
 	WebAuthNChallenge     *WebAuthNChallengeModel
 	OTPSMSCodeChallenge   *OTPCode
 	OTPEmailCodeChallenge *OTPCode
+	PushChallenge         *PushChallengeModel
+	X509Challenge         *X509ChallengeModel
 	aggregate             *eventstore.Aggregate
 }
 
@@ -110,6 +150,20 @@ func (wm *SessionWriteModel) Reduce() error {
 			wm.reduceTerminate()
 		case *session.RecoveryCodeCheckedEvent:
 			wm.reduceRecoveryCodeChecked(e)
+		case *session.PushChallengedEvent:
+			wm.reducePushChallenged(e)
+		case *session.PushApprovedEvent:
+			wm.reducePushApproved(e)
+		case *session.PushRejectedEvent:
+			wm.reducePushRejected(e)
+		case *session.PushCheckedEvent:
+			wm.reducePushChecked(e)
+		case *session.X509ChallengedEvent:
+			wm.reduceX509Challenged(e)
+		case *session.X509CheckedEvent:
+			wm.reduceX509Checked(e)
+		case *session.RiskEvaluatedEvent:
+			wm.reduceRiskEvaluated(e)
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -134,6 +188,13 @@ func (wm *SessionWriteModel) Query() *eventstore.SearchQueryBuilder {
 			session.OTPEmailChallengedType,
 			session.OTPEmailCheckedType,
 			session.RecoveryCodeCheckedType,
+			session.PushChallengedType,
+			session.PushApprovedType,
+			session.PushRejectedType,
+			session.PushCheckedType,
+			session.X509ChallengedType,
+			session.X509CheckedType,
+			session.RiskEvaluatedType,
 			session.TokenSetType,
 			session.MetadataSetType,
 			session.LifetimeSetType,
@@ -234,6 +295,57 @@ func (wm *SessionWriteModel) reduceRecoveryCodeChecked(e *session.RecoveryCodeCh
 	wm.RecoveryCodeCheckedAt = e.CheckedAt
 }
 
+func (wm *SessionWriteModel) reducePushChallenged(e *session.PushChallengedEvent) {
+	wm.PushChallenge = &PushChallengeModel{
+		Challenge:    e.Challenge,
+		Code:         e.Code,
+		Expiry:       e.Expiry,
+		CreationDate: e.CreationDate(),
+		UserID:       e.UserID,
+		DeviceIDs:    e.DeviceIDs,
+	}
+}
+
+func (wm *SessionWriteModel) reducePushApproved(e *session.PushApprovedEvent) {
+	if wm.PushChallenge == nil {
+		return
+	}
+	wm.PushChallenge.ApprovedBy = e.DeviceID
+}
+
+func (wm *SessionWriteModel) reducePushRejected(e *session.PushRejectedEvent) {
+	if wm.PushChallenge == nil {
+		return
+	}
+	wm.PushChallenge.RejectedBy = e.DeviceID
+}
+
+func (wm *SessionWriteModel) reducePushChecked(e *session.PushCheckedEvent) {
+	wm.PushChallenge = nil
+	wm.PushCheckedAt = e.CheckedAt
+}
+
+func (wm *SessionWriteModel) reduceX509Challenged(e *session.X509ChallengedEvent) {
+	wm.X509Challenge = &X509ChallengeModel{
+		Challenge:    e.Challenge,
+		Expiry:       e.Expiry,
+		CreationDate: e.CreationDate(),
+	}
+}
+
+func (wm *SessionWriteModel) reduceX509Checked(e *session.X509CheckedEvent) {
+	wm.X509Challenge = nil
+	wm.X509CheckedAt = e.CheckedAt
+}
+
+func (wm *SessionWriteModel) reduceRiskEvaluated(e *session.RiskEvaluatedEvent) {
+	wm.Risk = &domain.SessionRisk{
+		Score:   e.Score,
+		Signals: e.Signals,
+		Action:  e.Action,
+	}
+}
+
 // AuthenticationTime returns the time the user authenticated using the latest time of all checks
 func (wm *SessionWriteModel) AuthenticationTime() time.Time {
 	var authTime time.Time
@@ -244,6 +356,8 @@ func (wm *SessionWriteModel) AuthenticationTime() time.Time {
 		wm.IntentCheckedAt,
 		wm.OTPSMSCheckedAt,
 		wm.OTPEmailCheckedAt,
+		wm.PushCheckedAt,
+		wm.X509CheckedAt,
 	} {
 		if check.After(authTime) {
 			authTime = check
@@ -280,6 +394,12 @@ func (wm *SessionWriteModel) AuthMethodTypes() []domain.UserAuthMethodType {
 	if !wm.RecoveryCodeCheckedAt.IsZero() {
 		types = append(types, domain.UserAuthMethodTypeRecoveryCode)
 	}
+	if !wm.PushCheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypePush)
+	}
+	if !wm.X509CheckedAt.IsZero() {
+		types = append(types, domain.UserAuthMethodTypeX509Certificate)
+	}
 	return types
 }
 
@@ -302,3 +422,17 @@ func (wm *SessionWriteModel) CheckIsActive() error {
 	}
 	return wm.CheckNotInvalidated()
 }
+
+// CheckRisk checks that a second factor was checked, if the risk evaluated for the session requires it.
+func (wm *SessionWriteModel) CheckRisk() error {
+	if wm.Risk == nil || wm.Risk.Action < domain.RiskActionRequireMFA {
+		return nil
+	}
+	if wm.Risk.Action == domain.RiskActionBlock {
+		return zerrors.ThrowPermissionDenied(nil, "COMMAND-Rsk4b", "Errors.Session.RiskBlocked")
+	}
+	if !domain.HasMFA(wm.AuthMethodTypes()) {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Rsk2m", "Errors.Session.RiskMFARequired")
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package command
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// CreatePushChallenge requests the approval of the session on the verified push devices of the user.
+// The appName and location are displayed on the device to give the user the context of the login.
+// The number matching code is returned in dst and has to be displayed to the user, who enters it on the device.
+func (c *Commands) CreatePushChallenge(appName, location string, dst *string) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		if cmd.sessionWriteModel.UserID == "" {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5cU", "Errors.User.UserIDMissing")
+		}
+		writeModel := NewHumanPushDevicesWriteModel(cmd.sessionWriteModel.UserID, "")
+		if err := cmd.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+			return nil, err
+		}
+		devices := writeModel.ReadyDevices()
+		if len(devices) == 0 {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5cN", "Errors.User.MFA.Push.NotReady")
+		}
+		deviceIDs := make([]string, len(devices))
+		for i, device := range devices {
+			deviceIDs[i] = device.DeviceID
+		}
+		challenge, code, err := cmd.createPushChallenge()
+		if err != nil {
+			return nil, err
+		}
+		var ip string
+		if userAgent := cmd.sessionWriteModel.UserAgent; userAgent != nil && len(userAgent.IP) > 0 {
+			ip = userAgent.IP.String()
+		}
+		*dst = code
+		cmd.PushChallenged(ctx, challenge, code, c.multifactors.Push.ChallengeLifetime, deviceIDs, appName, ip, location)
+		return nil, nil
+	}
+}
+
+// CheckPush defines a check for an approved push challenge to be executed for a session update.
+// As long as no device responded to the challenge, the check fails with a precondition error and can be repeated.
+func CheckPush() SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		challenge := cmd.sessionWriteModel.PushChallenge
+		if challenge == nil {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5kN", "Errors.Session.Push.NoChallenge")
+		}
+		if challenge.RejectedBy != "" {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5kR", "Errors.Session.Push.Rejected")
+		}
+		if challenge.ApprovedBy == "" {
+			if challenge.Expired(cmd.now()) {
+				return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5kE", "Errors.Session.Push.Expired")
+			}
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5kP", "Errors.Session.Push.Pending")
+		}
+		cmd.PushChecked(ctx, cmd.now())
+		return nil, nil
+	}
+}
+
+// PushSent records the delivery of the push challenge to the device.
+func (c *Commands) PushSent(ctx context.Context, sessionID, instanceID, challenge, deviceID string) error {
+	sessionWriteModel := NewSessionWriteModel(sessionID, instanceID)
+	if err := c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel); err != nil {
+		return err
+	}
+	if sessionWriteModel.PushChallenge == nil || sessionWriteModel.PushChallenge.Challenge != challenge {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5sN", "Errors.Session.Push.NoChallenge")
+	}
+	return c.pushAppendAndReduce(ctx, sessionWriteModel,
+		session.NewPushSentEvent(ctx, &session.NewAggregate(sessionID, sessionWriteModel.ResourceOwner).Aggregate, challenge, deviceID),
+	)
+}
+
+type PushChallengeResponse struct {
+	SessionID string
+	DeviceID  string
+	// Code is the number matching code entered by the user, it's only required for an approval
+	Code     string
+	Approved bool
+	// Signature of [domain.PushApprovalData] created with the private key of the device
+	Signature []byte
+}
+
+// RespondToPushChallenge approves or rejects the push challenge of a session.
+// It's called by the device itself, which authenticates with its signature, so no permission is checked.
+// An approval with a wrong number matching code rejects the challenge.
+func (c *Commands) RespondToPushChallenge(ctx context.Context, response *PushChallengeResponse) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if response.SessionID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu5pS", "Errors.Session.IDMissing")
+	}
+	if response.DeviceID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu5pI", "Errors.User.MFA.Push.IDMissing")
+	}
+	sessionWriteModel := NewSessionWriteModel(response.SessionID, authz.GetInstance(ctx).InstanceID())
+	if err = c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel); err != nil {
+		return nil, err
+	}
+	if err = sessionWriteModel.CheckIsActive(); err != nil {
+		return nil, err
+	}
+	challenge := sessionWriteModel.PushChallenge
+	if challenge == nil || !slices.Contains(challenge.DeviceIDs, response.DeviceID) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5pN", "Errors.Session.Push.NoChallenge")
+	}
+	if challenge.Responded() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5pA", "Errors.Session.Push.AlreadyResponded")
+	}
+	if challenge.Expired(time.Now()) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5pE", "Errors.Session.Push.Expired")
+	}
+	devicesWriteModel, err := c.pushDevicesWriteModel(ctx, challenge.UserID, "")
+	if err != nil {
+		return nil, err
+	}
+	device := devicesWriteModel.Device(response.DeviceID)
+	if device == nil || device.State != domain.MFAStateReady {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pu5pD", "Errors.User.MFA.Push.NotExisting")
+	}
+	data := domain.PushApprovalData(response.SessionID, challenge.Challenge, response.Code, response.Approved)
+	if err = domain.VerifyPushSignature(device.PublicKey, data, response.Signature); err != nil {
+		return nil, err
+	}
+	sessionAgg := &session.NewAggregate(response.SessionID, sessionWriteModel.ResourceOwner).Aggregate
+	if !response.Approved {
+		if err = c.pushAppendAndReduce(ctx, sessionWriteModel, session.NewPushRejectedEvent(ctx, sessionAgg, response.DeviceID)); err != nil {
+			return nil, err
+		}
+		return writeModelToObjectDetails(&sessionWriteModel.WriteModel), nil
+	}
+	if response.Code != challenge.Code {
+		// a wrong code indicates that the user didn't start the login, so the challenge is rejected
+		if err = c.pushAppendAndReduce(ctx, sessionWriteModel, session.NewPushRejectedEvent(ctx, sessionAgg, response.DeviceID)); err != nil {
+			return nil, err
+		}
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu5pC", "Errors.Session.Push.CodeInvalid")
+	}
+	if err = c.pushAppendAndReduce(ctx, sessionWriteModel, session.NewPushApprovedEvent(ctx, sessionAgg, response.DeviceID)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&sessionWriteModel.WriteModel), nil
+}
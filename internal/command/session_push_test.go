@@ -0,0 +1,380 @@
+package command
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_CreatePushChallenge(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	sessAgg := &session.NewAggregate("sessionID", "instance1").Aggregate
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	tests := []struct {
+		name       string
+		userID     string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		wantCode   string
+		wantCmds   []eventstore.Command
+		wantErr    error
+	}{
+		{
+			name:       "userID missing, precondition error",
+			eventstore: expectEventstore(),
+			wantErr:    zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5cU", "Errors.User.UserIDMissing"),
+		},
+		{
+			name:   "no ready device, precondition error",
+			userID: "user1",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device1", nil, time.Hour),
+					),
+				),
+			),
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5cN", "Errors.User.MFA.Push.NotReady"),
+		},
+		{
+			name:   "challenge, ok",
+			userID: "user1",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device1", nil, time.Hour),
+					),
+					eventFromEventPusher(
+						user.NewHumanPushDeviceVerifiedEvent(ctx, userAgg, "device1", "phone", []byte("key"), "token1"),
+					),
+					eventFromEventPusher(
+						user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device2", nil, time.Hour),
+					),
+				),
+			),
+			wantCode: "42",
+			wantCmds: []eventstore.Command{
+				session.NewPushChallengedEvent(ctx, sessAgg, "challenge", "42", 2*time.Minute, "user1", []string{"device1"}, "app", "1.2.3.4", "Zurich"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				multifactors: domain.MultifactorConfigs{
+					Push: domain.PushConfig{ChallengeLifetime: 2 * time.Minute},
+				},
+			}
+			var dst string
+			cmd := c.CreatePushChallenge("app", "Zurich", &dst)
+			cmds := &SessionCommands{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:        tt.userID,
+					UserCheckedAt: testNow,
+					State:         domain.SessionStateActive,
+					UserAgent:     &domain.UserAgent{IP: net.ParseIP("1.2.3.4")},
+					aggregate:     sessAgg,
+				},
+				eventstore: tt.eventstore(t),
+				createPushChallenge: func() (string, string, error) {
+					return "challenge", "42", nil
+				},
+				now: time.Now,
+			}
+			gotCmds, err := cmd(ctx, cmds)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Empty(t, gotCmds)
+			assert.Equal(t, tt.wantCode, dst)
+			assert.Equal(t, tt.wantCmds, cmds.eventCommands)
+		})
+	}
+}
+
+func TestCheckPush(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	sessAgg := &session.NewAggregate("sessionID", "instance1").Aggregate
+	tests := []struct {
+		name      string
+		challenge *PushChallengeModel
+		wantCmds  []eventstore.Command
+		wantErr   error
+	}{
+		{
+			name:    "no challenge, precondition error",
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5kN", "Errors.Session.Push.NoChallenge"),
+		},
+		{
+			name: "rejected, precondition error",
+			challenge: &PushChallengeModel{
+				CreationDate: testNow,
+				Expiry:       time.Minute,
+				RejectedBy:   "device1",
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5kR", "Errors.Session.Push.Rejected"),
+		},
+		{
+			name: "expired, precondition error",
+			challenge: &PushChallengeModel{
+				CreationDate: testNow.Add(-time.Hour),
+				Expiry:       time.Minute,
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5kE", "Errors.Session.Push.Expired"),
+		},
+		{
+			name: "pending, precondition error",
+			challenge: &PushChallengeModel{
+				CreationDate: testNow,
+				Expiry:       time.Minute,
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5kP", "Errors.Session.Push.Pending"),
+		},
+		{
+			name: "approved, ok",
+			challenge: &PushChallengeModel{
+				CreationDate: testNow.Add(-time.Hour),
+				Expiry:       time.Minute,
+				ApprovedBy:   "device1",
+			},
+			wantCmds: []eventstore.Command{
+				session.NewPushCheckedEvent(ctx, sessAgg, testNow),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmds := &SessionCommands{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:        "user1",
+					UserCheckedAt: testNow,
+					PushChallenge: tt.challenge,
+					aggregate:     sessAgg,
+				},
+				now: func() time.Time { return testNow },
+			}
+			gotCmds, err := CheckPush()(ctx, cmds)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Empty(t, gotCmds)
+			assert.Equal(t, tt.wantCmds, cmds.eventCommands)
+		})
+	}
+}
+
+func TestCommands_RespondToPushChallenge(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	sessAgg := &session.NewAggregate("sessionID", "instance1").Aggregate
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	key := newTestPushKey(t)
+	publicKey := marshalTestPushPublicKey(t, key)
+	sign := func(code string, approved bool) []byte {
+		hash := sha256.Sum256(domain.PushApprovalData("sessionID", "challenge", code, approved))
+		signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+		require.NoError(t, err)
+		return signature
+	}
+	sessionEvents := func(events ...eventstore.Event) []eventstore.Event {
+		return append([]eventstore.Event{
+			eventFromEventPusher(
+				session.NewAddedEvent(context.Background(), sessAgg, &domain.UserAgent{}),
+			),
+			eventFromEventPusher(
+				session.NewUserCheckedEvent(context.Background(), sessAgg, "user1", "org1", testNow, nil),
+			),
+			eventFromEventPusherWithCreationDateNow(
+				session.NewPushChallengedEvent(context.Background(), sessAgg, "challenge", "42", time.Minute, "user1", []string{"device1"}, "app", "", ""),
+			),
+		}, events...)
+	}
+	deviceEvents := []eventstore.Event{
+		eventFromEventPusher(
+			user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device1", nil, time.Hour),
+		),
+		eventFromEventPusher(
+			user.NewHumanPushDeviceVerifiedEvent(ctx, userAgg, "device1", "phone", publicKey, "token1"),
+		),
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		response   *PushChallengeResponse
+		want       *domain.ObjectDetails
+		wantErr    error
+	}{
+		{
+			name:       "missing sessionID, invalid argument error",
+			eventstore: expectEventstore(),
+			response:   &PushChallengeResponse{DeviceID: "device1"},
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu5pS", "Errors.Session.IDMissing"),
+		},
+		{
+			name: "device not challenged, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(sessionEvents()...),
+			),
+			response: &PushChallengeResponse{SessionID: "sessionID", DeviceID: "device2"},
+			wantErr:  zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5pN", "Errors.Session.Push.NoChallenge"),
+		},
+		{
+			name: "already responded, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(sessionEvents(
+					eventFromEventPusher(
+						session.NewPushRejectedEvent(context.Background(), sessAgg, "device1"),
+					),
+				)...),
+			),
+			response: &PushChallengeResponse{SessionID: "sessionID", DeviceID: "device1"},
+			wantErr:  zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5pA", "Errors.Session.Push.AlreadyResponded"),
+		},
+		{
+			name: "invalid signature, invalid argument error",
+			eventstore: expectEventstore(
+				expectFilter(sessionEvents()...),
+				expectFilter(deviceEvents...),
+			),
+			response: &PushChallengeResponse{
+				SessionID: "sessionID",
+				DeviceID:  "device1",
+				Code:      "42",
+				Approved:  true,
+				Signature: sign("42", false),
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Pu5kS", "Errors.User.MFA.Push.SignatureInvalid"),
+		},
+		{
+			name: "wrong code, rejected and invalid argument error",
+			eventstore: expectEventstore(
+				expectFilter(sessionEvents()...),
+				expectFilter(deviceEvents...),
+				expectPush(
+					session.NewPushRejectedEvent(ctx, sessAgg, "device1"),
+				),
+			),
+			response: &PushChallengeResponse{
+				SessionID: "sessionID",
+				DeviceID:  "device1",
+				Code:      "13",
+				Approved:  true,
+				Signature: sign("13", true),
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu5pC", "Errors.Session.Push.CodeInvalid"),
+		},
+		{
+			name: "reject, ok",
+			eventstore: expectEventstore(
+				expectFilter(sessionEvents()...),
+				expectFilter(deviceEvents...),
+				expectPush(
+					session.NewPushRejectedEvent(ctx, sessAgg, "device1"),
+				),
+			),
+			response: &PushChallengeResponse{
+				SessionID: "sessionID",
+				DeviceID:  "device1",
+				Signature: sign("", false),
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+		{
+			name: "approve, ok",
+			eventstore: expectEventstore(
+				expectFilter(sessionEvents()...),
+				expectFilter(deviceEvents...),
+				expectPush(
+					session.NewPushApprovedEvent(ctx, sessAgg, "device1"),
+				),
+			),
+			response: &PushChallengeResponse{
+				SessionID: "sessionID",
+				DeviceID:  "device1",
+				Code:      "42",
+				Approved:  true,
+				Signature: sign("42", true),
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			got, err := c.RespondToPushChallenge(ctx, tt.response)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assertObjectDetails(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_PushSent(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	sessAgg := &session.NewAggregate("sessionID", "instance1").Aggregate
+	sessionEvents := []eventstore.Event{
+		eventFromEventPusher(
+			session.NewAddedEvent(context.Background(), sessAgg, &domain.UserAgent{}),
+		),
+		eventFromEventPusher(
+			session.NewPushChallengedEvent(context.Background(), sessAgg, "challenge", "42", time.Minute, "user1", []string{"device1", "device2"}, "app", "", ""),
+		),
+	}
+	tests := []struct {
+		name       string
+		eventstore func(*testing.T) *eventstore.Eventstore
+		challenge  string
+		wantErr    error
+	}{
+		{
+			name: "no challenge, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(sessionEvents[0]),
+			),
+			challenge: "challenge",
+			wantErr:   zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5sN", "Errors.Session.Push.NoChallenge"),
+		},
+		{
+			name: "other challenge, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(sessionEvents...),
+			),
+			challenge: "other",
+			wantErr:   zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5sN", "Errors.Session.Push.NoChallenge"),
+		},
+		{
+			name: "sent, ok",
+			eventstore: expectEventstore(
+				expectFilter(sessionEvents...),
+				expectPush(
+					session.NewPushSentEvent(ctx, sessAgg, "challenge", "device2"),
+				),
+			),
+			challenge: "challenge",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.PushSent(ctx, "sessionID", "instance1", tt.challenge, "device2")
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/risk"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// evaluateSessionRisk evaluates the risk of the checks of the session update and applies the action of the policy.
+// The risk of the session is only changed if it increased, a blocked check returns an error.
+func (c *Commands) evaluateSessionRisk(ctx context.Context, checks *SessionCommands) error {
+	if c.riskEngine == nil || checks.sessionWriteModel.UserID == "" || !slices.ContainsFunc(checks.eventCommands, isSessionCheck) {
+		return nil
+	}
+	model := checks.sessionWriteModel
+	failedChecks := newUserFailedChecksReadModel(model.UserID, model.UserResourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, failedChecks); err != nil {
+		return err
+	}
+	history, err := c.sessionRiskHistory(ctx, model.UserID, model.AggregateID, c.riskEngine.History())
+	if err != nil {
+		return err
+	}
+	userAgent := model.UserAgent
+	if userAgent == nil {
+		userAgent = new(domain.UserAgent)
+	}
+	evaluation := c.riskEngine.Evaluate(&risk.Check{
+		InstanceID:     model.aggregate.InstanceID,
+		UserID:         model.UserID,
+		IP:             userAgent.IP,
+		UserAgent:      riskUserAgent(userAgent),
+		FailedAttempts: failedChecks.FailedChecks,
+		History:        history.Logins,
+	})
+	if evaluation.Action == domain.RiskActionBlock {
+		return zerrors.ThrowPermissionDenied(nil, "COMMAND-Rsk8b", "Errors.Session.RiskBlocked")
+	}
+	sessionRisk := evaluation.SessionRisk
+	if model.Risk != nil {
+		sessionRisk = model.Risk.Merge(sessionRisk)
+		if sessionRisk.Equal(*model.Risk) {
+			return nil
+		}
+	}
+	var ip string
+	if len(userAgent.IP) > 0 {
+		ip = userAgent.IP.String()
+	}
+	checks.eventCommands = append(checks.eventCommands, session.NewRiskEvaluatedEvent(ctx, model.aggregate,
+		model.UserID,
+		sessionRisk,
+		ip,
+		riskUserAgent(userAgent),
+		evaluation.Location,
+	))
+	return nil
+}
+
+// recordSessionCheckFailure remembers the failed check for the detection of credential stuffing.
+func (c *Commands) recordSessionCheckFailure(checks *SessionCommands) {
+	model := checks.sessionWriteModel
+	if c.riskEngine == nil || model.UserID == "" || model.UserAgent == nil {
+		return
+	}
+	c.riskEngine.RecordFailure(model.aggregate.InstanceID, model.UserAgent.IP, model.UserID)
+}
+
+// isSessionCheck returns true for the events of successful session checks.
+func isSessionCheck(cmd eventstore.Command) bool {
+	switch cmd.(type) {
+	case *session.UserCheckedEvent,
+		*session.PasswordCheckedEvent,
+		*session.IntentCheckedEvent,
+		*session.WebAuthNCheckedEvent,
+		*session.TOTPCheckedEvent,
+		*session.OTPSMSCheckedEvent,
+		*session.OTPEmailCheckedEvent,
+		*session.RecoveryCodeCheckedEvent,
+		*session.PushCheckedEvent,
+		*session.X509CheckedEvent:
+		return true
+	default:
+		return false
+	}
+}
+
+// riskUserAgent identifies the user agent by the fingerprint, the description or the header.
+func riskUserAgent(userAgent *domain.UserAgent) string {
+	if fingerprintID := userAgent.GetFingerprintID(); fingerprintID != "" {
+		return fingerprintID
+	}
+	if userAgent.Description != nil {
+		return *userAgent.Description
+	}
+	return userAgent.Header.Get("User-Agent")
+}
+
+var (
+	userFailedCheckTypes = []eventstore.EventType{
+		user.HumanPasswordCheckFailedType,
+		user.UserV1PasswordCheckFailedType,
+		user.HumanMFAOTPCheckFailedType,
+		user.UserV1MFAOTPCheckFailedType,
+		user.HumanOTPSMSCheckFailedType,
+		user.HumanOTPEmailCheckFailedType,
+		user.HumanU2FTokenCheckFailedType,
+		user.HumanPasswordlessTokenCheckFailedType,
+		user.HumanRecoveryCodeCheckFailedType,
+		user.HumanX509CheckFailedType,
+	}
+	userSucceededCheckTypes = []eventstore.EventType{
+		user.HumanPasswordCheckSucceededType,
+		user.UserV1PasswordCheckSucceededType,
+		user.HumanMFAOTPCheckSucceededType,
+		user.UserV1MFAOTPCheckSucceededType,
+		user.HumanOTPSMSCheckSucceededType,
+		user.HumanOTPEmailCheckSucceededType,
+		user.HumanU2FTokenCheckSucceededType,
+		user.HumanPasswordlessTokenCheckSucceededType,
+		user.HumanRecoveryCodeCheckSucceededType,
+		user.HumanX509CheckSucceededType,
+	}
+)
+
+// userFailedChecksReadModel counts the failed checks of the user since the last successful check.
+type userFailedChecksReadModel struct {
+	eventstore.WriteModel
+
+	FailedChecks uint64
+}
+
+func newUserFailedChecksReadModel(userID, resourceOwner string) *userFailedChecksReadModel {
+	return &userFailedChecksReadModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (rm *userFailedChecksReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch {
+		case slices.Contains(userFailedCheckTypes, event.Type()):
+			rm.FailedChecks++
+		case slices.Contains(userSucceededCheckTypes, event.Type()):
+			rm.FailedChecks = 0
+		}
+	}
+	return rm.WriteModel.Reduce()
+}
+
+func (rm *userFailedChecksReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(rm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(slices.Concat(userFailedCheckTypes, userSucceededCheckTypes)...).
+		Builder()
+}
+
+// sessionRiskHistory returns the latest risk evaluations of the other sessions of the user.
+// The sessions of the user are searched in the fields, so only their events have to be filtered.
+func (c *Commands) sessionRiskHistory(ctx context.Context, userID, sessionID string, limit uint64) (*sessionRiskHistoryReadModel, error) {
+	history := newSessionRiskHistoryReadModel(limit)
+	if limit == 0 {
+		return history, nil
+	}
+	results, err := c.eventstore.Search(ctx, map[eventstore.FieldType]any{
+		eventstore.FieldTypeObjectType: session.RiskSearchType,
+		eventstore.FieldTypeFieldName:  session.RiskUserIDSearchField,
+		eventstore.FieldTypeValue:      userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		if result.Aggregate.ID != sessionID {
+			history.sessionIDs = append(history.sessionIDs, result.Aggregate.ID)
+		}
+	}
+	if len(history.sessionIDs) == 0 {
+		return history, nil
+	}
+	if err := c.eventstore.FilterToQueryReducer(ctx, history); err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// sessionRiskHistoryReadModel contains the latest risk evaluations of the given sessions.
+type sessionRiskHistoryReadModel struct {
+	eventstore.WriteModel
+
+	sessionIDs []string
+	limit      uint64
+	// Logins are ordered by the time of the evaluation, the latest first.
+	Logins []*risk.Login
+}
+
+func newSessionRiskHistoryReadModel(limit uint64) *sessionRiskHistoryReadModel {
+	return &sessionRiskHistoryReadModel{
+		limit: limit,
+	}
+}
+
+func (rm *sessionRiskHistoryReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		e, ok := event.(*session.RiskEvaluatedEvent)
+		if !ok {
+			continue
+		}
+		rm.Logins = append(rm.Logins, &risk.Login{
+			CheckedAt: e.CreatedAt(),
+			UserAgent: e.UserAgent,
+			Location:  e.Location,
+		})
+	}
+	return rm.WriteModel.Reduce()
+}
+
+func (rm *sessionRiskHistoryReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		OrderDesc().
+		Limit(rm.limit).
+		AddQuery().
+		AggregateTypes(session.AggregateType).
+		AggregateIDs(rm.sessionIDs...).
+		EventTypes(session.RiskEvaluatedType).
+		Builder()
+}
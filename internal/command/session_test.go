@@ -21,6 +21,7 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/id"
 	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/group"
 	"github.com/zitadel/zitadel/internal/repository/idpintent"
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/session"
@@ -1018,6 +1019,8 @@ func TestCommands_updateSession(t *testing.T) {
 							),
 						),
 					),
+					expectFilter(),
+					expectFilter(),
 					expectPush(
 						session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
 							"userID", "org1", testNow, &language.Afrikaans),
@@ -1058,6 +1061,108 @@ func TestCommands_updateSession(t *testing.T) {
 				},
 			},
 		},
+		{
+			"set user, intent (user linked on first login), claim mappings applied",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate,
+								"username", "", "", "", "", language.English, domain.GenderUnspecified, "", false),
+						),
+						eventFromEventPusher(
+							idpintent.NewStartedEvent(context.Background(),
+								&idpintent.NewAggregate("id", "instance1").Aggregate,
+								nil,
+								nil,
+								"idpID",
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							idpintent.NewSucceededEvent(context.Background(),
+								&idpintent.NewAggregate("intent", "instance1").Aggregate,
+								[]byte(`{"groups":["admins"]}`),
+								"idpUserID",
+								"idpUsername",
+								"",
+								nil,
+								"",
+								time.Now().Add(time.Hour),
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewUserIDPLinkAddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate,
+								"idpID",
+								"idpUsername",
+								"idpUserID",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewIDPClaimMappingsSetEvent(context.Background(), &org.NewAggregate("org1").Aggregate, "idpID",
+								[]*domain.IDPClaimMapping{{Claim: "groups", Value: "admins", GroupID: "group1"}},
+							),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate,
+								"username", "", "", "", "", language.English, domain.GenderUnspecified, "", false),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							group.NewGroupAddedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, "admins", ""),
+						),
+					),
+					expectPush(
+						session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							"userID", "org1", testNow, &language.Afrikaans),
+						group.NewGroupUsersAddedEvent(context.Background(), &group.NewAggregate("group1", "org1").Aggregate, []string{"userID"}),
+						user.NewUserIDPClaimMappingsAppliedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate,
+							"idpID", []string{"group1"}, []domain.IDPClaimMappingRole{}),
+						session.NewIntentCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							testNow),
+						idpintent.NewConsumedEvent(context.Background(), &idpintent.NewAggregate("intent", "org1").Aggregate),
+						session.NewTokenSetEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
+							"tokenID"),
+					),
+				),
+			},
+			args{
+				ctx: authz.NewMockContext("instance1", "", ""),
+				checks: &SessionCommands{
+					sessionWriteModel: NewSessionWriteModel("sessionID", "instance1"),
+					sessionCommands: []SessionCommand{
+						CheckUser("userID", "org1", &language.Afrikaans),
+						CheckIntent("intent", "aW50ZW50"),
+					},
+					createToken: func(sessionID string) (string, string, error) {
+						return "tokenID",
+							"token",
+							nil
+					},
+					intentAlg: decryption(nil),
+					now: func() time.Time {
+						return testNow
+					},
+				},
+			},
+			res{
+				want: &SessionChanged{
+					ObjectDetails: &domain.ObjectDetails{
+						ResourceOwner: "instance1",
+					},
+					ID:       "sessionID",
+					NewToken: "token",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1065,6 +1170,7 @@ func TestCommands_updateSession(t *testing.T) {
 				eventstore: tt.fields.eventstore(t),
 			}
 			tt.args.checks.eventstore = c.eventstore
+			tt.args.checks.idpClaimMappings = c.idpClaimMappingsCommands
 			got, err := c.updateSession(tt.args.ctx, tt.args.checks, tt.args.metadata, tt.args.lifetime)
 			require.ErrorIs(t, err, tt.res.err)
 			assert.Equal(t, tt.res.want, got)
@@ -1080,6 +1186,10 @@ func TestCheckTOTP(t *testing.T) {
 	require.NoError(t, err)
 	secret, err := crypto.Encrypt([]byte(key.Secret()), cryptoAlg)
 	require.NoError(t, err)
+	otherKey, err := domain.NewTOTPKey("example.com", "user1")
+	require.NoError(t, err)
+	otherSecret, err := crypto.Encrypt([]byte(otherKey.Secret()), cryptoAlg)
+	require.NoError(t, err)
 
 	sessAgg := &session.NewAggregate("session1", "instance1").Aggregate
 	userAgg := &user.NewAggregate("user1", "org1").Aggregate
@@ -1238,6 +1348,39 @@ func TestCheckTOTP(t *testing.T) {
 				session.NewTOTPCheckedEvent(ctx, sessAgg, testNow),
 			},
 		},
+		{
+			name: "ok, named authenticator",
+			code: code,
+			fields: fields{
+				sessionWriteModel: &SessionWriteModel{
+					UserID:        "user1",
+					UserCheckedAt: testNow,
+					aggregate:     sessAgg,
+				},
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPAddedEvent(ctx, userAgg, otherSecret),
+						),
+						eventFromEventPusher(
+							user.NewHumanOTPVerifiedEvent(ctx, userAgg, "agent1"),
+						),
+						eventFromEventPusher(
+							user.NewHumanTOTPAddedEvent(ctx, userAgg, "totp1", "phone", secret),
+						),
+						eventFromEventPusher(
+							user.NewHumanTOTPVerifiedEvent(ctx, userAgg, "totp1", "", "agent1"),
+						),
+					),
+					expectFilter(), // recheck
+				),
+				tarpit: expectTarpit(0),
+			},
+			wantEventCommands: []eventstore.Command{
+				user.NewHumanOTPCheckSucceededEvent(ctx, userAgg, nil),
+				session.NewTOTPCheckedEvent(ctx, sessAgg, testNow),
+			},
+		},
 		{
 			name: "ok, but locked in the meantime",
 			code: code,
@@ -0,0 +1,137 @@
+package command
+
+import (
+	"context"
+	"crypto/x509"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// X509RevocationChecker checks the revocation status of client certificates
+// against the responders of the [domain.X509Policy].
+type X509RevocationChecker interface {
+	CheckRevocation(ctx context.Context, policy *domain.X509Policy, cert, issuer *x509.Certificate) error
+}
+
+// CreateX509Challenge requests the proof of possession of the private key of a client certificate (e.g. of a PIV or CAC smart card).
+// The challenge is returned in dst and has to be signed with the private key for the check of the certificate.
+func (c *Commands) CreateX509Challenge(dst *string) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		challenge, err := cmd.createX509Challenge()
+		if err != nil {
+			return nil, err
+		}
+		*dst = challenge
+		cmd.X509Challenged(ctx, challenge, domain.X509ChallengeLifetime)
+		return nil, nil
+	}
+}
+
+// CheckX509Certificate defines a check of a client certificate (e.g. of a PIV or CAC smart card) to be executed for a session update.
+// The certificate (and optional intermediates) are expected PEM or DER encoded.
+// The possession of the private key is proven by the signature of the challenge, which was requested in a previous request.
+func (c *Commands) CheckX509Certificate(certificate []byte, intermediates [][]byte, signature []byte) SessionCommand {
+	return func(ctx context.Context, cmd *SessionCommands) ([]eventstore.Command, error) {
+		if cmd.sessionWriteModel.UserID == "" {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-X5s1U", "Errors.User.UserIDMissing")
+		}
+		challenge := cmd.sessionWriteModel.X509Challenge
+		if challenge == nil {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-X5s1C", "Errors.Session.X509.NoChallenge")
+		}
+		if challenge.Expired(cmd.now()) {
+			return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-X5s1E", "Errors.Session.X509.Expired")
+		}
+		certs, err := domain.ParseX509Certificates(certificate)
+		if err != nil {
+			return nil, err
+		}
+		if err = domain.VerifyX509Signature(certs[0], domain.X509ChallengeData(cmd.sessionWriteModel.AggregateID, challenge.Challenge), signature); err != nil {
+			return nil, err
+		}
+		fingerprint, err := c.verifyX509Certificate(ctx, cmd.sessionWriteModel.UserID, cmd.sessionWriteModel.UserResourceOwner, certificate, intermediates, cmd.now())
+		if err != nil {
+			return nil, err
+		}
+		cmd.X509Checked(ctx, cmd.now(), fingerprint)
+		return nil, nil
+	}
+}
+
+// verifyX509Certificate verifies the client certificate against the policy of the user's organization,
+// checks its revocation status and that it's mapped to the user.
+// The fingerprint of the certificate is returned as soon as it could be parsed.
+func (c *Commands) verifyX509Certificate(ctx context.Context, userID, resourceOwner string, certificate []byte, intermediates [][]byte, now time.Time) (fingerprint string, err error) {
+	certs, err := domain.ParseX509Certificates(certificate)
+	if err != nil {
+		return "", err
+	}
+	cert := certs[0]
+	fingerprint = domain.X509Fingerprint(cert)
+	intermediateCerts := certs[1:]
+	for _, intermediate := range intermediates {
+		parsed, err := domain.ParseX509Certificates(intermediate)
+		if err != nil {
+			return fingerprint, err
+		}
+		intermediateCerts = append(intermediateCerts, parsed...)
+	}
+
+	userWriteModel := NewUserHumanWriteModel(userID, resourceOwner, false, true, false, false, false, false, false)
+	if err = c.eventstore.FilterToQueryReducer(ctx, userWriteModel); err != nil {
+		return fingerprint, err
+	}
+	if !isUserStateExists(userWriteModel.UserState) {
+		return fingerprint, zerrors.ThrowNotFound(nil, "COMMAND-X5s1N", "Errors.User.NotFound")
+	}
+	if userWriteModel.UserState == domain.UserStateLocked {
+		return fingerprint, zerrors.ThrowPreconditionFailed(nil, "COMMAND-X5s1L", "Errors.User.Locked")
+	}
+	policy, err := c.x509Policy(ctx, userWriteModel.ResourceOwner)
+	if err != nil {
+		return fingerprint, err
+	}
+	chain, err := policy.VerifyCertificate(cert, intermediateCerts, now)
+	if err != nil {
+		return fingerprint, err
+	}
+	issuer := chain[len(chain)-1]
+	if len(chain) > 1 {
+		issuer = chain[1]
+	}
+	if err = c.x509Revocation.CheckRevocation(ctx, policy, cert, issuer); err != nil {
+		return fingerprint, err
+	}
+	loginNames, err := c.x509LoginNames(ctx, userWriteModel)
+	if err != nil {
+		return fingerprint, err
+	}
+	var verifiedEmail string
+	if userWriteModel.IsEmailVerified {
+		verifiedEmail = string(userWriteModel.Email)
+	}
+	for _, identifier := range policy.Identifiers(cert) {
+		if domain.X509IdentifierMatches(identifier, loginNames, verifiedEmail) {
+			return fingerprint, nil
+		}
+	}
+	return fingerprint, zerrors.ThrowPreconditionFailed(nil, "COMMAND-X5s1M", "Errors.User.X509.NotMapped")
+}
+
+// x509LoginNames returns the username and its combinations with the verified domains of the user's organization.
+func (c *Commands) x509LoginNames(ctx context.Context, user *UserV2WriteModel) ([]string, error) {
+	domains := NewOrgDomainsWriteModel(user.ResourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, domains); err != nil {
+		return nil, err
+	}
+	loginNames := []string{user.UserName}
+	for _, orgDomain := range domains.Domains {
+		if orgDomain.Verified && orgDomain.State == domain.OrgDomainStateActive {
+			loginNames = append(loginNames, user.UserName+"@"+orgDomain.Domain)
+		}
+	}
+	return loginNames, nil
+}
@@ -0,0 +1,347 @@
+package command
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type testX509CA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestX509CA(t *testing.T) *testX509CA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testX509CA{cert: cert, key: key}
+}
+
+func (ca *testX509CA) pem() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw})
+}
+
+// issue returns a DER encoded client certificate with the user principal name
+// or, if empty, the email address as subject alternative name.
+func (ca *testX509CA) issue(t *testing.T, upn, email string) []byte {
+	der, _ := ca.issueWithKey(t, upn, email)
+	return der
+}
+
+// issueWithKey returns the DER encoded client certificate and its private key.
+func (ca *testX509CA) issueWithKey(t *testing.T, upn, email string) ([]byte, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if email != "" {
+		template.EmailAddresses = []string{email}
+	}
+	if upn != "" {
+		value, err := asn1.MarshalWithParams(upn, "utf8")
+		require.NoError(t, err)
+		typeID, err := asn1.Marshal(asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 20, 2, 3})
+		require.NoError(t, err)
+		explicitValue, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: value})
+		require.NoError(t, err)
+		san, err := asn1.Marshal([]asn1.RawValue{
+			{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: append(typeID, explicitValue...)},
+		})
+		require.NoError(t, err)
+		template.ExtraExtensions = []pkix.Extension{{Id: asn1.ObjectIdentifier{2, 5, 29, 17}, Value: san}}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, key.Public(), ca.key)
+	require.NoError(t, err)
+	return der, key
+}
+
+func signX509Challenge(t *testing.T, key *ecdsa.PrivateKey, sessionID, challenge string) []byte {
+	hash := sha256.Sum256(domain.X509ChallengeData(sessionID, challenge))
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+	return signature
+}
+
+type testX509Revocation struct {
+	err error
+}
+
+func (r testX509Revocation) CheckRevocation(context.Context, *domain.X509Policy, *x509.Certificate, *x509.Certificate) error {
+	return r.err
+}
+
+func TestCommands_CheckX509Certificate(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	sessAgg := &session.NewAggregate("sessionID", "instance1").Aggregate
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	orgAgg := &org.NewAggregate("org1").Aggregate
+	ca := newTestX509CA(t)
+	otherCA := newTestX509CA(t)
+	trustedCert, trustedKey := ca.issueWithKey(t, "john@example.com", "")
+	challenge := &X509ChallengeModel{
+		Challenge:    "challenge",
+		Expiry:       domain.X509ChallengeLifetime,
+		CreationDate: testNow,
+	}
+	trustedSignature := signX509Challenge(t, trustedKey, "sessionID", "challenge")
+
+	userEvents := func() expect {
+		return expectFilter(
+			eventFromEventPusher(
+				user.NewHumanAddedEvent(ctx, userAgg, "john", "John", "Doe", "", "John Doe", language.English, domain.GenderUnspecified, "john@mail.com", false),
+			),
+			eventFromEventPusher(
+				user.NewHumanEmailVerifiedEvent(ctx, userAgg),
+			),
+		)
+	}
+	policyEvents := func() []expect {
+		return []expect{
+			expectFilter(),
+			expectFilter(
+				eventFromEventPusher(
+					instance.NewX509PolicySetEvent(ctx, &instance.NewAggregate("instance1").Aggregate, &domain.X509Policy{TrustedCAs: ca.pem()}),
+				),
+			),
+		}
+	}
+	domainEvents := func() expect {
+		return expectFilter(
+			eventFromEventPusher(org.NewDomainAddedEvent(ctx, orgAgg, "example.com")),
+			eventFromEventPusher(org.NewDomainVerifiedEvent(ctx, orgAgg, "example.com")),
+		)
+	}
+	untrustedCert, untrustedKey := otherCA.issueWithKey(t, "john@example.com", "")
+	otherUserCert, otherUserKey := ca.issueWithKey(t, "jane@example.com", "")
+	emailCert, emailKey := ca.issueWithKey(t, "", "john@mail.com")
+	tests := []struct {
+		name        string
+		userID      string
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		revocation  error
+		challenge   *X509ChallengeModel
+		certificate []byte
+		signature   []byte
+		wantCmds    []eventstore.Command
+		wantErr     error
+	}{
+		{
+			name:       "userID missing, precondition error",
+			eventstore: expectEventstore(),
+			wantErr:    zerrors.ThrowPreconditionFailed(nil, "COMMAND-X5s1U", "Errors.User.UserIDMissing"),
+		},
+		{
+			name:        "no challenge, precondition error",
+			userID:      "user1",
+			eventstore:  expectEventstore(),
+			certificate: trustedCert,
+			signature:   trustedSignature,
+			wantErr:     zerrors.ThrowPreconditionFailed(nil, "COMMAND-X5s1C", "Errors.Session.X509.NoChallenge"),
+		},
+		{
+			name:       "challenge expired, precondition error",
+			userID:     "user1",
+			eventstore: expectEventstore(),
+			challenge: &X509ChallengeModel{
+				Challenge:    "challenge",
+				Expiry:       domain.X509ChallengeLifetime,
+				CreationDate: testNow.Add(-domain.X509ChallengeLifetime - time.Second),
+			},
+			certificate: trustedCert,
+			signature:   trustedSignature,
+			wantErr:     zerrors.ThrowPreconditionFailed(nil, "COMMAND-X5s1E", "Errors.Session.X509.Expired"),
+		},
+		{
+			name:        "invalid certificate, invalid argument error",
+			userID:      "user1",
+			eventstore:  expectEventstore(),
+			challenge:   challenge,
+			certificate: []byte("certificate"),
+			wantErr:     zerrors.ThrowInvalidArgument(nil, "DOMAIN-X5c1P", "Errors.User.X509.Invalid"),
+		},
+		{
+			name:        "signature of other key, invalid argument error",
+			userID:      "user1",
+			eventstore:  expectEventstore(),
+			challenge:   challenge,
+			certificate: trustedCert,
+			signature:   signX509Challenge(t, otherUserKey, "sessionID", "challenge"),
+			wantErr:     zerrors.ThrowInvalidArgument(nil, "DOMAIN-X5c1S", "Errors.User.X509.SignatureInvalid"),
+		},
+		{
+			name:        "signature of other challenge, invalid argument error",
+			userID:      "user1",
+			eventstore:  expectEventstore(),
+			challenge:   challenge,
+			certificate: trustedCert,
+			signature:   signX509Challenge(t, trustedKey, "sessionID", "other"),
+			wantErr:     zerrors.ThrowInvalidArgument(nil, "DOMAIN-X5c1S", "Errors.User.X509.SignatureInvalid"),
+		},
+		{
+			name:   "user not found, not found error",
+			userID: "user1",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			challenge:   challenge,
+			certificate: trustedCert,
+			signature:   trustedSignature,
+			wantErr:     zerrors.ThrowNotFound(nil, "COMMAND-X5s1N", "Errors.User.NotFound"),
+		},
+		{
+			name:   "untrusted certificate, precondition error",
+			userID: "user1",
+			eventstore: expectEventstore(
+				append([]expect{userEvents()}, policyEvents()...)...,
+			),
+			challenge:   challenge,
+			certificate: untrustedCert,
+			signature:   signX509Challenge(t, untrustedKey, "sessionID", "challenge"),
+			wantErr:     zerrors.ThrowPreconditionFailed(nil, "DOMAIN-X5v1V", "Errors.User.X509.Untrusted"),
+		},
+		{
+			name:   "revoked certificate, precondition error",
+			userID: "user1",
+			eventstore: expectEventstore(
+				append([]expect{userEvents()}, policyEvents()...)...,
+			),
+			revocation:  zerrors.ThrowPreconditionFailed(nil, "CERT-Rv5kO", "Errors.User.X509.Revoked"),
+			challenge:   challenge,
+			certificate: trustedCert,
+			signature:   trustedSignature,
+			wantErr:     zerrors.ThrowPreconditionFailed(nil, "CERT-Rv5kO", "Errors.User.X509.Revoked"),
+		},
+		{
+			name:   "other user, precondition error",
+			userID: "user1",
+			eventstore: expectEventstore(
+				append(append([]expect{userEvents()}, policyEvents()...), domainEvents())...,
+			),
+			challenge:   challenge,
+			certificate: otherUserCert,
+			signature:   signX509Challenge(t, otherUserKey, "sessionID", "challenge"),
+			wantErr:     zerrors.ThrowPreconditionFailed(nil, "COMMAND-X5s1M", "Errors.User.X509.NotMapped"),
+		},
+		{
+			name:   "user principal name, ok",
+			userID: "user1",
+			eventstore: expectEventstore(
+				append(append([]expect{userEvents()}, policyEvents()...), domainEvents())...,
+			),
+			challenge:   challenge,
+			certificate: trustedCert,
+			signature:   trustedSignature,
+			wantCmds: []eventstore.Command{
+				session.NewX509CheckedEvent(ctx, sessAgg, testNow, x509Fingerprint(t, trustedCert)),
+			},
+		},
+		{
+			name:   "verified email, ok",
+			userID: "user1",
+			eventstore: expectEventstore(
+				append(append([]expect{userEvents()}, policyEvents()...), expectFilter())...,
+			),
+			challenge:   challenge,
+			certificate: emailCert,
+			signature:   signX509Challenge(t, emailKey, "sessionID", "challenge"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:     tt.eventstore(t),
+				x509Revocation: testX509Revocation{err: tt.revocation},
+			}
+			cmds := &SessionCommands{
+				sessionWriteModel: &SessionWriteModel{
+					WriteModel: eventstore.WriteModel{
+						AggregateID: "sessionID",
+					},
+					UserID:            tt.userID,
+					UserResourceOwner: "org1",
+					UserCheckedAt:     testNow,
+					X509Challenge:     tt.challenge,
+					aggregate:         sessAgg,
+				},
+				now: func() time.Time { return testNow },
+			}
+			gotCmds, err := c.CheckX509Certificate(tt.certificate, nil, tt.signature)(ctx, cmds)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Empty(t, gotCmds)
+			if tt.wantErr != nil {
+				assert.Empty(t, cmds.eventCommands)
+				return
+			}
+			require.Len(t, cmds.eventCommands, 1)
+			if tt.wantCmds != nil {
+				assert.Equal(t, tt.wantCmds, cmds.eventCommands)
+			}
+		})
+	}
+}
+
+func TestCommands_CreateX509Challenge(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	sessAgg := &session.NewAggregate("sessionID", "instance1").Aggregate
+	cmds := &SessionCommands{
+		sessionWriteModel: &SessionWriteModel{
+			aggregate: sessAgg,
+		},
+		createX509Challenge: func() (string, error) {
+			return "challenge", nil
+		},
+	}
+	var dst string
+	gotCmds, err := new(Commands).CreateX509Challenge(&dst)(ctx, cmds)
+	require.NoError(t, err)
+	assert.Empty(t, gotCmds)
+	assert.Equal(t, "challenge", dst)
+	assert.Equal(t, []eventstore.Command{
+		session.NewX509ChallengedEvent(ctx, sessAgg, "challenge", domain.X509ChallengeLifetime),
+	}, cmds.eventCommands)
+}
+
+func x509Fingerprint(t *testing.T, der []byte) string {
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return domain.X509Fingerprint(cert)
+}
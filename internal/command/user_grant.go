@@ -3,6 +3,7 @@ package command
 import (
 	"context"
 	"slices"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/domain"
@@ -22,11 +23,11 @@ func (c *Commands) AddUserGrant(ctx context.Context, usergrant *domain.UserGrant
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	event, addedUserGrant, err := c.addUserGrant(ctx, usergrant, check)
+	cmds, addedUserGrant, err := c.addUserGrant(ctx, usergrant, check)
 	if err != nil {
 		return nil, err
 	}
-	pushedEvents, err := c.eventstore.Push(ctx, event)
+	pushedEvents, err := c.eventstore.Push(ctx, cmds...)
 	if err != nil {
 		return nil, err
 	}
@@ -38,10 +39,15 @@ func (c *Commands) AddUserGrant(ctx context.Context, usergrant *domain.UserGrant
 	return userGrantWriteModelToUserGrant(addedUserGrant), nil
 }
 
-func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant, check UserGrantPermissionCheck) (command eventstore.Command, _ *UserGrantWriteModel, err error) {
+// addUserGrant returns the commands to add the grant.
+// A grant with a validity starting in the future is added deactivated and activated by the [scheduledaccess] worker.
+func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant, check UserGrantPermissionCheck) (cmds []eventstore.Command, _ *UserGrantWriteModel, err error) {
 	if !userGrant.IsValid() {
 		return nil, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-kVfMa", "Errors.UserGrant.Invalid")
 	}
+	if userGrant.ValidUntil != nil && !userGrant.ValidUntil.After(time.Now()) {
+		return nil, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ug2pX", "Errors.UserGrant.ValidityInvalid")
+	}
 	err = c.checkUserGrantPreCondition(ctx, userGrant, check)
 	if err != nil {
 		return nil, nil, err
@@ -53,7 +59,7 @@ func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant
 
 	addedUserGrant := NewUserGrantWriteModel(userGrant.AggregateID, userGrant.ResourceOwner)
 	userGrantAgg := UserGrantAggregateFromWriteModel(&addedUserGrant.WriteModel)
-	command = usergrant.NewUserGrantAddedEvent(
+	added := usergrant.NewUserGrantAddedEvent(
 		ctx,
 		userGrantAgg,
 		userGrant.UserID,
@@ -61,7 +67,15 @@ func (c *Commands) addUserGrant(ctx context.Context, userGrant *domain.UserGrant
 		userGrant.ProjectGrantID,
 		userGrant.RoleKeys,
 	)
-	return command, addedUserGrant, nil
+	added.ValidUntil = userGrant.ValidUntil
+	if !userGrant.IsScheduled(time.Now()) {
+		return []eventstore.Command{added}, addedUserGrant, nil
+	}
+	added.ValidFrom = userGrant.ValidFrom
+	return []eventstore.Command{
+		added,
+		usergrant.NewUserGrantDeactivatedEvent(ctx, userGrantAgg),
+	}, addedUserGrant, nil
 }
 
 func (c *Commands) ChangeUserGrant(ctx context.Context, userGrant *domain.UserGrant, cascade, ignoreUnchanged bool, check UserGrantPermissionCheck) (_ *domain.UserGrant, err error) {
@@ -76,7 +90,15 @@ func (c *Commands) ChangeUserGrant(ctx context.Context, userGrant *domain.UserGr
 		return nil, zerrors.ThrowNotFound(nil, "COMMAND-3M9sd", "Errors.UserGrant.NotFound")
 	}
 
-	grantUnchanged := slices.Equal(existingUserGrant.RoleKeys, userGrant.RoleKeys)
+	if !domain.IsValidPeriod(existingUserGrant.ValidFrom, userGrant.ValidUntil) ||
+		userGrant.ValidUntil != nil && (userGrant.ClearValidUntil || !userGrant.ValidUntil.After(time.Now())) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ug4vT", "Errors.UserGrant.ValidityInvalid")
+	}
+	// a grant without validity in the request keeps its current validity, unless it's cleared
+	clearValidity := userGrant.ClearValidUntil && existingUserGrant.ValidUntil != nil
+	validityUnchanged := !clearValidity && (userGrant.ValidUntil == nil ||
+		existingUserGrant.ValidUntil != nil && existingUserGrant.ValidUntil.Equal(*userGrant.ValidUntil))
+	grantUnchanged := slices.Equal(existingUserGrant.RoleKeys, userGrant.RoleKeys) && validityUnchanged
 	if grantUnchanged {
 		if ignoreUnchanged {
 			return userGrantWriteModelToUserGrant(existingUserGrant), nil
@@ -88,7 +110,8 @@ func (c *Commands) ChangeUserGrant(ctx context.Context, userGrant *domain.UserGr
 	userGrant.ProjectGrantID = existingUserGrant.ProjectGrantID
 	userGrant.ResourceOwner = existingUserGrant.ResourceOwner
 
-	err = c.checkUserGrantPreCondition(ctx, userGrant, check)
+	// the revoked roles must be permitted as well
+	err = c.checkUserGrantPreCondition(ctx, userGrant, check.withRoleKeys(existingUserGrant.RoleKeys...))
 	if err != nil {
 		return nil, err
 	}
@@ -96,9 +119,15 @@ func (c *Commands) ChangeUserGrant(ctx context.Context, userGrant *domain.UserGr
 	changedUserGrant := NewUserGrantWriteModel(userGrant.AggregateID, userGrant.ResourceOwner)
 	userGrantAgg := UserGrantAggregateFromWriteModel(&changedUserGrant.WriteModel)
 
-	var event eventstore.Command = usergrant.NewUserGrantChangedEvent(ctx, userGrantAgg, existingUserGrant.UserID, userGrant.RoleKeys)
+	changed := usergrant.NewUserGrantChangedEvent(ctx, userGrantAgg, existingUserGrant.UserID, userGrant.RoleKeys)
+	changed.ValidUntil = userGrant.ValidUntil
+	changed.ValidityCleared = clearValidity
+	var event eventstore.Command = changed
 	if cascade {
-		event = usergrant.NewUserGrantCascadeChangedEvent(ctx, userGrantAgg, userGrant.RoleKeys)
+		cascadeChanged := usergrant.NewUserGrantCascadeChangedEvent(ctx, userGrantAgg, userGrant.RoleKeys)
+		cascadeChanged.ValidUntil = userGrant.ValidUntil
+		cascadeChanged.ValidityCleared = clearValidity
+		event = cascadeChanged
 	}
 	pushedEvents, err := c.eventstore.Push(ctx, event)
 	if err != nil {
@@ -156,11 +185,13 @@ func (c *Commands) DeactivateUserGrant(ctx context.Context, grantID string, reso
 	if existingUserGrant.State == domain.UserGrantStateUnspecified || existingUserGrant.State == domain.UserGrantStateRemoved {
 		return nil, zerrors.ThrowNotFound(nil, "COMMAND-3M9sd", "Errors.UserGrant.NotFound")
 	}
-	if existingUserGrant.State != domain.UserGrantStateActive {
+	// a scheduled grant is inactive until its activation, deactivating it cancels the activation
+	scheduled := existingUserGrant.State == domain.UserGrantStateInactive && existingUserGrant.ValidFrom != nil
+	if existingUserGrant.State != domain.UserGrantStateActive && !scheduled {
 		return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
 	}
 	if check != nil {
-		err = check(existingUserGrant.ProjectID, existingUserGrant.ProjectGrantID)(existingUserGrant.ResourceOwner, "")
+		err = check(existingUserGrant.ProjectID, existingUserGrant.ProjectGrantID, existingUserGrant.RoleKeys...)(existingUserGrant.ResourceOwner, "")
 	} else {
 		err = checkExplicitProjectPermission(ctx, existingUserGrant.ProjectGrantID, existingUserGrant.ProjectID)
 	}
@@ -169,7 +200,9 @@ func (c *Commands) DeactivateUserGrant(ctx context.Context, grantID string, reso
 	}
 	deactivateUserGrant := NewUserGrantWriteModel(grantID, existingUserGrant.ResourceOwner)
 	userGrantAgg := UserGrantAggregateFromWriteModel(&deactivateUserGrant.WriteModel)
-	pushedEvents, err := c.eventstore.Push(ctx, usergrant.NewUserGrantDeactivatedEvent(ctx, userGrantAgg))
+	deactivated := usergrant.NewUserGrantDeactivatedEvent(ctx, userGrantAgg)
+	deactivated.ScheduleCancelled = scheduled
+	pushedEvents, err := c.eventstore.Push(ctx, deactivated)
 	if err != nil {
 		return nil, err
 	}
@@ -196,7 +229,7 @@ func (c *Commands) ReactivateUserGrant(ctx context.Context, grantID string, reso
 		return writeModelToObjectDetails(&existingUserGrant.WriteModel), nil
 	}
 	if check != nil {
-		err = check(existingUserGrant.ProjectID, existingUserGrant.ProjectGrantID)(existingUserGrant.ResourceOwner, "")
+		err = check(existingUserGrant.ProjectID, existingUserGrant.ProjectGrantID, existingUserGrant.RoleKeys...)(existingUserGrant.ResourceOwner, "")
 	} else {
 		err = checkExplicitProjectPermission(ctx, existingUserGrant.ProjectGrantID, existingUserGrant.ProjectID)
 	}
@@ -273,7 +306,7 @@ func (c *Commands) removeUserGrant(ctx context.Context, grantID string, resource
 		}
 	}
 	if check != nil {
-		if err = check(existingUserGrant.ProjectID, existingUserGrant.ProjectGrantID)(existingUserGrant.ResourceOwner, ""); err != nil {
+		if err = check(existingUserGrant.ProjectID, existingUserGrant.ProjectGrantID, existingUserGrant.RoleKeys...)(existingUserGrant.ResourceOwner, ""); err != nil {
 			return nil, nil, err
 		}
 	}
@@ -338,7 +371,7 @@ func (c *Commands) checkUserGrantPreCondition(ctx context.Context, usergrant *do
 		return zerrors.ThrowPreconditionFailed(err, "COMMAND-mm9F4", "Errors.Project.Role.NotFound")
 	}
 	if check != nil {
-		return check(usergrant.ProjectID, usergrant.ProjectGrantID)(usergrant.ResourceOwner, "")
+		return check(usergrant.ProjectID, usergrant.ProjectGrantID, usergrant.RoleKeys...)(usergrant.ResourceOwner, "")
 	}
 	return nil
 }
@@ -486,7 +519,7 @@ func (c *Commands) checkUserGrantPreConditionOld(ctx context.Context, usergrant
 		return zerrors.ThrowPreconditionFailed(err, "COMMAND-mm9F4", "Errors.Project.Role.NotFound")
 	}
 	if check != nil {
-		return check(usergrant.ProjectID, usergrant.ProjectGrantID)(usergrant.ResourceOwner, "")
+		return check(usergrant.ProjectID, usergrant.ProjectGrantID, usergrant.RoleKeys...)(usergrant.ResourceOwner, "")
 	}
 	return nil
 }
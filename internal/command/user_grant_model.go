@@ -2,6 +2,7 @@ package command
 
 import (
 	"slices"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -17,7 +18,11 @@ type UserGrantWriteModel struct {
 	ProjectID      string
 	ProjectGrantID string
 	RoleKeys       []string
+	ValidFrom      *time.Time
+	ValidUntil     *time.Time
 	State          domain.UserGrantState
+	// CreationDate is the time the grant was added.
+	CreationDate time.Time
 }
 
 func NewUserGrantWriteModel(userGrantID string, resourceOwner string) *UserGrantWriteModel {
@@ -37,21 +42,35 @@ func (wm *UserGrantWriteModel) Reduce() error {
 			wm.ProjectID = e.ProjectID
 			wm.ProjectGrantID = e.ProjectGrantID
 			wm.RoleKeys = e.RoleKeys
+			wm.ValidFrom = e.ValidFrom
+			wm.ValidUntil = e.ValidUntil
 			wm.State = domain.UserGrantStateActive
 			wm.ResourceOwner = e.Aggregate().ResourceOwner
+			wm.CreationDate = e.CreationDate()
 		case *usergrant.UserGrantChangedEvent:
 			wm.RoleKeys = e.RoleKeys
+			if e.ValidUntil != nil || e.ValidityCleared {
+				wm.ValidUntil = e.ValidUntil
+			}
 		case *usergrant.UserGrantCascadeChangedEvent:
 			wm.RoleKeys = e.RoleKeys
+			if e.ValidUntil != nil || e.ValidityCleared {
+				wm.ValidUntil = e.ValidUntil
+			}
 		case *usergrant.UserGrantDeactivatedEvent:
 			if wm.State == domain.UserGrantStateRemoved {
 				continue
 			}
+			if e.ScheduleCancelled {
+				wm.ValidFrom = nil
+			}
 			wm.State = domain.UserGrantStateInactive
 		case *usergrant.UserGrantReactivatedEvent:
 			if wm.State == domain.UserGrantStateRemoved {
 				continue
 			}
+			// the scheduled activation is obsolete, once the grant is active
+			wm.ValidFrom = nil
 			wm.State = domain.UserGrantStateActive
 		case *usergrant.UserGrantRemovedEvent:
 			wm.State = domain.UserGrantStateRemoved
@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/muhlemmer/gu"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/text/language"
 
@@ -25,10 +27,10 @@ var (
 	isMockedPermissionCheckErr = func(err error) bool {
 		return errors.Is(err, errMockedPermissionCheck)
 	}
-	succeedingUserGrantPermissionCheck = func(_, _ string) PermissionCheck {
+	succeedingUserGrantPermissionCheck = func(_, _ string, _ ...string) PermissionCheck {
 		return func(_, _ string) error { return nil }
 	}
-	failingUserGrantPermissionCheck = func(_, _ string) PermissionCheck {
+	failingUserGrantPermissionCheck = func(_, _ string, _ ...string) PermissionCheck {
 		return func(_, _ string) error { return errMockedPermissionCheck }
 	}
 )
@@ -1620,6 +1622,122 @@ func TestCommandSide_ChangeUserGrant(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "clear and set validity, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							usergrant.NewUserGrantAddedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"", []string{"rolekey1"}),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				userGrant: &domain.UserGrant{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "usergrant1",
+						ResourceOwner: "org1",
+					},
+					RoleKeys:        []string{"rolekey1"},
+					ValidUntil:      gu.Ptr(time.Now().Add(time.Hour)),
+					ClearValidUntil: true,
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "usergrant validity cleared, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							func() eventstore.Command {
+								e := usergrant.NewUserGrantAddedEvent(context.Background(),
+									&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+									"user1",
+									"project1",
+									"", []string{"rolekey1"})
+								e.ValidUntil = gu.Ptr(time.Now().Add(time.Hour))
+								return e
+							}(),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username1",
+								"firstname1",
+								"lastname1",
+								"nickname1",
+								"displayname1",
+								language.German,
+								domain.GenderMale,
+								"email1",
+								true,
+							),
+						),
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"rolekey1",
+								"rolekey",
+								"",
+							),
+						),
+					),
+					expectPush(
+						func() eventstore.Command {
+							e := usergrant.NewUserGrantCascadeChangedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								[]string{"rolekey1"},
+							)
+							e.ValidityCleared = true
+							return e
+						}(),
+					),
+				),
+			},
+			args: args{
+				ctx: authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				userGrant: &domain.UserGrant{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "usergrant1",
+						ResourceOwner: "org1",
+					},
+					RoleKeys:        []string{"rolekey1"},
+					ClearValidUntil: true,
+				},
+				cascade: true,
+			},
+			res: res{
+				want: &domain.UserGrant{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "usergrant1",
+						ResourceOwner: "org1",
+					},
+					UserID:    "user1",
+					ProjectID: "project1",
+					State:     domain.UserGrantStateActive,
+					RoleKeys:  []string{"rolekey1"},
+				},
+			},
+		},
 		{
 			name: "usergrant for projectgrant, ok",
 			fields: fields{
@@ -2321,6 +2439,36 @@ func TestCommandSide_DeactivateUserGrant(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "scheduled, schedule cancelled, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							scheduledUserGrantAddedEvent(time.Now().Add(time.Hour)),
+						),
+						eventFromEventPusher(
+							usergrant.NewUserGrantDeactivatedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							),
+						),
+					),
+					expectPush(
+						scheduleCancelledUserGrantDeactivatedEvent(),
+					),
+				),
+			},
+			args: args{
+				ctx:           authz.NewMockContextWithPermissions("", "", "", []string{domain.RoleProjectOwner}),
+				userGrantID:   "usergrant1",
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
 		{
 			name: "with passed succeeding permission check, ok",
 			fields: fields{
@@ -3209,3 +3357,23 @@ func TestCommandSide_BulkRemoveUserGrant(t *testing.T) {
 		})
 	}
 }
+
+func scheduledUserGrantAddedEvent(validFrom time.Time) *usergrant.UserGrantAddedEvent {
+	event := usergrant.NewUserGrantAddedEvent(context.Background(),
+		&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+		"user1",
+		"project1",
+		"",
+		[]string{"rolekey1"},
+	)
+	event.ValidFrom = &validFrom
+	return event
+}
+
+func scheduleCancelledUserGrantDeactivatedEvent() *usergrant.UserGrantDeactivatedEvent {
+	event := usergrant.NewUserGrantDeactivatedEvent(context.Background(),
+		&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+	)
+	event.ScheduleCancelled = true
+	return event
+}
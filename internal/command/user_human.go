@@ -13,6 +13,7 @@ import (
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/notification/senders"
+	"github.com/zitadel/zitadel/internal/repository/quota"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -185,6 +186,9 @@ func (c *Commands) AddHumanCommand(human *AddHuman, orgID string, hasher *crypto
 		}
 
 		return func(ctx context.Context, filter preparation.FilterToQueryReducer) ([]eventstore.Command, error) {
+			if err := c.checkOrgQuota(ctx, orgID, quota.UsersAll); err != nil {
+				return nil, err
+			}
 			if err := c.addHumanCommandCheckID(ctx, filter, human, orgID); err != nil {
 				return nil, err
 			}
@@ -445,6 +449,9 @@ func (c *Commands) ImportHuman(ctx context.Context, orgID string, human *domain.
 	if orgID == "" {
 		return nil, nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-5N8fs", "Errors.ResourceOwnerMissing")
 	}
+	if err := c.checkOrgQuota(ctx, orgID, quota.UsersAll); err != nil {
+		return nil, nil, err
+	}
 	domainPolicy, err := c.getOrgDomainPolicy(ctx, orgID)
 	if err != nil {
 		return nil, nil, zerrors.ThrowPreconditionFailed(err, "COMMAND-2N9fs", "Errors.Org.DomainPolicy.NotFound")
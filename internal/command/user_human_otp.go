@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/pquerna/otp"
@@ -50,29 +51,53 @@ func (c *Commands) AddHumanTOTP(ctx context.Context, userID, resourceOwner strin
 	if userID == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-5M0sd", "Errors.User.UserIDMissing")
 	}
-	prep, err := c.createHumanTOTP(ctx, userID, resourceOwner)
+	prep, err := c.createHumanTOTP(ctx, userID, resourceOwner, "", "")
 	if err != nil {
 		return nil, err
 	}
-	err = c.pushAppendAndReduce(ctx, prep.wm, prep.cmds...)
+	return c.pushPreparedTOTP(ctx, prep)
+}
+
+// AddHumanNamedTOTP adds an additional TOTP authenticator to the user, which is identified by a generated ID.
+// Other than the default authenticator of [Commands.AddHumanTOTP] it can be added even if the user already has a verified one.
+// The name is optional and can also be set on verification.
+func (c *Commands) AddHumanNamedTOTP(ctx context.Context, userID, resourceOwner, name string) (*domain.TOTP, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tq5nA", "Errors.User.UserIDMissing")
+	}
+	totpID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	prep, err := c.createHumanTOTP(ctx, userID, resourceOwner, totpID, strings.TrimSpace(name))
 	if err != nil {
 		return nil, err
 	}
+	return c.pushPreparedTOTP(ctx, prep)
+}
+
+func (c *Commands) pushPreparedTOTP(ctx context.Context, prep *preparedTOTP) (*domain.TOTP, error) {
+	if err := c.pushAppendAndReduce(ctx, prep.wm, prep.cmds...); err != nil {
+		return nil, err
+	}
 	return &domain.TOTP{
 		ObjectDetails: writeModelToObjectDetails(&prep.wm.WriteModel),
+		ID:            prep.totpID,
 		Secret:        prep.key.Secret(),
 		URI:           prep.key.URL(),
 	}, nil
 }
 
 type preparedTOTP struct {
-	wm      *HumanTOTPWriteModel
+	wm      *HumanTOTPsWriteModel
 	userAgg *eventstore.Aggregate
+	totpID  string
 	key     *otp.Key
 	cmds    []eventstore.Command
 }
 
-func (c *Commands) createHumanTOTP(ctx context.Context, userID, resourceOwner string) (*preparedTOTP, error) {
+// createHumanTOTP prepares the default authenticator if the totpID is empty, otherwise a named one.
+func (c *Commands) createHumanTOTP(ctx context.Context, userID, resourceOwner, totpID, name string) (*preparedTOTP, error) {
 	human, err := c.getHuman(ctx, userID, resourceOwner)
 	if err != nil {
 		logging.WithError(err).WithField("traceID", tracing.TraceIDFromCtx(ctx)).Debug("unable to get human for loginname")
@@ -92,11 +117,12 @@ func (c *Commands) createHumanTOTP(ctx context.Context, userID, resourceOwner st
 		return nil, zerrors.ThrowPreconditionFailed(err, "COMMAND-8ugTs", "Errors.Org.DomainPolicy.NotFound")
 	}
 
-	otpWriteModel, err := c.totpWriteModelByID(ctx, userID, resourceOwner)
+	otpWriteModel, err := c.totpsWriteModelByID(ctx, userID, resourceOwner)
 	if err != nil {
 		return nil, err
 	}
-	if otpWriteModel.State == domain.MFAStateReady {
+	// the default authenticator keeps the behavior of a single TOTP per user
+	if totpID == "" && len(otpWriteModel.ReadyAuthenticators()) > 0 {
 		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-do9se", "Errors.User.MFA.OTP.AlreadyReady")
 	}
 	userAgg := UserAggregateFromWriteModel(&otpWriteModel.WriteModel)
@@ -117,22 +143,40 @@ func (c *Commands) createHumanTOTP(ctx context.Context, userID, resourceOwner st
 	if err != nil {
 		return nil, err
 	}
+	added := user.NewHumanOTPAddedEvent(ctx, userAgg, encryptedSecret)
+	if totpID != "" {
+		added = user.NewHumanTOTPAddedEvent(ctx, userAgg, totpID, name, encryptedSecret)
+	}
 	return &preparedTOTP{
 		wm:      otpWriteModel,
 		userAgg: userAgg,
+		totpID:  totpID,
 		key:     key,
 		cmds: []eventstore.Command{
-			user.NewHumanOTPAddedEvent(ctx, userAgg, encryptedSecret),
+			added,
 		},
 	}, nil
 }
 
 func (c *Commands) HumanCheckMFATOTPSetup(ctx context.Context, userID, code, userAgentID, resourceOwner string) (*domain.ObjectDetails, error) {
+	return c.humanCheckMFATOTPSetup(ctx, userID, "", "", code, userAgentID, resourceOwner)
+}
+
+// HumanCheckMFANamedTOTPSetup verifies the TOTP authenticator identified by the totpID.
+// A non-empty name overwrites the name provided on registration.
+func (c *Commands) HumanCheckMFANamedTOTPSetup(ctx context.Context, userID, totpID, name, code, userAgentID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if totpID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tq5vI", "Errors.User.MFA.OTP.IDMissing")
+	}
+	return c.humanCheckMFATOTPSetup(ctx, userID, totpID, strings.TrimSpace(name), code, userAgentID, resourceOwner)
+}
+
+func (c *Commands) humanCheckMFATOTPSetup(ctx context.Context, userID, totpID, name, code, userAgentID, resourceOwner string) (*domain.ObjectDetails, error) {
 	if userID == "" {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-8N9ds", "Errors.User.UserIDMissing")
 	}
 
-	existingOTP, err := c.totpWriteModelByID(ctx, userID, resourceOwner)
+	existingOTP, err := c.namedTOTPWriteModelByID(ctx, userID, totpID, resourceOwner)
 	if err != nil {
 		return nil, err
 	}
@@ -149,8 +193,12 @@ func (c *Commands) HumanCheckMFATOTPSetup(ctx context.Context, userID, code, use
 		return nil, err
 	}
 	userAgg := UserAggregateFromWriteModel(&existingOTP.WriteModel)
+	verified := user.NewHumanOTPVerifiedEvent(ctx, userAgg, userAgentID)
+	if totpID != "" {
+		verified = user.NewHumanTOTPVerifiedEvent(ctx, userAgg, totpID, name, userAgentID)
+	}
 
-	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanOTPVerifiedEvent(ctx, userAgg, userAgentID))
+	pushedEvents, err := c.eventstore.Push(ctx, verified)
 	if err != nil {
 		return nil, err
 	}
@@ -178,6 +226,8 @@ func (c *Commands) HumanCheckMFATOTP(ctx context.Context, userID, code, resource
 	return err
 }
 
+// checkTOTP verifies the code against all verified TOTP authenticators of the user,
+// the check succeeds if any of them matches.
 func checkTOTP(
 	ctx context.Context,
 	userID, resourceOwner, code string,
@@ -189,16 +239,22 @@ func checkTOTP(
 	if userID == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-8N9ds", "Errors.User.UserIDMissing")
 	}
-	existingOTP := NewHumanTOTPWriteModel(userID, resourceOwner)
+	existingOTP := NewHumanTOTPsWriteModel(userID, resourceOwner)
 	err := queryReducer(ctx, existingOTP)
 	if err != nil {
 		return nil, err
 	}
-	if existingOTP.State != domain.MFAStateReady {
+	authenticators := existingOTP.ReadyAuthenticators()
+	if len(authenticators) == 0 {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-3Mif9s", "Errors.User.MFA.OTP.NotReady")
 	}
 	userAgg := UserAggregateFromWriteModel(&existingOTP.WriteModel)
-	verifyErr := domain.VerifyTOTP(code, existingOTP.Secret, alg)
+	var verifyErr error
+	for _, authenticator := range authenticators {
+		if verifyErr = domain.VerifyTOTP(code, authenticator.Secret, alg); verifyErr == nil {
+			break
+		}
+	}
 
 	// recheck for additional events (failed OTP checks or locks)
 	recheckErr := queryReducer(ctx, existingOTP)
@@ -228,30 +284,61 @@ func checkTOTP(
 	return commands, verifyErr
 }
 
+// HumanRemoveTOTP removes all TOTP authenticators of the user,
+// so the user does not have TOTP as second factor afterward.
 func (c *Commands) HumanRemoveTOTP(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error) {
 	if userID == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-5M0sd", "Errors.User.UserIDMissing")
 	}
 
-	existingOTP, err := c.totpWriteModelByID(ctx, userID, resourceOwner)
+	existingOTP, err := c.totpsWriteModelByID(ctx, userID, resourceOwner)
 	if err != nil {
 		return nil, err
 	}
-	if existingOTP.State == domain.MFAStateUnspecified || existingOTP.State == domain.MFAStateRemoved {
+	if len(existingOTP.Authenticators) == 0 {
 		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Hd9sd", "Errors.User.MFA.OTP.NotExisting")
 	}
 	if err := c.checkPermissionUpdateUser(ctx, existingOTP.ResourceOwner, userID, true); err != nil {
 		return nil, err
 	}
 	userAgg := UserAggregateFromWriteModel(&existingOTP.WriteModel)
-	pushedEvents, err := c.eventstore.Push(ctx, user.NewHumanOTPRemovedEvent(ctx, userAgg))
-	if err != nil {
+	cmds := make([]eventstore.Command, len(existingOTP.Authenticators))
+	for i, authenticator := range existingOTP.Authenticators {
+		cmds[i] = user.NewHumanOTPRemovedEvent(ctx, userAgg)
+		if authenticator.TOTPID != "" {
+			cmds[i] = user.NewHumanTOTPRemovedEvent(ctx, userAgg, authenticator.TOTPID)
+		}
+	}
+	if err = c.pushAppendAndReduce(ctx, existingOTP, cmds...); err != nil {
 		return nil, err
 	}
-	err = AppendAndReduce(existingOTP, pushedEvents...)
+	return writeModelToObjectDetails(&existingOTP.WriteModel), nil
+}
+
+// HumanRemoveNamedTOTP removes the TOTP authenticator identified by the totpID,
+// other authenticators of the user are kept.
+func (c *Commands) HumanRemoveNamedTOTP(ctx context.Context, userID, totpID, resourceOwner string) (*domain.ObjectDetails, error) {
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tq5rU", "Errors.User.UserIDMissing")
+	}
+	if totpID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tq5rI", "Errors.User.MFA.OTP.IDMissing")
+	}
+
+	existingOTP, err := c.namedTOTPWriteModelByID(ctx, userID, totpID, resourceOwner)
 	if err != nil {
 		return nil, err
 	}
+	if existingOTP.State == domain.MFAStateUnspecified || existingOTP.State == domain.MFAStateRemoved {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Tq5rN", "Errors.User.MFA.OTP.NotExisting")
+	}
+	if err := c.checkPermissionUpdateUser(ctx, existingOTP.ResourceOwner, userID, true); err != nil {
+		return nil, err
+	}
+	userAgg := UserAggregateFromWriteModel(&existingOTP.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, existingOTP, user.NewHumanTOTPRemovedEvent(ctx, userAgg, totpID)); err != nil {
+		return nil, err
+	}
 	return writeModelToObjectDetails(&existingOTP.WriteModel), nil
 }
 
@@ -638,10 +725,26 @@ func checkOTP(
 }
 
 func (c *Commands) totpWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *HumanTOTPWriteModel, err error) {
+	return c.namedTOTPWriteModelByID(ctx, userID, "", resourceOwner)
+}
+
+func (c *Commands) namedTOTPWriteModelByID(ctx context.Context, userID, totpID, resourceOwner string) (writeModel *HumanTOTPWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel = NewHumanNamedTOTPWriteModel(userID, totpID, resourceOwner)
+	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
+	if err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
+
+func (c *Commands) totpsWriteModelByID(ctx context.Context, userID, resourceOwner string) (writeModel *HumanTOTPsWriteModel, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	writeModel = NewHumanTOTPWriteModel(userID, resourceOwner)
+	writeModel = NewHumanTOTPsWriteModel(userID, resourceOwner)
 	err = c.eventstore.FilterToQueryReducer(ctx, writeModel)
 	if err != nil {
 		return nil, err
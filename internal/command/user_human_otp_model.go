@@ -9,9 +9,13 @@ import (
 	"github.com/zitadel/zitadel/internal/repository/user"
 )
 
+// HumanTOTPWriteModel represents a single TOTP authenticator of a user.
+// An empty TOTPID represents the default authenticator, which was the only one before named authenticators were introduced.
 type HumanTOTPWriteModel struct {
 	eventstore.WriteModel
 
+	TOTPID           string
+	Name             string
 	State            domain.MFAState
 	Secret           *crypto.CryptoValue
 	CheckFailedCount uint64
@@ -19,11 +23,16 @@ type HumanTOTPWriteModel struct {
 }
 
 func NewHumanTOTPWriteModel(userID, resourceOwner string) *HumanTOTPWriteModel {
+	return NewHumanNamedTOTPWriteModel(userID, "", resourceOwner)
+}
+
+func NewHumanNamedTOTPWriteModel(userID, totpID, resourceOwner string) *HumanTOTPWriteModel {
 	return &HumanTOTPWriteModel{
 		WriteModel: eventstore.WriteModel{
 			AggregateID:   userID,
 			ResourceOwner: resourceOwner,
 		},
+		TOTPID: totpID,
 	}
 }
 
@@ -31,9 +40,19 @@ func (wm *HumanTOTPWriteModel) Reduce() error {
 	for _, event := range wm.Events {
 		switch e := event.(type) {
 		case *user.HumanOTPAddedEvent:
+			if e.TOTPID != wm.TOTPID {
+				continue
+			}
 			wm.Secret = e.Secret
+			wm.Name = e.Name
 			wm.State = domain.MFAStateNotReady
 		case *user.HumanOTPVerifiedEvent:
+			if e.TOTPID != wm.TOTPID {
+				continue
+			}
+			if e.Name != "" {
+				wm.Name = e.Name
+			}
 			wm.State = domain.MFAStateReady
 			wm.CheckFailedCount = 0
 		case *user.HumanOTPCheckSucceededEvent:
@@ -46,6 +65,9 @@ func (wm *HumanTOTPWriteModel) Reduce() error {
 			wm.CheckFailedCount = 0
 			wm.UserLocked = false
 		case *user.HumanOTPRemovedEvent:
+			if e.TOTPID != wm.TOTPID {
+				continue
+			}
 			wm.State = domain.MFAStateRemoved
 		case *user.UserRemovedEvent:
 			wm.State = domain.MFAStateRemoved
@@ -55,10 +77,112 @@ func (wm *HumanTOTPWriteModel) Reduce() error {
 }
 
 func (wm *HumanTOTPWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return humanTOTPQuery(wm.AggregateID, wm.ResourceOwner)
+}
+
+// TOTPAuthenticator is a single TOTP authenticator of the [HumanTOTPsWriteModel].
+type TOTPAuthenticator struct {
+	TOTPID string
+	Name   string
+	State  domain.MFAState
+	Secret *crypto.CryptoValue
+}
+
+// HumanTOTPsWriteModel represents all TOTP authenticators of a user,
+// including the default authenticator with an empty TOTPID.
+// The failed check count and the lock are shared by all authenticators.
+type HumanTOTPsWriteModel struct {
+	eventstore.WriteModel
+
+	Authenticators   []*TOTPAuthenticator
+	CheckFailedCount uint64
+	UserLocked       bool
+}
+
+func NewHumanTOTPsWriteModel(userID, resourceOwner string) *HumanTOTPsWriteModel {
+	return &HumanTOTPsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanTOTPsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanOTPAddedEvent:
+			wm.removeAuthenticator(e.TOTPID)
+			wm.Authenticators = append(wm.Authenticators, &TOTPAuthenticator{
+				TOTPID: e.TOTPID,
+				Name:   e.Name,
+				State:  domain.MFAStateNotReady,
+				Secret: e.Secret,
+			})
+		case *user.HumanOTPVerifiedEvent:
+			if authenticator := wm.authenticator(e.TOTPID); authenticator != nil {
+				if e.Name != "" {
+					authenticator.Name = e.Name
+				}
+				authenticator.State = domain.MFAStateReady
+			}
+			wm.CheckFailedCount = 0
+		case *user.HumanOTPCheckSucceededEvent:
+			wm.CheckFailedCount = 0
+		case *user.HumanOTPCheckFailedEvent:
+			wm.CheckFailedCount++
+		case *user.UserLockedEvent:
+			wm.UserLocked = true
+		case *user.UserUnlockedEvent:
+			wm.CheckFailedCount = 0
+			wm.UserLocked = false
+		case *user.HumanOTPRemovedEvent:
+			wm.removeAuthenticator(e.TOTPID)
+		case *user.UserRemovedEvent:
+			wm.Authenticators = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanTOTPsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return humanTOTPQuery(wm.AggregateID, wm.ResourceOwner)
+}
+
+func (wm *HumanTOTPsWriteModel) authenticator(totpID string) *TOTPAuthenticator {
+	for _, authenticator := range wm.Authenticators {
+		if authenticator.TOTPID == totpID {
+			return authenticator
+		}
+	}
+	return nil
+}
+
+func (wm *HumanTOTPsWriteModel) removeAuthenticator(totpID string) {
+	for i, authenticator := range wm.Authenticators {
+		if authenticator.TOTPID == totpID {
+			wm.Authenticators = append(wm.Authenticators[:i], wm.Authenticators[i+1:]...)
+			return
+		}
+	}
+}
+
+// ReadyAuthenticators returns the verified authenticators, which can be used to check a code.
+func (wm *HumanTOTPsWriteModel) ReadyAuthenticators() []*TOTPAuthenticator {
+	ready := make([]*TOTPAuthenticator, 0, len(wm.Authenticators))
+	for _, authenticator := range wm.Authenticators {
+		if authenticator.State == domain.MFAStateReady {
+			ready = append(ready, authenticator)
+		}
+	}
+	return ready
+}
+
+func humanTOTPQuery(userID, resourceOwner string) *eventstore.SearchQueryBuilder {
 	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
 		AddQuery().
 		AggregateTypes(user.AggregateType).
-		AggregateIDs(wm.AggregateID).
+		AggregateIDs(userID).
 		EventTypes(user.HumanMFAOTPAddedType,
 			user.HumanMFAOTPVerifiedType,
 			user.HumanMFAOTPRemovedType,
@@ -72,8 +196,8 @@ func (wm *HumanTOTPWriteModel) Query() *eventstore.SearchQueryBuilder {
 			user.UserV1MFAOTPRemovedType).
 		Builder()
 
-	if wm.ResourceOwner != "" {
-		query.ResourceOwner(wm.ResourceOwner)
+	if resourceOwner != "" {
+		query.ResourceOwner(resourceOwner)
 	}
 	return query
 }
@@ -263,6 +263,8 @@ func TestCommands_createHumanTOTP(t *testing.T) {
 		ctx           context.Context
 		userID        string
 		resourceOwner string
+		totpID        string
+		name          string
 	}
 	tests := []struct {
 		name    string
@@ -592,6 +594,134 @@ func TestCommands_createHumanTOTP(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "success, named with verified default",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(),
+								&user.NewAggregate("org1", "org1").Aggregate,
+								"org",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanOTPVerifiedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"agent1",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           http_util.WithRequestedHost(authz.NewMockContext("instanceID", "org1", "user1"), "zitadel.com"),
+				resourceOwner: "org1",
+				userID:        "user1",
+				totpID:        "totp1",
+				name:          "phone",
+			},
+			want: true,
+		},
+		{
+			name: "default with verified named, already exists error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(),
+								&user.NewAggregate("org1", "org1").Aggregate,
+								"org",
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							org.NewDomainPolicyAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								true,
+								true,
+								true,
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanTOTPAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"totp1",
+								"phone",
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanTOTPVerifiedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"totp1",
+								"",
+								"agent1",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:           authz.NewMockContext("instanceID", "org1", "user1"),
+				resourceOwner: "org1",
+				userID:        "user1",
+			},
+			wantErr: zerrors.ThrowAlreadyExists(nil, "COMMAND-do9se", "Errors.User.MFA.OTP.AlreadyReady"),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -604,7 +734,7 @@ func TestCommands_createHumanTOTP(t *testing.T) {
 					},
 				},
 			}
-			got, err := c.createHumanTOTP(tt.args.ctx, tt.args.userID, tt.args.resourceOwner)
+			got, err := c.createHumanTOTP(tt.args.ctx, tt.args.userID, tt.args.resourceOwner, tt.args.totpID, tt.args.name)
 			require.ErrorIs(t, err, tt.wantErr)
 			if tt.want {
 				require.NotNil(t, got)
@@ -613,7 +743,12 @@ func TestCommands_createHumanTOTP(t *testing.T) {
 				require.NotNil(t, got.key)
 				assert.NotEmpty(t, got.key.URL())
 				assert.NotEmpty(t, got.key.Secret())
-				assert.Len(t, got.cmds, 1)
+				require.Len(t, got.cmds, 1)
+				assert.Equal(t, tt.args.totpID, got.totpID)
+				added, ok := got.cmds[0].(*user.HumanOTPAddedEvent)
+				require.True(t, ok)
+				assert.Equal(t, tt.args.totpID, added.TOTPID)
+				assert.Equal(t, tt.args.name, added.Name)
 			}
 		})
 	}
@@ -842,6 +977,112 @@ func TestCommands_HumanCheckMFATOTPSetup(t *testing.T) {
 	}
 }
 
+func TestCommands_HumanCheckMFANamedTOTPSetup(t *testing.T) {
+	ctx := authz.NewMockContext("", "org1", "user1")
+
+	cryptoAlg := crypto.CreateMockEncryptionAlg(gomock.NewController(t))
+	key, err := domain.NewTOTPKey("example.com", "user1")
+	require.NoError(t, err)
+	secret, err := crypto.Encrypt([]byte(key.Secret()), cryptoAlg)
+	require.NoError(t, err)
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+
+	code, err := totp.GenerateCode(key.Secret(), time.Now())
+	require.NoError(t, err)
+
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		totpID string
+		name   string
+		code   string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    bool
+		wantErr error
+	}{
+		{
+			name: "missing totp id",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args:    args{},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Tq5vI", "Errors.User.MFA.OTP.IDMissing"),
+		},
+		{
+			name: "other authenticator, not existing error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPAddedEvent(ctx, userAgg, secret),
+						),
+					),
+				),
+			},
+			args: args{
+				totpID: "totp1",
+				code:   code,
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-3Mif9s", "Errors.User.MFA.OTP.NotExisting"),
+		},
+		{
+			name: "success, with verified default",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPAddedEvent(ctx, userAgg, secret),
+						),
+						eventFromEventPusher(
+							user.NewHumanOTPVerifiedEvent(ctx, userAgg, "agent1"),
+						),
+						eventFromEventPusher(
+							user.NewHumanTOTPAddedEvent(ctx, userAgg, "totp1", "", secret),
+						),
+					),
+					expectPush(
+						user.NewHumanTOTPVerifiedEvent(ctx,
+							userAgg,
+							"totp1",
+							"phone",
+							"agent1",
+						),
+					),
+				),
+			},
+			args: args{
+				totpID: "totp1",
+				name:   " phone ",
+				code:   code,
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+				multifactors: domain.MultifactorConfigs{
+					OTP: domain.OTPConfig{
+						CryptoMFA: cryptoAlg,
+					},
+				},
+			}
+			got, err := c.HumanCheckMFANamedTOTPSetup(ctx, "user1", tt.args.totpID, tt.args.name, tt.args.code, "agent1", "org1")
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.want {
+				require.NotNil(t, got)
+				assert.Equal(t, "org1", got.ResourceOwner)
+			}
+		})
+	}
+}
+
 func TestCommandSide_RemoveHumanTOTP(t *testing.T) {
 	type fields struct {
 		eventstore      func(t *testing.T) *eventstore.Eventstore
@@ -949,6 +1190,49 @@ func TestCommandSide_RemoveHumanTOTP(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "otp remove multiple, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanTOTPAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"totp1",
+								"phone",
+								nil,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanOTPRemovedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+						),
+						user.NewHumanTOTPRemovedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"totp1",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "user1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -970,6 +1254,157 @@ func TestCommandSide_RemoveHumanTOTP(t *testing.T) {
 	}
 }
 
+func TestCommandSide_RemoveHumanNamedTOTP(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type (
+		args struct {
+			ctx    context.Context
+			orgID  string
+			userID string
+			totpID string
+		}
+	)
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "totp id missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "user1",
+				totpID: "",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "totp not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "user1",
+				totpID: "totp1",
+			},
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "totp, no permission error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanTOTPAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"totp1",
+								"phone",
+								nil,
+							),
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "user1",
+				totpID: "totp1",
+			},
+			res: res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			name: "totp remove, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanOTPAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							user.NewHumanTOTPAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"totp1",
+								"phone",
+								nil,
+							),
+						),
+					),
+					expectPush(
+						user.NewHumanTOTPRemovedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"totp1",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "user1",
+				totpID: "totp1",
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := r.HumanRemoveNamedTOTP(tt.args.ctx, tt.args.userID, tt.args.totpID, tt.args.orgID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.want, got)
+			}
+		})
+	}
+}
+
 func TestCommandSide_AddHumanOTPSMS(t *testing.T) {
 	ctx := authz.NewMockContext("inst1", "org1", "user1")
 	type fields struct {
@@ -0,0 +1,141 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AddUserPushDevice starts the enrollment of a device, which approves push notifications as second factor.
+// The returned URI contains the enrollment code and is meant to be transferred to the device as QR code.
+// The enrollment code shares the generator settings of the passkey registration codes.
+func (c *Commands) AddUserPushDevice(ctx context.Context, userID, resourceOwner string) (_ *domain.PushDeviceRegistration, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu5aU", "Errors.User.UserIDMissing")
+	}
+	human, err := c.getHuman(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, zerrors.ThrowPreconditionFailed(err, "COMMAND-Pu5aN", "Errors.User.NotFound")
+	}
+	if err := c.checkPermissionUpdateUserCredentials(ctx, human.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
+	deviceID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	code, err := c.newEncryptedCode(ctx, c.eventstore.Filter, domain.SecretGeneratorTypePasswordlessInitCode, c.userEncryption)
+	if err != nil {
+		return nil, err
+	}
+	wm, err := c.pushDevicesWriteModel(ctx, userID, human.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	userAgg := UserAggregateFromWriteModel(&wm.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, wm, user.NewHumanPushDeviceAddedEvent(ctx, userAgg, deviceID, code.Crypted, code.Expiry)); err != nil {
+		return nil, err
+	}
+	return &domain.PushDeviceRegistration{
+		ObjectDetails: writeModelToObjectDetails(&wm.WriteModel),
+		DeviceID:      deviceID,
+		Code:          code.Plain,
+		Expiry:        code.Expiry,
+		URI:           domain.PushEnrollmentURI(http_util.DomainContext(ctx).Origin(), userID, deviceID, code.Plain),
+	}, nil
+}
+
+type VerifyPushDevice struct {
+	UserID    string
+	DeviceID  string
+	Code      string
+	Name      string
+	PublicKey []byte
+	PushToken string
+}
+
+// VerifyUserPushDevice finishes the enrollment of a device.
+// It's called by the device itself, which authenticates with the enrollment code, so no permission is checked.
+func (c *Commands) VerifyUserPushDevice(ctx context.Context, verify *VerifyPushDevice) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if verify.UserID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu5vU", "Errors.User.UserIDMissing")
+	}
+	if verify.DeviceID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu5vI", "Errors.User.MFA.Push.IDMissing")
+	}
+	if verify.PushToken == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu5vT", "Errors.User.MFA.Push.PushTokenMissing")
+	}
+	if _, err = domain.ParsePushPublicKey(verify.PublicKey); err != nil {
+		return nil, err
+	}
+	wm, err := c.pushDevicesWriteModel(ctx, verify.UserID, "")
+	if err != nil {
+		return nil, err
+	}
+	device := wm.Device(verify.DeviceID)
+	if device == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pu5vN", "Errors.User.MFA.Push.NotExisting")
+	}
+	if device.State == domain.MFAStateReady {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5vR", "Errors.User.MFA.Push.AlreadyReady")
+	}
+	if err = crypto.VerifyCode(device.CodeCreationDate, device.CodeExpiry, device.Code, verify.Code, c.userEncryption); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "COMMAND-Pu5vC", "Errors.User.MFA.Push.CodeInvalid")
+	}
+	userAgg := UserAggregateFromWriteModel(&wm.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, wm,
+		user.NewHumanPushDeviceVerifiedEvent(ctx, userAgg, verify.DeviceID, strings.TrimSpace(verify.Name), verify.PublicKey, verify.PushToken),
+	); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+// RemoveUserPushDevice removes the device identified by the deviceID, other devices of the user are kept.
+func (c *Commands) RemoveUserPushDevice(ctx context.Context, userID, deviceID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu5rU", "Errors.User.UserIDMissing")
+	}
+	if deviceID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu5rI", "Errors.User.MFA.Push.IDMissing")
+	}
+	wm, err := c.pushDevicesWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if wm.Device(deviceID) == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Pu5rN", "Errors.User.MFA.Push.NotExisting")
+	}
+	if err := c.checkPermissionUpdateUser(ctx, wm.ResourceOwner, userID, true); err != nil {
+		return nil, err
+	}
+	userAgg := UserAggregateFromWriteModel(&wm.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, wm, user.NewHumanPushDeviceRemovedEvent(ctx, userAgg, deviceID)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&wm.WriteModel), nil
+}
+
+func (c *Commands) pushDevicesWriteModel(ctx context.Context, userID, resourceOwner string) (*HumanPushDevicesWriteModel, error) {
+	wm := NewHumanPushDevicesWriteModel(userID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
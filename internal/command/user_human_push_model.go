@@ -0,0 +1,114 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// PushDevice is a single device of the [HumanPushDevicesWriteModel], which approves push notifications.
+type PushDevice struct {
+	DeviceID  string
+	Name      string
+	State     domain.MFAState
+	PublicKey []byte
+	PushToken string
+
+	Code             *crypto.CryptoValue
+	CodeCreationDate time.Time
+	CodeExpiry       time.Duration
+}
+
+type HumanPushDevicesWriteModel struct {
+	eventstore.WriteModel
+
+	Devices []*PushDevice
+}
+
+func NewHumanPushDevicesWriteModel(userID, resourceOwner string) *HumanPushDevicesWriteModel {
+	return &HumanPushDevicesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *HumanPushDevicesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanPushDeviceAddedEvent:
+			wm.Devices = append(wm.Devices, &PushDevice{
+				DeviceID:         e.DeviceID,
+				State:            domain.MFAStateNotReady,
+				Code:             e.Code,
+				CodeCreationDate: e.CreationDate(),
+				CodeExpiry:       e.Expiry,
+			})
+		case *user.HumanPushDeviceVerifiedEvent:
+			if device := wm.Device(e.DeviceID); device != nil {
+				device.Name = e.Name
+				device.PublicKey = e.PublicKey
+				device.PushToken = e.PushToken
+				device.State = domain.MFAStateReady
+				device.Code = nil
+			}
+		case *user.HumanPushDeviceRemovedEvent:
+			wm.removeDevice(e.DeviceID)
+		case *user.UserRemovedEvent:
+			wm.Devices = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *HumanPushDevicesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanPushDeviceAddedType,
+			user.HumanPushDeviceVerifiedType,
+			user.HumanPushDeviceRemovedType,
+			user.UserRemovedType,
+		).
+		Builder()
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// Device returns the device identified by the deviceID or nil if it doesn't exist.
+func (wm *HumanPushDevicesWriteModel) Device(deviceID string) *PushDevice {
+	for _, device := range wm.Devices {
+		if device.DeviceID == deviceID {
+			return device
+		}
+	}
+	return nil
+}
+
+// ReadyDevices returns the verified devices, which can approve push notifications.
+func (wm *HumanPushDevicesWriteModel) ReadyDevices() []*PushDevice {
+	ready := make([]*PushDevice, 0, len(wm.Devices))
+	for _, device := range wm.Devices {
+		if device.State == domain.MFAStateReady {
+			ready = append(ready, device)
+		}
+	}
+	return ready
+}
+
+func (wm *HumanPushDevicesWriteModel) removeDevice(deviceID string) {
+	for i, device := range wm.Devices {
+		if device.DeviceID == deviceID {
+			wm.Devices = append(wm.Devices[:i], wm.Devices[i+1:]...)
+			return
+		}
+	}
+}
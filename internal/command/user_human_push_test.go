@@ -0,0 +1,387 @@
+package command
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddUserPushDevice(t *testing.T) {
+	ctx := http_util.WithDomainContext(authz.NewMockContext("instance1", "org1", "admin1"), &http_util.DomainCtx{
+		InstanceHost: "example.com",
+		Protocol:     "https",
+	})
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	humanAdded := eventFromEventPusher(
+		user.NewHumanAddedEvent(context.Background(),
+			userAgg,
+			"username",
+			"firstname",
+			"lastname",
+			"nickname",
+			"displayname",
+			language.German,
+			domain.GenderUnspecified,
+			"email@test.ch",
+			true,
+		),
+	)
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		idGenerator     id.Generator
+		checkPermission domain.PermissionCheck
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		userID  string
+		want    *domain.PushDeviceRegistration
+		wantErr error
+	}{
+		{
+			name: "missing userID, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu5aU", "Errors.User.UserIDMissing"),
+		},
+		{
+			name: "user not existing, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			userID:  "user1",
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5aN", "Errors.User.NotFound"),
+		},
+		{
+			name: "no permission, permission denied error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(humanAdded),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			userID:  "user1",
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "id generator error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(humanAdded),
+				),
+				idGenerator:     id_mock.NewIDGeneratorExpectError(t, io.ErrClosedPipe),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			userID:  "user1",
+			wantErr: io.ErrClosedPipe,
+		},
+		{
+			name: "add, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(humanAdded),
+					expectFilter(),
+					expectPush(
+						user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device1",
+							&crypto.CryptoValue{
+								CryptoType: crypto.TypeEncryption,
+								Algorithm:  "enc",
+								KeyID:      "id",
+								Crypted:    []byte("code"),
+							},
+							time.Hour,
+						),
+					),
+				),
+				idGenerator:     id_mock.NewIDGeneratorExpectIDs(t, "device1"),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			userID: "user1",
+			want: &domain.PushDeviceRegistration{
+				ObjectDetails: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				DeviceID: "device1",
+				Code:     "code",
+				Expiry:   time.Hour,
+				URI:      "zitadel-push://enroll?code=code&device=device1&origin=https%3A%2F%2Fexample.com&user=user1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:       tt.fields.eventstore(t),
+				idGenerator:      tt.fields.idGenerator,
+				checkPermission:  tt.fields.checkPermission,
+				newEncryptedCode: mockEncryptedCode("code", time.Hour),
+			}
+			got, err := c.AddUserPushDevice(ctx, tt.userID, "org1")
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assertObjectDetails(t, tt.want.ObjectDetails, got.ObjectDetails)
+			assert.Equal(t, tt.want.DeviceID, got.DeviceID)
+			assert.Equal(t, tt.want.Code, got.Code)
+			assert.Equal(t, tt.want.Expiry, got.Expiry)
+			assert.Equal(t, tt.want.URI, got.URI)
+		})
+	}
+}
+
+func TestCommands_VerifyUserPushDevice(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	publicKey := newTestPushPublicKey(t)
+	code := &crypto.CryptoValue{
+		CryptoType: crypto.TypeEncryption,
+		Algorithm:  "enc",
+		KeyID:      "id",
+		Crypted:    []byte("code"),
+	}
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		verify  *VerifyPushDevice
+		want    *domain.ObjectDetails
+		wantErr error
+	}{
+		{
+			name: "missing deviceID, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			verify: &VerifyPushDevice{
+				UserID: "user1",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu5vI", "Errors.User.MFA.Push.IDMissing"),
+		},
+		{
+			name: "invalid public key, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			verify: &VerifyPushDevice{
+				UserID:    "user1",
+				DeviceID:  "device1",
+				PublicKey: []byte("invalid"),
+				PushToken: "token",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Pu5kP", "Errors.User.MFA.Push.PublicKeyInvalid"),
+		},
+		{
+			name: "device not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			verify: &VerifyPushDevice{
+				UserID:    "user1",
+				DeviceID:  "device1",
+				PublicKey: publicKey,
+				PushToken: "token",
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Pu5vN", "Errors.User.MFA.Push.NotExisting"),
+		},
+		{
+			name: "invalid code, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(
+							user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device1", code, time.Hour),
+						),
+					),
+				),
+			},
+			verify: &VerifyPushDevice{
+				UserID:    "user1",
+				DeviceID:  "device1",
+				Code:      "wrong",
+				PublicKey: publicKey,
+				PushToken: "token",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu5vC", "Errors.User.MFA.Push.CodeInvalid"),
+		},
+		{
+			name: "already verified, precondition error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(
+							user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device1", code, time.Hour),
+						),
+						eventFromEventPusher(
+							user.NewHumanPushDeviceVerifiedEvent(ctx, userAgg, "device1", "phone", publicKey, "token"),
+						),
+					),
+				),
+			},
+			verify: &VerifyPushDevice{
+				UserID:    "user1",
+				DeviceID:  "device1",
+				Code:      "code",
+				PublicKey: publicKey,
+				PushToken: "token",
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Pu5vR", "Errors.User.MFA.Push.AlreadyReady"),
+		},
+		{
+			name: "verify, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusherWithCreationDateNow(
+							user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device1", code, time.Hour),
+						),
+					),
+					expectPush(
+						user.NewHumanPushDeviceVerifiedEvent(ctx, userAgg, "device1", "phone", publicKey, "token"),
+					),
+				),
+			},
+			verify: &VerifyPushDevice{
+				UserID:    "user1",
+				DeviceID:  "device1",
+				Code:      "code",
+				Name:      " phone ",
+				PublicKey: publicKey,
+				PushToken: "token",
+			},
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:     tt.fields.eventstore(t),
+				userEncryption: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			got, err := c.VerifyUserPushDevice(ctx, tt.verify)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assertObjectDetails(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveUserPushDevice(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		deviceID string
+		want     *domain.ObjectDetails
+		wantErr  error
+	}{
+		{
+			name: "missing deviceID, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Pu5rI", "Errors.User.MFA.Push.IDMissing"),
+		},
+		{
+			name: "device not existing, not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device2", nil, time.Hour),
+						),
+					),
+				),
+			},
+			deviceID: "device1",
+			wantErr:  zerrors.ThrowNotFound(nil, "COMMAND-Pu5rN", "Errors.User.MFA.Push.NotExisting"),
+		},
+		{
+			name: "remove, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanPushDeviceAddedEvent(ctx, userAgg, "device1", nil, time.Hour),
+						),
+						eventFromEventPusher(
+							user.NewHumanPushDeviceVerifiedEvent(ctx, userAgg, "device1", "phone", []byte("key"), "token"),
+						),
+					),
+					expectPush(
+						user.NewHumanPushDeviceRemovedEvent(ctx, userAgg, "device1"),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			deviceID: "device1",
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			got, err := c.RemoveUserPushDevice(ctx, "user1", tt.deviceID, "org1")
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assertObjectDetails(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func newTestPushKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func newTestPushPublicKey(t *testing.T) []byte {
+	return marshalTestPushPublicKey(t, newTestPushKey(t))
+}
+
+func marshalTestPushPublicKey(t *testing.T, key *ecdsa.PrivateKey) []byte {
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return der
+}
@@ -0,0 +1,84 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// HumanSignOutEverywhere terminates all sessions of the user and revokes all tokens issued to the user,
+// except personal access tokens.
+// The sessions (v2) are terminated, the access and refresh tokens of the OIDC sessions and the (v1) tokens are revoked.
+// The [user.HumanSignedOutEverywhereEvent] terminates the (v1) sessions of the login UI on all user agents
+// and triggers the back-channel logout for all sessions of the user.
+// Users can sign out themselves, otherwise the "session.delete" permission is required.
+func (c *Commands) HumanSignOutEverywhere(ctx context.Context, userID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Soe1U", "Errors.User.UserIDMissing")
+	}
+	writeModel := NewHumanSessionsWriteModel(userID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(writeModel.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Soe1N", "Errors.User.NotFound")
+	}
+	if authz.GetCtxData(ctx).UserID != userID {
+		if err = c.checkPermission(ctx, domain.PermissionSessionDelete, writeModel.ResourceOwner, userID); err != nil {
+			return nil, err
+		}
+	}
+	sessionsWriteModel := NewUserSessionsWriteModel(authz.GetInstance(ctx).InstanceID(), writeModel.SessionIDs, writeModel.OIDCSessionIDs)
+	if len(writeModel.SessionIDs) > 0 || len(writeModel.OIDCSessionIDs) > 0 {
+		if err = c.eventstore.FilterToQueryReducer(ctx, sessionsWriteModel); err != nil {
+			return nil, err
+		}
+	}
+	now := time.Now()
+	events := make([]eventstore.Command, 0)
+	for _, sessionID := range writeModel.SessionIDs {
+		state, ok := sessionsWriteModel.Sessions[sessionID]
+		if !ok || !state.active(now) {
+			continue
+		}
+		events = append(events, session.NewTerminateEvent(ctx, &session.NewAggregate(sessionID, state.resourceOwner).Aggregate))
+	}
+	for _, oidcSessionID := range writeModel.OIDCSessionIDs {
+		state, ok := sessionsWriteModel.OIDCSessions[oidcSessionID]
+		if !ok {
+			continue
+		}
+		oidcSessionAgg := &oidcsession.NewAggregate(oidcSessionID, state.resourceOwner).Aggregate
+		// revoking the refresh token also revokes the access token of the OIDC session
+		if state.refreshTokenActive(now) {
+			events = append(events, oidcsession.NewRefreshTokenRevokedEvent(ctx, oidcSessionAgg))
+			continue
+		}
+		if state.accessTokenActive(now) {
+			events = append(events, oidcsession.NewAccessTokenRevokedEvent(ctx, oidcSessionAgg))
+		}
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	for _, tokenID := range writeModel.activeRefreshTokenIDs(now) {
+		events = append(events, user.NewHumanRefreshTokenRemovedEvent(ctx, userAgg, tokenID))
+	}
+	for _, tokenID := range writeModel.activeAccessTokenIDs(now) {
+		events = append(events, user.NewUserTokenRemovedEvent(ctx, userAgg, tokenID))
+	}
+	events = append(events, user.NewHumanSignedOutEverywhereEvent(ctx, userAgg))
+	if err = c.pushAppendAndReduce(ctx, writeModel, events...); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
@@ -0,0 +1,261 @@
+package command
+
+import (
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// HumanSessionsWriteModel holds the (v1) access and refresh tokens of a user,
+// as well as the IDs of the sessions and OIDC sessions created for the user.
+type HumanSessionsWriteModel struct {
+	eventstore.WriteModel
+
+	UserState domain.UserState
+	// RefreshTokens maps the ID of the (v1) refresh tokens to their expiration
+	RefreshTokens map[string]time.Time
+	// AccessTokens maps the ID of the (v1) access tokens to their expiration
+	AccessTokens   map[string]time.Time
+	SessionIDs     []string
+	OIDCSessionIDs []string
+}
+
+func NewHumanSessionsWriteModel(userID string) *HumanSessionsWriteModel {
+	return &HumanSessionsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID: userID,
+		},
+		RefreshTokens: make(map[string]time.Time),
+		AccessTokens:  make(map[string]time.Time),
+	}
+}
+
+func (wm *HumanSessionsWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *session.UserCheckedEvent:
+			if !slices.Contains(wm.SessionIDs, e.Aggregate().ID) {
+				wm.SessionIDs = append(wm.SessionIDs, e.Aggregate().ID)
+			}
+		case *oidcsession.AddedEvent:
+			wm.OIDCSessionIDs = append(wm.OIDCSessionIDs, e.Aggregate().ID)
+		default:
+			if event.Aggregate().Type == user.AggregateType {
+				wm.WriteModel.AppendEvents(event)
+			}
+		}
+	}
+}
+
+func (wm *HumanSessionsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.HumanRefreshTokenAddedEvent:
+			wm.RefreshTokens[e.TokenID] = e.CreationDate().Add(e.Expiration)
+		case *user.HumanRefreshTokenRemovedEvent:
+			delete(wm.RefreshTokens, e.TokenID)
+		case *user.UserTokenAddedEvent:
+			wm.AccessTokens[e.TokenID] = e.Expiration
+		case *user.UserTokenRemovedEvent:
+			delete(wm.AccessTokens, e.TokenID)
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+			wm.RefreshTokens = make(map[string]time.Time)
+			wm.AccessTokens = make(map[string]time.Time)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+// Query doesn't filter by resource owner, as the sessions and OIDC sessions are stored on the instance.
+func (wm *HumanSessionsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.UserV1AddedType,
+			user.UserV1RegisteredType,
+			user.UserRemovedType,
+			user.HumanRefreshTokenAddedType,
+			user.HumanRefreshTokenRemovedType,
+			user.UserTokenAddedType,
+			user.UserTokenRemovedType,
+		).
+		Builder().
+		AddQuery().
+		AggregateTypes(session.AggregateType).
+		EventTypes(session.UserCheckedType).
+		EventData(map[string]interface{}{
+			"userID": wm.AggregateID,
+		}).
+		Builder().
+		AddQuery().
+		AggregateTypes(oidcsession.AggregateType).
+		EventTypes(oidcsession.AddedType).
+		EventData(map[string]interface{}{
+			"userID": wm.AggregateID,
+		}).
+		Builder()
+}
+
+// activeRefreshTokenIDs returns the IDs of the (v1) refresh tokens, which are not expired yet.
+func (wm *HumanSessionsWriteModel) activeRefreshTokenIDs(now time.Time) []string {
+	return activeTokenIDs(wm.RefreshTokens, now)
+}
+
+// activeAccessTokenIDs returns the IDs of the (v1) access tokens, which are not expired yet.
+func (wm *HumanSessionsWriteModel) activeAccessTokenIDs(now time.Time) []string {
+	return activeTokenIDs(wm.AccessTokens, now)
+}
+
+func activeTokenIDs(tokens map[string]time.Time, now time.Time) []string {
+	ids := make([]string, 0, len(tokens))
+	for id, expiration := range tokens {
+		if expiration.After(now) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// UserSessionsWriteModel reduces the sessions and OIDC sessions of a user to their current state.
+type UserSessionsWriteModel struct {
+	eventstore.WriteModel
+
+	sessionIDs     []string
+	oidcSessionIDs []string
+
+	Sessions     map[string]*userSessionState
+	OIDCSessions map[string]*userOIDCSessionState
+}
+
+type userSessionState struct {
+	resourceOwner string
+	terminated    bool
+	expiration    time.Time
+}
+
+// active returns true, if the session is neither terminated nor expired.
+func (s *userSessionState) active(now time.Time) bool {
+	return !s.terminated && (s.expiration.IsZero() || s.expiration.After(now))
+}
+
+type userOIDCSessionState struct {
+	resourceOwner              string
+	accessTokenExpiration      time.Time
+	refreshTokenID             string
+	refreshTokenExpiration     time.Time
+	refreshTokenIdleExpiration time.Time
+}
+
+// accessTokenActive returns true, if an access token was issued on the OIDC session,
+// which is neither revoked nor expired.
+func (s *userOIDCSessionState) accessTokenActive(now time.Time) bool {
+	return s.accessTokenExpiration.After(now)
+}
+
+// refreshTokenActive returns true, if a refresh token was issued on the OIDC session,
+// which is neither revoked nor expired.
+func (s *userOIDCSessionState) refreshTokenActive(now time.Time) bool {
+	return s.refreshTokenID != "" && s.refreshTokenExpiration.After(now) && s.refreshTokenIdleExpiration.After(now)
+}
+
+func NewUserSessionsWriteModel(instanceID string, sessionIDs, oidcSessionIDs []string) *UserSessionsWriteModel {
+	return &UserSessionsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			InstanceID: instanceID,
+		},
+		sessionIDs:     sessionIDs,
+		oidcSessionIDs: oidcSessionIDs,
+		Sessions:       make(map[string]*userSessionState, len(sessionIDs)),
+		OIDCSessions:   make(map[string]*userOIDCSessionState, len(oidcSessionIDs)),
+	}
+}
+
+func (wm *UserSessionsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *session.AddedEvent:
+			wm.Sessions[e.Aggregate().ID] = &userSessionState{resourceOwner: e.Aggregate().ResourceOwner}
+		case *session.LifetimeSetEvent:
+			if s, ok := wm.Sessions[e.Aggregate().ID]; ok {
+				s.expiration = time.Time{}
+				if e.Lifetime > 0 {
+					s.expiration = e.CreationDate().Add(e.Lifetime)
+				}
+			}
+		case *session.TerminateEvent:
+			if s, ok := wm.Sessions[e.Aggregate().ID]; ok {
+				s.terminated = true
+			}
+		case *oidcsession.AddedEvent:
+			wm.OIDCSessions[e.Aggregate().ID] = &userOIDCSessionState{resourceOwner: e.Aggregate().ResourceOwner}
+		case *oidcsession.AccessTokenAddedEvent:
+			if s, ok := wm.OIDCSessions[e.Aggregate().ID]; ok {
+				s.accessTokenExpiration = e.CreationDate().Add(e.Lifetime)
+			}
+		case *oidcsession.AccessTokenRevokedEvent:
+			if s, ok := wm.OIDCSessions[e.Aggregate().ID]; ok {
+				s.accessTokenExpiration = time.Time{}
+			}
+		case *oidcsession.RefreshTokenAddedEvent:
+			if s, ok := wm.OIDCSessions[e.Aggregate().ID]; ok {
+				s.refreshTokenID = e.ID
+				s.refreshTokenExpiration = e.CreationDate().Add(e.Lifetime)
+				s.refreshTokenIdleExpiration = e.CreationDate().Add(e.IdleLifetime)
+			}
+		case *oidcsession.RefreshTokenRenewedEvent:
+			if s, ok := wm.OIDCSessions[e.Aggregate().ID]; ok {
+				s.refreshTokenID = e.ID
+				s.refreshTokenIdleExpiration = e.CreationDate().Add(e.IdleLifetime)
+			}
+		case *oidcsession.RefreshTokenRevokedEvent:
+			if s, ok := wm.OIDCSessions[e.Aggregate().ID]; ok {
+				s.refreshTokenID = ""
+				s.accessTokenExpiration = time.Time{}
+			}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserSessionsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent)
+	if len(wm.sessionIDs) > 0 {
+		query = query.AddQuery().
+			AggregateTypes(session.AggregateType).
+			AggregateIDs(wm.sessionIDs...).
+			EventTypes(
+				session.AddedType,
+				session.LifetimeSetType,
+				session.TerminateType,
+			).
+			Builder()
+	}
+	if len(wm.oidcSessionIDs) > 0 {
+		query = query.AddQuery().
+			AggregateTypes(oidcsession.AggregateType).
+			AggregateIDs(wm.oidcSessionIDs...).
+			EventTypes(
+				oidcsession.AddedType,
+				oidcsession.AccessTokenAddedType,
+				oidcsession.AccessTokenRevokedType,
+				oidcsession.RefreshTokenAddedType,
+				oidcsession.RefreshTokenRenewedType,
+				oidcsession.RefreshTokenRevokedType,
+			).
+			Builder()
+	}
+	return query
+}
@@ -0,0 +1,148 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_HumanSignOutEverywhere(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "admin1")
+	ownCtx := authz.NewMockContext("instance1", "org1", "user1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	sessionAgg := &session.NewAggregate("session1", "instance1").Aggregate
+	terminatedSessionAgg := &session.NewAggregate("session2", "instance1").Aggregate
+	oidcSessionAgg := &oidcsession.NewAggregate("V2_oidcSession1", "org1").Aggregate
+	tests := []struct {
+		name            string
+		ctx             context.Context
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		permissionCheck domain.PermissionCheck
+		userID          string
+		want            *domain.ObjectDetails
+		wantErr         error
+	}{
+		{
+			name:       "missing user, invalid argument error",
+			eventstore: expectEventstore(),
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "COMMAND-Soe1U", "Errors.User.UserIDMissing"),
+		},
+		{
+			name: "user not existing, not found error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			userID:  "user1",
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-Soe1N", "Errors.User.NotFound"),
+		},
+		{
+			name: "missing permission, permission denied error",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+				),
+			),
+			permissionCheck: newMockPermissionCheckNotAllowed(),
+			userID:          "user1",
+			wantErr:         zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "own user without sessions, ok",
+			ctx:  ownCtx,
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+				),
+				expectPush(
+					user.NewHumanSignedOutEverywhereEvent(ownCtx, userAgg),
+				),
+			),
+			permissionCheck: newMockPermissionCheckNotAllowed(),
+			userID:          "user1",
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+		{
+			name: "sessions and tokens, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanRefreshTokenAddedEvent(ctx, userAgg,
+						"refreshToken1", "client1", "agent1", "de", []string{"client1"}, []string{"openid"}, []string{"pwd"},
+						time.Now(), time.Hour, 24*time.Hour, nil,
+					)),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanRefreshTokenAddedEvent(ctx, userAgg,
+						"refreshToken2", "client1", "agent1", "de", []string{"client1"}, []string{"openid"}, []string{"pwd"},
+						time.Now(), time.Hour, 24*time.Hour, nil,
+					)),
+					eventFromEventPusher(user.NewHumanRefreshTokenRemovedEvent(ctx, userAgg, "refreshToken2")),
+					eventFromEventPusher(user.NewUserTokenAddedEvent(ctx, userAgg,
+						"accessToken1", "client1", "agent1", "de", "", []string{"client1"}, []string{"openid"}, []string{"pwd"},
+						time.Now(), time.Now().Add(time.Hour), domain.TokenReasonAuthRequest, nil,
+					)),
+					eventFromEventPusher(user.NewUserTokenAddedEvent(ctx, userAgg,
+						"accessToken2", "client1", "agent1", "de", "", []string{"client1"}, []string{"openid"}, []string{"pwd"},
+						time.Now(), time.Now().Add(-time.Hour), domain.TokenReasonAuthRequest, nil,
+					)),
+					eventFromEventPusher(session.NewUserCheckedEvent(ctx, sessionAgg, "user1", "org1", time.Now(), nil)),
+					eventFromEventPusher(session.NewUserCheckedEvent(ctx, terminatedSessionAgg, "user1", "org1", time.Now(), nil)),
+					eventFromEventPusher(oidcsession.NewAddedEvent(ctx, oidcSessionAgg,
+						"user1", "org1", "session1", "client1", []string{"client1"}, []string{"openid"}, nil, time.Now(), "", nil, nil,
+					)),
+				),
+				expectFilter(
+					eventFromEventPusher(session.NewAddedEvent(ctx, sessionAgg, nil)),
+					eventFromEventPusher(session.NewAddedEvent(ctx, terminatedSessionAgg, nil)),
+					eventFromEventPusher(session.NewTerminateEvent(ctx, terminatedSessionAgg)),
+					eventFromEventPusher(oidcsession.NewAddedEvent(ctx, oidcSessionAgg,
+						"user1", "org1", "session1", "client1", []string{"client1"}, []string{"openid"}, nil, time.Now(), "", nil, nil,
+					)),
+					eventFromEventPusherWithCreationDateNow(oidcsession.NewAccessTokenAddedEvent(ctx, oidcSessionAgg,
+						"accessToken1", []string{"openid"}, time.Hour, domain.TokenReasonAuthRequest, nil,
+					)),
+					eventFromEventPusherWithCreationDateNow(oidcsession.NewRefreshTokenAddedEvent(ctx, oidcSessionAgg,
+						"refreshToken1", 24*time.Hour, time.Hour,
+					)),
+				),
+				expectPush(
+					session.NewTerminateEvent(ctx, sessionAgg),
+					oidcsession.NewRefreshTokenRevokedEvent(ctx, oidcSessionAgg),
+					user.NewHumanRefreshTokenRemovedEvent(ctx, userAgg, "refreshToken1"),
+					user.NewUserTokenRemovedEvent(ctx, userAgg, "accessToken1"),
+					user.NewHumanSignedOutEverywhereEvent(ctx, userAgg),
+				),
+			),
+			permissionCheck: newMockPermissionCheckAllowed(),
+			userID:          "user1",
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.eventstore(t),
+				checkPermission: tt.permissionCheck,
+			}
+			callCtx := ctx
+			if tt.ctx != nil {
+				callCtx = tt.ctx
+			}
+			got, err := c.HumanSignOutEverywhere(callCtx, tt.userID)
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/zitadel/zitadel/internal/repository/idp"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/quota"
 	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -46,6 +47,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 		newEncryptedCodeWithDefault encryptedCodeWithDefaultFunc
 		defaultSecretGenerators     *SecretGenerators
 		defaultEmailCodeURLTemplate func(ctx context.Context) string
+		orgQuotaCheck               OrgQuotaCheck
 	}
 	type args struct {
 		ctx             context.Context
@@ -113,6 +115,34 @@ func TestCommandSide_AddHuman(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "org quota exhausted, resource exhausted error",
+			fields: fields{
+				eventstore: expectEventstore(),
+				orgQuotaCheck: func(_ context.Context, _, orgID string, unit quota.Unit) bool {
+					return orgID == "org1" && unit == quota.UsersAll
+				},
+			},
+			args: args{
+				ctx:   context.Background(),
+				orgID: "org1",
+				human: &AddHuman{
+					Username:  "username",
+					FirstName: "firstname",
+					LastName:  "lastname",
+					Email: Email{
+						Address: "email@test.ch",
+					},
+					PreferredLanguage: AllowedLanguage,
+				},
+				allowInitMail: true,
+			},
+			res: res{
+				err: func(err error) bool {
+					return errors.Is(err, zerrors.ThrowResourceExhausted(nil, "COMMAND-Qo7xK", "Errors.Quota.Org.Exhausted"))
+				},
+			},
+		},
 		{
 			name: "with id, already exists, precondition error",
 			fields: fields{
@@ -1482,6 +1512,7 @@ func TestCommandSide_AddHuman(t *testing.T) {
 				newEncryptedCodeWithDefault: tt.fields.newEncryptedCodeWithDefault,
 				defaultSecretGenerators:     tt.fields.defaultSecretGenerators,
 				defaultEmailCodeURLTemplate: tt.fields.defaultEmailCodeURLTemplate,
+				orgQuotaCheck:               tt.fields.orgQuotaCheck,
 			}
 			err := r.AddHuman(tt.args.ctx, tt.args.orgID, tt.args.human, tt.args.allowInitMail)
 			if tt.res.err == nil {
@@ -0,0 +1,146 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// trustDeviceMFACheckMaxAge is the duration after a check of a second factor in which the device can be trusted.
+const trustDeviceMFACheckMaxAge = 5 * time.Minute
+
+// HumanTrustDevice trusts the device (user agent) of the user, so the second factor check is skipped on it
+// until the lifetime of the device trust policy passes.
+// The second factor must have been checked just before.
+// Devices previously trusted on the same user agent are replaced.
+func (c *Commands) HumanTrustDevice(ctx context.Context, userID, resourceOwner, fingerprintID, name string, authRequest *domain.AuthRequest) (_ *domain.TrustedDevice, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tdv1U", "Errors.User.UserIDMissing")
+	}
+	if fingerprintID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tdv1F", "Errors.User.TrustedDevice.Invalid")
+	}
+	writeModel, err := c.humanTrustedDevicesWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !isUserStateExists(writeModel.UserState) {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Tdv1N", "Errors.User.NotFound")
+	}
+	policy, err := c.deviceTrustPolicy(ctx, writeModel.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.Enabled() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tdv1E", "Errors.User.TrustedDevice.NotEnabled")
+	}
+	if writeModel.SecondFactorChecked.IsZero() || time.Since(writeModel.SecondFactorChecked) > trustDeviceMFACheckMaxAge {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tdv1M", "Errors.User.TrustedDevice.MFARequired")
+	}
+	deviceID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	previousDevices := writeModel.devicesByFingerprint(fingerprintID)
+	events := make([]eventstore.Command, 0, len(previousDevices)+1)
+	for _, device := range previousDevices {
+		events = append(events, user.NewHumanTrustedDeviceRemovedEvent(ctx, userAgg, device.ID))
+	}
+	events = append(events, user.NewHumanTrustedDeviceAddedEvent(
+		ctx,
+		userAgg,
+		deviceID,
+		fingerprintID,
+		name,
+		writeModel.SecondFactorCheckedType,
+		policy.Lifetime,
+		authRequestDomainToAuthRequestInfo(authRequest),
+	))
+	if err = c.pushAppendAndReduce(ctx, writeModel, events...); err != nil {
+		return nil, err
+	}
+	return writeModel.Devices[deviceID], nil
+}
+
+// HumanCheckTrustedDevice checks if the device is still trusted on the user agent,
+// in which case the result is stored on the user as a replacement of the second factor check.
+func (c *Commands) HumanCheckTrustedDevice(ctx context.Context, userID, resourceOwner, deviceID, fingerprintID string, authRequest *domain.AuthRequest) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || deviceID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Tdv2U", "Errors.IDMissing")
+	}
+	writeModel, err := c.humanTrustedDevicesWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return err
+	}
+	if writeModel.UserState != domain.UserStateActive {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tdv2S", "Errors.User.NotActive")
+	}
+	device, ok := writeModel.Devices[deviceID]
+	if !ok {
+		return zerrors.ThrowPermissionDenied(nil, "COMMAND-Tdv2N", "Errors.User.TrustedDevice.Invalid")
+	}
+	policy, err := c.deviceTrustPolicy(ctx, writeModel.ResourceOwner)
+	if err != nil {
+		return err
+	}
+	if !device.IsValid(fingerprintID, policy, time.Now()) {
+		return zerrors.ThrowPermissionDenied(nil, "COMMAND-Tdv2I", "Errors.User.TrustedDevice.Invalid")
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	_, err = c.eventstore.Push(ctx, user.NewHumanTrustedDeviceCheckSucceededEvent(
+		ctx,
+		userAgg,
+		deviceID,
+		device.MFAType,
+		authRequestDomainToAuthRequestInfo(authRequest),
+	))
+	return err
+}
+
+// RemoveHumanTrustedDevice revokes the trust of the device, so the second factor has to be checked on it again.
+func (c *Commands) RemoveHumanTrustedDevice(ctx context.Context, userID, resourceOwner, deviceID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || deviceID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Tdv3U", "Errors.IDMissing")
+	}
+	writeModel, err := c.humanTrustedDevicesWriteModel(ctx, userID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := writeModel.Devices[deviceID]; !ok {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Tdv3N", "Errors.User.TrustedDevice.NotFound")
+	}
+	if err = c.checkPermissionUpdateUserCredentials(ctx, writeModel.ResourceOwner, userID); err != nil {
+		return nil, err
+	}
+	userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+	if err = c.pushAppendAndReduce(ctx, writeModel, user.NewHumanTrustedDeviceRemovedEvent(ctx, userAgg, deviceID)); err != nil {
+		return nil, err
+	}
+	return writeModelToObjectDetails(&writeModel.WriteModel), nil
+}
+
+func (c *Commands) humanTrustedDevicesWriteModel(ctx context.Context, userID, resourceOwner string) (_ *HumanTrustedDevicesWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewHumanTrustedDevicesWriteModel(userID, resourceOwner)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
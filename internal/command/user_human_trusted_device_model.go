@@ -0,0 +1,152 @@
+package command
+
+import (
+	"maps"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type HumanTrustedDevicesWriteModel struct {
+	eventstore.WriteModel
+
+	UserState domain.UserState
+	Devices   map[string]*domain.TrustedDevice
+
+	// SecondFactorChecked is the time of the last successful check of a second factor,
+	// which can be used to trust a device.
+	SecondFactorChecked     time.Time
+	SecondFactorCheckedType domain.MFAType
+}
+
+func NewHumanTrustedDevicesWriteModel(userID, resourceOwner string) *HumanTrustedDevicesWriteModel {
+	return &HumanTrustedDevicesWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		Devices: make(map[string]*domain.TrustedDevice),
+	}
+}
+
+func (wm *HumanTrustedDevicesWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			wm.UserState = domain.UserStateActive
+		case *user.UserLockedEvent:
+			wm.UserState = domain.UserStateLocked
+		case *user.UserDeactivatedEvent:
+			wm.UserState = domain.UserStateInactive
+		case *user.UserUnlockedEvent, *user.UserReactivatedEvent:
+			if wm.UserState != domain.UserStateDeleted {
+				wm.UserState = domain.UserStateActive
+			}
+		case *user.UserRemovedEvent:
+			wm.UserState = domain.UserStateDeleted
+			wm.Devices = make(map[string]*domain.TrustedDevice)
+		case *user.HumanOTPCheckSucceededEvent:
+			wm.secondFactorChecked(e.CreationDate(), domain.MFATypeTOTP)
+		case *user.HumanOTPSMSCheckSucceededEvent:
+			wm.secondFactorChecked(e.CreationDate(), domain.MFATypeOTPSMS)
+		case *user.HumanOTPEmailCheckSucceededEvent:
+			wm.secondFactorChecked(e.CreationDate(), domain.MFATypeOTPEmail)
+		case *user.HumanU2FCheckSucceededEvent:
+			wm.secondFactorChecked(e.CreationDate(), domain.MFATypeU2F)
+		case *user.HumanTrustedDeviceAddedEvent:
+			wm.Devices[e.DeviceID] = &domain.TrustedDevice{
+				ID:             e.DeviceID,
+				UserID:         e.Aggregate().ID,
+				ResourceOwner:  e.Aggregate().ResourceOwner,
+				FingerprintID:  e.FingerprintID,
+				Name:           e.Name,
+				MFAType:        e.MFAType,
+				CreationDate:   e.CreationDate(),
+				ExpirationDate: e.CreationDate().Add(e.Lifetime),
+			}
+		case *user.HumanTrustedDeviceCheckSucceededEvent:
+			if device, ok := wm.Devices[e.DeviceID]; ok {
+				device.LastUsed = e.CreationDate()
+			}
+		case *user.HumanTrustedDeviceRemovedEvent:
+			delete(wm.Devices, e.DeviceID)
+		case *user.HumanPasswordChangedEvent, *user.HumanSignedOutEverywhereEvent:
+			wm.Devices = make(map[string]*domain.TrustedDevice)
+		case *user.HumanOTPRemovedEvent:
+			wm.removeDevicesByMFAType(domain.MFATypeTOTP)
+		case *user.HumanOTPSMSRemovedEvent:
+			wm.removeDevicesByMFAType(domain.MFATypeOTPSMS)
+		case *user.HumanOTPEmailRemovedEvent:
+			wm.removeDevicesByMFAType(domain.MFATypeOTPEmail)
+		case *user.HumanU2FRemovedEvent:
+			wm.removeDevicesByMFAType(domain.MFATypeU2F)
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+// removeDevicesByMFAType removes the devices trusted by a second factor of the type,
+// because the trust must not outlive the removal of the factor.
+func (wm *HumanTrustedDevicesWriteModel) removeDevicesByMFAType(mfaType domain.MFAType) {
+	maps.DeleteFunc(wm.Devices, func(_ string, device *domain.TrustedDevice) bool {
+		return device.MFAType == mfaType
+	})
+}
+
+func (wm *HumanTrustedDevicesWriteModel) secondFactorChecked(checked time.Time, mfaType domain.MFAType) {
+	wm.SecondFactorChecked = checked
+	wm.SecondFactorCheckedType = mfaType
+}
+
+func (wm *HumanTrustedDevicesWriteModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.UserV1AddedType,
+			user.UserV1RegisteredType,
+			user.UserLockedType,
+			user.UserUnlockedType,
+			user.UserDeactivatedType,
+			user.UserReactivatedType,
+			user.UserRemovedType,
+			user.HumanMFAOTPCheckSucceededType,
+			user.UserV1MFAOTPCheckSucceededType,
+			user.HumanOTPSMSCheckSucceededType,
+			user.HumanOTPEmailCheckSucceededType,
+			user.HumanU2FTokenCheckSucceededType,
+			user.HumanTrustedDeviceAddedType,
+			user.HumanTrustedDeviceCheckSucceededType,
+			user.HumanTrustedDeviceRemovedType,
+			user.HumanPasswordChangedType,
+			user.UserV1PasswordChangedType,
+			user.HumanSignedOutEverywhereType,
+			user.HumanMFAOTPRemovedType,
+			user.UserV1MFAOTPRemovedType,
+			user.HumanOTPSMSRemovedType,
+			user.HumanOTPEmailRemovedType,
+			user.HumanU2FTokenRemovedType,
+		).
+		Builder()
+
+	if wm.ResourceOwner != "" {
+		query.ResourceOwner(wm.ResourceOwner)
+	}
+	return query
+}
+
+// devicesByFingerprint returns all devices bound to the user agent.
+func (wm *HumanTrustedDevicesWriteModel) devicesByFingerprint(fingerprintID string) []*domain.TrustedDevice {
+	devices := make([]*domain.TrustedDevice, 0)
+	for _, device := range wm.Devices {
+		if device.FingerprintID == fingerprintID {
+			devices = append(devices, device)
+		}
+	}
+	return devices
+}
@@ -0,0 +1,447 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func trustedDeviceUserAddedEvent(t *testing.T, userAgg *eventstore.Aggregate) eventstore.Event {
+	t.Helper()
+	return eventFromEventPusher(user.NewHumanAddedEvent(
+		authz.NewMockContext("instance1", "org1", "user1"),
+		userAgg,
+		"username",
+		"firstname",
+		"lastname",
+		"nickname",
+		"displayname",
+		language.English,
+		domain.GenderUnspecified,
+		"email@test.ch",
+		true,
+	))
+}
+
+func TestCommands_HumanTrustDevice(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	instanceAgg := &instance.NewAggregate("instance1").Aggregate
+	orgAgg := &org.NewAggregate("org1").Aggregate
+	tests := []struct {
+		name          string
+		eventstore    func(*testing.T) *eventstore.Eventstore
+		idGenerator   id.Generator
+		userID        string
+		fingerprintID string
+		want          *domain.TrustedDevice
+		wantErr       error
+	}{
+		{
+			name:          "missing user, invalid argument error",
+			eventstore:    expectEventstore(),
+			fingerprintID: "agent1",
+			wantErr:       zerrors.ThrowInvalidArgument(nil, "COMMAND-Tdv1U", "Errors.User.UserIDMissing"),
+		},
+		{
+			name:       "missing fingerprint, invalid argument error",
+			eventstore: expectEventstore(),
+			userID:     "user1",
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "COMMAND-Tdv1F", "Errors.User.TrustedDevice.Invalid"),
+		},
+		{
+			name: "user not existing, not found error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			userID:        "user1",
+			fingerprintID: "agent1",
+			wantErr:       zerrors.ThrowNotFound(nil, "COMMAND-Tdv1N", "Errors.User.NotFound"),
+		},
+		{
+			name: "policy disabled, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanOTPCheckSucceededEvent(ctx, userAgg, nil)),
+				),
+				expectFilter(),
+				expectFilter(),
+			),
+			userID:        "user1",
+			fingerprintID: "agent1",
+			wantErr:       zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tdv1E", "Errors.User.TrustedDevice.NotEnabled"),
+		},
+		{
+			name: "disabled by org policy, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanOTPCheckSucceededEvent(ctx, userAgg, nil)),
+				),
+				expectFilter(
+					eventFromEventPusher(org.NewDeviceTrustPolicySetEvent(ctx, orgAgg, 0)),
+				),
+			),
+			userID:        "user1",
+			fingerprintID: "agent1",
+			wantErr:       zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tdv1E", "Errors.User.TrustedDevice.NotEnabled"),
+		},
+		{
+			name: "second factor not checked, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+				),
+				expectFilter(),
+				expectFilter(
+					eventFromEventPusher(instance.NewDeviceTrustPolicySetEvent(ctx, instanceAgg, 24*time.Hour)),
+				),
+			),
+			userID:        "user1",
+			fingerprintID: "agent1",
+			wantErr:       zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tdv1M", "Errors.User.TrustedDevice.MFARequired"),
+		},
+		{
+			name: "second factor checked too long ago, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+					func() eventstore.Event {
+						e := eventFromEventPusher(user.NewHumanOTPCheckSucceededEvent(ctx, userAgg, nil))
+						e.CreationDate = time.Now().Add(-time.Hour)
+						return e
+					}(),
+				),
+				expectFilter(),
+				expectFilter(
+					eventFromEventPusher(instance.NewDeviceTrustPolicySetEvent(ctx, instanceAgg, 24*time.Hour)),
+				),
+			),
+			userID:        "user1",
+			fingerprintID: "agent1",
+			wantErr:       zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tdv1M", "Errors.User.TrustedDevice.MFARequired"),
+		},
+		{
+			name: "trust device, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanOTPSMSCheckSucceededEvent(ctx, userAgg, nil)),
+				),
+				expectFilter(),
+				expectFilter(
+					eventFromEventPusher(instance.NewDeviceTrustPolicySetEvent(ctx, instanceAgg, 24*time.Hour)),
+				),
+				expectPush(
+					user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, "device1", "agent1", "Firefox", domain.MFATypeOTPSMS, 24*time.Hour, nil),
+				),
+			),
+			idGenerator:   id_mock.NewIDGeneratorExpectIDs(t, "device1"),
+			userID:        "user1",
+			fingerprintID: "agent1",
+			want: &domain.TrustedDevice{
+				ID:            "device1",
+				UserID:        "user1",
+				ResourceOwner: "org1",
+				FingerprintID: "agent1",
+				Name:          "Firefox",
+				MFAType:       domain.MFATypeOTPSMS,
+			},
+		},
+		{
+			name: "replace device of user agent, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+					eventFromEventPusher(user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, "device0", "agent1", "Firefox", domain.MFATypeTOTP, 24*time.Hour, nil)),
+					eventFromEventPusher(user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, "device2", "agent2", "Chrome", domain.MFATypeTOTP, 24*time.Hour, nil)),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanU2FCheckSucceededEvent(ctx, userAgg, nil)),
+				),
+				expectFilter(
+					eventFromEventPusher(org.NewDeviceTrustPolicySetEvent(ctx, orgAgg, time.Hour)),
+				),
+				expectPush(
+					user.NewHumanTrustedDeviceRemovedEvent(ctx, userAgg, "device0"),
+					user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, "device1", "agent1", "Firefox", domain.MFATypeU2F, time.Hour, nil),
+				),
+			),
+			idGenerator:   id_mock.NewIDGeneratorExpectIDs(t, "device1"),
+			userID:        "user1",
+			fingerprintID: "agent1",
+			want: &domain.TrustedDevice{
+				ID:            "device1",
+				UserID:        "user1",
+				ResourceOwner: "org1",
+				FingerprintID: "agent1",
+				Name:          "Firefox",
+				MFAType:       domain.MFATypeU2F,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.eventstore(t),
+				idGenerator: tt.idGenerator,
+			}
+			got, err := c.HumanTrustDevice(ctx, tt.userID, "org1", tt.fingerprintID, "Firefox", nil)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			// the dates are set by the eventstore
+			got.CreationDate, got.ExpirationDate = time.Time{}, time.Time{}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCommands_HumanCheckTrustedDevice(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "user1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	instanceAgg := &instance.NewAggregate("instance1").Aggregate
+	tests := []struct {
+		name          string
+		eventstore    func(*testing.T) *eventstore.Eventstore
+		deviceID      string
+		fingerprintID string
+		wantErr       error
+	}{
+		{
+			name:          "missing device, invalid argument error",
+			eventstore:    expectEventstore(),
+			fingerprintID: "agent1",
+			wantErr:       zerrors.ThrowInvalidArgument(nil, "COMMAND-Tdv2U", "Errors.IDMissing"),
+		},
+		{
+			name: "user not active, precondition error",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, "device1", "agent1", "Firefox", domain.MFATypeTOTP, 24*time.Hour, nil)),
+					eventFromEventPusher(user.NewUserLockedEvent(ctx, userAgg)),
+				),
+			),
+			deviceID:      "device1",
+			fingerprintID: "agent1",
+			wantErr:       zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tdv2S", "Errors.User.NotActive"),
+		},
+		{
+			name: "device removed, permission denied error",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, "device1", "agent1", "Firefox", domain.MFATypeTOTP, 24*time.Hour, nil)),
+					eventFromEventPusher(user.NewHumanTrustedDeviceRemovedEvent(ctx, userAgg, "device1")),
+				),
+			),
+			deviceID:      "device1",
+			fingerprintID: "agent1",
+			wantErr:       zerrors.ThrowPermissionDenied(nil, "COMMAND-Tdv2N", "Errors.User.TrustedDevice.Invalid"),
+		},
+		{
+			name: "password changed, permission denied error",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, "device1", "agent1", "Firefox", domain.MFATypeTOTP, 24*time.Hour, nil)),
+					eventFromEventPusher(user.NewHumanPasswordChangedEvent(ctx, userAgg, "$plain$x$password", false, "")),
+				),
+			),
+			deviceID:      "device1",
+			fingerprintID: "agent1",
+			wantErr:       zerrors.ThrowPermissionDenied(nil, "COMMAND-Tdv2N", "Errors.User.TrustedDevice.Invalid"),
+		},
+		{
+			name: "signed out everywhere, permission denied error",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, "device1", "agent1", "Firefox", domain.MFATypeTOTP, 24*time.Hour, nil)),
+					eventFromEventPusher(user.NewHumanSignedOutEverywhereEvent(ctx, userAgg)),
+				),
+			),
+			deviceID:      "device1",
+			fingerprintID: "agent1",
+			wantErr:       zerrors.ThrowPermissionDenied(nil, "COMMAND-Tdv2N", "Errors.User.TrustedDevice.Invalid"),
+		},
+		{
+			name: "second factor removed, permission denied error",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, "device1", "agent1", "Firefox", domain.MFATypeTOTP, 24*time.Hour, nil)),
+					eventFromEventPusher(user.NewHumanOTPRemovedEvent(ctx, userAgg)),
+				),
+			),
+			deviceID:      "device1",
+			fingerprintID: "agent1",
+			wantErr:       zerrors.ThrowPermissionDenied(nil, "COMMAND-Tdv2N", "Errors.User.TrustedDevice.Invalid"),
+		},
+		{
+			name: "other user agent, permission denied error",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, "device1", "agent1", "Firefox", domain.MFATypeTOTP, 24*time.Hour, nil)),
+				),
+				expectFilter(),
+				expectFilter(
+					eventFromEventPusher(instance.NewDeviceTrustPolicySetEvent(ctx, instanceAgg, 24*time.Hour)),
+				),
+			),
+			deviceID:      "device1",
+			fingerprintID: "agent2",
+			wantErr:       zerrors.ThrowPermissionDenied(nil, "COMMAND-Tdv2I", "Errors.User.TrustedDevice.Invalid"),
+		},
+		{
+			name: "lifetime of policy reduced, permission denied error",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+					func() eventstore.Event {
+						e := eventFromEventPusher(user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, "device1", "agent1", "Firefox", domain.MFATypeTOTP, 24*time.Hour, nil))
+						e.CreationDate = time.Now().Add(-2 * time.Hour)
+						return e
+					}(),
+				),
+				expectFilter(),
+				expectFilter(
+					eventFromEventPusher(instance.NewDeviceTrustPolicySetEvent(ctx, instanceAgg, time.Hour)),
+				),
+			),
+			deviceID:      "device1",
+			fingerprintID: "agent1",
+			wantErr:       zerrors.ThrowPermissionDenied(nil, "COMMAND-Tdv2I", "Errors.User.TrustedDevice.Invalid"),
+		},
+		{
+			name: "other second factor removed, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, "device1", "agent1", "Firefox", domain.MFATypeOTPEmail, 24*time.Hour, nil)),
+					eventFromEventPusher(user.NewHumanOTPSMSRemovedEvent(ctx, userAgg)),
+				),
+				expectFilter(),
+				expectFilter(
+					eventFromEventPusher(instance.NewDeviceTrustPolicySetEvent(ctx, instanceAgg, 24*time.Hour)),
+				),
+				expectPush(
+					user.NewHumanTrustedDeviceCheckSucceededEvent(ctx, userAgg, "device1", domain.MFATypeOTPEmail, nil),
+				),
+			),
+			deviceID:      "device1",
+			fingerprintID: "agent1",
+		},
+		{
+			name: "trusted device, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+					eventFromEventPusherWithCreationDateNow(user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, "device1", "agent1", "Firefox", domain.MFATypeOTPEmail, 24*time.Hour, nil)),
+				),
+				expectFilter(),
+				expectFilter(
+					eventFromEventPusher(instance.NewDeviceTrustPolicySetEvent(ctx, instanceAgg, 24*time.Hour)),
+				),
+				expectPush(
+					user.NewHumanTrustedDeviceCheckSucceededEvent(ctx, userAgg, "device1", domain.MFATypeOTPEmail, nil),
+				),
+			),
+			deviceID:      "device1",
+			fingerprintID: "agent1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.eventstore(t),
+			}
+			err := c.HumanCheckTrustedDevice(ctx, "user1", "org1", tt.deviceID, tt.fingerprintID, nil)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommands_RemoveHumanTrustedDevice(t *testing.T) {
+	ctx := authz.NewMockContext("instance1", "org1", "admin1")
+	userAgg := &user.NewAggregate("user1", "org1").Aggregate
+	tests := []struct {
+		name            string
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		permissionCheck domain.PermissionCheck
+		deviceID        string
+		want            *domain.ObjectDetails
+		wantErr         error
+	}{
+		{
+			name:       "missing device, invalid argument error",
+			eventstore: expectEventstore(),
+			wantErr:    zerrors.ThrowInvalidArgument(nil, "COMMAND-Tdv3U", "Errors.IDMissing"),
+		},
+		{
+			name: "device not existing, not found error",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+				),
+			),
+			deviceID: "device1",
+			wantErr:  zerrors.ThrowNotFound(nil, "COMMAND-Tdv3N", "Errors.User.TrustedDevice.NotFound"),
+		},
+		{
+			name: "missing permission, permission denied error",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+					eventFromEventPusher(user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, "device1", "agent1", "Firefox", domain.MFATypeTOTP, 24*time.Hour, nil)),
+				),
+			),
+			permissionCheck: newMockPermissionCheckNotAllowed(),
+			deviceID:        "device1",
+			wantErr:         zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "remove device, ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					trustedDeviceUserAddedEvent(t, userAgg),
+					eventFromEventPusher(user.NewHumanTrustedDeviceAddedEvent(ctx, userAgg, "device1", "agent1", "Firefox", domain.MFATypeTOTP, 24*time.Hour, nil)),
+				),
+				expectPush(
+					user.NewHumanTrustedDeviceRemovedEvent(ctx, userAgg, "device1"),
+				),
+			),
+			permissionCheck: newMockPermissionCheckAllowed(),
+			deviceID:        "device1",
+			want: &domain.ObjectDetails{
+				ResourceOwner: "org1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.eventstore(t),
+				checkPermission: tt.permissionCheck,
+			}
+			got, err := c.RemoveHumanTrustedDevice(ctx, "user1", "org1", tt.deviceID)
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.want, got)
+		})
+	}
+}
@@ -160,7 +160,11 @@ func (c *Commands) addHumanWebAuthN(ctx context.Context, userID, resourceowner,
 	if accountName == "" {
 		accountName = string(user.EmailAddress)
 	}
-	webAuthN, err := c.webauthnConfig.BeginRegistration(ctx, user, accountName, authenticatorPlatform, userVerification, rpID, tokens...)
+	policy, err := c.webAuthNPolicy(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	webAuthN, err := c.webauthnConfig.BeginRegistration(ctx, user, accountName, authenticatorPlatform, userVerification, policy, rpID, tokens...)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -275,7 +279,11 @@ func (c *Commands) verifyHumanWebAuthN(ctx context.Context, userID, resourceowne
 		return nil, nil, nil, err
 	}
 	_, token := domain.GetTokenToVerify(tokens)
-	webAuthN, err := c.webauthnConfig.FinishRegistration(ctx, user, token, tokenName, credentialData)
+	policy, err := c.webAuthNPolicy(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	webAuthN, err := c.webauthnConfig.FinishRegistration(ctx, user, token, tokenName, credentialData, policy)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -0,0 +1,40 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// HumanCheckX509Certificate checks the client certificate of the user for the login (v1).
+// The result of the check is stored on the user, so it's taken into account for the auth request.
+// The possession of the private key is proven by the TLS handshake with the login
+// or the TLS terminating proxy, which passes the certificate in the configured header.
+func (c *Commands) HumanCheckX509Certificate(ctx context.Context, userID, resourceOwner string, certificate []byte, intermediates [][]byte, authRequest *domain.AuthRequest) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-X5h1U", "Errors.User.UserIDMissing")
+	}
+	fingerprint, err := c.verifyX509Certificate(ctx, userID, resourceOwner, certificate, intermediates, time.Now())
+	// certificates, which can't be parsed, are not recorded
+	if fingerprint == "" {
+		return err
+	}
+	userAgg := &user.NewAggregate(userID, resourceOwner).Aggregate
+	info := authRequestDomainToAuthRequestInfo(authRequest)
+	if err != nil {
+		_, pushErr := c.eventstore.Push(ctx, user.NewHumanX509CheckFailedEvent(ctx, userAgg, fingerprint, info))
+		logging.OnError(pushErr).Error("failed to push x509 check failed event")
+		return err
+	}
+	_, err = c.eventstore.Push(ctx, user.NewHumanX509CheckSucceededEvent(ctx, userAgg, fingerprint, info))
+	return err
+}
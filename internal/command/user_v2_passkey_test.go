@@ -109,6 +109,7 @@ func TestCommands_RegisterUserPasskey(t *testing.T) {
 							false, false, false,
 						),
 					)),
+					expectFilter(), // webAuthNPolicy
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectError(t, io.ErrClosedPipe),
 			},
@@ -377,6 +378,7 @@ func TestCommands_pushUserPasskey(t *testing.T) {
 				false, false, false,
 			),
 		)),
+		expectFilter(), // webAuthNPolicy
 		expectFilter(eventFromEventPusher(
 			user.NewHumanWebAuthNAddedEvent(eventstore.NewBaseEventForPush(
 				ctx, &org.NewAggregate("org1").Aggregate, user.HumanPasswordlessTokenAddedType,
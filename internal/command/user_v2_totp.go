@@ -7,20 +7,21 @@ import (
 )
 
 func (c *Commands) AddUserTOTP(ctx context.Context, userID, resourceOwner string) (*domain.TOTP, error) {
-	prep, err := c.createHumanTOTP(ctx, userID, resourceOwner)
+	prep, err := c.createHumanTOTP(ctx, userID, resourceOwner, "", "")
 	if err != nil {
 		return nil, err
 	}
-	if err = c.pushAppendAndReduce(ctx, prep.wm, prep.cmds...); err != nil {
-		return nil, err
-	}
-	return &domain.TOTP{
-		ObjectDetails: writeModelToObjectDetails(&prep.wm.WriteModel),
-		Secret:        prep.key.Secret(),
-		URI:           prep.key.URL(),
-	}, nil
+	return c.pushPreparedTOTP(ctx, prep)
+}
+
+func (c *Commands) AddUserNamedTOTP(ctx context.Context, userID, resourceOwner, name string) (*domain.TOTP, error) {
+	return c.AddHumanNamedTOTP(ctx, userID, resourceOwner, name)
 }
 
 func (c *Commands) CheckUserTOTP(ctx context.Context, userID, code, resourceOwner string) (*domain.ObjectDetails, error) {
 	return c.HumanCheckMFATOTPSetup(ctx, userID, code, "", resourceOwner)
 }
+
+func (c *Commands) CheckUserNamedTOTP(ctx context.Context, userID, totpID, code, resourceOwner string) (*domain.ObjectDetails, error) {
+	return c.HumanCheckMFANamedTOTPSetup(ctx, userID, totpID, "", code, "", resourceOwner)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/risk"
 )
 
 type SystemDefaults struct {
@@ -14,6 +15,7 @@ type SystemDefaults struct {
 	SecretHasher         crypto.HashConfig
 	Multifactors         MultifactorConfig
 	Tarpit               TarpitConfig
+	Risk                 risk.Config
 	DomainVerification   DomainVerification
 	Notifications        Notifications
 	KeyConfig            KeyConfig
@@ -36,12 +38,17 @@ type SecretGenerators struct {
 type MultifactorConfig struct {
 	OTP           OTPConfig
 	RecoveryCodes RecoveryCodesConfig
+	Push          PushConfig
 }
 
 type OTPConfig struct {
 	Issuer string
 }
 
+type PushConfig struct {
+	ChallengeLifetime time.Duration
+}
+
 type RecoveryCodesConfig struct {
 	MaxCount   int
 	Format     string
@@ -81,6 +81,17 @@ type DB struct {
 	*sql.DB
 	dialect.Database
 	Pool *pgxpool.Pool
+
+	replicas *replicaSet
+}
+
+// Close closes the connections to the primary and all replicas.
+func (db *DB) Close() error {
+	err := db.DB.Close()
+	if db.replicas != nil {
+		err = errors.Join(err, db.replicas.close())
+	}
+	return err
 }
 
 func (db *DB) Query(scan func(*sql.Rows) error, query string, args ...any) error {
@@ -146,11 +157,24 @@ func Connect(config Config, useAdmin bool) (*DB, error) {
 		return nil, zerrors.ThrowPreconditionFailed(err, "DATAB-0pIWD", "Errors.Database.Connection.Failed")
 	}
 
-	return &DB{
+	db := &DB{
 		DB:       client,
 		Database: config.connector,
 		Pool:     pool,
-	}, nil
+	}
+	replicaConnector, ok := config.connector.(dialect.ReplicaConnector)
+	if useAdmin || !ok {
+		return db, nil
+	}
+	replicaClients, replicaPools, err := replicaConnector.ConnectReplicas()
+	if err != nil {
+		logging.OnError(client.Close()).Warn("unable to close database connection")
+		return nil, zerrors.ThrowPreconditionFailed(err, "DATAB-Rp1c4", "Errors.Database.Connection.Failed")
+	}
+	if len(replicaClients) > 0 {
+		db.replicas = newReplicaSet(db, replicaClients, replicaPools, replicaConnector.ReplicaConfig())
+	}
+	return db, nil
 }
 
 func DecodeHook(allowCockroach bool) func(from, to reflect.Value) (_ interface{}, err error) {
@@ -3,6 +3,7 @@ package dialect
 import (
 	"database/sql"
 	"sync"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -42,6 +43,23 @@ type Connector interface {
 	Database
 }
 
+// ReplicaConnector is implemented by connectors which support read replicas.
+type ReplicaConnector interface {
+	// ConnectReplicas connects to all configured replicas using the (non admin) user of the primary.
+	ConnectReplicas() ([]*sql.DB, []*pgxpool.Pool, error)
+	ReplicaConfig() ReplicaConfig
+}
+
+// ReplicaConfig defines how read replicas are used.
+type ReplicaConfig struct {
+	// MaxStaleness is the maximum time a replica is allowed to lag behind the primary
+	// measured by the positions of the projections.
+	// Replicas exceeding it are not used until they caught up.
+	MaxStaleness time.Duration
+	// CheckInterval defines how often the staleness of the replicas is checked.
+	CheckInterval time.Duration
+}
+
 type Database interface {
 	DatabaseName() string
 	Username() string
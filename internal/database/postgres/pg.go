@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"net"
 	"strconv"
 	"strings"
 	"time"
@@ -42,6 +43,15 @@ type Config struct {
 	// Additional options to be appended as options=<Options>
 	// The value will be taken as is. Multiple options are space separated.
 	Options string
+	// Replicas are optional read replicas of the database.
+	// Read-only queries which tolerate a bounded staleness are sent to them.
+	Replicas Replicas
+}
+
+type Replicas struct {
+	// Hosts of the replicas in the form host:port, the port defaults to the port of the primary.
+	Hosts                 []string
+	dialect.ReplicaConfig `mapstructure:",squash"`
 }
 
 func (c *Config) MatchName(name string) bool {
@@ -56,7 +66,10 @@ func (c *Config) MatchName(name string) bool {
 func (_ *Config) Decode(configs []interface{}) (dialect.Connector, error) {
 	connector := new(Config)
 	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
-		DecodeHook:       mapstructure.StringToTimeDurationHookFunc(),
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			mapstructure.StringToTimeDurationHookFunc(),
+			mapstructure.StringToSliceHookFunc(","),
+		),
 		WeaklyTypedInput: true,
 		Result:           connector,
 	})
@@ -74,9 +87,62 @@ func (_ *Config) Decode(configs []interface{}) (dialect.Connector, error) {
 }
 
 func (c *Config) Connect(useAdmin bool) (*sql.DB, *pgxpool.Pool, error) {
+	return c.connect(c.String(useAdmin))
+}
+
+// ConnectReplicas implements [dialect.ReplicaConnector].
+func (c *Config) ConnectReplicas() (_ []*sql.DB, _ []*pgxpool.Pool, err error) {
+	clients := make([]*sql.DB, 0, len(c.Replicas.Hosts))
+	pools := make([]*pgxpool.Pool, 0, len(c.Replicas.Hosts))
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, client := range clients {
+			logging.OnError(client.Close()).Warn("unable to close replica connection")
+		}
+	}()
+	for _, host := range c.Replicas.Hosts {
+		replica, err := c.replica(host)
+		if err != nil {
+			return nil, nil, err
+		}
+		client, pool, err := replica.connect(replica.String(false))
+		if err != nil {
+			return nil, nil, err
+		}
+		clients = append(clients, client)
+		pools = append(pools, pool)
+	}
+	return clients, pools, nil
+}
+
+// ReplicaConfig implements [dialect.ReplicaConnector].
+func (c *Config) ReplicaConfig() dialect.ReplicaConfig {
+	return c.Replicas.ReplicaConfig
+}
+
+// replica returns the config of the primary pointing to the replica host
+func (c Config) replica(hostPort string) (*Config, error) {
+	host, port, err := net.SplitHostPort(strings.TrimSpace(hostPort))
+	if err != nil {
+		// no port specified
+		c.Host = strings.TrimSpace(hostPort)
+		return &c, nil
+	}
+	c.Host = host
+	p, err := strconv.ParseInt(port, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	c.Port = int32(p)
+	return &c, nil
+}
+
+func (c *Config) connect(connString string) (*sql.DB, *pgxpool.Pool, error) {
 	connConfig := dialect.NewConnectionConfig(c.MaxOpenConns, c.MaxIdleConns)
 
-	config, err := pgxpool.ParseConfig(c.String(useAdmin))
+	config, err := pgxpool.ParseConfig(connString)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,149 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/database/dialect"
+)
+
+const defaultReplicaCheckInterval = 5 * time.Second
+
+// replicaSet contains the read replicas of a [DB].
+// A replica is only used if its staleness was checked and is within the configured bounds.
+type replicaSet struct {
+	replicas []*replica
+	config   dialect.ReplicaConfig
+	next     atomic.Uint64
+}
+
+type replica struct {
+	*DB
+	fresh atomic.Bool
+}
+
+func newReplicaSet(primary *DB, clients []*sql.DB, pools []*pgxpool.Pool, config dialect.ReplicaConfig) *replicaSet {
+	if config.CheckInterval <= 0 {
+		config.CheckInterval = defaultReplicaCheckInterval
+	}
+	set := &replicaSet{
+		replicas: make([]*replica, len(clients)),
+		config:   config,
+	}
+	for i, client := range clients {
+		set.replicas[i] = &replica{
+			DB: &DB{
+				DB:       client,
+				Database: primary.Database,
+				Pool:     pools[i],
+			},
+		}
+	}
+	return set
+}
+
+// Reader returns the client to be used for read-only queries which tolerate a bounded staleness,
+// such as list and search queries.
+// It returns one of the replicas within the staleness bounds, or the primary if there is none.
+// Commands, event pushes and consistency-critical reads, such as the ones of the token and userinfo endpoints,
+// must use the [DB] itself. This also applies to reads after a projection was triggered,
+// as the replica might not have received the triggered changes yet.
+func (db *DB) Reader() *DB {
+	if db.replicas == nil {
+		return db
+	}
+	return db.replicas.reader(db)
+}
+
+// ReaderPool returns the pool of the client returned by [DB.Reader].
+func (db *DB) ReaderPool() *pgxpool.Pool {
+	return db.Reader().Pool
+}
+
+func (s *replicaSet) reader(primary *DB) *DB {
+	start := s.next.Add(1)
+	for i := range uint64(len(s.replicas)) {
+		r := s.replicas[(start+i)%uint64(len(s.replicas))]
+		if r.fresh.Load() {
+			return r.DB
+		}
+	}
+	return primary
+}
+
+// StartReplicaCheck periodically checks the staleness of the replicas until the context is done.
+// The positionStmt must return the current position of the projections as a single float value,
+// the difference between the positions of the primary and a replica is compared to the configured max staleness.
+// Until the first check succeeded, all reads are sent to the primary.
+func (db *DB) StartReplicaCheck(ctx context.Context, positionStmt string) {
+	if db.replicas == nil {
+		return
+	}
+	db.replicas.check(ctx, db, positionStmt)
+	go func() {
+		ticker := time.NewTicker(db.replicas.config.CheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				db.replicas.check(ctx, db, positionStmt)
+			}
+		}
+	}()
+}
+
+func (s *replicaSet) check(ctx context.Context, primary *DB, positionStmt string) {
+	ctx, cancel := context.WithTimeout(ctx, s.config.CheckInterval)
+	defer cancel()
+
+	primaryPosition, err := queryPosition(ctx, primary, positionStmt)
+	if err != nil {
+		logging.WithError(err).Warn("unable to query position of primary, replicas are not used")
+		for _, r := range s.replicas {
+			r.fresh.Store(false)
+		}
+		return
+	}
+	for i, r := range s.replicas {
+		position, err := queryPosition(ctx, r.DB, positionStmt)
+		if err != nil {
+			logging.WithFields("replica", i).WithError(err).Warn("unable to query position of replica")
+			r.fresh.Store(false)
+			continue
+		}
+		staleness := time.Duration((primaryPosition - position) * float64(time.Second))
+		fresh := staleness <= s.config.MaxStaleness
+		logging.WithFields("replica", i, "staleness", staleness).Debug("replica checked")
+		if r.fresh.Swap(fresh) != fresh {
+			logging.WithFields("replica", i, "staleness", staleness, "fresh", fresh).Info("replica staleness changed")
+		}
+	}
+}
+
+func queryPosition(ctx context.Context, db *DB, stmt string) (position float64, err error) {
+	err = db.QueryRowContext(ctx, func(row *sql.Row) error {
+		var p sql.NullFloat64
+		if err := row.Scan(&p); err != nil {
+			return err
+		}
+		position = p.Float64
+		return nil
+	}, stmt)
+	return position, err
+}
+
+func (s *replicaSet) close() error {
+	errs := make([]error, 0, len(s.replicas))
+	for _, r := range s.replicas {
+		errs = append(errs, r.DB.DB.Close())
+	}
+	return errors.Join(errs...)
+}
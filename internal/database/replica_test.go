@@ -0,0 +1,145 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/database/dialect"
+	"github.com/zitadel/zitadel/internal/database/mock"
+)
+
+const positionQuery = "SELECT MAX(position) FROM projections.current_states"
+
+func expectPosition(position float64) mock.Expectation {
+	return mock.ExpectQuery(positionQuery,
+		mock.WithQueryResult([]string{"position"}, [][]driver.Value{{position}}),
+	)
+}
+
+func TestDB_Reader(t *testing.T) {
+	primary := &DB{}
+	replica1, replica2 := &replica{DB: &DB{}}, &replica{DB: &DB{}}
+
+	t.Run("no replicas, primary", func(t *testing.T) {
+		assert.Same(t, primary, primary.Reader())
+	})
+
+	primary.replicas = &replicaSet{replicas: []*replica{replica1, replica2}}
+	t.Run("no fresh replicas, primary", func(t *testing.T) {
+		assert.Same(t, primary, primary.Reader())
+	})
+
+	replica2.fresh.Store(true)
+	t.Run("single fresh replica, replica", func(t *testing.T) {
+		assert.Same(t, replica2.DB, primary.Reader())
+		assert.Same(t, replica2.DB, primary.Reader())
+	})
+
+	replica1.fresh.Store(true)
+	t.Run("fresh replicas, round robin", func(t *testing.T) {
+		first := primary.Reader()
+		second := primary.Reader()
+		assert.NotSame(t, first, second)
+		assert.ElementsMatch(t, []*DB{replica1.DB, replica2.DB}, []*DB{first, second})
+	})
+}
+
+func TestDB_ReaderPool(t *testing.T) {
+	primaryPool, replicaPool := new(pgxpool.Pool), new(pgxpool.Pool)
+	r := &replica{DB: &DB{Pool: replicaPool}}
+	primary := &DB{
+		Pool:     primaryPool,
+		replicas: &replicaSet{replicas: []*replica{r}},
+	}
+	assert.Same(t, primaryPool, primary.ReaderPool())
+	r.fresh.Store(true)
+	assert.Same(t, replicaPool, primary.ReaderPool())
+}
+
+func Test_replicaSet_check(t *testing.T) {
+	tests := []struct {
+		name      string
+		primary   func(*testing.T) *mock.SQLMock
+		replica   func(*testing.T) *mock.SQLMock
+		wasFresh  bool
+		wantFresh bool
+	}{
+		{
+			name: "primary error, not fresh",
+			primary: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t, mock.ExpectQuery(positionQuery, mock.WithQueryErr(sql.ErrConnDone)))
+			},
+			replica: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t)
+			},
+			wasFresh:  true,
+			wantFresh: false,
+		},
+		{
+			name: "replica error, not fresh",
+			primary: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t, expectPosition(100))
+			},
+			replica: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t, mock.ExpectQuery(positionQuery, mock.WithQueryErr(sql.ErrConnDone)))
+			},
+			wasFresh:  true,
+			wantFresh: false,
+		},
+		{
+			name: "replica too stale, not fresh",
+			primary: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t, expectPosition(100))
+			},
+			replica: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t, expectPosition(89.5))
+			},
+			wasFresh:  true,
+			wantFresh: false,
+		},
+		{
+			name: "replica within staleness, fresh",
+			primary: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t, expectPosition(100))
+			},
+			replica: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t, expectPosition(95))
+			},
+			wasFresh:  false,
+			wantFresh: true,
+		},
+		{
+			name: "replica up to date, fresh",
+			primary: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t, expectPosition(100))
+			},
+			replica: func(t *testing.T) *mock.SQLMock {
+				return mock.NewSQLMock(t, expectPosition(100))
+			},
+			wasFresh:  false,
+			wantFresh: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primaryMock, replicaMock := tt.primary(t), tt.replica(t)
+			defer primaryMock.Assert(t)
+			defer replicaMock.Assert(t)
+
+			primary := &DB{DB: primaryMock.DB}
+			set := newReplicaSet(primary, []*sql.DB{replicaMock.DB}, []*pgxpool.Pool{nil}, dialect.ReplicaConfig{
+				MaxStaleness: 10 * time.Second,
+			})
+			set.replicas[0].fresh.Store(tt.wasFresh)
+
+			set.check(context.Background(), primary, positionQuery)
+			assert.Equal(t, tt.wantFresh, set.replicas[0].fresh.Load())
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package domain
+
+type AccessReviewState int32
+
+const (
+	AccessReviewStateUnspecified AccessReviewState = iota
+	AccessReviewStateActive
+	AccessReviewStateCompleted
+)
+
+// AccessReviewDecision is the outcome of the review of a single authorization or membership.
+type AccessReviewDecision int32
+
+const (
+	AccessReviewDecisionUnspecified AccessReviewDecision = iota
+	AccessReviewDecisionApproved
+	AccessReviewDecisionRevoked
+)
+
+func (d AccessReviewDecision) Valid() bool {
+	return d == AccessReviewDecisionApproved || d == AccessReviewDecisionRevoked
+}
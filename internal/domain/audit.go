@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"encoding/json"
+	"net"
+	"slices"
+	"strings"
+)
+
+// AuditActorType describes who caused an audited action.
+type AuditActorType int32
+
+const (
+	AuditActorTypeUnspecified AuditActorType = iota
+	AuditActorTypeHuman
+	AuditActorTypeMachine
+	AuditActorTypeSystem
+)
+
+type AuditOutcome int32
+
+const (
+	AuditOutcomeUnspecified AuditOutcome = iota
+	AuditOutcomeSuccess
+	AuditOutcomeFailure
+)
+
+type AuditActionCategory int32
+
+const (
+	AuditActionCategoryUnspecified AuditActionCategory = iota
+	AuditActionCategoryCreate
+	AuditActionCategoryUpdate
+	AuditActionCategoryDelete
+	AuditActionCategoryAuthentication
+)
+
+// auditAuthenticationAggregateTypes only contain events caused by logins and token requests.
+var auditAuthenticationAggregateTypes = []string{
+	"auth_request",
+	"device_auth",
+	"oidc_session",
+	"saml_request",
+	"saml_session",
+	"session",
+}
+
+// AuditActionCategoryFromEventType derives the category of an audited action from its event type.
+func AuditActionCategoryFromEventType(eventType string) AuditActionCategory {
+	if eventType == "" {
+		return AuditActionCategoryUnspecified
+	}
+	aggregateType, _, _ := strings.Cut(eventType, ".")
+	switch {
+	case slices.Contains(auditAuthenticationAggregateTypes, aggregateType),
+		strings.Contains(eventType, ".check."),
+		strings.HasSuffix(eventType, ".checked"),
+		strings.Contains(eventType, ".token."),
+		strings.HasSuffix(eventType, ".signed.out"):
+		return AuditActionCategoryAuthentication
+	case strings.HasSuffix(eventType, ".added"),
+		strings.HasSuffix(eventType, ".created"):
+		return AuditActionCategoryCreate
+	case strings.HasSuffix(eventType, ".removed"),
+		strings.HasSuffix(eventType, ".deleted"):
+		return AuditActionCategoryDelete
+	default:
+		return AuditActionCategoryUpdate
+	}
+}
+
+// AuditOutcomeFromEventType returns a failure for events which record a failed attempt, like a failed password check.
+func AuditOutcomeFromEventType(eventType string) AuditOutcome {
+	if strings.Contains(eventType, ".failed") {
+		return AuditOutcomeFailure
+	}
+	return AuditOutcomeSuccess
+}
+
+// auditPayload contains the fields of event payloads describing the client which caused the event.
+type auditPayload struct {
+	UserAgent        json.RawMessage `json:"userAgent,omitempty"`
+	SessionUserAgent *UserAgent      `json:"user_agent,omitempty"`
+	RemoteIP         net.IP          `json:"remoteIP,omitempty"`
+}
+
+// AuditClientFromPayload extracts the IP and user agent of the client which caused an event from the event payload.
+// Login events contain the browser info of the auth request, sessions and OIDC sessions the user agent.
+func AuditClientFromPayload(data []byte) (ip, userAgent string) {
+	if len(data) == 0 {
+		return "", ""
+	}
+	payload := new(auditPayload)
+	if err := json.Unmarshal(data, payload); err != nil {
+		return "", ""
+	}
+	if len(payload.RemoteIP) > 0 {
+		ip = payload.RemoteIP.String()
+	}
+	if len(payload.UserAgent) > 0 && json.Unmarshal(payload.UserAgent, &userAgent) != nil {
+		agent := new(UserAgent)
+		if json.Unmarshal(payload.UserAgent, agent) == nil {
+			payload.SessionUserAgent = agent
+		}
+	}
+	if agent := payload.SessionUserAgent; agent != nil {
+		if len(agent.IP) > 0 {
+			ip = agent.IP.String()
+		}
+		if header := agent.Header.Get("User-Agent"); header != "" {
+			userAgent = header
+		} else if agent.Description != nil {
+			userAgent = *agent.Description
+		}
+	}
+	return ip, userAgent
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditActionCategoryFromEventType(t *testing.T) {
+	tests := []struct {
+		eventType string
+		want      AuditActionCategory
+	}{
+		{
+			eventType: "",
+			want:      AuditActionCategoryUnspecified,
+		},
+		{
+			eventType: "user.human.added",
+			want:      AuditActionCategoryCreate,
+		},
+		{
+			eventType: "project.application.added",
+			want:      AuditActionCategoryCreate,
+		},
+		{
+			eventType: "user.human.email.changed",
+			want:      AuditActionCategoryUpdate,
+		},
+		{
+			eventType: "user.deactivated",
+			want:      AuditActionCategoryUpdate,
+		},
+		{
+			eventType: "org.removed",
+			want:      AuditActionCategoryDelete,
+		},
+		{
+			eventType: "user.human.password.check.failed",
+			want:      AuditActionCategoryAuthentication,
+		},
+		{
+			eventType: "session.password.checked",
+			want:      AuditActionCategoryAuthentication,
+		},
+		{
+			eventType: "session.added",
+			want:      AuditActionCategoryAuthentication,
+		},
+		{
+			eventType: "user.token.added",
+			want:      AuditActionCategoryAuthentication,
+		},
+		{
+			eventType: "user.human.signed.out",
+			want:      AuditActionCategoryAuthentication,
+		},
+		{
+			eventType: "user.pat.added",
+			want:      AuditActionCategoryCreate,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.eventType, func(t *testing.T) {
+			assert.Equal(t, tt.want, AuditActionCategoryFromEventType(tt.eventType))
+		})
+	}
+}
+
+func TestAuditOutcomeFromEventType(t *testing.T) {
+	tests := []struct {
+		eventType string
+		want      AuditOutcome
+	}{
+		{
+			eventType: "user.human.password.check.failed",
+			want:      AuditOutcomeFailure,
+		},
+		{
+			eventType: "user.human.mfa.otp.check.failed",
+			want:      AuditOutcomeFailure,
+		},
+		{
+			eventType: "user.human.password.check.succeeded",
+			want:      AuditOutcomeSuccess,
+		},
+		{
+			eventType: "user.human.added",
+			want:      AuditOutcomeSuccess,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.eventType, func(t *testing.T) {
+			assert.Equal(t, tt.want, AuditOutcomeFromEventType(tt.eventType))
+		})
+	}
+}
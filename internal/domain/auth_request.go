@@ -104,6 +104,8 @@ type ExternalUser struct {
 	Phone             PhoneNumber
 	IsPhoneVerified   bool
 	Metadatas         []*Metadata
+	// Claims of the external user, used to apply the claim mappings of the identity provider.
+	Claims map[string][]string
 }
 
 type Prompt int32
@@ -141,6 +143,7 @@ const (
 	MFATypeOTPSMS
 	MFATypeOTPEmail
 	MFATypeRecoveryCode
+	MFATypeX509Certificate
 )
 
 func (m MFAType) UserAuthMethodType() UserAuthMethodType {
@@ -157,6 +160,8 @@ func (m MFAType) UserAuthMethodType() UserAuthMethodType {
 		return UserAuthMethodTypeOTPEmail
 	case MFATypeRecoveryCode:
 		return UserAuthMethodTypeRecoveryCode
+	case MFATypeX509Certificate:
+		return UserAuthMethodTypeX509Certificate
 	default:
 		return UserAuthMethodTypeUnspecified
 	}
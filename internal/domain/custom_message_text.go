@@ -18,6 +18,7 @@ const (
 	PasswordlessRegistrationMessageType = "PasswordlessRegistration"
 	PasswordChangeMessageType           = "PasswordChange"
 	InviteUserMessageType               = "InviteUser"
+	QuotaNotificationMessageType        = "QuotaNotification"
 	MessageTitle                        = "Title"
 	MessagePreHeader                    = "PreHeader"
 	MessageSubject                      = "Subject"
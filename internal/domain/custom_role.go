@@ -0,0 +1,22 @@
+package domain
+
+import "strings"
+
+type CustomRoleState int32
+
+const (
+	CustomRoleStateUnspecified CustomRoleState = iota
+	CustomRoleStateActive
+	CustomRoleStateRemoved
+)
+
+func (s CustomRoleState) Exists() bool {
+	return s != CustomRoleStateUnspecified && s != CustomRoleStateRemoved
+}
+
+// IsValidCustomRoleName checks that a custom administrator role can be granted on instance or organization level.
+// The prefix of the name defines on which level, same as for the predefined roles (e.g. IAM_OWNER, ORG_OWNER).
+func IsValidCustomRoleName(role string) bool {
+	return strings.HasPrefix(role, IAMRolePrefix+"_") && len(role) > len(IAMRolePrefix)+1 ||
+		strings.HasPrefix(role, OrgRolePrefix+"_") && len(role) > len(OrgRolePrefix)+1
+}
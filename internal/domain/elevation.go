@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+type ElevationState int32
+
+const (
+	ElevationStateUnspecified ElevationState = iota
+	ElevationStateRequested
+	ElevationStateApproved
+	ElevationStateActive
+	ElevationStateDenied
+	ElevationStateCancelled
+	ElevationStateExpired
+)
+
+// IsPending returns true if the elevation still waits for the approval of another administrator.
+func (s ElevationState) IsPending() bool {
+	return s == ElevationStateRequested
+}
+
+// IsValidPeriod checks that a time-bound membership or grant ends after it starts.
+// Both times are optional.
+func IsValidPeriod(validFrom, validUntil *time.Time) bool {
+	if validFrom == nil || validUntil == nil {
+		return true
+	}
+	return validUntil.After(*validFrom)
+}
@@ -9,6 +9,7 @@ const (
 	SecondFactorTypeOTPEmail
 	SecondFactorTypeOTPSMS
 	SecondFactorTypeRecoveryCodes
+	SecondFactorTypePush
 
 	secondFactorCount
 )
@@ -18,6 +19,7 @@ type MultiFactorType int32
 const (
 	MultiFactorTypeUnspecified MultiFactorType = iota
 	MultiFactorTypeU2FWithPIN
+	MultiFactorTypeX509Certificate
 
 	multiFactorCount
 )
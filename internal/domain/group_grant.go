@@ -0,0 +1,28 @@
+package domain
+
+import es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+
+// GroupGrant authorizes all members of a group for a project.
+// The members inherit the role keys of the grant as long as they are part of the group.
+type GroupGrant struct {
+	es_models.ObjectRoot
+
+	State          UserGrantState
+	GroupID        string
+	ProjectID      string
+	ProjectGrantID string
+	RoleKeys       []string
+}
+
+func (g *GroupGrant) IsValid() bool {
+	return g.ProjectID != "" && g.GroupID != ""
+}
+
+func (g *GroupGrant) HasInvalidRoles(validRoles []string) bool {
+	for _, roleKey := range g.RoleKeys {
+		if !containsRoleKey(roleKey, validRoles) {
+			return true
+		}
+	}
+	return false
+}
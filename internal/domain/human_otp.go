@@ -11,6 +11,8 @@ import (
 type TOTP struct {
 	*ObjectDetails
 
+	// ID identifies a named TOTP authenticator, it's empty for the default authenticator
+	ID     string
 	Secret string
 	URI    string
 }
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// PushEnrollmentScheme is the scheme of the URI, which is encoded as QR code for the enrollment of push devices.
+	PushEnrollmentScheme = "zitadel-push"
+
+	pushChallengeLength = 32
+	pushCodeDigits      = 2
+)
+
+type PushDeviceRegistration struct {
+	*ObjectDetails
+
+	DeviceID string
+	Code     string
+	Expiry   time.Duration
+	URI      string
+}
+
+// PushEnrollmentURI returns the URI, which contains everything the device needs to finish its enrollment.
+// The origin is the base URL of the API the device calls.
+func PushEnrollmentURI(origin, userID, deviceID, code string) string {
+	query := url.Values{}
+	query.Set("origin", origin)
+	query.Set("user", userID)
+	query.Set("device", deviceID)
+	query.Set("code", code)
+	return (&url.URL{
+		Scheme:   PushEnrollmentScheme,
+		Host:     "enroll",
+		RawQuery: query.Encode(),
+	}).String()
+}
+
+// NewPushChallenge returns a random challenge, which is signed by the device,
+// and the number matching code, which is displayed to the user and has to be entered on the device.
+func NewPushChallenge() (challenge, code string, err error) {
+	random := make([]byte, pushChallengeLength)
+	if _, err = rand.Read(random); err != nil {
+		return "", "", err
+	}
+	number, err := rand.Int(rand.Reader, big.NewInt(100))
+	if err != nil {
+		return "", "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(random), fmt.Sprintf("%0*d", pushCodeDigits, number.Int64()), nil
+}
+
+// PushApprovalData returns the data the device signs to approve or reject a push challenge.
+func PushApprovalData(sessionID, challenge, code string, approved bool) []byte {
+	decision := "reject"
+	if approved {
+		decision = "approve"
+	}
+	return []byte(strings.Join([]string{sessionID, challenge, code, decision}, "."))
+}
+
+// ParsePushPublicKey parses the PKIX, ASN.1 DER encoded public key of a push device.
+// ECDSA, Ed25519 and RSA keys are supported.
+func ParsePushPublicKey(der []byte) (crypto.PublicKey, error) {
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "DOMAIN-Pu5kP", "Errors.User.MFA.Push.PublicKeyInvalid")
+	}
+	switch key.(type) {
+	case *ecdsa.PublicKey, ed25519.PublicKey, *rsa.PublicKey:
+		return key, nil
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-Pu5kT", "Errors.User.MFA.Push.PublicKeyInvalid")
+	}
+}
+
+// VerifyPushSignature verifies the signature of the data with the public key of the push device.
+// ECDSA signatures are expected ASN.1 encoded, ECDSA and RSA (PKCS #1 v1.5) signatures are computed over the SHA-256 hash of the data.
+func VerifyPushSignature(publicKey []byte, data, signature []byte) error {
+	key, err := ParsePushPublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+	hash := sha256.Sum256(data)
+	var valid bool
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(k, hash[:], signature)
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(k, data, signature)
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], signature) == nil
+	}
+	if !valid {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Pu5kS", "Errors.User.MFA.Push.SignatureInvalid")
+	}
+	return nil
+}
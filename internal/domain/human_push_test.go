@@ -0,0 +1,110 @@
+package domain
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestPushEnrollmentURI(t *testing.T) {
+	got := PushEnrollmentURI("https://example.com", "userID", "deviceID", "a&b")
+	uri, err := url.Parse(got)
+	require.NoError(t, err)
+	assert.Equal(t, PushEnrollmentScheme, uri.Scheme)
+	assert.Equal(t, "enroll", uri.Host)
+	assert.Equal(t, url.Values{
+		"origin": {"https://example.com"},
+		"user":   {"userID"},
+		"device": {"deviceID"},
+		"code":   {"a&b"},
+	}, uri.Query())
+}
+
+func TestNewPushChallenge(t *testing.T) {
+	challenge, code, err := NewPushChallenge()
+	require.NoError(t, err)
+	assert.Len(t, challenge, 43)
+	assert.Regexp(t, "^[0-9]{2}$", code)
+}
+
+func TestPushApprovalData(t *testing.T) {
+	assert.Equal(t, []byte("sessionID.challenge.42.approve"), PushApprovalData("sessionID", "challenge", "42", true))
+	assert.Equal(t, []byte("sessionID.challenge..reject"), PushApprovalData("sessionID", "challenge", "", false))
+}
+
+func TestVerifyPushSignature(t *testing.T) {
+	data := PushApprovalData("sessionID", "challenge", "42", true)
+	hash := sha256.Sum256(data)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecSignature, err := ecdsa.SignASN1(rand.Reader, ecKey, hash[:])
+	require.NoError(t, err)
+
+	edPublic, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaSignature, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, hash[:])
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		publicKey []byte
+		signature []byte
+		wantErr   error
+	}{
+		{
+			name:      "invalid public key",
+			publicKey: []byte("invalid"),
+			signature: ecSignature,
+			wantErr:   zerrors.ThrowInvalidArgument(nil, "DOMAIN-Pu5kP", "Errors.User.MFA.Push.PublicKeyInvalid"),
+		},
+		{
+			name:      "invalid signature",
+			publicKey: marshalPublicKey(t, &ecKey.PublicKey),
+			signature: rsaSignature,
+			wantErr:   zerrors.ThrowInvalidArgument(nil, "DOMAIN-Pu5kS", "Errors.User.MFA.Push.SignatureInvalid"),
+		},
+		{
+			name:      "ecdsa",
+			publicKey: marshalPublicKey(t, &ecKey.PublicKey),
+			signature: ecSignature,
+		},
+		{
+			name:      "ed25519",
+			publicKey: marshalPublicKey(t, edPublic),
+			signature: ed25519.Sign(edKey, data),
+		},
+		{
+			name:      "rsa",
+			publicKey: marshalPublicKey(t, &rsaKey.PublicKey),
+			signature: rsaSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyPushSignature(tt.publicKey, data, tt.signature)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func marshalPublicKey(t *testing.T, key crypto.PublicKey) []byte {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return der
+}
@@ -0,0 +1,149 @@
+package domain
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// X509ChallengeLifetime is the time the challenge of a session can be signed with the private key of the client certificate.
+	X509ChallengeLifetime = 5 * time.Minute
+
+	x509ChallengeLength = 32
+)
+
+var (
+	oidExtensionSubjectAltName = asn1.ObjectIdentifier{2, 5, 29, 17}
+	oidEmailAddress            = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 1}
+	oidUserPrincipalName       = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 20, 2, 3}
+)
+
+// x509OtherName is the otherName of the GeneralName (RFC 5280, 4.2.1.6), which is not parsed by the standard library.
+type x509OtherName struct {
+	TypeID asn1.ObjectIdentifier
+	// Value is the explicitly tagged [0] value
+	Value asn1.RawValue
+}
+
+// X509UserPrincipalNames returns the Microsoft user principal names of the subject alternative names.
+func X509UserPrincipalNames(cert *x509.Certificate) []string {
+	var upns []string
+	for _, ext := range cert.Extensions {
+		if !ext.Id.Equal(oidExtensionSubjectAltName) {
+			continue
+		}
+		var names asn1.RawValue
+		if rest, err := asn1.Unmarshal(ext.Value, &names); err != nil || len(rest) > 0 || names.Tag != asn1.TagSequence {
+			return nil
+		}
+		for rest := names.Bytes; len(rest) > 0; {
+			var name asn1.RawValue
+			var err error
+			if rest, err = asn1.Unmarshal(rest, &name); err != nil {
+				return upns
+			}
+			if name.Class != asn1.ClassContextSpecific || name.Tag != 0 {
+				continue
+			}
+			var otherName x509OtherName
+			if _, err = asn1.UnmarshalWithParams(name.FullBytes, &otherName, "tag:0"); err != nil {
+				continue
+			}
+			if !otherName.TypeID.Equal(oidUserPrincipalName) || otherName.Value.Tag != 0 {
+				continue
+			}
+			var value asn1.RawValue
+			if _, err = asn1.Unmarshal(otherName.Value.Bytes, &value); err != nil {
+				continue
+			}
+			switch value.Tag {
+			case asn1.TagUTF8String, asn1.TagPrintableString, asn1.TagIA5String:
+				upns = append(upns, string(value.Bytes))
+			}
+		}
+	}
+	return upns
+}
+
+// X509SubjectEmails returns the email addresses of the subject distinguished name.
+func X509SubjectEmails(cert *x509.Certificate) []string {
+	var emails []string
+	for _, name := range cert.Subject.Names {
+		if !name.Type.Equal(oidEmailAddress) {
+			continue
+		}
+		if email, ok := name.Value.(string); ok {
+			emails = append(emails, email)
+		}
+	}
+	return emails
+}
+
+// X509Fingerprint returns the lowercase hex encoded SHA-256 fingerprint of the certificate.
+func X509Fingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// X509IdentifierMatches returns true if the identifier of the client certificate matches any of the values of the user.
+// Login names are compared case-insensitive, as it's done for the login.
+func X509IdentifierMatches(identifier X509Identifier, loginNames []string, verifiedEmail string) bool {
+	switch identifier.Target {
+	case X509MappingTargetLoginName:
+		for _, loginName := range loginNames {
+			if strings.EqualFold(identifier.Value, loginName) {
+				return true
+			}
+		}
+	case X509MappingTargetEmail:
+		return verifiedEmail != "" && strings.EqualFold(identifier.Value, verifiedEmail)
+	}
+	return false
+}
+
+// NewX509Challenge returns a random challenge, which has to be signed with the private key of the client certificate
+// to prove its possession.
+func NewX509Challenge() (string, error) {
+	random := make([]byte, x509ChallengeLength)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(random), nil
+}
+
+// X509ChallengeData returns the data, which is signed with the private key of the client certificate.
+func X509ChallengeData(sessionID, challenge string) []byte {
+	return []byte(strings.Join([]string{sessionID, challenge}, "."))
+}
+
+// VerifyX509Signature verifies the signature of the data with the public key of the client certificate.
+// ECDSA signatures are expected ASN.1 encoded, ECDSA and RSA (PKCS #1 v1.5 or PSS) signatures are computed over the SHA-256 hash of the data.
+func VerifyX509Signature(cert *x509.Certificate, data, signature []byte) error {
+	hash := sha256.Sum256(data)
+	var valid bool
+	switch k := cert.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(k, hash[:], signature)
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(k, data, signature)
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], signature) == nil ||
+			rsa.VerifyPSS(k, crypto.SHA256, hash[:], signature, nil) == nil
+	}
+	if !valid {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-X5c1S", "Errors.User.X509.SignatureInvalid")
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestX509ChallengeData(t *testing.T) {
+	assert.Equal(t, []byte("sessionID.challenge"), X509ChallengeData("sessionID", "challenge"))
+}
+
+func TestVerifyX509Signature(t *testing.T) {
+	data := X509ChallengeData("sessionID", "challenge")
+	hash := sha256.Sum256(data)
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecSignature, err := ecdsa.SignASN1(rand.Reader, ecKey, hash[:])
+	require.NoError(t, err)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaSignature, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, hash[:])
+	require.NoError(t, err)
+	rsaPSSSignature, err := rsa.SignPSS(rand.Reader, rsaKey, crypto.SHA256, hash[:], nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		cert      *x509.Certificate
+		signature []byte
+		wantErr   error
+	}{
+		{
+			name:      "invalid signature",
+			cert:      &x509.Certificate{PublicKey: &ecKey.PublicKey},
+			signature: rsaSignature,
+			wantErr:   zerrors.ThrowInvalidArgument(nil, "DOMAIN-X5c1S", "Errors.User.X509.SignatureInvalid"),
+		},
+		{
+			name:      "unsupported key",
+			cert:      &x509.Certificate{},
+			signature: ecSignature,
+			wantErr:   zerrors.ThrowInvalidArgument(nil, "DOMAIN-X5c1S", "Errors.User.X509.SignatureInvalid"),
+		},
+		{
+			name:      "ecdsa",
+			cert:      &x509.Certificate{PublicKey: &ecKey.PublicKey},
+			signature: ecSignature,
+		},
+		{
+			name:      "rsa",
+			cert:      &x509.Certificate{PublicKey: &rsaKey.PublicKey},
+			signature: rsaSignature,
+		},
+		{
+			name:      "rsa pss",
+			cert:      &x509.Certificate{PublicKey: &rsaKey.PublicKey},
+			signature: rsaPSSSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyX509Signature(tt.cert, data, tt.signature)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -38,6 +38,7 @@ const (
 	IDPTypeGoogle
 	IDPTypeApple
 	IDPTypeSAML
+	IDPTypeWSFederation
 )
 
 func (t IDPType) GetCSSClass() string {
@@ -59,7 +60,8 @@ func (t IDPType) GetCSSClass() string {
 		IDPTypeJWT,
 		IDPTypeOAuth,
 		IDPTypeLDAP,
-		IDPTypeSAML:
+		IDPTypeSAML,
+		IDPTypeWSFederation:
 		fallthrough
 	default:
 		return ""
@@ -93,7 +95,8 @@ func (t IDPType) DisplayName() string {
 		IDPTypeAzureAD,
 		IDPTypeGitHubEnterprise,
 		IDPTypeGitLabSelfHosted,
-		IDPTypeSAML:
+		IDPTypeSAML,
+		IDPTypeWSFederation:
 		fallthrough
 	default:
 		// we should never get here, so log it
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// IDPClaimMapping maps a value of a claim (or attribute) of the external user to a group membership or a role,
+// which is granted to the linked user on every login and revoked as soon as the claim doesn't contain the value anymore.
+// Exactly one of GroupID or ProjectID with RoleKey has to be set.
+type IDPClaimMapping struct {
+	// Claim is the name of the claim or attribute, e.g. `groups` of Entra ID or `memberOf` of SAML and LDAP
+	Claim string `json:"claim"`
+	// Value is compared case-insensitively to the values of the claim
+	Value     string `json:"value"`
+	GroupID   string `json:"groupId,omitempty"`
+	ProjectID string `json:"projectId,omitempty"`
+	RoleKey   string `json:"roleKey,omitempty"`
+}
+
+func (m *IDPClaimMapping) Validate() error {
+	if m == nil || m.Claim == "" || m.Value == "" {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Cm1vC", "Errors.IDP.ClaimMapping.Invalid")
+	}
+	hasGroup := m.GroupID != ""
+	hasRole := m.ProjectID != "" || m.RoleKey != ""
+	if hasGroup == hasRole || (hasRole && (m.ProjectID == "" || m.RoleKey == "")) {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Cm1vT", "Errors.IDP.ClaimMapping.Invalid")
+	}
+	return nil
+}
+
+// Matches returns true if the claims contain the value of the mapping.
+func (m *IDPClaimMapping) Matches(claims map[string][]string) bool {
+	return slices.ContainsFunc(claims[m.Claim], func(value string) bool {
+		return strings.EqualFold(value, m.Value)
+	})
+}
+
+// IDPClaimMappingRole is a role of a project granted by a claim mapping.
+type IDPClaimMappingRole struct {
+	ProjectID string `json:"projectId"`
+	RoleKey   string `json:"roleKey"`
+}
+
+// MappedIDPClaims returns the group IDs and roles of all mappings matching the claims, without duplicates.
+func MappedIDPClaims(mappings []*IDPClaimMapping, claims map[string][]string) (groupIDs []string, roles []IDPClaimMappingRole) {
+	groupIDs = make([]string, 0)
+	roles = make([]IDPClaimMappingRole, 0)
+	for _, mapping := range mappings {
+		if !mapping.Matches(claims) {
+			continue
+		}
+		if mapping.GroupID != "" {
+			if !slices.Contains(groupIDs, mapping.GroupID) {
+				groupIDs = append(groupIDs, mapping.GroupID)
+			}
+			continue
+		}
+		role := IDPClaimMappingRole{ProjectID: mapping.ProjectID, RoleKey: mapping.RoleKey}
+		if !slices.Contains(roles, role) {
+			roles = append(roles, role)
+		}
+	}
+	return groupIDs, roles
+}
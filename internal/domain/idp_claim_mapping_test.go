@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestIDPClaimMapping_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping *IDPClaimMapping
+		wantErr bool
+	}{
+		{
+			name:    "nil",
+			mapping: nil,
+			wantErr: true,
+		},
+		{
+			name:    "claim missing",
+			mapping: &IDPClaimMapping{Value: "admins", GroupID: "group1"},
+			wantErr: true,
+		},
+		{
+			name:    "value missing",
+			mapping: &IDPClaimMapping{Claim: "groups", GroupID: "group1"},
+			wantErr: true,
+		},
+		{
+			name:    "no target",
+			mapping: &IDPClaimMapping{Claim: "groups", Value: "admins"},
+			wantErr: true,
+		},
+		{
+			name:    "group and role",
+			mapping: &IDPClaimMapping{Claim: "groups", Value: "admins", GroupID: "group1", ProjectID: "project1", RoleKey: "admin"},
+			wantErr: true,
+		},
+		{
+			name:    "role key missing",
+			mapping: &IDPClaimMapping{Claim: "groups", Value: "admins", ProjectID: "project1"},
+			wantErr: true,
+		},
+		{
+			name:    "group, ok",
+			mapping: &IDPClaimMapping{Claim: "groups", Value: "admins", GroupID: "group1"},
+		},
+		{
+			name:    "role, ok",
+			mapping: &IDPClaimMapping{Claim: "groups", Value: "admins", ProjectID: "project1", RoleKey: "admin"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.mapping.Validate()
+			if tt.wantErr {
+				assert.True(t, zerrors.IsErrorInvalidArgument(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMappedIDPClaims(t *testing.T) {
+	mappings := []*IDPClaimMapping{
+		{Claim: "groups", Value: "8c1a4f9e-admins", GroupID: "group1"},
+		{Claim: "memberOf", Value: "cn=admins,ou=groups,dc=example,dc=com", GroupID: "group1"},
+		{Claim: "groups", Value: "8c1a4f9e-admins", ProjectID: "project1", RoleKey: "admin"},
+		{Claim: "groups", Value: "2d7b0c31-users", ProjectID: "project1", RoleKey: "user"},
+		{Claim: "department", Value: "it", GroupID: "group2"},
+	}
+	groupIDs, roles := MappedIDPClaims(mappings, map[string][]string{
+		"groups":   {"8C1A4F9E-ADMINS", "other"},
+		"memberOf": {"CN=Admins,OU=Groups,DC=example,DC=com"},
+	})
+	assert.Equal(t, []string{"group1"}, groupIDs)
+	assert.Equal(t, []IDPClaimMappingRole{{ProjectID: "project1", RoleKey: "admin"}}, roles)
+
+	groupIDs, roles = MappedIDPClaims(mappings, nil)
+	assert.Empty(t, groupIDs)
+	assert.Empty(t, roles)
+}
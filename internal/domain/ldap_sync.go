@@ -0,0 +1,67 @@
+package domain
+
+type LDAPSyncState int32
+
+const (
+	LDAPSyncStateUnspecified LDAPSyncState = iota
+	LDAPSyncStateActive
+	LDAPSyncStateRemoved
+)
+
+func (s LDAPSyncState) Exists() bool {
+	return s == LDAPSyncStateActive
+}
+
+// LDAPSyncIncrementalAttribute is the operational attribute used to only read the entries
+// changed since the last synchronization.
+type LDAPSyncIncrementalAttribute int32
+
+const (
+	// LDAPSyncIncrementalAttributeUnspecified reads all entries on every synchronization
+	LDAPSyncIncrementalAttributeUnspecified LDAPSyncIncrementalAttribute = iota
+	// LDAPSyncIncrementalAttributeModifyTimestamp uses the modifyTimestamp of RFC 4512
+	LDAPSyncIncrementalAttributeModifyTimestamp
+	// LDAPSyncIncrementalAttributeUSNChanged uses the update sequence number of Active Directory
+	LDAPSyncIncrementalAttributeUSNChanged
+	ldapSyncIncrementalAttributeCount
+)
+
+func (a LDAPSyncIncrementalAttribute) Valid() bool {
+	return a >= LDAPSyncIncrementalAttributeUnspecified && a < ldapSyncIncrementalAttributeCount
+}
+
+// Name returns the name of the LDAP attribute, empty if all entries are read.
+func (a LDAPSyncIncrementalAttribute) Name() string {
+	switch a {
+	case LDAPSyncIncrementalAttributeModifyTimestamp:
+		return "modifyTimestamp"
+	case LDAPSyncIncrementalAttributeUSNChanged:
+		return "uSNChanged"
+	case LDAPSyncIncrementalAttributeUnspecified,
+		ldapSyncIncrementalAttributeCount:
+		fallthrough
+	default:
+		return ""
+	}
+}
+
+// LDAPSyncGroupMapping maps the DN of an LDAP group to a group,
+// the synchronized users which are members of the LDAP group are added to the group.
+type LDAPSyncGroupMapping struct {
+	LDAPGroup string `json:"ldapGroup"`
+	GroupID   string `json:"groupId"`
+}
+
+// LDAPSyncSummary is the result of a synchronization run.
+type LDAPSyncSummary struct {
+	Full          bool   `json:"full,omitempty"`
+	Created       uint32 `json:"created,omitempty"`
+	Updated       uint32 `json:"updated,omitempty"`
+	Deactivated   uint32 `json:"deactivated,omitempty"`
+	Reactivated   uint32 `json:"reactivated,omitempty"`
+	GroupsAdded   uint32 `json:"groupsAdded,omitempty"`
+	GroupsRemoved uint32 `json:"groupsRemoved,omitempty"`
+	Failed        uint32 `json:"failed,omitempty"`
+	// LastError is the error of the last entry which failed to synchronize
+	LastError string `json:"lastError,omitempty"`
+}
@@ -0,0 +1,39 @@
+package domain
+
+// LoginOutcome is the result of an authentication check or a login over OIDC or SAML.
+type LoginOutcome int32
+
+const (
+	LoginOutcomeUnspecified LoginOutcome = iota
+	LoginOutcomeSuccess
+	LoginOutcomeWrongPassword
+	LoginOutcomeLocked
+	LoginOutcomeMFAFailed
+	LoginOutcomeIDPError
+	// LoginOutcomeFailed is used for failed OIDC and SAML logins, the reason is stated separately.
+	LoginOutcomeFailed
+)
+
+func (o LoginOutcome) IsFailure() bool {
+	return o > LoginOutcomeSuccess
+}
+
+// LoginMethod is the authentication check or login protocol a LoginOutcome was recorded for.
+type LoginMethod string
+
+const (
+	LoginMethodUnspecified  LoginMethod = ""
+	LoginMethodPassword     LoginMethod = "password"
+	LoginMethodTOTP         LoginMethod = "totp"
+	LoginMethodOTPSMS       LoginMethod = "otp_sms"
+	LoginMethodOTPEmail     LoginMethod = "otp_email"
+	LoginMethodU2F          LoginMethod = "u2f"
+	LoginMethodPasswordless LoginMethod = "passwordless"
+	LoginMethodWebAuthN     LoginMethod = "webauthn"
+	LoginMethodRecoveryCode LoginMethod = "recovery_code"
+	LoginMethodX509         LoginMethod = "x509"
+	LoginMethodPush         LoginMethod = "push"
+	LoginMethodIDP          LoginMethod = "idp"
+	LoginMethodOIDC         LoginMethod = "oidc"
+	LoginMethodSAML         LoginMethod = "saml"
+)
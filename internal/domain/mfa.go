@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/zitadel/zitadel/internal/crypto"
 )
 
@@ -18,6 +20,7 @@ const (
 type MultifactorConfigs struct {
 	OTP           OTPConfig
 	RecoveryCodes RecoveryCodesConfig
+	Push          PushConfig
 }
 
 type OTPConfig struct {
@@ -25,6 +28,11 @@ type OTPConfig struct {
 	CryptoMFA crypto.EncryptionAlgorithm
 }
 
+type PushConfig struct {
+	// ChallengeLifetime is the duration a push challenge can be approved on a device
+	ChallengeLifetime time.Duration
+}
+
 type RecoveryCodeFormat string
 
 const (
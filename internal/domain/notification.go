@@ -45,6 +45,9 @@ type NotificationArguments struct {
 	CodeID          string        `json:"codeID,omitempty"`
 	SessionID       string        `json:"sessionID,omitempty"`
 	AuthRequestID   string        `json:"authRequestID,omitempty"`
+	QuotaUnit       string        `json:"quotaUnit,omitempty"`
+	QuotaThreshold  uint16        `json:"quotaThreshold,omitempty"`
+	QuotaUsage      uint64        `json:"quotaUsage,omitempty"`
 }
 
 // ToMap creates a type safe map of the notification arguments.
@@ -62,5 +65,8 @@ func (n *NotificationArguments) ToMap() map[string]interface{} {
 	m["CodeID"] = n.CodeID
 	m["SessionID"] = n.SessionID
 	m["AuthRequestID"] = n.AuthRequestID
+	m["QuotaUnit"] = n.QuotaUnit
+	m["QuotaThreshold"] = n.QuotaThreshold
+	m["QuotaUsage"] = n.QuotaUsage
 	return m
 }
@@ -46,3 +46,47 @@ func OIDCErrorReasonFromError(err error) OIDCErrorReason {
 	}
 	return OIDCErrorReasonUnspecified
 }
+
+// OIDCErrorReasonToString returns the error code of the reason as defined by OAuth 2.0 and OpenID Connect.
+func OIDCErrorReasonToString(reason OIDCErrorReason) string {
+	switch reason {
+	case OIDCErrorReasonInvalidRequest:
+		return "invalid_request"
+	case OIDCErrorReasonUnauthorizedClient:
+		return "unauthorized_client"
+	case OIDCErrorReasonAccessDenied:
+		return "access_denied"
+	case OIDCErrorReasonUnsupportedResponseType:
+		return "unsupported_response_type"
+	case OIDCErrorReasonInvalidScope:
+		return "invalid_scope"
+	case OIDCErrorReasonServerError:
+		return "server_error"
+	case OIDCErrorReasonTemporaryUnavailable:
+		return "temporarily_unavailable"
+	case OIDCErrorReasonInteractionRequired:
+		return "interaction_required"
+	case OIDCErrorReasonLoginRequired:
+		return "login_required"
+	case OIDCErrorReasonAccountSelectionRequired:
+		return "account_selection_required"
+	case OIDCErrorReasonConsentRequired:
+		return "consent_required"
+	case OIDCErrorReasonInvalidRequestURI:
+		return "invalid_request_uri"
+	case OIDCErrorReasonInvalidRequestObject:
+		return "invalid_request_object"
+	case OIDCErrorReasonRequestNotSupported:
+		return "request_not_supported"
+	case OIDCErrorReasonRequestURINotSupported:
+		return "request_uri_not_supported"
+	case OIDCErrorReasonRegistrationNotSupported:
+		return "registration_not_supported"
+	case OIDCErrorReasonInvalidGrant:
+		return "invalid_grant"
+	case OIDCErrorReasonUnspecified:
+		fallthrough
+	default:
+		return "unspecified"
+	}
+}
@@ -39,6 +39,8 @@ const (
 	PermissionOrgRead                  = "org.read"
 	PermissionIDPRead                  = "iam.idp.read"
 	PermissionOrgIDPRead               = "org.idp.read"
+	PermissionIDPWrite                 = "iam.idp.write"
+	PermissionOrgIDPWrite              = "org.idp.write"
 	PermissionProjectCreate            = "project.create"
 	PermissionProjectWrite             = "project.write"
 	PermissionProjectRead              = "project.read"
@@ -70,6 +72,8 @@ const (
 	PermissionIAMPolicyWrite           = "iam.policy.write"
 	PermissionIAMPolicyDelete          = "iam.policy.delete"
 	PermissionPolicyRead               = "policy.read"
+	PermissionPolicyWrite              = "policy.write"
+	PermissionPolicyDelete             = "policy.delete"
 	PermissionInstanceRead             = "iam.read"
 	PermissionInstanceWrite            = "iam.write"
 	PermissionSystemInstanceRead       = "system.instance.read"
@@ -81,6 +85,8 @@ const (
 	PermissionGroupUserWrite           = "group.user.write"
 	PermissionGroupUserRead            = "group.user.read"
 	PermissionGroupUserDelete          = "group.user.delete"
+	PermissionAuditRead                = "audit.read"
+	PermissionAnalyticsRead            = "analytics.read"
 )
 
 // ProjectPermissionCheck is used as a check for preconditions dependent on application, project, user resourceowner and usergrants.
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// MaxDeviceTrustLifetime is the longest duration a device can be trusted.
+const MaxDeviceTrustLifetime = 365 * 24 * time.Hour
+
+// DeviceTrustPolicy defines if users can trust a device (browser) after checking a second factor,
+// so the second factor check is skipped on it.
+type DeviceTrustPolicy struct {
+	models.ObjectRoot
+
+	// IsDefault is true if the policy of the instance is returned for an organization.
+	IsDefault bool
+	// Lifetime is the duration a device is trusted, a lifetime of 0 disables trusted devices.
+	Lifetime time.Duration
+}
+
+func (p *DeviceTrustPolicy) Validate() error {
+	if p.Lifetime < 0 || p.Lifetime > MaxDeviceTrustLifetime {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-Dtp1L", "Errors.Policy.DeviceTrust.LifetimeInvalid")
+	}
+	return nil
+}
+
+func (p *DeviceTrustPolicy) Enabled() bool {
+	return p != nil && p.Lifetime > 0
+}
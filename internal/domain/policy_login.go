@@ -2,6 +2,7 @@ package domain
 
 import (
 	"net/url"
+	"slices"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
@@ -95,3 +96,8 @@ func (p *LoginPolicy) HasSecondFactors() bool {
 func (p *LoginPolicy) HasMultiFactors() bool {
 	return len(p.MultiFactors) > 0
 }
+
+// AllowX509Certificate is used in html rendering
+func (p *LoginPolicy) AllowX509Certificate() bool {
+	return slices.Contains(p.MultiFactors, MultiFactorTypeX509Certificate)
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
+
+// WebAuthNPolicy restricts the authenticators which can be registered as passkey or U2F.
+type WebAuthNPolicy struct {
+	models.ObjectRoot
+
+	// RequireAttestation rejects registrations without a (non-self) attestation statement.
+	RequireAttestation bool
+	// VerifyMetadata verifies the attestation against the FIDO Metadata Service BLOB.
+	VerifyMetadata bool
+	// AllowedAAGUIDs restricts the registration to the listed authenticator models, if not empty.
+	AllowedAAGUIDs []string
+	// DeniedAAGUIDs rejects the registration of the listed authenticator models.
+	DeniedAAGUIDs []string
+}
+
+// RequestsAttestation returns true if the policy requires the authenticator to provide its attestation.
+// Without attestation browsers might anonymize the AAGUID, therefore the allow and deny lists request it as well.
+func (p *WebAuthNPolicy) RequestsAttestation() bool {
+	return p != nil && (p.RequireAttestation || p.VerifyMetadata || len(p.AllowedAAGUIDs) > 0 || len(p.DeniedAAGUIDs) > 0)
+}
@@ -0,0 +1,222 @@
+package domain
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// X509Policy defines the trusted certificate authorities and how client certificates (e.g. of PIV / CAC smart cards)
+// are mapped to users for the certificate based authentication.
+type X509Policy struct {
+	models.ObjectRoot
+
+	// IsDefault is true if the policy of the instance is returned for an organization.
+	IsDefault bool
+	// TrustedCAs contains the PEM encoded root certificates, client certificates have to be issued by.
+	TrustedCAs []byte
+	// MappingRules are evaluated in order, the first identifier which matches the user is used.
+	// If empty, [DefaultX509MappingRules] are used.
+	MappingRules []X509MappingRule
+	// OCSPResponderURL is the responder, which is asked for the revocation status of client certificates.
+	OCSPResponderURL string
+	// CRLURLs are the revocation lists, which are checked if no OCSP responder is configured or it can't be reached.
+	CRLURLs []string
+}
+
+type X509MappingSource int32
+
+const (
+	X509MappingSourceUnspecified X509MappingSource = iota
+	// X509MappingSourceSubjectCommonName is the common name (CN) of the subject.
+	X509MappingSourceSubjectCommonName
+	// X509MappingSourceSubjectEmail is the email address (E) of the subject.
+	X509MappingSourceSubjectEmail
+	// X509MappingSourceSANEmail are the email addresses (rfc822Name) of the subject alternative names.
+	X509MappingSourceSANEmail
+	// X509MappingSourceSANUPN are the Microsoft user principal names (otherName) of the subject alternative names,
+	// which are used on most PIV and CAC cards.
+	X509MappingSourceSANUPN
+
+	x509MappingSourceCount
+)
+
+func (s X509MappingSource) Valid() bool {
+	return s > X509MappingSourceUnspecified && s < x509MappingSourceCount
+}
+
+type X509MappingTarget int32
+
+const (
+	X509MappingTargetUnspecified X509MappingTarget = iota
+	// X509MappingTargetLoginName matches the identifier with the login names of the user.
+	X509MappingTargetLoginName
+	// X509MappingTargetEmail matches the identifier with the verified email address of the user.
+	X509MappingTargetEmail
+
+	x509MappingTargetCount
+)
+
+func (t X509MappingTarget) Valid() bool {
+	return t > X509MappingTargetUnspecified && t < x509MappingTargetCount
+}
+
+type X509MappingRule struct {
+	Source X509MappingSource
+	Target X509MappingTarget
+	// Pattern optionally restricts and transforms the values of the source.
+	// The value has to match the regular expression and the first capturing group (or the whole match) is used as identifier.
+	Pattern string
+}
+
+// DefaultX509MappingRules map the user principal name to the login name and the email address to the email of the user.
+var DefaultX509MappingRules = []X509MappingRule{
+	{Source: X509MappingSourceSANUPN, Target: X509MappingTargetLoginName},
+	{Source: X509MappingSourceSANEmail, Target: X509MappingTargetEmail},
+}
+
+// X509Identifier is a value of a client certificate, which identifies the user.
+type X509Identifier struct {
+	Target X509MappingTarget
+	Value  string
+}
+
+// Enabled returns true if any certificate authority is trusted.
+func (p *X509Policy) Enabled() bool {
+	return p != nil && len(p.TrustedCAs) > 0
+}
+
+func (p *X509Policy) Validate() error {
+	if _, err := ParseX509Certificates(p.TrustedCAs); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "DOMAIN-X5p1C", "Errors.Policy.X509.CAInvalid")
+	}
+	for _, rule := range p.MappingRules {
+		if !rule.Source.Valid() || !rule.Target.Valid() {
+			return zerrors.ThrowInvalidArgument(nil, "DOMAIN-X5p1R", "Errors.Policy.X509.MappingRuleInvalid")
+		}
+		if rule.Pattern == "" {
+			continue
+		}
+		if _, err := regexp.Compile(rule.Pattern); err != nil {
+			return zerrors.ThrowInvalidArgument(err, "DOMAIN-X5p1P", "Errors.Policy.X509.MappingRuleInvalid")
+		}
+	}
+	if p.OCSPResponderURL != "" && !validX509ResponderURL(p.OCSPResponderURL) {
+		return zerrors.ThrowInvalidArgument(nil, "DOMAIN-X5p1O", "Errors.Policy.X509.URLInvalid")
+	}
+	for _, crlURL := range p.CRLURLs {
+		if !validX509ResponderURL(crlURL) {
+			return zerrors.ThrowInvalidArgument(nil, "DOMAIN-X5p1L", "Errors.Policy.X509.URLInvalid")
+		}
+	}
+	return nil
+}
+
+func validX509ResponderURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// VerifyCertificate verifies the client certificate against the trusted certificate authorities
+// and returns the verified chain, starting with the client certificate.
+// Revocation is not checked.
+func (p *X509Policy) VerifyCertificate(cert *x509.Certificate, intermediates []*x509.Certificate, now time.Time) ([]*x509.Certificate, error) {
+	if !p.Enabled() {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "DOMAIN-X5v1E", "Errors.User.X509.NotEnabled")
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(p.TrustedCAs) {
+		return nil, zerrors.ThrowInternal(nil, "DOMAIN-X5v1C", "Errors.Policy.X509.CAInvalid")
+	}
+	intermediatePool := x509.NewCertPool()
+	for _, intermediate := range intermediates {
+		intermediatePool.AddCert(intermediate)
+	}
+	chains, err := cert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediatePool,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return nil, zerrors.ThrowPreconditionFailed(err, "DOMAIN-X5v1V", "Errors.User.X509.Untrusted")
+	}
+	return chains[0], nil
+}
+
+// Identifiers returns the identifiers of the client certificate according to the mapping rules in their order.
+func (p *X509Policy) Identifiers(cert *x509.Certificate) []X509Identifier {
+	rules := p.MappingRules
+	if len(rules) == 0 {
+		rules = DefaultX509MappingRules
+	}
+	identifiers := make([]X509Identifier, 0, len(rules))
+	for _, rule := range rules {
+		for _, value := range x509SourceValues(cert, rule.Source) {
+			if identifier, ok := rule.apply(value); ok {
+				identifiers = append(identifiers, X509Identifier{Target: rule.Target, Value: identifier})
+			}
+		}
+	}
+	return identifiers
+}
+
+func (r X509MappingRule) apply(value string) (string, bool) {
+	if value == "" {
+		return "", false
+	}
+	if r.Pattern == "" {
+		return value, true
+	}
+	pattern, err := regexp.Compile(r.Pattern)
+	if err != nil {
+		return "", false
+	}
+	match := pattern.FindStringSubmatch(value)
+	switch {
+	case match == nil:
+		return "", false
+	case len(match) > 1:
+		return match[1], match[1] != ""
+	default:
+		return match[0], match[0] != ""
+	}
+}
+
+func x509SourceValues(cert *x509.Certificate, source X509MappingSource) []string {
+	switch source {
+	case X509MappingSourceSubjectCommonName:
+		return []string{cert.Subject.CommonName}
+	case X509MappingSourceSubjectEmail:
+		return X509SubjectEmails(cert)
+	case X509MappingSourceSANEmail:
+		return cert.EmailAddresses
+	case X509MappingSourceSANUPN:
+		return X509UserPrincipalNames(cert)
+	default:
+		return nil
+	}
+}
+
+// ParseX509Certificates parses PEM or DER encoded certificates.
+func ParseX509Certificates(data []byte) ([]*x509.Certificate, error) {
+	var der []byte
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type == "CERTIFICATE" {
+			der = append(der, block.Bytes...)
+		}
+	}
+	if der == nil {
+		der = data
+	}
+	certs, err := x509.ParseCertificates(der)
+	if err != nil || len(certs) == 0 {
+		return nil, zerrors.ThrowInvalidArgument(err, "DOMAIN-X5c1P", "Errors.User.X509.Invalid")
+	}
+	return certs, nil
+}
@@ -0,0 +1,253 @@
+package domain
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type testX509CA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestX509CA(t *testing.T) *testX509CA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testX509CA{cert: cert, key: key}
+}
+
+func (ca *testX509CA) pem() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw})
+}
+
+func (ca *testX509CA) issue(t *testing.T, template *x509.Certificate) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template.SerialNumber = big.NewInt(2)
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	if template.ExtKeyUsage == nil {
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, key.Public(), ca.key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func testUPNExtension(t *testing.T, upn string) pkix.Extension {
+	value, err := asn1.MarshalWithParams(upn, "utf8")
+	require.NoError(t, err)
+	typeID, err := asn1.Marshal(oidUserPrincipalName)
+	require.NoError(t, err)
+	explicitValue, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: value})
+	require.NoError(t, err)
+	san, err := asn1.Marshal([]asn1.RawValue{
+		{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: append(typeID, explicitValue...)},
+		{Class: asn1.ClassContextSpecific, Tag: 1, Bytes: []byte("john@mail.example.com")},
+	})
+	require.NoError(t, err)
+	return pkix.Extension{Id: oidExtensionSubjectAltName, Value: san}
+}
+
+func TestX509Policy_Validate(t *testing.T) {
+	ca := newTestX509CA(t)
+	tests := []struct {
+		name    string
+		policy  *X509Policy
+		wantErr error
+	}{
+		{
+			name:    "no CA, invalid argument error",
+			policy:  &X509Policy{},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-X5p1C", "Errors.Policy.X509.CAInvalid"),
+		},
+		{
+			name: "invalid mapping rule, invalid argument error",
+			policy: &X509Policy{
+				TrustedCAs:   ca.pem(),
+				MappingRules: []X509MappingRule{{Source: X509MappingSourceSANUPN}},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-X5p1R", "Errors.Policy.X509.MappingRuleInvalid"),
+		},
+		{
+			name: "invalid pattern, invalid argument error",
+			policy: &X509Policy{
+				TrustedCAs:   ca.pem(),
+				MappingRules: []X509MappingRule{{Source: X509MappingSourceSANUPN, Target: X509MappingTargetLoginName, Pattern: "("}},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-X5p1P", "Errors.Policy.X509.MappingRuleInvalid"),
+		},
+		{
+			name: "invalid OCSP responder, invalid argument error",
+			policy: &X509Policy{
+				TrustedCAs:       ca.pem(),
+				OCSPResponderURL: "ldap://ocsp.example.com",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-X5p1O", "Errors.Policy.X509.URLInvalid"),
+		},
+		{
+			name: "invalid CRL, invalid argument error",
+			policy: &X509Policy{
+				TrustedCAs: ca.pem(),
+				CRLURLs:    []string{"crl.example.com"},
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-X5p1L", "Errors.Policy.X509.URLInvalid"),
+		},
+		{
+			name: "valid",
+			policy: &X509Policy{
+				TrustedCAs:       ca.pem(),
+				MappingRules:     []X509MappingRule{{Source: X509MappingSourceSubjectCommonName, Target: X509MappingTargetLoginName, Pattern: `^(\d+)$`}},
+				OCSPResponderURL: "http://ocsp.example.com",
+				CRLURLs:          []string{"https://crl.example.com/ca.crl"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestX509Policy_VerifyCertificate(t *testing.T) {
+	ca := newTestX509CA(t)
+	otherCA := newTestX509CA(t)
+	policy := &X509Policy{TrustedCAs: ca.pem()}
+
+	t.Run("not enabled, precondition error", func(t *testing.T) {
+		_, err := (&X509Policy{}).VerifyCertificate(ca.issue(t, &x509.Certificate{}), nil, time.Now())
+		assert.ErrorIs(t, err, zerrors.ThrowPreconditionFailed(nil, "DOMAIN-X5v1E", "Errors.User.X509.NotEnabled"))
+	})
+	t.Run("untrusted CA, precondition error", func(t *testing.T) {
+		_, err := policy.VerifyCertificate(otherCA.issue(t, &x509.Certificate{}), nil, time.Now())
+		assert.ErrorIs(t, err, zerrors.ThrowPreconditionFailed(nil, "DOMAIN-X5v1V", "Errors.User.X509.Untrusted"))
+	})
+	t.Run("no client authentication usage, precondition error", func(t *testing.T) {
+		cert := ca.issue(t, &x509.Certificate{ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}})
+		_, err := policy.VerifyCertificate(cert, nil, time.Now())
+		assert.ErrorIs(t, err, zerrors.ThrowPreconditionFailed(nil, "DOMAIN-X5v1V", "Errors.User.X509.Untrusted"))
+	})
+	t.Run("expired, precondition error", func(t *testing.T) {
+		_, err := policy.VerifyCertificate(ca.issue(t, &x509.Certificate{}), nil, time.Now().Add(2*time.Hour))
+		assert.ErrorIs(t, err, zerrors.ThrowPreconditionFailed(nil, "DOMAIN-X5v1V", "Errors.User.X509.Untrusted"))
+	})
+	t.Run("trusted, ok", func(t *testing.T) {
+		cert := ca.issue(t, &x509.Certificate{})
+		chain, err := policy.VerifyCertificate(cert, nil, time.Now())
+		require.NoError(t, err)
+		require.Len(t, chain, 2)
+		assert.Equal(t, cert, chain[0])
+		assert.Equal(t, ca.cert.Raw, chain[1].Raw)
+	})
+}
+
+func TestX509Policy_Identifiers(t *testing.T) {
+	ca := newTestX509CA(t)
+	cert := ca.issue(t, &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName: "DOE.JOHN.1234567890",
+			ExtraNames: []pkix.AttributeTypeAndValue{{Type: oidEmailAddress, Value: "john@subject.example.com"}},
+		},
+		ExtraExtensions: []pkix.Extension{testUPNExtension(t, "1234567890@mil")},
+	})
+	tests := []struct {
+		name  string
+		rules []X509MappingRule
+		want  []X509Identifier
+	}{
+		{
+			name: "default rules",
+			want: []X509Identifier{
+				{Target: X509MappingTargetLoginName, Value: "1234567890@mil"},
+				{Target: X509MappingTargetEmail, Value: "john@mail.example.com"},
+			},
+		},
+		{
+			name: "subject",
+			rules: []X509MappingRule{
+				{Source: X509MappingSourceSubjectEmail, Target: X509MappingTargetEmail},
+				{Source: X509MappingSourceSubjectCommonName, Target: X509MappingTargetLoginName},
+			},
+			want: []X509Identifier{
+				{Target: X509MappingTargetEmail, Value: "john@subject.example.com"},
+				{Target: X509MappingTargetLoginName, Value: "DOE.JOHN.1234567890"},
+			},
+		},
+		{
+			name: "patterns",
+			rules: []X509MappingRule{
+				{Source: X509MappingSourceSubjectCommonName, Target: X509MappingTargetLoginName, Pattern: `\.(\d{10})$`},
+				{Source: X509MappingSourceSANUPN, Target: X509MappingTargetLoginName, Pattern: `^\d+`},
+				{Source: X509MappingSourceSANEmail, Target: X509MappingTargetEmail, Pattern: `@example\.com$`},
+			},
+			want: []X509Identifier{
+				{Target: X509MappingTargetLoginName, Value: "1234567890"},
+				{Target: X509MappingTargetLoginName, Value: "1234567890"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &X509Policy{MappingRules: tt.rules}
+			assert.Equal(t, tt.want, policy.Identifiers(cert))
+		})
+	}
+}
+
+func TestParseX509Certificates(t *testing.T) {
+	ca := newTestX509CA(t)
+	cert := ca.issue(t, &x509.Certificate{})
+
+	t.Run("invalid, invalid argument error", func(t *testing.T) {
+		_, err := ParseX509Certificates([]byte("certificate"))
+		assert.ErrorIs(t, err, zerrors.ThrowInvalidArgument(nil, "DOMAIN-X5c1P", "Errors.User.X509.Invalid"))
+	})
+	t.Run("der", func(t *testing.T) {
+		got, err := ParseX509Certificates(append(cert.Raw, ca.cert.Raw...))
+		require.NoError(t, err)
+		assert.Equal(t, []*x509.Certificate{cert, ca.cert}, got)
+	})
+	t.Run("pem", func(t *testing.T) {
+		got, err := ParseX509Certificates(append(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}), ca.pem()...))
+		require.NoError(t, err)
+		assert.Equal(t, []*x509.Certificate{cert, ca.cert}, got)
+	})
+}
+
+func TestX509IdentifierMatches(t *testing.T) {
+	loginNames := []string{"john", "john@example.com"}
+	assert.True(t, X509IdentifierMatches(X509Identifier{Target: X509MappingTargetLoginName, Value: "John@Example.com"}, loginNames, ""))
+	assert.False(t, X509IdentifierMatches(X509Identifier{Target: X509MappingTargetLoginName, Value: "jane"}, loginNames, ""))
+	assert.True(t, X509IdentifierMatches(X509Identifier{Target: X509MappingTargetEmail, Value: "john@mail.com"}, loginNames, "John@mail.com"))
+	assert.False(t, X509IdentifierMatches(X509Identifier{Target: X509MappingTargetEmail, Value: "john@mail.com"}, loginNames, ""))
+}
@@ -11,6 +11,8 @@ const (
 	OrgRolePrefix            = "ORG"
 	ProjectRolePrefix        = "PROJECT"
 	ProjectGrantRolePrefix   = "PROJECT_GRANT"
+	ApplicationRolePrefix    = "PROJECT_APPLICATION"
+	ProjectRoleRolePrefix    = "PROJECT_ROLE"
 	RoleOrgOwner             = "ORG_OWNER"
 	RoleOrgProjectCreator    = "ORG_PROJECT_CREATOR"
 	RoleIAMOwner             = "IAM_OWNER"
@@ -21,6 +23,12 @@ const (
 	RoleSelfManagementGlobal = "SELF_MANAGEMENT_GLOBAL"
 )
 
+// ProjectRoleContextID is the context of the permissions granted to administrators of a single project role.
+// Permissions are only granted on roles without colon, as the colon separates the context from the permission.
+func ProjectRoleContextID(projectID, roleKey string) string {
+	return projectID + "/" + roleKey
+}
+
 func CheckForInvalidRoles(roles []string, rolePrefix string, validRoles []authz.RoleMapping) []string {
 	invalidRoles := make([]string, 0)
 	for _, role := range roles {
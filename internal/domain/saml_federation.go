@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"regexp"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type SAMLFederationState int32
+
+const (
+	SAMLFederationStateUnspecified SAMLFederationState = iota
+	SAMLFederationStateActive
+	SAMLFederationStateRemoved
+)
+
+func (s SAMLFederationState) Exists() bool {
+	return s == SAMLFederationStateActive
+}
+
+// SAMLFederationEntity is an identity provider found in the metadata aggregate of a federation (e.g. eduGAIN or InCommon).
+type SAMLFederationEntity struct {
+	EntityID    string
+	DisplayName string
+	// RegistrationAuthority is the federation which registered the entity (mdrpi:RegistrationInfo)
+	RegistrationAuthority string
+	// EntityCategories are the entity categories (e.g. REFEDS R&S) the entity is member of
+	EntityCategories []string
+	// Metadata is the EntityDescriptor of the entity
+	Metadata []byte
+}
+
+// SAMLFederationFilter selects the entities of the metadata aggregate, for which an identity provider is created.
+// All set conditions must match, an empty filter selects all identity providers.
+type SAMLFederationFilter struct {
+	EntityIDs []string `json:"entityIds,omitempty"`
+	// EntityIDPattern is a regular expression, which must match the entity id
+	EntityIDPattern         string   `json:"entityIdPattern,omitempty"`
+	RegistrationAuthorities []string `json:"registrationAuthorities,omitempty"`
+	// EntityCategories selects the entities being member of any of the categories
+	EntityCategories []string `json:"entityCategories,omitempty"`
+}
+
+func (f *SAMLFederationFilter) Validate() error {
+	if f == nil || f.EntityIDPattern == "" {
+		return nil
+	}
+	if _, err := regexp.Compile(f.EntityIDPattern); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "DOMAIN-Sf1fP", "Errors.IDP.SAMLFederation.InvalidFilter")
+	}
+	return nil
+}
+
+// Matches returns true if the entity fulfills all conditions of the filter.
+// The filter must be validated before.
+func (f *SAMLFederationFilter) Matches(entity *SAMLFederationEntity) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.EntityIDs) > 0 && !slices.Contains(f.EntityIDs, entity.EntityID) {
+		return false
+	}
+	if f.EntityIDPattern != "" && !regexp.MustCompile(f.EntityIDPattern).MatchString(entity.EntityID) {
+		return false
+	}
+	if len(f.RegistrationAuthorities) > 0 && !slices.Contains(f.RegistrationAuthorities, entity.RegistrationAuthority) {
+		return false
+	}
+	if len(f.EntityCategories) > 0 && !slices.ContainsFunc(entity.EntityCategories, func(category string) bool {
+		return slices.Contains(f.EntityCategories, category)
+	}) {
+		return false
+	}
+	return true
+}
+
+// SAMLFederationImportSummary is the result of an import of the metadata aggregate.
+type SAMLFederationImportSummary struct {
+	// Entities is the number of identity providers in the metadata aggregate matching the filter
+	Entities uint32 `json:"entities,omitempty"`
+	Created  uint32 `json:"created,omitempty"`
+	Updated  uint32 `json:"updated,omitempty"`
+	Removed  uint32 `json:"removed,omitempty"`
+	Failed   uint32 `json:"failed,omitempty"`
+	// LastError is the error of the last entity which failed to import
+	LastError string `json:"lastError,omitempty"`
+}
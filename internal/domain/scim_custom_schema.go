@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ScimCustomSchemaMetadataKeyPrefix prefixes the organization metadata keys holding the definitions of custom scim schema extensions,
+// the id of the schema is appended to the prefix.
+const ScimCustomSchemaMetadataKeyPrefix = "urn:zitadel:scim:schemas:"
+
+// scimBuiltInSchemaPrefixes are the ids of the schemas provided by ZITADEL itself,
+// they can't be redefined by custom schemas.
+var scimBuiltInSchemaPrefixes = []string{
+	"urn:ietf:params:scim:schemas:core:2.0:",
+	"urn:ietf:params:scim:api:messages:2.0:",
+	"urn:ietf:params:scim:api:zitadel:messages:2.0:",
+}
+
+const scimEnterpriseUserSchemaID = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+
+// scimAttrNamePattern the attribute name according to the scim ABNF
+var scimAttrNamePattern = regexp.MustCompile(`^[a-zA-Z][\w-]*$`)
+
+// ScimCustomSchemaDefinition the definition of an admin defined scim schema extension.
+// Custom schemas are stored as organization metadata
+// and only support single valued attributes of simple types.
+type ScimCustomSchemaDefinition struct {
+	Name        string                                 `json:"name"`
+	Description string                                 `json:"description,omitempty"`
+	Attributes  []*ScimCustomSchemaAttributeDefinition `json:"attributes"`
+}
+
+type ScimCustomSchemaAttributeDefinition struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type"`
+	Required    bool   `json:"required,omitempty"`
+	CaseExact   bool   `json:"caseExact,omitempty"`
+}
+
+// IsScimBuiltInSchema returns true if the id matches a schema provided by ZITADEL itself.
+func IsScimBuiltInSchema(id string) bool {
+	lowerID := strings.ToLower(id)
+	for _, prefix := range scimBuiltInSchemaPrefixes {
+		if strings.HasPrefix(lowerID, prefix) {
+			return true
+		}
+	}
+	return strings.EqualFold(id, scimEnterpriseUserSchemaID)
+}
+
+// ValidateScimCustomSchemaMetadata validates the definition of a custom scim schema extension before it is stored as organization metadata.
+// Metadata with other keys is not validated.
+func ValidateScimCustomSchemaMetadata(key string, value []byte) error {
+	if !strings.HasPrefix(key, ScimCustomSchemaMetadataKeyPrefix) {
+		return nil
+	}
+	_, err := ParseScimCustomSchemaDefinition(strings.TrimPrefix(key, ScimCustomSchemaMetadataKeyPrefix), value)
+	return err
+}
+
+// ParseScimCustomSchemaDefinition parses and validates the json encoded definition of a custom scim schema extension.
+func ParseScimCustomSchemaDefinition(id string, data []byte) (*ScimCustomSchemaDefinition, error) {
+	if !strings.HasPrefix(strings.ToLower(id), "urn:") || IsScimBuiltInSchema(id) {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "SCIM-CSid1", "Invalid custom schema id %s", id)
+	}
+
+	definition := new(ScimCustomSchemaDefinition)
+	if err := json.Unmarshal(data, definition); err != nil {
+		return nil, zerrors.ThrowInvalidArgumentf(err, "SCIM-CSjs1", "Invalid custom schema definition %s", id)
+	}
+
+	if definition.Name == "" || len(definition.Attributes) == 0 {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "SCIM-CSnm1", "Custom schema %s requires a name and at least one attribute", id)
+	}
+
+	attributeNames := make(map[string]bool, len(definition.Attributes))
+	for _, attribute := range definition.Attributes {
+		if !scimAttrNamePattern.MatchString(attribute.Name) || attributeNames[strings.ToLower(attribute.Name)] {
+			return nil, zerrors.ThrowInvalidArgumentf(nil, "SCIM-CSat1", "Invalid or duplicate attribute name %s in custom schema %s", attribute.Name, id)
+		}
+		attributeNames[strings.ToLower(attribute.Name)] = true
+
+		switch attribute.Type {
+		case "string", "boolean", "integer", "decimal":
+		default:
+			return nil, zerrors.ThrowInvalidArgumentf(nil, "SCIM-CSat2", "Unsupported type %s of attribute %s in custom schema %s", attribute.Type, attribute.Name, id)
+		}
+	}
+	return definition, nil
+}
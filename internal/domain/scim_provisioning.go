@@ -0,0 +1,47 @@
+package domain
+
+type SCIMProvisioningAuthType int32
+
+const (
+	SCIMProvisioningAuthTypeUnspecified SCIMProvisioningAuthType = iota
+	// SCIMProvisioningAuthTypeBearer authenticates with a static bearer token
+	SCIMProvisioningAuthTypeBearer
+	// SCIMProvisioningAuthTypeClientCredentials authenticates with an access token
+	// requested from the token endpoint of the downstream application using the OAuth client credentials grant
+	SCIMProvisioningAuthTypeClientCredentials
+	scimProvisioningAuthTypeCount
+)
+
+func (t SCIMProvisioningAuthType) Valid() bool {
+	return t > SCIMProvisioningAuthTypeUnspecified && t < scimProvisioningAuthTypeCount
+}
+
+type SCIMProvisioningConnectorState int32
+
+const (
+	SCIMProvisioningConnectorStateUnspecified SCIMProvisioningConnectorState = iota
+	SCIMProvisioningConnectorStateActive
+	SCIMProvisioningConnectorStateRemoved
+)
+
+func (s SCIMProvisioningConnectorState) Exists() bool {
+	return s == SCIMProvisioningConnectorStateActive
+}
+
+// SCIMProvisioningResourceType is the type of resource provisioned into a downstream application.
+type SCIMProvisioningResourceType string
+
+const (
+	SCIMProvisioningResourceTypeUser  SCIMProvisioningResourceType = "user"
+	SCIMProvisioningResourceTypeGroup SCIMProvisioningResourceType = "group"
+)
+
+type SCIMProvisioningResourceState int32
+
+const (
+	SCIMProvisioningResourceStateUnspecified SCIMProvisioningResourceState = iota
+	// SCIMProvisioningResourceStateProvisioned the resource was successfully created or updated in the downstream application
+	SCIMProvisioningResourceStateProvisioned
+	// SCIMProvisioningResourceStateFailed the last provisioning attempt of the resource failed
+	SCIMProvisioningResourceStateFailed
+)
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"slices"
+)
+
+// RiskAction is the consequence of the risk evaluated for a session check.
+// The actions are ordered by their severity.
+type RiskAction int32
+
+const (
+	RiskActionNone RiskAction = iota
+	// RiskActionNotify only records the risk, so it can be forwarded to Actions v2 targets and the security log.
+	RiskActionNotify
+	// RiskActionRequireMFA prevents the session from being used for OIDC and SAML logins until a second factor is checked.
+	RiskActionRequireMFA
+	// RiskActionBlock rejects the session check.
+	RiskActionBlock
+)
+
+func (a RiskAction) String() string {
+	switch a {
+	case RiskActionNotify:
+		return "notify"
+	case RiskActionRequireMFA:
+		return "require_mfa"
+	case RiskActionBlock:
+		return "block"
+	case RiskActionNone:
+		fallthrough
+	default:
+		return "none"
+	}
+}
+
+// RiskSignal is an anomaly detected during a session check, which raises the risk score.
+type RiskSignal string
+
+const (
+	// RiskSignalFailedAttempts is raised if the user had multiple failed checks since the last successful one.
+	RiskSignalFailedAttempts RiskSignal = "failed_attempts"
+	// RiskSignalCredentialStuffing is raised if checks of multiple users failed from the same IP in a short time.
+	RiskSignalCredentialStuffing RiskSignal = "credential_stuffing"
+	// RiskSignalImpossibleTravel is raised if the distance to the location of the previous login can't be travelled in the elapsed time.
+	RiskSignalImpossibleTravel RiskSignal = "impossible_travel"
+	// RiskSignalNewCountry is raised if the user never logged in from the country before.
+	RiskSignalNewCountry RiskSignal = "new_country"
+	// RiskSignalNewUserAgent is raised if the user never logged in with the user agent before.
+	RiskSignalNewUserAgent RiskSignal = "new_user_agent"
+)
+
+// SessionRisk is the risk evaluated for the checks of a session.
+type SessionRisk struct {
+	// Score is between 0 (no risk) and 100.
+	Score   uint32
+	Signals []RiskSignal
+	Action  RiskAction
+}
+
+// Merge returns the higher risk of both, the signals are combined.
+// The risk of a session never decreases, so a successful check can't lift a required MFA.
+func (r SessionRisk) Merge(other SessionRisk) SessionRisk {
+	merged := SessionRisk{
+		Score:   max(r.Score, other.Score),
+		Signals: slices.Clone(r.Signals),
+		Action:  max(r.Action, other.Action),
+	}
+	for _, signal := range other.Signals {
+		if !slices.Contains(merged.Signals, signal) {
+			merged.Signals = append(merged.Signals, signal)
+		}
+	}
+	return merged
+}
+
+func (r SessionRisk) Equal(other SessionRisk) bool {
+	return r.Score == other.Score && r.Action == other.Action && slices.Equal(r.Signals, other.Signals)
+}
+
+// GeoLocation is the location of an IP address resolved by the GeoIP database.
+type GeoLocation struct {
+	Country   string  `json:"country"`
+	City      string  `json:"city,omitempty"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+func (l *GeoLocation) IsEmpty() bool {
+	return l == nil || l.Country == ""
+}
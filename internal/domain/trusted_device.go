@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"time"
+)
+
+// TrustedDevice is a device (browser), on which the user doesn't need to check a second factor until it expires.
+type TrustedDevice struct {
+	ID            string
+	UserID        string
+	ResourceOwner string
+	// FingerprintID is the ID of the user agent the device is bound to.
+	FingerprintID string
+	// Name describes the device, e.g. by the user agent header.
+	Name string
+	// MFAType is the second factor, which was checked when the device was trusted.
+	MFAType        MFAType
+	CreationDate   time.Time
+	ExpirationDate time.Time
+	LastUsed       time.Time
+}
+
+// ValidUntil returns the expiration of the device, which is shortened if the lifetime of the policy
+// was reduced after the device was trusted.
+func (d *TrustedDevice) ValidUntil(policy *DeviceTrustPolicy) time.Time {
+	if !policy.Enabled() {
+		return time.Time{}
+	}
+	if validUntil := d.CreationDate.Add(policy.Lifetime); validUntil.Before(d.ExpirationDate) {
+		return validUntil
+	}
+	return d.ExpirationDate
+}
+
+// IsValid returns true if the device is bound to the user agent and not expired.
+func (d *TrustedDevice) IsValid(fingerprintID string, policy *DeviceTrustPolicy, now time.Time) bool {
+	return d.FingerprintID != "" &&
+		d.FingerprintID == fingerprintID &&
+		now.Before(d.ValidUntil(policy))
+}
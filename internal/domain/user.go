@@ -46,6 +46,8 @@ const (
 	UserAuthMethodTypePrivateKey
 	userAuthMethodTypeCount
 	UserAuthMethodTypeRecoveryCode
+	UserAuthMethodTypePush
+	UserAuthMethodTypeX509Certificate
 )
 
 // HasMFA checks whether the user authenticated with multiple auth factors.
@@ -55,7 +57,8 @@ func HasMFA(methods []UserAuthMethodType) bool {
 	var factors int
 	for _, method := range methods {
 		switch method {
-		case UserAuthMethodTypePasswordless:
+		case UserAuthMethodTypePasswordless,
+			UserAuthMethodTypeX509Certificate:
 			return true
 		case UserAuthMethodTypePassword,
 			UserAuthMethodTypeU2F,
@@ -65,6 +68,7 @@ func HasMFA(methods []UserAuthMethodType) bool {
 			UserAuthMethodTypeIDP,
 			UserAuthMethodTypeOTP,
 			UserAuthMethodTypeRecoveryCode,
+			UserAuthMethodTypePush,
 			UserAuthMethodTypePrivateKey:
 			factors++
 		case UserAuthMethodTypeUnspecified,
@@ -86,6 +90,7 @@ func Has2FA(methods []UserAuthMethodType) bool {
 			UserAuthMethodTypeOTPSMS,
 			UserAuthMethodTypeOTPEmail,
 			UserAuthMethodTypeRecoveryCode,
+			UserAuthMethodTypePush,
 			UserAuthMethodTypeOTP:
 			factors++
 		case UserAuthMethodTypeUnspecified,
@@ -93,6 +98,7 @@ func Has2FA(methods []UserAuthMethodType) bool {
 			UserAuthMethodTypePasswordless,
 			UserAuthMethodTypeIDP,
 			UserAuthMethodTypePrivateKey,
+			UserAuthMethodTypeX509Certificate,
 			userAuthMethodTypeCount:
 			// ignore
 		}
@@ -123,6 +129,8 @@ func AuthMethodToSecondFactor(method UserAuthMethodType) SecondFactorType {
 		return SecondFactorTypeOTPEmail
 	case UserAuthMethodTypeOTP:
 		return SecondFactorTypeOTPSMS
+	case UserAuthMethodTypePush:
+		return SecondFactorTypePush
 	default:
 		// First-factor methods: password, IDP, passwordless, private key
 		return 0
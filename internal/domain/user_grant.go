@@ -1,6 +1,10 @@
 package domain
 
-import es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+import (
+	"time"
+
+	es_models "github.com/zitadel/zitadel/internal/eventstore/v1/models"
+)
 
 type UserGrant struct {
 	es_models.ObjectRoot
@@ -10,6 +14,12 @@ type UserGrant struct {
 	ProjectID      string
 	ProjectGrantID string
 	RoleKeys       []string
+	// ValidFrom defers the activation of the grant, the grant is inactive until then.
+	ValidFrom *time.Time
+	// ValidUntil limits the grant in time, the grant is removed after it expired.
+	ValidUntil *time.Time
+	// ClearValidUntil makes a time-bound grant permanent on change.
+	ClearValidUntil bool
 }
 
 type UserGrantState int32
@@ -22,7 +32,12 @@ const (
 )
 
 func (u *UserGrant) IsValid() bool {
-	return u.ProjectID != "" && u.UserID != ""
+	return u.ProjectID != "" && u.UserID != "" && IsValidPeriod(u.ValidFrom, u.ValidUntil)
+}
+
+// IsScheduled returns true if the grant is only activated in the future.
+func (u *UserGrant) IsScheduled(now time.Time) bool {
+	return u.ValidFrom != nil && u.ValidFrom.After(now)
 }
 
 func (g *UserGrant) HasInvalidRoles(validRoles []string) bool {
@@ -0,0 +1,329 @@
+// Package ldaptest provides an in-memory LDAP server for tests.
+// It supports simple binds and paged searches with the filters used by the LDAP provider.
+package ldaptest
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+)
+
+const (
+	applicationBindRequest      ber.Tag = 0
+	applicationBindResponse     ber.Tag = 1
+	applicationUnbindRequest    ber.Tag = 2
+	applicationSearchRequest    ber.Tag = 3
+	applicationSearchResult     ber.Tag = 4
+	applicationSearchResultDone ber.Tag = 5
+
+	filterAnd      ber.Tag = 0
+	filterOr       ber.Tag = 1
+	filterNot      ber.Tag = 2
+	filterEquality ber.Tag = 3
+	filterGreater  ber.Tag = 5
+	filterLess     ber.Tag = 6
+	filterPresent  ber.Tag = 7
+)
+
+// Entry is an entry of the directory.
+type Entry struct {
+	DN         string
+	Attributes map[string][]string
+}
+
+func (e *Entry) values(attribute string) []string {
+	for name, values := range e.Attributes {
+		if strings.EqualFold(name, attribute) {
+			return values
+		}
+	}
+	return nil
+}
+
+// Server is an in-memory LDAP server listening on a random local port.
+type Server struct {
+	listener     net.Listener
+	bindDN       string
+	bindPassword string
+
+	mu      sync.Mutex
+	entries []*Entry
+	// Searches are the filters of the received search requests.
+	searches []string
+}
+
+// NewServer starts a server accepting binds with the given credentials.
+// The server is stopped when the test finishes.
+func NewServer(t testing.TB, bindDN, bindPassword string, entries ...*Entry) *Server {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &Server{
+		listener:     listener,
+		bindDN:       bindDN,
+		bindPassword: bindPassword,
+		entries:      entries,
+	}
+	go s.serve()
+	t.Cleanup(func() { _ = listener.Close() })
+	return s
+}
+
+// URL returns the address of the server usable as server of the LDAP provider.
+func (s *Server) URL() string {
+	return "ldap://" + s.listener.Addr().String()
+}
+
+// SetEntries replaces the entries of the directory.
+func (s *Server) SetEntries(entries ...*Entry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = entries
+}
+
+// Searches returns the filters of the search requests received so far.
+func (s *Server) Searches() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.searches...)
+}
+
+func (s *Server) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *Server) handle(conn net.Conn) {
+	defer conn.Close()
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		messageID, ok := packet.Children[0].Value.(int64)
+		if !ok {
+			return
+		}
+		request := packet.Children[1]
+		var responses []*ber.Packet
+		switch request.Tag {
+		case applicationBindRequest:
+			responses = []*ber.Packet{s.bind(messageID, request)}
+		case applicationSearchRequest:
+			responses = s.search(messageID, request, packet)
+		case applicationUnbindRequest:
+			return
+		default:
+			return
+		}
+		for _, response := range responses {
+			if _, err := conn.Write(response.Bytes()); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *Server) bind(messageID int64, request *ber.Packet) *ber.Packet {
+	resultCode := ldap.LDAPResultSuccess
+	if len(request.Children) < 3 ||
+		packetString(request.Children[1]) != s.bindDN ||
+		packetString(request.Children[2]) != s.bindPassword {
+		resultCode = ldap.LDAPResultInvalidCredentials
+	}
+	return message(messageID, result(applicationBindResponse, resultCode), nil)
+}
+
+func (s *Server) search(messageID int64, request, packet *ber.Packet) []*ber.Packet {
+	if len(request.Children) < 8 {
+		return []*ber.Packet{message(messageID, result(applicationSearchResultDone, ldap.LDAPResultProtocolError), nil)}
+	}
+	baseDN := strings.ToLower(packetString(request.Children[0]))
+	filter := request.Children[6]
+	attributes := make([]string, 0, len(request.Children[7].Children))
+	for _, attribute := range request.Children[7].Children {
+		attributes = append(attributes, packetString(attribute))
+	}
+	filterString, err := ldap.DecompileFilter(filter)
+	if err != nil {
+		return []*ber.Packet{message(messageID, result(applicationSearchResultDone, ldap.LDAPResultProtocolError), nil)}
+	}
+
+	s.mu.Lock()
+	s.searches = append(s.searches, filterString)
+	matches := make([]*Entry, 0, len(s.entries))
+	for _, entry := range s.entries {
+		if strings.HasSuffix(strings.ToLower(entry.DN), baseDN) && matchFilter(entry, filter) {
+			matches = append(matches, entry)
+		}
+	}
+	s.mu.Unlock()
+
+	var responseControls *ber.Packet
+	if paging := pagingControl(packet); paging != nil {
+		offset := 0
+		if len(paging.Cookie) > 0 {
+			offset, _ = strconv.Atoi(string(paging.Cookie))
+		}
+		offset = min(offset, len(matches))
+		end := len(matches)
+		if paging.PagingSize > 0 {
+			end = min(offset+int(paging.PagingSize), len(matches))
+		}
+		total := len(matches)
+		matches = matches[offset:end]
+		next := ldap.NewControlPaging(0)
+		if end < total {
+			next.SetCookie([]byte(strconv.Itoa(end)))
+		}
+		responseControls = ber.Encode(ber.ClassContext, ber.TypeConstructed, 0, nil, "Controls")
+		responseControls.AppendChild(next.Encode())
+	}
+
+	responses := make([]*ber.Packet, 0, len(matches)+1)
+	for _, entry := range matches {
+		responses = append(responses, message(messageID, searchEntry(entry, attributes), nil))
+	}
+	return append(responses, message(messageID, result(applicationSearchResultDone, ldap.LDAPResultSuccess), responseControls))
+}
+
+func pagingControl(packet *ber.Packet) *ldap.ControlPaging {
+	if len(packet.Children) < 3 {
+		return nil
+	}
+	for _, child := range packet.Children[2].Children {
+		control, err := ldap.DecodeControl(child)
+		if err != nil {
+			continue
+		}
+		if paging, ok := control.(*ldap.ControlPaging); ok {
+			return paging
+		}
+	}
+	return nil
+}
+
+func matchFilter(entry *Entry, filter *ber.Packet) bool {
+	switch filter.Tag {
+	case filterAnd:
+		for _, child := range filter.Children {
+			if !matchFilter(entry, child) {
+				return false
+			}
+		}
+		return true
+	case filterOr:
+		for _, child := range filter.Children {
+			if matchFilter(entry, child) {
+				return true
+			}
+		}
+		return false
+	case filterNot:
+		return len(filter.Children) == 1 && !matchFilter(entry, filter.Children[0])
+	case filterEquality, filterGreater, filterLess:
+		if len(filter.Children) != 2 {
+			return false
+		}
+		expected := packetString(filter.Children[1])
+		for _, value := range entry.values(packetString(filter.Children[0])) {
+			if compare(filter.Tag, value, expected) {
+				return true
+			}
+		}
+		return false
+	case filterPresent:
+		return len(entry.values(packetString(filter))) > 0
+	}
+	return false
+}
+
+// compare compares numbers numerically and everything else case-insensitive,
+// which is sufficient for USNs and generalized times.
+func compare(tag ber.Tag, value, expected string) bool {
+	cmp := strings.Compare(strings.ToLower(value), strings.ToLower(expected))
+	if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if e, err := strconv.ParseInt(expected, 10, 64); err == nil {
+			cmp = compareInt(v, e)
+		}
+	}
+	switch tag {
+	case filterGreater:
+		return cmp >= 0
+	case filterLess:
+		return cmp <= 0
+	default:
+		return cmp == 0
+	}
+}
+
+func compareInt(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func searchEntry(entry *Entry, attributes []string) *ber.Packet {
+	response := ber.Encode(ber.ClassApplication, ber.TypeConstructed, applicationSearchResult, nil, "Search Result Entry")
+	response.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.DN, "DN"))
+	attributeList := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attributes")
+	for _, name := range attributes {
+		values := entry.values(name)
+		if len(values) == 0 {
+			continue
+		}
+		attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "Attribute")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, value := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "Value"))
+		}
+		attribute.AppendChild(set)
+		attributeList.AppendChild(attribute)
+	}
+	response.AppendChild(attributeList)
+	return response
+}
+
+func result(tag ber.Tag, resultCode int) *ber.Packet {
+	response := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	response.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(resultCode), "Result Code"))
+	response.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	response.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	return response
+}
+
+func message(messageID int64, operation, controls *ber.Packet) *ber.Packet {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "Message ID"))
+	packet.AppendChild(operation)
+	if controls != nil {
+		packet.AppendChild(controls)
+	}
+	return packet
+}
+
+func packetString(packet *ber.Packet) string {
+	if value, ok := packet.Value.(string); ok {
+		return value
+	}
+	if packet.Data != nil {
+		return packet.Data.String()
+	}
+	return ""
+}
@@ -0,0 +1,237 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/zitadel/logging"
+)
+
+const (
+	DefaultSyncPageSize = 500
+
+	incrementalAttributeModifyTimestamp = "modifyTimestamp"
+	incrementalAttributeUSNChanged      = "uSNChanged"
+)
+
+var ErrNoServerAvailable = errors.New("no ldap server available")
+
+// SyncRequest defines the entries read by [Provider.Sync].
+type SyncRequest struct {
+	// Filter is an additional LDAP filter the entries must match, e.g. to exclude disabled accounts.
+	Filter string
+	// PageSize is the number of entries requested per page, [DefaultSyncPageSize] if not set.
+	PageSize uint32
+	// GroupAttribute is the attribute of the entry listing the DNs of its groups, e.g. memberOf.
+	GroupAttribute string
+	// IncrementalAttribute is the operational attribute used to only read changed entries,
+	// either modifyTimestamp or uSNChanged.
+	IncrementalAttribute string
+	// Since is the watermark returned by the previous synchronization,
+	// if set only the entries changed afterward are read.
+	Since string
+}
+
+// SyncEntry is a user read from the directory.
+type SyncEntry struct {
+	DN     string
+	User   *User
+	Groups []string
+	// Err is set if the entry could not be mapped to a user, User is nil then.
+	Err error
+}
+
+// Sync pages through the users below the base DN, which have the configured object classes and match the filter of the request.
+// Every page is passed to reduce, a failing reduce stops the synchronization.
+// Entries which could not be mapped are passed with their error, so the caller knows the result is incomplete.
+// The returned watermark is the highest value of the incremental attribute read,
+// it's passed as [SyncRequest.Since] to the next synchronization.
+func (p *Provider) Sync(ctx context.Context, request *SyncRequest, reduce func(entries []*SyncEntry) error) (watermark string, err error) {
+	conn, err := p.syncConnection()
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultSyncPageSize
+	}
+	paging := ldap.NewControlPaging(pageSize)
+	searchRequest := ldap.NewSearchRequest(
+		p.baseDN,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, int(p.timeout.Seconds()), false,
+		syncSearchQuery(p.userObjectClasses, request),
+		p.syncAttributes(request),
+		[]ldap.Control{paging},
+	)
+
+	watermark = request.Since
+	for {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		result, err := conn.Search(searchRequest)
+		if err != nil {
+			return "", err
+		}
+		entries := make([]*SyncEntry, 0, len(result.Entries))
+		for _, entry := range result.Entries {
+			syncEntry, err := p.mapSyncEntry(entry, request.GroupAttribute)
+			if err != nil {
+				logging.WithFields("dn", entry.DN).WithError(err).Info("ldap: unable to map entry")
+				entries = append(entries, &SyncEntry{DN: entry.DN, Err: err})
+				continue
+			}
+			entries = append(entries, syncEntry)
+			watermark = maxWatermark(request.IncrementalAttribute, watermark, entry.GetAttributeValue(request.IncrementalAttribute))
+		}
+		if err := reduce(entries); err != nil {
+			return "", err
+		}
+
+		control, ok := ldap.FindControl(result.Controls, ldap.ControlTypePaging).(*ldap.ControlPaging)
+		if !ok || len(control.Cookie) == 0 {
+			return watermark, nil
+		}
+		paging.SetCookie(control.Cookie)
+	}
+}
+
+// syncConnection returns a connection to the first available server, bound with the configured bind DN.
+func (p *Provider) syncConnection() (*ldap.Conn, error) {
+	errs := make([]error, 0, len(p.servers)+1)
+	for _, server := range p.servers {
+		conn, err := getConnection(server, p.startTLS, p.timeout, p.rootCA)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := conn.Bind(p.bindDN, p.bindPassword); err != nil {
+			conn.Close()
+			errs = append(errs, err)
+			continue
+		}
+		return conn, nil
+	}
+	return nil, errors.Join(append(errs, ErrNoServerAvailable)...)
+}
+
+func (p *Provider) syncAttributes(request *SyncRequest) []string {
+	attributes := p.getNecessaryAttributes()
+	if request.GroupAttribute != "" {
+		attributes = append(attributes, request.GroupAttribute)
+	}
+	if request.IncrementalAttribute != "" {
+		attributes = append(attributes, request.IncrementalAttribute)
+	}
+	return attributes
+}
+
+func (p *Provider) mapSyncEntry(entry *ldap.Entry, groupAttribute string) (*SyncEntry, error) {
+	user, err := mapLDAPEntryToUser(
+		entry,
+		p.idAttribute,
+		p.firstNameAttribute,
+		p.lastNameAttribute,
+		p.displayNameAttribute,
+		p.nickNameAttribute,
+		p.preferredUsernameAttribute,
+		p.emailAttribute,
+		p.emailVerifiedAttribute,
+		p.phoneAttribute,
+		p.phoneVerifiedAttribute,
+		p.preferredLanguageAttribute,
+		p.avatarURLAttribute,
+		p.profileAttribute,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if user.ID == "" {
+		return nil, errors.New("id attribute missing")
+	}
+	syncEntry := &SyncEntry{
+		DN:   entry.DN,
+		User: user,
+	}
+	if groupAttribute != "" {
+		syncEntry.Groups = entry.GetAttributeValues(groupAttribute)
+	}
+	return syncEntry, nil
+}
+
+func syncSearchQuery(objectClasses []string, request *SyncRequest) string {
+	queries := make([]string, 0, len(objectClasses)+2)
+	for _, class := range objectClasses {
+		queries = append(queries, objectClassesToSearchQuery([]string{class}))
+	}
+	if filter := strings.TrimSpace(request.Filter); filter != "" {
+		if !strings.HasPrefix(filter, "(") {
+			filter = "(" + filter + ")"
+		}
+		queries = append(queries, filter)
+	}
+	if query := incrementalSearchQuery(request.IncrementalAttribute, request.Since); query != "" {
+		queries = append(queries, query)
+	}
+	if len(queries) == 0 {
+		return "(objectClass=*)"
+	}
+	return queriesAndToSearchQuery(queries...)
+}
+
+// incrementalSearchQuery returns the filter for the entries changed since the watermark.
+// The modifyTimestamp only has a precision of seconds, entries changed at the watermark are therefore read again.
+func incrementalSearchQuery(attribute, since string) string {
+	if since == "" {
+		return ""
+	}
+	switch attribute {
+	case incrementalAttributeModifyTimestamp:
+		return "(" + attribute + ">=" + ldap.EscapeFilter(since) + ")"
+	case incrementalAttributeUSNChanged:
+		usn, err := strconv.ParseUint(since, 10, 64)
+		if err != nil {
+			return ""
+		}
+		return "(" + attribute + ">=" + strconv.FormatUint(usn+1, 10) + ")"
+	}
+	return ""
+}
+
+func maxWatermark(attribute, current, value string) string {
+	if value == "" {
+		return current
+	}
+	if current == "" {
+		return value
+	}
+	switch attribute {
+	case incrementalAttributeModifyTimestamp:
+		currentTime, err := ber.ParseGeneralizedTime([]byte(current))
+		if err != nil {
+			return value
+		}
+		valueTime, err := ber.ParseGeneralizedTime([]byte(value))
+		if err != nil || !valueTime.After(currentTime) {
+			return current
+		}
+		return value
+	case incrementalAttributeUSNChanged:
+		currentUSN, err := strconv.ParseUint(current, 10, 64)
+		if err != nil {
+			return value
+		}
+		valueUSN, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || valueUSN <= currentUSN {
+			return current
+		}
+		return value
+	}
+	return current
+}
@@ -0,0 +1,299 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap/ldaptest"
+)
+
+func TestProvider_Sync(t *testing.T) {
+	entries := []*ldaptest.Entry{
+		{
+			DN: "uid=alice,ou=people,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"objectClass":     {"inetOrgPerson"},
+				"uid":             {"alice"},
+				"givenName":       {"Alice"},
+				"sn":              {"Doe"},
+				"mail":            {"alice@example.com"},
+				"memberOf":        {"cn=admins,ou=groups,dc=example,dc=com", "cn=users,ou=groups,dc=example,dc=com"},
+				"modifyTimestamp": {"20250101100000Z"},
+				"uSNChanged":      {"10"},
+			},
+		},
+		{
+			DN: "uid=bob,ou=people,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"objectClass":     {"inetOrgPerson"},
+				"uid":             {"bob"},
+				"givenName":       {"Bob"},
+				"sn":              {"Doe"},
+				"memberOf":        {"cn=users,ou=groups,dc=example,dc=com"},
+				"modifyTimestamp": {"20250102100000Z"},
+				"uSNChanged":      {"12"},
+			},
+		},
+		{
+			DN: "uid=carol,ou=people,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"objectClass":     {"inetOrgPerson"},
+				"uid":             {"carol"},
+				"givenName":       {"Carol"},
+				"sn":              {"Doe"},
+				"employeeType":    {"disabled"},
+				"modifyTimestamp": {"20250103100000Z"},
+				"uSNChanged":      {"11"},
+			},
+		},
+		{
+			DN: "cn=admins,ou=groups,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"objectClass": {"groupOfNames"},
+				"cn":          {"admins"},
+			},
+		},
+		{
+			DN: "uid=dave,ou=people,dc=other,dc=com",
+			Attributes: map[string][]string{
+				"objectClass": {"inetOrgPerson"},
+				"uid":         {"dave"},
+			},
+		},
+	}
+
+	type want struct {
+		ids       []string
+		groups    map[string][]string
+		watermark string
+		filter    string
+		pages     int
+		err       bool
+	}
+	tests := []struct {
+		name    string
+		request *SyncRequest
+		reduce  error
+		want    want
+	}{
+		{
+			name:    "full, all pages",
+			request: &SyncRequest{PageSize: 2, GroupAttribute: "memberOf"},
+			want: want{
+				ids: []string{"alice", "bob", "carol"},
+				groups: map[string][]string{
+					"alice": {"cn=admins,ou=groups,dc=example,dc=com", "cn=users,ou=groups,dc=example,dc=com"},
+					"bob":   {"cn=users,ou=groups,dc=example,dc=com"},
+				},
+				filter: "(objectClass=inetOrgPerson)",
+				pages:  2,
+			},
+		},
+		{
+			name:    "filter",
+			request: &SyncRequest{Filter: "!(employeeType=disabled)"},
+			want: want{
+				ids:    []string{"alice", "bob"},
+				filter: "(&(objectClass=inetOrgPerson)(!(employeeType=disabled)))",
+				pages:  1,
+			},
+		},
+		{
+			name:    "modify timestamp, initial",
+			request: &SyncRequest{IncrementalAttribute: "modifyTimestamp"},
+			want: want{
+				ids:       []string{"alice", "bob", "carol"},
+				watermark: "20250103100000Z",
+				filter:    "(objectClass=inetOrgPerson)",
+				pages:     1,
+			},
+		},
+		{
+			name:    "modify timestamp, since",
+			request: &SyncRequest{IncrementalAttribute: "modifyTimestamp", Since: "20250102100000Z"},
+			want: want{
+				ids:       []string{"bob", "carol"},
+				watermark: "20250103100000Z",
+				filter:    "(&(objectClass=inetOrgPerson)(modifyTimestamp>=20250102100000Z))",
+				pages:     1,
+			},
+		},
+		{
+			name:    "usn, since",
+			request: &SyncRequest{IncrementalAttribute: "uSNChanged", Since: "10"},
+			want: want{
+				ids:       []string{"bob", "carol"},
+				watermark: "12",
+				filter:    "(&(objectClass=inetOrgPerson)(uSNChanged>=11))",
+				pages:     1,
+			},
+		},
+		{
+			name:    "usn, no changes",
+			request: &SyncRequest{IncrementalAttribute: "uSNChanged", Since: "12"},
+			want: want{
+				ids:       []string{},
+				watermark: "12",
+				filter:    "(&(objectClass=inetOrgPerson)(uSNChanged>=13))",
+				pages:     1,
+			},
+		},
+		{
+			name:    "reduce failed",
+			request: &SyncRequest{PageSize: 1},
+			reduce:  errors.New("reduce failed"),
+			want: want{
+				ids:    []string{"alice"},
+				filter: "(objectClass=inetOrgPerson)",
+				pages:  1,
+				err:    true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := ldaptest.NewServer(t, "cn=admin,dc=example,dc=com", "password", entries...)
+			provider := New(
+				"ldap",
+				[]string{server.URL()},
+				"dc=example,dc=com",
+				"cn=admin,dc=example,dc=com",
+				"password",
+				"uid",
+				[]string{"inetOrgPerson"},
+				[]string{"uid"},
+				time.Second,
+				nil,
+				"",
+				WithoutStartTLS(),
+				WithCustomIDAttribute("uid"),
+				WithFirstNameAttribute("givenName"),
+				WithLastNameAttribute("sn"),
+				WithEmailAttribute("mail"),
+			)
+
+			ids := make([]string, 0)
+			groups := make(map[string][]string)
+			pages := 0
+			watermark, err := provider.Sync(context.Background(), tt.request, func(entries []*SyncEntry) error {
+				pages++
+				for _, entry := range entries {
+					require.NoError(t, entry.Err)
+					ids = append(ids, entry.User.ID)
+					if len(entry.Groups) > 0 {
+						groups[entry.User.ID] = entry.Groups
+					}
+				}
+				return tt.reduce
+			})
+			if tt.want.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.want.watermark, watermark)
+			}
+			assert.Equal(t, tt.want.ids, ids)
+			if tt.want.groups != nil {
+				assert.Equal(t, tt.want.groups, groups)
+			}
+			assert.Equal(t, tt.want.pages, pages)
+			searches := server.Searches()
+			require.NotEmpty(t, searches)
+			assert.Equal(t, tt.want.filter, searches[0])
+		})
+	}
+}
+
+func TestProvider_Sync_invalidCredentials(t *testing.T) {
+	server := ldaptest.NewServer(t, "cn=admin,dc=example,dc=com", "password")
+	provider := New(
+		"ldap",
+		[]string{server.URL()},
+		"dc=example,dc=com",
+		"cn=admin,dc=example,dc=com",
+		"wrong",
+		"uid",
+		[]string{"inetOrgPerson"},
+		[]string{"uid"},
+		time.Second,
+		nil,
+		"",
+		WithoutStartTLS(),
+		WithCustomIDAttribute("uid"),
+	)
+	_, err := provider.Sync(context.Background(), &SyncRequest{}, func([]*SyncEntry) error { return nil })
+	assert.ErrorIs(t, err, ErrNoServerAvailable)
+}
+
+func TestProvider_Sync_unmappedEntry(t *testing.T) {
+	server := ldaptest.NewServer(t, "cn=admin,dc=example,dc=com", "password",
+		&ldaptest.Entry{
+			DN: "uid=alice,ou=people,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"objectClass": {"inetOrgPerson"},
+				"uid":         {"alice"},
+			},
+		},
+		&ldaptest.Entry{
+			DN: "cn=bob,ou=people,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"objectClass": {"inetOrgPerson"},
+				"cn":          {"bob"},
+			},
+		},
+	)
+	provider := New(
+		"ldap",
+		[]string{server.URL()},
+		"dc=example,dc=com",
+		"cn=admin,dc=example,dc=com",
+		"password",
+		"uid",
+		[]string{"inetOrgPerson"},
+		[]string{"uid"},
+		time.Second,
+		nil,
+		"",
+		WithoutStartTLS(),
+		WithCustomIDAttribute("uid"),
+	)
+	var synced []*SyncEntry
+	_, err := provider.Sync(context.Background(), &SyncRequest{}, func(entries []*SyncEntry) error {
+		synced = append(synced, entries...)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, synced, 2)
+	assert.Equal(t, "alice", synced[0].User.ID)
+	assert.Equal(t, "cn=bob,ou=people,dc=example,dc=com", synced[1].DN)
+	assert.Nil(t, synced[1].User)
+	assert.Error(t, synced[1].Err)
+}
+
+func Test_maxWatermark(t *testing.T) {
+	tests := []struct {
+		name      string
+		attribute string
+		current   string
+		value     string
+		want      string
+	}{
+		{"empty value", "uSNChanged", "10", "", "10"},
+		{"empty current", "uSNChanged", "", "10", "10"},
+		{"usn greater", "uSNChanged", "9", "10", "10"},
+		{"usn lower", "uSNChanged", "10", "9", "10"},
+		{"timestamp greater", "modifyTimestamp", "20250101100000Z", "20250101100001Z", "20250101100001Z"},
+		{"timestamp lower", "modifyTimestamp", "20250101100000Z", "20241231235959Z", "20250101100000Z"},
+		{"timestamp with offset", "modifyTimestamp", "20250101100000Z", "20250101103000+0100", "20250101100000Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, maxWatermark(tt.attribute, tt.current, tt.value))
+		})
+	}
+}
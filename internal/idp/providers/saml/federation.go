@@ -0,0 +1,155 @@
+package saml
+
+import (
+	"context"
+	"crypto/x509"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// maxFederationMetadataSize limits the size of metadata aggregates, eduGAIN is about 100MB
+	maxFederationMetadataSize = 512 << 20
+
+	entityCategoryAttribute = "http://macedir.org/entity-category"
+)
+
+// FetchFederationMetadata reads the metadata aggregate from the url.
+func FetchFederationMetadata(ctx context.Context, client *http.Client, metadataURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataURL, nil)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "SAML-Fd8fU", "Errors.IDP.SAMLFederation.MetadataUnavailable")
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, zerrors.ThrowUnavailable(err, "SAML-Fd8fR", "Errors.IDP.SAMLFederation.MetadataUnavailable")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, zerrors.ThrowUnavailable(nil, "SAML-Fd8fS", "Errors.IDP.SAMLFederation.MetadataUnavailable")
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxFederationMetadataSize))
+	if err != nil {
+		return nil, zerrors.ThrowUnavailable(err, "SAML-Fd8fB", "Errors.IDP.SAMLFederation.MetadataUnavailable")
+	}
+	return data, nil
+}
+
+// ParseFederationMetadata verifies the signature of the metadata aggregate (EntitiesDescriptor)
+// with the signing certificate of the federation and returns the identity providers it contains.
+// Only the signed content is parsed, so injected entities are ignored.
+// Aggregates, whose validUntil is exceeded, are rejected to prevent the replay of outdated metadata.
+func ParseFederationMetadata(data []byte, signingCertificate *x509.Certificate, now time.Time) (entities []*domain.SAMLFederationEntity, validUntil time.Time, err error) {
+	doc := etree.NewDocument()
+	if err = doc.ReadFromBytes(data); err != nil || doc.Root() == nil {
+		return nil, time.Time{}, zerrors.ThrowInvalidArgument(err, "SAML-Fp9fX", "Errors.IDP.SAMLFederation.MetadataInvalid")
+	}
+	validationContext := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{
+		Roots: []*x509.Certificate{signingCertificate},
+	})
+	validationContext.Clock = dsig.NewFakeClockAt(now)
+	root, err := validationContext.Validate(doc.Root())
+	if err != nil {
+		return nil, time.Time{}, zerrors.ThrowPreconditionFailed(err, "SAML-Fp9fS", "Errors.IDP.SAMLFederation.SignatureInvalid")
+	}
+	if root.Tag != "EntitiesDescriptor" {
+		return nil, time.Time{}, zerrors.ThrowInvalidArgument(nil, "SAML-Fp9fE", "Errors.IDP.SAMLFederation.MetadataInvalid")
+	}
+	if value := root.SelectAttrValue("validUntil", ""); value != "" {
+		if validUntil, err = time.Parse(time.RFC3339, value); err != nil {
+			return nil, time.Time{}, zerrors.ThrowInvalidArgument(err, "SAML-Fp9fV", "Errors.IDP.SAMLFederation.MetadataInvalid")
+		}
+		if now.After(validUntil) {
+			return nil, time.Time{}, zerrors.ThrowPreconditionFailed(nil, "SAML-Fp9fO", "Errors.IDP.SAMLFederation.MetadataExpired")
+		}
+	}
+	for _, entityDescriptor := range root.FindElements(".//EntityDescriptor") {
+		if entityDescriptor.FindElement("./IDPSSODescriptor") == nil {
+			continue
+		}
+		entity, err := federationEntity(entityDescriptor)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		entities = append(entities, entity)
+	}
+	return entities, validUntil, nil
+}
+
+func federationEntity(entityDescriptor *etree.Element) (*domain.SAMLFederationEntity, error) {
+	entity := &domain.SAMLFederationEntity{
+		EntityID: entityDescriptor.SelectAttrValue("entityID", ""),
+	}
+	if entity.EntityID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SAML-Fe9fI", "Errors.IDP.SAMLFederation.MetadataInvalid")
+	}
+	if registrationInfo := entityDescriptor.FindElement("./Extensions/RegistrationInfo"); registrationInfo != nil {
+		entity.RegistrationAuthority = registrationInfo.SelectAttrValue("registrationAuthority", "")
+	}
+	for _, attribute := range entityDescriptor.FindElements("./Extensions/EntityAttributes/Attribute") {
+		if attribute.SelectAttrValue("Name", "") != entityCategoryAttribute {
+			continue
+		}
+		for _, value := range attribute.SelectElements("AttributeValue") {
+			entity.EntityCategories = append(entity.EntityCategories, strings.TrimSpace(value.Text()))
+		}
+	}
+	entity.DisplayName = federationEntityDisplayName(entityDescriptor)
+
+	metadata, err := detachedEntityDescriptor(entityDescriptor).WriteToBytes()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "SAML-Fe9fM", "Errors.IDP.SAMLFederation.MetadataInvalid")
+	}
+	if _, err = ParseMetadata(metadata); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "SAML-Fe9fP", "Errors.IDP.SAMLFederation.MetadataInvalid")
+	}
+	entity.Metadata = metadata
+	return entity, nil
+}
+
+// federationEntityDisplayName prefers the english display name of the login UI information (mdui),
+// then the display name of the organization and finally the entity id.
+func federationEntityDisplayName(entityDescriptor *etree.Element) string {
+	for _, path := range []string{
+		"./IDPSSODescriptor/Extensions/UIInfo/DisplayName",
+		"./Organization/OrganizationDisplayName",
+	} {
+		names := entityDescriptor.FindElements(path)
+		for _, name := range names {
+			if name.SelectAttrValue("xml:lang", "") == "en" {
+				return strings.TrimSpace(name.Text())
+			}
+		}
+		if len(names) > 0 {
+			return strings.TrimSpace(names[0].Text())
+		}
+	}
+	return entityDescriptor.SelectAttrValue("entityID", "")
+}
+
+// detachedEntityDescriptor returns a document of the entity,
+// which declares the namespaces inherited from the aggregate.
+func detachedEntityDescriptor(entityDescriptor *etree.Element) *etree.Document {
+	detached := entityDescriptor.Copy()
+	for parent := entityDescriptor.Parent(); parent != nil; parent = parent.Parent() {
+		for _, attr := range parent.Attr {
+			if attr.Space != "xmlns" && !(attr.Space == "" && attr.Key == "xmlns") {
+				continue
+			}
+			if detached.SelectAttr(attr.FullKey()) == nil {
+				detached.CreateAttr(attr.FullKey(), attr.Value)
+			}
+		}
+	}
+	doc := etree.NewDocument()
+	doc.SetRoot(detached)
+	return doc
+}
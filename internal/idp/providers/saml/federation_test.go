@@ -0,0 +1,186 @@
+package saml
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const federationTestAggregate = `<md:EntitiesDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:mdui="urn:oasis:names:tc:SAML:metadata:ui" xmlns:mdrpi="urn:oasis:names:tc:SAML:metadata:rpi" xmlns:mdattr="urn:oasis:names:tc:SAML:metadata:attribute" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" validUntil="%s">
+  <md:EntityDescriptor entityID="https://idp.university.example/idp">
+    <md:Extensions>
+      <mdrpi:RegistrationInfo registrationAuthority="https://federation.example"/>
+      <mdattr:EntityAttributes>
+        <saml:Attribute Name="http://macedir.org/entity-category">
+          <saml:AttributeValue>http://refeds.org/category/research-and-scholarship</saml:AttributeValue>
+        </saml:Attribute>
+      </mdattr:EntityAttributes>
+    </md:Extensions>
+    <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+      <md:Extensions>
+        <mdui:UIInfo>
+          <mdui:DisplayName xml:lang="de">Universität</mdui:DisplayName>
+          <mdui:DisplayName xml:lang="en">University</mdui:DisplayName>
+        </mdui:UIInfo>
+      </md:Extensions>
+      <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="https://idp.university.example/sso"/>
+    </md:IDPSSODescriptor>
+  </md:EntityDescriptor>
+  <md:EntityDescriptor entityID="https://idp.college.example/idp">
+    <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+      <md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.college.example/sso"/>
+    </md:IDPSSODescriptor>
+    <md:Organization>
+      <md:OrganizationName xml:lang="en">college</md:OrganizationName>
+      <md:OrganizationDisplayName xml:lang="en">College</md:OrganizationDisplayName>
+      <md:OrganizationURL xml:lang="en">https://college.example</md:OrganizationURL>
+    </md:Organization>
+  </md:EntityDescriptor>
+  <md:EntityDescriptor entityID="https://sp.example/sp">
+    <md:SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+      <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://sp.example/acs" index="0"/>
+    </md:SPSSODescriptor>
+  </md:EntityDescriptor>
+</md:EntitiesDescriptor>`
+
+func signedFederationTestAggregate(t *testing.T, keyStore dsig.X509KeyStore, validUntil time.Time) []byte {
+	doc := etree.NewDocument()
+	require.NoError(t, doc.ReadFromString(fmt.Sprintf(federationTestAggregate, validUntil.UTC().Format(time.RFC3339))))
+	signed, err := dsig.NewDefaultSigningContext(keyStore).SignEnveloped(doc.Root())
+	require.NoError(t, err)
+	doc.SetRoot(signed)
+	data, err := doc.WriteToBytes()
+	require.NoError(t, err)
+	return data
+}
+
+func federationTestCertificate(t *testing.T, keyStore dsig.X509KeyStore) *x509.Certificate {
+	_, der, err := keyStore.GetKeyPair()
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return certificate
+}
+
+func TestParseFederationMetadata(t *testing.T) {
+	now := time.Now()
+	keyStore := dsig.RandomKeyStoreForTest()
+	otherKeyStore := dsig.RandomKeyStoreForTest()
+	signed := signedFederationTestAggregate(t, keyStore, now.Add(time.Hour))
+
+	type args struct {
+		data               []byte
+		signingCertificate *x509.Certificate
+	}
+	type res struct {
+		entities []*domain.SAMLFederationEntity
+		err      func(error) bool
+	}
+	tests := []struct {
+		name string
+		args args
+		res  res
+	}{
+		{
+			name: "invalid xml, error",
+			args: args{
+				data:               []byte("not xml"),
+				signingCertificate: federationTestCertificate(t, keyStore),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "unsigned, error",
+			args: args{
+				data:               []byte(fmt.Sprintf(federationTestAggregate, now.Add(time.Hour).UTC().Format(time.RFC3339))),
+				signingCertificate: federationTestCertificate(t, keyStore),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "signed by other key, error",
+			args: args{
+				data:               signed,
+				signingCertificate: federationTestCertificate(t, otherKeyStore),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "tampered, error",
+			args: args{
+				data:               []byte(strings.Replace(string(signed), "https://idp.college.example/sso", "https://attacker.example/sso", 1)),
+				signingCertificate: federationTestCertificate(t, keyStore),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "expired, error",
+			args: args{
+				data:               signedFederationTestAggregate(t, keyStore, now.Add(-time.Minute)),
+				signingCertificate: federationTestCertificate(t, keyStore),
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "ok",
+			args: args{
+				data:               signed,
+				signingCertificate: federationTestCertificate(t, keyStore),
+			},
+			res: res{
+				entities: []*domain.SAMLFederationEntity{
+					{
+						EntityID:              "https://idp.university.example/idp",
+						DisplayName:           "University",
+						RegistrationAuthority: "https://federation.example",
+						EntityCategories:      []string{"http://refeds.org/category/research-and-scholarship"},
+					},
+					{
+						EntityID:    "https://idp.college.example/idp",
+						DisplayName: "College",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entities, _, err := ParseFederationMetadata(tt.args.data, tt.args.signingCertificate, now)
+			if tt.res.err != nil {
+				assert.True(t, tt.res.err(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, entities, len(tt.res.entities))
+			for i, entity := range entities {
+				assert.Equal(t, tt.res.entities[i].EntityID, entity.EntityID)
+				assert.Equal(t, tt.res.entities[i].DisplayName, entity.DisplayName)
+				assert.Equal(t, tt.res.entities[i].RegistrationAuthority, entity.RegistrationAuthority)
+				assert.Equal(t, tt.res.entities[i].EntityCategories, entity.EntityCategories)
+				metadata, err := ParseMetadata(entity.Metadata)
+				require.NoError(t, err)
+				assert.Equal(t, tt.res.entities[i].EntityID, metadata.EntityID)
+			}
+		})
+	}
+}
@@ -0,0 +1,361 @@
+package wsfed
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	saml1AssertionNS = "urn:oasis:names:tc:SAML:1.0:assertion"
+	saml2AssertionNS = "urn:oasis:names:tc:SAML:2.0:assertion"
+
+	// allowedClockSkew is the tolerated time difference when checking the validity conditions of the assertion
+	allowedClockSkew = 5 * time.Minute
+	// maxAssertionLifetime limits the validity of accepted assertions,
+	// so that used assertions don't need to be remembered for longer.
+	maxAssertionLifetime = 24 * time.Hour
+)
+
+// UsedAssertionIndex are the indices of the cache of used assertions.
+type UsedAssertionIndex int
+
+const (
+	UsedAssertionIndexUnspecified UsedAssertionIndex = iota
+	UsedAssertionIndexID
+)
+
+// UsedAssertion is kept in the cache until the assertion expires to prevent it from being replayed.
+type UsedAssertion struct {
+	Issuer    string
+	ID        string
+	ExpiresAt time.Time
+}
+
+// Keys implements [cache.Entry].
+func (a *UsedAssertion) Keys(index UsedAssertionIndex) []string {
+	if index == UsedAssertionIndexID {
+		return []string{UsedAssertionKey(a.Issuer, a.ID)}
+	}
+	return nil
+}
+
+// UsedAssertionKey is the key of an assertion, its id is only unique for the issuer.
+func UsedAssertionKey(issuer, id string) string {
+	return issuer + "-" + id
+}
+
+var _ idp.Session = (*Session)(nil)
+
+// Session is the [idp.Session] implementation for the WS-Federation provider.
+type Session struct {
+	Provider *Provider
+	state    string
+
+	// WCtx is the `wctx` parameter posted by the provider to the callback,
+	// it must match the state the authentication was started with.
+	WCtx string
+	// WResult is the `wresult` parameter posted by the provider to the callback,
+	// containing the RequestSecurityTokenResponse.
+	WResult string
+
+	Assertion *Assertion
+
+	usedAssertions cache.Cache[UsedAssertionIndex, string, *UsedAssertion]
+}
+
+// Assertion contains the verified information of the SAML 1.1 or SAML 2.0 token
+type Assertion struct {
+	ID           string
+	Issuer       string
+	NameID       string
+	Audiences    []string
+	NotBefore    time.Time
+	NotOnOrAfter time.Time
+	Attributes   map[string][]string
+}
+
+// NewSession creates the session for the callback of the provider.
+// The state is the one the authentication was started with, the response is only accepted for the same `wctx`.
+// The used assertions are remembered in the cache to prevent replays.
+func NewSession(provider *Provider, state, wctx, wresult string, usedAssertions cache.Cache[UsedAssertionIndex, string, *UsedAssertion]) *Session {
+	return &Session{
+		Provider:       provider,
+		state:          state,
+		WCtx:           wctx,
+		WResult:        wresult,
+		usedAssertions: usedAssertions,
+	}
+}
+
+// GetAuth implements the [idp.Session] interface.
+func (s *Session) GetAuth(ctx context.Context) (idp.Auth, error) {
+	endpoint, err := url.Parse(s.Provider.ssoEndpoint)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "WSFED-Kd82n", "Errors.Intent.IDPInvalid")
+	}
+	query := endpoint.Query()
+	query.Set("wa", "wsignin1.0")
+	query.Set("wtrealm", s.Provider.realm)
+	query.Set("wreply", s.Provider.callbackURL)
+	query.Set("wctx", s.state)
+	endpoint.RawQuery = query.Encode()
+	return idp.Redirect(endpoint.String())
+}
+
+// PersistentParameters implements the [idp.Session] interface.
+func (s *Session) PersistentParameters() map[string]any {
+	return nil
+}
+
+// FetchUser implements the [idp.Session] interface.
+// It verifies the signature and conditions of the token contained in the `wresult` and maps its claims to the user.
+// Each assertion is only accepted once.
+func (s *Session) FetchUser(ctx context.Context) (_ idp.User, err error) {
+	if s.WResult == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "WSFED-Nf82s", "Errors.Intent.ResponseInvalid")
+	}
+	if s.state == "" || s.WCtx != s.state {
+		return nil, zerrors.ThrowInvalidArgument(nil, "WSFED-Wc7x2", "Errors.Intent.ResponseInvalid")
+	}
+	now := time.Now()
+	s.Assertion, err = s.verifyAssertion(now)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkReplay(ctx, now); err != nil {
+		return nil, err
+	}
+	user := NewUser()
+	user.Attributes = s.Assertion.Attributes
+	user.SetID(s.Assertion.NameID)
+	if s.Provider.idClaim != "" {
+		values := s.Assertion.Attributes[s.Provider.idClaim]
+		if len(values) != 1 {
+			return nil, zerrors.ThrowInvalidArgument(nil, "WSFED-Jd9s2", "Errors.Intent.MissingSingleMappingAttribute")
+		}
+		user.SetID(values[0])
+	}
+	if user.GetID() == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "WSFED-Jd9s3", "Errors.Intent.ResponseInvalid")
+	}
+	return user, nil
+}
+
+// ExpiresAt implements the [idp.Session] interface.
+func (s *Session) ExpiresAt() time.Time {
+	if s.Assertion == nil {
+		return time.Time{}
+	}
+	return s.Assertion.NotOnOrAfter
+}
+
+// checkReplay rejects assertions which were already used and remembers the assertion until it expires.
+func (s *Session) checkReplay(ctx context.Context, now time.Time) error {
+	if s.usedAssertions == nil {
+		return nil
+	}
+	key := UsedAssertionKey(s.Assertion.Issuer, s.Assertion.ID)
+	if used, ok := s.usedAssertions.Get(ctx, UsedAssertionIndexID, key); ok && now.Add(-allowedClockSkew).Before(used.ExpiresAt) {
+		return zerrors.ThrowInvalidArgument(nil, "WSFED-Rp3l4", "Errors.Intent.ResponseInvalid")
+	}
+	s.usedAssertions.Set(ctx, &UsedAssertion{
+		Issuer:    s.Assertion.Issuer,
+		ID:        s.Assertion.ID,
+		ExpiresAt: s.Assertion.NotOnOrAfter,
+	})
+	return nil
+}
+
+func (s *Session) verifyAssertion(now time.Time) (*Assertion, error) {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromString(s.WResult); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "WSFED-Pq02n", "Errors.Intent.ResponseInvalid")
+	}
+	if doc.Root() == nil {
+		return nil, zerrors.ThrowInvalidArgument(nil, "WSFED-Pq02o", "Errors.Intent.ResponseInvalid")
+	}
+	// the RequestSecurityTokenResponse echoes the wctx as context, if set by the provider
+	if responseContext := doc.Root().SelectAttrValue("Context", ""); responseContext != "" && responseContext != s.WCtx {
+		return nil, zerrors.ThrowInvalidArgument(nil, "WSFED-Wc7x3", "Errors.Intent.ResponseInvalid")
+	}
+	assertion, idAttribute, parse := findAssertion(doc.Root())
+	if assertion == nil {
+		return nil, zerrors.ThrowInvalidArgument(nil, "WSFED-Pq02p", "Errors.Intent.ResponseInvalid")
+	}
+	// the assertion is detached from the response, so that namespaces declared on the envelope are kept for the canonicalization
+	nsContext, err := etreeutils.NSBuildParentContext(assertion)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "WSFED-Pq02q", "Errors.Intent.ResponseInvalid")
+	}
+	assertion, err = etreeutils.NSDetatch(nsContext, assertion)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "WSFED-Pq02r", "Errors.Intent.ResponseInvalid")
+	}
+	validationContext := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{Roots: s.Provider.certificates})
+	validationContext.IdAttribute = idAttribute
+	verified, err := validationContext.Validate(assertion)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "WSFED-Sg2mw", "Errors.Intent.ResponseInvalid")
+	}
+	result, err := parse(verified)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(result.Audiences, s.Provider.realm) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "WSFED-Au2nd", "Errors.Intent.ResponseInvalid")
+	}
+	if result.ID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "WSFED-Pq02s", "Errors.Intent.ResponseInvalid")
+	}
+	if !result.NotBefore.IsZero() && now.Add(allowedClockSkew).Before(result.NotBefore) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "WSFED-Cn3d1", "Errors.Intent.ResponseInvalid")
+	}
+	if !now.Add(-allowedClockSkew).Before(result.NotOnOrAfter) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "WSFED-Cn3d2", "Errors.Intent.ResponseInvalid")
+	}
+	if result.NotOnOrAfter.After(now.Add(maxAssertionLifetime)) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "WSFED-Cn3d7", "Errors.Intent.ResponseInvalid")
+	}
+	return result, nil
+}
+
+// findAssertion returns the SAML 2.0 or SAML 1.1 assertion of the response,
+// the name of its id attribute and the corresponding parse function.
+func findAssertion(root *etree.Element) (*etree.Element, string, func(*etree.Element) (*Assertion, error)) {
+	if assertion, err := etreeutils.NSFindOne(root, saml2AssertionNS, "Assertion"); err == nil && assertion != nil {
+		return assertion, "ID", parseSAML2Assertion
+	}
+	if assertion, err := etreeutils.NSFindOne(root, saml1AssertionNS, "Assertion"); err == nil && assertion != nil {
+		return assertion, "AssertionID", parseSAML1Assertion
+	}
+	return nil, "", nil
+}
+
+func parseSAML2Assertion(el *etree.Element) (*Assertion, error) {
+	assertion := &Assertion{
+		ID:         el.SelectAttrValue("ID", ""),
+		Attributes: make(map[string][]string),
+	}
+	if issuer := childElement(el, saml2AssertionNS, "Issuer"); issuer != nil {
+		assertion.Issuer = strings.TrimSpace(issuer.Text())
+	}
+	if nameID := childElement(childElement(el, saml2AssertionNS, "Subject"), saml2AssertionNS, "NameID"); nameID != nil {
+		assertion.NameID = strings.TrimSpace(nameID.Text())
+	}
+	conditions := childElement(el, saml2AssertionNS, "Conditions")
+	if err := parseConditions(assertion, conditions); err != nil {
+		return nil, err
+	}
+	for _, restriction := range childElements(conditions, saml2AssertionNS, "AudienceRestriction") {
+		for _, audience := range childElements(restriction, saml2AssertionNS, "Audience") {
+			assertion.Audiences = append(assertion.Audiences, strings.TrimSpace(audience.Text()))
+		}
+	}
+	for _, statement := range childElements(el, saml2AssertionNS, "AttributeStatement") {
+		for _, attribute := range childElements(statement, saml2AssertionNS, "Attribute") {
+			name := attribute.SelectAttrValue("Name", "")
+			assertion.Attributes[name] = append(assertion.Attributes[name], attributeValues(attribute, saml2AssertionNS)...)
+		}
+	}
+	return assertion, nil
+}
+
+func parseSAML1Assertion(el *etree.Element) (*Assertion, error) {
+	assertion := &Assertion{
+		ID:         el.SelectAttrValue("AssertionID", ""),
+		Issuer:     el.SelectAttrValue("Issuer", ""),
+		Attributes: make(map[string][]string),
+	}
+	conditions := childElement(el, saml1AssertionNS, "Conditions")
+	if err := parseConditions(assertion, conditions); err != nil {
+		return nil, err
+	}
+	for _, restriction := range childElements(conditions, saml1AssertionNS, "AudienceRestrictionCondition") {
+		for _, audience := range childElements(restriction, saml1AssertionNS, "Audience") {
+			assertion.Audiences = append(assertion.Audiences, strings.TrimSpace(audience.Text()))
+		}
+	}
+	for _, statement := range el.ChildElements() {
+		if statement.NamespaceURI() != saml1AssertionNS {
+			continue
+		}
+		if nameID := childElement(childElement(statement, saml1AssertionNS, "Subject"), saml1AssertionNS, "NameIdentifier"); nameID != nil && assertion.NameID == "" {
+			assertion.NameID = strings.TrimSpace(nameID.Text())
+		}
+		if statement.Tag != "AttributeStatement" {
+			continue
+		}
+		for _, attribute := range childElements(statement, saml1AssertionNS, "Attribute") {
+			// SAML 1.1 splits the claim type into namespace and name, e.g. ADFS sends
+			// http://schemas.xmlsoap.org/ws/2005/05/identity/claims and emailaddress
+			name := attribute.SelectAttrValue("AttributeName", "")
+			if namespace := attribute.SelectAttrValue("AttributeNamespace", ""); namespace != "" {
+				name = strings.TrimSuffix(namespace, "/") + "/" + name
+			}
+			assertion.Attributes[name] = append(assertion.Attributes[name], attributeValues(attribute, saml1AssertionNS)...)
+		}
+	}
+	return assertion, nil
+}
+
+func parseConditions(assertion *Assertion, conditions *etree.Element) (err error) {
+	if conditions == nil {
+		return zerrors.ThrowInvalidArgument(nil, "WSFED-Cn3d3", "Errors.Intent.ResponseInvalid")
+	}
+	if notBefore := conditions.SelectAttrValue("NotBefore", ""); notBefore != "" {
+		assertion.NotBefore, err = time.Parse(time.RFC3339, notBefore)
+		if err != nil {
+			return zerrors.ThrowInvalidArgument(err, "WSFED-Cn3d4", "Errors.Intent.ResponseInvalid")
+		}
+	}
+	// the expiration is required, as used assertions are remembered until they expire
+	notOnOrAfter := conditions.SelectAttrValue("NotOnOrAfter", "")
+	if notOnOrAfter == "" {
+		return zerrors.ThrowInvalidArgument(nil, "WSFED-Cn3d6", "Errors.Intent.ResponseInvalid")
+	}
+	assertion.NotOnOrAfter, err = time.Parse(time.RFC3339, notOnOrAfter)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "WSFED-Cn3d5", "Errors.Intent.ResponseInvalid")
+	}
+	return nil
+}
+
+func attributeValues(attribute *etree.Element, namespace string) []string {
+	values := make([]string, 0, 1)
+	for _, value := range childElements(attribute, namespace, "AttributeValue") {
+		values = append(values, strings.TrimSpace(value.Text()))
+	}
+	return values
+}
+
+func childElement(el *etree.Element, namespace, tag string) *etree.Element {
+	children := childElements(el, namespace, tag)
+	if len(children) == 0 {
+		return nil
+	}
+	return children[0]
+}
+
+func childElements(el *etree.Element, namespace, tag string) []*etree.Element {
+	if el == nil {
+		return nil
+	}
+	children := make([]*etree.Element, 0)
+	for _, child := range el.ChildElements() {
+		if child.Tag == tag && child.NamespaceURI() == namespace {
+			children = append(children, child)
+		}
+	}
+	return children
+}
@@ -0,0 +1,321 @@
+package wsfed
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/connector/gomap"
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	testRealm       = "urn:zitadel:test"
+	testSSOEndpoint = "https://adfs.example.com/adfs/ls/"
+	testCallbackURL = "https://localhost:8080/idps/wsfed/callback"
+)
+
+func TestSession_GetAuth(t *testing.T) {
+	provider, err := New("adfs", testSSOEndpoint, testRealm, testCallbackURL, testCertificate(t, dsig.RandomKeyStoreForTest()))
+	require.NoError(t, err)
+	session, err := provider.BeginAuth(context.Background(), "intentID")
+	require.NoError(t, err)
+
+	auth, err := session.GetAuth(context.Background())
+	require.NoError(t, err)
+
+	redirect, ok := auth.(*idp.RedirectAuth)
+	require.True(t, ok)
+	assert.Equal(t, "https://adfs.example.com/adfs/ls/?wa=wsignin1.0&wctx=intentID&wreply=https%3A%2F%2Flocalhost%3A8080%2Fidps%2Fwsfed%2Fcallback&wtrealm=urn%3Azitadel%3Atest", redirect.RedirectURL)
+}
+
+func TestSession_FetchUser(t *testing.T) {
+	keyStore := dsig.RandomKeyStoreForTest()
+	now := time.Now().UTC()
+	type fields struct {
+		options []ProviderOpts
+		wctx    string
+		wresult string
+	}
+	type want struct {
+		err          func(error) bool
+		id           string
+		email        string
+		firstName    string
+		lastName     string
+		username     string
+		expiresAfter time.Time
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		want   want
+	}{
+		{
+			name: "missing wresult, invalid argument error",
+			fields: fields{
+				wresult: "",
+			},
+			want: want{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "wctx of other request, invalid argument error",
+			fields: fields{
+				wctx:    "otherIntentID",
+				wresult: wrapAssertion(t, saml2Assertion(testRealm, now, "user@example.com"), keyStore),
+			},
+			want: want{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "no assertion, invalid argument error",
+			fields: fields{
+				wresult: `<t:RequestSecurityTokenResponse xmlns:t="http://schemas.xmlsoap.org/ws/2005/02/trust"/>`,
+			},
+			want: want{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "unsigned assertion, invalid argument error",
+			fields: fields{
+				wresult: wrapAssertion(t, saml2Assertion(testRealm, now, "user@example.com"), nil),
+			},
+			want: want{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "signed by unknown certificate, invalid argument error",
+			fields: fields{
+				wresult: wrapAssertion(t, saml2Assertion(testRealm, now, "user@example.com"), dsig.RandomKeyStoreForTest()),
+			},
+			want: want{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "tampered assertion, invalid argument error",
+			fields: fields{
+				wresult: strings.Replace(
+					wrapAssertion(t, saml2Assertion(testRealm, now, "user@example.com"), keyStore),
+					"user@example.com", "admin@example.com", 1,
+				),
+			},
+			want: want{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "wrong audience, invalid argument error",
+			fields: fields{
+				wresult: wrapAssertion(t, saml2Assertion("urn:other", now, "user@example.com"), keyStore),
+			},
+			want: want{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "expired assertion, invalid argument error",
+			fields: fields{
+				wresult: wrapAssertion(t, saml2Assertion(testRealm, now.Add(-2*time.Hour), "user@example.com"), keyStore),
+			},
+			want: want{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "assertion without expiration, invalid argument error",
+			fields: fields{
+				wresult: wrapAssertion(t, strings.Replace(saml2Assertion(testRealm, now, "user@example.com"), ` NotOnOrAfter="`, ` Expiration="`, 1), keyStore),
+			},
+			want: want{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "assertion valid for too long, invalid argument error",
+			fields: fields{
+				wresult: wrapAssertion(t, strings.Replace(
+					saml2Assertion(testRealm, now, "user@example.com"),
+					now.Add(time.Hour).Format(time.RFC3339), now.Add(48*time.Hour).Format(time.RFC3339), 1,
+				), keyStore),
+			},
+			want: want{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "id claim missing, invalid argument error",
+			fields: fields{
+				options: []ProviderOpts{WithIDClaim("http://schemas.microsoft.com/ws/2008/06/identity/claims/primarysid")},
+				wresult: wrapAssertion(t, saml2Assertion(testRealm, now, "user@example.com"), keyStore),
+			},
+			want: want{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "saml 2.0 assertion, ok",
+			fields: fields{
+				wresult: wrapAssertion(t, saml2Assertion(testRealm, now, "user@example.com"), keyStore),
+			},
+			want: want{
+				id:           "user@example.com",
+				email:        "user@example.com",
+				firstName:    "first",
+				lastName:     "last",
+				username:     "user@example.com",
+				expiresAfter: now,
+			},
+		},
+		{
+			name: "saml 2.0 assertion with id claim, ok",
+			fields: fields{
+				options: []ProviderOpts{WithIDClaim(ClaimUPN)},
+				wresult: wrapAssertion(t, saml2Assertion(testRealm, now, "nameID"), keyStore),
+			},
+			want: want{
+				id:           "user@example.com",
+				email:        "user@example.com",
+				firstName:    "first",
+				lastName:     "last",
+				username:     "user@example.com",
+				expiresAfter: now,
+			},
+		},
+		{
+			name: "saml 1.1 assertion, ok",
+			fields: fields{
+				wresult: wrapAssertion(t, saml1Assertion(testRealm, now, "user@example.com"), keyStore),
+			},
+			want: want{
+				id:           "user@example.com",
+				email:        "user@example.com",
+				firstName:    "first",
+				lastName:     "last",
+				username:     "user@example.com",
+				expiresAfter: now,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := New("adfs", testSSOEndpoint, testRealm, testCallbackURL, testCertificate(t, keyStore), tt.fields.options...)
+			require.NoError(t, err)
+			wctx := tt.fields.wctx
+			if wctx == "" {
+				wctx = "intentID"
+			}
+			session := NewSession(provider, "intentID", wctx, tt.fields.wresult, nil)
+
+			user, err := session.FetchUser(context.Background())
+			if tt.want.err != nil {
+				assert.True(t, tt.want.err(err), "got wrong err: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.id, user.GetID())
+			assert.Equal(t, tt.want.email, string(user.GetEmail()))
+			assert.Equal(t, tt.want.firstName, user.GetFirstName())
+			assert.Equal(t, tt.want.lastName, user.GetLastName())
+			assert.Equal(t, tt.want.username, user.GetPreferredUsername())
+			assert.True(t, session.ExpiresAt().After(tt.want.expiresAfter))
+		})
+	}
+}
+
+func TestSession_FetchUser_replay(t *testing.T) {
+	keyStore := dsig.RandomKeyStoreForTest()
+	provider, err := New("adfs", testSSOEndpoint, testRealm, testCallbackURL, testCertificate(t, keyStore))
+	require.NoError(t, err)
+	usedAssertions := gomap.NewCache[UsedAssertionIndex, string, *UsedAssertion](context.Background(), []UsedAssertionIndex{UsedAssertionIndexID}, cache.Config{
+		MaxAge: time.Hour,
+	})
+	wresult := wrapAssertion(t, saml2Assertion(testRealm, time.Now().UTC(), "user@example.com"), keyStore)
+
+	_, err = NewSession(provider, "intentID", "intentID", wresult, usedAssertions).FetchUser(context.Background())
+	require.NoError(t, err)
+
+	_, err = NewSession(provider, "otherIntentID", "otherIntentID", wresult, usedAssertions).FetchUser(context.Background())
+	assert.True(t, zerrors.IsErrorInvalidArgument(err), "got wrong err: %v", err)
+}
+
+func testCertificate(t *testing.T, keyStore dsig.X509KeyStore) []byte {
+	_, cert, err := keyStore.GetKeyPair()
+	require.NoError(t, err)
+	_, err = x509.ParseCertificate(cert)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})
+}
+
+// wrapAssertion signs the assertion with the provided key store (if any)
+// and wraps it into a RequestSecurityTokenResponse as sent in the `wresult`
+func wrapAssertion(t *testing.T, assertion string, keyStore dsig.X509KeyStore) string {
+	doc := etree.NewDocument()
+	require.NoError(t, doc.ReadFromString(assertion))
+	element := doc.Root()
+	if keyStore != nil {
+		signingContext := dsig.NewDefaultSigningContext(keyStore)
+		// ADFS signs the assertions using exclusive canonicalization
+		signingContext.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+		signingContext.IdAttribute = "ID"
+		if element.SelectAttr("AssertionID") != nil {
+			signingContext.IdAttribute = "AssertionID"
+		}
+		signed, err := signingContext.SignEnveloped(element)
+		require.NoError(t, err)
+		element = signed
+	}
+	response := etree.NewDocument()
+	rstr := response.CreateElement("t:RequestSecurityTokenResponse")
+	rstr.CreateAttr("xmlns:t", "http://schemas.xmlsoap.org/ws/2005/02/trust")
+	rstr.CreateElement("t:RequestedSecurityToken").AddChild(element)
+	result, err := response.WriteToString()
+	require.NoError(t, err)
+	return result
+}
+
+func saml2Assertion(audience string, issueInstant time.Time, nameID string) string {
+	return fmt.Sprintf(`<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_assertion" IssueInstant="%[2]s" Version="2.0">`+
+		`<saml:Issuer>http://adfs.example.com/adfs/services/trust</saml:Issuer>`+
+		`<saml:Subject><saml:NameID>%[4]s</saml:NameID></saml:Subject>`+
+		`<saml:Conditions NotBefore="%[2]s" NotOnOrAfter="%[3]s"><saml:AudienceRestriction><saml:Audience>%[1]s</saml:Audience></saml:AudienceRestriction></saml:Conditions>`+
+		`<saml:AttributeStatement>`+
+		`<saml:Attribute Name="http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress"><saml:AttributeValue>user@example.com</saml:AttributeValue></saml:Attribute>`+
+		`<saml:Attribute Name="http://schemas.xmlsoap.org/ws/2005/05/identity/claims/upn"><saml:AttributeValue>user@example.com</saml:AttributeValue></saml:Attribute>`+
+		`<saml:Attribute Name="http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname"><saml:AttributeValue>first</saml:AttributeValue></saml:Attribute>`+
+		`<saml:Attribute Name="http://schemas.xmlsoap.org/ws/2005/05/identity/claims/surname"><saml:AttributeValue>last</saml:AttributeValue></saml:Attribute>`+
+		`</saml:AttributeStatement>`+
+		`</saml:Assertion>`,
+		audience, issueInstant.Format(time.RFC3339), issueInstant.Add(time.Hour).Format(time.RFC3339), nameID,
+	)
+}
+
+func saml1Assertion(audience string, issueInstant time.Time, nameID string) string {
+	return fmt.Sprintf(`<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:1.0:assertion" AssertionID="_assertion" Issuer="http://adfs.example.com/adfs/services/trust" IssueInstant="%[2]s" MajorVersion="1" MinorVersion="1">`+
+		`<saml:Conditions NotBefore="%[2]s" NotOnOrAfter="%[3]s"><saml:AudienceRestrictionCondition><saml:Audience>%[1]s</saml:Audience></saml:AudienceRestrictionCondition></saml:Conditions>`+
+		`<saml:AttributeStatement>`+
+		`<saml:Subject><saml:NameIdentifier>%[4]s</saml:NameIdentifier></saml:Subject>`+
+		`<saml:Attribute AttributeName="emailaddress" AttributeNamespace="http://schemas.xmlsoap.org/ws/2005/05/identity/claims"><saml:AttributeValue>user@example.com</saml:AttributeValue></saml:Attribute>`+
+		`<saml:Attribute AttributeName="givenname" AttributeNamespace="http://schemas.xmlsoap.org/ws/2005/05/identity/claims"><saml:AttributeValue>first</saml:AttributeValue></saml:Attribute>`+
+		`<saml:Attribute AttributeName="surname" AttributeNamespace="http://schemas.xmlsoap.org/ws/2005/05/identity/claims"><saml:AttributeValue>last</saml:AttributeValue></saml:Attribute>`+
+		`</saml:AttributeStatement>`+
+		`</saml:Assertion>`,
+		audience, issueInstant.Format(time.RFC3339), issueInstant.Add(time.Hour).Format(time.RFC3339), nameID,
+	)
+}
@@ -0,0 +1,116 @@
+package wsfed
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/idp"
+)
+
+// well known claim types sent by ADFS and other WS-Federation providers
+const (
+	ClaimEmail       = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress"
+	ClaimGivenName   = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname"
+	ClaimSurname     = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/surname"
+	ClaimName        = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name"
+	ClaimUPN         = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/upn"
+	ClaimDisplayName = "http://schemas.microsoft.com/identity/claims/displayname"
+	ClaimMobilePhone = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/mobilephone"
+)
+
+var _ idp.User = (*UserMapper)(nil)
+
+// UserMapper is an implementation of [idp.User].
+// It maps the well known claim types of the token to the user information,
+// all claims are kept as attributes.
+type UserMapper struct {
+	ID         string              `json:"id,omitempty"`
+	Attributes map[string][]string `json:"attributes,omitempty"`
+}
+
+func NewUser() *UserMapper {
+	return &UserMapper{Attributes: map[string][]string{}}
+}
+
+func (u *UserMapper) SetID(id string) {
+	u.ID = id
+}
+
+func (u *UserMapper) claim(claimTypes ...string) string {
+	for _, claimType := range claimTypes {
+		if values := u.Attributes[claimType]; len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// GetID is an implementation of the [idp.User] interface.
+func (u *UserMapper) GetID() string {
+	return u.ID
+}
+
+// GetFirstName is an implementation of the [idp.User] interface.
+func (u *UserMapper) GetFirstName() string {
+	return u.claim(ClaimGivenName)
+}
+
+// GetLastName is an implementation of the [idp.User] interface.
+func (u *UserMapper) GetLastName() string {
+	return u.claim(ClaimSurname)
+}
+
+// GetDisplayName is an implementation of the [idp.User] interface.
+func (u *UserMapper) GetDisplayName() string {
+	if displayName := u.claim(ClaimDisplayName); displayName != "" {
+		return displayName
+	}
+	return strings.TrimSpace(u.GetFirstName() + " " + u.GetLastName())
+}
+
+// GetNickname is an implementation of the [idp.User] interface.
+func (u *UserMapper) GetNickname() string {
+	return ""
+}
+
+// GetPreferredUsername is an implementation of the [idp.User] interface.
+func (u *UserMapper) GetPreferredUsername() string {
+	return u.claim(ClaimUPN, ClaimName, ClaimEmail)
+}
+
+// GetEmail is an implementation of the [idp.User] interface.
+func (u *UserMapper) GetEmail() domain.EmailAddress {
+	return domain.EmailAddress(u.claim(ClaimEmail))
+}
+
+// IsEmailVerified is an implementation of the [idp.User] interface.
+func (u *UserMapper) IsEmailVerified() bool {
+	return false
+}
+
+// GetPhone is an implementation of the [idp.User] interface.
+func (u *UserMapper) GetPhone() domain.PhoneNumber {
+	return domain.PhoneNumber(u.claim(ClaimMobilePhone))
+}
+
+// IsPhoneVerified is an implementation of the [idp.User] interface.
+func (u *UserMapper) IsPhoneVerified() bool {
+	return false
+}
+
+// GetPreferredLanguage is an implementation of the [idp.User] interface.
+func (u *UserMapper) GetPreferredLanguage() language.Tag {
+	return language.Und
+}
+
+// GetAvatarURL is an implementation of the [idp.User] interface.
+func (u *UserMapper) GetAvatarURL() string {
+	return ""
+}
+
+// GetProfile is an implementation of the [idp.User] interface.
+func (u *UserMapper) GetProfile() string {
+	return ""
+}
@@ -0,0 +1,140 @@
+package wsfed
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var _ idp.Provider = (*Provider)(nil)
+
+// Provider is the [idp.Provider] implementation for a generic WS-Federation provider (e.g. ADFS)
+// using the passive requestor profile.
+type Provider struct {
+	name         string
+	ssoEndpoint  string
+	realm        string
+	callbackURL  string
+	certificates []*x509.Certificate
+	idClaim      string
+
+	isLinkingAllowed  bool
+	isCreationAllowed bool
+	isAutoCreation    bool
+	isAutoUpdate      bool
+}
+
+type ProviderOpts func(provider *Provider)
+
+// WithLinkingAllowed allows end users to link the federated user to an existing one.
+func WithLinkingAllowed() ProviderOpts {
+	return func(p *Provider) {
+		p.isLinkingAllowed = true
+	}
+}
+
+// WithCreationAllowed allows end users to create a new user using the federated information.
+func WithCreationAllowed() ProviderOpts {
+	return func(p *Provider) {
+		p.isCreationAllowed = true
+	}
+}
+
+// WithAutoCreation enables that federated users are automatically created if not already existing.
+func WithAutoCreation() ProviderOpts {
+	return func(p *Provider) {
+		p.isAutoCreation = true
+	}
+}
+
+// WithAutoUpdate enables that information retrieved from the provider is automatically used to update
+// the existing user on each authentication.
+func WithAutoUpdate() ProviderOpts {
+	return func(p *Provider) {
+		p.isAutoUpdate = true
+	}
+}
+
+// WithIDClaim uses the value of the provided claim as id of the federated user instead of the NameID.
+func WithIDClaim(claim string) ProviderOpts {
+	return func(p *Provider) {
+		p.idClaim = claim
+	}
+}
+
+// New creates a WS-Federation provider.
+// The certificate contains one or more PEM encoded token signing certificates of the provider.
+func New(name, ssoEndpoint, realm, callbackURL string, certificate []byte, options ...ProviderOpts) (*Provider, error) {
+	certificates, err := ParseCertificates(certificate)
+	if err != nil {
+		return nil, err
+	}
+	provider := &Provider{
+		name:         name,
+		ssoEndpoint:  ssoEndpoint,
+		realm:        realm,
+		callbackURL:  callbackURL,
+		certificates: certificates,
+	}
+	for _, option := range options {
+		option(provider)
+	}
+	return provider, nil
+}
+
+// ParseCertificates parses all PEM encoded certificates.
+// At least one certificate must be present.
+func ParseCertificates(certificate []byte) ([]*x509.Certificate, error) {
+	certificates := make([]*x509.Certificate, 0, 1)
+	for {
+		var block *pem.Block
+		block, certificate = pem.Decode(certificate)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "WSFED-Vb3ks", "Errors.IDP.WSFederation.InvalidCertificate")
+		}
+		certificates = append(certificates, cert)
+	}
+	if len(certificates) == 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "WSFED-Vb3kt", "Errors.IDP.WSFederation.InvalidCertificate")
+	}
+	return certificates, nil
+}
+
+func (p *Provider) Name() string {
+	return p.name
+}
+
+func (p *Provider) IsLinkingAllowed() bool {
+	return p.isLinkingAllowed
+}
+
+func (p *Provider) IsCreationAllowed() bool {
+	return p.isCreationAllowed
+}
+
+func (p *Provider) IsAutoCreation() bool {
+	return p.isAutoCreation
+}
+
+func (p *Provider) IsAutoUpdate() bool {
+	return p.isAutoUpdate
+}
+
+// BeginAuth implements the [idp.Provider] interface.
+// The state is sent as `wctx` parameter and returned by the provider on the callback.
+func (p *Provider) BeginAuth(_ context.Context, state string, _ ...idp.Parameter) (idp.Session, error) {
+	return &Session{
+		Provider: p,
+		state:    state,
+	}, nil
+}
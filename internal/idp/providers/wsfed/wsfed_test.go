@@ -0,0 +1,94 @@
+package wsfed
+
+import (
+	"testing"
+
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestProvider_Options(t *testing.T) {
+	type args struct {
+		certificate []byte
+		options     []ProviderOpts
+	}
+	type want struct {
+		err               func(error) bool
+		name              string
+		linkingAllowed    bool
+		creationAllowed   bool
+		autoCreation      bool
+		autoUpdate        bool
+		idClaim           string
+		certificatesCount int
+	}
+	certificate := testCertificate(t, dsig.RandomKeyStoreForTest())
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "invalid certificate, error",
+			args: args{
+				certificate: []byte("certificate"),
+			},
+			want: want{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "default",
+			args: args{
+				certificate: certificate,
+			},
+			want: want{
+				name:              "adfs",
+				certificatesCount: 1,
+			},
+		},
+		{
+			name: "all true",
+			args: args{
+				certificate: append(certificate, testCertificate(t, dsig.RandomKeyStoreForTest())...),
+				options: []ProviderOpts{
+					WithLinkingAllowed(),
+					WithCreationAllowed(),
+					WithAutoCreation(),
+					WithAutoUpdate(),
+					WithIDClaim(ClaimUPN),
+				},
+			},
+			want: want{
+				name:              "adfs",
+				linkingAllowed:    true,
+				creationAllowed:   true,
+				autoCreation:      true,
+				autoUpdate:        true,
+				idClaim:           ClaimUPN,
+				certificatesCount: 2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := New("adfs", testSSOEndpoint, testRealm, testCallbackURL, tt.args.certificate, tt.args.options...)
+			if tt.want.err != nil {
+				assert.True(t, tt.want.err(err), "got wrong err: %v", err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want.name, provider.Name())
+			assert.Equal(t, tt.want.linkingAllowed, provider.IsLinkingAllowed())
+			assert.Equal(t, tt.want.creationAllowed, provider.IsCreationAllowed())
+			assert.Equal(t, tt.want.autoCreation, provider.IsAutoCreation())
+			assert.Equal(t, tt.want.autoUpdate, provider.IsAutoUpdate())
+			assert.Equal(t, tt.want.idClaim, provider.idClaim)
+			assert.Len(t, provider.certificates, tt.want.certificatesCount)
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package ldapsync
+
+type Config struct {
+	Enabled bool
+	// Interval is the cron schedule of the job searching for due synchronizations.
+	Interval    string
+	MaxAttempts uint8
+	// BulkSize limits the synchronizations executed per run, the remaining are executed on the next run.
+	BulkSize uint32
+	// MaxDeactivationShare is the share of the active users a full synchronization may deactivate at once,
+	// e.g. 0.5 for half of them. If more users are missing in the directory, none are deactivated.
+	MaxDeactivationShare float64
+}
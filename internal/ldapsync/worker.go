@@ -0,0 +1,334 @@
+package ldapsync
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/robfig/cron/v3"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	QueueName = "ldap_sync"
+)
+
+var (
+	_ river.Worker[*LDAPSync] = (*Worker)(nil)
+
+	errTooManyMissing = errors.New("too many users missing in the directory, no users deactivated")
+)
+
+// LDAPSync is the periodic job executing the due synchronizations of LDAP identity providers.
+type LDAPSync struct{}
+
+func (*LDAPSync) Kind() string {
+	return "ldap_sync"
+}
+
+type Worker struct {
+	river.WorkerDefaults[*LDAPSync]
+
+	db       Queries
+	commands Commands
+	config   *Config
+	now      func() time.Time
+}
+
+type Queries interface {
+	SearchDueLDAPSyncs(ctx context.Context, dueAt time.Time, limit uint32) ([]*query.DueLDAPSync, error)
+	LDAPSyncByIDPID(ctx context.Context, shouldTriggerBulk bool, idpID string) (*query.LDAPSync, error)
+	LDAPSyncedUsers(ctx context.Context, shouldTriggerBulk bool, idpID string) ([]*query.LDAPSyncedUser, error)
+}
+
+type Commands interface {
+	LDAPSyncProvider(ctx context.Context, idpID string) (*ldap.Provider, error)
+	AddLDAPSyncedUser(ctx context.Context, orgID, idpID string, ldapUser *ldap.User) (string, error)
+	UpdateLDAPSyncedUser(ctx context.Context, userID, resourceOwner string, ldapUser *ldap.User) (updated, reactivated bool, err error)
+	DeactivateUser(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error)
+	SyncLDAPGroupMembers(ctx context.Context, groupID string, addUserIDs, removeUserIDs []string) (added, removed int, err error)
+	LDAPSyncSucceeded(ctx context.Context, idpID, resourceOwner string, startedAt time.Time, summary *domain.LDAPSyncSummary, watermark string, nextRunAt time.Time) error
+	LDAPSyncFailed(ctx context.Context, idpID, resourceOwner string, startedAt time.Time, errorMessage string, nextRunAt time.Time) error
+	RemoveLDAPSyncOfRemovedIDP(ctx context.Context, idpID, resourceOwner string) error
+}
+
+// Register implements the [queue.Worker] interface.
+func (w *Worker) Register(workers *river.Workers, queues map[string]river.QueueConfig) {
+	river.AddWorker[*LDAPSync](workers, w)
+	queues[QueueName] = river.QueueConfig{
+		MaxWorkers: 1, // the job is periodic, a single worker prevents synchronizing the same directory concurrently
+	}
+}
+
+// Work implements the [river.Worker] interface.
+// A failing synchronization does not stop the others, it's reported on the synchronization and retried after its interval.
+func (w *Worker) Work(ctx context.Context, _ *river.Job[*LDAPSync]) error {
+	due, err := w.db.SearchDueLDAPSyncs(ctx, w.now(), w.config.BulkSize)
+	if err != nil {
+		return err
+	}
+	errs := make([]error, 0)
+	for _, sync := range due {
+		if err := w.execute(authz.WithInstanceID(ctx, sync.InstanceID), sync); err != nil {
+			logging.WithFields("instance", sync.InstanceID, "idp", sync.IDPID).
+				OnError(err).Warn("unable to execute ldap synchronization")
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (w *Worker) execute(ctx context.Context, due *query.DueLDAPSync) error {
+	sync, err := w.db.LDAPSyncByIDPID(ctx, true, due.IDPID)
+	// the projection removes the synchronization together with its identity provider
+	if zerrors.IsNotFound(err) {
+		return w.commands.RemoveLDAPSyncOfRemovedIDP(ctx, due.IDPID, due.ResourceOwner)
+	}
+	if err != nil {
+		return err
+	}
+	startedAt := w.now()
+	nextRunAt := startedAt.Add(sync.Interval)
+	summary, watermark, err := w.synchronize(ctx, sync, startedAt)
+	if err != nil {
+		return w.commands.LDAPSyncFailed(ctx, sync.IDPID, sync.ResourceOwner, startedAt, err.Error(), nextRunAt)
+	}
+	return w.commands.LDAPSyncSucceeded(ctx, sync.IDPID, sync.ResourceOwner, startedAt, summary, watermark, nextRunAt)
+}
+
+// isFullSync returns true if all entries of the directory have to be synchronized,
+// which is required to deactivate the users removed from the directory.
+func isFullSync(sync *query.LDAPSync, now time.Time) bool {
+	return sync.IncrementalAttribute.Name() == "" ||
+		sync.Watermark == "" ||
+		sync.FullSyncRequested ||
+		(sync.FullSyncInterval > 0 && !sync.LastFullSyncDate.Add(sync.FullSyncInterval).After(now))
+}
+
+func (w *Worker) synchronize(ctx context.Context, sync *query.LDAPSync, startedAt time.Time) (*domain.LDAPSyncSummary, string, error) {
+	provider, err := w.commands.LDAPSyncProvider(ctx, sync.IDPID)
+	if err != nil {
+		return nil, "", err
+	}
+	linked, err := w.db.LDAPSyncedUsers(ctx, true, sync.IDPID)
+	if err != nil {
+		return nil, "", err
+	}
+	run := &syncRun{
+		Worker:  w,
+		sync:    sync,
+		summary: &domain.LDAPSyncSummary{Full: isFullSync(sync, startedAt)},
+		linked:  make(map[string]*query.LDAPSyncedUser, len(linked)),
+		seen:    make(map[string][]string),
+	}
+	for _, user := range linked {
+		run.linked[user.ExternalUserID] = user
+	}
+	request := &ldap.SyncRequest{
+		Filter:         sync.Filter,
+		PageSize:       sync.PageSize,
+		GroupAttribute: sync.GroupAttribute,
+	}
+	if attribute := sync.IncrementalAttribute.Name(); attribute != "" {
+		request.IncrementalAttribute = attribute
+		if !run.summary.Full {
+			request.Since = sync.Watermark
+		}
+	}
+	watermark, err := provider.Sync(ctx, request, func(entries []*ldap.SyncEntry) error {
+		for _, entry := range entries {
+			run.reduceEntry(ctx, entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	removeMissing := run.removeMissing()
+	if removeMissing {
+		run.deactivateMissing(ctx)
+	}
+	run.syncGroups(ctx, removeMissing)
+	// failed users must be synchronized again on the next run, therefore the watermark is kept
+	if run.summary.Failed > 0 {
+		watermark = sync.Watermark
+	}
+	return run.summary, watermark, nil
+}
+
+type syncRun struct {
+	*Worker
+	sync    *query.LDAPSync
+	summary *domain.LDAPSyncSummary
+	// linked are the users linked to the identity provider by their external id
+	linked map[string]*query.LDAPSyncedUser
+	// seen are the groups of the users found in the directory by their user id
+	seen map[string][]string
+	// unmapped is the number of entries which could not be mapped to a user
+	unmapped int
+}
+
+func (r *syncRun) reduceEntry(ctx context.Context, entry *ldap.SyncEntry) {
+	if entry.Err != nil {
+		r.unmapped++
+		r.failed(entry.DN, entry.Err)
+		return
+	}
+	if linked, ok := r.linked[entry.User.ID]; ok {
+		r.seen[linked.UserID] = entry.Groups
+		updated, reactivated, err := r.commands.UpdateLDAPSyncedUser(ctx, linked.UserID, linked.ResourceOwner, entry.User)
+		if err != nil {
+			r.failed(entry.DN, err)
+			return
+		}
+		if updated {
+			r.summary.Updated++
+		}
+		if reactivated {
+			r.summary.Reactivated++
+		}
+		return
+	}
+	userID, err := r.commands.AddLDAPSyncedUser(ctx, r.sync.OrganizationID, r.sync.IDPID, entry.User)
+	if err != nil {
+		r.failed(entry.DN, err)
+		return
+	}
+	r.seen[userID] = entry.Groups
+	r.summary.Created++
+}
+
+// removeMissing returns true if the users not found in the directory can be deactivated and removed from the groups,
+// which requires a full synchronization.
+// If an entry could not be mapped, it might belong to a linked user, so no users are removed.
+// If more than the configured share of the active users is missing, e.g. because of a changed filter or base DN,
+// no users are removed and the failure is reported.
+func (r *syncRun) removeMissing() bool {
+	if !r.summary.Full || r.unmapped > 0 {
+		return false
+	}
+	var active, missing int
+	for _, user := range r.linked {
+		if user.State != domain.UserStateActive {
+			continue
+		}
+		active++
+		if _, ok := r.seen[user.UserID]; !ok {
+			missing++
+		}
+	}
+	if float64(missing) > float64(active)*r.config.MaxDeactivationShare {
+		r.failed(r.sync.IDPID, errTooManyMissing)
+		return false
+	}
+	return true
+}
+
+// deactivateMissing deactivates the active users which were not found in the directory.
+func (r *syncRun) deactivateMissing(ctx context.Context) {
+	for _, user := range r.linked {
+		if _, ok := r.seen[user.UserID]; ok || user.State != domain.UserStateActive {
+			continue
+		}
+		if _, err := r.commands.DeactivateUser(ctx, user.UserID, user.ResourceOwner); err != nil {
+			r.failed(user.ExternalUserID, err)
+			continue
+		}
+		r.summary.Deactivated++
+	}
+}
+
+// syncGroups applies the group memberships of the synchronized users to the mapped groups.
+// Only the memberships of users linked to the identity provider are changed.
+// The users not found in the directory are only removed if removeMissing is set,
+// otherwise only the users found in the directory are changed.
+func (r *syncRun) syncGroups(ctx context.Context, removeMissing bool) {
+	if r.sync.GroupAttribute == "" {
+		return
+	}
+	for _, mapping := range r.sync.GroupMappings {
+		add := make([]string, 0)
+		remove := make([]string, 0)
+		for userID, groups := range r.seen {
+			if containsGroup(groups, mapping.LDAPGroup) {
+				add = append(add, userID)
+				continue
+			}
+			remove = append(remove, userID)
+		}
+		if removeMissing {
+			for _, user := range r.linked {
+				if _, ok := r.seen[user.UserID]; !ok {
+					remove = append(remove, user.UserID)
+				}
+			}
+		}
+		slices.Sort(add)
+		slices.Sort(remove)
+		added, removed, err := r.commands.SyncLDAPGroupMembers(ctx, mapping.GroupID, add, remove)
+		if err != nil {
+			r.failed(mapping.LDAPGroup, err)
+			continue
+		}
+		r.summary.GroupsAdded += uint32(added)
+		r.summary.GroupsRemoved += uint32(removed)
+	}
+}
+
+// containsGroup compares the distinguished names case-insensitive as most directories do.
+func containsGroup(groups []string, group string) bool {
+	return slices.ContainsFunc(groups, func(g string) bool {
+		return strings.EqualFold(g, group)
+	})
+}
+
+func (r *syncRun) failed(entry string, err error) {
+	logging.WithFields("idp", r.sync.IDPID, "entry", entry).WithError(err).Warn("unable to synchronize ldap entry")
+	r.summary.Failed++
+	r.summary.LastError = err.Error()
+}
+
+func Register(
+	q *queue.Queue,
+	queries Queries,
+	commands Commands,
+	config *Config,
+) {
+	if !config.Enabled {
+		return
+	}
+	q.ShouldStart()
+	q.AddWorkers(&Worker{
+		db:       queries,
+		commands: commands,
+		config:   config,
+		now:      time.Now,
+	})
+}
+
+func Start(config *Config, q *queue.Queue) error {
+	if !config.Enabled {
+		return nil
+	}
+	schedule, err := cron.ParseStandard(config.Interval)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "LDAPS-Ls9wI", "invalid interval")
+	}
+	q.AddPeriodicJob(
+		schedule,
+		&LDAPSync{},
+		queue.WithQueueName(QueueName),
+		queue.WithMaxAttempts(config.MaxAttempts),
+	)
+	return nil
+}
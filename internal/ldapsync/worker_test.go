@@ -0,0 +1,401 @@
+package ldapsync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap/ldaptest"
+	"github.com/zitadel/zitadel/internal/ldapsync/mock"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	testNow   = time.Now()
+	errDB     = zerrors.ThrowInternal(nil, "id", "db error")
+	errPush   = zerrors.ThrowInternal(nil, "id", "push error")
+	adminsDN  = "cn=admins,ou=groups,dc=example,dc=com"
+	due       = []*query.DueLDAPSync{{InstanceID: "instance1", ResourceOwner: "instance1", IDPID: "idp1"}}
+	directory = []*ldaptest.Entry{
+		{
+			DN: "uid=alice,ou=people,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"objectClass": {"inetOrgPerson"},
+				"uid":         {"alice"},
+				"givenName":   {"Alice"},
+				"sn":          {"Doe"},
+				"memberOf":    {"CN=Admins,OU=Groups,DC=example,DC=com"},
+				"uSNChanged":  {"10"},
+			},
+		},
+		{
+			DN: "uid=bob,ou=people,dc=example,dc=com",
+			Attributes: map[string][]string{
+				"objectClass": {"inetOrgPerson"},
+				"uid":         {"bob"},
+				"givenName":   {"Bob"},
+				"sn":          {"Doe"},
+				"uSNChanged":  {"12"},
+			},
+		},
+	}
+)
+
+func newTestProvider(t *testing.T, entries ...*ldaptest.Entry) *ldap.Provider {
+	server := ldaptest.NewServer(t, "cn=admin,dc=example,dc=com", "password", entries...)
+	return ldap.New(
+		"ldap",
+		[]string{server.URL()},
+		"dc=example,dc=com",
+		"cn=admin,dc=example,dc=com",
+		"password",
+		"uid",
+		[]string{"inetOrgPerson"},
+		[]string{"uid"},
+		time.Second,
+		nil,
+		"",
+		ldap.WithoutStartTLS(),
+		ldap.WithCustomIDAttribute("uid"),
+		ldap.WithFirstNameAttribute("givenName"),
+		ldap.WithLastNameAttribute("sn"),
+	)
+}
+
+func testSync(modify func(sync *query.LDAPSync)) *query.LDAPSync {
+	sync := &query.LDAPSync{
+		IDPID:                "idp1",
+		ResourceOwner:        "instance1",
+		OrganizationID:       "org1",
+		Interval:             time.Hour,
+		IncrementalAttribute: domain.LDAPSyncIncrementalAttributeUSNChanged,
+		GroupAttribute:       "memberOf",
+		GroupMappings: []*domain.LDAPSyncGroupMapping{
+			{LDAPGroup: adminsDN, GroupID: "group1"},
+		},
+	}
+	if modify != nil {
+		modify(sync)
+	}
+	return sync
+}
+
+func userWithID(id string) gomock.Matcher {
+	return gomock.Cond(func(user *ldap.User) bool {
+		return user.ID == id
+	})
+}
+
+func TestWorker_Work(t *testing.T) {
+	type fields struct {
+		db       func(*testing.T) Queries
+		commands func(*testing.T) Commands
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "database error, error",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueLDAPSyncs(gomock.Any(), testNow, uint32(100)).Return(nil, errDB)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					return mock.NewMockCommands(gomock.NewController(t))
+				},
+			},
+			wantErr: errDB,
+		},
+		{
+			name: "nothing due, ok",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueLDAPSyncs(gomock.Any(), testNow, uint32(100)).Return([]*query.DueLDAPSync{}, nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					return mock.NewMockCommands(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "idp removed, synchronization removed",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueLDAPSyncs(gomock.Any(), testNow, uint32(100)).Return(due, nil)
+					queries.EXPECT().LDAPSyncByIDPID(gomock.Any(), true, "idp1").Return(nil, zerrors.ThrowNotFound(nil, "id", "not found"))
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().RemoveLDAPSyncOfRemovedIDP(gomock.Any(), "idp1", "instance1").Return(nil)
+					return commands
+				},
+			},
+		},
+		{
+			name: "provider error, failure reported",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueLDAPSyncs(gomock.Any(), testNow, uint32(100)).Return(due, nil)
+					queries.EXPECT().LDAPSyncByIDPID(gomock.Any(), true, "idp1").Return(testSync(nil), nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().LDAPSyncProvider(gomock.Any(), "idp1").Return(nil, errDB)
+					commands.EXPECT().LDAPSyncFailed(gomock.Any(), "idp1", "instance1", testNow, errDB.Error(), testNow.Add(time.Hour)).Return(nil)
+					return commands
+				},
+			},
+		},
+		{
+			name: "full, users created, updated and deactivated",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueLDAPSyncs(gomock.Any(), testNow, uint32(100)).Return(due, nil)
+					queries.EXPECT().LDAPSyncByIDPID(gomock.Any(), true, "idp1").Return(testSync(nil), nil)
+					queries.EXPECT().LDAPSyncedUsers(gomock.Any(), true, "idp1").Return([]*query.LDAPSyncedUser{
+						{UserID: "user1", ExternalUserID: "alice", ResourceOwner: "org1", State: domain.UserStateActive},
+						{UserID: "user3", ExternalUserID: "carol", ResourceOwner: "org1", State: domain.UserStateActive},
+						{UserID: "user4", ExternalUserID: "dave", ResourceOwner: "org1", State: domain.UserStateInactive},
+					}, nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().LDAPSyncProvider(gomock.Any(), "idp1").Return(newTestProvider(t, directory...), nil)
+					commands.EXPECT().UpdateLDAPSyncedUser(gomock.Any(), "user1", "org1", userWithID("alice")).Return(true, false, nil)
+					commands.EXPECT().AddLDAPSyncedUser(gomock.Any(), "org1", "idp1", userWithID("bob")).Return("user2", nil)
+					commands.EXPECT().DeactivateUser(gomock.Any(), "user3", "org1").Return(&domain.ObjectDetails{}, nil)
+					commands.EXPECT().SyncLDAPGroupMembers(gomock.Any(), "group1", []string{"user1"}, []string{"user2", "user3", "user4"}).Return(1, 1, nil)
+					commands.EXPECT().LDAPSyncSucceeded(gomock.Any(), "idp1", "instance1", testNow,
+						&domain.LDAPSyncSummary{Full: true, Created: 1, Updated: 1, Deactivated: 1, GroupsAdded: 1, GroupsRemoved: 1},
+						"12",
+						testNow.Add(time.Hour),
+					).Return(nil)
+					return commands
+				},
+			},
+		},
+		{
+			name: "full, unmapped entry, no users removed",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueLDAPSyncs(gomock.Any(), testNow, uint32(100)).Return(due, nil)
+					queries.EXPECT().LDAPSyncByIDPID(gomock.Any(), true, "idp1").Return(testSync(nil), nil)
+					queries.EXPECT().LDAPSyncedUsers(gomock.Any(), true, "idp1").Return([]*query.LDAPSyncedUser{
+						{UserID: "user1", ExternalUserID: "alice", ResourceOwner: "org1", State: domain.UserStateActive},
+						{UserID: "user3", ExternalUserID: "carol", ResourceOwner: "org1", State: domain.UserStateActive},
+					}, nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().LDAPSyncProvider(gomock.Any(), "idp1").Return(newTestProvider(t,
+						directory[0],
+						&ldaptest.Entry{
+							DN: "cn=carol,ou=people,dc=example,dc=com",
+							Attributes: map[string][]string{
+								"objectClass": {"inetOrgPerson"},
+								"cn":          {"carol"},
+								"uSNChanged":  {"11"},
+							},
+						},
+					), nil)
+					commands.EXPECT().UpdateLDAPSyncedUser(gomock.Any(), "user1", "org1", userWithID("alice")).Return(false, false, nil)
+					commands.EXPECT().SyncLDAPGroupMembers(gomock.Any(), "group1", []string{"user1"}, []string{}).Return(0, 0, nil)
+					commands.EXPECT().LDAPSyncSucceeded(gomock.Any(), "idp1", "instance1", testNow,
+						&domain.LDAPSyncSummary{Full: true, Failed: 1, LastError: "id attribute missing"},
+						"",
+						testNow.Add(time.Hour),
+					).Return(nil)
+					return commands
+				},
+			},
+		},
+		{
+			name: "full, too many users missing, no users removed",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueLDAPSyncs(gomock.Any(), testNow, uint32(100)).Return(due, nil)
+					queries.EXPECT().LDAPSyncByIDPID(gomock.Any(), true, "idp1").Return(testSync(func(sync *query.LDAPSync) {
+						sync.GroupAttribute = ""
+					}), nil)
+					queries.EXPECT().LDAPSyncedUsers(gomock.Any(), true, "idp1").Return([]*query.LDAPSyncedUser{
+						{UserID: "user1", ExternalUserID: "alice", ResourceOwner: "org1", State: domain.UserStateActive},
+						{UserID: "user3", ExternalUserID: "carol", ResourceOwner: "org1", State: domain.UserStateActive},
+						{UserID: "user4", ExternalUserID: "dave", ResourceOwner: "org1", State: domain.UserStateActive},
+					}, nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().LDAPSyncProvider(gomock.Any(), "idp1").Return(newTestProvider(t, directory...), nil)
+					commands.EXPECT().UpdateLDAPSyncedUser(gomock.Any(), "user1", "org1", userWithID("alice")).Return(false, false, nil)
+					commands.EXPECT().AddLDAPSyncedUser(gomock.Any(), "org1", "idp1", userWithID("bob")).Return("user2", nil)
+					commands.EXPECT().LDAPSyncSucceeded(gomock.Any(), "idp1", "instance1", testNow,
+						&domain.LDAPSyncSummary{Full: true, Created: 1, Failed: 1, LastError: errTooManyMissing.Error()},
+						"",
+						testNow.Add(time.Hour),
+					).Return(nil)
+					return commands
+				},
+			},
+		},
+		{
+			name: "incremental, only changed users",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueLDAPSyncs(gomock.Any(), testNow, uint32(100)).Return(due, nil)
+					queries.EXPECT().LDAPSyncByIDPID(gomock.Any(), true, "idp1").Return(testSync(func(sync *query.LDAPSync) {
+						sync.Watermark = "11"
+						sync.FullSyncInterval = 24 * time.Hour
+						sync.LastFullSyncDate = testNow.Add(-time.Hour)
+					}), nil)
+					queries.EXPECT().LDAPSyncedUsers(gomock.Any(), true, "idp1").Return([]*query.LDAPSyncedUser{
+						{UserID: "user2", ExternalUserID: "bob", ResourceOwner: "org1", State: domain.UserStateInactive},
+						{UserID: "user3", ExternalUserID: "carol", ResourceOwner: "org1", State: domain.UserStateActive},
+					}, nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().LDAPSyncProvider(gomock.Any(), "idp1").Return(newTestProvider(t, directory...), nil)
+					commands.EXPECT().UpdateLDAPSyncedUser(gomock.Any(), "user2", "org1", userWithID("bob")).Return(false, true, nil)
+					commands.EXPECT().SyncLDAPGroupMembers(gomock.Any(), "group1", []string{}, []string{"user2"}).Return(0, 0, nil)
+					commands.EXPECT().LDAPSyncSucceeded(gomock.Any(), "idp1", "instance1", testNow,
+						&domain.LDAPSyncSummary{Reactivated: 1},
+						"12",
+						testNow.Add(time.Hour),
+					).Return(nil)
+					return commands
+				},
+			},
+		},
+		{
+			name: "failed user, watermark kept",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueLDAPSyncs(gomock.Any(), testNow, uint32(100)).Return(due, nil)
+					queries.EXPECT().LDAPSyncByIDPID(gomock.Any(), true, "idp1").Return(testSync(func(sync *query.LDAPSync) {
+						sync.Watermark = "9"
+						sync.GroupAttribute = ""
+					}), nil)
+					queries.EXPECT().LDAPSyncedUsers(gomock.Any(), true, "idp1").Return([]*query.LDAPSyncedUser{}, nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().LDAPSyncProvider(gomock.Any(), "idp1").Return(newTestProvider(t, directory...), nil)
+					commands.EXPECT().AddLDAPSyncedUser(gomock.Any(), "org1", "idp1", userWithID("alice")).Return("", errPush)
+					commands.EXPECT().AddLDAPSyncedUser(gomock.Any(), "org1", "idp1", userWithID("bob")).Return("user2", nil)
+					commands.EXPECT().LDAPSyncSucceeded(gomock.Any(), "idp1", "instance1", testNow,
+						&domain.LDAPSyncSummary{Created: 1, Failed: 1, LastError: errPush.Error()},
+						"9",
+						testNow.Add(time.Hour),
+					).Return(nil)
+					return commands
+				},
+			},
+		},
+		{
+			name: "report failed, error",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueLDAPSyncs(gomock.Any(), testNow, uint32(100)).Return(due, nil)
+					queries.EXPECT().LDAPSyncByIDPID(gomock.Any(), true, "idp1").Return(testSync(nil), nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().LDAPSyncProvider(gomock.Any(), "idp1").Return(nil, errDB)
+					commands.EXPECT().LDAPSyncFailed(gomock.Any(), "idp1", "instance1", testNow, errDB.Error(), testNow.Add(time.Hour)).Return(errPush)
+					return commands
+				},
+			},
+			wantErr: errPush,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &Worker{
+				db:       tt.fields.db(t),
+				commands: tt.fields.commands(t),
+				config: &Config{
+					BulkSize:             100,
+					MaxDeactivationShare: 0.5,
+				},
+				now: func() time.Time {
+					return testNow
+				},
+			}
+			err := w.Work(context.Background(), nil)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_isFullSync(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name string
+		sync *query.LDAPSync
+		want bool
+	}{
+		{
+			name: "no incremental attribute",
+			sync: &query.LDAPSync{Watermark: "10"},
+			want: true,
+		},
+		{
+			name: "no watermark",
+			sync: &query.LDAPSync{IncrementalAttribute: domain.LDAPSyncIncrementalAttributeUSNChanged},
+			want: true,
+		},
+		{
+			name: "requested",
+			sync: &query.LDAPSync{IncrementalAttribute: domain.LDAPSyncIncrementalAttributeUSNChanged, Watermark: "10", FullSyncRequested: true},
+			want: true,
+		},
+		{
+			name: "full sync interval passed",
+			sync: &query.LDAPSync{IncrementalAttribute: domain.LDAPSyncIncrementalAttributeUSNChanged, Watermark: "10", FullSyncInterval: time.Hour, LastFullSyncDate: now.Add(-time.Hour)},
+			want: true,
+		},
+		{
+			name: "full sync interval not passed",
+			sync: &query.LDAPSync{IncrementalAttribute: domain.LDAPSyncIncrementalAttributeUSNChanged, Watermark: "10", FullSyncInterval: time.Hour, LastFullSyncDate: now.Add(-time.Minute)},
+			want: false,
+		},
+		{
+			name: "incremental",
+			sync: &query.LDAPSync{IncrementalAttribute: domain.LDAPSyncIncrementalAttributeUSNChanged, Watermark: "10"},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isFullSync(tt.sync, now))
+		})
+	}
+}
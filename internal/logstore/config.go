@@ -1,14 +1,74 @@
 package logstore
 
+import (
+	"time"
+)
+
 type Configs struct {
 	Access    *Config
 	Execution *Config
+	Security  *Config
 }
 
 type Config struct {
 	Stdout *StdConfig
+	Syslog *SyslogConfig
 }
 
 type StdConfig struct {
 	Enabled bool
 }
+
+// SyslogConfig forwards the log records to a syslog server, for example to ingest them into a SIEM.
+type SyslogConfig struct {
+	Enabled      bool
+	Debounce     *DebouncerConfig
+	SyslogTarget `mapstructure:",squash"`
+	// Instances overrides the default target for the instances with the given IDs.
+	Instances map[string]*SyslogTarget
+}
+
+type SyslogTarget struct {
+	// Network is either udp, tcp or tls.
+	// Records of instances without an address are not forwarded.
+	Network string
+	Address string
+	// Format is either rfc5424 or cef.
+	Format       string
+	Facility     uint8
+	Hostname     string
+	AppName      string
+	WriteTimeout time.Duration
+	TLS          *SyslogTLSConfig
+}
+
+type SyslogTLSConfig struct {
+	// CAPath is the path to the PEM encoded certificates of the CAs the server certificate is verified against.
+	// The system certificate pool is used if empty.
+	CAPath             string
+	InsecureSkipVerify bool
+}
+
+// EmitterConfig returns the configuration for the emitter forwarding the records to syslog.
+func (c *SyslogConfig) EmitterConfig() *EmitterConfig {
+	if c == nil {
+		return nil
+	}
+	return &EmitterConfig{
+		Enabled:  c.Enabled,
+		Debounce: c.Debounce,
+	}
+}
+
+// Target returns the syslog target of the instance.
+// Nil is returned if the records of the instance are not forwarded.
+func (c *SyslogConfig) Target(instanceID string) *SyslogTarget {
+	target := &c.SyslogTarget
+	if instanceTarget, ok := c.Instances[instanceID]; ok {
+		target = instanceTarget
+	}
+	if target == nil || target.Address == "" {
+		return nil
+	}
+	return target
+}
@@ -30,15 +30,23 @@ type debouncer[T LogRecord[T]] struct {
 	clock             clock.Clock
 	ticker            *clock.Ticker
 	mux               sync.Mutex
+	shipMux           sync.Mutex
 	cfg               DebouncerConfig
 	storage           bulkSink[T]
 	cache             []T
 	cacheLen          uint
+	dropped           uint
+	// shipPending is set while a ship started by add waits for the previous bulk to be sent,
+	// so a slow sink doesn't pile up go routines.
+	shipPending bool
 }
 
 type DebouncerConfig struct {
 	MinFrequency time.Duration
 	MaxBulkSize  uint
+	// MaxBufferSize limits the records waiting to be shipped.
+	// If a sink can't keep up, further records are dropped instead of piling up in memory.
+	MaxBufferSize uint
 }
 
 func newDebouncer[T LogRecord[T]](binarySignaledCtx context.Context, cfg DebouncerConfig, clock clock.Clock, ship bulkSink[T]) *debouncer[T] {
@@ -59,28 +67,44 @@ func newDebouncer[T LogRecord[T]](binarySignaledCtx context.Context, cfg Debounc
 func (d *debouncer[T]) add(item T) {
 	d.mux.Lock()
 	defer d.mux.Unlock()
+	if d.cfg.MaxBufferSize > 0 && d.cacheLen >= d.cfg.MaxBufferSize {
+		d.dropped++
+		return
+	}
 	d.cache = append(d.cache, item)
 	d.cacheLen++
-	if d.cfg.MaxBulkSize > 0 && d.cacheLen >= d.cfg.MaxBulkSize {
+	if d.cfg.MaxBulkSize > 0 && d.cacheLen >= d.cfg.MaxBulkSize && !d.shipPending {
+		d.shipPending = true
 		// Add should not block and release the lock
 		go d.ship()
 	}
 }
 
 func (d *debouncer[T]) ship() {
+	// the cache is released before the bulk is sent, so a slow sink doesn't block adding records
+	d.shipMux.Lock()
+	defer d.shipMux.Unlock()
+	d.mux.Lock()
+	d.shipPending = false
 	if d.cacheLen == 0 {
+		d.mux.Unlock()
 		return
 	}
-	d.mux.Lock()
-	defer d.mux.Unlock()
-	if err := d.storage.SendBulk(d.binarySignaledCtx, d.cache); err != nil {
-		logging.WithError(err).WithField("size", len(d.cache)).Error("storing bulk failed")
-	}
+	bulk, dropped := d.cache, d.dropped
 	d.cache = nil
 	d.cacheLen = 0
+	d.dropped = 0
 	if d.cfg.MinFrequency > 0 {
 		d.ticker.Reset(d.cfg.MinFrequency)
 	}
+	d.mux.Unlock()
+
+	if dropped > 0 {
+		logging.WithFields("dropped", dropped, "size", d.cfg.MaxBufferSize).Warn("buffer exhausted, log records dropped")
+	}
+	if err := d.storage.SendBulk(d.binarySignaledCtx, bulk); err != nil {
+		logging.WithError(err).WithField("size", len(bulk)).Error("storing bulk failed")
+	}
 }
 
 func (d *debouncer[T]) shipOnTicks() {
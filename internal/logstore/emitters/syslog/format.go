@@ -0,0 +1,213 @@
+package syslog
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FormatRFC5424 = "rfc5424"
+	FormatCEF     = "cef"
+
+	// structuredDataID identifies the structured data element of the RFC 5424 messages.
+	// 32473 is the private enterprise number reserved for documentation (RFC 5612).
+	structuredDataID = "zitadel@32473"
+	nilValue         = "-"
+	cefVendor        = "ZITADEL"
+	cefProduct       = "ZITADEL"
+	// cefCustomStrings is the amount of the custom string extensions cs1 to cs6.
+	cefCustomStrings = 6
+)
+
+// Severity of the message as defined by RFC 5424.
+type Severity uint8
+
+const (
+	SeverityEmergency Severity = iota
+	SeverityAlert
+	SeverityCritical
+	SeverityError
+	SeverityWarning
+	SeverityNotice
+	SeverityInformational
+	SeverityDebug
+)
+
+// cefSeverity maps the syslog severity to the CEF severity between 0 and 10.
+func (s Severity) cefSeverity() int {
+	switch s {
+	case SeverityEmergency:
+		return 10
+	case SeverityAlert, SeverityCritical:
+		return 9
+	case SeverityError:
+		return 8
+	case SeverityWarning:
+		return 6
+	case SeverityNotice:
+		return 4
+	case SeverityInformational:
+		return 3
+	default:
+		return 1
+	}
+}
+
+// Message is the format independent representation of a log record.
+type Message struct {
+	Time     time.Time
+	Severity Severity
+	// ID identifies the kind of the record,
+	// it's used as MSGID of RFC 5424 messages and as signature ID of CEF messages.
+	ID         string
+	Name       string
+	Text       string
+	InstanceID string
+	Fields     []Field
+}
+
+type Field struct {
+	// Key is the name of the RFC 5424 structured data parameter.
+	Key string
+	// CEFKey is the key of the CEF extension.
+	// If empty, the field is sent as custom string labeled with the Key.
+	CEFKey string
+	Value  string
+}
+
+// header contains the parts of the RFC 5424 header, which don't change between messages.
+type header struct {
+	facility uint8
+	hostname string
+	appName  string
+	procID   string
+}
+
+func (h *header) write(b *strings.Builder, msg *Message) {
+	b.WriteString("<")
+	b.WriteString(strconv.Itoa(int(h.facility)*8 + int(msg.Severity)))
+	b.WriteString(">1 ")
+	b.WriteString(msg.Time.UTC().Format("2006-01-02T15:04:05.000000Z07:00"))
+	b.WriteString(" ")
+	b.WriteString(headerValue(h.hostname, 255))
+	b.WriteString(" ")
+	b.WriteString(headerValue(h.appName, 48))
+	b.WriteString(" ")
+	b.WriteString(headerValue(h.procID, 128))
+	b.WriteString(" ")
+	b.WriteString(headerValue(msg.ID, 32))
+	b.WriteString(" ")
+}
+
+// formatRFC5424 formats the message with its fields as structured data.
+func formatRFC5424(h *header, msg *Message) []byte {
+	b := new(strings.Builder)
+	h.write(b, msg)
+	b.WriteString("[")
+	b.WriteString(structuredDataID)
+	writeParam(b, "instanceId", msg.InstanceID)
+	for _, field := range msg.Fields {
+		writeParam(b, field.Key, field.Value)
+	}
+	b.WriteString("]")
+	text := msg.Text
+	if text == "" {
+		text = msg.Name
+	}
+	if text != "" {
+		b.WriteString(" ")
+		b.WriteString(text)
+	}
+	return []byte(b.String())
+}
+
+func writeParam(b *strings.Builder, key, value string) {
+	if value == "" {
+		return
+	}
+	b.WriteString(" ")
+	b.WriteString(key)
+	b.WriteString(`="`)
+	b.WriteString(paramValueReplacer.Replace(value))
+	b.WriteString(`"`)
+}
+
+// formatCEF formats the message as ArcSight CEF and sends it as the message of a RFC 5424 message without structured data.
+func formatCEF(h *header, version string, msg *Message) []byte {
+	b := new(strings.Builder)
+	h.write(b, msg)
+	b.WriteString(nilValue)
+	b.WriteString(" CEF:0|")
+	b.WriteString(cefHeaderReplacer.Replace(cefVendor))
+	b.WriteString("|")
+	b.WriteString(cefHeaderReplacer.Replace(cefProduct))
+	b.WriteString("|")
+	b.WriteString(cefHeaderReplacer.Replace(version))
+	b.WriteString("|")
+	b.WriteString(cefHeaderReplacer.Replace(msg.ID))
+	b.WriteString("|")
+	b.WriteString(cefHeaderReplacer.Replace(msg.Name))
+	b.WriteString("|")
+	b.WriteString(strconv.Itoa(msg.Severity.cefSeverity()))
+	b.WriteString("|")
+	b.WriteString("rt=")
+	b.WriteString(strconv.FormatInt(msg.Time.UnixMilli(), 10))
+	writeExtension(b, "msg", msg.Text)
+
+	customStrings := 0
+	writeCustomString := func(label, value string) {
+		if value == "" || customStrings == cefCustomStrings {
+			return
+		}
+		customStrings++
+		key := "cs" + strconv.Itoa(customStrings)
+		writeExtension(b, key+"Label", label)
+		writeExtension(b, key, value)
+	}
+	writeCustomString("instanceId", msg.InstanceID)
+	for _, field := range msg.Fields {
+		if field.CEFKey != "" {
+			writeExtension(b, field.CEFKey, field.Value)
+			continue
+		}
+		writeCustomString(field.Key, field.Value)
+	}
+	return []byte(b.String())
+}
+
+func writeExtension(b *strings.Builder, key, value string) {
+	if value == "" {
+		return
+	}
+	b.WriteString(" ")
+	b.WriteString(key)
+	b.WriteString("=")
+	b.WriteString(cefExtensionReplacer.Replace(value))
+}
+
+var (
+	// paramValueReplacer escapes the characters of structured data parameter values as defined by RFC 5424.
+	paramValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+	// cefHeaderReplacer and cefExtensionReplacer escape the characters as defined by the CEF specification.
+	cefHeaderReplacer    = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+	cefExtensionReplacer = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r\n", `\n`, "\n", `\n`, "\r", `\r`)
+)
+
+// headerValue returns the value as a RFC 5424 header field,
+// which must consist of printable US-ASCII characters without spaces.
+func headerValue(value string, maxLength int) string {
+	value = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+		return r
+	}, value)
+	if value == "" {
+		return nilValue
+	}
+	if len(value) > maxLength {
+		return value[:maxLength]
+	}
+	return value
+}
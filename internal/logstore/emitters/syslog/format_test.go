@@ -0,0 +1,123 @@
+package syslog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testHeader = &header{
+	facility: 13,
+	hostname: "zitadel-0",
+	appName:  "zitadel",
+	procID:   "1",
+}
+
+func Test_formatRFC5424(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *Message
+		want string
+	}{
+		{
+			name: "fields",
+			msg: &Message{
+				Time:       time.Date(2025, 1, 2, 3, 4, 5, 6000, time.UTC),
+				Severity:   SeverityNotice,
+				ID:         "login_failed",
+				Name:       "Login failed",
+				InstanceID: "instance",
+				Fields: []Field{
+					{Key: "userId", CEFKey: "duid", Value: "user"},
+					{Key: "ip", CEFKey: "src", Value: ""},
+				},
+			},
+			want: `<109>1 2025-01-02T03:04:05.000006Z zitadel-0 zitadel 1 login_failed [zitadel@32473 instanceId="instance" userId="user"] Login failed`,
+		},
+		{
+			name: "escaped values",
+			msg: &Message{
+				Time:       time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+				Severity:   SeverityWarning,
+				ID:         "execution",
+				Text:       "log message",
+				InstanceID: "instance",
+				Fields: []Field{
+					{Key: "actionId", Value: `a"b\c]d`},
+				},
+			},
+			want: `<108>1 2025-01-02T03:04:05.000000Z zitadel-0 zitadel 1 execution [zitadel@32473 instanceId="instance" actionId="a\"b\\c\]d"] log message`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, string(formatRFC5424(testHeader, tt.msg)))
+		})
+	}
+}
+
+func Test_formatCEF(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *Message
+		want string
+	}{
+		{
+			name: "fields",
+			msg: &Message{
+				Time:       time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+				Severity:   SeverityWarning,
+				ID:         "lockout",
+				Name:       "User locked",
+				InstanceID: "instance",
+				Fields: []Field{
+					{Key: "userId", CEFKey: "duid", Value: "user"},
+					{Key: "organizationId", Value: "org"},
+				},
+			},
+			want: `<108>1 2025-01-02T03:04:05.000000Z zitadel-0 zitadel 1 lockout - CEF:0|ZITADEL|ZITADEL|v1.0.0|lockout|User locked|6|rt=1735787045000 cs1Label=instanceId cs1=instance duid=user cs2Label=organizationId cs2=org`,
+		},
+		{
+			name: "escaped values",
+			msg: &Message{
+				Time:     time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+				Severity: SeverityError,
+				ID:       "execution",
+				Name:     "Action|executed",
+				Text:     "a=b\\c\nd",
+			},
+			want: `<107>1 2025-01-02T03:04:05.000000Z zitadel-0 zitadel 1 execution - CEF:0|ZITADEL|ZITADEL|v1.0.0|execution|Action\|executed|8|rt=1735787045000 msg=a\=b\\c\nd`,
+		},
+		{
+			name: "custom strings exceeded",
+			msg: &Message{
+				Time:       time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+				Severity:   SeverityInformational,
+				ID:         "access",
+				Name:       "Request handled",
+				InstanceID: "instance",
+				Fields: []Field{
+					{Key: "a", Value: "a"},
+					{Key: "b", Value: "b"},
+					{Key: "c", Value: "c"},
+					{Key: "d", Value: "d"},
+					{Key: "e", Value: "e"},
+					{Key: "f", Value: "f"},
+				},
+			},
+			want: `<110>1 2025-01-02T03:04:05.000000Z zitadel-0 zitadel 1 access - CEF:0|ZITADEL|ZITADEL|v1.0.0|access|Request handled|3|rt=1735787045000 cs1Label=instanceId cs1=instance cs2Label=a cs2=a cs3Label=b cs3=b cs4Label=c cs4=c cs5Label=d cs5=d cs6Label=e cs6=e`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, string(formatCEF(testHeader, "v1.0.0", tt.msg)))
+		})
+	}
+}
+
+func Test_headerValue(t *testing.T) {
+	assert.Equal(t, "-", headerValue("", 10))
+	assert.Equal(t, "my_host", headerValue("my host", 10))
+	assert.Equal(t, "abc", headerValue("abcdef", 3))
+}
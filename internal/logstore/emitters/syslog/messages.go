@@ -0,0 +1,137 @@
+package syslog
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/logstore/record"
+)
+
+// AccessLogMessage maps an access log to a syslog message.
+func AccessLogMessage(log *record.AccessLog) *Message {
+	msg := &Message{
+		Time:       log.LogDate,
+		Severity:   SeverityInformational,
+		ID:         "access",
+		Name:       "Request handled",
+		InstanceID: log.InstanceID,
+	}
+	protocol, status := "grpc", strconv.FormatUint(uint64(log.ResponseStatus), 10)
+	switch log.Protocol {
+	case record.HTTP:
+		protocol = "http"
+		if log.ResponseStatus >= http.StatusInternalServerError {
+			msg.Severity = SeverityWarning
+		}
+	case record.GRPC:
+		switch codes.Code(log.ResponseStatus) {
+		case codes.Internal, codes.Unknown, codes.Unavailable, codes.DataLoss:
+			msg.Severity = SeverityWarning
+		}
+		status = codes.Code(log.ResponseStatus).String()
+	}
+	msg.Fields = []Field{
+		{Key: "protocol", CEFKey: "app", Value: protocol},
+		{Key: "requestUrl", CEFKey: "request", Value: log.RequestURL},
+		{Key: "responseStatus", Value: status},
+		{Key: "requestedHost", CEFKey: "dhost", Value: log.RequestedHost},
+		{Key: "requestedDomain", Value: log.RequestedDomain},
+		{Key: "projectId", Value: log.ProjectID},
+		{Key: "ip", CEFKey: "src", Value: forwardedFor(log.RequestHeaders)},
+		{Key: "userAgent", CEFKey: "requestClientApplication", Value: firstHeaderValue(log.RequestHeaders, "user-agent")},
+	}
+	return msg
+}
+
+// forwardedFor returns the client IP added by the proxies in front of ZITADEL.
+func forwardedFor(headers map[string][]string) string {
+	ip, _, _ := strings.Cut(firstHeaderValue(headers, "x-forwarded-for"), ",")
+	if ip = strings.TrimSpace(ip); ip != "" {
+		return ip
+	}
+	return firstHeaderValue(headers, "x-real-ip")
+}
+
+// firstHeaderValue expects the normalized headers of the access log, which have lower case keys.
+func firstHeaderValue(headers map[string][]string, key string) string {
+	if values := headers[key]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// ExecutionLogMessage maps an execution log of an action to a syslog message.
+func ExecutionLogMessage(log *record.ExecutionLog) *Message {
+	msg := &Message{
+		Time:       log.LogDate,
+		Severity:   SeverityInformational,
+		ID:         "execution",
+		Name:       "Action executed",
+		Text:       log.Message,
+		InstanceID: log.InstanceID,
+		Fields: []Field{
+			{Key: "actionId", Value: log.ActionID},
+			{Key: "took", Value: log.Took.String()},
+		},
+	}
+	switch log.LogLevel {
+	case logrus.PanicLevel, logrus.FatalLevel, logrus.ErrorLevel:
+		msg.Severity = SeverityError
+	case logrus.WarnLevel:
+		msg.Severity = SeverityWarning
+	case logrus.DebugLevel, logrus.TraceLevel:
+		msg.Severity = SeverityDebug
+	}
+	return msg
+}
+
+// SecurityEventMessage maps a security event to a syslog message.
+func SecurityEventMessage(event *record.SecurityEvent) *Message {
+	msg := &Message{
+		Time:       event.LogDate,
+		Severity:   SeverityNotice,
+		ID:         string(event.Category),
+		InstanceID: event.InstanceID,
+		Fields: []Field{
+			{Key: "eventType", CEFKey: "act", Value: event.EventType},
+			{Key: "actorId", CEFKey: "suid", Value: event.ActorID},
+			{Key: "userId", CEFKey: "duid", Value: event.UserID},
+			{Key: "ip", CEFKey: "src", Value: event.IP},
+			{Key: "userAgent", CEFKey: "requestClientApplication", Value: event.UserAgent},
+			{Key: "organizationId", Value: event.OrganizationID},
+			{Key: "resourceType", Value: event.ResourceType},
+			{Key: "resourceId", Value: event.ResourceID},
+			{Key: "roles", Value: strings.Join(event.Roles, ",")},
+		},
+	}
+	switch event.Category {
+	case record.SecurityEventCategoryLoginFailed:
+		msg.Name = "Login failed"
+		msg.Fields = append(msg.Fields, Field{Key: "outcome", CEFKey: "outcome", Value: "failure"})
+	case record.SecurityEventCategoryLockout:
+		msg.Name = "User locked"
+		msg.Severity = SeverityWarning
+	case record.SecurityEventCategoryMembershipChanged:
+		msg.Name = "Administrator membership changed"
+	case record.SecurityEventCategoryRiskDetected:
+		msg.Name = "Risk detected"
+		if event.Risk == nil {
+			break
+		}
+		if event.Risk.Action == domain.RiskActionRequireMFA.String() || event.Risk.Action == domain.RiskActionBlock.String() {
+			msg.Severity = SeverityWarning
+		}
+		msg.Fields = append(msg.Fields,
+			Field{Key: "riskScore", Value: strconv.FormatUint(uint64(event.Risk.Score), 10)},
+			Field{Key: "riskAction", Value: event.Risk.Action},
+			Field{Key: "riskSignals", Value: strings.Join(event.Risk.Signals, ",")},
+			Field{Key: "country", Value: event.Risk.Country},
+		)
+	}
+	return msg
+}
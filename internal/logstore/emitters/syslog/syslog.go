@@ -0,0 +1,219 @@
+package syslog
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/zitadel/zitadel/cmd/build"
+	"github.com/zitadel/zitadel/internal/logstore"
+)
+
+const (
+	NetworkUDP = "udp"
+	NetworkTCP = "tcp"
+	NetworkTLS = "tls"
+
+	defaultAppName      = "zitadel"
+	defaultWriteTimeout = 5 * time.Second
+)
+
+type syslogEmitter[T logstore.LogRecord[T]] struct {
+	cfg      *logstore.SyslogConfig
+	message  func(T) *Message
+	hostname string
+	version  string
+
+	mux     sync.Mutex
+	writers map[*logstore.SyslogTarget]*writer
+}
+
+// NewSyslogEmitter forwards the records to the syslog target of their instance.
+// The message maps the records to the format independent syslog message.
+func NewSyslogEmitter[T logstore.LogRecord[T]](cfg *logstore.SyslogConfig, message func(T) *Message) (logstore.LogEmitter[T], error) {
+	if cfg != nil && cfg.Enabled {
+		if err := validateTarget(&cfg.SyslogTarget); err != nil {
+			return nil, err
+		}
+		for instanceID, target := range cfg.Instances {
+			if err := validateTarget(target); err != nil {
+				return nil, fmt.Errorf("syslog target of instance %s: %w", instanceID, err)
+			}
+		}
+	}
+	hostname, _ := os.Hostname()
+	return &syslogEmitter[T]{
+		cfg:      cfg,
+		message:  message,
+		hostname: hostname,
+		version:  build.Version(),
+		writers:  make(map[*logstore.SyslogTarget]*writer),
+	}, nil
+}
+
+func validateTarget(target *logstore.SyslogTarget) error {
+	if target == nil || target.Address == "" {
+		return nil
+	}
+	switch target.Network {
+	case NetworkUDP, NetworkTCP, NetworkTLS:
+	default:
+		return fmt.Errorf("syslog network %q is not supported, use %s, %s or %s", target.Network, NetworkUDP, NetworkTCP, NetworkTLS)
+	}
+	switch target.Format {
+	case FormatRFC5424, FormatCEF:
+	default:
+		return fmt.Errorf("syslog format %q is not supported, use %s or %s", target.Format, FormatRFC5424, FormatCEF)
+	}
+	if target.Facility > 23 {
+		return fmt.Errorf("syslog facility %d is invalid, it must be between 0 and 23", target.Facility)
+	}
+	return nil
+}
+
+// Emit writes the records to their targets.
+// After a failed dial or write, the remaining records of the target are skipped for this bulk,
+// so an unreachable target doesn't block the bulk with a timeout per record.
+func (e *syslogEmitter[T]) Emit(ctx context.Context, bulk []T) (err error) {
+	failed := make(map[*logstore.SyslogTarget]int)
+	for _, record := range bulk {
+		msg := e.message(record)
+		target := e.cfg.Target(msg.InstanceID)
+		if target == nil {
+			continue
+		}
+		if _, ok := failed[target]; ok {
+			failed[target]++
+			continue
+		}
+		if writeErr := e.writer(target).write(ctx, msg); writeErr != nil {
+			failed[target] = 0
+			err = errors.Join(err, writeErr)
+		}
+	}
+	for target, skipped := range failed {
+		if skipped > 0 {
+			err = errors.Join(err, fmt.Errorf("%d records for syslog target %s skipped", skipped, target.Address))
+		}
+	}
+	return err
+}
+
+func (e *syslogEmitter[T]) writer(target *logstore.SyslogTarget) *writer {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	w, ok := e.writers[target]
+	if !ok {
+		w = newWriter(target, e.hostname, e.version)
+		e.writers[target] = w
+	}
+	return w
+}
+
+// writer sends the messages over a single connection to the syslog server,
+// which is reestablished on the next message after a failed write.
+type writer struct {
+	target  *logstore.SyslogTarget
+	header  *header
+	version string
+
+	mux  sync.Mutex
+	conn net.Conn
+}
+
+func newWriter(target *logstore.SyslogTarget, hostname, version string) *writer {
+	if target.Hostname != "" {
+		hostname = target.Hostname
+	}
+	appName := target.AppName
+	if appName == "" {
+		appName = defaultAppName
+	}
+	return &writer{
+		target: target,
+		header: &header{
+			facility: target.Facility,
+			hostname: hostname,
+			appName:  appName,
+			procID:   strconv.Itoa(os.Getpid()),
+		},
+		version: version,
+	}
+}
+
+func (w *writer) write(ctx context.Context, msg *Message) (err error) {
+	var data []byte
+	switch w.target.Format {
+	case FormatCEF:
+		data = formatCEF(w.header, w.version, msg)
+	default:
+		data = formatRFC5424(w.header, msg)
+	}
+	// stream transports use the octet counting framing of RFC 6587 and RFC 5425
+	if w.target.Network != NetworkUDP {
+		data = append([]byte(strconv.Itoa(len(data))+" "), data...)
+	}
+
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	if w.conn == nil {
+		if w.conn, err = w.dial(ctx); err != nil {
+			return err
+		}
+	}
+	if err = w.conn.SetWriteDeadline(time.Now().Add(w.timeout())); err == nil {
+		_, err = w.conn.Write(data)
+	}
+	if err != nil {
+		w.conn.Close()
+		w.conn = nil
+	}
+	return err
+}
+
+func (w *writer) dial(ctx context.Context) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: w.timeout()}
+	if w.target.Network != NetworkTLS {
+		return dialer.DialContext(ctx, w.target.Network, w.target.Address)
+	}
+	tlsConfig, err := w.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+	tlsDialer := &tls.Dialer{NetDialer: dialer, Config: tlsConfig}
+	return tlsDialer.DialContext(ctx, NetworkTCP, w.target.Address)
+}
+
+func (w *writer) tlsConfig() (*tls.Config, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if w.target.TLS == nil {
+		return config, nil
+	}
+	config.InsecureSkipVerify = w.target.TLS.InsecureSkipVerify
+	if w.target.TLS.CAPath == "" {
+		return config, nil
+	}
+	certs, err := os.ReadFile(w.target.TLS.CAPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read syslog CAs: %w", err)
+	}
+	config.RootCAs = x509.NewCertPool()
+	if !config.RootCAs.AppendCertsFromPEM(certs) {
+		return nil, fmt.Errorf("no syslog CA found in %s", w.target.TLS.CAPath)
+	}
+	return config, nil
+}
+
+func (w *writer) timeout() time.Duration {
+	if w.target.WriteTimeout > 0 {
+		return w.target.WriteTimeout
+	}
+	return defaultWriteTimeout
+}
@@ -0,0 +1,41 @@
+package syslog
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/logstore"
+	emittermock "github.com/zitadel/zitadel/internal/logstore/mock"
+)
+
+func Test_syslogEmitter_Emit_unreachableTarget(t *testing.T) {
+	listener, err := net.Listen(NetworkTCP, "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	emitter, err := NewSyslogEmitter(&logstore.SyslogConfig{
+		Enabled: true,
+		SyslogTarget: logstore.SyslogTarget{
+			Network:      NetworkTCP,
+			Address:      address,
+			Format:       FormatRFC5424,
+			WriteTimeout: time.Second,
+		},
+	}, func(*emittermock.Record) *Message {
+		return &Message{Time: time.Now(), Severity: SeverityNotice, ID: "test", InstanceID: "instance"}
+	})
+	require.NoError(t, err)
+
+	clock := clock.NewMock()
+	bulk := []*emittermock.Record{emittermock.NewRecord(clock), emittermock.NewRecord(clock), emittermock.NewRecord(clock)}
+	err = emitter.Emit(context.Background(), bulk)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "2 records for syslog target "+address+" skipped")
+}
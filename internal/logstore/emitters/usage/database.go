@@ -0,0 +1,177 @@
+package usage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/logstore"
+	"github.com/zitadel/zitadel/internal/logstore/record"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/quota"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+var _ logstore.UsageStorer[*record.UsageLog] = (*databaseLogStorage)(nil)
+
+type databaseLogStorage struct {
+	dbClient *database.DB
+	commands *command.Commands
+	queries  *query.Queries
+	unit     quota.Unit
+	// orgOnly is true if the usage of the instance is already recorded by another storage
+	orgOnly bool
+}
+
+// NewDatabaseLogStorage returns a storage which increments the usage of the passed unit.
+// Records with an organization also increment the usage of the organization's quota.
+// For [quota.UsersAllActive] only distinct users are counted per quota period.
+func NewDatabaseLogStorage(dbClient *database.DB, commands *command.Commands, queries *query.Queries, unit quota.Unit) *databaseLogStorage {
+	return &databaseLogStorage{dbClient: dbClient, commands: commands, queries: queries, unit: unit}
+}
+
+// NewOrgDatabaseLogStorage returns a storage which only increments the usage of the organization quotas of the passed unit.
+func NewOrgDatabaseLogStorage(dbClient *database.DB, commands *command.Commands, queries *query.Queries, unit quota.Unit) *databaseLogStorage {
+	return &databaseLogStorage{dbClient: dbClient, commands: commands, queries: queries, unit: unit, orgOnly: true}
+}
+
+func (l *databaseLogStorage) QuotaUnit() quota.Unit {
+	return l.unit
+}
+
+func (l *databaseLogStorage) Emit(ctx context.Context, bulk []*record.UsageLog) error {
+	if len(bulk) == 0 {
+		return nil
+	}
+	var err error
+	if !l.orgOnly {
+		err = l.incrementUsage(ctx, bulk)
+	}
+	return errors.Join(err, l.incrementOrgUsage(ctx, bulk))
+}
+
+func (l *databaseLogStorage) incrementUsage(ctx context.Context, bulk []*record.UsageLog) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	byInstance := make(map[string][]*record.UsageLog)
+	for _, r := range bulk {
+		if r.InstanceID != "" {
+			byInstance[r.InstanceID] = append(byInstance[r.InstanceID], r)
+		}
+	}
+	for instanceID, instanceBulk := range byInstance {
+		q, getQuotaErr := l.queries.GetQuota(ctx, instanceID, l.unit)
+		if errors.Is(getQuotaErr, sql.ErrNoRows) {
+			continue
+		}
+		err = errors.Join(err, getQuotaErr)
+		if getQuotaErr != nil {
+			continue
+		}
+		sum, incrementErr := l.incrementUsageFromUsageLogs(ctx, instanceID, q.CurrentPeriodStart, instanceBulk)
+		err = errors.Join(err, incrementErr)
+		if incrementErr != nil {
+			continue
+		}
+		notifications, getNotificationErr := l.queries.GetDueQuotaNotifications(ctx, instanceID, l.unit, q, q.CurrentPeriodStart, sum)
+		err = errors.Join(err, getNotificationErr)
+		if getNotificationErr != nil || len(notifications) == 0 {
+			continue
+		}
+		ctx = authz.WithInstanceID(ctx, instanceID)
+		reportErr := l.commands.ReportQuotaUsage(ctx, notifications)
+		err = errors.Join(err, reportErr)
+		if reportErr != nil {
+			continue
+		}
+	}
+	return err
+}
+
+type orgKey struct {
+	instanceID string
+	orgID      string
+}
+
+func (l *databaseLogStorage) incrementOrgUsage(ctx context.Context, bulk []*record.UsageLog) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	byOrg := make(map[orgKey][]*record.UsageLog)
+	for _, r := range bulk {
+		if r.InstanceID != "" && r.OrgID != "" {
+			key := orgKey{instanceID: r.InstanceID, orgID: r.OrgID}
+			byOrg[key] = append(byOrg[key], r)
+		}
+	}
+	for key, orgBulk := range byOrg {
+		q, getQuotaErr := l.queries.GetOrgQuota(ctx, key.instanceID, key.orgID, l.unit)
+		if errors.Is(getQuotaErr, sql.ErrNoRows) {
+			continue
+		}
+		err = errors.Join(err, getQuotaErr)
+		if getQuotaErr != nil {
+			continue
+		}
+		sum, incrementErr := l.incrementOrgUsageFromUsageLogs(ctx, key, q.CurrentPeriodStart, orgBulk)
+		err = errors.Join(err, incrementErr)
+		if incrementErr != nil {
+			continue
+		}
+		notifications, getNotificationErr := l.queries.GetDueOrgQuotaNotifications(ctx, key.instanceID, key.orgID, l.unit, q, q.CurrentPeriodStart, sum)
+		err = errors.Join(err, getNotificationErr)
+		if getNotificationErr != nil || len(notifications) == 0 {
+			continue
+		}
+		reportErr := l.commands.ReportQuotaUsage(authz.WithInstanceID(ctx, key.instanceID), notifications)
+		err = errors.Join(err, reportErr)
+	}
+	return err
+}
+
+func (l *databaseLogStorage) incrementOrgUsageFromUsageLogs(ctx context.Context, key orgKey, periodStart time.Time, records []*record.UsageLog) (sum uint64, err error) {
+	if l.unit == quota.UsersAllActive {
+		return projection.OrgQuotaProjection.IncrementActiveUsers(ctx, key.instanceID, key.orgID, periodStart, distinctUserIDs(records))
+	}
+	var count uint64
+	for _, r := range records {
+		count += r.Count
+	}
+	return projection.OrgQuotaProjection.IncrementUsage(ctx, l.unit, key.instanceID, key.orgID, periodStart, count)
+}
+
+func (l *databaseLogStorage) incrementUsageFromUsageLogs(ctx context.Context, instanceID string, periodStart time.Time, records []*record.UsageLog) (sum uint64, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if l.unit == quota.UsersAllActive {
+		return projection.QuotaProjection.IncrementActiveUsers(ctx, instanceID, periodStart, distinctUserIDs(records))
+	}
+	var count uint64
+	for _, r := range records {
+		count += r.Count
+	}
+	return projection.QuotaProjection.IncrementUsage(ctx, l.unit, instanceID, periodStart, count)
+}
+
+func distinctUserIDs(records []*record.UsageLog) []string {
+	seen := make(map[string]struct{}, len(records))
+	userIDs := make([]string, 0, len(records))
+	for _, r := range records {
+		if r.UserID == "" {
+			continue
+		}
+		if _, ok := seen[r.UserID]; ok {
+			continue
+		}
+		seen[r.UserID] = struct{}{}
+		userIDs = append(userIDs, r.UserID)
+	}
+	return userIDs
+}
@@ -0,0 +1,46 @@
+package record
+
+import (
+	"time"
+)
+
+// SecurityEvent is a security relevant event, which is forwarded to external systems like a SIEM.
+type SecurityEvent struct {
+	LogDate        time.Time             `json:"logDate"`
+	Category       SecurityEventCategory `json:"category"`
+	EventType      string                `json:"eventType"`
+	InstanceID     string                `json:"instanceId"`
+	OrganizationID string                `json:"organizationId,omitempty"`
+	// ActorID is the user who caused the event, it's empty if the event was caused by the system.
+	ActorID string `json:"actorId,omitempty"`
+	// UserID is the affected user, like the user who failed to log in or the added member.
+	UserID       string   `json:"userId,omitempty"`
+	ResourceType string   `json:"resourceType"`
+	ResourceID   string   `json:"resourceId"`
+	Roles        []string `json:"roles,omitempty"`
+	IP           string   `json:"ip,omitempty"`
+	UserAgent    string   `json:"userAgent,omitempty"`
+	// Risk is only set for the detected risks of session checks.
+	Risk *SecurityEventRisk `json:"risk,omitempty"`
+}
+
+type SecurityEventRisk struct {
+	Score   uint32   `json:"score"`
+	Signals []string `json:"signals,omitempty"`
+	Action  string   `json:"action"`
+	Country string   `json:"country,omitempty"`
+}
+
+type SecurityEventCategory string
+
+const (
+	SecurityEventCategoryLoginFailed       SecurityEventCategory = "login_failed"
+	SecurityEventCategoryLockout           SecurityEventCategory = "lockout"
+	SecurityEventCategoryMembershipChanged SecurityEventCategory = "membership_changed"
+	SecurityEventCategoryRiskDetected      SecurityEventCategory = "risk_detected"
+)
+
+func (e SecurityEvent) Normalize() *SecurityEvent {
+	e.UserAgent = cutString(e.UserAgent, 200)
+	return &e
+}
@@ -0,0 +1,21 @@
+package record
+
+import (
+	"time"
+)
+
+// UsageLog records countable usage of a quota unit which is neither an access nor an execution,
+// e.g. an active user, an issued token or a sent notification.
+type UsageLog struct {
+	LogDate    time.Time `json:"logDate"`
+	InstanceID string    `json:"instanceId"`
+	// OrgID is set if the usage is also attributed to the quota of the organization
+	OrgID string `json:"orgId,omitempty"`
+	// UserID is set if the usage is attributed to a user, e.g. for the active users
+	UserID string `json:"userId,omitempty"`
+	Count  uint64 `json:"count"`
+}
+
+func (u UsageLog) Normalize() *UsageLog {
+	return &u
+}
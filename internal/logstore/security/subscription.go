@@ -0,0 +1,153 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/logstore"
+	"github.com/zitadel/zitadel/internal/logstore/record"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// queueSize is the amount of events waiting to be handled,
+// further events are dropped by the eventstore, so pushing events is never blocked.
+const queueSize = 1000
+
+var (
+	loginFailedEventTypes = []eventstore.EventType{
+		user.HumanPasswordCheckFailedType,
+		user.HumanMFAOTPCheckFailedType,
+		user.HumanOTPSMSCheckFailedType,
+		user.HumanOTPEmailCheckFailedType,
+		user.HumanU2FTokenCheckFailedType,
+		user.HumanPasswordlessTokenCheckFailedType,
+		user.HumanRecoveryCodeCheckFailedType,
+		user.HumanX509CheckFailedType,
+		user.UserV1PasswordCheckFailedType,
+		user.UserV1MFAOTPCheckFailedType,
+	}
+	lockoutEventTypes = []eventstore.EventType{
+		user.UserLockedType,
+	}
+	instanceMembershipEventTypes = []eventstore.EventType{
+		instance.MemberAddedEventType,
+		instance.MemberChangedEventType,
+		instance.MemberRemovedEventType,
+		instance.MemberCascadeRemovedEventType,
+	}
+	orgMembershipEventTypes = []eventstore.EventType{
+		org.MemberAddedEventType,
+		org.MemberChangedEventType,
+		org.MemberRemovedEventType,
+		org.MemberCascadeRemovedEventType,
+	}
+)
+
+// Subscribe forwards the security relevant events pushed by this process to the log store service
+// until the context is done.
+func Subscribe(ctx context.Context, svc *logstore.Service[*record.SecurityEvent]) {
+	if !svc.Enabled() {
+		return
+	}
+	subscription := eventstore.SubscribeEventTypes(make(chan eventstore.Event, queueSize), map[eventstore.AggregateType][]eventstore.EventType{
+		user.AggregateType:     slices.Concat(loginFailedEventTypes, lockoutEventTypes),
+		instance.AggregateType: instanceMembershipEventTypes,
+		org.AggregateType:      orgMembershipEventTypes,
+		session.AggregateType:  {session.RiskEvaluatedType},
+	})
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-subscription.Events:
+				securityEvent := SecurityEventFromEvent(event)
+				if securityEvent == nil {
+					continue
+				}
+				svc.Handle(ctx, securityEvent)
+			}
+		}
+	}()
+}
+
+// memberPayload contains the fields of the member events describing the membership.
+type memberPayload struct {
+	UserID string   `json:"userId"`
+	Roles  []string `json:"roles"`
+}
+
+// SecurityEventFromEvent maps the event to a security event.
+// Nil is returned if the event is not security relevant.
+func SecurityEventFromEvent(event eventstore.Event) *record.SecurityEvent {
+	aggregate := event.Aggregate()
+	securityEvent := &record.SecurityEvent{
+		LogDate:        event.CreatedAt(),
+		EventType:      string(event.Type()),
+		InstanceID:     aggregate.InstanceID,
+		OrganizationID: aggregate.ResourceOwner,
+		ActorID:        event.Creator(),
+		ResourceType:   string(aggregate.Type),
+		ResourceID:     aggregate.ID,
+	}
+	switch {
+	case slices.Contains(loginFailedEventTypes, event.Type()):
+		securityEvent.Category = record.SecurityEventCategoryLoginFailed
+		securityEvent.UserID = aggregate.ID
+	case slices.Contains(lockoutEventTypes, event.Type()):
+		securityEvent.Category = record.SecurityEventCategoryLockout
+		securityEvent.UserID = aggregate.ID
+	case slices.Contains(instanceMembershipEventTypes, event.Type()),
+		slices.Contains(orgMembershipEventTypes, event.Type()):
+		securityEvent.Category = record.SecurityEventCategoryMembershipChanged
+		member := new(memberPayload)
+		if err := json.Unmarshal(event.DataAsBytes(), member); err != nil {
+			logging.WithError(err).WithField("event_type", event.Type()).Warn("unable to parse member event")
+		}
+		securityEvent.UserID = member.UserID
+		securityEvent.Roles = member.Roles
+	case event.Type() == session.RiskEvaluatedType:
+		return riskSecurityEvent(event, securityEvent)
+	default:
+		return nil
+	}
+	securityEvent.IP, securityEvent.UserAgent = domain.AuditClientFromPayload(event.DataAsBytes())
+	return securityEvent
+}
+
+// riskSecurityEvent completes the security event with the risk evaluated for a session check.
+// Nil is returned if the risk policy doesn't require a notification.
+func riskSecurityEvent(event eventstore.Event, securityEvent *record.SecurityEvent) *record.SecurityEvent {
+	risk := new(session.RiskEvaluatedEvent)
+	if err := json.Unmarshal(event.DataAsBytes(), risk); err != nil {
+		logging.WithError(err).WithField("event_type", event.Type()).Warn("unable to parse risk event")
+		return nil
+	}
+	if risk.Action < domain.RiskActionNotify {
+		return nil
+	}
+	securityEvent.Category = record.SecurityEventCategoryRiskDetected
+	securityEvent.UserID = risk.UserID
+	securityEvent.IP = risk.IP
+	securityEvent.UserAgent = risk.UserAgent
+	securityEvent.Risk = &record.SecurityEventRisk{
+		Score:   risk.Score,
+		Signals: make([]string, len(risk.Signals)),
+		Action:  risk.Action.String(),
+	}
+	for i, signal := range risk.Signals {
+		securityEvent.Risk.Signals[i] = string(signal)
+	}
+	if risk.Location != nil {
+		securityEvent.Risk.Country = risk.Location.Country
+	}
+	return securityEvent
+}
@@ -199,6 +199,56 @@ func TestService(t *testing.T) {
 	}
 }
 
+func TestService_bufferExhausted(t *testing.T) {
+	ctx := context.Background()
+	clock := clock.NewMock()
+	config := quotaConfig()
+	clock.Set(config.From)
+
+	storage := emittermock.NewInMemoryStorage(clock, config)
+	sending := make(chan struct{}, 1)
+	release := make(chan struct{})
+	blockingEmitter := logstore.LogEmitterFunc[*emittermock.Record](func(ctx context.Context, bulk []*emittermock.Record) error {
+		sending <- struct{}{}
+		<-release
+		return storage.Emit(ctx, bulk)
+	})
+	emitter, err := logstore.NewEmitter[*emittermock.Record](ctx, clock, emitterConfig(withDebouncerConfig(&logstore.DebouncerConfig{
+		MaxBulkSize:   2,
+		MaxBufferSize: 4,
+	})), blockingEmitter)
+	if err != nil {
+		t.Fatalf("expected no error but got %v", err)
+	}
+	svc := logstore.New[*emittermock.Record](storage, nil, emitter)
+
+	// the first bulk blocks the sink
+	svc.Handle(ctx, emittermock.NewRecord(clock))
+	svc.Handle(ctx, emittermock.NewRecord(clock))
+	<-sending
+
+	goroutines := runtime.NumGoroutine()
+	// 4 records are buffered, the others are dropped
+	for i := 0; i < 10; i++ {
+		svc.Handle(ctx, emittermock.NewRecord(clock))
+	}
+	if started := runtime.NumGoroutine() - goroutines; started > 1 {
+		t.Errorf("wanted at most one pending ship, but %d go routines were started", started)
+	}
+
+	close(release)
+	<-sending
+	time.Sleep(time.Millisecond)
+	runtime.Gosched()
+
+	if want, bulks := []int{2, 4}, storage.Bulks(); !reflect.DeepEqual(want, bulks) {
+		t.Errorf("wanted storage to have bulks %v, but got %v", want, bulks)
+	}
+	if want, got := 6, storage.Len(); want != got {
+		t.Errorf("wanted storage to have len %d, but got %d", want, got)
+	}
+}
+
 func runTest(t *testing.T, name string, args args, want want) bool {
 	return t.Run("Given over a minute, each second a log record is emitted", func(tt *testing.T) {
 		tt.Run(name, func(t *testing.T) {
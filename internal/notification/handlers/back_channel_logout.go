@@ -84,6 +84,10 @@ func (u *backChannelLogoutNotifier) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanSignedOutType,
 					Reduce: u.reduceUserSignedOut,
 				},
+				{
+					Event:  user.HumanSignedOutEverywhereType,
+					Reduce: u.reduceUserSignedOutEverywhere,
+				},
 			},
 		},
 	}
@@ -110,6 +114,32 @@ func (u *backChannelLogoutNotifier) reduceUserSignedOut(event eventstore.Event)
 	}), nil
 }
 
+func (u *backChannelLogoutNotifier) reduceUserSignedOutEverywhere(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanSignedOutEverywhereEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Soe2h", "reduce.wrong.event.type %s", user.HumanSignedOutEverywhereType)
+	}
+
+	return handler.NewStatement(event, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+		ctx, err := u.queries.HandlerContext(ctx, event.Aggregate())
+		if err != nil {
+			return err
+		}
+		if !authz.GetFeatures(ctx).EnableBackChannelLogout {
+			return nil
+		}
+		sessions := &backChannelLogoutUserSessions{userID: e.Aggregate().ID}
+		if err = u.eventstore.FilterToQueryReducer(ctx, sessions); err != nil {
+			return err
+		}
+		errs := make([]error, 0, len(sessions.sessionIDs))
+		for _, sessionID := range sessions.sessionIDs {
+			errs = append(errs, u.terminateSession(ctx, sessionID, e))
+		}
+		return errors.Join(errs...)
+	}), nil
+}
+
 func (u *backChannelLogoutNotifier) reduceSessionTerminated(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*session.TerminateEvent)
 	if !ok {
@@ -215,6 +245,37 @@ type backChannelLogoutOIDCSessions struct {
 	BackChannelLogoutURI string
 }
 
+// backChannelLogoutUserSessions collects the IDs of all sessions of a user,
+// for which a back-channel logout was registered.
+type backChannelLogoutUserSessions struct {
+	userID string
+
+	sessionIDs []string
+}
+
+func (b *backChannelLogoutUserSessions) Reduce() error {
+	return nil
+}
+
+func (b *backChannelLogoutUserSessions) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		if !slices.Contains(b.sessionIDs, event.Aggregate().ID) {
+			b.sessionIDs = append(b.sessionIDs, event.Aggregate().ID)
+		}
+	}
+}
+
+func (b *backChannelLogoutUserSessions) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(sessionlogout.AggregateType).
+		EventTypes(sessionlogout.BackChannelLogoutRegisteredType).
+		EventData(map[string]interface{}{
+			"user_id": b.userID,
+		}).
+		Builder()
+}
+
 func (b *backChannelLogoutSession) Reduce() error {
 	return nil
 }
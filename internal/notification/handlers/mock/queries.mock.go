@@ -207,7 +207,21 @@ func (mr *MockQueriesMockRecorder) NotificationProviderByIDAndType(arg0, arg1, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotificationProviderByIDAndType", reflect.TypeOf((*MockQueries)(nil).NotificationProviderByIDAndType), arg0, arg1, arg2)
 }
 
-// SMSProviderConfigActive mocks base method.
+// OrgMembers mocks base method.
+func (m *MockQueries) OrgMembers(arg0 context.Context, arg1 *query.OrgMembersQuery) (*query.Members, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrgMembers", arg0, arg1)
+	ret0, _ := ret[0].(*query.Members)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrgMembers indicates an expected call of OrgMembers.
+func (mr *MockQueriesMockRecorder) OrgMembers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrgMembers", reflect.TypeOf((*MockQueries)(nil).OrgMembers), arg0, arg1)
+}
+
 func (m *MockQueries) SMSProviderConfigActive(arg0 context.Context, arg1 string) (*query.SMSConfig, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SMSProviderConfigActive", arg0, arg1)
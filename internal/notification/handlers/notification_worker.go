@@ -84,6 +84,7 @@ type WorkerConfig struct {
 	TransactionDuration time.Duration
 	MaxTtl              time.Duration
 	MaxAttempts         uint8
+	Push                PushConfig
 }
 
 // nowFunc makes [time.Now] mockable
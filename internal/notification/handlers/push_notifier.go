@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	_ "github.com/zitadel/zitadel/internal/notification/statik"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	PushNotificationsProjectionTable = "projections.notifications_push"
+)
+
+// PushConfig configures the gateway, which delivers the push challenges to the devices
+// over the respective push service (e.g. APNs or FCM) using the push token of the device.
+type PushConfig struct {
+	// Endpoint of the push gateway, push challenges are not delivered if empty.
+	Endpoint string
+	Headers  http.Header
+}
+
+type pushNotifier struct {
+	config   PushConfig
+	commands *command.Commands
+	queries  *NotificationQueries
+	channels types.ChannelChains
+}
+
+func NewPushNotifier(
+	ctx context.Context,
+	config handler.Config,
+	pushConfig PushConfig,
+	commands *command.Commands,
+	queries *NotificationQueries,
+	channels types.ChannelChains,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &pushNotifier{
+		config:   pushConfig,
+		commands: commands,
+		queries:  queries,
+		channels: channels,
+	})
+}
+
+func (*pushNotifier) Name() string {
+	return PushNotificationsProjectionTable
+}
+
+func (p *pushNotifier) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: session.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  session.PushChallengedType,
+					Reduce: p.reducePushChallenged,
+				},
+			},
+		},
+	}
+}
+
+// PushChallengeMessage is sent to the push gateway for every challenged device.
+// The number matching code is not part of the message, it's only displayed to the user on the login.
+type PushChallengeMessage struct {
+	InstanceID string    `json:"instanceId"`
+	Origin     string    `json:"origin"`
+	SessionID  string    `json:"sessionId"`
+	UserID     string    `json:"userId"`
+	DeviceID   string    `json:"deviceId"`
+	PushToken  string    `json:"pushToken"`
+	Challenge  string    `json:"challenge"`
+	AppName    string    `json:"appName,omitempty"`
+	IP         string    `json:"ip,omitempty"`
+	Location   string    `json:"location,omitempty"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+}
+
+func (p *pushNotifier) reducePushChallenged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.PushChallengedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Pu5n1", "reduce.wrong.event.type %s", session.PushChallengedType)
+	}
+	expiresAt := e.CreatedAt().Add(e.Expiry)
+	// challenges, which can no longer be approved, are not delivered (e.g. on a reprocessing of the events)
+	if time.Now().After(expiresAt) {
+		return handler.NewNoOpStatement(e), nil
+	}
+
+	return handler.NewStatement(event, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+		ctx = HandlerContext(ctx, event.Aggregate())
+		ctx, err := p.queries.Origin(ctx, e)
+		if err != nil {
+			return err
+		}
+		devices := command.NewHumanPushDevicesWriteModel(e.UserID, "")
+		if err = p.queries.es.FilterToQueryReducer(ctx, devices); err != nil {
+			return err
+		}
+		errs := make([]error, 0, len(e.DeviceIDs))
+		for _, deviceID := range e.DeviceIDs {
+			device := devices.Device(deviceID)
+			// the device might have been removed in the meantime
+			if device == nil || device.State != domain.MFAStateReady {
+				continue
+			}
+			// the delivery is recorded per device, so a retry after a failed delivery only sends to the remaining devices
+			alreadySent, err := p.queries.IsAlreadyHandled(ctx, event, map[string]interface{}{"challenge": e.Challenge, "deviceId": deviceID}, session.PushSentType)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if alreadySent {
+				continue
+			}
+			err = types.SendJSON(
+				ctx,
+				webhook.Config{
+					CallURL: p.config.Endpoint,
+					Method:  http.MethodPost,
+					Headers: p.config.Headers,
+				},
+				p.channels,
+				&PushChallengeMessage{
+					InstanceID: authz.GetInstance(ctx).InstanceID(),
+					Origin:     http_util.DomainContext(ctx).Origin(),
+					SessionID:  e.Aggregate().ID,
+					UserID:     e.UserID,
+					DeviceID:   deviceID,
+					PushToken:  device.PushToken,
+					Challenge:  e.Challenge,
+					AppName:    e.AppName,
+					IP:         e.IP,
+					Location:   e.Location,
+					ExpiresAt:  expiresAt,
+				},
+				e.Type(),
+			).WithoutTemplate()
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			errs = append(errs, p.commands.PushSent(ctx, e.Aggregate().ID, e.Aggregate().InstanceID, e.Challenge, deviceID))
+		}
+		return errors.Join(errs...)
+	}), nil
+}
@@ -29,6 +29,7 @@ type Queries interface {
 	GetInstanceRestrictions(ctx context.Context) (restrictions query.Restrictions, err error)
 	InstanceByID(ctx context.Context, id string) (instance authz.Instance, err error)
 	GetActiveSigningWebKey(ctx context.Context) (*jose.JSONWebKey, error)
+	OrgMembers(ctx context.Context, queries *query.OrgMembersQuery) (*query.Members, error)
 
 	ActiveInstances() []string
 }
@@ -3,13 +3,21 @@ package handlers
 import (
 	"context"
 	"net/http"
+	"slices"
 
+	http_util "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/ui/console"
 	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/notification/senders"
 	_ "github.com/zitadel/zitadel/internal/notification/statik"
 	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/repository/notification"
 	"github.com/zitadel/zitadel/internal/repository/quota"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -18,10 +26,21 @@ const (
 	QuotaNotificationsProjectionTable = "projections.notifications_quota"
 )
 
+func init() {
+	// the quota notification is marked as sent as soon as all requests to the organization owners are queued
+	RegisterSentHandler(quota.NotificationDueEventType,
+		func(ctx context.Context, commands Commands, id, orgID string, _ *senders.CodeGeneratorInfo, args map[string]any) error {
+			return nil
+		},
+	)
+}
+
 type quotaNotifier struct {
-	commands *command.Commands
-	queries  *NotificationQueries
-	channels types.ChannelChains
+	commands    *command.Commands
+	queries     *NotificationQueries
+	channels    types.ChannelChains
+	queue       Queue
+	maxAttempts uint8
 }
 
 func NewQuotaNotifier(
@@ -30,12 +49,20 @@ func NewQuotaNotifier(
 	commands *command.Commands,
 	queries *NotificationQueries,
 	channels types.ChannelChains,
+	workerConfig WorkerConfig,
+	queue Queue,
 ) *handler.Handler {
-	return handler.NewHandler(ctx, &config, &quotaNotifier{
-		commands: commands,
-		queries:  queries,
-		channels: channels,
-	})
+	notifier := &quotaNotifier{
+		commands:    commands,
+		queries:     queries,
+		channels:    channels,
+		maxAttempts: workerConfig.MaxAttempts,
+	}
+	// the organization owners are only notified by the notification worker
+	if !workerConfig.LegacyEnabled {
+		notifier.queue = queue
+	}
+	return handler.NewHandler(ctx, &config, notifier)
 }
 
 func (*quotaNotifier) Name() string {
@@ -71,10 +98,62 @@ func (u *quotaNotifier) reduceNotificationDue(event eventstore.Event) (*handler.
 		if alreadyHandled {
 			return nil
 		}
-		err = types.SendJSON(ctx, webhook.Config{CallURL: e.CallURL, Method: http.MethodPost}, u.channels, e, e.Type()).WithoutTemplate()
-		if err != nil {
-			return err
+		// notifications of organization quotas don't require a call URL, as they are sent to the organization owners
+		if e.CallURL != "" {
+			err = types.SendJSON(ctx, webhook.Config{CallURL: e.CallURL, Method: http.MethodPost}, u.channels, e, e.Type()).WithoutTemplate()
+			if err != nil {
+				return err
+			}
+		}
+		if quota.IsOrgAggregate(e.Aggregate()) {
+			if err = u.notifyOrgOwners(ctx, e); err != nil {
+				return err
+			}
 		}
 		return u.commands.UsageNotificationSent(ctx, e)
 	}), nil
 }
+
+// notifyOrgOwners queues an email to each owner of the organization the quota belongs to
+func (u *quotaNotifier) notifyOrgOwners(ctx context.Context, e *quota.NotificationDueEvent) error {
+	if u.queue == nil {
+		return nil
+	}
+	members, err := u.queries.OrgMembers(ctx, &query.OrgMembersQuery{OrgID: e.Aggregate().ResourceOwner})
+	if err != nil {
+		return err
+	}
+	ctx, err = u.queries.Origin(ctx, e)
+	if err != nil {
+		return err
+	}
+	origin := http_util.DomainContext(ctx).Origin()
+	for _, member := range members.Members {
+		if !slices.Contains(member.Roles, domain.RoleOrgOwner) {
+			continue
+		}
+		err = u.queue.Insert(ctx,
+			&notification.Request{
+				Aggregate:         e.Aggregate(),
+				UserID:            member.UserID,
+				UserResourceOwner: member.UserResourceOwner,
+				TriggeredAtOrigin: origin,
+				EventType:         e.EventType,
+				NotificationType:  domain.NotificationTypeEmail,
+				MessageType:       domain.QuotaNotificationMessageType,
+				URLTemplate:       console.LoginHintLink(origin, "{{.PreferredLoginName}}"),
+				Args: &domain.NotificationArguments{
+					QuotaUnit:      string(command.QuotaUnitFromEnum(e.Unit)),
+					QuotaThreshold: e.Threshold,
+					QuotaUsage:     e.Usage,
+				},
+			},
+			queue.WithQueueName(notification.QueueName),
+			queue.WithMaxAttempts(u.maxAttempts),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
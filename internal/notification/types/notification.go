@@ -5,10 +5,12 @@ import (
 	"html"
 	"strings"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/i18n"
+	zchannels "github.com/zitadel/zitadel/internal/notification/channels"
 	"github.com/zitadel/zitadel/internal/notification/channels/email"
 	"github.com/zitadel/zitadel/internal/notification/channels/set"
 	"github.com/zitadel/zitadel/internal/notification/channels/sms"
@@ -16,6 +18,8 @@ import (
 	"github.com/zitadel/zitadel/internal/notification/senders"
 	"github.com/zitadel/zitadel/internal/notification/templates"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/usage"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 type Notify func(
@@ -47,6 +51,12 @@ func SendEmail(
 		messageType string,
 		allowUnverifiedNotificationChannel bool,
 	) error {
+		instanceID := authz.GetInstance(ctx).InstanceID()
+		if usage.NotificationsExhausted(ctx, instanceID) {
+			return zchannels.NewCancelError(
+				zerrors.ThrowResourceExhausted(nil, "MAIL-Qz5nE", "Errors.Quota.Notifications.Exhausted"),
+			)
+		}
 		args = mapNotifyUserToArgs(user, args)
 		sanitizeArgsForHTML(args)
 		url, err := urlFromTemplate(urlTmpl, args)
@@ -58,7 +68,7 @@ func SendEmail(
 		if err != nil {
 			return err
 		}
-		return generateEmail(
+		err = generateEmail(
 			ctx,
 			channels,
 			user,
@@ -68,6 +78,11 @@ func SendEmail(
 			allowUnverifiedNotificationChannel,
 			triggeringEventType,
 		)
+		if err != nil {
+			return err
+		}
+		usage.NotificationSent(ctx, instanceID)
+		return nil
 	}
 }
 
@@ -113,13 +128,18 @@ func SendSMS(
 		messageType string,
 		allowUnverifiedNotificationChannel bool,
 	) error {
+		if usage.NotificationsExhausted(ctx, instanceID) {
+			return zchannels.NewCancelError(
+				zerrors.ThrowResourceExhausted(nil, "PHONE-Ux8wD", "Errors.Quota.Notifications.Exhausted"),
+			)
+		}
 		args = mapNotifyUserToArgs(user, args)
 		url, err := urlFromTemplate(urlTmpl, args)
 		if err != nil {
 			return err
 		}
 		data := GetTemplateData(ctx, translator, args, url, messageType, user.PreferredLanguage.String(), colors)
-		return generateSms(
+		err = generateSms(
 			ctx,
 			channels,
 			user,
@@ -131,6 +151,11 @@ func SendSMS(
 			jobID,
 			generatorInfo,
 		)
+		if err != nil {
+			return err
+		}
+		usage.NotificationSent(ctx, instanceID)
+		return nil
 	}
 }
 
@@ -0,0 +1,225 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type AccessReviewCampaign struct {
+	ID            string
+	ResourceOwner string
+	Name          string
+	ResourceType  string
+	ProjectID     string
+	RoleKey       string
+	ReviewerIDs   database.TextArray[string]
+	Deadline      time.Time
+	AutoRevoke    bool
+	State         domain.AccessReviewState
+}
+
+// AccessReviewItem is an authorization or membership in the scope of a campaign and its decision, if already reviewed.
+// The ID is the id of the authorization for project reviews and the id of the user for organization reviews.
+type AccessReviewItem struct {
+	ID         string
+	UserID     string
+	Roles      database.TextArray[string]
+	Decision   domain.AccessReviewDecision
+	ReviewerID string
+	Comment    string
+}
+
+var (
+	//go:embed access_review_campaigns.sql
+	accessReviewCampaignsQuery string
+	//go:embed access_review_items.sql
+	accessReviewItemsQuery string
+	//go:embed access_review_decisions.sql
+	accessReviewDecisionsQuery string
+)
+
+// ListAccessReviewCampaigns returns the campaigns of the organization, which the caller reviews or is allowed to read.
+func (q *Queries) ListAccessReviewCampaigns(ctx context.Context, orgID string) (_ []*AccessReviewCampaign, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	campaigns, err := q.searchAccessReviewCampaigns(ctx, orgID, "")
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(campaigns, func(campaign *AccessReviewCampaign) bool {
+		return q.checkAccessReviewPermission(ctx, campaign) != nil
+	}), nil
+}
+
+// AccessReviewCampaignByID returns the campaign, if the caller reviews it or is allowed to read it.
+func (q *Queries) AccessReviewCampaignByID(ctx context.Context, id string) (_ *AccessReviewCampaign, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if id == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Ar1qI", "Errors.IDMissing")
+	}
+	campaigns, err := q.searchAccessReviewCampaigns(ctx, "", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(campaigns) != 1 {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Ar2nF", "Errors.AccessReview.NotFound")
+	}
+	if err := q.checkAccessReviewPermission(ctx, campaigns[0]); err != nil {
+		return nil, err
+	}
+	return campaigns[0], nil
+}
+
+// ListAccessReviewItems returns the current authorizations or memberships in the scope of the campaign and their decisions.
+func (q *Queries) ListAccessReviewItems(ctx context.Context, campaignID string) (_ []*AccessReviewItem, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if _, err := q.AccessReviewCampaignByID(ctx, campaignID); err != nil {
+		return nil, err
+	}
+	return q.searchAccessReviewItems(ctx, campaignID)
+}
+
+// ListAccessReviewDecisions returns all decisions of the campaign, including revoked items which no longer exist.
+// The roles of the items are not returned.
+func (q *Queries) ListAccessReviewDecisions(ctx context.Context, campaignID string) (_ []*AccessReviewItem, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if _, err := q.AccessReviewCampaignByID(ctx, campaignID); err != nil {
+		return nil, err
+	}
+	decisions := make([]*AccessReviewItem, 0)
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			decision := new(AccessReviewItem)
+			if err := rows.Scan(
+				&decision.ID,
+				&decision.UserID,
+				&decision.Decision,
+				&decision.ReviewerID,
+				&decision.Comment,
+			); err != nil {
+				return err
+			}
+			decisions = append(decisions, decision)
+		}
+		return rows.Err()
+	},
+		accessReviewDecisionsQuery,
+		authz.GetInstance(ctx).InstanceID(),
+		campaignID,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ar3dQ", "Errors.Internal")
+	}
+	return decisions, nil
+}
+
+// SearchUndecidedAccessReviewItems returns the ids of the items in the scope of the campaign without decision.
+// It is used by the scheduled access worker and therefore doesn't check any permission.
+func (q *Queries) SearchUndecidedAccessReviewItems(ctx context.Context, campaignID string) (_ []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	items, err := q.searchAccessReviewItems(ctx, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.Decision == domain.AccessReviewDecisionUnspecified {
+			ids = append(ids, item.ID)
+		}
+	}
+	return ids, nil
+}
+
+func (q *Queries) searchAccessReviewCampaigns(ctx context.Context, resourceOwner, id string) (_ []*AccessReviewCampaign, err error) {
+	campaigns := make([]*AccessReviewCampaign, 0)
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			campaign := new(AccessReviewCampaign)
+			if err := rows.Scan(
+				&campaign.ID,
+				&campaign.ResourceOwner,
+				&campaign.Name,
+				&campaign.ResourceType,
+				&campaign.ProjectID,
+				&campaign.RoleKey,
+				&campaign.ReviewerIDs,
+				&campaign.Deadline,
+				&campaign.AutoRevoke,
+				&campaign.State,
+			); err != nil {
+				return err
+			}
+			campaigns = append(campaigns, campaign)
+		}
+		return rows.Err()
+	},
+		accessReviewCampaignsQuery,
+		authz.GetInstance(ctx).InstanceID(),
+		resourceOwner,
+		id,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ar4cS", "Errors.Internal")
+	}
+	return campaigns, nil
+}
+
+func (q *Queries) searchAccessReviewItems(ctx context.Context, campaignID string) (_ []*AccessReviewItem, err error) {
+	items := make([]*AccessReviewItem, 0)
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			item := new(AccessReviewItem)
+			if err := rows.Scan(
+				&item.ID,
+				&item.UserID,
+				&item.Roles,
+				&item.Decision,
+				&item.ReviewerID,
+				&item.Comment,
+			); err != nil {
+				return err
+			}
+			items = append(items, item)
+		}
+		return rows.Err()
+	},
+		accessReviewItemsQuery,
+		authz.GetInstance(ctx).InstanceID(),
+		campaignID,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ar5iS", "Errors.Internal")
+	}
+	return items, nil
+}
+
+// checkAccessReviewPermission allows the reviewers of the campaign
+// and everyone allowed to read the reviewed authorizations or administrators.
+func (q *Queries) checkAccessReviewPermission(ctx context.Context, campaign *AccessReviewCampaign) error {
+	if slices.Contains(campaign.ReviewerIDs, authz.GetCtxData(ctx).UserID) {
+		return nil
+	}
+	if campaign.ResourceType == accessreview.ResourceTypeProject {
+		return q.checkPermission(ctx, domain.PermissionUserGrantRead, campaign.ResourceOwner, campaign.ProjectID)
+	}
+	return q.checkPermission(ctx, domain.PermissionOrgMemberRead, campaign.ResourceOwner, campaign.ResourceOwner)
+}
@@ -0,0 +1,25 @@
+SELECT
+	c.aggregate_id AS id
+	, c.resource_owner
+	, c."value"->>'name' AS name
+	, c."value"->>'resourceType' AS resource_type
+	, COALESCE(c."value"->>'projectId', '') AS project_id
+	, COALESCE(c."value"->>'roleKey', '') AS role_key
+	, ARRAY(SELECT jsonb_array_elements_text(c."value"->'reviewerIds')) AS reviewer_ids
+	, (c."value"->>'deadline')::TIMESTAMPTZ AS deadline
+	, COALESCE((c."value"->>'autoRevoke')::BOOLEAN, FALSE) AS auto_revoke
+	, (s."value")::SMALLINT AS state
+FROM eventstore.fields c
+JOIN eventstore.fields s
+	ON s.instance_id = c.instance_id
+	AND s.aggregate_type = c.aggregate_type
+	AND s.aggregate_id = c.aggregate_id
+	AND s.object_type = 'access_review'
+	AND s.field_name = 'state'
+WHERE c.instance_id = $1
+AND ($2 = '' OR c.resource_owner = $2)
+AND ($3 = '' OR c.aggregate_id = $3)
+AND c.aggregate_type = 'access_review'
+AND c.object_type = 'access_review'
+AND c.field_name = 'campaign'
+ORDER BY c.aggregate_id;
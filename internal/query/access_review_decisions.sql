@@ -0,0 +1,13 @@
+SELECT
+	object_id AS item_id
+	, "value"->>'userId' AS user_id
+	, ("value"->>'decision')::SMALLINT AS decision
+	, COALESCE("value"->>'reviewerId', '') AS reviewer_id
+	, COALESCE("value"->>'comment', '') AS comment
+FROM eventstore.fields
+WHERE instance_id = $1
+AND aggregate_type = 'access_review'
+AND aggregate_id = $2
+AND object_type = 'access_review_item'
+AND field_name = 'decision'
+ORDER BY object_id;
@@ -0,0 +1,63 @@
+WITH campaign AS (
+	SELECT
+		resource_owner
+		, "value"->>'resourceType' AS resource_type
+		, COALESCE("value"->>'projectId', '') AS project_id
+		, COALESCE("value"->>'roleKey', '') AS role_key
+		-- only authorizations and memberships which existed when the campaign started are reviewed
+		, (
+			SELECT e.created_at
+			FROM eventstore.events2 e
+			WHERE e.instance_id = $1
+			AND e.aggregate_type = 'access_review'
+			AND e.aggregate_id = $2
+			AND e.event_type = 'access_review.campaign.created'
+		) AS started_at
+	FROM eventstore.fields
+	WHERE instance_id = $1
+	AND aggregate_type = 'access_review'
+	AND aggregate_id = $2
+	AND object_type = 'access_review'
+	AND field_name = 'campaign'
+), items AS (
+	SELECT
+		g.id AS item_id
+		, g.user_id
+		, g.roles
+	FROM projections.user_grants5 g
+	JOIN campaign c
+		ON c.resource_type = 'project'
+		AND g.resource_owner = c.resource_owner
+		AND g.project_id = c.project_id
+		AND (c.role_key = '' OR c.role_key = ANY(g.roles))
+		AND g.creation_date <= c.started_at
+	WHERE g.instance_id = $1
+	UNION ALL
+	SELECT
+		m.user_id AS item_id
+		, m.user_id
+		, m.roles
+	FROM projections.org_members4 m
+	JOIN campaign c
+		ON c.resource_type = 'org'
+		AND m.org_id = c.resource_owner
+		AND (c.role_key = '' OR c.role_key = ANY(m.roles))
+		AND m.creation_date <= c.started_at
+	WHERE m.instance_id = $1
+)
+SELECT
+	i.item_id
+	, i.user_id
+	, i.roles
+	, COALESCE((d."value"->>'decision')::SMALLINT, 0) AS decision
+	, COALESCE(d."value"->>'reviewerId', '') AS reviewer_id
+	, COALESCE(d."value"->>'comment', '') AS comment
+FROM items i
+LEFT JOIN eventstore.fields d
+	ON d.instance_id = $1
+	AND d.aggregate_type = 'access_review'
+	AND d.aggregate_id = $2
+	AND d.object_type = 'access_review_item'
+	AND d.object_id = i.item_id
+	AND d.field_name = 'decision'
+ORDER BY i.user_id, i.item_id;
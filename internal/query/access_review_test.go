@@ -0,0 +1,179 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	accessReviewCampaignsCols = []string{"id", "resource_owner", "name", "resource_type", "project_id", "role_key", "reviewer_ids", "deadline", "auto_revoke", "state"}
+	accessReviewItemsCols     = []string{"item_id", "user_id", "roles", "decision", "reviewer_id", "comment"}
+)
+
+func TestQueries_AccessReviewCampaignByID(t *testing.T) {
+	expQuery := regexp.QuoteMeta(accessReviewCampaignsQuery)
+	deadline := time.Unix(1700000000, 0)
+	errPermission := zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied")
+
+	tests := []struct {
+		name       string
+		id         string
+		mock       sqlExpectation
+		permission domain.PermissionCheck
+		want       *AccessReviewCampaign
+		wantErr    error
+	}{
+		{
+			name:    "missing id",
+			mock:    func(m sqlmock.Sqlmock) sqlmock.Sqlmock { return m },
+			wantErr: zerrors.ThrowInvalidArgument(nil, "QUERY-Ar1qI", "Errors.IDMissing"),
+		},
+		{
+			name:    "internal error",
+			id:      "campaign1",
+			mock:    mockQueryErr(expQuery, sql.ErrConnDone, "instanceID", "", "campaign1"),
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-Ar4cS", "Errors.Internal"),
+		},
+		{
+			name:    "not found",
+			id:      "campaign1",
+			mock:    mockQueries(expQuery, accessReviewCampaignsCols, nil, "instanceID", "", "campaign1"),
+			wantErr: zerrors.ThrowNotFound(nil, "QUERY-Ar2nF", "Errors.AccessReview.NotFound"),
+		},
+		{
+			name: "no permission",
+			id:   "campaign1",
+			mock: mockQueries(expQuery, accessReviewCampaignsCols,
+				[][]driver.Value{
+					{"campaign1", "orgID", "Q3", "project", "project1", "", database.TextArray[string]{"reviewer1"}, deadline, false, domain.AccessReviewStateActive},
+				},
+				"instanceID", "", "campaign1",
+			),
+			permission: func(_ context.Context, permission, orgID, resourceID string) error {
+				assert.Equal(t, domain.PermissionUserGrantRead, permission)
+				assert.Equal(t, "orgID", orgID)
+				assert.Equal(t, "project1", resourceID)
+				return errPermission
+			},
+			wantErr: errPermission,
+		},
+		{
+			name: "reviewer",
+			id:   "campaign1",
+			mock: mockQueries(expQuery, accessReviewCampaignsCols,
+				[][]driver.Value{
+					{"campaign1", "orgID", "Q3", "org", "", "ORG_OWNER", database.TextArray[string]{"reviewer1", "userID"}, deadline, true, domain.AccessReviewStateCompleted},
+				},
+				"instanceID", "", "campaign1",
+			),
+			want: &AccessReviewCampaign{
+				ID:            "campaign1",
+				ResourceOwner: "orgID",
+				Name:          "Q3",
+				ResourceType:  "org",
+				RoleKey:       "ORG_OWNER",
+				ReviewerIDs:   database.TextArray[string]{"reviewer1", "userID"},
+				Deadline:      deadline,
+				AutoRevoke:    true,
+				State:         domain.AccessReviewStateCompleted,
+			},
+		},
+		{
+			name: "permitted",
+			id:   "campaign1",
+			mock: mockQueries(expQuery, accessReviewCampaignsCols,
+				[][]driver.Value{
+					{"campaign1", "orgID", "Q3", "org", "", "", database.TextArray[string]{"reviewer1"}, deadline, false, domain.AccessReviewStateActive},
+				},
+				"instanceID", "", "campaign1",
+			),
+			permission: func(_ context.Context, permission, orgID, resourceID string) error {
+				assert.Equal(t, domain.PermissionOrgMemberRead, permission)
+				assert.Equal(t, "orgID", orgID)
+				assert.Equal(t, "orgID", resourceID)
+				return nil
+			},
+			want: &AccessReviewCampaign{
+				ID:            "campaign1",
+				ResourceOwner: "orgID",
+				Name:          "Q3",
+				ResourceType:  "org",
+				ReviewerIDs:   database.TextArray[string]{"reviewer1"},
+				Deadline:      deadline,
+				State:         domain.AccessReviewStateActive,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+					checkPermission: tt.permission,
+				}
+				got, err := q.AccessReviewCampaignByID(authz.NewMockContext("instanceID", "orgID", "userID"), tt.id)
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
+
+func TestQueries_SearchUndecidedAccessReviewItems(t *testing.T) {
+	expQuery := regexp.QuoteMeta(accessReviewItemsQuery)
+
+	tests := []struct {
+		name    string
+		mock    sqlExpectation
+		want    []string
+		wantErr error
+	}{
+		{
+			name:    "internal error",
+			mock:    mockQueryErr(expQuery, sql.ErrConnDone, "instanceID", "campaign1"),
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-Ar5iS", "Errors.Internal"),
+		},
+		{
+			name: "success",
+			mock: mockQueries(expQuery, accessReviewItemsCols,
+				[][]driver.Value{
+					{"grant1", "user1", database.TextArray[string]{"role1"}, domain.AccessReviewDecisionApproved, "reviewer1", "still needed"},
+					{"grant2", "user2", database.TextArray[string]{"role1", "role2"}, domain.AccessReviewDecisionUnspecified, "", ""},
+					{"grant3", "user3", database.TextArray[string]{"role2"}, domain.AccessReviewDecisionRevoked, "reviewer1", ""},
+					{"grant4", "user4", database.TextArray[string]{"role1"}, domain.AccessReviewDecisionUnspecified, "", ""},
+				},
+				"instanceID", "campaign1",
+			),
+			want: []string{"grant2", "grant4"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+				}
+				got, err := q.SearchUndecidedAccessReviewItems(authz.NewMockContext("instanceID", "orgID", "userID"), "campaign1")
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
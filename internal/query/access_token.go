@@ -204,6 +204,7 @@ func (s *sessionTerminatedModel) Query() *eventstore.SearchQueryBuilder {
 				user.UserDeactivatedType,
 				user.UserLockedType,
 				user.UserRemovedType,
+				user.HumanSignedOutEverywhereType,
 			).
 			PositionAfter(s.position).
 			Builder()
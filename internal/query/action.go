@@ -129,7 +129,7 @@ func (q *Queries) SearchActions(ctx context.Context, queries *ActionSearchQuerie
 		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-SDgwg", "Errors.Query.InvalidRequest")
 	}
 
-	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
 		actions, err = scan(rows)
 		return err
 	}, stmt, args...)
@@ -192,7 +192,7 @@ func (q *Queries) searchAdministrators(ctx context.Context, queries *MembershipS
 		return nil, err
 	}
 	queryArgs = append(queryArgs, args...)
-	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
 		administrators, err = scan(rows)
 		return err
 	}, stmt, queryArgs...)
@@ -315,7 +315,7 @@ func (q *Queries) AppByIDWithPermission(ctx context.Context, appID string, activ
 		return nil, err
 	}
 
-	if err := appCheckPermission(ctx, app.ResourceOwner, app.ProjectID, permissionCheck); err != nil {
+	if err := appCheckPermission(ctx, app.ResourceOwner, app.ProjectID, app.ID, permissionCheck); err != nil {
 		return nil, err
 	}
 
@@ -393,6 +393,7 @@ func (q *Queries) CheckProjectPermissionByClientID(ctx context.Context, clientID
 		domain.UserStateActive,
 		domain.ProjectGrantStateActive,
 		domain.UserGrantStateActive,
+		domain.GroupStateActive,
 	)
 	return p, err
 }
@@ -417,6 +418,7 @@ func (q *Queries) CheckProjectPermissionByEntityID(ctx context.Context, entityID
 		domain.UserStateActive,
 		domain.ProjectGrantStateActive,
 		domain.UserGrantStateActive,
+		domain.GroupStateActive,
 	)
 	return p, err
 }
@@ -545,7 +547,7 @@ func (q *Queries) searchApps(ctx context.Context, queries *AppSearchQueries, isP
 		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-fajp8", "Errors.Query.InvalidRequest")
 	}
 
-	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
 		apps, err = scan(rows)
 		return err
 	}, stmt, args...)
@@ -567,6 +569,7 @@ func appPermissionCheckV2(ctx context.Context, query sq.SelectBuilder, enabled b
 		domain.PermissionProjectAppRead,
 		SingleOrgPermissionOption(queries.Queries),
 		WithProjectsPermissionOption(AppColumnProjectID),
+		WithApplicationsPermissionOption(AppColumnID),
 	)
 	return query.JoinClause(join, args...)
 }
@@ -647,14 +650,23 @@ func (q *Queries) SAMLAppLoginVersion(ctx context.Context, appID string) (loginV
 	return loginVersion, nil
 }
 
-func appCheckPermission(ctx context.Context, resourceOwner string, projectID string, permissionCheck domain.PermissionCheck) error {
-	return permissionCheck(ctx, domain.PermissionProjectAppRead, resourceOwner, projectID)
+// appCheckPermission checks the permission on the project first,
+// administrators of a single application are only permitted on the application itself.
+func appCheckPermission(ctx context.Context, resourceOwner, projectID, appID string, permissionCheck domain.PermissionCheck) error {
+	err := permissionCheck(ctx, domain.PermissionProjectAppRead, resourceOwner, projectID)
+	if err == nil || appID == "" {
+		return err
+	}
+	if permissionCheck(ctx, domain.PermissionProjectAppRead, resourceOwner, appID) == nil {
+		return nil
+	}
+	return err
 }
 
 // appsCheckPermission returns only the apps that the user in context has permission to read
 func appsCheckPermission(ctx context.Context, apps []*App, permissionCheck domain.PermissionCheck) []*App {
 	return slices.DeleteFunc(apps, func(app *App) bool {
-		return permissionCheck(ctx, domain.PermissionProjectAppRead, app.ResourceOwner, app.ProjectID) != nil
+		return appCheckPermission(ctx, app.ResourceOwner, app.ProjectID, app.ID, permissionCheck) != nil
 	})
 }
 
@@ -36,7 +36,7 @@ with application as (
      WHERE pg.instance_id = $1
        AND pg.state = $7
 ), project_role_check as (
-/* all usergrants active and associated with the user, then filtered with the project */
+/* all usergrants active and associated with the user, directly or through a group, then filtered with the project */
      SELECT ug.instance_id,
             ug.resource_owner,
             ug.project_id
@@ -44,6 +44,21 @@ with application as (
      WHERE ug.instance_id = $1
        AND ug.user_id = $5
        AND ug.state = $8
+     UNION ALL
+     SELECT gg.instance_id,
+            gg.resource_owner,
+            gg.project_id
+     FROM projections.group_grants1 as gg
+          INNER JOIN projections.group_users1 as gu
+                     ON gu.instance_id = gg.instance_id
+                     AND gu.group_id = gg.group_id
+          INNER JOIN projections.groups1 as g
+                     ON g.instance_id = gg.instance_id
+                     AND g.id = gg.group_id
+     WHERE gg.instance_id = $1
+       AND gu.user_id = $5
+       AND gg.state = $8
+       AND g.state = $9
 )
 SELECT
     /* project existence does not need to be checked, or resourceowner of user and project are equal, or resourceowner of user has project granted*/
@@ -0,0 +1,346 @@
+package query
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AuditRecord is the normalized representation of an event for auditing.
+type AuditRecord struct {
+	ID            string
+	CreationDate  time.Time
+	Actor         *AuditActor
+	IP            string
+	UserAgent     string
+	Resource      *AuditResource
+	Action        string
+	Category      domain.AuditActionCategory
+	Outcome       domain.AuditOutcome
+	ChangedFields []string
+}
+
+type AuditActor struct {
+	ID          string
+	Type        domain.AuditActorType
+	DisplayName string
+	LoginName   string
+}
+
+type AuditResource struct {
+	Type           string
+	ID             string
+	OrganizationID string
+}
+
+type AuditRecords struct {
+	Records    []*AuditRecord
+	NextCursor string
+}
+
+// AuditRecordSearchQuery filters the audit records.
+// The records are always returned from the newest to the oldest.
+type AuditRecordSearchQuery struct {
+	OrganizationID string
+	ActorID        string
+	ResourceType   string
+	ResourceID     string
+	Categories     []domain.AuditActionCategory
+	Since          time.Time
+	Until          time.Time
+	Limit          uint64
+	Cursor         string
+}
+
+// auditCursor is the position of the last returned record.
+// As the records are ordered by their creation date, the next page starts at the creation date of the last record
+// and the records of the same creation date are skipped up to the last returned one.
+// The hash of the filters ensures that the cursor is only used with the filters of the query it was returned for.
+type auditCursor struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        string    `json:"id"`
+	// Ties is the count of the returned records with the same creation date as the last one.
+	Ties       uint64 `json:"ties"`
+	FilterHash string `json:"filterHash"`
+}
+
+// auditClientFields are reported as IP and user agent instead of changed fields.
+var auditClientFields = []string{"userAgent", "user_agent", "userAgentID", "remoteIP", "acceptLanguage"}
+
+// ListAuditRecords returns the audit records of the instance or, if an organization is given, of the organization.
+func (q *Queries) ListAuditRecords(ctx context.Context, query *AuditRecordSearchQuery) (_ *AuditRecords, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if query.OrganizationID != "" {
+		err = q.checkPermission(ctx, domain.PermissionAuditRead, query.OrganizationID, query.OrganizationID)
+	} else {
+		err = q.checkPermission(ctx, domain.PermissionAuditRead, instanceID, instanceID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	filterHash := query.filterHash()
+	cursor, err := decodeAuditCursor(query.Cursor, filterHash)
+	if err != nil {
+		return nil, err
+	}
+
+	builder, ok := q.auditRecordsSearchQuery(ctx, instanceID, query, cursor)
+	if !ok {
+		return &AuditRecords{Records: []*AuditRecord{}}, nil
+	}
+	reducer := &auditRecordsReducer{ctx: ctx, q: q, actors: make(map[string]*AuditActor), records: make([]*AuditRecord, 0, query.Limit), limit: query.Limit}
+	if cursor != nil {
+		reducer.skipUntilID = cursor.ID
+		reducer.skipCreatedAt = cursor.CreatedAt
+	}
+	if err = q.eventstore.FilterToReducer(ctx, builder, reducer); err != nil {
+		return nil, err
+	}
+	records := &AuditRecords{Records: reducer.records}
+	if query.Limit > 0 && uint64(len(reducer.records)) == query.Limit {
+		records.NextCursor = encodeAuditCursor(nextAuditCursor(cursor, reducer.records, filterHash))
+	}
+	return records, nil
+}
+
+// filterHash identifies the filters of the query, the limit and cursor are not part of it.
+func (query *AuditRecordSearchQuery) filterHash() string {
+	// marshalling strings, times and integers can't fail
+	data, _ := json.Marshal([]any{
+		query.OrganizationID,
+		query.ActorID,
+		query.ResourceType,
+		query.ResourceID,
+		query.Categories,
+		query.Since,
+		query.Until,
+	})
+	hash := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// nextAuditCursor returns the cursor after the last record of the page.
+func nextAuditCursor(previous *auditCursor, records []*AuditRecord, filterHash string) *auditCursor {
+	last := records[len(records)-1]
+	next := &auditCursor{
+		CreatedAt:  last.CreationDate,
+		ID:         last.ID,
+		FilterHash: filterHash,
+	}
+	if previous != nil && previous.CreatedAt.Equal(last.CreationDate) {
+		next.Ties = previous.Ties
+	}
+	for _, record := range records {
+		if record.CreationDate.Equal(last.CreationDate) {
+			next.Ties++
+		}
+	}
+	return next
+}
+
+// auditRecordsSearchQuery returns false if no event can match the query.
+func (q *Queries) auditRecordsSearchQuery(ctx context.Context, instanceID string, query *AuditRecordSearchQuery, cursor *auditCursor) (*eventstore.SearchQueryBuilder, bool) {
+	builder := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		OrderDesc().
+		InstanceID(instanceID).
+		AwaitOpenTransactions().
+		ResourceOwner(query.OrganizationID).
+		EditorUser(query.ActorID).
+		CreationDateAfter(query.Since).
+		CreationDateBefore(query.Until)
+	if cursor != nil {
+		// the creation date is stored with microsecond precision,
+		// so the records of the same creation date as the cursor are included and skipped by the reducer
+		builder = builder.CreationDateBefore(cursor.CreatedAt.Add(time.Microsecond))
+		if query.Limit > 0 {
+			builder = builder.Limit(query.Limit + cursor.Ties)
+		}
+	} else {
+		builder = builder.Limit(query.Limit)
+	}
+
+	auditLogRetention := q.defaultAuditLogRetention
+	if instanceAuditLogRetention := authz.GetInstance(ctx).AuditLogRetention(); instanceAuditLogRetention != nil {
+		auditLogRetention = *instanceAuditLogRetention
+	}
+	if auditLogRetention != 0 {
+		builder = filterAuditLogRetention(ctx, auditLogRetention, builder)
+	}
+
+	var eventTypes []eventstore.EventType
+	if len(query.Categories) > 0 {
+		for _, eventType := range q.eventstore.EventTypes() {
+			if slices.Contains(query.Categories, domain.AuditActionCategoryFromEventType(eventType)) {
+				eventTypes = append(eventTypes, eventstore.EventType(eventType))
+			}
+		}
+		if len(eventTypes) == 0 {
+			return nil, false
+		}
+	}
+	var aggregateTypes []eventstore.AggregateType
+	if query.ResourceType != "" {
+		aggregateTypes = append(aggregateTypes, eventstore.AggregateType(query.ResourceType))
+	} else {
+		for _, eventType := range eventTypes {
+			aggregateTypes = append(aggregateTypes, eventstore.AggregateTypeFromEventType(eventType))
+		}
+		slices.Sort(aggregateTypes)
+		aggregateTypes = slices.Compact(aggregateTypes)
+	}
+	var aggregateIDs []string
+	if query.ResourceID != "" {
+		aggregateIDs = append(aggregateIDs, query.ResourceID)
+	}
+	if len(aggregateIDs) > 0 || len(aggregateTypes) > 0 || len(eventTypes) > 0 {
+		builder.AddQuery().
+			AggregateIDs(aggregateIDs...).
+			AggregateTypes(aggregateTypes...).
+			EventTypes(eventTypes...).
+			Builder()
+	}
+	return builder, true
+}
+
+type auditRecordsReducer struct {
+	ctx     context.Context
+	q       *Queries
+	records []*AuditRecord
+	actors  map[string]*AuditActor
+	limit   uint64
+
+	// the records of the creation date of the cursor are skipped until the last returned record is passed
+	skipCreatedAt time.Time
+	skipUntilID   string
+}
+
+func (r *auditRecordsReducer) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		if r.skipUntilID != "" && event.CreatedAt().Equal(r.skipCreatedAt) {
+			if auditRecordID(event) == r.skipUntilID {
+				r.skipUntilID = ""
+			}
+			continue
+		}
+		r.skipUntilID = ""
+		if r.limit > 0 && uint64(len(r.records)) == r.limit {
+			return
+		}
+		r.records = append(r.records, r.auditRecord(event))
+	}
+}
+
+func (r *auditRecordsReducer) Reduce() error { return nil }
+
+func (r *auditRecordsReducer) auditRecord(event eventstore.Event) *AuditRecord {
+	actor, ok := r.actors[event.Creator()]
+	if !ok {
+		actor = r.q.auditActorByID(r.ctx, event.Creator())
+		r.actors[event.Creator()] = actor
+	}
+	aggregate := event.Aggregate()
+	record := &AuditRecord{
+		ID:           auditRecordID(event),
+		CreationDate: event.CreatedAt(),
+		Actor:        actor,
+		Resource: &AuditResource{
+			Type:           string(aggregate.Type),
+			ID:             aggregate.ID,
+			OrganizationID: aggregate.ResourceOwner,
+		},
+		Action:   string(event.Type()),
+		Category: domain.AuditActionCategoryFromEventType(string(event.Type())),
+		Outcome:  domain.AuditOutcomeFromEventType(string(event.Type())),
+	}
+	record.IP, record.UserAgent, record.ChangedFields = auditPayloadDetails(event.DataAsBytes())
+	return record
+}
+
+func auditRecordID(event eventstore.Event) string {
+	return event.Aggregate().ID + ":" + strconv.FormatUint(event.Sequence(), 10)
+}
+
+// auditPayloadDetails extracts the client information and the names of the changed fields from the event payload.
+// The values of the changed fields are not returned, as they might contain secrets.
+func auditPayloadDetails(data []byte) (ip, userAgent string, changedFields []string) {
+	if len(data) == 0 {
+		return "", "", nil
+	}
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", "", nil
+	}
+	changedFields = make([]string, 0, len(fields))
+	for field := range fields {
+		if !slices.Contains(auditClientFields, field) {
+			changedFields = append(changedFields, field)
+		}
+	}
+	slices.Sort(changedFields)
+
+	ip, userAgent = domain.AuditClientFromPayload(data)
+	return ip, userAgent, changedFields
+}
+
+// auditActorByID returns the details of the actor,
+// the names are only returned if the caller is allowed to read the user.
+func (q *Queries) auditActorByID(ctx context.Context, userID string) *AuditActor {
+	if userID == "" {
+		return &AuditActor{Type: domain.AuditActorTypeSystem}
+	}
+	user, err := q.GetUserByIDWithPermission(ctx, false, userID, q.checkPermission)
+	if err != nil {
+		return &AuditActor{ID: userID}
+	}
+	actor := &AuditActor{
+		ID:        user.ID,
+		LoginName: user.PreferredLoginName,
+	}
+	if user.Human != nil {
+		actor.Type = domain.AuditActorTypeHuman
+		actor.DisplayName = user.Human.DisplayName
+	} else if user.Machine != nil {
+		actor.Type = domain.AuditActorTypeMachine
+		actor.DisplayName = user.Machine.Name
+	}
+	return actor
+}
+
+// decodeAuditCursor returns nil if no cursor is passed.
+// The cursor must have been returned for the same filters.
+func decodeAuditCursor(cursor, filterHash string) (*auditCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Wd4hN", "Errors.Audit.InvalidCursor")
+	}
+	c := new(auditCursor)
+	if err = json.Unmarshal(data, c); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Lq8bS", "Errors.Audit.InvalidCursor")
+	}
+	if c.FilterHash != filterHash || c.CreatedAt.IsZero() || c.ID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Wd4hF", "Errors.Audit.InvalidCursor")
+	}
+	return c, nil
+}
+
+func encodeAuditCursor(cursor *auditCursor) string {
+	// marshalling a struct of a time, strings and an integer can't fail
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
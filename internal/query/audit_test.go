@@ -0,0 +1,151 @@
+package query
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func Test_auditPayloadDetails(t *testing.T) {
+	tests := []struct {
+		name              string
+		data              []byte
+		wantIP            string
+		wantUserAgent     string
+		wantChangedFields []string
+	}{
+		{
+			name: "no payload",
+		},
+		{
+			name:              "changed fields",
+			data:              []byte(`{"email": "minnie@mouse.com", "displayName": "Minnie"}`),
+			wantChangedFields: []string{"displayName", "email"},
+		},
+		{
+			name:              "browser info",
+			data:              []byte(`{"id": "request-id", "userAgentID": "agent-id", "userAgent": "Mozilla/5.0", "remoteIP": "192.0.2.1"}`),
+			wantIP:            "192.0.2.1",
+			wantUserAgent:     "Mozilla/5.0",
+			wantChangedFields: []string{"id"},
+		},
+		{
+			name:              "session user agent",
+			data:              []byte(`{"userID": "user-id", "user_agent": {"ip": "192.0.2.2", "description": "Firefox", "header": {"User-Agent": ["Mozilla/5.0"]}}}`),
+			wantIP:            "192.0.2.2",
+			wantUserAgent:     "Mozilla/5.0",
+			wantChangedFields: []string{"userID"},
+		},
+		{
+			name:              "oidc session user agent without header",
+			data:              []byte(`{"userID": "user-id", "userAgent": {"ip": "192.0.2.3", "description": "Firefox"}}`),
+			wantIP:            "192.0.2.3",
+			wantUserAgent:     "Firefox",
+			wantChangedFields: []string{"userID"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ip, userAgent, changedFields := auditPayloadDetails(tt.data)
+			assert.Equal(t, tt.wantIP, ip)
+			assert.Equal(t, tt.wantUserAgent, userAgent)
+			assert.Equal(t, tt.wantChangedFields, changedFields)
+		})
+	}
+}
+
+func Test_auditCursor(t *testing.T) {
+	query := &AuditRecordSearchQuery{OrganizationID: "org1", Limit: 10}
+	cursor := &auditCursor{
+		CreatedAt:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		ID:         "agg1:2",
+		Ties:       2,
+		FilterHash: query.filterHash(),
+	}
+	got, err := decodeAuditCursor(encodeAuditCursor(cursor), query.filterHash())
+	require.NoError(t, err)
+	assert.Equal(t, cursor, got)
+
+	got, err = decodeAuditCursor("", query.filterHash())
+	require.NoError(t, err)
+	assert.Nil(t, got)
+
+	_, err = decodeAuditCursor("not a cursor", query.filterHash())
+	assert.True(t, zerrors.IsErrorInvalidArgument(err))
+
+	otherFilters := &AuditRecordSearchQuery{OrganizationID: "org2", Limit: 10}
+	_, err = decodeAuditCursor(encodeAuditCursor(cursor), otherFilters.filterHash())
+	assert.True(t, zerrors.IsErrorInvalidArgument(err))
+
+	otherLimit := &AuditRecordSearchQuery{OrganizationID: "org1", Limit: 20}
+	_, err = decodeAuditCursor(encodeAuditCursor(cursor), otherLimit.filterHash())
+	assert.NoError(t, err)
+}
+
+func Test_nextAuditCursor(t *testing.T) {
+	first := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	records := []*AuditRecord{
+		{ID: "agg1:3", CreationDate: first},
+		{ID: "agg1:2", CreationDate: second},
+		{ID: "agg2:1", CreationDate: second},
+	}
+	tests := []struct {
+		name     string
+		previous *auditCursor
+		want     *auditCursor
+	}{
+		{
+			name: "first page",
+			want: &auditCursor{CreatedAt: second, ID: "agg2:1", Ties: 2, FilterHash: "hash"},
+		},
+		{
+			name:     "previous page of other creation date",
+			previous: &auditCursor{CreatedAt: first.Add(time.Hour), ID: "agg3:1", Ties: 1, FilterHash: "hash"},
+			want:     &auditCursor{CreatedAt: second, ID: "agg2:1", Ties: 2, FilterHash: "hash"},
+		},
+		{
+			name:     "previous page of same creation date",
+			previous: &auditCursor{CreatedAt: second, ID: "agg3:1", Ties: 3, FilterHash: "hash"},
+			want:     &auditCursor{CreatedAt: second, ID: "agg2:1", Ties: 5, FilterHash: "hash"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, nextAuditCursor(tt.previous, records, "hash"))
+		})
+	}
+}
+
+func Test_auditRecordsReducer_skip(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	event := func(id string, sequence uint64, creation time.Time) eventstore.Event {
+		return &eventstore.BaseEvent{
+			Agg:       &eventstore.Aggregate{ID: id, Type: "user"},
+			EventType: "user.added",
+			Seq:       sequence,
+			Creation:  creation,
+		}
+	}
+	reducer := &auditRecordsReducer{
+		actors:        make(map[string]*AuditActor),
+		limit:         2,
+		skipCreatedAt: createdAt,
+		skipUntilID:   "agg1:2",
+	}
+	reducer.AppendEvents(
+		event("agg1", 3, createdAt),
+		event("agg1", 2, createdAt),
+		event("agg2", 1, createdAt),
+		event("agg1", 1, createdAt.Add(-time.Second)),
+		event("agg3", 1, createdAt.Add(-time.Second)),
+	)
+	require.Len(t, reducer.records, 2)
+	assert.Equal(t, "agg2:1", reducer.records[0].ID)
+	assert.Equal(t, "agg1:1", reducer.records[1].ID)
+}
@@ -228,7 +228,7 @@ func (q *Queries) GetAuthNKeyByIDWithPermission(ctx context.Context, shouldTrigg
 		return nil, err
 	}
 
-	if err := appCheckPermission(ctx, key.ResourceOwner, key.AggregateID, permissionCheck); err != nil {
+	if err := appCheckPermission(ctx, key.ResourceOwner, key.AggregateID, key.ApplicationID, permissionCheck); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,58 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// CustomRole is an administrator role defined by the instance administrators.
+type CustomRole struct {
+	Role        string
+	DisplayName string
+	Permissions database.TextArray[string]
+}
+
+//go:embed custom_roles.sql
+var customRolesQuery string
+
+// ListCustomRoles returns the custom administrator roles of the instance and their permissions.
+// The permissions are read from the same source as the permission checks, so the result reflects what is enforced.
+func (q *Queries) ListCustomRoles(ctx context.Context) (_ []*CustomRole, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if err := q.checkPermission(ctx, domain.PermissionInstanceRead, instanceID, instanceID); err != nil {
+		return nil, err
+	}
+
+	roles := make([]*CustomRole, 0)
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			role := new(CustomRole)
+			if err := rows.Scan(
+				&role.Role,
+				&role.DisplayName,
+				&role.Permissions,
+			); err != nil {
+				return err
+			}
+			roles = append(roles, role)
+		}
+		return rows.Err()
+	},
+		customRolesQuery,
+		instanceID,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Cr4lQ", "Errors.Internal")
+	}
+	return roles, nil
+}
@@ -0,0 +1,87 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestQueries_ListCustomRoles(t *testing.T) {
+	expQuery := regexp.QuoteMeta(customRolesQuery)
+	cols := []string{"role", "display_name", "permissions"}
+
+	tests := []struct {
+		name            string
+		mock            sqlExpectation
+		checkPermission func(ctx context.Context, permission, orgID, resourceID string) error
+		want            []*CustomRole
+		wantErr         error
+	}{
+		{
+			name: "permission denied",
+			mock: func(m sqlmock.Sqlmock) sqlmock.Sqlmock { return m },
+			checkPermission: func(ctx context.Context, permission, orgID, resourceID string) error {
+				return zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied")
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "internal error",
+			mock: mockQueryErr(expQuery, sql.ErrConnDone, "instanceID"),
+			checkPermission: func(ctx context.Context, permission, orgID, resourceID string) error {
+				return nil
+			},
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-Cr4lQ", "Errors.Internal"),
+		},
+		{
+			name: "success",
+			mock: mockQueries(expQuery, cols,
+				[][]driver.Value{
+					{"IAM_AUDITOR", "", database.TextArray[string]{}},
+					{"ORG_HELPDESK", "Helpdesk", database.TextArray[string]{"user.credential.write", "user.read"}},
+				},
+				"instanceID",
+			),
+			checkPermission: func(ctx context.Context, permission, orgID, resourceID string) error {
+				return nil
+			},
+			want: []*CustomRole{
+				{
+					Role:        "IAM_AUDITOR",
+					Permissions: database.TextArray[string]{},
+				},
+				{
+					Role:        "ORG_HELPDESK",
+					DisplayName: "Helpdesk",
+					Permissions: database.TextArray[string]{"user.credential.write", "user.read"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+					checkPermission: tt.checkPermission,
+				}
+				ctx := authz.NewMockContext("instanceID", "orgID", "userID")
+				got, err := q.ListCustomRoles(ctx)
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
@@ -0,0 +1,19 @@
+SELECT
+	c.object_id AS role
+	, c."value" #>> '{}' AS display_name
+	, COALESCE(
+		array_agg(rp.permission ORDER BY rp.permission) FILTER (WHERE rp.permission IS NOT NULL)
+		, ARRAY[]::TEXT[]
+	) AS permissions
+FROM eventstore.fields c
+LEFT JOIN eventstore.role_permissions rp
+	ON rp.instance_id = c.instance_id
+	AND rp.aggregate_id = c.aggregate_id
+	AND rp.role = c.object_id
+WHERE c.instance_id = $1
+AND c.aggregate_type = 'permission'
+AND c.aggregate_id = $1
+AND c.object_type = 'custom_role'
+AND c.field_name = 'display_name'
+GROUP BY c.object_id, c."value"
+ORDER BY c.object_id;
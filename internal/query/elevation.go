@@ -0,0 +1,88 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/elevation"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ElevationRequest is a request for time-bound administrator roles, which waits for approval.
+type ElevationRequest struct {
+	ID            string
+	ResourceOwner string
+	UserID        string
+	ResourceType  string
+	Roles         database.TextArray[string]
+	Duration      time.Duration
+	ValidFrom     *time.Time
+	Reason        string
+}
+
+//go:embed elevation_requests.sql
+var elevationRequestsQuery string
+
+// ListElevationRequests returns the pending elevation requests on the instance or organization.
+// The resource owner is the id of the instance for requests of instance roles.
+func (q *Queries) ListElevationRequests(ctx context.Context, resourceOwner string) (_ []*ElevationRequest, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	permission := domain.PermissionOrgMemberRead
+	if resourceOwner == instanceID {
+		permission = domain.PermissionInstanceMemberRead
+	}
+	if err := q.checkPermission(ctx, permission, resourceOwner, resourceOwner); err != nil {
+		return nil, err
+	}
+
+	requests := make([]*ElevationRequest, 0)
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var (
+				request   = new(ElevationRequest)
+				duration  int64
+				validFrom sql.NullTime
+			)
+			if err := rows.Scan(
+				&request.ID,
+				&request.ResourceOwner,
+				&request.UserID,
+				&request.ResourceType,
+				&request.Roles,
+				&duration,
+				&validFrom,
+				&request.Reason,
+			); err != nil {
+				return err
+			}
+			request.Duration = time.Duration(duration) * time.Second
+			if validFrom.Valid {
+				request.ValidFrom = &validFrom.Time
+			}
+			requests = append(requests, request)
+		}
+		return rows.Err()
+	},
+		elevationRequestsQuery,
+		instanceID,
+		resourceOwner,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-El2rq", "Errors.Internal")
+	}
+	return requests, nil
+}
+
+// IsInstanceElevation returns true if the roles are requested on the instance.
+func (r *ElevationRequest) IsInstanceElevation() bool {
+	return r.ResourceType == elevation.ResourceTypeInstance
+}
@@ -0,0 +1,16 @@
+SELECT
+	aggregate_id AS id
+	, resource_owner
+	, "value"->>'userId' AS user_id
+	, "value"->>'resourceType' AS resource_type
+	, ARRAY(SELECT jsonb_array_elements_text("value"->'roles')) AS roles
+	, ("value"->>'durationSeconds')::BIGINT AS duration_seconds
+	, ("value"->>'validFrom')::TIMESTAMPTZ AS valid_from
+	, COALESCE("value"->>'reason', '') AS reason
+FROM eventstore.fields
+WHERE instance_id = $1
+AND resource_owner = $2
+AND aggregate_type = 'elevation'
+AND object_type = 'elevation'
+AND field_name = 'request'
+ORDER BY aggregate_id;
@@ -0,0 +1,109 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestQueries_ListElevationRequests(t *testing.T) {
+	expQuery := regexp.QuoteMeta(elevationRequestsQuery)
+	cols := []string{"id", "resource_owner", "user_id", "resource_type", "roles", "duration_seconds", "valid_from", "reason"}
+	validFrom := time.Date(2026, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		resourceOwner   string
+		mock            sqlExpectation
+		checkPermission func(ctx context.Context, permission, orgID, resourceID string) error
+		want            []*ElevationRequest
+		wantErr         error
+	}{
+		{
+			name:          "permission denied",
+			resourceOwner: "orgID",
+			mock:          func(m sqlmock.Sqlmock) sqlmock.Sqlmock { return m },
+			checkPermission: func(ctx context.Context, permission, orgID, resourceID string) error {
+				if permission != domain.PermissionOrgMemberRead {
+					return nil
+				}
+				return zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied")
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name:          "internal error",
+			resourceOwner: "orgID",
+			mock:          mockQueryErr(expQuery, sql.ErrConnDone, "instanceID", "orgID"),
+			checkPermission: func(ctx context.Context, permission, orgID, resourceID string) error {
+				return nil
+			},
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-El2rq", "Errors.Internal"),
+		},
+		{
+			name:          "instance requests",
+			resourceOwner: "instanceID",
+			mock: mockQueries(expQuery, cols,
+				[][]driver.Value{
+					{"elevation1", "instanceID", "user1", "instance", database.TextArray[string]{"IAM_OWNER"}, int64(3600), nil, "incident"},
+					{"elevation2", "instanceID", "user2", "instance", database.TextArray[string]{"IAM_OWNER", "IAM_AUDITOR"}, int64(1800), validFrom, ""},
+				},
+				"instanceID", "instanceID",
+			),
+			checkPermission: func(ctx context.Context, permission, orgID, resourceID string) error {
+				if permission != domain.PermissionInstanceMemberRead {
+					return zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied")
+				}
+				return nil
+			},
+			want: []*ElevationRequest{
+				{
+					ID:            "elevation1",
+					ResourceOwner: "instanceID",
+					UserID:        "user1",
+					ResourceType:  "instance",
+					Roles:         database.TextArray[string]{"IAM_OWNER"},
+					Duration:      time.Hour,
+					Reason:        "incident",
+				},
+				{
+					ID:            "elevation2",
+					ResourceOwner: "instanceID",
+					UserID:        "user2",
+					ResourceType:  "instance",
+					Roles:         database.TextArray[string]{"IAM_OWNER", "IAM_AUDITOR"},
+					Duration:      30 * time.Minute,
+					ValidFrom:     &validFrom,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+					checkPermission: tt.checkPermission,
+				}
+				ctx := authz.NewMockContext("instanceID", "orgID", "userID")
+				got, err := q.ListElevationRequests(ctx, tt.resourceOwner)
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
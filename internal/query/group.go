@@ -209,7 +209,7 @@ func (q *Queries) searchGroups(ctx context.Context, queries *GroupSearchQuery, p
 		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-FpBnrv", "Errors.Query.InvalidRequest")
 	}
 
-	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
 		groups, err = scan(rows)
 		return err
 	}, stmt, args...)
@@ -0,0 +1,260 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"slices"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	groupGrantTable = table{
+		name:          projection.GroupGrantProjectionTable,
+		instanceIDCol: projection.GroupGrantInstanceID,
+	}
+	GroupGrantColumnID = Column{
+		name:  projection.GroupGrantID,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnCreationDate = Column{
+		name:  projection.GroupGrantCreationDate,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnChangeDate = Column{
+		name:  projection.GroupGrantChangeDate,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnSequence = Column{
+		name:  projection.GroupGrantSequence,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnState = Column{
+		name:  projection.GroupGrantState,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnResourceOwner = Column{
+		name:  projection.GroupGrantResourceOwner,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnInstanceID = Column{
+		name:  projection.GroupGrantInstanceID,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnGroupID = Column{
+		name:  projection.GroupGrantGroupID,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnProjectID = Column{
+		name:  projection.GroupGrantProjectID,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnGrantID = Column{
+		name:  projection.GroupGrantGrantID,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnRoles = Column{
+		name:  projection.GroupGrantRoles,
+		table: groupGrantTable,
+	}
+)
+
+type GroupGrants struct {
+	SearchResponse
+	GroupGrants []*GroupGrant
+}
+
+type GroupGrant struct {
+	// ID represents the aggregate id (id of the group grant)
+	ID              string
+	CreationDate    time.Time
+	ChangeDate      time.Time
+	Sequence        uint64
+	State           domain.UserGrantState
+	ResourceOwner   string
+	Roles           database.TextArray[string]
+	RoleInformation []Role
+	// GrantID represents the project grant id
+	GrantID string
+
+	GroupID   string
+	GroupName string
+
+	ProjectID            string
+	ProjectName          string
+	ProjectResourceOwner string
+}
+
+type GroupGrantsQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *GroupGrantsQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewGroupGrantInIDsSearchQuery(ids []string) (SearchQuery, error) {
+	list := make([]interface{}, len(ids))
+	for i, value := range ids {
+		list[i] = value
+	}
+	return NewListQuery(GroupGrantColumnID, list, ListIn)
+}
+
+func NewGroupGrantInGroupIDsSearchQuery(ids []string) (SearchQuery, error) {
+	list := make([]interface{}, len(ids))
+	for i, value := range ids {
+		list[i] = value
+	}
+	return NewListQuery(GroupGrantColumnGroupID, list, ListIn)
+}
+
+func NewGroupGrantProjectIDSearchQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnProjectID, id, TextEquals)
+}
+
+func NewGroupGrantGrantIDSearchQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnGrantID, id, TextEquals)
+}
+
+func NewGroupGrantResourceOwnerSearchQuery(id string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnResourceOwner, id, TextEquals)
+}
+
+func NewGroupGrantRoleQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnRoles, value, TextListContains)
+}
+
+func groupGrantsCheckPermission(ctx context.Context, grants *GroupGrants, permissionCheck domain.PermissionCheck) {
+	grants.GroupGrants = slices.DeleteFunc(grants.GroupGrants,
+		func(grant *GroupGrant) bool {
+			return userGrantCheckPermission(ctx, grant.ResourceOwner, grant.ProjectID, grant.GrantID, "", nil, permissionCheck) != nil
+		},
+	)
+}
+
+// SearchGroupGrants returns the project authorizations granted to groups.
+func (q *Queries) SearchGroupGrants(ctx context.Context, queries *GroupGrantsQueries, permissionCheck domain.PermissionCheck) (grants *GroupGrants, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	query, scan := prepareGroupGrantsQuery()
+	eq := sq.Eq{GroupGrantColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Gg2Rk", "Errors.Query.InvalidRequest")
+	}
+
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
+		grants, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Tz7wq", "Errors.Internal")
+	}
+	grants.State, err = q.latestState(ctx, groupGrantTable)
+	if err != nil {
+		return nil, err
+	}
+	if permissionCheck != nil {
+		groupGrantsCheckPermission(ctx, grants, permissionCheck)
+	}
+	return grants, nil
+}
+
+func prepareGroupGrantsQuery() (sq.SelectBuilder, func(*sql.Rows) (*GroupGrants, error)) {
+	return sq.Select(
+			GroupGrantColumnID.identifier(),
+			GroupGrantColumnCreationDate.identifier(),
+			GroupGrantColumnChangeDate.identifier(),
+			GroupGrantColumnSequence.identifier(),
+			GroupGrantColumnState.identifier(),
+			GroupGrantColumnResourceOwner.identifier(),
+			GroupGrantColumnGrantID.identifier(),
+			GroupGrantColumnRoles.identifier(),
+			"roles.role_information",
+			GroupGrantColumnGroupID.identifier(),
+			GroupColumnName.identifier(),
+			GroupGrantColumnProjectID.identifier(),
+			ProjectColumnName.identifier(),
+			ProjectColumnResourceOwner.identifier(),
+			countColumn.identifier(),
+		).
+			From(groupGrantTable.identifier()).
+			LeftJoin(join(GroupColumnID, GroupGrantColumnGroupID)).
+			LeftJoin(join(ProjectColumnID, GroupGrantColumnProjectID)).
+			LeftJoin("LATERAL (" + groupGrantRolesInfoQuery + ") as roles ON true").
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*GroupGrants, error) {
+			groupGrants := make([]*GroupGrant, 0)
+			var count uint64
+			for rows.Next() {
+				g := new(GroupGrant)
+
+				var (
+					roles                []byte
+					groupName            sql.NullString
+					projectName          sql.NullString
+					projectResourceOwner sql.NullString
+				)
+
+				err := rows.Scan(
+					&g.ID,
+					&g.CreationDate,
+					&g.ChangeDate,
+					&g.Sequence,
+					&g.State,
+					&g.ResourceOwner,
+					&g.GrantID,
+					&g.Roles,
+					&roles,
+					&g.GroupID,
+					&groupName,
+					&g.ProjectID,
+					&projectName,
+					&projectResourceOwner,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				if len(roles) > 0 {
+					if err = json.Unmarshal(roles, &g.RoleInformation); err != nil {
+						return nil, err
+					}
+				}
+				g.GroupName = groupName.String
+				g.ProjectName = projectName.String
+				g.ProjectResourceOwner = projectResourceOwner.String
+
+				groupGrants = append(groupGrants, g)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Pl3vS", "Errors.Query.CloseRows")
+			}
+
+			return &GroupGrants{
+				GroupGrants: groupGrants,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+var groupGrantRolesInfoQuery = projectRolesInfoQuery(GroupGrantColumnInstanceID, GroupGrantColumnProjectID, GroupGrantColumnRoles)
@@ -0,0 +1,155 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+var (
+	groupGrantsStmt = regexp.QuoteMeta(
+		"SELECT projections.group_grants1.id" +
+			", projections.group_grants1.creation_date" +
+			", projections.group_grants1.change_date" +
+			", projections.group_grants1.sequence" +
+			", projections.group_grants1.state" +
+			", projections.group_grants1.resource_owner" +
+			", projections.group_grants1.grant_id" +
+			", projections.group_grants1.roles" +
+			", roles.role_information" +
+			", projections.group_grants1.group_id" +
+			", projections.groups1.name" +
+			", projections.group_grants1.project_id" +
+			", projections.projects4.name" +
+			", projections.projects4.resource_owner" +
+			", COUNT(*) OVER ()" +
+			" FROM projections.group_grants1" +
+			" LEFT JOIN projections.groups1 ON projections.group_grants1.group_id = projections.groups1.id AND projections.group_grants1.instance_id = projections.groups1.instance_id" +
+			" LEFT JOIN projections.projects4 ON projections.group_grants1.project_id = projections.projects4.id AND projections.group_grants1.instance_id = projections.projects4.instance_id" +
+			" LEFT JOIN LATERAL (SELECT JSON_AGG( JSON_BUILD_OBJECT( 'role_key', pr.role_key, 'display_name', pr.display_name, 'group_name', pr.group_name ) ) as role_information FROM projections.project_roles4 pr WHERE pr.instance_id = projections.group_grants1.instance_id AND pr.project_id = projections.group_grants1.project_id AND pr.role_key = ANY(projections.group_grants1.roles)) as roles ON true")
+
+	groupGrantsCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"state",
+		"resource_owner",
+		"grant_id",
+		"roles",
+		"role_information",
+		"group_id",
+		"name",
+		"project_id",
+		"name",
+		"resource_owner",
+		"count",
+	}
+)
+
+func Test_GroupGrantPrepares(t *testing.T) {
+	t.Parallel()
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareGroupGrantsQuery no result",
+			prepare: prepareGroupGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					groupGrantsStmt,
+					nil,
+					nil,
+				),
+			},
+			object: &GroupGrants{GroupGrants: []*GroupGrant{}},
+		},
+		{
+			name:    "prepareGroupGrantsQuery one grant",
+			prepare: prepareGroupGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					groupGrantsStmt,
+					groupGrantsCols,
+					[][]driver.Value{
+						{
+							"id",
+							testNow,
+							testNow,
+							20211111,
+							domain.UserGrantStateActive,
+							"ro",
+							"grant-id",
+							database.TextArray[string]{"role-key"},
+							`[{"display_name":"displayName","group_name":"groupName","role_key":"role-key"}]`,
+							"group-id",
+							"group-name",
+							"project-id",
+							"project-name",
+							"project-resource-owner",
+						},
+					},
+				),
+			},
+			object: &GroupGrants{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				GroupGrants: []*GroupGrant{
+					{
+						ID:                   "id",
+						CreationDate:         testNow,
+						ChangeDate:           testNow,
+						Sequence:             20211111,
+						State:                domain.UserGrantStateActive,
+						ResourceOwner:        "ro",
+						GrantID:              "grant-id",
+						Roles:                database.TextArray[string]{"role-key"},
+						RoleInformation:      []Role{{Key: "role-key", DisplayName: "displayName", GroupName: "groupName"}},
+						GroupID:              "group-id",
+						GroupName:            "group-name",
+						ProjectID:            "project-id",
+						ProjectName:          "project-name",
+						ProjectResourceOwner: "project-resource-owner",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareGroupGrantsQuery sql err",
+			prepare: prepareGroupGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					groupGrantsStmt,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*GroupGrants)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
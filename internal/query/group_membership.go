@@ -0,0 +1,57 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// GroupMembership is the membership a user inherits from the groups the user is part of.
+// The roles of all groups of the user on the same resource are combined.
+type GroupMembership struct {
+	// AggregateType is the type of the resource, either the instance, an organization or a project.
+	AggregateType eventstore.AggregateType
+	AggregateID   string
+	Roles         database.TextArray[string]
+}
+
+//go:embed group_memberships.sql
+var groupMembershipsQuery string
+
+// GroupMembershipsByUser returns the memberships the user inherits from its groups on the instance and the organization.
+// It is used to resolve the permissions of the user and therefore doesn't check any permission.
+func (q *Queries) GroupMembershipsByUser(ctx context.Context, userID, orgID string) (_ []*GroupMembership, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	memberships := make([]*GroupMembership, 0)
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			membership := new(GroupMembership)
+			if err := rows.Scan(
+				&membership.AggregateType,
+				&membership.AggregateID,
+				&membership.Roles,
+			); err != nil {
+				return err
+			}
+			memberships = append(memberships, membership)
+		}
+		return rows.Err()
+	},
+		groupMembershipsQuery,
+		authz.GetInstance(ctx).InstanceID(),
+		userID,
+		orgID,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Gm4uB", "Errors.Internal")
+	}
+	return memberships, nil
+}
@@ -0,0 +1,74 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestQueries_GroupMembershipsByUser(t *testing.T) {
+	expQuery := regexp.QuoteMeta(groupMembershipsQuery)
+	cols := []string{"aggregate_type", "aggregate_id", "array_agg"}
+
+	tests := []struct {
+		name    string
+		mock    sqlExpectation
+		want    []*GroupMembership
+		wantErr error
+	}{
+		{
+			name:    "internal error",
+			mock:    mockQueryErr(expQuery, sql.ErrConnDone, "instanceID", "userID", "orgID"),
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-Gm4uB", "Errors.Internal"),
+		},
+		{
+			name: "no memberships",
+			mock: mockQueries(expQuery, cols, nil, "instanceID", "userID", "orgID"),
+			want: []*GroupMembership{},
+		},
+		{
+			name: "instance and organization memberships",
+			mock: mockQueries(expQuery, cols,
+				[][]driver.Value{
+					{"instance", "instanceID", database.TextArray[string]{"IAM_OWNER_VIEWER"}},
+					{"org", "orgID", database.TextArray[string]{"ORG_OWNER", "ORG_USER_MANAGER"}},
+				},
+				"instanceID", "userID", "orgID",
+			),
+			want: []*GroupMembership{
+				{
+					AggregateType: "instance",
+					AggregateID:   "instanceID",
+					Roles:         database.TextArray[string]{"IAM_OWNER_VIEWER"},
+				},
+				{
+					AggregateType: "org",
+					AggregateID:   "orgID",
+					Roles:         database.TextArray[string]{"ORG_OWNER", "ORG_USER_MANAGER"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+				}
+				got, err := q.GroupMembershipsByUser(authz.NewMockContext("instanceID", "orgID", "userID"), "userID", "orgID")
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
@@ -0,0 +1,17 @@
+SELECT
+	m.aggregate_type
+	, m.aggregate_id
+	, array_agg(DISTINCT m.text_value)
+FROM eventstore.fields m
+JOIN eventstore.fields gu
+	ON gu.instance_id = m.instance_id
+	AND gu.aggregate_type = 'group'
+	AND gu.aggregate_id = m.object_id
+	AND gu.object_type = 'group_user'
+	AND gu.field_name = 'user_id'
+	AND gu.text_value = $2
+WHERE m.instance_id = $1
+AND m.object_type IN ('instance_group_member_role', 'org_group_member_role', 'project_group_member_role')
+AND m.field_name IN ('instance_role', 'org_role', 'project_role')
+AND (m.aggregate_type = 'instance' OR m.resource_owner = $3)
+GROUP BY m.aggregate_type, m.aggregate_id;
@@ -117,7 +117,7 @@ func (q *Queries) searchGroupUsers(ctx context.Context, queries *GroupUsersSearc
 		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-TTlfF6", "Errors.Query.InvalidRequest")
 	}
 
-	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
 		groupUsers, err = scan(rows)
 		return err
 	}, stmt, args...)
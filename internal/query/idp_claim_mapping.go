@@ -0,0 +1,77 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+type IDPClaimMappings struct {
+	IDPID      string
+	ChangeDate time.Time
+	Mappings   []*domain.IDPClaimMapping
+}
+
+// IDPClaimMappingsByIDPID returns the claim mappings of the identity provider,
+// the permission to read the identity provider is checked.
+func (q *Queries) IDPClaimMappingsByIDPID(ctx context.Context, idpID string, permissionCheck domain.PermissionCheck) (_ *IDPClaimMappings, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if _, err = q.IDPTemplateByID(ctx, false, idpID, false, permissionCheck); err != nil {
+		return nil, err
+	}
+	readModel := newIDPClaimMappingsReadModel(idpID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	return &IDPClaimMappings{
+		IDPID:      idpID,
+		ChangeDate: readModel.ChangeDate,
+		Mappings:   readModel.mappings,
+	}, nil
+}
+
+type idpClaimMappingsReadModel struct {
+	eventstore.ReadModel
+
+	idpID    string
+	mappings []*domain.IDPClaimMapping
+}
+
+func newIDPClaimMappingsReadModel(idpID string) *idpClaimMappingsReadModel {
+	return &idpClaimMappingsReadModel{
+		idpID: idpID,
+	}
+}
+
+func (rm *idpClaimMappingsReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *instance.IDPClaimMappingsSetEvent:
+			rm.mappings = e.Mappings
+		case *org.IDPClaimMappingsSetEvent:
+			rm.mappings = e.Mappings
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *idpClaimMappingsReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		EventTypes(instance.IDPClaimMappingsSetEventType).
+		EventData(map[string]interface{}{"id": rm.idpID}).
+		Or().
+		AggregateTypes(org.AggregateType).
+		EventTypes(org.IDPClaimMappingsSetEventType).
+		EventData(map[string]interface{}{"id": rm.idpID}).
+		Builder()
+}
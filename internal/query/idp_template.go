@@ -47,6 +47,7 @@ type IDPTemplate struct {
 	*LDAPIDPTemplate
 	*AppleIDPTemplate
 	*SAMLIDPTemplate
+	*WSFederationIDPTemplate
 }
 
 type IDPTemplates struct {
@@ -169,6 +170,15 @@ type SAMLIDPTemplate struct {
 	FederatedLogoutEnabled        bool
 }
 
+type WSFederationIDPTemplate struct {
+	IDPID       string
+	SSOEndpoint string
+	Realm       string
+	// SigningCertificate contains the PEM encoded token signing certificates of the provider
+	SigningCertificate []byte
+	IDClaim            string
+}
+
 var (
 	idpTemplateTable = table{
 		name:          projection.IDPTemplateTable,
@@ -736,6 +746,37 @@ var (
 	}
 )
 
+var (
+	wsFederationIdpTemplateTable = table{
+		name:          projection.IDPTemplateWSFederationTable,
+		instanceIDCol: projection.WSFederationInstanceIDCol,
+	}
+	WSFederationIDCol = Column{
+		name:  projection.WSFederationIDCol,
+		table: wsFederationIdpTemplateTable,
+	}
+	WSFederationInstanceIDCol = Column{
+		name:  projection.WSFederationInstanceIDCol,
+		table: wsFederationIdpTemplateTable,
+	}
+	WSFederationSSOEndpointCol = Column{
+		name:  projection.WSFederationSSOEndpointCol,
+		table: wsFederationIdpTemplateTable,
+	}
+	WSFederationRealmCol = Column{
+		name:  projection.WSFederationRealmCol,
+		table: wsFederationIdpTemplateTable,
+	}
+	WSFederationCertificateCol = Column{
+		name:  projection.WSFederationCertificateCol,
+		table: wsFederationIdpTemplateTable,
+	}
+	WSFederationIDClaimCol = Column{
+		name:  projection.WSFederationIDClaimCol,
+		table: wsFederationIdpTemplateTable,
+	}
+)
+
 // IDPTemplateByID searches for the requested id with permission check if necessary
 func (q *Queries) IDPTemplateByID(ctx context.Context, shouldTriggerBulk bool, id string, withOwnerRemoved bool, permissionCheck domain.PermissionCheck, queries ...SearchQuery) (template *IDPTemplate, err error) {
 	idp, err := q.idpTemplateByID(ctx, shouldTriggerBulk, id, withOwnerRemoved, queries...)
@@ -992,6 +1033,12 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 			AppleKeyIDCol.identifier(),
 			ApplePrivateKeyCol.identifier(),
 			AppleScopesCol.identifier(),
+			// ws-federation
+			WSFederationIDCol.identifier(),
+			WSFederationSSOEndpointCol.identifier(),
+			WSFederationRealmCol.identifier(),
+			WSFederationCertificateCol.identifier(),
+			WSFederationIDClaimCol.identifier(),
 		).From(idpTemplateTable.identifier()).
 			LeftJoin(join(OAuthIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(OIDCIDCol, IDPTemplateIDCol)).
@@ -1005,6 +1052,7 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 			LeftJoin(join(SAMLIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(LDAPIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(AppleIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(WSFederationIDCol, IDPTemplateIDCol)).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*IDPTemplate, error) {
 			idpTemplate := new(IDPTemplate)
@@ -1114,6 +1162,12 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 			applePrivateKey := new(crypto.CryptoValue)
 			appleScopes := database.TextArray[string]{}
 
+			wsFederationID := sql.NullString{}
+			wsFederationSSOEndpoint := sql.NullString{}
+			wsFederationRealm := sql.NullString{}
+			var wsFederationCertificate []byte
+			wsFederationIDClaim := sql.NullString{}
+
 			err := row.Scan(
 				&idpTemplate.ID,
 				&idpTemplate.ResourceOwner,
@@ -1232,6 +1286,12 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 				&appleKeyID,
 				&applePrivateKey,
 				&appleScopes,
+				// ws-federation
+				&wsFederationID,
+				&wsFederationSSOEndpoint,
+				&wsFederationRealm,
+				&wsFederationCertificate,
+				&wsFederationIDClaim,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -1383,6 +1443,15 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 					Scopes:     appleScopes,
 				}
 			}
+			if wsFederationID.Valid {
+				idpTemplate.WSFederationIDPTemplate = &WSFederationIDPTemplate{
+					IDPID:              wsFederationID.String,
+					SSOEndpoint:        wsFederationSSOEndpoint.String,
+					Realm:              wsFederationRealm.String,
+					SigningCertificate: wsFederationCertificate,
+					IDClaim:            wsFederationIDClaim.String,
+				}
+			}
 
 			return idpTemplate, nil
 		}
@@ -1508,6 +1577,12 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 			AppleKeyIDCol.identifier(),
 			ApplePrivateKeyCol.identifier(),
 			AppleScopesCol.identifier(),
+			// ws-federation
+			WSFederationIDCol.identifier(),
+			WSFederationSSOEndpointCol.identifier(),
+			WSFederationRealmCol.identifier(),
+			WSFederationCertificateCol.identifier(),
+			WSFederationIDClaimCol.identifier(),
 			// count
 			countColumn.identifier(),
 		).From(idpTemplateTable.identifier()).
@@ -1523,6 +1598,7 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 			LeftJoin(join(SAMLIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(LDAPIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(AppleIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(WSFederationIDCol, IDPTemplateIDCol)).
 			PlaceholderFormat(sq.Dollar),
 		func(rows *sql.Rows) (*IDPTemplates, error) {
 			templates := make([]*IDPTemplate, 0)
@@ -1635,6 +1711,12 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 				applePrivateKey := new(crypto.CryptoValue)
 				appleScopes := database.TextArray[string]{}
 
+				wsFederationID := sql.NullString{}
+				wsFederationSSOEndpoint := sql.NullString{}
+				wsFederationRealm := sql.NullString{}
+				var wsFederationCertificate []byte
+				wsFederationIDClaim := sql.NullString{}
+
 				err := rows.Scan(
 					&idpTemplate.ID,
 					&idpTemplate.ResourceOwner,
@@ -1753,6 +1835,12 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 					&appleKeyID,
 					&applePrivateKey,
 					&appleScopes,
+					// ws-federation
+					&wsFederationID,
+					&wsFederationSSOEndpoint,
+					&wsFederationRealm,
+					&wsFederationCertificate,
+					&wsFederationIDClaim,
 					&count,
 				)
 
@@ -1903,6 +1991,15 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 						Scopes:     appleScopes,
 					}
 				}
+				if wsFederationID.Valid {
+					idpTemplate.WSFederationIDPTemplate = &WSFederationIDPTemplate{
+						IDPID:              wsFederationID.String,
+						SSOEndpoint:        wsFederationSSOEndpoint.String,
+						Realm:              wsFederationRealm.String,
+						SigningCertificate: wsFederationCertificate,
+						IDClaim:            wsFederationIDClaim.String,
+					}
+				}
 				templates = append(templates, idpTemplate)
 			}
 
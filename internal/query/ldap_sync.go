@@ -0,0 +1,352 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	ldapSyncTable = table{
+		name:          projection.LDAPSyncTable,
+		instanceIDCol: projection.LDAPSyncInstanceIDCol,
+	}
+	LDAPSyncColumnInstanceID = Column{
+		name:  projection.LDAPSyncInstanceIDCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnIDPID = Column{
+		name:  projection.LDAPSyncIDPIDCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnResourceOwner = Column{
+		name:  projection.LDAPSyncResourceOwnerCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnOrganizationID = Column{
+		name:  projection.LDAPSyncOrganizationIDCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnCreationDate = Column{
+		name:  projection.LDAPSyncCreationDateCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnChangeDate = Column{
+		name:  projection.LDAPSyncChangeDateCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnSequence = Column{
+		name:  projection.LDAPSyncSequenceCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnInterval = Column{
+		name:  projection.LDAPSyncIntervalCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnFullSyncInterval = Column{
+		name:  projection.LDAPSyncFullSyncIntervalCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnFilter = Column{
+		name:  projection.LDAPSyncFilterCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnPageSize = Column{
+		name:  projection.LDAPSyncPageSizeCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnIncrementalAttribute = Column{
+		name:  projection.LDAPSyncIncrementalAttributeCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnGroupAttribute = Column{
+		name:  projection.LDAPSyncGroupAttributeCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnGroupMappings = Column{
+		name:  projection.LDAPSyncGroupMappingsCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnFullSyncRequested = Column{
+		name:  projection.LDAPSyncFullSyncRequestedCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnWatermark = Column{
+		name:  projection.LDAPSyncWatermarkCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnNextRunAt = Column{
+		name:  projection.LDAPSyncNextRunAtCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnLastRunDate = Column{
+		name:  projection.LDAPSyncLastRunDateCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnLastFullSyncDate = Column{
+		name:  projection.LDAPSyncLastFullSyncDateCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnLastSummary = Column{
+		name:  projection.LDAPSyncLastSummaryCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnLastErrorDate = Column{
+		name:  projection.LDAPSyncLastErrorDateCol,
+		table: ldapSyncTable,
+	}
+	LDAPSyncColumnLastError = Column{
+		name:  projection.LDAPSyncLastErrorCol,
+		table: ldapSyncTable,
+	}
+)
+
+// LDAPSync is the scheduled synchronization of an LDAP identity provider including the state of its latest run.
+type LDAPSync struct {
+	IDPID          string
+	ResourceOwner  string
+	OrganizationID string
+	CreationDate   time.Time
+	ChangeDate     time.Time
+	Sequence       uint64
+
+	Interval             time.Duration
+	FullSyncInterval     time.Duration
+	Filter               string
+	PageSize             uint32
+	IncrementalAttribute domain.LDAPSyncIncrementalAttribute
+	GroupAttribute       string
+	GroupMappings        []*domain.LDAPSyncGroupMapping
+
+	FullSyncRequested bool
+	Watermark         string
+	NextRunAt         time.Time
+	LastRunDate       time.Time
+	LastFullSyncDate  time.Time
+	LastSummary       *domain.LDAPSyncSummary
+	LastErrorDate     time.Time
+	LastError         string
+}
+
+// LDAPSyncByIDPID returns the synchronization of the identity provider.
+// It is used by the synchronization worker and therefore doesn't check any permission.
+func (q *Queries) LDAPSyncByIDPID(ctx context.Context, shouldTriggerBulk bool, idpID string) (_ *LDAPSync, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		triggerBatch(ctx, projection.LDAPSyncProjection)
+	}
+
+	eq := sq.Eq{
+		LDAPSyncColumnIDPID.identifier():      idpID,
+		LDAPSyncColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareLDAPSyncQuery()
+	return genericRowQuery(ctx, q.client, query.Where(eq), scan)
+}
+
+func (q *Queries) GetLDAPSyncWithPermission(ctx context.Context, idpID string, permissionCheck domain.PermissionCheck) (_ *LDAPSync, err error) {
+	sync, err := q.LDAPSyncByIDPID(ctx, false, idpID)
+	if err != nil {
+		return nil, err
+	}
+	permission := domain.PermissionOrgIDPRead
+	if sync.ResourceOwner == authz.GetInstance(ctx).InstanceID() {
+		permission = domain.PermissionIDPRead
+	}
+	if err := permissionCheck(ctx, permission, sync.ResourceOwner, sync.IDPID); err != nil {
+		return nil, err
+	}
+	return sync, nil
+}
+
+func prepareLDAPSyncQuery() (sq.SelectBuilder, func(row *sql.Row) (*LDAPSync, error)) {
+	return sq.Select(
+			LDAPSyncColumnIDPID.identifier(),
+			LDAPSyncColumnResourceOwner.identifier(),
+			LDAPSyncColumnOrganizationID.identifier(),
+			LDAPSyncColumnCreationDate.identifier(),
+			LDAPSyncColumnChangeDate.identifier(),
+			LDAPSyncColumnSequence.identifier(),
+			LDAPSyncColumnInterval.identifier(),
+			LDAPSyncColumnFullSyncInterval.identifier(),
+			LDAPSyncColumnFilter.identifier(),
+			LDAPSyncColumnPageSize.identifier(),
+			LDAPSyncColumnIncrementalAttribute.identifier(),
+			LDAPSyncColumnGroupAttribute.identifier(),
+			LDAPSyncColumnGroupMappings.identifier(),
+			LDAPSyncColumnFullSyncRequested.identifier(),
+			LDAPSyncColumnWatermark.identifier(),
+			LDAPSyncColumnNextRunAt.identifier(),
+			LDAPSyncColumnLastRunDate.identifier(),
+			LDAPSyncColumnLastFullSyncDate.identifier(),
+			LDAPSyncColumnLastSummary.identifier(),
+			LDAPSyncColumnLastErrorDate.identifier(),
+			LDAPSyncColumnLastError.identifier(),
+		).From(ldapSyncTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*LDAPSync, error) {
+			sync := new(LDAPSync)
+			var (
+				filter           sql.NullString
+				groupAttribute   sql.NullString
+				groupMappings    []byte
+				watermark        sql.NullString
+				nextRunAt        sql.NullTime
+				lastRunDate      sql.NullTime
+				lastFullSyncDate sql.NullTime
+				lastSummary      []byte
+				lastErrorDate    sql.NullTime
+				lastError        sql.NullString
+			)
+			err := row.Scan(
+				&sync.IDPID,
+				&sync.ResourceOwner,
+				&sync.OrganizationID,
+				&sync.CreationDate,
+				&sync.ChangeDate,
+				&sync.Sequence,
+				&sync.Interval,
+				&sync.FullSyncInterval,
+				&filter,
+				&sync.PageSize,
+				&sync.IncrementalAttribute,
+				&groupAttribute,
+				&groupMappings,
+				&sync.FullSyncRequested,
+				&watermark,
+				&nextRunAt,
+				&lastRunDate,
+				&lastFullSyncDate,
+				&lastSummary,
+				&lastErrorDate,
+				&lastError,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Ls9qN", "Errors.IDP.LDAPSync.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Ls9qI", "Errors.Internal")
+			}
+			if len(groupMappings) > 0 {
+				if err := json.Unmarshal(groupMappings, &sync.GroupMappings); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Ls9qM", "Errors.Internal")
+				}
+			}
+			if len(lastSummary) > 0 {
+				sync.LastSummary = new(domain.LDAPSyncSummary)
+				if err := json.Unmarshal(lastSummary, sync.LastSummary); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Ls9qS", "Errors.Internal")
+				}
+			}
+			sync.Filter = filter.String
+			sync.GroupAttribute = groupAttribute.String
+			sync.Watermark = watermark.String
+			sync.NextRunAt = nextRunAt.Time
+			sync.LastRunDate = lastRunDate.Time
+			sync.LastFullSyncDate = lastFullSyncDate.Time
+			sync.LastErrorDate = lastErrorDate.Time
+			sync.LastError = lastError.String
+			return sync, nil
+		}
+}
+
+// DueLDAPSync is the synchronization of an identity provider which is due to run.
+type DueLDAPSync struct {
+	InstanceID    string
+	ResourceOwner string
+	IDPID         string
+}
+
+//go:embed ldap_sync_due.sql
+var dueLDAPSyncsQuery string
+
+// SearchDueLDAPSyncs returns the synchronizations of all instances which are due at the given time, the longest overdue first.
+// It is used by the synchronization worker and therefore doesn't check any permission.
+func (q *Queries) SearchDueLDAPSyncs(ctx context.Context, dueAt time.Time, limit uint32) (_ []*DueLDAPSync, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	syncs := make([]*DueLDAPSync, 0)
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			sync := new(DueLDAPSync)
+			if err := rows.Scan(
+				&sync.InstanceID,
+				&sync.ResourceOwner,
+				&sync.IDPID,
+			); err != nil {
+				return err
+			}
+			syncs = append(syncs, sync)
+		}
+		return rows.Err()
+	},
+		dueLDAPSyncsQuery,
+		dueAt.Unix(),
+		limit,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ls9dU", "Errors.Internal")
+	}
+	return syncs, nil
+}
+
+// LDAPSyncedUser is a user linked to an LDAP identity provider.
+type LDAPSyncedUser struct {
+	UserID         string
+	ExternalUserID string
+	ResourceOwner  string
+	State          domain.UserState
+}
+
+//go:embed ldap_sync_users.sql
+var ldapSyncedUsersQuery string
+
+// LDAPSyncedUsers returns all users linked to the identity provider including their state.
+// It is used by the synchronization worker and therefore doesn't check any permission.
+func (q *Queries) LDAPSyncedUsers(ctx context.Context, shouldTriggerBulk bool, idpID string) (_ []*LDAPSyncedUser, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		triggerBatch(ctx, projection.IDPUserLinkProjection, projection.UserProjection)
+	}
+
+	users := make([]*LDAPSyncedUser, 0)
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			user := new(LDAPSyncedUser)
+			if err := rows.Scan(
+				&user.UserID,
+				&user.ExternalUserID,
+				&user.ResourceOwner,
+				&user.State,
+			); err != nil {
+				return err
+			}
+			users = append(users, user)
+		}
+		return rows.Err()
+	},
+		ldapSyncedUsersQuery,
+		authz.GetInstance(ctx).InstanceID(),
+		idpID,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Ls9uI", "Errors.Internal")
+	}
+	return users, nil
+}
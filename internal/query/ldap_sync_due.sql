@@ -0,0 +1,11 @@
+SELECT
+	instance_id
+	, resource_owner
+	, aggregate_id
+FROM eventstore.fields
+WHERE object_type = 'ldap_sync'
+AND field_name = 'next_run_at'
+AND number_value IS NOT NULL
+AND number_value <= $1
+ORDER BY number_value
+LIMIT $2;
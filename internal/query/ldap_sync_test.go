@@ -0,0 +1,246 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestQueries_SearchDueLDAPSyncs(t *testing.T) {
+	expQuery := regexp.QuoteMeta(dueLDAPSyncsQuery)
+	cols := []string{"instance_id", "resource_owner", "aggregate_id"}
+	dueAt := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name    string
+		mock    sqlExpectation
+		want    []*DueLDAPSync
+		wantErr error
+	}{
+		{
+			name:    "internal error",
+			mock:    mockQueryErr(expQuery, sql.ErrConnDone, int64(1700000000), uint32(10)),
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-Ls9dU", "Errors.Internal"),
+		},
+		{
+			name: "success",
+			mock: mockQueries(expQuery, cols,
+				[][]driver.Value{
+					{"instance1", "instance1", "idp1"},
+					{"instance2", "org2", "idp2"},
+				},
+				int64(1700000000), uint32(10),
+			),
+			want: []*DueLDAPSync{
+				{
+					InstanceID:    "instance1",
+					ResourceOwner: "instance1",
+					IDPID:         "idp1",
+				},
+				{
+					InstanceID:    "instance2",
+					ResourceOwner: "org2",
+					IDPID:         "idp2",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+				}
+				got, err := q.SearchDueLDAPSyncs(context.Background(), dueAt, 10)
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
+
+func TestQueries_LDAPSyncedUsers(t *testing.T) {
+	expQuery := regexp.QuoteMeta(ldapSyncedUsersQuery)
+	cols := []string{"user_id", "external_user_id", "resource_owner", "state"}
+
+	tests := []struct {
+		name    string
+		mock    sqlExpectation
+		want    []*LDAPSyncedUser
+		wantErr error
+	}{
+		{
+			name:    "internal error",
+			mock:    mockQueryErr(expQuery, sql.ErrConnDone, "instance1", "idp1"),
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-Ls9uI", "Errors.Internal"),
+		},
+		{
+			name: "success",
+			mock: mockQueries(expQuery, cols,
+				[][]driver.Value{
+					{"user1", "alice", "org1", domain.UserStateActive},
+					{"user2", "bob", "org1", domain.UserStateInactive},
+				},
+				"instance1", "idp1",
+			),
+			want: []*LDAPSyncedUser{
+				{
+					UserID:         "user1",
+					ExternalUserID: "alice",
+					ResourceOwner:  "org1",
+					State:          domain.UserStateActive,
+				},
+				{
+					UserID:         "user2",
+					ExternalUserID: "bob",
+					ResourceOwner:  "org1",
+					State:          domain.UserStateInactive,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+				}
+				got, err := q.LDAPSyncedUsers(authz.WithInstanceID(context.Background(), "instance1"), false, "idp1")
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
+
+func Test_LDAPSyncPrepares(t *testing.T) {
+	prepareStmt := regexp.QuoteMeta(`SELECT projections.ldap_syncs.idp_id,` +
+		` projections.ldap_syncs.resource_owner,` +
+		` projections.ldap_syncs.organization_id,` +
+		` projections.ldap_syncs.creation_date,` +
+		` projections.ldap_syncs.change_date,` +
+		` projections.ldap_syncs.sequence,` +
+		` projections.ldap_syncs.interval,` +
+		` projections.ldap_syncs.full_sync_interval,` +
+		` projections.ldap_syncs.filter,` +
+		` projections.ldap_syncs.page_size,` +
+		` projections.ldap_syncs.incremental_attribute,` +
+		` projections.ldap_syncs.group_attribute,` +
+		` projections.ldap_syncs.group_mappings,` +
+		` projections.ldap_syncs.full_sync_requested,` +
+		` projections.ldap_syncs.watermark,` +
+		` projections.ldap_syncs.next_run_at,` +
+		` projections.ldap_syncs.last_run_date,` +
+		` projections.ldap_syncs.last_full_sync_date,` +
+		` projections.ldap_syncs.last_summary,` +
+		` projections.ldap_syncs.last_error_date,` +
+		` projections.ldap_syncs.last_error` +
+		` FROM projections.ldap_syncs`)
+	prepareCols := []string{"idp_id", "resource_owner", "organization_id", "creation_date", "change_date", "sequence", "interval", "full_sync_interval", "filter", "page_size", "incremental_attribute", "group_attribute", "group_mappings", "full_sync_requested", "watermark", "next_run_at", "last_run_date", "last_full_sync_date", "last_summary", "last_error_date", "last_error"}
+
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareLDAPSyncQuery, no result",
+			prepare: prepareLDAPSyncQuery,
+			want: want{
+				sqlExpectations: mockQueryScanErr(
+					prepareStmt,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*LDAPSync)(nil),
+		},
+		{
+			name:    "prepareLDAPSyncQuery, found",
+			prepare: prepareLDAPSyncQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					prepareStmt,
+					prepareCols,
+					[]driver.Value{
+						"idp-id",
+						"ro",
+						"org-id",
+						testNow,
+						testNow,
+						uint64(20211109),
+						int64(time.Hour),
+						int64(24 * time.Hour),
+						"(department=it)",
+						uint32(100),
+						domain.LDAPSyncIncrementalAttributeModifyTimestamp,
+						"memberOf",
+						[]byte(`[{"ldapGroup":"cn=admins,dc=example,dc=com","groupId":"group-id"}]`),
+						false,
+						"20250101100000Z",
+						testNow,
+						testNow,
+						testNow,
+						[]byte(`{"full":true,"created":2}`),
+						nil,
+						nil,
+					},
+				),
+			},
+			object: &LDAPSync{
+				IDPID:                "idp-id",
+				ResourceOwner:        "ro",
+				OrganizationID:       "org-id",
+				CreationDate:         testNow,
+				ChangeDate:           testNow,
+				Sequence:             20211109,
+				Interval:             time.Hour,
+				FullSyncInterval:     24 * time.Hour,
+				Filter:               "(department=it)",
+				PageSize:             100,
+				IncrementalAttribute: domain.LDAPSyncIncrementalAttributeModifyTimestamp,
+				GroupAttribute:       "memberOf",
+				GroupMappings: []*domain.LDAPSyncGroupMapping{
+					{LDAPGroup: "cn=admins,dc=example,dc=com", GroupID: "group-id"},
+				},
+				Watermark:        "20250101100000Z",
+				NextRunAt:        testNow,
+				LastRunDate:      testNow,
+				LastFullSyncDate: testNow,
+				LastSummary:      &domain.LDAPSyncSummary{Full: true, Created: 2},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -0,0 +1,12 @@
+SELECT
+	l.user_id
+	, l.external_user_id
+	, l.resource_owner
+	, u.state
+FROM projections.idp_user_links3 l
+JOIN projections.users14 u
+	ON u.instance_id = l.instance_id
+	AND u.id = l.user_id
+WHERE l.instance_id = $1
+AND l.idp_id = $2
+AND l.owner_removed = false;
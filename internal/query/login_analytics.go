@@ -0,0 +1,238 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	loginAnalyticsTable = table{
+		name:          projection.LoginAnalyticsProjectionTable,
+		instanceIDCol: projection.LoginAnalyticsColumnInstanceID,
+	}
+	LoginAnalyticsColumnInstanceID = Column{
+		name:  projection.LoginAnalyticsColumnInstanceID,
+		table: loginAnalyticsTable,
+	}
+	LoginAnalyticsColumnBucket = Column{
+		name:  projection.LoginAnalyticsColumnBucket,
+		table: loginAnalyticsTable,
+	}
+	LoginAnalyticsColumnMethod = Column{
+		name:  projection.LoginAnalyticsColumnMethod,
+		table: loginAnalyticsTable,
+	}
+	LoginAnalyticsColumnOutcome = Column{
+		name:  projection.LoginAnalyticsColumnOutcome,
+		table: loginAnalyticsTable,
+	}
+	LoginAnalyticsColumnReason = Column{
+		name:  projection.LoginAnalyticsColumnReason,
+		table: loginAnalyticsTable,
+	}
+	LoginAnalyticsColumnClientID = Column{
+		name:  projection.LoginAnalyticsColumnClientID,
+		table: loginAnalyticsTable,
+	}
+	LoginAnalyticsColumnIDPID = Column{
+		name:  projection.LoginAnalyticsColumnIDPID,
+		table: loginAnalyticsTable,
+	}
+	LoginAnalyticsColumnCount = Column{
+		name:  projection.LoginAnalyticsColumnCount,
+		table: loginAnalyticsTable,
+	}
+)
+
+// defaultLoginAnalyticsRange is used if no start of the time range is passed.
+const defaultLoginAnalyticsRange = 24 * time.Hour
+
+type LoginAnalyticsInterval int32
+
+const (
+	LoginAnalyticsIntervalHour LoginAnalyticsInterval = iota
+	LoginAnalyticsIntervalDay
+)
+
+type LoginAnalyticsDimension int32
+
+const (
+	LoginAnalyticsDimensionMethod LoginAnalyticsDimension = iota
+	LoginAnalyticsDimensionReason
+	LoginAnalyticsDimensionClient
+	LoginAnalyticsDimensionIDP
+)
+
+// LoginAnalyticsSearchQuery filters the counted login outcomes of the instance.
+// The time range is rounded to the hours the outcomes are counted in.
+type LoginAnalyticsSearchQuery struct {
+	Since    time.Time
+	Until    time.Time
+	Methods  []domain.LoginMethod
+	Outcomes []domain.LoginOutcome
+	ClientID string
+	IDPID    string
+}
+
+type LoginAnalyticsDataPoint struct {
+	Bucket  time.Time
+	Outcome domain.LoginOutcome
+	Count   uint64
+}
+
+type LoginAnalyticsBreakdownEntry struct {
+	// Key is the value of the dimension, like the client ID or the failure reason.
+	Key      string
+	Count    uint64
+	Failures uint64
+}
+
+// LoginAnalyticsTimeSeries returns the amount of login outcomes per interval.
+// Intervals without any login are omitted.
+func (q *Queries) LoginAnalyticsTimeSeries(ctx context.Context, query *LoginAnalyticsSearchQuery, interval LoginAnalyticsInterval) (_ []*LoginAnalyticsDataPoint, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if err = q.checkPermission(ctx, domain.PermissionAnalyticsRead, instanceID, instanceID); err != nil {
+		return nil, err
+	}
+	where, err := loginAnalyticsWhere(instanceID, query)
+	if err != nil {
+		return nil, err
+	}
+	bucket := LoginAnalyticsColumnBucket.identifier()
+	if interval == LoginAnalyticsIntervalDay {
+		bucket = "date_trunc('day', " + bucket + " AT TIME ZONE 'UTC') AT TIME ZONE 'UTC'"
+	}
+	stmt, args, err := sq.Select(
+		bucket+" AS b",
+		LoginAnalyticsColumnOutcome.identifier(),
+		"SUM("+LoginAnalyticsColumnCount.identifier()+")::BIGINT",
+	).
+		From(loginAnalyticsTable.identifier()).
+		Where(where).
+		GroupBy("b", LoginAnalyticsColumnOutcome.identifier()).
+		OrderBy("b", LoginAnalyticsColumnOutcome.identifier()).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Lt5sQ", "Errors.Query.SQLStatement")
+	}
+
+	dataPoints := make([]*LoginAnalyticsDataPoint, 0)
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			dataPoint := new(LoginAnalyticsDataPoint)
+			if err := rows.Scan(&dataPoint.Bucket, &dataPoint.Outcome, &dataPoint.Count); err != nil {
+				return err
+			}
+			dataPoints = append(dataPoints, dataPoint)
+		}
+		return rows.Err()
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Lt8sC", "Errors.Internal")
+	}
+	return dataPoints, nil
+}
+
+// LoginAnalyticsBreakdown returns the dimension values with the most login outcomes, like the applications with the most failed logins.
+func (q *Queries) LoginAnalyticsBreakdown(ctx context.Context, query *LoginAnalyticsSearchQuery, dimension LoginAnalyticsDimension, limit uint64) (_ []*LoginAnalyticsBreakdownEntry, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if err = q.checkPermission(ctx, domain.PermissionAnalyticsRead, instanceID, instanceID); err != nil {
+		return nil, err
+	}
+	where, err := loginAnalyticsWhere(instanceID, query)
+	if err != nil {
+		return nil, err
+	}
+	var key Column
+	switch dimension {
+	case LoginAnalyticsDimensionReason:
+		key = LoginAnalyticsColumnReason
+	case LoginAnalyticsDimensionClient:
+		key = LoginAnalyticsColumnClientID
+	case LoginAnalyticsDimensionIDP:
+		key = LoginAnalyticsColumnIDPID
+	default:
+		key = LoginAnalyticsColumnMethod
+	}
+	stmt, args, err := sq.Select(
+		key.identifier(),
+		"SUM("+LoginAnalyticsColumnCount.identifier()+")::BIGINT AS total",
+	).
+		Column(sq.Expr(
+			"COALESCE(SUM("+LoginAnalyticsColumnCount.identifier()+") FILTER (WHERE "+LoginAnalyticsColumnOutcome.identifier()+" > ?), 0)::BIGINT",
+			domain.LoginOutcomeSuccess,
+		)).
+		From(loginAnalyticsTable.identifier()).
+		Where(where).
+		GroupBy(key.identifier()).
+		OrderBy("total DESC", key.identifier()).
+		Limit(limit).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Lt2bQ", "Errors.Query.SQLStatement")
+	}
+	entries := make([]*LoginAnalyticsBreakdownEntry, 0, limit)
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			entry := new(LoginAnalyticsBreakdownEntry)
+			if err := rows.Scan(&entry.Key, &entry.Count, &entry.Failures); err != nil {
+				return err
+			}
+			entries = append(entries, entry)
+		}
+		return rows.Err()
+	}, stmt, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Lt7bC", "Errors.Internal")
+	}
+	return entries, nil
+}
+
+func loginAnalyticsWhere(instanceID string, query *LoginAnalyticsSearchQuery) (sq.And, error) {
+	until := query.Until
+	if until.IsZero() {
+		until = time.Now()
+	}
+	since := query.Since
+	if since.IsZero() {
+		since = until.Add(-defaultLoginAnalyticsRange)
+	}
+	if !since.Before(until) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Lt4rI", "Errors.LoginAnalytics.InvalidTimeRange")
+	}
+	where := sq.And{
+		sq.Eq{LoginAnalyticsColumnInstanceID.identifier(): instanceID},
+		sq.GtOrEq{LoginAnalyticsColumnBucket.identifier(): since.UTC().Truncate(projection.LoginAnalyticsBucketSize)},
+		sq.Lt{LoginAnalyticsColumnBucket.identifier(): until.UTC()},
+	}
+	if len(query.Methods) > 0 {
+		where = append(where, sq.Eq{LoginAnalyticsColumnMethod.identifier(): query.Methods})
+	}
+	if len(query.Outcomes) > 0 {
+		where = append(where, sq.Eq{LoginAnalyticsColumnOutcome.identifier(): query.Outcomes})
+	}
+	if query.ClientID != "" {
+		where = append(where, sq.Eq{LoginAnalyticsColumnClientID.identifier(): query.ClientID})
+	}
+	if query.IDPID != "" {
+		where = append(where, sq.Eq{LoginAnalyticsColumnIDPID.identifier(): query.IDPID})
+	}
+	return where, nil
+}
@@ -292,7 +292,7 @@ func (q *Queries) searchOrgs(ctx context.Context, queries *OrgSearchQueries, per
 		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-wQ3by", "Errors.Query.InvalidRequest")
 	}
 
-	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
 		orgs, err = scan(rows)
 		return err
 	}, stmt, args...)
@@ -0,0 +1,461 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/quota"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	orgQuotasTable = table{
+		name:          projection.OrgQuotasProjectionTable,
+		instanceIDCol: projection.QuotaColumnInstanceID,
+	}
+	OrgQuotaColumnID = Column{
+		name:  projection.QuotaColumnID,
+		table: orgQuotasTable,
+	}
+	OrgQuotaColumnInstanceID = Column{
+		name:  projection.QuotaColumnInstanceID,
+		table: orgQuotasTable,
+	}
+	OrgQuotaColumnOrgID = Column{
+		name:  projection.OrgQuotaColumnOrgID,
+		table: orgQuotasTable,
+	}
+	OrgQuotaColumnUnit = Column{
+		name:  projection.QuotaColumnUnit,
+		table: orgQuotasTable,
+	}
+	OrgQuotaColumnAmount = Column{
+		name:  projection.QuotaColumnAmount,
+		table: orgQuotasTable,
+	}
+	OrgQuotaColumnLimit = Column{
+		name:  projection.QuotaColumnLimit,
+		table: orgQuotasTable,
+	}
+	OrgQuotaColumnInterval = Column{
+		name:  projection.QuotaColumnInterval,
+		table: orgQuotasTable,
+	}
+	OrgQuotaColumnFrom = Column{
+		name:  projection.QuotaColumnFrom,
+		table: orgQuotasTable,
+	}
+
+	orgQuotaPeriodsTable = table{
+		name:          projection.OrgQuotaPeriodsProjectionTable,
+		instanceIDCol: projection.QuotaPeriodColumnInstanceID,
+	}
+	OrgQuotaPeriodColumnInstanceID = Column{
+		name:  projection.QuotaPeriodColumnInstanceID,
+		table: orgQuotaPeriodsTable,
+	}
+	OrgQuotaPeriodColumnOrgID = Column{
+		name:  projection.OrgQuotaPeriodColumnOrgID,
+		table: orgQuotaPeriodsTable,
+	}
+	OrgQuotaPeriodColumnUnit = Column{
+		name:  projection.QuotaPeriodColumnUnit,
+		table: orgQuotaPeriodsTable,
+	}
+	OrgQuotaPeriodColumnStart = Column{
+		name:  projection.QuotaPeriodColumnStart,
+		table: orgQuotaPeriodsTable,
+	}
+	OrgQuotaPeriodColumnUsage = Column{
+		name:  projection.QuotaPeriodColumnUsage,
+		table: orgQuotaPeriodsTable,
+	}
+
+	orgQuotaNotificationsTable = table{
+		name:          projection.OrgQuotaNotificationsTable,
+		instanceIDCol: projection.QuotaNotificationColumnInstanceID,
+	}
+	OrgQuotaNotificationColumnInstanceID = Column{
+		name:  projection.QuotaNotificationColumnInstanceID,
+		table: orgQuotaNotificationsTable,
+	}
+	OrgQuotaNotificationColumnOrgID = Column{
+		name:  projection.OrgQuotaNotificationColumnOrgID,
+		table: orgQuotaNotificationsTable,
+	}
+	OrgQuotaNotificationColumnUnit = Column{
+		name:  projection.QuotaNotificationColumnUnit,
+		table: orgQuotaNotificationsTable,
+	}
+	OrgQuotaNotificationColumnID = Column{
+		name:  projection.QuotaNotificationColumnID,
+		table: orgQuotaNotificationsTable,
+	}
+	OrgQuotaNotificationColumnCallURL = Column{
+		name:  projection.QuotaNotificationColumnCallURL,
+		table: orgQuotaNotificationsTable,
+	}
+	OrgQuotaNotificationColumnPercent = Column{
+		name:  projection.QuotaNotificationColumnPercent,
+		table: orgQuotaNotificationsTable,
+	}
+	OrgQuotaNotificationColumnRepeat = Column{
+		name:  projection.QuotaNotificationColumnRepeat,
+		table: orgQuotaNotificationsTable,
+	}
+	OrgQuotaNotificationColumnLatestDuePeriodStart = Column{
+		name:  projection.QuotaNotificationColumnLatestDuePeriodStart,
+		table: orgQuotaNotificationsTable,
+	}
+	OrgQuotaNotificationColumnNextDueThreshold = Column{
+		name:  projection.QuotaNotificationColumnNextDueThreshold,
+		table: orgQuotaNotificationsTable,
+	}
+
+	orgQuotaActiveUsersTable = table{
+		name:          projection.OrgQuotaActiveUsersTable,
+		instanceIDCol: projection.QuotaActiveUserColumnInstanceID,
+	}
+	OrgQuotaActiveUserColumnInstanceID = Column{
+		name:  projection.QuotaActiveUserColumnInstanceID,
+		table: orgQuotaActiveUsersTable,
+	}
+	OrgQuotaActiveUserColumnOrgID = Column{
+		name:  projection.OrgQuotaActiveUserColumnOrgID,
+		table: orgQuotaActiveUsersTable,
+	}
+	OrgQuotaActiveUserColumnStart = Column{
+		name:  projection.QuotaActiveUserColumnStart,
+		table: orgQuotaActiveUsersTable,
+	}
+	OrgQuotaActiveUserColumnUserID = Column{
+		name:  projection.QuotaActiveUserColumnUserID,
+		table: orgQuotaActiveUsersTable,
+	}
+)
+
+// orgQuotaResources are the resources which are counted by the organization quota units which don't reset.
+var orgQuotaResources = []struct {
+	unit          quota.Unit
+	resourceOwner Column
+}{
+	{unit: quota.UsersAll, resourceOwner: UserResourceOwnerCol},
+	{unit: quota.ProjectsAll, resourceOwner: ProjectColumnResourceOwner},
+	{unit: quota.ApplicationsAll, resourceOwner: AppColumnResourceOwner},
+}
+
+// GetOrgQuota returns the quota of the organization for the unit
+func (q *Queries) GetOrgQuota(ctx context.Context, instanceID, orgID string, unit quota.Unit) (qu *Quota, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	query, scan := prepareOrgQuotaQuery()
+	stmt, args, err := query.Where(
+		sq.Eq{
+			OrgQuotaColumnInstanceID.identifier(): instanceID,
+			OrgQuotaColumnOrgID.identifier():      orgID,
+			OrgQuotaColumnUnit.identifier():       unit,
+		},
+	).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Pw4mR", "Errors.Query.SQLStatement")
+	}
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		qu, err = scan(row)
+		return err
+	}, stmt, args...)
+	return qu, err
+}
+
+func prepareOrgQuotaQuery() (sq.SelectBuilder, func(*sql.Row) (*Quota, error)) {
+	return sq.
+			Select(
+				OrgQuotaColumnID.identifier(),
+				OrgQuotaColumnFrom.identifier(),
+				OrgQuotaColumnInterval.identifier(),
+				OrgQuotaColumnAmount.identifier(),
+				OrgQuotaColumnLimit.identifier(),
+				"now()",
+			).
+			From(orgQuotasTable.identifier()).
+			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*Quota, error) {
+			q := new(Quota)
+			var from sql.NullTime
+			var interval database.NullDuration
+			var now time.Time
+			err := row.Scan(&q.ID, &from, &interval, &q.Amount, &q.Limit, &now)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Jx6nE", "Errors.Quota.NotExisting")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Bv2tY", "Errors.Internal")
+			}
+			q.From = from.Time
+			q.ResetInterval = interval.Duration
+			q.CurrentPeriodStart = pushPeriodStart(q.From, q.ResetInterval, now)
+			return q, nil
+		}
+}
+
+// GetRemainingOrgQuotaUsage returns the remaining usage of a limiting quota of the organization.
+// It returns nil if the organization has no limiting quota for the unit.
+// For the units which don't reset, the existing resources of the organization are counted.
+func (q *Queries) GetRemainingOrgQuotaUsage(ctx context.Context, instanceID, orgID string, unit quota.Unit) (remaining *uint64, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	stmt, scan := prepareRemainingOrgQuotaUsageQuery(unit)
+	query, args, err := stmt.Where(
+		sq.Eq{
+			OrgQuotaColumnInstanceID.identifier(): instanceID,
+			OrgQuotaColumnOrgID.identifier():      orgID,
+			OrgQuotaColumnUnit.identifier():       unit,
+			OrgQuotaColumnLimit.identifier():      true,
+		},
+	).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Kt3sB", "Errors.Query.SQLStatement")
+	}
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		remaining, err = scan(row)
+		return err
+	}, query, args...)
+	if zerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return remaining, err
+}
+
+func prepareRemainingOrgQuotaUsageQuery(unit quota.Unit) (sq.SelectBuilder, func(*sql.Row) (*uint64, error)) {
+	query := sq.Select(
+		"greatest(0, " + OrgQuotaColumnAmount.identifier() + " - " + orgQuotaUsageExpression(unit) + ")",
+	).From(orgQuotasTable.identifier())
+	if !isOrgQuotaCountUnit(unit) {
+		query = query.LeftJoin(orgQuotaCurrentPeriodJoin())
+	}
+	return query.PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*uint64, error) {
+		remaining := new(uint64)
+		err := row.Scan(remaining)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, zerrors.ThrowNotFound(err, "QUERY-Hn8cL", "Errors.Internal")
+			}
+			return nil, zerrors.ThrowInternal(err, "QUERY-Dm5wX", "Errors.Internal")
+		}
+		return remaining, nil
+	}
+}
+
+// GetOrgQuotaUsageReport returns the usage of all quotas of the organization.
+// The usage of the units which don't reset is the number of existing resources.
+func (q *Queries) GetOrgQuotaUsageReport(ctx context.Context, instanceID, orgID string) (usages []*QuotaUsage, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	query, scan := prepareOrgQuotaUsageReportQuery()
+	stmt, args, err := query.Where(
+		sq.Eq{
+			OrgQuotaColumnInstanceID.identifier(): instanceID,
+			OrgQuotaColumnOrgID.identifier():      orgID,
+		},
+	).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Sg7pF", "Errors.Query.SQLStatement")
+	}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		usages, err = scan(rows)
+		return err
+	}, stmt, args...)
+	return usages, err
+}
+
+func prepareOrgQuotaUsageReportQuery() (sq.SelectBuilder, func(*sql.Rows) ([]*QuotaUsage, error)) {
+	return sq.
+			Select(
+				OrgQuotaColumnUnit.identifier(),
+				OrgQuotaColumnFrom.identifier(),
+				OrgQuotaColumnInterval.identifier(),
+				OrgQuotaColumnAmount.identifier(),
+				OrgQuotaColumnLimit.identifier(),
+				orgQuotaUsageReportExpression(),
+				"now()",
+			).
+			From(orgQuotasTable.identifier()).
+			LeftJoin(orgQuotaCurrentPeriodJoin()).
+			OrderBy(OrgQuotaColumnUnit.identifier()).
+			PlaceholderFormat(sq.Dollar), func(rows *sql.Rows) ([]*QuotaUsage, error) {
+			usages := make([]*QuotaUsage, 0)
+			for rows.Next() {
+				u := new(QuotaUsage)
+				var from sql.NullTime
+				var interval database.NullDuration
+				var now time.Time
+				err := rows.Scan(&u.Unit, &from, &interval, &u.Amount, &u.Limit, &u.Usage, &now)
+				if err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Ew9rK", "Errors.Internal")
+				}
+				u.From = from.Time
+				u.ResetInterval = interval.Duration
+				u.CurrentPeriodStart = pushPeriodStart(u.From, u.ResetInterval, now)
+				usages = append(usages, u)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Yc4hT", "Errors.Query.CloseRows")
+			}
+			return usages, nil
+		}
+}
+
+// GetDueOrgQuotaNotifications returns the notifications of the organization quota which are due for the used amount
+func (q *Queries) GetDueOrgQuotaNotifications(ctx context.Context, instanceID, orgID string, unit quota.Unit, qu *Quota, periodStart time.Time, usedAbs uint64) (dueNotifications []*quota.NotificationDueEvent, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	usedRel := uint16(math.Floor(float64(usedAbs*100) / float64(qu.Amount)))
+	query, scan := prepareOrgQuotaNotificationsQuery()
+	stmt, args, err := query.Where(
+		sq.And{
+			sq.Eq{
+				OrgQuotaNotificationColumnInstanceID.identifier(): instanceID,
+				OrgQuotaNotificationColumnOrgID.identifier():      orgID,
+				OrgQuotaNotificationColumnUnit.identifier():       unit,
+			},
+			dueQuotaNotificationsCondition(
+				periodStart,
+				usedRel,
+				OrgQuotaNotificationColumnLatestDuePeriodStart,
+				OrgQuotaNotificationColumnNextDueThreshold,
+				OrgQuotaNotificationColumnPercent,
+			),
+		},
+	).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Rb5vJ", "Errors.Query.SQLStatement")
+	}
+	var notifications *QuotaNotifications
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		notifications, err = scan(rows)
+		return err
+	}, stmt, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return dueQuotaNotificationEvents(ctx, &quota.NewOrgAggregate(qu.ID, instanceID, orgID).Aggregate, unit, notifications, periodStart, usedRel, usedAbs), nil
+}
+
+func prepareOrgQuotaNotificationsQuery() (sq.SelectBuilder, func(*sql.Rows) (*QuotaNotifications, error)) {
+	return sq.Select(
+			OrgQuotaNotificationColumnID.identifier(),
+			OrgQuotaNotificationColumnCallURL.identifier(),
+			OrgQuotaNotificationColumnPercent.identifier(),
+			OrgQuotaNotificationColumnRepeat.identifier(),
+			OrgQuotaNotificationColumnNextDueThreshold.identifier(),
+		).
+			From(orgQuotaNotificationsTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		scanQuotaNotifications
+}
+
+// IsUserActiveInOrgQuotaPeriod returns true if the user is already counted as active user in the current quota period of the organization
+func (q *Queries) IsUserActiveInOrgQuotaPeriod(ctx context.Context, instanceID, orgID, userID string) (active bool, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	query, scan := prepareUserActiveInOrgQuotaPeriodQuery()
+	stmt, args, err := query.Where(
+		sq.And{
+			sq.Eq{
+				OrgQuotaActiveUserColumnInstanceID.identifier(): instanceID,
+				OrgQuotaActiveUserColumnOrgID.identifier():      orgID,
+				OrgQuotaActiveUserColumnUserID.identifier():     userID,
+				OrgQuotaColumnUnit.identifier():                 quota.UsersAllActive,
+			},
+			sq.Expr("age(" + OrgQuotaActiveUserColumnStart.identifier() + ") < " + OrgQuotaColumnInterval.identifier()),
+			sq.Expr(OrgQuotaActiveUserColumnStart.identifier() + " <= now()"),
+			sq.Expr(OrgQuotaActiveUserColumnStart.identifier() + " >= " + OrgQuotaColumnFrom.identifier()),
+		},
+	).ToSql()
+	if err != nil {
+		return false, zerrors.ThrowInternal(err, "QUERY-Gk2zM", "Errors.Query.SQLStatement")
+	}
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		active, err = scan(row)
+		return err
+	}, stmt, args...)
+	return active, err
+}
+
+func prepareUserActiveInOrgQuotaPeriodQuery() (sq.SelectBuilder, func(*sql.Row) (bool, error)) {
+	return sq.
+			Select(
+				OrgQuotaActiveUserColumnUserID.identifier(),
+			).
+			From(orgQuotaActiveUsersTable.identifier()).
+			Join(join(OrgQuotaColumnOrgID, OrgQuotaActiveUserColumnOrgID)).
+			Limit(1).
+			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (bool, error) {
+			var userID string
+			err := row.Scan(&userID)
+			if errors.Is(err, sql.ErrNoRows) {
+				return false, nil
+			}
+			if err != nil {
+				return false, zerrors.ThrowInternal(err, "QUERY-Xf3dQ", "Errors.Internal")
+			}
+			return true, nil
+		}
+}
+
+func isOrgQuotaCountUnit(unit quota.Unit) bool {
+	for _, resource := range orgQuotaResources {
+		if resource.unit == unit {
+			return true
+		}
+	}
+	return false
+}
+
+// orgQuotaCurrentPeriodJoin joins the period of the organization quota which is currently active
+func orgQuotaCurrentPeriodJoin() string {
+	return join(OrgQuotaPeriodColumnUnit, OrgQuotaColumnUnit) +
+		" AND " + OrgQuotaPeriodColumnOrgID.identifier() + " = " + OrgQuotaColumnOrgID.identifier() +
+		" AND age(" + OrgQuotaPeriodColumnStart.identifier() + ") < " + OrgQuotaColumnInterval.identifier() +
+		" AND " + OrgQuotaPeriodColumnStart.identifier() + " <= now()" +
+		" AND " + OrgQuotaPeriodColumnStart.identifier() + " >= " + OrgQuotaColumnFrom.identifier()
+}
+
+// orgQuotaUsageExpression returns the usage of the unit,
+// which is either the count of the organization's resources or the usage of the current period.
+func orgQuotaUsageExpression(unit quota.Unit) string {
+	for _, resource := range orgQuotaResources {
+		if resource.unit == unit {
+			return orgQuotaResourceCount(resource.resourceOwner)
+		}
+	}
+	return "COALESCE(" + OrgQuotaPeriodColumnUsage.identifier() + ", 0)"
+}
+
+// orgQuotaUsageReportExpression returns the usage of each unit in a single column
+func orgQuotaUsageReportExpression() string {
+	var expr strings.Builder
+	expr.WriteString("CASE " + OrgQuotaColumnUnit.identifier())
+	for _, resource := range orgQuotaResources {
+		expr.WriteString(" WHEN " + strconv.FormatUint(uint64(resource.unit), 10) + " THEN " + orgQuotaResourceCount(resource.resourceOwner))
+	}
+	expr.WriteString(" ELSE COALESCE(" + OrgQuotaPeriodColumnUsage.identifier() + ", 0) END")
+	return expr.String()
+}
+
+func orgQuotaResourceCount(resourceOwner Column) string {
+	return "(SELECT count(*) FROM " + resourceOwner.table.identifier() +
+		" WHERE " + resourceOwner.table.InstanceIDIdentifier() + " = " + OrgQuotaColumnInstanceID.identifier() +
+		" AND " + resourceOwner.identifier() + " = " + OrgQuotaColumnOrgID.identifier() + ")"
+}
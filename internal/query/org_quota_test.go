@@ -0,0 +1,228 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/muhlemmer/gu"
+
+	"github.com/zitadel/zitadel/internal/repository/quota"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	expectedOrgQuotaQuery = regexp.QuoteMeta(`SELECT projections.org_quotas.id,` +
+		` projections.org_quotas.from_anchor,` +
+		` projections.org_quotas.interval,` +
+		` projections.org_quotas.amount,` +
+		` projections.org_quotas.limit_usage,` +
+		` now()` +
+		` FROM projections.org_quotas`)
+
+	expectedRemainingOrgQuotaUsersQuery = regexp.QuoteMeta(`SELECT greatest(0, projections.org_quotas.amount - ` +
+		`(SELECT count(*) FROM projections.users14` +
+		` WHERE projections.users14.instance_id = projections.org_quotas.instance_id` +
+		` AND projections.users14.resource_owner = projections.org_quotas.org_id))` +
+		` FROM projections.org_quotas`)
+
+	expectedRemainingOrgQuotaRequestsQuery = regexp.QuoteMeta(`SELECT greatest(0, projections.org_quotas.amount - COALESCE(projections.org_quotas_periods.usage, 0))` +
+		` FROM projections.org_quotas` +
+		` LEFT JOIN projections.org_quotas_periods ON projections.org_quotas.unit = projections.org_quotas_periods.unit AND projections.org_quotas.instance_id = projections.org_quotas_periods.instance_id` +
+		` AND projections.org_quotas_periods.org_id = projections.org_quotas.org_id` +
+		` AND age(projections.org_quotas_periods.start) < projections.org_quotas.interval` +
+		` AND projections.org_quotas_periods.start <= now()` +
+		` AND projections.org_quotas_periods.start >= projections.org_quotas.from_anchor`)
+
+	expectedUserActiveInOrgQuotaPeriodQuery = regexp.QuoteMeta(`SELECT projections.org_quotas_active_users.user_id` +
+		` FROM projections.org_quotas_active_users` +
+		` JOIN projections.org_quotas ON projections.org_quotas_active_users.org_id = projections.org_quotas.org_id AND projections.org_quotas_active_users.instance_id = projections.org_quotas.instance_id` +
+		` LIMIT 1`)
+)
+
+func Test_OrgQuotaPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name        string
+		prepare     interface{}
+		prepareArgs []reflect.Value
+		want        want
+		object      interface{}
+	}{
+		{
+			name:    "prepareOrgQuotaQuery no result",
+			prepare: prepareOrgQuotaQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					expectedOrgQuotaQuery,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*Quota)(nil),
+		},
+		{
+			name:    "prepareOrgQuotaQuery with period",
+			prepare: prepareOrgQuotaQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					expectedOrgQuotaQuery,
+					quotaCols,
+					[]driver.Value{
+						"quota-id",
+						dayNow,
+						&pgtype.Interval{
+							Days: 1,
+						},
+						uint64(1000),
+						true,
+						testNow,
+					},
+				),
+			},
+			object: &Quota{
+				ID:                 "quota-id",
+				From:               dayNow,
+				ResetInterval:      time.Hour * 24,
+				CurrentPeriodStart: dayNow,
+				Amount:             1000,
+				Limit:              true,
+			},
+		},
+		{
+			name:    "prepareOrgQuotaQuery without period",
+			prepare: prepareOrgQuotaQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					expectedOrgQuotaQuery,
+					quotaCols,
+					[]driver.Value{
+						"quota-id",
+						nil,
+						nil,
+						uint64(10),
+						true,
+						testNow,
+					},
+				),
+			},
+			object: &Quota{
+				ID:     "quota-id",
+				Amount: 10,
+				Limit:  true,
+			},
+		},
+		{
+			name:        "prepareRemainingOrgQuotaUsageQuery users",
+			prepare:     prepareRemainingOrgQuotaUsageQuery,
+			prepareArgs: []reflect.Value{reflect.ValueOf(quota.UsersAll)},
+			want: want{
+				sqlExpectations: mockQuery(
+					expectedRemainingOrgQuotaUsersQuery,
+					[]string{"greatest"},
+					[]driver.Value{
+						uint64(3),
+					},
+				),
+			},
+			object: gu.Ptr(uint64(3)),
+		},
+		{
+			name:        "prepareRemainingOrgQuotaUsageQuery requests",
+			prepare:     prepareRemainingOrgQuotaUsageQuery,
+			prepareArgs: []reflect.Value{reflect.ValueOf(quota.RequestsAllAuthenticated)},
+			want: want{
+				sqlExpectations: mockQuery(
+					expectedRemainingOrgQuotaRequestsQuery,
+					[]string{"greatest"},
+					[]driver.Value{
+						uint64(0),
+					},
+				),
+			},
+			object: gu.Ptr(uint64(0)),
+		},
+		{
+			name:        "prepareRemainingOrgQuotaUsageQuery no limiting quota",
+			prepare:     prepareRemainingOrgQuotaUsageQuery,
+			prepareArgs: []reflect.Value{reflect.ValueOf(quota.UsersAll)},
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					expectedRemainingOrgQuotaUsersQuery,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*uint64)(nil),
+		},
+		{
+			name:    "prepareUserActiveInOrgQuotaPeriodQuery not active",
+			prepare: prepareUserActiveInOrgQuotaPeriodQuery,
+			want: want{
+				sqlExpectations: mockQueryScanErr(
+					expectedUserActiveInOrgQuotaPeriodQuery,
+					nil,
+					nil,
+				),
+			},
+			object: false,
+		},
+		{
+			name:    "prepareUserActiveInOrgQuotaPeriodQuery active",
+			prepare: prepareUserActiveInOrgQuotaPeriodQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					expectedUserActiveInOrgQuotaPeriodQuery,
+					[]string{"user_id"},
+					[]driver.Value{
+						"user-id",
+					},
+				),
+			},
+			object: true,
+		},
+		{
+			name:    "prepareUserActiveInOrgQuotaPeriodQuery sql err",
+			prepare: prepareUserActiveInOrgQuotaPeriodQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					expectedUserActiveInOrgQuotaPeriodQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err, tt.prepareArgs...)
+		})
+	}
+}
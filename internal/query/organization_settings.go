@@ -127,7 +127,7 @@ func (q *Queries) searchOrganizationSettings(ctx context.Context, queries *Organ
 		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-qNPeOXlMwj", "Errors.Query.InvalidRequest")
 	}
 
-	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
 		settingsList, err = scan(rows)
 		return err
 	}, stmt, args...)
@@ -30,9 +30,11 @@ type permissionClauseBuilder struct {
 	permission        string
 
 	// optional fields
-	orgID           *string
-	projectIDColumn *Column
-	connections     []sq.Eq
+	orgID                *string
+	projectIDColumn      *Column
+	applicationIDColumn  *Column
+	projectRoleKeyColumn *Column
+	connections          []sq.Eq
 }
 
 func (b *permissionClauseBuilder) appendConnection(column string, value any) {
@@ -57,13 +59,25 @@ func (b *permissionClauseBuilder) joinFunction() (sql string, args []any) {
 // joinConditions returns the conditions for the join,
 // which are dynamic based on the provided options.
 func (b *permissionClauseBuilder) joinConditions() sq.Or {
-	conditions := make(sq.Or, 2, len(b.connections)+3)
+	conditions := make(sq.Or, 2, len(b.connections)+5)
 	conditions[0] = sq.Expr("permissions.instance_permitted")
 	conditions[1] = sq.Expr(b.orgIDColumn.identifier() + " = ANY(permissions.org_ids)")
 	if b.projectIDColumn != nil {
 		conditions = append(conditions,
 			sq.Expr(b.projectIDColumn.identifier()+" = ANY(permissions.project_ids)"),
 		)
+		if b.applicationIDColumn != nil {
+			conditions = append(conditions,
+				sq.Expr(b.applicationIDColumn.identifier()+" = ANY(permissions.application_ids)"),
+			)
+		}
+		if b.projectRoleKeyColumn != nil {
+			// all roles of the row must be permitted
+			conditions = append(conditions,
+				sq.Expr("(cardinality("+b.projectRoleKeyColumn.identifier()+") > 0 AND "+
+					"ARRAY(SELECT "+b.projectIDColumn.identifier()+" || '/' || role_key FROM unnest("+b.projectRoleKeyColumn.identifier()+") AS role_key) <@ permissions.project_role_ids)"),
+			)
+		}
 	}
 	for _, c := range b.connections {
 		conditions = append(conditions, c)
@@ -114,11 +128,30 @@ func WithProjectsPermissionOption(projectIDColumn Column) PermissionOption {
 	}
 }
 
+// WithApplicationsPermissionOption sets an additional filter against the application ID column,
+// allowing for application specific permissions.
+// It is only applied in combination with [WithProjectsPermissionOption].
+func WithApplicationsPermissionOption(applicationIDColumn Column) PermissionOption {
+	return func(b *permissionClauseBuilder) {
+		b.applicationIDColumn = &applicationIDColumn
+	}
+}
+
+// WithProjectRolesPermissionOption sets an additional filter against the role keys column,
+// allowing for permissions on specific project roles.
+// Rows are only returned if the permission was granted on all of their roles.
+// It is only applied in combination with [WithProjectsPermissionOption].
+func WithProjectRolesPermissionOption(roleKeysColumn Column) PermissionOption {
+	return func(b *permissionClauseBuilder) {
+		b.projectRoleKeyColumn = &roleKeysColumn
+	}
+}
+
 // PermissionClause builds a `INNER JOIN` clause which can be applied to a query builder.
 // It filters returned rows the current authenticated user has the requested permission to.
 // See permission_example_test.go for examples.
 //
-// Experimental: Work in progress. Currently only organization and project permissions are supported
+// Experimental: Work in progress. Currently only organization, project, application and project role permissions are supported
 // TODO: Add support for project grants.
 func PermissionClause(ctx context.Context, orgIDCol Column, permission string, options ...PermissionOption) (string, []any) {
 	ctxData := authz.GetCtxData(ctx)
@@ -140,6 +173,8 @@ func PermissionClause(ctx context.Context, orgIDCol Column, permission string, o
 		"permission", b.permission,
 		"org_id", b.orgID,
 		"project_id_column", b.projectIDColumn,
+		"application_id_column", b.applicationIDColumn,
+		"project_role_key_column", b.projectRoleKeyColumn,
 		"connections", b.connections,
 	).Debug("permitted orgs check used")
 
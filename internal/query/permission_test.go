@@ -164,6 +164,65 @@ func TestPermissionClause(t *testing.T) {
 				gu.Ptr("orgID"),
 			},
 		},
+		{
+			name: "application",
+			args: args{
+				ctx:        ctx,
+				orgIDCol:   AppColumnResourceOwner,
+				permission: "permission1",
+				options: []PermissionOption{
+					WithProjectsPermissionOption(AppColumnProjectID),
+					WithApplicationsPermissionOption(AppColumnID),
+				},
+			},
+			wantSql: "INNER JOIN eventstore.permitted_projects(?, ?, ?, ?, ?) permissions ON (permissions.instance_permitted OR projections.apps7.resource_owner = ANY(permissions.org_ids) OR projections.apps7.project_id = ANY(permissions.project_ids) OR projections.apps7.id = ANY(permissions.application_ids))",
+			wantArgs: []any{
+				"instanceID",
+				"userID",
+				database.NewJSONArray(permissions),
+				"permission1",
+				(*string)(nil),
+			},
+		},
+		{
+			name: "application without project",
+			args: args{
+				ctx:        ctx,
+				orgIDCol:   AppColumnResourceOwner,
+				permission: "permission1",
+				options: []PermissionOption{
+					WithApplicationsPermissionOption(AppColumnID),
+				},
+			},
+			wantSql: "INNER JOIN eventstore.permitted_orgs(?, ?, ?, ?, ?) permissions ON (permissions.instance_permitted OR projections.apps7.resource_owner = ANY(permissions.org_ids))",
+			wantArgs: []any{
+				"instanceID",
+				"userID",
+				database.NewJSONArray(permissions),
+				"permission1",
+				(*string)(nil),
+			},
+		},
+		{
+			name: "project roles",
+			args: args{
+				ctx:        ctx,
+				orgIDCol:   UserGrantResourceOwner,
+				permission: "permission1",
+				options: []PermissionOption{
+					WithProjectsPermissionOption(UserGrantProjectID),
+					WithProjectRolesPermissionOption(UserGrantRoles),
+				},
+			},
+			wantSql: "INNER JOIN eventstore.permitted_projects(?, ?, ?, ?, ?) permissions ON (permissions.instance_permitted OR projections.user_grants5.resource_owner = ANY(permissions.org_ids) OR projections.user_grants5.project_id = ANY(permissions.project_ids) OR (cardinality(projections.user_grants5.roles) > 0 AND ARRAY(SELECT projections.user_grants5.project_id || '/' || role_key FROM unnest(projections.user_grants5.roles) AS role_key) <@ permissions.project_role_ids))",
+			wantArgs: []any{
+				"instanceID",
+				"userID",
+				database.NewJSONArray(permissions),
+				"permission1",
+				(*string)(nil),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
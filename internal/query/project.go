@@ -243,7 +243,7 @@ func (q *Queries) searchProjects(ctx context.Context, queries *ProjectSearchQuer
 		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-fn9ew", "Errors.Query.InvalidRequest")
 	}
 
-	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
 		projects, err = scan(rows)
 		return err
 	}, stmt, args...)
@@ -306,7 +306,7 @@ func (q *Queries) searchGrantedProjects(ctx context.Context, queries *ProjectAnd
 		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-T84X9", "Errors.Query.InvalidRequest")
 	}
 
-	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
 		grantedProjects, err = scan(rows)
 		return err
 	}, stmt, args...)
@@ -226,7 +226,7 @@ func (q *Queries) searchProjectGrants(ctx context.Context, queries *ProjectGrant
 		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-N9fsg", "Errors.Query.InvalidRequest")
 	}
 
-	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
 		grants, err = scan(rows)
 		return err
 	}, stmt, args...)
@@ -0,0 +1,58 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ProjectResourceMembership is the membership of a user on a single application or role of a project.
+type ProjectResourceMembership struct {
+	ProjectID string
+	// ResourceType is either [project.ApplicationMemberSearchType] or [project.RoleMemberSearchType]
+	ResourceType string
+	// ResourceID is the id of the application or the key of the role
+	ResourceID string
+	Roles      database.TextArray[string]
+}
+
+//go:embed project_resource_memberships.sql
+var projectResourceMembershipsQuery string
+
+// ProjectResourceMembershipsByUser returns the application and role memberships of the user on the projects of the organization.
+// It is used to resolve the permissions of the user and therefore doesn't check any permission.
+func (q *Queries) ProjectResourceMembershipsByUser(ctx context.Context, userID, orgID string) (_ []*ProjectResourceMembership, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	memberships := make([]*ProjectResourceMembership, 0)
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			membership := new(ProjectResourceMembership)
+			if err := rows.Scan(
+				&membership.ProjectID,
+				&membership.ResourceType,
+				&membership.ResourceID,
+				&membership.Roles,
+			); err != nil {
+				return err
+			}
+			memberships = append(memberships, membership)
+		}
+		return rows.Err()
+	},
+		projectResourceMembershipsQuery,
+		authz.GetInstance(ctx).InstanceID(),
+		userID,
+		orgID,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Pr1mS", "Errors.Internal")
+	}
+	return memberships, nil
+}
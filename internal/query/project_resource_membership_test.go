@@ -0,0 +1,76 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestQueries_ProjectResourceMembershipsByUser(t *testing.T) {
+	expQuery := regexp.QuoteMeta(projectResourceMembershipsQuery)
+	cols := []string{"aggregate_id", "object_type", "object_id", "array_agg"}
+
+	tests := []struct {
+		name    string
+		mock    sqlExpectation
+		want    []*ProjectResourceMembership
+		wantErr error
+	}{
+		{
+			name:    "internal error",
+			mock:    mockQueryErr(expQuery, sql.ErrConnDone, "instanceID", "userID", "orgID"),
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-Pr1mS", "Errors.Internal"),
+		},
+		{
+			name: "no memberships",
+			mock: mockQueries(expQuery, cols, nil, "instanceID", "userID", "orgID"),
+			want: []*ProjectResourceMembership{},
+		},
+		{
+			name: "application and role memberships",
+			mock: mockQueries(expQuery, cols,
+				[][]driver.Value{
+					{"project1", "project_application_member", "app1", database.TextArray[string]{"PROJECT_APPLICATION_OWNER"}},
+					{"project1", "project_role_member", "role1", database.TextArray[string]{"PROJECT_ROLE_ASSIGNER", "PROJECT_ROLE_ASSIGNER_VIEWER"}},
+				},
+				"instanceID", "userID", "orgID",
+			),
+			want: []*ProjectResourceMembership{
+				{
+					ProjectID:    "project1",
+					ResourceType: "project_application_member",
+					ResourceID:   "app1",
+					Roles:        database.TextArray[string]{"PROJECT_APPLICATION_OWNER"},
+				},
+				{
+					ProjectID:    "project1",
+					ResourceType: "project_role_member",
+					ResourceID:   "role1",
+					Roles:        database.TextArray[string]{"PROJECT_ROLE_ASSIGNER", "PROJECT_ROLE_ASSIGNER_VIEWER"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+				}
+				got, err := q.ProjectResourceMembershipsByUser(authz.NewMockContext("instanceID", "orgID", "userID"), "userID", "orgID")
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
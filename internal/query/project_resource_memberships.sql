@@ -0,0 +1,12 @@
+SELECT
+	aggregate_id
+	, object_type
+	, object_id
+	, array_agg(text_value)
+FROM eventstore.fields
+WHERE instance_id = $1
+AND aggregate_type = 'project'
+AND object_type IN ('project_application_member', 'project_role_member')
+AND field_name = $2
+AND resource_owner = $3
+GROUP BY aggregate_id, object_type, object_id;
@@ -0,0 +1,189 @@
+package projection
+
+import (
+	"context"
+	"database/sql"
+
+	repoDomain "github.com/zitadel/zitadel/backend/v3/domain"
+	v3_sql "github.com/zitadel/zitadel/backend/v3/storage/database/dialect/sql"
+	"github.com/zitadel/zitadel/backend/v3/storage/database/repository"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type authorizationRelationalProjection struct{}
+
+func (*authorizationRelationalProjection) Name() string {
+	return "zitadel.authorizations"
+}
+
+func newAuthorizationRelationalProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(authorizationRelationalProjection))
+}
+
+func (p *authorizationRelationalProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: usergrant.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  usergrant.UserGrantAddedType,
+					Reduce: p.reduceAuthorizationAdded,
+				},
+				{
+					Event:  usergrant.UserGrantChangedType,
+					Reduce: p.reduceAuthorizationChanged,
+				},
+				{
+					Event:  usergrant.UserGrantCascadeChangedType,
+					Reduce: p.reduceAuthorizationCascadeChanged,
+				},
+				{
+					Event:  usergrant.UserGrantDeactivatedType,
+					Reduce: p.reduceAuthorizationDeactivated,
+				},
+				{
+					Event:  usergrant.UserGrantReactivatedType,
+					Reduce: p.reduceAuthorizationReactivated,
+				},
+				{
+					Event:  usergrant.UserGrantRemovedType,
+					Reduce: p.reduceAuthorizationRemoved,
+				},
+				{
+					Event:  usergrant.UserGrantCascadeRemovedType,
+					Reduce: p.reduceAuthorizationRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *authorizationRelationalProjection) reduceAuthorizationAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-FTTpwrCkQl", "reduce.wrong.event.type %s", usergrant.UserGrantAddedType)
+	}
+	return handler.NewStatement(e, func(ctx context.Context, ex handler.Executer, _ string) error {
+		tx, ok := ex.(*sql.Tx)
+		if !ok {
+			return zerrors.ThrowInvalidArgumentf(nil, "HANDL-PbqMSPMomf", "reduce.wrong.db.pool %T", ex)
+		}
+		repo := repository.AuthorizationRepository()
+		return repo.Create(ctx, v3_sql.SQLTx(tx), &repoDomain.Authorization{
+			InstanceID:     e.Aggregate().InstanceID,
+			ID:             e.Aggregate().ID,
+			OrganizationID: e.Aggregate().ResourceOwner,
+			UserID:         e.UserID,
+			ProjectID:      e.ProjectID,
+			ProjectGrantID: e.ProjectGrantID,
+			CreatedAt:      e.CreationDate(),
+			UpdatedAt:      e.CreationDate(),
+			State:          repoDomain.AuthorizationStateActive,
+			RoleKeys:       e.RoleKeys,
+		})
+	}), nil
+}
+
+func (p *authorizationRelationalProjection) reduceAuthorizationChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-bLPhZfbOIz", "reduce.wrong.event.type %s", usergrant.UserGrantChangedType)
+	}
+	return handler.NewStatement(e, func(ctx context.Context, ex handler.Executer, _ string) error {
+		tx, ok := ex.(*sql.Tx)
+		if !ok {
+			return zerrors.ThrowInvalidArgumentf(nil, "HANDL-fu0iAZdND4", "reduce.wrong.db.pool %T", ex)
+		}
+		repo := repository.AuthorizationRepository()
+		_, err := repo.Update(ctx, v3_sql.SQLTx(tx),
+			repo.PrimaryKeyCondition(e.Aggregate().InstanceID, e.Aggregate().ID),
+			e.RoleKeys,
+			repo.SetUpdatedAt(e.CreationDate()),
+		)
+		return err
+	}), nil
+}
+
+func (p *authorizationRelationalProjection) reduceAuthorizationCascadeChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantCascadeChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-1dU3NUSfbf", "reduce.wrong.event.type %s", usergrant.UserGrantCascadeChangedType)
+	}
+	return handler.NewStatement(e, func(ctx context.Context, ex handler.Executer, _ string) error {
+		tx, ok := ex.(*sql.Tx)
+		if !ok {
+			return zerrors.ThrowInvalidArgumentf(nil, "HANDL-SNdR5GwOba", "reduce.wrong.db.pool %T", ex)
+		}
+		repo := repository.AuthorizationRepository()
+		_, err := repo.Update(ctx, v3_sql.SQLTx(tx),
+			repo.PrimaryKeyCondition(e.Aggregate().InstanceID, e.Aggregate().ID),
+			e.RoleKeys,
+			repo.SetUpdatedAt(e.CreationDate()),
+		)
+		return err
+	}), nil
+}
+
+func (p *authorizationRelationalProjection) reduceAuthorizationDeactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantDeactivatedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-hcMwR3ntHd", "reduce.wrong.event.type %s", usergrant.UserGrantDeactivatedType)
+	}
+	return handler.NewStatement(e, func(ctx context.Context, ex handler.Executer, _ string) error {
+		tx, ok := ex.(*sql.Tx)
+		if !ok {
+			return zerrors.ThrowInvalidArgumentf(nil, "HANDL-WzjGRREvYq", "reduce.wrong.db.pool %T", ex)
+		}
+		repo := repository.AuthorizationRepository()
+		_, err := repo.Update(ctx, v3_sql.SQLTx(tx),
+			repo.PrimaryKeyCondition(e.Aggregate().InstanceID, e.Aggregate().ID),
+			nil,
+			repo.SetUpdatedAt(e.CreationDate()),
+			repo.SetState(repoDomain.AuthorizationStateInactive),
+		)
+		return err
+	}), nil
+}
+
+func (p *authorizationRelationalProjection) reduceAuthorizationReactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantReactivatedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-v8XkFwMmcg", "reduce.wrong.event.type %s", usergrant.UserGrantReactivatedType)
+	}
+	return handler.NewStatement(e, func(ctx context.Context, ex handler.Executer, _ string) error {
+		tx, ok := ex.(*sql.Tx)
+		if !ok {
+			return zerrors.ThrowInvalidArgumentf(nil, "HANDL-dEH1tenn5x", "reduce.wrong.db.pool %T", ex)
+		}
+		repo := repository.AuthorizationRepository()
+		_, err := repo.Update(ctx, v3_sql.SQLTx(tx),
+			repo.PrimaryKeyCondition(e.Aggregate().InstanceID, e.Aggregate().ID),
+			nil,
+			repo.SetUpdatedAt(e.CreationDate()),
+			repo.SetState(repoDomain.AuthorizationStateActive),
+		)
+		return err
+	}), nil
+}
+
+func (p *authorizationRelationalProjection) reduceAuthorizationRemoved(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *usergrant.UserGrantRemovedEvent, *usergrant.UserGrantCascadeRemovedEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-pUsMyvWn6J", "reduce.wrong.event.type %v", []eventstore.EventType{usergrant.UserGrantRemovedType, usergrant.UserGrantCascadeRemovedType})
+	}
+	return handler.NewStatement(event, func(ctx context.Context, ex handler.Executer, _ string) error {
+		tx, ok := ex.(*sql.Tx)
+		if !ok {
+			return zerrors.ThrowInvalidArgumentf(nil, "HANDL-PXEEBRAc1t", "reduce.wrong.db.pool %T", ex)
+		}
+		repo := repository.AuthorizationRepository()
+		_, err := repo.Delete(ctx, v3_sql.SQLTx(tx),
+			repo.PrimaryKeyCondition(event.Aggregate().InstanceID, event.Aggregate().ID),
+		)
+		return err
+	}), nil
+}
@@ -58,6 +58,12 @@ func withVersion(v eventstore.Version) eventOption {
 	}
 }
 
+func withResourceOwner(resourceOwner string) eventOption {
+	return func(e *repository.Event) {
+		e.ResourceOwner = sql.NullString{String: resourceOwner, Valid: true}
+	}
+}
+
 func baseEvent(*testing.T) eventstore.Event {
 	return &eventstore.BaseEvent{}
 }
@@ -3,10 +3,12 @@ package projection
 import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/group"
 	"github.com/zitadel/zitadel/internal/repository/instance"
 	"github.com/zitadel/zitadel/internal/repository/org"
 	"github.com/zitadel/zitadel/internal/repository/permission"
 	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/session"
 )
 
 const (
@@ -15,6 +17,8 @@ const (
 	fieldsInstanceDomain    = "instance_domain_fields"
 	fieldsMemberships       = "membership_fields"
 	fieldsPermission        = "permission_fields"
+	fieldsGroupUsers        = "group_user_fields"
+	fieldsSessionRisk       = "session_risk_fields"
 )
 
 func newFillProjectGrantFields(config handler.Config) *handler.FieldHandler {
@@ -66,6 +70,9 @@ func newFillMembershipFields(config handler.Config) *handler.FieldHandler {
 				instance.MemberChangedEventType,
 				instance.MemberRemovedEventType,
 				instance.MemberCascadeRemovedEventType,
+				instance.GroupMemberAddedEventType,
+				instance.GroupMemberChangedEventType,
+				instance.GroupMemberRemovedEventType,
 				instance.InstanceRemovedEventType,
 			},
 			org.AggregateType: {
@@ -73,6 +80,9 @@ func newFillMembershipFields(config handler.Config) *handler.FieldHandler {
 				org.MemberChangedEventType,
 				org.MemberRemovedEventType,
 				org.MemberCascadeRemovedEventType,
+				org.GroupMemberAddedEventType,
+				org.GroupMemberChangedEventType,
+				org.GroupMemberRemovedEventType,
 				org.OrgRemovedEventType,
 			},
 			project.AggregateType: {
@@ -80,12 +90,54 @@ func newFillMembershipFields(config handler.Config) *handler.FieldHandler {
 				project.MemberChangedEventType,
 				project.MemberRemovedEventType,
 				project.MemberCascadeRemovedEventType,
+				project.GroupMemberAddedEventType,
+				project.GroupMemberChangedEventType,
+				project.GroupMemberRemovedEventType,
+				project.GrantMemberAddedType,
+				project.GrantMemberChangedType,
+				project.GrantMemberRemovedType,
+				project.GrantMemberCascadeRemovedType,
+				project.ApplicationMemberAddedType,
+				project.ApplicationMemberChangedType,
+				project.ApplicationMemberRemovedType,
+				project.ApplicationRemovedType,
+				project.RoleMemberAddedType,
+				project.RoleMemberChangedType,
+				project.RoleMemberRemovedType,
+				project.RoleRemovedType,
 				project.ProjectRemovedType,
 			},
 		},
 	)
 }
 
+func newFillGroupUserFields(config handler.Config) *handler.FieldHandler {
+	return handler.NewFieldHandler(
+		&config,
+		fieldsGroupUsers,
+		map[eventstore.AggregateType][]eventstore.EventType{
+			group.AggregateType: {
+				group.GroupUsersAddedEventType,
+				group.GroupUsersRemovedEventType,
+				group.GroupRemovedEventType,
+			},
+		},
+	)
+}
+
+func newFillSessionRiskFields(config handler.Config) *handler.FieldHandler {
+	return handler.NewFieldHandler(
+		&config,
+		fieldsSessionRisk,
+		map[eventstore.AggregateType][]eventstore.EventType{
+			session.AggregateType: {
+				session.RiskEvaluatedType,
+				session.TerminateType,
+			},
+		},
+	)
+}
+
 func newFillPermissionFields(config handler.Config) *handler.FieldHandler {
 	return handler.NewFieldHandler(
 		&config,
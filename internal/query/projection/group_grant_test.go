@@ -0,0 +1,299 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/groupgrant"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestGroupGrantProjection_reduces(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						groupgrant.GroupGrantAddedType,
+						groupgrant.AggregateType,
+						[]byte(`{"groupId": "group-id", "projectId": "project-id", "grantId": "grant-id", "roleKeys": ["role"]}`),
+					), eventstore.GenericEventMapper[groupgrant.GroupGrantAddedEvent]),
+			},
+			reduce: (&groupGrantProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: groupgrant.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.group_grants1 (id, resource_owner, instance_id, creation_date, change_date, sequence, group_id, project_id, grant_id, roles, state) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"ro-id",
+								"instance-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"group-id",
+								"project-id",
+								"grant-id",
+								database.TextArray[string]{"role"},
+								domain.UserGrantStateActive,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						groupgrant.GroupGrantChangedType,
+						groupgrant.AggregateType,
+						[]byte(`{"groupId": "group-id", "roleKeys": ["role"]}`),
+					), eventstore.GenericEventMapper[groupgrant.GroupGrantChangedEvent]),
+			},
+			reduce: (&groupGrantProjection{}).reduceChanged,
+			want: wantReduce{
+				aggregateType: groupgrant.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.group_grants1 SET (change_date, roles, sequence) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								database.TextArray[string]{"role"},
+								uint64(15),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						groupgrant.GroupGrantRemovedType,
+						groupgrant.AggregateType,
+						[]byte(`{"groupId": "group-id", "projectId": "project-id"}`),
+					), eventstore.GenericEventMapper[groupgrant.GroupGrantRemovedEvent]),
+			},
+			reduce: (&groupGrantProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: groupgrant.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.group_grants1 WHERE (id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceGroupRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.GroupRemovedEventType,
+						group.AggregateType,
+						nil,
+					), eventstore.GenericEventMapper[group.GroupRemovedEvent]),
+			},
+			reduce: (&groupGrantProjection{}).reduceGroupRemoved,
+			want: wantReduce{
+				aggregateType: group.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.group_grants1 WHERE (group_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProjectRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ProjectRemovedType,
+						project.AggregateType,
+						nil,
+					), project.ProjectRemovedEventMapper),
+			},
+			reduce: (&groupGrantProjection{}).reduceProjectRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.group_grants1 WHERE (project_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProjectGrantRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.GrantRemovedType,
+						project.AggregateType,
+						[]byte(`{"grantId": "grantID"}`),
+					), project.GrantRemovedEventMapper),
+			},
+			reduce: (&groupGrantProjection{}).reduceProjectGrantRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.group_grants1 WHERE (grant_id = $1) AND (instance_id = $2)",
+							expectedArgs: []interface{}{
+								"grantID",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRoleRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.RoleRemovedType,
+						project.AggregateType,
+						[]byte(`{"key": "key"}`),
+					), project.RoleRemovedEventMapper),
+			},
+			reduce: (&groupGrantProjection{}).reduceRoleRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.group_grants1 SET roles = array_remove(roles, $1) WHERE (project_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"key",
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProjectGrantChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.GrantChangedType,
+						project.AggregateType,
+						[]byte(`{"grantId": "grantID", "roleKeys": ["key"]}`),
+					), project.GrantChangedEventMapper),
+			},
+			reduce: (&groupGrantProjection{}).reduceProjectGrantChanged,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.group_grants1 SET (roles) = (SELECT ARRAY( SELECT UNNEST(roles) INTERSECT SELECT UNNEST ($1::TEXT[]))) WHERE (grant_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								database.TextArray[string]{"key"},
+								"grantID",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					), org.OrgRemovedEventMapper),
+			},
+			reduce: (&groupGrantProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.group_grants1 WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, GroupGrantProjectionTable, tt.want)
+		})
+	}
+}
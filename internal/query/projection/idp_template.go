@@ -30,6 +30,7 @@ const (
 	IDPTemplateLDAPTable             = IDPTemplateTable + "_" + IDPTemplateLDAPSuffix
 	IDPTemplateAppleTable            = IDPTemplateTable + "_" + IDPTemplateAppleSuffix
 	IDPTemplateSAMLTable             = IDPTemplateTable + "_" + IDPTemplateSAMLSuffix
+	IDPTemplateWSFederationTable     = IDPTemplateTable + "_" + IDPTemplateWSFederationSuffix
 
 	IDPTemplateOAuthSuffix            = "oauth2"
 	IDPTemplateOIDCSuffix             = "oidc"
@@ -43,6 +44,7 @@ const (
 	IDPTemplateLDAPSuffix             = "ldap2"
 	IDPTemplateAppleSuffix            = "apple"
 	IDPTemplateSAMLSuffix             = "saml"
+	IDPTemplateWSFederationSuffix     = "wsfed"
 
 	IDPTemplateIDCol                = "id"
 	IDPTemplateCreationDateCol      = "creation_date"
@@ -175,6 +177,13 @@ const (
 	SAMLNameIDFormatCol               = "name_id_format"
 	SAMLTransientMappingAttributeName = "transient_mapping_attribute_name"
 	SAMLFederatedLogoutEnabled        = "federated_logout_enabled"
+
+	WSFederationIDCol          = "idp_id"
+	WSFederationInstanceIDCol  = "instance_id"
+	WSFederationSSOEndpointCol = "sso_endpoint"
+	WSFederationRealmCol       = "realm"
+	WSFederationCertificateCol = "certificate"
+	WSFederationIDClaimCol     = "id_claim"
 )
 
 type idpTemplateProjection struct{}
@@ -386,6 +395,18 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			IDPTemplateSAMLSuffix,
 			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
 		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(WSFederationIDCol, handler.ColumnTypeText),
+			handler.NewColumn(WSFederationInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(WSFederationSSOEndpointCol, handler.ColumnTypeText),
+			handler.NewColumn(WSFederationRealmCol, handler.ColumnTypeText),
+			handler.NewColumn(WSFederationCertificateCol, handler.ColumnTypeBytes),
+			handler.NewColumn(WSFederationIDClaimCol, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(WSFederationInstanceIDCol, WSFederationIDCol),
+			IDPTemplateWSFederationSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
 	)
 }
 
@@ -522,6 +543,14 @@ func (p *idpTemplateProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.SAMLIDPChangedEventType,
 					Reduce: p.reduceSAMLIDPChanged,
 				},
+				{
+					Event:  instance.WSFederationIDPAddedEventType,
+					Reduce: p.reduceWSFederationIDPAdded,
+				},
+				{
+					Event:  instance.WSFederationIDPChangedEventType,
+					Reduce: p.reduceWSFederationIDPChanged,
+				},
 				{
 					Event:  instance.IDPConfigRemovedEventType,
 					Reduce: p.reduceIDPConfigRemoved,
@@ -667,6 +696,14 @@ func (p *idpTemplateProjection) Reducers() []handler.AggregateReducer {
 					Event:  org.SAMLIDPChangedEventType,
 					Reduce: p.reduceSAMLIDPChanged,
 				},
+				{
+					Event:  org.WSFederationIDPAddedEventType,
+					Reduce: p.reduceWSFederationIDPAdded,
+				},
+				{
+					Event:  org.WSFederationIDPChangedEventType,
+					Reduce: p.reduceWSFederationIDPChanged,
+				},
 				{
 					Event:  org.IDPConfigRemovedEventType,
 					Reduce: p.reduceIDPConfigRemoved,
@@ -2162,6 +2199,96 @@ func (p *idpTemplateProjection) reduceAppleIDPChanged(event eventstore.Event) (*
 	), nil
 }
 
+func (p *idpTemplateProjection) reduceWSFederationIDPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.WSFederationIDPAddedEvent
+	var idpOwnerType domain.IdentityProviderType
+	switch e := event.(type) {
+	case *org.WSFederationIDPAddedEvent:
+		idpEvent = e.WSFederationIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeOrg
+	case *instance.WSFederationIDPAddedEvent:
+		idpEvent = e.WSFederationIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeSystem
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Wf3aA", "reduce.wrong.event.type %v", []eventstore.EventType{org.WSFederationIDPAddedEventType, instance.WSFederationIDPAddedEventType})
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTemplateResourceOwnerCol, idpEvent.Aggregate().ResourceOwner),
+				handler.NewCol(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(IDPTemplateStateCol, domain.IDPStateActive),
+				handler.NewCol(IDPTemplateNameCol, idpEvent.Name),
+				handler.NewCol(IDPTemplateOwnerTypeCol, idpOwnerType),
+				handler.NewCol(IDPTemplateTypeCol, domain.IDPTypeWSFederation),
+				handler.NewCol(IDPTemplateIsCreationAllowedCol, idpEvent.IsCreationAllowed),
+				handler.NewCol(IDPTemplateIsLinkingAllowedCol, idpEvent.IsLinkingAllowed),
+				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
+				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
+				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(WSFederationIDCol, idpEvent.ID),
+				handler.NewCol(WSFederationInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(WSFederationSSOEndpointCol, idpEvent.SSOEndpoint),
+				handler.NewCol(WSFederationRealmCol, idpEvent.Realm),
+				handler.NewCol(WSFederationCertificateCol, idpEvent.Certificate),
+				handler.NewCol(WSFederationIDClaimCol, idpEvent.IDClaim),
+			},
+			handler.WithTableSuffix(IDPTemplateWSFederationSuffix),
+		),
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceWSFederationIDPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.WSFederationIDPChangedEvent
+	switch e := event.(type) {
+	case *org.WSFederationIDPChangedEvent:
+		idpEvent = e.WSFederationIDPChangedEvent
+	case *instance.WSFederationIDPChangedEvent:
+		idpEvent = e.WSFederationIDPChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Wf3cA", "reduce.wrong.event.type %v", []eventstore.EventType{org.WSFederationIDPChangedEventType, instance.WSFederationIDPChangedEventType})
+	}
+
+	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	ops = append(ops,
+		handler.AddUpdateStatement(
+			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			[]handler.Condition{
+				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+	)
+	wsFederationCols := reduceWSFederationIDPChangedColumns(idpEvent)
+	if len(wsFederationCols) > 0 {
+		ops = append(ops,
+			handler.AddUpdateStatement(
+				wsFederationCols,
+				[]handler.Condition{
+					handler.NewCond(WSFederationIDCol, idpEvent.ID),
+					handler.NewCond(WSFederationInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				},
+				handler.WithTableSuffix(IDPTemplateWSFederationSuffix),
+			),
+		)
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		ops...,
+	), nil
+}
+
 func (p *idpTemplateProjection) reduceIDPConfigRemoved(event eventstore.Event) (*handler.Statement, error) {
 	var idpEvent idpconfig.IDPConfigRemovedEvent
 	switch e := event.(type) {
@@ -2539,3 +2666,20 @@ func reduceSAMLIDPChangedColumns(idpEvent idp.SAMLIDPChangedEvent) []handler.Col
 	}
 	return SAMLCols
 }
+
+func reduceWSFederationIDPChangedColumns(idpEvent idp.WSFederationIDPChangedEvent) []handler.Column {
+	wsFederationCols := make([]handler.Column, 0, 4)
+	if idpEvent.SSOEndpoint != nil {
+		wsFederationCols = append(wsFederationCols, handler.NewCol(WSFederationSSOEndpointCol, *idpEvent.SSOEndpoint))
+	}
+	if idpEvent.Realm != nil {
+		wsFederationCols = append(wsFederationCols, handler.NewCol(WSFederationRealmCol, *idpEvent.Realm))
+	}
+	if idpEvent.Certificate != nil {
+		wsFederationCols = append(wsFederationCols, handler.NewCol(WSFederationCertificateCol, idpEvent.Certificate))
+	}
+	if idpEvent.IDClaim != nil {
+		wsFederationCols = append(wsFederationCols, handler.NewCol(WSFederationIDClaimCol, *idpEvent.IDClaim))
+	}
+	return wsFederationCols
+}
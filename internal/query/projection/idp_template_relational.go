@@ -191,6 +191,14 @@ func (p *idpTemplateRelationalProjection) Reducers() []handler.AggregateReducer
 					Event:  instance.SAMLIDPChangedEventType,
 					Reduce: p.reduceSAMLIDPChanged,
 				},
+				{
+					Event:  instance.WSFederationIDPAddedEventType,
+					Reduce: p.reduceWSFederationIDPAdded,
+				},
+				{
+					Event:  instance.WSFederationIDPChangedEventType,
+					Reduce: p.reduceWSFederationIDPChanged,
+				},
 				{
 					Event:  instance.IDPRemovedEventType,
 					Reduce: p.reduceIDPRemoved,
@@ -340,6 +348,14 @@ func (p *idpTemplateRelationalProjection) Reducers() []handler.AggregateReducer
 					Event:  org.SAMLIDPChangedEventType,
 					Reduce: p.reduceSAMLIDPChanged,
 				},
+				{
+					Event:  org.WSFederationIDPAddedEventType,
+					Reduce: p.reduceWSFederationIDPAdded,
+				},
+				{
+					Event:  org.WSFederationIDPChangedEventType,
+					Reduce: p.reduceWSFederationIDPChanged,
+				},
 				{
 					Event:  org.IDPRemovedEventType,
 					Reduce: p.reduceIDPRemoved,
@@ -2092,6 +2108,110 @@ func (p *idpTemplateRelationalProjection) reduceSAMLIDPChanged(event eventstore.
 	}), nil
 }
 
+func (p *idpTemplateRelationalProjection) reduceWSFederationIDPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var orgId *string
+	var idpEvent idp.WSFederationIDPAddedEvent
+	switch e := event.(type) {
+	case *org.WSFederationIDPAddedEvent:
+		idpEvent = e.WSFederationIDPAddedEvent
+		orgId = &idpEvent.Aggregate().ResourceOwner
+	case *instance.WSFederationIDPAddedEvent:
+		idpEvent = e.WSFederationIDPAddedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Wf2rA", "reduce.wrong.event.type %v", []eventstore.EventType{org.WSFederationIDPAddedEventType, instance.WSFederationIDPAddedEventType})
+	}
+
+	wsFederation := domain.WSFederation{
+		SSOEndpoint: idpEvent.SSOEndpoint,
+		Realm:       idpEvent.Realm,
+		Certificate: idpEvent.Certificate,
+		IDClaim:     idpEvent.IDClaim,
+	}
+
+	payloadJSON, err := json.Marshal(wsFederation)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewCreateStatement(
+		&idpEvent,
+		[]handler.Column{
+			handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
+			handler.NewCol(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			handler.NewCol(IDPRelationalOrgId, orgId),
+			handler.NewCol(IDPTemplateNameCol, idpEvent.Name),
+			handler.NewCol(IDPTemplateTypeCol, domain.IDPTypeWSFederation),
+			handler.NewCol(IDPTemplateStateCol, domain.IDPStateActive.String()),
+			handler.NewCol(IDPRelationalAllowCreationCol, idpEvent.IsCreationAllowed),
+			handler.NewCol(IDPRelationalAllowLinkingCol, idpEvent.IsLinkingAllowed),
+			handler.NewCol(IDPRelationalAllowAutoCreationCol, idpEvent.IsAutoCreation),
+			handler.NewCol(IDPRelationalAllowAutoUpdateCol, idpEvent.IsAutoUpdate),
+			handler.NewCol(IDPRelationalAllowAutoLinkingCol, func() any {
+				if idpEvent.AutoLinkingOption == internal_domain.AutoLinkingOptionUnspecified {
+					return nil
+				}
+				return domain.IDPAutoLinkingField(idpEvent.AutoLinkingOption)
+			}()),
+			handler.NewCol(IDPRelationalPayloadCol, payloadJSON),
+			handler.NewCol(CreatedAt, idpEvent.CreationDate()),
+			handler.NewCol(UpdatedAt, idpEvent.CreationDate()),
+		},
+	), nil
+}
+
+func (p *idpTemplateRelationalProjection) reduceWSFederationIDPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var orgId *string
+	var orgCond handler.Condition
+	var idpEvent idp.WSFederationIDPChangedEvent
+	switch e := event.(type) {
+	case *org.WSFederationIDPChangedEvent:
+		idpEvent = e.WSFederationIDPChangedEvent
+		orgId = &idpEvent.Aggregate().ResourceOwner
+		orgCond = handler.NewCond(IDPRelationalOrgId, orgId)
+	case *instance.WSFederationIDPChangedEvent:
+		idpEvent = e.WSFederationIDPChangedEvent
+		orgCond = handler.NewIsNullCond((IDPRelationalOrgId))
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Wf2rC", "reduce.wrong.event.type %v", []eventstore.EventType{org.WSFederationIDPChangedEventType, instance.WSFederationIDPChangedEventType})
+	}
+
+	return handler.NewStatement(event, func(ctx context.Context, ex handler.Executer, projectionName string) error {
+		tx, ok := ex.(*sql.Tx)
+		if !ok {
+			return zerrors.ThrowInternal(nil, "HANDL-Wf2rT", "unable to cast to tx executer")
+		}
+		wsFederation, err := p.idpRepo.GetWSFederation(ctx, v3_sql.SQLTx(tx), p.idpRepo.IDCondition(idpEvent.ID), idpEvent.Agg.InstanceID, orgId)
+		if err != nil {
+			return err
+		}
+
+		columns := reduceIDPRelationalChangedTemplateColumns(idpEvent.Name, idpEvent.OptionChanges)
+
+		payload := &wsFederation.WSFederation
+		payloadChanged := reduceWSFederationIDPRelationalChangedColumns(payload, &idpEvent)
+		if payloadChanged {
+			payloadJSON, err := json.Marshal(payload)
+			if err != nil {
+				return err
+			}
+			columns = append(columns, handler.NewCol(IDPRelationalPayloadCol, payloadJSON))
+		}
+
+		columns = append(columns, handler.NewCol(UpdatedAt, idpEvent.CreationDate()))
+
+		return handler.NewUpdateStatement(
+			&idpEvent,
+			columns,
+			[]handler.Condition{
+				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				orgCond,
+			},
+		).Execute(ctx, ex, projectionName)
+
+	}), nil
+}
+
 func (p *idpTemplateRelationalProjection) reduceIDPRemoved(event eventstore.Event) (*handler.Statement, error) {
 	var orgCond handler.Condition
 	var idpEvent idp.RemovedEvent
@@ -2521,3 +2641,24 @@ func reduceSAMLIDPRelationalChangedColumns(payload *domain.SAML, idpEvent *idp.S
 	}
 	return payloadChange
 }
+
+func reduceWSFederationIDPRelationalChangedColumns(payload *domain.WSFederation, idpEvent *idp.WSFederationIDPChangedEvent) bool {
+	payloadChange := false
+	if idpEvent.SSOEndpoint != nil {
+		payloadChange = true
+		payload.SSOEndpoint = *idpEvent.SSOEndpoint
+	}
+	if idpEvent.Realm != nil {
+		payloadChange = true
+		payload.Realm = *idpEvent.Realm
+	}
+	if idpEvent.Certificate != nil {
+		payloadChange = true
+		payload.Certificate = idpEvent.Certificate
+	}
+	if idpEvent.IDClaim != nil {
+		payloadChange = true
+		payload.IDClaim = *idpEvent.IDClaim
+	}
+	return payloadChange
+}
@@ -4505,3 +4505,188 @@ func stringToJSONByte(data string) string {
 	jsondata, _ := json.Marshal([]byte(data))
 	return string(jsondata)
 }
+
+func TestIDPTemplateProjection_reducesWSFederation(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "instance reduceWSFederationIDPAdded",
+			args: args{
+				event: getEvent(testEvent(
+					instance.WSFederationIDPAddedEventType,
+					instance.AggregateType,
+					[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"ssoEndpoint": "https://adfs.example.com/adfs/ls/",
+	"realm": "urn:zitadel",
+	"certificate": "Y2VydGlmaWNhdGU=",
+	"idClaim": "upn",
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+				), instance.WSFederationIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceWSFederationIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeSystem,
+								domain.IDPTypeWSFederation,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_wsfed (idp_id, instance_id, sso_endpoint, realm, certificate, id_claim) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"https://adfs.example.com/adfs/ls/",
+								"urn:zitadel",
+								[]byte("certificate"),
+								"upn",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceWSFederationIDPAdded",
+			args: args{
+				event: getEvent(testEvent(
+					org.WSFederationIDPAddedEventType,
+					org.AggregateType,
+					[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"ssoEndpoint": "https://adfs.example.com/adfs/ls/",
+	"realm": "urn:zitadel",
+	"certificate": "Y2VydGlmaWNhdGU="
+}`),
+				), org.WSFederationIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceWSFederationIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeOrg,
+								domain.IDPTypeWSFederation,
+								false,
+								false,
+								false,
+								false,
+								domain.AutoLinkingOptionUnspecified,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_wsfed (idp_id, instance_id, sso_endpoint, realm, certificate, id_claim) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"https://adfs.example.com/adfs/ls/",
+								"urn:zitadel",
+								[]byte("certificate"),
+								"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceWSFederationIDPChanged minimal",
+			args: args{
+				event: getEvent(testEvent(
+					instance.WSFederationIDPChangedEventType,
+					instance.AggregateType,
+					[]byte(`{
+	"id": "idp-id",
+	"isCreationAllowed": true,
+	"realm": "urn:other"
+}`),
+				), instance.WSFederationIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceWSFederationIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateMinimalStmt,
+							expectedArgs: []interface{}{
+								true,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_wsfed SET realm = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"urn:other",
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, IDPTemplateTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,298 @@
+package projection
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/ldapsync"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	LDAPSyncTable                   = "projections.ldap_syncs"
+	LDAPSyncInstanceIDCol           = "instance_id"
+	LDAPSyncIDPIDCol                = "idp_id"
+	LDAPSyncResourceOwnerCol        = "resource_owner"
+	LDAPSyncOrganizationIDCol       = "organization_id"
+	LDAPSyncCreationDateCol         = "creation_date"
+	LDAPSyncChangeDateCol           = "change_date"
+	LDAPSyncSequenceCol             = "sequence"
+	LDAPSyncIntervalCol             = "interval"
+	LDAPSyncFullSyncIntervalCol     = "full_sync_interval"
+	LDAPSyncFilterCol               = "filter"
+	LDAPSyncPageSizeCol             = "page_size"
+	LDAPSyncIncrementalAttributeCol = "incremental_attribute"
+	LDAPSyncGroupAttributeCol       = "group_attribute"
+	LDAPSyncGroupMappingsCol        = "group_mappings"
+	LDAPSyncFullSyncRequestedCol    = "full_sync_requested"
+	LDAPSyncWatermarkCol            = "watermark"
+	LDAPSyncNextRunAtCol            = "next_run_at"
+	LDAPSyncLastRunDateCol          = "last_run_date"
+	LDAPSyncLastFullSyncDateCol     = "last_full_sync_date"
+	LDAPSyncLastSummaryCol          = "last_summary"
+	LDAPSyncLastErrorDateCol        = "last_error_date"
+	LDAPSyncLastErrorCol            = "last_error"
+)
+
+type ldapSyncProjection struct{}
+
+func newLDAPSyncProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(ldapSyncProjection))
+}
+
+func (*ldapSyncProjection) Name() string {
+	return LDAPSyncTable
+}
+
+func (*ldapSyncProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(LDAPSyncInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(LDAPSyncIDPIDCol, handler.ColumnTypeText),
+			handler.NewColumn(LDAPSyncResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(LDAPSyncOrganizationIDCol, handler.ColumnTypeText),
+			handler.NewColumn(LDAPSyncCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(LDAPSyncChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(LDAPSyncSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(LDAPSyncIntervalCol, handler.ColumnTypeInt64),
+			handler.NewColumn(LDAPSyncFullSyncIntervalCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(LDAPSyncFilterCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(LDAPSyncPageSizeCol, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(LDAPSyncIncrementalAttributeCol, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(LDAPSyncGroupAttributeCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(LDAPSyncGroupMappingsCol, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(LDAPSyncFullSyncRequestedCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(LDAPSyncWatermarkCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(LDAPSyncNextRunAtCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(LDAPSyncLastRunDateCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(LDAPSyncLastFullSyncDateCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(LDAPSyncLastSummaryCol, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(LDAPSyncLastErrorDateCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(LDAPSyncLastErrorCol, handler.ColumnTypeText, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(LDAPSyncInstanceIDCol, LDAPSyncIDPIDCol),
+			handler.WithIndex(handler.NewIndex("organization_id", []string{LDAPSyncInstanceIDCol, LDAPSyncOrganizationIDCol})),
+		),
+	)
+}
+
+func (p *ldapSyncProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: ldapsync.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  ldapsync.SetType,
+					Reduce: p.reduceSet,
+				},
+				{
+					Event:  ldapsync.RemovedType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  ldapsync.RunRequestedType,
+					Reduce: p.reduceRunRequested,
+				},
+				{
+					Event:  ldapsync.RunSucceededType,
+					Reduce: p.reduceRunSucceeded,
+				},
+				{
+					Event:  ldapsync.RunFailedType,
+					Reduce: p.reduceRunFailed,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.IDPRemovedEventType,
+					Reduce: p.reduceIDPRemoved,
+				},
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.IDPRemovedEventType,
+					Reduce: p.reduceIDPRemoved,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(LDAPSyncInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *ldapSyncProjection) reduceSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*ldapsync.SetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	groupMappings, err := json.Marshal(e.GroupMappings)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "HANDL-Ls9pM", "Errors.Internal")
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(LDAPSyncInstanceIDCol, nil),
+			handler.NewCol(LDAPSyncIDPIDCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(LDAPSyncInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(LDAPSyncIDPIDCol, e.Aggregate().ID),
+			handler.NewCol(LDAPSyncResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(LDAPSyncOrganizationIDCol, e.OrganizationID),
+			handler.NewCol(LDAPSyncCreationDateCol, handler.OnlySetValueOnInsert(LDAPSyncTable, e.CreationDate())),
+			handler.NewCol(LDAPSyncChangeDateCol, e.CreationDate()),
+			handler.NewCol(LDAPSyncSequenceCol, e.Sequence()),
+			handler.NewCol(LDAPSyncIntervalCol, e.Interval),
+			handler.NewCol(LDAPSyncFullSyncIntervalCol, e.FullSyncInterval),
+			handler.NewCol(LDAPSyncFilterCol, e.Filter),
+			handler.NewCol(LDAPSyncPageSizeCol, e.PageSize),
+			handler.NewCol(LDAPSyncIncrementalAttributeCol, e.IncrementalAttribute),
+			handler.NewCol(LDAPSyncGroupAttributeCol, e.GroupAttribute),
+			handler.NewCol(LDAPSyncGroupMappingsCol, groupMappings),
+			handler.NewCol(LDAPSyncNextRunAtCol, e.CreationDate()),
+		},
+	), nil
+}
+
+func (p *ldapSyncProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*ldapsync.RemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(LDAPSyncInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(LDAPSyncIDPIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *ldapSyncProjection) reduceRunRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*ldapsync.RunRequestedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	columns := []handler.Column{
+		handler.NewCol(LDAPSyncChangeDateCol, e.CreationDate()),
+		handler.NewCol(LDAPSyncSequenceCol, e.Sequence()),
+		handler.NewCol(LDAPSyncNextRunAtCol, e.CreationDate()),
+	}
+	// a requested full synchronization is kept until it's executed
+	if e.Full {
+		columns = append(columns, handler.NewCol(LDAPSyncFullSyncRequestedCol, true))
+	}
+	return handler.NewUpdateStatement(e, columns, p.syncConditions(e)), nil
+}
+
+func (p *ldapSyncProjection) reduceRunSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*ldapsync.RunSucceededEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := json.Marshal(e.Summary)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "HANDL-Ls9pS", "Errors.Internal")
+	}
+	columns := []handler.Column{
+		handler.NewCol(LDAPSyncChangeDateCol, e.CreationDate()),
+		handler.NewCol(LDAPSyncSequenceCol, e.Sequence()),
+		handler.NewCol(LDAPSyncWatermarkCol, e.Watermark),
+		handler.NewCol(LDAPSyncNextRunAtCol, e.NextRunAt),
+		handler.NewCol(LDAPSyncLastRunDateCol, e.StartedAt),
+		handler.NewCol(LDAPSyncLastSummaryCol, summary),
+	}
+	if e.Summary != nil && e.Summary.Full {
+		columns = append(columns,
+			handler.NewCol(LDAPSyncLastFullSyncDateCol, e.StartedAt),
+			handler.NewCol(LDAPSyncFullSyncRequestedCol, false),
+		)
+	}
+	return handler.NewUpdateStatement(e, columns, p.syncConditions(e)), nil
+}
+
+func (p *ldapSyncProjection) reduceRunFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*ldapsync.RunFailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(LDAPSyncChangeDateCol, e.CreationDate()),
+			handler.NewCol(LDAPSyncSequenceCol, e.Sequence()),
+			handler.NewCol(LDAPSyncNextRunAtCol, e.NextRunAt),
+			handler.NewCol(LDAPSyncLastErrorDateCol, e.StartedAt),
+			handler.NewCol(LDAPSyncLastErrorCol, e.Error),
+		},
+		p.syncConditions(e),
+	), nil
+}
+
+func (p *ldapSyncProjection) syncConditions(event eventstore.Event) []handler.Condition {
+	return []handler.Condition{
+		handler.NewCond(LDAPSyncInstanceIDCol, event.Aggregate().InstanceID),
+		handler.NewCond(LDAPSyncIDPIDCol, event.Aggregate().ID),
+	}
+}
+
+func (p *ldapSyncProjection) reduceIDPRemoved(event eventstore.Event) (*handler.Statement, error) {
+	var idpID string
+	switch e := event.(type) {
+	case *org.IDPRemovedEvent:
+		idpID = e.ID
+	case *instance.IDPRemovedEvent:
+		idpID = e.ID
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ls9pR", "reduce.wrong.event.type %v", []eventstore.EventType{org.IDPRemovedEventType, instance.IDPRemovedEventType})
+	}
+	return handler.NewDeleteStatement(
+		event,
+		[]handler.Condition{
+			handler.NewCond(LDAPSyncInstanceIDCol, event.Aggregate().InstanceID),
+			handler.NewCond(LDAPSyncIDPIDCol, idpID),
+		},
+	), nil
+}
+
+// reduceOwnerRemoved removes the synchronizations of the identity providers of the organization
+// and the ones synchronizing into the organization.
+func (p *ldapSyncProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(LDAPSyncInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(LDAPSyncResourceOwnerCol, e.Aggregate().ID),
+			},
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(LDAPSyncInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(LDAPSyncOrganizationIDCol, e.Aggregate().ID),
+			},
+		),
+	), nil
+}
@@ -0,0 +1,309 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/ldapsync"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestLDAPSyncProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						ldapsync.SetType,
+						ldapsync.AggregateType,
+						[]byte(`{"organizationId": "org-id", "interval": 3600000000000, "fullSyncInterval": 86400000000000, "filter": "(department=it)", "pageSize": 100, "incrementalAttribute": 1, "groupAttribute": "memberOf", "groupMappings": [{"ldapGroup": "cn=admins,dc=example,dc=com", "groupId": "group-id"}]}`),
+					),
+					eventstore.GenericEventMapper[ldapsync.SetEvent],
+				),
+			},
+			reduce: (&ldapSyncProjection{}).reduceSet,
+			want: wantReduce{
+				aggregateType: ldapsync.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.ldap_syncs (instance_id, idp_id, resource_owner, organization_id, creation_date, change_date, sequence, interval, full_sync_interval, filter, page_size, incremental_attribute, group_attribute, group_mappings, next_run_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) ON CONFLICT (instance_id, idp_id) DO UPDATE SET (resource_owner, organization_id, creation_date, change_date, sequence, interval, full_sync_interval, filter, page_size, incremental_attribute, group_attribute, group_mappings, next_run_at) = (EXCLUDED.resource_owner, EXCLUDED.organization_id, projections.ldap_syncs.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.interval, EXCLUDED.full_sync_interval, EXCLUDED.filter, EXCLUDED.page_size, EXCLUDED.incremental_attribute, EXCLUDED.group_attribute, EXCLUDED.group_mappings, EXCLUDED.next_run_at)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"ro-id",
+								"org-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								time.Hour,
+								24 * time.Hour,
+								"(department=it)",
+								uint32(100),
+								domain.LDAPSyncIncrementalAttributeModifyTimestamp,
+								"memberOf",
+								[]byte(`[{"ldapGroup":"cn=admins,dc=example,dc=com","groupId":"group-id"}]`),
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						ldapsync.RemovedType,
+						ldapsync.AggregateType,
+						nil,
+					),
+					eventstore.GenericEventMapper[ldapsync.RemovedEvent],
+				),
+			},
+			reduce: (&ldapSyncProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: ldapsync.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.ldap_syncs WHERE (instance_id = $1) AND (idp_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRunRequested, full",
+			args: args{
+				event: getEvent(
+					testEvent(
+						ldapsync.RunRequestedType,
+						ldapsync.AggregateType,
+						[]byte(`{"full": true}`),
+					),
+					eventstore.GenericEventMapper[ldapsync.RunRequestedEvent],
+				),
+			},
+			reduce: (&ldapSyncProjection{}).reduceRunRequested,
+			want: wantReduce{
+				aggregateType: ldapsync.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.ldap_syncs SET (change_date, sequence, next_run_at, full_sync_requested) = ($1, $2, $3, $4) WHERE (instance_id = $5) AND (idp_id = $6)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								anyArg{},
+								true,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRunSucceeded, full",
+			args: args{
+				event: getEvent(
+					testEvent(
+						ldapsync.RunSucceededType,
+						ldapsync.AggregateType,
+						[]byte(`{"startedAt": "2025-01-01T10:00:00Z", "summary": {"full": true, "created": 2}, "watermark": "12", "nextRunAt": "2025-01-01T11:00:00Z"}`),
+					),
+					eventstore.GenericEventMapper[ldapsync.RunSucceededEvent],
+				),
+			},
+			reduce: (&ldapSyncProjection{}).reduceRunSucceeded,
+			want: wantReduce{
+				aggregateType: ldapsync.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.ldap_syncs SET (change_date, sequence, watermark, next_run_at, last_run_date, last_summary, last_full_sync_date, full_sync_requested) = ($1, $2, $3, $4, $5, $6, $7, $8) WHERE (instance_id = $9) AND (idp_id = $10)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"12",
+								time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC),
+								time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+								anyArg{},
+								time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+								false,
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRunFailed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						ldapsync.RunFailedType,
+						ldapsync.AggregateType,
+						[]byte(`{"startedAt": "2025-01-01T10:00:00Z", "error": "no server available", "nextRunAt": "2025-01-01T11:00:00Z"}`),
+					),
+					eventstore.GenericEventMapper[ldapsync.RunFailedEvent],
+				),
+			},
+			reduce: (&ldapSyncProjection{}).reduceRunFailed,
+			want: wantReduce{
+				aggregateType: ldapsync.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.ldap_syncs SET (change_date, sequence, next_run_at, last_error_date, last_error) = ($1, $2, $3, $4, $5) WHERE (instance_id = $6) AND (idp_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC),
+								time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+								"no server available",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceIDPRemoved, instance",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.IDPRemovedEventType,
+						instance.AggregateType,
+						[]byte(`{"id": "idp-id"}`),
+					),
+					instance.IDPRemovedEventMapper,
+				),
+			},
+			reduce: (&ldapSyncProjection{}).reduceIDPRemoved,
+			want: wantReduce{
+				aggregateType: instance.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.ldap_syncs WHERE (instance_id = $1) AND (idp_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"idp-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceIDPRemoved, org",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.IDPRemovedEventType,
+						org.AggregateType,
+						[]byte(`{"id": "idp-id"}`),
+					),
+					org.IDPRemovedEventMapper,
+				),
+			},
+			reduce: (&ldapSyncProjection{}).reduceIDPRemoved,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.ldap_syncs WHERE (instance_id = $1) AND (idp_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"idp-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						nil,
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&ldapSyncProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: org.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.ldap_syncs WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.ldap_syncs WHERE (instance_id = $1) AND (organization_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, LDAPSyncTable, tt.want)
+		})
+	}
+}
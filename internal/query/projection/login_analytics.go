@@ -0,0 +1,430 @@
+package projection
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/repository/idpintent"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/samlrequest"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// The login analytics table counts the login outcomes per hour.
+// The requests table keeps the application and identity provider of the pending OIDC and SAML requests and IdP intents,
+// so they can be attributed to the outcome, which is stored on a separate event.
+const (
+	LoginAnalyticsProjectionTable = "projections.login_analytics"
+	loginAnalyticsRequestsSuffix  = "requests"
+	LoginAnalyticsRequestsTable   = LoginAnalyticsProjectionTable + "_" + loginAnalyticsRequestsSuffix
+
+	LoginAnalyticsColumnInstanceID = "instance_id"
+	LoginAnalyticsColumnBucket     = "bucket"
+	LoginAnalyticsColumnMethod     = "method"
+	LoginAnalyticsColumnOutcome    = "outcome"
+	LoginAnalyticsColumnReason     = "reason"
+	LoginAnalyticsColumnClientID   = "client_id"
+	LoginAnalyticsColumnIDPID      = "idp_id"
+	LoginAnalyticsColumnCount      = "count"
+
+	LoginAnalyticsRequestColumnInstanceID   = "instance_id"
+	LoginAnalyticsRequestColumnID           = "id"
+	LoginAnalyticsRequestColumnClientID     = "client_id"
+	LoginAnalyticsRequestColumnIDPID        = "idp_id"
+	LoginAnalyticsRequestColumnCreationDate = "creation_date"
+
+	// LoginAnalyticsBucketSize is the duration the outcomes are counted in.
+	LoginAnalyticsBucketSize = time.Hour
+	// LoginAnalyticsRequestLifetime is the time a pending request is kept to attribute its outcome.
+	// Requests are completed within minutes, requests without outcome are removed after the lifetime.
+	LoginAnalyticsRequestLifetime = 24 * time.Hour
+	// loginAnalyticsReasonMaxLength limits the reasons of IdP errors, which are the messages returned by the IdP.
+	loginAnalyticsReasonMaxLength = 200
+)
+
+// incrementLoginAnalyticsStatement counts the outcome.
+// The client and IdP of a pending request with the given ID take precedence over the passed ones.
+const incrementLoginAnalyticsStatement = `INSERT INTO ` + LoginAnalyticsProjectionTable +
+	` (instance_id, bucket, method, outcome, reason, client_id, idp_id, count)` +
+	` SELECT $1, $2, $3, $4, $5, COALESCE(r.client_id, $6), COALESCE(r.idp_id, $7), 1` +
+	` FROM (SELECT $8::TEXT AS id) AS e LEFT JOIN ` + LoginAnalyticsRequestsTable + ` AS r ON r.instance_id = $1 AND r.id = e.id` +
+	` ON CONFLICT (instance_id, bucket, method, outcome, reason, client_id, idp_id)` +
+	` DO UPDATE SET count = ` + LoginAnalyticsProjectionTable + `.count + EXCLUDED.count`
+
+type loginAnalyticsProjection struct{}
+
+func newLoginAnalyticsProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(loginAnalyticsProjection))
+}
+
+func (*loginAnalyticsProjection) Name() string {
+	return LoginAnalyticsProjectionTable
+}
+
+func (*loginAnalyticsProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(LoginAnalyticsColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(LoginAnalyticsColumnBucket, handler.ColumnTypeTimestamp),
+			handler.NewColumn(LoginAnalyticsColumnMethod, handler.ColumnTypeText),
+			handler.NewColumn(LoginAnalyticsColumnOutcome, handler.ColumnTypeEnum),
+			handler.NewColumn(LoginAnalyticsColumnReason, handler.ColumnTypeText),
+			handler.NewColumn(LoginAnalyticsColumnClientID, handler.ColumnTypeText),
+			handler.NewColumn(LoginAnalyticsColumnIDPID, handler.ColumnTypeText),
+			handler.NewColumn(LoginAnalyticsColumnCount, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(
+				LoginAnalyticsColumnInstanceID,
+				LoginAnalyticsColumnBucket,
+				LoginAnalyticsColumnMethod,
+				LoginAnalyticsColumnOutcome,
+				LoginAnalyticsColumnReason,
+				LoginAnalyticsColumnClientID,
+				LoginAnalyticsColumnIDPID,
+			),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(LoginAnalyticsRequestColumnInstanceID, handler.ColumnTypeText),
+			handler.NewColumn(LoginAnalyticsRequestColumnID, handler.ColumnTypeText),
+			handler.NewColumn(LoginAnalyticsRequestColumnClientID, handler.ColumnTypeText),
+			handler.NewColumn(LoginAnalyticsRequestColumnIDPID, handler.ColumnTypeText),
+			handler.NewColumn(LoginAnalyticsRequestColumnCreationDate, handler.ColumnTypeTimestamp),
+		},
+			handler.NewPrimaryKey(LoginAnalyticsRequestColumnInstanceID, LoginAnalyticsRequestColumnID),
+			loginAnalyticsRequestsSuffix,
+			handler.WithIndex(handler.NewIndex("creation_date", []string{LoginAnalyticsRequestColumnInstanceID, LoginAnalyticsRequestColumnCreationDate})),
+		),
+	)
+}
+
+// loginAnalyticsUserChecks maps the check events of the user to the checked method.
+// Failed password checks are counted as wrong password, all other failed checks as failed MFA.
+var loginAnalyticsUserChecks = map[eventstore.EventType]struct {
+	method  domain.LoginMethod
+	outcome domain.LoginOutcome
+}{
+	user.HumanPasswordCheckSucceededType:          {domain.LoginMethodPassword, domain.LoginOutcomeSuccess},
+	user.HumanPasswordCheckFailedType:             {domain.LoginMethodPassword, domain.LoginOutcomeWrongPassword},
+	user.UserV1PasswordCheckSucceededType:         {domain.LoginMethodPassword, domain.LoginOutcomeSuccess},
+	user.UserV1PasswordCheckFailedType:            {domain.LoginMethodPassword, domain.LoginOutcomeWrongPassword},
+	user.HumanMFAOTPCheckSucceededType:            {domain.LoginMethodTOTP, domain.LoginOutcomeSuccess},
+	user.HumanMFAOTPCheckFailedType:               {domain.LoginMethodTOTP, domain.LoginOutcomeMFAFailed},
+	user.UserV1MFAOTPCheckSucceededType:           {domain.LoginMethodTOTP, domain.LoginOutcomeSuccess},
+	user.UserV1MFAOTPCheckFailedType:              {domain.LoginMethodTOTP, domain.LoginOutcomeMFAFailed},
+	user.HumanOTPSMSCheckSucceededType:            {domain.LoginMethodOTPSMS, domain.LoginOutcomeSuccess},
+	user.HumanOTPSMSCheckFailedType:               {domain.LoginMethodOTPSMS, domain.LoginOutcomeMFAFailed},
+	user.HumanOTPEmailCheckSucceededType:          {domain.LoginMethodOTPEmail, domain.LoginOutcomeSuccess},
+	user.HumanOTPEmailCheckFailedType:             {domain.LoginMethodOTPEmail, domain.LoginOutcomeMFAFailed},
+	user.HumanU2FTokenCheckSucceededType:          {domain.LoginMethodU2F, domain.LoginOutcomeSuccess},
+	user.HumanU2FTokenCheckFailedType:             {domain.LoginMethodU2F, domain.LoginOutcomeMFAFailed},
+	user.HumanPasswordlessTokenCheckSucceededType: {domain.LoginMethodPasswordless, domain.LoginOutcomeSuccess},
+	user.HumanPasswordlessTokenCheckFailedType:    {domain.LoginMethodPasswordless, domain.LoginOutcomeMFAFailed},
+	user.HumanRecoveryCodeCheckSucceededType:      {domain.LoginMethodRecoveryCode, domain.LoginOutcomeSuccess},
+	user.HumanRecoveryCodeCheckFailedType:         {domain.LoginMethodRecoveryCode, domain.LoginOutcomeMFAFailed},
+	user.HumanX509CheckSucceededType:              {domain.LoginMethodX509, domain.LoginOutcomeSuccess},
+	user.HumanX509CheckFailedType:                 {domain.LoginMethodX509, domain.LoginOutcomeMFAFailed},
+}
+
+func (p *loginAnalyticsProjection) Reducers() []handler.AggregateReducer {
+	userReducers := make([]handler.EventReducer, 0, len(loginAnalyticsUserChecks)+1)
+	for _, eventType := range slices.Sorted(maps.Keys(loginAnalyticsUserChecks)) {
+		userReducers = append(userReducers, handler.EventReducer{
+			Event:  eventType,
+			Reduce: p.reduceUserChecked,
+		})
+	}
+	userReducers = append(userReducers, handler.EventReducer{
+		Event:  user.UserLockedType,
+		Reduce: p.reduceUserLocked,
+	})
+	return []handler.AggregateReducer{
+		{
+			Aggregate:     user.AggregateType,
+			EventReducers: userReducers,
+		},
+		{
+			Aggregate: session.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  session.WebAuthNCheckedType,
+					Reduce: p.reduceSessionChecked(domain.LoginMethodWebAuthN, domain.LoginOutcomeSuccess),
+				},
+				{
+					Event:  session.PushCheckedType,
+					Reduce: p.reduceSessionChecked(domain.LoginMethodPush, domain.LoginOutcomeSuccess),
+				},
+				{
+					Event:  session.PushRejectedType,
+					Reduce: p.reduceSessionChecked(domain.LoginMethodPush, domain.LoginOutcomeMFAFailed),
+				},
+			},
+		},
+		{
+			Aggregate: idpintent.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  idpintent.StartedEventType,
+					Reduce: p.reduceIntentStarted,
+				},
+				{
+					Event:  idpintent.SucceededEventType,
+					Reduce: p.reduceIntentSucceeded,
+				},
+				{
+					Event:  idpintent.SAMLSucceededEventType,
+					Reduce: p.reduceIntentSucceeded,
+				},
+				{
+					Event:  idpintent.LDAPSucceededEventType,
+					Reduce: p.reduceIntentSucceeded,
+				},
+				{
+					Event:  idpintent.FailedEventType,
+					Reduce: p.reduceIntentFailed,
+				},
+			},
+		},
+		{
+			Aggregate: authrequest.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  authrequest.AddedType,
+					Reduce: p.reduceAuthRequestAdded,
+				},
+				{
+					Event:  authrequest.SucceededType,
+					Reduce: p.reduceAuthRequestSucceeded,
+				},
+				{
+					Event:  authrequest.FailedType,
+					Reduce: p.reduceAuthRequestFailed,
+				},
+			},
+		},
+		{
+			Aggregate: samlrequest.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  samlrequest.AddedType,
+					Reduce: p.reduceSAMLRequestAdded,
+				},
+				{
+					Event:  samlrequest.SucceededType,
+					Reduce: p.reduceSAMLRequestSucceeded,
+				},
+				{
+					Event:  samlrequest.FailedType,
+					Reduce: p.reduceSAMLRequestFailed,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: p.reduceInstanceRemoved,
+				},
+			},
+		},
+	}
+}
+
+// loginAnalyticsCheckPayload contains the IdP the user selected on the login UI (v1), if any.
+type loginAnalyticsCheckPayload struct {
+	SelectedIDPConfigID string `json:"selectedIDPConfigID"`
+}
+
+func (p *loginAnalyticsProjection) reduceUserChecked(event eventstore.Event) (*handler.Statement, error) {
+	check, ok := loginAnalyticsUserChecks[event.Type()]
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Lg4nC", "reduce.wrong.event.type %s", event.Type())
+	}
+	payload := new(loginAnalyticsCheckPayload)
+	if err := event.Unmarshal(payload); err != nil {
+		return nil, zerrors.ThrowInternal(err, "HANDL-Lg8pU", "unable to unmarshal event")
+	}
+	return handler.NewStatement(event, incrementLoginAnalytics(event, check.method, check.outcome, "", "", payload.SelectedIDPConfigID, "")), nil
+}
+
+func (p *loginAnalyticsProjection) reduceUserLocked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserLockedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Lg2kL", "reduce.wrong.event.type %s", user.UserLockedType)
+	}
+	return handler.NewStatement(e, incrementLoginAnalytics(e, domain.LoginMethodUnspecified, domain.LoginOutcomeLocked, "", "", "", "")), nil
+}
+
+func (p *loginAnalyticsProjection) reduceSessionChecked(method domain.LoginMethod, outcome domain.LoginOutcome) handler.Reduce {
+	return func(event eventstore.Event) (*handler.Statement, error) {
+		return handler.NewStatement(event, incrementLoginAnalytics(event, method, outcome, "", "", "", "")), nil
+	}
+}
+
+func (p *loginAnalyticsProjection) reduceIntentStarted(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*idpintent.StartedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Lg6sI", "reduce.wrong.event.type %s", idpintent.StartedEventType)
+	}
+	return addLoginAnalyticsRequest(e, "", e.IDPID), nil
+}
+
+func (p *loginAnalyticsProjection) reduceIntentSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *idpintent.SucceededEvent, *idpintent.SAMLSucceededEvent, *idpintent.LDAPSucceededEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Lg3sU", "reduce.wrong.event.type %v", []eventstore.EventType{idpintent.SucceededEventType, idpintent.SAMLSucceededEventType, idpintent.LDAPSucceededEventType})
+	}
+	return removeLoginAnalyticsRequest(event, domain.LoginMethodIDP, domain.LoginOutcomeSuccess, ""), nil
+}
+
+func (p *loginAnalyticsProjection) reduceIntentFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*idpintent.FailedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Lg7fI", "reduce.wrong.event.type %s", idpintent.FailedEventType)
+	}
+	reason := e.Reason
+	if len(reason) > loginAnalyticsReasonMaxLength {
+		reason = reason[:loginAnalyticsReasonMaxLength]
+	}
+	return removeLoginAnalyticsRequest(e, domain.LoginMethodIDP, domain.LoginOutcomeIDPError, reason), nil
+}
+
+func (p *loginAnalyticsProjection) reduceAuthRequestAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*authrequest.AddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Lg5aR", "reduce.wrong.event.type %s", authrequest.AddedType)
+	}
+	return addLoginAnalyticsRequest(e, e.ClientID, ""), nil
+}
+
+func (p *loginAnalyticsProjection) reduceAuthRequestSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*authrequest.SucceededEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Lg9aS", "reduce.wrong.event.type %s", authrequest.SucceededType)
+	}
+	return removeLoginAnalyticsRequest(e, domain.LoginMethodOIDC, domain.LoginOutcomeSuccess, ""), nil
+}
+
+func (p *loginAnalyticsProjection) reduceAuthRequestFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*authrequest.FailedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Lg1aF", "reduce.wrong.event.type %s", authrequest.FailedType)
+	}
+	return removeLoginAnalyticsRequest(e, domain.LoginMethodOIDC, domain.LoginOutcomeFailed, domain.OIDCErrorReasonToString(e.Reason)), nil
+}
+
+func (p *loginAnalyticsProjection) reduceSAMLRequestAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*samlrequest.AddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Lg4sR", "reduce.wrong.event.type %s", samlrequest.AddedType)
+	}
+	return addLoginAnalyticsRequest(e, e.ApplicationID, ""), nil
+}
+
+func (p *loginAnalyticsProjection) reduceSAMLRequestSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*samlrequest.SucceededEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Lg2sS", "reduce.wrong.event.type %s", samlrequest.SucceededType)
+	}
+	return removeLoginAnalyticsRequest(e, domain.LoginMethodSAML, domain.LoginOutcomeSuccess, ""), nil
+}
+
+func (p *loginAnalyticsProjection) reduceSAMLRequestFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*samlrequest.FailedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Lg8sF", "reduce.wrong.event.type %s", samlrequest.FailedType)
+	}
+	return removeLoginAnalyticsRequest(e, domain.LoginMethodSAML, domain.LoginOutcomeFailed, domain.SAMLErrorReasonToString(e.Reason)), nil
+}
+
+func (p *loginAnalyticsProjection) reduceInstanceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*instance.InstanceRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Lg6iR", "reduce.wrong.event.type %s", instance.InstanceRemovedEventType)
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(LoginAnalyticsColumnInstanceID, e.Aggregate().ID),
+			},
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(LoginAnalyticsRequestColumnInstanceID, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(loginAnalyticsRequestsSuffix),
+		),
+	), nil
+}
+
+// addLoginAnalyticsRequest stores the pending request
+// and removes the requests of the instance, which didn't have an outcome within the lifetime.
+func addLoginAnalyticsRequest(event eventstore.Event, clientID, idpID string) *handler.Statement {
+	return handler.NewMultiStatement(
+		event,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(LoginAnalyticsRequestColumnInstanceID, event.Aggregate().InstanceID),
+				handler.NewCol(LoginAnalyticsRequestColumnID, event.Aggregate().ID),
+				handler.NewCol(LoginAnalyticsRequestColumnClientID, clientID),
+				handler.NewCol(LoginAnalyticsRequestColumnIDPID, idpID),
+				handler.NewCol(LoginAnalyticsRequestColumnCreationDate, event.CreatedAt()),
+			},
+			handler.WithTableSuffix(loginAnalyticsRequestsSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(LoginAnalyticsRequestColumnInstanceID, event.Aggregate().InstanceID),
+				handler.NewLessThanCond(LoginAnalyticsRequestColumnCreationDate, event.CreatedAt().Add(-LoginAnalyticsRequestLifetime)),
+			},
+			handler.WithTableSuffix(loginAnalyticsRequestsSuffix),
+		),
+	)
+}
+
+// removeLoginAnalyticsRequest counts the outcome of the request and removes the request, as it's done.
+func removeLoginAnalyticsRequest(event eventstore.Event, method domain.LoginMethod, outcome domain.LoginOutcome, reason string) *handler.Statement {
+	return handler.NewMultiStatement(
+		event,
+		func(event eventstore.Event) handler.Exec {
+			return incrementLoginAnalytics(event, method, outcome, reason, "", "", event.Aggregate().ID)
+		},
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(LoginAnalyticsRequestColumnInstanceID, event.Aggregate().InstanceID),
+				handler.NewCond(LoginAnalyticsRequestColumnID, event.Aggregate().ID),
+			},
+			handler.WithTableSuffix(loginAnalyticsRequestsSuffix),
+		),
+	)
+}
+
+func incrementLoginAnalytics(event eventstore.Event, method domain.LoginMethod, outcome domain.LoginOutcome, reason, clientID, idpID, requestID string) handler.Exec {
+	return func(ctx context.Context, ex handler.Executer, _ string) error {
+		_, err := ex.Exec(incrementLoginAnalyticsStatement,
+			event.Aggregate().InstanceID,
+			event.CreatedAt().UTC().Truncate(LoginAnalyticsBucketSize),
+			method,
+			outcome,
+			reason,
+			clientID,
+			idpID,
+			requestID,
+		)
+		if err != nil {
+			return zerrors.ThrowInternal(err, "HANDL-Lg5cI", "unable to count login outcome")
+		}
+		return nil
+	}
+}
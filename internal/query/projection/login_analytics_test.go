@@ -0,0 +1,300 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/repository/idpintent"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestLoginAnalyticsProjection_reduces(t *testing.T) {
+	creationDate := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	bucket := time.Date(2024, 5, 6, 7, 0, 0, 0, time.UTC)
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceUserChecked password failed",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						user.HumanPasswordCheckFailedType,
+						user.AggregateType,
+						[]byte(`{"selectedIDPConfigID": "idp-id"}`),
+						creationDate,
+					), user.HumanPasswordCheckFailedEventMapper),
+			},
+			reduce: (&loginAnalyticsProjection{}).reduceUserChecked,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: incrementLoginAnalyticsStatement,
+							expectedArgs: []interface{}{
+								"instance-id",
+								bucket,
+								domain.LoginMethodPassword,
+								domain.LoginOutcomeWrongPassword,
+								"",
+								"",
+								"idp-id",
+								"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserLocked",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						user.UserLockedType,
+						user.AggregateType,
+						nil,
+						creationDate,
+					), user.UserLockedEventMapper),
+			},
+			reduce: (&loginAnalyticsProjection{}).reduceUserLocked,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: incrementLoginAnalyticsStatement,
+							expectedArgs: []interface{}{
+								"instance-id",
+								bucket,
+								domain.LoginMethodUnspecified,
+								domain.LoginOutcomeLocked,
+								"",
+								"",
+								"",
+								"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceIntentStarted",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						idpintent.StartedEventType,
+						idpintent.AggregateType,
+						[]byte(`{"idpId": "idp-id"}`),
+						creationDate,
+					), idpintent.StartedEventMapper),
+			},
+			reduce: (&loginAnalyticsProjection{}).reduceIntentStarted,
+			want: wantReduce{
+				aggregateType: idpintent.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.login_analytics_requests (instance_id, id, client_id, idp_id, creation_date) VALUES ($1, $2, $3, $4, $5)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"",
+								"idp-id",
+								creationDate,
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.login_analytics_requests WHERE (instance_id = $1) AND (creation_date < $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								creationDate.Add(-LoginAnalyticsRequestLifetime),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceIntentFailed",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						idpintent.FailedEventType,
+						idpintent.AggregateType,
+						[]byte(`{"reason": "user canceled"}`),
+						creationDate,
+					), idpintent.FailedEventMapper),
+			},
+			reduce: (&loginAnalyticsProjection{}).reduceIntentFailed,
+			want: wantReduce{
+				aggregateType: idpintent.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: incrementLoginAnalyticsStatement,
+							expectedArgs: []interface{}{
+								"instance-id",
+								bucket,
+								domain.LoginMethodIDP,
+								domain.LoginOutcomeIDPError,
+								"user canceled",
+								"",
+								"",
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.login_analytics_requests WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceAuthRequestAdded",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						authrequest.AddedType,
+						authrequest.AggregateType,
+						[]byte(`{"client_id": "client-id"}`),
+						creationDate,
+					), authrequest.AddedEventMapper),
+			},
+			reduce: (&loginAnalyticsProjection{}).reduceAuthRequestAdded,
+			want: wantReduce{
+				aggregateType: authrequest.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.login_analytics_requests (instance_id, id, client_id, idp_id, creation_date) VALUES ($1, $2, $3, $4, $5)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"client-id",
+								"",
+								creationDate,
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.login_analytics_requests WHERE (instance_id = $1) AND (creation_date < $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								creationDate.Add(-LoginAnalyticsRequestLifetime),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceAuthRequestFailed",
+			args: args{
+				event: getEvent(
+					timedTestEvent(
+						authrequest.FailedType,
+						authrequest.AggregateType,
+						[]byte(`{"reason": 3}`),
+						creationDate,
+					), authrequest.FailedEventMapper),
+			},
+			reduce: (&loginAnalyticsProjection{}).reduceAuthRequestFailed,
+			want: wantReduce{
+				aggregateType: authrequest.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: incrementLoginAnalyticsStatement,
+							expectedArgs: []interface{}{
+								"instance-id",
+								bucket,
+								domain.LoginMethodOIDC,
+								domain.LoginOutcomeFailed,
+								"access_denied",
+								"",
+								"",
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.login_analytics_requests WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					), instance.InstanceRemovedEventMapper),
+			},
+			reduce: (&loginAnalyticsProjection{}).reduceInstanceRemoved,
+			want: wantReduce{
+				aggregateType: instance.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.login_analytics WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.login_analytics_requests WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, LoginAnalyticsProjectionTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,395 @@
+package projection
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/quota"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// The org quota tables use the same columns as the quota tables, extended by the organization.
+// They are kept separate, because the usage in the periods isn't event sourced and can't be projected again.
+const (
+	OrgQuotasProjectionTable       = "projections.org_quotas"
+	OrgQuotaPeriodsProjectionTable = OrgQuotasProjectionTable + "_" + quotaPeriodsTableSuffix
+	OrgQuotaNotificationsTable     = OrgQuotasProjectionTable + "_" + quotaNotificationsTableSuffix
+	OrgQuotaActiveUsersTable       = OrgQuotasProjectionTable + "_" + quotaActiveUsersTableSuffix
+
+	OrgQuotaColumnOrgID             = "org_id"
+	OrgQuotaPeriodColumnOrgID       = "org_id"
+	OrgQuotaNotificationColumnOrgID = "org_id"
+	OrgQuotaActiveUserColumnOrgID   = "org_id"
+)
+
+const (
+	incrementOrgQuotaStatement = `INSERT INTO projections.org_quotas_periods` +
+		` (instance_id, org_id, unit, start, usage)` +
+		` VALUES ($1, $2, $3, $4, $5) ON CONFLICT (instance_id, org_id, unit, start)` +
+		` DO UPDATE SET usage = projections.org_quotas_periods.usage + excluded.usage RETURNING usage`
+	// incrementOrgActiveUsersStatement only counts the users which were not yet active in the period.
+	// The users of past periods are removed, as their usage is already counted.
+	incrementOrgActiveUsersStatement = `WITH pruned AS (` +
+		`DELETE FROM projections.org_quotas_active_users WHERE instance_id = $1 AND org_id = $2 AND start < $3)` +
+		`, inserted AS (` +
+		`INSERT INTO projections.org_quotas_active_users (instance_id, org_id, start, user_id)` +
+		` SELECT $1, $2, $3, user_id FROM unnest($4::TEXT[]) AS user_id` +
+		` ON CONFLICT (instance_id, org_id, start, user_id) DO NOTHING RETURNING 1)` +
+		` INSERT INTO projections.org_quotas_periods (instance_id, org_id, unit, start, usage)` +
+		` SELECT $1, $2, $5, $3, count(*) FROM inserted ON CONFLICT (instance_id, org_id, unit, start)` +
+		` DO UPDATE SET usage = projections.org_quotas_periods.usage + excluded.usage RETURNING usage`
+)
+
+type orgQuotaProjection struct {
+	handler *handler.Handler
+	client  *database.DB
+}
+
+func newOrgQuotaProjection(ctx context.Context, config handler.Config) *orgQuotaProjection {
+	p := &orgQuotaProjection{
+		client: config.Client,
+	}
+	p.handler = handler.NewHandler(ctx, &config, p)
+	return p
+}
+
+func (*orgQuotaProjection) Name() string {
+	return OrgQuotasProjectionTable
+}
+
+func (*orgQuotaProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable(
+			[]*handler.InitColumn{
+				handler.NewColumn(QuotaColumnID, handler.ColumnTypeText),
+				handler.NewColumn(QuotaColumnInstanceID, handler.ColumnTypeText),
+				handler.NewColumn(OrgQuotaColumnOrgID, handler.ColumnTypeText),
+				handler.NewColumn(QuotaColumnUnit, handler.ColumnTypeEnum),
+				handler.NewColumn(QuotaColumnAmount, handler.ColumnTypeInt64, handler.Nullable()),
+				handler.NewColumn(QuotaColumnFrom, handler.ColumnTypeTimestamp, handler.Nullable()),
+				handler.NewColumn(QuotaColumnInterval, handler.ColumnTypeInterval, handler.Nullable()),
+				handler.NewColumn(QuotaColumnLimit, handler.ColumnTypeBool, handler.Nullable()),
+			},
+			handler.NewPrimaryKey(QuotaColumnInstanceID, OrgQuotaColumnOrgID, QuotaColumnUnit),
+		),
+		handler.NewSuffixedTable(
+			[]*handler.InitColumn{
+				handler.NewColumn(QuotaPeriodColumnInstanceID, handler.ColumnTypeText),
+				handler.NewColumn(OrgQuotaPeriodColumnOrgID, handler.ColumnTypeText),
+				handler.NewColumn(QuotaPeriodColumnUnit, handler.ColumnTypeEnum),
+				handler.NewColumn(QuotaPeriodColumnStart, handler.ColumnTypeTimestamp),
+				handler.NewColumn(QuotaPeriodColumnUsage, handler.ColumnTypeInt64),
+			},
+			handler.NewPrimaryKey(QuotaPeriodColumnInstanceID, OrgQuotaPeriodColumnOrgID, QuotaPeriodColumnUnit, QuotaPeriodColumnStart),
+			quotaPeriodsTableSuffix,
+		),
+		handler.NewSuffixedTable(
+			[]*handler.InitColumn{
+				handler.NewColumn(QuotaNotificationColumnInstanceID, handler.ColumnTypeText),
+				handler.NewColumn(OrgQuotaNotificationColumnOrgID, handler.ColumnTypeText),
+				handler.NewColumn(QuotaNotificationColumnUnit, handler.ColumnTypeEnum),
+				handler.NewColumn(QuotaNotificationColumnID, handler.ColumnTypeText),
+				handler.NewColumn(QuotaNotificationColumnCallURL, handler.ColumnTypeText),
+				handler.NewColumn(QuotaNotificationColumnPercent, handler.ColumnTypeInt64),
+				handler.NewColumn(QuotaNotificationColumnRepeat, handler.ColumnTypeBool),
+				handler.NewColumn(QuotaNotificationColumnLatestDuePeriodStart, handler.ColumnTypeTimestamp, handler.Nullable()),
+				handler.NewColumn(QuotaNotificationColumnNextDueThreshold, handler.ColumnTypeInt64, handler.Nullable()),
+			},
+			handler.NewPrimaryKey(QuotaNotificationColumnInstanceID, OrgQuotaNotificationColumnOrgID, QuotaNotificationColumnUnit, QuotaNotificationColumnID),
+			quotaNotificationsTableSuffix,
+		),
+		handler.NewSuffixedTable(
+			[]*handler.InitColumn{
+				handler.NewColumn(QuotaActiveUserColumnInstanceID, handler.ColumnTypeText),
+				handler.NewColumn(OrgQuotaActiveUserColumnOrgID, handler.ColumnTypeText),
+				handler.NewColumn(QuotaActiveUserColumnStart, handler.ColumnTypeTimestamp),
+				handler.NewColumn(QuotaActiveUserColumnUserID, handler.ColumnTypeText),
+			},
+			handler.NewPrimaryKey(QuotaActiveUserColumnInstanceID, OrgQuotaActiveUserColumnOrgID, QuotaActiveUserColumnStart, QuotaActiveUserColumnUserID),
+			quotaActiveUsersTableSuffix,
+		),
+	)
+}
+
+func (q *orgQuotaProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: q.reduceInstanceRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: q.reduceOrgRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: quota.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  quota.SetEventType,
+					Reduce: q.reduceQuotaSet,
+				},
+				{
+					Event:  quota.RemovedEventType,
+					Reduce: q.reduceQuotaRemoved,
+				},
+				{
+					Event:  quota.NotificationDueEventType,
+					Reduce: q.reduceQuotaNotificationDue,
+				},
+			},
+		},
+	}
+}
+
+func (q *orgQuotaProjection) reduceQuotaSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*quota.SetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	// quotas of instances are projected by the quota projection
+	if !quota.IsOrgAggregate(e.Aggregate()) {
+		return handler.NewNoOpStatement(e), nil
+	}
+	var statements []func(e eventstore.Event) handler.Exec
+
+	// 1. Insert or update quota if the event has not only notification changes
+	quotaConflictColumns := []handler.Column{
+		handler.NewCol(QuotaColumnInstanceID, e.Aggregate().InstanceID),
+		handler.NewCol(OrgQuotaColumnOrgID, e.Aggregate().ResourceOwner),
+		handler.NewCol(QuotaColumnUnit, e.Unit),
+	}
+	quotaUpdateCols := make([]handler.Column, 0, 4+1+len(quotaConflictColumns))
+	if e.Limit != nil {
+		quotaUpdateCols = append(quotaUpdateCols, handler.NewCol(QuotaColumnLimit, *e.Limit))
+	}
+	if e.Amount != nil {
+		quotaUpdateCols = append(quotaUpdateCols, handler.NewCol(QuotaColumnAmount, *e.Amount))
+	}
+	if e.From != nil {
+		quotaUpdateCols = append(quotaUpdateCols, handler.NewCol(QuotaColumnFrom, *e.From))
+	}
+	if e.ResetInterval != nil {
+		quotaUpdateCols = append(quotaUpdateCols, handler.NewCol(QuotaColumnInterval, *e.ResetInterval))
+	}
+	if len(quotaUpdateCols) > 0 {
+		quotaUpdateCols = append(quotaUpdateCols, handler.NewCol(QuotaColumnID, e.Aggregate().ID))
+		quotaUpdateCols = append(quotaUpdateCols, quotaConflictColumns...)
+		statements = append(statements, handler.AddUpsertStatement(quotaConflictColumns, quotaUpdateCols))
+	}
+
+	// 2. Replace existing notifications
+	if e.Notifications == nil {
+		return handler.NewMultiStatement(e, statements...), nil
+	}
+	statements = append(statements, handler.AddDeleteStatement(
+		[]handler.Condition{
+			handler.NewCond(QuotaNotificationColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(OrgQuotaNotificationColumnOrgID, e.Aggregate().ResourceOwner),
+			handler.NewCond(QuotaNotificationColumnUnit, e.Unit),
+		},
+		handler.WithTableSuffix(quotaNotificationsTableSuffix),
+	))
+	for _, notification := range *e.Notifications {
+		statements = append(statements, handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(QuotaNotificationColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCol(OrgQuotaNotificationColumnOrgID, e.Aggregate().ResourceOwner),
+				handler.NewCol(QuotaNotificationColumnUnit, e.Unit),
+				handler.NewCol(QuotaNotificationColumnID, notification.ID),
+				handler.NewCol(QuotaNotificationColumnCallURL, notification.CallURL),
+				handler.NewCol(QuotaNotificationColumnPercent, notification.Percent),
+				handler.NewCol(QuotaNotificationColumnRepeat, notification.Repeat),
+			},
+			handler.WithTableSuffix(quotaNotificationsTableSuffix),
+		))
+	}
+	return handler.NewMultiStatement(e, statements...), nil
+}
+
+func (q *orgQuotaProjection) reduceQuotaNotificationDue(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*quota.NotificationDueEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	if !quota.IsOrgAggregate(e.Aggregate()) {
+		return handler.NewNoOpStatement(e), nil
+	}
+	return handler.NewUpdateStatement(e,
+		[]handler.Column{
+			handler.NewCol(QuotaNotificationColumnLatestDuePeriodStart, e.PeriodStart),
+			handler.NewCol(QuotaNotificationColumnNextDueThreshold, e.Threshold+100),
+		},
+		[]handler.Condition{
+			handler.NewCond(QuotaNotificationColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(OrgQuotaNotificationColumnOrgID, e.Aggregate().ResourceOwner),
+			handler.NewCond(QuotaNotificationColumnUnit, e.Unit),
+			handler.NewCond(QuotaNotificationColumnID, e.ID),
+		},
+		handler.WithTableSuffix(quotaNotificationsTableSuffix),
+	), nil
+}
+
+func (q *orgQuotaProjection) reduceQuotaRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*quota.RemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	if !quota.IsOrgAggregate(e.Aggregate()) {
+		return handler.NewNoOpStatement(e), nil
+	}
+	statements := []func(e eventstore.Event) handler.Exec{
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(QuotaPeriodColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(OrgQuotaPeriodColumnOrgID, e.Aggregate().ResourceOwner),
+				handler.NewCond(QuotaPeriodColumnUnit, e.Unit),
+			},
+			handler.WithTableSuffix(quotaPeriodsTableSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(QuotaNotificationColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(OrgQuotaNotificationColumnOrgID, e.Aggregate().ResourceOwner),
+				handler.NewCond(QuotaNotificationColumnUnit, e.Unit),
+			},
+			handler.WithTableSuffix(quotaNotificationsTableSuffix),
+		),
+	}
+	if e.Unit == quota.UsersAllActive {
+		statements = append(statements, handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(QuotaActiveUserColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(OrgQuotaActiveUserColumnOrgID, e.Aggregate().ResourceOwner),
+			},
+			handler.WithTableSuffix(quotaActiveUsersTableSuffix),
+		))
+	}
+	statements = append(statements, handler.AddDeleteStatement(
+		[]handler.Condition{
+			handler.NewCond(QuotaColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(OrgQuotaColumnOrgID, e.Aggregate().ResourceOwner),
+			handler.NewCond(QuotaColumnUnit, e.Unit),
+		},
+	))
+	return handler.NewMultiStatement(e, statements...), nil
+}
+
+func (q *orgQuotaProjection) reduceOrgRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(QuotaPeriodColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(OrgQuotaPeriodColumnOrgID, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(quotaPeriodsTableSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(QuotaNotificationColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(OrgQuotaNotificationColumnOrgID, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(quotaNotificationsTableSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(QuotaActiveUserColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(OrgQuotaActiveUserColumnOrgID, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(quotaActiveUsersTableSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(QuotaColumnInstanceID, e.Aggregate().InstanceID),
+				handler.NewCond(OrgQuotaColumnOrgID, e.Aggregate().ID),
+			},
+		),
+	), nil
+}
+
+func (q *orgQuotaProjection) reduceInstanceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.InstanceRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(QuotaPeriodColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(quotaPeriodsTableSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(QuotaNotificationColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(quotaNotificationsTableSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(QuotaActiveUserColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(quotaActiveUsersTableSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(QuotaColumnInstanceID, e.Aggregate().InstanceID),
+			},
+		),
+	), nil
+}
+
+func (q *orgQuotaProjection) IncrementUsage(ctx context.Context, unit quota.Unit, instanceID, orgID string, periodStart time.Time, count uint64) (sum uint64, err error) {
+	if count == 0 {
+		return 0, nil
+	}
+
+	err = q.client.DB.QueryRowContext(
+		ctx,
+		incrementOrgQuotaStatement,
+		instanceID, orgID, unit, periodStart, count,
+	).Scan(&sum)
+	if err != nil {
+		return 0, zerrors.ThrowInternalf(err, "PROJ-Zq4sG", "incrementing organization usage for unit %d failed", unit)
+	}
+	return sum, err
+}
+
+// IncrementActiveUsers records the users as active in the period of the organization
+// and returns the number of distinct active users in the period.
+func (q *orgQuotaProjection) IncrementActiveUsers(ctx context.Context, instanceID, orgID string, periodStart time.Time, userIDs []string) (sum uint64, err error) {
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+
+	err = q.client.DB.QueryRowContext(
+		ctx,
+		incrementOrgActiveUsersStatement,
+		instanceID, orgID, periodStart, database.TextArray[string](userIDs), quota.UsersAllActive,
+	).Scan(&sum)
+	if err != nil {
+		return 0, zerrors.ThrowInternal(err, "PROJ-Nc8hW", "incrementing organization active users failed")
+	}
+	return sum, err
+}
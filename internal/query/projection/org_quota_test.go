@@ -0,0 +1,353 @@
+package projection
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/database"
+	db_mock "github.com/zitadel/zitadel/internal/database/mock"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/quota"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestOrgQuotasProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceQuotaSet instance quota",
+			args: args{
+				event: getEvent(testEvent(
+					quota.SetEventType,
+					quota.AggregateType,
+					[]byte(`{
+							"unit": 1,
+							"amount": 10,
+							"limit": true,
+							"from": "2023-01-01T00:00:00Z",
+							"interval": 300000000000
+					}`),
+					withResourceOwner("instance-id"),
+				), quota.SetEventMapper),
+			},
+			reduce: (&orgQuotaProjection{}).reduceQuotaSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("quota"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{},
+				},
+			},
+		},
+		{
+			name: "reduceQuotaSet with notification",
+			args: args{
+				event: getEvent(testEvent(
+					quota.SetEventType,
+					quota.AggregateType,
+					[]byte(`{
+							"unit": 6,
+							"amount": 10,
+							"limit": true,
+							"notifications": [
+								{
+									"id": "id",
+									"percent": 80,
+									"repeat": false
+								}
+							]
+					}`),
+				), quota.SetEventMapper),
+			},
+			reduce: (&orgQuotaProjection{}).reduceQuotaSet,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("quota"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.org_quotas (limit_usage, amount, id, instance_id, org_id, unit) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (instance_id, org_id, unit) DO UPDATE SET (limit_usage, amount, id) = (EXCLUDED.limit_usage, EXCLUDED.amount, EXCLUDED.id)",
+							expectedArgs: []interface{}{
+								true,
+								uint64(10),
+								"agg-id",
+								"instance-id",
+								"ro-id",
+								quota.UsersAll,
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.org_quotas_notifications WHERE (instance_id = $1) AND (org_id = $2) AND (unit = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								quota.UsersAll,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.org_quotas_notifications (instance_id, org_id, unit, id, call_url, percent, repeat) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								quota.UsersAll,
+								"id",
+								"",
+								uint16(80),
+								false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceQuotaNotificationDue",
+			args: args{
+				event: getEvent(testEvent(
+					quota.NotificationDueEventType,
+					quota.AggregateType,
+					[]byte(`{
+							"id": "id",
+							"unit": 1,
+							"periodStart": "2023-01-01T00:00:00Z",
+							"threshold": 200,
+							"usage": 100
+					}`),
+				), quota.NotificationDueEventMapper),
+			},
+			reduce: (&orgQuotaProjection{}).reduceQuotaNotificationDue,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("quota"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.org_quotas_notifications SET (latest_due_period_start, next_due_threshold) = ($1, $2) WHERE (instance_id = $3) AND (org_id = $4) AND (unit = $5) AND (id = $6)",
+							expectedArgs: []interface{}{
+								time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+								uint16(300),
+								"instance-id",
+								"ro-id",
+								quota.RequestsAllAuthenticated,
+								"id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceQuotaRemoved users all active",
+			args: args{
+				event: getEvent(testEvent(
+					quota.RemovedEventType,
+					quota.AggregateType,
+					[]byte(`{
+							"unit": 3
+					}`),
+				), quota.RemovedEventMapper),
+			},
+			reduce: (&orgQuotaProjection{}).reduceQuotaRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("quota"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.org_quotas_periods WHERE (instance_id = $1) AND (org_id = $2) AND (unit = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								quota.UsersAllActive,
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.org_quotas_notifications WHERE (instance_id = $1) AND (org_id = $2) AND (unit = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								quota.UsersAllActive,
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.org_quotas_active_users WHERE (instance_id = $1) AND (org_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.org_quotas WHERE (instance_id = $1) AND (org_id = $2) AND (unit = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"ro-id",
+								quota.UsersAllActive,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOrgRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					org.OrgRemovedEventType,
+					org.AggregateType,
+					nil,
+				), org.OrgRemovedEventMapper),
+			},
+			reduce: (&orgQuotaProjection{}).reduceOrgRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.org_quotas_periods WHERE (instance_id = $1) AND (org_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.org_quotas_notifications WHERE (instance_id = $1) AND (org_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.org_quotas_active_users WHERE (instance_id = $1) AND (org_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.org_quotas WHERE (instance_id = $1) AND (org_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(testEvent(
+					instance.InstanceRemovedEventType,
+					instance.AggregateType,
+					[]byte(`{
+							"name": "name"
+					}`),
+				), instance.InstanceRemovedEventMapper),
+			},
+			reduce: (&orgQuotaProjection{}).reduceInstanceRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.org_quotas_periods WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.org_quotas_notifications WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.org_quotas_active_users WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.org_quotas WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, OrgQuotasProjectionTable, tt.want)
+		})
+	}
+}
+
+func Test_orgQuotaProjection_IncrementUsage(t *testing.T) {
+	testNow := time.Now()
+	db, mock, _ := sqlmock.New(sqlmock.ValueConverterOption(new(db_mock.TypeConverter)))
+	mock.ExpectQuery(regexp.QuoteMeta(incrementOrgQuotaStatement)).
+		WithArgs(
+			"instance_id",
+			"org_id",
+			quota.Unit(1),
+			testNow,
+			uint64(2),
+		).
+		WillReturnRows(mock.NewRows([]string{"usage"}).
+			AddRow(3))
+	q := &orgQuotaProjection{
+		client: &database.DB{DB: db},
+	}
+	gotSum, err := q.IncrementUsage(context.Background(), quota.RequestsAllAuthenticated, "instance_id", "org_id", testNow, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), gotSum)
+}
+
+func Test_orgQuotaProjection_IncrementActiveUsers(t *testing.T) {
+	testNow := time.Now()
+	db, mock, _ := sqlmock.New(sqlmock.ValueConverterOption(new(db_mock.TypeConverter)))
+	mock.ExpectQuery(regexp.QuoteMeta(incrementOrgActiveUsersStatement)).
+		WithArgs(
+			"instance_id",
+			"org_id",
+			testNow,
+			database.TextArray[string]{"user1", "user2"},
+			quota.UsersAllActive,
+		).
+		WillReturnRows(mock.NewRows([]string{"usage"}).
+			AddRow(5))
+	q := &orgQuotaProjection{
+		client: &database.DB{DB: db},
+	}
+	gotSum, err := q.IncrementActiveUsers(context.Background(), "instance_id", "org_id", testNow, []string{"user1", "user2"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), gotSum)
+}
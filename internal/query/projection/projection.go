@@ -77,6 +77,7 @@ var (
 	SamlRequestProjection               *handler.Handler
 	MilestoneProjection                 *handler.Handler
 	QuotaProjection                     *quotaProjection
+	OrgQuotaProjection                  *orgQuotaProjection
 	LimitsProjection                    *handler.Handler
 	RestrictionsProjection              *handler.Handler
 	SystemFeatureProjection             *handler.Handler
@@ -99,15 +100,26 @@ var (
 	OrganizationMetadataRelationalProjection *handler.Handler
 	ProjectGrantRelationalProjection         *handler.Handler
 	SettingsRelationalProjection             *handler.Handler
+	UserRelationalProjection                 *handler.Handler
+	SessionRelationalProjection              *handler.Handler
+	AuthorizationRelationalProjection        *handler.Handler
 
 	ProjectGrantFields      *handler.FieldHandler
 	OrgDomainVerifiedFields *handler.FieldHandler
 	InstanceDomainFields    *handler.FieldHandler
 	MembershipFields        *handler.FieldHandler
 	PermissionFields        *handler.FieldHandler
+	GroupUserFields         *handler.FieldHandler
+	SessionRiskFields       *handler.FieldHandler
 
 	GroupProjection      *handler.Handler
 	GroupUsersProjection *handler.Handler
+	GroupGrantProjection *handler.Handler
+
+	SCIMProvisioningProjection *handler.Handler
+	LDAPSyncProjection         *handler.Handler
+	SAMLFederationProjection   *handler.Handler
+	LoginAnalyticsProjection   *handler.Handler
 )
 
 type projection interface {
@@ -194,6 +206,7 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	SamlRequestProjection = newSamlRequestProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["saml_requests"]))
 	MilestoneProjection = newMilestoneProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["milestones"]))
 	QuotaProjection = newQuotaProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["quotas"]))
+	OrgQuotaProjection = newOrgQuotaProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["org_quotas"]))
 	LimitsProjection = newLimitsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["limits"]))
 	RestrictionsProjection = newRestrictionsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["restrictions"]))
 	SystemFeatureProjection = newSystemFeatureProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["system_features"]))
@@ -211,10 +224,17 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	InstanceDomainFields = newFillInstanceDomainFields(applyCustomConfig(projectionConfig, config.Customizations[fieldsInstanceDomain]))
 	MembershipFields = newFillMembershipFields(applyCustomConfig(projectionConfig, config.Customizations[fieldsMemberships]))
 	PermissionFields = newFillPermissionFields(applyCustomConfig(projectionConfig, config.Customizations[fieldsPermission]))
+	GroupUserFields = newFillGroupUserFields(applyCustomConfig(projectionConfig, config.Customizations[fieldsGroupUsers]))
+	SessionRiskFields = newFillSessionRiskFields(applyCustomConfig(projectionConfig, config.Customizations[fieldsSessionRisk]))
 	// Don't forget to add the new field handler to [ProjectInstanceFields]
 
 	GroupProjection = newGroupProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["groups"]))
 	GroupUsersProjection = newGroupUsersProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["group_users"]))
+	GroupGrantProjection = newGroupGrantProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["group_grants"]))
+	SCIMProvisioningProjection = newSCIMProvisioningProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["scim_provisioning"]))
+	LDAPSyncProjection = newLDAPSyncProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["ldap_syncs"]))
+	SAMLFederationProjection = newSAMLFederationProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["saml_federations"]))
+	LoginAnalyticsProjection = newLoginAnalyticsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["login_analytics"]))
 
 	InstanceRelationalProjection = newInstanceRelationalProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["instances_relational"]))
 	OrganizationRelationalProjection = newOrgRelationalProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["organizations_relational"]))
@@ -226,6 +246,9 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	ProjectRoleRelationalProjection = newProjectRoleRelationalProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_roles_relational"]))
 	OrganizationMetadataRelationalProjection = newOrgMetadataRelationalProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["organization_metadata_relational"]))
 	ProjectGrantRelationalProjection = newProjectGrantRelationalProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["project_grant_relational"]))
+	UserRelationalProjection = newUserRelationalProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["users_relational"]))
+	SessionRelationalProjection = newSessionRelationalProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["sessions_relational"]))
+	AuthorizationRelationalProjection = newAuthorizationRelationalProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["authorizations_relational"]))
 
 	newProjectionsList()
 	newFieldsList()
@@ -335,6 +358,8 @@ func newFieldsList() {
 		InstanceDomainFields,
 		MembershipFields,
 		PermissionFields,
+		GroupUserFields,
+		SessionRiskFields,
 	}
 }
 
@@ -397,6 +422,7 @@ func newProjectionsList() {
 		SamlRequestProjection,
 		MilestoneProjection,
 		QuotaProjection.handler,
+		OrgQuotaProjection.handler,
 		LimitsProjection,
 		RestrictionsProjection,
 		SystemFeatureProjection,
@@ -410,6 +436,11 @@ func newProjectionsList() {
 		OrganizationSettingsProjection,
 		GroupProjection,
 		GroupUsersProjection,
+		GroupGrantProjection,
+		SCIMProvisioningProjection,
+		LDAPSyncProjection,
+		SAMLFederationProjection,
+		LoginAnalyticsProjection,
 
 		InstanceRelationalProjection,
 		OrganizationRelationalProjection,
@@ -421,5 +452,8 @@ func newProjectionsList() {
 		ProjectRoleRelationalProjection,
 		OrganizationMetadataRelationalProjection,
 		ProjectGrantRelationalProjection,
+		UserRelationalProjection,
+		SessionRelationalProjection,
+		AuthorizationRelationalProjection,
 	}
 }
@@ -17,6 +17,7 @@ const (
 	QuotasProjectionTable       = "projections.quotas"
 	QuotaPeriodsProjectionTable = QuotasProjectionTable + "_" + quotaPeriodsTableSuffix
 	QuotaNotificationsTable     = QuotasProjectionTable + "_" + quotaNotificationsTableSuffix
+	QuotaActiveUsersTable       = QuotasProjectionTable + "_" + quotaActiveUsersTableSuffix
 
 	QuotaColumnID         = "id"
 	QuotaColumnInstanceID = "instance_id"
@@ -41,6 +42,11 @@ const (
 	QuotaNotificationColumnRepeat               = "repeat"
 	QuotaNotificationColumnLatestDuePeriodStart = "latest_due_period_start"
 	QuotaNotificationColumnNextDueThreshold     = "next_due_threshold"
+
+	quotaActiveUsersTableSuffix     = "active_users"
+	QuotaActiveUserColumnInstanceID = "instance_id"
+	QuotaActiveUserColumnStart      = "start"
+	QuotaActiveUserColumnUserID     = "user_id"
 )
 
 const (
@@ -48,6 +54,17 @@ const (
 		` (instance_id, unit, start, usage)` +
 		` VALUES ($1, $2, $3, $4) ON CONFLICT (instance_id, unit, start)` +
 		` DO UPDATE SET usage = projections.quotas_periods.usage + excluded.usage RETURNING usage`
+	// incrementActiveUsersStatement only counts the users which were not yet active in the period.
+	// The users of past periods are removed, as their usage is already counted.
+	incrementActiveUsersStatement = `WITH pruned AS (` +
+		`DELETE FROM projections.quotas_active_users WHERE instance_id = $1 AND start < $2)` +
+		`, inserted AS (` +
+		`INSERT INTO projections.quotas_active_users (instance_id, start, user_id)` +
+		` SELECT $1, $2, user_id FROM unnest($3::TEXT[]) AS user_id` +
+		` ON CONFLICT (instance_id, start, user_id) DO NOTHING RETURNING 1)` +
+		` INSERT INTO projections.quotas_periods (instance_id, unit, start, usage)` +
+		` SELECT $1, $4, $2, count(*) FROM inserted ON CONFLICT (instance_id, unit, start)` +
+		` DO UPDATE SET usage = projections.quotas_periods.usage + excluded.usage RETURNING usage`
 )
 
 type quotaProjection struct {
@@ -105,6 +122,15 @@ func (*quotaProjection) Init() *old_handler.Check {
 			handler.NewPrimaryKey(QuotaNotificationColumnInstanceID, QuotaNotificationColumnUnit, QuotaNotificationColumnID),
 			quotaNotificationsTableSuffix,
 		),
+		handler.NewSuffixedTable(
+			[]*handler.InitColumn{
+				handler.NewColumn(QuotaActiveUserColumnInstanceID, handler.ColumnTypeText),
+				handler.NewColumn(QuotaActiveUserColumnStart, handler.ColumnTypeTimestamp),
+				handler.NewColumn(QuotaActiveUserColumnUserID, handler.ColumnTypeText),
+			},
+			handler.NewPrimaryKey(QuotaActiveUserColumnInstanceID, QuotaActiveUserColumnStart, QuotaActiveUserColumnUserID),
+			quotaActiveUsersTableSuffix,
+		),
 	)
 }
 
@@ -156,6 +182,10 @@ func (q *quotaProjection) reduceQuotaSet(event eventstore.Event) (*handler.State
 	if err != nil {
 		return nil, err
 	}
+	// quotas of organizations are projected by the org quota projection
+	if quota.IsOrgAggregate(e.Aggregate()) {
+		return handler.NewNoOpStatement(e), nil
+	}
 	var statements []func(e eventstore.Event) handler.Exec
 
 	// 1. Insert or update quota if the event has not only notification changes
@@ -217,6 +247,10 @@ func (q *quotaProjection) reduceQuotaNotificationDue(event eventstore.Event) (*h
 	if err != nil {
 		return nil, err
 	}
+	// quotas of organizations are projected by the org quota projection
+	if quota.IsOrgAggregate(e.Aggregate()) {
+		return handler.NewNoOpStatement(e), nil
+	}
 	return handler.NewUpdateStatement(e,
 		[]handler.Column{
 			handler.NewCol(QuotaNotificationColumnLatestDuePeriodStart, e.PeriodStart),
@@ -236,8 +270,11 @@ func (q *quotaProjection) reduceQuotaRemoved(event eventstore.Event) (*handler.S
 	if err != nil {
 		return nil, err
 	}
-	return handler.NewMultiStatement(
-		e,
+	// quotas of organizations are projected by the org quota projection
+	if quota.IsOrgAggregate(e.Aggregate()) {
+		return handler.NewNoOpStatement(e), nil
+	}
+	statements := []func(e eventstore.Event) handler.Exec{
 		handler.AddDeleteStatement(
 			[]handler.Condition{
 				handler.NewCond(QuotaPeriodColumnInstanceID, e.Aggregate().InstanceID),
@@ -252,13 +289,22 @@ func (q *quotaProjection) reduceQuotaRemoved(event eventstore.Event) (*handler.S
 			},
 			handler.WithTableSuffix(quotaNotificationsTableSuffix),
 		),
-		handler.AddDeleteStatement(
+	}
+	if e.Unit == quota.UsersAllActive {
+		statements = append(statements, handler.AddDeleteStatement(
 			[]handler.Condition{
-				handler.NewCond(QuotaColumnInstanceID, e.Aggregate().InstanceID),
-				handler.NewCond(QuotaColumnUnit, e.Unit),
+				handler.NewCond(QuotaActiveUserColumnInstanceID, e.Aggregate().InstanceID),
 			},
-		),
-	), nil
+			handler.WithTableSuffix(quotaActiveUsersTableSuffix),
+		))
+	}
+	statements = append(statements, handler.AddDeleteStatement(
+		[]handler.Condition{
+			handler.NewCond(QuotaColumnInstanceID, e.Aggregate().InstanceID),
+			handler.NewCond(QuotaColumnUnit, e.Unit),
+		},
+	))
+	return handler.NewMultiStatement(e, statements...), nil
 }
 
 func (q *quotaProjection) reduceInstanceRemoved(event eventstore.Event) (*handler.Statement, error) {
@@ -281,6 +327,12 @@ func (q *quotaProjection) reduceInstanceRemoved(event eventstore.Event) (*handle
 			},
 			handler.WithTableSuffix(quotaNotificationsTableSuffix),
 		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(QuotaActiveUserColumnInstanceID, e.Aggregate().InstanceID),
+			},
+			handler.WithTableSuffix(quotaActiveUsersTableSuffix),
+		),
 		handler.AddDeleteStatement(
 			[]handler.Condition{
 				handler.NewCond(QuotaColumnInstanceID, e.Aggregate().InstanceID),
@@ -304,3 +356,20 @@ func (q *quotaProjection) IncrementUsage(ctx context.Context, unit quota.Unit, i
 	}
 	return sum, err
 }
+
+// IncrementActiveUsers records the users as active in the period and returns the number of distinct active users in the period.
+func (q *quotaProjection) IncrementActiveUsers(ctx context.Context, instanceID string, periodStart time.Time, userIDs []string) (sum uint64, err error) {
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+
+	err = q.client.DB.QueryRowContext(
+		ctx,
+		incrementActiveUsersStatement,
+		instanceID, periodStart, database.TextArray[string](userIDs), quota.UsersAllActive,
+	).Scan(&sum)
+	if err != nil {
+		return 0, zerrors.ThrowInternal(err, "PROJ-u3Kd9", "incrementing active users failed")
+	}
+	return sum, err
+}
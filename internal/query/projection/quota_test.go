@@ -41,6 +41,7 @@ func TestQuotasProjection_reduces(t *testing.T) {
 							"from": "2023-01-01T00:00:00Z",
 							"interval": 300000000000
 					}`),
+					withResourceOwner("instance-id"),
 				), quota.SetEventMapper),
 			},
 			reduce: (&quotaProjection{}).reduceQuotaSet,
@@ -86,6 +87,7 @@ func TestQuotasProjection_reduces(t *testing.T) {
 								}
 							]
 					}`),
+					withResourceOwner("instance-id"),
 				), quota.SetEventMapper),
 			},
 			reduce: (&quotaProjection{}).reduceQuotaSet,
@@ -141,6 +143,7 @@ func TestQuotasProjection_reduces(t *testing.T) {
 							"from": "2023-01-01T00:00:00Z",
 							"interval": 300000000000
 					}`),
+					withResourceOwner("instance-id"),
 				), quota.SetEventMapper),
 			},
 			reduce: (&quotaProjection{}).reduceQuotaSet,
@@ -186,6 +189,7 @@ func TestQuotasProjection_reduces(t *testing.T) {
 								}
 							]
 					}`),
+					withResourceOwner("instance-id"),
 				), quota.SetEventMapper),
 			},
 			reduce: (&quotaProjection{}).reduceQuotaSet,
@@ -242,6 +246,7 @@ func TestQuotasProjection_reduces(t *testing.T) {
 							"threshold": 200,
 							"usage": 100
 					}`),
+					withResourceOwner("instance-id"),
 				), quota.NotificationDueEventMapper),
 			},
 			reduce: (&quotaProjection{}).reduceQuotaNotificationDue,
@@ -273,6 +278,7 @@ func TestQuotasProjection_reduces(t *testing.T) {
 					[]byte(`{
 							"unit": 1
 					}`),
+					withResourceOwner("instance-id"),
 				), quota.RemovedEventMapper),
 			},
 			reduce: (&quotaProjection{}).reduceQuotaRemoved,
@@ -305,6 +311,54 @@ func TestQuotasProjection_reduces(t *testing.T) {
 					},
 				},
 			},
+		}, {
+			name: "reduceQuotaRemoved users all active",
+			args: args{
+				event: getEvent(testEvent(
+					quota.RemovedEventType,
+					quota.AggregateType,
+					[]byte(`{
+							"unit": 3
+					}`),
+					withResourceOwner("instance-id"),
+				), quota.RemovedEventMapper),
+			},
+			reduce: (&quotaProjection{}).reduceQuotaRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("quota"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.quotas_periods WHERE (instance_id = $1) AND (unit = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								quota.UsersAllActive,
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.quotas_notifications WHERE (instance_id = $1) AND (unit = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								quota.UsersAllActive,
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.quotas_active_users WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.quotas WHERE (instance_id = $1) AND (unit = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								quota.UsersAllActive,
+							},
+						},
+					},
+				},
+			},
 		}, {
 			name: "reduceInstanceRemoved",
 			args: args{
@@ -334,6 +388,12 @@ func TestQuotasProjection_reduces(t *testing.T) {
 								"instance-id",
 							},
 						},
+						{
+							expectedStmt: "DELETE FROM projections.quotas_active_users WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"instance-id",
+							},
+						},
 						{
 							expectedStmt: "DELETE FROM projections.quotas WHERE (instance_id = $1)",
 							expectedArgs: []interface{}{
@@ -421,3 +481,81 @@ func Test_quotaProjection_IncrementUsage(t *testing.T) {
 		})
 	}
 }
+
+func Test_quotaProjection_IncrementActiveUsers(t *testing.T) {
+	testNow := time.Now()
+	type fields struct {
+		client *database.DB
+	}
+	type args struct {
+		ctx         context.Context
+		instanceID  string
+		periodStart time.Time
+		userIDs     []string
+	}
+	type res struct {
+		sum uint64
+		err error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "no users",
+			fields: fields{
+				client: func() *database.DB {
+					db, _, _ := sqlmock.New(sqlmock.ValueConverterOption(new(db_mock.TypeConverter)))
+					return &database.DB{DB: db}
+				}(),
+			},
+			args: args{
+				ctx:         context.Background(),
+				instanceID:  "instance_id",
+				periodStart: testNow,
+			},
+			res: res{
+				sum: 0,
+			},
+		},
+		{
+			name: "users",
+			fields: fields{
+				client: func() *database.DB {
+					db, mock, _ := sqlmock.New(sqlmock.ValueConverterOption(new(db_mock.TypeConverter)))
+					mock.ExpectQuery(regexp.QuoteMeta(incrementActiveUsersStatement)).
+						WithArgs(
+							"instance_id",
+							testNow,
+							database.TextArray[string]{"user1", "user2"},
+							quota.UsersAllActive,
+						).
+						WillReturnRows(mock.NewRows([]string{"usage"}).
+							AddRow(5))
+					return &database.DB{DB: db}
+				}(),
+			},
+			args: args{
+				ctx:         context.Background(),
+				instanceID:  "instance_id",
+				periodStart: testNow,
+				userIDs:     []string{"user1", "user2"},
+			},
+			res: res{
+				sum: 5,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &quotaProjection{
+				client: tt.fields.client,
+			}
+			gotSum, err := q.IncrementActiveUsers(tt.args.ctx, tt.args.instanceID, tt.args.periodStart, tt.args.userIDs)
+			assert.Equal(t, tt.res.sum, gotSum)
+			assert.ErrorIs(t, err, tt.res.err)
+		})
+	}
+}
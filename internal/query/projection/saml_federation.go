@@ -0,0 +1,373 @@
+package projection
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/samlfederation"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	SAMLFederationTable                 = "projections.saml_federations"
+	SAMLFederationInstanceIDCol         = "instance_id"
+	SAMLFederationIDCol                 = "id"
+	SAMLFederationCreationDateCol       = "creation_date"
+	SAMLFederationChangeDateCol         = "change_date"
+	SAMLFederationSequenceCol           = "sequence"
+	SAMLFederationNameCol               = "name"
+	SAMLFederationMetadataURLCol        = "metadata_url"
+	SAMLFederationSigningCertificateCol = "signing_certificate"
+	SAMLFederationFilterCol             = "filter"
+	SAMLFederationIntervalCol           = "interval"
+	SAMLFederationAddToLoginPolicyCol   = "add_to_login_policy"
+	SAMLFederationTemplateCol           = "template"
+	SAMLFederationNextRunAtCol          = "next_run_at"
+	SAMLFederationLastRunDateCol        = "last_run_date"
+	SAMLFederationLastSummaryCol        = "last_summary"
+	SAMLFederationValidUntilCol         = "valid_until"
+	SAMLFederationLastErrorDateCol      = "last_error_date"
+	SAMLFederationLastErrorCol          = "last_error"
+
+	samlFederationEntityTableSuffix     = "entities"
+	SAMLFederationEntityTable           = SAMLFederationTable + "_" + samlFederationEntityTableSuffix
+	SAMLFederationEntityInstanceIDCol   = "instance_id"
+	SAMLFederationEntityFederationIDCol = "federation_id"
+	SAMLFederationEntityEntityIDCol     = "entity_id"
+	SAMLFederationEntityIDPIDCol        = "idp_id"
+	SAMLFederationEntityDisplayNameCol  = "display_name"
+	SAMLFederationEntityChangeDateCol   = "change_date"
+	SAMLFederationEntitySequenceCol     = "sequence"
+)
+
+type samlFederationProjection struct{}
+
+func newSAMLFederationProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(samlFederationProjection))
+}
+
+func (*samlFederationProjection) Name() string {
+	return SAMLFederationTable
+}
+
+func (*samlFederationProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(SAMLFederationInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SAMLFederationIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SAMLFederationCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SAMLFederationChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SAMLFederationSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(SAMLFederationNameCol, handler.ColumnTypeText),
+			handler.NewColumn(SAMLFederationMetadataURLCol, handler.ColumnTypeText),
+			handler.NewColumn(SAMLFederationSigningCertificateCol, handler.ColumnTypeBytes),
+			handler.NewColumn(SAMLFederationFilterCol, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SAMLFederationIntervalCol, handler.ColumnTypeInt64),
+			handler.NewColumn(SAMLFederationAddToLoginPolicyCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(SAMLFederationTemplateCol, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SAMLFederationNextRunAtCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SAMLFederationLastRunDateCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SAMLFederationLastSummaryCol, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SAMLFederationValidUntilCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SAMLFederationLastErrorDateCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SAMLFederationLastErrorCol, handler.ColumnTypeText, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(SAMLFederationInstanceIDCol, SAMLFederationIDCol),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SAMLFederationEntityInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SAMLFederationEntityFederationIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SAMLFederationEntityEntityIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SAMLFederationEntityIDPIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SAMLFederationEntityDisplayNameCol, handler.ColumnTypeText),
+			handler.NewColumn(SAMLFederationEntityChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SAMLFederationEntitySequenceCol, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(SAMLFederationEntityInstanceIDCol, SAMLFederationEntityFederationIDCol, SAMLFederationEntityEntityIDCol),
+			samlFederationEntityTableSuffix,
+			handler.WithIndex(handler.NewIndex("idp_id", []string{SAMLFederationEntityInstanceIDCol, SAMLFederationEntityIDPIDCol})),
+		),
+	)
+}
+
+func (p *samlFederationProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: samlfederation.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  samlfederation.AddedType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  samlfederation.ChangedType,
+					Reduce: p.reduceChanged,
+				},
+				{
+					Event:  samlfederation.RemovedType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  samlfederation.ImportRequestedType,
+					Reduce: p.reduceImportRequested,
+				},
+				{
+					Event:  samlfederation.ImportSucceededType,
+					Reduce: p.reduceImportSucceeded,
+				},
+				{
+					Event:  samlfederation.ImportFailedType,
+					Reduce: p.reduceImportFailed,
+				},
+				{
+					Event:  samlfederation.EntityLinkedType,
+					Reduce: p.reduceEntityLinked,
+				},
+				{
+					Event:  samlfederation.EntityUnlinkedType,
+					Reduce: p.reduceEntityUnlinked,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.IDPRemovedEventType,
+					Reduce: p.reduceIDPRemoved,
+				},
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: p.reduceInstanceRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *samlFederationProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*samlfederation.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := samlFederationConfigColumns(&e.Config)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		append(columns,
+			handler.NewCol(SAMLFederationInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(SAMLFederationIDCol, e.Aggregate().ID),
+			handler.NewCol(SAMLFederationCreationDateCol, e.CreationDate()),
+			handler.NewCol(SAMLFederationChangeDateCol, e.CreationDate()),
+			handler.NewCol(SAMLFederationSequenceCol, e.Sequence()),
+			handler.NewCol(SAMLFederationNextRunAtCol, e.CreationDate()),
+		),
+	), nil
+}
+
+func (p *samlFederationProjection) reduceChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*samlfederation.ChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := samlFederationConfigColumns(&e.Config)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		append(columns,
+			handler.NewCol(SAMLFederationChangeDateCol, e.CreationDate()),
+			handler.NewCol(SAMLFederationSequenceCol, e.Sequence()),
+			handler.NewCol(SAMLFederationNextRunAtCol, e.CreationDate()),
+		),
+		p.federationConditions(e),
+	), nil
+}
+
+func samlFederationConfigColumns(config *samlfederation.Config) ([]handler.Column, error) {
+	filter, err := json.Marshal(config.Filter)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "HANDL-Sf3pF", "Errors.Internal")
+	}
+	template, err := json.Marshal(config.Template)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "HANDL-Sf3pT", "Errors.Internal")
+	}
+	return []handler.Column{
+		handler.NewCol(SAMLFederationNameCol, config.Name),
+		handler.NewCol(SAMLFederationMetadataURLCol, config.MetadataURL),
+		handler.NewCol(SAMLFederationSigningCertificateCol, config.SigningCertificate),
+		handler.NewCol(SAMLFederationFilterCol, filter),
+		handler.NewCol(SAMLFederationIntervalCol, config.Interval),
+		handler.NewCol(SAMLFederationAddToLoginPolicyCol, config.AddToLoginPolicy),
+		handler.NewCol(SAMLFederationTemplateCol, template),
+	}, nil
+}
+
+func (p *samlFederationProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*samlfederation.RemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SAMLFederationEntityInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(SAMLFederationEntityFederationIDCol, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(samlFederationEntityTableSuffix),
+		),
+		handler.AddDeleteStatement(p.federationConditions(e)),
+	), nil
+}
+
+func (p *samlFederationProjection) reduceImportRequested(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*samlfederation.ImportRequestedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SAMLFederationChangeDateCol, e.CreationDate()),
+			handler.NewCol(SAMLFederationSequenceCol, e.Sequence()),
+			handler.NewCol(SAMLFederationNextRunAtCol, e.CreationDate()),
+		},
+		p.federationConditions(e),
+	), nil
+}
+
+func (p *samlFederationProjection) reduceImportSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*samlfederation.ImportSucceededEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := json.Marshal(e.Summary)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "HANDL-Sf3pS", "Errors.Internal")
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SAMLFederationChangeDateCol, e.CreationDate()),
+			handler.NewCol(SAMLFederationSequenceCol, e.Sequence()),
+			handler.NewCol(SAMLFederationNextRunAtCol, e.NextRunAt),
+			handler.NewCol(SAMLFederationLastRunDateCol, e.StartedAt),
+			handler.NewCol(SAMLFederationLastSummaryCol, summary),
+			handler.NewCol(SAMLFederationValidUntilCol, e.ValidUntil),
+		},
+		p.federationConditions(e),
+	), nil
+}
+
+func (p *samlFederationProjection) reduceImportFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*samlfederation.ImportFailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SAMLFederationChangeDateCol, e.CreationDate()),
+			handler.NewCol(SAMLFederationSequenceCol, e.Sequence()),
+			handler.NewCol(SAMLFederationNextRunAtCol, e.NextRunAt),
+			handler.NewCol(SAMLFederationLastErrorDateCol, e.StartedAt),
+			handler.NewCol(SAMLFederationLastErrorCol, e.Error),
+		},
+		p.federationConditions(e),
+	), nil
+}
+
+func (p *samlFederationProjection) reduceEntityLinked(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*samlfederation.EntityLinkedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SAMLFederationEntityInstanceIDCol, nil),
+			handler.NewCol(SAMLFederationEntityFederationIDCol, nil),
+			handler.NewCol(SAMLFederationEntityEntityIDCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(SAMLFederationEntityInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(SAMLFederationEntityFederationIDCol, e.Aggregate().ID),
+			handler.NewCol(SAMLFederationEntityEntityIDCol, e.EntityID),
+			handler.NewCol(SAMLFederationEntityIDPIDCol, e.IDPID),
+			handler.NewCol(SAMLFederationEntityDisplayNameCol, e.DisplayName),
+			handler.NewCol(SAMLFederationEntityChangeDateCol, e.CreationDate()),
+			handler.NewCol(SAMLFederationEntitySequenceCol, e.Sequence()),
+		},
+		handler.WithTableSuffix(samlFederationEntityTableSuffix),
+	), nil
+}
+
+func (p *samlFederationProjection) reduceEntityUnlinked(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*samlfederation.EntityUnlinkedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SAMLFederationEntityInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(SAMLFederationEntityFederationIDCol, e.Aggregate().ID),
+			handler.NewCond(SAMLFederationEntityEntityIDCol, e.EntityID),
+		},
+		handler.WithTableSuffix(samlFederationEntityTableSuffix),
+	), nil
+}
+
+// reduceIDPRemoved removes the entity of an identity provider removed manually,
+// it's created again on the next import.
+func (p *samlFederationProjection) reduceIDPRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.IDPRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(SAMLFederationEntityInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(SAMLFederationEntityIDPIDCol, e.ID),
+		},
+		handler.WithTableSuffix(samlFederationEntityTableSuffix),
+	), nil
+}
+
+func (p *samlFederationProjection) reduceInstanceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.InstanceRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SAMLFederationEntityInstanceIDCol, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(samlFederationEntityTableSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SAMLFederationInstanceIDCol, e.Aggregate().ID),
+			},
+		),
+	), nil
+}
+
+func (p *samlFederationProjection) federationConditions(event eventstore.Event) []handler.Condition {
+	return []handler.Condition{
+		handler.NewCond(SAMLFederationInstanceIDCol, event.Aggregate().InstanceID),
+		handler.NewCond(SAMLFederationIDCol, event.Aggregate().ID),
+	}
+}
@@ -0,0 +1,310 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/samlfederation"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestSAMLFederationProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						samlfederation.AddedType,
+						samlfederation.AggregateType,
+						[]byte(`{"name": "federation", "metadataUrl": "https://federation.example/metadata.xml", "signingCertificate": "Y2VydGlmaWNhdGU=", "filter": {"entityCategories": ["http://refeds.org/category/research-and-scholarship"]}, "interval": 86400000000000, "addToLoginPolicy": true, "template": {"binding": "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST", "isLinkingAllowed": true}}`),
+					),
+					eventstore.GenericEventMapper[samlfederation.AddedEvent],
+				),
+			},
+			reduce: (&samlFederationProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: samlfederation.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.saml_federations (name, metadata_url, signing_certificate, filter, interval, add_to_login_policy, template, instance_id, id, creation_date, change_date, sequence, next_run_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)",
+							expectedArgs: []interface{}{
+								"federation",
+								"https://federation.example/metadata.xml",
+								[]byte("certificate"),
+								[]byte(`{"entityCategories":["http://refeds.org/category/research-and-scholarship"]}`),
+								24 * time.Hour,
+								true,
+								[]byte(`{"binding":"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST","isLinkingAllowed":true}`),
+								"instance-id",
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								anyArg{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						samlfederation.RemovedType,
+						samlfederation.AggregateType,
+						nil,
+					),
+					eventstore.GenericEventMapper[samlfederation.RemovedEvent],
+				),
+			},
+			reduce: (&samlFederationProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: samlfederation.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.saml_federations_entities WHERE (instance_id = $1) AND (federation_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.saml_federations WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceImportSucceeded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						samlfederation.ImportSucceededType,
+						samlfederation.AggregateType,
+						[]byte(`{"startedAt": "2024-01-01T00:00:00Z", "summary": {"entities": 2, "created": 1, "updated": 1}, "validUntil": "2024-01-08T00:00:00Z", "nextRunAt": "2024-01-02T00:00:00Z"}`),
+					),
+					eventstore.GenericEventMapper[samlfederation.ImportSucceededEvent],
+				),
+			},
+			reduce: (&samlFederationProjection{}).reduceImportSucceeded,
+			want: wantReduce{
+				aggregateType: samlfederation.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.saml_federations SET (change_date, sequence, next_run_at, last_run_date, last_summary, valid_until) = ($1, $2, $3, $4, $5, $6) WHERE (instance_id = $7) AND (id = $8)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+								time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+								[]byte(`{"entities":2,"created":1,"updated":1}`),
+								time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC),
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceImportFailed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						samlfederation.ImportFailedType,
+						samlfederation.AggregateType,
+						[]byte(`{"startedAt": "2024-01-01T00:00:00Z", "error": "signature invalid", "nextRunAt": "2024-01-02T00:00:00Z"}`),
+					),
+					eventstore.GenericEventMapper[samlfederation.ImportFailedEvent],
+				),
+			},
+			reduce: (&samlFederationProjection{}).reduceImportFailed,
+			want: wantReduce{
+				aggregateType: samlfederation.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.saml_federations SET (change_date, sequence, next_run_at, last_error_date, last_error) = ($1, $2, $3, $4, $5) WHERE (instance_id = $6) AND (id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+								time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+								"signature invalid",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceEntityLinked",
+			args: args{
+				event: getEvent(
+					testEvent(
+						samlfederation.EntityLinkedType,
+						samlfederation.AggregateType,
+						[]byte(`{"entityId": "https://idp.university.example/idp", "idpId": "idp-id", "displayName": "University"}`),
+					),
+					eventstore.GenericEventMapper[samlfederation.EntityLinkedEvent],
+				),
+			},
+			reduce: (&samlFederationProjection{}).reduceEntityLinked,
+			want: wantReduce{
+				aggregateType: samlfederation.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.saml_federations_entities (instance_id, federation_id, entity_id, idp_id, display_name, change_date, sequence) VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (instance_id, federation_id, entity_id) DO UPDATE SET (idp_id, display_name, change_date, sequence) = (EXCLUDED.idp_id, EXCLUDED.display_name, EXCLUDED.change_date, EXCLUDED.sequence)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"https://idp.university.example/idp",
+								"idp-id",
+								"University",
+								anyArg{},
+								uint64(15),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceEntityUnlinked",
+			args: args{
+				event: getEvent(
+					testEvent(
+						samlfederation.EntityUnlinkedType,
+						samlfederation.AggregateType,
+						[]byte(`{"entityId": "https://idp.university.example/idp", "idpId": "idp-id"}`),
+					),
+					eventstore.GenericEventMapper[samlfederation.EntityUnlinkedEvent],
+				),
+			},
+			reduce: (&samlFederationProjection{}).reduceEntityUnlinked,
+			want: wantReduce{
+				aggregateType: samlfederation.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.saml_federations_entities WHERE (instance_id = $1) AND (federation_id = $2) AND (entity_id = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"https://idp.university.example/idp",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceIDPRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.IDPRemovedEventType,
+						instance.AggregateType,
+						[]byte(`{"id": "idp-id"}`),
+					),
+					instance.IDPRemovedEventMapper,
+				),
+			},
+			reduce: (&samlFederationProjection{}).reduceIDPRemoved,
+			want: wantReduce{
+				aggregateType: instance.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.saml_federations_entities WHERE (instance_id = $1) AND (idp_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"idp-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: (&samlFederationProjection{}).reduceInstanceRemoved,
+			want: wantReduce{
+				aggregateType: instance.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.saml_federations_entities WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.saml_federations WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, SAMLFederationTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,412 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/scimprovisioning"
+)
+
+const (
+	SCIMProvisioningTable                  = "projections.scim_provisioning"
+	SCIMProvisioningInstanceIDCol          = "instance_id"
+	SCIMProvisioningAppIDCol               = "app_id"
+	SCIMProvisioningProjectIDCol           = "project_id"
+	SCIMProvisioningResourceOwnerCol       = "resource_owner"
+	SCIMProvisioningCreationDateCol        = "creation_date"
+	SCIMProvisioningChangeDateCol          = "change_date"
+	SCIMProvisioningSequenceCol            = "sequence"
+	SCIMProvisioningEndpointCol            = "endpoint"
+	SCIMProvisioningAuthTypeCol            = "auth_type"
+	SCIMProvisioningTokenCol               = "token"
+	SCIMProvisioningClientIDCol            = "client_id"
+	SCIMProvisioningClientSecretCol        = "client_secret"
+	SCIMProvisioningTokenEndpointCol       = "token_endpoint"
+	SCIMProvisioningScopesCol              = "scopes"
+	SCIMProvisioningProvisionGroupsCol     = "provision_groups"
+	SCIMProvisioningLastSuccessDateCol     = "last_success_date"
+	SCIMProvisioningLastErrorDateCol       = "last_error_date"
+	SCIMProvisioningLastErrorCol           = "last_error"
+	SCIMProvisionedResourceSuffix          = "resources"
+	SCIMProvisionedResourceTable           = SCIMProvisioningTable + "_" + SCIMProvisionedResourceSuffix
+	SCIMProvisionedResourceInstanceIDCol   = "instance_id"
+	SCIMProvisionedResourceAppIDCol        = "app_id"
+	SCIMProvisionedResourceOwnerCol        = "resource_owner"
+	SCIMProvisionedResourceTypeCol         = "resource_type"
+	SCIMProvisionedResourceIDCol           = "resource_id"
+	SCIMProvisionedResourceRemoteIDCol     = "remote_id"
+	SCIMProvisionedResourceStateCol        = "state"
+	SCIMProvisionedResourceErrorCol        = "error"
+	SCIMProvisionedResourceCreationDateCol = "creation_date"
+	SCIMProvisionedResourceChangeDateCol   = "change_date"
+	SCIMProvisionedResourceSequenceCol     = "sequence"
+	scimProvisionedResourceOfProjectQuery  = SCIMProvisionedResourceAppIDCol + " IN (SELECT c." + SCIMProvisioningAppIDCol + " FROM " + SCIMProvisioningTable + " c WHERE c." + SCIMProvisioningInstanceIDCol + " = " + SCIMProvisionedResourceTable + "." + SCIMProvisionedResourceInstanceIDCol + " AND c." + SCIMProvisioningProjectIDCol + " = "
+)
+
+type scimProvisioningProjection struct{}
+
+func newSCIMProvisioningProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(scimProvisioningProjection))
+}
+
+func (*scimProvisioningProjection) Name() string {
+	return SCIMProvisioningTable
+}
+
+func (*scimProvisioningProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(SCIMProvisioningInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMProvisioningAppIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMProvisioningProjectIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMProvisioningResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMProvisioningCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SCIMProvisioningChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SCIMProvisioningSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(SCIMProvisioningEndpointCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMProvisioningAuthTypeCol, handler.ColumnTypeEnum),
+			handler.NewColumn(SCIMProvisioningTokenCol, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SCIMProvisioningClientIDCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(SCIMProvisioningClientSecretCol, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(SCIMProvisioningTokenEndpointCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(SCIMProvisioningScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(SCIMProvisioningProvisionGroupsCol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(SCIMProvisioningLastSuccessDateCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SCIMProvisioningLastErrorDateCol, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SCIMProvisioningLastErrorCol, handler.ColumnTypeText, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(SCIMProvisioningInstanceIDCol, SCIMProvisioningAppIDCol),
+			handler.WithIndex(handler.NewIndex("project_id", []string{SCIMProvisioningInstanceIDCol, SCIMProvisioningProjectIDCol})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(SCIMProvisionedResourceInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMProvisionedResourceAppIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMProvisionedResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMProvisionedResourceTypeCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMProvisionedResourceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(SCIMProvisionedResourceRemoteIDCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(SCIMProvisionedResourceStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(SCIMProvisionedResourceErrorCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(SCIMProvisionedResourceCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SCIMProvisionedResourceChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(SCIMProvisionedResourceSequenceCol, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(SCIMProvisionedResourceInstanceIDCol, SCIMProvisionedResourceAppIDCol, SCIMProvisionedResourceTypeCol, SCIMProvisionedResourceIDCol),
+			SCIMProvisionedResourceSuffix,
+			handler.WithIndex(handler.NewIndex("resource", []string{SCIMProvisionedResourceInstanceIDCol, SCIMProvisionedResourceTypeCol, SCIMProvisionedResourceIDCol})),
+		),
+	)
+}
+
+func (p *scimProvisioningProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.ApplicationSCIMProvisioningSetType,
+					Reduce: p.reduceSet,
+				},
+				{
+					Event:  project.ApplicationSCIMProvisioningRemovedType,
+					Reduce: p.reduceRemoved,
+				},
+				{
+					Event:  project.ApplicationRemovedType,
+					Reduce: p.reduceApplicationRemoved,
+				},
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: scimprovisioning.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  scimprovisioning.ResourceProvisionedType,
+					Reduce: p.reduceResourceProvisioned,
+				},
+				{
+					Event:  scimprovisioning.ResourceDeprovisionedType,
+					Reduce: p.reduceResourceDeprovisioned,
+				},
+				{
+					Event:  scimprovisioning.ResourceFailedType,
+					Reduce: p.reduceResourceFailed,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: p.reduceInstanceRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (p *scimProvisioningProjection) reduceSet(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ApplicationSCIMProvisioningSetEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpsertStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SCIMProvisioningInstanceIDCol, nil),
+			handler.NewCol(SCIMProvisioningAppIDCol, nil),
+		},
+		[]handler.Column{
+			handler.NewCol(SCIMProvisioningInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(SCIMProvisioningAppIDCol, e.AppID),
+			handler.NewCol(SCIMProvisioningProjectIDCol, e.Aggregate().ID),
+			handler.NewCol(SCIMProvisioningResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(SCIMProvisioningCreationDateCol, handler.OnlySetValueOnInsert(SCIMProvisioningTable, e.CreationDate())),
+			handler.NewCol(SCIMProvisioningChangeDateCol, e.CreationDate()),
+			handler.NewCol(SCIMProvisioningSequenceCol, e.Sequence()),
+			handler.NewCol(SCIMProvisioningEndpointCol, e.Endpoint),
+			handler.NewCol(SCIMProvisioningAuthTypeCol, e.AuthType),
+			handler.NewCol(SCIMProvisioningTokenCol, e.Token),
+			handler.NewCol(SCIMProvisioningClientIDCol, e.ClientID),
+			handler.NewCol(SCIMProvisioningClientSecretCol, e.ClientSecret),
+			handler.NewCol(SCIMProvisioningTokenEndpointCol, e.TokenEndpoint),
+			handler.NewCol(SCIMProvisioningScopesCol, database.TextArray[string](e.Scopes)),
+			handler.NewCol(SCIMProvisioningProvisionGroupsCol, e.ProvisionGroups),
+		},
+	), nil
+}
+
+func (p *scimProvisioningProjection) reduceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ApplicationSCIMProvisioningRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.deleteApp(e, e.AppID), nil
+}
+
+func (p *scimProvisioningProjection) reduceApplicationRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ApplicationRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return p.deleteApp(e, e.AppID), nil
+}
+
+func (p *scimProvisioningProjection) deleteApp(event eventstore.Event, appID string) *handler.Statement {
+	return handler.NewMultiStatement(
+		event,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SCIMProvisionedResourceInstanceIDCol, event.Aggregate().InstanceID),
+				handler.NewCond(SCIMProvisionedResourceAppIDCol, appID),
+			},
+			handler.WithTableSuffix(SCIMProvisionedResourceSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SCIMProvisioningInstanceIDCol, event.Aggregate().InstanceID),
+				handler.NewCond(SCIMProvisioningAppIDCol, appID),
+			},
+		),
+	)
+}
+
+func (p *scimProvisioningProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		// the resources must be deleted first, they are resolved using the connectors of the project
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SCIMProvisionedResourceInstanceIDCol, e.Aggregate().InstanceID),
+				scimProvisionedResourceOfProjectCond(e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(SCIMProvisionedResourceSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SCIMProvisioningInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(SCIMProvisioningProjectIDCol, e.Aggregate().ID),
+			},
+		),
+	), nil
+}
+
+// scimProvisionedResourceOfProjectCond matches the provisioned resources of all connectors of the project
+func scimProvisionedResourceOfProjectCond(projectID string) handler.Condition {
+	return func(param string) (string, []any) {
+		return scimProvisionedResourceOfProjectQuery + param + ")", []any{projectID}
+	}
+}
+
+func (p *scimProvisioningProjection) reduceResourceProvisioned(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*scimprovisioning.ResourceProvisionedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpsertStatement(
+			p.resourceIndexColumns(),
+			append(p.resourceColumns(e, e.ResourceType, e.ResourceID, domain.SCIMProvisioningResourceStateProvisioned),
+				handler.NewCol(SCIMProvisionedResourceRemoteIDCol, e.RemoteID),
+				handler.NewCol(SCIMProvisionedResourceErrorCol, nil),
+			),
+			handler.WithTableSuffix(SCIMProvisionedResourceSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SCIMProvisioningLastSuccessDateCol, e.CreationDate()),
+			},
+			p.connectorConditions(e),
+		),
+	), nil
+}
+
+func (p *scimProvisioningProjection) reduceResourceDeprovisioned(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*scimprovisioning.ResourceDeprovisionedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SCIMProvisionedResourceInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(SCIMProvisionedResourceAppIDCol, e.Aggregate().ID),
+				handler.NewCond(SCIMProvisionedResourceTypeCol, e.ResourceType),
+				handler.NewCond(SCIMProvisionedResourceIDCol, e.ResourceID),
+			},
+			handler.WithTableSuffix(SCIMProvisionedResourceSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SCIMProvisioningLastSuccessDateCol, e.CreationDate()),
+			},
+			p.connectorConditions(e),
+		),
+	), nil
+}
+
+// reduceResourceFailed keeps the remote id of a previously provisioned resource
+func (p *scimProvisioningProjection) reduceResourceFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*scimprovisioning.ResourceFailedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddUpsertStatement(
+			p.resourceIndexColumns(),
+			append(p.resourceColumns(e, e.ResourceType, e.ResourceID, domain.SCIMProvisioningResourceStateFailed),
+				handler.NewCol(SCIMProvisionedResourceErrorCol, e.Error),
+			),
+			handler.WithTableSuffix(SCIMProvisionedResourceSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(SCIMProvisioningLastErrorDateCol, e.CreationDate()),
+				handler.NewCol(SCIMProvisioningLastErrorCol, e.Error),
+			},
+			p.connectorConditions(e),
+		),
+	), nil
+}
+
+func (p *scimProvisioningProjection) resourceIndexColumns() []handler.Column {
+	return []handler.Column{
+		handler.NewCol(SCIMProvisionedResourceInstanceIDCol, nil),
+		handler.NewCol(SCIMProvisionedResourceAppIDCol, nil),
+		handler.NewCol(SCIMProvisionedResourceTypeCol, nil),
+		handler.NewCol(SCIMProvisionedResourceIDCol, nil),
+	}
+}
+
+func (p *scimProvisioningProjection) resourceColumns(event eventstore.Event, resourceType domain.SCIMProvisioningResourceType, resourceID string, state domain.SCIMProvisioningResourceState) []handler.Column {
+	return []handler.Column{
+		handler.NewCol(SCIMProvisionedResourceInstanceIDCol, event.Aggregate().InstanceID),
+		handler.NewCol(SCIMProvisionedResourceAppIDCol, event.Aggregate().ID),
+		handler.NewCol(SCIMProvisionedResourceOwnerCol, event.Aggregate().ResourceOwner),
+		handler.NewCol(SCIMProvisionedResourceTypeCol, resourceType),
+		handler.NewCol(SCIMProvisionedResourceIDCol, resourceID),
+		handler.NewCol(SCIMProvisionedResourceStateCol, state),
+		handler.NewCol(SCIMProvisionedResourceCreationDateCol, handler.OnlySetValueOnInsert(SCIMProvisionedResourceTable, event.CreatedAt())),
+		handler.NewCol(SCIMProvisionedResourceChangeDateCol, event.CreatedAt()),
+		handler.NewCol(SCIMProvisionedResourceSequenceCol, event.Sequence()),
+	}
+}
+
+func (p *scimProvisioningProjection) connectorConditions(event eventstore.Event) []handler.Condition {
+	return []handler.Condition{
+		handler.NewCond(SCIMProvisioningInstanceIDCol, event.Aggregate().InstanceID),
+		handler.NewCond(SCIMProvisioningAppIDCol, event.Aggregate().ID),
+	}
+}
+
+func (p *scimProvisioningProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SCIMProvisionedResourceInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(SCIMProvisionedResourceOwnerCol, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(SCIMProvisionedResourceSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SCIMProvisioningInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(SCIMProvisioningResourceOwnerCol, e.Aggregate().ID),
+			},
+		),
+	), nil
+}
+
+func (p *scimProvisioningProjection) reduceInstanceRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*instance.InstanceRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SCIMProvisionedResourceInstanceIDCol, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(SCIMProvisionedResourceSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(SCIMProvisioningInstanceIDCol, e.Aggregate().ID),
+			},
+		),
+	), nil
+}
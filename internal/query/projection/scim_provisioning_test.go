@@ -0,0 +1,245 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/scimprovisioning"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestSCIMProvisioningProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceSet",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ApplicationSCIMProvisioningSetType,
+						project.AggregateType,
+						[]byte(`{"appId": "app-id", "endpoint": "https://example.com/scim/v2", "authType": 2, "clientId": "client", "clientSecret": { "cryptoType": 0, "algorithm": "enc", "keyId": "key-id" }, "tokenEndpoint": "https://example.com/token", "scopes": ["scim"], "provisionGroups": true}`),
+					),
+					eventstore.GenericEventMapper[project.ApplicationSCIMProvisioningSetEvent],
+				),
+			},
+			reduce: (&scimProvisioningProjection{}).reduceSet,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.scim_provisioning (instance_id, app_id, project_id, resource_owner, creation_date, change_date, sequence, endpoint, auth_type, token, client_id, client_secret, token_endpoint, scopes, provision_groups) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) ON CONFLICT (instance_id, app_id) DO UPDATE SET (project_id, resource_owner, creation_date, change_date, sequence, endpoint, auth_type, token, client_id, client_secret, token_endpoint, scopes, provision_groups) = (EXCLUDED.project_id, EXCLUDED.resource_owner, projections.scim_provisioning.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.endpoint, EXCLUDED.auth_type, EXCLUDED.token, EXCLUDED.client_id, EXCLUDED.client_secret, EXCLUDED.token_endpoint, EXCLUDED.scopes, EXCLUDED.provision_groups)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"app-id",
+								"agg-id",
+								"ro-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"https://example.com/scim/v2",
+								domain.SCIMProvisioningAuthTypeClientCredentials,
+								anyArg{},
+								"client",
+								anyArg{},
+								"https://example.com/token",
+								database.TextArray[string]{"scim"},
+								true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ApplicationSCIMProvisioningRemovedType,
+						project.AggregateType,
+						[]byte(`{"appId": "app-id"}`),
+					),
+					eventstore.GenericEventMapper[project.ApplicationSCIMProvisioningRemovedEvent],
+				),
+			},
+			reduce: (&scimProvisioningProjection{}).reduceRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.scim_provisioning_resources WHERE (instance_id = $1) AND (app_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"app-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.scim_provisioning WHERE (instance_id = $1) AND (app_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"app-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProjectRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ProjectRemovedType,
+						project.AggregateType,
+						[]byte(`{}`),
+					),
+					project.ProjectRemovedEventMapper,
+				),
+			},
+			reduce: (&scimProvisioningProjection{}).reduceProjectRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.scim_provisioning_resources WHERE (instance_id = $1) AND (app_id IN (SELECT c.app_id FROM projections.scim_provisioning c WHERE c.instance_id = projections.scim_provisioning_resources.instance_id AND c.project_id = $2))",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.scim_provisioning WHERE (instance_id = $1) AND (project_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceResourceProvisioned",
+			args: args{
+				event: getEvent(
+					testEvent(
+						scimprovisioning.ResourceProvisionedType,
+						scimprovisioning.AggregateType,
+						[]byte(`{"resourceType": "user", "resourceId": "user-id", "remoteId": "remote-id"}`),
+					),
+					eventstore.GenericEventMapper[scimprovisioning.ResourceProvisionedEvent],
+				),
+			},
+			reduce: (&scimProvisioningProjection{}).reduceResourceProvisioned,
+			want: wantReduce{
+				aggregateType: scimprovisioning.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.scim_provisioning_resources (instance_id, app_id, resource_owner, resource_type, resource_id, state, creation_date, change_date, sequence, remote_id, error) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) ON CONFLICT (instance_id, app_id, resource_type, resource_id) DO UPDATE SET (resource_owner, state, creation_date, change_date, sequence, remote_id, error) = (EXCLUDED.resource_owner, EXCLUDED.state, projections.scim_provisioning_resources.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.remote_id, EXCLUDED.error)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"ro-id",
+								domain.SCIMProvisioningResourceTypeUser,
+								"user-id",
+								domain.SCIMProvisioningResourceStateProvisioned,
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"remote-id",
+								nil,
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.scim_provisioning SET last_success_date = $1 WHERE (instance_id = $2) AND (app_id = $3)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceResourceFailed",
+			args: args{
+				event: getEvent(
+					testEvent(
+						scimprovisioning.ResourceFailedType,
+						scimprovisioning.AggregateType,
+						[]byte(`{"resourceType": "group", "resourceId": "group-id", "error": "409 Conflict"}`),
+					),
+					eventstore.GenericEventMapper[scimprovisioning.ResourceFailedEvent],
+				),
+			},
+			reduce: (&scimProvisioningProjection{}).reduceResourceFailed,
+			want: wantReduce{
+				aggregateType: scimprovisioning.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.scim_provisioning_resources (instance_id, app_id, resource_owner, resource_type, resource_id, state, creation_date, change_date, sequence, error) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT (instance_id, app_id, resource_type, resource_id) DO UPDATE SET (resource_owner, state, creation_date, change_date, sequence, error) = (EXCLUDED.resource_owner, EXCLUDED.state, projections.scim_provisioning_resources.creation_date, EXCLUDED.change_date, EXCLUDED.sequence, EXCLUDED.error)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"ro-id",
+								domain.SCIMProvisioningResourceTypeGroup,
+								"group-id",
+								domain.SCIMProvisioningResourceStateFailed,
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"409 Conflict",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.scim_provisioning SET (last_error_date, last_error) = ($1, $2) WHERE (instance_id = $3) AND (app_id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								"409 Conflict",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, SCIMProvisioningTable, tt.want)
+		})
+	}
+}
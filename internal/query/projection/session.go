@@ -3,6 +3,7 @@ package projection
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
@@ -35,6 +36,11 @@ const (
 	SessionColumnOTPSMSCheckedAt        = "otp_sms_checked_at"
 	SessionColumnOTPEmailCheckedAt      = "otp_email_checked_at"
 	SessionColumnRecoveryCodeCheckedAt  = "mfa_recovery_code_checked_at"
+	SessionColumnPushCheckedAt          = "mfa_push_checked_at"
+	SessionColumnX509CheckedAt          = "x509_checked_at"
+	SessionColumnRiskScore              = "risk_score"
+	SessionColumnRiskAction             = "risk_action"
+	SessionColumnRiskSignals            = "risk_signals"
 	SessionColumnMetadata               = "metadata"
 	SessionColumnTokenID                = "token_id"
 	SessionColumnUserAgentFingerprintID = "user_agent_fingerprint_id"
@@ -76,6 +82,11 @@ func (*sessionProjection) Init() *old_handler.Check {
 			handler.NewColumn(SessionColumnOTPSMSCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
 			handler.NewColumn(SessionColumnOTPEmailCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
 			handler.NewColumn(SessionColumnRecoveryCodeCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SessionColumnPushCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SessionColumnX509CheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SessionColumnRiskScore, handler.ColumnTypeInt64, handler.Nullable()),
+			handler.NewColumn(SessionColumnRiskAction, handler.ColumnTypeEnum, handler.Nullable()),
+			handler.NewColumn(SessionColumnRiskSignals, handler.ColumnTypeTextArray, handler.Nullable()),
 			handler.NewColumn(SessionColumnMetadata, handler.ColumnTypeJSONB, handler.Nullable()),
 			handler.NewColumn(SessionColumnTokenID, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(SessionColumnUserAgentFingerprintID, handler.ColumnTypeText, handler.Nullable()),
@@ -135,6 +146,18 @@ func (p *sessionProjection) Reducers() []handler.AggregateReducer {
 					Event:  session.RecoveryCodeCheckedType,
 					Reduce: p.reduceRecoveryCodeChecked,
 				},
+				{
+					Event:  session.PushCheckedType,
+					Reduce: p.reducePushChecked,
+				},
+				{
+					Event:  session.X509CheckedType,
+					Reduce: p.reduceX509Checked,
+				},
+				{
+					Event:  session.RiskEvaluatedType,
+					Reduce: p.reduceRiskEvaluated,
+				},
 				{
 					Event:  session.TokenSetType,
 					Reduce: p.reduceTokenSet,
@@ -372,6 +395,68 @@ func (p *sessionProjection) reduceRecoveryCodeChecked(event eventstore.Event) (*
 	), nil
 }
 
+func (p *sessionProjection) reducePushChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*session.PushCheckedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SessionColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SessionColumnSequence, e.Sequence()),
+			handler.NewCol(SessionColumnPushCheckedAt, e.CheckedAt),
+		},
+		[]handler.Condition{
+			handler.NewCond(SessionColumnID, e.Aggregate().ID),
+			handler.NewCond(SessionColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *sessionProjection) reduceX509Checked(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*session.X509CheckedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SessionColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SessionColumnSequence, e.Sequence()),
+			handler.NewCol(SessionColumnX509CheckedAt, e.CheckedAt),
+		},
+		[]handler.Condition{
+			handler.NewCond(SessionColumnID, e.Aggregate().ID),
+			handler.NewCond(SessionColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+func (p *sessionProjection) reduceRiskEvaluated(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*session.RiskEvaluatedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(SessionColumnChangeDate, e.CreationDate()),
+			handler.NewCol(SessionColumnSequence, e.Sequence()),
+			handler.NewCol(SessionColumnRiskScore, e.Score),
+			handler.NewCol(SessionColumnRiskAction, e.Action),
+			handler.NewCol(SessionColumnRiskSignals, database.TextArray[domain.RiskSignal](e.Signals)),
+		},
+		[]handler.Condition{
+			handler.NewCond(SessionColumnID, e.Aggregate().ID),
+			handler.NewCond(SessionColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
 func (p *sessionProjection) reduceTokenSet(event eventstore.Event) (*handler.Statement, error) {
 	e, ok := event.(*session.TokenSetEvent)
 	if !ok {
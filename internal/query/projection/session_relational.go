@@ -0,0 +1,325 @@
+package projection
+
+import (
+	"context"
+	"database/sql"
+	"maps"
+	"slices"
+
+	repoDomain "github.com/zitadel/zitadel/backend/v3/domain"
+	"github.com/zitadel/zitadel/backend/v3/storage/database"
+	v3_sql "github.com/zitadel/zitadel/backend/v3/storage/database/dialect/sql"
+	"github.com/zitadel/zitadel/backend/v3/storage/database/repository"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type sessionRelationalProjection struct{}
+
+func newSessionRelationalProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(sessionRelationalProjection))
+}
+
+func (*sessionRelationalProjection) Name() string {
+	return "zitadel.sessions"
+}
+
+func (p *sessionRelationalProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: session.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  session.AddedType,
+					Reduce: p.reduceSessionAdded,
+				},
+				{
+					Event:  session.UserCheckedType,
+					Reduce: p.reduceUserChecked,
+				},
+				{
+					Event:  session.PasswordCheckedType,
+					Reduce: p.reducePasswordChecked,
+				},
+				{
+					Event:  session.IntentCheckedType,
+					Reduce: p.reduceIntentChecked,
+				},
+				{
+					Event:  session.WebAuthNChallengedType,
+					Reduce: p.reduceWebAuthNChallenged,
+				},
+				{
+					Event:  session.WebAuthNCheckedType,
+					Reduce: p.reduceWebAuthNChecked,
+				},
+				{
+					Event:  session.TOTPCheckedType,
+					Reduce: p.reduceTOTPChecked,
+				},
+				{
+					Event:  session.OTPSMSChallengedType,
+					Reduce: p.reduceOTPSMSChallenged,
+				},
+				{
+					Event:  session.OTPSMSCheckedType,
+					Reduce: p.reduceOTPSMSChecked,
+				},
+				{
+					Event:  session.OTPEmailChallengedType,
+					Reduce: p.reduceOTPEmailChallenged,
+				},
+				{
+					Event:  session.OTPEmailCheckedType,
+					Reduce: p.reduceOTPEmailChecked,
+				},
+				{
+					Event:  session.TokenSetType,
+					Reduce: p.reduceTokenSet,
+				},
+				{
+					Event:  session.MetadataSetType,
+					Reduce: p.reduceMetadataSet,
+				},
+				{
+					Event:  session.LifetimeSetType,
+					Reduce: p.reduceLifetimeSet,
+				},
+				{
+					Event:  session.TerminateType,
+					Reduce: p.reduceSessionTerminated,
+				},
+			},
+		},
+	}
+}
+
+func (p *sessionRelationalProjection) reduceSessionAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.AddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-3EYn0EpzRo", "reduce.wrong.event.type %s", session.AddedType)
+	}
+	return handler.NewStatement(e, func(ctx context.Context, ex handler.Executer, _ string) error {
+		tx, ok := ex.(*sql.Tx)
+		if !ok {
+			return zerrors.ThrowInvalidArgumentf(nil, "HANDL-heFH90D5Pk", "reduce.wrong.db.pool %T", ex)
+		}
+		var userAgent *repoDomain.SessionUserAgent
+		if e.UserAgent != nil && !e.UserAgent.IsEmpty() {
+			userAgent = &repoDomain.SessionUserAgent{
+				InstanceID:    e.Aggregate().InstanceID,
+				FingerprintID: e.UserAgent.FingerprintID,
+				Description:   e.UserAgent.Description,
+				IP:            e.UserAgent.IP,
+				Header:        e.UserAgent.Header,
+			}
+		}
+		return repository.SessionRepository().Create(ctx, v3_sql.SQLTx(tx), &repoDomain.Session{
+			InstanceID: e.Aggregate().InstanceID,
+			ID:         e.Aggregate().ID,
+			CreatedAt:  e.CreationDate(),
+			UpdatedAt:  e.CreationDate(),
+			UserAgent:  userAgent,
+		})
+	}), nil
+}
+
+func (p *sessionRelationalProjection) reduceUserChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.UserCheckedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-PGzj4z4HJA", "reduce.wrong.event.type %s", session.UserCheckedType)
+	}
+	repo := repository.SessionRepository()
+	return p.updateSession(e, repo.SetFactor(&repoDomain.SessionFactorUser{
+		UserID:         e.UserID,
+		LastVerifiedAt: e.CheckedAt,
+	})), nil
+}
+
+func (p *sessionRelationalProjection) reducePasswordChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.PasswordCheckedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-yrwGhXyV6F", "reduce.wrong.event.type %s", session.PasswordCheckedType)
+	}
+	repo := repository.SessionRepository()
+	return p.updateSession(e, repo.SetFactor(&repoDomain.SessionFactorPassword{
+		LastVerifiedAt: e.CheckedAt,
+	})), nil
+}
+
+func (p *sessionRelationalProjection) reduceIntentChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.IntentCheckedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-pUmya18DI5", "reduce.wrong.event.type %s", session.IntentCheckedType)
+	}
+	repo := repository.SessionRepository()
+	return p.updateSession(e, repo.SetFactor(&repoDomain.SessionFactorIdentityProviderIntent{
+		LastVerifiedAt: e.CheckedAt,
+	})), nil
+}
+
+func (p *sessionRelationalProjection) reduceWebAuthNChallenged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.WebAuthNChallengedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-L0eIe48nbQ", "reduce.wrong.event.type %s", session.WebAuthNChallengedType)
+	}
+	repo := repository.SessionRepository()
+	return p.updateSession(e, repo.SetChallenge(&repoDomain.SessionChallengePasskey{
+		LastChallengedAt:     e.CreationDate(),
+		Challenge:            e.Challenge,
+		AllowedCredentialIDs: e.AllowedCrentialIDs,
+		UserVerification:     e.UserVerification,
+		RPID:                 e.RPID,
+	})), nil
+}
+
+func (p *sessionRelationalProjection) reduceWebAuthNChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.WebAuthNCheckedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-6Ye6cvY6Fh", "reduce.wrong.event.type %s", session.WebAuthNCheckedType)
+	}
+	repo := repository.SessionRepository()
+	return p.updateSession(e, repo.SetFactor(&repoDomain.SessionFactorPasskey{
+		LastVerifiedAt: e.CheckedAt,
+		UserVerified:   e.UserVerified,
+	})), nil
+}
+
+func (p *sessionRelationalProjection) reduceTOTPChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.TOTPCheckedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-g3sOlsj0DP", "reduce.wrong.event.type %s", session.TOTPCheckedType)
+	}
+	repo := repository.SessionRepository()
+	return p.updateSession(e, repo.SetFactor(&repoDomain.SessionFactorTOTP{
+		LastVerifiedAt: e.CheckedAt,
+	})), nil
+}
+
+func (p *sessionRelationalProjection) reduceOTPSMSChallenged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.OTPSMSChallengedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-JWX3Qcdtyj", "reduce.wrong.event.type %s", session.OTPSMSChallengedType)
+	}
+	repo := repository.SessionRepository()
+	return p.updateSession(e, repo.SetChallenge(&repoDomain.SessionChallengeOTPSMS{
+		LastChallengedAt:  e.CreationDate(),
+		Code:              e.Code,
+		Expiry:            e.Expiry,
+		CodeReturned:      e.CodeReturned,
+		GeneratorID:       e.GeneratorID,
+		TriggeredAtOrigin: e.TriggeredAtOrigin,
+	})), nil
+}
+
+func (p *sessionRelationalProjection) reduceOTPSMSChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.OTPSMSCheckedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-X9sItTUEs7", "reduce.wrong.event.type %s", session.OTPSMSCheckedType)
+	}
+	repo := repository.SessionRepository()
+	return p.updateSession(e, repo.SetFactor(&repoDomain.SessionFactorOTPSMS{
+		LastVerifiedAt: e.CheckedAt,
+	})), nil
+}
+
+func (p *sessionRelationalProjection) reduceOTPEmailChallenged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.OTPEmailChallengedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-UuK3VEOgak", "reduce.wrong.event.type %s", session.OTPEmailChallengedType)
+	}
+	repo := repository.SessionRepository()
+	return p.updateSession(e, repo.SetChallenge(&repoDomain.SessionChallengeOTPEmail{
+		LastChallengedAt:  e.CreationDate(),
+		Code:              e.Code,
+		Expiry:            e.Expiry,
+		CodeReturned:      e.ReturnCode,
+		URLTmpl:           e.URLTmpl,
+		TriggeredAtOrigin: e.TriggeredAtOrigin,
+	})), nil
+}
+
+func (p *sessionRelationalProjection) reduceOTPEmailChecked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.OTPEmailCheckedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Rj2CF8ABcA", "reduce.wrong.event.type %s", session.OTPEmailCheckedType)
+	}
+	repo := repository.SessionRepository()
+	return p.updateSession(e, repo.SetFactor(&repoDomain.SessionFactorOTPEmail{
+		LastVerifiedAt: e.CheckedAt,
+	})), nil
+}
+
+func (p *sessionRelationalProjection) reduceTokenSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.TokenSetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-QFQHd09z5t", "reduce.wrong.event.type %s", session.TokenSetType)
+	}
+	repo := repository.SessionRepository()
+	return p.updateSession(e, repo.SetToken(e.TokenID)), nil
+}
+
+func (p *sessionRelationalProjection) reduceMetadataSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.MetadataSetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-gmweDQXQiN", "reduce.wrong.event.type %s", session.MetadataSetType)
+	}
+	metadata := make([]repoDomain.SessionMetadata, 0, len(e.Metadata))
+	for _, key := range slices.Sorted(maps.Keys(e.Metadata)) {
+		metadata = append(metadata, repoDomain.SessionMetadata{
+			Metadata: repoDomain.Metadata{
+				InstanceID: e.Aggregate().InstanceID,
+				Key:        key,
+				Value:      e.Metadata[key],
+				CreatedAt:  e.CreationDate(),
+				UpdatedAt:  e.CreationDate(),
+			},
+			SessionID: e.Aggregate().ID,
+		})
+	}
+	repo := repository.SessionRepository()
+	return p.updateSession(e, repo.SetMetadata(metadata)), nil
+}
+
+func (p *sessionRelationalProjection) reduceLifetimeSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.LifetimeSetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-m7moKA5KS4", "reduce.wrong.event.type %s", session.LifetimeSetType)
+	}
+	repo := repository.SessionRepository()
+	return p.updateSession(e, repo.SetLifetime(e.Lifetime)), nil
+}
+
+func (p *sessionRelationalProjection) reduceSessionTerminated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*session.TerminateEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-gv6ySo8BjG", "reduce.wrong.event.type %s", session.TerminateType)
+	}
+	return handler.NewStatement(e, func(ctx context.Context, ex handler.Executer, _ string) error {
+		tx, ok := ex.(*sql.Tx)
+		if !ok {
+			return zerrors.ThrowInvalidArgumentf(nil, "HANDL-5kZpYjc2jv", "reduce.wrong.db.pool %T", ex)
+		}
+		repo := repository.SessionRepository()
+		return repo.Delete(ctx, v3_sql.SQLTx(tx),
+			repo.PrimaryKeyCondition(e.Aggregate().InstanceID, e.Aggregate().ID),
+		)
+	}), nil
+}
+
+func (p *sessionRelationalProjection) updateSession(e eventstore.Event, changes ...database.Change) *handler.Statement {
+	return handler.NewStatement(e, func(ctx context.Context, ex handler.Executer, _ string) error {
+		tx, ok := ex.(*sql.Tx)
+		if !ok {
+			return zerrors.ThrowInvalidArgumentf(nil, "HANDL-phWqoSFLkf", "reduce.wrong.db.pool %T", ex)
+		}
+		repo := repository.SessionRepository()
+		_, err := repo.Update(ctx, v3_sql.SQLTx(tx),
+			repo.PrimaryKeyCondition(e.Aggregate().InstanceID, e.Aggregate().ID),
+			append(changes, repo.SetUpdatedAt(e.CreatedAt()))...,
+		)
+		return err
+	})
+}
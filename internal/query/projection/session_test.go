@@ -6,6 +6,7 @@ import (
 
 	"github.com/muhlemmer/gu"
 
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
@@ -232,6 +233,107 @@ func TestSessionProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "instance reducePushChecked",
+			args: args{
+				event: getEvent(testEvent(
+					session.PushCheckedType,
+					session.AggregateType,
+					[]byte(`{
+						"checkedAt": "2023-05-04T00:00:00Z"
+					}`),
+				), eventstore.GenericEventMapper[session.PushCheckedEvent]),
+			},
+			reduce: (&sessionProjection{}).reducePushChecked,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("session"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.sessions8 SET (change_date, sequence, mfa_push_checked_at) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								anyArg{},
+								time.Date(2023, time.May, 4, 0, 0, 0, 0, time.UTC),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceX509Checked",
+			args: args{
+				event: getEvent(testEvent(
+					session.X509CheckedType,
+					session.AggregateType,
+					[]byte(`{
+						"checkedAt": "2023-05-04T00:00:00Z",
+						"fingerprint": "fingerprint"
+					}`),
+				), eventstore.GenericEventMapper[session.X509CheckedEvent]),
+			},
+			reduce: (&sessionProjection{}).reduceX509Checked,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("session"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.sessions8 SET (change_date, sequence, x509_checked_at) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								anyArg{},
+								time.Date(2023, time.May, 4, 0, 0, 0, 0, time.UTC),
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceRiskEvaluated",
+			args: args{
+				event: getEvent(testEvent(
+					session.RiskEvaluatedType,
+					session.AggregateType,
+					[]byte(`{
+						"userID": "user-id",
+						"score": 70,
+						"signals": ["new_country", "impossible_travel"],
+						"action": 2,
+						"ip": "1.0.0.1",
+						"location": {"country": "AU", "city": "South Brisbane", "latitude": -27.4767, "longitude": 153.017}
+					}`),
+				), eventstore.GenericEventMapper[session.RiskEvaluatedEvent]),
+			},
+			reduce: (&sessionProjection{}).reduceRiskEvaluated,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("session"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.sessions8 SET (change_date, sequence, risk_score, risk_action, risk_signals) = ($1, $2, $3, $4, $5) WHERE (id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								anyArg{},
+								uint32(70),
+								domain.RiskActionRequireMFA,
+								database.TextArray[domain.RiskSignal]{domain.RiskSignalNewCountry, domain.RiskSignalImpossibleTravel},
+								"agg-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "instance reduceTokenSet",
 			args: args{
@@ -137,6 +137,18 @@ func (p *userAuthMethodProjection) Reducers() []handler.AggregateReducer {
 					Event:  user.HumanRecoveryCodesRemovedType,
 					Reduce: p.reduceRemoveAuthMethod,
 				},
+				{
+					Event:  user.HumanPushDeviceAddedType,
+					Reduce: p.reduceInitAuthMethod,
+				},
+				{
+					Event:  user.HumanPushDeviceVerifiedType,
+					Reduce: p.reduceActivateEvent,
+				},
+				{
+					Event:  user.HumanPushDeviceRemovedType,
+					Reduce: p.reduceRemoveAuthMethod,
+				},
 			},
 		},
 		{
@@ -162,6 +174,7 @@ func (p *userAuthMethodProjection) Reducers() []handler.AggregateReducer {
 
 func (p *userAuthMethodProjection) reduceInitAuthMethod(event eventstore.Event) (*handler.Statement, error) {
 	tokenID := ""
+	name := ""
 	var rpID *string
 	var methodType domain.UserAuthMethodType
 	switch e := event.(type) {
@@ -175,10 +188,15 @@ func (p *userAuthMethodProjection) reduceInitAuthMethod(event eventstore.Event)
 		rpID = &e.RPID
 	case *user.HumanOTPAddedEvent:
 		methodType = domain.UserAuthMethodTypeTOTP
+		tokenID = e.TOTPID
+		name = e.Name
 	case *user.HumanRecoveryCodesAddedEvent:
 		methodType = domain.UserAuthMethodTypeRecoveryCode
+	case *user.HumanPushDeviceAddedEvent:
+		methodType = domain.UserAuthMethodTypePush
+		tokenID = e.DeviceID
 	default:
-		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-f92f", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanPasswordlessTokenAddedType, user.HumanU2FTokenAddedType, user.HumanMFAOTPAddedType, user.HumanRecoveryCodesAddedType})
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-f92f", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanPasswordlessTokenAddedType, user.HumanU2FTokenAddedType, user.HumanMFAOTPAddedType, user.HumanRecoveryCodesAddedType, user.HumanPushDeviceAddedType})
 	}
 	cols := []handler.Column{
 		handler.NewCol(UserAuthMethodTokenIDCol, tokenID),
@@ -190,7 +208,7 @@ func (p *userAuthMethodProjection) reduceInitAuthMethod(event eventstore.Event)
 		handler.NewCol(UserAuthMethodSequenceCol, event.Sequence()),
 		handler.NewCol(UserAuthMethodStateCol, domain.MFAStateNotReady),
 		handler.NewCol(UserAuthMethodTypeCol, methodType),
-		handler.NewCol(UserAuthMethodNameCol, ""),
+		handler.NewCol(UserAuthMethodNameCol, name),
 	}
 	if rpID != nil {
 		cols = append(cols, handler.NewCol(UserAuthMethodDomainCol, rpID))
@@ -223,18 +241,28 @@ func (p *userAuthMethodProjection) reduceActivateEvent(event eventstore.Event) (
 		name = e.WebAuthNTokenName
 	case *user.HumanOTPVerifiedEvent:
 		methodType = domain.UserAuthMethodTypeTOTP
+		tokenID = e.TOTPID
+		name = e.Name
+	case *user.HumanPushDeviceVerifiedEvent:
+		methodType = domain.UserAuthMethodTypePush
+		tokenID = e.DeviceID
+		name = e.Name
 	default:
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-f92f", "reduce.wrong.event.type %v", []eventstore.EventType{user.HumanPasswordlessTokenAddedType, user.HumanU2FTokenAddedType})
 	}
 
+	cols := []handler.Column{
+		handler.NewCol(UserAuthMethodChangeDateCol, event.CreatedAt()),
+		handler.NewCol(UserAuthMethodSequenceCol, event.Sequence()),
+	}
+	// the name of a TOTP authenticator can already be set on registration
+	if methodType != domain.UserAuthMethodTypeTOTP || name != "" {
+		cols = append(cols, handler.NewCol(UserAuthMethodNameCol, name))
+	}
+	cols = append(cols, handler.NewCol(UserAuthMethodStateCol, domain.MFAStateReady))
 	return handler.NewUpdateStatement(
 		event,
-		[]handler.Column{
-			handler.NewCol(UserAuthMethodChangeDateCol, event.CreatedAt()),
-			handler.NewCol(UserAuthMethodSequenceCol, event.Sequence()),
-			handler.NewCol(UserAuthMethodNameCol, name),
-			handler.NewCol(UserAuthMethodStateCol, domain.MFAStateReady),
-		},
+		cols,
 		[]handler.Condition{
 			handler.NewCond(UserAuthMethodUserIDCol, event.Aggregate().ID),
 			handler.NewCond(UserAuthMethodTypeCol, methodType),
@@ -275,6 +303,8 @@ func (p *userAuthMethodProjection) reduceAddAuthMethod(event eventstore.Event) (
 
 func (p *userAuthMethodProjection) reduceRemoveAuthMethod(event eventstore.Event) (*handler.Statement, error) {
 	var tokenID string
+	// filterTokenID is set if the empty token id identifies a single method as well
+	var filterTokenID bool
 	var methodType domain.UserAuthMethodType
 	switch e := event.(type) {
 	case *user.HumanPasswordlessRemovedEvent:
@@ -285,6 +315,8 @@ func (p *userAuthMethodProjection) reduceRemoveAuthMethod(event eventstore.Event
 		tokenID = e.WebAuthNTokenID
 	case *user.HumanOTPRemovedEvent:
 		methodType = domain.UserAuthMethodTypeTOTP
+		tokenID = e.TOTPID
+		filterTokenID = true
 	case *user.HumanOTPSMSRemovedEvent,
 		*user.HumanPhoneRemovedEvent:
 		methodType = domain.UserAuthMethodTypeOTPSMS
@@ -292,10 +324,14 @@ func (p *userAuthMethodProjection) reduceRemoveAuthMethod(event eventstore.Event
 		methodType = domain.UserAuthMethodTypeOTPEmail
 	case *user.HumanRecoveryCodesRemovedEvent:
 		methodType = domain.UserAuthMethodTypeRecoveryCode
+	case *user.HumanPushDeviceRemovedEvent:
+		methodType = domain.UserAuthMethodTypePush
+		tokenID = e.DeviceID
 	default:
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-f92f", "reduce.wrong.event.type %v",
 			[]eventstore.EventType{user.HumanPasswordlessTokenAddedType, user.HumanU2FTokenAddedType, user.HumanMFAOTPRemovedType,
-				user.HumanOTPSMSRemovedType, user.HumanPhoneRemovedType, user.HumanOTPEmailRemovedType, user.HumanRecoveryCodesRemovedType})
+				user.HumanOTPSMSRemovedType, user.HumanPhoneRemovedType, user.HumanOTPEmailRemovedType, user.HumanRecoveryCodesRemovedType,
+				user.HumanPushDeviceRemovedType})
 	}
 	conditions := []handler.Condition{
 		handler.NewCond(UserAuthMethodUserIDCol, event.Aggregate().ID),
@@ -303,7 +339,7 @@ func (p *userAuthMethodProjection) reduceRemoveAuthMethod(event eventstore.Event
 		handler.NewCond(UserAuthMethodResourceOwnerCol, event.Aggregate().ResourceOwner),
 		handler.NewCond(UserAuthMethodInstanceIDCol, event.Aggregate().InstanceID),
 	}
-	if tokenID != "" {
+	if tokenID != "" || filterTokenID {
 		conditions = append(conditions, handler.NewCond(UserAuthMethodTokenIDCol, tokenID))
 	}
 	return handler.NewDeleteStatement(
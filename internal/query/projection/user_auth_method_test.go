@@ -177,6 +177,44 @@ func TestUserAuthMethodProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceAddedTOTP named",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanMFAOTPAddedType,
+						user.AggregateType,
+						[]byte(`{
+						"totpId": "totp-id",
+						"name": "name"
+					}`),
+					), user.HumanOTPAddedEventMapper),
+			},
+			reduce: (&userAuthMethodProjection{}).reduceInitAuthMethod,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_auth_methods5 (token_id, creation_date, change_date, resource_owner, instance_id, user_id, sequence, state, method_type, name) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT (instance_id, user_id, method_type, token_id) DO UPDATE SET (creation_date, change_date, resource_owner, sequence, state, name) = (projections.user_auth_methods5.creation_date, EXCLUDED.change_date, EXCLUDED.resource_owner, EXCLUDED.sequence, EXCLUDED.state, EXCLUDED.name)",
+							expectedArgs: []interface{}{
+								"totp-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								"instance-id",
+								"agg-id",
+								uint64(15),
+								domain.MFAStateNotReady,
+								domain.UserAuthMethodTypeTOTP,
+								"name",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceVerifiedPasswordless",
 			args: args{
@@ -269,11 +307,10 @@ func TestUserAuthMethodProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.user_auth_methods5 SET (change_date, sequence, name, state) = ($1, $2, $3, $4) WHERE (user_id = $5) AND (method_type = $6) AND (resource_owner = $7) AND (token_id = $8) AND (instance_id = $9)",
+							expectedStmt: "UPDATE projections.user_auth_methods5 SET (change_date, sequence, state) = ($1, $2, $3) WHERE (user_id = $4) AND (method_type = $5) AND (resource_owner = $6) AND (token_id = $7) AND (instance_id = $8)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
-								"",
 								domain.MFAStateReady,
 								"agg-id",
 								domain.UserAuthMethodTypeTOTP,
@@ -286,6 +323,43 @@ func TestUserAuthMethodProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceVerifiedTOTP named",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanMFAOTPVerifiedType,
+						user.AggregateType,
+						[]byte(`{
+						"totpId": "totp-id",
+						"name": "name"
+					}`),
+					), user.HumanOTPVerifiedEventMapper),
+			},
+			reduce: (&userAuthMethodProjection{}).reduceActivateEvent,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_auth_methods5 SET (change_date, sequence, name, state) = ($1, $2, $3, $4) WHERE (user_id = $5) AND (method_type = $6) AND (resource_owner = $7) AND (token_id = $8) AND (instance_id = $9)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"name",
+								domain.MFAStateReady,
+								"agg-id",
+								domain.UserAuthMethodTypeTOTP,
+								"ro-id",
+								"totp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "reduceAddedOTPSMS",
 			args: args{
@@ -432,12 +506,44 @@ func TestUserAuthMethodProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "DELETE FROM projections.user_auth_methods5 WHERE (user_id = $1) AND (method_type = $2) AND (resource_owner = $3) AND (instance_id = $4)",
+							expectedStmt: "DELETE FROM projections.user_auth_methods5 WHERE (user_id = $1) AND (method_type = $2) AND (resource_owner = $3) AND (instance_id = $4) AND (token_id = $5)",
 							expectedArgs: []interface{}{
 								"agg-id",
 								domain.UserAuthMethodTypeTOTP,
 								"ro-id",
 								"instance-id",
+								"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemoveTOTP named",
+			args: args{
+				event: getEvent(testEvent(
+					user.HumanMFAOTPRemovedType,
+					user.AggregateType,
+					[]byte(`{
+						"totpId": "totp-id"
+					}`),
+				), user.HumanOTPRemovedEventMapper),
+			},
+			reduce: (&userAuthMethodProjection{}).reduceRemoveAuthMethod,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_auth_methods5 WHERE (user_id = $1) AND (method_type = $2) AND (resource_owner = $3) AND (instance_id = $4) AND (token_id = $5)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								domain.UserAuthMethodTypeTOTP,
+								"ro-id",
+								"instance-id",
+								"totp-id",
 							},
 						},
 					},
@@ -593,6 +699,111 @@ func TestUserAuthMethodProjection_reduces(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reduceAddedPushDevice",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanPushDeviceAddedType,
+						user.AggregateType,
+						[]byte(`{
+						"deviceId": "device-id"
+					}`),
+					), eventstore.GenericEventMapper[user.HumanPushDeviceAddedEvent]),
+			},
+			reduce: (&userAuthMethodProjection{}).reduceInitAuthMethod,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.user_auth_methods5 (token_id, creation_date, change_date, resource_owner, instance_id, user_id, sequence, state, method_type, name) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT (instance_id, user_id, method_type, token_id) DO UPDATE SET (creation_date, change_date, resource_owner, sequence, state, name) = (projections.user_auth_methods5.creation_date, EXCLUDED.change_date, EXCLUDED.resource_owner, EXCLUDED.sequence, EXCLUDED.state, EXCLUDED.name)",
+							expectedArgs: []interface{}{
+								"device-id",
+								anyArg{},
+								anyArg{},
+								"ro-id",
+								"instance-id",
+								"agg-id",
+								uint64(15),
+								domain.MFAStateNotReady,
+								domain.UserAuthMethodTypePush,
+								"",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceVerifiedPushDevice",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.HumanPushDeviceVerifiedType,
+						user.AggregateType,
+						[]byte(`{
+						"deviceId": "device-id",
+						"name": "phone"
+					}`),
+					), eventstore.GenericEventMapper[user.HumanPushDeviceVerifiedEvent]),
+			},
+			reduce: (&userAuthMethodProjection{}).reduceActivateEvent,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.user_auth_methods5 SET (change_date, sequence, name, state) = ($1, $2, $3, $4) WHERE (user_id = $5) AND (method_type = $6) AND (resource_owner = $7) AND (token_id = $8) AND (instance_id = $9)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"phone",
+								domain.MFAStateReady,
+								"agg-id",
+								domain.UserAuthMethodTypePush,
+								"ro-id",
+								"device-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRemovePushDevice",
+			args: args{
+				event: getEvent(testEvent(
+					user.HumanPushDeviceRemovedType,
+					user.AggregateType,
+					[]byte(`{
+						"deviceId": "device-id"
+					}`),
+				), eventstore.GenericEventMapper[user.HumanPushDeviceRemovedEvent]),
+			},
+			reduce: (&userAuthMethodProjection{}).reduceRemoveAuthMethod,
+			want: wantReduce{
+				aggregateType: user.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.user_auth_methods5 WHERE (user_id = $1) AND (method_type = $2) AND (resource_owner = $3) AND (instance_id = $4) AND (token_id = $5)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								domain.UserAuthMethodTypePush,
+								"ro-id",
+								"instance-id",
+								"device-id",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:   "reduceUserRemoved",
 			reduce: (&userAuthMethodProjection{}).reduceUserRemoved,
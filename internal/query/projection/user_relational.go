@@ -0,0 +1,719 @@
+package projection
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	repoDomain "github.com/zitadel/zitadel/backend/v3/domain"
+	"github.com/zitadel/zitadel/backend/v3/storage/database"
+	v3_sql "github.com/zitadel/zitadel/backend/v3/storage/database/dialect/sql"
+	"github.com/zitadel/zitadel/backend/v3/storage/database/repository"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type userRelationalProjection struct{}
+
+func newUserRelationalProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(userRelationalProjection))
+}
+
+func (*userRelationalProjection) Name() string {
+	return "zitadel.users"
+}
+
+func (p *userRelationalProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserV1AddedType,
+					Reduce: p.reduceHumanAdded,
+				},
+				{
+					Event:  user.HumanAddedType,
+					Reduce: p.reduceHumanAdded,
+				},
+				{
+					Event:  user.UserV1RegisteredType,
+					Reduce: p.reduceHumanRegistered,
+				},
+				{
+					Event:  user.HumanRegisteredType,
+					Reduce: p.reduceHumanRegistered,
+				},
+				{
+					Event:  user.HumanInitialCodeAddedType,
+					Reduce: p.reduceHumanInitCodeAdded,
+				},
+				{
+					Event:  user.UserV1InitialCodeAddedType,
+					Reduce: p.reduceHumanInitCodeAdded,
+				},
+				{
+					Event:  user.HumanInitializedCheckSucceededType,
+					Reduce: p.reduceHumanInitCodeSucceeded,
+				},
+				{
+					Event:  user.UserV1InitializedCheckSucceededType,
+					Reduce: p.reduceHumanInitCodeSucceeded,
+				},
+				{
+					Event:  user.UserLockedType,
+					Reduce: p.reduceUserLocked,
+				},
+				{
+					Event:  user.UserUnlockedType,
+					Reduce: p.reduceUserUnlocked,
+				},
+				{
+					Event:  user.UserDeactivatedType,
+					Reduce: p.reduceUserDeactivated,
+				},
+				{
+					Event:  user.UserReactivatedType,
+					Reduce: p.reduceUserReactivated,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+				{
+					Event:  user.UserUserNameChangedType,
+					Reduce: p.reduceUserNameChanged,
+				},
+				{
+					Event:  user.UserDomainClaimedType,
+					Reduce: p.reduceDomainClaimed,
+				},
+				{
+					Event:  user.HumanProfileChangedType,
+					Reduce: p.reduceHumanProfileChanged,
+				},
+				{
+					Event:  user.UserV1ProfileChangedType,
+					Reduce: p.reduceHumanProfileChanged,
+				},
+				{
+					Event:  user.HumanPhoneChangedType,
+					Reduce: p.reduceHumanPhoneChanged,
+				},
+				{
+					Event:  user.UserV1PhoneChangedType,
+					Reduce: p.reduceHumanPhoneChanged,
+				},
+				{
+					Event:  user.HumanPhoneRemovedType,
+					Reduce: p.reduceHumanPhoneRemoved,
+				},
+				{
+					Event:  user.UserV1PhoneRemovedType,
+					Reduce: p.reduceHumanPhoneRemoved,
+				},
+				{
+					Event:  user.HumanPhoneVerifiedType,
+					Reduce: p.reduceHumanPhoneVerified,
+				},
+				{
+					Event:  user.UserV1PhoneVerifiedType,
+					Reduce: p.reduceHumanPhoneVerified,
+				},
+				{
+					Event:  user.HumanEmailChangedType,
+					Reduce: p.reduceHumanEmailChanged,
+				},
+				{
+					Event:  user.UserV1EmailChangedType,
+					Reduce: p.reduceHumanEmailChanged,
+				},
+				{
+					Event:  user.HumanEmailVerifiedType,
+					Reduce: p.reduceHumanEmailVerified,
+				},
+				{
+					Event:  user.UserV1EmailVerifiedType,
+					Reduce: p.reduceHumanEmailVerified,
+				},
+				{
+					Event:  user.HumanAvatarAddedType,
+					Reduce: p.reduceHumanAvatarAdded,
+				},
+				{
+					Event:  user.HumanAvatarRemovedType,
+					Reduce: p.reduceHumanAvatarRemoved,
+				},
+				{
+					Event:  user.HumanPasswordChangedType,
+					Reduce: p.reduceHumanPasswordChanged,
+				},
+				{
+					Event:  user.UserV1PasswordChangedType,
+					Reduce: p.reduceHumanPasswordChanged,
+				},
+				{
+					Event:  user.HumanPasswordCheckSucceededType,
+					Reduce: p.reduceHumanPasswordCheckSucceeded,
+				},
+				{
+					Event:  user.UserV1PasswordCheckSucceededType,
+					Reduce: p.reduceHumanPasswordCheckSucceeded,
+				},
+				{
+					Event:  user.HumanPasswordCheckFailedType,
+					Reduce: p.reduceHumanPasswordCheckFailed,
+				},
+				{
+					Event:  user.UserV1PasswordCheckFailedType,
+					Reduce: p.reduceHumanPasswordCheckFailed,
+				},
+				{
+					Event:  user.UserV1MFAOTPVerifiedType,
+					Reduce: p.reduceUnsetMFAInitSkipped,
+				},
+				{
+					Event:  user.HumanMFAOTPVerifiedType,
+					Reduce: p.reduceUnsetMFAInitSkipped,
+				},
+				{
+					Event:  user.HumanOTPSMSAddedType,
+					Reduce: p.reduceUnsetMFAInitSkipped,
+				},
+				{
+					Event:  user.HumanOTPEmailAddedType,
+					Reduce: p.reduceUnsetMFAInitSkipped,
+				},
+				{
+					Event:  user.HumanU2FTokenVerifiedType,
+					Reduce: p.reduceUnsetMFAInitSkipped,
+				},
+				{
+					Event:  user.HumanPasswordlessTokenVerifiedType,
+					Reduce: p.reduceUnsetMFAInitSkipped,
+				},
+				{
+					Event:  user.UserV1MFAInitSkippedType,
+					Reduce: p.reduceMFAInitSkipped,
+				},
+				{
+					Event:  user.HumanMFAInitSkippedType,
+					Reduce: p.reduceMFAInitSkipped,
+				},
+				{
+					Event:  user.MachineAddedEventType,
+					Reduce: p.reduceMachineAdded,
+				},
+				{
+					Event:  user.MachineChangedEventType,
+					Reduce: p.reduceMachineChanged,
+				},
+				{
+					Event:  user.MachineSecretSetType,
+					Reduce: p.reduceMachineSecretSet,
+				},
+				{
+					Event:  user.MachineSecretHashUpdatedType,
+					Reduce: p.reduceMachineSecretHashUpdated,
+				},
+				{
+					Event:  user.MachineSecretRemovedType,
+					Reduce: p.reduceMachineSecretRemoved,
+				},
+				{
+					Event:  user.MetadataSetType,
+					Reduce: p.reduceMetadataSet,
+				},
+				{
+					Event:  user.MetadataRemovedType,
+					Reduce: p.reduceMetadataRemoved,
+				},
+				{
+					Event:  user.MetadataRemovedAllType,
+					Reduce: p.reduceMetadataRemovedAll,
+				},
+			},
+		},
+	}
+}
+
+func (p *userRelationalProjection) reduceHumanAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Q2jzOOQwVH", "reduce.wrong.event.type %s", user.HumanAddedType)
+	}
+	return p.createHuman(e, &repoDomain.HumanUser{
+		FirstName:         e.FirstName,
+		LastName:          e.LastName,
+		Nickname:          e.NickName,
+		DisplayName:       e.DisplayName,
+		PreferredLanguage: e.PreferredLanguage,
+		Gender:            repoDomain.HumanGender(e.Gender),
+		Email: repoDomain.HumanEmail{
+			Address: string(e.EmailAddress),
+		},
+		Phone: humanPhone(e.PhoneNumber),
+		Password: repoDomain.HumanPassword{
+			Password:         crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash),
+			IsChangeRequired: e.ChangeRequired,
+		},
+	}, e.UserName), nil
+}
+
+func (p *userRelationalProjection) reduceHumanRegistered(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanRegisteredEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-6WItu4v8It", "reduce.wrong.event.type %s", user.HumanRegisteredType)
+	}
+	return p.createHuman(e, &repoDomain.HumanUser{
+		FirstName:         e.FirstName,
+		LastName:          e.LastName,
+		Nickname:          e.NickName,
+		DisplayName:       e.DisplayName,
+		PreferredLanguage: e.PreferredLanguage,
+		Gender:            repoDomain.HumanGender(e.Gender),
+		Email: repoDomain.HumanEmail{
+			Address: string(e.EmailAddress),
+		},
+		Phone: humanPhone(e.PhoneNumber),
+		Password: repoDomain.HumanPassword{
+			Password:         crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash),
+			IsChangeRequired: e.ChangeRequired,
+		},
+	}, e.UserName), nil
+}
+
+func (p *userRelationalProjection) createHuman(e eventstore.Event, human *repoDomain.HumanUser, username string) *handler.Statement {
+	return handler.NewStatement(e, func(ctx context.Context, ex handler.Executer, _ string) error {
+		tx, ok := ex.(*sql.Tx)
+		if !ok {
+			return zerrors.ThrowInvalidArgumentf(nil, "HANDL-rT0A99fNHL", "reduce.wrong.db.pool %T", ex)
+		}
+		return repository.UserRepository().Create(ctx, v3_sql.SQLTx(tx), &repoDomain.User{
+			InstanceID:     e.Aggregate().InstanceID,
+			OrganizationID: e.Aggregate().ResourceOwner,
+			ID:             e.Aggregate().ID,
+			Username:       username,
+			State:          repoDomain.UserStateActive,
+			CreatedAt:      e.CreatedAt(),
+			UpdatedAt:      e.CreatedAt(),
+			Human:          human,
+		})
+	})
+}
+
+func humanPhone(number domain.PhoneNumber) *repoDomain.HumanPhone {
+	if number == "" {
+		return nil
+	}
+	return &repoDomain.HumanPhone{Number: string(number)}
+}
+
+func (p *userRelationalProjection) reduceHumanInitCodeAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanInitialCodeAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-BGPZFojpz9", "reduce.wrong.event.type %s", user.HumanInitialCodeAddedType)
+	}
+	repo := repository.UserRepository()
+	return p.updateHuman(e, repo.SetState(repoDomain.UserStateInitial)), nil
+}
+
+func (p *userRelationalProjection) reduceHumanInitCodeSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanInitializedCheckSucceededEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-MIiTkLjvGS", "reduce.wrong.event.type %s", user.HumanInitializedCheckSucceededType)
+	}
+	repo := repository.UserRepository()
+	return p.updateHuman(e, repo.SetState(repoDomain.UserStateActive)), nil
+}
+
+func (p *userRelationalProjection) reduceUserLocked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserLockedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-tcxUYASkuU", "reduce.wrong.event.type %s", user.UserLockedType)
+	}
+	repo := repository.UserRepository()
+	return p.updateUser(e, repo.SetState(repoDomain.UserStateLocked)), nil
+}
+
+func (p *userRelationalProjection) reduceUserUnlocked(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserUnlockedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-N2rs1LNtDU", "reduce.wrong.event.type %s", user.UserUnlockedType)
+	}
+	repo := repository.UserRepository()
+	return p.updateUser(e, repo.SetState(repoDomain.UserStateActive)), nil
+}
+
+func (p *userRelationalProjection) reduceUserDeactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserDeactivatedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-PMTUVD7awC", "reduce.wrong.event.type %s", user.UserDeactivatedType)
+	}
+	repo := repository.UserRepository()
+	return p.updateUser(e, repo.SetState(repoDomain.UserStateInactive)), nil
+}
+
+func (p *userRelationalProjection) reduceUserReactivated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserReactivatedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-IzSdvISm2g", "reduce.wrong.event.type %s", user.UserReactivatedType)
+	}
+	repo := repository.UserRepository()
+	return p.updateUser(e, repo.SetState(repoDomain.UserStateActive)), nil
+}
+
+func (p *userRelationalProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UserRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-NeuZLdmg3K", "reduce.wrong.event.type %s", user.UserRemovedType)
+	}
+	return handler.NewStatement(e, func(ctx context.Context, ex handler.Executer, _ string) error {
+		tx, ok := ex.(*sql.Tx)
+		if !ok {
+			return zerrors.ThrowInvalidArgumentf(nil, "HANDL-FI8hZf0RHp", "reduce.wrong.db.pool %T", ex)
+		}
+		repo := repository.UserRepository()
+		_, err := repo.Delete(ctx, v3_sql.SQLTx(tx),
+			repo.PrimaryKeyCondition(e.Aggregate().InstanceID, e.Aggregate().ID),
+		)
+		return err
+	}), nil
+}
+
+func (p *userRelationalProjection) reduceUserNameChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.UsernameChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-F2l7g0Aspa", "reduce.wrong.event.type %s", user.UserUserNameChangedType)
+	}
+	repo := repository.UserRepository()
+	return p.updateUser(e, repo.SetUsername(e.UserName)), nil
+}
+
+func (p *userRelationalProjection) reduceDomainClaimed(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.DomainClaimedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Vf81D9IUTq", "reduce.wrong.event.type %s", user.UserDomainClaimedType)
+	}
+	repo := repository.UserRepository()
+	return p.updateUser(e, repo.SetUsername(e.UserName)), nil
+}
+
+func (p *userRelationalProjection) reduceHumanProfileChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanProfileChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-O9yTF84fqR", "reduce.wrong.event.type %s", user.HumanProfileChangedType)
+	}
+	repo := repository.UserRepository().Human()
+	changes := make([]database.Change, 0, 6)
+	if e.FirstName != "" {
+		changes = append(changes, repo.SetFirstName(e.FirstName))
+	}
+	if e.LastName != "" {
+		changes = append(changes, repo.SetLastName(e.LastName))
+	}
+	if e.NickName != nil {
+		changes = append(changes, repo.SetNickname(*e.NickName))
+	}
+	if e.DisplayName != nil {
+		changes = append(changes, repo.SetDisplayName(*e.DisplayName))
+	}
+	if e.PreferredLanguage != nil {
+		changes = append(changes, repo.SetPreferredLanguage(*e.PreferredLanguage))
+	}
+	if e.Gender != nil {
+		changes = append(changes, repo.SetGender(repoDomain.HumanGender(*e.Gender)))
+	}
+	return p.updateHuman(e, changes...), nil
+}
+
+func (p *userRelationalProjection) reduceHumanPhoneChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanPhoneChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-nxbPr6mxWo", "reduce.wrong.event.type %s", user.HumanPhoneChangedType)
+	}
+	repo := repository.UserRepository().Human()
+	return p.updateHuman(e, repo.SetPhone(string(e.PhoneNumber))), nil
+}
+
+func (p *userRelationalProjection) reduceHumanPhoneRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanPhoneRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-ZesJoxYUsv", "reduce.wrong.event.type %s", user.HumanPhoneRemovedType)
+	}
+	repo := repository.UserRepository().Human()
+	return p.updateHuman(e, repo.RemovePhone()), nil
+}
+
+func (p *userRelationalProjection) reduceHumanPhoneVerified(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanPhoneVerifiedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Yx80nwj1jC", "reduce.wrong.event.type %s", user.HumanPhoneVerifiedType)
+	}
+	repo := repository.UserRepository().Human()
+	return p.updateHuman(e, repo.VerifyPhone(e.CreationDate())), nil
+}
+
+func (p *userRelationalProjection) reduceHumanEmailChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanEmailChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-V0p26npzUv", "reduce.wrong.event.type %s", user.HumanEmailChangedType)
+	}
+	repo := repository.UserRepository().Human()
+	return p.updateHuman(e, repo.SetEmail(string(e.EmailAddress))), nil
+}
+
+func (p *userRelationalProjection) reduceHumanEmailVerified(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanEmailVerifiedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-sKJ2E3mBOZ", "reduce.wrong.event.type %s", user.HumanEmailVerifiedType)
+	}
+	repo := repository.UserRepository().Human()
+	return p.updateHuman(e, repo.VerifyEmail(e.CreationDate())), nil
+}
+
+func (p *userRelationalProjection) reduceHumanAvatarAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanAvatarAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-EtW9FWJBTf", "reduce.wrong.event.type %s", user.HumanAvatarAddedType)
+	}
+	repo := repository.UserRepository().Human()
+	return p.updateHuman(e, repo.SetAvatarKey(&e.StoreKey)), nil
+}
+
+func (p *userRelationalProjection) reduceHumanAvatarRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanAvatarRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-F5aV7RNzYH", "reduce.wrong.event.type %s", user.HumanAvatarRemovedType)
+	}
+	repo := repository.UserRepository().Human()
+	return p.updateHuman(e, repo.SetAvatarKey(nil)), nil
+}
+
+func (p *userRelationalProjection) reduceHumanPasswordChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanPasswordChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-w0R7noFm2F", "reduce.wrong.event.type %s", user.HumanPasswordChangedType)
+	}
+	repo := repository.UserRepository().Human()
+	return p.updateHuman(e,
+		repo.SetPassword(crypto.SecretOrEncodedHash(e.Secret, e.EncodedHash)),
+		repo.SetPasswordChangeRequired(e.ChangeRequired),
+	), nil
+}
+
+func (p *userRelationalProjection) reduceHumanPasswordCheckSucceeded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanPasswordCheckSucceededEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-YmrLXE46D6", "reduce.wrong.event.type %s", user.HumanPasswordCheckSucceededType)
+	}
+	repo := repository.UserRepository().Human()
+	return p.updateHuman(e, repo.CheckPassword(&repoDomain.CheckTypeSucceeded{SucceededAt: e.CreationDate()})), nil
+}
+
+func (p *userRelationalProjection) reduceHumanPasswordCheckFailed(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanPasswordCheckFailedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-dP3RjkkG5b", "reduce.wrong.event.type %s", user.HumanPasswordCheckFailedType)
+	}
+	repo := repository.UserRepository().Human()
+	return p.updateHuman(e, repo.CheckPassword(&repoDomain.CheckTypeFailed{FailedAt: e.CreationDate()})), nil
+}
+
+func (p *userRelationalProjection) reduceMFAInitSkipped(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.HumanMFAInitSkippedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Hpv65MrA96", "reduce.wrong.event.type %s", user.HumanMFAInitSkippedType)
+	}
+	repo := repository.UserRepository().Human()
+	return p.updateHuman(e, repo.SetMultifactorInitializationSkippedAt(e.CreationDate())), nil
+}
+
+func (p *userRelationalProjection) reduceUnsetMFAInitSkipped(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *user.HumanOTPVerifiedEvent,
+		*user.HumanOTPSMSAddedEvent,
+		*user.HumanOTPEmailAddedEvent,
+		*user.HumanU2FVerifiedEvent,
+		*user.HumanPasswordlessVerifiedEvent:
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-g6RosHmCjK", "reduce.wrong.event.type %v", []eventstore.EventType{
+			user.HumanMFAOTPVerifiedType,
+			user.HumanOTPSMSAddedType,
+			user.HumanOTPEmailAddedType,
+			user.HumanU2FTokenVerifiedType,
+			user.HumanPasswordlessTokenVerifiedType,
+		})
+	}
+	repo := repository.UserRepository().Human()
+	return p.updateHuman(event, repo.SetMultifactorInitializationSkippedAt(time.Time{})), nil
+}
+
+func (p *userRelationalProjection) reduceMachineAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.MachineAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-d9MPZJx1C3", "reduce.wrong.event.type %s", user.MachineAddedEventType)
+	}
+	return handler.NewStatement(e, func(ctx context.Context, ex handler.Executer, _ string) error {
+		tx, ok := ex.(*sql.Tx)
+		if !ok {
+			return zerrors.ThrowInvalidArgumentf(nil, "HANDL-QEtge4qpGn", "reduce.wrong.db.pool %T", ex)
+		}
+		return repository.UserRepository().Create(ctx, v3_sql.SQLTx(tx), &repoDomain.User{
+			InstanceID:     e.Aggregate().InstanceID,
+			OrganizationID: e.Aggregate().ResourceOwner,
+			ID:             e.Aggregate().ID,
+			Username:       e.UserName,
+			State:          repoDomain.UserStateActive,
+			CreatedAt:      e.CreationDate(),
+			UpdatedAt:      e.CreationDate(),
+			Machine: &repoDomain.MachineUser{
+				Name:            e.Name,
+				Description:     e.Description,
+				AccessTokenType: accessTokenType(e.AccessTokenType),
+			},
+		})
+	}), nil
+}
+
+func (p *userRelationalProjection) reduceMachineChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.MachineChangedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-wWeNF2Why5", "reduce.wrong.event.type %s", user.MachineChangedEventType)
+	}
+	repo := repository.UserRepository().Machine()
+	changes := make([]database.Change, 0, 3)
+	if e.Name != nil {
+		changes = append(changes, repo.SetName(*e.Name))
+	}
+	if e.Description != nil {
+		changes = append(changes, repo.SetDescription(*e.Description))
+	}
+	if e.AccessTokenType != nil {
+		changes = append(changes, repo.SetAccessTokenType(accessTokenType(*e.AccessTokenType)))
+	}
+	return p.updateMachine(e, changes...), nil
+}
+
+func accessTokenType(tokenType domain.OIDCTokenType) repoDomain.PersonalAccessTokenType {
+	switch tokenType {
+	case domain.OIDCTokenTypeBearer:
+		return repoDomain.PersonalAccessTokenTypeBearer
+	case domain.OIDCTokenTypeJWT:
+		return repoDomain.PersonalAccessTokenTypeJWT
+	}
+	return repoDomain.PersonalAccessTokenTypeUnspecified
+}
+
+func (p *userRelationalProjection) reduceMachineSecretSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.MachineSecretSetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-en0gpXMbTm", "reduce.wrong.event.type %s", user.MachineSecretSetType)
+	}
+	repo := repository.UserRepository().Machine()
+	secret := crypto.SecretOrEncodedHash(e.ClientSecret, e.HashedSecret)
+	return p.updateMachine(e, repo.SetSecret(&secret)), nil
+}
+
+func (p *userRelationalProjection) reduceMachineSecretHashUpdated(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.MachineSecretHashUpdatedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-zU1zJgZ54a", "reduce.wrong.event.type %s", user.MachineSecretHashUpdatedType)
+	}
+	repo := repository.UserRepository().Machine()
+	return p.updateMachine(e, repo.SetSecret(&e.HashedSecret)), nil
+}
+
+func (p *userRelationalProjection) reduceMachineSecretRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.MachineSecretRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-i8fVQyizp0", "reduce.wrong.event.type %s", user.MachineSecretRemovedType)
+	}
+	repo := repository.UserRepository().Machine()
+	return p.updateMachine(e, repo.SetSecret(nil)), nil
+}
+
+func (p *userRelationalProjection) reduceMetadataSet(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.MetadataSetEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-qSdyFy31bJ", "reduce.wrong.event.type %s", user.MetadataSetType)
+	}
+	repo := repository.UserRepository()
+	return p.updateUser(e, repo.AddMetadata(&repoDomain.Metadata{
+		InstanceID: e.Aggregate().InstanceID,
+		Key:        e.Key,
+		Value:      e.Value,
+		CreatedAt:  e.CreationDate(),
+		UpdatedAt:  e.CreationDate(),
+	})), nil
+}
+
+func (p *userRelationalProjection) reduceMetadataRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.MetadataRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-FDcFvxuh7I", "reduce.wrong.event.type %s", user.MetadataRemovedType)
+	}
+	repo := repository.UserRepository()
+	return p.updateUser(e, repo.RemoveMetadata(repo.MetadataKeyCondition(database.TextOperationEqual, e.Key))), nil
+}
+
+func (p *userRelationalProjection) reduceMetadataRemovedAll(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*user.MetadataRemovedAllEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-V385haE5Pa", "reduce.wrong.event.type %s", user.MetadataRemovedAllType)
+	}
+	repo := repository.UserRepository()
+	return p.updateUser(e, repo.RemoveMetadata(nil)), nil
+}
+
+// updateUser updates the user of the event regardless of its type.
+func (p *userRelationalProjection) updateUser(e eventstore.Event, changes ...database.Change) *handler.Statement {
+	return handler.NewStatement(e, func(ctx context.Context, ex handler.Executer, _ string) error {
+		tx, ok := ex.(*sql.Tx)
+		if !ok {
+			return zerrors.ThrowInvalidArgumentf(nil, "HANDL-fZRNeB8bWn", "reduce.wrong.db.pool %T", ex)
+		}
+		repo := repository.UserRepository()
+		_, err := repo.Update(ctx, v3_sql.SQLTx(tx),
+			repo.PrimaryKeyCondition(e.Aggregate().InstanceID, e.Aggregate().ID),
+			append(changes, repo.SetUpdatedAt(e.CreatedAt()))...,
+		)
+		return err
+	})
+}
+
+// updateHuman updates the user of the event if it is a human.
+func (p *userRelationalProjection) updateHuman(e eventstore.Event, changes ...database.Change) *handler.Statement {
+	return handler.NewStatement(e, func(ctx context.Context, ex handler.Executer, _ string) error {
+		tx, ok := ex.(*sql.Tx)
+		if !ok {
+			return zerrors.ThrowInvalidArgumentf(nil, "HANDL-m5le33N0Ze", "reduce.wrong.db.pool %T", ex)
+		}
+		repo := repository.UserRepository()
+		_, err := repo.Human().Update(ctx, v3_sql.SQLTx(tx),
+			repo.PrimaryKeyCondition(e.Aggregate().InstanceID, e.Aggregate().ID),
+			append(changes, repo.SetUpdatedAt(e.CreatedAt()))...,
+		)
+		return err
+	})
+}
+
+// updateMachine updates the user of the event if it is a machine.
+func (p *userRelationalProjection) updateMachine(e eventstore.Event, changes ...database.Change) *handler.Statement {
+	return handler.NewStatement(e, func(ctx context.Context, ex handler.Executer, _ string) error {
+		tx, ok := ex.(*sql.Tx)
+		if !ok {
+			return zerrors.ThrowInvalidArgumentf(nil, "HANDL-5tRSyGeJQC", "reduce.wrong.db.pool %T", ex)
+		}
+		repo := repository.UserRepository()
+		_, err := repo.Machine().Update(ctx, v3_sql.SQLTx(tx),
+			repo.PrimaryKeyCondition(e.Aggregate().InstanceID, e.Aggregate().ID),
+			append(changes, repo.SetUpdatedAt(e.CreatedAt()))...,
+		)
+		return err
+	})
+}
@@ -23,6 +23,10 @@ import (
 	es_v4 "github.com/zitadel/zitadel/internal/v2/eventstore"
 )
 
+// replicaPositionQuery returns the latest position of all projections.
+// It is used to check the staleness of the read replicas, see [database.DB.Reader].
+const replicaPositionQuery = "SELECT MAX(position)::DOUBLE PRECISION FROM projections.current_states"
+
 type Queries struct {
 	eventstore   *eventstore.Eventstore
 	eventStoreV4 es_v4.Querier
@@ -87,6 +91,7 @@ func StartQueries(
 	}
 
 	repo.checkPermission = permissionCheck(repo)
+	querySqlClient.StartReplicaCheck(ctx, replicaPositionQuery)
 
 	projections.ActiveInstancer = repo
 	err = projection.Create(ctx, projectionSqlClient, es, projections, keyEncryptionAlgorithm, certEncryptionAlgorithm, systemAPIUsers)
@@ -111,6 +111,10 @@ func pushPeriodStart(from time.Time, interval time.Duration, now time.Time) time
 	if now.IsZero() {
 		now = time.Now()
 	}
+	// quotas counting existing resources don't reset
+	if interval <= 0 {
+		return from
+	}
 	for {
 		next := from.Add(interval)
 		if next.After(now) {
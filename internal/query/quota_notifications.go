@@ -9,6 +9,7 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/repository/quota"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -65,21 +66,13 @@ func (q *Queries) GetDueQuotaNotifications(ctx context.Context, instanceID strin
 				QuotaNotificationColumnInstanceID.identifier(): instanceID,
 				QuotaNotificationColumnUnit.identifier():       unit,
 			},
-			sq.Or{
-				// If the relative usage is greater than the next due threshold in the current period, it's clear we can notify
-				sq.And{
-					sq.Eq{QuotaNotificationColumnLatestDuePeriodStart.identifier(): periodStart},
-					sq.LtOrEq{QuotaNotificationColumnNextDueThreshold.identifier(): usedRel},
-				},
-				// In case we haven't seen a due notification for this quota period, we compare against the configured percent
-				sq.And{
-					sq.Or{
-						sq.Expr(QuotaNotificationColumnLatestDuePeriodStart.identifier() + " IS NULL"),
-						sq.NotEq{QuotaNotificationColumnLatestDuePeriodStart.identifier(): periodStart},
-					},
-					sq.LtOrEq{QuotaNotificationColumnPercent.identifier(): usedRel},
-				},
-			},
+			dueQuotaNotificationsCondition(
+				periodStart,
+				usedRel,
+				QuotaNotificationColumnLatestDuePeriodStart,
+				QuotaNotificationColumnNextDueThreshold,
+				QuotaNotificationColumnPercent,
+			),
 		},
 	).ToSql()
 	if err != nil {
@@ -96,6 +89,29 @@ func (q *Queries) GetDueQuotaNotifications(ctx context.Context, instanceID strin
 	if err != nil {
 		return nil, err
 	}
+	return dueQuotaNotificationEvents(ctx, &quota.NewAggregate(qu.ID, instanceID).Aggregate, unit, notifications, periodStart, usedRel, usedAbs), nil
+}
+
+// dueQuotaNotificationsCondition filters the notifications which are due for the relative usage in the period
+func dueQuotaNotificationsCondition(periodStart time.Time, usedRel uint16, latestDuePeriodStart, nextDueThreshold, percent Column) sq.Or {
+	return sq.Or{
+		// If the relative usage is greater than the next due threshold in the current period, it's clear we can notify
+		sq.And{
+			sq.Eq{latestDuePeriodStart.identifier(): periodStart},
+			sq.LtOrEq{nextDueThreshold.identifier(): usedRel},
+		},
+		// In case we haven't seen a due notification for this quota period, we compare against the configured percent
+		sq.And{
+			sq.Or{
+				sq.Expr(latestDuePeriodStart.identifier() + " IS NULL"),
+				sq.NotEq{latestDuePeriodStart.identifier(): periodStart},
+			},
+			sq.LtOrEq{percent.identifier(): usedRel},
+		},
+	}
+}
+
+func dueQuotaNotificationEvents(ctx context.Context, aggregate *eventstore.Aggregate, unit quota.Unit, notifications *QuotaNotifications, periodStart time.Time, usedRel uint16, usedAbs uint64) (dueNotifications []*quota.NotificationDueEvent) {
 	for _, notification := range notifications.Configs {
 		reachedThreshold := calculateThreshold(usedRel, notification.Percent)
 		if !notification.Repeat && notification.Percent < reachedThreshold {
@@ -105,7 +121,7 @@ func (q *Queries) GetDueQuotaNotifications(ctx context.Context, instanceID strin
 			dueNotifications,
 			quota.NewNotificationDueEvent(
 				ctx,
-				&quota.NewAggregate(qu.ID, instanceID).Aggregate,
+				aggregate,
 				unit,
 				notification.ID,
 				notification.CallURL,
@@ -115,7 +131,7 @@ func (q *Queries) GetDueQuotaNotifications(ctx context.Context, instanceID strin
 			),
 		)
 	}
-	return dueNotifications, nil
+	return dueNotifications
 }
 
 type QuotaNotification struct {
@@ -157,23 +173,26 @@ func prepareQuotaNotificationsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Quota
 			QuotaNotificationColumnNextDueThreshold.identifier(),
 		).
 			From(quotaNotificationsTable.identifier()).
-			PlaceholderFormat(sq.Dollar), func(rows *sql.Rows) (*QuotaNotifications, error) {
-			cfgs := &QuotaNotifications{Configs: []*QuotaNotification{}}
-			for rows.Next() {
-				cfg := new(QuotaNotification)
-				var nextDueThreshold sql.NullInt16
-				err := rows.Scan(&cfg.ID, &cfg.CallURL, &cfg.Percent, &cfg.Repeat, &nextDueThreshold)
-				if err != nil {
-					if errors.Is(err, sql.ErrNoRows) {
-						return nil, zerrors.ThrowNotFound(err, "QUERY-bbqWb", "Errors.QuotaNotification.NotExisting")
-					}
-					return nil, zerrors.ThrowInternal(err, "QUERY-8copS", "Errors.Internal")
-				}
-				if nextDueThreshold.Valid {
-					cfg.NextDueThreshold = uint16(nextDueThreshold.Int16)
-				}
-				cfgs.Configs = append(cfgs.Configs, cfg)
+			PlaceholderFormat(sq.Dollar),
+		scanQuotaNotifications
+}
+
+func scanQuotaNotifications(rows *sql.Rows) (*QuotaNotifications, error) {
+	cfgs := &QuotaNotifications{Configs: []*QuotaNotification{}}
+	for rows.Next() {
+		cfg := new(QuotaNotification)
+		var nextDueThreshold sql.NullInt16
+		err := rows.Scan(&cfg.ID, &cfg.CallURL, &cfg.Percent, &cfg.Repeat, &nextDueThreshold)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, zerrors.ThrowNotFound(err, "QUERY-bbqWb", "Errors.QuotaNotification.NotExisting")
 			}
-			return cfgs, nil
+			return nil, zerrors.ThrowInternal(err, "QUERY-8copS", "Errors.Internal")
 		}
+		if nextDueThreshold.Valid {
+			cfg.NextDueThreshold = uint16(nextDueThreshold.Int16)
+		}
+		cfgs.Configs = append(cfgs.Configs, cfg)
+	}
+	return cfgs, nil
 }
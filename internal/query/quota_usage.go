@@ -0,0 +1,152 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/quota"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	quotaActiveUsersTable = table{
+		name:          projection.QuotaActiveUsersTable,
+		instanceIDCol: projection.QuotaActiveUserColumnInstanceID,
+	}
+	QuotaActiveUserColumnInstanceID = Column{
+		name:  projection.QuotaActiveUserColumnInstanceID,
+		table: quotaActiveUsersTable,
+	}
+	QuotaActiveUserColumnStart = Column{
+		name:  projection.QuotaActiveUserColumnStart,
+		table: quotaActiveUsersTable,
+	}
+	QuotaActiveUserColumnUserID = Column{
+		name:  projection.QuotaActiveUserColumnUserID,
+		table: quotaActiveUsersTable,
+	}
+)
+
+// QuotaUsage is the usage of a quota in its current period
+type QuotaUsage struct {
+	Unit               quota.Unit
+	From               time.Time
+	ResetInterval      time.Duration
+	Amount             uint64
+	Limit              bool
+	CurrentPeriodStart time.Time
+	Usage              uint64
+}
+
+// GetQuotaUsageReport returns the usage of the current period of all quotas of the instance
+func (q *Queries) GetQuotaUsageReport(ctx context.Context, instanceID string) (usages []*QuotaUsage, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	query, scan := prepareQuotaUsageReportQuery()
+	stmt, args, err := query.Where(
+		sq.Eq{
+			QuotaColumnInstanceID.identifier(): instanceID,
+		},
+	).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Fz2Lq", "Errors.Query.SQLStatement")
+	}
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		usages, err = scan(rows)
+		return err
+	}, stmt, args...)
+	return usages, err
+}
+
+func prepareQuotaUsageReportQuery() (sq.SelectBuilder, func(*sql.Rows) ([]*QuotaUsage, error)) {
+	return sq.
+			Select(
+				QuotaColumnUnit.identifier(),
+				QuotaColumnFrom.identifier(),
+				QuotaColumnInterval.identifier(),
+				QuotaColumnAmount.identifier(),
+				QuotaColumnLimit.identifier(),
+				"COALESCE("+QuotaPeriodColumnUsage.identifier()+", 0)",
+				"now()",
+			).
+			From(quotasTable.identifier()).
+			LeftJoin(join(QuotaPeriodColumnUnit, QuotaColumnUnit) +
+				" AND age(" + QuotaPeriodColumnStart.identifier() + ") < " + QuotaColumnInterval.identifier() +
+				" AND " + QuotaPeriodColumnStart.identifier() + " <= now()" +
+				" AND " + QuotaPeriodColumnStart.identifier() + " >= " + QuotaColumnFrom.identifier()).
+			OrderBy(QuotaColumnUnit.identifier()).
+			PlaceholderFormat(sq.Dollar), func(rows *sql.Rows) ([]*QuotaUsage, error) {
+			usages := make([]*QuotaUsage, 0)
+			for rows.Next() {
+				u := new(QuotaUsage)
+				var interval database.NullDuration
+				var now time.Time
+				err := rows.Scan(&u.Unit, &u.From, &interval, &u.Amount, &u.Limit, &u.Usage, &now)
+				if err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Tb7Ws", "Errors.Internal")
+				}
+				u.ResetInterval = interval.Duration
+				u.CurrentPeriodStart = pushPeriodStart(u.From, u.ResetInterval, now)
+				usages = append(usages, u)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Wq3pM", "Errors.Query.CloseRows")
+			}
+			return usages, nil
+		}
+}
+
+// IsUserActiveInQuotaPeriod returns true if the user is already counted as active user in the current quota period
+func (q *Queries) IsUserActiveInQuotaPeriod(ctx context.Context, instanceID, userID string) (active bool, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+	query, scan := prepareUserActiveInQuotaPeriodQuery()
+	stmt, args, err := query.Where(
+		sq.And{
+			sq.Eq{
+				QuotaActiveUserColumnInstanceID.identifier(): instanceID,
+				QuotaActiveUserColumnUserID.identifier():     userID,
+				QuotaColumnUnit.identifier():                 quota.UsersAllActive,
+			},
+			sq.Expr("age(" + QuotaActiveUserColumnStart.identifier() + ") < " + QuotaColumnInterval.identifier()),
+			sq.Expr(QuotaActiveUserColumnStart.identifier() + " <= now()"),
+			sq.Expr(QuotaActiveUserColumnStart.identifier() + " >= " + QuotaColumnFrom.identifier()),
+		},
+	).ToSql()
+	if err != nil {
+		return false, zerrors.ThrowInternal(err, "QUERY-Vn5sK", "Errors.Query.SQLStatement")
+	}
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		active, err = scan(row)
+		return err
+	}, stmt, args...)
+	return active, err
+}
+
+func prepareUserActiveInQuotaPeriodQuery() (sq.SelectBuilder, func(*sql.Row) (bool, error)) {
+	return sq.
+			Select(
+				QuotaActiveUserColumnUserID.identifier(),
+			).
+			From(quotaActiveUsersTable.identifier()).
+			Join(join(QuotaColumnInstanceID, QuotaActiveUserColumnInstanceID)).
+			Limit(1).
+			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (bool, error) {
+			var userID string
+			err := row.Scan(&userID)
+			if errors.Is(err, sql.ErrNoRows) {
+				return false, nil
+			}
+			if err != nil {
+				return false, zerrors.ThrowInternal(err, "QUERY-Ls8eB", "Errors.Internal")
+			}
+			return true, nil
+		}
+}
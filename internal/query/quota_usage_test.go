@@ -0,0 +1,191 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/zitadel/zitadel/internal/repository/quota"
+)
+
+var (
+	expectedQuotaUsageReportQuery = regexp.QuoteMeta(`SELECT projections.quotas.unit,` +
+		` projections.quotas.from_anchor,` +
+		` projections.quotas.interval,` +
+		` projections.quotas.amount,` +
+		` projections.quotas.limit_usage,` +
+		` COALESCE(projections.quotas_periods.usage, 0),` +
+		` now()` +
+		` FROM projections.quotas` +
+		` LEFT JOIN projections.quotas_periods ON projections.quotas.unit = projections.quotas_periods.unit AND projections.quotas.instance_id = projections.quotas_periods.instance_id` +
+		` AND age(projections.quotas_periods.start) < projections.quotas.interval` +
+		` AND projections.quotas_periods.start <= now()` +
+		` AND projections.quotas_periods.start >= projections.quotas.from_anchor` +
+		` ORDER BY projections.quotas.unit`)
+
+	quotaUsageReportCols = []string{
+		"unit",
+		"from_anchor",
+		"interval",
+		"amount",
+		"limit_usage",
+		"usage",
+		"now",
+	}
+
+	expectedUserActiveInQuotaPeriodQuery = regexp.QuoteMeta(`SELECT projections.quotas_active_users.user_id` +
+		` FROM projections.quotas_active_users` +
+		` JOIN projections.quotas ON projections.quotas_active_users.instance_id = projections.quotas.instance_id` +
+		` LIMIT 1`)
+)
+
+func Test_QuotaUsagePrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareQuotaUsageReportQuery no result",
+			prepare: prepareQuotaUsageReportQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedQuotaUsageReportQuery,
+					nil,
+					nil,
+				),
+			},
+			object: []*QuotaUsage{},
+		},
+		{
+			name:    "prepareQuotaUsageReportQuery",
+			prepare: prepareQuotaUsageReportQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					expectedQuotaUsageReportQuery,
+					quotaUsageReportCols,
+					[][]driver.Value{
+						{
+							quota.RequestsAllAuthenticated,
+							dayNow,
+							&pgtype.Interval{
+								Days: 1,
+							},
+							uint64(1000),
+							true,
+							uint64(10),
+							testNow,
+						},
+						{
+							quota.UsersAllActive,
+							dayNow,
+							&pgtype.Interval{
+								Days: 1,
+							},
+							uint64(100),
+							false,
+							uint64(0),
+							testNow,
+						},
+					},
+				),
+			},
+			object: []*QuotaUsage{
+				{
+					Unit:               quota.RequestsAllAuthenticated,
+					From:               dayNow,
+					ResetInterval:      time.Hour * 24,
+					Amount:             1000,
+					Limit:              true,
+					CurrentPeriodStart: dayNow,
+					Usage:              10,
+				},
+				{
+					Unit:               quota.UsersAllActive,
+					From:               dayNow,
+					ResetInterval:      time.Hour * 24,
+					Amount:             100,
+					Limit:              false,
+					CurrentPeriodStart: dayNow,
+					Usage:              0,
+				},
+			},
+		},
+		{
+			name:    "prepareQuotaUsageReportQuery sql err",
+			prepare: prepareQuotaUsageReportQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					expectedQuotaUsageReportQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: ([]*QuotaUsage)(nil),
+		},
+		{
+			name:    "prepareUserActiveInQuotaPeriodQuery not active",
+			prepare: prepareUserActiveInQuotaPeriodQuery,
+			want: want{
+				sqlExpectations: mockQueryScanErr(
+					expectedUserActiveInQuotaPeriodQuery,
+					nil,
+					nil,
+				),
+			},
+			object: false,
+		},
+		{
+			name:    "prepareUserActiveInQuotaPeriodQuery active",
+			prepare: prepareUserActiveInQuotaPeriodQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					expectedUserActiveInQuotaPeriodQuery,
+					[]string{"user_id"},
+					[]driver.Value{
+						"user-id",
+					},
+				),
+			},
+			object: true,
+		},
+		{
+			name:    "prepareUserActiveInQuotaPeriodQuery sql err",
+			prepare: prepareUserActiveInQuotaPeriodQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					expectedUserActiveInQuotaPeriodQuery,
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
@@ -35,7 +35,7 @@ func (q *Queries) ListResourceCounts(ctx context.Context, lastID, limit int) (re
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
 		for rows.Next() {
 			var count ResourceCount
 			err := rows.Scan(
@@ -0,0 +1,379 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/samlfederation"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	samlFederationTable = table{
+		name:          projection.SAMLFederationTable,
+		instanceIDCol: projection.SAMLFederationInstanceIDCol,
+	}
+	SAMLFederationColumnInstanceID = Column{
+		name:  projection.SAMLFederationInstanceIDCol,
+		table: samlFederationTable,
+	}
+	SAMLFederationColumnID = Column{
+		name:  projection.SAMLFederationIDCol,
+		table: samlFederationTable,
+	}
+	SAMLFederationColumnCreationDate = Column{
+		name:  projection.SAMLFederationCreationDateCol,
+		table: samlFederationTable,
+	}
+	SAMLFederationColumnChangeDate = Column{
+		name:  projection.SAMLFederationChangeDateCol,
+		table: samlFederationTable,
+	}
+	SAMLFederationColumnSequence = Column{
+		name:  projection.SAMLFederationSequenceCol,
+		table: samlFederationTable,
+	}
+	SAMLFederationColumnName = Column{
+		name:  projection.SAMLFederationNameCol,
+		table: samlFederationTable,
+	}
+	SAMLFederationColumnMetadataURL = Column{
+		name:  projection.SAMLFederationMetadataURLCol,
+		table: samlFederationTable,
+	}
+	SAMLFederationColumnSigningCertificate = Column{
+		name:  projection.SAMLFederationSigningCertificateCol,
+		table: samlFederationTable,
+	}
+	SAMLFederationColumnFilter = Column{
+		name:  projection.SAMLFederationFilterCol,
+		table: samlFederationTable,
+	}
+	SAMLFederationColumnInterval = Column{
+		name:  projection.SAMLFederationIntervalCol,
+		table: samlFederationTable,
+	}
+	SAMLFederationColumnAddToLoginPolicy = Column{
+		name:  projection.SAMLFederationAddToLoginPolicyCol,
+		table: samlFederationTable,
+	}
+	SAMLFederationColumnTemplate = Column{
+		name:  projection.SAMLFederationTemplateCol,
+		table: samlFederationTable,
+	}
+	SAMLFederationColumnNextRunAt = Column{
+		name:  projection.SAMLFederationNextRunAtCol,
+		table: samlFederationTable,
+	}
+	SAMLFederationColumnLastRunDate = Column{
+		name:  projection.SAMLFederationLastRunDateCol,
+		table: samlFederationTable,
+	}
+	SAMLFederationColumnLastSummary = Column{
+		name:  projection.SAMLFederationLastSummaryCol,
+		table: samlFederationTable,
+	}
+	SAMLFederationColumnValidUntil = Column{
+		name:  projection.SAMLFederationValidUntilCol,
+		table: samlFederationTable,
+	}
+	SAMLFederationColumnLastErrorDate = Column{
+		name:  projection.SAMLFederationLastErrorDateCol,
+		table: samlFederationTable,
+	}
+	SAMLFederationColumnLastError = Column{
+		name:  projection.SAMLFederationLastErrorCol,
+		table: samlFederationTable,
+	}
+)
+
+var (
+	samlFederationEntityTable = table{
+		name:          projection.SAMLFederationEntityTable,
+		instanceIDCol: projection.SAMLFederationEntityInstanceIDCol,
+	}
+	SAMLFederationEntityColumnInstanceID = Column{
+		name:  projection.SAMLFederationEntityInstanceIDCol,
+		table: samlFederationEntityTable,
+	}
+	SAMLFederationEntityColumnFederationID = Column{
+		name:  projection.SAMLFederationEntityFederationIDCol,
+		table: samlFederationEntityTable,
+	}
+	SAMLFederationEntityColumnEntityID = Column{
+		name:  projection.SAMLFederationEntityEntityIDCol,
+		table: samlFederationEntityTable,
+	}
+	SAMLFederationEntityColumnIDPID = Column{
+		name:  projection.SAMLFederationEntityIDPIDCol,
+		table: samlFederationEntityTable,
+	}
+	SAMLFederationEntityColumnDisplayName = Column{
+		name:  projection.SAMLFederationEntityDisplayNameCol,
+		table: samlFederationEntityTable,
+	}
+)
+
+// SAMLFederation is a federation of the instance including the state of its latest import.
+type SAMLFederation struct {
+	ID           string
+	CreationDate time.Time
+	ChangeDate   time.Time
+	Sequence     uint64
+
+	Name               string
+	MetadataURL        string
+	SigningCertificate []byte
+	Filter             *domain.SAMLFederationFilter
+	Interval           time.Duration
+	AddToLoginPolicy   bool
+	Template           *samlfederation.IDPTemplate
+
+	NextRunAt     time.Time
+	LastRunDate   time.Time
+	LastSummary   *domain.SAMLFederationImportSummary
+	ValidUntil    time.Time
+	LastErrorDate time.Time
+	LastError     string
+}
+
+// GetSAMLFederation returns a federation of the instance.
+func (q *Queries) GetSAMLFederation(ctx context.Context, id string, permissionCheck domain.PermissionCheck) (_ *SAMLFederation, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if err := permissionCheck(ctx, domain.PermissionIDPRead, instanceID, id); err != nil {
+		return nil, err
+	}
+	eq := sq.Eq{
+		SAMLFederationColumnID.identifier():         id,
+		SAMLFederationColumnInstanceID.identifier(): instanceID,
+	}
+	query, scan := prepareSAMLFederationQuery()
+	return genericRowQuery(ctx, q.client, query.Where(eq), func(row *sql.Row) (*SAMLFederation, error) {
+		federation, err := scan(row)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, zerrors.ThrowNotFound(err, "QUERY-Sf4qN", "Errors.IDP.SAMLFederation.NotFound")
+		}
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "QUERY-Sf4qI", "Errors.Internal")
+		}
+		return federation, nil
+	})
+}
+
+// ListSAMLFederations returns all federations of the instance ordered by their name.
+func (q *Queries) ListSAMLFederations(ctx context.Context, permissionCheck domain.PermissionCheck) (_ []*SAMLFederation, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	if err := permissionCheck(ctx, domain.PermissionIDPRead, instanceID, instanceID); err != nil {
+		return nil, err
+	}
+	query, scan := prepareSAMLFederationQuery()
+	return genericRowsQuery(ctx, q.client,
+		query.Where(sq.Eq{SAMLFederationColumnInstanceID.identifier(): instanceID}).OrderBy(SAMLFederationColumnName.identifier()),
+		func(rows *sql.Rows) ([]*SAMLFederation, error) {
+			federations := make([]*SAMLFederation, 0)
+			for rows.Next() {
+				federation, err := scan(rows)
+				if err != nil {
+					return nil, err
+				}
+				federations = append(federations, federation)
+			}
+			return federations, rows.Err()
+		},
+	)
+}
+
+// samlFederationScanner is implemented by [sql.Row] and [sql.Rows].
+type samlFederationScanner interface {
+	Scan(dest ...any) error
+}
+
+func prepareSAMLFederationQuery() (sq.SelectBuilder, func(row samlFederationScanner) (*SAMLFederation, error)) {
+	return sq.Select(
+			SAMLFederationColumnID.identifier(),
+			SAMLFederationColumnCreationDate.identifier(),
+			SAMLFederationColumnChangeDate.identifier(),
+			SAMLFederationColumnSequence.identifier(),
+			SAMLFederationColumnName.identifier(),
+			SAMLFederationColumnMetadataURL.identifier(),
+			SAMLFederationColumnSigningCertificate.identifier(),
+			SAMLFederationColumnFilter.identifier(),
+			SAMLFederationColumnInterval.identifier(),
+			SAMLFederationColumnAddToLoginPolicy.identifier(),
+			SAMLFederationColumnTemplate.identifier(),
+			SAMLFederationColumnNextRunAt.identifier(),
+			SAMLFederationColumnLastRunDate.identifier(),
+			SAMLFederationColumnLastSummary.identifier(),
+			SAMLFederationColumnValidUntil.identifier(),
+			SAMLFederationColumnLastErrorDate.identifier(),
+			SAMLFederationColumnLastError.identifier(),
+		).From(samlFederationTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row samlFederationScanner) (*SAMLFederation, error) {
+			federation := new(SAMLFederation)
+			var (
+				filter        []byte
+				template      []byte
+				nextRunAt     sql.NullTime
+				lastRunDate   sql.NullTime
+				lastSummary   []byte
+				validUntil    sql.NullTime
+				lastErrorDate sql.NullTime
+				lastError     sql.NullString
+			)
+			err := row.Scan(
+				&federation.ID,
+				&federation.CreationDate,
+				&federation.ChangeDate,
+				&federation.Sequence,
+				&federation.Name,
+				&federation.MetadataURL,
+				&federation.SigningCertificate,
+				&filter,
+				&federation.Interval,
+				&federation.AddToLoginPolicy,
+				&template,
+				&nextRunAt,
+				&lastRunDate,
+				&lastSummary,
+				&validUntil,
+				&lastErrorDate,
+				&lastError,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if len(filter) > 0 {
+				if err := json.Unmarshal(filter, &federation.Filter); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Sf4qF", "Errors.Internal")
+				}
+			}
+			if len(template) > 0 {
+				if err := json.Unmarshal(template, &federation.Template); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Sf4qT", "Errors.Internal")
+				}
+			}
+			if len(lastSummary) > 0 {
+				if err := json.Unmarshal(lastSummary, &federation.LastSummary); err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-Sf4qS", "Errors.Internal")
+				}
+			}
+			federation.NextRunAt = nextRunAt.Time
+			federation.LastRunDate = lastRunDate.Time
+			federation.ValidUntil = validUntil.Time
+			federation.LastErrorDate = lastErrorDate.Time
+			federation.LastError = lastError.String
+			return federation, nil
+		}
+}
+
+// DueSAMLFederation is a federation whose metadata aggregate is due to be imported.
+type DueSAMLFederation struct {
+	InstanceID string
+	ID         string
+}
+
+//go:embed saml_federation_due.sql
+var dueSAMLFederationsQuery string
+
+// SearchDueSAMLFederations returns the federations of all instances which are due at the given time, the longest overdue first.
+// It is used by the import worker and therefore doesn't check any permission.
+func (q *Queries) SearchDueSAMLFederations(ctx context.Context, dueAt time.Time, limit uint32) (_ []*DueSAMLFederation, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	federations := make([]*DueSAMLFederation, 0)
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			federation := new(DueSAMLFederation)
+			if err := rows.Scan(
+				&federation.InstanceID,
+				&federation.ID,
+			); err != nil {
+				return err
+			}
+			federations = append(federations, federation)
+		}
+		return rows.Err()
+	},
+		dueSAMLFederationsQuery,
+		dueAt.Unix(),
+		limit,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Sf4dU", "Errors.Internal")
+	}
+	return federations, nil
+}
+
+// SAMLFederationEntity is an identity provider created for an entity of a federation.
+type SAMLFederationEntity struct {
+	FederationID string
+	EntityID     string
+	IDPID        string
+	DisplayName  string
+}
+
+// SearchSAMLFederationEntities returns the identity providers of all federations of the instance,
+// whose display name contains the search text, ordered by their display name.
+// If idpIDs is not nil, only the identity providers contained are returned.
+// It is used by the discovery service of the login UI and therefore doesn't check any permission.
+func (q *Queries) SearchSAMLFederationEntities(ctx context.Context, search string, idpIDs []string, limit uint64) (_ []*SAMLFederationEntity, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	where := sq.And{
+		sq.Eq{SAMLFederationEntityColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()},
+	}
+	if search != "" {
+		where = append(where, sq.ILike{SAMLFederationEntityColumnDisplayName.identifier(): "%" + search + "%"})
+	}
+	if idpIDs != nil {
+		where = append(where, sq.Expr(SAMLFederationEntityColumnIDPID.identifier()+" = ANY(?)", database.TextArray[string](idpIDs)))
+	}
+	query := sq.Select(
+		SAMLFederationEntityColumnFederationID.identifier(),
+		SAMLFederationEntityColumnEntityID.identifier(),
+		SAMLFederationEntityColumnIDPID.identifier(),
+		SAMLFederationEntityColumnDisplayName.identifier(),
+	).From(samlFederationEntityTable.identifier()).
+		Where(where).
+		OrderBy(SAMLFederationEntityColumnDisplayName.identifier()).
+		PlaceholderFormat(sq.Dollar)
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	return genericRowsQuery(ctx, q.client, query, func(rows *sql.Rows) ([]*SAMLFederationEntity, error) {
+		entities := make([]*SAMLFederationEntity, 0)
+		for rows.Next() {
+			entity := new(SAMLFederationEntity)
+			if err := rows.Scan(
+				&entity.FederationID,
+				&entity.EntityID,
+				&entity.IDPID,
+				&entity.DisplayName,
+			); err != nil {
+				return nil, err
+			}
+			entities = append(entities, entity)
+		}
+		return entities, rows.Err()
+	})
+}
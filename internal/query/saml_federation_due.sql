@@ -0,0 +1,10 @@
+SELECT
+	instance_id
+	, aggregate_id
+FROM eventstore.fields
+WHERE object_type = 'saml_federation'
+AND field_name = 'next_run_at'
+AND number_value IS NOT NULL
+AND number_value <= $1
+ORDER BY number_value
+LIMIT $2;
@@ -0,0 +1,129 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestQueries_SearchDueSAMLFederations(t *testing.T) {
+	expQuery := regexp.QuoteMeta(dueSAMLFederationsQuery)
+	cols := []string{"instance_id", "aggregate_id"}
+	dueAt := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name    string
+		mock    sqlExpectation
+		want    []*DueSAMLFederation
+		wantErr error
+	}{
+		{
+			name:    "internal error",
+			mock:    mockQueryErr(expQuery, sql.ErrConnDone, int64(1700000000), uint32(10)),
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-Sf4dU", "Errors.Internal"),
+		},
+		{
+			name: "success",
+			mock: mockQueries(expQuery, cols,
+				[][]driver.Value{
+					{"instance1", "federation1"},
+					{"instance2", "federation2"},
+				},
+				int64(1700000000), uint32(10),
+			),
+			want: []*DueSAMLFederation{
+				{
+					InstanceID: "instance1",
+					ID:         "federation1",
+				},
+				{
+					InstanceID: "instance2",
+					ID:         "federation2",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+				}
+				got, err := q.SearchDueSAMLFederations(context.Background(), dueAt, 10)
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
+
+func TestQueries_SearchSAMLFederationEntities(t *testing.T) {
+	expQuery := regexp.QuoteMeta(`SELECT projections.saml_federations_entities.federation_id,` +
+		` projections.saml_federations_entities.entity_id,` +
+		` projections.saml_federations_entities.idp_id,` +
+		` projections.saml_federations_entities.display_name` +
+		` FROM projections.saml_federations_entities` +
+		` WHERE (projections.saml_federations_entities.instance_id = $1 AND projections.saml_federations_entities.display_name ILIKE $2 AND projections.saml_federations_entities.idp_id = ANY($3))` +
+		` ORDER BY projections.saml_federations_entities.display_name LIMIT 20`)
+	cols := []string{"federation_id", "entity_id", "idp_id", "display_name"}
+
+	tests := []struct {
+		name    string
+		mock    sqlExpectation
+		want    []*SAMLFederationEntity
+		wantErr func(error) bool
+	}{
+		{
+			name:    "internal error",
+			mock:    mockQueryErr(expQuery, sql.ErrConnDone, "instance1", "%univ%", database.TextArray[string]{"idp1", "idp2"}),
+			wantErr: zerrors.IsInternal,
+		},
+		{
+			name: "success",
+			mock: mockQueries(expQuery, cols,
+				[][]driver.Value{
+					{"federation1", "https://idp.university.example/idp", "idp1", "University"},
+				},
+				"instance1", "%univ%", database.TextArray[string]{"idp1", "idp2"},
+			),
+			want: []*SAMLFederationEntity{
+				{
+					FederationID: "federation1",
+					EntityID:     "https://idp.university.example/idp",
+					IDPID:        "idp1",
+					DisplayName:  "University",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+				}
+				got, err := q.SearchSAMLFederationEntities(authz.WithInstanceID(context.Background(), "instance1"), "univ", []string{"idp1", "idp2"}, 20)
+				if tt.wantErr != nil {
+					assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+					return
+				}
+				require.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
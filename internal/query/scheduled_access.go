@@ -0,0 +1,64 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ScheduledAccess is a time-bound membership, authorization or elevation which is due
+// to be activated or removed, or an access review campaign which passed its deadline.
+// FieldName defines which of the scheduled changes is due,
+// except for the members of project grants, applications and roles, which are defined by ObjectType
+// and of which the field name is the id of the user.
+type ScheduledAccess struct {
+	InstanceID    string
+	ResourceOwner string
+	AggregateType string
+	AggregateID   string
+	ObjectType    string
+	ObjectID      string
+	FieldName     string
+}
+
+//go:embed scheduled_access.sql
+var dueScheduledAccessQuery string
+
+// SearchDueScheduledAccess returns the scheduled changes of all instances which are due at the given time, the oldest first.
+// It is used by the scheduled access worker and therefore doesn't check any permission.
+func (q *Queries) SearchDueScheduledAccess(ctx context.Context, dueAt time.Time, limit uint32) (_ []*ScheduledAccess, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	scheduled := make([]*ScheduledAccess, 0)
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			access := new(ScheduledAccess)
+			if err := rows.Scan(
+				&access.InstanceID,
+				&access.ResourceOwner,
+				&access.AggregateType,
+				&access.AggregateID,
+				&access.ObjectType,
+				&access.ObjectID,
+				&access.FieldName,
+			); err != nil {
+				return err
+			}
+			scheduled = append(scheduled, access)
+		}
+		return rows.Err()
+	},
+		dueScheduledAccessQuery,
+		dueAt.Unix(),
+		limit,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Sa3dU", "Errors.Internal")
+	}
+	return scheduled, nil
+}
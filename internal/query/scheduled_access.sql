@@ -0,0 +1,20 @@
+SELECT
+	instance_id
+	, resource_owner
+	, aggregate_type
+	, aggregate_id
+	, object_type
+	, object_id
+	, field_name
+FROM eventstore.fields
+WHERE (
+	(
+		object_type IN ('instance_member_role', 'org_member_role', 'project_member_role', 'user_grant', 'elevation', 'access_review')
+		AND field_name IN ('instance_valid_until', 'org_valid_until', 'project_valid_until', 'valid_from', 'valid_until', 'activate_at', 'deadline')
+	)
+	OR object_type IN ('project_grant_member_validity', 'project_application_member_validity', 'project_role_member_validity')
+)
+AND number_value IS NOT NULL
+AND number_value <= $1
+ORDER BY number_value
+LIMIT $2;
@@ -0,0 +1,89 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestQueries_SearchDueScheduledAccess(t *testing.T) {
+	expQuery := regexp.QuoteMeta(dueScheduledAccessQuery)
+	cols := []string{"instance_id", "resource_owner", "aggregate_type", "aggregate_id", "object_type", "object_id", "field_name"}
+	dueAt := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name    string
+		mock    sqlExpectation
+		want    []*ScheduledAccess
+		wantErr error
+	}{
+		{
+			name:    "internal error",
+			mock:    mockQueryErr(expQuery, sql.ErrConnDone, int64(1700000000), uint32(10)),
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-Sa3dU", "Errors.Internal"),
+		},
+		{
+			name: "success",
+			mock: mockQueries(expQuery, cols,
+				[][]driver.Value{
+					{"instance1", "org1", "org", "org1", "org_member_role", "user1", "org_valid_until"},
+					{"instance2", "org2", "usergrant", "grant1", "user_grant", "grant1", "valid_from"},
+					{"instance3", "org3", "project", "project1", "project_application_member_validity", "app1", "user1"},
+				},
+				int64(1700000000), uint32(10),
+			),
+			want: []*ScheduledAccess{
+				{
+					InstanceID:    "instance1",
+					ResourceOwner: "org1",
+					AggregateType: "org",
+					AggregateID:   "org1",
+					ObjectType:    "org_member_role",
+					ObjectID:      "user1",
+					FieldName:     "org_valid_until",
+				},
+				{
+					InstanceID:    "instance2",
+					ResourceOwner: "org2",
+					AggregateType: "usergrant",
+					AggregateID:   "grant1",
+					ObjectType:    "user_grant",
+					ObjectID:      "grant1",
+					FieldName:     "valid_from",
+				},
+				{
+					InstanceID:    "instance3",
+					ResourceOwner: "org3",
+					AggregateType: "project",
+					AggregateID:   "project1",
+					ObjectType:    "project_application_member_validity",
+					ObjectID:      "app1",
+					FieldName:     "user1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+				}
+				got, err := q.SearchDueScheduledAccess(context.Background(), dueAt, 10)
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
@@ -0,0 +1,576 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	scimProvisioningTable = table{
+		name:          projection.SCIMProvisioningTable,
+		instanceIDCol: projection.SCIMProvisioningInstanceIDCol,
+	}
+	SCIMProvisioningColumnAppID = Column{
+		name:  projection.SCIMProvisioningAppIDCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnProjectID = Column{
+		name:  projection.SCIMProvisioningProjectIDCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnResourceOwner = Column{
+		name:  projection.SCIMProvisioningResourceOwnerCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnInstanceID = Column{
+		name:  projection.SCIMProvisioningInstanceIDCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnCreationDate = Column{
+		name:  projection.SCIMProvisioningCreationDateCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnChangeDate = Column{
+		name:  projection.SCIMProvisioningChangeDateCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnSequence = Column{
+		name:  projection.SCIMProvisioningSequenceCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnEndpoint = Column{
+		name:  projection.SCIMProvisioningEndpointCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnAuthType = Column{
+		name:  projection.SCIMProvisioningAuthTypeCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnClientID = Column{
+		name:  projection.SCIMProvisioningClientIDCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnTokenEndpoint = Column{
+		name:  projection.SCIMProvisioningTokenEndpointCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnScopes = Column{
+		name:  projection.SCIMProvisioningScopesCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnProvisionGroups = Column{
+		name:  projection.SCIMProvisioningProvisionGroupsCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnLastSuccessDate = Column{
+		name:  projection.SCIMProvisioningLastSuccessDateCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnLastErrorDate = Column{
+		name:  projection.SCIMProvisioningLastErrorDateCol,
+		table: scimProvisioningTable,
+	}
+	SCIMProvisioningColumnLastError = Column{
+		name:  projection.SCIMProvisioningLastErrorCol,
+		table: scimProvisioningTable,
+	}
+
+	scimProvisionedResourceTable = table{
+		name:          projection.SCIMProvisionedResourceTable,
+		instanceIDCol: projection.SCIMProvisionedResourceInstanceIDCol,
+	}
+	SCIMProvisionedResourceColumnAppID = Column{
+		name:  projection.SCIMProvisionedResourceAppIDCol,
+		table: scimProvisionedResourceTable,
+	}
+	SCIMProvisionedResourceColumnInstanceID = Column{
+		name:  projection.SCIMProvisionedResourceInstanceIDCol,
+		table: scimProvisionedResourceTable,
+	}
+	SCIMProvisionedResourceColumnResourceType = Column{
+		name:  projection.SCIMProvisionedResourceTypeCol,
+		table: scimProvisionedResourceTable,
+	}
+	SCIMProvisionedResourceColumnResourceID = Column{
+		name:  projection.SCIMProvisionedResourceIDCol,
+		table: scimProvisionedResourceTable,
+	}
+	SCIMProvisionedResourceColumnRemoteID = Column{
+		name:  projection.SCIMProvisionedResourceRemoteIDCol,
+		table: scimProvisionedResourceTable,
+	}
+	SCIMProvisionedResourceColumnState = Column{
+		name:  projection.SCIMProvisionedResourceStateCol,
+		table: scimProvisionedResourceTable,
+	}
+	SCIMProvisionedResourceColumnError = Column{
+		name:  projection.SCIMProvisionedResourceErrorCol,
+		table: scimProvisionedResourceTable,
+	}
+	SCIMProvisionedResourceColumnCreationDate = Column{
+		name:  projection.SCIMProvisionedResourceCreationDateCol,
+		table: scimProvisionedResourceTable,
+	}
+	SCIMProvisionedResourceColumnChangeDate = Column{
+		name:  projection.SCIMProvisionedResourceChangeDateCol,
+		table: scimProvisionedResourceTable,
+	}
+	SCIMProvisionedResourceColumnSequence = Column{
+		name:  projection.SCIMProvisionedResourceSequenceCol,
+		table: scimProvisionedResourceTable,
+	}
+)
+
+// SCIMProvisioningConnector is the outbound SCIM connector of an application including its latest provisioning status.
+// The secrets are never returned.
+type SCIMProvisioningConnector struct {
+	AppID         string
+	ProjectID     string
+	ResourceOwner string
+	CreationDate  time.Time
+	ChangeDate    time.Time
+	Sequence      uint64
+
+	Endpoint        string
+	AuthType        domain.SCIMProvisioningAuthType
+	ClientID        string
+	TokenEndpoint   string
+	Scopes          database.TextArray[string]
+	ProvisionGroups bool
+
+	LastSuccessDate time.Time
+	LastErrorDate   time.Time
+	LastError       string
+}
+
+type SCIMProvisionedResources struct {
+	SearchResponse
+	Resources []*SCIMProvisionedResource
+}
+
+func (r *SCIMProvisionedResources) SetState(s *State) {
+	r.State = s
+}
+
+// SCIMProvisionedResource is the mapping of a user or group to its id in the downstream application.
+type SCIMProvisionedResource struct {
+	AppID        string
+	ResourceType domain.SCIMProvisioningResourceType
+	ResourceID   string
+	RemoteID     string
+	State        domain.SCIMProvisioningResourceState
+	Error        string
+	CreationDate time.Time
+	ChangeDate   time.Time
+	Sequence     uint64
+}
+
+type SCIMProvisionedResourceSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *SCIMProvisionedResourceSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func NewSCIMProvisionedResourceTypeSearchQuery(resourceType domain.SCIMProvisioningResourceType) (SearchQuery, error) {
+	return NewTextQuery(SCIMProvisionedResourceColumnResourceType, string(resourceType), TextEquals)
+}
+
+func NewSCIMProvisionedResourceStateSearchQuery(state domain.SCIMProvisioningResourceState) (SearchQuery, error) {
+	return NewNumberQuery(SCIMProvisionedResourceColumnState, state, NumberEquals)
+}
+
+// SCIMProvisioningConnectorsExist returns true if at least one application of the instance has an outbound SCIM connector.
+// It is used to skip the provisioning of instances without connectors and therefore doesn't check any permission.
+func (q *Queries) SCIMProvisioningConnectorsExist(ctx context.Context) (exists bool, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, args, err := sq.Select("1").
+		From(scimProvisioningTable.identifier()).
+		Where(sq.Eq{SCIMProvisioningColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}).
+		Limit(1).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, zerrors.ThrowInternal(err, "QUERY-Sp3xS", "Errors.Query.SQLStatement")
+	}
+	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
+		var one int
+		return row.Scan(&one)
+	}, stmt, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, zerrors.ThrowInternal(err, "QUERY-Sp3xI", "Errors.Internal")
+	}
+	return true, nil
+}
+
+func (q *Queries) GetSCIMProvisioningConnectorWithPermission(ctx context.Context, appID string, permissionCheck domain.PermissionCheck) (_ *SCIMProvisioningConnector, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		SCIMProvisioningColumnAppID.identifier():      appID,
+		SCIMProvisioningColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareSCIMProvisioningConnectorQuery()
+	connector, err := genericRowQuery(ctx, q.client, query.Where(eq), scan)
+	if err != nil {
+		return nil, err
+	}
+	if err := appCheckPermission(ctx, connector.ResourceOwner, connector.ProjectID, connector.AppID, permissionCheck); err != nil {
+		return nil, err
+	}
+	return connector, nil
+}
+
+// SearchSCIMProvisionedResources returns the users and groups provisioned into the downstream application.
+func (q *Queries) SearchSCIMProvisionedResources(ctx context.Context, appID string, queries *SCIMProvisionedResourceSearchQueries, permissionCheck domain.PermissionCheck) (_ *SCIMProvisionedResources, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if _, err := q.GetSCIMProvisioningConnectorWithPermission(ctx, appID, permissionCheck); err != nil {
+		return nil, err
+	}
+	eq := sq.Eq{
+		SCIMProvisionedResourceColumnAppID.identifier():      appID,
+		SCIMProvisionedResourceColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareSCIMProvisionedResourcesQuery()
+	return genericRowsQueryWithState(ctx, q.client, scimProvisionedResourceTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+func prepareSCIMProvisioningConnectorQuery() (sq.SelectBuilder, func(row *sql.Row) (*SCIMProvisioningConnector, error)) {
+	return sq.Select(
+			SCIMProvisioningColumnAppID.identifier(),
+			SCIMProvisioningColumnProjectID.identifier(),
+			SCIMProvisioningColumnResourceOwner.identifier(),
+			SCIMProvisioningColumnCreationDate.identifier(),
+			SCIMProvisioningColumnChangeDate.identifier(),
+			SCIMProvisioningColumnSequence.identifier(),
+			SCIMProvisioningColumnEndpoint.identifier(),
+			SCIMProvisioningColumnAuthType.identifier(),
+			SCIMProvisioningColumnClientID.identifier(),
+			SCIMProvisioningColumnTokenEndpoint.identifier(),
+			SCIMProvisioningColumnScopes.identifier(),
+			SCIMProvisioningColumnProvisionGroups.identifier(),
+			SCIMProvisioningColumnLastSuccessDate.identifier(),
+			SCIMProvisioningColumnLastErrorDate.identifier(),
+			SCIMProvisioningColumnLastError.identifier(),
+		).From(scimProvisioningTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*SCIMProvisioningConnector, error) {
+			connector := new(SCIMProvisioningConnector)
+			var (
+				clientID        sql.NullString
+				tokenEndpoint   sql.NullString
+				lastSuccessDate sql.NullTime
+				lastErrorDate   sql.NullTime
+				lastError       sql.NullString
+			)
+			err := row.Scan(
+				&connector.AppID,
+				&connector.ProjectID,
+				&connector.ResourceOwner,
+				&connector.CreationDate,
+				&connector.ChangeDate,
+				&connector.Sequence,
+				&connector.Endpoint,
+				&connector.AuthType,
+				&clientID,
+				&tokenEndpoint,
+				&connector.Scopes,
+				&connector.ProvisionGroups,
+				&lastSuccessDate,
+				&lastErrorDate,
+				&lastError,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-Sp3qN", "Errors.Project.App.SCIMProvisioning.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-Sp3qI", "Errors.Internal")
+			}
+			connector.ClientID = clientID.String
+			connector.TokenEndpoint = tokenEndpoint.String
+			connector.LastSuccessDate = lastSuccessDate.Time
+			connector.LastErrorDate = lastErrorDate.Time
+			connector.LastError = lastError.String
+			return connector, nil
+		}
+}
+
+func prepareSCIMProvisionedResourcesQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*SCIMProvisionedResources, error)) {
+	return sq.Select(
+			SCIMProvisionedResourceColumnAppID.identifier(),
+			SCIMProvisionedResourceColumnResourceType.identifier(),
+			SCIMProvisionedResourceColumnResourceID.identifier(),
+			SCIMProvisionedResourceColumnRemoteID.identifier(),
+			SCIMProvisionedResourceColumnState.identifier(),
+			SCIMProvisionedResourceColumnError.identifier(),
+			SCIMProvisionedResourceColumnCreationDate.identifier(),
+			SCIMProvisionedResourceColumnChangeDate.identifier(),
+			SCIMProvisionedResourceColumnSequence.identifier(),
+			countColumn.identifier(),
+		).From(scimProvisionedResourceTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*SCIMProvisionedResources, error) {
+			resources := make([]*SCIMProvisionedResource, 0)
+			var count uint64
+			for rows.Next() {
+				resource := new(SCIMProvisionedResource)
+				var (
+					remoteID sql.NullString
+					errorMsg sql.NullString
+				)
+				err := rows.Scan(
+					&resource.AppID,
+					&resource.ResourceType,
+					&resource.ResourceID,
+					&remoteID,
+					&resource.State,
+					&errorMsg,
+					&resource.CreationDate,
+					&resource.ChangeDate,
+					&resource.Sequence,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				resource.RemoteID = remoteID.String
+				resource.Error = errorMsg.String
+				resources = append(resources, resource)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Sp3qC", "Errors.Query.CloseRows")
+			}
+			return &SCIMProvisionedResources{
+				Resources: resources,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+// SCIMProvisioningTarget is a connector a user or group has to be provisioned into or deprovisioned from.
+// Entitled is true as long as the resource is authorized for the project of the application,
+// RemoteID is set if the resource was already provisioned.
+type SCIMProvisioningTarget struct {
+	AppID           string
+	ProjectID       string
+	ResourceOwner   string
+	Endpoint        string
+	AuthType        domain.SCIMProvisioningAuthType
+	Token           string
+	ClientID        string
+	ClientSecret    string
+	TokenEndpoint   string
+	Scopes          []string
+	ProvisionGroups bool
+	RemoteID        string
+	Entitled        bool
+}
+
+//go:embed scim_provisioning_targets.sql
+var scimProvisioningTargetsQuery string
+
+// SCIMProvisioningTargets returns the connectors of the instance the resource is either entitled for or already provisioned into.
+// The secrets are returned decrypted.
+// It is used by the provisioning worker and therefore doesn't check any permission.
+func (q *Queries) SCIMProvisioningTargets(ctx context.Context, shouldTriggerBulk bool, resourceType domain.SCIMProvisioningResourceType, resourceID string) (_ []*SCIMProvisioningTarget, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if shouldTriggerBulk {
+		triggerBatch(ctx,
+			projection.SCIMProvisioningProjection,
+			projection.UserGrantProjection,
+			projection.GroupUsersProjection,
+			projection.GroupGrantProjection,
+		)
+	}
+
+	targets := make([]*SCIMProvisioningTarget, 0)
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var (
+				target        = new(SCIMProvisioningTarget)
+				token         *crypto.CryptoValue
+				clientID      sql.NullString
+				clientSecret  *crypto.CryptoValue
+				tokenEndpoint sql.NullString
+				scopes        database.TextArray[string]
+				remoteID      sql.NullString
+			)
+			if err := rows.Scan(
+				&target.AppID,
+				&target.ProjectID,
+				&target.ResourceOwner,
+				&target.Endpoint,
+				&target.AuthType,
+				&token,
+				&clientID,
+				&clientSecret,
+				&tokenEndpoint,
+				&scopes,
+				&target.ProvisionGroups,
+				&remoteID,
+				&target.Entitled,
+			); err != nil {
+				return err
+			}
+			if !target.Entitled && !remoteID.Valid {
+				continue
+			}
+			target.ClientID = clientID.String
+			target.TokenEndpoint = tokenEndpoint.String
+			target.Scopes = scopes
+			target.RemoteID = remoteID.String
+			if err := target.decryptSecrets(token, clientSecret, q.targetEncryptionAlgorithm); err != nil {
+				return err
+			}
+			targets = append(targets, target)
+		}
+		return rows.Err()
+	},
+		scimProvisioningTargetsQuery,
+		authz.GetInstance(ctx).InstanceID(),
+		string(resourceType),
+		resourceID,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Sp3tI", "Errors.Internal")
+	}
+	return targets, nil
+}
+
+func (t *SCIMProvisioningTarget) decryptSecrets(token, clientSecret *crypto.CryptoValue, alg crypto.EncryptionAlgorithm) (err error) {
+	if token != nil {
+		if t.Token, err = crypto.DecryptString(token, alg); err != nil {
+			return err
+		}
+	}
+	if clientSecret != nil {
+		if t.ClientSecret, err = crypto.DecryptString(clientSecret, alg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SCIMProvisioningGroupMember is a member of a group, RemoteID is set if the user is provisioned into the downstream application.
+type SCIMProvisioningGroupMember struct {
+	UserID   string
+	RemoteID string
+}
+
+//go:embed scim_provisioning_group_members.sql
+var scimProvisioningGroupMembersQuery string
+
+// SCIMProvisioningGroupMembers returns the members of the group and their ids in the downstream application.
+// It is used by the provisioning worker and therefore doesn't check any permission.
+func (q *Queries) SCIMProvisioningGroupMembers(ctx context.Context, appID, groupID string) (_ []*SCIMProvisioningGroupMember, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	members := make([]*SCIMProvisioningGroupMember, 0)
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var (
+				member   = new(SCIMProvisioningGroupMember)
+				remoteID sql.NullString
+			)
+			if err := rows.Scan(&member.UserID, &remoteID); err != nil {
+				return err
+			}
+			member.RemoteID = remoteID.String
+			members = append(members, member)
+		}
+		return rows.Err()
+	},
+		scimProvisioningGroupMembersQuery,
+		authz.GetInstance(ctx).InstanceID(),
+		appID,
+		groupID,
+	)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Sp3gM", "Errors.Internal")
+	}
+	return members, nil
+}
+
+//go:embed scim_provisioning_user_groups.sql
+var scimProvisionedUserGroupsQuery string
+
+// SCIMProvisionedGroupsOfUser returns the ids of the groups provisioned into the downstream application the user is a member of.
+// It is used by the provisioning worker and therefore doesn't check any permission.
+func (q *Queries) SCIMProvisionedGroupsOfUser(ctx context.Context, appID, userID string) (_ []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return q.scimProvisioningIDs(ctx, "QUERY-Sp3uG", scimProvisionedUserGroupsQuery, authz.GetInstance(ctx).InstanceID(), appID, userID)
+}
+
+//go:embed scim_provisioning_stale_users.sql
+var scimProvisioningStaleUsersQuery string
+
+// SCIMProvisioningStaleUsers returns the ids of the users still provisioned into a downstream application
+// without being authorized for its project anymore, e.g. because a group or group grant was removed.
+// It is used by the provisioning worker and therefore doesn't check any permission.
+func (q *Queries) SCIMProvisioningStaleUsers(ctx context.Context) (_ []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return q.scimProvisioningIDs(ctx, "QUERY-Sp3sU", scimProvisioningStaleUsersQuery, authz.GetInstance(ctx).InstanceID())
+}
+
+// SCIMProvisioningGroup returns the group to be provisioned.
+// It is used by the provisioning worker and therefore doesn't check any permission.
+func (q *Queries) SCIMProvisioningGroup(ctx context.Context, groupID string) (_ *Group, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return q.getGroupByID(ctx, groupID, nil)
+}
+
+func (q *Queries) scimProvisioningIDs(ctx context.Context, errID, query string, args ...any) ([]string, error) {
+	ids := make([]string, 0)
+	err := q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+		return rows.Err()
+	}, query, args...)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, errID, "Errors.Internal")
+	}
+	return ids, nil
+}
@@ -0,0 +1,12 @@
+SELECT
+	gu.user_id
+	, r.remote_id
+FROM projections.group_users1 gu
+LEFT JOIN projections.scim_provisioning_resources r
+	ON r.instance_id = gu.instance_id
+	AND r.app_id = $2
+	AND r.resource_type = 'user'
+	AND r.resource_id = gu.user_id
+WHERE gu.instance_id = $1
+AND gu.group_id = $3
+ORDER BY gu.user_id;
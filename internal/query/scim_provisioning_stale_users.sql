@@ -0,0 +1,30 @@
+SELECT DISTINCT
+	r.resource_id
+FROM projections.scim_provisioning_resources r
+JOIN projections.scim_provisioning c
+	ON c.instance_id = r.instance_id
+	AND c.app_id = r.app_id
+WHERE r.instance_id = $1
+AND r.resource_type = 'user'
+AND r.remote_id IS NOT NULL
+AND NOT EXISTS (
+	SELECT 1 FROM projections.user_grants5 ug
+	WHERE ug.instance_id = r.instance_id
+	AND ug.project_id = c.project_id
+	AND ug.user_id = r.resource_id
+	AND ug.state = 1
+)
+AND NOT EXISTS (
+	SELECT 1 FROM projections.group_users1 gu
+	JOIN projections.group_grants1 gg
+		ON gg.instance_id = gu.instance_id
+		AND gg.group_id = gu.group_id
+	JOIN projections.groups1 g
+		ON g.instance_id = gu.instance_id
+		AND g.id = gu.group_id
+	WHERE gu.instance_id = r.instance_id
+	AND gu.user_id = r.resource_id
+	AND gg.project_id = c.project_id
+	AND gg.state = 1
+	AND g.state = 1
+);
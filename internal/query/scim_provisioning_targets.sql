@@ -0,0 +1,54 @@
+SELECT
+	c.app_id
+	, c.project_id
+	, c.resource_owner
+	, c.endpoint
+	, c.auth_type
+	, c.token
+	, c.client_id
+	, c.client_secret
+	, c.token_endpoint
+	, c.scopes
+	, c.provision_groups
+	, r.remote_id
+	, CASE $2
+		WHEN 'user' THEN EXISTS (
+			SELECT 1 FROM projections.user_grants5 ug
+			WHERE ug.instance_id = c.instance_id
+			AND ug.project_id = c.project_id
+			AND ug.user_id = $3
+			AND ug.state = 1
+		) OR EXISTS (
+			SELECT 1 FROM projections.group_users1 gu
+			JOIN projections.group_grants1 gg
+				ON gg.instance_id = gu.instance_id
+				AND gg.group_id = gu.group_id
+			JOIN projections.groups1 g
+				ON g.instance_id = gu.instance_id
+				AND g.id = gu.group_id
+			WHERE gu.instance_id = c.instance_id
+			AND gu.user_id = $3
+			AND gg.project_id = c.project_id
+			AND gg.state = 1
+			AND g.state = 1
+		)
+		WHEN 'group' THEN c.provision_groups AND EXISTS (
+			SELECT 1 FROM projections.group_grants1 gg
+			JOIN projections.groups1 g
+				ON g.instance_id = gg.instance_id
+				AND g.id = gg.group_id
+			WHERE gg.instance_id = c.instance_id
+			AND gg.project_id = c.project_id
+			AND gg.group_id = $3
+			AND gg.state = 1
+			AND g.state = 1
+		)
+		ELSE FALSE
+	END AS entitled
+FROM projections.scim_provisioning c
+LEFT JOIN projections.scim_provisioning_resources r
+	ON r.instance_id = c.instance_id
+	AND r.app_id = c.app_id
+	AND r.resource_type = $2
+	AND r.resource_id = $3
+WHERE c.instance_id = $1;
@@ -0,0 +1,387 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestQueries_SCIMProvisioningTargets(t *testing.T) {
+	expQuery := regexp.QuoteMeta(scimProvisioningTargetsQuery)
+	cols := []string{"app_id", "project_id", "resource_owner", "endpoint", "auth_type", "token", "client_id", "client_secret", "token_endpoint", "scopes", "provision_groups", "remote_id", "entitled"}
+
+	tests := []struct {
+		name    string
+		mock    sqlExpectation
+		want    []*SCIMProvisioningTarget
+		wantErr error
+	}{
+		{
+			name:    "internal error",
+			mock:    mockQueryErr(expQuery, sql.ErrConnDone, "instance-id", "user", "user-id"),
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-Sp3tI", "Errors.Internal"),
+		},
+		{
+			name: "success",
+			mock: mockQueries(expQuery, cols,
+				[][]driver.Value{
+					{"app1", "project1", "org1", "https://example.com/scim/v2", domain.SCIMProvisioningAuthTypeBearer, []byte(`{"CryptoType":0,"Algorithm":"enc","KeyID":"id","Crypted":"dG9rZW4="}`), nil, nil, nil, nil, false, nil, true},
+					{"app2", "project2", "org1", "https://example.org/scim/v2", domain.SCIMProvisioningAuthTypeClientCredentials, nil, "client", []byte(`{"CryptoType":0,"Algorithm":"enc","KeyID":"id","Crypted":"c2VjcmV0"}`), "https://example.org/token", database.TextArray[string]{"scim"}, true, "remote-id", false},
+					{"app3", "project3", "org1", "https://example.net/scim/v2", domain.SCIMProvisioningAuthTypeBearer, []byte(`{"CryptoType":0,"Algorithm":"enc","KeyID":"id","Crypted":"dG9rZW4="}`), nil, nil, nil, nil, false, nil, false},
+				},
+				"instance-id", "user", "user-id",
+			),
+			want: []*SCIMProvisioningTarget{
+				{
+					AppID:         "app1",
+					ProjectID:     "project1",
+					ResourceOwner: "org1",
+					Endpoint:      "https://example.com/scim/v2",
+					AuthType:      domain.SCIMProvisioningAuthTypeBearer,
+					Token:         "token",
+					Scopes:        []string{},
+					Entitled:      true,
+				},
+				{
+					AppID:           "app2",
+					ProjectID:       "project2",
+					ResourceOwner:   "org1",
+					Endpoint:        "https://example.org/scim/v2",
+					AuthType:        domain.SCIMProvisioningAuthTypeClientCredentials,
+					ClientID:        "client",
+					ClientSecret:    "secret",
+					TokenEndpoint:   "https://example.org/token",
+					Scopes:          []string{"scim"},
+					ProvisionGroups: true,
+					RemoteID:        "remote-id",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+					targetEncryptionAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				}
+				got, err := q.SCIMProvisioningTargets(authz.NewMockContext("instance-id", "", ""), false, domain.SCIMProvisioningResourceTypeUser, "user-id")
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
+
+func TestQueries_SCIMProvisioningConnectorsExist(t *testing.T) {
+	expQuery := regexp.QuoteMeta(`SELECT 1 FROM projections.scim_provisioning WHERE projections.scim_provisioning.instance_id = $1 LIMIT 1`)
+
+	tests := []struct {
+		name    string
+		mock    sqlExpectation
+		want    bool
+		wantErr error
+	}{
+		{
+			name:    "internal error",
+			mock:    mockQueryErr(expQuery, sql.ErrConnDone, "instance-id"),
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-Sp3xI", "Errors.Internal"),
+		},
+		{
+			name: "no connector",
+			mock: mockQueries(expQuery, []string{"?column?"}, nil, "instance-id"),
+			want: false,
+		},
+		{
+			name: "connector exists",
+			mock: mockQuery(expQuery, []string{"?column?"}, []driver.Value{1}, "instance-id"),
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+				}
+				got, err := q.SCIMProvisioningConnectorsExist(authz.NewMockContext("instance-id", "", ""))
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
+
+func TestQueries_SCIMProvisioningGroupMembers(t *testing.T) {
+	expQuery := regexp.QuoteMeta(scimProvisioningGroupMembersQuery)
+	cols := []string{"user_id", "remote_id"}
+
+	tests := []struct {
+		name    string
+		mock    sqlExpectation
+		want    []*SCIMProvisioningGroupMember
+		wantErr error
+	}{
+		{
+			name:    "internal error",
+			mock:    mockQueryErr(expQuery, sql.ErrConnDone, "instance-id", "app-id", "group-id"),
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-Sp3gM", "Errors.Internal"),
+		},
+		{
+			name: "success",
+			mock: mockQueries(expQuery, cols,
+				[][]driver.Value{
+					{"user1", "remote1"},
+					{"user2", nil},
+				},
+				"instance-id", "app-id", "group-id",
+			),
+			want: []*SCIMProvisioningGroupMember{
+				{UserID: "user1", RemoteID: "remote1"},
+				{UserID: "user2"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+				}
+				got, err := q.SCIMProvisioningGroupMembers(authz.NewMockContext("instance-id", "", ""), "app-id", "group-id")
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
+
+func TestQueries_SCIMProvisioningStaleUsers(t *testing.T) {
+	expQuery := regexp.QuoteMeta(scimProvisioningStaleUsersQuery)
+
+	tests := []struct {
+		name    string
+		mock    sqlExpectation
+		want    []string
+		wantErr error
+	}{
+		{
+			name:    "internal error",
+			mock:    mockQueryErr(expQuery, sql.ErrConnDone, "instance-id"),
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-Sp3sU", "Errors.Internal"),
+		},
+		{
+			name: "success",
+			mock: mockQueries(expQuery, []string{"resource_id"},
+				[][]driver.Value{
+					{"user1"},
+					{"user2"},
+				},
+				"instance-id",
+			),
+			want: []string{"user1", "user2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+				}
+				got, err := q.SCIMProvisioningStaleUsers(authz.NewMockContext("instance-id", "", ""))
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
+
+func Test_SCIMProvisioningPrepares(t *testing.T) {
+	prepareConnectorStmt := regexp.QuoteMeta(`SELECT projections.scim_provisioning.app_id,` +
+		` projections.scim_provisioning.project_id,` +
+		` projections.scim_provisioning.resource_owner,` +
+		` projections.scim_provisioning.creation_date,` +
+		` projections.scim_provisioning.change_date,` +
+		` projections.scim_provisioning.sequence,` +
+		` projections.scim_provisioning.endpoint,` +
+		` projections.scim_provisioning.auth_type,` +
+		` projections.scim_provisioning.client_id,` +
+		` projections.scim_provisioning.token_endpoint,` +
+		` projections.scim_provisioning.scopes,` +
+		` projections.scim_provisioning.provision_groups,` +
+		` projections.scim_provisioning.last_success_date,` +
+		` projections.scim_provisioning.last_error_date,` +
+		` projections.scim_provisioning.last_error` +
+		` FROM projections.scim_provisioning`)
+	prepareConnectorCols := []string{"app_id", "project_id", "resource_owner", "creation_date", "change_date", "sequence", "endpoint", "auth_type", "client_id", "token_endpoint", "scopes", "provision_groups", "last_success_date", "last_error_date", "last_error"}
+	prepareResourcesStmt := regexp.QuoteMeta(`SELECT projections.scim_provisioning_resources.app_id,` +
+		` projections.scim_provisioning_resources.resource_type,` +
+		` projections.scim_provisioning_resources.resource_id,` +
+		` projections.scim_provisioning_resources.remote_id,` +
+		` projections.scim_provisioning_resources.state,` +
+		` projections.scim_provisioning_resources.error,` +
+		` projections.scim_provisioning_resources.creation_date,` +
+		` projections.scim_provisioning_resources.change_date,` +
+		` projections.scim_provisioning_resources.sequence,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.scim_provisioning_resources`)
+	prepareResourcesCols := []string{"app_id", "resource_type", "resource_id", "remote_id", "state", "error", "creation_date", "change_date", "sequence", "count"}
+
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareSCIMProvisioningConnectorQuery, no result",
+			prepare: prepareSCIMProvisioningConnectorQuery,
+			want: want{
+				sqlExpectations: mockQueryScanErr(
+					prepareConnectorStmt,
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*SCIMProvisioningConnector)(nil),
+		},
+		{
+			name:    "prepareSCIMProvisioningConnectorQuery, found",
+			prepare: prepareSCIMProvisioningConnectorQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					prepareConnectorStmt,
+					prepareConnectorCols,
+					[]driver.Value{
+						"app-id",
+						"project-id",
+						"ro",
+						testNow,
+						testNow,
+						uint64(20211109),
+						"https://example.com/scim/v2",
+						domain.SCIMProvisioningAuthTypeClientCredentials,
+						"client",
+						"https://example.com/token",
+						database.TextArray[string]{"scim"},
+						true,
+						testNow,
+						nil,
+						nil,
+					},
+				),
+			},
+			object: &SCIMProvisioningConnector{
+				AppID:           "app-id",
+				ProjectID:       "project-id",
+				ResourceOwner:   "ro",
+				CreationDate:    testNow,
+				ChangeDate:      testNow,
+				Sequence:        20211109,
+				Endpoint:        "https://example.com/scim/v2",
+				AuthType:        domain.SCIMProvisioningAuthTypeClientCredentials,
+				ClientID:        "client",
+				TokenEndpoint:   "https://example.com/token",
+				Scopes:          database.TextArray[string]{"scim"},
+				ProvisionGroups: true,
+				LastSuccessDate: testNow,
+			},
+		},
+		{
+			name:    "prepareSCIMProvisionedResourcesQuery, found",
+			prepare: prepareSCIMProvisionedResourcesQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					prepareResourcesStmt,
+					prepareResourcesCols,
+					[][]driver.Value{
+						{
+							"app-id",
+							"user",
+							"user-id",
+							"remote-id",
+							domain.SCIMProvisioningResourceStateProvisioned,
+							nil,
+							testNow,
+							testNow,
+							uint64(20211109),
+						},
+						{
+							"app-id",
+							"group",
+							"group-id",
+							nil,
+							domain.SCIMProvisioningResourceStateFailed,
+							"409 Conflict",
+							testNow,
+							testNow,
+							uint64(20211110),
+						},
+					},
+				),
+			},
+			object: &SCIMProvisionedResources{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Resources: []*SCIMProvisionedResource{
+					{
+						AppID:        "app-id",
+						ResourceType: domain.SCIMProvisioningResourceTypeUser,
+						ResourceID:   "user-id",
+						RemoteID:     "remote-id",
+						State:        domain.SCIMProvisioningResourceStateProvisioned,
+						CreationDate: testNow,
+						ChangeDate:   testNow,
+						Sequence:     20211109,
+					},
+					{
+						AppID:        "app-id",
+						ResourceType: domain.SCIMProvisioningResourceTypeGroup,
+						ResourceID:   "group-id",
+						State:        domain.SCIMProvisioningResourceStateFailed,
+						Error:        "409 Conflict",
+						CreationDate: testNow,
+						ChangeDate:   testNow,
+						Sequence:     20211110,
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
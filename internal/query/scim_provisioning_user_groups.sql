@@ -0,0 +1,12 @@
+SELECT
+	r.resource_id
+FROM projections.group_users1 gu
+JOIN projections.scim_provisioning_resources r
+	ON r.instance_id = gu.instance_id
+	AND r.resource_type = 'group'
+	AND r.resource_id = gu.group_id
+WHERE gu.instance_id = $1
+AND r.app_id = $2
+AND gu.user_id = $3
+AND r.remote_id IS NOT NULL
+ORDER BY r.resource_id;
@@ -156,7 +156,7 @@ func (q *Queries) SearchSecretGenerators(ctx context.Context, queries *SecretGen
 		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-sn9lw", "Errors.Query.InvalidRequest")
 	}
 
-	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
 		secretGenerators, err = scan(rows)
 		return err
 	}, stmt, args...)
@@ -42,6 +42,9 @@ type Session struct {
 	OTPSMSFactor       SessionOTPFactor
 	OTPEmailFactor     SessionOTPFactor
 	RecoveryCodeFactor SessionRecoveryCodeFactor
+	PushFactor         SessionPushFactor
+	X509Factor         SessionX509Factor
+	Risk               domain.SessionRisk
 	Metadata           map[string][]byte
 	UserAgent          domain.UserAgent
 	Expiration         time.Time
@@ -80,6 +83,14 @@ type SessionRecoveryCodeFactor struct {
 	RecoveryCodeCheckedAt time.Time
 }
 
+type SessionPushFactor struct {
+	PushCheckedAt time.Time
+}
+
+type SessionX509Factor struct {
+	X509CheckedAt time.Time
+}
+
 type SessionsSearchQueries struct {
 	SearchRequest
 	Queries []SearchQuery
@@ -225,6 +236,26 @@ var (
 		name:  projection.SessionColumnRecoveryCodeCheckedAt,
 		table: sessionsTable,
 	}
+	SessionColumnPushCheckedAt = Column{
+		name:  projection.SessionColumnPushCheckedAt,
+		table: sessionsTable,
+	}
+	SessionColumnX509CheckedAt = Column{
+		name:  projection.SessionColumnX509CheckedAt,
+		table: sessionsTable,
+	}
+	SessionColumnRiskScore = Column{
+		name:  projection.SessionColumnRiskScore,
+		table: sessionsTable,
+	}
+	SessionColumnRiskAction = Column{
+		name:  projection.SessionColumnRiskAction,
+		table: sessionsTable,
+	}
+	SessionColumnRiskSignals = Column{
+		name:  projection.SessionColumnRiskSignals,
+		table: sessionsTable,
+	}
 	SessionColumnMetadata = Column{
 		name:  projection.SessionColumnMetadata,
 		table: sessionsTable,
@@ -335,7 +366,7 @@ func (q *Queries) searchSessions(ctx context.Context, queries *SessionsSearchQue
 		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-sn9Jf", "Errors.Query.InvalidRequest")
 	}
 
-	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
 		sessions, err = scan(rows)
 		return err
 	}, stmt, args...)
@@ -397,6 +428,11 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 			SessionColumnOTPSMSCheckedAt.identifier(),
 			SessionColumnOTPEmailCheckedAt.identifier(),
 			SessionColumnRecoveryCodeCheckedAt.identifier(),
+			SessionColumnPushCheckedAt.identifier(),
+			SessionColumnX509CheckedAt.identifier(),
+			SessionColumnRiskScore.identifier(),
+			SessionColumnRiskAction.identifier(),
+			SessionColumnRiskSignals.identifier(),
 			SessionColumnMetadata.identifier(),
 			SessionColumnToken.identifier(),
 			SessionColumnUserAgentFingerprintID.identifier(),
@@ -425,6 +461,11 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 				otpSMSCheckedAt        sql.NullTime
 				otpEmailCheckedAt      sql.NullTime
 				recoveryCodesCheckedAt sql.NullTime
+				pushCheckedAt          sql.NullTime
+				x509CheckedAt          sql.NullTime
+				riskScore              sql.NullInt64
+				riskAction             sql.NullInt16
+				riskSignals            database.TextArray[domain.RiskSignal]
 				metadata               database.Map[[]byte]
 				token                  sql.NullString
 				userAgentIP            sql.NullString
@@ -453,6 +494,11 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 				&otpSMSCheckedAt,
 				&otpEmailCheckedAt,
 				&recoveryCodesCheckedAt,
+				&pushCheckedAt,
+				&x509CheckedAt,
+				&riskScore,
+				&riskAction,
+				&riskSignals,
 				&metadata,
 				&token,
 				&session.UserAgent.FingerprintID,
@@ -482,6 +528,9 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 			session.OTPSMSFactor.OTPCheckedAt = otpSMSCheckedAt.Time
 			session.OTPEmailFactor.OTPCheckedAt = otpEmailCheckedAt.Time
 			session.RecoveryCodeFactor.RecoveryCodeCheckedAt = recoveryCodesCheckedAt.Time
+			session.PushFactor.PushCheckedAt = pushCheckedAt.Time
+			session.X509Factor.X509CheckedAt = x509CheckedAt.Time
+			session.Risk = sessionRisk(riskScore, riskAction, riskSignals)
 			session.Metadata = metadata
 			session.UserAgent.Header = http.Header(userAgentHeader)
 			if userAgentIP.Valid {
@@ -514,6 +563,11 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 			SessionColumnOTPSMSCheckedAt.identifier(),
 			SessionColumnOTPEmailCheckedAt.identifier(),
 			SessionColumnRecoveryCodeCheckedAt.identifier(),
+			SessionColumnPushCheckedAt.identifier(),
+			SessionColumnX509CheckedAt.identifier(),
+			SessionColumnRiskScore.identifier(),
+			SessionColumnRiskAction.identifier(),
+			SessionColumnRiskSignals.identifier(),
 			SessionColumnMetadata.identifier(),
 			SessionColumnUserAgentFingerprintID.identifier(),
 			SessionColumnUserAgentIP.identifier(),
@@ -545,6 +599,11 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 					otpSMSCheckedAt        sql.NullTime
 					otpEmailCheckedAt      sql.NullTime
 					recoveryCodesCheckedAt sql.NullTime
+					pushCheckedAt          sql.NullTime
+					x509CheckedAt          sql.NullTime
+					riskScore              sql.NullInt64
+					riskAction             sql.NullInt16
+					riskSignals            database.TextArray[domain.RiskSignal]
 					metadata               database.Map[[]byte]
 					userAgentIP            sql.NullString
 					userAgentHeader        database.Map[[]string]
@@ -572,6 +631,11 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 					&otpSMSCheckedAt,
 					&otpEmailCheckedAt,
 					&recoveryCodesCheckedAt,
+					&pushCheckedAt,
+					&x509CheckedAt,
+					&riskScore,
+					&riskAction,
+					&riskSignals,
 					&metadata,
 					&session.UserAgent.FingerprintID,
 					&userAgentIP,
@@ -597,6 +661,9 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 				session.OTPSMSFactor.OTPCheckedAt = otpSMSCheckedAt.Time
 				session.OTPEmailFactor.OTPCheckedAt = otpEmailCheckedAt.Time
 				session.RecoveryCodeFactor.RecoveryCodeCheckedAt = recoveryCodesCheckedAt.Time
+				session.PushFactor.PushCheckedAt = pushCheckedAt.Time
+				session.X509Factor.X509CheckedAt = x509CheckedAt.Time
+				session.Risk = sessionRisk(riskScore, riskAction, riskSignals)
 				session.Metadata = metadata
 				session.UserAgent.Header = http.Header(userAgentHeader)
 				if userAgentIP.Valid {
@@ -610,3 +677,14 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 			return sessions, nil
 		}
 }
+
+func sessionRisk(score sql.NullInt64, action sql.NullInt16, signals database.TextArray[domain.RiskSignal]) domain.SessionRisk {
+	if !score.Valid {
+		return domain.SessionRisk{}
+	}
+	return domain.SessionRisk{
+		Score:   uint32(score.Int64),
+		Signals: signals,
+		Action:  domain.RiskAction(action.Int16),
+	}
+}
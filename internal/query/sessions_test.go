@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -41,6 +42,11 @@ var (
 		` projections.sessions8.otp_sms_checked_at,` +
 		` projections.sessions8.otp_email_checked_at,` +
 		` projections.sessions8.mfa_recovery_code_checked_at,` +
+		` projections.sessions8.mfa_push_checked_at,` +
+		` projections.sessions8.x509_checked_at,` +
+		` projections.sessions8.risk_score,` +
+		` projections.sessions8.risk_action,` +
+		` projections.sessions8.risk_signals,` +
 		` projections.sessions8.metadata,` +
 		` projections.sessions8.token_id,` +
 		` projections.sessions8.user_agent_fingerprint_id,` +
@@ -72,6 +78,11 @@ var (
 		` projections.sessions8.otp_sms_checked_at,` +
 		` projections.sessions8.otp_email_checked_at,` +
 		` projections.sessions8.mfa_recovery_code_checked_at,` +
+		` projections.sessions8.mfa_push_checked_at,` +
+		` projections.sessions8.x509_checked_at,` +
+		` projections.sessions8.risk_score,` +
+		` projections.sessions8.risk_action,` +
+		` projections.sessions8.risk_signals,` +
 		` projections.sessions8.metadata,` +
 		` projections.sessions8.user_agent_fingerprint_id,` +
 		` projections.sessions8.user_agent_ip,` +
@@ -105,6 +116,11 @@ var (
 		"otp_sms_checked_at",
 		"otp_email_checked_at",
 		"mfa_recovery_code_checked_at",
+		"mfa_push_checked_at",
+		"x509_checked_at",
+		"risk_score",
+		"risk_action",
+		"risk_signals",
 		"metadata",
 		"token",
 		"user_agent_fingerprint_id",
@@ -135,6 +151,11 @@ var (
 		"otp_sms_checked_at",
 		"otp_email_checked_at",
 		"mfa_recovery_code_checked_at",
+		"mfa_push_checked_at",
+		"x509_checked_at",
+		"risk_score",
+		"risk_action",
+		"risk_signals",
 		"metadata",
 		"user_agent_fingerprint_id",
 		"user_agent_ip",
@@ -197,6 +218,11 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
+							testNow,
+							nil,
+							nil,
+							nil,
 							[]byte(`{"key": "dmFsdWU="}`),
 							"fingerPrintID",
 							"1.2.3.4",
@@ -249,6 +275,12 @@ func Test_SessionsPrepare(t *testing.T) {
 						RecoveryCodeFactor: SessionRecoveryCodeFactor{
 							RecoveryCodeCheckedAt: testNow,
 						},
+						PushFactor: SessionPushFactor{
+							PushCheckedAt: testNow,
+						},
+						X509Factor: SessionX509Factor{
+							X509CheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
@@ -292,6 +324,11 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
+							testNow,
+							nil,
+							nil,
+							nil,
 							[]byte(`{"key": "dmFsdWU="}`),
 							"fingerPrintID",
 							"1.2.3.4",
@@ -320,6 +357,11 @@ func Test_SessionsPrepare(t *testing.T) {
 							testNow,
 							testNow,
 							testNow,
+							testNow,
+							testNow,
+							nil,
+							nil,
+							nil,
 							[]byte(`{"key": "dmFsdWU="}`),
 							"fingerPrintID",
 							"1.2.3.4",
@@ -372,6 +414,12 @@ func Test_SessionsPrepare(t *testing.T) {
 						RecoveryCodeFactor: SessionRecoveryCodeFactor{
 							RecoveryCodeCheckedAt: testNow,
 						},
+						PushFactor: SessionPushFactor{
+							PushCheckedAt: testNow,
+						},
+						X509Factor: SessionX509Factor{
+							X509CheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
@@ -420,6 +468,12 @@ func Test_SessionsPrepare(t *testing.T) {
 						RecoveryCodeFactor: SessionRecoveryCodeFactor{
 							RecoveryCodeCheckedAt: testNow,
 						},
+						PushFactor: SessionPushFactor{
+							PushCheckedAt: testNow,
+						},
+						X509Factor: SessionX509Factor{
+							X509CheckedAt: testNow,
+						},
 						Metadata: map[string][]byte{
 							"key": []byte("value"),
 						},
@@ -516,6 +570,11 @@ func Test_SessionPrepare(t *testing.T) {
 						testNow,
 						testNow,
 						testNow,
+						testNow,
+						testNow,
+						int64(70),
+						int16(domain.RiskActionRequireMFA),
+						database.TextArray[domain.RiskSignal]{domain.RiskSignalNewCountry, domain.RiskSignalImpossibleTravel},
 						[]byte(`{"key": "dmFsdWU="}`),
 						"tokenID",
 						"fingerPrintID",
@@ -563,6 +622,17 @@ func Test_SessionPrepare(t *testing.T) {
 				RecoveryCodeFactor: SessionRecoveryCodeFactor{
 					RecoveryCodeCheckedAt: testNow,
 				},
+				PushFactor: SessionPushFactor{
+					PushCheckedAt: testNow,
+				},
+				X509Factor: SessionX509Factor{
+					X509CheckedAt: testNow,
+				},
+				Risk: domain.SessionRisk{
+					Score:   70,
+					Signals: []domain.RiskSignal{domain.RiskSignalNewCountry, domain.RiskSignalImpossibleTravel},
+					Action:  domain.RiskActionRequireMFA,
+				},
 				Metadata: map[string][]byte{
 					"key": []byte("value"),
 				},
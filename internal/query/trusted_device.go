@@ -0,0 +1,264 @@
+package query
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// DeviceTrustPolicy returns the policy for the trusted devices of the organization.
+// If the organization has no policy or no orgID is passed, the policy of the instance is returned.
+func (q *Queries) DeviceTrustPolicy(ctx context.Context, orgID string) (_ *domain.DeviceTrustPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID != "" {
+		orgReadModel := NewOrgDeviceTrustPolicyReadModel(orgID)
+		if err = q.eventstore.FilterToQueryReducer(ctx, orgReadModel); err != nil {
+			return nil, err
+		}
+		if orgReadModel.active {
+			return orgReadModel.deviceTrustPolicy(false), nil
+		}
+	}
+	instanceReadModel := NewInstanceDeviceTrustPolicyReadModel(authz.GetInstance(ctx).InstanceID())
+	if err = q.eventstore.FilterToQueryReducer(ctx, instanceReadModel); err != nil {
+		return nil, err
+	}
+	return instanceReadModel.deviceTrustPolicy(true), nil
+}
+
+// TrustedDevices returns the devices of the user, which are still trusted, ordered by their creation.
+// The expiration of the devices is shortened to the lifetime of the current policy.
+func (q *Queries) TrustedDevices(ctx context.Context, userID string) (_ []*domain.TrustedDevice, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Tdv1U", "Errors.User.UserIDMissing")
+	}
+	readModel := NewTrustedDevicesReadModel(userID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	if readModel.ResourceOwner == "" {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Tdv1N", "Errors.User.NotFound")
+	}
+	if authz.GetCtxData(ctx).UserID != userID {
+		if err = q.checkPermission(ctx, domain.PermissionUserRead, readModel.ResourceOwner, userID); err != nil {
+			return nil, err
+		}
+	}
+	policy, err := q.DeviceTrustPolicy(ctx, readModel.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	devices := make([]*domain.TrustedDevice, 0, len(readModel.devices))
+	for _, device := range readModel.devices {
+		validUntil := device.ValidUntil(policy)
+		if !now.Before(validUntil) {
+			continue
+		}
+		device.ExpirationDate = validUntil
+		devices = append(devices, device)
+	}
+	slices.SortFunc(devices, func(a, b *domain.TrustedDevice) int {
+		return a.CreationDate.Compare(b.CreationDate)
+	})
+	return devices, nil
+}
+
+type TrustedDevicesReadModel struct {
+	*eventstore.ReadModel
+
+	devices map[string]*domain.TrustedDevice
+}
+
+func NewTrustedDevicesReadModel(userID string) *TrustedDevicesReadModel {
+	return &TrustedDevicesReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID: userID,
+		},
+		devices: make(map[string]*domain.TrustedDevice),
+	}
+}
+
+func (rm *TrustedDevicesReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *user.HumanTrustedDeviceAddedEvent:
+			rm.devices[e.DeviceID] = &domain.TrustedDevice{
+				ID:             e.DeviceID,
+				UserID:         e.Aggregate().ID,
+				ResourceOwner:  e.Aggregate().ResourceOwner,
+				FingerprintID:  e.FingerprintID,
+				Name:           e.Name,
+				MFAType:        e.MFAType,
+				CreationDate:   e.CreationDate(),
+				ExpirationDate: e.CreationDate().Add(e.Lifetime),
+			}
+		case *user.HumanTrustedDeviceCheckSucceededEvent:
+			if device, ok := rm.devices[e.DeviceID]; ok {
+				device.LastUsed = e.CreationDate()
+			}
+		case *user.HumanTrustedDeviceRemovedEvent:
+			delete(rm.devices, e.DeviceID)
+		case *user.UserRemovedEvent, *user.HumanPasswordChangedEvent, *user.HumanSignedOutEverywhereEvent:
+			rm.devices = make(map[string]*domain.TrustedDevice)
+		case *user.HumanOTPRemovedEvent:
+			rm.removeDevicesByMFAType(domain.MFATypeTOTP)
+		case *user.HumanOTPSMSRemovedEvent:
+			rm.removeDevicesByMFAType(domain.MFATypeOTPSMS)
+		case *user.HumanOTPEmailRemovedEvent:
+			rm.removeDevicesByMFAType(domain.MFATypeOTPEmail)
+		case *user.HumanU2FRemovedEvent:
+			rm.removeDevicesByMFAType(domain.MFATypeU2F)
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *TrustedDevicesReadModel) removeDevicesByMFAType(mfaType domain.MFAType) {
+	maps.DeleteFunc(rm.devices, func(_ string, device *domain.TrustedDevice) bool {
+		return device.MFAType == mfaType
+	})
+}
+
+func (rm *TrustedDevicesReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.UserV1AddedType,
+			user.UserV1RegisteredType,
+			user.UserRemovedType,
+			user.HumanTrustedDeviceAddedType,
+			user.HumanTrustedDeviceCheckSucceededType,
+			user.HumanTrustedDeviceRemovedType,
+			user.HumanPasswordChangedType,
+			user.UserV1PasswordChangedType,
+			user.HumanSignedOutEverywhereType,
+			user.HumanMFAOTPRemovedType,
+			user.UserV1MFAOTPRemovedType,
+			user.HumanOTPSMSRemovedType,
+			user.HumanOTPEmailRemovedType,
+			user.HumanU2FTokenRemovedType,
+		).
+		Builder()
+}
+
+type DeviceTrustPolicyReadModel struct {
+	*eventstore.ReadModel
+
+	active   bool
+	lifetime time.Duration
+}
+
+func (rm *DeviceTrustPolicyReadModel) deviceTrustPolicy(isDefault bool) *domain.DeviceTrustPolicy {
+	return &domain.DeviceTrustPolicy{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   rm.AggregateID,
+			Sequence:      rm.ProcessedSequence,
+			ResourceOwner: rm.ResourceOwner,
+			InstanceID:    rm.InstanceID,
+			CreationDate:  rm.CreationDate,
+			ChangeDate:    rm.ChangeDate,
+		},
+		IsDefault: isDefault,
+		Lifetime:  rm.lifetime,
+	}
+}
+
+type InstanceDeviceTrustPolicyReadModel struct {
+	DeviceTrustPolicyReadModel
+}
+
+func NewInstanceDeviceTrustPolicyReadModel(instanceID string) *InstanceDeviceTrustPolicyReadModel {
+	return &InstanceDeviceTrustPolicyReadModel{
+		DeviceTrustPolicyReadModel{
+			ReadModel: &eventstore.ReadModel{
+				AggregateID:   instanceID,
+				ResourceOwner: instanceID,
+			},
+		},
+	}
+}
+
+func (rm *InstanceDeviceTrustPolicyReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		if e, ok := event.(*instance.DeviceTrustPolicySetEvent); ok {
+			rm.active = true
+			rm.lifetime = e.Lifetime
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *InstanceDeviceTrustPolicyReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		ResourceOwner(rm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(instance.DeviceTrustPolicySetEventType).
+		Builder()
+}
+
+type OrgDeviceTrustPolicyReadModel struct {
+	DeviceTrustPolicyReadModel
+}
+
+func NewOrgDeviceTrustPolicyReadModel(orgID string) *OrgDeviceTrustPolicyReadModel {
+	return &OrgDeviceTrustPolicyReadModel{
+		DeviceTrustPolicyReadModel{
+			ReadModel: &eventstore.ReadModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+		},
+	}
+}
+
+func (rm *OrgDeviceTrustPolicyReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *org.DeviceTrustPolicySetEvent:
+			rm.active = true
+			rm.lifetime = e.Lifetime
+		case *org.DeviceTrustPolicyRemovedEvent:
+			rm.active = false
+			rm.lifetime = 0
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *OrgDeviceTrustPolicyReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		ResourceOwner(rm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			org.DeviceTrustPolicySetEventType,
+			org.DeviceTrustPolicyRemovedEventType).
+		Builder()
+}
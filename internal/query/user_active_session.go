@@ -0,0 +1,474 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type ActiveSessionType int32
+
+const (
+	ActiveSessionTypeUnspecified ActiveSessionType = iota
+	// ActiveSessionTypeSession is a session created through the session API
+	ActiveSessionTypeSession
+	// ActiveSessionTypeLogin is a session of the login UI (v1) on a user agent
+	ActiveSessionTypeLogin
+	// ActiveSessionTypeOIDCSession holds the tokens issued to a client based on a session
+	ActiveSessionTypeOIDCSession
+	// ActiveSessionTypeRefreshToken is a refresh token issued to a client based on a login UI (v1) session
+	ActiveSessionTypeRefreshToken
+)
+
+// ActiveSession is a session or token of a user, which is neither terminated, revoked nor expired.
+type ActiveSession struct {
+	ID   string
+	Type ActiveSessionType
+	// ClientID of the application, the tokens were issued to
+	ClientID string
+	// SessionID of the session, the tokens of an OIDC session were issued on
+	SessionID      string
+	UserAgent      domain.UserAgent
+	CreationDate   time.Time
+	LastUsed       time.Time
+	ExpirationDate time.Time
+}
+
+// UserActiveSessions returns all sessions and tokens of the user, which are still active, ordered by their creation:
+// the sessions created through the session API, the sessions of the login UI (v1) on each user agent,
+// the OIDC sessions with the tokens issued on them and the refresh tokens issued based on the login UI (v1).
+// Personal access tokens are not returned.
+func (q *Queries) UserActiveSessions(ctx context.Context, userID string) (_ []*ActiveSession, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "QUERY-Uas1U", "Errors.User.UserIDMissing")
+	}
+	readModel := NewUserActiveSessionsReadModel(userID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	if !readModel.exists {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Uas1N", "Errors.User.NotFound")
+	}
+	if authz.GetCtxData(ctx).UserID != userID {
+		if err = q.checkPermission(ctx, domain.PermissionSessionRead, readModel.ResourceOwner, userID); err != nil {
+			return nil, err
+		}
+	}
+	sessionsReadModel := NewUserSessionsReadModel(readModel.sessionIDs, readModel.oidcSessionIDs)
+	if len(readModel.sessionIDs) > 0 || len(readModel.oidcSessionIDs) > 0 {
+		if err = q.eventstore.FilterToQueryReducer(ctx, sessionsReadModel); err != nil {
+			return nil, err
+		}
+	}
+	policy, err := q.LoginPolicyByID(ctx, false, readModel.ResourceOwner, false)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	sessions := make([]*ActiveSession, 0, len(readModel.logins)+len(readModel.refreshTokens)+len(sessionsReadModel.sessions))
+	for _, login := range readModel.logins {
+		login.ExpirationDate = login.expiration(policy)
+		sessions = appendActiveSession(sessions, login.ActiveSession, now)
+	}
+	for _, refreshToken := range readModel.refreshTokens {
+		sessions = appendActiveSession(sessions, refreshToken.ActiveSession, now)
+	}
+	for _, s := range sessionsReadModel.sessions {
+		sessions = appendActiveSession(sessions, s, now)
+	}
+	slices.SortFunc(sessions, func(a, b *ActiveSession) int {
+		return a.CreationDate.Compare(b.CreationDate)
+	})
+	return sessions, nil
+}
+
+func appendActiveSession(sessions []*ActiveSession, session *ActiveSession, now time.Time) []*ActiveSession {
+	if !session.ExpirationDate.IsZero() && !session.ExpirationDate.After(now) {
+		return sessions
+	}
+	return append(sessions, session)
+}
+
+// loginSession is the session of the login UI (v1) on a user agent.
+// The expiration depends on the factors checked and the lifetimes of the login policy.
+type loginSession struct {
+	*ActiveSession
+
+	passwordChecked      time.Time
+	externalLoginChecked time.Time
+	multiFactorChecked   time.Time
+}
+
+func (s *loginSession) expiration(policy *LoginPolicy) time.Time {
+	var expiration time.Time
+	for _, check := range []struct {
+		checked  time.Time
+		lifetime time.Duration
+	}{
+		{s.passwordChecked, time.Duration(policy.PasswordCheckLifetime)},
+		{s.externalLoginChecked, time.Duration(policy.ExternalLoginCheckLifetime)},
+		{s.multiFactorChecked, time.Duration(policy.MultiFactorCheckLifetime)},
+	} {
+		if check.checked.IsZero() {
+			continue
+		}
+		if checkExpiration := check.checked.Add(check.lifetime); checkExpiration.After(expiration) {
+			expiration = checkExpiration
+		}
+	}
+	return expiration
+}
+
+// refreshTokenSession is a refresh token issued based on the login UI (v1).
+// It expires after the idle expiration or at the latest at the (absolute) expiration.
+type refreshTokenSession struct {
+	*ActiveSession
+
+	expiration time.Time
+}
+
+// UserActiveSessionsReadModel reduces the login UI (v1) sessions and refresh tokens of a user
+// and collects the IDs of the sessions and OIDC sessions created for the user.
+type UserActiveSessionsReadModel struct {
+	*eventstore.ReadModel
+
+	exists         bool
+	logins         map[string]*loginSession
+	refreshTokens  map[string]*refreshTokenSession
+	sessionIDs     []string
+	oidcSessionIDs []string
+}
+
+func NewUserActiveSessionsReadModel(userID string) *UserActiveSessionsReadModel {
+	return &UserActiveSessionsReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID: userID,
+		},
+		logins:        make(map[string]*loginSession),
+		refreshTokens: make(map[string]*refreshTokenSession),
+	}
+}
+
+func (rm *UserActiveSessionsReadModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *session.UserCheckedEvent:
+			if !slices.Contains(rm.sessionIDs, e.Aggregate().ID) {
+				rm.sessionIDs = append(rm.sessionIDs, e.Aggregate().ID)
+			}
+		case *oidcsession.AddedEvent:
+			rm.oidcSessionIDs = append(rm.oidcSessionIDs, e.Aggregate().ID)
+		default:
+			if event.Aggregate().Type == user.AggregateType {
+				rm.ReadModel.AppendEvents(event)
+			}
+		}
+	}
+}
+
+func (rm *UserActiveSessionsReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent:
+			rm.exists = true
+			rm.ResourceOwner = e.Aggregate().ResourceOwner
+		case *user.HumanPasswordCheckSucceededEvent:
+			if login := rm.login(e, e.AuthRequestInfo); login != nil {
+				login.passwordChecked = e.CreatedAt()
+			}
+		case *user.UserIDPCheckSucceededEvent:
+			if login := rm.login(e, e.AuthRequestInfo); login != nil {
+				login.externalLoginChecked = e.CreatedAt()
+			}
+		case *user.HumanPasswordlessCheckSucceededEvent:
+			if login := rm.login(e, e.AuthRequestInfo); login != nil {
+				login.multiFactorChecked = e.CreatedAt()
+			}
+		case *user.HumanX509CheckSucceededEvent:
+			if login := rm.login(e, e.AuthRequestInfo); login != nil {
+				login.multiFactorChecked = e.CreatedAt()
+			}
+		case *user.HumanSignedOutEvent:
+			delete(rm.logins, e.UserAgentID)
+			for id, refreshToken := range rm.refreshTokens {
+				if refreshToken.UserAgent.GetFingerprintID() == e.UserAgentID {
+					delete(rm.refreshTokens, id)
+				}
+			}
+		case *user.HumanRefreshTokenAddedEvent:
+			refreshToken := &refreshTokenSession{
+				ActiveSession: &ActiveSession{
+					ID:           e.TokenID,
+					Type:         ActiveSessionTypeRefreshToken,
+					ClientID:     e.ClientID,
+					UserAgent:    domain.UserAgent{FingerprintID: &e.UserAgentID},
+					CreationDate: e.CreatedAt(),
+					LastUsed:     e.CreatedAt(),
+				},
+				expiration: e.CreatedAt().Add(e.Expiration),
+			}
+			refreshToken.ExpirationDate = earliest(refreshToken.expiration, e.CreatedAt().Add(e.IdleExpiration))
+			rm.refreshTokens[e.TokenID] = refreshToken
+		case *user.HumanRefreshTokenRenewedEvent:
+			if refreshToken, ok := rm.refreshTokens[e.TokenID]; ok {
+				refreshToken.LastUsed = e.CreatedAt()
+				refreshToken.ExpirationDate = earliest(refreshToken.expiration, e.CreatedAt().Add(e.IdleExpiration))
+			}
+		case *user.HumanRefreshTokenRemovedEvent:
+			delete(rm.refreshTokens, e.TokenID)
+		case *user.HumanSignedOutEverywhereEvent,
+			*user.UserLockedEvent,
+			*user.UserDeactivatedEvent:
+			rm.logins = make(map[string]*loginSession)
+			rm.refreshTokens = make(map[string]*refreshTokenSession)
+		case *user.UserRemovedEvent:
+			rm.exists = false
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+// login returns the login UI (v1) session of the user agent the factor was checked on.
+func (rm *UserActiveSessionsReadModel) login(event eventstore.Event, info *user.AuthRequestInfo) *loginSession {
+	if info == nil || info.UserAgentID == "" {
+		return nil
+	}
+	login, ok := rm.logins[info.UserAgentID]
+	if !ok {
+		login = &loginSession{
+			ActiveSession: &ActiveSession{
+				ID:           info.UserAgentID,
+				Type:         ActiveSessionTypeLogin,
+				UserAgent:    domain.UserAgent{FingerprintID: &info.UserAgentID},
+				CreationDate: event.CreatedAt(),
+			},
+		}
+		rm.logins[info.UserAgentID] = login
+	}
+	login.LastUsed = event.CreatedAt()
+	if info.BrowserInfo != nil {
+		login.UserAgent.IP = info.RemoteIP
+		if info.UserAgent != "" {
+			login.UserAgent.Description = &info.BrowserInfo.UserAgent
+		}
+	}
+	return login
+}
+
+func (rm *UserActiveSessionsReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.UserV1AddedType,
+			user.UserV1RegisteredType,
+			user.UserV1PasswordCheckSucceededType,
+			user.HumanPasswordCheckSucceededType,
+			user.UserIDPLoginCheckSucceededType,
+			user.HumanPasswordlessTokenCheckSucceededType,
+			user.HumanX509CheckSucceededType,
+			user.UserV1SignedOutType,
+			user.HumanSignedOutType,
+			user.HumanSignedOutEverywhereType,
+			user.HumanRefreshTokenAddedType,
+			user.HumanRefreshTokenRenewedType,
+			user.HumanRefreshTokenRemovedType,
+			user.UserLockedType,
+			user.UserDeactivatedType,
+			user.UserRemovedType,
+		).
+		Builder().
+		AddQuery().
+		AggregateTypes(session.AggregateType).
+		EventTypes(session.UserCheckedType).
+		EventData(map[string]interface{}{
+			"userID": rm.AggregateID,
+		}).
+		Builder().
+		AddQuery().
+		AggregateTypes(oidcsession.AggregateType).
+		EventTypes(oidcsession.AddedType).
+		EventData(map[string]interface{}{
+			"userID": rm.AggregateID,
+		}).
+		Builder()
+}
+
+// UserSessionsReadModel reduces the sessions and OIDC sessions of a user.
+// Terminated sessions and OIDC sessions without active tokens are removed.
+type UserSessionsReadModel struct {
+	*eventstore.ReadModel
+
+	sessionIDs     []string
+	oidcSessionIDs []string
+
+	sessions map[string]*ActiveSession
+	// oidcSessions holds the expiration of the access and refresh token of the OIDC sessions
+	oidcSessions map[string]*oidcSessionTokens
+}
+
+type oidcSessionTokens struct {
+	accessTokenExpiration      time.Time
+	refreshTokenExpiration     time.Time
+	refreshTokenIdleExpiration time.Time
+}
+
+// expiration returns the time, when the last token of the OIDC session expires.
+func (t *oidcSessionTokens) expiration() time.Time {
+	refreshTokenExpiration := earliest(t.refreshTokenExpiration, t.refreshTokenIdleExpiration)
+	if refreshTokenExpiration.After(t.accessTokenExpiration) {
+		return refreshTokenExpiration
+	}
+	return t.accessTokenExpiration
+}
+
+func NewUserSessionsReadModel(sessionIDs, oidcSessionIDs []string) *UserSessionsReadModel {
+	return &UserSessionsReadModel{
+		ReadModel:      &eventstore.ReadModel{},
+		sessionIDs:     sessionIDs,
+		oidcSessionIDs: oidcSessionIDs,
+		sessions:       make(map[string]*ActiveSession, len(sessionIDs)+len(oidcSessionIDs)),
+		oidcSessions:   make(map[string]*oidcSessionTokens, len(oidcSessionIDs)),
+	}
+}
+
+func (rm *UserSessionsReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *session.AddedEvent:
+			s := &ActiveSession{
+				ID:           e.Aggregate().ID,
+				Type:         ActiveSessionTypeSession,
+				CreationDate: e.CreatedAt(),
+				LastUsed:     e.CreatedAt(),
+			}
+			if e.UserAgent != nil {
+				s.UserAgent = *e.UserAgent
+			}
+			rm.sessions[e.Aggregate().ID] = s
+		case *session.TokenSetEvent:
+			if s, ok := rm.sessions[e.Aggregate().ID]; ok {
+				s.LastUsed = e.CreatedAt()
+			}
+		case *session.LifetimeSetEvent:
+			if s, ok := rm.sessions[e.Aggregate().ID]; ok {
+				s.ExpirationDate = time.Time{}
+				if e.Lifetime > 0 {
+					s.ExpirationDate = e.CreatedAt().Add(e.Lifetime)
+				}
+			}
+		case *session.TerminateEvent:
+			delete(rm.sessions, e.Aggregate().ID)
+		case *oidcsession.AddedEvent:
+			s := &ActiveSession{
+				ID:           e.Aggregate().ID,
+				Type:         ActiveSessionTypeOIDCSession,
+				ClientID:     e.ClientID,
+				SessionID:    e.SessionID,
+				CreationDate: e.CreatedAt(),
+				LastUsed:     e.CreatedAt(),
+				// the OIDC session is only active as long as tokens issued on it are valid
+				ExpirationDate: e.CreatedAt(),
+			}
+			if e.UserAgent != nil {
+				s.UserAgent = *e.UserAgent
+			}
+			rm.sessions[e.Aggregate().ID] = s
+			rm.oidcSessions[e.Aggregate().ID] = new(oidcSessionTokens)
+		case *oidcsession.AccessTokenAddedEvent:
+			rm.reduceOIDCSessionTokens(e, func(tokens *oidcSessionTokens) {
+				tokens.accessTokenExpiration = e.CreatedAt().Add(e.Lifetime)
+			})
+		case *oidcsession.AccessTokenRevokedEvent:
+			rm.reduceOIDCSessionTokens(e, func(tokens *oidcSessionTokens) {
+				tokens.accessTokenExpiration = e.CreatedAt()
+			})
+		case *oidcsession.RefreshTokenAddedEvent:
+			rm.reduceOIDCSessionTokens(e, func(tokens *oidcSessionTokens) {
+				tokens.refreshTokenExpiration = e.CreatedAt().Add(e.Lifetime)
+				tokens.refreshTokenIdleExpiration = e.CreatedAt().Add(e.IdleLifetime)
+			})
+		case *oidcsession.RefreshTokenRenewedEvent:
+			rm.reduceOIDCSessionTokens(e, func(tokens *oidcSessionTokens) {
+				tokens.refreshTokenIdleExpiration = e.CreatedAt().Add(e.IdleLifetime)
+			})
+		case *oidcsession.RefreshTokenRevokedEvent:
+			rm.reduceOIDCSessionTokens(e, func(tokens *oidcSessionTokens) {
+				tokens.accessTokenExpiration = e.CreatedAt()
+				tokens.refreshTokenExpiration = e.CreatedAt()
+				tokens.refreshTokenIdleExpiration = e.CreatedAt()
+			})
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+// reduceOIDCSessionTokens updates the tokens of the OIDC session
+// and sets the last usage and the expiration of the OIDC session accordingly.
+func (rm *UserSessionsReadModel) reduceOIDCSessionTokens(event eventstore.Event, reduce func(tokens *oidcSessionTokens)) {
+	tokens, ok := rm.oidcSessions[event.Aggregate().ID]
+	if !ok {
+		return
+	}
+	reduce(tokens)
+	s := rm.sessions[event.Aggregate().ID]
+	s.LastUsed = event.CreatedAt()
+	s.ExpirationDate = tokens.expiration()
+}
+
+func (rm *UserSessionsReadModel) Query() *eventstore.SearchQueryBuilder {
+	query := eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions()
+	if len(rm.sessionIDs) > 0 {
+		query = query.AddQuery().
+			AggregateTypes(session.AggregateType).
+			AggregateIDs(rm.sessionIDs...).
+			EventTypes(
+				session.AddedType,
+				session.TokenSetType,
+				session.LifetimeSetType,
+				session.TerminateType,
+			).
+			Builder()
+	}
+	if len(rm.oidcSessionIDs) > 0 {
+		query = query.AddQuery().
+			AggregateTypes(oidcsession.AggregateType).
+			AggregateIDs(rm.oidcSessionIDs...).
+			EventTypes(
+				oidcsession.AddedType,
+				oidcsession.AccessTokenAddedType,
+				oidcsession.AccessTokenRevokedType,
+				oidcsession.RefreshTokenAddedType,
+				oidcsession.RefreshTokenRenewedType,
+				oidcsession.RefreshTokenRevokedType,
+			).
+			Builder()
+	}
+	return query
+}
+
+// earliest returns the earlier of both times.
+func earliest(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
@@ -57,6 +57,10 @@ type UserGrant struct {
 	GrantedOrgID     string `json:"granted_org_id,omitempty"`
 	GrantedOrgName   string `json:"granted_org_name,omitempty"`
 	GrantedOrgDomain string `json:"granted_org_domain,omitempty"`
+
+	// GroupID is set if the grant is inherited from a group the user is member of.
+	// In this case ID represents the id of the group grant.
+	GroupID string `json:"group_id,omitempty"`
 }
 
 type Role struct {
@@ -73,12 +77,12 @@ type UserGrants struct {
 func userGrantsCheckPermission(ctx context.Context, grants *UserGrants, permissionCheck domain.PermissionCheck) {
 	grants.UserGrants = slices.DeleteFunc(grants.UserGrants,
 		func(grant *UserGrant) bool {
-			return userGrantCheckPermission(ctx, grant.ResourceOwner, grant.ProjectID, grant.GrantID, grant.UserID, permissionCheck) != nil
+			return userGrantCheckPermission(ctx, grant.ResourceOwner, grant.ProjectID, grant.GrantID, grant.UserID, grant.Roles, permissionCheck) != nil
 		},
 	)
 }
 
-func userGrantCheckPermission(ctx context.Context, resourceOwner, projectID, grantID, userID string, permissionCheck domain.PermissionCheck) error {
+func userGrantCheckPermission(ctx context.Context, resourceOwner, projectID, grantID, userID string, roleKeys []string, permissionCheck domain.PermissionCheck) error {
 	// you should always be able to read your own permissions
 	if authz.GetCtxData(ctx).UserID == userID {
 		return nil
@@ -88,18 +92,36 @@ func userGrantCheckPermission(ctx context.Context, resourceOwner, projectID, gra
 		return permissionCheck(ctx, domain.PermissionUserGrantRead, resourceOwner, grantID)
 	}
 	// check on project
-	return permissionCheck(ctx, domain.PermissionUserGrantRead, resourceOwner, projectID)
+	err := permissionCheck(ctx, domain.PermissionUserGrantRead, resourceOwner, projectID)
+	if err == nil || len(roleKeys) == 0 {
+		return err
+	}
+	// administrators of single roles are only permitted on grants consisting of their roles
+	for _, roleKey := range roleKeys {
+		if permissionCheck(ctx, domain.PermissionUserGrantRead, resourceOwner, domain.ProjectRoleContextID(projectID, roleKey)) != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 type UserGrantsQueries struct {
 	SearchRequest
 	Queries []SearchQuery
+	// IncludeInherited adds the grants users inherit through the groups they are member of.
+	IncludeInherited bool
 }
 
 func (q *UserGrantsQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	if q.IncludeInherited {
+		q.SortingColumn = userGrantColumnOnTable(q.SortingColumn, inheritedUserGrantTable)
+	}
 	query = q.SearchRequest.toQuery(query)
-	for _, q := range q.Queries {
-		query = q.toQuery(query)
+	for _, q2 := range q.Queries {
+		if q.IncludeInherited {
+			q2 = userGrantQueryOnTable(q2, inheritedUserGrantTable)
+		}
+		query = q2.toQuery(query)
 	}
 	return query
 }
@@ -108,13 +130,23 @@ func userGrantPermissionCheckV2(ctx context.Context, query sq.SelectBuilder, ena
 	if !enabled {
 		return query
 	}
+	grants := userGrantTable
+	searchQueries := queries.Queries
+	if queries.IncludeInherited {
+		grants = inheritedUserGrantTable
+		searchQueries = make([]SearchQuery, len(queries.Queries))
+		for i, searchQuery := range queries.Queries {
+			searchQueries[i] = userGrantQueryOnTable(searchQuery, grants)
+		}
+	}
 	join, args := PermissionClause(
 		ctx,
-		UserGrantResourceOwner,
+		userGrantColumnOnTable(UserGrantResourceOwner, grants),
 		domain.PermissionUserGrantRead,
-		SingleOrgPermissionOption(queries.Queries),
-		WithProjectsPermissionOption(UserGrantProjectID),
-		OwnedRowsPermissionOption(UserGrantUserID),
+		SingleOrgPermissionOption(searchQueries),
+		WithProjectsPermissionOption(userGrantColumnOnTable(UserGrantProjectID, grants)),
+		WithProjectRolesPermissionOption(userGrantColumnOnTable(UserGrantRoles, grants)),
+		OwnedRowsPermissionOption(userGrantColumnOnTable(UserGrantUserID, grants)),
 	)
 	return query.JoinClause(join, args...)
 }
@@ -312,8 +344,78 @@ var (
 		name:  projection.OrgColumnDomain,
 		table: GrantedOrgsTable,
 	}
+
+	// inheritedUserGrantTable combines the grants of the users with the grants
+	// of the groups they are member of.
+	inheritedUserGrantTable = table{
+		name: "(" +
+			"SELECT id, creation_date, change_date, sequence, state, resource_owner, instance_id, user_id, project_id, grant_id, roles, NULL::TEXT AS group_id" +
+			" FROM " + projection.UserGrantProjectionTable +
+			" UNION ALL " +
+			"SELECT gg.id, gg.creation_date, gg.change_date, gg.sequence, gg.state, gg.resource_owner, gg.instance_id, gu.user_id, gg.project_id, gg.grant_id, gg.roles, gg.group_id" +
+			" FROM " + projection.GroupGrantProjectionTable + " gg" +
+			" JOIN " + projection.GroupUsersProjectionTable + " gu ON gu.instance_id = gg.instance_id AND gu.group_id = gg.group_id" +
+			")",
+		alias:         "user_grants",
+		instanceIDCol: projection.UserGrantInstanceID,
+	}
+	inheritedUserGrantGroupID = Column{
+		name:  projection.GroupGrantGroupID,
+		table: inheritedUserGrantTable,
+	}
 )
 
+// userGrantColumnOnTable returns the column bound to the given grants table
+// if it is a column of the user grants projection.
+func userGrantColumnOnTable(col Column, grants table) Column {
+	if col.table != userGrantTable {
+		return col
+	}
+	return col.setTable(grants)
+}
+
+// userGrantQueryOnTable returns a copy of the query with all user grant columns bound to the given grants table.
+func userGrantQueryOnTable(query SearchQuery, grants table) SearchQuery {
+	switch q := query.(type) {
+	case *textQuery:
+		c := *q
+		c.Column = userGrantColumnOnTable(q.Column, grants)
+		return &c
+	case *listQuery:
+		c := *q
+		c.Column = userGrantColumnOnTable(q.Column, grants)
+		return &c
+	case *NumberQuery:
+		c := *q
+		c.Column = userGrantColumnOnTable(q.Column, grants)
+		return &c
+	case *InTextQuery:
+		c := *q
+		c.Column = userGrantColumnOnTable(q.Column, grants)
+		return &c
+	case *BoolQuery:
+		c := *q
+		c.Column = userGrantColumnOnTable(q.Column, grants)
+		return &c
+	case *OrQuery:
+		queries := make([]SearchQuery, len(q.queries))
+		for i, query := range q.queries {
+			queries[i] = userGrantQueryOnTable(query, grants)
+		}
+		return &OrQuery{queries: queries}
+	case *AndQuery:
+		queries := make([]SearchQuery, len(q.queries))
+		for i, query := range q.queries {
+			queries[i] = userGrantQueryOnTable(query, grants)
+		}
+		return &AndQuery{queries: queries}
+	case *NotQuery:
+		return &NotQuery{query: userGrantQueryOnTable(q.query, grants)}
+	default:
+		return query
+	}
+}
+
 func (q *Queries) UserGrant(ctx context.Context, shouldTriggerBulk bool, queries ...SearchQuery) (grant *UserGrant, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -366,15 +468,22 @@ func (q *Queries) userGrants(ctx context.Context, queries *UserGrantsQueries, sh
 		traceSpan.EndWithError(err)
 	}
 
+	grantsTable := userGrantTable
 	query, scan := prepareUserGrantsQuery()
+	stateTables := []table{userGrantTable}
+	if queries.IncludeInherited {
+		grantsTable = inheritedUserGrantTable
+		query, scan = prepareInheritedUserGrantsQuery()
+		stateTables = append(stateTables, groupGrantTable, groupUsersTable)
+	}
 	query = userGrantPermissionCheckV2(ctx, query, permissionCheckV2, queries)
-	eq := sq.Eq{UserGrantInstanceID.identifier(): authz.GetInstance(ctx).InstanceID()}
+	eq := sq.Eq{userGrantColumnOnTable(UserGrantInstanceID, grantsTable).identifier(): authz.GetInstance(ctx).InstanceID()}
 	stmt, args, err := queries.toQuery(query).Where(eq).ToSql()
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-wXnQR", "Errors.Query.SQLStatement")
 	}
 
-	latestState, err := q.latestState(ctx, userGrantTable)
+	latestState, err := q.latestState(ctx, stateTables...)
 	if err != nil {
 		return nil, err
 	}
@@ -540,53 +649,73 @@ func prepareUserGrantQuery() (sq.SelectBuilder, func(*sql.Row) (*UserGrant, erro
 }
 
 func prepareUserGrantsQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, error)) {
-	return sq.Select(
-			UserGrantID.identifier(),
-			UserGrantCreationDate.identifier(),
-			UserGrantChangeDate.identifier(),
-			UserGrantSequence.identifier(),
-			UserGrantGrantID.identifier(),
-			UserGrantRoles.identifier(),
-			"roles.role_information",
-			UserGrantState.identifier(),
-
-			UserGrantUserID.identifier(),
-			UserUsernameCol.identifier(),
-			UserTypeCol.identifier(),
-			UserOrgColumnId.identifier(),
-			UserOrgColumnName.identifier(),
-			UserOrgColumnDomain.identifier(),
-			HumanFirstNameCol.identifier(),
-			HumanLastNameCol.identifier(),
-			HumanEmailCol.identifier(),
-			HumanDisplayNameCol.identifier(),
-			HumanAvatarURLCol.identifier(),
-			LoginNameNameCol.identifier(),
-
-			UserGrantResourceOwner.identifier(),
-			OrgColumnName.identifier(),
-			OrgColumnDomain.identifier(),
-
-			UserGrantProjectID.identifier(),
-			ProjectColumnName.identifier(),
-			ProjectColumnResourceOwner.identifier(),
-
-			GrantedOrgColumnId.identifier(),
-			GrantedOrgColumnName.identifier(),
-			GrantedOrgColumnDomain.identifier(),
-
-			countColumn.identifier(),
-		).
-			From(userGrantTable.identifier()).
-			LeftJoin(join(UserIDCol, UserGrantUserID)).
-			LeftJoin(join(HumanUserIDCol, UserGrantUserID)).
-			LeftJoin(join(OrgColumnID, UserGrantResourceOwner)).
-			LeftJoin(join(ProjectColumnID, UserGrantProjectID)).
+	return prepareUserGrantsQueryOnTable(userGrantTable, Column{})
+}
+
+func prepareInheritedUserGrantsQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, error)) {
+	return prepareUserGrantsQueryOnTable(inheritedUserGrantTable, inheritedUserGrantGroupID)
+}
+
+// prepareUserGrantsQuery selects the user grants from the given grants table.
+// If groupIDCol is set, the id of the group the grant was inherited from is returned as well.
+func prepareUserGrantsQueryOnTable(grants table, groupIDCol Column) (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, error)) {
+	grantIDCol := userGrantColumnOnTable(UserGrantID, grants)
+	grantUserIDCol := userGrantColumnOnTable(UserGrantUserID, grants)
+	grantResourceOwnerCol := userGrantColumnOnTable(UserGrantResourceOwner, grants)
+	grantProjectIDCol := userGrantColumnOnTable(UserGrantProjectID, grants)
+	grantGrantIDCol := userGrantColumnOnTable(UserGrantGrantID, grants)
+
+	columns := []string{
+		grantIDCol.identifier(),
+		userGrantColumnOnTable(UserGrantCreationDate, grants).identifier(),
+		userGrantColumnOnTable(UserGrantChangeDate, grants).identifier(),
+		userGrantColumnOnTable(UserGrantSequence, grants).identifier(),
+		grantGrantIDCol.identifier(),
+		userGrantColumnOnTable(UserGrantRoles, grants).identifier(),
+		"roles.role_information",
+		userGrantColumnOnTable(UserGrantState, grants).identifier(),
+
+		grantUserIDCol.identifier(),
+		UserUsernameCol.identifier(),
+		UserTypeCol.identifier(),
+		UserOrgColumnId.identifier(),
+		UserOrgColumnName.identifier(),
+		UserOrgColumnDomain.identifier(),
+		HumanFirstNameCol.identifier(),
+		HumanLastNameCol.identifier(),
+		HumanEmailCol.identifier(),
+		HumanDisplayNameCol.identifier(),
+		HumanAvatarURLCol.identifier(),
+		LoginNameNameCol.identifier(),
+
+		grantResourceOwnerCol.identifier(),
+		OrgColumnName.identifier(),
+		OrgColumnDomain.identifier(),
+
+		grantProjectIDCol.identifier(),
+		ProjectColumnName.identifier(),
+		ProjectColumnResourceOwner.identifier(),
+
+		GrantedOrgColumnId.identifier(),
+		GrantedOrgColumnName.identifier(),
+		GrantedOrgColumnDomain.identifier(),
+	}
+	if !groupIDCol.isZero() {
+		columns = append(columns, groupIDCol.identifier())
+	}
+	columns = append(columns, countColumn.identifier())
+
+	return sq.Select(columns...).
+			From(grants.identifier()).
+			LeftJoin(join(UserIDCol, grantUserIDCol)).
+			LeftJoin(join(HumanUserIDCol, grantUserIDCol)).
+			LeftJoin(join(OrgColumnID, grantResourceOwnerCol)).
+			LeftJoin(join(ProjectColumnID, grantProjectIDCol)).
 			LeftJoin(join(UserOrgColumnId, UserResourceOwnerCol)).
-			LeftJoin(join(ProjectGrantColumnGrantID, UserGrantGrantID) + " AND " + ProjectGrantColumnProjectID.identifier() + " = " + UserGrantProjectID.identifier()).
+			LeftJoin(join(ProjectGrantColumnGrantID, grantGrantIDCol) + " AND " + ProjectGrantColumnProjectID.identifier() + " = " + grantProjectIDCol.identifier()).
 			LeftJoin(join(GrantedOrgColumnId, ProjectGrantColumnGrantedOrgID)).
-			LeftJoin(join(LoginNameUserIDCol, UserGrantUserID)).
-			LeftJoin("LATERAL (" + rolesInfoQuery + ") as roles ON true").
+			LeftJoin(join(LoginNameUserIDCol, grantUserIDCol)).
+			LeftJoin("LATERAL (" + userGrantRolesInfoQuery(grants) + ") as roles ON true").
 			Where(
 				sq.Eq{LoginNameIsPrimaryCol.identifier(): true},
 			).PlaceholderFormat(sq.Dollar),
@@ -620,9 +749,11 @@ func prepareUserGrantsQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, e
 
 					projectName          sql.NullString
 					projectResourceOwner sql.NullString
+
+					groupID sql.NullString
 				)
 
-				err := rows.Scan(
+				dest := []any{
 					&g.ID,
 					&g.CreationDate,
 					&g.ChangeDate,
@@ -656,9 +787,13 @@ func prepareUserGrantsQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, e
 					&grantedOrgID,
 					&grantedOrgName,
 					&grantedOrgDomain,
+				}
+				if !groupIDCol.isZero() {
+					dest = append(dest, &groupID)
+				}
+				dest = append(dest, &count)
 
-					&count,
-				)
+				err := rows.Scan(dest...)
 				if err != nil {
 					return nil, err
 				}
@@ -687,6 +822,7 @@ func prepareUserGrantsQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, e
 				g.GrantedOrgID = grantedOrgID.String
 				g.GrantedOrgName = grantedOrgName.String
 				g.GrantedOrgDomain = grantedOrgDomain.String
+				g.GroupID = groupID.String
 
 				userGrants = append(userGrants, g)
 			}
@@ -704,8 +840,20 @@ func prepareUserGrantsQuery() (sq.SelectBuilder, func(*sql.Rows) (*UserGrants, e
 		}
 }
 
-var rolesInfoQuery = fmt.Sprintf(
-	`SELECT JSON_AGG(
+var rolesInfoQuery = userGrantRolesInfoQuery(userGrantTable)
+
+func userGrantRolesInfoQuery(grants table) string {
+	return projectRolesInfoQuery(
+		userGrantColumnOnTable(UserGrantInstanceID, grants),
+		userGrantColumnOnTable(UserGrantProjectID, grants),
+		userGrantColumnOnTable(UserGrantRoles, grants),
+	)
+}
+
+// projectRolesInfoQuery aggregates the information of the granted roles of a project.
+func projectRolesInfoQuery(instanceIDCol, projectIDCol, rolesCol Column) string {
+	return fmt.Sprintf(
+		`SELECT JSON_AGG(
                  JSON_BUILD_OBJECT(
                      'role_key', pr.role_key,
                      'display_name', pr.display_name,
@@ -716,8 +864,9 @@ var rolesInfoQuery = fmt.Sprintf(
 			 WHERE pr.instance_id = %[2]s
 			   AND pr.project_id = %[3]s
 			   AND pr.role_key = ANY(%[4]s)`,
-	projectRolesTable.identifier(),
-	UserGrantInstanceID.identifier(),
-	UserGrantProjectID.identifier(),
-	UserGrantRoles.identifier(),
-)
+		projectRolesTable.identifier(),
+		instanceIDCol.identifier(),
+		projectIDCol.identifier(),
+		rolesCol.identifier(),
+	)
+}
@@ -1,13 +1,19 @@
 package query
 
 import (
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
 	"testing"
 
+	sq "github.com/Masterminds/squirrel"
+	"github.com/muhlemmer/gu"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -132,6 +138,56 @@ var (
 		userGrantCols,
 		"count",
 	)
+	inheritedUserGrantsStmt = regexp.QuoteMeta(
+		"SELECT user_grants.id" +
+			", user_grants.creation_date" +
+			", user_grants.change_date" +
+			", user_grants.sequence" +
+			", user_grants.grant_id" +
+			", user_grants.roles" +
+			", roles.role_information" +
+			", user_grants.state" +
+			", user_grants.user_id" +
+			", projections.users14.username" +
+			", projections.users14.type" +
+			", user_orgs.id" +
+			", user_orgs.name" +
+			", user_orgs.primary_domain" +
+			", projections.users14_humans.first_name" +
+			", projections.users14_humans.last_name" +
+			", projections.users14_humans.email" +
+			", projections.users14_humans.display_name" +
+			", projections.users14_humans.avatar_key" +
+			", projections.login_names3.login_name" +
+			", user_grants.resource_owner" +
+			", projections.orgs1.name" +
+			", projections.orgs1.primary_domain" +
+			", user_grants.project_id" +
+			", projections.projects4.name" +
+			", projections.projects4.resource_owner" +
+			", granted_orgs.id" +
+			", granted_orgs.name" +
+			", granted_orgs.primary_domain" +
+			", user_grants.group_id" +
+			", COUNT(*) OVER ()" +
+			" FROM (SELECT id, creation_date, change_date, sequence, state, resource_owner, instance_id, user_id, project_id, grant_id, roles, NULL::TEXT AS group_id FROM projections.user_grants5" +
+			" UNION ALL SELECT gg.id, gg.creation_date, gg.change_date, gg.sequence, gg.state, gg.resource_owner, gg.instance_id, gu.user_id, gg.project_id, gg.grant_id, gg.roles, gg.group_id FROM projections.group_grants1 gg" +
+			" JOIN projections.group_users1 gu ON gu.instance_id = gg.instance_id AND gu.group_id = gg.group_id) AS user_grants" +
+			" LEFT JOIN projections.users14 ON user_grants.user_id = projections.users14.id AND user_grants.instance_id = projections.users14.instance_id" +
+			" LEFT JOIN projections.users14_humans ON user_grants.user_id = projections.users14_humans.user_id AND user_grants.instance_id = projections.users14_humans.instance_id" +
+			" LEFT JOIN projections.orgs1 ON user_grants.resource_owner = projections.orgs1.id AND user_grants.instance_id = projections.orgs1.instance_id" +
+			" LEFT JOIN projections.projects4 ON user_grants.project_id = projections.projects4.id AND user_grants.instance_id = projections.projects4.instance_id" +
+			" LEFT JOIN projections.orgs1 AS user_orgs ON projections.users14.resource_owner = user_orgs.id AND projections.users14.instance_id = user_orgs.instance_id" +
+			" LEFT JOIN projections.project_grants4 ON user_grants.grant_id = projections.project_grants4.grant_id AND user_grants.instance_id = projections.project_grants4.instance_id AND projections.project_grants4.project_id = user_grants.project_id" +
+			" LEFT JOIN projections.orgs1 AS granted_orgs ON projections.project_grants4.granted_org_id = granted_orgs.id AND projections.project_grants4.instance_id = granted_orgs.instance_id" +
+			" LEFT JOIN projections.login_names3 ON user_grants.user_id = projections.login_names3.user_id AND user_grants.instance_id = projections.login_names3.instance_id" +
+			" LEFT JOIN LATERAL (SELECT JSON_AGG( JSON_BUILD_OBJECT( 'role_key', pr.role_key, 'display_name', pr.display_name, 'group_name', pr.group_name ) ) as role_information FROM projections.project_roles4 pr WHERE pr.instance_id = user_grants.instance_id AND pr.project_id = user_grants.project_id AND pr.role_key = ANY(user_grants.roles)) as roles ON true " +
+			" WHERE projections.login_names3.is_primary = $1")
+	inheritedUserGrantsCols = append(
+		append([]string{}, userGrantCols...),
+		"group_id",
+		"count",
+	)
 )
 
 func Test_UserGrantPrepares(t *testing.T) {
@@ -1117,6 +1173,142 @@ func Test_UserGrantPrepares(t *testing.T) {
 			},
 			object: (*UserGrants)(nil),
 		},
+		{
+			name:    "prepareInheritedUserGrantsQuery one direct and one inherited grant",
+			prepare: prepareInheritedUserGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					inheritedUserGrantsStmt,
+					inheritedUserGrantsCols,
+					[][]driver.Value{
+						{
+							"id",
+							testNow,
+							testNow,
+							20211111,
+							"",
+							database.TextArray[string]{"role-key"},
+							nil,
+							domain.UserGrantStateActive,
+							"user-id",
+							"username",
+							domain.UserTypeHuman,
+							"user-resource-owner",
+							"user-resource-owner-name",
+							"user-resource-owner-domain",
+							"first-name",
+							"last-name",
+							"email",
+							"display-name",
+							"avatar-key",
+							"login-name",
+							"ro",
+							"org-name",
+							"primary-domain",
+							"project-id",
+							"project-name",
+							"project-resource-owner",
+							nil,
+							nil,
+							nil,
+							nil,
+						},
+						{
+							"group-grant-id",
+							testNow,
+							testNow,
+							20211112,
+							"",
+							database.TextArray[string]{"role-key"},
+							nil,
+							domain.UserGrantStateActive,
+							"user-id",
+							"username",
+							domain.UserTypeHuman,
+							"user-resource-owner",
+							"user-resource-owner-name",
+							"user-resource-owner-domain",
+							"first-name",
+							"last-name",
+							"email",
+							"display-name",
+							"avatar-key",
+							"login-name",
+							"ro",
+							"org-name",
+							"primary-domain",
+							"project-id",
+							"project-name",
+							"project-resource-owner",
+							nil,
+							nil,
+							nil,
+							"group-id",
+						},
+					},
+				),
+			},
+			object: &UserGrants{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				UserGrants: []*UserGrant{
+					{
+						ID:                      "id",
+						CreationDate:            testNow,
+						ChangeDate:              testNow,
+						Sequence:                20211111,
+						Roles:                   database.TextArray[string]{"role-key"},
+						State:                   domain.UserGrantStateActive,
+						UserID:                  "user-id",
+						Username:                "username",
+						UserType:                domain.UserTypeHuman,
+						UserResourceOwner:       "user-resource-owner",
+						UserResourceOwnerName:   "user-resource-owner-name",
+						UserResourceOwnerDomain: "user-resource-owner-domain",
+						FirstName:               "first-name",
+						LastName:                "last-name",
+						Email:                   "email",
+						DisplayName:             "display-name",
+						AvatarURL:               "avatar-key",
+						PreferredLoginName:      "login-name",
+						ResourceOwner:           "ro",
+						OrgName:                 "org-name",
+						OrgPrimaryDomain:        "primary-domain",
+						ProjectID:               "project-id",
+						ProjectName:             "project-name",
+						ProjectResourceOwner:    "project-resource-owner",
+					},
+					{
+						ID:                      "group-grant-id",
+						CreationDate:            testNow,
+						ChangeDate:              testNow,
+						Sequence:                20211112,
+						Roles:                   database.TextArray[string]{"role-key"},
+						State:                   domain.UserGrantStateActive,
+						UserID:                  "user-id",
+						Username:                "username",
+						UserType:                domain.UserTypeHuman,
+						UserResourceOwner:       "user-resource-owner",
+						UserResourceOwnerName:   "user-resource-owner-name",
+						UserResourceOwnerDomain: "user-resource-owner-domain",
+						FirstName:               "first-name",
+						LastName:                "last-name",
+						Email:                   "email",
+						DisplayName:             "display-name",
+						AvatarURL:               "avatar-key",
+						PreferredLoginName:      "login-name",
+						ResourceOwner:           "ro",
+						OrgName:                 "org-name",
+						OrgPrimaryDomain:        "primary-domain",
+						ProjectID:               "project-id",
+						ProjectName:             "project-name",
+						ProjectResourceOwner:    "project-resource-owner",
+						GroupID:                 "group-id",
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1124,3 +1316,115 @@ func Test_UserGrantPrepares(t *testing.T) {
 		})
 	}
 }
+
+func Test_userGrantQueryOnTable(t *testing.T) {
+	userQuery, err := NewUserGrantUserIDSearchQuery("user-id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	grantedQuery, err := NewUserGrantWithGrantedQuery("org-id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	notQuery, err := NewNotQuery(userQuery)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		query    SearchQuery
+		wantStmt string
+		wantArgs []any
+	}{
+		{
+			name:     "user grant column",
+			query:    userQuery,
+			wantStmt: "user_grants.user_id = ?",
+			wantArgs: []any{"user-id"},
+		},
+		{
+			name:     "or query with foreign column",
+			query:    grantedQuery,
+			wantStmt: "(user_grants.resource_owner = ? OR projections.projects4.resource_owner = ?)",
+			wantArgs: []any{"org-id", "org-id"},
+		},
+		{
+			name:     "not query",
+			query:    notQuery,
+			wantStmt: "NOT (user_grants.user_id = ?)",
+			wantArgs: []any{"user-id"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stmt, args, err := userGrantQueryOnTable(tt.query, inheritedUserGrantTable).comp().ToSql()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stmt != tt.wantStmt {
+				t.Errorf("unexpected statement: want %q got %q", tt.wantStmt, stmt)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("unexpected args: want %v got %v", tt.wantArgs, args)
+			}
+		})
+	}
+}
+
+func Test_userGrantPermissionCheckV2(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instanceID")
+	ctx = authz.SetCtxData(ctx, authz.CtxData{UserID: "userID"})
+	tests := []struct {
+		name             string
+		includeInherited bool
+		wantStmt         string
+	}{
+		{
+			name: "user grants",
+			wantStmt: "SELECT projections.user_grants5.id FROM projections.user_grants5 " +
+				"INNER JOIN eventstore.permitted_projects(?, ?, ?, ?, ?) permissions ON (permissions.instance_permitted" +
+				" OR projections.user_grants5.resource_owner = ANY(permissions.org_ids)" +
+				" OR projections.user_grants5.project_id = ANY(permissions.project_ids)" +
+				" OR (cardinality(projections.user_grants5.roles) > 0 AND ARRAY(SELECT projections.user_grants5.project_id || '/' || role_key FROM unnest(projections.user_grants5.roles) AS role_key) <@ permissions.project_role_ids)" +
+				" OR projections.user_grants5.user_id = ?)",
+		},
+		{
+			name:             "inherited user grants",
+			includeInherited: true,
+			wantStmt: "SELECT user_grants.id FROM " + inheritedUserGrantTable.identifier() + " " +
+				"INNER JOIN eventstore.permitted_projects(?, ?, ?, ?, ?) permissions ON (permissions.instance_permitted" +
+				" OR user_grants.resource_owner = ANY(permissions.org_ids)" +
+				" OR user_grants.project_id = ANY(permissions.project_ids)" +
+				" OR (cardinality(user_grants.roles) > 0 AND ARRAY(SELECT user_grants.project_id || '/' || role_key FROM unnest(user_grants.roles) AS role_key) <@ permissions.project_role_ids)" +
+				" OR user_grants.user_id = ?)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grants := userGrantTable
+			if tt.includeInherited {
+				grants = inheritedUserGrantTable
+			}
+			query := sq.Select(userGrantColumnOnTable(UserGrantID, grants).identifier()).From(grants.identifier())
+			orgQuery, err := NewUserGrantResourceOwnerSearchQuery("orgID")
+			if err != nil {
+				t.Fatal(err)
+			}
+			query = userGrantPermissionCheckV2(ctx, query, true, &UserGrantsQueries{
+				Queries:          []SearchQuery{orgQuery},
+				IncludeInherited: tt.includeInherited,
+			})
+			stmt, args, err := query.ToSql()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stmt != tt.wantStmt {
+				t.Errorf("unexpected statement: want %q got %q", tt.wantStmt, stmt)
+			}
+			// the org of the search query must be passed to the permission check on both tables
+			if len(args) != 6 || !reflect.DeepEqual(args[4], gu.Ptr("orgID")) {
+				t.Errorf("unexpected args: %v", args)
+			}
+		})
+	}
+}
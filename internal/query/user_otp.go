@@ -61,15 +61,25 @@ func NewHumanOTPReadModel(userID, resourceOwner string) *HumanOTPReadModel {
 	}
 }
 
+// Reduce only considers the default TOTP authenticator, named authenticators are ignored.
 func (wm *HumanOTPReadModel) Reduce() error {
 	for _, event := range wm.Events {
 		switch e := event.(type) {
 		case *user.HumanOTPAddedEvent:
+			if e.TOTPID != "" {
+				continue
+			}
 			wm.Secret = e.Secret
 			wm.State = domain.MFAStateNotReady
 		case *user.HumanOTPVerifiedEvent:
+			if e.TOTPID != "" {
+				continue
+			}
 			wm.State = domain.MFAStateReady
 		case *user.HumanOTPRemovedEvent:
+			if e.TOTPID != "" {
+				continue
+			}
 			wm.State = domain.MFAStateRemoved
 		case *user.UserRemovedEvent:
 			wm.State = domain.MFAStateRemoved
@@ -163,7 +163,7 @@ func (q *Queries) searchPersonalAccessTokens(ctx context.Context, queries *Perso
 		return nil, zerrors.ThrowInvalidArgument(err, "QUERY-Hjw2w", "Errors.Query.InvalidRequest")
 	}
 
-	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+	err = q.client.Reader().QueryContext(ctx, func(rows *sql.Rows) error {
 		personalAccessTokens, err = scan(rows)
 		return err
 
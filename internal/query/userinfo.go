@@ -26,6 +26,8 @@ var oidcUserInfoTriggerHandlers = sync.OnceValue(func() []*handler.Handler {
 		projection.UserProjection,
 		projection.UserMetadataProjection,
 		projection.UserGrantProjection,
+		projection.GroupUsersProjection,
+		projection.GroupGrantProjection,
 		projection.OrgProjection,
 		projection.ProjectProjection,
 	}
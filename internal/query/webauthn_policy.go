@@ -0,0 +1,81 @@
+package query
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+type WebAuthNPolicy struct {
+	Details *domain.ObjectDetails
+
+	RequireAttestation bool
+	VerifyMetadata     bool
+	AllowedAAGUIDs     []string
+	DeniedAAGUIDs      []string
+}
+
+// WebAuthNPolicy returns the restrictions for the registration of passkeys and U2F tokens of the instance.
+func (q *Queries) WebAuthNPolicy(ctx context.Context) (_ *WebAuthNPolicy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	readModel := NewWebAuthNPolicyReadModel(authz.GetInstance(ctx).InstanceID())
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return nil, err
+	}
+	readModel.policy.Details = readModelToObjectDetails(readModel.ReadModel)
+	return readModel.policy, nil
+}
+
+type WebAuthNPolicyReadModel struct {
+	*eventstore.ReadModel
+	policy *WebAuthNPolicy
+}
+
+func NewWebAuthNPolicyReadModel(instanceID string) *WebAuthNPolicyReadModel {
+	return &WebAuthNPolicyReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID:   instanceID,
+			ResourceOwner: instanceID,
+		},
+		policy: new(WebAuthNPolicy),
+	}
+}
+
+func (rm *WebAuthNPolicyReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		e, ok := event.(*instance.WebAuthNPolicySetEvent)
+		if !ok {
+			continue
+		}
+		if e.RequireAttestation != nil {
+			rm.policy.RequireAttestation = *e.RequireAttestation
+		}
+		if e.VerifyMetadata != nil {
+			rm.policy.VerifyMetadata = *e.VerifyMetadata
+		}
+		if e.AllowedAAGUIDs != nil {
+			rm.policy.AllowedAAGUIDs = *e.AllowedAAGUIDs
+		}
+		if e.DeniedAAGUIDs != nil {
+			rm.policy.DeniedAAGUIDs = *e.DeniedAAGUIDs
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *WebAuthNPolicyReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		ResourceOwner(rm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(instance.WebAuthNPolicySetEventType).
+		Builder()
+}
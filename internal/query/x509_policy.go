@@ -0,0 +1,246 @@
+package query
+
+import (
+	"context"
+	"crypto/x509"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// X509Policy returns the policy for the certificate based authentication of the organization.
+// If the organization has no policy or no orgID is passed, the policy of the instance is returned.
+func (q *Queries) X509Policy(ctx context.Context, orgID string) (_ *domain.X509Policy, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID != "" {
+		orgReadModel := NewOrgX509PolicyReadModel(orgID)
+		if err = q.eventstore.FilterToQueryReducer(ctx, orgReadModel); err != nil {
+			return nil, err
+		}
+		if orgReadModel.active {
+			return orgReadModel.x509Policy(false), nil
+		}
+	}
+	instanceReadModel := NewInstanceX509PolicyReadModel(authz.GetInstance(ctx).InstanceID())
+	if err = q.eventstore.FilterToQueryReducer(ctx, instanceReadModel); err != nil {
+		return nil, err
+	}
+	return instanceReadModel.x509Policy(true), nil
+}
+
+// UserByX509Certificate returns the user, to which the client certificate is mapped by the rules of the policy.
+// If no orgID is passed, the user is searched by the rules of the instance's policy
+// and the mapping is then confirmed by the rules of the policy of the user's organization.
+// The certificate itself is not verified, this is done on the check of the session or auth request.
+func (q *Queries) UserByX509Certificate(ctx context.Context, orgID string, certificate []byte) (userID, resourceOwner string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	certs, err := domain.ParseX509Certificates(certificate)
+	if err != nil {
+		return "", "", err
+	}
+	x509Policy, err := q.X509Policy(ctx, orgID)
+	if err != nil {
+		return "", "", err
+	}
+	userID, resourceOwner, err = q.userByX509Policy(ctx, orgID, x509Policy, certs[0])
+	if err != nil || orgID != "" {
+		return userID, resourceOwner, err
+	}
+	orgPolicy, err := q.X509Policy(ctx, resourceOwner)
+	if err != nil {
+		return "", "", err
+	}
+	if orgPolicy.IsDefault {
+		return userID, resourceOwner, nil
+	}
+	orgUserID, _, err := q.userByX509Policy(ctx, resourceOwner, orgPolicy, certs[0])
+	if err != nil {
+		return "", "", err
+	}
+	if orgUserID != userID {
+		return "", "", zerrors.ThrowNotFound(nil, "QUERY-X5u1R", "Errors.User.X509.NotMapped")
+	}
+	return userID, resourceOwner, nil
+}
+
+// userByX509Policy returns the first user, to which an identifier of the certificate is mapped by the rules of the policy.
+func (q *Queries) userByX509Policy(ctx context.Context, orgID string, x509Policy *domain.X509Policy, cert *x509.Certificate) (userID, resourceOwner string, err error) {
+	for _, identifier := range x509Policy.Identifiers(cert) {
+		userID, resourceOwner, err = q.userByX509Identifier(ctx, orgID, identifier)
+		if err == nil {
+			return userID, resourceOwner, nil
+		}
+		if !zerrors.IsNotFound(err) {
+			return "", "", err
+		}
+	}
+	return "", "", zerrors.ThrowNotFound(nil, "QUERY-X5u1N", "Errors.User.X509.NotMapped")
+}
+
+func (q *Queries) userByX509Identifier(ctx context.Context, orgID string, identifier domain.X509Identifier) (userID, resourceOwner string, err error) {
+	switch identifier.Target {
+	case domain.X509MappingTargetLoginName:
+		user, err := q.GetUserByLoginName(ctx, false, identifier.Value)
+		if err != nil {
+			return "", "", err
+		}
+		if orgID != "" && user.ResourceOwner != orgID {
+			return "", "", zerrors.ThrowNotFound(nil, "QUERY-X5u1O", "Errors.User.NotFound")
+		}
+		return user.ID, user.ResourceOwner, nil
+	case domain.X509MappingTargetEmail:
+		queries := make([]SearchQuery, 0, 2)
+		emailQuery, err := NewUserVerifiedEmailSearchQuery(identifier.Value)
+		if err != nil {
+			return "", "", err
+		}
+		queries = append(queries, emailQuery)
+		if orgID != "" {
+			resourceOwnerQuery, err := NewUserResourceOwnerSearchQuery(orgID, TextEquals)
+			if err != nil {
+				return "", "", err
+			}
+			queries = append(queries, resourceOwnerQuery)
+		}
+		user, err := q.GetNotifyUser(ctx, false, queries...)
+		if err != nil {
+			return "", "", err
+		}
+		return user.ID, user.ResourceOwner, nil
+	default:
+		logging.WithFields("target", identifier.Target).Warn("unknown x509 mapping target")
+		return "", "", zerrors.ThrowNotFound(nil, "QUERY-X5u1T", "Errors.User.NotFound")
+	}
+}
+
+type X509PolicyReadModel struct {
+	*eventstore.ReadModel
+
+	active           bool
+	trustedCAs       []byte
+	mappingRules     []domain.X509MappingRule
+	ocspResponderURL string
+	crlURLs          []string
+}
+
+func (rm *X509PolicyReadModel) reduceSet(e *policy.X509PolicySetEvent) {
+	rm.active = true
+	rm.trustedCAs = e.TrustedCAs
+	rm.mappingRules = e.DomainMappingRules()
+	rm.ocspResponderURL = e.OCSPResponderURL
+	rm.crlURLs = e.CRLURLs
+}
+
+func (rm *X509PolicyReadModel) reduceRemoved() {
+	rm.active = false
+	rm.trustedCAs = nil
+	rm.mappingRules = nil
+	rm.ocspResponderURL = ""
+	rm.crlURLs = nil
+}
+
+func (rm *X509PolicyReadModel) x509Policy(isDefault bool) *domain.X509Policy {
+	return &domain.X509Policy{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   rm.AggregateID,
+			Sequence:      rm.ProcessedSequence,
+			ResourceOwner: rm.ResourceOwner,
+			InstanceID:    rm.InstanceID,
+			CreationDate:  rm.CreationDate,
+			ChangeDate:    rm.ChangeDate,
+		},
+		IsDefault:        isDefault,
+		TrustedCAs:       rm.trustedCAs,
+		MappingRules:     rm.mappingRules,
+		OCSPResponderURL: rm.ocspResponderURL,
+		CRLURLs:          rm.crlURLs,
+	}
+}
+
+type InstanceX509PolicyReadModel struct {
+	X509PolicyReadModel
+}
+
+func NewInstanceX509PolicyReadModel(instanceID string) *InstanceX509PolicyReadModel {
+	return &InstanceX509PolicyReadModel{
+		X509PolicyReadModel{
+			ReadModel: &eventstore.ReadModel{
+				AggregateID:   instanceID,
+				ResourceOwner: instanceID,
+			},
+		},
+	}
+}
+
+func (rm *InstanceX509PolicyReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		if e, ok := event.(*instance.X509PolicySetEvent); ok {
+			rm.reduceSet(&e.X509PolicySetEvent)
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *InstanceX509PolicyReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		ResourceOwner(rm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(instance.X509PolicySetEventType).
+		Builder()
+}
+
+type OrgX509PolicyReadModel struct {
+	X509PolicyReadModel
+}
+
+func NewOrgX509PolicyReadModel(orgID string) *OrgX509PolicyReadModel {
+	return &OrgX509PolicyReadModel{
+		X509PolicyReadModel{
+			ReadModel: &eventstore.ReadModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+		},
+	}
+}
+
+func (rm *OrgX509PolicyReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		switch e := event.(type) {
+		case *org.X509PolicySetEvent:
+			rm.reduceSet(&e.X509PolicySetEvent)
+		case *org.X509PolicyRemovedEvent:
+			rm.reduceRemoved()
+		}
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *OrgX509PolicyReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AwaitOpenTransactions().
+		ResourceOwner(rm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(
+			org.X509PolicySetEventType,
+			org.X509PolicyRemovedEventType).
+		Builder()
+}
@@ -0,0 +1,262 @@
+package accessreview
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	accessReviewEventTypePrefix = eventstore.EventType("access_review.")
+	CampaignCreatedType         = accessReviewEventTypePrefix + "campaign.created"
+	CampaignCompletedType       = accessReviewEventTypePrefix + "campaign.completed"
+	ItemDecidedType             = accessReviewEventTypePrefix + "item.decided"
+)
+
+// Field table types
+const (
+	CampaignSearchType     string = "access_review"
+	CampaignSearchRevision uint8  = 1
+	CampaignSearchField    string = "campaign"
+	StateSearchField       string = "state"
+	DeadlineSearchField    string = "deadline"
+
+	ItemSearchType      string = "access_review_item"
+	ItemSearchRevision  uint8  = 1
+	DecisionSearchField string = "decision"
+
+	// ResourceTypeProject reviews the authorizations of a project in the organization.
+	ResourceTypeProject string = "project"
+	// ResourceTypeOrganization reviews the administrators of the organization.
+	ResourceTypeOrganization string = "org"
+)
+
+// Campaign is stored in the fields table, so campaigns can be listed without a projection.
+type Campaign struct {
+	Name         string    `json:"name"`
+	ResourceType string    `json:"resourceType"`
+	ProjectID    string    `json:"projectId,omitempty"`
+	RoleKey      string    `json:"roleKey,omitempty"`
+	ReviewerIDs  []string  `json:"reviewerIds"`
+	Deadline     time.Time `json:"deadline"`
+	AutoRevoke   bool      `json:"autoRevoke"`
+}
+
+// Decision is stored in the fields table for each reviewed authorization or membership.
+type Decision struct {
+	UserID     string                      `json:"userId"`
+	Decision   domain.AccessReviewDecision `json:"decision"`
+	ReviewerID string                      `json:"reviewerId,omitempty"`
+	Comment    string                      `json:"comment,omitempty"`
+}
+
+// CampaignCreatedEvent starts the review of the authorizations of a project
+// or the administrators of an organization, optionally restricted to a single role.
+type CampaignCreatedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Name         string    `json:"name"`
+	ResourceType string    `json:"resourceType"`
+	ProjectID    string    `json:"projectId,omitempty"`
+	RoleKey      string    `json:"roleKey,omitempty"`
+	ReviewerIDs  []string  `json:"reviewerIds"`
+	Deadline     time.Time `json:"deadline"`
+	// AutoRevoke revokes all authorizations or memberships, which are not reviewed until the deadline.
+	AutoRevoke bool `json:"autoRevoke,omitempty"`
+}
+
+func (e *CampaignCreatedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *CampaignCreatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *CampaignCreatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *CampaignCreatedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		campaignFieldOperation(e.Aggregate(), CampaignSearchField, &eventstore.Value{
+			Value: &Campaign{
+				Name:         e.Name,
+				ResourceType: e.ResourceType,
+				ProjectID:    e.ProjectID,
+				RoleKey:      e.RoleKey,
+				ReviewerIDs:  e.ReviewerIDs,
+				Deadline:     e.Deadline,
+				AutoRevoke:   e.AutoRevoke,
+			},
+		}),
+		campaignFieldOperation(e.Aggregate(), StateSearchField, &eventstore.Value{
+			Value: domain.AccessReviewStateActive,
+		}),
+		campaignFieldOperation(e.Aggregate(), DeadlineSearchField, &eventstore.Value{
+			Value:       e.Deadline.Unix(),
+			ShouldIndex: true,
+		}),
+	}
+}
+
+func NewCampaignCreatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	name,
+	resourceType,
+	projectID,
+	roleKey string,
+	reviewerIDs []string,
+	deadline time.Time,
+	autoRevoke bool,
+) *CampaignCreatedEvent {
+	return &CampaignCreatedEvent{
+		BaseEvent:    eventstore.NewBaseEventForPush(ctx, aggregate, CampaignCreatedType),
+		Name:         name,
+		ResourceType: resourceType,
+		ProjectID:    projectID,
+		RoleKey:      roleKey,
+		ReviewerIDs:  reviewerIDs,
+		Deadline:     deadline,
+		AutoRevoke:   autoRevoke,
+	}
+}
+
+// ItemDecidedEvent keeps the decision about a single authorization or membership.
+// The item is the id of the authorization for project reviews and the id of the user for organization reviews.
+// The reviewer is empty, if the item was revoked automatically on the deadline.
+type ItemDecidedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ItemID     string                      `json:"itemId"`
+	UserID     string                      `json:"userId"`
+	Decision   domain.AccessReviewDecision `json:"decision"`
+	ReviewerID string                      `json:"reviewerId,omitempty"`
+	Comment    string                      `json:"comment,omitempty"`
+}
+
+func (e *ItemDecidedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *ItemDecidedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ItemDecidedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *ItemDecidedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.SetField(
+			e.Aggregate(),
+			eventstore.Object{
+				Type:     ItemSearchType,
+				ID:       e.ItemID,
+				Revision: ItemSearchRevision,
+			},
+			DecisionSearchField,
+			&eventstore.Value{
+				Value: &Decision{
+					UserID:     e.UserID,
+					Decision:   e.Decision,
+					ReviewerID: e.ReviewerID,
+					Comment:    e.Comment,
+				},
+			},
+
+			eventstore.FieldTypeInstanceID,
+			eventstore.FieldTypeResourceOwner,
+			eventstore.FieldTypeAggregateType,
+			eventstore.FieldTypeAggregateID,
+			eventstore.FieldTypeObjectType,
+			eventstore.FieldTypeObjectID,
+			eventstore.FieldTypeFieldName,
+		),
+	}
+}
+
+func NewItemDecidedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	itemID,
+	userID string,
+	decision domain.AccessReviewDecision,
+	reviewerID,
+	comment string,
+) *ItemDecidedEvent {
+	return &ItemDecidedEvent{
+		BaseEvent:  eventstore.NewBaseEventForPush(ctx, aggregate, ItemDecidedType),
+		ItemID:     itemID,
+		UserID:     userID,
+		Decision:   decision,
+		ReviewerID: reviewerID,
+		Comment:    comment,
+	}
+}
+
+// CampaignCompletedEvent is pushed once the deadline of the campaign passed.
+// The decisions are kept for auditing.
+type CampaignCompletedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *CampaignCompletedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *CampaignCompletedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *CampaignCompletedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *CampaignCompletedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregateAndObjectAndField(
+			e.Aggregate(),
+			campaignSearchObject(e.Aggregate().ID),
+			DeadlineSearchField,
+		),
+		campaignFieldOperation(e.Aggregate(), StateSearchField, &eventstore.Value{
+			Value: domain.AccessReviewStateCompleted,
+		}),
+	}
+}
+
+func NewCampaignCompletedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *CampaignCompletedEvent {
+	return &CampaignCompletedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(ctx, aggregate, CampaignCompletedType),
+	}
+}
+
+func campaignSearchObject(id string) eventstore.Object {
+	return eventstore.Object{
+		Type:     CampaignSearchType,
+		ID:       id,
+		Revision: CampaignSearchRevision,
+	}
+}
+
+func campaignFieldOperation(aggregate *eventstore.Aggregate, field string, value *eventstore.Value) *eventstore.FieldOperation {
+	return eventstore.SetField(
+		aggregate,
+		campaignSearchObject(aggregate.ID),
+		field,
+		value,
+
+		eventstore.FieldTypeInstanceID,
+		eventstore.FieldTypeResourceOwner,
+		eventstore.FieldTypeAggregateType,
+		eventstore.FieldTypeAggregateID,
+		eventstore.FieldTypeObjectType,
+		eventstore.FieldTypeObjectID,
+		eventstore.FieldTypeFieldName,
+	)
+}
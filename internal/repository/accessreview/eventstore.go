@@ -0,0 +1,11 @@
+package accessreview
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, CampaignCreatedType, eventstore.GenericEventMapper[CampaignCreatedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ItemDecidedType, eventstore.GenericEventMapper[ItemDecidedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CampaignCompletedType, eventstore.GenericEventMapper[CampaignCompletedEvent])
+}
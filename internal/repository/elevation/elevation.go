@@ -0,0 +1,352 @@
+package elevation
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	elevationEventTypePrefix = eventstore.EventType("elevation.")
+	RequestedType            = elevationEventTypePrefix + "requested"
+	ApprovedType             = elevationEventTypePrefix + "approved"
+	DeniedType               = elevationEventTypePrefix + "denied"
+	ActivatedType            = elevationEventTypePrefix + "activated"
+	CancelledType            = elevationEventTypePrefix + "cancelled"
+	ExpiredType              = elevationEventTypePrefix + "expired"
+)
+
+// Field table types
+const (
+	ElevationSearchType      string = "elevation"
+	ElevationSearchRevision  uint8  = 1
+	RequestSearchField       string = "request"
+	ActivateAtSearchField    string = "activate_at"
+	ValidUntilSearchField    string = "valid_until"
+	ResourceTypeInstance     string = "instance"
+	ResourceTypeOrganization string = "org"
+	// The memberships of projects, project grants, applications and roles can't be requested,
+	// they are only scheduled by administrators for a validity starting in the future.
+	ResourceTypeProject      string = "project"
+	ResourceTypeProjectGrant string = "project_grant"
+	ResourceTypeApplication  string = "project_application"
+	ResourceTypeProjectRole  string = "project_role"
+)
+
+// Request is stored in the fields table while the elevation waits for approval,
+// so the pending requests can be listed without a projection.
+type Request struct {
+	UserID       string     `json:"userId"`
+	ResourceType string     `json:"resourceType"`
+	ProjectID    string     `json:"projectId,omitempty"`
+	ObjectID     string     `json:"objectId,omitempty"`
+	Roles        []string   `json:"roles"`
+	Duration     int64      `json:"durationSeconds"`
+	ValidFrom    *time.Time `json:"validFrom,omitempty"`
+	Reason       string     `json:"reason,omitempty"`
+}
+
+// RequestedEvent is pushed if a user requests administrator roles on the instance or an organization for a limited duration.
+// The roles are only granted after another administrator approved the request.
+type RequestedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	UserID       string        `json:"userId"`
+	ResourceType string        `json:"resourceType"`
+	Roles        []string      `json:"roles"`
+	Duration     time.Duration `json:"duration"`
+	// ValidFrom is set if the roles must not be granted before the given time.
+	ValidFrom *time.Time `json:"validFrom,omitempty"`
+	Reason    string     `json:"reason,omitempty"`
+	// ProjectID is set for the memberships of projects, project grants, applications and roles.
+	ProjectID string `json:"projectId,omitempty"`
+	// ObjectID is the id of the project grant or application or the key of the role.
+	ObjectID string `json:"objectId,omitempty"`
+}
+
+func (e *RequestedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *RequestedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RequestedEvent) Fields() []*eventstore.FieldOperation {
+	request := &Request{
+		UserID:       e.UserID,
+		ResourceType: e.ResourceType,
+		ProjectID:    e.ProjectID,
+		ObjectID:     e.ObjectID,
+		Roles:        e.Roles,
+		Duration:     int64(e.Duration.Seconds()),
+		ValidFrom:    e.ValidFrom,
+		Reason:       e.Reason,
+	}
+	return []*eventstore.FieldOperation{
+		eventstore.SetField(
+			e.Aggregate(),
+			elevationSearchObject(e.Aggregate().ID),
+			RequestSearchField,
+			&eventstore.Value{
+				Value:        request,
+				MustBeUnique: false,
+				ShouldIndex:  false,
+			},
+
+			eventstore.FieldTypeInstanceID,
+			eventstore.FieldTypeResourceOwner,
+			eventstore.FieldTypeAggregateType,
+			eventstore.FieldTypeAggregateID,
+			eventstore.FieldTypeObjectType,
+			eventstore.FieldTypeObjectID,
+			eventstore.FieldTypeFieldName,
+		),
+	}
+}
+
+func NewRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID,
+	resourceType string,
+	roles []string,
+	duration time.Duration,
+	validFrom *time.Time,
+	reason string,
+) *RequestedEvent {
+	return &RequestedEvent{
+		BaseEvent:    eventstore.NewBaseEventForPush(ctx, aggregate, RequestedType),
+		UserID:       userID,
+		ResourceType: resourceType,
+		Roles:        roles,
+		Duration:     duration,
+		ValidFrom:    validFrom,
+		Reason:       reason,
+	}
+}
+
+// ApprovedEvent is pushed if another administrator approved the request.
+// If the requested validity starts in the future, the roles are granted at ActivateAt,
+// otherwise the [ActivatedEvent] is pushed together with the approval.
+type ApprovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ApproverID string     `json:"approverId"`
+	ActivateAt *time.Time `json:"activateAt,omitempty"`
+}
+
+func (e *ApprovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *ApprovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ApprovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *ApprovedEvent) Fields() []*eventstore.FieldOperation {
+	ops := []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregateAndObjectAndField(
+			e.Aggregate(),
+			elevationSearchObject(e.Aggregate().ID),
+			RequestSearchField,
+		),
+	}
+	if e.ActivateAt == nil {
+		return ops
+	}
+	return append(ops, eventstore.SetField(
+		e.Aggregate(),
+		elevationSearchObject(e.Aggregate().ID),
+		ActivateAtSearchField,
+		&eventstore.Value{
+			Value:        e.ActivateAt.Unix(),
+			MustBeUnique: false,
+			ShouldIndex:  true,
+		},
+
+		eventstore.FieldTypeInstanceID,
+		eventstore.FieldTypeResourceOwner,
+		eventstore.FieldTypeAggregateType,
+		eventstore.FieldTypeAggregateID,
+		eventstore.FieldTypeObjectType,
+		eventstore.FieldTypeObjectID,
+		eventstore.FieldTypeFieldName,
+	))
+}
+
+func NewApprovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, approverID string, activateAt *time.Time) *ApprovedEvent {
+	return &ApprovedEvent{
+		BaseEvent:  eventstore.NewBaseEventForPush(ctx, aggregate, ApprovedType),
+		ApproverID: approverID,
+		ActivateAt: activateAt,
+	}
+}
+
+type DeniedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	DeniedBy string `json:"deniedBy"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+func (e *DeniedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *DeniedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DeniedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *DeniedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregate(e.Aggregate()),
+	}
+}
+
+func NewDeniedEvent(ctx context.Context, aggregate *eventstore.Aggregate, deniedBy, reason string) *DeniedEvent {
+	return &DeniedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(ctx, aggregate, DeniedType),
+		DeniedBy:  deniedBy,
+		Reason:    reason,
+	}
+}
+
+// ActivatedEvent is pushed together with the time-bound membership granting the requested roles.
+// If the user is already a member of the resource, the membership is changed instead
+// and the roles added by the elevation are revoked again at ValidUntil.
+type ActivatedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ValidUntil time.Time `json:"validUntil"`
+	// ElevatedRoles are the roles added to an existing membership.
+	ElevatedRoles []string `json:"elevatedRoles,omitempty"`
+}
+
+func (e *ActivatedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *ActivatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ActivatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *ActivatedEvent) Fields() []*eventstore.FieldOperation {
+	ops := []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregate(e.Aggregate()),
+	}
+	if len(e.ElevatedRoles) == 0 {
+		return ops
+	}
+	return append(ops, eventstore.SetField(
+		e.Aggregate(),
+		elevationSearchObject(e.Aggregate().ID),
+		ValidUntilSearchField,
+		&eventstore.Value{
+			Value:        e.ValidUntil.Unix(),
+			MustBeUnique: false,
+			ShouldIndex:  true,
+		},
+
+		eventstore.FieldTypeInstanceID,
+		eventstore.FieldTypeResourceOwner,
+		eventstore.FieldTypeAggregateType,
+		eventstore.FieldTypeAggregateID,
+		eventstore.FieldTypeObjectType,
+		eventstore.FieldTypeObjectID,
+		eventstore.FieldTypeFieldName,
+	))
+}
+
+func NewActivatedEvent(ctx context.Context, aggregate *eventstore.Aggregate, validUntil time.Time, elevatedRoles ...string) *ActivatedEvent {
+	return &ActivatedEvent{
+		BaseEvent:     eventstore.NewBaseEventForPush(ctx, aggregate, ActivatedType),
+		ValidUntil:    validUntil,
+		ElevatedRoles: elevatedRoles,
+	}
+}
+
+// ExpiredEvent is pushed together with the change of the membership,
+// which revokes the roles an elevation added to an existing membership.
+type ExpiredEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *ExpiredEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *ExpiredEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ExpiredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *ExpiredEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregate(e.Aggregate()),
+	}
+}
+
+func NewExpiredEvent(ctx context.Context, aggregate *eventstore.Aggregate) *ExpiredEvent {
+	return &ExpiredEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(ctx, aggregate, ExpiredType),
+	}
+}
+
+// CancelledEvent is pushed if an elevation can no longer be granted,
+// e.g. because the user was granted the requested roles in the meantime.
+type CancelledEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *CancelledEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *CancelledEvent) Payload() interface{} {
+	return e
+}
+
+func (e *CancelledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *CancelledEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregate(e.Aggregate()),
+	}
+}
+
+func NewCancelledEvent(ctx context.Context, aggregate *eventstore.Aggregate) *CancelledEvent {
+	return &CancelledEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(ctx, aggregate, CancelledType),
+	}
+}
+
+func elevationSearchObject(id string) eventstore.Object {
+	return eventstore.Object{
+		Type:     ElevationSearchType,
+		ID:       id,
+		Revision: ElevationSearchRevision,
+	}
+}
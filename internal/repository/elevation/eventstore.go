@@ -0,0 +1,14 @@
+package elevation
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, RequestedType, eventstore.GenericEventMapper[RequestedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ApprovedType, eventstore.GenericEventMapper[ApprovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DeniedType, eventstore.GenericEventMapper[DeniedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ActivatedType, eventstore.GenericEventMapper[ActivatedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CancelledType, eventstore.GenericEventMapper[CancelledEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ExpiredType, eventstore.GenericEventMapper[ExpiredEvent])
+}
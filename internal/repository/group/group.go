@@ -153,3 +153,9 @@ func (g *GroupRemovedEvent) Payload() any {
 func (g *GroupRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
 	return []*eventstore.UniqueConstraint{NewRemoveGroupNameUniqueConstraint(g.name, g.Aggregate().ResourceOwner)}
 }
+
+func (g *GroupRemovedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregate(g.Aggregate()),
+	}
+}
@@ -11,6 +11,13 @@ const (
 	GroupUsersRemovedEventType = groupEventTypePrefix + "users.removed"
 )
 
+// The users of a group are stored as fields, so the administrator memberships of groups can be resolved for a user.
+const (
+	GroupUserSearchType        = "group_user"
+	GroupUserUserIDSearchField = "user_id"
+	GroupUserObjectRevision    = uint8(1)
+)
+
 type GroupUsersAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
@@ -40,6 +47,30 @@ func (e *GroupUsersAddedEvent) UniqueConstraints() []*eventstore.UniqueConstrain
 	return nil
 }
 
+func (e *GroupUsersAddedEvent) Fields() []*eventstore.FieldOperation {
+	ops := make([]*eventstore.FieldOperation, len(e.UserIDs))
+	for i, userID := range e.UserIDs {
+		ops[i] = eventstore.SetField(
+			e.Aggregate(),
+			groupUserSearchObject(userID),
+			GroupUserUserIDSearchField,
+			&eventstore.Value{
+				Value:       userID,
+				ShouldIndex: true,
+			},
+
+			eventstore.FieldTypeInstanceID,
+			eventstore.FieldTypeResourceOwner,
+			eventstore.FieldTypeAggregateType,
+			eventstore.FieldTypeAggregateID,
+			eventstore.FieldTypeObjectType,
+			eventstore.FieldTypeObjectID,
+			eventstore.FieldTypeFieldName,
+		)
+	}
+	return ops
+}
+
 type GroupUsersRemovedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
@@ -72,3 +103,19 @@ func (e *GroupUsersRemovedEvent) Payload() interface{} {
 func (e *GroupUsersRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
 	return nil
 }
+
+func (e *GroupUsersRemovedEvent) Fields() []*eventstore.FieldOperation {
+	ops := make([]*eventstore.FieldOperation, len(e.UserIDs))
+	for i, userID := range e.UserIDs {
+		ops[i] = eventstore.RemoveSearchFieldsByAggregateAndObject(e.Aggregate(), groupUserSearchObject(userID))
+	}
+	return ops
+}
+
+func groupUserSearchObject(userID string) eventstore.Object {
+	return eventstore.Object{
+		Type:     GroupUserSearchType,
+		ID:       userID,
+		Revision: GroupUserObjectRevision,
+	}
+}
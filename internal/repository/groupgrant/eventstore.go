@@ -0,0 +1,9 @@
+package groupgrant
+
+import "github.com/zitadel/zitadel/internal/eventstore"
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, GroupGrantAddedType, eventstore.GenericEventMapper[GroupGrantAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, GroupGrantChangedType, eventstore.GenericEventMapper[GroupGrantChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, GroupGrantRemovedType, eventstore.GenericEventMapper[GroupGrantRemovedEvent])
+}
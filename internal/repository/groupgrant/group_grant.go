@@ -0,0 +1,147 @@
+package groupgrant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	UniqueGroupGrant          = "group_grant"
+	groupGrantEventTypePrefix = eventstore.EventType("group.grant.")
+	GroupGrantAddedType       = groupGrantEventTypePrefix + "added"
+	GroupGrantChangedType     = groupGrantEventTypePrefix + "changed"
+	GroupGrantRemovedType     = groupGrantEventTypePrefix + "removed"
+)
+
+func NewAddGroupGrantUniqueConstraint(resourceOwner, groupID, projectID, projectGrantID string) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueGroupGrant,
+		fmt.Sprintf("%s:%s:%s:%s", resourceOwner, groupID, projectID, projectGrantID),
+		"Errors.GroupGrant.AlreadyExists")
+}
+
+func NewRemoveGroupGrantUniqueConstraint(resourceOwner, groupID, projectID, projectGrantID string) *eventstore.UniqueConstraint {
+	return eventstore.NewRemoveUniqueConstraint(
+		UniqueGroupGrant,
+		fmt.Sprintf("%s:%s:%s:%s", resourceOwner, groupID, projectID, projectGrantID))
+}
+
+// GroupGrantAddedEvent authorizes all members of a group for a project with the given role keys.
+type GroupGrantAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	GroupID        string   `json:"groupId,omitempty"`
+	ProjectID      string   `json:"projectId,omitempty"`
+	ProjectGrantID string   `json:"grantId,omitempty"`
+	RoleKeys       []string `json:"roleKeys,omitempty"`
+}
+
+func (e *GroupGrantAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func (e *GroupGrantAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *GroupGrantAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewAddGroupGrantUniqueConstraint(e.Aggregate().ResourceOwner, e.GroupID, e.ProjectID, e.ProjectGrantID)}
+}
+
+func NewGroupGrantAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	groupID,
+	projectID,
+	projectGrantID string,
+	roleKeys []string,
+) *GroupGrantAddedEvent {
+	return &GroupGrantAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			GroupGrantAddedType,
+		),
+		GroupID:        groupID,
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+		RoleKeys:       roleKeys,
+	}
+}
+
+type GroupGrantChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	GroupID  string   `json:"groupId"`
+	RoleKeys []string `json:"roleKeys"`
+}
+
+func (e *GroupGrantChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func (e *GroupGrantChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *GroupGrantChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewGroupGrantChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	groupID string,
+	roleKeys []string,
+) *GroupGrantChangedEvent {
+	return &GroupGrantChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			GroupGrantChangedType,
+		),
+		GroupID:  groupID,
+		RoleKeys: roleKeys,
+	}
+}
+
+type GroupGrantRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	GroupID        string `json:"groupId,omitempty"`
+	ProjectID      string `json:"projectId,omitempty"`
+	ProjectGrantID string `json:"grantId,omitempty"`
+}
+
+func (e *GroupGrantRemovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func (e *GroupGrantRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *GroupGrantRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemoveGroupGrantUniqueConstraint(e.Aggregate().ResourceOwner, e.GroupID, e.ProjectID, e.ProjectGrantID)}
+}
+
+func NewGroupGrantRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	groupID,
+	projectID,
+	projectGrantID string,
+) *GroupGrantRemovedEvent {
+	return &GroupGrantRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			GroupGrantRemovedType,
+		),
+		GroupID:        groupID,
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+	}
+}
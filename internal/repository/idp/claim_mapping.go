@@ -0,0 +1,48 @@
+package idp
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ClaimMappingsSetEvent replaces the claim mappings of the identity provider.
+type ClaimMappingsSetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID       string                    `json:"id"`
+	Mappings []*domain.IDPClaimMapping `json:"mappings,omitempty"`
+}
+
+func NewClaimMappingsSetEvent(
+	base *eventstore.BaseEvent,
+	id string,
+	mappings []*domain.IDPClaimMapping,
+) *ClaimMappingsSetEvent {
+	return &ClaimMappingsSetEvent{
+		BaseEvent: *base,
+		ID:        id,
+		Mappings:  mappings,
+	}
+}
+
+func (e *ClaimMappingsSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ClaimMappingsSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func ClaimMappingsSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &ClaimMappingsSetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-Cm2eU", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,150 @@
+package idp
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type WSFederationIDPAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID          string `json:"id"`
+	Name        string `json:"name,omitempty"`
+	SSOEndpoint string `json:"ssoEndpoint"`
+	Realm       string `json:"realm"`
+	Certificate []byte `json:"certificate"`
+	IDClaim     string `json:"idClaim,omitempty"`
+	Options
+}
+
+func NewWSFederationIDPAddedEvent(
+	base *eventstore.BaseEvent,
+	id,
+	name,
+	ssoEndpoint,
+	realm string,
+	certificate []byte,
+	idClaim string,
+	options Options,
+) *WSFederationIDPAddedEvent {
+	return &WSFederationIDPAddedEvent{
+		BaseEvent:   *base,
+		ID:          id,
+		Name:        name,
+		SSOEndpoint: ssoEndpoint,
+		Realm:       realm,
+		Certificate: certificate,
+		IDClaim:     idClaim,
+		Options:     options,
+	}
+}
+
+func (e *WSFederationIDPAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *WSFederationIDPAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func WSFederationIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &WSFederationIDPAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-Wf1aM", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
+
+type WSFederationIDPChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID          string  `json:"id"`
+	Name        *string `json:"name,omitempty"`
+	SSOEndpoint *string `json:"ssoEndpoint,omitempty"`
+	Realm       *string `json:"realm,omitempty"`
+	Certificate []byte  `json:"certificate,omitempty"`
+	IDClaim     *string `json:"idClaim,omitempty"`
+	OptionChanges
+}
+
+func NewWSFederationIDPChangedEvent(
+	base *eventstore.BaseEvent,
+	id string,
+	changes []WSFederationIDPChanges,
+) (*WSFederationIDPChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IDP-Wf1cN", "Errors.NoChangesFound")
+	}
+	changedEvent := &WSFederationIDPChangedEvent{
+		BaseEvent: *base,
+		ID:        id,
+	}
+	for _, change := range changes {
+		change(changedEvent)
+	}
+	return changedEvent, nil
+}
+
+type WSFederationIDPChanges func(*WSFederationIDPChangedEvent)
+
+func ChangeWSFederationName(name string) func(*WSFederationIDPChangedEvent) {
+	return func(e *WSFederationIDPChangedEvent) {
+		e.Name = &name
+	}
+}
+
+func ChangeWSFederationSSOEndpoint(ssoEndpoint string) func(*WSFederationIDPChangedEvent) {
+	return func(e *WSFederationIDPChangedEvent) {
+		e.SSOEndpoint = &ssoEndpoint
+	}
+}
+
+func ChangeWSFederationRealm(realm string) func(*WSFederationIDPChangedEvent) {
+	return func(e *WSFederationIDPChangedEvent) {
+		e.Realm = &realm
+	}
+}
+
+func ChangeWSFederationCertificate(certificate []byte) func(*WSFederationIDPChangedEvent) {
+	return func(e *WSFederationIDPChangedEvent) {
+		e.Certificate = certificate
+	}
+}
+
+func ChangeWSFederationIDClaim(idClaim string) func(*WSFederationIDPChangedEvent) {
+	return func(e *WSFederationIDPChangedEvent) {
+		e.IDClaim = &idClaim
+	}
+}
+
+func ChangeWSFederationOptions(options OptionChanges) func(*WSFederationIDPChangedEvent) {
+	return func(e *WSFederationIDPChangedEvent) {
+		e.OptionChanges = options
+	}
+}
+
+func (e *WSFederationIDPChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *WSFederationIDPChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func WSFederationIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &WSFederationIDPChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-Wf1cM", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,121 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/member"
+)
+
+const (
+	GroupMemberAddedEventType   = instanceEventTypePrefix + member.GroupAddedEventType
+	GroupMemberChangedEventType = instanceEventTypePrefix + member.GroupChangedEventType
+	GroupMemberRemovedEventType = instanceEventTypePrefix + member.GroupRemovedEventType
+)
+
+// GroupMemberAddedEvent grants administrator roles on the instance to all users of a group.
+type GroupMemberAddedEvent struct {
+	member.GroupMemberAddedEvent
+}
+
+func (e *GroupMemberAddedEvent) Fields() []*eventstore.FieldOperation {
+	return e.FieldOperations(fieldPrefix)
+}
+
+func NewGroupMemberAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	groupID string,
+	roles ...string,
+) *GroupMemberAddedEvent {
+	return &GroupMemberAddedEvent{
+		GroupMemberAddedEvent: *member.NewGroupMemberAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				GroupMemberAddedEventType,
+			),
+			groupID,
+			roles...,
+		),
+	}
+}
+
+func GroupMemberAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := member.GroupMemberAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GroupMemberAddedEvent{GroupMemberAddedEvent: *e.(*member.GroupMemberAddedEvent)}, nil
+}
+
+type GroupMemberChangedEvent struct {
+	member.GroupMemberChangedEvent
+}
+
+func (e *GroupMemberChangedEvent) Fields() []*eventstore.FieldOperation {
+	return e.FieldOperations(fieldPrefix)
+}
+
+func NewGroupMemberChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	groupID string,
+	roles ...string,
+) *GroupMemberChangedEvent {
+	return &GroupMemberChangedEvent{
+		GroupMemberChangedEvent: *member.NewGroupMemberChangedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				GroupMemberChangedEventType,
+			),
+			groupID,
+			roles...,
+		),
+	}
+}
+
+func GroupMemberChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := member.GroupMemberChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GroupMemberChangedEvent{GroupMemberChangedEvent: *e.(*member.GroupMemberChangedEvent)}, nil
+}
+
+type GroupMemberRemovedEvent struct {
+	member.GroupMemberRemovedEvent
+}
+
+func (e *GroupMemberRemovedEvent) Fields() []*eventstore.FieldOperation {
+	return e.FieldOperations(fieldPrefix)
+}
+
+func NewGroupMemberRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	groupID string,
+) *GroupMemberRemovedEvent {
+	return &GroupMemberRemovedEvent{
+		GroupMemberRemovedEvent: *member.NewGroupMemberRemovedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				GroupMemberRemovedEventType,
+			),
+			groupID,
+		),
+	}
+}
+
+func GroupMemberRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := member.GroupMemberRemovedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GroupMemberRemovedEvent{GroupMemberRemovedEvent: *e.(*member.GroupMemberRemovedEvent)}, nil
+}
@@ -37,7 +37,10 @@ const (
 	AppleIDPChangedEventType            eventstore.EventType = "instance.idp.apple.changed"
 	SAMLIDPAddedEventType               eventstore.EventType = "instance.idp.saml.added"
 	SAMLIDPChangedEventType             eventstore.EventType = "instance.idp.saml.changed"
+	WSFederationIDPAddedEventType       eventstore.EventType = "instance.idp.wsfed.added"
+	WSFederationIDPChangedEventType     eventstore.EventType = "instance.idp.wsfed.changed"
 	IDPRemovedEventType                 eventstore.EventType = "instance.idp.removed"
+	IDPClaimMappingsSetEventType        eventstore.EventType = "instance.idp.claim_mappings.set"
 )
 
 type OAuthIDPAddedEvent struct {
@@ -1095,6 +1098,84 @@ func SAMLIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error)
 	return &SAMLIDPChangedEvent{SAMLIDPChangedEvent: *e.(*idp.SAMLIDPChangedEvent)}, nil
 }
 
+type WSFederationIDPAddedEvent struct {
+	idp.WSFederationIDPAddedEvent
+}
+
+func NewWSFederationIDPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	ssoEndpoint,
+	realm string,
+	certificate []byte,
+	idClaim string,
+	options idp.Options,
+) *WSFederationIDPAddedEvent {
+
+	return &WSFederationIDPAddedEvent{
+		WSFederationIDPAddedEvent: *idp.NewWSFederationIDPAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				WSFederationIDPAddedEventType,
+			),
+			id,
+			name,
+			ssoEndpoint,
+			realm,
+			certificate,
+			idClaim,
+			options,
+		),
+	}
+}
+
+func WSFederationIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.WSFederationIDPAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WSFederationIDPAddedEvent{WSFederationIDPAddedEvent: *e.(*idp.WSFederationIDPAddedEvent)}, nil
+}
+
+type WSFederationIDPChangedEvent struct {
+	idp.WSFederationIDPChangedEvent
+}
+
+func NewWSFederationIDPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []idp.WSFederationIDPChanges,
+) (*WSFederationIDPChangedEvent, error) {
+
+	changedEvent, err := idp.NewWSFederationIDPChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			WSFederationIDPChangedEventType,
+		),
+		id,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &WSFederationIDPChangedEvent{WSFederationIDPChangedEvent: *changedEvent}, nil
+}
+
+func WSFederationIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.WSFederationIDPChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WSFederationIDPChangedEvent{WSFederationIDPChangedEvent: *e.(*idp.WSFederationIDPChangedEvent)}, nil
+}
+
 type IDPRemovedEvent struct {
 	idp.RemovedEvent
 }
@@ -1128,3 +1209,35 @@ func IDPRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 
 	return &IDPRemovedEvent{RemovedEvent: *e.(*idp.RemovedEvent)}, nil
 }
+
+type IDPClaimMappingsSetEvent struct {
+	idp.ClaimMappingsSetEvent
+}
+
+func NewIDPClaimMappingsSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	mappings []*domain.IDPClaimMapping,
+) *IDPClaimMappingsSetEvent {
+	return &IDPClaimMappingsSetEvent{
+		ClaimMappingsSetEvent: *idp.NewClaimMappingsSetEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				IDPClaimMappingsSetEventType,
+			),
+			id,
+			mappings,
+		),
+	}
+}
+
+func IDPClaimMappingsSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.ClaimMappingsSetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDPClaimMappingsSetEvent{ClaimMappingsSetEvent: *e.(*idp.ClaimMappingsSetEvent)}, nil
+}
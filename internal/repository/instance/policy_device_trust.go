@@ -0,0 +1,41 @@
+package instance
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+var (
+	DeviceTrustPolicySetEventType = instanceEventTypePrefix + policy.DeviceTrustPolicySetEventType
+)
+
+type DeviceTrustPolicySetEvent struct {
+	policy.DeviceTrustPolicySetEvent
+}
+
+func NewDeviceTrustPolicySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	lifetime time.Duration,
+) *DeviceTrustPolicySetEvent {
+	return &DeviceTrustPolicySetEvent{
+		DeviceTrustPolicySetEvent: *policy.NewDeviceTrustPolicySetEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				DeviceTrustPolicySetEventType),
+			lifetime),
+	}
+}
+
+func DeviceTrustPolicySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.DeviceTrustPolicySetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeviceTrustPolicySetEvent{DeviceTrustPolicySetEvent: *e.(*policy.DeviceTrustPolicySetEvent)}, nil
+}
@@ -0,0 +1,95 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	webAuthNPolicyPrefix       = "policy.webauthn."
+	WebAuthNPolicySetEventType = instanceEventTypePrefix + webAuthNPolicyPrefix + "set"
+)
+
+type WebAuthNPolicySetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	RequireAttestation *bool     `json:"requireAttestation,omitempty"`
+	VerifyMetadata     *bool     `json:"verifyMetadata,omitempty"`
+	AllowedAAGUIDs     *[]string `json:"allowedAAGUIDs,omitempty"`
+	DeniedAAGUIDs      *[]string `json:"deniedAAGUIDs,omitempty"`
+}
+
+func NewWebAuthNPolicySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	changes []WebAuthNPolicyChanges,
+) (*WebAuthNPolicySetEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "POLICY-Wa8nC", "Errors.NoChangesFound")
+	}
+	event := &WebAuthNPolicySetEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			WebAuthNPolicySetEventType,
+		),
+	}
+	for _, change := range changes {
+		change(event)
+	}
+	return event, nil
+}
+
+type WebAuthNPolicyChanges func(event *WebAuthNPolicySetEvent)
+
+func ChangeWebAuthNPolicyRequireAttestation(require bool) func(event *WebAuthNPolicySetEvent) {
+	return func(e *WebAuthNPolicySetEvent) {
+		e.RequireAttestation = &require
+	}
+}
+
+func ChangeWebAuthNPolicyVerifyMetadata(verify bool) func(event *WebAuthNPolicySetEvent) {
+	return func(e *WebAuthNPolicySetEvent) {
+		e.VerifyMetadata = &verify
+	}
+}
+
+func ChangeWebAuthNPolicyAllowedAAGUIDs(aaguids []string) func(event *WebAuthNPolicySetEvent) {
+	return func(e *WebAuthNPolicySetEvent) {
+		if len(aaguids) == 0 {
+			aaguids = []string{}
+		}
+		e.AllowedAAGUIDs = &aaguids
+	}
+}
+
+func ChangeWebAuthNPolicyDeniedAAGUIDs(aaguids []string) func(event *WebAuthNPolicySetEvent) {
+	return func(e *WebAuthNPolicySetEvent) {
+		if len(aaguids) == 0 {
+			aaguids = []string{}
+		}
+		e.DeniedAAGUIDs = &aaguids
+	}
+}
+
+func (e *WebAuthNPolicySetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *WebAuthNPolicySetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func WebAuthNPolicySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	policySet := &WebAuthNPolicySetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(policySet)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IAM-Wa8nM", "unable to unmarshal webauthn policy set")
+	}
+
+	return policySet, nil
+}
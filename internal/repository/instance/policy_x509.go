@@ -0,0 +1,41 @@
+package instance
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+var (
+	X509PolicySetEventType = instanceEventTypePrefix + policy.X509PolicySetEventType
+)
+
+type X509PolicySetEvent struct {
+	policy.X509PolicySetEvent
+}
+
+func NewX509PolicySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	x509Policy *domain.X509Policy,
+) *X509PolicySetEvent {
+	return &X509PolicySetEvent{
+		X509PolicySetEvent: *policy.NewX509PolicySetEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				X509PolicySetEventType),
+			x509Policy),
+	}
+}
+
+func X509PolicySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.X509PolicySetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &X509PolicySetEvent{X509PolicySetEvent: *e.(*policy.X509PolicySetEvent)}, nil
+}
@@ -0,0 +1,27 @@
+package ldapsync
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	AggregateType    = "ldap_sync"
+	AggregateVersion = "v1"
+)
+
+type Aggregate struct {
+	eventstore.Aggregate
+}
+
+// NewAggregate returns the aggregate of the directory synchronization of an LDAP identity provider,
+// the id is the id of the identity provider and the resource owner its owner.
+func NewAggregate(idpID, resourceOwner string) *Aggregate {
+	return &Aggregate{
+		Aggregate: eventstore.Aggregate{
+			Type:          AggregateType,
+			Version:       AggregateVersion,
+			ID:            idpID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
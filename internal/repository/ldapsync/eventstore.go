@@ -0,0 +1,13 @@
+package ldapsync
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, SetType, eventstore.GenericEventMapper[SetEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RemovedType, eventstore.GenericEventMapper[RemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RunRequestedType, eventstore.GenericEventMapper[RunRequestedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RunSucceededType, eventstore.GenericEventMapper[RunSucceededEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RunFailedType, eventstore.GenericEventMapper[RunFailedEvent])
+}
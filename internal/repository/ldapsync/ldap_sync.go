@@ -0,0 +1,275 @@
+package ldapsync
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix  = eventstore.EventType("ldap_sync.")
+	SetType          = eventTypePrefix + "set"
+	RemovedType      = eventTypePrefix + "removed"
+	RunRequestedType = eventTypePrefix + "run.requested"
+	RunSucceededType = eventTypePrefix + "run.succeeded"
+	RunFailedType    = eventTypePrefix + "run.failed"
+
+	SearchType     string = "ldap_sync"
+	SearchRevision uint8  = 1
+	// NextRunAtSearchField is the unix timestamp at which the next synchronization is due.
+	NextRunAtSearchField string = "next_run_at"
+)
+
+// SetEvent configures the periodic synchronization of the users of an LDAP identity provider.
+type SetEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	// OrganizationID is the organization the users are created in
+	OrganizationID       string                              `json:"organizationId"`
+	Interval             time.Duration                       `json:"interval"`
+	FullSyncInterval     time.Duration                       `json:"fullSyncInterval,omitempty"`
+	Filter               string                              `json:"filter,omitempty"`
+	PageSize             uint32                              `json:"pageSize,omitempty"`
+	IncrementalAttribute domain.LDAPSyncIncrementalAttribute `json:"incrementalAttribute,omitempty"`
+	GroupAttribute       string                              `json:"groupAttribute,omitempty"`
+	GroupMappings        []*domain.LDAPSyncGroupMapping      `json:"groupMappings,omitempty"`
+}
+
+func (e *SetEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *SetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+// Fields marks the synchronization as due, so the changed configuration is applied immediately.
+func (e *SetEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{nextRunAtField(e.Aggregate(), time.Time{})}
+}
+
+func NewSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	organizationID string,
+	interval,
+	fullSyncInterval time.Duration,
+	filter string,
+	pageSize uint32,
+	incrementalAttribute domain.LDAPSyncIncrementalAttribute,
+	groupAttribute string,
+	groupMappings []*domain.LDAPSyncGroupMapping,
+) *SetEvent {
+	return &SetEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SetType,
+		),
+		OrganizationID:       organizationID,
+		Interval:             interval,
+		FullSyncInterval:     fullSyncInterval,
+		Filter:               filter,
+		PageSize:             pageSize,
+		IncrementalAttribute: incrementalAttribute,
+		GroupAttribute:       groupAttribute,
+		GroupMappings:        groupMappings,
+	}
+}
+
+// RemovedEvent stops the synchronization, already synchronized users are kept.
+type RemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *RemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *RemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *RemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RemovedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregate(e.Aggregate()),
+	}
+}
+
+func NewRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *RemovedEvent {
+	return &RemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RemovedType,
+		),
+	}
+}
+
+// RunRequestedEvent requests a synchronization independent of the interval.
+// If Full is set, all entries are read and users not found in the directory anymore are deactivated.
+type RunRequestedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Full bool `json:"full,omitempty"`
+}
+
+func (e *RunRequestedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *RunRequestedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RunRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RunRequestedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{nextRunAtField(e.Aggregate(), time.Time{})}
+}
+
+func NewRunRequestedEvent(ctx context.Context, aggregate *eventstore.Aggregate, full bool) *RunRequestedEvent {
+	return &RunRequestedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RunRequestedType,
+		),
+		Full: full,
+	}
+}
+
+// RunSucceededEvent reports the summary of a synchronization.
+// Entries failing to synchronize don't fail the run, they are counted in the summary.
+type RunSucceededEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	StartedAt time.Time               `json:"startedAt"`
+	Summary   *domain.LDAPSyncSummary `json:"summary"`
+	// Watermark is the highest value of the incremental attribute read,
+	// the next incremental synchronization only reads entries changed afterward.
+	Watermark string    `json:"watermark,omitempty"`
+	NextRunAt time.Time `json:"nextRunAt"`
+}
+
+func (e *RunSucceededEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *RunSucceededEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RunSucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RunSucceededEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{nextRunAtField(e.Aggregate(), e.NextRunAt)}
+}
+
+func NewRunSucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	startedAt time.Time,
+	summary *domain.LDAPSyncSummary,
+	watermark string,
+	nextRunAt time.Time,
+) *RunSucceededEvent {
+	return &RunSucceededEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RunSucceededType,
+		),
+		StartedAt: startedAt,
+		Summary:   summary,
+		Watermark: watermark,
+		NextRunAt: nextRunAt,
+	}
+}
+
+// RunFailedEvent reports a synchronization which could not be executed, e.g. because the directory was unavailable.
+type RunFailedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	StartedAt time.Time `json:"startedAt"`
+	Error     string    `json:"error"`
+	NextRunAt time.Time `json:"nextRunAt"`
+}
+
+func (e *RunFailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *RunFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RunFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RunFailedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{nextRunAtField(e.Aggregate(), e.NextRunAt)}
+}
+
+func NewRunFailedEvent(ctx context.Context, aggregate *eventstore.Aggregate, startedAt time.Time, errorMessage string, nextRunAt time.Time) *RunFailedEvent {
+	return &RunFailedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RunFailedType,
+		),
+		StartedAt: startedAt,
+		Error:     errorMessage,
+		NextRunAt: nextRunAt,
+	}
+}
+
+// nextRunAtField indexes the time of the next synchronization,
+// a zero time marks the synchronization as due immediately.
+func nextRunAtField(aggregate *eventstore.Aggregate, nextRunAt time.Time) *eventstore.FieldOperation {
+	var value int64
+	if !nextRunAt.IsZero() {
+		value = nextRunAt.Unix()
+	}
+	return eventstore.SetField(
+		aggregate,
+		searchObject(aggregate.ID),
+		NextRunAtSearchField,
+		&eventstore.Value{
+			Value:        value,
+			MustBeUnique: false,
+			ShouldIndex:  true,
+		},
+
+		eventstore.FieldTypeInstanceID,
+		eventstore.FieldTypeResourceOwner,
+		eventstore.FieldTypeAggregateType,
+		eventstore.FieldTypeAggregateID,
+		eventstore.FieldTypeObjectType,
+		eventstore.FieldTypeObjectID,
+		eventstore.FieldTypeFieldName,
+	)
+}
+
+func searchObject(id string) eventstore.Object {
+	return eventstore.Object{
+		Type:     SearchType,
+		ID:       id,
+		Revision: SearchRevision,
+	}
+}
@@ -2,6 +2,7 @@ package member
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -18,11 +19,18 @@ const (
 
 // Field table and unique types
 const (
-	memberRoleTypeSuffix  string = "_member_role"
-	MemberRoleRevision    uint8  = 1
-	roleSearchFieldSuffix string = "_role"
+	memberRoleTypeSuffix        string = "_member_role"
+	MemberRoleRevision          uint8  = 1
+	roleSearchFieldSuffix       string = "_role"
+	validUntilSearchFieldSuffix string = "_valid_until"
 )
 
+// ValidUntilSearchField returns the name of the field used to find expired memberships,
+// e.g. `instance_valid_until` for instance members.
+func ValidUntilSearchField(prefix string) string {
+	return prefix + validUntilSearchFieldSuffix
+}
+
 func NewAddMemberUniqueConstraint(aggregateID, userID string) *eventstore.UniqueConstraint {
 	return eventstore.NewAddEventUniqueConstraint(
 		UniqueMember,
@@ -42,6 +50,8 @@ type MemberAddedEvent struct {
 
 	Roles  []string `json:"roles"`
 	UserID string   `json:"userId"`
+	// ValidUntil is set for time-bound memberships, which are removed automatically after expiry.
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
 }
 
 func (e *MemberAddedEvent) Payload() interface{} {
@@ -53,7 +63,7 @@ func (e *MemberAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
 }
 
 func (e *MemberAddedEvent) FieldOperations(prefix string) []*eventstore.FieldOperation {
-	ops := make([]*eventstore.FieldOperation, len(e.Roles))
+	ops := make([]*eventstore.FieldOperation, len(e.Roles), len(e.Roles)+1)
 	for i, role := range e.Roles {
 		ops[i] = eventstore.SetField(
 			e.Aggregate(),
@@ -75,6 +85,9 @@ func (e *MemberAddedEvent) FieldOperations(prefix string) []*eventstore.FieldOpe
 			eventstore.FieldTypeValue,
 		)
 	}
+	if e.ValidUntil != nil {
+		ops = append(ops, validUntilFieldOperation(e.Aggregate(), prefix, e.UserID, *e.ValidUntil))
+	}
 	return ops
 }
 
@@ -109,6 +122,10 @@ type MemberChangedEvent struct {
 
 	Roles  []string `json:"roles,omitempty"`
 	UserID string   `json:"userId,omitempty"`
+	// ValidUntil must be passed again on every change of a time-bound membership,
+	// as the fields of the membership are recreated.
+	// A change without it makes the membership permanent.
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
 }
 
 func (e *MemberChangedEvent) Payload() interface{} {
@@ -121,7 +138,7 @@ func (e *MemberChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint
 
 // FieldOperations removes the existing membership role fields first and sets the new roles after.
 func (e *MemberChangedEvent) FieldOperations(prefix string) []*eventstore.FieldOperation {
-	ops := make([]*eventstore.FieldOperation, len(e.Roles)+1)
+	ops := make([]*eventstore.FieldOperation, len(e.Roles)+1, len(e.Roles)+2)
 	ops[0] = eventstore.RemoveSearchFieldsByAggregateAndObject(
 		e.Aggregate(),
 		memberSearchObject(prefix, e.UserID),
@@ -148,6 +165,9 @@ func (e *MemberChangedEvent) FieldOperations(prefix string) []*eventstore.FieldO
 			eventstore.FieldTypeValue,
 		)
 	}
+	if e.ValidUntil != nil {
+		ops = append(ops, validUntilFieldOperation(e.Aggregate(), prefix, e.UserID, *e.ValidUntil))
+	}
 	return ops
 }
 
@@ -277,3 +297,25 @@ func memberSearchObject(prefix, userID string) eventstore.Object {
 		Revision: MemberRoleRevision,
 	}
 }
+
+// validUntilFieldOperation stores the expiry as unix timestamp, so expired memberships can be searched by number.
+func validUntilFieldOperation(aggregate *eventstore.Aggregate, prefix, userID string, validUntil time.Time) *eventstore.FieldOperation {
+	return eventstore.SetField(
+		aggregate,
+		memberSearchObject(prefix, userID),
+		ValidUntilSearchField(prefix),
+		&eventstore.Value{
+			Value:        validUntil.Unix(),
+			MustBeUnique: false,
+			ShouldIndex:  true,
+		},
+
+		eventstore.FieldTypeInstanceID,
+		eventstore.FieldTypeResourceOwner,
+		eventstore.FieldTypeAggregateType,
+		eventstore.FieldTypeAggregateID,
+		eventstore.FieldTypeObjectType,
+		eventstore.FieldTypeObjectID,
+		eventstore.FieldTypeFieldName,
+	)
+}
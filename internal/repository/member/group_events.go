@@ -0,0 +1,209 @@
+package member
+
+import (
+	"fmt"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// Event types of the memberships of groups, all users of the group inherit the roles of the membership.
+const (
+	UniqueGroupMember     = "group_member"
+	GroupAddedEventType   = "group_member.added"
+	GroupChangedEventType = "group_member.changed"
+	GroupRemovedEventType = "group_member.removed"
+)
+
+const (
+	groupMemberRoleTypeSuffix string = "_group_member_role"
+	GroupMemberRoleRevision   uint8  = 1
+)
+
+func NewAddGroupMemberUniqueConstraint(aggregateID, groupID string) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueGroupMember,
+		fmt.Sprintf("%s:%s", aggregateID, groupID),
+		"Errors.Member.AlreadyExists")
+}
+
+func NewRemoveGroupMemberUniqueConstraint(aggregateID, groupID string) *eventstore.UniqueConstraint {
+	return eventstore.NewRemoveUniqueConstraint(
+		UniqueGroupMember,
+		fmt.Sprintf("%s:%s", aggregateID, groupID),
+	)
+}
+
+type GroupMemberAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Roles   []string `json:"roles"`
+	GroupID string   `json:"groupId"`
+}
+
+func (e *GroupMemberAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *GroupMemberAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewAddGroupMemberUniqueConstraint(e.Aggregate().ID, e.GroupID)}
+}
+
+func (e *GroupMemberAddedEvent) FieldOperations(prefix string) []*eventstore.FieldOperation {
+	return groupMemberRoleFields(e.Aggregate(), prefix, e.GroupID, e.Roles)
+}
+
+func NewGroupMemberAddedEvent(
+	base *eventstore.BaseEvent,
+	groupID string,
+	roles ...string,
+) *GroupMemberAddedEvent {
+	return &GroupMemberAddedEvent{
+		BaseEvent: *base,
+		Roles:     roles,
+		GroupID:   groupID,
+	}
+}
+
+func GroupMemberAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &GroupMemberAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "MEMBER-Gm1uA", "unable to unmarshal group member")
+	}
+
+	return e, nil
+}
+
+type GroupMemberChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Roles   []string `json:"roles,omitempty"`
+	GroupID string   `json:"groupId,omitempty"`
+}
+
+func (e *GroupMemberChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *GroupMemberChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+// FieldOperations removes the existing membership role fields first and sets the new roles after.
+func (e *GroupMemberChangedEvent) FieldOperations(prefix string) []*eventstore.FieldOperation {
+	return append(
+		[]*eventstore.FieldOperation{
+			eventstore.RemoveSearchFieldsByAggregateAndObject(e.Aggregate(), groupMemberSearchObject(prefix, e.GroupID)),
+		},
+		groupMemberRoleFields(e.Aggregate(), prefix, e.GroupID, e.Roles)...,
+	)
+}
+
+func NewGroupMemberChangedEvent(
+	base *eventstore.BaseEvent,
+	groupID string,
+	roles ...string,
+) *GroupMemberChangedEvent {
+	return &GroupMemberChangedEvent{
+		BaseEvent: *base,
+		Roles:     roles,
+		GroupID:   groupID,
+	}
+}
+
+func GroupMemberChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &GroupMemberChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "MEMBER-Gm2uA", "unable to unmarshal group member")
+	}
+
+	return e, nil
+}
+
+type GroupMemberRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	GroupID string `json:"groupId"`
+}
+
+func (e *GroupMemberRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *GroupMemberRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewRemoveGroupMemberUniqueConstraint(e.Aggregate().ID, e.GroupID)}
+}
+
+func (e *GroupMemberRemovedEvent) FieldOperations(prefix string) []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregateAndObject(e.Aggregate(), groupMemberSearchObject(prefix, e.GroupID)),
+	}
+}
+
+func NewGroupMemberRemovedEvent(
+	base *eventstore.BaseEvent,
+	groupID string,
+) *GroupMemberRemovedEvent {
+	return &GroupMemberRemovedEvent{
+		BaseEvent: *base,
+		GroupID:   groupID,
+	}
+}
+
+func GroupMemberRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &GroupMemberRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "MEMBER-Gm3uA", "unable to unmarshal group member")
+	}
+
+	return e, nil
+}
+
+// groupMemberSearchObject groups the roles of the membership of a group.
+// The roles are stored in the same field as the ones of users (e.g. `org_role`),
+// so the users of the group can be resolved by joining the users of the group.
+func groupMemberSearchObject(prefix, groupID string) eventstore.Object {
+	return eventstore.Object{
+		Type:     prefix + groupMemberRoleTypeSuffix,
+		ID:       groupID,
+		Revision: GroupMemberRoleRevision,
+	}
+}
+
+func groupMemberRoleFields(aggregate *eventstore.Aggregate, prefix, groupID string, roles []string) []*eventstore.FieldOperation {
+	ops := make([]*eventstore.FieldOperation, len(roles))
+	for i, role := range roles {
+		ops[i] = eventstore.SetField(
+			aggregate,
+			groupMemberSearchObject(prefix, groupID),
+			prefix+roleSearchFieldSuffix,
+			&eventstore.Value{
+				Value:        role,
+				MustBeUnique: false,
+				ShouldIndex:  true,
+			},
+
+			eventstore.FieldTypeInstanceID,
+			eventstore.FieldTypeResourceOwner,
+			eventstore.FieldTypeAggregateType,
+			eventstore.FieldTypeAggregateID,
+			eventstore.FieldTypeObjectType,
+			eventstore.FieldTypeObjectID,
+			eventstore.FieldTypeFieldName,
+			eventstore.FieldTypeValue,
+		)
+	}
+	return ops
+}
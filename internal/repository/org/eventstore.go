@@ -20,6 +20,9 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberChangedEventType, MemberChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberRemovedEventType, MemberRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberCascadeRemovedEventType, MemberCascadeRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, GroupMemberAddedEventType, GroupMemberAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, GroupMemberChangedEventType, GroupMemberChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, GroupMemberRemovedEventType, GroupMemberRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LabelPolicyAddedEventType, LabelPolicyAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LabelPolicyChangedEventType, LabelPolicyChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LabelPolicyActivatedEventType, LabelPolicyActivatedEventMapper)
@@ -56,6 +59,10 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, LockoutPolicyAddedEventType, LockoutPolicyAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LockoutPolicyChangedEventType, LockoutPolicyChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LockoutPolicyRemovedEventType, LockoutPolicyRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, X509PolicySetEventType, X509PolicySetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, DeviceTrustPolicySetEventType, DeviceTrustPolicySetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, X509PolicyRemovedEventType, X509PolicyRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, DeviceTrustPolicyRemovedEventType, DeviceTrustPolicyRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, PrivacyPolicyAddedEventType, PrivacyPolicyAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, PrivacyPolicyChangedEventType, PrivacyPolicyChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, PrivacyPolicyRemovedEventType, PrivacyPolicyRemovedEventMapper)
@@ -103,7 +110,10 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, AppleIDPChangedEventType, AppleIDPChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLIDPAddedEventType, SAMLIDPAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLIDPChangedEventType, SAMLIDPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, WSFederationIDPAddedEventType, WSFederationIDPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, WSFederationIDPChangedEventType, WSFederationIDPChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, IDPRemovedEventType, IDPRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, IDPClaimMappingsSetEventType, IDPClaimMappingsSetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, TriggerActionsSetEventType, TriggerActionsSetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, TriggerActionsCascadeRemovedEventType, TriggerActionsCascadeRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, FlowClearedEventType, FlowClearedEventMapper)
@@ -37,7 +37,10 @@ const (
 	AppleIDPChangedEventType            eventstore.EventType = "org.idp.apple.changed"
 	SAMLIDPAddedEventType               eventstore.EventType = "org.idp.saml.added"
 	SAMLIDPChangedEventType             eventstore.EventType = "org.idp.saml.changed"
+	WSFederationIDPAddedEventType       eventstore.EventType = "org.idp.wsfed.added"
+	WSFederationIDPChangedEventType     eventstore.EventType = "org.idp.wsfed.changed"
 	IDPRemovedEventType                 eventstore.EventType = "org.idp.removed"
+	IDPClaimMappingsSetEventType        eventstore.EventType = "org.idp.claim_mappings.set"
 )
 
 type OAuthIDPAddedEvent struct {
@@ -1096,6 +1099,84 @@ func SAMLIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error)
 	return &SAMLIDPChangedEvent{SAMLIDPChangedEvent: *e.(*idp.SAMLIDPChangedEvent)}, nil
 }
 
+type WSFederationIDPAddedEvent struct {
+	idp.WSFederationIDPAddedEvent
+}
+
+func NewWSFederationIDPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	ssoEndpoint,
+	realm string,
+	certificate []byte,
+	idClaim string,
+	options idp.Options,
+) *WSFederationIDPAddedEvent {
+
+	return &WSFederationIDPAddedEvent{
+		WSFederationIDPAddedEvent: *idp.NewWSFederationIDPAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				WSFederationIDPAddedEventType,
+			),
+			id,
+			name,
+			ssoEndpoint,
+			realm,
+			certificate,
+			idClaim,
+			options,
+		),
+	}
+}
+
+func WSFederationIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.WSFederationIDPAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WSFederationIDPAddedEvent{WSFederationIDPAddedEvent: *e.(*idp.WSFederationIDPAddedEvent)}, nil
+}
+
+type WSFederationIDPChangedEvent struct {
+	idp.WSFederationIDPChangedEvent
+}
+
+func NewWSFederationIDPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []idp.WSFederationIDPChanges,
+) (*WSFederationIDPChangedEvent, error) {
+
+	changedEvent, err := idp.NewWSFederationIDPChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			WSFederationIDPChangedEventType,
+		),
+		id,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &WSFederationIDPChangedEvent{WSFederationIDPChangedEvent: *changedEvent}, nil
+}
+
+func WSFederationIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.WSFederationIDPChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WSFederationIDPChangedEvent{WSFederationIDPChangedEvent: *e.(*idp.WSFederationIDPChangedEvent)}, nil
+}
+
 type IDPRemovedEvent struct {
 	idp.RemovedEvent
 }
@@ -1129,3 +1210,35 @@ func IDPRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 
 	return &IDPRemovedEvent{RemovedEvent: *e.(*idp.RemovedEvent)}, nil
 }
+
+type IDPClaimMappingsSetEvent struct {
+	idp.ClaimMappingsSetEvent
+}
+
+func NewIDPClaimMappingsSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	mappings []*domain.IDPClaimMapping,
+) *IDPClaimMappingsSetEvent {
+	return &IDPClaimMappingsSetEvent{
+		ClaimMappingsSetEvent: *idp.NewClaimMappingsSetEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				IDPClaimMappingsSetEventType,
+			),
+			id,
+			mappings,
+		),
+	}
+}
+
+func IDPClaimMappingsSetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.ClaimMappingsSetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IDPClaimMappingsSetEvent{ClaimMappingsSetEvent: *e.(*idp.ClaimMappingsSetEvent)}, nil
+}
@@ -0,0 +1,69 @@
+package org
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+var (
+	DeviceTrustPolicySetEventType     = orgEventTypePrefix + policy.DeviceTrustPolicySetEventType
+	DeviceTrustPolicyRemovedEventType = orgEventTypePrefix + policy.DeviceTrustPolicyRemovedEventType
+)
+
+type DeviceTrustPolicySetEvent struct {
+	policy.DeviceTrustPolicySetEvent
+}
+
+func NewDeviceTrustPolicySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	lifetime time.Duration,
+) *DeviceTrustPolicySetEvent {
+	return &DeviceTrustPolicySetEvent{
+		DeviceTrustPolicySetEvent: *policy.NewDeviceTrustPolicySetEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				DeviceTrustPolicySetEventType),
+			lifetime),
+	}
+}
+
+func DeviceTrustPolicySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.DeviceTrustPolicySetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeviceTrustPolicySetEvent{DeviceTrustPolicySetEvent: *e.(*policy.DeviceTrustPolicySetEvent)}, nil
+}
+
+type DeviceTrustPolicyRemovedEvent struct {
+	policy.DeviceTrustPolicyRemovedEvent
+}
+
+func NewDeviceTrustPolicyRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *DeviceTrustPolicyRemovedEvent {
+	return &DeviceTrustPolicyRemovedEvent{
+		DeviceTrustPolicyRemovedEvent: *policy.NewDeviceTrustPolicyRemovedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				DeviceTrustPolicyRemovedEventType),
+		),
+	}
+}
+
+func DeviceTrustPolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.DeviceTrustPolicyRemovedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeviceTrustPolicyRemovedEvent{DeviceTrustPolicyRemovedEvent: *e.(*policy.DeviceTrustPolicyRemovedEvent)}, nil
+}
@@ -0,0 +1,69 @@
+package org
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/policy"
+)
+
+var (
+	X509PolicySetEventType     = orgEventTypePrefix + policy.X509PolicySetEventType
+	X509PolicyRemovedEventType = orgEventTypePrefix + policy.X509PolicyRemovedEventType
+)
+
+type X509PolicySetEvent struct {
+	policy.X509PolicySetEvent
+}
+
+func NewX509PolicySetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	x509Policy *domain.X509Policy,
+) *X509PolicySetEvent {
+	return &X509PolicySetEvent{
+		X509PolicySetEvent: *policy.NewX509PolicySetEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				X509PolicySetEventType),
+			x509Policy),
+	}
+}
+
+func X509PolicySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.X509PolicySetEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &X509PolicySetEvent{X509PolicySetEvent: *e.(*policy.X509PolicySetEvent)}, nil
+}
+
+type X509PolicyRemovedEvent struct {
+	policy.X509PolicyRemovedEvent
+}
+
+func NewX509PolicyRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *X509PolicyRemovedEvent {
+	return &X509PolicyRemovedEvent{
+		X509PolicyRemovedEvent: *policy.NewX509PolicyRemovedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				X509PolicyRemovedEventType),
+		),
+	}
+}
+
+func X509PolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := policy.X509PolicyRemovedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &X509PolicyRemovedEvent{X509PolicyRemovedEvent: *e.(*policy.X509PolicyRemovedEvent)}, nil
+}
@@ -0,0 +1,174 @@
+package permission
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+// Event types
+const (
+	customRoleEventPrefix = permissionEventPrefix + "custom_role."
+	CustomRoleAddedType   = customRoleEventPrefix + "added"
+	CustomRoleChangedType = customRoleEventPrefix + "changed"
+	CustomRoleRemovedType = customRoleEventPrefix + "removed"
+)
+
+// Field table and unique types
+const (
+	CustomRoleType                string = "custom_role"
+	CustomRoleRevision            uint8  = 1
+	CustomRoleSearchField         string = "display_name"
+	CustomRoleUniqueType          string = "custom_role"
+	CustomRoleAlreadyExistsErrMsg string = "Errors.IAM.CustomRole.AlreadyExists"
+)
+
+// CustomRoleAddedEvent defines an administrator role created by the instance administrators.
+// The permissions of the role are stored using the [AddedEvent] and [RemovedEvent],
+// so they are resolved the same way as the roles configured in the runtime configuration.
+type CustomRoleAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	Role                  string `json:"role"`
+	DisplayName           string `json:"displayName,omitempty"`
+}
+
+func (e *CustomRoleAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *CustomRoleAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{
+		eventstore.NewAddEventUniqueConstraint(CustomRoleUniqueType, e.Role, CustomRoleAlreadyExistsErrMsg),
+	}
+}
+
+func (e *CustomRoleAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *CustomRoleAddedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.SetField(
+			e.Aggregate(),
+			customRoleSearchObject(e.Role),
+			CustomRoleSearchField,
+			&eventstore.Value{
+				Value:        e.DisplayName,
+				MustBeUnique: false,
+				ShouldIndex:  false,
+			},
+
+			eventstore.FieldTypeInstanceID,
+			eventstore.FieldTypeResourceOwner,
+			eventstore.FieldTypeAggregateType,
+			eventstore.FieldTypeAggregateID,
+			eventstore.FieldTypeObjectType,
+			eventstore.FieldTypeObjectID,
+			eventstore.FieldTypeFieldName,
+		),
+	}
+}
+
+func NewCustomRoleAddedEvent(ctx context.Context, aggregate *eventstore.Aggregate, role, displayName string) *CustomRoleAddedEvent {
+	return &CustomRoleAddedEvent{
+		BaseEvent:   eventstore.NewBaseEventForPush(ctx, aggregate, CustomRoleAddedType),
+		Role:        role,
+		DisplayName: displayName,
+	}
+}
+
+type CustomRoleChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	Role                  string `json:"role"`
+	DisplayName           string `json:"displayName,omitempty"`
+}
+
+func (e *CustomRoleChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *CustomRoleChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *CustomRoleChangedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *CustomRoleChangedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.SetField(
+			e.Aggregate(),
+			customRoleSearchObject(e.Role),
+			CustomRoleSearchField,
+			&eventstore.Value{
+				Value:        e.DisplayName,
+				MustBeUnique: false,
+				ShouldIndex:  false,
+			},
+
+			eventstore.FieldTypeInstanceID,
+			eventstore.FieldTypeResourceOwner,
+			eventstore.FieldTypeAggregateType,
+			eventstore.FieldTypeAggregateID,
+			eventstore.FieldTypeObjectType,
+			eventstore.FieldTypeObjectID,
+			eventstore.FieldTypeFieldName,
+		),
+	}
+}
+
+func NewCustomRoleChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, role, displayName string) *CustomRoleChangedEvent {
+	return &CustomRoleChangedEvent{
+		BaseEvent:   eventstore.NewBaseEventForPush(ctx, aggregate, CustomRoleChangedType),
+		Role:        role,
+		DisplayName: displayName,
+	}
+}
+
+type CustomRoleRemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	Role                  string `json:"role"`
+}
+
+func (e *CustomRoleRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *CustomRoleRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{
+		eventstore.NewRemoveUniqueConstraint(CustomRoleUniqueType, e.Role),
+	}
+}
+
+func (e *CustomRoleRemovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = event
+}
+
+func (e *CustomRoleRemovedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregateAndObject(
+			e.Aggregate(),
+			customRoleSearchObject(e.Role),
+		),
+		eventstore.RemoveSearchFieldsByAggregateAndObject(
+			e.Aggregate(),
+			roleSearchObject(e.Role),
+		),
+	}
+}
+
+func NewCustomRoleRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, role string) *CustomRoleRemovedEvent {
+	return &CustomRoleRemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(ctx, aggregate, CustomRoleRemovedType),
+		Role:      role,
+	}
+}
+
+func customRoleSearchObject(role string) eventstore.Object {
+	return eventstore.Object{
+		Type:     CustomRoleType,
+		ID:       role,
+		Revision: CustomRoleRevision,
+	}
+}
@@ -5,4 +5,7 @@ import "github.com/zitadel/zitadel/internal/eventstore"
 func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, AddedType, eventstore.GenericEventMapper[AddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, RemovedType, eventstore.GenericEventMapper[RemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CustomRoleAddedType, eventstore.GenericEventMapper[CustomRoleAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CustomRoleChangedType, eventstore.GenericEventMapper[CustomRoleChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CustomRoleRemovedType, eventstore.GenericEventMapper[CustomRoleRemovedEvent])
 }
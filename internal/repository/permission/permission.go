@@ -90,10 +90,17 @@ func (e *RemovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
 
 func (e *RemovedEvent) Fields() []*eventstore.FieldOperation {
 	return []*eventstore.FieldOperation{
-		eventstore.RemoveSearchFieldsByAggregateAndObject(
-			e.Aggregate(),
-			roleSearchObject(e.Role),
-		),
+		eventstore.RemoveSearchFields(map[eventstore.FieldType]any{
+			eventstore.FieldTypeInstanceID:     e.Aggregate().InstanceID,
+			eventstore.FieldTypeResourceOwner:  e.Aggregate().ResourceOwner,
+			eventstore.FieldTypeAggregateType:  e.Aggregate().Type,
+			eventstore.FieldTypeAggregateID:    e.Aggregate().ID,
+			eventstore.FieldTypeObjectType:     RolePermissionType,
+			eventstore.FieldTypeObjectID:       e.Role,
+			eventstore.FieldTypeObjectRevision: RolePermissionRevision,
+			eventstore.FieldTypeFieldName:      PermissionSearchField,
+			eventstore.FieldTypeValue:          e.Permission,
+		}),
 	}
 }
 
@@ -0,0 +1,75 @@
+package policy
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	DeviceTrustPolicySetEventType     = "policy.device.trust.set"
+	DeviceTrustPolicyRemovedEventType = "policy.device.trust.removed"
+)
+
+// DeviceTrustPolicySetEvent always contains the complete policy.
+type DeviceTrustPolicySetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Lifetime time.Duration `json:"lifetime"`
+}
+
+func (e *DeviceTrustPolicySetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DeviceTrustPolicySetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeviceTrustPolicySetEvent(
+	base *eventstore.BaseEvent,
+	lifetime time.Duration,
+) *DeviceTrustPolicySetEvent {
+	return &DeviceTrustPolicySetEvent{
+		BaseEvent: *base,
+		Lifetime:  lifetime,
+	}
+}
+
+func DeviceTrustPolicySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &DeviceTrustPolicySetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-Dtp9M", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type DeviceTrustPolicyRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *DeviceTrustPolicyRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *DeviceTrustPolicyRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewDeviceTrustPolicyRemovedEvent(base *eventstore.BaseEvent) *DeviceTrustPolicyRemovedEvent {
+	return &DeviceTrustPolicyRemovedEvent{
+		BaseEvent: *base,
+	}
+}
+
+func DeviceTrustPolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &DeviceTrustPolicyRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
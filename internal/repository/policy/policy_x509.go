@@ -0,0 +1,106 @@
+package policy
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	X509PolicySetEventType     = "policy.x509.set"
+	X509PolicyRemovedEventType = "policy.x509.removed"
+)
+
+// X509PolicySetEvent always contains the complete policy.
+type X509PolicySetEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	TrustedCAs       []byte            `json:"trustedCAs,omitempty"`
+	MappingRules     []X509MappingRule `json:"mappingRules,omitempty"`
+	OCSPResponderURL string            `json:"ocspResponderURL,omitempty"`
+	CRLURLs          []string          `json:"crlURLs,omitempty"`
+}
+
+type X509MappingRule struct {
+	Source  domain.X509MappingSource `json:"source"`
+	Target  domain.X509MappingTarget `json:"target"`
+	Pattern string                   `json:"pattern,omitempty"`
+}
+
+func (e *X509PolicySetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *X509PolicySetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewX509PolicySetEvent(
+	base *eventstore.BaseEvent,
+	policy *domain.X509Policy,
+) *X509PolicySetEvent {
+	rules := make([]X509MappingRule, len(policy.MappingRules))
+	for i, rule := range policy.MappingRules {
+		rules[i] = X509MappingRule{
+			Source:  rule.Source,
+			Target:  rule.Target,
+			Pattern: rule.Pattern,
+		}
+	}
+	return &X509PolicySetEvent{
+		BaseEvent:        *base,
+		TrustedCAs:       policy.TrustedCAs,
+		MappingRules:     rules,
+		OCSPResponderURL: policy.OCSPResponderURL,
+		CRLURLs:          policy.CRLURLs,
+	}
+}
+
+func (e *X509PolicySetEvent) DomainMappingRules() []domain.X509MappingRule {
+	rules := make([]domain.X509MappingRule, len(e.MappingRules))
+	for i, rule := range e.MappingRules {
+		rules[i] = domain.X509MappingRule{
+			Source:  rule.Source,
+			Target:  rule.Target,
+			Pattern: rule.Pattern,
+		}
+	}
+	return rules
+}
+
+func X509PolicySetEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &X509PolicySetEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "POLIC-X5p9M", "unable to unmarshal policy")
+	}
+
+	return e, nil
+}
+
+type X509PolicyRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *X509PolicyRemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *X509PolicyRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewX509PolicyRemovedEvent(base *eventstore.BaseEvent) *X509PolicyRemovedEvent {
+	return &X509PolicyRemovedEvent{
+		BaseEvent: *base,
+	}
+}
+
+func X509PolicyRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &X509PolicyRemovedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
@@ -231,6 +231,16 @@ func (e *ApplicationRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstr
 	return remove
 }
 
+func (e *ApplicationRemovedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregateAndObject(
+			e.Aggregate(),
+			applicationMemberSearchObject(e.AppID),
+		),
+		removeMemberValidityObject(e.Aggregate(), ApplicationMemberValiditySearchType, e.AppID),
+	}
+}
+
 func NewApplicationRemovedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
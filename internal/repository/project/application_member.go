@@ -0,0 +1,202 @@
+package project
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/member"
+)
+
+const (
+	ApplicationMemberSearchType = "project_application_member"
+	ApplicationMemberRevision   = uint8(1)
+)
+
+var (
+	ApplicationMemberAddedType   = applicationEventTypePrefix + member.AddedEventType
+	ApplicationMemberChangedType = applicationEventTypePrefix + member.ChangedEventType
+	ApplicationMemberRemovedType = applicationEventTypePrefix + member.RemovedEventType
+)
+
+// ApplicationMemberAddedEvent grants administrator roles (e.g. PROJECT_APPLICATION_OWNER)
+// on a single application of the project.
+type ApplicationMemberAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	AppID  string   `json:"appId"`
+	UserID string   `json:"userId"`
+	Roles  []string `json:"roles"`
+	// ValidUntil is set for time-bound memberships, which are removed automatically after expiry.
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+}
+
+func (e *ApplicationMemberAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ApplicationMemberAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ApplicationMemberAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *ApplicationMemberAddedEvent) Fields() []*eventstore.FieldOperation {
+	return append(
+		applicationMemberRoleFields(e.Aggregate(), e.AppID, e.UserID, e.Roles),
+		memberValidityFields(e.Aggregate(), ApplicationMemberValiditySearchType, e.AppID, e.UserID, e.ValidUntil)...,
+	)
+}
+
+func NewApplicationMemberAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID,
+	userID string,
+	roles ...string,
+) *ApplicationMemberAddedEvent {
+	return &ApplicationMemberAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ApplicationMemberAddedType,
+		),
+		AppID:  appID,
+		UserID: userID,
+		Roles:  roles,
+	}
+}
+
+type ApplicationMemberChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	AppID  string   `json:"appId"`
+	UserID string   `json:"userId"`
+	Roles  []string `json:"roles"`
+	// ValidUntil is the validity of the membership after the change, nil for permanent memberships.
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+}
+
+func (e *ApplicationMemberChangedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ApplicationMemberChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ApplicationMemberChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+// Fields removes the existing roles of the member first and sets the new roles and validity after.
+func (e *ApplicationMemberChangedEvent) Fields() []*eventstore.FieldOperation {
+	ops := append(
+		[]*eventstore.FieldOperation{
+			eventstore.RemoveSearchFieldsByAggregateAndObjectAndField(e.Aggregate(), applicationMemberSearchObject(e.AppID), e.UserID),
+		},
+		applicationMemberRoleFields(e.Aggregate(), e.AppID, e.UserID, e.Roles)...,
+	)
+	return append(ops, changedMemberValidityFields(e.Aggregate(), ApplicationMemberValiditySearchType, e.AppID, e.UserID, e.ValidUntil)...)
+}
+
+func NewApplicationMemberChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID,
+	userID string,
+	roles ...string,
+) *ApplicationMemberChangedEvent {
+	return &ApplicationMemberChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ApplicationMemberChangedType,
+		),
+		AppID:  appID,
+		UserID: userID,
+		Roles:  roles,
+	}
+}
+
+type ApplicationMemberRemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	AppID  string `json:"appId"`
+	UserID string `json:"userId"`
+}
+
+func (e *ApplicationMemberRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ApplicationMemberRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ApplicationMemberRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *ApplicationMemberRemovedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregateAndObjectAndField(e.Aggregate(), applicationMemberSearchObject(e.AppID), e.UserID),
+		removeMemberValidityField(e.Aggregate(), ApplicationMemberValiditySearchType, e.AppID, e.UserID),
+	}
+}
+
+func NewApplicationMemberRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID,
+	userID string,
+) *ApplicationMemberRemovedEvent {
+	return &ApplicationMemberRemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ApplicationMemberRemovedType,
+		),
+		AppID:  appID,
+		UserID: userID,
+	}
+}
+
+// applicationMemberSearchObject groups the members of a single application.
+// The field name is the id of the user, the values are the roles of the member,
+// which allows to remove all members at once if the application is removed.
+func applicationMemberSearchObject(appID string) eventstore.Object {
+	return eventstore.Object{
+		Type:     ApplicationMemberSearchType,
+		ID:       appID,
+		Revision: ApplicationMemberRevision,
+	}
+}
+
+func applicationMemberRoleFields(aggregate *eventstore.Aggregate, appID, userID string, roles []string) []*eventstore.FieldOperation {
+	ops := make([]*eventstore.FieldOperation, len(roles))
+	for i, role := range roles {
+		ops[i] = eventstore.SetField(
+			aggregate,
+			applicationMemberSearchObject(appID),
+			userID,
+			&eventstore.Value{
+				Value:        role,
+				MustBeUnique: false,
+				ShouldIndex:  true,
+			},
+
+			eventstore.FieldTypeInstanceID,
+			eventstore.FieldTypeResourceOwner,
+			eventstore.FieldTypeAggregateType,
+			eventstore.FieldTypeAggregateID,
+			eventstore.FieldTypeObjectType,
+			eventstore.FieldTypeObjectID,
+			eventstore.FieldTypeFieldName,
+			eventstore.FieldTypeValue,
+		)
+	}
+	return ops
+}
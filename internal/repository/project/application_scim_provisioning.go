@@ -0,0 +1,110 @@
+package project
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	applicationSCIMProvisioningEventTypePrefix = applicationEventTypePrefix + "scim.provisioning."
+	ApplicationSCIMProvisioningSetType         = applicationSCIMProvisioningEventTypePrefix + "set"
+	ApplicationSCIMProvisioningRemovedType     = applicationSCIMProvisioningEventTypePrefix + "removed"
+)
+
+// ApplicationSCIMProvisioningSetEvent configures the outbound SCIM connector of an application,
+// users and groups authorized for the project are provisioned into the downstream application.
+// The event always contains the complete configuration.
+type ApplicationSCIMProvisioningSetEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	AppID           string                          `json:"appId"`
+	Endpoint        string                          `json:"endpoint"`
+	AuthType        domain.SCIMProvisioningAuthType `json:"authType"`
+	Token           *crypto.CryptoValue             `json:"token,omitempty"`
+	ClientID        string                          `json:"clientId,omitempty"`
+	ClientSecret    *crypto.CryptoValue             `json:"clientSecret,omitempty"`
+	TokenEndpoint   string                          `json:"tokenEndpoint,omitempty"`
+	Scopes          []string                        `json:"scopes,omitempty"`
+	ProvisionGroups bool                            `json:"provisionGroups,omitempty"`
+}
+
+func (e *ApplicationSCIMProvisioningSetEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ApplicationSCIMProvisioningSetEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ApplicationSCIMProvisioningSetEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewApplicationSCIMProvisioningSetEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID,
+	endpoint string,
+	authType domain.SCIMProvisioningAuthType,
+	token *crypto.CryptoValue,
+	clientID string,
+	clientSecret *crypto.CryptoValue,
+	tokenEndpoint string,
+	scopes []string,
+	provisionGroups bool,
+) *ApplicationSCIMProvisioningSetEvent {
+	return &ApplicationSCIMProvisioningSetEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ApplicationSCIMProvisioningSetType,
+		),
+		AppID:           appID,
+		Endpoint:        endpoint,
+		AuthType:        authType,
+		Token:           token,
+		ClientID:        clientID,
+		ClientSecret:    clientSecret,
+		TokenEndpoint:   tokenEndpoint,
+		Scopes:          scopes,
+		ProvisionGroups: provisionGroups,
+	}
+}
+
+// ApplicationSCIMProvisioningRemovedEvent stops the provisioning into the downstream application.
+// Already provisioned users and groups are kept in the downstream application.
+type ApplicationSCIMProvisioningRemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	AppID string `json:"appId"`
+}
+
+func (e *ApplicationSCIMProvisioningRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ApplicationSCIMProvisioningRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ApplicationSCIMProvisioningRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewApplicationSCIMProvisioningRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID string,
+) *ApplicationSCIMProvisioningRemovedEvent {
+	return &ApplicationSCIMProvisioningRemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ApplicationSCIMProvisioningRemovedType,
+		),
+		AppID: appID,
+	}
+}
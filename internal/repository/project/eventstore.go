@@ -14,9 +14,15 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberChangedEventType, MemberChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberRemovedEventType, MemberRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MemberCascadeRemovedEventType, MemberCascadeRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, GroupMemberAddedEventType, GroupMemberAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, GroupMemberChangedEventType, GroupMemberChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, GroupMemberRemovedEventType, GroupMemberRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, RoleAddedType, RoleAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, RoleChangedType, RoleChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, RoleRemovedType, RoleRemovedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, RoleMemberAddedType, eventstore.GenericEventMapper[RoleMemberAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RoleMemberChangedType, eventstore.GenericEventMapper[RoleMemberChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RoleMemberRemovedType, eventstore.GenericEventMapper[RoleMemberRemovedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, GrantAddedType, GrantAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, GrantChangedType, GrantChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, GrantCascadeChangedType, GrantCascadeChangedEventMapper)
@@ -32,6 +38,11 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationRemovedType, ApplicationRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationDeactivatedType, ApplicationDeactivatedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationReactivatedType, ApplicationReactivatedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationMemberAddedType, eventstore.GenericEventMapper[ApplicationMemberAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationMemberChangedType, eventstore.GenericEventMapper[ApplicationMemberChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationMemberRemovedType, eventstore.GenericEventMapper[ApplicationMemberRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationSCIMProvisioningSetType, eventstore.GenericEventMapper[ApplicationSCIMProvisioningSetEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationSCIMProvisioningRemovedType, eventstore.GenericEventMapper[ApplicationSCIMProvisioningRemovedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, OIDCConfigAddedType, OIDCConfigAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, OIDCConfigChangedType, OIDCConfigChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, OIDCConfigSecretChangedType, OIDCConfigSecretChangedEventMapper)
@@ -481,6 +481,7 @@ func (e *GrantRemovedEvent) Fields() []*eventstore.FieldOperation {
 			eventstore.FieldTypeObjectID,
 			eventstore.FieldTypeFieldName,
 		),
+		removeMemberValidityObject(e.Aggregate(), GrantMemberValiditySearchType, e.GrantID),
 	}
 }
 
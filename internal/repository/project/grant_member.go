@@ -3,6 +3,7 @@ package project
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/member"
@@ -37,6 +38,8 @@ type GrantMemberAddedEvent struct {
 	Roles   []string `json:"roles"`
 	UserID  string   `json:"userId"`
 	GrantID string   `json:"grantId"`
+	// ValidUntil is set for time-bound memberships, which are removed automatically after expiry.
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
 }
 
 func (e *GrantMemberAddedEvent) Payload() interface{} {
@@ -47,6 +50,10 @@ func (e *GrantMemberAddedEvent) UniqueConstraints() []*eventstore.UniqueConstrai
 	return []*eventstore.UniqueConstraint{NewAddProjectGrantMemberUniqueConstraint(e.Aggregate().ID, e.UserID, e.GrantID)}
 }
 
+func (e *GrantMemberAddedEvent) Fields() []*eventstore.FieldOperation {
+	return memberValidityFields(e.Aggregate(), GrantMemberValiditySearchType, e.GrantID, e.UserID, e.ValidUntil)
+}
+
 func NewProjectGrantMemberAddedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
@@ -85,6 +92,8 @@ type GrantMemberChangedEvent struct {
 	Roles   []string `json:"roles"`
 	GrantID string   `json:"grantId"`
 	UserID  string   `json:"userId"`
+	// ValidUntil is the validity of the membership after the change, nil for permanent memberships.
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
 }
 
 func (e *GrantMemberChangedEvent) Payload() interface{} {
@@ -95,6 +104,10 @@ func (e *GrantMemberChangedEvent) UniqueConstraints() []*eventstore.UniqueConstr
 	return nil
 }
 
+func (e *GrantMemberChangedEvent) Fields() []*eventstore.FieldOperation {
+	return changedMemberValidityFields(e.Aggregate(), GrantMemberValiditySearchType, e.GrantID, e.UserID, e.ValidUntil)
+}
+
 func NewProjectGrantMemberChangedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
@@ -142,6 +155,12 @@ func (e *GrantMemberRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstr
 	return []*eventstore.UniqueConstraint{NewRemoveProjectGrantMemberUniqueConstraint(e.Aggregate().ID, e.UserID, e.GrantID)}
 }
 
+func (e *GrantMemberRemovedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		removeMemberValidityField(e.Aggregate(), GrantMemberValiditySearchType, e.GrantID, e.UserID),
+	}
+}
+
 func NewProjectGrantMemberRemovedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
@@ -187,6 +206,12 @@ func (e *GrantMemberCascadeRemovedEvent) UniqueConstraints() []*eventstore.Uniqu
 	return []*eventstore.UniqueConstraint{NewRemoveProjectGrantMemberUniqueConstraint(e.Aggregate().ID, e.UserID, e.GrantID)}
 }
 
+func (e *GrantMemberCascadeRemovedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		removeMemberValidityField(e.Aggregate(), GrantMemberValiditySearchType, e.GrantID, e.UserID),
+	}
+}
+
 func NewProjectGrantMemberCascadeRemovedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
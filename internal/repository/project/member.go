@@ -18,6 +18,9 @@ const (
 	fieldPrefix = "project"
 )
 
+// MemberValidUntilSearchField is the field name of the expiry of time-bound memberships.
+var MemberValidUntilSearchField = member.ValidUntilSearchField(fieldPrefix)
+
 type MemberAddedEvent struct {
 	member.MemberAddedEvent
 }
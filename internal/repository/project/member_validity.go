@@ -0,0 +1,73 @@
+package project
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+// The expiry of the memberships of a project grant, application or role is stored in a separate object,
+// as the field name of their roles is already the id of the user.
+const (
+	GrantMemberValiditySearchType       = "project_grant_member_validity"
+	ApplicationMemberValiditySearchType = "project_application_member_validity"
+	RoleMemberValiditySearchType        = "project_role_member_validity"
+	MemberValidityRevision              = uint8(1)
+)
+
+// memberValiditySearchObject groups the time-bound members of a single grant, application or role.
+// The field name is the id of the user, the value is the expiry as unix timestamp,
+// so expired memberships can be searched by number.
+func memberValiditySearchObject(objectType, objectID string) eventstore.Object {
+	return eventstore.Object{
+		Type:     objectType,
+		ID:       objectID,
+		Revision: MemberValidityRevision,
+	}
+}
+
+// memberValidityFields sets the expiry of the membership, memberships without expiry don't have a field.
+func memberValidityFields(aggregate *eventstore.Aggregate, objectType, objectID, userID string, validUntil *time.Time) []*eventstore.FieldOperation {
+	if validUntil == nil {
+		return nil
+	}
+	return []*eventstore.FieldOperation{
+		eventstore.SetField(
+			aggregate,
+			memberValiditySearchObject(objectType, objectID),
+			userID,
+			&eventstore.Value{
+				Value:        validUntil.Unix(),
+				MustBeUnique: false,
+				ShouldIndex:  true,
+			},
+
+			eventstore.FieldTypeInstanceID,
+			eventstore.FieldTypeResourceOwner,
+			eventstore.FieldTypeAggregateType,
+			eventstore.FieldTypeAggregateID,
+			eventstore.FieldTypeObjectType,
+			eventstore.FieldTypeObjectID,
+			eventstore.FieldTypeFieldName,
+		),
+	}
+}
+
+// changedMemberValidityFields replaces the expiry of the membership, as the change event always contains the resulting validity.
+func changedMemberValidityFields(aggregate *eventstore.Aggregate, objectType, objectID, userID string, validUntil *time.Time) []*eventstore.FieldOperation {
+	return append(
+		[]*eventstore.FieldOperation{
+			removeMemberValidityField(aggregate, objectType, objectID, userID),
+		},
+		memberValidityFields(aggregate, objectType, objectID, userID, validUntil)...,
+	)
+}
+
+func removeMemberValidityField(aggregate *eventstore.Aggregate, objectType, objectID, userID string) *eventstore.FieldOperation {
+	return eventstore.RemoveSearchFieldsByAggregateAndObjectAndField(aggregate, memberValiditySearchObject(objectType, objectID), userID)
+}
+
+// removeMemberValidityObject removes the expiry of all members, e.g. if the application is removed.
+func removeMemberValidityObject(aggregate *eventstore.Aggregate, objectType, objectID string) *eventstore.FieldOperation {
+	return eventstore.RemoveSearchFieldsByAggregateAndObject(aggregate, memberValiditySearchObject(objectType, objectID))
+}
@@ -271,6 +271,11 @@ func (e *RoleRemovedEvent) Fields() []*eventstore.FieldOperation {
 			e.Aggregate(),
 			projectRoleSearchObject(e.Key),
 		),
+		eventstore.RemoveSearchFieldsByAggregateAndObject(
+			e.Aggregate(),
+			roleMemberSearchObject(e.Key),
+		),
+		removeMemberValidityObject(e.Aggregate(), RoleMemberValiditySearchType, e.Key),
 	}
 }
 
@@ -0,0 +1,202 @@
+package project
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/member"
+)
+
+const (
+	RoleMemberSearchType = "project_role_member"
+	RoleMemberRevision   = uint8(1)
+)
+
+var (
+	RoleMemberAddedType   = roleEventTypePrefix + member.AddedEventType
+	RoleMemberChangedType = roleEventTypePrefix + member.ChangedEventType
+	RoleMemberRemovedType = roleEventTypePrefix + member.RemovedEventType
+)
+
+// RoleMemberAddedEvent grants administrator roles (e.g. PROJECT_ROLE_ASSIGNER)
+// on a single role of the project, e.g. to authorize users only with this role.
+type RoleMemberAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	RoleKey string   `json:"roleKey"`
+	UserID  string   `json:"userId"`
+	Roles   []string `json:"roles"`
+	// ValidUntil is set for time-bound memberships, which are removed automatically after expiry.
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+}
+
+func (e *RoleMemberAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *RoleMemberAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RoleMemberAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RoleMemberAddedEvent) Fields() []*eventstore.FieldOperation {
+	return append(
+		roleMemberRoleFields(e.Aggregate(), e.RoleKey, e.UserID, e.Roles),
+		memberValidityFields(e.Aggregate(), RoleMemberValiditySearchType, e.RoleKey, e.UserID, e.ValidUntil)...,
+	)
+}
+
+func NewRoleMemberAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	roleKey,
+	userID string,
+	roles ...string,
+) *RoleMemberAddedEvent {
+	return &RoleMemberAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RoleMemberAddedType,
+		),
+		RoleKey: roleKey,
+		UserID:  userID,
+		Roles:   roles,
+	}
+}
+
+type RoleMemberChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	RoleKey string   `json:"roleKey"`
+	UserID  string   `json:"userId"`
+	Roles   []string `json:"roles"`
+	// ValidUntil is the validity of the membership after the change, nil for permanent memberships.
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+}
+
+func (e *RoleMemberChangedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *RoleMemberChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RoleMemberChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+// Fields removes the existing roles of the member first and sets the new roles and validity after.
+func (e *RoleMemberChangedEvent) Fields() []*eventstore.FieldOperation {
+	ops := append(
+		[]*eventstore.FieldOperation{
+			eventstore.RemoveSearchFieldsByAggregateAndObjectAndField(e.Aggregate(), roleMemberSearchObject(e.RoleKey), e.UserID),
+		},
+		roleMemberRoleFields(e.Aggregate(), e.RoleKey, e.UserID, e.Roles)...,
+	)
+	return append(ops, changedMemberValidityFields(e.Aggregate(), RoleMemberValiditySearchType, e.RoleKey, e.UserID, e.ValidUntil)...)
+}
+
+func NewRoleMemberChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	roleKey,
+	userID string,
+	roles ...string,
+) *RoleMemberChangedEvent {
+	return &RoleMemberChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RoleMemberChangedType,
+		),
+		RoleKey: roleKey,
+		UserID:  userID,
+		Roles:   roles,
+	}
+}
+
+type RoleMemberRemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	RoleKey string `json:"roleKey"`
+	UserID  string `json:"userId"`
+}
+
+func (e *RoleMemberRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *RoleMemberRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RoleMemberRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RoleMemberRemovedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregateAndObjectAndField(e.Aggregate(), roleMemberSearchObject(e.RoleKey), e.UserID),
+		removeMemberValidityField(e.Aggregate(), RoleMemberValiditySearchType, e.RoleKey, e.UserID),
+	}
+}
+
+func NewRoleMemberRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	roleKey,
+	userID string,
+) *RoleMemberRemovedEvent {
+	return &RoleMemberRemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RoleMemberRemovedType,
+		),
+		RoleKey: roleKey,
+		UserID:  userID,
+	}
+}
+
+// roleMemberSearchObject groups the members of a single role.
+// The field name is the id of the user, the values are the roles of the member,
+// which allows to remove all members at once if the role is removed.
+func roleMemberSearchObject(roleKey string) eventstore.Object {
+	return eventstore.Object{
+		Type:     RoleMemberSearchType,
+		ID:       roleKey,
+		Revision: RoleMemberRevision,
+	}
+}
+
+func roleMemberRoleFields(aggregate *eventstore.Aggregate, roleKey, userID string, roles []string) []*eventstore.FieldOperation {
+	ops := make([]*eventstore.FieldOperation, len(roles))
+	for i, role := range roles {
+		ops[i] = eventstore.SetField(
+			aggregate,
+			roleMemberSearchObject(roleKey),
+			userID,
+			&eventstore.Value{
+				Value:        role,
+				MustBeUnique: false,
+				ShouldIndex:  true,
+			},
+
+			eventstore.FieldTypeInstanceID,
+			eventstore.FieldTypeResourceOwner,
+			eventstore.FieldTypeAggregateType,
+			eventstore.FieldTypeAggregateID,
+			eventstore.FieldTypeObjectType,
+			eventstore.FieldTypeObjectID,
+			eventstore.FieldTypeFieldName,
+			eventstore.FieldTypeValue,
+		)
+	}
+	return ops
+}
@@ -24,3 +24,21 @@ func NewAggregate(id, instanceId string) *Aggregate {
 		},
 	}
 }
+
+// NewOrgAggregate returns the aggregate of a quota which only applies to the organization.
+func NewOrgAggregate(id, instanceId, orgId string) *Aggregate {
+	return &Aggregate{
+		Aggregate: eventstore.Aggregate{
+			Type:          AggregateType,
+			Version:       AggregateVersion,
+			ID:            id,
+			InstanceID:    instanceId,
+			ResourceOwner: orgId,
+		},
+	}
+}
+
+// IsOrgAggregate returns true if the quota is owned by an organization instead of the instance.
+func IsOrgAggregate(aggregate *eventstore.Aggregate) bool {
+	return aggregate.ResourceOwner != "" && aggregate.ResourceOwner != aggregate.InstanceID
+}
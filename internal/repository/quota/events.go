@@ -25,6 +25,12 @@ const (
 	Unimplemented Unit = iota
 	RequestsAllAuthenticated
 	ActionsAllRunsSeconds
+	UsersAllActive
+	TokensAllIssued
+	NotificationsAllSent
+	UsersAll
+	ProjectsAll
+	ApplicationsAll
 )
 
 func NewRemoveQuotaNameUniqueConstraint(unit Unit) *eventstore.UniqueConstraint {
@@ -137,6 +143,12 @@ func (n *NotificationDueEvent) UniqueConstraints() []*eventstore.UniqueConstrain
 	return nil
 }
 
+// TriggerOrigin is empty, as the notification is not triggered by a request.
+// The organization owners are notified using the primary domain of the instance.
+func (n *NotificationDueEvent) TriggerOrigin() string {
+	return ""
+}
+
 func NewNotificationDueEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
@@ -244,6 +256,10 @@ func (e *RemovedEvent) Payload() interface{} {
 }
 
 func (e *RemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	// the unique constraint was only added for instance quotas by the deprecated added event
+	if IsOrgAggregate(e.Aggregate()) {
+		return nil
+	}
 	return []*eventstore.UniqueConstraint{NewRemoveQuotaNameUniqueConstraint(e.Unit)}
 }
 
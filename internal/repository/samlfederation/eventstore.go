@@ -0,0 +1,16 @@
+package samlfederation
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, AddedType, eventstore.GenericEventMapper[AddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ChangedType, eventstore.GenericEventMapper[ChangedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RemovedType, eventstore.GenericEventMapper[RemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ImportRequestedType, eventstore.GenericEventMapper[ImportRequestedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ImportSucceededType, eventstore.GenericEventMapper[ImportSucceededEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ImportFailedType, eventstore.GenericEventMapper[ImportFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, EntityLinkedType, eventstore.GenericEventMapper[EntityLinkedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, EntityUnlinkedType, eventstore.GenericEventMapper[EntityUnlinkedEvent])
+}
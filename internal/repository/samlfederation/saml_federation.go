@@ -0,0 +1,375 @@
+package samlfederation
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/idp"
+)
+
+const (
+	eventTypePrefix     = eventstore.EventType("saml_federation.")
+	AddedType           = eventTypePrefix + "added"
+	ChangedType         = eventTypePrefix + "changed"
+	RemovedType         = eventTypePrefix + "removed"
+	ImportRequestedType = eventTypePrefix + "import.requested"
+	ImportSucceededType = eventTypePrefix + "import.succeeded"
+	ImportFailedType    = eventTypePrefix + "import.failed"
+	EntityLinkedType    = eventTypePrefix + "entity.linked"
+	EntityUnlinkedType  = eventTypePrefix + "entity.unlinked"
+
+	SearchType     string = "saml_federation"
+	SearchRevision uint8  = 1
+	// NextRunAtSearchField is the unix timestamp at which the next import is due.
+	NextRunAtSearchField string = "next_run_at"
+)
+
+// Config is the configuration of a federation, it's fully replaced on every change.
+type Config struct {
+	Name        string `json:"name"`
+	MetadataURL string `json:"metadataUrl"`
+	// SigningCertificate is the PEM encoded certificate the metadata aggregate is signed with
+	SigningCertificate []byte                       `json:"signingCertificate"`
+	Filter             *domain.SAMLFederationFilter `json:"filter,omitempty"`
+	Interval           time.Duration                `json:"interval"`
+	// AddToLoginPolicy adds the created identity providers to the default login policy
+	AddToLoginPolicy bool `json:"addToLoginPolicy,omitempty"`
+	// Template is applied to every identity provider created from the metadata aggregate
+	Template *IDPTemplate `json:"template,omitempty"`
+}
+
+// IDPTemplate are the settings of the SAML identity providers, which are not part of the metadata.
+type IDPTemplate struct {
+	Binding                       string                   `json:"binding,omitempty"`
+	WithSignedRequest             bool                     `json:"withSignedRequest,omitempty"`
+	SignatureAlgorithm            string                   `json:"signatureAlgorithm,omitempty"`
+	NameIDFormat                  *domain.SAMLNameIDFormat `json:"nameIDFormat,omitempty"`
+	TransientMappingAttributeName string                   `json:"transientMappingAttributeName,omitempty"`
+	FederatedLogoutEnabled        bool                     `json:"federatedLogoutEnabled,omitempty"`
+	idp.Options
+}
+
+// AddedEvent creates a federation, whose metadata aggregate is imported immediately.
+type AddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	Config
+}
+
+func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *AddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *AddedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{nextRunAtField(e.Aggregate(), time.Time{})}
+}
+
+func NewAddedEvent(ctx context.Context, aggregate *eventstore.Aggregate, config Config) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			AddedType,
+		),
+		Config: config,
+	}
+}
+
+// ChangedEvent replaces the configuration of the federation, the metadata aggregate is imported immediately
+// so the changed filter and template are applied.
+type ChangedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+	Config
+}
+
+func (e *ChangedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *ChangedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{nextRunAtField(e.Aggregate(), time.Time{})}
+}
+
+func NewChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, config Config) *ChangedEvent {
+	return &ChangedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ChangedType,
+		),
+		Config: config,
+	}
+}
+
+// RemovedEvent removes the federation, the identity providers are removed by their own events.
+type RemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *RemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *RemovedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *RemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RemovedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregate(e.Aggregate()),
+	}
+}
+
+func NewRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *RemovedEvent {
+	return &RemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RemovedType,
+		),
+	}
+}
+
+// ImportRequestedEvent requests an import independent of the interval.
+type ImportRequestedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *ImportRequestedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ImportRequestedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *ImportRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *ImportRequestedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{nextRunAtField(e.Aggregate(), time.Time{})}
+}
+
+func NewImportRequestedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *ImportRequestedEvent {
+	return &ImportRequestedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ImportRequestedType,
+		),
+	}
+}
+
+// ImportSucceededEvent reports the summary of an import.
+// Entities failing to import don't fail the import, they are counted in the summary.
+type ImportSucceededEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	StartedAt time.Time                           `json:"startedAt"`
+	Summary   *domain.SAMLFederationImportSummary `json:"summary"`
+	// ValidUntil is the expiration of the imported metadata aggregate
+	ValidUntil time.Time `json:"validUntil,omitempty"`
+	NextRunAt  time.Time `json:"nextRunAt"`
+}
+
+func (e *ImportSucceededEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ImportSucceededEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ImportSucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *ImportSucceededEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{nextRunAtField(e.Aggregate(), e.NextRunAt)}
+}
+
+func NewImportSucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	startedAt time.Time,
+	summary *domain.SAMLFederationImportSummary,
+	validUntil,
+	nextRunAt time.Time,
+) *ImportSucceededEvent {
+	return &ImportSucceededEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ImportSucceededType,
+		),
+		StartedAt:  startedAt,
+		Summary:    summary,
+		ValidUntil: validUntil,
+		NextRunAt:  nextRunAt,
+	}
+}
+
+// ImportFailedEvent reports an import which could not be executed,
+// e.g. because the metadata aggregate was unavailable or its signature invalid.
+// The identity providers of the previous import are kept.
+type ImportFailedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	StartedAt time.Time `json:"startedAt"`
+	Error     string    `json:"error"`
+	NextRunAt time.Time `json:"nextRunAt"`
+}
+
+func (e *ImportFailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ImportFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ImportFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *ImportFailedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{nextRunAtField(e.Aggregate(), e.NextRunAt)}
+}
+
+func NewImportFailedEvent(ctx context.Context, aggregate *eventstore.Aggregate, startedAt time.Time, errorMessage string, nextRunAt time.Time) *ImportFailedEvent {
+	return &ImportFailedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ImportFailedType,
+		),
+		StartedAt: startedAt,
+		Error:     errorMessage,
+		NextRunAt: nextRunAt,
+	}
+}
+
+// EntityLinkedEvent links an entity of the metadata aggregate to the identity provider created for it.
+// It's pushed again if the display name of the entity changed.
+type EntityLinkedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	EntityID    string `json:"entityId"`
+	IDPID       string `json:"idpId"`
+	DisplayName string `json:"displayName"`
+}
+
+func (e *EntityLinkedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *EntityLinkedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *EntityLinkedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewEntityLinkedEvent(ctx context.Context, aggregate *eventstore.Aggregate, entityID, idpID, displayName string) *EntityLinkedEvent {
+	return &EntityLinkedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			EntityLinkedType,
+		),
+		EntityID:    entityID,
+		IDPID:       idpID,
+		DisplayName: displayName,
+	}
+}
+
+// EntityUnlinkedEvent is pushed together with the removal of the identity provider of an entity,
+// which was removed from the metadata aggregate or doesn't match the filter anymore.
+type EntityUnlinkedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	EntityID string `json:"entityId"`
+	IDPID    string `json:"idpId"`
+}
+
+func (e *EntityUnlinkedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *EntityUnlinkedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *EntityUnlinkedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewEntityUnlinkedEvent(ctx context.Context, aggregate *eventstore.Aggregate, entityID, idpID string) *EntityUnlinkedEvent {
+	return &EntityUnlinkedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			EntityUnlinkedType,
+		),
+		EntityID: entityID,
+		IDPID:    idpID,
+	}
+}
+
+// nextRunAtField indexes the time of the next import,
+// a zero time marks the import as due immediately.
+func nextRunAtField(aggregate *eventstore.Aggregate, nextRunAt time.Time) *eventstore.FieldOperation {
+	var value int64
+	if !nextRunAt.IsZero() {
+		value = nextRunAt.Unix()
+	}
+	return eventstore.SetField(
+		aggregate,
+		searchObject(aggregate.ID),
+		NextRunAtSearchField,
+		&eventstore.Value{
+			Value:        value,
+			MustBeUnique: false,
+			ShouldIndex:  true,
+		},
+
+		eventstore.FieldTypeInstanceID,
+		eventstore.FieldTypeResourceOwner,
+		eventstore.FieldTypeAggregateType,
+		eventstore.FieldTypeAggregateID,
+		eventstore.FieldTypeObjectType,
+		eventstore.FieldTypeObjectID,
+		eventstore.FieldTypeFieldName,
+	)
+}
+
+func searchObject(id string) eventstore.Object {
+	return eventstore.Object{
+		Type:     SearchType,
+		ID:       id,
+		Revision: SearchRevision,
+	}
+}
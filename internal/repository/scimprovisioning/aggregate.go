@@ -0,0 +1,27 @@
+package scimprovisioning
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	AggregateType    = "scim_provisioning"
+	AggregateVersion = "v1"
+)
+
+type Aggregate struct {
+	eventstore.Aggregate
+}
+
+// NewAggregate returns the aggregate of the provisioning state of an application,
+// the id is the id of the application and the resource owner the organization of its project.
+func NewAggregate(appID, resourceOwner string) *Aggregate {
+	return &Aggregate{
+		Aggregate: eventstore.Aggregate{
+			Type:          AggregateType,
+			Version:       AggregateVersion,
+			ID:            appID,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
@@ -0,0 +1,11 @@
+package scimprovisioning
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+func init() {
+	eventstore.RegisterFilterEventMapper(AggregateType, ResourceProvisionedType, eventstore.GenericEventMapper[ResourceProvisionedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ResourceDeprovisionedType, eventstore.GenericEventMapper[ResourceDeprovisionedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, ResourceFailedType, eventstore.GenericEventMapper[ResourceFailedEvent])
+}
@@ -0,0 +1,28 @@
+package scimprovisioning
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	QueueName = "scim_provisioning"
+)
+
+// Request is the job to synchronize a user or group with all downstream applications
+// it is (or was) provisioned to.
+// The job does not contain the changes, the current state of the resource is provisioned,
+// so that retries and jobs of outdated events do not overwrite newer changes.
+type Request struct {
+	Aggregate    *eventstore.Aggregate               `json:"aggregate"`
+	EventType    eventstore.EventType                `json:"eventType"`
+	ResourceType domain.SCIMProvisioningResourceType `json:"resourceType"`
+	ResourceID   string                              `json:"resourceId"`
+	// IncludeMembers additionally synchronizes the members of a group before the group itself,
+	// e.g. if a group was authorized for a project and all its members are entitled to be provisioned.
+	IncludeMembers bool `json:"includeMembers,omitempty"`
+}
+
+func (r *Request) Kind() string {
+	return "scim_provisioning_request"
+}
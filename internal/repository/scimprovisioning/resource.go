@@ -0,0 +1,133 @@
+package scimprovisioning
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	resourceEventTypePrefix   = eventstore.EventType("scim_provisioning.resource.")
+	ResourceProvisionedType   = resourceEventTypePrefix + "provisioned"
+	ResourceDeprovisionedType = resourceEventTypePrefix + "deprovisioned"
+	ResourceFailedType        = resourceEventTypePrefix + "failed"
+)
+
+// ResourceProvisionedEvent maps a user or group to its id in the downstream application.
+type ResourceProvisionedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ResourceType domain.SCIMProvisioningResourceType `json:"resourceType"`
+	ResourceID   string                              `json:"resourceId"`
+	RemoteID     string                              `json:"remoteId"`
+}
+
+func (e *ResourceProvisionedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ResourceProvisionedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ResourceProvisionedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewResourceProvisionedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	resourceType domain.SCIMProvisioningResourceType,
+	resourceID,
+	remoteID string,
+) *ResourceProvisionedEvent {
+	return &ResourceProvisionedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ResourceProvisionedType,
+		),
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		RemoteID:     remoteID,
+	}
+}
+
+// ResourceDeprovisionedEvent removes the mapping of a user or group
+// after it was deleted in the downstream application.
+type ResourceDeprovisionedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ResourceType domain.SCIMProvisioningResourceType `json:"resourceType"`
+	ResourceID   string                              `json:"resourceId"`
+}
+
+func (e *ResourceDeprovisionedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ResourceDeprovisionedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ResourceDeprovisionedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewResourceDeprovisionedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	resourceType domain.SCIMProvisioningResourceType,
+	resourceID string,
+) *ResourceDeprovisionedEvent {
+	return &ResourceDeprovisionedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ResourceDeprovisionedType,
+		),
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+	}
+}
+
+// ResourceFailedEvent records the error of a failed provisioning attempt of a user or group.
+type ResourceFailedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ResourceType domain.SCIMProvisioningResourceType `json:"resourceType"`
+	ResourceID   string                              `json:"resourceId"`
+	Error        string                              `json:"error"`
+}
+
+func (e *ResourceFailedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ResourceFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *ResourceFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewResourceFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	resourceType domain.SCIMProvisioningResourceType,
+	resourceID,
+	errorMessage string,
+) *ResourceFailedEvent {
+	return &ResourceFailedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ResourceFailedType,
+		),
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Error:        errorMessage,
+	}
+}
@@ -17,6 +17,14 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, OTPEmailSentType, eventstore.GenericEventMapper[OTPEmailSentEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, OTPEmailCheckedType, eventstore.GenericEventMapper[OTPEmailCheckedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, RecoveryCodeCheckedType, eventstore.GenericEventMapper[RecoveryCodeCheckedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PushChallengedType, eventstore.GenericEventMapper[PushChallengedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PushApprovedType, eventstore.GenericEventMapper[PushApprovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PushSentType, eventstore.GenericEventMapper[PushSentEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PushRejectedType, eventstore.GenericEventMapper[PushRejectedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, PushCheckedType, eventstore.GenericEventMapper[PushCheckedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, X509ChallengedType, eventstore.GenericEventMapper[X509ChallengedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, X509CheckedType, eventstore.GenericEventMapper[X509CheckedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, RiskEvaluatedType, eventstore.GenericEventMapper[RiskEvaluatedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, TokenSetType, TokenSetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, MetadataSetType, MetadataSetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LifetimeSetType, eventstore.GenericEventMapper[LifetimeSetEvent])
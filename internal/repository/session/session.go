@@ -30,12 +30,28 @@ const (
 	OTPEmailSentType        = sessionEventPrefix + "otp.email.sent"
 	OTPEmailCheckedType     = sessionEventPrefix + "otp.email.checked"
 	RecoveryCodeCheckedType = sessionEventPrefix + "recoveryCode.checked"
+	PushChallengedType      = sessionEventPrefix + "push.challenged"
+	PushSentType            = sessionEventPrefix + "push.sent"
+	PushApprovedType        = sessionEventPrefix + "push.approved"
+	PushRejectedType        = sessionEventPrefix + "push.rejected"
+	PushCheckedType         = sessionEventPrefix + "push.checked"
+	X509ChallengedType      = sessionEventPrefix + "x509.challenged"
+	X509CheckedType         = sessionEventPrefix + "x509.checked"
+	RiskEvaluatedType       = sessionEventPrefix + "risk.evaluated"
 	TokenSetType            = sessionEventPrefix + "token.set"
 	MetadataSetType         = sessionEventPrefix + "metadata.set"
 	LifetimeSetType         = sessionEventPrefix + "lifetime.set"
 	TerminateType           = sessionEventPrefix + "terminated"
 )
 
+// The user of the sessions with evaluated risk is stored as field,
+// so the risk history of the user can be filtered by the ids of the sessions.
+const (
+	RiskSearchType        = "session_risk"
+	RiskSearchRevision    = uint8(1)
+	RiskUserIDSearchField = "user_id"
+)
+
 type AddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 	UserAgent            *domain.UserAgent `json:"user_agent,omitempty"`
@@ -672,6 +688,13 @@ func (e *TerminateEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
 	return nil
 }
 
+// Fields removes the risk of the session from the history of the user.
+func (e *TerminateEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregate(e.Aggregate()),
+	}
+}
+
 func (e *TerminateEvent) TriggerOrigin() string {
 	return e.TriggeredAtOrigin
 }
@@ -728,3 +751,350 @@ func NewRecoveryCodeCheckedEvent(
 		CheckedAt: checkedAt,
 	}
 }
+
+// PushChallengedEvent requests the approval of the session on one of the registered push devices of the user.
+// The Code is displayed to the user on the login and has to be entered on the device (number matching).
+type PushChallengedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Challenge string        `json:"challenge"`
+	Code      string        `json:"code"`
+	Expiry    time.Duration `json:"expiry"`
+	UserID    string        `json:"userId"`
+	DeviceIDs []string      `json:"deviceIds"`
+	AppName   string        `json:"appName,omitempty"`
+	IP        string        `json:"ip,omitempty"`
+	Location  string        `json:"location,omitempty"`
+}
+
+func (e *PushChallengedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushChallengedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *PushChallengedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewPushChallengedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	challenge,
+	code string,
+	expiry time.Duration,
+	userID string,
+	deviceIDs []string,
+	appName,
+	ip,
+	location string,
+) *PushChallengedEvent {
+	return &PushChallengedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushChallengedType,
+		),
+		Challenge: challenge,
+		Code:      code,
+		Expiry:    expiry,
+		UserID:    userID,
+		DeviceIDs: deviceIDs,
+		AppName:   appName,
+		IP:        ip,
+		Location:  location,
+	}
+}
+
+// PushSentEvent records the delivery of the push challenge to a device,
+// so the challenge isn't delivered to the device again if the delivery to other devices failed.
+type PushSentEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Challenge string `json:"challenge"`
+	DeviceID  string `json:"deviceId"`
+}
+
+func (e *PushSentEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushSentEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *PushSentEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewPushSentEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	challenge,
+	deviceID string,
+) *PushSentEvent {
+	return &PushSentEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushSentType,
+		),
+		Challenge: challenge,
+		DeviceID:  deviceID,
+	}
+}
+
+type PushApprovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID string `json:"deviceId"`
+}
+
+func (e *PushApprovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushApprovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *PushApprovedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewPushApprovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+) *PushApprovedEvent {
+	return &PushApprovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushApprovedType,
+		),
+		DeviceID: deviceID,
+	}
+}
+
+type PushRejectedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID string `json:"deviceId"`
+}
+
+func (e *PushRejectedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushRejectedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *PushRejectedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewPushRejectedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+) *PushRejectedEvent {
+	return &PushRejectedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushRejectedType,
+		),
+		DeviceID: deviceID,
+	}
+}
+
+type PushCheckedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CheckedAt time.Time `json:"checkedAt"`
+}
+
+func (e *PushCheckedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushCheckedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *PushCheckedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewPushCheckedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	checkedAt time.Time,
+) *PushCheckedEvent {
+	return &PushCheckedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushCheckedType,
+		),
+		CheckedAt: checkedAt,
+	}
+}
+
+// X509ChallengedEvent requests the proof of possession of the private key of the client certificate.
+// The Challenge has to be signed with the private key for the check of the certificate.
+type X509ChallengedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Challenge string        `json:"challenge"`
+	Expiry    time.Duration `json:"expiry"`
+}
+
+func (e *X509ChallengedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *X509ChallengedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *X509ChallengedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewX509ChallengedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	challenge string,
+	expiry time.Duration,
+) *X509ChallengedEvent {
+	return &X509ChallengedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			X509ChallengedType,
+		),
+		Challenge: challenge,
+		Expiry:    expiry,
+	}
+}
+
+type X509CheckedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	CheckedAt time.Time `json:"checkedAt"`
+	// Fingerprint is the SHA-256 fingerprint of the client certificate.
+	Fingerprint string `json:"fingerprint"`
+}
+
+func (e *X509CheckedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *X509CheckedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *X509CheckedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+func NewX509CheckedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	checkedAt time.Time,
+	fingerprint string,
+) *X509CheckedEvent {
+	return &X509CheckedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			X509CheckedType,
+		),
+		CheckedAt:   checkedAt,
+		Fingerprint: fingerprint,
+	}
+}
+
+// RiskEvaluatedEvent contains the risk of the session, which is the highest risk evaluated for its checks.
+// The IP, user agent and location are used to evaluate the risk of future sessions of the user.
+type RiskEvaluatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	UserID    string              `json:"userID"`
+	Score     uint32              `json:"score"`
+	Signals   []domain.RiskSignal `json:"signals,omitempty"`
+	Action    domain.RiskAction   `json:"action"`
+	IP        string              `json:"ip,omitempty"`
+	UserAgent string              `json:"userAgent,omitempty"`
+	Location  *domain.GeoLocation `json:"location,omitempty"`
+}
+
+func (e *RiskEvaluatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *RiskEvaluatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *RiskEvaluatedEvent) SetBaseEvent(base *eventstore.BaseEvent) {
+	e.BaseEvent = *base
+}
+
+// Fields stores the user of the session, an upsert is used as the risk can be evaluated multiple times per session.
+func (e *RiskEvaluatedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.SetField(
+			e.Aggregate(),
+			eventstore.Object{
+				Type:     RiskSearchType,
+				ID:       e.Aggregate().ID,
+				Revision: RiskSearchRevision,
+			},
+			RiskUserIDSearchField,
+			&eventstore.Value{
+				Value:        e.UserID,
+				MustBeUnique: false,
+				ShouldIndex:  true,
+			},
+
+			eventstore.FieldTypeInstanceID,
+			eventstore.FieldTypeResourceOwner,
+			eventstore.FieldTypeAggregateType,
+			eventstore.FieldTypeAggregateID,
+			eventstore.FieldTypeObjectType,
+			eventstore.FieldTypeObjectID,
+			eventstore.FieldTypeFieldName,
+		),
+	}
+}
+
+func NewRiskEvaluatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userID string,
+	risk domain.SessionRisk,
+	ip string,
+	userAgent string,
+	location *domain.GeoLocation,
+) *RiskEvaluatedEvent {
+	return &RiskEvaluatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			RiskEvaluatedType,
+		),
+		UserID:    userID,
+		Score:     risk.Score,
+		Signals:   risk.Signals,
+		Action:    risk.Action,
+		IP:        ip,
+		UserAgent: userAgent,
+		Location:  location,
+	}
+}
@@ -57,6 +57,7 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanInitializedCheckSucceededType, HumanInitializedCheckSucceededEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanInitializedCheckFailedType, HumanInitializedCheckFailedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanSignedOutType, HumanSignedOutEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanSignedOutEverywhereType, eventstore.GenericEventMapper[HumanSignedOutEverywhereEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordChangedType, HumanPasswordChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordCodeAddedType, HumanPasswordCodeAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanPasswordCodeSentType, eventstore.GenericEventMapper[HumanPasswordCodeSentEvent])
@@ -70,6 +71,7 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, UserIDPLoginCheckSucceededType, UserIDPCheckSucceededEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, UserIDPExternalIDMigratedType, eventstore.GenericEventMapper[UserIDPExternalIDMigratedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, UserIDPExternalUsernameChangedType, eventstore.GenericEventMapper[UserIDPExternalUsernameEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, UserIDPClaimMappingsAppliedType, eventstore.GenericEventMapper[UserIDPClaimMappingsAppliedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailChangedType, HumanEmailChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailVerifiedType, HumanEmailVerifiedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanEmailVerificationFailedType, HumanEmailVerificationFailedEventMapper)
@@ -107,6 +109,14 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanRecoveryCodesRemovedType, eventstore.GenericEventMapper[HumanRecoveryCodesRemovedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanRecoveryCodeCheckSucceededType, eventstore.GenericEventMapper[HumanRecoveryCodeCheckSucceededEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanRecoveryCodeCheckFailedType, eventstore.GenericEventMapper[HumanRecoveryCodeCheckFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanX509CheckSucceededType, eventstore.GenericEventMapper[HumanX509CheckSucceededEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanX509CheckFailedType, eventstore.GenericEventMapper[HumanX509CheckFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanTrustedDeviceAddedType, eventstore.GenericEventMapper[HumanTrustedDeviceAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanTrustedDeviceCheckSucceededType, eventstore.GenericEventMapper[HumanTrustedDeviceCheckSucceededEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanTrustedDeviceRemovedType, eventstore.GenericEventMapper[HumanTrustedDeviceRemovedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPushDeviceAddedType, eventstore.GenericEventMapper[HumanPushDeviceAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPushDeviceVerifiedType, eventstore.GenericEventMapper[HumanPushDeviceVerifiedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, HumanPushDeviceRemovedType, eventstore.GenericEventMapper[HumanPushDeviceRemovedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanU2FTokenAddedType, HumanU2FAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanU2FTokenVerifiedType, HumanU2FVerifiedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanU2FTokenSignCountChangedType, HumanU2FSignCountChangedEventMapper)
@@ -26,6 +26,7 @@ const (
 	HumanInviteCheckSucceededType      = humanEventPrefix + "invite.check.succeeded"
 	HumanInviteCheckFailedType         = humanEventPrefix + "invite.check.failed"
 	HumanSignedOutType                 = humanEventPrefix + "signed.out"
+	HumanSignedOutEverywhereType       = humanEventPrefix + "signed.out.everywhere"
 )
 
 type HumanAddedEvent struct {
@@ -565,3 +566,40 @@ func HumanSignedOutEventMapper(event eventstore.Event) (eventstore.Event, error)
 
 	return signedOut, nil
 }
+
+// HumanSignedOutEverywhereEvent terminates all sessions of the user on all user agents.
+type HumanSignedOutEverywhereEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	TriggeredAtOrigin string `json:"triggerOrigin,omitempty"`
+}
+
+func (e *HumanSignedOutEverywhereEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanSignedOutEverywhereEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanSignedOutEverywhereEvent) TriggerOrigin() string {
+	return e.TriggeredAtOrigin
+}
+
+func (e *HumanSignedOutEverywhereEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanSignedOutEverywhereEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *HumanSignedOutEverywhereEvent {
+	return &HumanSignedOutEverywhereEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanSignedOutEverywhereType,
+		),
+		TriggeredAtOrigin: http.DomainContext(ctx).Origin(),
+	}
+}
@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -17,6 +18,7 @@ const (
 	UserIDPLinkCascadeRemovedType      = UserIDPLinkEventPrefix + "cascade.removed"
 	UserIDPExternalIDMigratedType      = UserIDPLinkEventPrefix + "id.migrated"
 	UserIDPExternalUsernameChangedType = UserIDPLinkEventPrefix + "username.changed"
+	UserIDPClaimMappingsAppliedType    = UserIDPLinkEventPrefix + "claim_mappings.applied"
 
 	UserIDPLoginCheckSucceededType = idpLoginEventPrefix + "check.succeeded"
 )
@@ -287,3 +289,43 @@ func NewUserIDPExternalUsernameEvent(
 		ExternalUsername: externalUsername,
 	}
 }
+
+// UserIDPClaimMappingsAppliedEvent contains the group memberships and roles granted to the user
+// by the claim mappings of the identity provider, only these are revoked if the claims change.
+type UserIDPClaimMappingsAppliedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	IDPConfigID          string                       `json:"idpConfigId"`
+	GroupIDs             []string                     `json:"groupIds,omitempty"`
+	Roles                []domain.IDPClaimMappingRole `json:"roles,omitempty"`
+}
+
+func (e *UserIDPClaimMappingsAppliedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *UserIDPClaimMappingsAppliedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *UserIDPClaimMappingsAppliedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewUserIDPClaimMappingsAppliedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	idpConfigID string,
+	groupIDs []string,
+	roles []domain.IDPClaimMappingRole,
+) *UserIDPClaimMappingsAppliedEvent {
+	return &UserIDPClaimMappingsAppliedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			UserIDPClaimMappingsAppliedType,
+		),
+		IDPConfigID: idpConfigID,
+		GroupIDs:    groupIDs,
+		Roles:       roles,
+	}
+}
@@ -40,6 +40,9 @@ type HumanOTPAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
 	Secret *crypto.CryptoValue `json:"otpSecret,omitempty"`
+	// TOTPID identifies a named TOTP authenticator, it's empty for the default authenticator
+	TOTPID string `json:"totpId,omitempty"`
+	Name   string `json:"name,omitempty"`
 }
 
 func (e *HumanOTPAddedEvent) Payload() interface{} {
@@ -65,6 +68,21 @@ func NewHumanOTPAddedEvent(
 	}
 }
 
+// NewHumanTOTPAddedEvent adds an additional TOTP authenticator, which is identified by the totpID.
+// The name is optional and can also be set on verification.
+func NewHumanTOTPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	totpID,
+	name string,
+	secret *crypto.CryptoValue,
+) *HumanOTPAddedEvent {
+	event := NewHumanOTPAddedEvent(ctx, aggregate, secret)
+	event.TOTPID = totpID
+	event.Name = name
+	return event
+}
+
 func HumanOTPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	otpAdded := &HumanOTPAddedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -79,6 +97,8 @@ func HumanOTPAddedEventMapper(event eventstore.Event) (eventstore.Event, error)
 type HumanOTPVerifiedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 	UserAgentID          string `json:"userAgentID,omitempty"`
+	TOTPID               string `json:"totpId,omitempty"`
+	Name                 string `json:"name,omitempty"`
 }
 
 func (e *HumanOTPVerifiedEvent) Payload() interface{} {
@@ -104,19 +124,42 @@ func NewHumanOTPVerifiedEvent(
 	}
 }
 
+// NewHumanTOTPVerifiedEvent verifies the TOTP authenticator identified by the totpID.
+// A non-empty name overwrites the name of the registration.
+func NewHumanTOTPVerifiedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	totpID,
+	name,
+	userAgentID string,
+) *HumanOTPVerifiedEvent {
+	event := NewHumanOTPVerifiedEvent(ctx, aggregate, userAgentID)
+	event.TOTPID = totpID
+	event.Name = name
+	return event
+}
+
 func HumanOTPVerifiedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	out := &HumanOTPVerifiedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
 	}
+	err := event.Unmarshal(out)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Tq4vE", "unable to unmarshal human otp verified")
+	}
 	return out, nil
 }
 
 type HumanOTPRemovedEvent struct {
 	eventstore.BaseEvent `json:"-"`
+	TOTPID               string `json:"totpId,omitempty"`
 }
 
 func (e *HumanOTPRemovedEvent) Payload() interface{} {
-	return nil
+	if e.TOTPID == "" {
+		return nil
+	}
+	return e
 }
 
 func (e *HumanOTPRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
@@ -136,10 +179,26 @@ func NewHumanOTPRemovedEvent(
 	}
 }
 
+// NewHumanTOTPRemovedEvent removes the TOTP authenticator identified by the totpID.
+func NewHumanTOTPRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	totpID string,
+) *HumanOTPRemovedEvent {
+	event := NewHumanOTPRemovedEvent(ctx, aggregate)
+	event.TOTPID = totpID
+	return event
+}
+
 func HumanOTPRemovedEventMapper(event eventstore.Event) (eventstore.Event, error) {
-	return &HumanOTPRemovedEvent{
+	out := &HumanOTPRemovedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
-	}, nil
+	}
+	err := event.Unmarshal(out)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "USER-Tq4vR", "unable to unmarshal human otp removed")
+	}
+	return out, nil
 }
 
 type HumanOTPCheckSucceededEvent struct {
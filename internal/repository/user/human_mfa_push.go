@@ -0,0 +1,135 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	pushEventPrefix             = mfaEventPrefix + "push."
+	HumanPushDeviceAddedType    = pushEventPrefix + "added"
+	HumanPushDeviceVerifiedType = pushEventPrefix + "verified"
+	HumanPushDeviceRemovedType  = pushEventPrefix + "removed"
+)
+
+// HumanPushDeviceAddedEvent starts the enrollment of a device, which approves push notifications.
+// The device finishes the enrollment with the code, which is transferred to the device (e.g. by a QR code).
+type HumanPushDeviceAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID string              `json:"deviceId"`
+	Code     *crypto.CryptoValue `json:"code"`
+	Expiry   time.Duration       `json:"expiry"`
+}
+
+func (e *HumanPushDeviceAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPushDeviceAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanPushDeviceAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanPushDeviceAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+	code *crypto.CryptoValue,
+	expiry time.Duration,
+) *HumanPushDeviceAddedEvent {
+	return &HumanPushDeviceAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPushDeviceAddedType,
+		),
+		DeviceID: deviceID,
+		Code:     code,
+		Expiry:   expiry,
+	}
+}
+
+// HumanPushDeviceVerifiedEvent finishes the enrollment of the device.
+// The PublicKey (PKIX, ASN.1 DER) verifies the approvals signed by the device,
+// the PushToken is used by the push channel to deliver the challenges to the device.
+type HumanPushDeviceVerifiedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID  string `json:"deviceId"`
+	Name      string `json:"name,omitempty"`
+	PublicKey []byte `json:"publicKey"`
+	PushToken string `json:"pushToken,omitempty"`
+}
+
+func (e *HumanPushDeviceVerifiedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPushDeviceVerifiedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanPushDeviceVerifiedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanPushDeviceVerifiedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID,
+	name string,
+	publicKey []byte,
+	pushToken string,
+) *HumanPushDeviceVerifiedEvent {
+	return &HumanPushDeviceVerifiedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPushDeviceVerifiedType,
+		),
+		DeviceID:  deviceID,
+		Name:      name,
+		PublicKey: publicKey,
+		PushToken: pushToken,
+	}
+}
+
+type HumanPushDeviceRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	DeviceID string `json:"deviceId"`
+}
+
+func (e *HumanPushDeviceRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanPushDeviceRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanPushDeviceRemovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanPushDeviceRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+) *HumanPushDeviceRemovedEvent {
+	return &HumanPushDeviceRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanPushDeviceRemovedType,
+		),
+		DeviceID: deviceID,
+	}
+}
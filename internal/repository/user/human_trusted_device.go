@@ -0,0 +1,137 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	trustedDeviceEventPrefix             = humanEventPrefix + "trusted.device."
+	HumanTrustedDeviceAddedType          = trustedDeviceEventPrefix + "added"
+	HumanTrustedDeviceCheckSucceededType = trustedDeviceEventPrefix + "check.succeeded"
+	HumanTrustedDeviceRemovedType        = trustedDeviceEventPrefix + "removed"
+)
+
+type HumanTrustedDeviceAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	*AuthRequestInfo
+	DeviceID string `json:"deviceId"`
+	// FingerprintID is the ID of the user agent the device is bound to.
+	FingerprintID string         `json:"fingerprintId"`
+	Name          string         `json:"name,omitempty"`
+	MFAType       domain.MFAType `json:"mfaType"`
+	// Lifetime is the duration the device is trusted from the creation of the event.
+	Lifetime time.Duration `json:"lifetime"`
+}
+
+func (e *HumanTrustedDeviceAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanTrustedDeviceAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanTrustedDeviceAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanTrustedDeviceAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID,
+	fingerprintID,
+	name string,
+	mfaType domain.MFAType,
+	lifetime time.Duration,
+	info *AuthRequestInfo,
+) *HumanTrustedDeviceAddedEvent {
+	return &HumanTrustedDeviceAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanTrustedDeviceAddedType,
+		),
+		AuthRequestInfo: info,
+		DeviceID:        deviceID,
+		FingerprintID:   fingerprintID,
+		Name:            name,
+		MFAType:         mfaType,
+		Lifetime:        lifetime,
+	}
+}
+
+// HumanTrustedDeviceCheckSucceededEvent replaces the check of the second factor on a trusted device.
+type HumanTrustedDeviceCheckSucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	*AuthRequestInfo
+	DeviceID string `json:"deviceId"`
+	// MFAType is the second factor, which was checked when the device was trusted.
+	MFAType domain.MFAType `json:"mfaType"`
+}
+
+func (e *HumanTrustedDeviceCheckSucceededEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanTrustedDeviceCheckSucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanTrustedDeviceCheckSucceededEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanTrustedDeviceCheckSucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+	mfaType domain.MFAType,
+	info *AuthRequestInfo,
+) *HumanTrustedDeviceCheckSucceededEvent {
+	return &HumanTrustedDeviceCheckSucceededEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanTrustedDeviceCheckSucceededType,
+		),
+		AuthRequestInfo: info,
+		DeviceID:        deviceID,
+		MFAType:         mfaType,
+	}
+}
+
+type HumanTrustedDeviceRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	DeviceID             string `json:"deviceId"`
+}
+
+func (e *HumanTrustedDeviceRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanTrustedDeviceRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanTrustedDeviceRemovedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanTrustedDeviceRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	deviceID string,
+) *HumanTrustedDeviceRemovedEvent {
+	return &HumanTrustedDeviceRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanTrustedDeviceRemovedType,
+		),
+		DeviceID: deviceID,
+	}
+}
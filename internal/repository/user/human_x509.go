@@ -0,0 +1,84 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	x509EventPrefix             = humanEventPrefix + "x509."
+	HumanX509CheckSucceededType = x509EventPrefix + "check.succeeded"
+	HumanX509CheckFailedType    = x509EventPrefix + "check.failed"
+)
+
+type HumanX509CheckSucceededEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	*AuthRequestInfo
+	// Fingerprint is the SHA-256 fingerprint of the client certificate.
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+func (e *HumanX509CheckSucceededEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanX509CheckSucceededEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanX509CheckSucceededEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanX509CheckSucceededEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	fingerprint string,
+	info *AuthRequestInfo,
+) *HumanX509CheckSucceededEvent {
+	return &HumanX509CheckSucceededEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanX509CheckSucceededType,
+		),
+		AuthRequestInfo: info,
+		Fingerprint:     fingerprint,
+	}
+}
+
+type HumanX509CheckFailedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+	*AuthRequestInfo
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+func (e *HumanX509CheckFailedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *HumanX509CheckFailedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *HumanX509CheckFailedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewHumanX509CheckFailedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	fingerprint string,
+	info *AuthRequestInfo,
+) *HumanX509CheckFailedEvent {
+	return &HumanX509CheckFailedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			HumanX509CheckFailedType,
+		),
+		AuthRequestInfo: info,
+		Fingerprint:     fingerprint,
+	}
+}
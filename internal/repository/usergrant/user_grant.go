@@ -3,6 +3,7 @@ package usergrant
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -20,6 +21,14 @@ const (
 	UserGrantReactivatedType    = userGrantEventTypePrefix + "reactivated"
 )
 
+// Field table types
+const (
+	UserGrantSearchType     string = "user_grant"
+	UserGrantSearchRevision uint8  = 1
+	ValidFromSearchField    string = "valid_from"
+	ValidUntilSearchField   string = "valid_until"
+)
+
 func NewAddUserGrantUniqueConstraint(resourceOwner, userID, projectID, projectGrantID string) *eventstore.UniqueConstraint {
 	return eventstore.NewAddEventUniqueConstraint(
 		UniqueUserGrant,
@@ -40,6 +49,10 @@ type UserGrantAddedEvent struct {
 	ProjectID      string   `json:"projectId,omitempty"`
 	ProjectGrantID string   `json:"grantId,omitempty"`
 	RoleKeys       []string `json:"roleKeys,omitempty"`
+	// ValidFrom is set if the grant was added deactivated and will be activated at the given time.
+	ValidFrom *time.Time `json:"validFrom,omitempty"`
+	// ValidUntil is set for time-bound grants, which are removed automatically after expiry.
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
 }
 
 func (e *UserGrantAddedEvent) Payload() interface{} {
@@ -50,6 +63,17 @@ func (e *UserGrantAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint
 	return []*eventstore.UniqueConstraint{NewAddUserGrantUniqueConstraint(e.Aggregate().ResourceOwner, e.UserID, e.ProjectID, e.ProjectGrantID)}
 }
 
+func (e *UserGrantAddedEvent) Fields() []*eventstore.FieldOperation {
+	ops := make([]*eventstore.FieldOperation, 0, 2)
+	if e.ValidFrom != nil {
+		ops = append(ops, scheduleFieldOperation(e.Aggregate(), ValidFromSearchField, *e.ValidFrom))
+	}
+	if e.ValidUntil != nil {
+		ops = append(ops, scheduleFieldOperation(e.Aggregate(), ValidUntilSearchField, *e.ValidUntil))
+	}
+	return ops
+}
+
 func NewUserGrantAddedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
@@ -85,8 +109,11 @@ func UserGrantAddedEventMapper(event eventstore.Event) (eventstore.Event, error)
 
 type UserGrantChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
-	UserID               string   `json:"userId"`
-	RoleKeys             []string `json:"roleKeys"`
+	UserID               string     `json:"userId"`
+	RoleKeys             []string   `json:"roleKeys"`
+	ValidUntil           *time.Time `json:"validUntil,omitempty"`
+	// ValidityCleared is set if the time-bound grant was made permanent.
+	ValidityCleared bool `json:"validityCleared,omitempty"`
 }
 
 func (e *UserGrantChangedEvent) Payload() interface{} {
@@ -97,6 +124,25 @@ func (e *UserGrantChangedEvent) UniqueConstraints() []*eventstore.UniqueConstrai
 	return nil
 }
 
+// Fields replaces the expiry of the grant, if it was changed or cleared.
+func (e *UserGrantChangedEvent) Fields() []*eventstore.FieldOperation {
+	if e.ValidityCleared {
+		return []*eventstore.FieldOperation{
+			eventstore.RemoveSearchFieldsByAggregateAndObjectAndField(
+				e.Aggregate(),
+				userGrantSearchObject(e.Aggregate().ID),
+				ValidUntilSearchField,
+			),
+		}
+	}
+	if e.ValidUntil == nil {
+		return nil
+	}
+	return []*eventstore.FieldOperation{
+		scheduleFieldOperation(e.Aggregate(), ValidUntilSearchField, *e.ValidUntil),
+	}
+}
+
 func NewUserGrantChangedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
@@ -128,7 +174,10 @@ func UserGrantChangedEventMapper(event eventstore.Event) (eventstore.Event, erro
 
 type UserGrantCascadeChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
-	RoleKeys             []string `json:"roleKeys,omitempty"`
+	RoleKeys             []string   `json:"roleKeys,omitempty"`
+	ValidUntil           *time.Time `json:"validUntil,omitempty"`
+	// ValidityCleared is set if the time-bound grant was made permanent.
+	ValidityCleared bool `json:"validityCleared,omitempty"`
 }
 
 func (e *UserGrantCascadeChangedEvent) Payload() interface{} {
@@ -139,6 +188,25 @@ func (e *UserGrantCascadeChangedEvent) UniqueConstraints() []*eventstore.UniqueC
 	return nil
 }
 
+// Fields replaces the expiry of the grant, if it was changed or cleared.
+func (e *UserGrantCascadeChangedEvent) Fields() []*eventstore.FieldOperation {
+	if e.ValidityCleared {
+		return []*eventstore.FieldOperation{
+			eventstore.RemoveSearchFieldsByAggregateAndObjectAndField(
+				e.Aggregate(),
+				userGrantSearchObject(e.Aggregate().ID),
+				ValidUntilSearchField,
+			),
+		}
+	}
+	if e.ValidUntil == nil {
+		return nil
+	}
+	return []*eventstore.FieldOperation{
+		scheduleFieldOperation(e.Aggregate(), ValidUntilSearchField, *e.ValidUntil),
+	}
+}
+
 func NewUserGrantCascadeChangedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
@@ -181,6 +249,12 @@ func (e *UserGrantRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstrai
 	return []*eventstore.UniqueConstraint{NewRemoveUserGrantUniqueConstraint(e.Aggregate().ResourceOwner, e.UserID, e.ProjectID, e.ProjectGrantID)}
 }
 
+func (e *UserGrantRemovedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregate(e.Aggregate()),
+	}
+}
+
 func NewUserGrantRemovedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
@@ -221,6 +295,12 @@ func (e *UserGrantCascadeRemovedEvent) UniqueConstraints() []*eventstore.UniqueC
 	return []*eventstore.UniqueConstraint{NewRemoveUserGrantUniqueConstraint(e.Aggregate().ResourceOwner, e.userID, e.projectID, e.projectGrantID)}
 }
 
+func (e *UserGrantCascadeRemovedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregate(e.Aggregate()),
+	}
+}
+
 func NewUserGrantCascadeRemovedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
@@ -248,16 +328,37 @@ func UserGrantCascadeRemovedEventMapper(event eventstore.Event) (eventstore.Even
 
 type UserGrantDeactivatedEvent struct {
 	eventstore.BaseEvent `json:"-"`
+
+	// ScheduleCancelled is set if a scheduled grant was deactivated,
+	// so it will not be activated at its ValidFrom.
+	ScheduleCancelled bool `json:"scheduleCancelled,omitempty"`
 }
 
 func (e *UserGrantDeactivatedEvent) Payload() interface{} {
-	return nil
+	if !e.ScheduleCancelled {
+		return nil
+	}
+	return e
 }
 
 func (e *UserGrantDeactivatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
 	return nil
 }
 
+// Fields removes the scheduled activation, if it was cancelled.
+func (e *UserGrantDeactivatedEvent) Fields() []*eventstore.FieldOperation {
+	if !e.ScheduleCancelled {
+		return nil
+	}
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregateAndObjectAndField(
+			e.Aggregate(),
+			userGrantSearchObject(e.Aggregate().ID),
+			ValidFromSearchField,
+		),
+	}
+}
+
 func NewUserGrantDeactivatedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UserGrantDeactivatedEvent {
 	return &UserGrantDeactivatedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -269,9 +370,16 @@ func NewUserGrantDeactivatedEvent(ctx context.Context, aggregate *eventstore.Agg
 }
 
 func UserGrantDeactivatedEventMapper(event eventstore.Event) (eventstore.Event, error) {
-	return &UserGrantDeactivatedEvent{
+	e := &UserGrantDeactivatedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
-	}, nil
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "UGRANT-Sc7dE", "unable to unmarshal user grant")
+	}
+
+	return e, nil
 }
 
 type UserGrantReactivatedEvent struct {
@@ -286,6 +394,17 @@ func (e *UserGrantReactivatedEvent) UniqueConstraints() []*eventstore.UniqueCons
 	return nil
 }
 
+// Fields removes a scheduled activation, as the grant is active from now on.
+func (e *UserGrantReactivatedEvent) Fields() []*eventstore.FieldOperation {
+	return []*eventstore.FieldOperation{
+		eventstore.RemoveSearchFieldsByAggregateAndObjectAndField(
+			e.Aggregate(),
+			userGrantSearchObject(e.Aggregate().ID),
+			ValidFromSearchField,
+		),
+	}
+}
+
 func NewUserGrantReactivatedEvent(ctx context.Context, aggregate *eventstore.Aggregate) *UserGrantReactivatedEvent {
 	return &UserGrantReactivatedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -301,3 +420,33 @@ func UserGrantReactivatedEventMapper(event eventstore.Event) (eventstore.Event,
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
 	}, nil
 }
+
+func userGrantSearchObject(grantID string) eventstore.Object {
+	return eventstore.Object{
+		Type:     UserGrantSearchType,
+		ID:       grantID,
+		Revision: UserGrantSearchRevision,
+	}
+}
+
+// scheduleFieldOperation stores the time as unix timestamp, so due grants can be searched by number.
+func scheduleFieldOperation(aggregate *eventstore.Aggregate, field string, at time.Time) *eventstore.FieldOperation {
+	return eventstore.SetField(
+		aggregate,
+		userGrantSearchObject(aggregate.ID),
+		field,
+		&eventstore.Value{
+			Value:        at.Unix(),
+			MustBeUnique: false,
+			ShouldIndex:  true,
+		},
+
+		eventstore.FieldTypeInstanceID,
+		eventstore.FieldTypeResourceOwner,
+		eventstore.FieldTypeAggregateType,
+		eventstore.FieldTypeAggregateID,
+		eventstore.FieldTypeObjectType,
+		eventstore.FieldTypeObjectID,
+		eventstore.FieldTypeFieldName,
+	)
+}
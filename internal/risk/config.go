@@ -0,0 +1,63 @@
+package risk
+
+import (
+	"time"
+)
+
+type Config struct {
+	Enabled bool
+	// GeoIPDatabase is the path to the GeoIP database file, see [GeoIP] for the format.
+	// The location based signals are disabled if it's empty.
+	GeoIPDatabase string
+	// History is the amount of previous risk evaluations of the user the location and user agent are compared to.
+	// Every session of the user has at least one evaluation.
+	History uint64
+
+	FailedAttempts     FailedAttemptsConfig
+	CredentialStuffing CredentialStuffingConfig
+	ImpossibleTravel   ImpossibleTravelConfig
+	NewCountry         SignalConfig
+	NewUserAgent       SignalConfig
+
+	Policy PolicyConfig
+}
+
+// SignalConfig defines the score added if the signal is raised.
+// A score of 0 disables the signal.
+type SignalConfig struct {
+	Score uint32
+}
+
+type FailedAttemptsConfig struct {
+	SignalConfig `mapstructure:",squash"`
+	// Threshold is the amount of failed checks since the last successful check, the signal is raised at.
+	Threshold uint64
+}
+
+// CredentialStuffingConfig configures the detection of failed checks of many users from the same IP.
+// The failed checks are counted in the memory of each process, see [failureTracker] for the limitations.
+type CredentialStuffingConfig struct {
+	SignalConfig `mapstructure:",squash"`
+	// Window is the duration failed checks are remembered.
+	Window time.Duration
+	// MinUsers is the amount of distinct users with failed checks from the same IP, the signal is raised at.
+	MinUsers int
+	// MaxIPs limits the memory used, the IPs with the oldest failed checks are forgotten first.
+	MaxIPs int
+}
+
+type ImpossibleTravelConfig struct {
+	SignalConfig `mapstructure:",squash"`
+	// MaxSpeed in kilometres per hour, faster travels are considered impossible.
+	MaxSpeed float64
+	// MinDistance in kilometres, shorter distances are ignored because of the inaccuracy of the GeoIP database.
+	MinDistance float64
+}
+
+// PolicyConfig defines the score at which an action is taken.
+// A score of 0 disables the action.
+type PolicyConfig struct {
+	NotifyScore     uint32
+	RequireMFAScore uint32
+	BlockScore      uint32
+}
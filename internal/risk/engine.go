@@ -0,0 +1,160 @@
+package risk
+
+import (
+	"math"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+// maxScore is the highest possible risk score.
+const maxScore = 100
+
+// earthRadius in kilometres
+const earthRadius = 6371.0
+
+// Engine evaluates the risk of session checks.
+type Engine struct {
+	config   *Config
+	geoIP    *GeoIP
+	failures *failureTracker
+	now      func() time.Time
+}
+
+// NewEngine returns nil if the risk engine is not enabled.
+func NewEngine(config *Config) (*Engine, error) {
+	if config == nil || !config.Enabled {
+		return nil, nil
+	}
+	engine := &Engine{
+		config:   config,
+		failures: newFailureTracker(config.CredentialStuffing.Window, config.CredentialStuffing.MaxIPs),
+		now:      time.Now,
+	}
+	if config.GeoIPDatabase != "" {
+		geoIP, err := OpenGeoIP(config.GeoIPDatabase)
+		if err != nil {
+			return nil, err
+		}
+		engine.geoIP = geoIP
+	}
+	return engine, nil
+}
+
+// Check contains the signals of a session check.
+type Check struct {
+	InstanceID string
+	UserID     string
+	IP         net.IP
+	UserAgent  string
+	// FailedAttempts is the amount of failed checks of the user since the last successful one.
+	FailedAttempts uint64
+	// History contains the previous logins of the user, the latest first.
+	History []*Login
+}
+
+// Login is a previous login of the user.
+type Login struct {
+	CheckedAt time.Time
+	UserAgent string
+	Location  *domain.GeoLocation
+}
+
+type Evaluation struct {
+	domain.SessionRisk
+	// Location of the IP of the check, nil if unknown.
+	Location *domain.GeoLocation
+}
+
+// Evaluate returns the risk of the check and the action defined by the policy.
+func (e *Engine) Evaluate(check *Check) *Evaluation {
+	now := e.now()
+	evaluation := &Evaluation{
+		Location: e.geoIP.Locate(check.IP),
+	}
+	if e.config.FailedAttempts.Threshold > 0 && check.FailedAttempts >= e.config.FailedAttempts.Threshold {
+		evaluation.raise(domain.RiskSignalFailedAttempts, e.config.FailedAttempts.Score)
+	}
+	if e.config.CredentialStuffing.MinUsers > 0 && e.failures.users(check.InstanceID, check.IP, now) >= e.config.CredentialStuffing.MinUsers {
+		evaluation.raise(domain.RiskSignalCredentialStuffing, e.config.CredentialStuffing.Score)
+	}
+	if len(check.History) > 0 {
+		if check.UserAgent != "" && !slices.ContainsFunc(check.History, func(login *Login) bool { return login.UserAgent == check.UserAgent }) {
+			evaluation.raise(domain.RiskSignalNewUserAgent, e.config.NewUserAgent.Score)
+		}
+		if !evaluation.Location.IsEmpty() {
+			if !slices.ContainsFunc(check.History, func(login *Login) bool {
+				return !login.Location.IsEmpty() && login.Location.Country == evaluation.Location.Country
+			}) {
+				evaluation.raise(domain.RiskSignalNewCountry, e.config.NewCountry.Score)
+			}
+			if e.impossibleTravel(check.History, evaluation.Location, now) {
+				evaluation.raise(domain.RiskSignalImpossibleTravel, e.config.ImpossibleTravel.Score)
+			}
+		}
+	}
+	evaluation.Action = e.config.Policy.action(evaluation.Score)
+	return evaluation
+}
+
+// History is the amount of previous risk evaluations of the user, the check should be compared to.
+func (e *Engine) History() uint64 {
+	return e.config.History
+}
+
+// RecordFailure remembers the failed check of the user for the detection of credential stuffing.
+// The failure is only known to the current process.
+func (e *Engine) RecordFailure(instanceID string, ip net.IP, userID string) {
+	e.failures.record(instanceID, ip, userID, e.now())
+}
+
+// impossibleTravel compares the location to the latest previous login with a known location.
+func (e *Engine) impossibleTravel(history []*Login, location *domain.GeoLocation, now time.Time) bool {
+	if e.config.ImpossibleTravel.MaxSpeed <= 0 {
+		return false
+	}
+	index := slices.IndexFunc(history, func(login *Login) bool { return !login.Location.IsEmpty() })
+	if index < 0 {
+		return false
+	}
+	previous := history[index]
+	km := distance(previous.Location, location)
+	if km < e.config.ImpossibleTravel.MinDistance {
+		return false
+	}
+	// prevent a division by zero for checks at the same time
+	hours := max(now.Sub(previous.CheckedAt).Hours(), time.Minute.Hours())
+	return km/hours > e.config.ImpossibleTravel.MaxSpeed
+}
+
+func (e *Evaluation) raise(signal domain.RiskSignal, score uint32) {
+	if score == 0 {
+		return
+	}
+	e.Signals = append(e.Signals, signal)
+	e.Score = min(e.Score+score, maxScore)
+}
+
+func (p *PolicyConfig) action(score uint32) domain.RiskAction {
+	switch {
+	case p.BlockScore > 0 && score >= p.BlockScore:
+		return domain.RiskActionBlock
+	case p.RequireMFAScore > 0 && score >= p.RequireMFAScore:
+		return domain.RiskActionRequireMFA
+	case p.NotifyScore > 0 && score >= p.NotifyScore:
+		return domain.RiskActionNotify
+	default:
+		return domain.RiskActionNone
+	}
+}
+
+// distance returns the great-circle distance between the locations in kilometres using the haversine formula.
+func distance(a, b *domain.GeoLocation) float64 {
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	deltaLat := lat2 - lat1
+	deltaLon := (b.Longitude - a.Longitude) * math.Pi / 180
+	h := math.Sin(deltaLat/2)*math.Sin(deltaLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(deltaLon/2)*math.Sin(deltaLon/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
@@ -0,0 +1,260 @@
+package risk
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func testEngine(t *testing.T, now time.Time) *Engine {
+	geoIP, err := ReadGeoIP(strings.NewReader(testGeoIPDatabase))
+	require.NoError(t, err)
+	config := &Config{
+		Enabled: true,
+		History: 10,
+		FailedAttempts: FailedAttemptsConfig{
+			SignalConfig: SignalConfig{Score: 30},
+			Threshold:    3,
+		},
+		CredentialStuffing: CredentialStuffingConfig{
+			SignalConfig: SignalConfig{Score: 60},
+			Window:       10 * time.Minute,
+			MinUsers:     3,
+			MaxIPs:       2,
+		},
+		ImpossibleTravel: ImpossibleTravelConfig{
+			SignalConfig: SignalConfig{Score: 50},
+			MaxSpeed:     1000,
+			MinDistance:  500,
+		},
+		NewCountry:   SignalConfig{Score: 20},
+		NewUserAgent: SignalConfig{Score: 10},
+		Policy: PolicyConfig{
+			NotifyScore:     10,
+			RequireMFAScore: 40,
+			BlockScore:      90,
+		},
+	}
+	return &Engine{
+		config:   config,
+		geoIP:    geoIP,
+		failures: newFailureTracker(config.CredentialStuffing.Window, config.CredentialStuffing.MaxIPs),
+		now:      func() time.Time { return now },
+	}
+}
+
+func TestEngine_Evaluate(t *testing.T) {
+	now := time.Date(2024, 5, 6, 12, 0, 0, 0, time.UTC)
+	frankfurt := &domain.GeoLocation{Country: "DE", City: "Frankfurt am Main", Latitude: 50.1109, Longitude: 8.68213}
+	brisbane := &domain.GeoLocation{Country: "AU", City: "South Brisbane", Latitude: -27.4767, Longitude: 153.017}
+	tests := []struct {
+		name  string
+		check *Check
+		want  *Evaluation
+	}{
+		{
+			name: "first login",
+			check: &Check{
+				InstanceID: "instance",
+				UserID:     "user",
+				IP:         net.ParseIP("2.16.0.1"),
+				UserAgent:  "browser",
+			},
+			want: &Evaluation{
+				Location: frankfurt,
+			},
+		},
+		{
+			name: "known location and user agent",
+			check: &Check{
+				InstanceID: "instance",
+				UserID:     "user",
+				IP:         net.ParseIP("2.16.0.1"),
+				UserAgent:  "browser",
+				History: []*Login{
+					{CheckedAt: now.Add(-time.Hour), UserAgent: "browser", Location: frankfurt},
+				},
+			},
+			want: &Evaluation{
+				Location: frankfurt,
+			},
+		},
+		{
+			name: "new user agent, notify",
+			check: &Check{
+				InstanceID: "instance",
+				UserID:     "user",
+				IP:         net.ParseIP("2.16.0.1"),
+				UserAgent:  "other browser",
+				History: []*Login{
+					{CheckedAt: now.Add(-time.Hour), UserAgent: "browser", Location: frankfurt},
+				},
+			},
+			want: &Evaluation{
+				SessionRisk: domain.SessionRisk{
+					Score:   10,
+					Signals: []domain.RiskSignal{domain.RiskSignalNewUserAgent},
+					Action:  domain.RiskActionNotify,
+				},
+				Location: frankfurt,
+			},
+		},
+		{
+			name: "failed attempts, notify",
+			check: &Check{
+				InstanceID:     "instance",
+				UserID:         "user",
+				IP:             net.ParseIP("2.16.0.1"),
+				UserAgent:      "browser",
+				FailedAttempts: 5,
+				History: []*Login{
+					{CheckedAt: now.Add(-time.Hour), UserAgent: "browser", Location: frankfurt},
+				},
+			},
+			want: &Evaluation{
+				SessionRisk: domain.SessionRisk{
+					Score:   30,
+					Signals: []domain.RiskSignal{domain.RiskSignalFailedAttempts},
+					Action:  domain.RiskActionNotify,
+				},
+				Location: frankfurt,
+			},
+		},
+		{
+			name: "new country and impossible travel, require mfa",
+			check: &Check{
+				InstanceID: "instance",
+				UserID:     "user",
+				IP:         net.ParseIP("1.0.0.1"),
+				UserAgent:  "browser",
+				History: []*Login{
+					{CheckedAt: now.Add(-time.Hour), UserAgent: "browser", Location: frankfurt},
+				},
+			},
+			want: &Evaluation{
+				SessionRisk: domain.SessionRisk{
+					Score:   70,
+					Signals: []domain.RiskSignal{domain.RiskSignalNewCountry, domain.RiskSignalImpossibleTravel},
+					Action:  domain.RiskActionRequireMFA,
+				},
+				Location: brisbane,
+			},
+		},
+		{
+			name: "new country, travel possible",
+			check: &Check{
+				InstanceID: "instance",
+				UserID:     "user",
+				IP:         net.ParseIP("1.0.0.1"),
+				UserAgent:  "browser",
+				History: []*Login{
+					{CheckedAt: now.Add(-48 * time.Hour), UserAgent: "browser", Location: frankfurt},
+				},
+			},
+			want: &Evaluation{
+				SessionRisk: domain.SessionRisk{
+					Score:   20,
+					Signals: []domain.RiskSignal{domain.RiskSignalNewCountry},
+					Action:  domain.RiskActionNotify,
+				},
+				Location: brisbane,
+			},
+		},
+		{
+			name: "all signals, block",
+			check: &Check{
+				InstanceID:     "instance",
+				UserID:         "user",
+				IP:             net.ParseIP("1.0.0.1"),
+				UserAgent:      "other browser",
+				FailedAttempts: 3,
+				History: []*Login{
+					{CheckedAt: now.Add(-time.Hour), UserAgent: "browser"},
+					{CheckedAt: now.Add(-2 * time.Hour), UserAgent: "browser", Location: frankfurt},
+				},
+			},
+			want: &Evaluation{
+				SessionRisk: domain.SessionRisk{
+					Score: 100,
+					Signals: []domain.RiskSignal{
+						domain.RiskSignalFailedAttempts,
+						domain.RiskSignalNewUserAgent,
+						domain.RiskSignalNewCountry,
+						domain.RiskSignalImpossibleTravel,
+					},
+					Action: domain.RiskActionBlock,
+				},
+				Location: brisbane,
+			},
+		},
+		{
+			name: "unknown location",
+			check: &Check{
+				InstanceID: "instance",
+				UserID:     "user",
+				IP:         net.ParseIP("10.0.0.1"),
+				UserAgent:  "browser",
+				History: []*Login{
+					{CheckedAt: now.Add(-time.Hour), UserAgent: "browser", Location: frankfurt},
+				},
+			},
+			want: &Evaluation{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := testEngine(t, now).Evaluate(tt.check)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEngine_RecordFailure(t *testing.T) {
+	now := time.Date(2024, 5, 6, 12, 0, 0, 0, time.UTC)
+	engine := testEngine(t, now)
+	ip := net.ParseIP("2.16.0.1")
+	check := &Check{
+		InstanceID: "instance",
+		UserID:     "user",
+		IP:         ip,
+	}
+
+	engine.RecordFailure("instance", ip, "user1")
+	engine.RecordFailure("instance", ip, "user2")
+	engine.RecordFailure("instance", ip, "user2")
+	engine.RecordFailure("other", ip, "user3")
+	assert.Empty(t, engine.Evaluate(check).Signals, "two distinct users of the instance")
+
+	engine.RecordFailure("instance", ip, "user3")
+	assert.Equal(t, []domain.RiskSignal{domain.RiskSignalCredentialStuffing}, engine.Evaluate(check).Signals)
+	assert.Equal(t, domain.RiskActionRequireMFA, engine.Evaluate(check).Action)
+
+	engine.now = func() time.Time { return now.Add(11 * time.Minute) }
+	assert.Empty(t, engine.Evaluate(check).Signals, "failures outside the window")
+}
+
+func Test_failureTracker_maxIPs(t *testing.T) {
+	now := time.Now()
+	tracker := newFailureTracker(time.Minute, 2)
+	tracker.record("instance", net.ParseIP("1.1.1.1"), "user", now)
+	tracker.record("instance", net.ParseIP("2.2.2.2"), "user", now)
+	tracker.record("instance", net.ParseIP("1.1.1.1"), "user", now)
+	tracker.record("instance", net.ParseIP("3.3.3.3"), "user", now)
+
+	assert.Equal(t, 1, tracker.users("instance", net.ParseIP("1.1.1.1"), now))
+	assert.Equal(t, 0, tracker.users("instance", net.ParseIP("2.2.2.2"), now), "oldest ip is forgotten")
+	assert.Equal(t, 1, tracker.users("instance", net.ParseIP("3.3.3.3"), now))
+}
+
+func Test_distance(t *testing.T) {
+	frankfurt := &domain.GeoLocation{Latitude: 50.1109, Longitude: 8.68213}
+	brisbane := &domain.GeoLocation{Latitude: -27.4767, Longitude: 153.017}
+	assert.InDelta(t, 16090, distance(frankfurt, brisbane), 50)
+	assert.Zero(t, distance(frankfurt, frankfurt))
+}
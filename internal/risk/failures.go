@@ -0,0 +1,93 @@
+package risk
+
+import (
+	"container/list"
+	"net"
+	"sync"
+	"time"
+)
+
+// failureTracker remembers the users with failed checks per instance and IP for the configured window.
+//
+// The failures are only tracked in the memory of the process, they are neither persisted nor shared:
+//   - every ZITADEL process detects credential stuffing on its own,
+//     if the checks of an IP are balanced over n processes, up to n times [CredentialStuffingConfig.MinUsers] users can fail before the signal is raised
+//   - the failures are lost on a restart of the process
+type failureTracker struct {
+	mu      sync.Mutex
+	window  time.Duration
+	maxIPs  int
+	entries map[failureKey]*list.Element
+	// order contains the entries ordered by their last failure, the oldest first.
+	order *list.List
+}
+
+type failureKey struct {
+	instanceID string
+	ip         string
+}
+
+type failureEntry struct {
+	key   failureKey
+	users map[string]time.Time
+}
+
+func newFailureTracker(window time.Duration, maxIPs int) *failureTracker {
+	return &failureTracker{
+		window:  window,
+		maxIPs:  maxIPs,
+		entries: make(map[failureKey]*list.Element),
+		order:   list.New(),
+	}
+}
+
+func (t *failureTracker) record(instanceID string, ip net.IP, userID string, now time.Time) {
+	if len(ip) == 0 || userID == "" {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := failureKey{instanceID: instanceID, ip: ip.String()}
+	element, ok := t.entries[key]
+	if !ok {
+		element = t.order.PushBack(&failureEntry{key: key, users: make(map[string]time.Time)})
+		t.entries[key] = element
+	}
+	entry := element.Value.(*failureEntry)
+	entry.users[userID] = now
+	t.order.MoveToBack(element)
+
+	for t.order.Len() > t.maxIPs {
+		t.remove(t.order.Front())
+	}
+}
+
+// users returns the amount of distinct users with failed checks from the IP within the window.
+func (t *failureTracker) users(instanceID string, ip net.IP, now time.Time) int {
+	if len(ip) == 0 {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	element, ok := t.entries[failureKey{instanceID: instanceID, ip: ip.String()}]
+	if !ok {
+		return 0
+	}
+	entry := element.Value.(*failureEntry)
+	for userID, failedAt := range entry.users {
+		if now.Sub(failedAt) > t.window {
+			delete(entry.users, userID)
+		}
+	}
+	if len(entry.users) == 0 {
+		t.remove(element)
+	}
+	return len(entry.users)
+}
+
+func (t *failureTracker) remove(element *list.Element) {
+	entry := t.order.Remove(element).(*failureEntry)
+	delete(t.entries, entry.key)
+}
@@ -0,0 +1,137 @@
+package risk
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/csv"
+	"errors"
+	"io"
+	"net"
+	"net/netip"
+	"os"
+	"slices"
+	"strconv"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// GeoIP resolves the location of IP addresses using a local database file.
+//
+// The file is a CSV in the format of the DB-IP "IP to City Lite" database:
+// ip_start,ip_end,continent,country,stateprov,city,latitude,longitude
+// The ranges of a file must not overlap.
+type GeoIP struct {
+	v4        []v4Range
+	v6        []v6Range
+	locations []domain.GeoLocation
+}
+
+type v4Range struct {
+	start, end uint32
+	location   uint32
+}
+
+type v6Range struct {
+	start, end [16]byte
+	location   uint32
+}
+
+// OpenGeoIP reads the database file at path.
+func OpenGeoIP(path string) (*GeoIP, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "RISK-Geo1o", "unable to open GeoIP database")
+	}
+	defer file.Close()
+	return ReadGeoIP(file)
+}
+
+// ReadGeoIP reads a database in the format described on [GeoIP].
+func ReadGeoIP(r io.Reader) (*GeoIP, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 8
+	reader.ReuseRecord = true
+
+	db := new(GeoIP)
+	locationIndex := make(map[domain.GeoLocation]uint32)
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "RISK-Geo2r", "invalid GeoIP database")
+		}
+		start, startErr := netip.ParseAddr(record[0])
+		end, endErr := netip.ParseAddr(record[1])
+		if err = errors.Join(startErr, endErr); err != nil || start.Is4() != end.Is4() || end.Less(start) {
+			return nil, zerrors.ThrowInternalf(err, "RISK-Geo3a", "invalid address range on line %d of GeoIP database", line)
+		}
+		location := domain.GeoLocation{
+			Country: record[3],
+			City:    record[5],
+		}
+		location.Latitude, _ = strconv.ParseFloat(record[6], 64)
+		location.Longitude, _ = strconv.ParseFloat(record[7], 64)
+		index, ok := locationIndex[location]
+		if !ok {
+			index = uint32(len(db.locations))
+			locationIndex[location] = index
+			db.locations = append(db.locations, location)
+		}
+		if start.Is4() {
+			db.v4 = append(db.v4, v4Range{start: v4ToUint(start), end: v4ToUint(end), location: index})
+			continue
+		}
+		db.v6 = append(db.v6, v6Range{start: start.As16(), end: end.As16(), location: index})
+	}
+	slices.SortFunc(db.v4, func(a, b v4Range) int { return cmp.Compare(a.start, b.start) })
+	slices.SortFunc(db.v6, func(a, b v6Range) int { return bytes.Compare(a.start[:], b.start[:]) })
+	return db, nil
+}
+
+// Locate returns the location of the IP address or nil if it's not found.
+func (db *GeoIP) Locate(ip net.IP) *domain.GeoLocation {
+	if db == nil {
+		return nil
+	}
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return nil
+	}
+	addr = addr.Unmap()
+	if addr.Is4() {
+		value := v4ToUint(addr)
+		i, found := slices.BinarySearchFunc(db.v4, value, func(r v4Range, target uint32) int { return cmp.Compare(r.start, target) })
+		if found {
+			return db.location(db.v4[i].location)
+		}
+		if i == 0 || db.v4[i-1].end < value {
+			return nil
+		}
+		return db.location(db.v4[i-1].location)
+	}
+	value := addr.As16()
+	i, found := slices.BinarySearchFunc(db.v6, value, func(r v6Range, target [16]byte) int { return bytes.Compare(r.start[:], target[:]) })
+	if found {
+		return db.location(db.v6[i].location)
+	}
+	if i == 0 || bytes.Compare(db.v6[i-1].end[:], value[:]) < 0 {
+		return nil
+	}
+	return db.location(db.v6[i-1].location)
+}
+
+func (db *GeoIP) location(index uint32) *domain.GeoLocation {
+	location := db.locations[index]
+	if location.Country == "" || location.Country == "ZZ" {
+		return nil
+	}
+	return &location
+}
+
+func v4ToUint(addr netip.Addr) uint32 {
+	b := addr.As4()
+	return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+}
@@ -0,0 +1,112 @@
+package risk
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+const testGeoIPDatabase = `2.16.0.0,2.16.0.255,EU,DE,Hesse,"Frankfurt am Main",50.1109,8.68213
+1.0.0.0,1.0.0.255,OC,AU,Queensland,"South Brisbane",-27.4767,153.017
+1.0.1.0,1.0.3.255,AS,CN,Fujian,Wenzhou,26.0614,119.306
+10.0.0.0,10.255.255.255,ZZ,ZZ,,,0,0
+2001:200::,2001:200:ffff:ffff:ffff:ffff:ffff:ffff,AS,JP,Tokyo,Tokyo,35.6895,139.692
+`
+
+func TestReadGeoIP(t *testing.T) {
+	tests := []struct {
+		name    string
+		ip      string
+		want    *domain.GeoLocation
+		wantErr bool
+	}{
+		{
+			name: "start of range",
+			ip:   "1.0.0.0",
+			want: &domain.GeoLocation{Country: "AU", City: "South Brisbane", Latitude: -27.4767, Longitude: 153.017},
+		},
+		{
+			name: "end of range",
+			ip:   "1.0.3.255",
+			want: &domain.GeoLocation{Country: "CN", City: "Wenzhou", Latitude: 26.0614, Longitude: 119.306},
+		},
+		{
+			name: "unordered range",
+			ip:   "2.16.0.42",
+			want: &domain.GeoLocation{Country: "DE", City: "Frankfurt am Main", Latitude: 50.1109, Longitude: 8.68213},
+		},
+		{
+			name: "between ranges",
+			ip:   "1.0.4.0",
+		},
+		{
+			name: "before ranges",
+			ip:   "0.0.0.1",
+		},
+		{
+			name: "unknown country",
+			ip:   "10.1.2.3",
+		},
+		{
+			name: "ipv4 mapped",
+			ip:   "::ffff:1.0.0.1",
+			want: &domain.GeoLocation{Country: "AU", City: "South Brisbane", Latitude: -27.4767, Longitude: 153.017},
+		},
+		{
+			name: "ipv6",
+			ip:   "2001:200::1",
+			want: &domain.GeoLocation{Country: "JP", City: "Tokyo", Latitude: 35.6895, Longitude: 139.692},
+		},
+		{
+			name: "ipv6 not found",
+			ip:   "2001:201::1",
+		},
+	}
+	db, err := ReadGeoIP(strings.NewReader(testGeoIPDatabase))
+	require.NoError(t, err)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, db.Locate(net.ParseIP(tt.ip)))
+		})
+	}
+}
+
+func TestReadGeoIP_invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		database string
+	}{
+		{
+			name:     "missing fields",
+			database: "1.0.0.0,1.0.0.255,OC,AU\n",
+		},
+		{
+			name:     "invalid address",
+			database: "1.0.0,1.0.0.255,OC,AU,Queensland,South Brisbane,-27.4767,153.017\n",
+		},
+		{
+			name:     "mixed address families",
+			database: "1.0.0.0,2001:200::,OC,AU,Queensland,South Brisbane,-27.4767,153.017\n",
+		},
+		{
+			name:     "end before start",
+			database: "1.0.0.255,1.0.0.0,OC,AU,Queensland,South Brisbane,-27.4767,153.017\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadGeoIP(strings.NewReader(tt.database))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestGeoIP_Locate_nil(t *testing.T) {
+	var db *GeoIP
+	assert.Nil(t, db.Locate(net.ParseIP("1.0.0.1")))
+}
@@ -0,0 +1,10 @@
+package samlfederation
+
+type Config struct {
+	Enabled bool
+	// Interval is the cron schedule of the job searching for due imports.
+	Interval    string
+	MaxAttempts uint8
+	// BulkSize limits the imports executed per run, the remaining are executed on the next run.
+	BulkSize uint32
+}
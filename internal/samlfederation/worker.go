@@ -0,0 +1,115 @@
+package samlfederation
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/robfig/cron/v3"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	QueueName = "saml_federation"
+)
+
+var (
+	_ river.Worker[*SAMLFederationImport] = (*Worker)(nil)
+)
+
+// SAMLFederationImport is the periodic job executing the due imports of the SAML federations.
+type SAMLFederationImport struct{}
+
+func (*SAMLFederationImport) Kind() string {
+	return "saml_federation_import"
+}
+
+type Worker struct {
+	river.WorkerDefaults[*SAMLFederationImport]
+
+	db       Queries
+	commands Commands
+	config   *Config
+	now      func() time.Time
+}
+
+type Queries interface {
+	SearchDueSAMLFederations(ctx context.Context, dueAt time.Time, limit uint32) ([]*query.DueSAMLFederation, error)
+}
+
+type Commands interface {
+	ImportSAMLFederation(ctx context.Context, id string, startedAt time.Time) error
+}
+
+// Register implements the [queue.Worker] interface.
+func (w *Worker) Register(workers *river.Workers, queues map[string]river.QueueConfig) {
+	river.AddWorker[*SAMLFederationImport](workers, w)
+	queues[QueueName] = river.QueueConfig{
+		MaxWorkers: 1, // the job is periodic, a single worker prevents importing the same federation concurrently
+	}
+}
+
+// Work implements the [river.Worker] interface.
+// A failing import does not stop the others, unavailable or invalid metadata aggregates are reported on the federation
+// and retried after its interval.
+func (w *Worker) Work(ctx context.Context, _ *river.Job[*SAMLFederationImport]) error {
+	due, err := w.db.SearchDueSAMLFederations(ctx, w.now(), w.config.BulkSize)
+	if err != nil {
+		return err
+	}
+	errs := make([]error, 0)
+	for _, federation := range due {
+		err := w.commands.ImportSAMLFederation(authz.WithInstanceID(ctx, federation.InstanceID), federation.ID, w.now())
+		// the search fields are removed together with the federation, so it's not due anymore
+		if zerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			logging.WithFields("instance", federation.InstanceID, "federation", federation.ID).
+				WithError(err).Warn("unable to import saml federation")
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func Register(
+	q *queue.Queue,
+	queries Queries,
+	commands Commands,
+	config *Config,
+) {
+	if !config.Enabled {
+		return
+	}
+	q.ShouldStart()
+	q.AddWorkers(&Worker{
+		db:       queries,
+		commands: commands,
+		config:   config,
+		now:      time.Now,
+	})
+}
+
+func Start(config *Config, q *queue.Queue) error {
+	if !config.Enabled {
+		return nil
+	}
+	schedule, err := cron.ParseStandard(config.Interval)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "SAMLF-Sf5wI", "invalid interval")
+	}
+	q.AddPeriodicJob(
+		schedule,
+		&SAMLFederationImport{},
+		queue.WithQueueName(QueueName),
+		queue.WithMaxAttempts(config.MaxAttempts),
+	)
+	return nil
+}
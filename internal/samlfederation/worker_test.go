@@ -0,0 +1,130 @@
+package samlfederation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/samlfederation/mock"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	testNow = time.Now()
+	errDB   = zerrors.ThrowInternal(nil, "id", "db error")
+	errPush = zerrors.ThrowInternal(nil, "id", "push error")
+)
+
+func TestWorker_Work(t *testing.T) {
+	type fields struct {
+		db       func(*testing.T) Queries
+		commands func(*testing.T) Commands
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "database error, error",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueSAMLFederations(gomock.Any(), testNow, uint32(100)).Return(nil, errDB)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					return mock.NewMockCommands(gomock.NewController(t))
+				},
+			},
+			wantErr: errDB,
+		},
+		{
+			name: "nothing due, ok",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueSAMLFederations(gomock.Any(), testNow, uint32(100)).Return([]*query.DueSAMLFederation{}, nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					return mock.NewMockCommands(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "federation removed, ok",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueSAMLFederations(gomock.Any(), testNow, uint32(100)).Return([]*query.DueSAMLFederation{
+						{InstanceID: "instance1", ID: "federation1"},
+					}, nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().ImportSAMLFederation(gomock.Any(), "federation1", testNow).Return(zerrors.ThrowNotFound(nil, "id", "not found"))
+					return commands
+				},
+			},
+		},
+		{
+			name: "import failed, others imported, error",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueSAMLFederations(gomock.Any(), testNow, uint32(100)).Return([]*query.DueSAMLFederation{
+						{InstanceID: "instance1", ID: "federation1"},
+						{InstanceID: "instance2", ID: "federation2"},
+					}, nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().ImportSAMLFederation(gomock.Any(), "federation1", testNow).Return(errPush)
+					commands.EXPECT().ImportSAMLFederation(gomock.Any(), "federation2", testNow).Return(nil)
+					return commands
+				},
+			},
+			wantErr: errPush,
+		},
+		{
+			name: "imported, ok",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueSAMLFederations(gomock.Any(), testNow, uint32(100)).Return([]*query.DueSAMLFederation{
+						{InstanceID: "instance1", ID: "federation1"},
+					}, nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().ImportSAMLFederation(gomock.Any(), "federation1", testNow).Return(nil)
+					return commands
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &Worker{
+				db:       tt.fields.db(t),
+				commands: tt.fields.commands(t),
+				config: &Config{
+					BulkSize: 100,
+				},
+				now: func() time.Time {
+					return testNow
+				},
+			}
+			err := w.Work(context.Background(), nil)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
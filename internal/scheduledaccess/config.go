@@ -0,0 +1,10 @@
+package scheduledaccess
+
+type Config struct {
+	Enabled bool
+	// Interval is the cron schedule of the job searching for due memberships, authorizations and elevations.
+	Interval    string
+	MaxAttempts uint8
+	// BulkSize limits the changes executed per run, the remaining are executed on the next run.
+	BulkSize uint32
+}
@@ -0,0 +1,161 @@
+package scheduledaccess
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/robfig/cron/v3"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/repository/accessreview"
+	"github.com/zitadel/zitadel/internal/repository/elevation"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	QueueName = "scheduled_access"
+)
+
+var (
+	_ river.Worker[*ScheduledAccess] = (*Worker)(nil)
+)
+
+// ScheduledAccess is the periodic job activating and removing time-bound memberships, authorizations and elevations
+// and completing access review campaigns after their deadline.
+type ScheduledAccess struct{}
+
+func (*ScheduledAccess) Kind() string {
+	return "scheduled_access"
+}
+
+type Worker struct {
+	river.WorkerDefaults[*ScheduledAccess]
+
+	db       Queries
+	commands Commands
+	config   *Config
+	now      func() time.Time
+}
+
+type Queries interface {
+	SearchDueScheduledAccess(ctx context.Context, dueAt time.Time, limit uint32) ([]*query.ScheduledAccess, error)
+	SearchUndecidedAccessReviewItems(ctx context.Context, campaignID string) ([]string, error)
+}
+
+type Commands interface {
+	ExpireInstanceMember(ctx context.Context, instanceID, userID string) error
+	ExpireOrgMember(ctx context.Context, orgID, userID string) error
+	ExpireProjectMember(ctx context.Context, projectID, userID, resourceOwner string) error
+	ExpireProjectGrantMember(ctx context.Context, projectID, grantID, userID, resourceOwner string) error
+	ExpireApplicationMember(ctx context.Context, projectID, appID, userID, resourceOwner string) error
+	ExpireProjectRoleMember(ctx context.Context, projectID, roleKey, userID, resourceOwner string) error
+	ActivateScheduledUserGrant(ctx context.Context, grantID, resourceOwner string) error
+	ExpireUserGrant(ctx context.Context, grantID, resourceOwner string) error
+	ActivateElevation(ctx context.Context, id, resourceOwner string) error
+	ExpireElevation(ctx context.Context, id, resourceOwner string) error
+	CompleteAccessReviewCampaign(ctx context.Context, campaignID, resourceOwner string, itemIDs []string) error
+}
+
+// Register implements the [queue.Worker] interface.
+func (w *Worker) Register(workers *river.Workers, queues map[string]river.QueueConfig) {
+	river.AddWorker[*ScheduledAccess](workers, w)
+	queues[QueueName] = river.QueueConfig{
+		MaxWorkers: 1, // the job is periodic, a single worker prevents executing the same changes concurrently
+	}
+}
+
+// Work implements the [river.Worker] interface.
+// A failing change does not stop the others, it is retried on the next run as long as it is due.
+func (w *Worker) Work(ctx context.Context, _ *river.Job[*ScheduledAccess]) error {
+	due, err := w.db.SearchDueScheduledAccess(ctx, w.now(), w.config.BulkSize)
+	if err != nil {
+		return err
+	}
+	errs := make([]error, 0)
+	for _, access := range due {
+		if err := w.execute(authz.WithInstanceID(ctx, access.InstanceID), access); err != nil {
+			logging.WithFields("instance", access.InstanceID, "aggregate", access.AggregateID, "field", access.FieldName).
+				OnError(err).Warn("unable to execute scheduled access change")
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (w *Worker) execute(ctx context.Context, access *query.ScheduledAccess) error {
+	switch {
+	case access.AggregateType == instance.AggregateType && access.FieldName == instance.MemberValidUntilSearchField:
+		return w.commands.ExpireInstanceMember(ctx, access.AggregateID, access.ObjectID)
+	case access.AggregateType == org.AggregateType && access.FieldName == org.MemberValidUntilSearchField:
+		return w.commands.ExpireOrgMember(ctx, access.AggregateID, access.ObjectID)
+	case access.AggregateType == project.AggregateType && access.FieldName == project.MemberValidUntilSearchField:
+		return w.commands.ExpireProjectMember(ctx, access.AggregateID, access.ObjectID, access.ResourceOwner)
+	// the field name of the expiry of project grant, application and role members is the id of the user
+	case access.AggregateType == project.AggregateType && access.ObjectType == project.GrantMemberValiditySearchType:
+		return w.commands.ExpireProjectGrantMember(ctx, access.AggregateID, access.ObjectID, access.FieldName, access.ResourceOwner)
+	case access.AggregateType == project.AggregateType && access.ObjectType == project.ApplicationMemberValiditySearchType:
+		return w.commands.ExpireApplicationMember(ctx, access.AggregateID, access.ObjectID, access.FieldName, access.ResourceOwner)
+	case access.AggregateType == project.AggregateType && access.ObjectType == project.RoleMemberValiditySearchType:
+		return w.commands.ExpireProjectRoleMember(ctx, access.AggregateID, access.ObjectID, access.FieldName, access.ResourceOwner)
+	case access.AggregateType == usergrant.AggregateType && access.FieldName == usergrant.ValidFromSearchField:
+		return w.commands.ActivateScheduledUserGrant(ctx, access.AggregateID, access.ResourceOwner)
+	case access.AggregateType == usergrant.AggregateType && access.FieldName == usergrant.ValidUntilSearchField:
+		return w.commands.ExpireUserGrant(ctx, access.AggregateID, access.ResourceOwner)
+	case access.AggregateType == elevation.AggregateType && access.FieldName == elevation.ActivateAtSearchField:
+		return w.commands.ActivateElevation(ctx, access.AggregateID, access.ResourceOwner)
+	case access.AggregateType == elevation.AggregateType && access.FieldName == elevation.ValidUntilSearchField:
+		return w.commands.ExpireElevation(ctx, access.AggregateID, access.ResourceOwner)
+	case access.AggregateType == accessreview.AggregateType && access.FieldName == accessreview.DeadlineSearchField:
+		itemIDs, err := w.db.SearchUndecidedAccessReviewItems(ctx, access.AggregateID)
+		if err != nil {
+			return err
+		}
+		return w.commands.CompleteAccessReviewCampaign(ctx, access.AggregateID, access.ResourceOwner, itemIDs)
+	}
+	logging.WithFields("aggregateType", access.AggregateType, "field", access.FieldName).Error("unknown scheduled access")
+	return nil
+}
+
+func Register(
+	q *queue.Queue,
+	queries Queries,
+	commands Commands,
+	config *Config,
+) {
+	if !config.Enabled {
+		return
+	}
+	q.ShouldStart()
+	q.AddWorkers(&Worker{
+		db:       queries,
+		commands: commands,
+		config:   config,
+		now:      time.Now,
+	})
+}
+
+func Start(config *Config, q *queue.Queue) error {
+	if !config.Enabled {
+		return nil
+	}
+	schedule, err := cron.ParseStandard(config.Interval)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "SCHED-Wq3nF", "invalid interval")
+	}
+	q.AddPeriodicJob(
+		schedule,
+		&ScheduledAccess{},
+		queue.WithQueueName(QueueName),
+		queue.WithMaxAttempts(config.MaxAttempts),
+	)
+	return nil
+}
@@ -0,0 +1,143 @@
+package scheduledaccess
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/scheduledaccess/mock"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	testNow = time.Now()
+	errDB   = zerrors.ThrowInternal(nil, "id", "db error")
+	errPush = zerrors.ThrowInternal(nil, "id", "push error")
+)
+
+func TestWorker_Work(t *testing.T) {
+	type fields struct {
+		db       func(*testing.T) Queries
+		commands func(*testing.T) Commands
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "database error, error",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueScheduledAccess(gomock.Any(), testNow, uint32(100)).Return(
+						nil, errDB,
+					)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					return mock.NewMockCommands(gomock.NewController(t))
+				},
+			},
+			wantErr: errDB,
+		},
+		{
+			name: "nothing due, ok",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueScheduledAccess(gomock.Any(), testNow, uint32(100)).Return(
+						[]*query.ScheduledAccess{}, nil,
+					)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					return mock.NewMockCommands(gomock.NewController(t))
+				},
+			},
+		},
+		{
+			name: "all changes executed, ok",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueScheduledAccess(gomock.Any(), testNow, uint32(100)).Return(
+						[]*query.ScheduledAccess{
+							{InstanceID: "instance1", ResourceOwner: "instance1", AggregateType: "instance", AggregateID: "instance1", ObjectID: "user1", FieldName: "instance_valid_until"},
+							{InstanceID: "instance1", ResourceOwner: "org1", AggregateType: "org", AggregateID: "org1", ObjectID: "user2", FieldName: "org_valid_until"},
+							{InstanceID: "instance1", ResourceOwner: "org1", AggregateType: "project", AggregateID: "project1", ObjectType: "project_member_role", ObjectID: "user3", FieldName: "project_valid_until"},
+							{InstanceID: "instance1", ResourceOwner: "org1", AggregateType: "project", AggregateID: "project1", ObjectType: "project_grant_member_validity", ObjectID: "projectgrant1", FieldName: "user4"},
+							{InstanceID: "instance1", ResourceOwner: "org1", AggregateType: "project", AggregateID: "project1", ObjectType: "project_application_member_validity", ObjectID: "app1", FieldName: "user5"},
+							{InstanceID: "instance1", ResourceOwner: "org1", AggregateType: "project", AggregateID: "project1", ObjectType: "project_role_member_validity", ObjectID: "role1", FieldName: "user6"},
+							{InstanceID: "instance2", ResourceOwner: "org2", AggregateType: "usergrant", AggregateID: "grant1", ObjectID: "grant1", FieldName: "valid_from"},
+							{InstanceID: "instance2", ResourceOwner: "org2", AggregateType: "usergrant", AggregateID: "grant2", ObjectID: "grant2", FieldName: "valid_until"},
+							{InstanceID: "instance2", ResourceOwner: "org2", AggregateType: "elevation", AggregateID: "elevation1", ObjectID: "elevation1", FieldName: "activate_at"},
+							{InstanceID: "instance2", ResourceOwner: "org2", AggregateType: "elevation", AggregateID: "elevation2", ObjectID: "elevation2", FieldName: "valid_until"},
+							{InstanceID: "instance2", ResourceOwner: "org2", AggregateType: "access_review", AggregateID: "campaign1", ObjectID: "campaign1", FieldName: "deadline"},
+							{InstanceID: "instance2", ResourceOwner: "org2", AggregateType: "unknown", AggregateID: "unknown1", ObjectID: "unknown1", FieldName: "valid_until"},
+						}, nil,
+					)
+					queries.EXPECT().SearchUndecidedAccessReviewItems(gomock.Any(), "campaign1").Return([]string{"grant3"}, nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().CompleteAccessReviewCampaign(gomock.Any(), "campaign1", "org2", []string{"grant3"}).Return(nil)
+					commands.EXPECT().ExpireInstanceMember(gomock.Any(), "instance1", "user1").Return(nil)
+					commands.EXPECT().ExpireOrgMember(gomock.Any(), "org1", "user2").Return(nil)
+					commands.EXPECT().ExpireProjectMember(gomock.Any(), "project1", "user3", "org1").Return(nil)
+					commands.EXPECT().ExpireProjectGrantMember(gomock.Any(), "project1", "projectgrant1", "user4", "org1").Return(nil)
+					commands.EXPECT().ExpireApplicationMember(gomock.Any(), "project1", "app1", "user5", "org1").Return(nil)
+					commands.EXPECT().ExpireProjectRoleMember(gomock.Any(), "project1", "role1", "user6", "org1").Return(nil)
+					commands.EXPECT().ActivateScheduledUserGrant(gomock.Any(), "grant1", "org2").Return(nil)
+					commands.EXPECT().ExpireUserGrant(gomock.Any(), "grant2", "org2").Return(nil)
+					commands.EXPECT().ActivateElevation(gomock.Any(), "elevation1", "org2").Return(nil)
+					commands.EXPECT().ExpireElevation(gomock.Any(), "elevation2", "org2").Return(nil)
+					return commands
+				},
+			},
+		},
+		{
+			name: "failing change doesn't stop others, error",
+			fields: fields{
+				db: func(t *testing.T) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SearchDueScheduledAccess(gomock.Any(), testNow, uint32(100)).Return(
+						[]*query.ScheduledAccess{
+							{InstanceID: "instance1", ResourceOwner: "org1", AggregateType: "org", AggregateID: "org1", ObjectID: "user1", FieldName: "org_valid_until"},
+							{InstanceID: "instance1", ResourceOwner: "org1", AggregateType: "org", AggregateID: "org1", ObjectID: "user2", FieldName: "org_valid_until"},
+						}, nil,
+					)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().ExpireOrgMember(gomock.Any(), "org1", "user1").Return(errPush)
+					commands.EXPECT().ExpireOrgMember(gomock.Any(), "org1", "user2").Return(nil)
+					return commands
+				},
+			},
+			wantErr: errPush,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &Worker{
+				db:       tt.fields.db(t),
+				commands: tt.fields.commands(t),
+				config: &Config{
+					BulkSize: 100,
+				},
+				now: func() time.Time {
+					return testNow
+				},
+			}
+			err := w.Work(context.Background(), nil)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
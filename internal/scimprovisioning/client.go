@@ -0,0 +1,154 @@
+package scimprovisioning
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	contentTypeSCIM = "application/scim+json"
+
+	usersPath  = "Users"
+	groupsPath = "Groups"
+)
+
+var errRemoteNotFound = errors.New("resource not found in downstream application")
+
+// client calls the SCIM 2.0 endpoint of a downstream application,
+// see https://datatracker.ietf.org/doc/html/rfc7644
+type client struct {
+	endpoint string
+	http     *http.Client
+}
+
+func newClient(ctx context.Context, target *query.SCIMProvisioningTarget, timeout time.Duration) *client {
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Timeout: timeout})
+	var httpClient *http.Client
+	switch target.AuthType { //nolint:exhaustive
+	case domain.SCIMProvisioningAuthTypeClientCredentials:
+		httpClient = (&clientcredentials.Config{
+			ClientID:     target.ClientID,
+			ClientSecret: target.ClientSecret,
+			TokenURL:     target.TokenEndpoint,
+			Scopes:       target.Scopes,
+		}).Client(ctx)
+	default:
+		httpClient = oauth2.NewClient(ctx, oauth2.StaticTokenSource(&oauth2.Token{
+			AccessToken: target.Token,
+			TokenType:   "Bearer",
+		}))
+	}
+	httpClient.Timeout = timeout
+	return &client{
+		endpoint: strings.TrimSuffix(target.Endpoint, "/"),
+		http:     httpClient,
+	}
+}
+
+// upsert replaces the resource if it is already provisioned, otherwise it's created.
+// A resource deleted in the downstream application is created again.
+// The id of the resource in the downstream application is returned.
+func (c *client) upsert(ctx context.Context, path, remoteID string, resource any) (string, error) {
+	if remoteID != "" {
+		err := c.do(ctx, http.MethodPut, path+"/"+remoteID, resource, nil)
+		if !errors.Is(err, errRemoteNotFound) {
+			return remoteID, err
+		}
+	}
+	created := new(resourceID)
+	if err := c.do(ctx, http.MethodPost, path, resource, created); err != nil {
+		return "", err
+	}
+	if created.ID == "" {
+		return "", errors.New("downstream application returned no id")
+	}
+	return created.ID, nil
+}
+
+// delete removes the resource, a resource already deleted in the downstream application is ignored.
+func (c *client) delete(ctx context.Context, path, remoteID string) error {
+	err := c.do(ctx, http.MethodDelete, path+"/"+remoteID, nil, nil)
+	if errors.Is(err, errRemoteNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (c *client) do(ctx context.Context, method, path string, body, response any) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+"/"+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", contentTypeSCIM)
+	if body != nil {
+		req.Header.Set("Content-Type", contentTypeSCIM)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return errRemoteNotFound
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return newStatusError(resp)
+	}
+	if response == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+// statusError is returned if the downstream application responds with an unsuccessful status,
+// the detail of the SCIM error response is added if available.
+type statusError struct {
+	status string
+	detail string
+}
+
+func newStatusError(resp *http.Response) error {
+	scimErr := new(scimError)
+	_ = json.NewDecoder(io.LimitReader(resp.Body, 4096)).Decode(scimErr)
+	return &statusError{
+		status: resp.Status,
+		detail: scimErr.Detail,
+	}
+}
+
+func (e *statusError) Error() string {
+	if e.detail == "" {
+		return e.status
+	}
+	return fmt.Sprintf("%s: %s", e.status, e.detail)
+}
+
+type scimError struct {
+	Detail string `json:"detail"`
+}
+
+type resourceID struct {
+	ID string `json:"id"`
+}
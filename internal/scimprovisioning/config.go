@@ -0,0 +1,17 @@
+package scimprovisioning
+
+import (
+	"time"
+)
+
+type Config struct {
+	// Workers is the amount of workers provisioning users and groups into downstream applications.
+	// If set to 0, no provisioning jobs are handled by this instance.
+	Workers uint8
+	// TransactionDuration is the maximum duration a job can do its work before it is considered as failed.
+	TransactionDuration time.Duration
+	// MaxAttempts of a job, failed provisionings are retried with an exponential backoff.
+	MaxAttempts uint8
+	// HTTPTimeout limits every request to the downstream applications.
+	HTTPTimeout time.Duration
+}
@@ -0,0 +1,270 @@
+package scimprovisioning
+
+import (
+	"context"
+
+	"github.com/riverqueue/river"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/groupgrant"
+	scim_repo "github.com/zitadel/zitadel/internal/repository/scimprovisioning"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	RequestsProjectionTable = "projections.scim_provisioning_requests"
+)
+
+type Queue interface {
+	Insert(ctx context.Context, args river.JobArgs, opts ...queue.InsertOpt) error
+}
+
+// requestHandler enqueues a provisioning job for every change of a user, group or their authorizations.
+// Instances without any connector are skipped.
+type requestHandler struct {
+	queries     Queries
+	queue       Queue
+	maxAttempts uint8
+}
+
+func newRequestHandler(ctx context.Context, config handler.Config, queries Queries, queue Queue, maxAttempts uint8) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &requestHandler{
+		queries:     queries,
+		queue:       queue,
+		maxAttempts: maxAttempts,
+	})
+}
+
+func (*requestHandler) Name() string {
+	return RequestsProjectionTable
+}
+
+func (h *requestHandler) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.HumanProfileChangedType,
+					Reduce: h.reduceUserChanged,
+				},
+				{
+					Event:  user.HumanEmailChangedType,
+					Reduce: h.reduceUserChanged,
+				},
+				{
+					Event:  user.HumanPhoneChangedType,
+					Reduce: h.reduceUserChanged,
+				},
+				{
+					Event:  user.MachineChangedEventType,
+					Reduce: h.reduceUserChanged,
+				},
+				{
+					Event:  user.UserUserNameChangedType,
+					Reduce: h.reduceUserChanged,
+				},
+				{
+					Event:  user.UserDeactivatedType,
+					Reduce: h.reduceUserChanged,
+				},
+				{
+					Event:  user.UserReactivatedType,
+					Reduce: h.reduceUserChanged,
+				},
+				{
+					Event:  user.UserLockedType,
+					Reduce: h.reduceUserChanged,
+				},
+				{
+					Event:  user.UserUnlockedType,
+					Reduce: h.reduceUserChanged,
+				},
+				{
+					Event:  user.UserRemovedType,
+					Reduce: h.reduceUserChanged,
+				},
+			},
+		},
+		{
+			Aggregate: usergrant.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  usergrant.UserGrantAddedType,
+					Reduce: h.reduceUserGrantAdded,
+				},
+				{
+					Event:  usergrant.UserGrantRemovedType,
+					Reduce: h.reduceUserGrantRemoved,
+				},
+				{
+					Event:  usergrant.UserGrantCascadeRemovedType,
+					Reduce: h.reduceUserGrantStateChanged,
+				},
+				{
+					Event:  usergrant.UserGrantDeactivatedType,
+					Reduce: h.reduceUserGrantStateChanged,
+				},
+				{
+					Event:  usergrant.UserGrantReactivatedType,
+					Reduce: h.reduceUserGrantStateChanged,
+				},
+			},
+		},
+		{
+			Aggregate: group.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  group.GroupChangedEventType,
+					Reduce: h.reduceGroupChanged,
+				},
+				{
+					Event:  group.GroupRemovedEventType,
+					Reduce: h.reduceGroupRemoved,
+				},
+				{
+					Event:  group.GroupUsersAddedEventType,
+					Reduce: h.reduceGroupUsersAdded,
+				},
+				{
+					Event:  group.GroupUsersRemovedEventType,
+					Reduce: h.reduceGroupUsersRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: groupgrant.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  groupgrant.GroupGrantAddedType,
+					Reduce: h.reduceGroupGrantAdded,
+				},
+				{
+					Event:  groupgrant.GroupGrantRemovedType,
+					Reduce: h.reduceGroupGrantRemoved,
+				},
+			},
+		},
+	}
+}
+
+func (h *requestHandler) reduceUserChanged(event eventstore.Event) (*handler.Statement, error) {
+	return h.enqueue(event, userRequest(event, event.Aggregate().ID)), nil
+}
+
+func (h *requestHandler) reduceUserGrantAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "SCIMP-Hd3uA", "reduce.wrong.event.type %s", usergrant.UserGrantAddedType)
+	}
+	return h.enqueue(e, userRequest(e, e.UserID)), nil
+}
+
+func (h *requestHandler) reduceUserGrantRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*usergrant.UserGrantRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "SCIMP-Hd3uR", "reduce.wrong.event.type %s", usergrant.UserGrantRemovedType)
+	}
+	return h.enqueue(e, userRequest(e, e.UserID)), nil
+}
+
+// reduceUserGrantStateChanged enqueues a job without the user, as the events don't contain it,
+// the worker resolves the affected users itself.
+func (h *requestHandler) reduceUserGrantStateChanged(event eventstore.Event) (*handler.Statement, error) {
+	return h.enqueue(event, userRequest(event, "")), nil
+}
+
+func (h *requestHandler) reduceGroupChanged(event eventstore.Event) (*handler.Statement, error) {
+	return h.enqueue(event, groupRequest(event, event.Aggregate().ID, false)), nil
+}
+
+func (h *requestHandler) reduceGroupRemoved(event eventstore.Event) (*handler.Statement, error) {
+	return h.enqueue(event, groupRequest(event, event.Aggregate().ID, true)), nil
+}
+
+func (h *requestHandler) reduceGroupUsersAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.GroupUsersAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "SCIMP-Hd3gA", "reduce.wrong.event.type %s", group.GroupUsersAddedEventType)
+	}
+	return h.enqueue(e, groupUsersRequests(e, e.UserIDs)...), nil
+}
+
+func (h *requestHandler) reduceGroupUsersRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*group.GroupUsersRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "SCIMP-Hd3gR", "reduce.wrong.event.type %s", group.GroupUsersRemovedEventType)
+	}
+	return h.enqueue(e, groupUsersRequests(e, e.UserIDs)...), nil
+}
+
+func (h *requestHandler) reduceGroupGrantAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*groupgrant.GroupGrantAddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "SCIMP-Hd3pA", "reduce.wrong.event.type %s", groupgrant.GroupGrantAddedType)
+	}
+	return h.enqueue(e, groupRequest(e, e.GroupID, true)), nil
+}
+
+func (h *requestHandler) reduceGroupGrantRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*groupgrant.GroupGrantRemovedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "SCIMP-Hd3pR", "reduce.wrong.event.type %s", groupgrant.GroupGrantRemovedType)
+	}
+	return h.enqueue(e, groupRequest(e, e.GroupID, true)), nil
+}
+
+func userRequest(event eventstore.Event, userID string) *scim_repo.Request {
+	return &scim_repo.Request{
+		Aggregate:    event.Aggregate(),
+		EventType:    event.Type(),
+		ResourceType: domain.SCIMProvisioningResourceTypeUser,
+		ResourceID:   userID,
+	}
+}
+
+func groupRequest(event eventstore.Event, groupID string, includeMembers bool) *scim_repo.Request {
+	return &scim_repo.Request{
+		Aggregate:      event.Aggregate(),
+		EventType:      event.Type(),
+		ResourceType:   domain.SCIMProvisioningResourceTypeGroup,
+		ResourceID:     groupID,
+		IncludeMembers: includeMembers,
+	}
+}
+
+// groupUsersRequests synchronizes the users, as their authorizations might have changed through the group,
+// and the members of the group afterward.
+func groupUsersRequests(event eventstore.Event, userIDs []string) []*scim_repo.Request {
+	requests := make([]*scim_repo.Request, 0, len(userIDs)+1)
+	for _, userID := range userIDs {
+		requests = append(requests, userRequest(event, userID))
+	}
+	return append(requests, groupRequest(event, event.Aggregate().ID, false))
+}
+
+func (h *requestHandler) enqueue(event eventstore.Event, requests ...*scim_repo.Request) *handler.Statement {
+	return handler.NewStatement(event, func(ctx context.Context, _ handler.Executer, _ string) error {
+		ctx = workerContext(ctx, event.Aggregate())
+		exists, err := h.queries.SCIMProvisioningConnectorsExist(ctx)
+		if err != nil || !exists {
+			return err
+		}
+		for _, request := range requests {
+			err = h.queue.Insert(ctx, request,
+				queue.WithQueueName(scim_repo.QueueName),
+				queue.WithMaxAttempts(h.maxAttempts),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,4 @@
+package mock
+
+//go:generate mockgen -package mock -destination queries.mock.go github.com/zitadel/zitadel/internal/scimprovisioning Queries
+//go:generate mockgen -package mock -destination commands.mock.go github.com/zitadel/zitadel/internal/scimprovisioning Commands
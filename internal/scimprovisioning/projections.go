@@ -0,0 +1,36 @@
+package scimprovisioning
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/queue"
+)
+
+var (
+	projections []*handler.Handler
+)
+
+// Register adds the handler enqueuing the provisioning jobs and the worker executing them.
+func Register(
+	ctx context.Context,
+	requestsHandlerCustomConfig projection.CustomConfig,
+	config *Config,
+	q *queue.Queue,
+	queries Queries,
+	commands Commands,
+) {
+	// make sure the slice does not contain old values
+	projections = nil
+
+	q.ShouldStart()
+	projections = append(projections, newRequestHandler(ctx, projection.ApplyCustomConfig(requestsHandlerCustomConfig), queries, q, config.MaxAttempts))
+	q.AddWorkers(NewWorker(queries, commands, config))
+}
+
+func Start(ctx context.Context) {
+	for _, projection := range projections {
+		projection.Start(ctx)
+	}
+}
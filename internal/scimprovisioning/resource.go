@@ -0,0 +1,97 @@
+package scimprovisioning
+
+import (
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	schemaUser  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup = "urn:ietf:params:scim:schemas:core:2.0:Group"
+)
+
+// scimUser is the SCIM user resource provisioned into the downstream application,
+// the externalId is the id of the user in ZITADEL.
+type scimUser struct {
+	Schemas           []string      `json:"schemas"`
+	ExternalID        string        `json:"externalId"`
+	UserName          string        `json:"userName"`
+	Name              *scimName     `json:"name,omitempty"`
+	DisplayName       string        `json:"displayName,omitempty"`
+	NickName          string        `json:"nickName,omitempty"`
+	PreferredLanguage string        `json:"preferredLanguage,omitempty"`
+	Active            bool          `json:"active"`
+	Emails            []*multiValue `json:"emails,omitempty"`
+	PhoneNumbers      []*multiValue `json:"phoneNumbers,omitempty"`
+}
+
+type scimName struct {
+	FamilyName string `json:"familyName,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+}
+
+type multiValue struct {
+	Value   string `json:"value"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// scimGroup is the SCIM group resource provisioned into the downstream application,
+// only members already provisioned into the downstream application are included.
+type scimGroup struct {
+	Schemas     []string       `json:"schemas"`
+	ExternalID  string         `json:"externalId"`
+	DisplayName string         `json:"displayName"`
+	Members     []*groupMember `json:"members"`
+}
+
+type groupMember struct {
+	Value string `json:"value"`
+}
+
+func userToSCIM(u *query.User) *scimUser {
+	resource := &scimUser{
+		Schemas:    []string{schemaUser},
+		ExternalID: u.ID,
+		UserName:   u.Username,
+		Active:     u.State == domain.UserStateActive || u.State == domain.UserStateInitial,
+	}
+	if u.Machine != nil {
+		resource.DisplayName = u.Machine.Name
+		return resource
+	}
+	if u.Human == nil {
+		return resource
+	}
+	resource.Name = &scimName{
+		FamilyName: u.Human.LastName,
+		GivenName:  u.Human.FirstName,
+	}
+	resource.DisplayName = u.Human.DisplayName
+	resource.NickName = u.Human.NickName
+	if !u.Human.PreferredLanguage.IsRoot() {
+		resource.PreferredLanguage = u.Human.PreferredLanguage.String()
+	}
+	if u.Human.Email != "" {
+		resource.Emails = []*multiValue{{Value: string(u.Human.Email), Primary: true}}
+	}
+	if u.Human.Phone != "" {
+		resource.PhoneNumbers = []*multiValue{{Value: string(u.Human.Phone), Primary: true}}
+	}
+	return resource
+}
+
+func groupToSCIM(g *query.Group, members []*query.SCIMProvisioningGroupMember) *scimGroup {
+	resource := &scimGroup{
+		Schemas:     []string{schemaGroup},
+		ExternalID:  g.ID,
+		DisplayName: g.Name,
+		Members:     make([]*groupMember, 0, len(members)),
+	}
+	for _, member := range members {
+		if member.RemoteID == "" {
+			continue
+		}
+		resource.Members = append(resource.Members, &groupMember{Value: member.RemoteID})
+	}
+	return resource
+}
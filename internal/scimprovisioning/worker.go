@@ -0,0 +1,271 @@
+package scimprovisioning
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	scim_repo "github.com/zitadel/zitadel/internal/repository/scimprovisioning"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const ProvisioningUserID = "SCIM_PROVISIONING"
+
+var (
+	_ river.Worker[*scim_repo.Request] = (*Worker)(nil)
+)
+
+// Worker synchronizes users and groups with the downstream applications of the instance.
+// Instead of the change of the event, the current state of the resource is provisioned:
+// entitled resources are created or replaced, resources which are not entitled anymore are deleted.
+type Worker struct {
+	river.WorkerDefaults[*scim_repo.Request]
+
+	queries  Queries
+	commands Commands
+	config   *Config
+}
+
+type Queries interface {
+	SCIMProvisioningConnectorsExist(ctx context.Context) (bool, error)
+	SCIMProvisioningTargets(ctx context.Context, shouldTriggerBulk bool, resourceType domain.SCIMProvisioningResourceType, resourceID string) ([]*query.SCIMProvisioningTarget, error)
+	SCIMProvisioningGroupMembers(ctx context.Context, appID, groupID string) ([]*query.SCIMProvisioningGroupMember, error)
+	SCIMProvisionedGroupsOfUser(ctx context.Context, appID, userID string) ([]string, error)
+	SCIMProvisioningStaleUsers(ctx context.Context) ([]string, error)
+	SCIMProvisioningGroup(ctx context.Context, groupID string) (*query.Group, error)
+	GetUserByID(ctx context.Context, shouldTriggerBulk bool, userID string) (*query.User, error)
+	UserGrant(ctx context.Context, shouldTriggerBulk bool, queries ...query.SearchQuery) (*query.UserGrant, error)
+}
+
+type Commands interface {
+	SCIMResourceProvisioned(ctx context.Context, appID, resourceOwner string, resourceType domain.SCIMProvisioningResourceType, resourceID, remoteID string) error
+	SCIMResourceDeprovisioned(ctx context.Context, appID, resourceOwner string, resourceType domain.SCIMProvisioningResourceType, resourceID string) error
+	SCIMResourceProvisioningFailed(ctx context.Context, appID, resourceOwner string, resourceType domain.SCIMProvisioningResourceType, resourceID, errorMessage string) error
+}
+
+func NewWorker(queries Queries, commands Commands, config *Config) *Worker {
+	return &Worker{
+		queries:  queries,
+		commands: commands,
+		config:   config,
+	}
+}
+
+// Register implements the [queue.Worker] interface.
+func (w *Worker) Register(workers *river.Workers, queues map[string]river.QueueConfig) {
+	river.AddWorker(workers, w)
+	queues[scim_repo.QueueName] = river.QueueConfig{
+		MaxWorkers: int(w.config.Workers),
+	}
+}
+
+// Timeout implements the Timeout-function of [river.Worker].
+func (w *Worker) Timeout(*river.Job[*scim_repo.Request]) time.Duration {
+	return w.config.TransactionDuration
+}
+
+// Work implements [river.Worker].
+// A failing downstream application does not stop the provisioning into the others,
+// the error is recorded on the application and the job is retried.
+func (w *Worker) Work(ctx context.Context, job *river.Job[*scim_repo.Request]) error {
+	ctx = workerContext(ctx, job.Args.Aggregate)
+
+	switch job.Args.ResourceType {
+	case domain.SCIMProvisioningResourceTypeUser:
+		userIDs, err := w.usersOfRequest(ctx, job.Args)
+		if err != nil {
+			return err
+		}
+		return w.syncUsers(ctx, userIDs)
+	case domain.SCIMProvisioningResourceTypeGroup:
+		if job.Args.IncludeMembers {
+			userIDs, err := w.membersOfRequest(ctx, job.Args)
+			if err != nil {
+				return err
+			}
+			if err := w.syncUsers(ctx, userIDs); err != nil {
+				return err
+			}
+		}
+		return w.syncGroup(ctx, job.Args.ResourceID, "")
+	}
+	return river.JobCancel(zerrors.ThrowInvalidArgument(nil, "SCIMP-Wk3rT", "unknown resource type"))
+}
+
+func workerContext(ctx context.Context, aggregate *eventstore.Aggregate) context.Context {
+	ctx = authz.WithInstanceID(ctx, aggregate.InstanceID)
+	return authz.SetCtxData(ctx, authz.CtxData{UserID: ProvisioningUserID, OrgID: aggregate.ResourceOwner})
+}
+
+// usersOfRequest returns the users to be synchronized.
+// Some user grant events don't contain the user, in this case the user is taken from the grant,
+// or if the grant was already removed, all users which lost their authorization are synchronized.
+func (w *Worker) usersOfRequest(ctx context.Context, request *scim_repo.Request) ([]string, error) {
+	if request.ResourceID != "" {
+		return []string{request.ResourceID}, nil
+	}
+	userIDs, err := w.queries.SCIMProvisioningStaleUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if request.Aggregate.Type != usergrant.AggregateType {
+		return userIDs, nil
+	}
+	grantQuery, err := query.NewUserGrantIDSearchQuery(request.Aggregate.ID)
+	if err != nil {
+		return nil, err
+	}
+	grant, err := w.queries.UserGrant(ctx, true, grantQuery)
+	if zerrors.IsNotFound(err) {
+		return userIDs, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(userIDs, grant.UserID) {
+		userIDs = append(userIDs, grant.UserID)
+	}
+	return userIDs, nil
+}
+
+// membersOfRequest returns the current members of the group
+// and the users which lost their authorization, e.g. because the group or its grant was removed.
+func (w *Worker) membersOfRequest(ctx context.Context, request *scim_repo.Request) ([]string, error) {
+	members, err := w.queries.SCIMProvisioningGroupMembers(ctx, "", request.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	userIDs, err := w.queries.SCIMProvisioningStaleUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if !slices.Contains(userIDs, member.UserID) {
+			userIDs = append(userIDs, member.UserID)
+		}
+	}
+	return userIDs, nil
+}
+
+func (w *Worker) syncUsers(ctx context.Context, userIDs []string) error {
+	errs := make([]error, 0)
+	for _, userID := range userIDs {
+		errs = append(errs, w.syncUser(ctx, userID))
+	}
+	return errors.Join(errs...)
+}
+
+func (w *Worker) syncUser(ctx context.Context, userID string) error {
+	targets, err := w.queries.SCIMProvisioningTargets(ctx, true, domain.SCIMProvisioningResourceTypeUser, userID)
+	if err != nil || len(targets) == 0 {
+		return err
+	}
+	user, err := w.queries.GetUserByID(ctx, true, userID)
+	if err != nil && !zerrors.IsNotFound(err) {
+		return err
+	}
+	errs := make([]error, 0, len(targets))
+	for _, target := range targets {
+		errs = append(errs, w.provisionUser(ctx, target, userID, user))
+	}
+	return errors.Join(errs...)
+}
+
+func (w *Worker) provisionUser(ctx context.Context, target *query.SCIMProvisioningTarget, userID string, user *query.User) error {
+	client := newClient(ctx, target, w.config.HTTPTimeout)
+	if user == nil || !target.Entitled {
+		if target.RemoteID == "" {
+			return nil
+		}
+		if err := client.delete(ctx, usersPath, target.RemoteID); err != nil {
+			return w.failed(ctx, target, domain.SCIMProvisioningResourceTypeUser, userID, err)
+		}
+		return w.commands.SCIMResourceDeprovisioned(ctx, target.AppID, target.ResourceOwner, domain.SCIMProvisioningResourceTypeUser, userID)
+	}
+	remoteID, err := client.upsert(ctx, usersPath, target.RemoteID, userToSCIM(user))
+	if err != nil {
+		return w.failed(ctx, target, domain.SCIMProvisioningResourceTypeUser, userID, err)
+	}
+	if err := w.commands.SCIMResourceProvisioned(ctx, target.AppID, target.ResourceOwner, domain.SCIMProvisioningResourceTypeUser, userID, remoteID); err != nil {
+		return err
+	}
+	if remoteID == target.RemoteID || !target.ProvisionGroups {
+		return nil
+	}
+	// the new id of the user has to be added to the groups it's a member of
+	groupIDs, err := w.queries.SCIMProvisionedGroupsOfUser(ctx, target.AppID, userID)
+	if err != nil {
+		return err
+	}
+	errs := make([]error, 0, len(groupIDs))
+	for _, groupID := range groupIDs {
+		errs = append(errs, w.syncGroup(ctx, groupID, target.AppID))
+	}
+	return errors.Join(errs...)
+}
+
+// syncGroup provisions the group into all downstream applications, or only into the one of appID if set.
+func (w *Worker) syncGroup(ctx context.Context, groupID, appID string) error {
+	targets, err := w.queries.SCIMProvisioningTargets(ctx, true, domain.SCIMProvisioningResourceTypeGroup, groupID)
+	if err != nil {
+		return err
+	}
+	if appID != "" {
+		targets = slices.DeleteFunc(targets, func(target *query.SCIMProvisioningTarget) bool {
+			return target.AppID != appID
+		})
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+	group, err := w.queries.SCIMProvisioningGroup(ctx, groupID)
+	if err != nil && !zerrors.IsNotFound(err) {
+		return err
+	}
+	errs := make([]error, 0, len(targets))
+	for _, target := range targets {
+		errs = append(errs, w.provisionGroup(ctx, target, groupID, group))
+	}
+	return errors.Join(errs...)
+}
+
+func (w *Worker) provisionGroup(ctx context.Context, target *query.SCIMProvisioningTarget, groupID string, group *query.Group) error {
+	client := newClient(ctx, target, w.config.HTTPTimeout)
+	if group == nil || !target.Entitled {
+		if target.RemoteID == "" {
+			return nil
+		}
+		if err := client.delete(ctx, groupsPath, target.RemoteID); err != nil {
+			return w.failed(ctx, target, domain.SCIMProvisioningResourceTypeGroup, groupID, err)
+		}
+		return w.commands.SCIMResourceDeprovisioned(ctx, target.AppID, target.ResourceOwner, domain.SCIMProvisioningResourceTypeGroup, groupID)
+	}
+	members, err := w.queries.SCIMProvisioningGroupMembers(ctx, target.AppID, groupID)
+	if err != nil {
+		return err
+	}
+	remoteID, err := client.upsert(ctx, groupsPath, target.RemoteID, groupToSCIM(group, members))
+	if err != nil {
+		return w.failed(ctx, target, domain.SCIMProvisioningResourceTypeGroup, groupID, err)
+	}
+	return w.commands.SCIMResourceProvisioned(ctx, target.AppID, target.ResourceOwner, domain.SCIMProvisioningResourceTypeGroup, groupID, remoteID)
+}
+
+// failed records the error on the application and returns it, so the job is retried.
+func (w *Worker) failed(ctx context.Context, target *query.SCIMProvisioningTarget, resourceType domain.SCIMProvisioningResourceType, resourceID string, err error) error {
+	logging.WithFields("app", target.AppID, "resourceType", resourceType, "resource", resourceID).
+		WithError(err).Warn("scim provisioning failed")
+	if cmdErr := w.commands.SCIMResourceProvisioningFailed(ctx, target.AppID, target.ResourceOwner, resourceType, resourceID, err.Error()); cmdErr != nil {
+		return errors.Join(err, cmdErr)
+	}
+	return err
+}
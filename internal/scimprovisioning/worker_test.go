@@ -0,0 +1,459 @@
+package scimprovisioning
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/query"
+	scim_repo "github.com/zitadel/zitadel/internal/repository/scimprovisioning"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/scimprovisioning/mock"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	testToken        = "token"
+	testClientID     = "client"
+	testClientSecret = "secret"
+)
+
+// scimServer is a minimal SCIM 2.0 service provider standing in for a downstream application.
+type scimServer struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	resources map[string]map[string]json.RawMessage
+	requests  []string
+	nextID    int
+	// failWith responds to every request with the status if set
+	failWith int
+}
+
+func newSCIMServer(t *testing.T) *scimServer {
+	s := &scimServer{
+		resources: map[string]map[string]json.RawMessage{
+			usersPath:  {},
+			groupsPath: {},
+		},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok || clientID != testClientID || clientSecret != testClientSecret {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"` + testToken + `","token_type":"Bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("/scim/v2/{resource}", s.handle)
+	mux.HandleFunc("/scim/v2/{resource}/{id}", s.handle)
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *scimServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resourceType, id := r.PathValue("resource"), r.PathValue("id")
+	s.requests = append(s.requests, strings.TrimSpace(r.Method+" "+resourceType+" "+id))
+	if r.Header.Get("Authorization") != "Bearer "+testToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if s.failWith != 0 {
+		w.WriteHeader(s.failWith)
+		_, _ = w.Write([]byte(`{"schemas":["urn:ietf:params:scim:api:messages:2.0:Error"],"detail":"userName exists","status":"409"}`))
+		return
+	}
+	resources := s.resources[resourceType]
+	switch r.Method {
+	case http.MethodPost:
+		s.nextID++
+		id = "remote" + strconv.Itoa(s.nextID)
+		fallthrough
+	case http.MethodPut:
+		if _, ok := resources[id]; !ok && r.Method == http.MethodPut {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body["id"] = id
+		resources[id], _ = json.Marshal(body)
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		_, _ = w.Write(resources[id])
+	case http.MethodDelete:
+		if _, ok := resources[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(resources, id)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *scimServer) add(resourceType, id, resource string) {
+	s.resources[resourceType][id] = json.RawMessage(resource)
+}
+
+func (s *scimServer) target(authType domain.SCIMProvisioningAuthType, remoteID string, entitled bool) *query.SCIMProvisioningTarget {
+	target := &query.SCIMProvisioningTarget{
+		AppID:           "app1",
+		ProjectID:       "project1",
+		ResourceOwner:   "org1",
+		Endpoint:        s.URL + "/scim/v2/",
+		AuthType:        authType,
+		ProvisionGroups: true,
+		RemoteID:        remoteID,
+		Entitled:        entitled,
+	}
+	if authType == domain.SCIMProvisioningAuthTypeClientCredentials {
+		target.ClientID = testClientID
+		target.ClientSecret = testClientSecret
+		target.TokenEndpoint = s.URL + "/token"
+		return target
+	}
+	target.Token = testToken
+	return target
+}
+
+func testUser() *query.User {
+	return &query.User{
+		ID:       "user1",
+		State:    domain.UserStateActive,
+		Username: "gigi",
+		Human: &query.Human{
+			FirstName:   "Gigi",
+			LastName:    "Giraffe",
+			DisplayName: "Gigi Giraffe",
+			Email:       "gigi@example.com",
+		},
+	}
+}
+
+func testJob(resourceType domain.SCIMProvisioningResourceType, resourceID string, includeMembers bool) *river.Job[*scim_repo.Request] {
+	return &river.Job[*scim_repo.Request]{
+		Args: &scim_repo.Request{
+			Aggregate: &eventstore.Aggregate{
+				ID:            resourceID,
+				Type:          user.AggregateType,
+				ResourceOwner: "org1",
+				InstanceID:    "instance1",
+			},
+			EventType:      user.UserDeactivatedType,
+			ResourceType:   resourceType,
+			ResourceID:     resourceID,
+			IncludeMembers: includeMembers,
+		},
+	}
+}
+
+func TestWorker_Work(t *testing.T) {
+	type fields struct {
+		queries  func(*testing.T, *scimServer) Queries
+		commands func(*testing.T) Commands
+	}
+	tests := []struct {
+		name         string
+		prepare      func(*scimServer)
+		job          *river.Job[*scim_repo.Request]
+		fields       fields
+		wantRequests []string
+		wantRemote   map[string][]string
+		wantErr      func(error) bool
+	}{
+		{
+			name: "no connector, ok",
+			job:  testJob(domain.SCIMProvisioningResourceTypeUser, "user1", false),
+			fields: fields{
+				queries: func(t *testing.T, s *scimServer) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SCIMProvisioningTargets(gomock.Any(), true, domain.SCIMProvisioningResourceTypeUser, "user1").Return([]*query.SCIMProvisioningTarget{}, nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					return mock.NewMockCommands(gomock.NewController(t))
+				},
+			},
+			wantRemote: map[string][]string{usersPath: {}, groupsPath: {}},
+		},
+		{
+			name: "entitled user created, bearer, ok",
+			job:  testJob(domain.SCIMProvisioningResourceTypeUser, "user1", false),
+			fields: fields{
+				queries: func(t *testing.T, s *scimServer) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SCIMProvisioningTargets(gomock.Any(), true, domain.SCIMProvisioningResourceTypeUser, "user1").Return(
+						[]*query.SCIMProvisioningTarget{s.target(domain.SCIMProvisioningAuthTypeBearer, "", true)}, nil,
+					)
+					queries.EXPECT().GetUserByID(gomock.Any(), true, "user1").Return(testUser(), nil)
+					queries.EXPECT().SCIMProvisionedGroupsOfUser(gomock.Any(), "app1", "user1").Return([]string{}, nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().SCIMResourceProvisioned(gomock.Any(), "app1", "org1", domain.SCIMProvisioningResourceTypeUser, "user1", "remote1").Return(nil)
+					return commands
+				},
+			},
+			wantRequests: []string{"POST Users"},
+			wantRemote:   map[string][]string{usersPath: {"remote1"}, groupsPath: {}},
+		},
+		{
+			name: "entitled user created, client credentials, ok",
+			job:  testJob(domain.SCIMProvisioningResourceTypeUser, "user1", false),
+			fields: fields{
+				queries: func(t *testing.T, s *scimServer) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SCIMProvisioningTargets(gomock.Any(), true, domain.SCIMProvisioningResourceTypeUser, "user1").Return(
+						[]*query.SCIMProvisioningTarget{s.target(domain.SCIMProvisioningAuthTypeClientCredentials, "", true)}, nil,
+					)
+					queries.EXPECT().GetUserByID(gomock.Any(), true, "user1").Return(testUser(), nil)
+					queries.EXPECT().SCIMProvisionedGroupsOfUser(gomock.Any(), "app1", "user1").Return([]string{}, nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().SCIMResourceProvisioned(gomock.Any(), "app1", "org1", domain.SCIMProvisioningResourceTypeUser, "user1", "remote1").Return(nil)
+					return commands
+				},
+			},
+			wantRequests: []string{"POST Users"},
+			wantRemote:   map[string][]string{usersPath: {"remote1"}, groupsPath: {}},
+		},
+		{
+			name: "provisioned user replaced, ok",
+			prepare: func(s *scimServer) {
+				s.add(usersPath, "remote-user1", `{"id":"remote-user1"}`)
+			},
+			job: testJob(domain.SCIMProvisioningResourceTypeUser, "user1", false),
+			fields: fields{
+				queries: func(t *testing.T, s *scimServer) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SCIMProvisioningTargets(gomock.Any(), true, domain.SCIMProvisioningResourceTypeUser, "user1").Return(
+						[]*query.SCIMProvisioningTarget{s.target(domain.SCIMProvisioningAuthTypeBearer, "remote-user1", true)}, nil,
+					)
+					queries.EXPECT().GetUserByID(gomock.Any(), true, "user1").Return(testUser(), nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().SCIMResourceProvisioned(gomock.Any(), "app1", "org1", domain.SCIMProvisioningResourceTypeUser, "user1", "remote-user1").Return(nil)
+					return commands
+				},
+			},
+			wantRequests: []string{"PUT Users remote-user1"},
+			wantRemote:   map[string][]string{usersPath: {"remote-user1"}, groupsPath: {}},
+		},
+		{
+			name: "provisioned user deleted downstream, created again, ok",
+			job:  testJob(domain.SCIMProvisioningResourceTypeUser, "user1", false),
+			fields: fields{
+				queries: func(t *testing.T, s *scimServer) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SCIMProvisioningTargets(gomock.Any(), true, domain.SCIMProvisioningResourceTypeUser, "user1").Return(
+						[]*query.SCIMProvisioningTarget{s.target(domain.SCIMProvisioningAuthTypeBearer, "remote-user1", true)}, nil,
+					)
+					queries.EXPECT().GetUserByID(gomock.Any(), true, "user1").Return(testUser(), nil)
+					queries.EXPECT().SCIMProvisionedGroupsOfUser(gomock.Any(), "app1", "user1").Return([]string{"group1"}, nil)
+					queries.EXPECT().SCIMProvisioningTargets(gomock.Any(), true, domain.SCIMProvisioningResourceTypeGroup, "group1").Return(
+						[]*query.SCIMProvisioningTarget{s.target(domain.SCIMProvisioningAuthTypeBearer, "remote-group1", true)}, nil,
+					)
+					queries.EXPECT().SCIMProvisioningGroup(gomock.Any(), "group1").Return(&query.Group{ID: "group1", Name: "Giraffes"}, nil)
+					queries.EXPECT().SCIMProvisioningGroupMembers(gomock.Any(), "app1", "group1").Return(
+						[]*query.SCIMProvisioningGroupMember{{UserID: "user1", RemoteID: "remote1"}}, nil,
+					)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().SCIMResourceProvisioned(gomock.Any(), "app1", "org1", domain.SCIMProvisioningResourceTypeUser, "user1", "remote1").Return(nil)
+					commands.EXPECT().SCIMResourceProvisioned(gomock.Any(), "app1", "org1", domain.SCIMProvisioningResourceTypeGroup, "group1", "remote-group1").Return(nil)
+					return commands
+				},
+			},
+			prepare: func(s *scimServer) {
+				s.add(groupsPath, "remote-group1", `{"id":"remote-group1"}`)
+			},
+			wantRequests: []string{"PUT Users remote-user1", "POST Users", "PUT Groups remote-group1"},
+			wantRemote:   map[string][]string{usersPath: {"remote1"}, groupsPath: {"remote-group1"}},
+		},
+		{
+			name: "user not entitled anymore, deleted, ok",
+			prepare: func(s *scimServer) {
+				s.add(usersPath, "remote-user1", `{"id":"remote-user1"}`)
+			},
+			job: testJob(domain.SCIMProvisioningResourceTypeUser, "user1", false),
+			fields: fields{
+				queries: func(t *testing.T, s *scimServer) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SCIMProvisioningTargets(gomock.Any(), true, domain.SCIMProvisioningResourceTypeUser, "user1").Return(
+						[]*query.SCIMProvisioningTarget{s.target(domain.SCIMProvisioningAuthTypeBearer, "remote-user1", false)}, nil,
+					)
+					queries.EXPECT().GetUserByID(gomock.Any(), true, "user1").Return(testUser(), nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().SCIMResourceDeprovisioned(gomock.Any(), "app1", "org1", domain.SCIMProvisioningResourceTypeUser, "user1").Return(nil)
+					return commands
+				},
+			},
+			wantRequests: []string{"DELETE Users remote-user1"},
+			wantRemote:   map[string][]string{usersPath: {}, groupsPath: {}},
+		},
+		{
+			name: "removed user, deleted, ok",
+			prepare: func(s *scimServer) {
+				s.add(usersPath, "remote-user1", `{"id":"remote-user1"}`)
+			},
+			job: testJob(domain.SCIMProvisioningResourceTypeUser, "user1", false),
+			fields: fields{
+				queries: func(t *testing.T, s *scimServer) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SCIMProvisioningTargets(gomock.Any(), true, domain.SCIMProvisioningResourceTypeUser, "user1").Return(
+						[]*query.SCIMProvisioningTarget{s.target(domain.SCIMProvisioningAuthTypeBearer, "remote-user1", true)}, nil,
+					)
+					queries.EXPECT().GetUserByID(gomock.Any(), true, "user1").Return(nil, zerrors.ThrowNotFound(nil, "id", "not found"))
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().SCIMResourceDeprovisioned(gomock.Any(), "app1", "org1", domain.SCIMProvisioningResourceTypeUser, "user1").Return(nil)
+					return commands
+				},
+			},
+			wantRequests: []string{"DELETE Users remote-user1"},
+			wantRemote:   map[string][]string{usersPath: {}, groupsPath: {}},
+		},
+		{
+			name: "downstream application fails, error recorded",
+			prepare: func(s *scimServer) {
+				s.failWith = http.StatusConflict
+			},
+			job: testJob(domain.SCIMProvisioningResourceTypeUser, "user1", false),
+			fields: fields{
+				queries: func(t *testing.T, s *scimServer) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SCIMProvisioningTargets(gomock.Any(), true, domain.SCIMProvisioningResourceTypeUser, "user1").Return(
+						[]*query.SCIMProvisioningTarget{s.target(domain.SCIMProvisioningAuthTypeBearer, "", true)}, nil,
+					)
+					queries.EXPECT().GetUserByID(gomock.Any(), true, "user1").Return(testUser(), nil)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().SCIMResourceProvisioningFailed(gomock.Any(), "app1", "org1", domain.SCIMProvisioningResourceTypeUser, "user1", "409 Conflict: userName exists").Return(nil)
+					return commands
+				},
+			},
+			wantRequests: []string{"POST Users"},
+			wantRemote:   map[string][]string{usersPath: {}, groupsPath: {}},
+			wantErr: func(err error) bool {
+				return err != nil && err.Error() == "409 Conflict: userName exists"
+			},
+		},
+		{
+			name: "group including members, ok",
+			job:  testJob(domain.SCIMProvisioningResourceTypeGroup, "group1", true),
+			fields: fields{
+				queries: func(t *testing.T, s *scimServer) Queries {
+					queries := mock.NewMockQueries(gomock.NewController(t))
+					queries.EXPECT().SCIMProvisioningGroupMembers(gomock.Any(), "", "group1").Return(
+						[]*query.SCIMProvisioningGroupMember{{UserID: "user1"}}, nil,
+					)
+					queries.EXPECT().SCIMProvisioningStaleUsers(gomock.Any()).Return([]string{}, nil)
+					queries.EXPECT().SCIMProvisioningTargets(gomock.Any(), true, domain.SCIMProvisioningResourceTypeUser, "user1").Return(
+						[]*query.SCIMProvisioningTarget{s.target(domain.SCIMProvisioningAuthTypeBearer, "", true)}, nil,
+					)
+					queries.EXPECT().GetUserByID(gomock.Any(), true, "user1").Return(testUser(), nil)
+					queries.EXPECT().SCIMProvisionedGroupsOfUser(gomock.Any(), "app1", "user1").Return([]string{}, nil)
+					queries.EXPECT().SCIMProvisioningTargets(gomock.Any(), true, domain.SCIMProvisioningResourceTypeGroup, "group1").Return(
+						[]*query.SCIMProvisioningTarget{s.target(domain.SCIMProvisioningAuthTypeBearer, "", true)}, nil,
+					)
+					queries.EXPECT().SCIMProvisioningGroup(gomock.Any(), "group1").Return(&query.Group{ID: "group1", Name: "Giraffes"}, nil)
+					queries.EXPECT().SCIMProvisioningGroupMembers(gomock.Any(), "app1", "group1").Return(
+						[]*query.SCIMProvisioningGroupMember{{UserID: "user1", RemoteID: "remote1"}, {UserID: "user2"}}, nil,
+					)
+					return queries
+				},
+				commands: func(t *testing.T) Commands {
+					commands := mock.NewMockCommands(gomock.NewController(t))
+					commands.EXPECT().SCIMResourceProvisioned(gomock.Any(), "app1", "org1", domain.SCIMProvisioningResourceTypeUser, "user1", "remote1").Return(nil)
+					commands.EXPECT().SCIMResourceProvisioned(gomock.Any(), "app1", "org1", domain.SCIMProvisioningResourceTypeGroup, "group1", "remote2").Return(nil)
+					return commands
+				},
+			},
+			wantRequests: []string{"POST Users", "POST Groups"},
+			wantRemote:   map[string][]string{usersPath: {"remote1"}, groupsPath: {"remote2"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newSCIMServer(t)
+			if tt.prepare != nil {
+				tt.prepare(server)
+			}
+			w := NewWorker(tt.fields.queries(t, server), tt.fields.commands(t), &Config{HTTPTimeout: 5 * time.Second})
+			err := w.Work(t.Context(), tt.job)
+			if tt.wantErr != nil {
+				assert.True(t, tt.wantErr(err), "unexpected error: %v", err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantRequests, server.requests)
+			for resourceType, ids := range tt.wantRemote {
+				got := make([]string, 0, len(server.resources[resourceType]))
+				for id := range server.resources[resourceType] {
+					got = append(got, id)
+				}
+				assert.ElementsMatch(t, ids, got, resourceType)
+			}
+		})
+	}
+}
+
+func Test_groupToSCIM(t *testing.T) {
+	got := groupToSCIM(
+		&query.Group{ID: "group1", Name: "Giraffes"},
+		[]*query.SCIMProvisioningGroupMember{{UserID: "user1", RemoteID: "remote1"}, {UserID: "user2"}},
+	)
+	data, err := json.Marshal(got)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"schemas":["urn:ietf:params:scim:schemas:core:2.0:Group"],"externalId":"group1","displayName":"Giraffes","members":[{"value":"remote1"}]}`, string(data))
+}
+
+func Test_userToSCIM(t *testing.T) {
+	data, err := json.Marshal(userToSCIM(testUser()))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"schemas":["urn:ietf:params:scim:schemas:core:2.0:User"],
+		"externalId":"user1",
+		"userName":"gigi",
+		"name":{"familyName":"Giraffe","givenName":"Gigi"},
+		"displayName":"Gigi Giraffe",
+		"active":true,
+		"emails":[{"value":"gigi@example.com","primary":true}]
+	}`, string(data))
+}
@@ -0,0 +1,177 @@
+// Package usage records the usage of the quota units which are not bound to a single request or action run,
+// namely active users, issued tokens and sent notifications.
+// It also checks the quotas of organizations, which are recorded separately from the instance quotas.
+package usage
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/logstore"
+	"github.com/zitadel/zitadel/internal/logstore/record"
+	"github.com/zitadel/zitadel/internal/repository/quota"
+)
+
+// Queries is used to check if a user already counts as active user in the current quota period
+// and to check the remaining usage of organization quotas.
+type Queries interface {
+	IsUserActiveInQuotaPeriod(ctx context.Context, instanceID, userID string) (bool, error)
+	IsUserActiveInOrgQuotaPeriod(ctx context.Context, instanceID, orgID, userID string) (bool, error)
+	GetRemainingOrgQuotaUsage(ctx context.Context, instanceID, orgID string, unit quota.Unit) (remaining *uint64, err error)
+}
+
+var (
+	queries                  Queries
+	activeUsersService       *logstore.Service[*record.UsageLog]
+	issuedTokensService      *logstore.Service[*record.UsageLog]
+	sentNotificationsService *logstore.Service[*record.UsageLog]
+	orgRequestsService       *logstore.Service[*record.UsageLog]
+)
+
+func SetLogstoreServices(q Queries, activeUsers, issuedTokens, sentNotifications, orgRequests *logstore.Service[*record.UsageLog]) {
+	queries = q
+	activeUsersService = activeUsers
+	issuedTokensService = issuedTokens
+	sentNotificationsService = sentNotifications
+	orgRequestsService = orgRequests
+}
+
+// UserActive records the user as active in the current quota period of the instance and of the user's organization.
+// Users are only counted once per period.
+func UserActive(ctx context.Context, instanceID, orgID, userID string) {
+	if userID == "" {
+		return
+	}
+	handle(ctx, activeUsersService, &record.UsageLog{
+		LogDate:    time.Now(),
+		InstanceID: instanceID,
+		OrgID:      orgID,
+		UserID:     userID,
+		Count:      1,
+	})
+}
+
+// RequestAuthenticated records an authenticated request for the quota of the organization.
+// The authenticated requests of the instance are recorded by the access logs.
+func RequestAuthenticated(ctx context.Context, instanceID, orgID string) {
+	if orgID == "" {
+		return
+	}
+	handle(ctx, orgRequestsService, &record.UsageLog{
+		LogDate:    time.Now(),
+		InstanceID: instanceID,
+		OrgID:      orgID,
+		Count:      1,
+	})
+}
+
+// TokensIssued records the amount of issued access and id tokens.
+func TokensIssued(ctx context.Context, instanceID string, count uint64) {
+	if count == 0 {
+		return
+	}
+	handle(ctx, issuedTokensService, &record.UsageLog{
+		LogDate:    time.Now(),
+		InstanceID: instanceID,
+		Count:      count,
+	})
+}
+
+// NotificationSent records a sent email or sms.
+func NotificationSent(ctx context.Context, instanceID string) {
+	handle(ctx, sentNotificationsService, &record.UsageLog{
+		LogDate:    time.Now(),
+		InstanceID: instanceID,
+		Count:      1,
+	})
+}
+
+// ActiveUsersExhausted returns true if the active users quota is limited and exhausted
+// and the user is not yet counted as active in the current period.
+func ActiveUsersExhausted(ctx context.Context, instanceID, userID string) bool {
+	if userID == "" || !exhausted(ctx, activeUsersService, instanceID) {
+		return false
+	}
+	active, err := queries.IsUserActiveInQuotaPeriod(ctx, instanceID, userID)
+	logging.OnError(err).Warn("failed to check if user is active in quota period")
+	return err == nil && !active
+}
+
+// TokensExhausted returns true if the issued tokens quota is limited and exhausted.
+func TokensExhausted(ctx context.Context, instanceID string) bool {
+	return exhausted(ctx, issuedTokensService, instanceID)
+}
+
+// NotificationsExhausted returns true if the sent notifications quota is limited and exhausted.
+func NotificationsExhausted(ctx context.Context, instanceID string) bool {
+	return exhausted(ctx, sentNotificationsService, instanceID)
+}
+
+// OrgQuotaExhausted returns true if the organization has a limiting quota for the unit which is exhausted.
+// The authenticated requests and active users are only checked if they are recorded.
+func OrgQuotaExhausted(ctx context.Context, instanceID, orgID string, unit quota.Unit) bool {
+	if queries == nil {
+		return false
+	}
+	switch unit {
+	case quota.RequestsAllAuthenticated:
+		if !enabled(orgRequestsService) {
+			return false
+		}
+	case quota.UsersAllActive:
+		if !enabled(activeUsersService) {
+			return false
+		}
+	}
+	return orgQuotaExhausted(ctx, queries, instanceID, orgID, unit)
+}
+
+// NewOrgQuotaCheck returns a check for the organization quotas of the units which are counted from the stored resources,
+// namely users, projects and applications.
+// In contrast to [OrgQuotaExhausted], it doesn't depend on the logstore services.
+func NewOrgQuotaCheck(q Queries) func(ctx context.Context, instanceID, orgID string, unit quota.Unit) bool {
+	return func(ctx context.Context, instanceID, orgID string, unit quota.Unit) bool {
+		return orgQuotaExhausted(ctx, q, instanceID, orgID, unit)
+	}
+}
+
+func orgQuotaExhausted(ctx context.Context, q Queries, instanceID, orgID string, unit quota.Unit) bool {
+	if orgID == "" {
+		return false
+	}
+	remaining, err := q.GetRemainingOrgQuotaUsage(ctx, instanceID, orgID, unit)
+	logging.OnError(err).Warn("failed to check if organization usage should be limited")
+	return err == nil && remaining != nil && *remaining == 0
+}
+
+// OrgActiveUsersExhausted returns true if the active users quota of the organization is limited and exhausted
+// and the user is not yet counted as active in the current period.
+func OrgActiveUsersExhausted(ctx context.Context, instanceID, orgID, userID string) bool {
+	if userID == "" || !OrgQuotaExhausted(ctx, instanceID, orgID, quota.UsersAllActive) {
+		return false
+	}
+	active, err := queries.IsUserActiveInOrgQuotaPeriod(ctx, instanceID, orgID, userID)
+	logging.OnError(err).Warn("failed to check if user is active in organization quota period")
+	return err == nil && !active
+}
+
+func enabled(svc *logstore.Service[*record.UsageLog]) bool {
+	return svc != nil && svc.Enabled()
+}
+
+func handle(ctx context.Context, svc *logstore.Service[*record.UsageLog], r *record.UsageLog) {
+	if !enabled(svc) {
+		return
+	}
+	svc.Handle(ctx, r)
+}
+
+func exhausted(ctx context.Context, svc *logstore.Service[*record.UsageLog], instanceID string) bool {
+	if svc == nil {
+		return false
+	}
+	remaining := svc.Limit(ctx, instanceID)
+	return remaining != nil && *remaining == 0
+}
@@ -15,25 +15,54 @@ type OTP struct {
 
 	Secret *crypto.CryptoValue `json:"otpSecret,omitempty"`
 	State  int32               `json:"-"`
+	// TOTPID is set for named authenticators, which are not part of the model
+	TOTPID string `json:"totpId,omitempty"`
 }
 
 type OTPVerified struct {
 	UserAgentID string `json:"userAgentID,omitempty"`
 }
 
+// The OTP events of named TOTP authenticators are ignored, the model only represents the default authenticator.
+
 func (u *Human) appendOTPAddedEvent(event eventstore.Event) error {
-	u.OTP = &OTP{
+	otp := &OTP{
 		State: int32(model.MFAStateNotReady),
 	}
-	return u.OTP.setData(event)
+	if err := otp.setData(event); err != nil {
+		return err
+	}
+	if otp.TOTPID != "" {
+		return nil
+	}
+	u.OTP = otp
+	return nil
 }
 
-func (u *Human) appendOTPVerifiedEvent() {
+func (u *Human) appendOTPVerifiedEvent(event eventstore.Event) error {
+	named, err := isNamedTOTPEvent(event)
+	if err != nil || named || u.OTP == nil {
+		return err
+	}
 	u.OTP.State = int32(model.MFAStateReady)
+	return nil
 }
 
-func (u *Human) appendOTPRemovedEvent() {
+func (u *Human) appendOTPRemovedEvent(event eventstore.Event) error {
+	named, err := isNamedTOTPEvent(event)
+	if err != nil || named {
+		return err
+	}
 	u.OTP = nil
+	return nil
+}
+
+func isNamedTOTPEvent(event eventstore.Event) (bool, error) {
+	otp := new(OTP)
+	if err := event.Unmarshal(otp); err != nil {
+		return false, zerrors.ThrowInternal(err, "MODEL-Tn3mD", "could not unmarshal event")
+	}
+	return otp.TOTPID != "", nil
 }
 
 func (o *OTP) setData(event eventstore.Event) error {
@@ -29,6 +29,15 @@ func TestAppendMFAOTPAddedEvent(t *testing.T) {
 			},
 			result: &Human{OTP: &OTP{Secret: &crypto.CryptoValue{KeyID: "KeyID"}, State: int32(model.MFAStateNotReady)}},
 		},
+		{
+			name: "append named otp event, ignored",
+			args: args{
+				user:  &Human{OTP: &OTP{Secret: &crypto.CryptoValue{KeyID: "KeyID"}, State: int32(model.MFAStateReady)}},
+				otp:   &OTP{Secret: &crypto.CryptoValue{KeyID: "KeyID2"}, TOTPID: "totp1"},
+				event: &es_models.Event{},
+			},
+			result: &Human{OTP: &OTP{Secret: &crypto.CryptoValue{KeyID: "KeyID"}, State: int32(model.MFAStateReady)}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -40,6 +49,9 @@ func TestAppendMFAOTPAddedEvent(t *testing.T) {
 			if tt.args.user.OTP.State != tt.result.OTP.State {
 				t.Errorf("got wrong result: expected: %v, actual: %v ", tt.result.OTP.State, tt.args.user.OTP.State)
 			}
+			if tt.args.user.OTP.Secret.KeyID != tt.result.OTP.Secret.KeyID {
+				t.Errorf("got wrong result: expected: %v, actual: %v ", tt.result.OTP.Secret.KeyID, tt.args.user.OTP.Secret.KeyID)
+			}
 		})
 	}
 }
@@ -64,6 +76,15 @@ func TestAppendMFAOTPVerifyEvent(t *testing.T) {
 			},
 			result: &Human{OTP: &OTP{Secret: &crypto.CryptoValue{KeyID: "KeyID"}, State: int32(model.MFAStateReady)}},
 		},
+		{
+			name: "append named otp verify event, ignored",
+			args: args{
+				user:  &Human{OTP: &OTP{Secret: &crypto.CryptoValue{KeyID: "KeyID"}, State: int32(model.MFAStateNotReady)}},
+				otp:   &OTP{TOTPID: "totp1"},
+				event: &es_models.Event{},
+			},
+			result: &Human{OTP: &OTP{Secret: &crypto.CryptoValue{KeyID: "KeyID"}, State: int32(model.MFAStateNotReady)}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -71,7 +92,7 @@ func TestAppendMFAOTPVerifyEvent(t *testing.T) {
 				data, _ := json.Marshal(tt.args.otp)
 				tt.args.event.Data = data
 			}
-			tt.args.user.appendOTPVerifiedEvent()
+			tt.args.user.appendOTPVerifiedEvent(tt.args.event)
 			if tt.args.user.OTP.State != tt.result.OTP.State {
 				t.Errorf("got wrong result: expected: %v, actual: %v ", tt.result.OTP.State, tt.args.user.OTP.State)
 			}
@@ -98,12 +119,25 @@ func TestAppendMFAOTPRemoveEvent(t *testing.T) {
 			},
 			result: &Human{},
 		},
+		{
+			name: "append named otp remove event, ignored",
+			args: args{
+				user:  &Human{OTP: &OTP{Secret: &crypto.CryptoValue{KeyID: "KeyID"}}},
+				otp:   &OTP{TOTPID: "totp1"},
+				event: &es_models.Event{},
+			},
+			result: &Human{OTP: &OTP{Secret: &crypto.CryptoValue{KeyID: "KeyID"}}},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.args.user.appendOTPRemovedEvent()
-			if tt.args.user.OTP != nil {
-				t.Errorf("got wrong result: actual: %v ", tt.result.OTP)
+			if tt.args.otp != nil {
+				data, _ := json.Marshal(tt.args.otp)
+				tt.args.event.Data = data
+			}
+			tt.args.user.appendOTPRemovedEvent(tt.args.event)
+			if (tt.args.user.OTP == nil) != (tt.result.OTP == nil) {
+				t.Errorf("got wrong result: expected: %v, actual: %v ", tt.result.OTP, tt.args.user.OTP)
 			}
 		})
 	}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type TrustedDeviceCheck struct {
+	UserAgentID string         `json:"userAgentID,omitempty"`
+	DeviceID    string         `json:"deviceId"`
+	MFAType     domain.MFAType `json:"mfaType"`
+}
+
+func (c *TrustedDeviceCheck) SetData(event eventstore.Event) error {
+	if err := event.Unmarshal(c); err != nil {
+		logging.Log("EVEN-Tdv1C").WithError(err).Error("could not unmarshal event data")
+		return zerrors.ThrowInternal(err, "MODEL-Tdv1C", "could not unmarshal event")
+	}
+	return nil
+}
@@ -95,10 +95,10 @@ func (h *Human) AppendEvent(event *es_models.Event) (err error) {
 		err = h.appendOTPAddedEvent(event)
 	case user.UserV1MFAOTPVerifiedType,
 		user.HumanMFAOTPVerifiedType:
-		h.appendOTPVerifiedEvent()
+		err = h.appendOTPVerifiedEvent(event)
 	case user.UserV1MFAOTPRemovedType,
 		user.HumanMFAOTPRemovedType:
-		h.appendOTPRemovedEvent()
+		err = h.appendOTPRemovedEvent(event)
 	case user.UserIDPLinkAddedType:
 		err = h.appendExternalIDPAddedEvent(event)
 	case user.UserIDPLinkRemovedType, user.UserIDPLinkCascadeRemovedType:
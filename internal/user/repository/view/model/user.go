@@ -3,6 +3,7 @@ package model
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"slices"
 	"time"
 
 	"github.com/zitadel/logging"
@@ -88,6 +89,7 @@ type HumanView struct {
 	Region                   string         `json:"region" gorm:"column:region"`
 	StreetAddress            string         `json:"streetAddress" gorm:"column:street_address"`
 	OTPState                 int32          `json:"-" gorm:"column:otp_state"`
+	TOTPs                    TOTPViews      `json:"-" gorm:"column:totps"`
 	OTPSMSAdded              bool           `json:"-" gorm:"column:otp_sms_added"`
 	OTPEmailAdded            bool           `json:"-" gorm:"column:otp_email_added"`
 	U2FTokens                WebAuthNTokens `json:"-" gorm:"column:u2f_tokens"`
@@ -128,6 +130,31 @@ func (t *WebAuthNTokens) Scan(src interface{}) error {
 	return nil
 }
 
+// TOTPViews are the TOTP authenticators of the user, the default authenticator has an empty id.
+type TOTPViews []*TOTPView
+
+type TOTPView struct {
+	ID    string `json:"totpId"`
+	State int32  `json:"state,omitempty"`
+}
+
+func (t TOTPViews) Value() (driver.Value, error) {
+	if t == nil {
+		return nil, nil
+	}
+	return json.Marshal(&t)
+}
+
+func (t *TOTPViews) Scan(src interface{}) error {
+	if b, ok := src.([]byte); ok {
+		return json.Unmarshal(b, t)
+	}
+	if s, ok := src.(string); ok {
+		return json.Unmarshal([]byte(s), t)
+	}
+	return nil
+}
+
 func (h *HumanView) IsZero() bool {
 	return h == nil || h.FirstName == ""
 }
@@ -319,18 +346,33 @@ func (u *UserView) AppendEvent(event eventstore.Event) (err error) {
 			logging.WithFields("event_sequence", event.Sequence, "aggregate_id", event.Aggregate().ID, "instance", event.Aggregate().InstanceID).Warn("event is ignored because human not exists")
 			return zerrors.ThrowInvalidArgument(nil, "MODEL-p2BXx", "event ignored: human not exists")
 		}
-		u.OTPState = int32(model.MFAStateNotReady)
+		var totpID string
+		totpID, err = totpIDFromEvent(event)
+		if err != nil {
+			return err
+		}
+		u.setTOTPState(totpID, model.MFAStateNotReady)
 	case user.UserV1MFAOTPVerifiedType,
 		user.HumanMFAOTPVerifiedType:
 		if u.HumanView == nil {
 			logging.WithFields("event_sequence", event.Sequence, "aggregate_id", event.Aggregate().ID, "instance", event.Aggregate().InstanceID).Warn("event is ignored because human not exists")
 			return zerrors.ThrowInvalidArgument(nil, "MODEL-o6Lcq", "event ignored: human not exists")
 		}
-		u.OTPState = int32(model.MFAStateReady)
+		var totpID string
+		totpID, err = totpIDFromEvent(event)
+		if err != nil {
+			return err
+		}
+		u.setTOTPState(totpID, model.MFAStateReady)
 		u.MFAInitSkipped = time.Time{}
 	case user.UserV1MFAOTPRemovedType,
 		user.HumanMFAOTPRemovedType:
-		u.OTPState = int32(model.MFAStateUnspecified)
+		var totpID string
+		totpID, err = totpIDFromEvent(event)
+		if err != nil {
+			return err
+		}
+		u.removeTOTP(totpID)
 	case user.HumanOTPSMSAddedType:
 		u.OTPSMSAdded = true
 	case user.HumanOTPSMSRemovedType:
@@ -508,6 +550,41 @@ func webAuthNViewFromEvent(event eventstore.Event) (*WebAuthNView, error) {
 	return token, err
 }
 
+// setTOTPState sets the state of the TOTP authenticator and computes the OTP state of the user.
+func (u *UserView) setTOTPState(totpID string, state model.MFAState) {
+	index := slices.IndexFunc(u.TOTPs, func(totp *TOTPView) bool { return totp.ID == totpID })
+	if index < 0 {
+		u.TOTPs = append(u.TOTPs, &TOTPView{ID: totpID})
+		index = len(u.TOTPs) - 1
+	}
+	u.TOTPs[index].State = int32(state)
+	u.computeOTPState()
+}
+
+// removeTOTP removes the TOTP authenticator, the OTP state is only reset if it was the last one.
+func (u *UserView) removeTOTP(totpID string) {
+	u.TOTPs = slices.DeleteFunc(u.TOTPs, func(totp *TOTPView) bool { return totp.ID == totpID })
+	u.computeOTPState()
+}
+
+// computeOTPState sets the highest state of the TOTP authenticators, so a verified authenticator is never hidden by an unverified one.
+func (u *UserView) computeOTPState() {
+	u.OTPState = int32(model.MFAStateUnspecified)
+	for _, totp := range u.TOTPs {
+		u.OTPState = max(u.OTPState, totp.State)
+	}
+}
+
+func totpIDFromEvent(event eventstore.Event) (string, error) {
+	totp := new(struct {
+		TOTPID string `json:"totpId"`
+	})
+	if err := event.Unmarshal(totp); err != nil {
+		return "", zerrors.ThrowInternal(err, "MODEL-Tq4oP", "could not unmarshal data")
+	}
+	return totp.TOTPID, nil
+}
+
 func (u *UserView) ComputeObject() {
 	if !u.MachineView.IsZero() {
 		if u.State == int32(model.UserStateUnspecified) {
@@ -137,6 +137,12 @@ func (v *UserSessionView) AppendEvent(event eventstore.Event) error {
 		v.MultiFactorVerification = sql.NullTime{Time: event.CreatedAt(), Valid: true}
 		v.MultiFactorVerificationType = sql.NullInt32{Int32: int32(domain.MFATypeU2FUserVerification)}
 		v.State.V = domain.UserSessionStateActive
+	case user.HumanX509CheckSucceededType:
+		v.MultiFactorVerification = sql.NullTime{Time: event.CreatedAt(), Valid: true}
+		v.MultiFactorVerificationType = sql.NullInt32{Int32: int32(domain.MFATypeX509Certificate)}
+		v.State.V = domain.UserSessionStateActive
+	case user.HumanX509CheckFailedType:
+		v.MultiFactorVerification = sql.NullTime{Time: time.Time{}, Valid: true}
 	case user.HumanPasswordlessTokenCheckFailedType,
 		user.HumanPasswordlessTokenRemovedType:
 		v.PasswordlessVerification = sql.NullTime{Time: time.Time{}, Valid: true}
@@ -184,6 +190,8 @@ func (v *UserSessionView) AppendEvent(event eventstore.Event) error {
 		if v.UserAgentID == data.UserAgentID {
 			v.setSecondFactorVerification(event.CreatedAt(), domain.MFATypeOTPEmail)
 		}
+	// the removal of a named TOTP authenticator resets the verification as well,
+	// as the check events don't tell which of the authenticators verified the session
 	case user.UserV1MFAOTPCheckFailedType,
 		user.UserV1MFAOTPRemovedType,
 		user.HumanMFAOTPCheckFailedType,
@@ -204,8 +212,18 @@ func (v *UserSessionView) AppendEvent(event eventstore.Event) error {
 		}
 	case user.HumanU2FTokenCheckSucceededType:
 		v.setSecondFactorVerification(event.CreatedAt(), domain.MFATypeU2F)
+	case user.HumanTrustedDeviceCheckSucceededType:
+		data := new(es_model.TrustedDeviceCheck)
+		err := data.SetData(event)
+		if err != nil {
+			return err
+		}
+		if v.UserAgentID == data.UserAgentID {
+			v.setSecondFactorVerification(event.CreatedAt(), data.MFAType)
+		}
 	case user.UserV1SignedOutType,
 		user.HumanSignedOutType,
+		user.HumanSignedOutEverywhereType,
 		user.UserLockedType,
 		user.UserDeactivatedType,
 		user.UserRemovedType:
@@ -238,6 +256,8 @@ func (v *UserSessionView) EventTypes() []eventstore.EventType {
 		user.HumanPasswordlessTokenCheckSucceededType,
 		user.HumanPasswordlessTokenCheckFailedType,
 		user.HumanPasswordlessTokenRemovedType,
+		user.HumanX509CheckSucceededType,
+		user.HumanX509CheckFailedType,
 		user.UserV1PasswordCheckFailedType,
 		user.HumanPasswordCheckFailedType,
 		user.UserV1PasswordChangedType,
@@ -257,8 +277,10 @@ func (v *UserSessionView) EventTypes() []eventstore.EventType {
 		user.HumanU2FTokenRemovedType,
 		user.HumanU2FTokenVerifiedType,
 		user.HumanU2FTokenCheckSucceededType,
+		user.HumanTrustedDeviceCheckSucceededType,
 		user.UserV1SignedOutType,
 		user.HumanSignedOutType,
+		user.HumanSignedOutEverywhereType,
 		user.UserLockedType,
 		user.UserDeactivatedType,
 		user.UserIDPLinkRemovedType,
@@ -45,7 +45,12 @@ SELECT
     , n.verified_email
     , h.phone
     , h.is_phone_verified
-    , (SELECT COALESCE((SELECT state FROM auth_methods WHERE method_type = 1), 0)) AS otp_state
+    , (SELECT COALESCE(MAX(state), 0) FROM auth_methods WHERE method_type = 1) AS otp_state
+    , (SELECT
+          JSONB_AGG(json_build_object('totpId', token_id, 'state', state))
+        FROM auth_methods
+        WHERE method_type = 1
+        ) AS totps
     , CASE
         WHEN EXISTS (SELECT true FROM verified_auth_methods WHERE method_type = 3) THEN 2
         WHEN EXISTS (SELECT true FROM verified_auth_methods WHERE method_type = 2) THEN 1
@@ -0,0 +1,220 @@
+package webauthn
+
+import (
+	"crypto/sha1" //nolint:gosec // the key identifiers of the metadata service are defined as SHA-1 hash
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"slices"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-webauthn/webauthn/metadata"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/google/uuid"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var metadataSignatureAlgorithms = []jose.SignatureAlgorithm{jose.RS256, jose.RS384, jose.RS512, jose.ES256, jose.ES384, jose.ES512, jose.PS256}
+
+// Metadata contains the entries of a FIDO Metadata Service BLOB.
+// The BLOB is loaded from a local file, so the registration of authenticators doesn't depend on the availability of the metadata service.
+type Metadata struct {
+	byAAGUID         map[uuid.UUID]*metadata.MetadataBLOBPayloadEntry
+	byKeyIdentifiers map[string]*metadata.MetadataBLOBPayloadEntry
+}
+
+// LoadMetadata reads the BLOB from the path and verifies its signature against the root certificate of the FIDO Metadata Service.
+// No metadata is returned if the path is empty.
+func LoadMetadata(path string) (*Metadata, error) {
+	if path == "" {
+		return nil, nil
+	}
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "WEBAU-Md9rF", "unable to read metadata blob")
+	}
+	root, err := parseBase64Certificate(metadata.ProductionMDSRoot)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "WEBAU-Md9rR", "unable to parse metadata root certificate")
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+	return ParseMetadata(blob, roots)
+}
+
+// ParseMetadata parses the BLOB after verifying its signing certificate chain against the roots.
+func ParseMetadata(blob []byte, roots *x509.CertPool) (*Metadata, error) {
+	jws, err := jose.ParseSigned(string(blob), metadataSignatureAlgorithms)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "WEBAU-Md9pA", "unable to parse metadata blob")
+	}
+	if len(jws.Signatures) != 1 {
+		return nil, zerrors.ThrowInternal(nil, "WEBAU-Md9sI", "metadata blob must have exactly one signature")
+	}
+	chains, err := jws.Signatures[0].Protected.Certificates(x509.VerifyOptions{
+		Roots:     roots,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "WEBAU-Md9cV", "untrusted metadata blob signing certificate")
+	}
+	payload, err := jws.Verify(chains[0][0].PublicKey)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "WEBAU-Md9vS", "invalid metadata blob signature")
+	}
+	var blobPayload metadata.MetadataBLOBPayload
+	if err = json.Unmarshal(payload, &blobPayload); err != nil {
+		return nil, zerrors.ThrowInternal(err, "WEBAU-Md9uP", "unable to unmarshal metadata blob")
+	}
+	return newMetadata(blobPayload.Entries), nil
+}
+
+func newMetadata(entries []metadata.MetadataBLOBPayloadEntry) *Metadata {
+	m := &Metadata{
+		byAAGUID:         make(map[uuid.UUID]*metadata.MetadataBLOBPayloadEntry, len(entries)),
+		byKeyIdentifiers: make(map[string]*metadata.MetadataBLOBPayloadEntry),
+	}
+	for i, entry := range entries {
+		// U2F authenticators have no AAGUID and are identified by their attestation certificate
+		for _, keyIdentifier := range entry.AttestationCertificateKeyIdentifiers {
+			m.byKeyIdentifiers[keyIdentifier] = &entries[i]
+		}
+		if entry.AaGUID == "" {
+			continue
+		}
+		aaguid, err := uuid.Parse(entry.AaGUID)
+		if err != nil {
+			continue
+		}
+		m.byAAGUID[aaguid] = &entries[i]
+	}
+	return m
+}
+
+func (m *Metadata) entry(aaguid uuid.UUID, attestationCert *x509.Certificate) *metadata.MetadataBLOBPayloadEntry {
+	if entry, ok := m.byAAGUID[aaguid]; ok {
+		return entry
+	}
+	return m.byKeyIdentifiers[keyIdentifier(attestationCert)]
+}
+
+// keyIdentifier returns the SHA-1 hash of the public key of the certificate as lowercase hex,
+// which identifies U2F authenticators in the metadata.
+func keyIdentifier(cert *x509.Certificate) string {
+	if len(cert.SubjectKeyId) > 0 {
+		return hex.EncodeToString(cert.SubjectKeyId)
+	}
+	hash := sha1.Sum(cert.RawSubjectPublicKeyInfo) //nolint:gosec
+	return hex.EncodeToString(hash[:])
+}
+
+// HasMetadata returns true if a metadata BLOB is loaded and attestations can be verified.
+func (w *Config) HasMetadata() bool {
+	return w.Metadata != nil
+}
+
+// verifyAttestation enforces the policy on the attestation of a registered credential.
+// The signature of the attestation statement is already verified while creating the credential.
+func (w *Config) verifyAttestation(policy *domain.WebAuthNPolicy, attestation protocol.AttestationObject) error {
+	if policy == nil {
+		return nil
+	}
+	aaguid, err := uuid.FromBytes(attestation.AuthData.AttData.AAGUID)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "WEBAU-At9gI", "Errors.User.WebAuthN.ErrorOnParseCredential")
+	}
+	if slices.Contains(policy.DeniedAAGUIDs, aaguid.String()) {
+		return zerrors.ThrowPreconditionFailed(nil, "WEBAU-At9dN", "Errors.User.WebAuthN.AuthenticatorDenied")
+	}
+	if len(policy.AllowedAAGUIDs) > 0 && !slices.Contains(policy.AllowedAAGUIDs, aaguid.String()) {
+		return zerrors.ThrowPreconditionFailed(nil, "WEBAU-At9aL", "Errors.User.WebAuthN.AuthenticatorNotAllowed")
+	}
+	if !policy.RequireAttestation && !policy.VerifyMetadata {
+		return nil
+	}
+	chain, err := attestationCertificates(attestation)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "WEBAU-At9cP", "Errors.User.WebAuthN.AttestationInvalid")
+	}
+	// self attestation is signed by the credential key itself and doesn't prove the authenticator model
+	if len(chain) == 0 {
+		return zerrors.ThrowPreconditionFailed(nil, "WEBAU-At9rQ", "Errors.User.WebAuthN.AttestationRequired")
+	}
+	if !policy.VerifyMetadata {
+		return nil
+	}
+	if w.Metadata == nil {
+		return zerrors.ThrowInternal(nil, "WEBAU-At9mU", "Errors.User.WebAuthN.MetadataUnavailable")
+	}
+	entry := w.Metadata.entry(aaguid, chain[0])
+	if entry == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "WEBAU-At9uK", "Errors.User.WebAuthN.AuthenticatorUnknown")
+	}
+	for _, report := range entry.StatusReports {
+		if metadata.IsUndesiredAuthenticatorStatus(report.Status) {
+			return zerrors.ThrowPreconditionFailed(nil, "WEBAU-At9sU", "Errors.User.WebAuthN.AuthenticatorCompromised")
+		}
+	}
+	if err = verifyAttestationChain(chain, entry.MetadataStatement.AttestationRootCertificates); err != nil {
+		return zerrors.ThrowPreconditionFailed(err, "WEBAU-At9tU", "Errors.User.WebAuthN.AttestationUntrusted")
+	}
+	return nil
+}
+
+func attestationCertificates(attestation protocol.AttestationObject) ([]*x509.Certificate, error) {
+	x5c, ok := attestation.AttStatement["x5c"].([]interface{})
+	if !ok {
+		return nil, nil
+	}
+	chain := make([]*x509.Certificate, len(x5c))
+	for i, raw := range x5c {
+		der, ok := raw.([]byte)
+		if !ok {
+			return nil, zerrors.ThrowInvalidArgument(nil, "WEBAU-At9xC", "invalid attestation certificate")
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, err
+		}
+		chain[i] = cert
+	}
+	return chain, nil
+}
+
+// verifyAttestationChain verifies the attestation certificate against the trust anchors of the authenticator model.
+// A trust anchor can be a root, an intermediate or the attestation certificate itself.
+func verifyAttestationChain(chain []*x509.Certificate, trustAnchors []string) error {
+	roots := x509.NewCertPool()
+	for _, anchor := range trustAnchors {
+		cert, err := parseBase64Certificate(anchor)
+		if err != nil {
+			continue
+		}
+		if cert.Equal(chain[0]) {
+			return nil
+		}
+		roots.AddCert(cert)
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := chain[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	return err
+}
+
+func parseBase64Certificate(encoded string) (*x509.Certificate, error) {
+	der, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der)
+}
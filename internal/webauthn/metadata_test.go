@@ -0,0 +1,285 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-webauthn/webauthn/metadata"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	testAAGUID     = uuid.MustParse("cb69481e-8ff7-4039-93ec-0a2729a154a8")
+	testU2FAAGUID  = uuid.UUID{}
+	otherAAGUID    = uuid.MustParse("ee882879-721c-4913-9775-3dfcce97072a")
+	revokedAAGUID  = uuid.MustParse("2fc0579f-8113-47ea-b116-bb5a8db9202a")
+	unknownAAGUID  = uuid.MustParse("0bb43545-fd2c-4185-87dd-feb0b2916ace")
+	testCertSerial = int64(1)
+)
+
+func TestParseMetadata(t *testing.T) {
+	root, rootKey := newTestCertificate(t, "mds root", nil, nil)
+	signer, signerKey := newTestCertificate(t, "mds signer", root, rootKey)
+	otherRoot, _ := newTestCertificate(t, "other root", nil, nil)
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+	payload := metadata.MetadataBLOBPayload{
+		Number: 1,
+		Entries: []metadata.MetadataBLOBPayloadEntry{
+			{AaGUID: testAAGUID.String()},
+			{AttestationCertificateKeyIdentifiers: []string{"abcd"}},
+		},
+	}
+
+	t.Run("untrusted signer", func(t *testing.T) {
+		other := x509.NewCertPool()
+		other.AddCert(otherRoot)
+		_, err := ParseMetadata(newTestBLOB(t, payload, signerKey, signer), other)
+		require.ErrorIs(t, err, zerrors.ThrowInternal(nil, "WEBAU-Md9cV", "untrusted metadata blob signing certificate"))
+	})
+	t.Run("invalid blob", func(t *testing.T) {
+		_, err := ParseMetadata([]byte("invalid"), roots)
+		require.ErrorIs(t, err, zerrors.ThrowInternal(nil, "WEBAU-Md9pA", "unable to parse metadata blob"))
+	})
+	t.Run("ok", func(t *testing.T) {
+		got, err := ParseMetadata(newTestBLOB(t, payload, signerKey, signer), roots)
+		require.NoError(t, err)
+		assert.Len(t, got.byAAGUID, 1)
+		assert.NotNil(t, got.byAAGUID[testAAGUID])
+		assert.NotNil(t, got.byKeyIdentifiers["abcd"])
+	})
+}
+
+func TestConfig_verifyAttestation(t *testing.T) {
+	authenticatorRoot, authenticatorRootKey := newTestCertificate(t, "authenticator root", nil, nil)
+	attestationCert, _ := newTestCertificate(t, "attestation", authenticatorRoot, authenticatorRootKey)
+	u2fCert, _ := newTestCertificate(t, "u2f attestation", authenticatorRoot, authenticatorRootKey)
+	untrustedRoot, untrustedRootKey := newTestCertificate(t, "untrusted root", nil, nil)
+	untrustedCert, _ := newTestCertificate(t, "untrusted attestation", untrustedRoot, untrustedRootKey)
+	trustAnchors := []string{base64.StdEncoding.EncodeToString(authenticatorRoot.Raw)}
+	meta := newMetadata([]metadata.MetadataBLOBPayloadEntry{
+		{
+			AaGUID:            testAAGUID.String(),
+			MetadataStatement: metadata.MetadataStatement{AttestationRootCertificates: trustAnchors},
+			StatusReports:     []metadata.StatusReport{{Status: metadata.FidoCertified}},
+		},
+		{
+			AttestationCertificateKeyIdentifiers: []string{keyIdentifier(u2fCert)},
+			MetadataStatement:                    metadata.MetadataStatement{AttestationRootCertificates: trustAnchors},
+		},
+		{
+			AaGUID:            otherAAGUID.String(),
+			MetadataStatement: metadata.MetadataStatement{AttestationRootCertificates: trustAnchors},
+		},
+		{
+			AaGUID:            revokedAAGUID.String(),
+			MetadataStatement: metadata.MetadataStatement{AttestationRootCertificates: trustAnchors},
+			StatusReports:     []metadata.StatusReport{{Status: metadata.FidoCertified}, {Status: metadata.Revoked}},
+		},
+	})
+	type fields struct {
+		metadata *Metadata
+	}
+	type args struct {
+		policy      *domain.WebAuthNPolicy
+		attestation protocol.AttestationObject
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "no policy, ok",
+			args: args{
+				attestation: newTestAttestation(unknownAAGUID),
+			},
+		},
+		{
+			name: "denied aaguid, error",
+			args: args{
+				policy:      &domain.WebAuthNPolicy{DeniedAAGUIDs: []string{testAAGUID.String()}},
+				attestation: newTestAttestation(testAAGUID, attestationCert),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "WEBAU-At9dN", "Errors.User.WebAuthN.AuthenticatorDenied"),
+		},
+		{
+			name: "aaguid not allowed, error",
+			args: args{
+				policy:      &domain.WebAuthNPolicy{AllowedAAGUIDs: []string{otherAAGUID.String()}},
+				attestation: newTestAttestation(testAAGUID, attestationCert),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "WEBAU-At9aL", "Errors.User.WebAuthN.AuthenticatorNotAllowed"),
+		},
+		{
+			name: "allowed aaguid without attestation, ok",
+			args: args{
+				policy:      &domain.WebAuthNPolicy{AllowedAAGUIDs: []string{testAAGUID.String()}},
+				attestation: newTestAttestation(testAAGUID),
+			},
+		},
+		{
+			name: "attestation required, none, error",
+			args: args{
+				policy:      &domain.WebAuthNPolicy{RequireAttestation: true},
+				attestation: newTestAttestation(testAAGUID),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "WEBAU-At9rQ", "Errors.User.WebAuthN.AttestationRequired"),
+		},
+		{
+			name: "attestation required, ok",
+			args: args{
+				policy:      &domain.WebAuthNPolicy{RequireAttestation: true},
+				attestation: newTestAttestation(unknownAAGUID, untrustedCert),
+			},
+		},
+		{
+			name: "verify metadata, not loaded, error",
+			args: args{
+				policy:      &domain.WebAuthNPolicy{VerifyMetadata: true},
+				attestation: newTestAttestation(testAAGUID, attestationCert),
+			},
+			wantErr: zerrors.ThrowInternal(nil, "WEBAU-At9mU", "Errors.User.WebAuthN.MetadataUnavailable"),
+		},
+		{
+			name:   "verify metadata, unknown authenticator, error",
+			fields: fields{metadata: meta},
+			args: args{
+				policy:      &domain.WebAuthNPolicy{VerifyMetadata: true},
+				attestation: newTestAttestation(unknownAAGUID, attestationCert),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "WEBAU-At9uK", "Errors.User.WebAuthN.AuthenticatorUnknown"),
+		},
+		{
+			name:   "verify metadata, revoked authenticator, error",
+			fields: fields{metadata: meta},
+			args: args{
+				policy:      &domain.WebAuthNPolicy{VerifyMetadata: true},
+				attestation: newTestAttestation(revokedAAGUID, attestationCert),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "WEBAU-At9sU", "Errors.User.WebAuthN.AuthenticatorCompromised"),
+		},
+		{
+			name:   "verify metadata, untrusted attestation, error",
+			fields: fields{metadata: meta},
+			args: args{
+				policy:      &domain.WebAuthNPolicy{VerifyMetadata: true},
+				attestation: newTestAttestation(testAAGUID, untrustedCert),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "WEBAU-At9tU", "Errors.User.WebAuthN.AttestationUntrusted"),
+		},
+		{
+			name:   "verify metadata, ok",
+			fields: fields{metadata: meta},
+			args: args{
+				policy:      &domain.WebAuthNPolicy{VerifyMetadata: true, AllowedAAGUIDs: []string{testAAGUID.String()}},
+				attestation: newTestAttestation(testAAGUID, attestationCert),
+			},
+		},
+		{
+			name:   "verify metadata, u2f by key identifier, ok",
+			fields: fields{metadata: meta},
+			args: args{
+				policy:      &domain.WebAuthNPolicy{VerifyMetadata: true},
+				attestation: newTestAttestation(testU2FAAGUID, u2fCert),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &Config{
+				Metadata: tt.fields.metadata,
+			}
+			err := w.verifyAttestation(tt.args.policy, tt.args.attestation)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestWebAuthNPolicy_RequestsAttestation(t *testing.T) {
+	assert.False(t, (*domain.WebAuthNPolicy)(nil).RequestsAttestation())
+	assert.False(t, (&domain.WebAuthNPolicy{}).RequestsAttestation())
+	assert.True(t, (&domain.WebAuthNPolicy{DeniedAAGUIDs: []string{otherAAGUID.String()}}).RequestsAttestation())
+	assert.True(t, (&domain.WebAuthNPolicy{RequireAttestation: true}).RequestsAttestation())
+}
+
+func newTestAttestation(aaguid uuid.UUID, chain ...*x509.Certificate) protocol.AttestationObject {
+	attestation := protocol.AttestationObject{
+		Format:       "none",
+		AttStatement: map[string]interface{}{},
+		AuthData: protocol.AuthenticatorData{
+			AttData: protocol.AttestedCredentialData{
+				AAGUID: aaguid[:],
+			},
+		},
+	}
+	if len(chain) == 0 {
+		return attestation
+	}
+	x5c := make([]interface{}, len(chain))
+	for i, cert := range chain {
+		x5c[i] = cert.Raw
+	}
+	attestation.Format = "packed"
+	attestation.AttStatement["x5c"] = x5c
+	return attestation
+}
+
+func newTestCertificate(t *testing.T, name string, parent *x509.Certificate, parentKey crypto.Signer) (*x509.Certificate, crypto.Signer) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	testCertSerial++
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(testCertSerial),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+func newTestBLOB(t *testing.T, payload metadata.MetadataBLOBPayload, key crypto.Signer, chain ...*x509.Certificate) []byte {
+	x5c := make([]string, len(chain))
+	for i, cert := range chain {
+		x5c[i] = base64.StdEncoding.EncodeToString(cert.Raw)
+	}
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("x5c", x5c),
+	)
+	require.NoError(t, err)
+	data, err := json.Marshal(payload)
+	require.NoError(t, err)
+	jws, err := signer.Sign(data)
+	require.NoError(t, err)
+	serialized, err := jws.CompactSerialize()
+	require.NoError(t, err)
+	return []byte(serialized)
+}